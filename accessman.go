@@ -44,6 +44,11 @@ type accessMan struct {
 	// numRestricted tracks the number of peers with restricted access in
 	// peerScores. This MUST be accessed with the banScoreMtx held.
 	numRestricted int64
+
+	// numInbound tracks the number of inbound peers in peerScores,
+	// regardless of their access. This MUST be accessed with the
+	// banScoreMtx held.
+	numInbound int64
 }
 
 type accessManConfig struct {
@@ -57,6 +62,19 @@ type accessManConfig struct {
 
 	// maxRestrictedSlots is the number of restricted slots we'll allocate.
 	maxRestrictedSlots int64
+
+	// maxInboundPeers is the number of inbound peers above which we reject
+	// inbound connections from peers without channels. Zero disables the
+	// limit.
+	maxInboundPeers int64
+
+	// reservedChanSlots is the number of the maxInboundPeers slots that
+	// only peers with channels and peers with a reserved slot may use.
+	reservedChanSlots int64
+
+	// hasReservedSlot determines whether a peer without channels may use
+	// the inbound slots reserved for channel peers.
+	hasReservedSlot func(*btcec.PublicKey) bool
 }
 
 func newAccessMan(cfg *accessManConfig) (*accessMan, error) {
@@ -186,9 +204,45 @@ func (a *accessMan) assignPeerPerms(remotePub *btcec.PublicKey) (
 		return access, ErrNoMoreRestrictedAccessSlots
 	}
 
+	if err := a.checkInboundSlot(ctx, remotePub); err != nil {
+		return access, err
+	}
+
 	return access, nil
 }
 
+// checkInboundSlot checks whether a peer without channels may take one of our
+// inbound slots. Once only the slots reserved for channel peers are left,
+// only peers with a reserved slot are accepted.
+//
+// NOTE: This MUST be called with the banScoreMtx held.
+func (a *accessMan) checkInboundSlot(ctx context.Context,
+	remotePub *btcec.PublicKey) error {
+
+	if a.cfg.maxInboundPeers == 0 {
+		return nil
+	}
+
+	unreserved := a.cfg.maxInboundPeers - a.cfg.reservedChanSlots
+	if a.numInbound < unreserved {
+		return nil
+	}
+
+	if a.cfg.hasReservedSlot(remotePub) {
+		acsmLog.DebugS(ctx, "Peer has a reserved inbound slot",
+			"num_inbound", a.numInbound)
+
+		return nil
+	}
+
+	acsmLog.WarnS(ctx, "Only reserved inbound slots available, "+
+		"denying peer", ErrNoMoreInboundSlots,
+		"num_inbound", a.numInbound, "max_inbound",
+		a.cfg.maxInboundPeers, "reserved", a.cfg.reservedChanSlots)
+
+	return ErrNoMoreInboundSlots
+}
+
 // newPendingOpenChan is called after the pending-open channel has been
 // committed to the database. This may transition a restricted-access peer to a
 // temporary-access peer.
@@ -258,9 +312,8 @@ func (a *accessMan) newPendingOpenChan(remotePub *btcec.PublicKey) error {
 		oldRestricted := a.numRestricted
 		a.numRestricted -= 1
 
-		a.peerScores[peerMapKey] = peerSlotStatus{
-			state: peerStatusTemporary,
-		}
+		status.state = peerStatusTemporary
+		a.peerScores[peerMapKey] = status
 
 		acsmLog.InfoS(ctx, "Peer transitioned restricted -> "+
 			"temporary (pending open)",
@@ -350,9 +403,8 @@ func (a *accessMan) newPendingCloseChan(remotePub *btcec.PublicKey) error {
 
 			// Otherwise, there is an available restricted-access
 			// slot, so we can demote this peer.
-			a.peerScores[peerMapKey] = peerSlotStatus{
-				state: peerStatusRestricted,
-			}
+			status.state = peerStatusRestricted
+			a.peerScores[peerMapKey] = status
 
 			// Update numRestricted.
 			oldRestricted := a.numRestricted
@@ -444,10 +496,8 @@ func (a *accessMan) newOpenChan(remotePub *btcec.PublicKey) error {
 
 		a.peerChanInfo[peerMapKey] = peerCount
 
-		newStatus := peerSlotStatus{
-			state: peerStatusProtected,
-		}
-		a.peerScores[peerMapKey] = newStatus
+		status.state = peerStatusProtected
+		a.peerScores[peerMapKey] = status
 
 		acsmLog.InfoS(ctx, "Peer transitioned temporary -> "+
 			"protected (channel opened)")
@@ -525,6 +575,10 @@ func (a *accessMan) checkAcceptIncomingConn(remotePub *btcec.PublicKey) (
 	acsmLog.DebugS(ctx, "Peer not found in counts, checking restricted "+
 		"slots")
 
+	if err := a.checkInboundSlot(ctx, remotePub); err != nil {
+		return false, err
+	}
+
 	// Check numRestricted to see if there is an available slot. In
 	// the future, it's possible to add better heuristics.
 	if a.numRestricted < a.cfg.maxRestrictedSlots {
@@ -571,7 +625,15 @@ func (a *accessMan) addPeerAccess(remotePub *btcec.PublicKey,
 		return
 	}
 
-	a.peerScores[peerMapKey] = peerSlotStatus{state: access}
+	a.peerScores[peerMapKey] = peerSlotStatus{
+		state:   access,
+		inbound: inbound,
+	}
+
+	// Every inbound peer takes one of our inbound slots.
+	if inbound {
+		a.numInbound++
+	}
 
 	// Exit early if this is not a restricted peer.
 	if access != peerStatusRestricted {
@@ -634,6 +696,10 @@ func (a *accessMan) removePeerAccess(ctx context.Context, peerPubKey string) {
 			"new_restricted", a.numRestricted)
 	}
 
+	if status.inbound {
+		a.numInbound--
+	}
+
 	acsmLog.TraceS(ctx, "Deleting from peerScores:")
 
 	delete(a.peerScores, peerPubKey)
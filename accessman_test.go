@@ -178,6 +178,74 @@ func TestAccessManRestrictedSlots(t *testing.T) {
 	require.False(t, ok)
 }
 
+// TestAccessManReservedInboundSlots tests that the inbound slots reserved for
+// channel peers can't be taken by other peers, unless they have a reserved
+// slot.
+func TestAccessManReservedInboundSlots(t *testing.T) {
+	t.Parallel()
+
+	newKey := func() *btcec.PublicKey {
+		priv, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		return priv.PubKey()
+	}
+	chanPeer, reservedPeer := newKey(), newKey()
+	peer1, peer2, peer3 := newKey(), newKey(), newKey()
+
+	cfg := &accessManConfig{
+		initAccessPerms: func() (map[string]channeldb.ChanCount,
+			error) {
+
+			return map[string]channeldb.ChanCount{
+				string(chanPeer.SerializeCompressed()): {
+					HasOpenOrClosedChan: true,
+				},
+			}, nil
+		},
+		shouldDisconnect: func(*btcec.PublicKey) (bool, error) {
+			return false, nil
+		},
+		maxRestrictedSlots: 10,
+		maxInboundPeers:    3,
+		reservedChanSlots:  1,
+		hasReservedSlot: func(pub *btcec.PublicKey) bool {
+			return pub.IsEqual(reservedPeer)
+		},
+	}
+
+	a, err := newAccessMan(cfg)
+	require.NoError(t, err)
+
+	// The two unreserved slots are taken by peers without channels.
+	assertInboundConnection(t, a, peer1, peerStatusRestricted)
+	assertInboundConnection(t, a, peer2, peerStatusRestricted)
+	require.Equal(t, int64(2), a.numInbound)
+
+	// Another peer without channels is rejected.
+	accepted, err := a.checkAcceptIncomingConn(peer3)
+	require.ErrorIs(t, err, ErrNoMoreInboundSlots)
+	require.False(t, accepted)
+
+	_, err = a.assignPeerPerms(peer3)
+	require.ErrorIs(t, err, ErrNoMoreInboundSlots)
+
+	// A peer with a reserved slot and a channel peer are still accepted,
+	// the latter even once all inbound slots are taken.
+	assertInboundConnection(t, a, reservedPeer, peerStatusRestricted)
+	assertInboundConnection(t, a, chanPeer, peerStatusProtected)
+	require.Equal(t, int64(4), a.numInbound)
+
+	// Once inbound peers disconnect, the slots are available again.
+	ctx := t.Context()
+	a.removePeerAccess(ctx, string(peer1.SerializeCompressed()))
+	a.removePeerAccess(ctx, string(peer2.SerializeCompressed()))
+	a.removePeerAccess(ctx, string(chanPeer.SerializeCompressed()))
+	require.Equal(t, int64(1), a.numInbound)
+
+	assertInboundConnection(t, a, peer3, peerStatusRestricted)
+}
+
 // TestAssignPeerPerms asserts that the peer's access status is correctly
 // assigned.
 func TestAssignPeerPerms(t *testing.T) {
@@ -584,7 +652,10 @@ func TestAddPeerAccessInbound(t *testing.T) {
 	score, ok := a.peerScores[pubStr]
 	require.True(t, ok)
 
-	expecedScore := peerSlotStatus{state: peerStatusRestricted}
+	expecedScore := peerSlotStatus{
+		state:   peerStatusRestricted,
+		inbound: true,
+	}
 	require.Equal(t, expecedScore, score)
 
 	// Add this peer again, we expect the available slots to stay unchanged.
@@ -619,7 +690,10 @@ func TestAddPeerAccessInbound(t *testing.T) {
 	score, ok = a.peerScores[pubStr]
 	require.True(t, ok)
 
-	expecedScore = peerSlotStatus{state: peerStatusTemporary}
+	expecedScore = peerSlotStatus{
+		state:   peerStatusTemporary,
+		inbound: true,
+	}
 	require.Equal(t, expecedScore, score)
 }
 
@@ -699,7 +773,10 @@ func TestAddPeerAccessOutbound(t *testing.T) {
 	score, ok = a.peerScores[pubStr]
 	require.True(t, ok)
 
-	expecedScore = peerSlotStatus{state: peerStatusTemporary}
+	expecedScore = peerSlotStatus{
+		state:   peerStatusTemporary,
+		inbound: true,
+	}
 	require.Equal(t, expecedScore, score)
 }
 
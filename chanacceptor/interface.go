@@ -24,8 +24,13 @@ type ChannelAcceptRequest struct {
 	Node *btcec.PublicKey
 
 	// OpenChanMsg is the actual OpenChannel protocol message that the peer
-	// sent to us.
+	// sent to us. For dual funded channels, this message is derived from
+	// the peer's open_channel2 message.
 	OpenChanMsg *lnwire.OpenChannel
+
+	// DualFunded is true if the peer requested a dual funded channel, in
+	// which case we may contribute funds to the channel ourselves.
+	DualFunded bool
 }
 
 // ChannelAcceptResponse is a struct containing the response to a request to
@@ -66,6 +71,10 @@ type ChannelAcceptResponse struct {
 	// ZeroConf indicates that the fundee wishes to send min_depth = 0 and
 	// request a zero-conf channel with the counter-party.
 	ZeroConf bool
+
+	// FundingContribution is the amount we contribute to a dual funded
+	// channel. It is ignored for channels that aren't dual funded.
+	FundingContribution btcutil.Amount
 }
 
 // NewChannelAcceptResponse is a constructor for a channel accept response,
@@ -217,6 +217,8 @@ func (r *RPCAcceptor) receiveResponses(errChan chan error,
 			MinHtlcIn:       resp.MinHtlcIn,
 			MinAcceptDepth:  resp.MinAcceptDepth,
 			ZeroConf:        resp.ZeroConf,
+
+			FundingContributionSat: resp.FundingContributionSat,
 		}
 
 		// We have received a decision for one of our channel
@@ -453,6 +455,7 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 				CommitmentType:   commitmentType,
 				WantsZeroConf:    wantsZeroConf,
 				WantsScidAlias:   wantsScidAlias,
+				DualFunded:       req.DualFunded,
 			}
 
 			if err := r.send(chanAcceptReq); err != nil {
@@ -483,7 +486,7 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 				log.Errorf("Invalid acceptor response: %v", err)
 			}
 
-			requestInfo.response <- NewChannelAcceptResponse(
+			acceptResp := NewChannelAcceptResponse(
 				accept, acceptErr, shutdown,
 				uint16(resp.CsvDelay),
 				uint16(resp.MaxHtlcCount),
@@ -494,6 +497,15 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 				resp.ZeroConf,
 			)
 
+			// We only contribute to channels that are dual funded.
+			if accept && requestInfo.request.DualFunded {
+				acceptResp.FundingContribution = btcutil.Amount(
+					resp.FundingContributionSat,
+				)
+			}
+
+			requestInfo.response <- acceptResp
+
 			// Delete the channel from the acceptRequests map.
 			delete(acceptRequests, pendingID)
 
@@ -8,6 +8,7 @@ import (
 	"os"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/btcsuite/btcd/wire/v2"
 	"github.com/lightningnetwork/lnd/channeldb"
@@ -48,8 +49,20 @@ type ChannelEvent struct {
 	// NewChans is the set of channels that have been opened since the last
 	// event.
 	NewChans []ChannelWithAddrs
+
+	// UpdatedChans is the set of channels whose commitment state has been
+	// updated since the last event. Only channels that are already part of
+	// the backup are refreshed, keeping the addresses we know of for the
+	// peer, so the Addrs field may be left empty.
+	UpdatedChans []ChannelWithAddrs
 }
 
+// DefaultStateRefreshInterval is the default interval at which the backup file
+// is rewritten to include the latest state of channels that received updates.
+// As channel states can change many times per second, we only refresh the
+// file periodically rather than on every single update.
+const DefaultStateRefreshInterval = time.Minute
+
 // manualUpdate holds a group of channel state updates and an error channel
 // to send back an error happened upon update processing or file updating.
 type manualUpdate struct {
@@ -113,6 +126,14 @@ type SubSwapper struct {
 
 	manualUpdates chan manualUpdate
 
+	// stateRefreshInterval is the interval at which we'll write the
+	// latest state of updated channels to the backup file.
+	stateRefreshInterval time.Duration
+
+	// staleState is true if the state of a channel in backupState has
+	// been updated since the backup file was last written.
+	staleState bool
+
 	// keyRing is the main key ring that will allow us to pack the new
 	// multi backup.
 	keyRing keychain.KeyRing
@@ -161,6 +182,8 @@ func NewSubSwapper(ctx context.Context, startingChans []Single,
 		Swapper:       backupSwapper,
 		quit:          make(chan struct{}),
 		manualUpdates: make(chan manualUpdate),
+
+		stateRefreshInterval: DefaultStateRefreshInterval,
 	}, nil
 }
 
@@ -277,9 +300,15 @@ func (s *SubSwapper) updateBackupFile(closedChans ...wire.OutPoint) error {
 	}
 	for _, memChannel := range s.backupState {
 		chanPoint := memChannel.FundingOutpoint
-		if _, ok := combinedBackup[chanPoint]; ok {
+		if diskChannel, ok := combinedBackup[chanPoint]; ok {
 			log.Warnf("Replacing disk backup for ChannelPoint(%v) "+
 				"w/ newer version", chanPoint)
+
+			// Carry over any addresses of the peer that we knew
+			// of before, so we don't lose them if the peer stops
+			// advertising them.
+			memChannel = memChannel.WithAddrHistory(diskChannel)
+			s.backupState[chanPoint] = memChannel
 		}
 
 		combinedBackup[chanPoint] = memChannel
@@ -315,6 +344,8 @@ func (s *SubSwapper) updateBackupFile(closedChans ...wire.OutPoint) error {
 		return fmt.Errorf("unable to update multi backup: %w", err)
 	}
 
+	s.staleState = false
+
 	return nil
 }
 
@@ -334,6 +365,9 @@ func (s *SubSwapper) backupUpdater() {
 	// Mark the SubSwapper as active.
 	s.isActive.Store(true)
 
+	refreshTicker := time.NewTicker(s.stateRefreshInterval)
+	defer refreshTicker.Stop()
+
 	for {
 		select {
 		// The channel state has been modified! We'll evaluate all
@@ -369,6 +403,21 @@ func (s *SubSwapper) backupUpdater() {
 				closedChans = append(closedChans, closedChan)
 			}
 
+			// For all updated channels, we'll refresh the backup
+			// with the latest commitment state. We don't write the
+			// file right away, but wait for the next refresh tick.
+			for _, updatedChan := range chanUpdate.UpdatedChans {
+				s.refreshChannelState(updatedChan.OpenChannel)
+			}
+
+			// If the event only carried state updates, we're done
+			// for now.
+			if len(chanUpdate.NewChans) == 0 &&
+				len(chanUpdate.ClosedChans) == 0 {
+
+				continue
+			}
+
 			newStateSize := len(s.backupState)
 
 			log.Infof("Updating on-disk multi SCB backup: "+
@@ -414,6 +463,21 @@ func (s *SubSwapper) backupUpdater() {
 			// 1 not to block here.
 			manualUpdate.errChan <- err
 
+		// Write the latest state of any updated channels to the
+		// backup file.
+		case <-refreshTicker.C:
+			if !s.staleState {
+				continue
+			}
+
+			log.Debugf("Refreshing on-disk multi SCB backup with " +
+				"latest channel states")
+
+			if err := s.updateBackupFile(); err != nil {
+				log.Errorf("unable to update backup file: %v",
+					err)
+			}
+
 		// TODO(roasbeef): refresh periodically on a time basis due to
 		// possible addr changes from node
 
@@ -423,3 +487,22 @@ func (s *SubSwapper) backupUpdater() {
 		}
 	}
 }
+
+// refreshChannelState replaces the backup of an already known channel with
+// one built from its latest state, keeping the addresses we know of for the
+// peer. Updates of channels that aren't part of the backup are ignored.
+func (s *SubSwapper) refreshChannelState(channel *channeldb.OpenChannel) {
+	prior, ok := s.backupState[channel.FundingOutpoint]
+	if !ok {
+		return
+	}
+
+	single := NewSingle(channel, prior.Addresses)
+	single.AddrHistory = prior.AddrHistory
+
+	log.Tracef("Refreshing backup state of channel %v",
+		channel.FundingOutpoint)
+
+	s.backupState[channel.FundingOutpoint] = single
+	s.staleState = true
+}
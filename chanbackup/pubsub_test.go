@@ -3,10 +3,12 @@ package chanbackup
 import (
 	"context"
 	"fmt"
+	"net"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/fn/v2"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnencrypt"
 	"github.com/stretchr/testify/require"
@@ -294,3 +296,79 @@ func TestSubSwapperUpdater(t *testing.T) {
 	swapper.fail = true
 	require.Error(t, subSwapper.ManualUpdate([]Single{single}))
 }
+
+// TestSubSwapperStateRefresh tests that the SubSwapper refreshes the backup of
+// known channels once their state is updated, while ignoring updates of
+// channels it doesn't know of.
+func TestSubSwapperStateRefresh(t *testing.T) {
+	t.Parallel()
+
+	keyRing := &lnencrypt.MockKeyRing{}
+	chanNotifier := newMockChannelNotifier()
+	swapper := newMockSwapper(keyRing)
+
+	channel, err := genRandomOpenChannelShell()
+	require.NoError(t, err)
+
+	single := NewSingle(channel, []net.Addr{addr1})
+	require.True(t, single.RemoteCommitHeight.IsNone())
+
+	subSwapper, err := NewSubSwapper(
+		t.Context(), []Single{single}, chanNotifier, keyRing, swapper,
+	)
+	require.NoError(t, err)
+	subSwapper.stateRefreshInterval = 10 * time.Millisecond
+
+	require.NoError(t, subSwapper.Start())
+	defer subSwapper.Stop()
+
+	backupSet := map[wire.OutPoint]Single{
+		channel.FundingOutpoint: single,
+	}
+	assertExpectedBackupSwap(t, swapper, subSwapper, keyRing, backupSet)
+
+	// An update of a channel we don't know of shouldn't lead to a new
+	// backup file.
+	unknownChannel, err := genRandomOpenChannelShell()
+	require.NoError(t, err)
+	unknownChannel.RemoteCommitment.CommitHeight = 1
+
+	select {
+	case chanNotifier.chanEvents <- ChannelEvent{
+		UpdatedChans: []ChannelWithAddrs{
+			{OpenChannel: unknownChannel},
+		},
+	}:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("update swapper didn't read channel update")
+	}
+
+	select {
+	case <-swapper.swaps:
+		t.Fatalf("unexpected backup swap")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Once the state of our channel is updated, the backup should be
+	// refreshed with the new remote commitment height on the next tick,
+	// while keeping the addresses of the peer.
+	channel.RemoteCommitment.CommitHeight = 42
+
+	select {
+	case chanNotifier.chanEvents <- ChannelEvent{
+		UpdatedChans: []ChannelWithAddrs{
+			{OpenChannel: channel},
+		},
+	}:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("update swapper didn't read channel update")
+	}
+
+	assertExpectedBackupSwap(t, swapper, subSwapper, keyRing, backupSet)
+
+	require.Len(t, swapper.swapState.StaticBackups, 1)
+	refreshed := swapper.swapState.StaticBackups[0]
+	require.Equal(t, fn.Some(uint64(42)), refreshed.RemoteCommitHeight)
+	require.Len(t, refreshed.Addresses, 1)
+	require.Equal(t, addr1.String(), refreshed.Addresses[0].String())
+}
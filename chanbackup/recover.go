@@ -62,16 +62,25 @@ func Recover(backups []Single, restorer ChannelRestorer,
 			return numRestored, err
 		}
 
+		// The remote commitment height of a v2 backup tells us which
+		// state the remote party is expected to broadcast.
+		backup.RemoteCommitHeight.WhenSome(func(h uint64) {
+			log.Infof("ChannelPoint(%v) was backed up at remote "+
+				"commitment height %d", backup.FundingOutpoint,
+				h)
+		})
+
+		// We'll try all the addresses we know of for the peer,
+		// including the historical ones of a v2 backup.
+		addrs := backups[i].AllAddresses()
+
 		numRestored++
 		log.Infof("Attempting to connect to node=%x (addrs=%v) to "+
 			"restore ChannelPoint(%v)",
 			backup.RemoteNodePub.SerializeCompressed(),
-			lnutils.SpewLogClosure(backups[i].Addresses),
-			backup.FundingOutpoint)
+			lnutils.SpewLogClosure(addrs), backup.FundingOutpoint)
 
-		err = peerConnector.ConnectPeer(
-			backup.RemoteNodePub, backup.Addresses,
-		)
+		err = peerConnector.ConnectPeer(backup.RemoteNodePub, addrs)
 		if err != nil {
 			return numRestored, err
 		}
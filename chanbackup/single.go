@@ -16,6 +16,7 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnencrypt"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
 )
 
 // SingleBackupVersion denotes the version of the single static channel backup.
@@ -64,9 +65,28 @@ const (
 	// final scripts (OP_CHECKSIGVERIFY instead of OP_CHECKSIG + OP_DROP).
 	SimpleTaprootFinalVersion = 7
 
+	// ZeroFeeCommitmentsVersion is a version that denotes this channel is
+	// using the zero-fee v3 commitment format with a single shared P2A
+	// anchor output.
+	ZeroFeeCommitmentsVersion = 8
+
 	// closeTxVersionMask is the byte mask used that is ORed to version byte
 	// on wire indicating that the backup has CloseTxInputs.
 	closeTxVersionMask = 1 << 7
+
+	// extDataVersionMask is the byte mask used that is ORed to version
+	// byte on wire indicating that the backup carries a trailing TLV
+	// stream with extended recovery data (address history, remote
+	// commitment height). As the lower bits of the version byte already
+	// encode the commitment type of the channel, this bit is what
+	// distinguishes a v2 backup from a legacy one. It's only set if
+	// there is extended data to store, so that backups of channels without
+	// any are still readable by older nodes.
+	extDataVersionMask = 1 << 6
+
+	// maxAddrHistory is the maximum number of historical addresses we'll
+	// retain for a single channel peer.
+	maxAddrHistory = 16
 )
 
 // Encode returns encoding of the version to put into channel backup.
@@ -89,13 +109,56 @@ func DecodeVersion(encoded byte) (SingleBackupVersion, bool) {
 	// Find if it has a closing transaction by inspecting the bit.
 	closeTx := (encoded & closeTxVersionMask) != 0
 
-	// The version byte also encodes the closeTxVersion feature, so we
-	// extract it here and return it separately to the backup version.
-	version := SingleBackupVersion(encoded &^ closeTxVersionMask)
+	// The version byte also encodes the closeTxVersion and extended data
+	// features, so we strip them here to obtain the backup version.
+	version := SingleBackupVersion(
+		encoded &^ (closeTxVersionMask | extDataVersionMask),
+	)
 
 	return version, closeTx
 }
 
+// hasExtData returns true if the encoded version byte signals that the backup
+// carries a trailing TLV stream of extended recovery data.
+func hasExtData(encoded byte) bool {
+	return (encoded & extDataVersionMask) != 0
+}
+
+// String returns a human readable name of the backup version.
+func (v SingleBackupVersion) String() string {
+	switch v {
+	case DefaultSingleVersion:
+		return "default"
+
+	case TweaklessCommitVersion:
+		return "tweakless"
+
+	case AnchorsCommitVersion:
+		return "anchors"
+
+	case AnchorsZeroFeeHtlcTxCommitVersion:
+		return "anchors_zero_fee_htlc_tx"
+
+	case ScriptEnforcedLeaseVersion:
+		return "script_enforced_lease"
+
+	case SimpleTaprootVersion:
+		return "simple_taproot"
+
+	case TapscriptRootVersion:
+		return "tapscript_root"
+
+	case SimpleTaprootFinalVersion:
+		return "simple_taproot_final"
+
+	case ZeroFeeCommitmentsVersion:
+		return "zero_fee_commitments"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", byte(v))
+	}
+}
+
 // IsTaproot returns if this is a backup of a taproot channel. This will also be
 // true for simple taproot overlay channels when a version is added.
 func (v SingleBackupVersion) IsTaproot() bool {
@@ -199,6 +262,248 @@ type Single struct {
 	//
 	// The field is optional.
 	CloseTxInputs fn.Option[CloseTxInputs]
+
+	// AddrHistory is the set of addresses that were included in a prior
+	// backup of this channel, but are no longer returned by the address
+	// source. We keep them around (up to maxAddrHistory entries) as the
+	// peer may still be reachable over one of them, e.g. an old Tor
+	// onion service, even if it stopped advertising it.
+	//
+	// NOTE: This field is only serialized as part of the extended data.
+	AddrHistory []net.Addr
+
+	// RemoteCommitHeight is the height of the latest remote commitment
+	// that we knew of at the time the backup was created. During recovery
+	// this serves as a hint of the state the remote party is expected to
+	// broadcast. It's only set once the channel has advanced past its
+	// initial state, so backups of fresh channels remain readable by older
+	// nodes.
+	//
+	// NOTE: This field is only serialized as part of the extended data.
+	RemoteCommitHeight fn.Option[uint64]
+
+	// InteractiveFunding is true if the funding transaction of the channel
+	// was constructed interactively with the remote party. The remote
+	// party knows such a channel by its v2 channel ID.
+	//
+	// NOTE: This field is only serialized as part of the extended data.
+	InteractiveFunding bool
+}
+
+// AllAddresses returns the set of addresses of the channel peer included in
+// the backup, followed by any historical addresses that aren't already part of
+// the main set.
+func (s *Single) AllAddresses() []net.Addr {
+	addrs := make([]net.Addr, 0, len(s.Addresses)+len(s.AddrHistory))
+	addrs = append(addrs, s.Addresses...)
+
+	return mergeAddrs(addrs, s.AddrHistory, len(s.AddrHistory))
+}
+
+// hasExtData returns true if any of the fields that are serialized within the
+// extended data TLV stream are set.
+func (s *Single) hasExtData() bool {
+	return len(s.AddrHistory) != 0 || s.RemoteCommitHeight.IsSome() ||
+		s.InteractiveFunding
+}
+
+// mergeAddrs appends up to limit addresses of the extra set to the base set,
+// skipping any that are already present.
+func mergeAddrs(base, extra []net.Addr, limit int) []net.Addr {
+	known := make(map[string]struct{}, len(base))
+	for _, addr := range base {
+		known[addr.String()] = struct{}{}
+	}
+
+	var added int
+	for _, addr := range extra {
+		if added >= limit {
+			break
+		}
+
+		if _, ok := known[addr.String()]; ok {
+			continue
+		}
+		known[addr.String()] = struct{}{}
+
+		base = append(base, addr)
+		added++
+	}
+
+	return base
+}
+
+// WithAddrHistory returns a copy of the backup with the address history
+// extended by any addresses of the passed prior backup of the same channel
+// that are no longer part of the current address set. The most recently known
+// addresses are retained first.
+func (s Single) WithAddrHistory(prior Single) Single {
+	var current []net.Addr
+	current = append(current, s.Addresses...)
+
+	// The addresses of the prior backup are more recent than its own
+	// history, so we'll add them first.
+	var candidates []net.Addr
+	candidates = append(candidates, prior.Addresses...)
+	candidates = append(candidates, prior.AddrHistory...)
+	candidates = append(candidates, s.AddrHistory...)
+
+	merged := mergeAddrs(current, candidates, maxAddrHistory)
+
+	s.AddrHistory = nil
+	if len(merged) > len(current) {
+		s.AddrHistory = merged[len(current):]
+	}
+
+	return s
+}
+
+// singleExtData houses the extended recovery data of a Single that is
+// serialized as a TLV stream after the legacy fields of the backup.
+type singleExtData struct {
+	// remoteCommitHeight is the height of the latest known remote
+	// commitment.
+	remoteCommitHeight tlv.OptionalRecordT[tlv.TlvType0, uint64]
+
+	// addrHistory is the lnwire encoding of the historical addresses of
+	// the channel peer.
+	addrHistory tlv.OptionalRecordT[tlv.TlvType1, tlv.Blob]
+
+	// interactiveFunding is set if the funding transaction of the channel
+	// was constructed interactively.
+	interactiveFunding tlv.OptionalRecordT[tlv.TlvType2, bool]
+}
+
+// encode serializes the singleExtData to the given io.Writer.
+func (e *singleExtData) encode(w io.Writer) error {
+	var tlvRecords []tlv.Record
+	e.remoteCommitHeight.WhenSome(
+		func(h tlv.RecordT[tlv.TlvType0, uint64]) {
+			tlvRecords = append(tlvRecords, h.Record())
+		},
+	)
+	e.addrHistory.WhenSome(func(b tlv.RecordT[tlv.TlvType1, tlv.Blob]) {
+		tlvRecords = append(tlvRecords, b.Record())
+	})
+	e.interactiveFunding.WhenSome(
+		func(i tlv.RecordT[tlv.TlvType2, bool]) {
+			tlvRecords = append(tlvRecords, i.Record())
+		},
+	)
+
+	tlv.SortRecords(tlvRecords)
+
+	tlvStream, err := tlv.NewStream(tlvRecords...)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// decode deserializes the singleExtData from the given io.Reader.
+func (e *singleExtData) decode(r io.Reader) error {
+	remoteCommitHeight := e.remoteCommitHeight.Zero()
+	addrHistory := e.addrHistory.Zero()
+	interactiveFunding := e.interactiveFunding.Zero()
+
+	tlvStream, err := tlv.NewStream(
+		remoteCommitHeight.Record(), addrHistory.Record(),
+		interactiveFunding.Record(),
+	)
+	if err != nil {
+		return err
+	}
+
+	tlvs, err := tlvStream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := tlvs[remoteCommitHeight.TlvType()]; ok {
+		e.remoteCommitHeight = tlv.SomeRecordT(remoteCommitHeight)
+	}
+	if _, ok := tlvs[addrHistory.TlvType()]; ok {
+		e.addrHistory = tlv.SomeRecordT(addrHistory)
+	}
+	if _, ok := tlvs[interactiveFunding.TlvType()]; ok {
+		e.interactiveFunding = tlv.SomeRecordT(interactiveFunding)
+	}
+
+	return nil
+}
+
+// encodeExtData writes the extended data of the backup as a length prefixed
+// TLV stream.
+func (s *Single) encodeExtData(w *bytes.Buffer) error {
+	var extData singleExtData
+	s.RemoteCommitHeight.WhenSome(func(h uint64) {
+		extData.remoteCommitHeight = tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType0](h),
+		)
+	})
+	if len(s.AddrHistory) != 0 {
+		var b bytes.Buffer
+		if err := lnwire.WriteElements(&b, s.AddrHistory); err != nil {
+			return err
+		}
+
+		extData.addrHistory = tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType1, tlv.Blob](
+				b.Bytes(),
+			),
+		)
+	}
+	if s.InteractiveFunding {
+		extData.interactiveFunding = tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType2](true),
+		)
+	}
+
+	var tlvBytes bytes.Buffer
+	if err := extData.encode(&tlvBytes); err != nil {
+		return err
+	}
+
+	return lnwire.WriteElements(
+		w, uint16(tlvBytes.Len()), tlvBytes.Bytes(),
+	)
+}
+
+// decodeExtData reads the length prefixed TLV stream of extended data written
+// by encodeExtData.
+func (s *Single) decodeExtData(r io.Reader) error {
+	var extLen uint16
+	if err := lnwire.ReadElement(r, &extLen); err != nil {
+		return err
+	}
+
+	var extData singleExtData
+	if err := extData.decode(io.LimitReader(r, int64(extLen))); err != nil {
+		return err
+	}
+
+	extData.remoteCommitHeight.WhenSomeV(func(h uint64) {
+		s.RemoteCommitHeight = fn.Some(h)
+	})
+	extData.interactiveFunding.WhenSomeV(func(i bool) {
+		s.InteractiveFunding = i
+	})
+
+	var addrBlob tlv.Blob
+	extData.addrHistory.WhenSomeV(func(b tlv.Blob) {
+		addrBlob = b
+	})
+	if len(addrBlob) != 0 {
+		err := lnwire.ReadElements(
+			bytes.NewReader(addrBlob), &s.AddrHistory,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // CloseTxInputs contains data needed to produce a force close transaction
@@ -307,6 +612,14 @@ func NewSingle(channel *channeldb.OpenChannel,
 		ShaChainRootDesc: shaChainRootDesc,
 	}
 
+	// Only record the remote commitment height once the channel has seen
+	// any updates, as it's part of the extended data which older nodes
+	// can't read.
+	if height := channel.RemoteCommitment.CommitHeight; height != 0 {
+		single.RemoteCommitHeight = fn.Some(height)
+	}
+	single.InteractiveFunding = channel.ChanType.HasInteractiveFunding()
+
 	switch {
 	case channel.ChanType.IsTaprootFinal():
 		single.Version = SimpleTaprootFinalVersion
@@ -322,6 +635,9 @@ func NewSingle(channel *channeldb.OpenChannel,
 		single.Version = ScriptEnforcedLeaseVersion
 		single.LeaseExpiry = channel.ThawHeight
 
+	case channel.ChanType.HasZeroFeeCommitments():
+		single.Version = ZeroFeeCommitmentsVersion
+
 	case channel.ChanType.ZeroHtlcTxFee():
 		single.Version = AnchorsZeroFeeHtlcTxCommitVersion
 
@@ -361,6 +677,7 @@ func (s *Single) Serialize(w io.Writer) error {
 	case SimpleTaprootVersion:
 	case TapscriptRootVersion:
 	case SimpleTaprootFinalVersion:
+	case ZeroFeeCommitmentsVersion:
 	default:
 		return fmt.Errorf("unable to serialize w/ unknown "+
 			"version: %v", s.Version)
@@ -429,8 +746,12 @@ func (s *Single) Serialize(w io.Writer) error {
 		}
 	}
 
-	// Encode version enum and hasCloseTx flag to version byte.
+	// Encode version enum and hasCloseTx flag to version byte. If we have
+	// any extended data, we'll signal that as well.
 	version := s.Version.Encode(s.CloseTxInputs.IsSome())
+	if s.hasExtData() {
+		version |= extDataVersionMask
+	}
 
 	// Serialize CloseTxInputs if it is provided. Fill err if it fails.
 	err := fn.MapOptionZ(s.CloseTxInputs, func(inputs CloseTxInputs) error {
@@ -483,6 +804,15 @@ func (s *Single) Serialize(w io.Writer) error {
 		return fmt.Errorf("failed to encode CloseTxInputs: %w", err)
 	}
 
+	// The extended data always comes last, so older fields can be parsed
+	// without knowledge of it.
+	if s.hasExtData() {
+		if err := s.encodeExtData(&singleBytes); err != nil {
+			return fmt.Errorf("failed to encode extended data: %w",
+				err)
+		}
+	}
+
 	// TODO(yy): remove the type assertion when we finished refactoring db
 	// into using write buffer.
 	buf, ok := w.(*bytes.Buffer)
@@ -586,6 +916,7 @@ func (s *Single) Deserialize(r io.Reader) error {
 	// Decode version byte to version enum and hasCloseTx flag.
 	var hasCloseTx bool
 	s.Version, hasCloseTx = DecodeVersion(version)
+	extData := hasExtData(version)
 
 	switch s.Version {
 	case DefaultSingleVersion:
@@ -596,6 +927,7 @@ func (s *Single) Deserialize(r io.Reader) error {
 	case SimpleTaprootVersion:
 	case TapscriptRootVersion:
 	case SimpleTaprootFinalVersion:
+	case ZeroFeeCommitmentsVersion:
 	default:
 		return fmt.Errorf("unable to de-serialize w/ unknown "+
 			"version: %v", s.Version)
@@ -700,11 +1032,25 @@ func (s *Single) Deserialize(r io.Reader) error {
 		}
 	}
 
-	if !hasCloseTx {
-		return nil
+	if hasCloseTx {
+		if err := s.decodeCloseTxInputs(r); err != nil {
+			return err
+		}
+	}
+
+	if extData {
+		if err := s.decodeExtData(r); err != nil {
+			return fmt.Errorf("unable to decode extended data: %w",
+				err)
+		}
 	}
 
-	// Deserialize CloseTxInputs if it is present in serialized data.
+	return nil
+}
+
+// decodeCloseTxInputs reads the CloseTxInputs of the backup from the passed
+// reader.
+func (s *Single) decodeCloseTxInputs(r io.Reader) error {
 	commitTx := &wire.MsgTx{}
 	if err := commitTx.Deserialize(r); err != nil {
 		return err
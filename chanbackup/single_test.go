@@ -111,6 +111,15 @@ func assertSingleEqual(t *testing.T, a, b Single) {
 		}
 	}
 
+	require.Len(t, b.AddrHistory, len(a.AddrHistory))
+	for i := 0; i < len(a.AddrHistory); i++ {
+		require.Equal(
+			t, a.AddrHistory[i].String(), b.AddrHistory[i].String(),
+		)
+	}
+	require.Equal(t, a.RemoteCommitHeight, b.RemoteCommitHeight)
+	require.Equal(t, a.InteractiveFunding, b.InteractiveFunding)
+
 	// Make sure that CloseTxInputs are present either in both backups,
 	// or in none of them.
 	require.Equal(t, a.CloseTxInputs.IsSome(), b.CloseTxInputs.IsSome())
@@ -302,6 +311,120 @@ func TestVersionEncoding(t *testing.T) {
 	}
 }
 
+// TestSingleExtData tests that the extended data of a backup is properly
+// signalled in the version byte and survives a serialization round trip.
+func TestSingleExtData(t *testing.T) {
+	t.Parallel()
+
+	channel, err := genRandomOpenChannelShell()
+	require.NoError(t, err)
+
+	// A fresh backup has no extended data, so it should be encoded in the
+	// legacy format that older nodes can still read.
+	single := NewSingle(channel, []net.Addr{addr1})
+
+	var b bytes.Buffer
+	require.NoError(t, single.Serialize(&b))
+	require.False(t, hasExtData(b.Bytes()[0]))
+	require.True(t, single.RemoteCommitHeight.IsNone())
+
+	// Once the channel has advanced, the backup should carry the remote
+	// commitment height.
+	channel.RemoteCommitment.CommitHeight = 1337
+	single = NewSingle(channel, []net.Addr{addr1})
+	require.Equal(t, fn.Some(uint64(1337)), single.RemoteCommitHeight)
+
+	single.AddrHistory = []net.Addr{addr2, addr3, addr4}
+
+	b.Reset()
+	require.NoError(t, single.Serialize(&b))
+
+	// The extended data bit should be set, while the version itself is
+	// left untouched.
+	rawVersion := b.Bytes()[0]
+	require.True(t, hasExtData(rawVersion))
+	version, _ := DecodeVersion(rawVersion)
+	require.Equal(t, single.Version, version)
+
+	var decoded Single
+	require.NoError(t, decoded.Deserialize(&b))
+	assertSingleEqual(t, single, decoded)
+
+	// All addresses should include the historical ones after the current
+	// set.
+	allAddrs := decoded.AllAddresses()
+	expectedAddrs := []net.Addr{addr1, addr2, addr3, addr4}
+	require.Len(t, allAddrs, len(expectedAddrs))
+	for i, addr := range expectedAddrs {
+		require.Equal(t, addr.String(), allAddrs[i].String())
+	}
+
+	// The backup of a channel whose funding transaction was constructed
+	// interactively should carry that through the extended data as well.
+	channel.RemoteCommitment.CommitHeight = 0
+	channel.ChanType |= channeldb.InteractiveFundingBit
+	single = NewSingle(channel, []net.Addr{addr1})
+	require.True(t, single.InteractiveFunding)
+
+	b.Reset()
+	require.NoError(t, single.Serialize(&b))
+	require.True(t, hasExtData(b.Bytes()[0]))
+
+	decoded = Single{}
+	require.NoError(t, decoded.Deserialize(&b))
+	assertSingleEqual(t, single, decoded)
+
+	// A backup without any extended data should be encoded in the legacy
+	// format.
+	single.AddrHistory = nil
+	single.RemoteCommitHeight = fn.None[uint64]()
+	single.InteractiveFunding = false
+
+	b.Reset()
+	require.NoError(t, single.Serialize(&b))
+	require.False(t, hasExtData(b.Bytes()[0]))
+
+	decoded = Single{}
+	require.NoError(t, decoded.Deserialize(&b))
+	assertSingleEqual(t, single, decoded)
+}
+
+// TestSingleWithAddrHistory tests that addresses of a prior backup that are no
+// longer part of the current address set are carried over as history.
+func TestSingleWithAddrHistory(t *testing.T) {
+	t.Parallel()
+
+	prior := Single{
+		Addresses:   []net.Addr{addr1, addr3},
+		AddrHistory: []net.Addr{addr4},
+	}
+	current := Single{
+		Addresses: []net.Addr{addr1, addr2},
+	}
+
+	updated := current.WithAddrHistory(prior)
+	require.Equal(t, []net.Addr{addr1, addr2}, updated.Addresses)
+	require.Equal(t, []net.Addr{addr3, addr4}, updated.AddrHistory)
+
+	// If the peer is back at an address of the history, it's no longer
+	// part of it.
+	current.Addresses = []net.Addr{addr3}
+	updated = current.WithAddrHistory(updated)
+	require.Equal(t, []net.Addr{addr1, addr2, addr4}, updated.AddrHistory)
+
+	// The history is capped at maxAddrHistory entries.
+	var manyAddrs []net.Addr
+	for i := 0; i < maxAddrHistory*2; i++ {
+		manyAddrs = append(manyAddrs, &net.TCPAddr{
+			IP:   net.IP{10, 0, 1, byte(i)},
+			Port: 9735,
+		})
+	}
+	prior = Single{Addresses: manyAddrs}
+	updated = current.WithAddrHistory(prior)
+	require.Len(t, updated.AddrHistory, maxAddrHistory)
+}
+
 var sampleCommitTx = &wire.MsgTx{
 	TxIn: []*wire.TxIn{
 		{PreviousOutPoint: wire.OutPoint{Hash: [32]byte{1}}},
@@ -0,0 +1,145 @@
+package chanbackup
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/txscript/v2"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// ErrInvalidCommitSig is returned by VerifySingle if the remote signature of
+// the commitment transaction included in the backup doesn't match the funding
+// output of the channel as derived from the seed.
+var ErrInvalidCommitSig = errors.New("remote commitment signature doesn't " +
+	"match funding output derived from seed")
+
+// ErrNoKeyData is returned by VerifySingle if the backup doesn't contain any
+// data that the keys derived from the seed can be compared against.
+var ErrNoKeyData = errors.New("backup contains no data to verify the " +
+	"seed-derived keys against")
+
+// VerifySingle checks that the passed backup is consistent with the keys
+// derived by the given key ring, which is expected to be backed by the seed of
+// the node that created the backup. All local keys of the channel must be
+// derivable, and the local multisig key derived from the seed must be the one
+// of the channel: the remote party's signature of the commitment transaction
+// included in the backup must be valid for the 2-of-2 funding output of our
+// derived multisig key and the remote multisig key.
+//
+// As the backup only stores the key locators of the local keys, the funding
+// output is the only data that ties them to the seed. If the backup doesn't
+// include the inputs needed to produce a force close transaction, or if it
+// belongs to a taproot channel whose commitment is signed with a MuSig2
+// partial signature, ErrNoKeyData is returned.
+//
+// NOTE: Successfully decrypting a packed backup with a key ring already proves
+// that the seed is the one used to create it, so a backup that was decrypted
+// with the same key ring can still be restored if ErrNoKeyData is returned.
+func VerifySingle(single Single, keyRing keychain.KeyRing) error {
+	localKeys := []struct {
+		name   string
+		keyLoc keychain.KeyLocator
+	}{
+		{"multisig", single.LocalChanCfg.MultiSigKey.KeyLocator},
+		{
+			"revocation base point",
+			single.LocalChanCfg.RevocationBasePoint.KeyLocator,
+		},
+		{
+			"payment base point",
+			single.LocalChanCfg.PaymentBasePoint.KeyLocator,
+		},
+		{"delay base point", single.LocalChanCfg.DelayBasePoint.KeyLocator},
+		{"htlc base point", single.LocalChanCfg.HtlcBasePoint.KeyLocator},
+	}
+
+	var localMultiSigKey *btcec.PublicKey
+	for i, localKey := range localKeys {
+		keyDesc, err := keyRing.DeriveKey(localKey.keyLoc)
+		if err != nil {
+			return fmt.Errorf("unable to derive local %v: %w",
+				localKey.name, err)
+		}
+
+		if i == 0 {
+			localMultiSigKey = keyDesc.PubKey
+		}
+	}
+
+	// Legacy backups store the public key of the shachain root instead of
+	// its key locator, which we can't verify.
+	if single.ShaChainRootDesc.PubKey == nil {
+		_, err := keyRing.DeriveKey(single.ShaChainRootDesc.KeyLocator)
+		if err != nil {
+			return fmt.Errorf("unable to derive shachain root: %w",
+				err)
+		}
+	}
+
+	closeTxInputs, err := single.CloseTxInputs.UnwrapOrErr(ErrNoKeyData)
+	if err != nil {
+		return err
+	}
+
+	// The MuSig2 partial signature of a taproot channel can't be verified
+	// without the remote nonce, so there's nothing to compare our keys
+	// against.
+	if single.Version.IsTaproot() {
+		return ErrNoKeyData
+	}
+
+	return verifyCommitSig(single, closeTxInputs, localMultiSigKey)
+}
+
+// verifyCommitSig verifies the remote party's signature of the commitment
+// transaction included in the backup against the p2wsh funding output created
+// from the local and remote multisig keys.
+func verifyCommitSig(single Single, inputs CloseTxInputs,
+	localMultiSigKey *btcec.PublicKey) error {
+
+	remoteMultiSigKey := single.RemoteChanCfg.MultiSigKey.PubKey
+	witnessScript, fundingOutput, err := input.GenFundingPkScript(
+		localMultiSigKey.SerializeCompressed(),
+		remoteMultiSigKey.SerializeCompressed(),
+		int64(single.Capacity),
+	)
+	if err != nil {
+		return err
+	}
+
+	commitTx := inputs.CommitTx
+	if len(commitTx.TxIn) != 1 ||
+		commitTx.TxIn[0].PreviousOutPoint != single.FundingOutpoint {
+
+		return fmt.Errorf("commitment transaction doesn't spend "+
+			"funding outpoint %v", single.FundingOutpoint)
+	}
+
+	sig, err := ecdsa.ParseDERSignature(inputs.CommitSig)
+	if err != nil {
+		return fmt.Errorf("unable to parse remote commitment "+
+			"signature: %w", err)
+	}
+
+	prevOutFetcher := txscript.NewCannedPrevOutputFetcher(
+		fundingOutput.PkScript, fundingOutput.Value,
+	)
+	sigHashes := txscript.NewTxSigHashes(commitTx, prevOutFetcher)
+	sigHash, err := txscript.CalcWitnessSigHash(
+		witnessScript, sigHashes, txscript.SigHashAll, commitTx, 0,
+		fundingOutput.Value,
+	)
+	if err != nil {
+		return err
+	}
+
+	if !sig.Verify(sigHash, remoteMultiSigKey) {
+		return ErrInvalidCommitSig
+	}
+
+	return nil
+}
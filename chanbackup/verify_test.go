@@ -0,0 +1,114 @@
+package chanbackup
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcutil/v2/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg/v2"
+	"github.com/btcsuite/btcd/txscript/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVerifySingle tests that a backup is verified against the keys derived
+// from a seed, including the remote signature of the commitment transaction.
+func TestVerifySingle(t *testing.T) {
+	t.Parallel()
+
+	rootKey, err := hdkeychain.NewMaster(
+		chainHash[:], &chaincfg.RegressionNetParams,
+	)
+	require.NoError(t, err)
+	keyRing, err := keychain.NewHDKeyRing(rootKey, keychain.CoinTypeTestnet)
+	require.NoError(t, err)
+
+	localMultiSigKey, err := keyRing.DeriveKey(keychain.KeyLocator{
+		Family: keychain.KeyFamilyMultiSig,
+		Index:  3,
+	})
+	require.NoError(t, err)
+
+	remotePriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	remotePub := remotePriv.PubKey()
+
+	single := Single{
+		Version:         AnchorsZeroFeeHtlcTxCommitVersion,
+		FundingOutpoint: op,
+		Capacity:        1_000_000,
+	}
+	single.LocalChanCfg.MultiSigKey = keychain.KeyDescriptor{
+		KeyLocator: localMultiSigKey.KeyLocator,
+	}
+	single.RemoteChanCfg.MultiSigKey.PubKey = remotePub
+	single.ShaChainRootDesc.KeyLocator = keychain.KeyLocator{
+		Family: keychain.KeyFamilyRevocationRoot,
+	}
+
+	// Without any close tx inputs, there's nothing to verify the derived
+	// keys against.
+	require.ErrorIs(t, VerifySingle(single, keyRing), ErrNoKeyData)
+
+	// Next, we'll create a commitment transaction spending the funding
+	// output and sign it with the remote key.
+	witnessScript, fundingOutput, err := input.GenFundingPkScript(
+		localMultiSigKey.PubKey.SerializeCompressed(),
+		remotePub.SerializeCompressed(), int64(single.Capacity),
+	)
+	require.NoError(t, err)
+
+	commitTx := wire.NewMsgTx(2)
+	commitTx.AddTxIn(&wire.TxIn{PreviousOutPoint: op})
+	commitTx.AddTxOut(&wire.TxOut{Value: 900_000, PkScript: []byte{1}})
+
+	sigHashes := txscript.NewTxSigHashes(
+		commitTx, txscript.NewCannedPrevOutputFetcher(
+			fundingOutput.PkScript, fundingOutput.Value,
+		),
+	)
+	sigHash, err := txscript.CalcWitnessSigHash(
+		witnessScript, sigHashes, txscript.SigHashAll, commitTx, 0,
+		fundingOutput.Value,
+	)
+	require.NoError(t, err)
+
+	single.CloseTxInputs = fn.Some(CloseTxInputs{
+		CommitTx:  commitTx,
+		CommitSig: ecdsa.Sign(remotePriv, sigHash).Serialize(),
+	})
+	require.NoError(t, VerifySingle(single, keyRing))
+
+	// If the backup was created by another seed, then the derived
+	// multisig key won't match and the signature is invalid.
+	otherRoot, err := hdkeychain.NewMaster(
+		op.Hash[1:], &chaincfg.RegressionNetParams,
+	)
+	require.NoError(t, err)
+	otherKeyRing, err := keychain.NewHDKeyRing(
+		otherRoot, keychain.CoinTypeTestnet,
+	)
+	require.NoError(t, err)
+	require.ErrorIs(
+		t, VerifySingle(single, otherKeyRing), ErrInvalidCommitSig,
+	)
+
+	// A commitment transaction that doesn't spend the funding outpoint
+	// should be rejected as well.
+	otherTx := commitTx.Copy()
+	otherTx.TxIn[0].PreviousOutPoint.Index++
+	single.CloseTxInputs = fn.Some(CloseTxInputs{
+		CommitTx:  otherTx,
+		CommitSig: ecdsa.Sign(remotePriv, sigHash).Serialize(),
+	})
+	require.Error(t, VerifySingle(single, keyRing))
+
+	// The partial signature of a taproot channel can't be verified, so
+	// there's nothing to compare the derived keys against.
+	single.Version = SimpleTaprootVersion
+	require.ErrorIs(t, VerifySingle(single, keyRing), ErrNoKeyData)
+}
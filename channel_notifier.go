@@ -147,6 +147,24 @@ func (c *channelNotifier) SubscribeChans(ctx context.Context,
 						return
 					}
 
+				// The commitment state of a channel has been
+				// updated, we'll let the sub-swapper refresh
+				// its backup.
+				case channelnotifier.ChannelUpdateEvent:
+					updated := chanbackup.ChannelWithAddrs{
+						OpenChannel: event.Channel,
+					}
+					var chanEvent chanbackup.ChannelEvent
+					chanEvent.UpdatedChans = append(
+						chanEvent.UpdatedChans, updated,
+					)
+
+					select {
+					case chanUpdates <- chanEvent:
+					case <-quit:
+						return
+					}
+
 				// A channel was fully resolved on chain. This
 				// should only really interest us if it was a
 				// locally force closed channel where we didn't
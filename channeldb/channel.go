@@ -128,6 +128,10 @@ var (
 	// shutdown on re-establish.
 	shutdownInfoKey = []byte("shutdown-info-key")
 
+	// pausedKey is set for a channel that was paused and hasn't been
+	// resumed since. A paused channel doesn't accept new HTLCs.
+	pausedKey = []byte("paused-key")
+
 	// commitDiffKey stores the current pending commitment state we've
 	// extended to the remote party (if any). Each time we propose a new
 	// state, we store the information necessary to reconstruct this state
@@ -473,6 +477,14 @@ const (
 	// TaprootFinalBit indicates that this is a MuSig2 channel using the
 	// final/production taproot scripts and feature bits 80/81.
 	TaprootFinalBit = cstate.TaprootFinalBit
+
+	// ZeroFeeCommitmentsBit indicates that the channel uses zero-fee v3
+	// commitment transactions with a single shared pay-to-anchor output.
+	ZeroFeeCommitmentsBit = cstate.ZeroFeeCommitmentsBit
+
+	// InteractiveFundingBit indicates that the funding transaction of the
+	// channel was constructed interactively with the remote party.
+	InteractiveFundingBit = cstate.InteractiveFundingBit
 )
 
 // ChannelStateBounds are the parameters from OpenChannel and AcceptChannel
@@ -1151,6 +1163,62 @@ var (
 	NewMusigVerificationNonce = cstate.NewMusigVerificationNonce
 )
 
+// StoreChannelPaused persists whether the target channel was paused.
+func (c *ChannelStateDB) StoreChannelPaused(channel *OpenChannel,
+	paused bool) error {
+
+	return kvdb.Update(c.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, channel.IdentityPub, &channel.FundingOutpoint,
+			channel.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		if !paused {
+			return chanBucket.Delete(pausedKey)
+		}
+
+		return chanBucket.Put(pausedKey, []byte{1})
+	}, func() {})
+}
+
+// FetchChannelPaused returns whether the target channel was paused and hasn't
+// been resumed since.
+func (c *ChannelStateDB) FetchChannelPaused(channel *OpenChannel) (bool,
+	error) {
+
+	var paused bool
+	err := kvdb.View(c.backend, func(tx kvdb.RTx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, channel.IdentityPub, &channel.FundingOutpoint,
+			channel.ChainHash,
+		)
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrNoChanDBExists),
+			errors.Is(err, ErrNoActiveChannels),
+			errors.Is(err, ErrChannelNotFound):
+
+			return nil
+		default:
+			return err
+		}
+
+		paused = chanBucket.Get(pausedKey) != nil
+
+		return nil
+	}, func() {
+		paused = false
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return paused, nil
+}
+
 // StoreChannelShutdownInfo persists the ShutdownInfo for the target channel.
 func (c *ChannelStateDB) StoreChannelShutdownInfo(channel *OpenChannel,
 	info *ShutdownInfo) error {
@@ -1748,9 +1816,9 @@ func processFinalHtlc(finalHtlcsBucket walletdb.ReadWriteBucket, upd LogUpdate,
 
 // serializeHtlcExtraData encodes a TLV stream of extra data to be stored with a
 // HTLC. It uses the update_add_htlc TLV types, because this is where extra
-// data is passed with a HTLC. At present blinding points are the only extra
-// data that we will store, and the function is a no-op if a nil blinding
-// point is provided.
+// data is passed with a HTLC. At present blinding points and upfront fees are
+// the only extra data that we will store, and the function is a no-op if
+// neither is provided.
 //
 // This function MUST be called to persist all HTLC values when they are
 // serialized.
@@ -1761,6 +1829,11 @@ func serializeHtlcExtraData(h *HTLC) error {
 
 		records = append(records, &b)
 	})
+	h.UpfrontFee.WhenSome(func(f tlv.RecordT[lnwire.UpfrontFeeTlvType,
+		lnwire.MilliSatoshi]) {
+
+		records = append(records, &f)
+	})
 
 	records, err := h.CustomRecords.ExtendRecordProducers(records)
 	if err != nil {
@@ -1781,7 +1854,8 @@ func deserializeHtlcExtraData(h *HTLC) error {
 	}
 
 	blindingPoint := h.BlindingPoint.Zero()
-	tlvMap, err := h.ExtraData.ExtractRecords(&blindingPoint)
+	upfrontFee := h.UpfrontFee.Zero()
+	tlvMap, err := h.ExtraData.ExtractRecords(&blindingPoint, &upfrontFee)
 	if err != nil {
 		return err
 	}
@@ -1794,6 +1868,11 @@ func deserializeHtlcExtraData(h *HTLC) error {
 		delete(tlvMap, h.BlindingPoint.TlvType())
 	}
 
+	if val, ok := tlvMap[h.UpfrontFee.TlvType()]; ok && val == nil {
+		h.UpfrontFee = tlv.SomeRecordT(upfrontFee)
+		delete(tlvMap, h.UpfrontFee.TlvType())
+	}
+
 	// Set the custom records field to the remaining TLV records.
 	customRecords, err := lnwire.NewCustomRecords(tlvMap)
 	if err != nil {
@@ -3171,13 +3250,20 @@ func writeChanConfig(b io.Writer, c *ChannelConfig) error {
 }
 
 // fundingTxPresent returns true if expect the funding transcation to be found
-// on disk or already populated within the passed open channel struct.
+// on disk or already populated within the passed open channel struct. Both
+// parties of an interactively funded channel know the funding transaction.
 func fundingTxPresent(channel *OpenChannel) bool {
 	chanType := channel.ChanType
+	if channel.HasChanStatusForStore(ChanStatusRestored) {
+		return false
+	}
+
+	if chanType.HasInteractiveFunding() {
+		return true
+	}
 
 	return chanType.IsSingleFunder() && chanType.HasFundingTx() &&
-		channel.IsInitiator &&
-		!channel.HasChanStatusForStore(ChanStatusRestored)
+		channel.IsInitiator
 }
 
 func putChanInfo(chanBucket kvdb.RwBucket, channel *OpenChannel) error {
@@ -1370,6 +1370,42 @@ func testShutdownInfo(t *testing.T, locallyInitiated bool) {
 	})
 }
 
+// TestChannelPaused asserts that the paused state of a channel is persisted
+// until it is cleared again.
+func TestChannelPaused(t *testing.T) {
+	t.Parallel()
+
+	fullDB, err := MakeTestDB(t)
+	require.NoError(t, err, "unable to make test database")
+
+	cdb := fullDB.ChannelStateDB()
+
+	// A new channel isn't paused.
+	channel := createTestChannel(t, cdb)
+
+	paused, err := channel.IsPaused()
+	require.NoError(t, err)
+	require.False(t, paused)
+
+	// Once paused, the state is visible to a freshly fetched copy of the
+	// channel.
+	require.NoError(t, channel.MarkPaused(true))
+
+	dbChannel, err := cdb.FetchChannel(channel.FundingOutpoint)
+	require.NoError(t, err)
+
+	paused, err = dbChannel.IsPaused()
+	require.NoError(t, err)
+	require.True(t, paused)
+
+	// Resuming the channel clears the state.
+	require.NoError(t, dbChannel.MarkPaused(false))
+
+	paused, err = channel.IsPaused()
+	require.NoError(t, err)
+	require.False(t, paused)
+}
+
 // TestRefresh asserts that Refresh updates the in-memory state of another
 // OpenChannel to reflect a preceding call to MarkOpen on a different
 // OpenChannel.
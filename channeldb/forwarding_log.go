@@ -12,6 +12,7 @@ import (
 	"github.com/lightningnetwork/lnd/fn/v2"
 	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
 )
 
 var (
@@ -32,9 +33,22 @@ const (
 	//    outgoing htlc id
 	//
 	// From the value in and value out, callers can easily compute the
-	// total fee extract from a forwarding event.
+	// total fee extract from a forwarding event. Events that carry upfront
+	// fees or describe a failed forward append an additional tlv stream.
 	forwardingEventSize = 48
 
+	// fwdEventUpfrontFeeInType is the tlv type of the upfront fee received
+	// on the incoming htlc of a forwarding event.
+	fwdEventUpfrontFeeInType tlv.Type = 0
+
+	// fwdEventUpfrontFeeOutType is the tlv type of the upfront fee paid on
+	// the outgoing htlc of a forwarding event.
+	fwdEventUpfrontFeeOutType tlv.Type = 1
+
+	// fwdEventFailedType is the tlv type of the flag that marks a
+	// forwarding event as failed.
+	fwdEventFailedType tlv.Type = 2
+
 	// MaxResponseEvents is the max number of forwarding events that will
 	// be returned by a single query response. This size was selected to
 	// safely remain under gRPC's 4MiB message size response limit. As each
@@ -68,7 +82,8 @@ type ForwardingLog struct {
 // ForwardingEvent is an event in the forwarding log's time series. Each
 // forwarding event logs the creation and tear-down of a payment circuit. A
 // circuit is created once an incoming HTLC has been fully forwarded, and
-// destroyed once the payment has been settled.
+// destroyed once the payment has been settled. Failed forwards are only logged
+// if they earned us upfront fees, in which case Failed is set.
 type ForwardingEvent struct {
 	// Timestamp is the settlement time of this payment circuit.
 	Timestamp time.Time
@@ -98,6 +113,43 @@ type ForwardingEvent struct {
 	// v0.20 and is made optional to make it backward compatible with
 	// existing forwarding events created before it's introduction.
 	OutgoingHtlcID fn.Option[uint64]
+
+	// UpfrontFeeIn is the unconditional fee that was received along with
+	// the incoming HTLC.
+	UpfrontFeeIn lnwire.MilliSatoshi
+
+	// UpfrontFeeOut is the unconditional fee that was paid along with the
+	// outgoing HTLC.
+	UpfrontFeeOut lnwire.MilliSatoshi
+
+	// Failed is set if the payment circuit was failed rather than settled.
+	// The only revenue of a failed forward is its upfront fee.
+	Failed bool
+}
+
+// UpfrontRevenue returns the net upfront fee earned by the forward, which is
+// the upfront fee received on the incoming HTLC minus the one paid on the
+// outgoing HTLC.
+func (f *ForwardingEvent) UpfrontRevenue() int64 {
+	return int64(f.UpfrontFeeIn) - int64(f.UpfrontFeeOut)
+}
+
+// hasTlvData returns true if the event carries any of the fields that are
+// stored in the optional tlv stream.
+func (f *ForwardingEvent) hasTlvData() bool {
+	return f.UpfrontFeeIn != 0 || f.UpfrontFeeOut != 0 || f.Failed
+}
+
+// fwdEventTlvStream returns a tlv stream over the optional fields of a
+// forwarding event, backed by the passed scratch variables.
+func fwdEventTlvStream(upfrontIn, upfrontOut *uint64,
+	failed *uint8) (*tlv.Stream, error) {
+
+	return tlv.NewStream(
+		tlv.MakePrimitiveRecord(fwdEventUpfrontFeeInType, upfrontIn),
+		tlv.MakePrimitiveRecord(fwdEventUpfrontFeeOutType, upfrontOut),
+		tlv.MakePrimitiveRecord(fwdEventFailedType, failed),
+	)
 }
 
 // encodeForwardingEvent writes out the target forwarding event to the passed
@@ -121,10 +173,33 @@ func encodeForwardingEvent(w io.Writer, f *ForwardingEvent) error {
 		return err
 	}
 
-	return WriteElements(
+	err = WriteElements(
 		w, f.IncomingChanID, f.OutgoingChanID, f.AmtIn, f.AmtOut,
 		incomingID, outgoingID,
 	)
+	if err != nil {
+		return err
+	}
+
+	// Only append the tlv stream if there is something to store, so that
+	// plain settled forwards keep their fixed size.
+	if !f.hasTlvData() {
+		return nil
+	}
+
+	upfrontIn := uint64(f.UpfrontFeeIn)
+	upfrontOut := uint64(f.UpfrontFeeOut)
+	var failed uint8
+	if f.Failed {
+		failed = 1
+	}
+
+	stream, err := fwdEventTlvStream(&upfrontIn, &upfrontOut, &failed)
+	if err != nil {
+		return err
+	}
+
+	return stream.Encode(w)
 }
 
 // decodeForwardingEvent attempts to decode the raw bytes of a serialized
@@ -150,14 +225,33 @@ func decodeForwardingEvent(r io.Reader, f *ForwardingEvent) error {
 		f.IncomingHtlcID = fn.Some(incomingHtlcID)
 		f.OutgoingHtlcID = fn.Some(outgoingHtlcID)
 
-		return nil
-
 	case errors.Is(err, io.EOF):
 		return nil
 
 	default:
 		return err
 	}
+
+	// Finally, decode the optional tlv stream. Events without upfront
+	// fees that were settled end right after the htlc IDs.
+	var (
+		upfrontIn, upfrontOut uint64
+		failed                uint8
+	)
+	stream, err := fwdEventTlvStream(&upfrontIn, &upfrontOut, &failed)
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Decode(r); err != nil {
+		return err
+	}
+
+	f.UpfrontFeeIn = lnwire.MilliSatoshi(upfrontIn)
+	f.UpfrontFeeOut = lnwire.MilliSatoshi(upfrontOut)
+	f.Failed = failed != 0
+
+	return nil
 }
 
 // AddForwardingEvents adds a series of forwarding events to the database.
@@ -271,6 +365,10 @@ type ForwardingEventQuery struct {
 	// forwarded to a particular channel.
 	// If the list is empty, then it is ignored.
 	OutgoingChanIDs fn.Set[uint64]
+
+	// IncludeFailed indicates whether failed forwards, which are only
+	// logged if they earned upfront fees, should be returned as well.
+	IncludeFailed bool
 }
 
 // ForwardingLogTimeSlice is the response to a forwarding query. It includes
@@ -391,6 +489,21 @@ func (f *ForwardingLog) Query(q ForwardingEventQuery) (ForwardingLogTimeSlice,
 			if !incomingMatch || !outgoingMatch {
 				continue
 			}
+
+			// Failed forwards are only returned if requested.
+			// Without channel filters the offset indexes all
+			// records in the log, so we still need to count the
+			// ones we hide.
+			if event.Failed && !q.IncludeFailed {
+				if q.IncomingChanIDs.IsEmpty() &&
+					q.OutgoingChanIDs.IsEmpty() {
+
+					recordOffset++
+				}
+
+				continue
+			}
+
 			// If we're not yet past the user defined offset
 			// then we'll continue to seek forward.
 			if recordsToSkip > 0 {
@@ -428,8 +541,9 @@ type DeleteStats struct {
 	// deleted from the database.
 	NumEventsDeleted uint64
 
-	// TotalFeeMsat is the sum of all fees (AmtIn - AmtOut) from the
-	// deleted events, expressed in millisatoshis.
+	// TotalFeeMsat is the sum of all fees (AmtIn - AmtOut for settled
+	// forwards plus any net upfront fees) from the deleted events,
+	// expressed in millisatoshis.
 	TotalFeeMsat int64
 }
 
@@ -527,10 +641,15 @@ func (f *ForwardingLog) DeleteForwardingEvents(ctx context.Context,
 
 					// Calculate the fee for this event. Cast
 					// before subtracting to avoid uint64
-					// underflow if AmtOut > AmtIn.
+					// underflow if AmtOut > AmtIn. Failed
+					// forwards only earn their upfront fee.
 					fee := int64(event.AmtIn) -
 						int64(event.AmtOut)
-					batchFees += fee
+					if event.Failed {
+						fee = 0
+					}
+					batchFees += fee +
+						event.UpfrontRevenue()
 				}
 
 				// Make a copy of the key to delete later.
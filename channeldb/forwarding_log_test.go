@@ -436,6 +436,89 @@ func TestForwardingLogDecodeForwardingEvent(t *testing.T) {
 	}
 }
 
+// TestForwardingLogUpfrontFees tests that upfront fees and failed forwards are
+// persisted, that failed forwards are only returned when requested and that
+// hidden failed forwards are still accounted for in the returned offset.
+func TestForwardingLogUpfrontFees(t *testing.T) {
+	t.Parallel()
+
+	db, err := MakeTestDB(t)
+	require.NoError(t, err)
+
+	log := ForwardingLog{
+		db: db,
+	}
+
+	initialTime := time.Unix(1234, 0)
+	endTime := time.Unix(1234, 0)
+
+	// We'll alternate between settled forwards that earned upfront fees
+	// and failed forwards.
+	numEvents := 10
+	events := make([]ForwardingEvent, numEvents)
+	for i := range numEvents {
+		events[i] = ForwardingEvent{
+			Timestamp:      endTime,
+			IncomingChanID: lnwire.NewShortChanIDFromInt(1),
+			OutgoingChanID: lnwire.NewShortChanIDFromInt(2),
+			AmtIn:          2000,
+			AmtOut:         1000,
+			IncomingHtlcID: fn.Some(uint64(i)),
+			OutgoingHtlcID: fn.Some(uint64(i)),
+			UpfrontFeeIn:   30,
+			UpfrontFeeOut:  20,
+			Failed:         i%2 == 1,
+		}
+
+		endTime = endTime.Add(time.Minute * 10)
+	}
+	require.NoError(t, log.AddForwardingEvents(events))
+
+	// Querying with failed forwards included should return all events,
+	// identical to the ones we wrote.
+	eventQuery := ForwardingEventQuery{
+		StartTime:     initialTime,
+		EndTime:       endTime,
+		NumMaxEvents:  uint32(numEvents),
+		IncludeFailed: true,
+	}
+	timeSlice, err := log.Query(eventQuery)
+	require.NoError(t, err)
+	require.Equal(t, events, timeSlice.ForwardingEvents)
+	require.EqualValues(t, 10, timeSlice.ForwardingEvents[0].UpfrontRevenue())
+
+	// By default, only the settled forwards are returned. Paging through
+	// them two at a time should visit each settled event exactly once.
+	eventQuery.IncludeFailed = false
+	eventQuery.NumMaxEvents = 2
+
+	var settled []ForwardingEvent
+	for {
+		timeSlice, err := log.Query(eventQuery)
+		require.NoError(t, err)
+
+		if len(timeSlice.ForwardingEvents) == 0 {
+			break
+		}
+
+		settled = append(settled, timeSlice.ForwardingEvents...)
+		eventQuery.IndexOffset = timeSlice.LastIndexOffset
+	}
+
+	require.Len(t, settled, numEvents/2)
+	for i, event := range settled {
+		require.False(t, event.Failed)
+		require.Equal(t, events[i*2], event)
+	}
+
+	// Deleting all events should account for the success fees of the
+	// settled forwards and the upfront revenue of all of them.
+	stats, err := log.DeleteForwardingEvents(t.Context(), endTime, 0)
+	require.NoError(t, err)
+	require.EqualValues(t, numEvents, stats.NumEventsDeleted)
+	require.EqualValues(t, 5*1000+10*10, stats.TotalFeeMsat)
+}
+
 // writeOldFormatEvents writes forwarding events to the database in the old
 // format (without incoming and outgoing htlc indices). This is used to test
 // backward compatibility.
@@ -133,6 +133,9 @@ const (
 	amtPaidType         tlv.Type = 13
 	hodlInvoiceType     tlv.Type = 14
 	invoiceAmpStateType tlv.Type = 15
+	maxHoldDurationType tlv.Type = 16
+	minCltvSlackType    tlv.Type = 17
+	autoSettleType      tlv.Type = 18
 
 	// A set of tlv type definitions used to serialize the invoice AMP
 	// state along-side the main invoice body.
@@ -814,6 +817,11 @@ func (k *kvInvoiceUpdater) UpdateInvoiceAmtPaid(_ lnwire.MilliSatoshi) error {
 	return nil
 }
 
+// NOTE: this method does nothing in the k/v implementation of InvoiceUpdater.
+func (k *kvInvoiceUpdater) UpdatePaymentRequest(_ []byte) error {
+	return nil
+}
+
 // UpdateAmpState updates the state of the AMP invoice identified by the setID.
 func (k *kvInvoiceUpdater) UpdateAmpState(setID [32]byte,
 	state invpkg.InvoiceStateAMP, circuitKey models.CircuitKey) error {
@@ -878,6 +886,9 @@ func (k *kvInvoiceUpdater) Finalize(updateType invpkg.UpdateType) error {
 		// invoice. All HTLCs which were accepted are now canceled, so
 		// we persist this state.
 		return k.storeCancelHtlcsUpdate()
+
+	case invpkg.PaymentRequestUpdate:
+		return k.serializeAndStoreInvoice()
 	}
 
 	return fmt.Errorf("unknown update type: %v", updateType)
@@ -1297,6 +1308,12 @@ func serializeInvoice(w io.Writer, i *invpkg.Invoice) error {
 		hodlInvoice = 1
 	}
 
+	maxHoldDuration := uint64(i.HoldPolicy.MaxHoldDuration)
+	var autoSettle uint8
+	if i.HoldPolicy.AutoSettle {
+		autoSettle = 1
+	}
+
 	tlvStream, err := tlv.NewStream(
 		// Memo and payreq.
 		tlv.MakePrimitiveRecord(memoType, &i.Memo),
@@ -1328,6 +1345,13 @@ func serializeInvoice(w io.Writer, i *invpkg.Invoice) error {
 			ampRecordSize(&i.AMPState),
 			ampStateEncoder, ampStateDecoder,
 		),
+
+		// Hold policy.
+		tlv.MakePrimitiveRecord(maxHoldDurationType, &maxHoldDuration),
+		tlv.MakePrimitiveRecord(
+			minCltvSlackType, &i.HoldPolicy.MinCltvSlack,
+		),
+		tlv.MakePrimitiveRecord(autoSettleType, &autoSettle),
 	)
 	if err != nil {
 		return err
@@ -1684,6 +1708,9 @@ func deserializeInvoice(r io.Reader) (invpkg.Invoice, error) {
 		state         uint8
 		hodlInvoice   uint8
 
+		maxHoldDuration uint64
+		autoSettle      uint8
+
 		creationDateBytes []byte
 		settleDateBytes   []byte
 		featureBytes      []byte
@@ -1721,6 +1748,13 @@ func deserializeInvoice(r io.Reader) (invpkg.Invoice, error) {
 			invoiceAmpStateType, &i.AMPState, nil,
 			ampStateEncoder, ampStateDecoder,
 		),
+
+		// Hold policy.
+		tlv.MakePrimitiveRecord(maxHoldDurationType, &maxHoldDuration),
+		tlv.MakePrimitiveRecord(
+			minCltvSlackType, &i.HoldPolicy.MinCltvSlack,
+		),
+		tlv.MakePrimitiveRecord(autoSettleType, &autoSettle),
 	)
 	if err != nil {
 		return i, err
@@ -1752,6 +1786,9 @@ func deserializeInvoice(r io.Reader) (invpkg.Invoice, error) {
 		i.HodlInvoice = true
 	}
 
+	i.HoldPolicy.MaxHoldDuration = time.Duration(maxHoldDuration)
+	i.HoldPolicy.AutoSettle = autoSettle != 0
+
 	err = i.CreationDate.UnmarshalBinary(creationDateBytes)
 	if err != nil {
 		return i, err
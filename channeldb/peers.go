@@ -20,6 +20,7 @@ var (
 	//      |
 	//      |-- <peer-pubkey>
 	//      |        |--flap-count-key: <ts><flap count>
+	//      |        |--peer-storage-key: <blob>
 	//      |
 	//      |-- <peer-pubkey>
 	//      |        |--flap-count-key: <ts><flap count>
@@ -29,12 +30,20 @@ var (
 	// the timestamp of a peer's last flap count and its all time flap
 	// count.
 	flapCountKey = []byte("flap-count")
+
+	// peerStorageKey is a key used in the peer pubkey sub-bucket that
+	// stores the latest blob the peer asked us to store on its behalf.
+	peerStorageKey = []byte("peer-storage")
 )
 
 var (
 	// ErrNoPeerBucket is returned when we try to read entries for a peer
 	// that is not tracked.
 	ErrNoPeerBucket = errors.New("peer bucket not found")
+
+	// ErrNoPeerStorage is returned when we try to read the blob of a peer
+	// that we don't store a blob for.
+	ErrNoPeerStorage = errors.New("no peer storage blob found")
 )
 
 // FlapCount contains information about a peer's flap count.
@@ -127,3 +136,65 @@ func (d *DB) ReadFlapCount(pubkey route.Vertex) (*FlapCount, error) {
 
 	return &flapCount, nil
 }
+
+// PutPeerStorage stores the given blob on behalf of a peer, replacing any blob
+// that was stored for the peer before.
+func (d *DB) PutPeerStorage(pubkey route.Vertex, blob []byte) error {
+	return kvdb.Update(d, func(tx kvdb.RwTx) error {
+		peers := tx.ReadWriteBucket(peersBucket)
+
+		peerBucket, err := peers.CreateBucketIfNotExists(pubkey[:])
+		if err != nil {
+			return err
+		}
+
+		return peerBucket.Put(peerStorageKey, blob)
+	}, func() {})
+}
+
+// FetchPeerStorage returns the blob stored on behalf of a peer. If we don't
+// store a blob for the peer, ErrNoPeerStorage is returned.
+func (d *DB) FetchPeerStorage(pubkey route.Vertex) ([]byte, error) {
+	var blob []byte
+	if err := kvdb.View(d, func(tx kvdb.RTx) error {
+		peers := tx.ReadBucket(peersBucket)
+
+		peerBucket := peers.NestedReadBucket(pubkey[:])
+		if peerBucket == nil {
+			return ErrNoPeerStorage
+		}
+
+		storedBlob := peerBucket.Get(peerStorageKey)
+		if storedBlob == nil {
+			return ErrNoPeerStorage
+		}
+
+		// The returned slice is only valid during the transaction, so
+		// we copy it.
+		blob = make([]byte, len(storedBlob))
+		copy(blob, storedBlob)
+
+		return nil
+	}, func() {
+		blob = nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return blob, nil
+}
+
+// DeletePeerStorage deletes the blob stored on behalf of a peer. Deleting the
+// blob of a peer that we don't store a blob for is a no-op.
+func (d *DB) DeletePeerStorage(pubkey route.Vertex) error {
+	return kvdb.Update(d, func(tx kvdb.RwTx) error {
+		peers := tx.ReadWriteBucket(peersBucket)
+
+		peerBucket := peers.NestedReadWriteBucket(pubkey[:])
+		if peerBucket == nil {
+			return nil
+		}
+
+		return peerBucket.Delete(peerStorageKey)
+	}, func() {})
+}
@@ -47,3 +47,44 @@ func TestFlapCount(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, peer2FlapCount, count)
 }
+
+// TestPeerStorage tests storing, replacing and deleting the blob of a peer.
+func TestPeerStorage(t *testing.T) {
+	db, err := MakeTestDB(t)
+	require.NoError(t, err)
+
+	// We don't store a blob for an unknown peer.
+	_, err = db.FetchPeerStorage(testPub)
+	require.ErrorIs(t, err, ErrNoPeerStorage)
+
+	// Deleting the blob of an unknown peer is a no-op.
+	require.NoError(t, db.DeletePeerStorage(testPub))
+
+	// Nor do we store one for a peer whose bucket only holds its flap
+	// count.
+	err = db.WriteFlapCounts(map[route.Vertex]*FlapCount{
+		testPub: {Count: 1, LastFlap: time.Unix(100, 0)},
+	})
+	require.NoError(t, err)
+
+	_, err = db.FetchPeerStorage(testPub)
+	require.ErrorIs(t, err, ErrNoPeerStorage)
+
+	// A stored blob is replaced by the next one.
+	require.NoError(t, db.PutPeerStorage(testPub, []byte{1, 2, 3}))
+	require.NoError(t, db.PutPeerStorage(testPub, []byte{4, 5}))
+
+	blob, err := db.FetchPeerStorage(testPub)
+	require.NoError(t, err)
+	require.Equal(t, []byte{4, 5}, blob)
+
+	// Deleting the blob leaves the flap count of the peer untouched.
+	require.NoError(t, db.DeletePeerStorage(testPub))
+
+	_, err = db.FetchPeerStorage(testPub)
+	require.ErrorIs(t, err, ErrNoPeerStorage)
+
+	count, err := db.ReadFlapCount(testPub)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, count.Count)
+}
@@ -178,15 +178,27 @@ func (c *chanDBRestorer) openChannelShell(backup chanbackup.Single) (
 		chanType |= channeldb.SimpleTaprootFeatureBit
 		chanType |= channeldb.TaprootFinalBit
 
+	case chanbackup.ZeroFeeCommitmentsVersion:
+		chanType = channeldb.ZeroHtlcTxFeeBit
+		chanType |= channeldb.AnchorOutputsBit
+		chanType |= channeldb.SingleFunderTweaklessBit
+		chanType |= channeldb.ZeroFeeCommitmentsBit
+
 	default:
 		return nil, fmt.Errorf("unknown Single version: %w", err)
 	}
 
+	// The remote party knows a channel whose funding transaction was
+	// constructed interactively by its v2 channel ID.
+	if backup.InteractiveFunding {
+		chanType |= channeldb.InteractiveFundingBit
+	}
+
 	ltndLog.Infof("SCB Recovery: created channel shell for ChannelPoint"+
 		"(%v), chan_type=%v", backup.FundingOutpoint, chanType)
 
 	chanShell := channeldb.ChannelShell{
-		NodeAddrs: backup.Addresses,
+		NodeAddrs: backup.AllAddresses(),
 		Chan: &channeldb.OpenChannel{
 			ChanType:                chanType,
 			ChainHash:               backup.ChainHash,
@@ -46,8 +46,10 @@ const (
 	ZeroHtlcTxFeeBit ChannelType = 1 << 5
 
 	// LeaseExpirationBit indicates that the channel has been leased for a
-	// period of time, constraining every output that pays to the channel
-	// initiator with an additional CLTV of the lease maturity.
+	// period of time, constraining every output that pays to the seller
+	// of the lease with an additional CLTV of the lease maturity. The
+	// seller is the channel initiator, unless the channel was funded
+	// interactively, in which case it's the responder.
 	LeaseExpirationBit ChannelType = 1 << 6
 
 	// ZeroConfBit indicates that the channel is a zero-conf channel.
@@ -74,6 +76,18 @@ const (
 	// final/production taproot scripts and feature bits 80/81. This MUST
 	// be set along with the SimpleTaprootFeatureBit.
 	TaprootFinalBit ChannelType = 1 << 12
+
+	// ZeroFeeCommitmentsBit indicates that the channel uses v3 (TRUC)
+	// commitment transactions that pay no fee and carry a single shared
+	// pay-to-anchor output instead of the two keyed anchors. This MUST be
+	// set along with the AnchorOutputsBit and ZeroHtlcTxFeeBit.
+	ZeroFeeCommitmentsBit ChannelType = 1 << 13
+
+	// InteractiveFundingBit indicates that the funding transaction of the
+	// channel was constructed interactively with the remote party, so
+	// both parties may have contributed to the funding output. The
+	// constructed funding transaction is stored with the channel.
+	InteractiveFundingBit ChannelType = 1 << 14
 )
 
 // IsSingleFunder returns true if the channel type if one of the known single
@@ -155,3 +169,15 @@ func (c ChannelType) HasTapscriptRoot() bool {
 func (c ChannelType) IsTaprootFinal() bool {
 	return c&TaprootFinalBit == TaprootFinalBit
 }
+
+// HasZeroFeeCommitments returns true if the channel uses zero-fee v3
+// commitment transactions with a single shared pay-to-anchor output.
+func (c ChannelType) HasZeroFeeCommitments() bool {
+	return c&ZeroFeeCommitmentsBit == ZeroFeeCommitmentsBit
+}
+
+// HasInteractiveFunding returns true if the funding transaction of the
+// channel was constructed interactively with the remote party.
+func (c ChannelType) HasInteractiveFunding() bool {
+	return c&InteractiveFundingBit == InteractiveFundingBit
+}
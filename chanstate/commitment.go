@@ -193,6 +193,14 @@ type HTLC struct {
 	// a TLV stream of additional information associated with the HTLC.
 	BlindingPoint lnwire.BlindingPointRecord
 
+	// UpfrontFee is the optional unconditional fee that was paid along
+	// with the HTLC.
+	//
+	// Note: this field is not a part of on-disk representation of the
+	// HTLC. It is stored in the ExtraData field alongside the blinding
+	// point.
+	UpfrontFee lnwire.UpfrontFeeRecord
+
 	// CustomRecords is a set of custom TLV records that are associated with
 	// this HTLC. These records are used to store additional information
 	// about the HTLC that is not part of the standard HTLC fields. This
@@ -221,6 +229,7 @@ func (h *HTLC) Copy() HTLC {
 		copy(clone.ExtraData, h.ExtraData)
 	}
 	clone.BlindingPoint = h.BlindingPoint
+	clone.UpfrontFee = h.UpfrontFee
 	if h.CustomRecords != nil {
 		clone.CustomRecords = make(
 			lnwire.CustomRecords, len(h.CustomRecords),
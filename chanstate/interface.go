@@ -190,6 +190,14 @@ type OpenChannelStatusStore interface {
 
 	// MarkChannelBorked marks the channel as irreconcilable.
 	MarkChannelBorked(channel *OpenChannel) error
+
+	// StoreChannelPaused persists whether the channel was paused, so that
+	// it stays paused across reconnections and restarts.
+	StoreChannelPaused(channel *OpenChannel, paused bool) error
+
+	// FetchChannelPaused returns whether the channel was paused and hasn't
+	// been resumed since.
+	FetchChannelPaused(channel *OpenChannel) (bool, error)
 }
 
 // OpenChannelShutdownStore owns persisted shutdown state.
@@ -176,7 +176,9 @@ type OpenChannel struct {
 	// NOTE: This value will only be populated for single-funder channels
 	// for which we are the initiator, and that we also have the funding
 	// transaction for. One can check this by using the HasFundingTx()
-	// method on the ChanType field.
+	// method on the ChanType field. For interactively funded channels, it
+	// is populated for both parties with the constructed transaction,
+	// which doesn't carry the witnesses of its inputs.
 	FundingTxn *wire.MsgTx
 
 	// LocalShutdownScript is set to a pre-set script if the channel was
@@ -279,6 +281,35 @@ func (c *OpenChannel) ShortChanID() lnwire.ShortChannelID {
 	return c.ShortChannelID
 }
 
+// WireChanID returns the ChannelID the remote peer knows the channel by. For
+// channels whose funding transaction was constructed interactively, this is
+// the ChannelID derived from the revocation basepoints of both peers, which
+// stays the same if the funding transaction is replaced. For all other
+// channels, it's the ChannelID derived from the funding outpoint.
+func (c *OpenChannel) WireChanID() lnwire.ChannelID {
+	if !c.ChanType.HasInteractiveFunding() {
+		return lnwire.NewChanIDFromOutPoint(c.FundingOutpoint)
+	}
+
+	return lnwire.NewChanIDFromRevocationBasepoints(
+		c.LocalChanCfg.RevocationBasePoint.PubKey,
+		c.RemoteChanCfg.RevocationBasePoint.PubKey,
+	)
+}
+
+// IsLeaseSeller returns true if we're the seller of a leased channel, whose
+// outputs are constrained by the lease expiry. The seller of a single-funded
+// lease is the initiator, who opened the channel to the buyer. A dual-funded
+// channel is leased through the request of the initiator to the responder to
+// contribute funds, which makes the responder the seller.
+func (c *OpenChannel) IsLeaseSeller() bool {
+	if c.ChanType.HasInteractiveFunding() {
+		return !c.IsInitiator
+	}
+
+	return c.IsInitiator
+}
+
 // ZeroConfRealScid returns the zero-conf channel's confirmed scid. This should
 // only be called if IsZeroConf returns true.
 func (c *OpenChannel) ZeroConfRealScid() lnwire.ShortChannelID {
@@ -724,6 +755,25 @@ func (c *OpenChannel) ShutdownInfo() (fn.Option[ShutdownInfo], error) {
 	return c.Db.FetchChannelShutdownInfo(c)
 }
 
+// MarkPaused persists whether the channel is paused. A paused channel doesn't
+// accept new HTLCs and stays paused across reconnections and restarts until it
+// is resumed.
+func (c *OpenChannel) MarkPaused(paused bool) error {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.Db.StoreChannelPaused(c, paused)
+}
+
+// IsPaused returns true if the channel was paused and hasn't been resumed
+// since.
+func (c *OpenChannel) IsPaused() (bool, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.Db.FetchChannelPaused(c)
+}
+
 // MarkCommitmentBroadcasted marks the channel as a commitment transaction has
 // been broadcast, either our own or the remote, and we should watch the chain
 // for it to confirm before taking any further action. It takes as argument the
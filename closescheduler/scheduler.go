@@ -0,0 +1,560 @@
+package closescheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chancloser"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/peer"
+)
+
+const (
+	// DefaultConfTarget is the confirmation target used to estimate the
+	// fee rate of a scheduled close if none was given.
+	DefaultConfTarget = 6
+)
+
+var (
+	// ErrAlreadyScheduled is returned when a close is scheduled for a
+	// channel that already has a close scheduled.
+	ErrAlreadyScheduled = errors.New("close already scheduled for channel")
+
+	// ErrCloseInitiated is returned when a scheduled close is canceled
+	// after the close of the channel was already initiated.
+	ErrCloseInitiated = errors.New("close of channel already initiated")
+
+	// ErrNoTrigger is returned when a close is scheduled with neither a
+	// deadline nor a target fee rate.
+	ErrNoTrigger = errors.New("either a deadline height or a target fee " +
+		"rate must be set")
+
+	// p2trScript is a placeholder for a P2TR script. As the largest
+	// standard script we pay out to, it's used to estimate the weight of
+	// closing transactions whose output scripts aren't known yet.
+	p2trScript = make([]byte, 34)
+)
+
+// CloseFunc initiates the cooperative close of a channel with the given fee
+// rate and returns the channels its updates and its error are delivered on. If
+// the close of the channel was already initiated, the fee of its closing
+// transaction is bumped instead, which is only possible for channels using the
+// RBF cooperative close protocol. A zero maxFeeRate means that the default
+// maximum fee rate applies.
+type CloseFunc func(ctx context.Context, chanPoint wire.OutPoint, feeRate,
+	maxFeeRate chainfee.SatPerKWeight,
+	deliveryScript lnwire.DeliveryAddress) (chan interface{}, chan error,
+	error)
+
+// Config holds the dependencies of the close scheduler.
+type Config struct {
+	// Store persists the scheduled closes.
+	Store Store
+
+	// Notifier is used to get notified of new blocks.
+	Notifier chainntnfs.ChainNotifier
+
+	// FeeEstimator is used to estimate the fee rate of closing
+	// transactions.
+	FeeEstimator chainfee.Estimator
+
+	// FetchChannel returns the open channel with the given funding
+	// outpoint, or channeldb.ErrChannelNotFound if it was closed.
+	FetchChannel func(chanPoint wire.OutPoint) (*channeldb.OpenChannel,
+		error)
+
+	// CloseChannel initiates the cooperative close of a channel or bumps
+	// the fee of its closing transaction.
+	CloseChannel CloseFunc
+}
+
+// Scheduler executes scheduled cooperative closes. A close is initiated once
+// its deadline height is reached or the estimated fee rate drops to its target
+// fee rate. As long as the closing transaction stays unconfirmed, its fee is
+// bumped each block up to the budget of the close if the channel uses the RBF
+// cooperative close protocol. Scheduled closes are persisted, so they survive
+// restarts.
+type Scheduler struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// mu serializes the updates of the scheduled closes.
+	mu sync.Mutex
+
+	// bestHeight is the height of the latest block we processed.
+	bestHeight uint32
+
+	// pending holds the channels with a closing attempt that awaits its
+	// closing transaction. No further attempt is made for them until the
+	// transaction was broadcast or the attempt failed.
+	pending map[wire.OutPoint]struct{}
+
+	// newClose is signaled when a close was scheduled, so that it's
+	// evaluated without waiting for the next block.
+	newClose chan struct{}
+
+	cg *fn.ContextGuard
+}
+
+// NewScheduler creates a new close scheduler.
+func NewScheduler(cfg *Config) *Scheduler {
+	return &Scheduler{
+		cfg:      cfg,
+		pending:  make(map[wire.OutPoint]struct{}),
+		newClose: make(chan struct{}, 1),
+		cg:       fn.NewContextGuard(),
+	}
+}
+
+// Start starts the scheduler.
+func (s *Scheduler) Start() error {
+	var startErr error
+	s.started.Do(func() {
+		log.Debugf("Close scheduler starting")
+
+		blockEpochs, err := s.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+		if err != nil {
+			startErr = fmt.Errorf("unable to register for block "+
+				"epochs: %w", err)
+
+			return
+		}
+
+		ctx, _ := s.cg.Create(context.Background())
+
+		s.cg.WgAdd(1)
+		go s.blockHandler(ctx, blockEpochs)
+	})
+
+	return startErr
+}
+
+// Stop stops the scheduler and waits for all of its goroutines to exit.
+func (s *Scheduler) Stop() error {
+	s.stopped.Do(func() {
+		log.Debugf("Close scheduler stopping")
+
+		s.cg.Quit()
+	})
+
+	return nil
+}
+
+// Schedule schedules the cooperative close of a channel. The close is
+// evaluated right away, so it's initiated without waiting for the next block
+// if its deadline or target fee rate is already reached.
+func (s *Scheduler) Schedule(c *ScheduledClose) error {
+	if c.DeadlineHeight == 0 && c.TargetFeeRate == 0 {
+		return ErrNoTrigger
+	}
+
+	if c.ConfTarget == 0 {
+		c.ConfTarget = DefaultConfTarget
+	}
+
+	c.State = StateWaiting
+	c.FeeRate = 0
+	c.ClosingTxid = chainhash.Hash{}
+
+	if _, err := s.cfg.FetchChannel(c.ChanPoint); err != nil {
+		return fmt.Errorf("unable to fetch channel %v: %w", c.ChanPoint,
+			err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := s.cfg.Store.FetchScheduledClose(c.ChanPoint)
+	switch {
+	case err == nil:
+		return ErrAlreadyScheduled
+
+	case !errors.Is(err, ErrNotScheduled):
+		return err
+	}
+
+	if err := s.cfg.Store.PutScheduledClose(c); err != nil {
+		return fmt.Errorf("unable to store scheduled close: %w", err)
+	}
+
+	log.Infof("Scheduled close of channel %v: deadline_height=%v, "+
+		"target_fee_rate=%v, conf_target=%v, budget=%v", c.ChanPoint,
+		c.DeadlineHeight, c.TargetFeeRate, c.ConfTarget, c.Budget)
+
+	select {
+	case s.newClose <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Cancel cancels the scheduled close of a channel. A close can only be
+// canceled before it was initiated.
+func (s *Scheduler) Cancel(chanPoint wire.OutPoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, err := s.cfg.Store.FetchScheduledClose(chanPoint)
+	if err != nil {
+		return err
+	}
+
+	if c.State != StateWaiting {
+		return ErrCloseInitiated
+	}
+
+	log.Infof("Canceled scheduled close of channel %v", chanPoint)
+
+	return s.cfg.Store.DeleteScheduledClose(chanPoint)
+}
+
+// ScheduledCloses returns all scheduled closes.
+func (s *Scheduler) ScheduledCloses() ([]*ScheduledClose, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cfg.Store.FetchScheduledCloses()
+}
+
+// blockHandler processes the scheduled closes on each new block.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *Scheduler) blockHandler(ctx context.Context,
+	blockEpochs *chainntnfs.BlockEpochEvent) {
+
+	defer s.cg.WgDone()
+	defer blockEpochs.Cancel()
+
+	for {
+		select {
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return
+			}
+
+			s.processBlock(ctx, uint32(epoch.Height))
+
+		case <-s.newClose:
+			s.mu.Lock()
+			height := s.bestHeight
+			s.mu.Unlock()
+
+			// Before we received our first block, the close is
+			// evaluated with the first one.
+			if height != 0 {
+				s.processBlock(ctx, height)
+			}
+
+		case <-s.cg.Done():
+			return
+		}
+	}
+}
+
+// processBlock processes all scheduled closes at the given height.
+func (s *Scheduler) processBlock(ctx context.Context, height uint32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.bestHeight = height
+
+	closes, err := s.cfg.Store.FetchScheduledCloses()
+	if err != nil {
+		log.Errorf("Unable to fetch scheduled closes: %v", err)
+		return
+	}
+
+	for _, c := range closes {
+		if err := s.process(ctx, c, height); err != nil {
+			log.Errorf("Unable to process scheduled close of "+
+				"channel %v: %v", c.ChanPoint, err)
+		}
+	}
+}
+
+// process initiates the given scheduled close if its deadline or target fee
+// rate is reached, or bumps the fee of its closing transaction if the close
+// was already initiated.
+//
+// NOTE: The caller must hold mu.
+func (s *Scheduler) process(ctx context.Context, c *ScheduledClose,
+	height uint32) error {
+
+	if _, ok := s.pending[c.ChanPoint]; ok {
+		return nil
+	}
+
+	channel, err := s.cfg.FetchChannel(c.ChanPoint)
+	switch {
+	// Once the closing transaction confirmed, or if the channel was
+	// closed in another way, there's nothing left to do for us.
+	case errors.Is(err, channeldb.ErrChannelNotFound),
+		errors.Is(err, channeldb.ErrNoActiveChannels):
+
+		log.Infof("Channel %v closed, removing its scheduled close",
+			c.ChanPoint)
+
+		return s.cfg.Store.DeleteScheduledClose(c.ChanPoint)
+
+	case err != nil:
+		return fmt.Errorf("unable to fetch channel: %w", err)
+	}
+
+	// We can only bump the fee of a closing transaction that was
+	// broadcast, and only if we have a budget for it.
+	if c.State == StateClosing &&
+		(c.Budget == 0 || c.ClosingTxid == (chainhash.Hash{})) {
+
+		return nil
+	}
+
+	feeRate, err := s.cfg.FeeEstimator.EstimateFeePerKW(c.ConfTarget)
+	if err != nil {
+		return fmt.Errorf("unable to estimate fee rate: %w", err)
+	}
+
+	maxFeeRate := maxCloseFeeRate(channel, c)
+
+	switch c.State {
+	case StateWaiting:
+		deadlineReached := c.DeadlineHeight != 0 &&
+			height >= c.DeadlineHeight
+		targetReached := c.TargetFeeRate != 0 &&
+			feeRate <= c.TargetFeeRate
+
+		if !deadlineReached && !targetReached {
+			return nil
+		}
+
+		log.Infof("Initiating scheduled close of channel %v at "+
+			"height=%v: deadline_reached=%v, target_reached=%v",
+			c.ChanPoint, height, deadlineReached, targetReached)
+
+	case StateClosing:
+		// The closing transaction didn't confirm within the last
+		// block, so we raise its fee rate at least by 10%, and by no
+		// less than the incremental relay fee.
+		minFeeRate := c.FeeRate + max(
+			c.FeeRate/10, chainfee.FeePerKwFloor,
+		)
+		feeRate = max(feeRate, minFeeRate)
+
+	default:
+		return fmt.Errorf("unknown state %v", c.State)
+	}
+
+	if maxFeeRate != 0 && feeRate > maxFeeRate {
+		feeRate = maxFeeRate
+	}
+
+	if c.State == StateClosing && feeRate <= c.FeeRate {
+		log.Debugf("Budget %v of scheduled close of channel %v "+
+			"exhausted at fee rate %v", c.Budget, c.ChanPoint,
+			c.FeeRate)
+
+		return nil
+	}
+
+	updates, errChan, err := s.cfg.CloseChannel(
+		ctx, c.ChanPoint, feeRate, maxFeeRate, c.DeliveryScript,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to close channel with fee rate "+
+			"%v: %w", feeRate, err)
+	}
+
+	c.State = StateClosing
+	c.FeeRate = feeRate
+	if err := s.cfg.Store.PutScheduledClose(c); err != nil {
+		return fmt.Errorf("unable to store scheduled close: %w", err)
+	}
+
+	s.pending[c.ChanPoint] = struct{}{}
+
+	s.cg.WgAdd(1)
+	go s.watchClose(ctx, c.ChanPoint, updates, errChan)
+
+	return nil
+}
+
+// watchClose processes the updates of a closing attempt.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *Scheduler) watchClose(ctx context.Context, chanPoint wire.OutPoint,
+	updates chan interface{}, errChan chan error) {
+
+	defer s.cg.WgDone()
+
+	for {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				s.closeFailed(chanPoint, errors.New("close "+
+					"updates closed"))
+
+				return
+			}
+
+			switch u := update.(type) {
+			case *peer.PendingUpdate:
+				// With the RBF cooperative close, we're also
+				// notified of the closing transactions of the
+				// remote party, which we don't track.
+				if !u.IsLocalCloseTx.UnwrapOr(true) {
+					continue
+				}
+
+				txid, err := chainhash.NewHash(u.Txid)
+				if err != nil {
+					s.closeFailed(chanPoint, err)
+					return
+				}
+
+				var feeRate chainfee.SatPerKWeight
+				u.FeePerVbyte.WhenSome(
+					func(r chainfee.SatPerVByte) {
+						feeRate = r.FeePerKWeight()
+					},
+				)
+
+				s.closeBroadcast(chanPoint, *txid, feeRate)
+
+			case *peer.ChannelCloseUpdate:
+				s.closeConfirmed(chanPoint)
+				return
+			}
+
+		case err := <-errChan:
+			s.closeFailed(chanPoint, err)
+			return
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// closeBroadcast records the closing transaction of a scheduled close. A
+// zero fee rate means that the fee rate of the closing attempt is kept.
+func (s *Scheduler) closeBroadcast(chanPoint wire.OutPoint,
+	txid chainhash.Hash, feeRate chainfee.SatPerKWeight) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.pending, chanPoint)
+
+	c, err := s.cfg.Store.FetchScheduledClose(chanPoint)
+	if err != nil {
+		log.Errorf("Unable to fetch scheduled close of channel %v: %v",
+			chanPoint, err)
+
+		return
+	}
+
+	log.Infof("Closing transaction %v of channel %v broadcast", txid,
+		chanPoint)
+
+	c.ClosingTxid = txid
+	if feeRate != 0 {
+		c.FeeRate = feeRate
+	}
+
+	if err := s.cfg.Store.PutScheduledClose(c); err != nil {
+		log.Errorf("Unable to store scheduled close of channel %v: %v",
+			chanPoint, err)
+	}
+}
+
+// closeConfirmed removes a scheduled close whose closing transaction
+// confirmed.
+func (s *Scheduler) closeConfirmed(chanPoint wire.OutPoint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.pending, chanPoint)
+
+	log.Infof("Scheduled close of channel %v completed", chanPoint)
+
+	if err := s.cfg.Store.DeleteScheduledClose(chanPoint); err != nil {
+		log.Errorf("Unable to delete scheduled close of channel %v: %v",
+			chanPoint, err)
+	}
+}
+
+// closeFailed handles a failed closing attempt. If no closing transaction was
+// broadcast yet, the close waits for its trigger again, so it's retried with
+// the next block.
+func (s *Scheduler) closeFailed(chanPoint wire.OutPoint, closeErr error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.pending, chanPoint)
+
+	log.Warnf("Closing attempt of scheduled close of channel %v failed: "+
+		"%v", chanPoint, closeErr)
+
+	c, err := s.cfg.Store.FetchScheduledClose(chanPoint)
+	if err != nil {
+		log.Errorf("Unable to fetch scheduled close of channel %v: %v",
+			chanPoint, err)
+
+		return
+	}
+
+	if c.ClosingTxid != (chainhash.Hash{}) {
+		return
+	}
+
+	c.State = StateWaiting
+	c.FeeRate = 0
+	if err := s.cfg.Store.PutScheduledClose(c); err != nil {
+		log.Errorf("Unable to store scheduled close of channel %v: %v",
+			chanPoint, err)
+	}
+}
+
+// maxCloseFeeRate returns the highest fee rate of a closing transaction of the
+// given channel that stays within the budget of the scheduled close. Zero is
+// returned if the close has no budget.
+func maxCloseFeeRate(channel *channeldb.OpenChannel,
+	c *ScheduledClose) chainfee.SatPerKWeight {
+
+	if c.Budget == 0 {
+		return 0
+	}
+
+	localScript := []byte(c.DeliveryScript)
+	if len(localScript) == 0 {
+		localScript = channel.LocalShutdownScript
+	}
+	if len(localScript) == 0 {
+		localScript = p2trScript
+	}
+
+	remoteScript := []byte(channel.RemoteShutdownScript)
+	if len(remoteScript) == 0 {
+		remoteScript = p2trScript
+	}
+
+	// The fee at 1000 sat/kw equals the weight of the closing transaction,
+	// which gives us the fee rate the budget pays for.
+	estimator := &chancloser.SimpleCoopFeeEstimator{}
+	weight := estimator.EstimateFee(
+		channel.ChanType, &wire.TxOut{PkScript: localScript},
+		&wire.TxOut{PkScript: remoteScript}, 1000,
+	)
+
+	return chainfee.SatPerKWeight(c.Budget * 1000 / weight)
+}
@@ -0,0 +1,352 @@
+package closescheduler
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lntest/mock"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/peer"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = 5 * time.Second
+
+var testChanPoint = wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1}
+
+// txidBytes returns the bytes of a test txid.
+func txidBytes(b byte) []byte {
+	txid := chainhash.Hash{b}
+	return txid[:]
+}
+
+// testEstimator is a fee estimator that returns the fee rates the test feeds
+// it, one per estimate.
+type testEstimator struct {
+	rates chan chainfee.SatPerKWeight
+}
+
+func (e *testEstimator) EstimateFeePerKW(uint32) (chainfee.SatPerKWeight,
+	error) {
+
+	return <-e.rates, nil
+}
+
+func (e *testEstimator) Start() error { return nil }
+
+func (e *testEstimator) Stop() error { return nil }
+
+func (e *testEstimator) RelayFeePerKW() chainfee.SatPerKWeight {
+	return chainfee.FeePerKwFloor
+}
+
+// closeAttempt is a closing attempt made by the scheduler.
+type closeAttempt struct {
+	feeRate    chainfee.SatPerKWeight
+	maxFeeRate chainfee.SatPerKWeight
+	updates    chan interface{}
+	errChan    chan error
+}
+
+// schedulerHarness holds a scheduler and its mocked dependencies.
+type schedulerHarness struct {
+	t *testing.T
+
+	scheduler *Scheduler
+	epochs    chan *chainntnfs.BlockEpoch
+	estimator *testEstimator
+	attempts  chan *closeAttempt
+	closed    atomic.Bool
+}
+
+func newSchedulerHarness(t *testing.T) *schedulerHarness {
+	cdb, err := channeldb.MakeTestDB(t)
+	require.NoError(t, err)
+
+	store, err := NewStore(cdb)
+	require.NoError(t, err)
+
+	h := &schedulerHarness{
+		t:      t,
+		epochs: make(chan *chainntnfs.BlockEpoch),
+		estimator: &testEstimator{
+			rates: make(chan chainfee.SatPerKWeight),
+		},
+		attempts: make(chan *closeAttempt, 1),
+	}
+
+	h.scheduler = NewScheduler(&Config{
+		Store: store,
+		Notifier: &mock.ChainNotifier{
+			EpochChan: h.epochs,
+		},
+		FeeEstimator: h.estimator,
+		FetchChannel: func(wire.OutPoint) (*channeldb.OpenChannel,
+			error) {
+
+			if h.closed.Load() {
+				return nil, channeldb.ErrChannelNotFound
+			}
+
+			return &channeldb.OpenChannel{
+				ChanType: channeldb.SingleFunderTweaklessBit,
+			}, nil
+		},
+		CloseChannel: func(_ context.Context, _ wire.OutPoint, feeRate,
+			maxFeeRate chainfee.SatPerKWeight,
+			_ lnwire.DeliveryAddress) (chan interface{}, chan error,
+			error) {
+
+			attempt := &closeAttempt{
+				feeRate:    feeRate,
+				maxFeeRate: maxFeeRate,
+				updates:    make(chan interface{}, 2),
+				errChan:    make(chan error, 1),
+			}
+			h.attempts <- attempt
+
+			return attempt.updates, attempt.errChan, nil
+		},
+	})
+	t.Cleanup(func() {
+		require.NoError(t, h.scheduler.Stop())
+	})
+
+	return h
+}
+
+// block notifies the scheduler of a new block. If a fee rate is given, it's
+// returned for the estimate the scheduler makes while processing the block.
+func (h *schedulerHarness) block(height int32,
+	feeRate fn.Option[chainfee.SatPerKWeight]) {
+
+	h.t.Helper()
+
+	select {
+	case h.epochs <- &chainntnfs.BlockEpoch{Height: height}:
+	case <-time.After(testTimeout):
+		h.t.Fatalf("block %v not processed", height)
+	}
+
+	feeRate.WhenSome(h.estimate)
+}
+
+// estimate returns the given fee rate for the next estimate the scheduler
+// makes.
+func (h *schedulerHarness) estimate(feeRate chainfee.SatPerKWeight) {
+	h.t.Helper()
+
+	select {
+	case h.estimator.rates <- feeRate:
+	case <-time.After(testTimeout):
+		h.t.Fatalf("no fee rate estimated")
+	}
+}
+
+// assertAttempt asserts that the scheduler made a closing attempt with the
+// given fee rate and maximum fee rate.
+func (h *schedulerHarness) assertAttempt(feeRate,
+	maxFeeRate chainfee.SatPerKWeight) *closeAttempt {
+
+	h.t.Helper()
+
+	select {
+	case attempt := <-h.attempts:
+		require.Equal(h.t, feeRate, attempt.feeRate)
+		require.Equal(h.t, maxFeeRate, attempt.maxFeeRate)
+
+		return attempt
+
+	case <-time.After(testTimeout):
+		h.t.Fatalf("no closing attempt made")
+		return nil
+	}
+}
+
+// assertNoAttempt asserts that the scheduler made no closing attempt.
+func (h *schedulerHarness) assertNoAttempt() {
+	h.t.Helper()
+
+	select {
+	case attempt := <-h.attempts:
+		h.t.Fatalf("unexpected closing attempt with fee rate %v",
+			attempt.feeRate)
+
+	default:
+	}
+}
+
+// assertClose asserts that the scheduled close of the test channel
+// eventually satisfies the given predicate, or that it no longer exists if the
+// predicate is nil.
+func (h *schedulerHarness) assertClose(pred func(*ScheduledClose) bool) {
+	h.t.Helper()
+
+	require.Eventually(h.t, func() bool {
+		closes, err := h.scheduler.ScheduledCloses()
+		require.NoError(h.t, err)
+
+		if pred == nil {
+			return len(closes) == 0
+		}
+
+		return len(closes) == 1 && pred(closes[0])
+	}, testTimeout, 10*time.Millisecond)
+}
+
+// TestSchedulerTargetFeeRate tests that a close is initiated once the fee
+// rate drops to its target, and that its closing transaction is bumped until
+// the budget is exhausted.
+func TestSchedulerTargetFeeRate(t *testing.T) {
+	t.Parallel()
+
+	h := newSchedulerHarness(t)
+	require.NoError(t, h.scheduler.Start())
+	h.block(100, fn.None[chainfee.SatPerKWeight]())
+
+	// A newly scheduled close is evaluated right away. As long as the fee
+	// rate is above the target, no close is initiated.
+	scheduled := &ScheduledClose{
+		ChanPoint:     testChanPoint,
+		TargetFeeRate: 1000,
+		Budget:        5000,
+	}
+	require.NoError(t, h.scheduler.Schedule(scheduled))
+	h.estimate(2000)
+
+	maxFeeRate := maxCloseFeeRate(&channeldb.OpenChannel{
+		ChanType: channeldb.SingleFunderTweaklessBit,
+	}, scheduled)
+	require.NotZero(t, maxFeeRate)
+
+	h.block(101, fn.Some[chainfee.SatPerKWeight](900))
+	attempt := h.assertAttempt(900, maxFeeRate)
+
+	// The closing transaction of the remote party isn't tracked.
+	attempt.updates <- &peer.PendingUpdate{
+		Txid:           txidBytes(2),
+		IsLocalCloseTx: fn.Some(false),
+	}
+	attempt.updates <- &peer.PendingUpdate{
+		Txid: txidBytes(3),
+	}
+	h.assertClose(func(c *ScheduledClose) bool {
+		return c.State == StateClosing &&
+			c.ClosingTxid == chainhash.Hash{3} && c.FeeRate == 900
+	})
+
+	// With the next block, the fee rate is raised at least by the
+	// incremental relay fee, even if the estimate didn't change.
+	h.block(102, fn.Some[chainfee.SatPerKWeight](900))
+	attempt = h.assertAttempt(900+chainfee.FeePerKwFloor, maxFeeRate)
+
+	// The fee rate reported for the bumped transaction is recorded.
+	attempt.updates <- &peer.PendingUpdate{
+		Txid:        txidBytes(4),
+		FeePerVbyte: fn.Some(chainfee.SatPerVByte(5)),
+	}
+	h.assertClose(func(c *ScheduledClose) bool {
+		return c.ClosingTxid == chainhash.Hash{4} &&
+			c.FeeRate == chainfee.SatPerVByte(5).FeePerKWeight()
+	})
+
+	// The fee rate is capped by the budget.
+	h.block(103, fn.Some(maxFeeRate*2))
+	attempt = h.assertAttempt(maxFeeRate, maxFeeRate)
+	attempt.updates <- &peer.PendingUpdate{
+		Txid: txidBytes(5),
+	}
+	h.assertClose(func(c *ScheduledClose) bool {
+		return c.ClosingTxid == chainhash.Hash{5}
+	})
+
+	// Once the budget is exhausted, the transaction is no longer bumped.
+	h.block(104, fn.Some(maxFeeRate*2))
+	h.block(105, fn.Some(maxFeeRate*2))
+	h.assertNoAttempt()
+
+	// The scheduled close is removed once the closing transaction
+	// confirmed.
+	attempt.updates <- &peer.ChannelCloseUpdate{}
+	h.assertClose(nil)
+}
+
+// TestSchedulerDeadline tests that a close is initiated once its deadline is
+// reached, that failed attempts are retried and that scheduled closes can be
+// canceled before they are initiated.
+func TestSchedulerDeadline(t *testing.T) {
+	t.Parallel()
+
+	h := newSchedulerHarness(t)
+	require.NoError(t, h.scheduler.Start())
+	h.block(100, fn.None[chainfee.SatPerKWeight]())
+
+	// A close needs a trigger.
+	err := h.scheduler.Schedule(&ScheduledClose{ChanPoint: testChanPoint})
+	require.ErrorIs(t, err, ErrNoTrigger)
+
+	scheduled := &ScheduledClose{
+		ChanPoint:      testChanPoint,
+		DeadlineHeight: 105,
+	}
+	require.NoError(t, h.scheduler.Schedule(scheduled))
+	require.Equal(t, uint32(DefaultConfTarget), scheduled.ConfTarget)
+	h.estimate(5000)
+
+	err = h.scheduler.Schedule(scheduled)
+	require.ErrorIs(t, err, ErrAlreadyScheduled)
+
+	// A close can be canceled before it's initiated.
+	require.NoError(t, h.scheduler.Cancel(testChanPoint))
+	require.ErrorIs(
+		t, h.scheduler.Cancel(testChanPoint), ErrNotScheduled,
+	)
+
+	require.NoError(t, h.scheduler.Schedule(scheduled))
+	h.estimate(5000)
+
+	// The close is initiated at its deadline, regardless of the fee rate.
+	h.block(104, fn.Some[chainfee.SatPerKWeight](5000))
+	h.block(105, fn.Some[chainfee.SatPerKWeight](5000))
+	attempt := h.assertAttempt(5000, 0)
+
+	// Once initiated, the close can't be canceled anymore.
+	require.ErrorIs(
+		t, h.scheduler.Cancel(testChanPoint), ErrCloseInitiated,
+	)
+
+	// A failed attempt is retried with the next block.
+	attempt.errChan <- errors.New("peer offline")
+	h.assertClose(func(c *ScheduledClose) bool {
+		return c.State == StateWaiting
+	})
+
+	h.block(106, fn.Some[chainfee.SatPerKWeight](6000))
+	attempt = h.assertAttempt(6000, 0)
+	attempt.updates <- &peer.PendingUpdate{
+		Txid: txidBytes(2),
+	}
+	h.assertClose(func(c *ScheduledClose) bool {
+		return c.ClosingTxid == chainhash.Hash{2}
+	})
+
+	// Without a budget, the closing transaction isn't bumped.
+	h.block(107, fn.None[chainfee.SatPerKWeight]())
+	h.assertNoAttempt()
+
+	// Once the channel is closed, the scheduled close is removed, even if
+	// we missed the confirmation of the closing transaction, e.g. because
+	// we restarted.
+	h.closed.Store(true)
+	h.block(108, fn.None[chainfee.SatPerKWeight]())
+	h.assertClose(nil)
+}
@@ -0,0 +1,340 @@
+package closescheduler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/v2"
+	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	graphdb "github.com/lightningnetwork/lnd/graph/db"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// scheduledClosesBucket is the top-level bucket that stores the
+	// scheduled closes.
+	//
+	// maps: chanPoint -> ScheduledClose
+	scheduledClosesBucket = []byte("scheduled-closes")
+
+	// ErrNotScheduled is returned when a scheduled close is requested for
+	// a channel that has no close scheduled.
+	ErrNotScheduled = errors.New("no close scheduled for channel")
+)
+
+// State is the state of a scheduled close.
+type State uint8
+
+const (
+	// StateWaiting means that the close waits for its deadline or target
+	// fee rate to be reached.
+	StateWaiting State = 0
+
+	// StateClosing means that the cooperative close of the channel was
+	// initiated.
+	StateClosing State = 1
+)
+
+// String returns a human-readable representation of the state.
+func (s State) String() string {
+	switch s {
+	case StateWaiting:
+		return "Waiting"
+
+	case StateClosing:
+		return "Closing"
+
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint8(s))
+	}
+}
+
+// ScheduledClose is a cooperative channel close that is executed once its
+// deadline or target fee rate is reached.
+type ScheduledClose struct {
+	// ChanPoint is the funding outpoint of the channel to close. It's
+	// stored as the key, so it's not part of the serialized record.
+	ChanPoint wire.OutPoint
+
+	// DeadlineHeight is the block height at which the close is executed
+	// regardless of the fee rate. Zero means no deadline.
+	DeadlineHeight uint32
+
+	// TargetFeeRate is the fee rate at or below which the close is
+	// executed. Zero means no target.
+	TargetFeeRate chainfee.SatPerKWeight
+
+	// ConfTarget is the confirmation target used to estimate the fee rate
+	// of the closing transaction.
+	ConfTarget uint32
+
+	// Budget is the maximum absolute fee we're willing to pay for the
+	// closing transaction. Zero means that the closing transaction is
+	// never bumped.
+	Budget btcutil.Amount
+
+	// DeliveryScript is the optional script our funds are paid out to.
+	DeliveryScript lnwire.DeliveryAddress
+
+	// State is the state of the scheduled close.
+	State State
+
+	// FeeRate is the fee rate of our latest closing attempt.
+	FeeRate chainfee.SatPerKWeight
+
+	// ClosingTxid is the txid of our latest closing transaction. It's
+	// only known once the transaction was broadcast.
+	ClosingTxid chainhash.Hash
+}
+
+// Store persists the scheduled closes.
+type Store interface {
+	// PutScheduledClose adds or replaces the scheduled close of a channel.
+	PutScheduledClose(c *ScheduledClose) error
+
+	// FetchScheduledClose returns the scheduled close of the given
+	// channel, or ErrNotScheduled if it has none.
+	FetchScheduledClose(chanPoint wire.OutPoint) (*ScheduledClose, error)
+
+	// FetchScheduledCloses returns all scheduled closes.
+	FetchScheduledCloses() ([]*ScheduledClose, error)
+
+	// DeleteScheduledClose deletes the scheduled close of the given
+	// channel.
+	DeleteScheduledClose(chanPoint wire.OutPoint) error
+}
+
+// kvStore is a Store backed by a kvdb backend.
+type kvStore struct {
+	db kvdb.Backend
+}
+
+// A compile-time assertion to ensure that kvStore implements the Store
+// interface.
+var _ Store = (*kvStore)(nil)
+
+// NewStore creates a new store for scheduled closes, creating its bucket if
+// needed.
+func NewStore(db kvdb.Backend) (Store, error) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(scheduledClosesBucket)
+		return err
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &kvStore{db: db}, nil
+}
+
+// closeRecord is the serialized representation of a ScheduledClose.
+type closeRecord struct {
+	deadlineHeight uint32
+	targetFeeRate  uint64
+	confTarget     uint32
+	budget         uint64
+	deliveryScript []byte
+	state          uint8
+	feeRate        uint64
+	closingTxid    [32]byte
+}
+
+// toTlvStream converts a closeRecord into a tlv representation.
+func (r *closeRecord) toTlvStream() (*tlv.Stream, error) {
+	const (
+		// A set of tlv type definitions used to serialize a scheduled
+		// close.
+		//
+		// NOTE: ChanPoint is stored as the key, so it's not included
+		// here.
+		deadlineHeightType tlv.Type = 0
+		targetFeeRateType  tlv.Type = 1
+		confTargetType     tlv.Type = 2
+		budgetType         tlv.Type = 3
+		deliveryScriptType tlv.Type = 4
+		stateType          tlv.Type = 5
+		feeRateType        tlv.Type = 6
+		closingTxidType    tlv.Type = 7
+	)
+
+	return tlv.NewStream(
+		tlv.MakePrimitiveRecord(deadlineHeightType, &r.deadlineHeight),
+		tlv.MakePrimitiveRecord(targetFeeRateType, &r.targetFeeRate),
+		tlv.MakePrimitiveRecord(confTargetType, &r.confTarget),
+		tlv.MakePrimitiveRecord(budgetType, &r.budget),
+		tlv.MakePrimitiveRecord(deliveryScriptType, &r.deliveryScript),
+		tlv.MakePrimitiveRecord(stateType, &r.state),
+		tlv.MakePrimitiveRecord(feeRateType, &r.feeRate),
+		tlv.MakePrimitiveRecord(closingTxidType, &r.closingTxid),
+	)
+}
+
+// serializeScheduledClose serializes a ScheduledClose based on tlv format.
+func serializeScheduledClose(c *ScheduledClose) ([]byte, error) {
+	r := &closeRecord{
+		deadlineHeight: c.DeadlineHeight,
+		targetFeeRate:  uint64(c.TargetFeeRate),
+		confTarget:     c.ConfTarget,
+		budget:         uint64(c.Budget),
+		deliveryScript: c.DeliveryScript,
+		state:          uint8(c.State),
+		feeRate:        uint64(c.FeeRate),
+		closingTxid:    c.ClosingTxid,
+	}
+
+	tlvStream, err := r.toTlvStream()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tlvStream.Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// deserializeScheduledClose deserializes the ScheduledClose of the given
+// channel based on tlv format.
+func deserializeScheduledClose(chanPoint wire.OutPoint,
+	b []byte) (*ScheduledClose, error) {
+
+	var r closeRecord
+	tlvStream, err := r.toTlvStream()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Decode(bytes.NewReader(b)); err != nil {
+		return nil, err
+	}
+
+	// An empty delivery script is decoded as an empty slice, which we
+	// normalize to the nil script it was encoded from.
+	if len(r.deliveryScript) == 0 {
+		r.deliveryScript = nil
+	}
+
+	return &ScheduledClose{
+		ChanPoint:      chanPoint,
+		DeadlineHeight: r.deadlineHeight,
+		TargetFeeRate:  chainfee.SatPerKWeight(r.targetFeeRate),
+		ConfTarget:     r.confTarget,
+		Budget:         btcutil.Amount(r.budget),
+		DeliveryScript: r.deliveryScript,
+		State:          State(r.state),
+		FeeRate:        chainfee.SatPerKWeight(r.feeRate),
+		ClosingTxid:    r.closingTxid,
+	}, nil
+}
+
+// chanPointKey returns the key the scheduled close of a channel is stored
+// under.
+func chanPointKey(chanPoint wire.OutPoint) ([]byte, error) {
+	var b bytes.Buffer
+	if err := graphdb.WriteOutpoint(&b, &chanPoint); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// PutScheduledClose adds or replaces the scheduled close of a channel.
+func (s *kvStore) PutScheduledClose(c *ScheduledClose) error {
+	key, err := chanPointKey(c.ChanPoint)
+	if err != nil {
+		return err
+	}
+
+	value, err := serializeScheduledClose(c)
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		return tx.ReadWriteBucket(scheduledClosesBucket).Put(key, value)
+	}, func() {})
+}
+
+// FetchScheduledClose returns the scheduled close of the given channel, or
+// ErrNotScheduled if it has none.
+func (s *kvStore) FetchScheduledClose(
+	chanPoint wire.OutPoint) (*ScheduledClose, error) {
+
+	key, err := chanPointKey(chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var c *ScheduledClose
+	err = kvdb.View(s.db, func(tx kvdb.RTx) error {
+		value := tx.ReadBucket(scheduledClosesBucket).Get(key)
+		if value == nil {
+			return ErrNotScheduled
+		}
+
+		c, err = deserializeScheduledClose(chanPoint, value)
+
+		return err
+	}, func() {
+		c = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// FetchScheduledCloses returns all scheduled closes.
+func (s *kvStore) FetchScheduledCloses() ([]*ScheduledClose, error) {
+	var closes []*ScheduledClose
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(scheduledClosesBucket)
+
+		return bucket.ForEach(func(k, v []byte) error {
+			var chanPoint wire.OutPoint
+			err := graphdb.ReadOutpoint(
+				bytes.NewReader(k), &chanPoint,
+			)
+			if err != nil {
+				return err
+			}
+
+			c, err := deserializeScheduledClose(chanPoint, v)
+			if err != nil {
+				return err
+			}
+
+			closes = append(closes, c)
+
+			return nil
+		})
+	}, func() {
+		closes = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return closes, nil
+}
+
+// DeleteScheduledClose deletes the scheduled close of the given channel.
+// Deleting the scheduled close of a channel without one is a no-op.
+func (s *kvStore) DeleteScheduledClose(chanPoint wire.OutPoint) error {
+	key, err := chanPointKey(chanPoint)
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		return tx.ReadWriteBucket(scheduledClosesBucket).Delete(key)
+	}, func() {})
+}
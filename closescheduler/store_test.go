@@ -0,0 +1,68 @@
+package closescheduler
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStore tests storing, replacing and deleting scheduled closes, and that
+// they survive recreating the store.
+func TestStore(t *testing.T) {
+	cdb, err := channeldb.MakeTestDB(t)
+	require.NoError(t, err)
+
+	store, err := NewStore(cdb)
+	require.NoError(t, err)
+
+	chanPoint1 := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1}
+	chanPoint2 := wire.OutPoint{Hash: chainhash.Hash{2}, Index: 2}
+
+	// A channel without a scheduled close isn't found.
+	_, err = store.FetchScheduledClose(chanPoint1)
+	require.ErrorIs(t, err, ErrNotScheduled)
+
+	close1 := &ScheduledClose{
+		ChanPoint:      chanPoint1,
+		DeadlineHeight: 100,
+		ConfTarget:     6,
+		DeliveryScript: []byte{0, 20, 1, 2, 3},
+	}
+	close2 := &ScheduledClose{
+		ChanPoint:     chanPoint2,
+		TargetFeeRate: 1000,
+		ConfTarget:    3,
+		Budget:        5000,
+	}
+	require.NoError(t, store.PutScheduledClose(close1))
+	require.NoError(t, store.PutScheduledClose(close2))
+
+	// A stored close is replaced by the next one.
+	close2.State = StateClosing
+	close2.FeeRate = 2000
+	close2.ClosingTxid = chainhash.Hash{3}
+	require.NoError(t, store.PutScheduledClose(close2))
+
+	// The closes survive recreating the store.
+	store, err = NewStore(cdb)
+	require.NoError(t, err)
+
+	stored, err := store.FetchScheduledClose(chanPoint2)
+	require.NoError(t, err)
+	require.Equal(t, close2, stored)
+
+	closes, err := store.FetchScheduledCloses()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*ScheduledClose{close1, close2}, closes)
+
+	// Deleting a close leaves the others untouched.
+	require.NoError(t, store.DeleteScheduledClose(chanPoint1))
+	require.NoError(t, store.DeleteScheduledClose(chanPoint1))
+
+	closes, err = store.FetchScheduledCloses()
+	require.NoError(t, err)
+	require.Equal(t, []*ScheduledClose{close2}, closes)
+}
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var bumpFundingFeeCommand = cli.Command{
+	Name:      "bumpfundingfee",
+	Category:  "Channels",
+	Usage:     "Bump the fee of a pending dual-funded channel.",
+	ArgsUsage: "chan_point sat_per_vbyte",
+	Description: `
+	Replace the funding transaction of a pending dual-funded channel with
+	one paying a higher fee rate. The replacement is negotiated with the
+	remote peer and both parties contribute the same amounts as in the
+	original funding transaction. Each funding candidate is tracked as a
+	pending channel until one of them confirms, at which point the others
+	are abandoned.
+
+	The command returns the channel point of the replacement funding
+	transaction.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "the channel point of the pending channel in " +
+				"the form funding_txid:output_index",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "the new fee rate in sat/vbyte for the funding " +
+				"transaction",
+		},
+	},
+	Action: actionDecorator(bumpFundingFee),
+}
+
+func bumpFundingFee(ctx *cli.Context) error {
+	ctxc := getContext()
+	args := ctx.Args()
+
+	var chanPointStr string
+	switch {
+	case ctx.IsSet("chan_point"):
+		chanPointStr = ctx.String("chan_point")
+
+	case args.Present():
+		chanPointStr = args.First()
+		args = args.Tail()
+
+	default:
+		return errors.New("chan_point argument missing")
+	}
+
+	chanPoint, err := parseChanPoint(chanPointStr)
+	if err != nil {
+		return fmt.Errorf("unable to parse chan_point: %w", err)
+	}
+
+	var feeRate uint64
+	switch {
+	case ctx.IsSet("sat_per_vbyte"):
+		feeRate = ctx.Uint64("sat_per_vbyte")
+
+	case args.Present():
+		feeRate, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode fee rate: %w", err)
+		}
+
+	default:
+		return errors.New("sat_per_vbyte argument missing")
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := lnrpc.NewLightningClient(conn)
+	resp, err := client.BumpFundingFee(ctxc, &lnrpc.BumpFundingFeeRequest{
+		ChanPoint:   chanPoint,
+		SatPerVbyte: feeRate,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
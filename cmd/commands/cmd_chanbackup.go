@@ -0,0 +1,236 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/v2/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg/v2"
+	"github.com/lightningnetwork/lnd/aezeed"
+	"github.com/lightningnetwork/lnd/chanbackup"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/urfave/cli"
+)
+
+var inspectChanBackupCommand = cli.Command{
+	Name:     "inspectchanbackup",
+	Category: "Channels",
+	Usage: "Decrypt and verify a channel backup offline using the " +
+		"wallet seed.",
+	ArgsUsage: "[--single_backup] [--multi_backup] [--single_file] " +
+		"[--multi_file]",
+	Description: `
+	Decrypt a Single or Multi channel backup with the keys derived from the
+	24-word aezeed cipher seed of the node that created it, list the
+	channels it contains and verify each of them against the seed. This
+	command does NOT require a running lnd instance, which allows
+	inspecting a backup before (or instead of) restoring it.
+
+	The backup is accepted in the same forms as the verifychanbackup
+	command. The cipher seed mnemonic and its optional passphrase are read
+	from the terminal. The global --network flag determines the chain the
+	keys are derived for.
+
+	A backup created with another seed can't be decrypted. For each
+	channel, the verification then checks that all local channel keys can
+	be derived from the seed and that the remote party's signature of the
+	latest commitment transaction matches the funding output derived from
+	the seed. As the funding output is the only data that ties the local
+	keys to the seed, channels are only verified if the backup contains
+	the commitment transaction of a non-taproot channel.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "single_backup",
+			Usage: "a hex encoded single channel backup obtained " +
+				"from exportchanbackup",
+		},
+		cli.StringFlag{
+			Name: "multi_backup",
+			Usage: "a hex encoded multi-channel backup obtained " +
+				"from exportchanbackup",
+		},
+		cli.StringFlag{
+			Name:      "single_file",
+			Usage:     "the path to a single-channel backup file",
+			TakesFile: true,
+		},
+		cli.StringFlag{
+			Name:      "multi_file",
+			Usage:     "the path to a multi-channel back up file",
+			TakesFile: true,
+		},
+	},
+	Action: actionDecorator(inspectChanBackup),
+}
+
+// inspectedChannel is the JSON representation of a single channel backup
+// that was decrypted and verified by the inspectchanbackup command.
+type inspectedChannel struct {
+	ChannelPoint  string   `json:"channel_point"`
+	ChanID        uint64   `json:"chan_id"`
+	Version       string   `json:"version"`
+	Initiator     bool     `json:"initiator"`
+	RemotePubkey  string   `json:"remote_pubkey"`
+	Capacity      int64    `json:"capacity"`
+	Addresses     []string `json:"addresses"`
+	HasCloseTx    bool     `json:"has_close_tx"`
+	Verified      bool     `json:"verified"`
+	VerifyFailure string   `json:"verify_failure,omitempty"`
+}
+
+// inspectChanBackupResponse is the JSON output of the inspectchanbackup
+// command.
+type inspectChanBackupResponse struct {
+	NumChannels int                 `json:"num_channels"`
+	NumVerified int                 `json:"num_verified"`
+	Channels    []*inspectedChannel `json:"channels"`
+}
+
+func inspectChanBackup(ctx *cli.Context) error {
+	// Show command help if no arguments provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		return cli.ShowCommandHelp(ctx, "inspectchanbackup")
+	}
+
+	backups, err := parseChanBackups(ctx)
+	if err != nil {
+		return err
+	}
+
+	params, err := networkParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	keyRing, err := readSeedKeyRing(params)
+	if err != nil {
+		return err
+	}
+
+	var singles []chanbackup.Single
+	switch {
+	case backups.GetChanBackups() != nil:
+		var packedSingles chanbackup.PackedSingles
+		for _, b := range backups.GetChanBackups().ChanBackups {
+			packedSingles = append(packedSingles, b.ChanBackup)
+		}
+
+		singles, err = packedSingles.Unpack(keyRing)
+		if err != nil {
+			return fmt.Errorf("unable to decrypt single channel "+
+				"backup, wrong seed?: %w", err)
+		}
+
+	default:
+		packedMulti := chanbackup.PackedMulti(
+			backups.GetMultiChanBackup(),
+		)
+		multi, err := packedMulti.Unpack(keyRing)
+		if err != nil {
+			return fmt.Errorf("unable to decrypt multi channel "+
+				"backup, wrong seed?: %w", err)
+		}
+
+		singles = multi.StaticBackups
+	}
+
+	resp := &inspectChanBackupResponse{
+		NumChannels: len(singles),
+		Channels:    make([]*inspectedChannel, 0, len(singles)),
+	}
+	for _, single := range singles {
+		addrs := single.AllAddresses()
+		channel := &inspectedChannel{
+			ChannelPoint: single.FundingOutpoint.String(),
+			ChanID:       single.ShortChannelID.ToUint64(),
+			Version:      single.Version.String(),
+			Initiator:    single.IsInitiator,
+			RemotePubkey: fmt.Sprintf(
+				"%x", single.RemoteNodePub.SerializeCompressed(),
+			),
+			Capacity:   int64(single.Capacity),
+			Addresses:  make([]string, 0, len(addrs)),
+			HasCloseTx: single.CloseTxInputs.IsSome(),
+			Verified:   true,
+		}
+		for _, addr := range addrs {
+			channel.Addresses = append(
+				channel.Addresses, addr.String(),
+			)
+		}
+
+		err := chanbackup.VerifySingle(single, keyRing)
+		if err != nil {
+			channel.Verified = false
+			channel.VerifyFailure = err.Error()
+		} else {
+			resp.NumVerified++
+		}
+
+		resp.Channels = append(resp.Channels, channel)
+	}
+
+	printJSON(resp)
+
+	return nil
+}
+
+// readSeedKeyRing prompts the user for their aezeed cipher seed mnemonic and
+// passphrase and returns a key ring that derives the node's keys from it.
+func readSeedKeyRing(params *chaincfg.Params) (keychain.KeyRing, error) {
+	// The prompts are written to stderr, so they don't end up in the JSON
+	// output of the command.
+	fmt.Fprint(os.Stderr, "Input your 24-word mnemonic separated by "+
+		"spaces: ")
+	reader := bufio.NewReader(os.Stdin)
+	mnemonicStr, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll trim off extra spaces, and ensure the mnemonic is all lower
+	// case.
+	mnemonicStr = strings.TrimSpace(mnemonicStr)
+	mnemonicStr = strings.ToLower(mnemonicStr)
+
+	cipherSeedMnemonic := strings.Fields(mnemonicStr)
+
+	fmt.Fprintln(os.Stderr)
+
+	if len(cipherSeedMnemonic) != aezeed.NumMnemonicWords {
+		return nil, fmt.Errorf("wrong cipher seed mnemonic length: "+
+			"got %v words, expecting %v words",
+			len(cipherSeedMnemonic), aezeed.NumMnemonicWords)
+	}
+
+	passphrase, err := promptPassword(
+		os.Stderr, "Input your cipher seed passphrase (press enter "+
+			"if your seed doesn't have a passphrase): ",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var mnemonic aezeed.Mnemonic
+	copy(mnemonic[:], cipherSeedMnemonic)
+
+	cipherSeed, err := mnemonic.ToCipherSeed(passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decipher seed: %w", err)
+	}
+
+	rootKey, err := hdkeychain.NewMaster(cipherSeed.Entropy[:], params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive master key: %w", err)
+	}
+
+	coinType := uint32(keychain.CoinTypeTestnet)
+	if params.Name == chaincfg.MainNetParams.Name {
+		coinType = keychain.CoinTypeBitcoin
+	}
+
+	return keychain.NewHDKeyRing(rootKey, coinType)
+}
@@ -124,6 +124,18 @@ var AddInvoiceCommand = cli.Command{
 				"id (separated by commas), starting from a " +
 				"channel which points to the self node.",
 		},
+		cli.BoolFlag{
+			Name: "blinded_path_diverse_intro_nodes",
+			Usage: "Use distinct introduction nodes for the " +
+				"blinded paths where possible.",
+		},
+		cli.BoolFlag{
+			Name: "blinded_path_refresh",
+			Usage: "Reissue the invoice with new blinded " +
+				"paths if a channel of its paths is closed " +
+				"while the invoice is open. The refresh " +
+				"doesn't persist across restarts.",
+		},
 	},
 	Action: actionDecorator(addInvoice),
 }
@@ -211,7 +223,9 @@ func parseBlindedPathCfg(ctx *cli.Context) (*lnrpc.BlindedPathConfig, error) {
 			ctx.IsSet("num_blinded_hops") ||
 			ctx.IsSet("max_blinded_paths") ||
 			ctx.IsSet("blinded_path_omit_node") ||
-			ctx.IsSet("blinded_path_incoming_channel_list") {
+			ctx.IsSet("blinded_path_incoming_channel_list") ||
+			ctx.IsSet("blinded_path_diverse_intro_nodes") ||
+			ctx.IsSet("blinded_path_refresh") {
 
 			return nil, fmt.Errorf("blinded path options are " +
 				"only used if the `--blind` options is set")
@@ -220,7 +234,12 @@ func parseBlindedPathCfg(ctx *cli.Context) (*lnrpc.BlindedPathConfig, error) {
 		return nil, nil
 	}
 
-	var blindCfg lnrpc.BlindedPathConfig
+	blindCfg := lnrpc.BlindedPathConfig{
+		DiverseIntroNodes: ctx.Bool(
+			"blinded_path_diverse_intro_nodes",
+		),
+		RefreshOnChannelClose: ctx.Bool("blinded_path_refresh"),
+	}
 
 	if ctx.IsSet("min_real_blinded_hops") {
 		minNumRealHops := uint32(ctx.Uint("min_real_blinded_hops"))
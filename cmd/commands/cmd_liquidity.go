@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var buyLiquidityCommand = cli.Command{
+	Name:      "buyliquidity",
+	Category:  "Channels",
+	Usage:     "Buy inbound liquidity from a node that advertises it.",
+	ArgsUsage: "node_key amt",
+	Description: `
+	Lease inbound liquidity from a node that advertises lease rates in its
+	node announcement. A dual-funded script enforced lease channel is opened
+	to the seller, which contributes the requested amount to the funding
+	output. The lease fee is paid to the seller out of our own contribution
+	to the channel, so local_amt must exceed the lease fee. The seller's
+	funds are locked in the channel until the lease expires. The command
+	returns once the channel is pending.
+
+	The nodes that advertise lease rates can be listed with the
+	listliquidityads command.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "node_key",
+			Usage: "the identity public key of the node to buy " +
+				"the liquidity from",
+		},
+		cli.Int64Flag{
+			Name: "amt",
+			Usage: "the amount of inbound liquidity to lease in " +
+				"satoshis",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "the fee rate in sat/vbyte to use for the " +
+				"funding transaction",
+		},
+		cli.Int64Flag{
+			Name: "max_fee",
+			Usage: "the maximum fee in satoshis to pay for the " +
+				"lease",
+		},
+		cli.Int64Flag{
+			Name: "local_amt",
+			Usage: "the number of satoshis we contribute to the " +
+				"channel, out of which the lease fee is paid",
+		},
+		cli.BoolFlag{
+			Name: "private",
+			Usage: "make the channel private, such that it won't " +
+				"be announced to the greater network",
+		},
+	},
+	Action: actionDecorator(buyLiquidity),
+}
+
+func buyLiquidity(ctx *cli.Context) error {
+	ctxc := getContext()
+	args := ctx.Args()
+
+	var nodeKey string
+	switch {
+	case ctx.IsSet("node_key"):
+		nodeKey = ctx.String("node_key")
+
+	case args.Present():
+		nodeKey = args.First()
+		args = args.Tail()
+
+	default:
+		return errors.New("node_key argument missing")
+	}
+
+	node, err := hex.DecodeString(nodeKey)
+	if err != nil {
+		return fmt.Errorf("invalid node key: %w", err)
+	}
+
+	var amt int64
+	switch {
+	case ctx.IsSet("amt"):
+		amt = ctx.Int64("amt")
+
+	case args.Present():
+		amt, err = strconv.ParseInt(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode amount: %w", err)
+		}
+
+	default:
+		return errors.New("amt argument missing")
+	}
+
+	if !ctx.IsSet("sat_per_vbyte") {
+		return errors.New("sat_per_vbyte argument missing")
+	}
+	if !ctx.IsSet("max_fee") {
+		return errors.New("max_fee argument missing")
+	}
+	if !ctx.IsSet("local_amt") {
+		return errors.New("local_amt argument missing")
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := lnrpc.NewLightningClient(conn)
+	resp, err := client.BuyLiquidity(ctxc, &lnrpc.BuyLiquidityRequest{
+		NodePubkey:         node,
+		AmtSat:             amt,
+		SatPerVbyte:        ctx.Uint64("sat_per_vbyte"),
+		MaxFeeSat:          ctx.Int64("max_fee"),
+		LocalFundingAmount: ctx.Int64("local_amt"),
+		Private:            ctx.Bool("private"),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var listLiquidityAdsCommand = cli.Command{
+	Name:     "listliquidityads",
+	Category: "Channels",
+	Usage:    "List the nodes that advertise inbound liquidity.",
+	Description: `
+	List the nodes in the graph that advertise lease rates for inbound
+	liquidity in their node announcement.
+	`,
+	Action: actionDecorator(listLiquidityAds),
+}
+
+func listLiquidityAds(ctx *cli.Context) error {
+	ctxc := getContext()
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := lnrpc.NewLightningClient(conn)
+	resp, err := client.ListLiquidityAds(
+		ctxc, &lnrpc.ListLiquidityAdsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -65,8 +65,8 @@ var setCfgCommand = cli.Command{
 		cli.StringFlag{
 			Name: "estimator",
 			Usage: "the probability estimator to use, choose " +
-				"between 'apriori' or 'bimodal' (bimodal is " +
-				"experimental)",
+				"between 'apriori', 'bimodal' or 'external' " +
+				"(bimodal and external are experimental)",
 		},
 		// Apriori config.
 		cli.DurationFlag{
@@ -111,6 +111,20 @@ var setCfgCommand = cli.Command{
 				"estimator takes into account other channels " +
 				"of a router",
 		},
+		// External config.
+		cli.DurationFlag{
+			Name: "externalcachettl",
+			Usage: "the duration for which an estimate of the " +
+				"external client is reused for identical " +
+				"queries, zero disables the cache",
+		},
+		cli.DurationFlag{
+			Name: "externalquerytimeout",
+			Usage: "the maximum time to wait for the external " +
+				"client to answer a query before the " +
+				"fallback estimator is used; the fallback " +
+				"is configured with the bimodal flags",
+		},
 	},
 	Action: actionDecorator(setCfg),
 }
@@ -250,6 +264,80 @@ func setCfg(ctx *cli.Context) error {
 				)
 			}
 
+		case routing.ExternalEstimatorName:
+			haveValue = true
+
+			// If we switch from another estimator, initialize with
+			// default values.
+			if mcCfg.Config.Model !=
+				routerrpc.MissionControlConfig_EXTERNAL {
+
+				dCfg := routing.DefaultExternalConfig()
+				fCfg := dCfg.FallbackConfig
+				timeout := dCfg.QueryTimeout
+				eParams := &routerrpc.ExternalParameters{
+					CacheTtlMs: uint64(
+						dCfg.CacheTTL.Milliseconds(),
+					),
+					QueryTimeoutMs: uint64(
+						timeout.Milliseconds(),
+					),
+					Fallback: &routerrpc.BimodalParameters{
+						DecayTime: uint64(
+							fCfg.BimodalDecayTime.
+								Seconds(),
+						),
+						ScaleMsat: uint64(
+							fCfg.BimodalScaleMsat,
+						),
+						NodeWeight: fCfg.
+							BimodalNodeWeight,
+					},
+				}
+
+				// We make sure the correct config is set.
+				//nolint:ll
+				mcCfg.Config.EstimatorConfig =
+					&routerrpc.MissionControlConfig_External{
+						External: eParams,
+					}
+			}
+
+			// We update all values for the external estimator.
+			mcCfg.Config.Model = routerrpc.
+				MissionControlConfig_EXTERNAL
+
+			eCfg := mcCfg.Config.GetExternal()
+			if ctx.IsSet("externalcachettl") {
+				eCfg.CacheTtlMs = uint64(ctx.Duration(
+					"externalcachettl",
+				).Milliseconds())
+			}
+
+			if ctx.IsSet("externalquerytimeout") {
+				eCfg.QueryTimeoutMs = uint64(ctx.Duration(
+					"externalquerytimeout",
+				).Milliseconds())
+			}
+
+			// The bimodal flags configure the fallback estimator.
+			fCfg := eCfg.Fallback
+			if ctx.IsSet("bimodaldecaytime") {
+				fCfg.DecayTime = uint64(ctx.Duration(
+					"bimodaldecaytime",
+				).Seconds())
+			}
+
+			if ctx.IsSet("bimodalscale") {
+				fCfg.ScaleMsat = ctx.Uint64("bimodalscale")
+			}
+
+			if ctx.IsSet("bimodalweight") {
+				fCfg.NodeWeight = ctx.Float64(
+					"bimodalweight",
+				)
+			}
+
 		default:
 			return fmt.Errorf("unknown estimator %v",
 				ctx.String("estimator"))
@@ -75,6 +75,10 @@ Signed base64 encoded PSBT or hex encoded raw wire TX (or path to file): `
 	// "taproot" that resolves to the same production taproot channel type.
 	// Retained so existing scripts continue to work.
 	channelTypeSimpleTaprootFinalAlias = "taproot-final"
+
+	// channelTypeZeroFeeCommitments selects the zero-fee v3 commitment
+	// channel type with a single shared pay-to-anchor output.
+	channelTypeZeroFeeCommitments = "zero-fee-commitments"
 )
 
 // TODO(roasbeef): change default number of confirmations.
@@ -267,11 +271,13 @@ var openChannelCommand = cli.Command{
 		cli.StringFlag{
 			Name: "channel_type",
 			Usage: fmt.Sprintf("(optional) the type of channel to "+
-				"propose to the remote peer (%q, %q, %q, %q). "+
-				"%q is accepted as a deprecated alias for %q",
+				"propose to the remote peer (%q, %q, %q, %q, "+
+				"%q). %q is accepted as a deprecated alias "+
+				"for %q",
 				channelTypeTweakless, channelTypeAnchors,
 				channelTypeSimpleTaproot,
 				channelTypeSimpleTaprootStaging,
+				channelTypeZeroFeeCommitments,
 				channelTypeSimpleTaprootFinalAlias,
 				channelTypeSimpleTaproot),
 		},
@@ -285,6 +291,13 @@ var openChannelCommand = cli.Command{
 			Usage: "(optional) whether a scid-alias channel type" +
 				" should be negotiated.",
 		},
+		cli.BoolFlag{
+			Name: "dual_fund",
+			Usage: "(optional) whether the channel should be " +
+				"opened with the dual funding protocol, " +
+				"allowing the remote peer to contribute " +
+				"funds to the channel.",
+		},
 		cli.Uint64Flag{
 			Name: "remote_reserve_sats",
 			Usage: "(optional) the minimum number of satoshis we " +
@@ -341,6 +354,7 @@ func openChannel(ctx *cli.Context) error {
 		MaxLocalCsv:                uint32(ctx.Uint64("max_local_csv")),
 		ZeroConf:                   ctx.Bool("zero_conf"),
 		ScidAlias:                  ctx.Bool("scid_alias"),
+		DualFund:                   ctx.Bool("dual_fund"),
 		RemoteChanReserveSat:       ctx.Uint64("remote_reserve_sats"),
 		FundMax:                    ctx.Bool("fundmax"),
 		Memo:                       ctx.String("memo"),
@@ -469,6 +483,8 @@ func openChannel(ctx *cli.Context) error {
 		req.CommitmentType = lnrpc.CommitmentType_SIMPLE_TAPROOT_FINAL
 	case channelTypeSimpleTaprootStaging:
 		req.CommitmentType = lnrpc.CommitmentType_SIMPLE_TAPROOT
+	case channelTypeZeroFeeCommitments:
+		req.CommitmentType = lnrpc.CommitmentType_ZERO_FEE_COMMITMENTS
 	default:
 		return fmt.Errorf("unsupported channel type %v", channelType)
 	}
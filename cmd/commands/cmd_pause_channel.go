@@ -0,0 +1,131 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var pauseChannelCommand = cli.Command{
+	Name:      "pausechannel",
+	Category:  "Channels",
+	Usage:     "Pause a channel until its HTLCs are resolved.",
+	ArgsUsage: "chan_point",
+	Description: `
+	Stop a channel from accepting new HTLCs in either direction and wait
+	for its in-flight HTLCs to be resolved. The HTLCs the remote peer adds
+	are failed back. The command returns once the channel has no HTLCs. If
+	the in-flight HTLCs aren't resolved within the timeout, the channel
+	accepts HTLCs again and an error is returned.
+
+	The pause is local to our node, so the channel can be resumed with
+	resumechannel without disconnecting the peer. It stays paused until it
+	is resumed, also across reconnections and restarts.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "the channel point of the channel to pause in " +
+				"the form funding_txid:output_index",
+		},
+		cli.DurationFlag{
+			Name: "timeout",
+			Usage: "the maximum time to wait for the HTLCs of " +
+				"the channel to be resolved; defaults to 60s " +
+				"if not set",
+		},
+	},
+	Action: actionDecorator(pauseChannel),
+}
+
+func pauseChannel(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	chanPoint, err := parseChanPointArg(ctx)
+	if err != nil {
+		return err
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := lnrpc.NewLightningClient(conn)
+	resp, err := client.PauseChannel(ctxc, &lnrpc.PauseChannelRequest{
+		ChanPoint:      chanPoint,
+		TimeoutSeconds: uint32(ctx.Duration("timeout").Seconds()),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var resumeChannelCommand = cli.Command{
+	Name:      "resumechannel",
+	Category:  "Channels",
+	Usage:     "Resume a channel that was paused with pausechannel.",
+	ArgsUsage: "chan_point",
+	Description: `
+	Allow HTLCs on a channel that was paused with pausechannel again.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "the channel point of the channel to resume in " +
+				"the form funding_txid:output_index",
+		},
+	},
+	Action: actionDecorator(resumeChannel),
+}
+
+func resumeChannel(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	chanPoint, err := parseChanPointArg(ctx)
+	if err != nil {
+		return err
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := lnrpc.NewLightningClient(conn)
+	resp, err := client.ResumeChannel(ctxc, &lnrpc.ResumeChannelRequest{
+		ChanPoint: chanPoint,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// parseChanPointArg parses the channel point either from the chan_point flag
+// or from the first positional argument.
+func parseChanPointArg(ctx *cli.Context) (*lnrpc.ChannelPoint, error) {
+	var chanPointStr string
+	switch {
+	case ctx.IsSet("chan_point"):
+		chanPointStr = ctx.String("chan_point")
+
+	case ctx.Args().Present():
+		chanPointStr = ctx.Args().First()
+
+	default:
+		return nil, errors.New("chan_point argument missing")
+	}
+
+	chanPoint, err := parseChanPoint(chanPointStr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse chan_point: %w", err)
+	}
+
+	return chanPoint, nil
+}
@@ -264,6 +264,13 @@ var SendPaymentCommand = cli.Command{
 				`"fee_proportional_millionths":3,` +
 				`"cltv_expiry_delta":4}]}]'`,
 		},
+		cli.BoolFlag{
+			Name: "request_receipt",
+			Usage: "(keysend and amp only) request a signed " +
+				"receipt from the receiver, which is " +
+				"returned over an onion message and " +
+				"stored alongside the payment",
+		},
 	),
 	Action: SendPayment,
 }
@@ -418,6 +425,7 @@ func SendPayment(ctx *cli.Context) error {
 		DestCustomRecords: make(map[uint64][]byte),
 		Amp:               ctx.Bool(ampFlag.Name),
 		Cancelable:        ctx.Bool(cancelableFlag.Name),
+		RequestReceipt:    ctx.Bool("request_receipt"),
 	}
 
 	var rHash []byte
@@ -709,6 +717,53 @@ func trackPayment(ctx *cli.Context) error {
 	return err
 }
 
+var cancelPaymentCommand = cli.Command{
+	Name:     "cancelpayment",
+	Category: "Payments",
+	Usage:    "Stop an in-flight payment from sending new HTLCs.",
+	Description: `
+	Stop the in-flight payment specified by the hash argument from sending
+	new HTLC attempts. HTLCs that are already in flight are resolved as
+	usual. Once they are resolved, the payment fails with the canceled
+	reason, unless one of them settles. Use trackpayment to follow the
+	resolution of the payment.
+	`,
+	ArgsUsage: "hash",
+	Action:    actionDecorator(cancelPayment),
+}
+
+func cancelPayment(ctx *cli.Context) error {
+	ctxc := getContext()
+	args := ctx.Args()
+
+	if !args.Present() {
+		return fmt.Errorf("hash argument missing")
+	}
+
+	hash, err := hex.DecodeString(args.First())
+	if err != nil {
+		return err
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	routerClient := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.CancelPaymentRequest{
+		PaymentHash: hash,
+	}
+
+	resp, err := routerClient.CancelPayment(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 // PaymentResultStream is an interface that abstracts the Recv method of the
 // SendPaymentV2 or TrackPaymentV2 client stream.
 type PaymentResultStream interface {
@@ -954,6 +1009,124 @@ func payInvoice(ctx *cli.Context) error {
 	return SendPaymentRequest(ctx, req, conn, conn, routerRPCSendPayment)
 }
 
+var sendBatchCommand = cli.Command{
+	Name:     "sendbatch",
+	Category: "Payments",
+	Usage:    "Pay a batch of invoices over lightning.",
+	Description: `
+	Pay all given invoices as a batch. Up to --max_parallel payments are in
+	flight at the same time, and the routing fees of all payments are
+	limited by --fee_budget. The final state of every payment is printed,
+	followed by a summary of the batch.
+	`,
+	ArgsUsage: "pay_req [pay_req...]",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "fee_limit",
+			Usage: "maximum fee allowed in satoshis when " +
+				"sending a single payment",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit_percent",
+			Usage: "percentage of the amount of a payment used as " +
+				"the maximum fee allowed when sending it",
+		},
+		cli.Int64Flag{
+			Name: "fee_budget",
+			Usage: "maximum total fee in satoshis of all " +
+				"payments; payments that would exceed the " +
+				"budget are skipped",
+		},
+		cli.UintFlag{
+			Name: "max_parallel",
+			Usage: "the maximum number of payments in flight at " +
+				"the same time",
+			Value: routerrpc.DefaultBatchMaxParallel,
+		},
+		cli.DurationFlag{
+			Name: "timeout",
+			Usage: "the maximum amount of time we should spend " +
+				"trying to fulfill a single payment",
+			Value: paymentTimeout,
+		},
+		cancelableFlag,
+		inflightUpdatesFlag,
+	},
+	Action: actionDecorator(sendBatch),
+}
+
+func sendBatch(ctx *cli.Context) error {
+	ctxc := getContext()
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	if ctx.NArg() == 0 {
+		return cli.ShowCommandHelp(ctx, "sendbatch")
+	}
+
+	pmtTimeout := ctx.Duration("timeout")
+	if pmtTimeout <= 0 {
+		return errors.New("payment timeout must be greater than zero")
+	}
+
+	lnClient := lnrpc.NewLightningClient(conn)
+	routerClient := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.SendPaymentBatchRequest{
+		MaxParallel: uint32(ctx.Uint("max_parallel")),
+		FeeBudgetMsat: int64(lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(ctx.Int64("fee_budget")),
+		)),
+		NoInflightUpdates: !ctx.Bool(inflightUpdatesFlag.Name),
+	}
+	for _, payReq := range ctx.Args() {
+		payReq = StripPrefix(payReq)
+
+		// Decode the payment request to determine the fee limit from
+		// its amount.
+		decodeResp, err := lnClient.DecodePayReq(
+			ctxc, &lnrpc.PayReqString{PayReq: payReq},
+		)
+		if err != nil {
+			return err
+		}
+
+		feeLimit, err := retrieveFeeLimit(
+			ctx, decodeResp.GetNumSatoshis(),
+		)
+		if err != nil {
+			return err
+		}
+
+		payment := &routerrpc.SendPaymentRequest{
+			PaymentRequest: payReq,
+			FeeLimitSat:    feeLimit,
+			TimeoutSeconds: int32(pmtTimeout.Seconds()),
+			Cancelable:     ctx.Bool(cancelableFlag.Name),
+			MaxParts:       routerrpc.DefaultMaxParts,
+		}
+		req.Payments = append(req.Payments, payment)
+	}
+
+	stream, err := routerClient.SendPaymentBatch(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(update)
+
+		if update.GetSummary() != nil {
+			return nil
+		}
+	}
+}
+
 var sendToRouteCommand = cli.Command{
 	Name:     "sendtoroute",
 	Category: "Payments",
@@ -1594,6 +1767,11 @@ var forwardingHistoryCommand = cli.Command{
 				"channel to filter events by; can be " +
 				"specified multiple times in the same command",
 		},
+		cli.BoolFlag{
+			Name: "include_failed",
+			Usage: "also return failed forwards that earned " +
+				"upfront fees",
+		},
 	},
 	Action: actionDecorator(forwardingHistory),
 }
@@ -1673,6 +1851,7 @@ func forwardingHistory(ctx *cli.Context) error {
 		IndexOffset:     indexOffset,
 		NumMaxEvents:    maxEvents,
 		PeerAliasLookup: lookupPeerAlias,
+		IncludeFailed:   ctx.Bool("include_failed"),
 	}
 
 	outgoingChannelIDs := ctx.StringSlice("outgoing_chan_ids")
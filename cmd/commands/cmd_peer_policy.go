@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/urfave/cli"
+)
+
+var setPeerPolicyCommand = cli.Command{
+	Name:      "setpeerpolicy",
+	Category:  "Channels",
+	Usage:     "Limit the HTLCs a peer may have us forward.",
+	ArgsUsage: "peer",
+	Description: `
+	Override the limits on the HTLCs the given peer may have us forward
+	across all of its channels. HTLCs that would exceed the limits are failed
+	back to the peer. A limit of 0 disables it. Use --remove to apply the
+	configured default limits to the peer again.
+
+	Overrides are not persisted and are reset on restart.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "peer",
+			Usage: "the pubkey of the peer to set the policy for",
+		},
+		cli.Uint64Flag{
+			Name: "max_htlcs",
+			Usage: "the maximum number of HTLCs the peer may have " +
+				"in flight",
+		},
+		cli.Uint64Flag{
+			Name: "max_in_flight_msat",
+			Usage: "the maximum total value of the HTLCs the peer " +
+				"may have in flight",
+		},
+		cli.Uint64Flag{
+			Name:  "min_htlc_msat",
+			Usage: "the minimum amount of an HTLC of the peer",
+		},
+		cli.BoolFlag{
+			Name: "remove",
+			Usage: "remove the override of the peer, all other " +
+				"limits are ignored",
+		},
+	},
+	Action: actionDecorator(setPeerPolicy),
+}
+
+func setPeerPolicy(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	var peerStr string
+	switch {
+	case ctx.IsSet("peer"):
+		peerStr = ctx.String("peer")
+
+	case ctx.Args().Present():
+		peerStr = ctx.Args().First()
+
+	default:
+		return cli.ShowCommandHelp(ctx, "setpeerpolicy")
+	}
+
+	peer, err := hex.DecodeString(peerStr)
+	if err != nil {
+		return fmt.Errorf("unable to decode peer pubkey: %w", err)
+	}
+
+	maxHtlcs := ctx.Uint64("max_htlcs")
+	if maxHtlcs > uint64(^uint32(0)) {
+		return fmt.Errorf("max_htlcs exceeds %d", ^uint32(0))
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+	resp, err := client.SetPeerPolicy(ctxc, &routerrpc.SetPeerPolicyRequest{
+		Peer:            peer,
+		MaxHtlcs:        uint32(maxHtlcs),
+		MaxInFlightMsat: ctx.Uint64("max_in_flight_msat"),
+		MinHtlcMsat:     ctx.Uint64("min_htlc_msat"),
+		Remove:          ctx.Bool("remove"),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
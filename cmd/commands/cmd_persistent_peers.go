@@ -0,0 +1,198 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var listPersistentPeersCommand = cli.Command{
+	Name:     "listpersistentpeers",
+	Category: "Peers",
+	Usage: "List the peers we maintain a persistent connection with and " +
+		"their reconnection state.",
+	Description: `
+	List the peers we maintain a persistent connection with, together with
+	their connection policy, the backoff of their latest scheduled
+	reconnection and their failed connection attempts.
+	`,
+	Action: actionDecorator(listPersistentPeers),
+}
+
+func listPersistentPeers(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListPersistentPeers(
+		ctxc, &lnrpc.ListPersistentPeersRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var setPeerConnPolicyCommand = cli.Command{
+	Name:      "setpeerconnpolicy",
+	Category:  "Peers",
+	Usage:     "Set how we maintain the connection with a peer.",
+	ArgsUsage: "node_key",
+	Description: `
+	Set the connection policy of a peer. The priority determines the order
+	in which peers are reconnected to on startup and scales their
+	reconnection backoff. High priority peers may also use the inbound
+	slots reserved for channel peers.
+
+	Once a peer reaches its maximum number of consecutive failed connection
+	attempts, we stop reconnecting to it until reconnectpeer is called or
+	the peer connects to us. A maximum of 0 applies the configured default.
+
+	The address preference limits the connection attempts to the clearnet
+	or the onion addresses of the peer, unless it has none of that kind.
+
+	Policies are persisted. Use --remove to apply the defaults to the peer
+	again.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "node_key",
+			Usage: "the pubkey of the peer to set the policy for",
+		},
+		cli.StringFlag{
+			Name:  "priority",
+			Usage: "the priority of the peer: normal, low or high",
+			Value: "normal",
+		},
+		cli.Uint64Flag{
+			Name: "max_attempts",
+			Usage: "the number of consecutive failed connection " +
+				"attempts after which we stop reconnecting",
+		},
+		cli.StringFlag{
+			Name: "addr_preference",
+			Usage: "the addresses of the peer we connect to: " +
+				"any, clearnet or tor",
+			Value: "any",
+		},
+		cli.BoolFlag{
+			Name: "remove",
+			Usage: "remove the policy of the peer, all other " +
+				"flags are ignored",
+		},
+	},
+	Action: actionDecorator(setPeerConnPolicy),
+}
+
+func setPeerConnPolicy(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	var pubKey string
+	switch {
+	case ctx.IsSet("node_key"):
+		pubKey = ctx.String("node_key")
+
+	case ctx.Args().Present():
+		pubKey = ctx.Args().First()
+
+	default:
+		return cli.ShowCommandHelp(ctx, "setpeerconnpolicy")
+	}
+
+	priorityStr := strings.ToUpper(ctx.String("priority"))
+	priority, ok := lnrpc.PeerConnPolicy_Priority_value[priorityStr]
+	if !ok {
+		return fmt.Errorf("unknown priority %q", ctx.String("priority"))
+	}
+
+	addrPrefStr := strings.ToUpper(ctx.String("addr_preference"))
+	addrPref, ok := lnrpc.PeerConnPolicy_AddrPreference_value[addrPrefStr]
+	if !ok {
+		return fmt.Errorf("unknown address preference %q",
+			ctx.String("addr_preference"))
+	}
+
+	maxAttempts := ctx.Uint64("max_attempts")
+	if maxAttempts > uint64(^uint32(0)) {
+		return fmt.Errorf("max_attempts exceeds %d", ^uint32(0))
+	}
+
+	policy := &lnrpc.PeerConnPolicy{
+		Priority:       lnrpc.PeerConnPolicy_Priority(priority),
+		MaxAttempts:    uint32(maxAttempts),
+		AddrPreference: lnrpc.PeerConnPolicy_AddrPreference(addrPref),
+	}
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SetPeerConnPolicy(
+		ctxc, &lnrpc.SetPeerConnPolicyRequest{
+			PubKey: pubKey,
+			Policy: policy,
+			Remove: ctx.Bool("remove"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var reconnectPeerCommand = cli.Command{
+	Name:      "reconnectpeer",
+	Category:  "Peers",
+	Usage:     "Immediately reconnect to a persistent peer.",
+	ArgsUsage: "node_key",
+	Description: `
+	Immediately reconnect to a persistent peer we're not connected to,
+	resetting its backoff and its failed connection attempts. This also
+	resumes the connection attempts to a peer that reached its maximum
+	number of connection attempts.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "node_key",
+			Usage: "the pubkey of the peer to reconnect to",
+		},
+	},
+	Action: actionDecorator(reconnectPeer),
+}
+
+func reconnectPeer(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	var pubKey string
+	switch {
+	case ctx.IsSet("node_key"):
+		pubKey = ctx.String("node_key")
+
+	case ctx.Args().Present():
+		pubKey = ctx.Args().First()
+
+	default:
+		return cli.ShowCommandHelp(ctx, "reconnectpeer")
+	}
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ReconnectPeer(ctxc, &lnrpc.ReconnectPeerRequest{
+		PubKey: pubKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/urfave/cli"
+)
+
+var probeCommand = cli.Command{
+	Name:     "probe",
+	Category: "Mission Control",
+	Usage:    "Interact with the background prober.",
+	Subcommands: []cli.Command{
+		liquidityMapCommand,
+	},
+}
+
+var liquidityMapCommand = cli.Command{
+	Name:  "liquiditymap",
+	Usage: "Show the discovered liquidity of channel directions.",
+	Description: `
+	Show the liquidity bounds of the channel directions that were discovered
+	by payments and the background prober, along with the status of the
+	prober. The lower bound is the largest amount that was forwarded
+	successfully, the upper bound the smallest amount that failed.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "node",
+			Usage: "only show the channel directions from or to " +
+				"the node with this pubkey",
+		},
+	},
+	Action: actionDecorator(liquidityMap),
+}
+
+func liquidityMap(ctx *cli.Context) error {
+	ctxc := getContext()
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.GetLiquidityMapRequest{}
+	if ctx.IsSet("node") {
+		node, err := hex.DecodeString(ctx.String("node"))
+		if err != nil {
+			return fmt.Errorf("invalid node pubkey: %w", err)
+		}
+
+		req.Node = node
+	}
+
+	resp, err := client.GetLiquidityMap(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/urfave/cli"
+)
+
+var resourceStatsCommand = cli.Command{
+	Name:     "resourcestats",
+	Category: "Channels",
+	Usage: "Show the reputation of peers and the resource usage of " +
+		"channels.",
+	Description: `
+	Show the state of the HTLC resource manager. This includes the
+	reputation of the peers that forwarded HTLCs to us, the revenue and
+	resource bucket usage of our outgoing channels and the number of HTLCs
+	that were admitted into the general and protected buckets or rejected.
+	`,
+	Action: actionDecorator(resourceStats),
+}
+
+func resourceStats(ctx *cli.Context) error {
+	ctxc := getContext()
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+	resp, err := client.GetResourceStats(
+		ctxc, &routerrpc.GetResourceStatsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
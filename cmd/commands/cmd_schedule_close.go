@@ -0,0 +1,149 @@
+package commands
+
+import (
+	"errors"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var scheduleCloseCommand = cli.Command{
+	Name:      "scheduleclose",
+	Category:  "Channels",
+	Usage:     "Schedule the cooperative close of a channel.",
+	ArgsUsage: "chan_point",
+	Description: `
+	Schedule the cooperative close of a channel. The close is initiated
+	once the deadline height is reached or the estimated fee rate drops to
+	the target fee rate, whichever happens first. At least one of the two
+	must be set.
+
+	As long as the closing transaction stays unconfirmed, its fee is bumped
+	each block up to the given budget if the channel uses the RBF
+	cooperative close protocol. Without a budget, the closing transaction
+	is never bumped.
+
+	Scheduled closes survive restarts and are listed by pendingchannels
+	until the closing transaction confirmed.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "the channel point of the channel to close in " +
+				"the form funding_txid:output_index",
+		},
+		cli.Uint64Flag{
+			Name: "deadline_height",
+			Usage: "the block height at which the close is " +
+				"initiated regardless of the fee rate",
+		},
+		cli.Uint64Flag{
+			Name: "target_sat_per_vbyte",
+			Usage: "the fee rate in sat/vbyte at or below which " +
+				"the close is initiated",
+		},
+		cli.Uint64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the confirmation target used to " +
+				"estimate the fee rate of the closing " +
+				"transaction; default is 6 blocks",
+		},
+		cli.Uint64Flag{
+			Name: "budget",
+			Usage: "(optional) the maximum fee in satoshis that " +
+				"may be paid for the closing transaction when " +
+				"bumping it",
+		},
+		cli.StringFlag{
+			Name: "delivery_addr",
+			Usage: "(optional) an address to deliver funds " +
+				"upon cooperative channel closing, may only " +
+				"be used if an upfront shutdown address is not " +
+				"already set",
+		},
+	},
+	Action: actionDecorator(scheduleClose),
+}
+
+func scheduleClose(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	chanPoint, err := parseChanPointArg(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.IsSet("deadline_height") &&
+		!ctx.IsSet("target_sat_per_vbyte") {
+
+		return errors.New("either deadline_height or " +
+			"target_sat_per_vbyte must be set")
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := lnrpc.NewLightningClient(conn)
+	req := &lnrpc.ScheduleChannelCloseRequest{
+		ChannelPoint:      chanPoint,
+		DeadlineHeight:    uint32(ctx.Uint64("deadline_height")),
+		TargetSatPerVbyte: ctx.Uint64("target_sat_per_vbyte"),
+		TargetConf:        uint32(ctx.Uint64("conf_target")),
+		BudgetSat:         ctx.Uint64("budget"),
+		DeliveryAddress:   ctx.String("delivery_addr"),
+	}
+	resp, err := client.ScheduleChannelClose(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var cancelScheduledCloseCommand = cli.Command{
+	Name:      "cancelscheduledclose",
+	Category:  "Channels",
+	Usage:     "Cancel the scheduled close of a channel.",
+	ArgsUsage: "chan_point",
+	Description: `
+	Cancel the close of a channel that was scheduled with scheduleclose. A
+	scheduled close can only be canceled before the close of the channel
+	was initiated.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "the channel point of the channel in the form " +
+				"funding_txid:output_index",
+		},
+	},
+	Action: actionDecorator(cancelScheduledClose),
+}
+
+func cancelScheduledClose(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	chanPoint, err := parseChanPointArg(ctx)
+	if err != nil {
+		return err
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := lnrpc.NewLightningClient(conn)
+	resp, err := client.CancelScheduledClose(
+		ctxc, &lnrpc.CancelScheduledCloseRequest{
+			ChannelPoint: chanPoint,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/urfave/cli"
@@ -18,6 +19,9 @@ func invoicesCommands() []cli.Command {
 		cancelInvoiceCommand,
 		addHoldInvoiceCommand,
 		settleInvoiceCommand,
+		addSubscriptionCommand,
+		listSubscriptionsCommand,
+		cancelSubscriptionCommand,
 	}
 }
 
@@ -198,6 +202,24 @@ var addHoldInvoiceCommand = cli.Command{
 				"private channels in order to assist the " +
 				"payer in reaching you",
 		},
+		cli.DurationFlag{
+			Name: "max_hold_time",
+			Usage: "the maximum time the accepted htlcs of the " +
+				"invoice may be held before the invoice is " +
+				"canceled; 0 means no limit",
+		},
+		cli.Uint64Flag{
+			Name: "min_cltv_slack",
+			Usage: "the minimum number of blocks that need to be " +
+				"left until the accepted htlcs expire before " +
+				"the invoice is canceled; 0 means the node " +
+				"default is used",
+		},
+		cli.BoolFlag{
+			Name: "auto_settle",
+			Usage: "settle the invoice automatically as soon as " +
+				"its preimage becomes known to the node",
+		},
 	},
 	Action: actionDecorator(addHoldInvoice),
 }
@@ -251,6 +273,11 @@ func addHoldInvoice(ctx *cli.Context) error {
 		Expiry:          ctx.Int64("expiry"),
 		CltvExpiry:      ctx.Uint64("cltv_expiry_delta"),
 		Private:         ctx.Bool("private"),
+		MaxHoldSeconds: uint64(
+			ctx.Duration("max_hold_time").Seconds(),
+		),
+		MinCltvSlack: uint32(ctx.Uint64("min_cltv_slack")),
+		AutoSettle:   ctx.Bool("auto_settle"),
 	}
 
 	resp, err := client.AddHoldInvoice(ctxc, invoice)
@@ -262,3 +289,205 @@ func addHoldInvoice(ctx *cli.Context) error {
 
 	return nil
 }
+
+var addSubscriptionCommand = cli.Command{
+	Name:     "addsubscription",
+	Category: "Invoices",
+	Usage:    "Add a recurring subscription.",
+	Description: `
+	Add a subscription that creates an invoice for a fixed amount at the
+	start of every period. The payment state of every period can be
+	tracked with listsubscriptions.
+
+	If no start time is given, the first period starts immediately. If
+	no number of periods is given, the subscription runs until it is
+	canceled with cancelsubscription.`,
+	ArgsUsage: "amt interval",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "memo",
+			Usage: "a description of the subscription which is " +
+				"included in the memo of every invoice",
+		},
+		cli.Int64Flag{
+			Name:  "amt",
+			Usage: "the amt of satoshis invoiced per period",
+		},
+		cli.Int64Flag{
+			Name:  "amt_msat",
+			Usage: "the amt of millisatoshis invoiced per period",
+		},
+		cli.DurationFlag{
+			Name: "interval",
+			Usage: "the length of a period, e.g. 720h; must be " +
+				"at least 1m",
+		},
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "the unix timestamp in seconds of the start " +
+				"of the first period",
+		},
+		cli.Uint64Flag{
+			Name: "num_periods",
+			Usage: "the total number of periods; if not set, " +
+				"the subscription runs until it is canceled",
+		},
+		cli.DurationFlag{
+			Name: "invoice_expiry",
+			Usage: "the expiry of the invoice of every period; " +
+				"if not set, 24h capped to the interval is " +
+				"used",
+		},
+	},
+	Action: actionDecorator(addSubscription),
+}
+
+func addSubscription(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getInvoicesClient(ctx)
+	defer cleanUp()
+
+	var (
+		args     = ctx.Args()
+		amt      = ctx.Int64("amt")
+		amtMsat  = ctx.Int64("amt_msat")
+		interval = ctx.Duration("interval")
+		err      error
+	)
+
+	if !ctx.IsSet("amt") && !ctx.IsSet("amt_msat") && args.Present() {
+		amt, err = strconv.ParseInt(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode amt argument: %w",
+				err)
+		}
+		args = args.Tail()
+	}
+
+	if !ctx.IsSet("interval") && args.Present() {
+		interval, err = time.ParseDuration(args.First())
+		if err != nil {
+			return fmt.Errorf("unable to decode interval "+
+				"argument: %w", err)
+		}
+	}
+
+	if amt != 0 && amtMsat != 0 {
+		return fmt.Errorf("only one of amt and amt_msat can be set")
+	}
+	if amt < 0 || amtMsat < 0 {
+		return fmt.Errorf("amount must not be negative")
+	}
+	if amt != 0 {
+		amtMsat = amt * 1000
+	}
+	if amtMsat == 0 || interval == 0 {
+		return fmt.Errorf("amount and interval must be set")
+	}
+
+	numPeriods := ctx.Uint64("num_periods")
+	if numPeriods > uint64(^uint32(0)) {
+		return fmt.Errorf("num_periods too large: %d", numPeriods)
+	}
+
+	req := &invoicesrpc.AddSubscriptionRequest{
+		Memo:            ctx.String("memo"),
+		ValueMsat:       uint64(amtMsat),
+		IntervalSeconds: uint64(interval / time.Second),
+		StartTime:       ctx.Int64("start_time"),
+		NumPeriods:      uint32(numPeriods),
+		InvoiceExpirySeconds: uint64(
+			ctx.Duration("invoice_expiry") / time.Second,
+		),
+	}
+
+	resp, err := client.AddSubscription(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var listSubscriptionsCommand = cli.Command{
+	Name:     "listsubscriptions",
+	Category: "Invoices",
+	Usage: "List all recurring subscriptions and the payment state " +
+		"of their periods.",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "active_only",
+			Usage: "only list active subscriptions",
+		},
+	},
+	Action: actionDecorator(listSubscriptions),
+}
+
+func listSubscriptions(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getInvoicesClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListSubscriptions(
+		ctxc, &invoicesrpc.ListSubscriptionsRequest{
+			ActiveOnly: ctx.Bool("active_only"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var cancelSubscriptionCommand = cli.Command{
+	Name:     "cancelsubscription",
+	Category: "Invoices",
+	Usage:    "Cancel a recurring subscription.",
+	Description: `
+	Cancel a subscription so that no invoices are created for new periods.
+	Invoices of the subscription that are still open are canceled.`,
+	ArgsUsage: "id",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "id",
+			Usage: "the id of the subscription to cancel",
+		},
+	},
+	Action: actionDecorator(cancelSubscription),
+}
+
+func cancelSubscription(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getInvoicesClient(ctx)
+	defer cleanUp()
+
+	id := ctx.Uint64("id")
+	if !ctx.IsSet("id") {
+		if !ctx.Args().Present() {
+			return cli.ShowCommandHelp(ctx, "cancelsubscription")
+		}
+
+		var err error
+		id, err = strconv.ParseUint(ctx.Args().First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode id argument: %w",
+				err)
+		}
+	}
+
+	resp, err := client.CancelSubscription(
+		ctxc, &invoicesrpc.CancelSubscriptionRequest{Id: id},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
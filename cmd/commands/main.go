@@ -8,6 +8,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"path/filepath"
@@ -458,10 +459,20 @@ func Main() {
 		listUnspentCommand,
 		connectCommand,
 		disconnectCommand,
+		listPersistentPeersCommand,
+		setPeerConnPolicyCommand,
+		reconnectPeerCommand,
 		openChannelCommand,
 		batchOpenChannelCommand,
+		buyLiquidityCommand,
+		listLiquidityAdsCommand,
+		bumpFundingFeeCommand,
+		pauseChannelCommand,
+		resumeChannelCommand,
 		closeChannelCommand,
 		closeAllChannelsCommand,
+		scheduleCloseCommand,
+		cancelScheduledCloseCommand,
 		abandonChannelCommand,
 		listPeersCommand,
 		walletBalanceCommand,
@@ -474,6 +485,7 @@ func Main() {
 		pendingChannelsCommand,
 		SendPaymentCommand,
 		payInvoiceCommand,
+		sendBatchCommand,
 		sendToRouteCommand,
 		AddInvoiceCommand,
 		lookupInvoiceCommand,
@@ -501,6 +513,7 @@ func Main() {
 		exportChanBackupCommand,
 		verifyChanBackupCommand,
 		restoreChanBackupCommand,
+		inspectChanBackupCommand,
 		bakeMacaroonCommand,
 		listMacaroonIDsCommand,
 		deleteMacaroonIDCommand,
@@ -508,6 +521,7 @@ func Main() {
 		printMacaroonCommand,
 		constrainMacaroonCommand,
 		trackPaymentCommand,
+		cancelPaymentCommand,
 		versionCommand,
 		profileSubCommand,
 		getStateCommand,
@@ -540,13 +554,19 @@ func Main() {
 // readPassword reads a password from the terminal. This requires there to be an
 // actual TTY so passing in a password from stdin won't work.
 func readPassword(text string) ([]byte, error) {
-	fmt.Print(text)
+	return promptPassword(os.Stdout, text)
+}
+
+// promptPassword writes the given prompt to w and reads a password from the
+// terminal without echoing it.
+func promptPassword(w io.Writer, text string) ([]byte, error) {
+	fmt.Fprint(w, text)
 
 	// The variable syscall.Stdin is of a different type in the Windows API
 	// that's why we need the explicit cast. And of course the linter
 	// doesn't like it either.
 	pw, err := term.ReadPassword(int(syscall.Stdin)) //nolint:unconvert
-	fmt.Println()
+	fmt.Fprintln(w)
 
 	return pw, err
 }
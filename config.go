@@ -562,6 +562,10 @@ type Config struct {
 
 	Htlcswitch *lncfg.Htlcswitch `group:"htlcswitch" namespace:"htlcswitch"`
 
+	LiquidityAds *lncfg.LiquidityAds `group:"liquidityads" namespace:"liquidityads"`
+
+	PeerManager *lncfg.PeerManager `group:"peermgr" namespace:"peermgr"`
+
 	GRPC *GRPCConfig `group:"grpc" namespace:"grpc"`
 
 	// SubLogMgr is the root logger that all the daemon's subloggers are
@@ -849,6 +853,29 @@ func DefaultConfig() Config {
 				PolicyIncreaseMultiplier: lncfg.DefaultBlindedPathPolicyIncreaseMultiplier,
 				PolicyDecreaseMultiplier: lncfg.DefaultBlindedPathPolicyDecreaseMultiplier,
 			},
+			DynamicFees: lncfg.DynamicFees{
+				Interval:     lncfg.DefaultDynamicFeesInterval,
+				BaseFeeMsat:  lncfg.DefaultDynamicFeesBaseFeeMsat,
+				FeeRateCurve: lncfg.DefaultDynamicFeesFeeRateCurve,
+				VolumeWindow: lncfg.DefaultDynamicFeesVolumeWindow,
+			},
+			Trampoline: lncfg.Trampoline{
+				FeeBaseMsat: lncfg.DefaultTrampolineFeeBaseMsat,
+				FeeRatePpm:  lncfg.DefaultTrampolineFeeRatePpm,
+				CltvDelta:   lncfg.DefaultTrampolineCltvDelta,
+			},
+			Prober: lncfg.Prober{
+				Interval:      lncfg.DefaultProberInterval,
+				MinAmtMsat:    lncfg.DefaultProberMinAmtMsat,
+				MaxAmtMsat:    lncfg.DefaultProberMaxAmtMsat,
+				MaxLockedMsat: lncfg.DefaultProberMaxLockedMsat,
+				MaxFeePPM:     lncfg.DefaultProberMaxFeePPM,
+				Timeout:       lncfg.DefaultProberTimeout,
+			},
+			StuckHTLC: lncfg.StuckHTLC{
+				Threshold:     lncfg.DefaultStuckHTLCThreshold,
+				CheckInterval: lncfg.DefaultStuckHTLCInterval,
+			},
 		},
 		MaxOutgoingCltvExpiry:     htlcswitch.DefaultMaxOutgoingCltvExpiry,
 		MaxChannelFeeAllocation:   htlcswitch.DefaultMaxLinkFeeAllocation,
@@ -869,9 +896,16 @@ func DefaultConfig() Config {
 		},
 		Sweeper: lncfg.DefaultSweeperConfig(),
 		Htlcswitch: &lncfg.Htlcswitch{
-			MailboxDeliveryTimeout: htlcswitch.DefaultMailboxDeliveryTimeout,
-			QuiescenceTimeout:      lncfg.DefaultQuiescenceTimeout,
+			MailboxDeliveryTimeout:    htlcswitch.DefaultMailboxDeliveryTimeout,
+			QuiescenceTimeout:         lncfg.DefaultQuiescenceTimeout,
+			ProtectedSlotPercent:      htlcswitch.DefaultProtectedPercent,
+			ProtectedLiquidityPercent: htlcswitch.DefaultProtectedPercent,
+			RevenueWindow:             htlcswitch.DefaultRevenueWindow,
+			ReputationWindow:          htlcswitch.DefaultReputationWindow,
+			ResolutionPeriod:          htlcswitch.DefaultResolutionPeriod,
 		},
+		LiquidityAds: lncfg.DefaultLiquidityAds(),
+		PeerManager:  &lncfg.PeerManager{},
 		GRPC: &GRPCConfig{
 			ServerPingTime:    defaultGrpcServerPingTime,
 			ServerPingTimeout: defaultGrpcServerPingTimeout,
@@ -1918,6 +1952,13 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor, fileParser,
 		)
 	}
 
+	// Leases are requested in the open_channel2 message, so we can only
+	// sell them if we accept dual-funded channels.
+	if cfg.LiquidityAds.Active && !cfg.ProtocolOptions.DualFund {
+		return nil, mkErr("liquidityads.active requires " +
+			"protocol.dual-fund")
+	}
+
 	// Validate the subconfigs for workers, caches, and the tower client.
 	err = lncfg.Validate(
 		cfg.Workers,
@@ -1930,6 +1971,8 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor, fileParser,
 		cfg.RemoteSigner,
 		cfg.Sweeper,
 		cfg.Htlcswitch,
+		cfg.LiquidityAds,
+		cfg.PeerManager,
 		cfg.Invoices,
 		cfg.Routing,
 		cfg.Pprof,
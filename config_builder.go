@@ -84,6 +84,11 @@ const (
 	// paymentMigration is the version number for the payments migration
 	// that migrates KV payments to the native SQL schema.
 	paymentMigration = 14
+
+	// invoiceHoldPolicyMigration is the version of the migration that
+	// migrates the hold policies of KV invoices, which can only be done
+	// once the hold policy table was created.
+	invoiceHoldPolicyMigration = 20
 )
 
 // GrpcRegistrar is an interface that must be satisfied by an external subserver
@@ -1166,6 +1171,20 @@ func (d *DefaultDatabaseBuilder) BuildDatabase(
 				return dbs.ChanStateDB.SetInvoiceBucketTombstone()
 			}
 
+			policyMig := func(tx *sqlc.Queries) error {
+				err := invoices.MigrateInvoiceHoldPoliciesToSQL(
+					ctx, dbs.ChanStateDB.Backend,
+					dbs.ChanStateDB, tx,
+				)
+				if err != nil {
+					return fmt.Errorf("failed to migrate "+
+						"invoice hold policies to "+
+						"SQL: %w", err)
+				}
+
+				return nil
+			}
+
 			graphMig := func(tx *sqlc.Queries) error {
 				cfg := &graphdbmig1.SQLStoreConfig{
 					//nolint:ll
@@ -1221,6 +1240,11 @@ func (d *DefaultDatabaseBuilder) BuildDatabase(
 
 					continue
 
+				case invoiceHoldPolicyMigration:
+					migrations[i].MigrationFn = policyMig
+
+					continue
+
 				default:
 				}
 
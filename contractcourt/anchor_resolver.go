@@ -227,24 +227,29 @@ func (c *anchorResolver) Launch() error {
 
 	exclusiveGroup := c.ShortChanID.ToUint64()
 
-	resultChan, err := c.Sweeper.SweepInput(
-		&anchorInput,
-		sweep.Params{
-			// For normal anchor sweeping, the budget is 330 sats.
-			Budget: btcutil.Amount(
-				anchorInput.SignDesc().Output.Value,
-			),
-
-			// There's no rush to sweep the anchor, so we use a nil
-			// deadline here.
-			DeadlineHeight: fn.None[int32](),
-
-			// Use the chan id as the exclusive group. This prevents
-			// any of the anchors from being batched together.
-			ExclusiveGroup: &exclusiveGroup,
-		},
-	)
+	params := sweep.Params{
+		// For normal anchor sweeping, the budget is 330 sats.
+		Budget: btcutil.Amount(anchorInput.SignDesc().Output.Value),
+
+		// There's no rush to sweep the anchor, so we use a nil
+		// deadline here.
+		DeadlineHeight: fn.None[int32](),
+
+		// Use the chan id as the exclusive group. This prevents any of
+		// the anchors from being batched together.
+		ExclusiveGroup: &exclusiveGroup,
+	}
+
+	// If an anchor reclaimer is configured, we hand the anchor to it
+	// instead. It'll wait for low fees and then sweep all anchors of our
+	// closed channels in a single batch, which makes sweeping them
+	// economical.
+	sweepAnchor := c.Sweeper.SweepInput
+	c.AnchorReclaimer.WhenSome(func(r AnchorReclaimer) {
+		sweepAnchor = r.ReclaimAnchor
+	})
 
+	resultChan, err := sweepAnchor(&anchorInput, params)
 	if err != nil {
 		return err
 	}
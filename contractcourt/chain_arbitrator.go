@@ -162,6 +162,10 @@ type ChainArbitratorConfig struct {
 	// Sweeper allows resolvers to sweep their final outputs.
 	Sweeper UtxoSweeper
 
+	// AnchorReclaimer is an optional reclaimer that anchor resolvers hand
+	// their anchors to instead of offering them to the sweeper directly.
+	AnchorReclaimer fn.Option[AnchorReclaimer]
+
 	// Registry is the invoice database that is used by resolvers to lookup
 	// preimages and settle invoices.
 	Registry Registry
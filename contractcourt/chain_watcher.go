@@ -566,7 +566,7 @@ func (c *chainWatcher) handleUnknownLocalState(
 		},
 	)(auxResult.AuxLeaves)
 	remoteScript, _, err := lnwallet.CommitScriptToRemote(
-		c.cfg.chanState.ChanType, c.cfg.chanState.IsInitiator,
+		c.cfg.chanState.ChanType, c.cfg.chanState.IsLeaseSeller(),
 		commitKeyRing.ToRemoteKey, leaseExpiry,
 		remoteAuxLeaf,
 	)
@@ -583,7 +583,7 @@ func (c *chainWatcher) handleUnknownLocalState(
 		},
 	)(auxResult.AuxLeaves)
 	localScript, err := lnwallet.CommitScriptToSelf(
-		c.cfg.chanState.ChanType, c.cfg.chanState.IsInitiator,
+		c.cfg.chanState.ChanType, c.cfg.chanState.IsLeaseSeller(),
 		commitKeyRing.ToLocalKey, commitKeyRing.RevocationKey,
 		uint32(c.cfg.chanState.LocalChanCfg.CsvDelay), leaseExpiry,
 		localAuxLeaf,
@@ -3165,11 +3165,14 @@ func (c *ChannelArbitrator) createSweepRequest(
 	witnessType := input.CommitmentAnchor
 
 	// For taproot channels, we need to use the proper witness type.
-	if txscript.IsPayToTaproot(
-		anchor.AnchorSignDescriptor.Output.PkScript,
-	) {
-
+	anchorPkScript := anchor.AnchorSignDescriptor.Output.PkScript
+	switch {
+	case txscript.IsPayToTaproot(anchorPkScript):
 		witnessType = input.TaprootAnchorSweepSpend
+
+	// Zero-fee commitments have a shared pay-to-anchor output instead.
+	case txscript.IsPayToAnchorScript(anchorPkScript):
+		witnessType = input.P2AAnchorSpend
 	}
 
 	// Prepare anchor output for sweeping.
@@ -3181,6 +3184,7 @@ func (c *ChannelArbitrator) createSweepRequest(
 		&input.TxInfo{
 			Fee:    anchor.CommitFee,
 			Weight: anchor.CommitWeight,
+			Tx:     anchor.CommitTx,
 		},
 	)
 
@@ -3195,11 +3199,18 @@ func (c *ChannelArbitrator) createSweepRequest(
 
 	// Calculate the budget based on the value under protection, which is
 	// the sum of all HTLCs on this commitment subtracted by their budgets.
-	// The anchor output in itself has a small output value of 330 sats so
-	// we also include it in the budget to pay for the cpfp transaction.
+	// The anchor output in itself has a small output value so we also
+	// include it in the budget to pay for the cpfp transaction.
+	anchorValue := btcutil.Amount(anchor.AnchorSignDescriptor.Output.Value)
 	budget := calculateBudget(
 		value, c.cfg.Budget.AnchorCPFPRatio, c.cfg.Budget.AnchorCPFP,
-	) + AnchorOutputValue
+	) + anchorValue
+
+	// A zero-fee commitment pays no fee itself, so the cpfp transaction
+	// must pay for the whole package even if there are no HTLCs at stake.
+	if witnessType == input.P2AAnchorSpend {
+		budget += c.zeroFeeCPFPBudget()
+	}
 
 	log.Infof("ChannelArbitrator(%v): offering anchor from %s commitment "+
 		"%v to sweeper with deadline=%v, budget=%v", c.cfg.ChanPoint,
@@ -3219,6 +3230,16 @@ func (c *ChannelArbitrator) createSweepRequest(
 	}, nil
 }
 
+// zeroFeeCPFPBudget returns the budget added to the anchor sweep of a zero-fee
+// commitment to pay for the commitment itself.
+func (c *ChannelArbitrator) zeroFeeCPFPBudget() btcutil.Amount {
+	if c.cfg.Budget.ZeroFeeCPFP == 0 {
+		return DefaultZeroFeeCPFP
+	}
+
+	return c.cfg.Budget.ZeroFeeCPFP
+}
+
 // prepareAnchorSweeps creates a list of requests to be used by the sweeper for
 // all possible commitment versions.
 func (c *ChannelArbitrator) prepareAnchorSweeps(heightHint uint32,
@@ -2769,6 +2769,64 @@ func TestSweepLocalAnchor(t *testing.T) {
 	)
 }
 
+// TestCreateSweepRequestZeroFeeBudget checks that the anchor sweep of a
+// zero-fee commitment gets a budget to pay for the fee-less commitment, even if
+// there are no HTLCs at stake.
+func TestCreateSweepRequestZeroFeeBudget(t *testing.T) {
+	log := &mockArbitratorLog{
+		state:     StateDefault,
+		newStates: make(chan ArbitratorState, 5),
+	}
+	chanArbCtx, err := createTestChannelArbitrator(t, log)
+	require.NoError(t, err, "unable to create ChannelArbitrator")
+
+	chanArb := chanArbCtx.chanArb
+	heightHint := uint32(1000)
+
+	// A keyed anchor only gets its own value as budget if there are no
+	// HTLCs at stake.
+	keyedAnchor := &lnwallet.AnchorResolution{
+		AnchorSignDescriptor: input.SignDescriptor{
+			Output: &wire.TxOut{
+				PkScript: make([]byte, 34),
+				Value:    int64(AnchorOutputValue),
+			},
+		},
+	}
+	req, err := chanArb.createSweepRequest(
+		keyedAnchor, htlcSet{}, "local", heightHint,
+	)
+	require.NoError(t, err)
+	require.Equal(t, AnchorOutputValue, req.params.Budget)
+
+	// The shared anchor of a zero-fee commitment adds its actual value
+	// and the budget to pay for the commitment itself.
+	const sharedAnchorValue = 240
+	sharedAnchor := &lnwallet.AnchorResolution{
+		AnchorSignDescriptor: input.SignDescriptor{
+			Output: &wire.TxOut{
+				PkScript: lnwallet.SharedAnchorScript(),
+				Value:    sharedAnchorValue,
+			},
+		},
+	}
+	req, err = chanArb.createSweepRequest(
+		sharedAnchor, htlcSet{}, "local", heightHint,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, DefaultZeroFeeCPFP+sharedAnchorValue, req.params.Budget,
+	)
+
+	// A configured budget takes precedence over the default.
+	chanArb.cfg.Budget.ZeroFeeCPFP = 10_000
+	req, err = chanArb.createSweepRequest(
+		sharedAnchor, htlcSet{}, "local", heightHint,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 10_000+sharedAnchorValue, req.params.Budget)
+}
+
 // TestChannelArbitratorAnchors asserts that the commitment tx anchor is swept.
 func TestChannelArbitratorAnchors(t *testing.T) {
 	log := &mockArbitratorLog{
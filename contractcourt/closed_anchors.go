@@ -0,0 +1,192 @@
+package contractcourt
+
+import (
+	"errors"
+
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+	"github.com/lightningnetwork/lnd/sweep"
+)
+
+// ClosedAnchorScannerConfig contains the functions required to find the
+// unspent anchors of closed channels.
+type ClosedAnchorScannerConfig struct {
+	// FetchClosedChannels fetches the summaries of all closed channels.
+	FetchClosedChannels func(pendingOnly bool) (
+		[]*channeldb.ChannelCloseSummary, error)
+
+	// FetchHistoricalChannel fetches the final state of a closed channel.
+	FetchHistoricalChannel func(outPoint *wire.OutPoint) (
+		*channeldb.OpenChannel, error)
+
+	// ChainIO is used to check whether an anchor is still unspent.
+	ChainIO lnwallet.BlockChainIO
+}
+
+// ClosedAnchorScanner finds the unspent anchors of closed channels.
+type ClosedAnchorScanner struct {
+	cfg *ClosedAnchorScannerConfig
+}
+
+// NewClosedAnchorScanner returns a new ClosedAnchorScanner.
+func NewClosedAnchorScanner(
+	cfg *ClosedAnchorScannerConfig) *ClosedAnchorScanner {
+
+	return &ClosedAnchorScanner{cfg: cfg}
+}
+
+// ScanClosedChanAnchors looks up our anchors of the force closed channels
+// that aren't part of the given set and returns the ones that are still
+// unspent. Once their resolvers are gone, the anchors of fully resolved
+// channels aren't swept by anyone else.
+//
+// Channels that are returned without an anchor don't have one we can sweep,
+// so they don't need to be scanned again. Channels that couldn't be scanned
+// because of an error are left out, so that they're scanned on the next
+// startup.
+//
+// NOTE: Anchors of taproot channels and the shared anchors of zero-fee
+// commitments aren't looked up, as their sign descriptors depend on state that
+// isn't part of the historical channel.
+func (c *ClosedAnchorScanner) ScanClosedChanAnchors(
+	scanned map[wire.OutPoint]struct{},
+	quit <-chan struct{}) ([]sweep.ClosedChanAnchor, error) {
+
+	// Channels that are still pending close have their anchors handed to
+	// the reclaimer by their resolvers.
+	summaries, err := c.cfg.FetchClosedChannels(false)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []sweep.ClosedChanAnchor
+	for _, summary := range summaries {
+		select {
+		case <-quit:
+			return results, nil
+		default:
+		}
+
+		if summary.IsPending {
+			continue
+		}
+
+		if _, ok := scanned[summary.ChanPoint]; ok {
+			continue
+		}
+
+		anchor, err := c.findAnchor(summary, quit)
+		if err != nil {
+			log.Warnf("Unable to scan closed channel %v for its "+
+				"anchor: %v", summary.ChanPoint, err)
+
+			continue
+		}
+
+		results = append(results, sweep.ClosedChanAnchor{
+			ChanPoint: summary.ChanPoint,
+			Anchor:    anchor,
+		})
+	}
+
+	return results, nil
+}
+
+// findAnchor returns our unspent anchor on the closing transaction of the
+// given closed channel, if any.
+func (c *ClosedAnchorScanner) findAnchor(
+	summary *channeldb.ChannelCloseSummary,
+	quit <-chan struct{}) (fn.Option[input.Input], error) {
+
+	none := fn.None[input.Input]()
+
+	// Only force closes have anchors.
+	if summary.CloseType != channeldb.LocalForceClose &&
+		summary.CloseType != channeldb.RemoteForceClose {
+
+		return none, nil
+	}
+
+	histChan, err := c.cfg.FetchHistoricalChannel(&summary.ChanPoint)
+	switch {
+	// Channels closed before the historical bucket was introduced don't
+	// have their final state stored.
+	case errors.Is(err, channeldb.ErrNoHistoricalBucket),
+		errors.Is(err, channeldb.ErrChannelNotFound):
+
+		return none, nil
+
+	case err != nil:
+		return none, err
+	}
+
+	chanType := histChan.ChanType
+	if !chanType.HasAnchors() || chanType.IsTaproot() ||
+		chanType.HasZeroFeeCommitments() {
+
+		return none, nil
+	}
+
+	// Find out which of the commitments confirmed.
+	var (
+		commitTx    *wire.MsgTx
+		whoseCommit lntypes.ChannelParty
+	)
+	localTx := histChan.LocalCommitment.CommitTx
+	remoteTx := histChan.RemoteCommitment.CommitTx
+	switch {
+	case localTx != nil && localTx.TxHash() == summary.ClosingTXID:
+		commitTx = localTx
+		whoseCommit = lntypes.Local
+
+	case remoteTx != nil && remoteTx.TxHash() == summary.ClosingTXID:
+		commitTx = remoteTx
+		whoseCommit = lntypes.Remote
+
+	// The confirmed commitment may be a pending remote commitment, which
+	// isn't part of the historical channel.
+	default:
+		return none, nil
+	}
+
+	// The key ring is only needed for taproot anchors.
+	resolution, err := lnwallet.NewAnchorResolution(
+		histChan, commitTx, nil, whoseCommit,
+	)
+	if err != nil {
+		return none, err
+	}
+	if resolution == nil {
+		return none, nil
+	}
+
+	_, err = c.cfg.ChainIO.GetUtxo(
+		&resolution.CommitAnchor,
+		resolution.AnchorSignDescriptor.Output.PkScript,
+		summary.CloseHeight, quit,
+	)
+	switch {
+	case errors.Is(err, btcwallet.ErrOutputSpent),
+		errors.Is(err, btcwallet.ErrOutputNotFound):
+
+		return none, nil
+
+	case err != nil:
+		return none, err
+	}
+
+	log.Infof("Found unspent anchor %v of closed channel %v",
+		resolution.CommitAnchor, summary.ChanPoint)
+
+	anchor := input.MakeBaseInput(
+		&resolution.CommitAnchor, input.CommitmentAnchor,
+		&resolution.AnchorSignDescriptor, summary.CloseHeight, nil,
+	)
+
+	return fn.Some[input.Input](&anchor), nil
+}
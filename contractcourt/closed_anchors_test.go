@@ -0,0 +1,174 @@
+package contractcourt
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnmock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+	"github.com/lightningnetwork/lnd/sweep"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScanClosedChanAnchors tests that only the unspent anchors of fully
+// resolved force closes are returned, and that channels that failed to be
+// scanned are left out.
+func TestScanClosedChanAnchors(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, _, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit|
+			channeldb.AnchorOutputsBit,
+	)
+	require.NoError(t, err)
+
+	histChan := aliceChannel.State()
+	localTxid := histChan.LocalCommitment.CommitTx.TxHash()
+	remoteTxid := histChan.RemoteCommitment.CommitTx.TxHash()
+
+	localAnchor, err := lnwallet.NewAnchorResolution(
+		histChan, histChan.LocalCommitment.CommitTx, nil, lntypes.Local,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, localAnchor)
+
+	remoteAnchor, err := lnwallet.NewAnchorResolution(
+		histChan, histChan.RemoteCommitment.CommitTx, nil,
+		lntypes.Remote,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, remoteAnchor)
+
+	chanPoint := func(i byte) wire.OutPoint {
+		return wire.OutPoint{Hash: chainhash.Hash{i}}
+	}
+
+	var (
+		unspent   = chanPoint(1)
+		spent     = chanPoint(2)
+		coop      = chanPoint(3)
+		pending   = chanPoint(4)
+		scanned   = chanPoint(5)
+		noHistory = chanPoint(6)
+		failed    = chanPoint(7)
+	)
+
+	summaries := []*channeldb.ChannelCloseSummary{
+		{
+			ChanPoint:   unspent,
+			ClosingTXID: localTxid,
+			CloseHeight: 100,
+			CloseType:   channeldb.LocalForceClose,
+		},
+		{
+			ChanPoint:   spent,
+			ClosingTXID: remoteTxid,
+			CloseHeight: 101,
+			CloseType:   channeldb.RemoteForceClose,
+		},
+		{
+			ChanPoint:   coop,
+			ClosingTXID: localTxid,
+			CloseType:   channeldb.CooperativeClose,
+		},
+		{
+			ChanPoint:   pending,
+			ClosingTXID: localTxid,
+			CloseType:   channeldb.LocalForceClose,
+			IsPending:   true,
+		},
+		{
+			ChanPoint:   scanned,
+			ClosingTXID: localTxid,
+			CloseType:   channeldb.LocalForceClose,
+		},
+		{
+			ChanPoint:   noHistory,
+			ClosingTXID: localTxid,
+			CloseType:   channeldb.LocalForceClose,
+		},
+		{
+			ChanPoint:   failed,
+			ClosingTXID: localTxid,
+			CloseHeight: 102,
+			CloseType:   channeldb.LocalForceClose,
+		},
+	}
+
+	localPkScript := localAnchor.AnchorSignDescriptor.Output.PkScript
+	remotePkScript := remoteAnchor.AnchorSignDescriptor.Output.PkScript
+
+	chain := &lnmock.MockChain{}
+	chain.On(
+		"GetUtxo", &localAnchor.CommitAnchor, localPkScript,
+		uint32(100), mock.Anything,
+	).Return(localAnchor.AnchorSignDescriptor.Output, nil).Once()
+	chain.On(
+		"GetUtxo", &remoteAnchor.CommitAnchor, remotePkScript,
+		uint32(101), mock.Anything,
+	).Return(nil, btcwallet.ErrOutputSpent).Once()
+	chain.On(
+		"GetUtxo", &localAnchor.CommitAnchor, localPkScript,
+		uint32(102), mock.Anything,
+	).Return(nil, errors.New("backend unavailable")).Once()
+
+	cfg := &ClosedAnchorScannerConfig{
+		FetchClosedChannels: func(pendingOnly bool) (
+			[]*channeldb.ChannelCloseSummary, error) {
+
+			require.False(t, pendingOnly)
+
+			return summaries, nil
+		},
+		FetchHistoricalChannel: func(op *wire.OutPoint) (
+			*channeldb.OpenChannel, error) {
+
+			if *op == noHistory {
+				return nil, channeldb.ErrChannelNotFound
+			}
+
+			return histChan, nil
+		},
+		ChainIO: chain,
+	}
+
+	scanner := NewClosedAnchorScanner(cfg)
+	results, err := scanner.ScanClosedChanAnchors(
+		map[wire.OutPoint]struct{}{scanned: {}}, nil,
+	)
+	require.NoError(t, err)
+	chain.AssertExpectations(t)
+
+	// The pending, scanned and failed channels are left out, and only the
+	// unspent anchor is returned.
+	require.Len(t, results, 4)
+
+	byChanPoint := make(map[wire.OutPoint]sweep.ClosedChanAnchor)
+	for _, result := range results {
+		byChanPoint[result.ChanPoint] = result
+	}
+
+	for _, op := range []wire.OutPoint{spent, coop, noHistory} {
+		require.Contains(t, byChanPoint, op)
+		require.True(t, byChanPoint[op].Anchor.IsNone())
+	}
+
+	anchor, err := byChanPoint[unspent].Anchor.UnwrapOrErr(
+		errors.New("no anchor"),
+	)
+	require.NoError(t, err)
+	require.Equal(t, localAnchor.CommitAnchor, anchor.OutPoint())
+	require.Equal(t, input.CommitmentAnchor, anchor.WitnessType())
+	require.EqualValues(t, 100, anchor.HeightHint())
+	require.Equal(
+		t, localAnchor.AnchorSignDescriptor.Output,
+		anchor.SignDesc().Output,
+	)
+}
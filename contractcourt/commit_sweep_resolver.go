@@ -47,7 +47,8 @@ type commitSweepResolver struct {
 	chanPoint wire.OutPoint
 
 	// channelInitiator denotes whether the party responsible for resolving
-	// the contract initiated the channel.
+	// the contract is the seller of a leased channel, whose outputs are
+	// locked until the lease expires.
 	channelInitiator bool
 
 	// leaseExpiry denotes the additional waiting period the contract must
@@ -215,7 +216,7 @@ func (c *commitSweepResolver) SupplementState(state *channeldb.OpenChannel) {
 		c.leaseExpiry = state.ThawHeight
 	}
 	c.localChanCfg = state.LocalChanCfg
-	c.channelInitiator = state.IsInitiator
+	c.channelInitiator = state.IsLeaseSeller()
 	c.chanType = state.ChanType
 }
 
@@ -23,6 +23,12 @@ const (
 	// sweeping inputs. This is a large value, which is fine as the final
 	// fee rate is capped at the max fee rate configured.
 	DefaultBudgetRatio = 0.5
+
+	// DefaultZeroFeeCPFP is the default budget added when CPFPing a
+	// zero-fee commitment using its shared anchor. The commitment itself
+	// pays no fee, so the child needs enough budget to pay for the whole
+	// package even when there are no HTLCs at stake.
+	DefaultZeroFeeCPFP btcutil.Amount = 50_000
 )
 
 // BudgetConfig is a struct that holds the configuration when offering outputs
@@ -36,6 +42,8 @@ type BudgetConfig struct {
 	AnchorCPFP      btcutil.Amount `long:"anchorcpfp" description:"The amount in satoshis to allocate as the budget to pay fees when CPFPing a force close tx using the anchor output. If set, the budget calculated using the ratio (if set) will be capped at this value."`
 	AnchorCPFPRatio float64        `long:"anchorcpfpratio" description:"The ratio of a special value to allocate as the budget to pay fees when CPFPing a force close tx using the anchor output. The special value is the sum of all time-sensitive HTLCs on this commitment subtracted by their budgets."`
 
+	ZeroFeeCPFP btcutil.Amount `long:"zerofeecpfp" description:"The amount in satoshis to add to the anchor CPFP budget when CPFPing a zero-fee commitment using its shared anchor, to pay for the fee-less commitment itself."`
+
 	DeadlineHTLC      btcutil.Amount `long:"deadlinehtlc" description:"The amount in satoshis to allocate as the budget to pay fees when sweeping a time-sensitive (first-level) HTLC. If set, the budget calculated using the ratio (if set) will be capped at this value."`
 	DeadlineHTLCRatio float64        `long:"deadlinehtlcratio" description:"The ratio of the value in a time-sensitive (first-level) HTLC to allocate as the budget to pay fees when sweeping it."`
 
@@ -69,6 +77,11 @@ func (b *BudgetConfig) Validate() error {
 			MinBudgetRatio)
 	}
 
+	if b.ZeroFeeCPFP != 0 && b.ZeroFeeCPFP < MinBudgetValue {
+		return fmt.Errorf("zerofeecpfp must be at least %v",
+			MinBudgetValue)
+	}
+
 	if b.DeadlineHTLC != 0 && b.DeadlineHTLC < MinBudgetValue {
 		return fmt.Errorf("deadlinehtlc must be at least %v",
 			MinBudgetValue)
@@ -95,11 +108,11 @@ func (b *BudgetConfig) Validate() error {
 // String returns a human-readable description of the budget configuration.
 func (b *BudgetConfig) String() string {
 	return fmt.Sprintf("tolocal=%v tolocalratio=%v anchorcpfp=%v "+
-		"anchorcpfpratio=%v deadlinehtlc=%v deadlinehtlcratio=%v "+
-		"nodeadlinehtlc=%v nodeadlinehtlcratio=%v",
-		b.ToLocal, b.ToLocalRatio, b.AnchorCPFP, b.AnchorCPFPRatio,
-		b.DeadlineHTLC, b.DeadlineHTLCRatio, b.NoDeadlineHTLC,
-		b.NoDeadlineHTLCRatio)
+		"anchorcpfpratio=%v zerofeecpfp=%v deadlinehtlc=%v "+
+		"deadlinehtlcratio=%v nodeadlinehtlc=%v "+
+		"nodeadlinehtlcratio=%v", b.ToLocal, b.ToLocalRatio,
+		b.AnchorCPFP, b.AnchorCPFPRatio, b.ZeroFeeCPFP, b.DeadlineHTLC,
+		b.DeadlineHTLCRatio, b.NoDeadlineHTLC, b.NoDeadlineHTLCRatio)
 }
 
 // DefaultSweeperConfig returns the default configuration for the sweeper.
@@ -107,6 +120,7 @@ func DefaultBudgetConfig() *BudgetConfig {
 	return &BudgetConfig{
 		ToLocalRatio:        DefaultBudgetRatio,
 		AnchorCPFPRatio:     DefaultBudgetRatio,
+		ZeroFeeCPFP:         DefaultZeroFeeCPFP,
 		DeadlineHTLCRatio:   DefaultBudgetRatio,
 		NoDeadlineHTLCRatio: DefaultBudgetRatio,
 	}
@@ -14,7 +14,8 @@ import (
 // input to offer to the sweeper.
 type htlcLeaseResolver struct {
 	// channelInitiator denotes whether the party responsible for resolving
-	// the contract initiated the channel.
+	// the contract is the seller of a leased channel, whose outputs are
+	// locked until the lease expires.
 	channelInitiator bool
 
 	// leaseExpiry denotes the additional waiting period the contract must
@@ -80,5 +81,5 @@ func (h *htlcLeaseResolver) SupplementState(state *channeldb.OpenChannel) {
 	if state.ChanType.HasLeaseExpiration() {
 		h.leaseExpiry = state.ThawHeight
 	}
-	h.channelInitiator = state.IsInitiator
+	h.channelInitiator = state.IsLeaseSeller()
 }
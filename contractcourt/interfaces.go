@@ -73,6 +73,16 @@ type UtxoSweeper interface {
 		chan sweep.Result, error)
 }
 
+// AnchorReclaimer defines an interface that holds back the anchor outputs of
+// closed channels until they can be swept economically.
+type AnchorReclaimer interface {
+	// ReclaimAnchor hands the anchor to the reclaimer. The given params
+	// are used if the anchor is swept on its own. The returned channel
+	// receives the final result of the sweep.
+	ReclaimAnchor(input input.Input, params sweep.Params) (
+		chan sweep.Result, error)
+}
+
 // HtlcNotifier defines the notification functions that contract court requires.
 type HtlcNotifier interface {
 	// NotifyFinalHtlcEvent notifies the HtlcNotifier that the final outcome
@@ -72,6 +72,10 @@ var deps = depDesc{
 	lnwire.KeysendOptional: {
 		lnwire.TLVOnionPayloadOptional: {},
 	},
+	lnwire.TrampolineRoutingOptionalStaging: {
+		lnwire.TLVOnionPayloadOptional: {},
+		lnwire.PaymentAddrOptional:     {},
+	},
 	lnwire.ZeroConfOptional: {
 		lnwire.ScidAliasOptional: {},
 	},
@@ -83,6 +87,10 @@ var deps = depDesc{
 		lnwire.AnchorsZeroFeeHtlcTxOptional: {},
 		lnwire.ExplicitChannelTypeOptional:  {},
 	},
+	lnwire.ZeroFeeCommitmentsOptional: {
+		lnwire.AnchorsZeroFeeHtlcTxOptional: {},
+		lnwire.ExplicitChannelTypeOptional:  {},
+	},
 	lnwire.SimpleTaprootOverlayChansOptional: {
 		lnwire.SimpleTaprootChannelsOptionalStaging: {},
 		lnwire.TLVOnionPayloadOptional:              {},
@@ -81,6 +81,26 @@ type Config struct {
 	// messaging.
 	NoOnionMessages bool
 
+	// NoTrampolineRouting unsets any bits that signal support for
+	// forwarding trampoline payments.
+	NoTrampolineRouting bool
+
+	// NoDualFund unsets any bits that signal support for the v2 channel
+	// establishment protocol.
+	NoDualFund bool
+
+	// NoUpfrontFees unsets any bits that signal support for experimental
+	// upfront htlc fees.
+	NoUpfrontFees bool
+
+	// NoPeerStorage unsets any bits that signal that we store backup blobs
+	// on behalf of our peers.
+	NoPeerStorage bool
+
+	// NoZeroFeeCommitments unsets any bits that signal support for zero-fee
+	// v3 commitment transactions.
+	NoZeroFeeCommitments bool
+
 	// CustomFeatures is a set of custom features to advertise in each
 	// set.
 	CustomFeatures map[Set][]lnwire.FeatureBit
@@ -231,6 +251,26 @@ func newManager(cfg Config, desc setDesc) (*Manager, error) {
 			raw.Unset(lnwire.OnionMessagesOptional)
 			raw.Unset(lnwire.OnionMessagesRequired)
 		}
+		if cfg.NoTrampolineRouting {
+			raw.Unset(lnwire.TrampolineRoutingOptionalStaging)
+			raw.Unset(lnwire.TrampolineRoutingRequiredStaging)
+		}
+		if cfg.NoDualFund {
+			raw.Unset(lnwire.DualFundOptional)
+			raw.Unset(lnwire.DualFundRequired)
+		}
+		if cfg.NoUpfrontFees {
+			raw.Unset(lnwire.ExperimentalUpfrontFeesOptional)
+			raw.Unset(lnwire.ExperimentalUpfrontFeesRequired)
+		}
+		if cfg.NoPeerStorage {
+			raw.Unset(lnwire.ProvideStorageOptional)
+			raw.Unset(lnwire.ProvideStorageRequired)
+		}
+		if cfg.NoZeroFeeCommitments {
+			raw.Unset(lnwire.ZeroFeeCommitmentsOptional)
+			raw.Unset(lnwire.ZeroFeeCommitmentsRequired)
+		}
 
 		for _, custom := range cfg.CustomFeatures[set] {
 			if custom > set.Maximum() {
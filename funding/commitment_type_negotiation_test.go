@@ -431,6 +431,48 @@ func TestCommitmentTypeNegotiation(t *testing.T) {
 			),
 			expectsErr: errUnsupportedChannelType,
 		},
+		{
+			name: "explicit zero-fee commitments with zero conf",
+			channelFeatures: lnwire.NewRawFeatureVector(
+				lnwire.ZeroFeeCommitmentsRequired,
+				lnwire.ZeroConfRequired,
+			),
+			localFeatures: lnwire.NewRawFeatureVector(
+				lnwire.ZeroFeeCommitmentsOptional,
+				lnwire.ZeroConfOptional,
+				lnwire.ExplicitChannelTypeOptional,
+			),
+			remoteFeatures: lnwire.NewRawFeatureVector(
+				lnwire.ZeroFeeCommitmentsOptional,
+				lnwire.ZeroConfOptional,
+				lnwire.ExplicitChannelTypeOptional,
+			),
+			expectsCommitType: lnwallet.CommitmentTypeZeroFeeCommitments, //nolint:ll
+			expectsChanType: (*lnwire.ChannelType)(
+				lnwire.NewRawFeatureVector(
+					lnwire.ZeroFeeCommitmentsRequired,
+					lnwire.ZeroConfRequired,
+				),
+			),
+			zeroConf:   true,
+			expectsErr: nil,
+		},
+		{
+			name: "explicit zero-fee commitments missing remote " +
+				"support",
+			channelFeatures: lnwire.NewRawFeatureVector(
+				lnwire.ZeroFeeCommitmentsRequired,
+			),
+			localFeatures: lnwire.NewRawFeatureVector(
+				lnwire.ZeroFeeCommitmentsOptional,
+				lnwire.ExplicitChannelTypeOptional,
+			),
+			remoteFeatures: lnwire.NewRawFeatureVector(
+				lnwire.AnchorsZeroFeeHtlcTxOptional,
+				lnwire.ExplicitChannelTypeOptional,
+			),
+			expectsErr: errUnsupportedChannelType,
+		},
 
 		// Test cases for implicit negotiation ignoring taproot feature
 		// bits. Taproot channels require an explicit channel type.
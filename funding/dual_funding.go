@@ -0,0 +1,1679 @@
+package funding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/v2"
+	"github.com/btcsuite/btcd/psbt/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/liquidityads"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
+	"github.com/lightningnetwork/lnd/lnwallet/interactivetx"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// The dual funding flow follows the v2 channel establishment protocol: the
+// peers exchange open_channel2 and accept_channel2, construct the funding
+// transaction together through tx_add_input, tx_add_output and tx_complete,
+// exchange signatures for their initial commitments through funding_created
+// and funding_signed, and finally exchange the witnesses of their inputs
+// through tx_signatures. Once accept_channel2 is exchanged, all messages
+// reference the channel by the v2 channel ID derived from the revocation
+// basepoints of both peers, which stays the same if the funding transaction is
+// replaced. Replacement transactions keep the contributions of both peers, so
+// the initial commitments only need to be re-signed for the new funding
+// outpoint.
+
+var (
+	// errFundingCandidateReplaced is returned when we stop waiting for the
+	// funding transaction of a dual-funded channel because a replacement
+	// of it confirmed.
+	errFundingCandidateReplaced = errors.New("funding transaction was " +
+		"replaced by a confirmed replacement")
+)
+
+// dualFundingState describes the progress of an interactively constructed
+// funding transaction.
+type dualFundingState uint8
+
+const (
+	// dualFundConstructing is the state while the funding transaction is
+	// being constructed.
+	dualFundConstructing dualFundingState = iota
+
+	// dualFundCommitting is the state once the funding transaction is
+	// constructed, but the initial commitments aren't signed yet.
+	dualFundCommitting
+
+	// dualFundSigning is the state once the initial commitments are
+	// signed and the witnesses of the funding transaction are exchanged.
+	dualFundSigning
+
+	// dualFundPublished is the state once the funding transaction was
+	// broadcast. It can still be replaced until it confirms.
+	dualFundPublished
+)
+
+// rbfAttempt tracks the construction of a transaction that replaces the
+// funding transaction of a dual-funded channel.
+type rbfAttempt struct {
+	// feeRate is the fee rate of the replacement.
+	feeRate chainfee.SatPerKWeight
+
+	// lockTime is the nLockTime of the replacement.
+	lockTime uint32
+
+	// contribution is our contribution at the new fee rate.
+	contribution *lnwallet.InteractiveContribution
+
+	// session is the construction session of the replacement. It is nil
+	// until both peers agreed to construct a replacement.
+	session *interactivetx.Session
+
+	// candidate is the channel state spending the replacement, which is
+	// set once the replacement is constructed.
+	candidate *channeldb.OpenChannel
+
+	// result is used to report the outcome of a replacement we initiated.
+	result chan fn.Result[wire.OutPoint]
+}
+
+// dualFundingCtx tracks a channel whose funding transaction is constructed
+// interactively with the remote peer.
+type dualFundingCtx struct {
+	peer lnpeer.Peer
+
+	// pendingChanID is the temporary channel ID of the channel.
+	pendingChanID PendingChanID
+
+	// channelID is the v2 channel ID of the channel, which is derived
+	// from the revocation basepoints of both peers once accept_channel2
+	// is exchanged.
+	channelID lnwire.ChannelID
+
+	// initiator is true if we opened the channel.
+	initiator bool
+
+	// localAmt and remoteAmt are the amounts both peers contribute to
+	// the funding output.
+	localAmt  btcutil.Amount
+	remoteAmt btcutil.Amount
+
+	// feeRate and lockTime are the fee rate and nLockTime of the current
+	// funding transaction.
+	feeRate  chainfee.SatPerKWeight
+	lockTime uint32
+
+	// dustLimit is the smallest output we accept in the transaction.
+	dustLimit btcutil.Amount
+
+	// requestFunds is the lease of inbound liquidity we request from the
+	// peer, and leaseRates are the rates the peer advertises for it.
+	requestFunds *lnwire.RequestFunds
+	leaseRates   *lnwire.LeaseRates
+
+	// lease is the lease of inbound liquidity the channel is funded with,
+	// if any. The seller's funds are locked in the channel until the lease
+	// expires.
+	lease *liquidityads.Lease
+
+	// willFund commits us to the lease we sell to the peer.
+	willFund *lnwire.WillFund
+
+	// contribution is our contribution to the current funding
+	// transaction.
+	contribution *lnwallet.InteractiveContribution
+
+	// session is the construction session of the current funding
+	// transaction.
+	session *interactivetx.Session
+
+	// psbtIntent is the funding intent of the reservation if we're the
+	// initiator. It is fed with the constructed transaction.
+	psbtIntent *chanfunding.PsbtIntent
+
+	// fundingTx is the current funding transaction once constructed.
+	fundingTx *wire.MsgTx
+
+	state dualFundingState
+
+	sentSigs     bool
+	receivedSigs bool
+
+	// channel is the pending channel spending the current funding
+	// transaction, which is set once the initial commitments are signed.
+	channel *channeldb.OpenChannel
+
+	// rbf is the replacement that is currently being constructed, if any.
+	rbf *rbfAttempt
+
+	// bumpResult is used to report the outcome of a replacement we
+	// initiated once it's published.
+	bumpResult chan fn.Result[wire.OutPoint]
+}
+
+// fundingPoint returns the outpoint of the funding output of the constructed
+// funding transaction.
+func (c *dualFundingCtx) fundingPoint() (wire.OutPoint, error) {
+	if c.fundingTx == nil {
+		return wire.OutPoint{}, errors.New("funding transaction not " +
+			"constructed")
+	}
+
+	index, err := c.session.SharedOutputIndex()
+	if err != nil {
+		return wire.OutPoint{}, err
+	}
+
+	return wire.OutPoint{Hash: c.fundingTx.TxHash(), Index: index}, nil
+}
+
+// activeSession returns the construction session that is currently running,
+// if any.
+func (c *dualFundingCtx) activeSession() *interactivetx.Session {
+	switch {
+	case c.rbf != nil:
+		return c.rbf.session
+
+	case c.state == dualFundConstructing:
+		return c.session
+
+	default:
+		return nil
+	}
+}
+
+// bumpFundingRequest is a request to replace the funding transaction of a
+// dual-funded channel with one that pays a higher fee rate.
+type bumpFundingRequest struct {
+	chanPoint wire.OutPoint
+	feeRate   chainfee.SatPerKWeight
+	result    chan fn.Result[wire.OutPoint]
+}
+
+// dualFundReserve returns the channel reserve both peers of a dual-funded
+// channel require, which is one percent of the capacity but at least the
+// given dust limit.
+func dualFundReserve(capacity, dustLimit btcutil.Amount) btcutil.Amount {
+	reserve := capacity / 100
+	if reserve < dustLimit {
+		return dustLimit
+	}
+
+	return reserve
+}
+
+// fundingPkScript returns the script of a funding output spendable by the
+// given multisig keys.
+func fundingPkScript(localKey, remoteKey *btcec.PublicKey,
+	capacity btcutil.Amount) ([]byte, error) {
+
+	_, out, err := input.GenFundingPkScript(
+		localKey.SerializeCompressed(), remoteKey.SerializeCompressed(),
+		int64(capacity),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.PkScript, nil
+}
+
+// secondCommitmentPoint returns our commitment point for the second state of
+// the channel.
+func secondCommitmentPoint(c *channeldb.OpenChannel) (*btcec.PublicKey,
+	error) {
+
+	preimage, err := c.RevocationProducer.AtIndex(1)
+	if err != nil {
+		return nil, err
+	}
+
+	return input.ComputeCommitmentPoint(preimage[:]), nil
+}
+
+// prepareDualFunding checks that a funding request can be carried out as a
+// dual-funded channel and sets up the funding intent that receives the
+// interactively constructed transaction.
+func (f *Manager) prepareDualFunding(msg *InitFundingMsg) error {
+	if !hasFeatures(
+		msg.Peer.LocalFeatures(), msg.Peer.RemoteFeatures(),
+		lnwire.DualFundOptional,
+	) {
+
+		return errors.New("peer doesn't support dual-funded channels")
+	}
+
+	switch {
+	case msg.PushAmt != 0:
+		return errors.New("push amount can't be used with dual " +
+			"funding")
+
+	case msg.ChanFunder != nil:
+		return errors.New("funding shims can't be used with dual " +
+			"funding")
+
+	case msg.SubtractFees || msg.FundUpToMaxAmt != 0 ||
+		len(msg.Outpoints) != 0:
+
+		return errors.New("coin selection options can't be used " +
+			"with dual funding")
+
+	case msg.LeaseExpiry != 0:
+		return errors.New("the lease expiry of a dual-funded " +
+			"channel follows from its lease request")
+
+	case msg.RequestFunds != nil && msg.LeaseRates == nil:
+		return errors.New("lease requested without the rates of " +
+			"the seller")
+	}
+
+	var isLease bool
+	if msg.ChannelType != nil {
+		chanType := lnwire.RawFeatureVector(*msg.ChannelType)
+		if chanType.IsSet(lnwire.ZeroConfRequired) ||
+			chanType.IsSet(lnwire.SimpleTaprootChannelsRequiredStaging) ||
+			chanType.IsSet(lnwire.SimpleTaprootChannelsRequiredFinal) {
+
+			return errors.New("zero-conf and taproot channels " +
+				"can't be dual funded")
+		}
+
+		isLease = chanType.IsSet(lnwire.ScriptEnforcedLeaseRequired)
+	}
+
+	// A dual-funded channel is leased if and only if we request inbound
+	// liquidity from the peer. Our reservation commits to the lease expiry
+	// the peer has to sign for.
+	if isLease != (msg.RequestFunds != nil) {
+		return errors.New("a dual-funded channel must use the " +
+			"script enforced lease channel type if and only if " +
+			"liquidity is leased")
+	}
+	if isLease {
+		msg.LeaseExpiry = msg.RequestFunds.BlockHeight +
+			liquidityads.LeaseDuration
+	}
+
+	msg.ChanFunder = chanfunding.NewPsbtAssembler(
+		msg.LocalFundingAmt, nil, &f.cfg.Wallet.Cfg.NetParams, false,
+	)
+
+	return nil
+}
+
+// registerDualFunding derives the v2 channel ID of a dual-funded channel from
+// the revocation basepoints of both peers, and makes the construction of its
+// funding transaction reachable through it.
+func (f *Manager) registerDualFunding(dualFund *dualFundingCtx,
+	localBasepoint, remoteBasepoint *btcec.PublicKey) error {
+
+	chanID := lnwire.NewChanIDFromRevocationBasepoints(
+		localBasepoint, remoteBasepoint,
+	)
+
+	f.resMtx.Lock()
+	defer f.resMtx.Unlock()
+
+	if _, ok := f.dualFundTxs[chanID]; ok {
+		return fmt.Errorf("channel %v already exists", chanID)
+	}
+	dualFund.channelID = chanID
+	f.dualFundTxs[chanID] = dualFund
+
+	return nil
+}
+
+// newOpenChannel2 selects the coins of our contribution and converts the
+// open_channel message of a dual-funded reservation into open_channel2.
+func (f *Manager) newOpenChannel2(msg *InitFundingMsg,
+	resCtx *reservationWithCtx,
+	open *lnwire.OpenChannel) (*lnwire.OpenChannel2, error) {
+
+	dualFund := resCtx.dualFund
+
+	contribution, err := f.cfg.Wallet.FundInteractiveContribution(
+		dualFund.localAmt, dualFund.feeRate, msg.MinConfs, true,
+		f.allowUtxoForFunding,
+	)
+	if err != nil {
+		return nil, err
+	}
+	dualFund.contribution = contribution
+
+	_, height, err := f.cfg.Wallet.Cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+	dualFund.lockTime = uint32(height)
+
+	secondPoint, err := secondCommitmentPoint(
+		resCtx.reservation.ChanState(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnwire.OpenChannel2{
+		ChainHash:             open.ChainHash,
+		PendingChannelID:      open.PendingChannelID,
+		FundingFeePerKw:       uint32(dualFund.feeRate),
+		CommitFeePerKw:        open.FeePerKiloWeight,
+		FundingAmount:         open.FundingAmount,
+		DustLimit:             open.DustLimit,
+		MaxValueInFlight:      open.MaxValueInFlight,
+		HtlcMinimum:           open.HtlcMinimum,
+		CsvDelay:              open.CsvDelay,
+		MaxAcceptedHTLCs:      open.MaxAcceptedHTLCs,
+		LockTime:              dualFund.lockTime,
+		FundingKey:            open.FundingKey,
+		RevocationPoint:       open.RevocationPoint,
+		PaymentPoint:          open.PaymentPoint,
+		DelayedPaymentPoint:   open.DelayedPaymentPoint,
+		HtlcPoint:             open.HtlcPoint,
+		FirstCommitmentPoint:  open.FirstCommitmentPoint,
+		SecondCommitmentPoint: secondPoint,
+		ChannelFlags:          open.ChannelFlags,
+		UpfrontShutdownScript: open.UpfrontShutdownScript,
+		ChannelType:           open.ChannelType,
+		RequestFunds:          dualFund.requestFunds,
+	}, nil
+}
+
+// fundeeProcessOpenChannel2 processes a request to open a dual-funded channel.
+// The request is handled like a single-funded one, with the channel acceptor
+// deciding how much we contribute.
+func (f *Manager) fundeeProcessOpenChannel2(peer lnpeer.Peer,
+	msg *lnwire.OpenChannel2) {
+
+	cid := newChanIdentifier(msg.PendingChannelID)
+
+	if !hasFeatures(
+		peer.LocalFeatures(), peer.RemoteFeatures(),
+		lnwire.DualFundOptional,
+	) {
+
+		err := errors.New("dual-funded channels not supported")
+		f.failFundingFlow(peer, cid, err)
+
+		return
+	}
+
+	feeRate := chainfee.SatPerKWeight(msg.FundingFeePerKw)
+	if feeRate < chainfee.FeePerKwFloor {
+		err := fmt.Errorf("funding fee rate %v below minimum %v",
+			feeRate, chainfee.FeePerKwFloor)
+		f.failFundingFlow(peer, cid, err)
+
+		return
+	}
+
+	open := &lnwire.OpenChannel{
+		ChainHash:             msg.ChainHash,
+		PendingChannelID:      msg.PendingChannelID,
+		FundingAmount:         msg.FundingAmount,
+		DustLimit:             msg.DustLimit,
+		MaxValueInFlight:      msg.MaxValueInFlight,
+		HtlcMinimum:           msg.HtlcMinimum,
+		FeePerKiloWeight:      msg.CommitFeePerKw,
+		CsvDelay:              msg.CsvDelay,
+		MaxAcceptedHTLCs:      msg.MaxAcceptedHTLCs,
+		FundingKey:            msg.FundingKey,
+		RevocationPoint:       msg.RevocationPoint,
+		PaymentPoint:          msg.PaymentPoint,
+		DelayedPaymentPoint:   msg.DelayedPaymentPoint,
+		HtlcPoint:             msg.HtlcPoint,
+		FirstCommitmentPoint:  msg.FirstCommitmentPoint,
+		ChannelFlags:          msg.ChannelFlags,
+		UpfrontShutdownScript: msg.UpfrontShutdownScript,
+		ChannelType:           msg.ChannelType,
+	}
+
+	dualFund := &dualFundingCtx{
+		peer:          peer,
+		pendingChanID: msg.PendingChannelID,
+		remoteAmt:     msg.FundingAmount,
+		feeRate:       feeRate,
+		lockTime:      msg.LockTime,
+	}
+
+	// If the initiator requests inbound liquidity, we only accept the
+	// channel if we lease out the requested amount.
+	if msg.RequestFunds != nil {
+		err := f.sellLease(msg, dualFund)
+		if err != nil {
+			err = fmt.Errorf("unable to lease liquidity: %w", err)
+			f.failFundingFlow(peer, cid, err)
+
+			return
+		}
+
+		leaseExpiry := lnwire.LeaseExpiry(dualFund.lease.LeaseExpiry)
+		open.LeaseExpiry = &leaseExpiry
+	}
+
+	f.processOpenChannel(peer, open, dualFund)
+}
+
+// sellLease leases out the inbound liquidity requested by the initiator of a
+// dual-funded channel, and records the lease along with the will_fund record
+// that commits us to it.
+func (f *Manager) sellLease(msg *lnwire.OpenChannel2,
+	dualFund *dualFundingCtx) error {
+
+	if f.cfg.SellLease == nil {
+		return errors.New("not leasing out liquidity")
+	}
+
+	_, height, err := f.cfg.Wallet.Cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return err
+	}
+
+	lease, willFund, err := f.cfg.SellLease(
+		msg.RequestFunds, msg.FundingKey, dualFund.feeRate,
+		uint32(height),
+	)
+	if err != nil {
+		return err
+	}
+
+	// The initiator pays the lease fee out of its contribution.
+	if lease.LeaseFee >= msg.FundingAmount {
+		return fmt.Errorf("lease fee of %v exceeds funding amount "+
+			"of %v", lease.LeaseFee, msg.FundingAmount)
+	}
+
+	dualFund.lease = lease
+	dualFund.willFund = willFund
+
+	return nil
+}
+
+// sendAcceptChannel2 selects the coins of our contribution, responds to a
+// dual-funded channel request with accept_channel2 and prepares the
+// construction of the funding transaction.
+func (f *Manager) sendAcceptChannel2(peer lnpeer.Peer,
+	resCtx *reservationWithCtx, accept *lnwire.AcceptChannel) error {
+
+	dualFund := resCtx.dualFund
+	dualFund.dustLimit = accept.DustLimit
+
+	dualFund.contribution = &lnwallet.InteractiveContribution{}
+	if dualFund.localAmt > 0 {
+		contribution, err := f.cfg.Wallet.FundInteractiveContribution(
+			dualFund.localAmt, dualFund.feeRate, 1, false,
+			f.allowUtxoForFunding,
+		)
+		if err != nil {
+			return err
+		}
+		dualFund.contribution = contribution
+	}
+
+	chanState := resCtx.reservation.ChanState()
+	secondPoint, err := secondCommitmentPoint(chanState)
+	if err != nil {
+		return err
+	}
+
+	theirContribution := resCtx.reservation.TheirContribution()
+	err = f.registerDualFunding(
+		dualFund, accept.RevocationPoint,
+		theirContribution.RevocationBasePoint.PubKey,
+	)
+	if err != nil {
+		return err
+	}
+
+	// The remote multisig key is only added to the channel state once the
+	// initial commitments are signed.
+	script, err := fundingPkScript(
+		accept.FundingKey, theirContribution.MultiSigKey.PubKey,
+		resCtx.reservation.Capacity(),
+	)
+	if err != nil {
+		return err
+	}
+
+	dualFund.session, err = interactivetx.NewSession(
+		&interactivetx.Config{
+			ChanID:             dualFund.channelID,
+			LockTime:           dualFund.lockTime,
+			FeeRate:            dualFund.feeRate,
+			DustLimit:          dualFund.dustLimit,
+			SharedScript:       script,
+			LocalContribution:  dualFund.localAmt,
+			RemoteContribution: dualFund.remoteAmt,
+			LocalInputs:        dualFund.contribution.Inputs,
+			LocalOutputs:       dualFund.contribution.Outputs(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return peer.SendMessage(true, &lnwire.AcceptChannel2{
+		PendingChannelID:      accept.PendingChannelID,
+		FundingAmount:         dualFund.localAmt,
+		DustLimit:             accept.DustLimit,
+		MaxValueInFlight:      accept.MaxValueInFlight,
+		HtlcMinimum:           accept.HtlcMinimum,
+		MinAcceptDepth:        accept.MinAcceptDepth,
+		CsvDelay:              accept.CsvDelay,
+		MaxAcceptedHTLCs:      accept.MaxAcceptedHTLCs,
+		FundingKey:            accept.FundingKey,
+		RevocationPoint:       accept.RevocationPoint,
+		PaymentPoint:          accept.PaymentPoint,
+		DelayedPaymentPoint:   accept.DelayedPaymentPoint,
+		HtlcPoint:             accept.HtlcPoint,
+		FirstCommitmentPoint:  accept.FirstCommitmentPoint,
+		SecondCommitmentPoint: secondPoint,
+		UpfrontShutdownScript: accept.UpfrontShutdownScript,
+		ChannelType:           accept.ChannelType,
+		WillFund:              dualFund.willFund,
+	})
+}
+
+// funderProcessAcceptChannel2 processes the response to our request to open a
+// dual-funded channel. The remote contribution is added to the reservation,
+// after which the response is handled like a single-funded one.
+func (f *Manager) funderProcessAcceptChannel2(peer lnpeer.Peer,
+	msg *lnwire.AcceptChannel2) {
+
+	resCtx, err := f.getReservationCtx(
+		peer.IdentityKey(), msg.PendingChannelID,
+	)
+	if err != nil {
+		log.Warnf("Can't find reservation (peerKey:%x, chan_id:%v)",
+			peer.IdentityKey().SerializeCompressed(),
+			msg.PendingChannelID)
+
+		return
+	}
+
+	cid := newChanIdentifier(msg.PendingChannelID)
+	if resCtx.dualFund == nil ||
+		resCtx.reservation.State() != lnwallet.SentOpenChannel {
+
+		err := errors.New("unexpected accept_channel2")
+		f.failFundingFlow(peer, cid, err)
+
+		return
+	}
+
+	dualFund := resCtx.dualFund
+	err = f.registerDualFunding(
+		dualFund,
+		resCtx.reservation.OurContribution().RevocationBasePoint.PubKey,
+		msg.RevocationPoint,
+	)
+	if err != nil {
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	if msg.FundingAmount > 0 {
+		err := resCtx.reservation.AddRemoteFunding(msg.FundingAmount)
+		if err != nil {
+			f.failFundingFlow(peer, cid, err)
+			return
+		}
+	}
+	dualFund.remoteAmt = msg.FundingAmount
+	resCtx.chanAmt = resCtx.reservation.Capacity()
+
+	// If we requested inbound liquidity, the peer must have committed to
+	// the lease, whose fee we pay out of our initial balance.
+	var leaseExpiry *lnwire.LeaseExpiry
+	if dualFund.requestFunds != nil {
+		if err := f.buyLease(peer, resCtx, msg); err != nil {
+			err = fmt.Errorf("unable to lease liquidity: %w", err)
+			f.failFundingFlow(peer, cid, err)
+
+			return
+		}
+
+		leaseExpiry = new(lnwire.LeaseExpiry)
+		*leaseExpiry = lnwire.LeaseExpiry(dualFund.lease.LeaseExpiry)
+	} else if msg.WillFund != nil {
+		err := errors.New("unexpected will_fund without a lease " +
+			"request")
+		f.failFundingFlow(peer, cid, err)
+
+		return
+	}
+
+	// Both peers require the same reserve, which depends on the final
+	// capacity of the channel.
+	dustLimit := resCtx.reservation.OurContribution().DustLimit
+	dualFund.dustLimit = dustLimit
+	if msg.DustLimit > dustLimit {
+		dustLimit = msg.DustLimit
+	}
+	reserve := dualFundReserve(resCtx.chanAmt, dustLimit)
+	resCtx.remoteChanReserve = reserve
+
+	f.funderProcessAcceptChannel(peer, &lnwire.AcceptChannel{
+		PendingChannelID:      msg.PendingChannelID,
+		DustLimit:             msg.DustLimit,
+		MaxValueInFlight:      msg.MaxValueInFlight,
+		ChannelReserve:        reserve,
+		HtlcMinimum:           msg.HtlcMinimum,
+		MinAcceptDepth:        msg.MinAcceptDepth,
+		CsvDelay:              msg.CsvDelay,
+		MaxAcceptedHTLCs:      msg.MaxAcceptedHTLCs,
+		FundingKey:            msg.FundingKey,
+		RevocationPoint:       msg.RevocationPoint,
+		PaymentPoint:          msg.PaymentPoint,
+		DelayedPaymentPoint:   msg.DelayedPaymentPoint,
+		HtlcPoint:             msg.HtlcPoint,
+		FirstCommitmentPoint:  msg.FirstCommitmentPoint,
+		UpfrontShutdownScript: msg.UpfrontShutdownScript,
+		ChannelType:           msg.ChannelType,
+		LeaseExpiry:           leaseExpiry,
+	})
+}
+
+// buyLease checks that the peer contributes the inbound liquidity we requested
+// and committed to lease it to us, and pays the lease fee out of our initial
+// balance.
+func (f *Manager) buyLease(peer lnpeer.Peer, resCtx *reservationWithCtx,
+	msg *lnwire.AcceptChannel2) error {
+
+	dualFund := resCtx.dualFund
+	req := dualFund.requestFunds
+
+	if msg.FundingAmount < req.RequestedSats {
+		return fmt.Errorf("peer contributes %v instead of the "+
+			"requested %v", msg.FundingAmount, req.RequestedSats)
+	}
+
+	fundingKey := resCtx.reservation.OurContribution().MultiSigKey.PubKey
+	lease, err := liquidityads.AcceptLease(
+		peer.IdentityKey(), fundingKey, req, dualFund.leaseRates,
+		dualFund.feeRate, msg.WillFund,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := resCtx.reservation.PayLeaseFee(lease.LeaseFee); err != nil {
+		return err
+	}
+	dualFund.lease = lease
+
+	log.Infof("Leasing %v from %x until height %d for a fee of %v",
+		lease.Amount, peer.IdentityKey().SerializeCompressed(),
+		lease.LeaseExpiry, lease.LeaseFee)
+
+	return nil
+}
+
+// startDualFundingConstruction starts the construction of the funding
+// transaction once the funding output of a channel we initiated is known.
+func (f *Manager) startDualFundingConstruction(resCtx *reservationWithCtx,
+	intent *chanfunding.PsbtIntent) error {
+
+	dualFund := resCtx.dualFund
+	dualFund.psbtIntent = intent
+
+	_, fundingOut, err := intent.FundingOutput()
+	if err != nil {
+		return err
+	}
+
+	dualFund.session, err = interactivetx.NewSession(
+		&interactivetx.Config{
+			ChanID:             dualFund.channelID,
+			Initiator:          true,
+			LockTime:           dualFund.lockTime,
+			FeeRate:            dualFund.feeRate,
+			DustLimit:          dualFund.dustLimit,
+			SharedScript:       fundingOut.PkScript,
+			LocalContribution:  dualFund.localAmt,
+			RemoteContribution: dualFund.remoteAmt,
+			LocalInputs:        dualFund.contribution.Inputs,
+			LocalOutputs:       dualFund.contribution.Outputs(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return f.continueConstruction(dualFund, dualFund.session)
+}
+
+// findDualFunding returns the dual funding context the given v2 channel ID
+// refers to.
+func (f *Manager) findDualFunding(peer lnpeer.Peer,
+	chanID lnwire.ChannelID) (*dualFundingCtx, error) {
+
+	f.resMtx.RLock()
+	dualFund, ok := f.dualFundTxs[chanID]
+	f.resMtx.RUnlock()
+
+	if !ok || !dualFund.peer.IdentityKey().IsEqual(peer.IdentityKey()) {
+		return nil, fmt.Errorf("unknown channel %v for peer %x",
+			chanID, peer.IdentityKey().SerializeCompressed())
+	}
+
+	// The peer may have reconnected since the funding transaction was
+	// constructed.
+	dualFund.peer = peer
+
+	return dualFund, nil
+}
+
+// handleInteractiveTxMsg processes a message of the interactive construction
+// of a funding transaction.
+func (f *Manager) handleInteractiveTxMsg(peer lnpeer.Peer,
+	chanID lnwire.ChannelID, msg lnwire.Message) {
+
+	dualFund, err := f.findDualFunding(peer, chanID)
+	if err != nil {
+		log.Warnf("Received %v for unknown funding flow: %v",
+			msg.MsgType(), err)
+
+		return
+	}
+
+	session := dualFund.activeSession()
+	if session == nil {
+		f.failDualFunding(dualFund, fmt.Errorf("unexpected %v",
+			msg.MsgType()))
+
+		return
+	}
+
+	if err := session.Receive(msg); err != nil {
+		f.failDualFunding(dualFund, err)
+		return
+	}
+
+	if err := f.continueConstruction(dualFund, session); err != nil {
+		f.failDualFunding(dualFund, err)
+	}
+}
+
+// continueConstruction sends our next construction message if it's our turn
+// and finishes the construction once both peers completed it.
+func (f *Manager) continueConstruction(dualFund *dualFundingCtx,
+	session *interactivetx.Session) error {
+
+	if session.OurTurn() {
+		msg, err := session.Next()
+		if err != nil {
+			return err
+		}
+
+		if err := dualFund.peer.SendMessage(true, msg); err != nil {
+			return err
+		}
+	}
+
+	if !session.Complete() {
+		return nil
+	}
+
+	if dualFund.rbf != nil {
+		return f.signFundingCandidate(dualFund)
+	}
+
+	tx, err := session.Tx()
+	if err != nil {
+		return err
+	}
+	dualFund.fundingTx = tx
+	dualFund.state = dualFundCommitting
+
+	// The transaction is stored with the channel, so that our
+	// contribution and the inputs it spends are known after a restart.
+	resCtx, err := f.getReservationCtx(
+		dualFund.peer.IdentityKey(), dualFund.pendingChanID,
+	)
+	if err != nil {
+		return err
+	}
+	resCtx.reservation.SetInteractiveFundingTx(tx)
+
+	log.Infof("Constructed funding tx %v for pending_id(%x)",
+		tx.TxHash(), dualFund.pendingChanID[:])
+
+	// The responder waits for funding_created, the initiator hands the
+	// transaction to the funding intent, which continues the flow with
+	// the funding outpoint known.
+	if !dualFund.initiator {
+		return nil
+	}
+
+	packet, err := psbt.NewFromUnsignedTx(tx.Copy())
+	if err != nil {
+		return err
+	}
+	for i, in := range session.Inputs() {
+		packet.Inputs[i].WitnessUtxo = in.PrevOut()
+	}
+
+	return dualFund.psbtIntent.Verify(packet, true)
+}
+
+// verifyDualFundingPoint checks that the funding outpoint the initiator sent
+// in funding_created spends the constructed funding transaction.
+func verifyDualFundingPoint(dualFund *dualFundingCtx,
+	fundingPoint wire.OutPoint) error {
+
+	if dualFund.state != dualFundCommitting {
+		return errors.New("funding transaction not constructed")
+	}
+
+	expected, err := dualFund.fundingPoint()
+	if err != nil {
+		return err
+	}
+	if fundingPoint != expected {
+		return fmt.Errorf("funding outpoint %v doesn't match "+
+			"constructed funding outpoint %v", fundingPoint,
+			expected)
+	}
+
+	return nil
+}
+
+// dualFundingCommitted continues the dual funding flow once the initial
+// commitments are signed by exchanging the witnesses of the funding
+// transaction.
+func (f *Manager) dualFundingCommitted(dualFund *dualFundingCtx,
+	channel *channeldb.OpenChannel) {
+
+	dualFund.channel = channel
+	dualFund.state = dualFundSigning
+
+	// The peer references the channel by its v2 channel ID in
+	// channel_ready, which we can only process once our local state of
+	// the confirmed funding transaction is in place.
+	f.localDiscoverySignals.LoadOrStore(
+		dualFund.channelID, make(chan struct{}),
+	)
+
+	if !f.sendsSignaturesFirst(dualFund) {
+		return
+	}
+
+	if err := f.sendTxSignatures(dualFund); err != nil {
+		f.failDualFunding(dualFund, err)
+	}
+}
+
+// sendsSignaturesFirst returns true if we need to send our tx_signatures
+// before the remote peer.
+func (f *Manager) sendsSignaturesFirst(dualFund *dualFundingCtx) bool {
+	localIn, remoteIn := dualFund.session.InputAmounts()
+
+	return interactivetx.SendsSignaturesFirst(
+		localIn, remoteIn, f.cfg.IDKey, dualFund.peer.IdentityKey(),
+	)
+}
+
+// sendTxSignatures signs our inputs of the funding transaction and sends their
+// witnesses to the remote peer.
+func (f *Manager) sendTxSignatures(dualFund *dualFundingCtx) error {
+	tx := dualFund.fundingTx
+	err := f.cfg.Wallet.SignInteractiveTx(tx, dualFund.session)
+	if err != nil {
+		return err
+	}
+
+	witnesses, err := dualFund.session.LocalWitnesses(tx)
+	if err != nil {
+		return err
+	}
+
+	err = dualFund.peer.SendMessage(true, &lnwire.TxSignatures{
+		ChanID:    dualFund.channelID,
+		TxID:      tx.TxHash(),
+		Witnesses: witnesses,
+	})
+	if err != nil {
+		return err
+	}
+	dualFund.sentSigs = true
+
+	return f.maybePublishDualFunding(dualFund)
+}
+
+// handleTxSignatures processes the witnesses the remote peer sent for its
+// inputs of the funding transaction.
+func (f *Manager) handleTxSignatures(peer lnpeer.Peer,
+	msg *lnwire.TxSignatures) {
+
+	dualFund, err := f.findDualFunding(peer, msg.ChanID)
+	if err != nil {
+		log.Warnf("Received tx_signatures for unknown funding "+
+			"flow: %v", err)
+
+		return
+	}
+
+	if dualFund.state != dualFundSigning || dualFund.receivedSigs {
+		f.failDualFunding(dualFund, errors.New("unexpected "+
+			"tx_signatures"))
+
+		return
+	}
+
+	tx := dualFund.fundingTx
+	if msg.TxID != tx.TxHash() {
+		f.failDualFunding(dualFund, fmt.Errorf("tx_signatures for "+
+			"unknown transaction %v", msg.TxID))
+
+		return
+	}
+
+	err = dualFund.session.AddRemoteWitnesses(tx, msg.Witnesses)
+	if err != nil {
+		f.failDualFunding(dualFund, err)
+		return
+	}
+	dualFund.receivedSigs = true
+
+	if !dualFund.sentSigs {
+		err = f.sendTxSignatures(dualFund)
+	} else {
+		err = f.maybePublishDualFunding(dualFund)
+	}
+	if err != nil {
+		f.failDualFunding(dualFund, err)
+	}
+}
+
+// maybePublishDualFunding broadcasts the funding transaction once both peers
+// exchanged their witnesses.
+func (f *Manager) maybePublishDualFunding(dualFund *dualFundingCtx) error {
+	if !dualFund.sentSigs || !dualFund.receivedSigs {
+		return nil
+	}
+
+	tx := dualFund.fundingTx
+	log.Infof("Broadcasting dual funded tx %v for ChannelPoint(%v)",
+		tx.TxHash(), dualFund.channel.FundingOutpoint)
+
+	// Like for single-funded channels, we keep watching the channel if
+	// the broadcast fails, in case the transaction made it to the
+	// network.
+	label := labels.MakeLabel(labels.LabelTypeChannelOpen, nil)
+	if err := f.cfg.PublishTransaction(tx, label); err != nil {
+		log.Errorf("Unable to broadcast funding tx %v for "+
+			"ChannelPoint(%v): %v", tx.TxHash(),
+			dualFund.channel.FundingOutpoint, err)
+	}
+	dualFund.state = dualFundPublished
+
+	if dualFund.bumpResult != nil {
+		dualFund.bumpResult <- fn.Ok(dualFund.channel.FundingOutpoint)
+		dualFund.bumpResult = nil
+	}
+
+	return nil
+}
+
+// BumpFundingFee replaces the funding transaction of a pending dual-funded
+// channel we initiated with one that pays the given fee rate. The outpoint of
+// the replacement is returned once it's published. Both funding transactions
+// stay valid until one of them confirms.
+func (f *Manager) BumpFundingFee(ctx context.Context, chanPoint wire.OutPoint,
+	feeRate chainfee.SatPerKWeight) (wire.OutPoint, error) {
+
+	req := &bumpFundingRequest{
+		chanPoint: chanPoint,
+		feeRate:   feeRate,
+		result:    make(chan fn.Result[wire.OutPoint], 1),
+	}
+
+	select {
+	case f.bumpFundingRequests <- req:
+	case <-ctx.Done():
+		return wire.OutPoint{}, ctx.Err()
+	case <-f.quit:
+		return wire.OutPoint{}, ErrFundingManagerShuttingDown
+	}
+
+	select {
+	case res := <-req.result:
+		return res.Unpack()
+	case <-ctx.Done():
+		return wire.OutPoint{}, ctx.Err()
+	case <-f.quit:
+		return wire.OutPoint{}, ErrFundingManagerShuttingDown
+	}
+}
+
+// handleBumpFundingFee starts the construction of a replacement funding
+// transaction by sending tx_init_rbf.
+func (f *Manager) handleBumpFundingFee(req *bumpFundingRequest) {
+	var (
+		dualFund *dualFundingCtx
+		ok       bool
+	)
+	f.resMtx.RLock()
+	for _, d := range f.dualFundTxs {
+		if d.channel != nil &&
+			d.channel.FundingOutpoint == req.chanPoint {
+
+			dualFund, ok = d, true
+			break
+		}
+	}
+	f.resMtx.RUnlock()
+
+	var err error
+	switch {
+	case !ok:
+		err = fmt.Errorf("no pending dual-funded channel %v",
+			req.chanPoint)
+
+	case !dualFund.initiator:
+		err = errors.New("only the initiator can replace the " +
+			"funding transaction")
+
+	case dualFund.state != dualFundPublished || dualFund.rbf != nil:
+		err = errors.New("funding transaction is still being " +
+			"negotiated")
+
+	default:
+		err = interactivetx.ValidateRbfFeeRate(
+			dualFund.feeRate, req.feeRate,
+		)
+	}
+	if err != nil {
+		req.result <- fn.Err[wire.OutPoint](err)
+		return
+	}
+
+	contribution := dualFund.contribution.Copy()
+	if err := contribution.UpdateFeeRate(req.feeRate, true); err != nil {
+		req.result <- fn.Err[wire.OutPoint](err)
+		return
+	}
+
+	dualFund.rbf = &rbfAttempt{
+		feeRate:      req.feeRate,
+		lockTime:     dualFund.lockTime,
+		contribution: contribution,
+		result:       req.result,
+	}
+
+	err = dualFund.peer.SendMessage(true, &lnwire.TxInitRbf{
+		ChanID:              dualFund.channelID,
+		LockTime:            dualFund.lockTime,
+		FeeRate:             uint32(req.feeRate),
+		FundingContribution: newFundingContribution(dualFund.localAmt),
+	})
+	if err != nil {
+		f.abortRbf(dualFund, err, false)
+	}
+}
+
+// newFundingContribution creates the funding contribution record of
+// tx_init_rbf and tx_ack_rbf.
+func newFundingContribution(
+	amt btcutil.Amount) lnwire.FundingContributionRecord {
+
+	return tlv.SomeRecordT(
+		tlv.NewPrimitiveRecord[tlv.TlvType0](uint64(amt)),
+	)
+}
+
+// checkFundingContribution makes sure the remote peer keeps its contribution
+// for a replacement, as we don't support changing the capacity of a channel
+// while replacing its funding transaction.
+func checkFundingContribution(dualFund *dualFundingCtx,
+	record lnwire.FundingContributionRecord) error {
+
+	amt := record.UnwrapOr(
+		tlv.NewPrimitiveRecord[tlv.TlvType0](
+			uint64(dualFund.remoteAmt),
+		),
+	).Val
+	if btcutil.Amount(amt) != dualFund.remoteAmt {
+		return fmt.Errorf("changing the funding contribution from "+
+			"%v to %v is not supported", dualFund.remoteAmt,
+			btcutil.Amount(amt))
+	}
+
+	return nil
+}
+
+// handleTxInitRbf processes the request of the initiator to replace the
+// funding transaction of a pending dual-funded channel.
+func (f *Manager) handleTxInitRbf(peer lnpeer.Peer, msg *lnwire.TxInitRbf) {
+	dualFund, err := f.findDualFunding(peer, msg.ChanID)
+	if err != nil {
+		log.Warnf("Received tx_init_rbf for unknown funding flow: %v",
+			err)
+
+		return
+	}
+
+	feeRate := chainfee.SatPerKWeight(msg.FeeRate)
+	switch {
+	case dualFund.initiator:
+		err = errors.New("only the initiator can replace the " +
+			"funding transaction")
+
+	case dualFund.state != dualFundPublished || dualFund.rbf != nil:
+		err = errors.New("funding transaction is still being " +
+			"negotiated")
+
+	default:
+		err = interactivetx.ValidateRbfFeeRate(dualFund.feeRate, feeRate)
+	}
+	if err == nil {
+		err = checkFundingContribution(
+			dualFund, msg.FundingContribution,
+		)
+	}
+
+	var contribution *lnwallet.InteractiveContribution
+	if err == nil {
+		contribution = dualFund.contribution.Copy()
+		err = contribution.UpdateFeeRate(feeRate, false)
+	}
+	if err != nil {
+		log.Warnf("Rejecting replacement of funding tx for "+
+			"ChannelPoint(%v): %v",
+			dualFund.channel.FundingOutpoint, err)
+		f.sendTxAbort(peer, msg.ChanID, err)
+
+		return
+	}
+
+	dualFund.rbf = &rbfAttempt{
+		feeRate:      feeRate,
+		lockTime:     msg.LockTime,
+		contribution: contribution,
+	}
+
+	err = peer.SendMessage(true, &lnwire.TxAckRbf{
+		ChanID:              msg.ChanID,
+		FundingContribution: newFundingContribution(dualFund.localAmt),
+	})
+	if err == nil {
+		err = f.startRbfConstruction(dualFund)
+	}
+	if err != nil {
+		f.failDualFunding(dualFund, err)
+	}
+}
+
+// handleTxAckRbf processes the acceptance of our request to replace the
+// funding transaction of a pending dual-funded channel.
+func (f *Manager) handleTxAckRbf(peer lnpeer.Peer, msg *lnwire.TxAckRbf) {
+	dualFund, err := f.findDualFunding(peer, msg.ChanID)
+	if err != nil {
+		log.Warnf("Received tx_ack_rbf for unknown funding flow: %v",
+			err)
+
+		return
+	}
+
+	if !dualFund.initiator || dualFund.rbf == nil ||
+		dualFund.rbf.session != nil {
+
+		f.failDualFunding(dualFund, errors.New("unexpected "+
+			"tx_ack_rbf"))
+
+		return
+	}
+
+	err = checkFundingContribution(dualFund, msg.FundingContribution)
+	if err == nil {
+		err = f.startRbfConstruction(dualFund)
+	}
+	if err != nil {
+		f.failDualFunding(dualFund, err)
+	}
+}
+
+// startRbfConstruction starts the construction of a replacement funding
+// transaction.
+func (f *Manager) startRbfConstruction(dualFund *dualFundingCtx) error {
+	rbf := dualFund.rbf
+
+	channel := dualFund.channel
+	script, err := fundingPkScript(
+		channel.LocalChanCfg.MultiSigKey.PubKey,
+		channel.RemoteChanCfg.MultiSigKey.PubKey, channel.Capacity,
+	)
+	if err != nil {
+		return err
+	}
+
+	rbf.session, err = interactivetx.NewSession(&interactivetx.Config{
+		ChanID:             dualFund.channelID,
+		Initiator:          dualFund.initiator,
+		LockTime:           rbf.lockTime,
+		FeeRate:            rbf.feeRate,
+		DustLimit:          dualFund.dustLimit,
+		SharedScript:       script,
+		LocalContribution:  dualFund.localAmt,
+		RemoteContribution: dualFund.remoteAmt,
+		LocalInputs:        rbf.contribution.Inputs,
+		LocalOutputs:       rbf.contribution.Outputs(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return f.continueConstruction(dualFund, rbf.session)
+}
+
+// signFundingCandidate signs the remote commitment spending a constructed
+// replacement funding transaction and sends the signature in funding_signed.
+func (f *Manager) signFundingCandidate(dualFund *dualFundingCtx) error {
+	rbf := dualFund.rbf
+
+	tx, err := rbf.session.Tx()
+	if err != nil {
+		return err
+	}
+	index, err := rbf.session.SharedOutputIndex()
+	if err != nil {
+		return err
+	}
+	fundingPoint := wire.OutPoint{Hash: tx.TxHash(), Index: index}
+
+	candidate, sig, err := f.cfg.Wallet.NewFundingCandidate(
+		dualFund.channel, fundingPoint,
+	)
+	if err != nil {
+		return err
+	}
+	candidate.FundingTxn = tx
+	rbf.candidate = candidate
+
+	commitSig, err := lnwire.NewSigFromSignature(sig)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Constructed replacement funding tx %v for "+
+		"ChannelPoint(%v)", tx.TxHash(),
+		dualFund.channel.FundingOutpoint)
+
+	return dualFund.peer.SendMessage(true, &lnwire.FundingSigned{
+		ChanID:    dualFund.channelID,
+		CommitSig: commitSig,
+	})
+}
+
+// isFundingCandidate returns true if the channel ID refers to a dual-funded
+// channel whose replacement funding transaction we're waiting for the
+// commitment signature of.
+func (f *Manager) isFundingCandidate(chanID lnwire.ChannelID) bool {
+	f.resMtx.RLock()
+	defer f.resMtx.RUnlock()
+
+	dualFund, ok := f.dualFundTxs[chanID]
+
+	return ok && dualFund.rbf != nil && dualFund.rbf.candidate != nil
+}
+
+// localFundingSigned returns the funding_signed message for the initial
+// commitments of a dual-funded channel with the channel ID derived from the
+// funding outpoint, which the single-funded flow continues with. Other
+// messages are returned unchanged.
+func (f *Manager) localFundingSigned(
+	msg *lnwire.FundingSigned) *lnwire.FundingSigned {
+
+	f.resMtx.RLock()
+	dualFund, ok := f.dualFundTxs[msg.ChanID]
+	f.resMtx.RUnlock()
+
+	if !ok || dualFund.state != dualFundCommitting {
+		return msg
+	}
+
+	fundingPoint, err := dualFund.fundingPoint()
+	if err != nil {
+		return msg
+	}
+
+	localMsg := *msg
+	localMsg.ChanID = lnwire.NewChanIDFromOutPoint(fundingPoint)
+
+	return &localMsg
+}
+
+// handleCandidateFundingSigned processes the remote signature of our
+// commitment spending a replacement funding transaction. Once verified, the
+// replacement is stored as an additional pending channel and its witnesses
+// are exchanged.
+func (f *Manager) handleCandidateFundingSigned(peer lnpeer.Peer,
+	msg *lnwire.FundingSigned) {
+
+	dualFund, err := f.findDualFunding(peer, msg.ChanID)
+	if err != nil {
+		log.Warnf("Received funding_signed for unknown funding "+
+			"flow: %v", err)
+
+		return
+	}
+	rbf := dualFund.rbf
+
+	commitSig, err := msg.CommitSig.ToSignature()
+	if err != nil {
+		f.failDualFunding(dualFund, err)
+		return
+	}
+
+	_, height, err := f.cfg.Wallet.Cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		f.failDualFunding(dualFund, err)
+		return
+	}
+
+	err = f.cfg.Wallet.CompleteFundingCandidate(
+		rbf.candidate, commitSig, peer.Address(), uint32(height),
+	)
+	if err != nil {
+		f.failDualFunding(dualFund, err)
+		return
+	}
+
+	f.registerFundingCandidate(dualFund)
+
+	// From now on the replacement is the funding transaction we exchange
+	// witnesses for.
+	tx, err := rbf.session.Tx()
+	if err != nil {
+		f.failDualFunding(dualFund, err)
+		return
+	}
+
+	dualFund.rbf = nil
+	dualFund.bumpResult = rbf.result
+	dualFund.channel = rbf.candidate
+	dualFund.session = rbf.session
+	dualFund.contribution = rbf.contribution
+	dualFund.feeRate = rbf.feeRate
+	dualFund.lockTime = rbf.lockTime
+	dualFund.fundingTx = tx
+	dualFund.state = dualFundSigning
+	dualFund.sentSigs = false
+	dualFund.receivedSigs = false
+
+	if !f.sendsSignaturesFirst(dualFund) {
+		return
+	}
+
+	if err := f.sendTxSignatures(dualFund); err != nil {
+		f.failDualFunding(dualFund, err)
+	}
+}
+
+// registerFundingCandidate makes the subsystems aware of a replacement
+// funding transaction that was stored as a pending channel, and starts
+// waiting for it to confirm.
+func (f *Manager) registerFundingCandidate(dualFund *dualFundingCtx) {
+	candidate := dualFund.rbf.candidate
+	chanPoint := candidate.FundingOutpoint
+	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+
+	err := dualFund.peer.AddPendingChannel(chanID, f.quit)
+	if err != nil {
+		log.Errorf("Unable to add pending channel %v with peer %x: %v",
+			chanID, dualFund.peer.IdentityKey().SerializeCompressed(),
+			err)
+	}
+
+	f.localDiscoverySignals.Store(chanID, make(chan struct{}))
+
+	// The replacement uses the forwarding policy of the channel it
+	// replaces.
+	policy, err := f.getInitialForwardingPolicy(dualFund.channelID)
+	if err == nil {
+		err = f.saveInitialForwardingPolicy(chanID, policy)
+	}
+	if err != nil {
+		log.Errorf("Unable to store the forwarding policy: %v", err)
+	}
+
+	err = f.cfg.WatchNewChannel(candidate, dualFund.peer.IdentityKey())
+	if err != nil {
+		log.Errorf("Unable to send new ChannelPoint(%v) for "+
+			"arbitration: %v", chanPoint, err)
+	}
+
+	f.cfg.NotifyPendingOpenChannelEvent(
+		chanPoint, candidate, candidate.IdentityPub,
+	)
+
+	f.wg.Add(1)
+	go f.advanceFundingState(candidate, dualFund.pendingChanID, nil)
+}
+
+// handleTxAbort processes the request of the remote peer to abort the
+// construction of a funding transaction.
+func (f *Manager) handleTxAbort(peer lnpeer.Peer, msg *lnwire.TxAbort) {
+	dualFund, err := f.findDualFunding(peer, msg.ChanID)
+	if err != nil {
+		log.Warnf("Received tx_abort for unknown funding flow: %v",
+			err)
+
+		return
+	}
+
+	abortErr := fmt.Errorf("remote aborted funding tx construction: %v",
+		string(msg.Data))
+	log.Warn(abortErr)
+
+	switch {
+	case dualFund.rbf != nil:
+		f.abortRbf(dualFund, abortErr, true)
+
+	case dualFund.state <= dualFundCommitting:
+		resCtx, err := f.cancelReservationCtx(
+			peer.IdentityKey(), dualFund.pendingChanID, true,
+		)
+		if err != nil {
+			log.Warnf("Unable to cancel reservation: %v", err)
+			return
+		}
+		resCtx.err <- abortErr
+
+	default:
+		// The commitments are signed already, so the channel stays
+		// pending until its funding transaction either confirms or
+		// times out.
+		if dualFund.bumpResult != nil {
+			dualFund.bumpResult <- fn.Err[wire.OutPoint](abortErr)
+			dualFund.bumpResult = nil
+		}
+	}
+}
+
+// sendTxAbort sends tx_abort with the given reason to the peer.
+func (f *Manager) sendTxAbort(peer lnpeer.Peer, chanID lnwire.ChannelID,
+	reason error) {
+
+	err := peer.SendMessage(false, &lnwire.TxAbort{
+		ChanID: chanID,
+		Data:   lnwire.ErrorData(reason.Error()),
+	})
+	if err != nil {
+		log.Errorf("Unable to send tx_abort to peer %x: %v",
+			peer.IdentityKey().SerializeCompressed(), err)
+	}
+}
+
+// abortRbf aborts the construction of a replacement funding transaction. The
+// current funding transaction stays valid.
+func (f *Manager) abortRbf(dualFund *dualFundingCtx, reason error,
+	notifyPeer bool) {
+
+	rbf := dualFund.rbf
+	dualFund.rbf = nil
+
+	if notifyPeer {
+		f.sendTxAbort(dualFund.peer, dualFund.channelID, reason)
+	}
+
+	if rbf.result != nil {
+		rbf.result <- fn.Err[wire.OutPoint](reason)
+	}
+}
+
+// failDualFunding fails the dual funding flow at its current stage.
+func (f *Manager) failDualFunding(dualFund *dualFundingCtx, err error) {
+	log.Errorf("Dual funding flow for pending_id(%x) failed: %v",
+		dualFund.pendingChanID[:], err)
+
+	switch {
+	case dualFund.rbf != nil:
+		f.abortRbf(dualFund, err, true)
+
+	case dualFund.state <= dualFundCommitting:
+		f.failFundingFlow(
+			dualFund.peer, newChanIdentifier(dualFund.pendingChanID),
+			err,
+		)
+
+	default:
+		// The commitments are signed already, so the channel stays
+		// pending until its funding transaction either confirms or
+		// times out.
+		f.sendTxAbort(dualFund.peer, dualFund.channelID, err)
+
+		if dualFund.bumpResult != nil {
+			dualFund.bumpResult <- fn.Err[wire.OutPoint](err)
+			dualFund.bumpResult = nil
+		}
+	}
+}
+
+// contributedFunds returns true if we contributed to the funding transaction
+// of the given channel. As pushing funds isn't possible for dual-funded
+// channels, our initial balance is our contribution.
+func contributedFunds(c *channeldb.OpenChannel) bool {
+	return c.ChanType.HasInteractiveFunding() && c.InitialLocalBalance > 0
+}
+
+// abandonFundingSiblings abandons the pending channels whose funding
+// transaction conflicts with the confirmed funding transaction of the given
+// channel. These are the replacements of an interactively constructed funding
+// transaction, which all spend at least one common input. As the funding
+// transactions are stored with the channels, this also works for replacements
+// negotiated before a restart.
+func (f *Manager) abandonFundingSiblings(channel *channeldb.OpenChannel) {
+	if !channel.ChanType.HasInteractiveFunding() {
+		return
+	}
+
+	// None of the candidates of the funding transaction can be replaced
+	// anymore.
+	f.resMtx.Lock()
+	delete(f.dualFundTxs, channel.WireChanID())
+	f.resMtx.Unlock()
+
+	if channel.FundingTxn == nil {
+		return
+	}
+
+	spentInputs := make(map[wire.OutPoint]struct{})
+	for _, txIn := range channel.FundingTxn.TxIn {
+		spentInputs[txIn.PreviousOutPoint] = struct{}{}
+	}
+
+	pendingChans, err := f.cfg.ChannelDB.FetchPendingChannels()
+	if err != nil {
+		log.Errorf("Unable to fetch pending channels: %v", err)
+		return
+	}
+
+	for _, c := range pendingChans {
+		if c.FundingOutpoint == channel.FundingOutpoint ||
+			!c.ChanType.HasInteractiveFunding() ||
+			c.FundingTxn == nil ||
+			!c.IdentityPub.IsEqual(channel.IdentityPub) {
+
+			continue
+		}
+
+		if !spendsAnyInput(c.FundingTxn, spentInputs) {
+			continue
+		}
+
+		f.abandonFundingCandidate(c)
+	}
+}
+
+// spendsAnyInput returns true if the transaction spends any of the given
+// outpoints.
+func spendsAnyInput(tx *wire.MsgTx, outpoints map[wire.OutPoint]struct{}) bool {
+	for _, txIn := range tx.TxIn {
+		if _, ok := outpoints[txIn.PreviousOutPoint]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// abandonFundingCandidate closes a pending channel whose funding transaction
+// was replaced and stops waiting for its confirmation.
+func (f *Manager) abandonFundingCandidate(c *channeldb.OpenChannel) {
+	log.Infof("Abandoning ChannelPoint(%v), its funding tx was replaced",
+		c.FundingOutpoint)
+
+	localBalance := c.LocalCommitment.LocalBalance.ToSatoshis()
+	closeInfo := &channeldb.ChannelCloseSummary{
+		ChainHash:               c.ChainHash,
+		ChanPoint:               c.FundingOutpoint,
+		RemotePub:               c.IdentityPub,
+		Capacity:                c.Capacity,
+		SettledBalance:          localBalance,
+		CloseType:               channeldb.FundingCanceled,
+		RemoteCurrentRevocation: c.RemoteCurrentRevocation,
+		RemoteNextRevocation:    c.RemoteNextRevocation,
+		LocalChanConfig:         c.LocalChanCfg,
+	}
+	err := c.CloseChannel(closeInfo, channeldb.ChanStatusLocalCloseInitiator)
+	if err != nil {
+		log.Errorf("Failed closing ChannelPoint(%v): %v",
+			c.FundingOutpoint, err)
+
+		return
+	}
+
+	abandonSignal, _ := f.fundingAbandonSignals.LoadOrStore(
+		c.FundingOutpoint, make(chan struct{}),
+	)
+	close(abandonSignal)
+
+	chanID := lnwire.NewChanIDFromOutPoint(c.FundingOutpoint)
+	f.localDiscoverySignals.Delete(chanID)
+	if err := f.deleteInitialForwardingPolicy(chanID); err != nil {
+		log.Errorf("Unable to delete forwarding policy of "+
+			"ChannelPoint(%v): %v", c.FundingOutpoint, err)
+	}
+
+	if f.cfg.AbandonFundingCandidate != nil {
+		err := f.cfg.AbandonFundingCandidate(c.FundingOutpoint)
+		if err != nil {
+			log.Errorf("Unable to abandon ChannelPoint(%v): %v",
+				c.FundingOutpoint, err)
+		}
+	}
+}
@@ -0,0 +1,645 @@
+package funding
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil/v2"
+	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	acpt "github.com/lightningnetwork/lnd/chanacceptor"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/liquidityads"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntest/mock"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// dualFundFeeRate is the fee rate of the initial funding transaction
+	// of the dual-funded channels in these tests.
+	dualFundFeeRate = chainfee.SatPerKWeight(1000)
+)
+
+// dualFundWallet is a mock wallet controller that owns a set of coins whose
+// previous transactions can be fetched, as required to contribute them to an
+// interactively constructed transaction.
+type dualFundWallet struct {
+	*mock.WalletController
+
+	prevTxs map[chainhash.Hash]*wire.MsgTx
+}
+
+// FetchTx returns the previous transaction of one of the wallet's coins.
+func (w *dualFundWallet) FetchTx(txid chainhash.Hash) (*wire.MsgTx, error) {
+	return w.prevTxs[txid], nil
+}
+
+// contributingAcceptor accepts dual-funded channels and contributes the given
+// amount to them.
+type contributingAcceptor struct {
+	amt btcutil.Amount
+}
+
+func (c *contributingAcceptor) Accept(
+	req *acpt.ChannelAcceptRequest) *acpt.ChannelAcceptResponse {
+
+	return &acpt.ChannelAcceptResponse{
+		FundingContribution: c.amt,
+	}
+}
+
+// setupDualFundingNode enables dual funding for the given node and gives its
+// wallet a single coin of the given value. The coins are spendable by the
+// mock signer of both nodes, which signs with alice's key.
+func setupDualFundingNode(t *testing.T, node *testNode, coinID byte,
+	value btcutil.Amount) {
+
+	features := []lnwire.FeatureBit{lnwire.DualFundOptional}
+	node.localFeatures = features
+	node.remoteFeatures = features
+
+	pkScript, err := input.WitnessPubKeyHash(
+		alicePrivKey.PubKey().SerializeCompressed(),
+	)
+	require.NoError(t, err)
+
+	prevTx := wire.NewMsgTx(2)
+	prevTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{coinID}},
+	})
+	prevTx.AddTxOut(wire.NewTxOut(int64(value), pkScript))
+
+	wc := &dualFundWallet{
+		WalletController: &mock.WalletController{
+			RootKey: alicePrivKey,
+			Utxos: []*lnwallet.Utxo{{
+				AddressType:   lnwallet.WitnessPubKey,
+				Value:         value,
+				PkScript:      pkScript,
+				Confirmations: 6,
+				OutPoint: wire.OutPoint{
+					Hash: prevTx.TxHash(),
+				},
+			}},
+		},
+		prevTxs: map[chainhash.Hash]*wire.MsgTx{
+			prevTx.TxHash(): prevTx,
+		},
+	}
+
+	lnw := node.fundingMgr.cfg.Wallet
+	lnw.WalletController = wc
+	lnw.Cfg.WalletController = wc
+}
+
+// sentFundingMsg is a message one of the nodes sent to the other.
+type sentFundingMsg struct {
+	from *testNode
+	msg  lnwire.Message
+}
+
+// fundingMsgPump forwards the funding messages the nodes send to each other
+// and records them in the order they were sent.
+type fundingMsgPump struct {
+	t *testing.T
+
+	mu   sync.Mutex
+	sent []sentFundingMsg
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// startFundingMsgPump starts forwarding the messages sent by both nodes.
+func startFundingMsgPump(t *testing.T, a, b *testNode) *fundingMsgPump {
+	p := &fundingMsgPump{
+		t:    t,
+		quit: make(chan struct{}),
+	}
+	p.forward(a, b)
+	p.forward(b, a)
+
+	return p
+}
+
+// forward delivers the messages sent by one node to the other. Messages are
+// queued, so that a node sending a message never waits for the other one.
+func (p *fundingMsgPump) forward(from, to *testNode) {
+	msgChan := from.msgChan
+	queue := make(chan lnwire.Message, 100)
+
+	p.wg.Add(2)
+	go func() {
+		defer p.wg.Done()
+
+		for {
+			select {
+			case msg := <-msgChan:
+				p.mu.Lock()
+				p.sent = append(p.sent, sentFundingMsg{
+					from: from,
+					msg:  msg,
+				})
+				p.mu.Unlock()
+
+				queue <- msg
+
+			case <-p.quit:
+				return
+			}
+		}
+	}()
+
+	go func() {
+		defer p.wg.Done()
+
+		for {
+			select {
+			case msg := <-queue:
+				to.fundingMgr.ProcessFundingMsg(msg, from)
+
+			case <-p.quit:
+				return
+			}
+		}
+	}()
+}
+
+// stop stops forwarding messages.
+func (p *fundingMsgPump) stop() {
+	close(p.quit)
+	p.wg.Wait()
+}
+
+// msgs returns the messages sent so far.
+func (p *fundingMsgPump) msgs() []sentFundingMsg {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]sentFundingMsg(nil), p.sent...)
+}
+
+// assertNoErrors checks that neither node failed the funding flow.
+func (p *fundingMsgPump) assertNoErrors() {
+	for _, sent := range p.msgs() {
+		if errMsg, ok := sent.msg.(*lnwire.Error); ok {
+			p.t.Fatalf("unexpected error: %v", errMsg.Error())
+		}
+	}
+}
+
+// receivePublishedTx waits for the node to broadcast a transaction.
+func receivePublishedTx(t *testing.T, node *testNode) *wire.MsgTx {
+	t.Helper()
+
+	select {
+	case tx := <-node.publTxChan:
+		return tx
+
+	case <-time.After(time.Second * 5):
+		t.Fatalf("node didn't publish the funding tx")
+		return nil
+	}
+}
+
+// openDualFundedChannel opens a channel funded by both nodes and returns the
+// funding transaction once both published it.
+func openDualFundedChannel(t *testing.T, initiator, fundee *testNode,
+	localAmt btcutil.Amount) *wire.MsgTx {
+
+	t.Helper()
+
+	errChan := make(chan error, 1)
+	initiator.fundingMgr.InitFundingWorkflow(&InitFundingMsg{
+		Peer:            fundee,
+		TargetPubkey:    fundee.privKey.PubKey(),
+		ChainHash:       *fundingNetParams.GenesisHash,
+		MinConfs:        1,
+		LocalFundingAmt: localAmt,
+		FundingFeePerKw: dualFundFeeRate,
+		DualFund:        true,
+		Updates:         make(chan *lnrpc.OpenStatusUpdate, 10),
+		Err:             errChan,
+	})
+
+	initiatorTx := receivePublishedTx(t, initiator)
+	fundeeTx := receivePublishedTx(t, fundee)
+	require.Equal(t, initiatorTx.TxHash(), fundeeTx.TxHash())
+
+	select {
+	case err := <-errChan:
+		t.Fatalf("unable to open channel: %v", err)
+	default:
+	}
+
+	return initiatorTx
+}
+
+// pendingChannel returns the pending channel of the node, which must be the
+// only one.
+func pendingChannel(t *testing.T, node *testNode) *channeldb.OpenChannel {
+	t.Helper()
+
+	pending, err := node.fundingMgr.cfg.ChannelDB.FetchPendingChannels()
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	return pending[0]
+}
+
+// assertV2ChannelID checks that all messages following accept_channel2
+// reference the channel by its v2 channel ID.
+func assertV2ChannelID(t *testing.T, msgs []sentFundingMsg,
+	c *channeldb.OpenChannel) {
+
+	t.Helper()
+
+	expected := lnwire.NewChanIDFromRevocationBasepoints(
+		c.LocalChanCfg.RevocationBasePoint.PubKey,
+		c.RemoteChanCfg.RevocationBasePoint.PubKey,
+	)
+	require.Equal(t, expected, c.WireChanID())
+
+	for _, sent := range msgs {
+		var chanID lnwire.ChannelID
+		switch msg := sent.msg.(type) {
+		case *lnwire.TxAddInput:
+			chanID = msg.ChanID
+		case *lnwire.TxAddOutput:
+			chanID = msg.ChanID
+		case *lnwire.TxComplete:
+			chanID = msg.ChanID
+		case *lnwire.TxSignatures:
+			chanID = msg.ChanID
+		case *lnwire.TxInitRbf:
+			chanID = msg.ChanID
+		case *lnwire.TxAckRbf:
+			chanID = msg.ChanID
+		case *lnwire.FundingSigned:
+			chanID = msg.ChanID
+		default:
+			continue
+		}
+
+		require.Equal(t, expected, chanID, "%v", sent.msg.MsgType())
+	}
+}
+
+// TestDualFundingWorkflow tests that a channel funded by both peers is opened
+// through open_channel2 and accept_channel2, that the peer with the smaller
+// contribution sends its tx_signatures first, and that both peers store the
+// constructed funding transaction with the channel.
+func TestDualFundingWorkflow(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t)
+	t.Cleanup(func() {
+		tearDownFundingManagers(t, alice, bob)
+	})
+
+	const (
+		aliceAmt = btcutil.Amount(300_000)
+		bobAmt   = btcutil.Amount(200_000)
+	)
+	setupDualFundingNode(t, alice, 1, btcutil.SatoshiPerBitcoin)
+	setupDualFundingNode(t, bob, 2, btcutil.SatoshiPerBitcoin/2)
+	bob.fundingMgr.cfg.OpenChannelPredicate = &contributingAcceptor{
+		amt: bobAmt,
+	}
+
+	pump := startFundingMsgPump(t, alice, bob)
+	defer pump.stop()
+
+	fundingTx := openDualFundedChannel(t, alice, bob, aliceAmt)
+	pump.assertNoErrors()
+
+	// The funding transaction spends the coins of both peers.
+	require.Len(t, fundingTx.TxIn, 2)
+
+	// Bob's inputs are worth less than Alice's, so he sends his
+	// tx_signatures first, right after his funding_signed.
+	var (
+		msgTypes   []lnwire.MessageType
+		sigSenders []*testNode
+	)
+	for _, sent := range pump.msgs() {
+		switch sent.msg.(type) {
+		case *lnwire.TxAddInput, *lnwire.TxAddOutput,
+			*lnwire.TxComplete:
+
+			continue
+
+		case *lnwire.TxSignatures:
+			sigSenders = append(sigSenders, sent.from)
+		}
+		msgTypes = append(msgTypes, sent.msg.MsgType())
+	}
+	require.Equal(t, []lnwire.MessageType{
+		lnwire.MsgOpenChannel2,
+		lnwire.MsgAcceptChannel2,
+		lnwire.MsgFundingCreated,
+		lnwire.MsgFundingSigned,
+		lnwire.MsgTxSignatures,
+		lnwire.MsgTxSignatures,
+	}, msgTypes)
+	require.Equal(t, []*testNode{bob, alice}, sigSenders)
+
+	// Both peers store the constructed transaction with the channel, and
+	// Bob's contribution is his initial balance, so he doesn't give up on
+	// the channel if it's slow to confirm.
+	aliceChan := pendingChannel(t, alice)
+	bobChan := pendingChannel(t, bob)
+	for _, c := range []*channeldb.OpenChannel{aliceChan, bobChan} {
+		require.True(t, c.ChanType.HasInteractiveFunding())
+		require.NotNil(t, c.FundingTxn)
+		require.Equal(t, fundingTx.TxHash(), c.FundingTxn.TxHash())
+		require.Equal(
+			t, fundingTx.TxHash(), c.FundingOutpoint.Hash,
+		)
+		require.Equal(t, aliceAmt+bobAmt, c.Capacity)
+		require.True(t, contributedFunds(c))
+	}
+	require.Equal(t, bobAmt, bobChan.InitialLocalBalance.ToSatoshis())
+
+	// Both peers derive the same v2 channel ID, which references the
+	// channel in all messages following accept_channel2.
+	require.Equal(t, aliceChan.WireChanID(), bobChan.WireChanID())
+	assertV2ChannelID(t, pump.msgs(), aliceChan)
+
+	// Once the funding transaction confirmed, the channel_ready message
+	// of the peer is resolved to the channel ID of the funding outpoint.
+	readyMsg := &lnwire.ChannelReady{ChanID: bobChan.WireChanID()}
+	require.Equal(t, readyMsg, bob.fundingMgr.localChannelReady(
+		alice, readyMsg,
+	))
+
+	require.NoError(t, bobChan.MarkAsOpen(lnwire.ShortChannelID{
+		BlockHeight: fundingBroadcastHeight + 1,
+	}))
+	localMsg := bob.fundingMgr.localChannelReady(alice, readyMsg)
+	require.Equal(
+		t, lnwire.NewChanIDFromOutPoint(bobChan.FundingOutpoint),
+		localMsg.ChanID,
+	)
+}
+
+// TestDualFundingNoTimeoutAfterRestart tests that a fundee that contributed
+// to a dual-funded channel keeps waiting for the funding transaction after a
+// restart instead of forgetting the channel.
+func TestDualFundingNoTimeoutAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t)
+	t.Cleanup(func() {
+		tearDownFundingManagers(t, alice, bob)
+	})
+
+	// Bob opens the channel, so that Alice is the fundee whose funding
+	// manager can be restarted.
+	setupDualFundingNode(t, alice, 1, btcutil.SatoshiPerBitcoin/2)
+	setupDualFundingNode(t, bob, 2, btcutil.SatoshiPerBitcoin)
+	alice.fundingMgr.cfg.OpenChannelPredicate = &contributingAcceptor{
+		amt: 200_000,
+	}
+
+	pump := startFundingMsgPump(t, alice, bob)
+	openDualFundedChannel(t, bob, alice, 300_000)
+	pump.stop()
+	pump.assertNoErrors()
+
+	recreateAliceFundingManager(t, alice)
+	pump = startFundingMsgPump(t, alice, bob)
+	defer pump.stop()
+
+	// Once the funding transaction didn't confirm for the maximum number
+	// of blocks a fundee waits, Alice still considers the channel pending
+	// as she has funds at stake.
+	alice.mockNotifier.epochChan <- &chainntnfs.BlockEpoch{
+		Height: fundingBroadcastHeight +
+			lncfg.DefaultMaxWaitNumBlocksFundingConf,
+	}
+	alice.mockNotifier.epochChan <- &chainntnfs.BlockEpoch{
+		Height: fundingBroadcastHeight +
+			lncfg.DefaultMaxWaitNumBlocksFundingConf + 1,
+	}
+
+	assertNumPendingChannelsRemains(t, alice, 1)
+	pump.assertNoErrors()
+}
+
+// TestDualFundingRbf tests that the initiator of a dual-funded channel can
+// replace the funding transaction, and that the replaced funding transaction
+// is abandoned once the replacement confirms, even if the fundee restarted in
+// between.
+func TestDualFundingRbf(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t)
+	t.Cleanup(func() {
+		tearDownFundingManagers(t, alice, bob)
+	})
+
+	setupDualFundingNode(t, alice, 1, btcutil.SatoshiPerBitcoin/2)
+	setupDualFundingNode(t, bob, 2, btcutil.SatoshiPerBitcoin)
+	alice.fundingMgr.cfg.OpenChannelPredicate = &contributingAcceptor{
+		amt: 200_000,
+	}
+
+	pump := startFundingMsgPump(t, alice, bob)
+	fundingTx := openDualFundedChannel(t, bob, alice, 300_000)
+	origPoint := pendingChannel(t, bob).FundingOutpoint
+
+	// Only the initiator can replace the funding transaction.
+	ctx := context.Background()
+	bumpRate := dualFundFeeRate * 2
+	_, err := alice.fundingMgr.BumpFundingFee(ctx, origPoint, bumpRate)
+	require.Error(t, err)
+
+	newPoint, err := bob.fundingMgr.BumpFundingFee(
+		ctx, origPoint, bumpRate,
+	)
+	require.NoError(t, err)
+	require.NotEqual(t, origPoint, newPoint)
+
+	bobTx := receivePublishedTx(t, bob)
+	aliceTx := receivePublishedTx(t, alice)
+	require.Equal(t, newPoint.Hash, bobTx.TxHash())
+	require.Equal(t, newPoint.Hash, aliceTx.TxHash())
+
+	pump.stop()
+	pump.assertNoErrors()
+
+	// Both candidates share the v2 channel ID the replacement was
+	// negotiated with.
+	bobPending, err := bob.fundingMgr.cfg.ChannelDB.FetchPendingChannels()
+	require.NoError(t, err)
+	require.Len(t, bobPending, 2)
+	require.Equal(t, bobPending[0].WireChanID(), bobPending[1].WireChanID())
+	assertV2ChannelID(t, pump.msgs(), bobPending[0])
+
+	// The replacement spends the same inputs at a higher fee.
+	origIns := []wire.OutPoint{
+		fundingTx.TxIn[0].PreviousOutPoint,
+		fundingTx.TxIn[1].PreviousOutPoint,
+	}
+	newIns := []wire.OutPoint{
+		aliceTx.TxIn[0].PreviousOutPoint,
+		aliceTx.TxIn[1].PreviousOutPoint,
+	}
+	require.ElementsMatch(t, origIns, newIns)
+
+	// Both funding candidates are pending for Alice.
+	pending, err := alice.fundingMgr.cfg.ChannelDB.FetchPendingChannels()
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+
+	var replacement *channeldb.OpenChannel
+	for _, c := range pending {
+		if c.FundingOutpoint == newPoint {
+			replacement = c
+		}
+	}
+	require.NotNil(t, replacement)
+	require.True(t, contributedFunds(replacement))
+
+	// After a restart, Alice no longer knows the candidates are related,
+	// but still abandons the original funding transaction once the
+	// replacement confirms as they spend the same inputs.
+	recreateAliceFundingManager(t, alice)
+	alice.fundingMgr.abandonFundingSiblings(replacement)
+
+	assertNumPendingChannelsBecomes(t, alice, 1)
+	require.Equal(t, newPoint, pendingChannel(t, alice).FundingOutpoint)
+
+	closed, err := alice.fundingMgr.cfg.ChannelDB.FetchClosedChannel(
+		&origPoint,
+	)
+	require.NoError(t, err)
+	require.Equal(t, channeldb.FundingCanceled, closed.CloseType)
+}
+
+// TestDualFundingLease tests that a buyer leases inbound liquidity through the
+// request_funds record of open_channel2, that the seller contributes the
+// requested amount and commits to the lease in accept_channel2, and that the
+// lease fee is moved from the buyer's to the seller's initial balance.
+func TestDualFundingLease(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t)
+	t.Cleanup(func() {
+		tearDownFundingManagers(t, alice, bob)
+	})
+
+	const (
+		aliceAmt = btcutil.Amount(300_000)
+		leaseAmt = btcutil.Amount(200_000)
+	)
+	setupDualFundingNode(t, alice, 1, btcutil.SatoshiPerBitcoin)
+	setupDualFundingNode(t, bob, 2, btcutil.SatoshiPerBitcoin/2)
+
+	features := []lnwire.FeatureBit{
+		lnwire.DualFundOptional,
+		lnwire.ExplicitChannelTypeOptional,
+		lnwire.StaticRemoteKeyOptional,
+		lnwire.AnchorsZeroFeeHtlcTxOptional,
+		lnwire.ScriptEnforcedLeaseOptional,
+	}
+	for _, node := range []*testNode{alice, bob} {
+		node.localFeatures = features
+		node.remoteFeatures = features
+	}
+
+	rates := lnwire.LeaseRates{
+		FundingWeight:             444,
+		LeaseFeeBasis:             100,
+		ChannelFeeMaxProportional: 100,
+		LeaseFeeBaseSat:           1000,
+		ChannelFeeMaxBaseMsat:     1000,
+	}
+	seller := liquidityads.NewSeller(&liquidityads.Config{
+		Rates:       rates,
+		MinLeaseAmt: leaseAmt,
+		Signer:      &mock.SecretKeyRing{RootKey: bobPrivKey},
+	})
+	bob.fundingMgr.cfg.SellLease = seller.Sell
+
+	pump := startFundingMsgPump(t, alice, bob)
+	defer pump.stop()
+
+	chanType := lnwire.ChannelType(*lnwire.NewRawFeatureVector(
+		lnwire.StaticRemoteKeyRequired,
+		lnwire.AnchorsZeroFeeHtlcTxRequired,
+		lnwire.ScriptEnforcedLeaseRequired,
+	))
+	errChan := make(chan error, 1)
+	alice.fundingMgr.InitFundingWorkflow(&InitFundingMsg{
+		Peer:            bob,
+		TargetPubkey:    bob.privKey.PubKey(),
+		ChainHash:       *fundingNetParams.GenesisHash,
+		MinConfs:        1,
+		LocalFundingAmt: aliceAmt,
+		FundingFeePerKw: dualFundFeeRate,
+		ChannelType:     &chanType,
+		DualFund:        true,
+		RequestFunds: &lnwire.RequestFunds{
+			RequestedSats: leaseAmt,
+			BlockHeight:   fundingBroadcastHeight,
+		},
+		LeaseRates: &rates,
+		Updates:    make(chan *lnrpc.OpenStatusUpdate, 10),
+		Err:        errChan,
+	})
+
+	aliceTx := receivePublishedTx(t, alice)
+	bobTx := receivePublishedTx(t, bob)
+	require.Equal(t, aliceTx.TxHash(), bobTx.TxHash())
+	pump.assertNoErrors()
+
+	select {
+	case err := <-errChan:
+		t.Fatalf("unable to open channel: %v", err)
+	default:
+	}
+
+	// Bob committed to the lease in his accept_channel2.
+	var willFund *lnwire.WillFund
+	for _, sent := range pump.msgs() {
+		if msg, ok := sent.msg.(*lnwire.AcceptChannel2); ok {
+			willFund = msg.WillFund
+		}
+	}
+	require.NotNil(t, willFund)
+	require.Equal(t, rates, willFund.LeaseRates)
+
+	// Both peers lock Bob's funds until the lease expires, and the lease
+	// fee is part of Bob's initial balance.
+	leaseFee := liquidityads.LeaseFee(&rates, leaseAmt, dualFundFeeRate)
+	aliceChan := pendingChannel(t, alice)
+	bobChan := pendingChannel(t, bob)
+	for _, c := range []*channeldb.OpenChannel{aliceChan, bobChan} {
+		require.True(t, c.ChanType.HasLeaseExpiration())
+		require.Equal(
+			t, uint32(fundingBroadcastHeight)+
+				liquidityads.LeaseDuration, c.ThawHeight,
+		)
+		require.Equal(t, aliceAmt+leaseAmt, c.Capacity)
+	}
+	require.False(t, aliceChan.IsLeaseSeller())
+	require.True(t, bobChan.IsLeaseSeller())
+	require.Equal(
+		t, leaseAmt+leaseFee, bobChan.InitialLocalBalance.ToSatoshis(),
+	)
+	require.Equal(
+		t, leaseAmt+leaseFee,
+		aliceChan.InitialRemoteBalance.ToSatoshis(),
+	)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/liquidityads"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -164,6 +165,10 @@ type reservationWithCtx struct {
 	// the channel.
 	channelType *lnwire.ChannelType
 
+	// dualFund is set if the funding transaction of the channel is
+	// constructed interactively with the peer.
+	dualFund *dualFundingCtx
+
 	updateMtx   sync.RWMutex
 	lastUpdated time.Time
 
@@ -297,6 +302,31 @@ type InitFundingMsg struct {
 	// channel that will be useful to our future selves.
 	Memo []byte
 
+	// LeaseExpiry is the absolute height until which the channel is
+	// leased to the peer. It must be set if ChannelType is a script
+	// enforced lease type of a single-funded channel that isn't funded
+	// through a funding shim, in which case our funds are locked in the
+	// channel until this height. The lease expiry of a dual-funded channel
+	// follows from RequestFunds instead.
+	LeaseExpiry uint32
+
+	// DualFund requests a dual-funded channel, whose funding transaction
+	// is constructed interactively with the peer, which may contribute to
+	// the channel as well.
+	DualFund bool
+
+	// RequestFunds requests the peer of a dual-funded channel to lease us
+	// inbound liquidity, which the peer contributes to the channel at the
+	// LeaseRates it advertises. The lease fee is paid out of our initial
+	// balance, and the peer's funds are locked in the channel until the
+	// lease expires. If set, ChannelType must be a script enforced lease
+	// type.
+	RequestFunds *lnwire.RequestFunds
+
+	// LeaseRates are the lease rates the peer advertises, which it must
+	// commit to if LeaseAmt is set.
+	LeaseRates *lnwire.LeaseRates
+
 	// Updates is a channel which updates to the opening status of the
 	// channel are sent on.
 	Updates chan *lnrpc.OpenStatusUpdate
@@ -556,6 +586,20 @@ type Config struct {
 	// subsystem.
 	IsSweeperOutpoint func(wire.OutPoint) bool
 
+	// SellLease checks that we're willing to lease out the inbound
+	// liquidity requested by a peer that opens a dual-funded channel with
+	// the given funding key and funding fee rate at the given height. It
+	// returns the lease along with the record that commits us to it. This
+	// may be nil, in which case we don't lease out any liquidity.
+	SellLease func(req *lnwire.RequestFunds, fundingKey *btcec.PublicKey,
+		fundingFeeRate chainfee.SatPerKWeight,
+		height uint32) (*liquidityads.Lease, *lnwire.WillFund, error)
+
+	// AbandonFundingCandidate is called for the funding outpoint of a
+	// pending dual-funded channel that was closed because a replacement of
+	// its funding transaction confirmed. This may be nil.
+	AbandonFundingCandidate func(wire.OutPoint) error
+
 	// AuxLeafStore is an optional store that can be used to store auxiliary
 	// leaves for certain custom channel types.
 	AuxLeafStore fn.Option[lnwallet.AuxLeafStore]
@@ -634,7 +678,11 @@ type Manager struct {
 	// signed by both parties.
 	signedReservations map[lnwire.ChannelID]PendingChanID
 
-	// resMtx guards both of the maps above to ensure that all access is
+	// dualFundTxs maps the v2 channel IDs of pending dual-funded channels
+	// to the context of the construction of their funding transaction.
+	dualFundTxs map[lnwire.ChannelID]*dualFundingCtx
+
+	// resMtx guards the maps above to ensure that all access is
 	// goroutine safe.
 	resMtx sync.RWMutex
 
@@ -646,6 +694,15 @@ type Manager struct {
 	// requests from a local subsystem within the daemon.
 	fundingRequests chan *InitFundingMsg
 
+	// bumpFundingRequests is a channel used to receive requests to replace
+	// the funding transaction of a pending dual-funded channel.
+	bumpFundingRequests chan *bumpFundingRequest
+
+	// fundingAbandonSignals is closed for the funding outpoint of a
+	// dual-funded channel once a replacement of its funding transaction
+	// confirmed.
+	fundingAbandonSignals *lnutils.SyncMap[wire.OutPoint, chan struct{}]
+
 	localDiscoverySignals *lnutils.SyncMap[lnwire.ChannelID, chan struct{}]
 
 	handleChannelReadyBarriers *lnutils.SyncMap[lnwire.ChannelID, struct{}]
@@ -708,6 +765,13 @@ func NewFundingManager(cfg Config) (*Manager, error) {
 		fundingRequests: make(
 			chan *InitFundingMsg, msgBufferSize,
 		),
+		dualFundTxs: make(
+			map[lnwire.ChannelID]*dualFundingCtx,
+		),
+		bumpFundingRequests: make(chan *bumpFundingRequest),
+		fundingAbandonSignals: &lnutils.SyncMap[
+			wire.OutPoint, chan struct{},
+		]{},
 		localDiscoverySignals: &lnutils.SyncMap[
 			lnwire.ChannelID, chan struct{},
 		]{},
@@ -760,6 +824,15 @@ func (f *Manager) start() error {
 				chanID, make(chan struct{}),
 			)
 
+			// The peer references dual-funded channels by their
+			// v2 channel ID in channel_ready.
+			if channel.ChanType.HasInteractiveFunding() {
+				f.localDiscoverySignals.LoadOrStore(
+					channel.WireChanID(),
+					make(chan struct{}),
+				)
+			}
+
 			// Rebroadcast the funding transaction for any pending
 			// channel that we initiated. No error will be returned
 			// if the transaction already has been broadcast.
@@ -1059,7 +1132,60 @@ func (f *Manager) reservationCoordinator() {
 				f.fundeeProcessFundingCreated(fmsg.peer, msg)
 
 			case *lnwire.FundingSigned:
-				f.funderProcessFundingSigned(fmsg.peer, msg)
+				if f.isFundingCandidate(msg.ChanID) {
+					f.handleCandidateFundingSigned(
+						fmsg.peer, msg,
+					)
+
+					continue
+				}
+
+				f.funderProcessFundingSigned(
+					fmsg.peer, f.localFundingSigned(msg),
+				)
+
+			case *lnwire.OpenChannel2:
+				f.fundeeProcessOpenChannel2(fmsg.peer, msg)
+
+			case *lnwire.AcceptChannel2:
+				f.funderProcessAcceptChannel2(fmsg.peer, msg)
+
+			case *lnwire.TxAddInput:
+				f.handleInteractiveTxMsg(
+					fmsg.peer, msg.ChanID, msg,
+				)
+
+			case *lnwire.TxAddOutput:
+				f.handleInteractiveTxMsg(
+					fmsg.peer, msg.ChanID, msg,
+				)
+
+			case *lnwire.TxRemoveInput:
+				f.handleInteractiveTxMsg(
+					fmsg.peer, msg.ChanID, msg,
+				)
+
+			case *lnwire.TxRemoveOutput:
+				f.handleInteractiveTxMsg(
+					fmsg.peer, msg.ChanID, msg,
+				)
+
+			case *lnwire.TxComplete:
+				f.handleInteractiveTxMsg(
+					fmsg.peer, msg.ChanID, msg,
+				)
+
+			case *lnwire.TxSignatures:
+				f.handleTxSignatures(fmsg.peer, msg)
+
+			case *lnwire.TxInitRbf:
+				f.handleTxInitRbf(fmsg.peer, msg)
+
+			case *lnwire.TxAckRbf:
+				f.handleTxAckRbf(fmsg.peer, msg)
+
+			case *lnwire.TxAbort:
+				f.handleTxAbort(fmsg.peer, msg)
 
 			case *lnwire.ChannelReady:
 				f.handleChannelReady(fmsg.peer, msg)
@@ -1073,6 +1199,9 @@ func (f *Manager) reservationCoordinator() {
 		case req := <-f.fundingRequests:
 			f.handleInitFundingMsg(req)
 
+		case req := <-f.bumpFundingRequests:
+			f.handleBumpFundingFee(req)
+
 		case <-zombieSweepTicker.C:
 			f.pruneZombieReservations()
 
@@ -1100,7 +1229,11 @@ func (f *Manager) advanceFundingState(channel *channeldb.OpenChannel,
 	// transaction to confirm.
 	if channel.IsPending {
 		err := f.advancePendingChannelState(channel, pendingChanID)
-		if err != nil {
+		if err == errFundingCandidateReplaced {
+			log.Infof("Stopped waiting for ChannelPoint(%v): %v",
+				channel.FundingOutpoint, err)
+			return
+		} else if err != nil {
 			log.Errorf("Unable to advance pending state of "+
 				"ChannelPoint(%v): %v",
 				channel.FundingOutpoint, err)
@@ -1339,11 +1472,7 @@ func (f *Manager) advancePendingChannelState(channel *channeldb.OpenChannel,
 
 		// Find and close the discoverySignal for this channel such
 		// that ChannelReady messages will be processed.
-		chanID := lnwire.NewChanIDFromOutPoint(channel.FundingOutpoint)
-		discoverySignal, ok := f.localDiscoverySignals.Load(chanID)
-		if ok {
-			close(discoverySignal)
-		}
+		f.signalLocalDiscovery(channel)
 
 		return nil
 	}
@@ -1351,6 +1480,8 @@ func (f *Manager) advancePendingChannelState(channel *channeldb.OpenChannel,
 	confChannel, err := f.waitForFundingWithTimeout(channel)
 	if err == ErrConfirmationTimeout {
 		return f.fundingTimeout(channel, pendingChanID)
+	} else if err == errFundingCandidateReplaced {
+		return err
 	} else if err != nil {
 		return fmt.Errorf("error waiting for funding "+
 			"confirmation for ChannelPoint(%v): %v",
@@ -1416,6 +1547,10 @@ func (f *Manager) advancePendingChannelState(channel *channeldb.OpenChannel,
 			channel.FundingOutpoint, err)
 	}
 
+	// Any replaced funding transactions of the channel can't confirm
+	// anymore.
+	f.abandonFundingSiblings(channel)
+
 	return nil
 }
 
@@ -1440,7 +1575,19 @@ func (f *Manager) ProcessFundingMsg(msg lnwire.Message, peer lnpeer.Peer) {
 func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 	msg *lnwire.OpenChannel) {
 
+	f.processOpenChannel(peer, msg, nil)
+}
+
+// processOpenChannel handles a request to open a channel. If dualFund is set,
+// the request is for a dual-funded channel and was converted from
+// open_channel2, in which case we respond with accept_channel2 instead.
+//
+//nolint:funlen
+func (f *Manager) processOpenChannel(peer lnpeer.Peer,
+	msg *lnwire.OpenChannel, dualFund *dualFundingCtx) {
+
 	amt := msg.FundingAmount
+	pushAmt := msg.PushAmount
 
 	// Create the channel identifier.
 	cid := newChanIdentifier(msg.PendingChannelID)
@@ -1572,6 +1719,7 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 	chanReq := &chanacceptor.ChannelAcceptRequest{
 		Node:        peer.IdentityKey(),
 		OpenChanMsg: msg,
+		DualFunded:  dualFund != nil,
 	}
 
 	// Query our channel acceptor to determine whether we should reject
@@ -1582,6 +1730,34 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 		return
 	}
 
+	// The channel acceptor decides how much we contribute to a
+	// dual-funded channel, unless we lease out the liquidity requested by
+	// the initiator. Our contribution is accounted for like a push of the
+	// initiator, which makes it our initial balance. The fee of a lease is
+	// paid out of the initiator's balance in the same way.
+	if dualFund != nil {
+		dualFund.localAmt = acceptorResp.FundingContribution
+		if dualFund.lease != nil {
+			dualFund.localAmt = dualFund.lease.Amount
+		}
+		amt += dualFund.localAmt
+		pushAmt = lnwire.NewMSatFromSatoshis(dualFund.localAmt)
+		if dualFund.lease != nil {
+			pushAmt += lnwire.NewMSatFromSatoshis(
+				dualFund.lease.LeaseFee,
+			)
+		}
+
+		if amt > f.cfg.MaxChanSize {
+			f.failFundingFlow(
+				peer, cid,
+				lnwallet.ErrChanTooLarge(amt, f.cfg.MaxChanSize),
+			)
+
+			return
+		}
+	}
+
 	log.Infof("Recv'd fundingRequest(amt=%v, push=%v, delay=%v, "+
 		"pendingId=%x) from peer(%x)", amt, msg.PushAmount,
 		msg.CsvDelay, msg.PendingChannelID,
@@ -1686,6 +1862,39 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 			"channel %v: %v", cid, err)
 		f.failFundingFlow(peer, cid, err)
 
+		return
+
+	// Zero-fee commitments never pay a fee, so the initiator must not
+	// propose one.
+	case commitType.HasZeroFeeCommitments() && msg.FeePerKiloWeight != 0:
+		err = fmt.Errorf("commitment fee rate of %v sat/kw for "+
+			"zero-fee commitment channel", msg.FeePerKiloWeight)
+		log.Errorf("Cancelling funding flow for channel %v: %v", cid,
+			err)
+		f.failFundingFlow(peer, cid, err)
+
+		return
+
+	// Dual-funded channels are only supported with segwit v0 funding
+	// outputs that confirm before the channel is used.
+	case dualFund != nil && (commitType.IsTaproot() || zeroConf ||
+		commitType.HasZeroFeeCommitments()):
+
+		err = fmt.Errorf("channel type %v can't be dual funded",
+			commitType)
+		f.failFundingFlow(peer, cid, err)
+
+		return
+
+	// A dual-funded channel is leased if and only if the initiator
+	// requested inbound liquidity from us.
+	case dualFund != nil && (dualFund.lease != nil) !=
+		(commitType == lnwallet.CommitmentTypeScriptEnforcedLease):
+
+		err = fmt.Errorf("channel type %v doesn't match lease "+
+			"request", commitType)
+		f.failFundingFlow(peer, cid, err)
+
 		return
 	}
 
@@ -1706,6 +1915,13 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 		return
 	}
 
+	// The funds we contribute to a leased dual-funded channel are locked
+	// until the lease we sell expires.
+	var leaseExpiry uint32
+	if dualFund != nil && dualFund.lease != nil {
+		leaseExpiry = dualFund.lease.LeaseExpiry
+	}
+
 	req := &lnwallet.InitFundingReserveMsg{
 		ChainHash:        &msg.ChainHash,
 		PendingChanID:    msg.PendingChannelID,
@@ -1715,10 +1931,11 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 		RemoteFundingAmt: amt,
 		CommitFeePerKw:   chainfee.SatPerKWeight(msg.FeePerKiloWeight),
 		FundingFeePerKw:  0,
-		PushMSat:         msg.PushAmount,
+		PushMSat:         pushAmt,
 		Flags:            msg.ChannelFlags,
 		MinConfs:         1,
 		CommitType:       commitType,
+		LeaseExpiry:      leaseExpiry,
 		ZeroConf:         zeroConf,
 		OptionScidAlias:  scid,
 		ScidAliasFeature: scidFeatureVal,
@@ -1755,7 +1972,7 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 	// the amount of the channel, and also if any funds are being pushed to
 	// us. If a depth value was set by our channel acceptor, we will use
 	// that value instead.
-	numConfsReq := f.cfg.NumRequiredConfs(msg.FundingAmount, msg.PushAmount)
+	numConfsReq := f.cfg.NumRequiredConfs(amt, pushAmt)
 	if acceptorResp.MinAcceptDepth != 0 {
 		numConfsReq = acceptorResp.MinAcceptDepth
 	}
@@ -1768,10 +1985,29 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 
 	reservation.SetNumConfsRequired(numConfsReq)
 
+	// If our default dust limit was above their ChannelReserve, we change
+	// it to the ChannelReserve. We must make sure the ChannelReserve we
+	// send in the AcceptChannel message is above both dust limits.
+	// Therefore, take the maximum of msg.DustLimit and our dust limit.
+	//
+	// NOTE: Even with this bounding, the ChannelAcceptor may return an
+	// BOLT#02-invalid ChannelReserve.
+	maxDustLimit := reservation.OurContribution().DustLimit
+	if msg.DustLimit > maxDustLimit {
+		maxDustLimit = msg.DustLimit
+	}
+
+	// Both peers of a dual-funded channel require the same reserve, which
+	// isn't negotiated.
+	theirReserve := msg.ChannelReserve
+	if dualFund != nil {
+		theirReserve = dualFundReserve(amt, maxDustLimit)
+	}
+
 	// We'll also validate and apply all the constraints the initiating
 	// party is attempting to dictate for our commitment transaction.
 	stateBounds := &channeldb.ChannelStateBounds{
-		ChanReserve:      msg.ChannelReserve,
+		ChanReserve:      theirReserve,
 		MaxPendingAmount: msg.MaxValueInFlight,
 		MinHTLC:          msg.HtlcMinimum,
 		MaxAcceptedHtlcs: msg.MaxAcceptedHTLCs,
@@ -1854,20 +2090,12 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 		remoteCsvDelay = acceptorResp.CSVDelay
 	}
 
-	// If our default dust limit was above their ChannelReserve, we change
-	// it to the ChannelReserve. We must make sure the ChannelReserve we
-	// send in the AcceptChannel message is above both dust limits.
-	// Therefore, take the maximum of msg.DustLimit and our dust limit.
-	//
-	// NOTE: Even with this bounding, the ChannelAcceptor may return an
-	// BOLT#02-invalid ChannelReserve.
-	maxDustLimit := reservation.OurContribution().DustLimit
-	if msg.DustLimit > maxDustLimit {
-		maxDustLimit = msg.DustLimit
-	}
-
 	chanReserve := f.cfg.RequiredRemoteChanReserve(amt, maxDustLimit)
-	if acceptorResp.Reserve != 0 {
+	switch {
+	case dualFund != nil:
+		chanReserve = theirReserve
+
+	case acceptorResp.Reserve != 0:
 		chanReserve = acceptorResp.Reserve
 	}
 
@@ -1881,6 +2109,13 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 		maxHtlcs = acceptorResp.HtlcLimit
 	}
 
+	// The commitment of a zero-fee channel must stay below the maximum
+	// weight of a v3 (TRUC) transaction, which limits the number of HTLCs
+	// we can accept.
+	if commitType.HasZeroFeeCommitments() {
+		maxHtlcs = min(maxHtlcs, lnwallet.MaxZeroFeeAcceptedHtlcs)
+	}
+
 	// Default to our default minimum hltc value, replacing it with the
 	// channel acceptor's value if it is set.
 	minHtlc := f.cfg.DefaultMinHtlcIn
@@ -1896,6 +2131,20 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 		ourContribution.ChannelStateBounds,
 	)
 
+	// The routing fees of a channel we lease out are capped at the
+	// maximums we committed to for the duration of the lease.
+	if dualFund != nil && dualFund.lease != nil {
+		rates := dualFund.lease.Rates
+		forwardingPolicy.BaseFee = min(
+			forwardingPolicy.BaseFee,
+			lnwire.MilliSatoshi(rates.ChannelFeeMaxBaseMsat),
+		)
+		forwardingPolicy.FeeRate = min(
+			forwardingPolicy.FeeRate,
+			lnwire.MilliSatoshi(rates.ChannelFeeMaxProportional),
+		)
+	}
+
 	// Once the reservation has been created successfully, we add it to
 	// this peer's map of pending reservations to track this particular
 	// reservation until either abort or completion.
@@ -1914,6 +2163,7 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 		remoteChanReserve: chanReserve,
 		maxLocalCsv:       f.cfg.MaxLocalCSVDelay,
 		channelType:       chanType,
+		dualFund:          dualFund,
 		err:               make(chan error, 1),
 		peer:              peer,
 	}
@@ -2021,6 +2271,17 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 		)
 	}
 
+	if dualFund != nil {
+		err := f.sendAcceptChannel2(peer, resCtx, &fundingAccept)
+		if err != nil {
+			log.Errorf("unable to accept dual-funded channel: %v",
+				err)
+			f.failFundingFlow(peer, cid, err)
+		}
+
+		return
+	}
+
 	if err := peer.SendMessage(true, &fundingAccept); err != nil {
 		log.Errorf("unable to send funding response to peer: %v", err)
 		f.failFundingFlow(peer, cid, err)
@@ -2242,7 +2503,22 @@ func (f *Manager) funderProcessAcceptChannel(peer lnpeer.Peer,
 	// multisig keys. We now have everything that is needed for the user to
 	// start constructing a PSBT that sends to the multisig funding address.
 	var psbtIntent *chanfunding.PsbtIntent
-	if psbtErr, ok := err.(*lnwallet.PsbtFundingRequired); ok {
+	psbtErr, isPsbt := err.(*lnwallet.PsbtFundingRequired)
+	switch {
+	// The funding transaction of a dual-funded channel is constructed
+	// interactively with the peer, which feeds the PSBT intent once
+	// done.
+	case isPsbt && resCtx.dualFund != nil:
+		err := f.startDualFundingConstruction(resCtx, psbtErr.Intent)
+		if err != nil {
+			log.Errorf("Unable to start funding tx construction "+
+				"with %x: %v", peerKeyBytes, err)
+			f.failFundingFlow(peer, cid, err)
+			return
+		}
+		psbtIntent = psbtErr.Intent
+
+	case isPsbt:
 		// Return the information that is needed by the user to
 		// construct the PSBT back to the caller.
 		addr, amt, packet, err := psbtErr.Intent.FundingParams()
@@ -2272,7 +2548,8 @@ func (f *Manager) funderProcessAcceptChannel(peer lnpeer.Peer,
 			},
 		}
 		psbtIntent = psbtErr.Intent
-	} else if err != nil {
+
+	case err != nil:
 		log.Errorf("Unable to process contribution from %x: %v",
 			peerKeyBytes, err)
 		f.failFundingFlow(peer, cid, err)
@@ -2526,6 +2803,18 @@ func (f *Manager) fundeeProcessFundingCreated(peer lnpeer.Peer,
 	// Create the channel identifier without setting the active channel ID.
 	cid := newChanIdentifier(pendingChanID)
 
+	// The funding outpoint of a dual-funded channel must spend the
+	// transaction we constructed together.
+	dualFund := resCtx.dualFund
+	if dualFund != nil {
+		err := verifyDualFundingPoint(dualFund, fundingOut)
+		if err != nil {
+			log.Errorf("Invalid funding outpoint: %v", err)
+			f.failFundingFlow(peer, cid, err)
+			return
+		}
+	}
+
 	// For taproot channels, the commit signature is actually the partial
 	// signature. Otherwise, we can convert the ECDSA commit signature into
 	// our internal input.Signature type.
@@ -2673,6 +2962,9 @@ func (f *Manager) fundeeProcessFundingCreated(peer lnpeer.Peer,
 	cid.setChanID(channelID)
 
 	fundingSigned.ChanID = cid.chanID
+	if dualFund != nil {
+		fundingSigned.ChanID = dualFund.channelID
+	}
 
 	log.Infof("sending FundingSigned for pending_id(%x) over "+
 		"ChannelPoint(%v)", pendingChanID[:], fundingOut)
@@ -2713,6 +3005,12 @@ func (f *Manager) fundeeProcessFundingCreated(peer lnpeer.Peer,
 		fundingOut, completeChan, completeChan.IdentityPub,
 	)
 
+	// With the commitments signed, the witnesses of a dual-funded
+	// transaction can be exchanged.
+	if dualFund != nil {
+		f.dualFundingCommitted(dualFund, completeChan)
+	}
+
 	// At this point we have sent our last funding message to the
 	// initiating peer before the funding transaction will be broadcast.
 	// With this last message, our job as the responder is now complete.
@@ -2939,6 +3237,12 @@ func (f *Manager) funderProcessFundingSigned(peer lnpeer.Peer,
 		return
 	}
 
+	// The funding transaction of a dual-funded channel is broadcast once
+	// the witnesses of both peers are exchanged.
+	if resCtx.dualFund != nil {
+		f.dualFundingCommitted(resCtx.dualFund, completeChan)
+	}
+
 	// At this point we have broadcast the funding transaction and done all
 	// necessary processing.
 	f.wg.Add(1)
@@ -3050,14 +3354,25 @@ func (f *Manager) waitForFundingWithTimeout(
 
 	// If we are not the initiator, we have no money at stake and will
 	// timeout waiting for the funding transaction to confirm after a
-	// while.
-	if !ch.IsInitiator && !ch.IsZeroConf() {
+	// while. This doesn't apply if we contributed to a dual-funded
+	// channel.
+	if !ch.IsInitiator && !ch.IsZeroConf() && !contributedFunds(ch) {
 		f.wg.Add(1)
 		go f.waitForTimeout(ch, cancelChan, timeoutChan)
 	}
 	defer close(cancelChan)
 
+	// The funding transaction of a dual-funded channel may be replaced,
+	// in which case we stop waiting once the replacement confirmed.
+	abandonSignal, _ := f.fundingAbandonSignals.LoadOrStore(
+		ch.FundingOutpoint, make(chan struct{}),
+	)
+	defer f.fundingAbandonSignals.Delete(ch.FundingOutpoint)
+
 	select {
+	case <-abandonSignal:
+		return nil, errFundingCandidateReplaced
+
 	case err := <-timeoutChan:
 		if err != nil {
 			return nil, err
@@ -3420,7 +3735,6 @@ func (f *Manager) handleFundingConfirmation(
 	confChannel *confirmedChannel) error {
 
 	fundingPoint := completeChan.FundingOutpoint
-	chanID := lnwire.NewChanIDFromOutPoint(fundingPoint)
 
 	// TODO(roasbeef): ideally persistent state update for chan above
 	// should be abstracted
@@ -3485,11 +3799,33 @@ func (f *Manager) handleFundingConfirmation(
 	// goroutine that the channel now is marked as open in the database
 	// and that it is acceptable to process channel_ready messages
 	// from the peer.
+	f.signalLocalDiscovery(completeChan)
+
+	return nil
+}
+
+// signalLocalDiscovery closes the discovery signal of a channel whose funding
+// transaction confirmed, so that the channel_ready message of the peer is
+// processed.
+func (f *Manager) signalLocalDiscovery(channel *channeldb.OpenChannel) {
+	chanID := lnwire.NewChanIDFromOutPoint(channel.FundingOutpoint)
 	if discoverySignal, ok := f.localDiscoverySignals.Load(chanID); ok {
 		close(discoverySignal)
 	}
 
-	return nil
+	// Dual-funded channels also have a signal for their v2 channel ID,
+	// which is shared by all candidates of the funding transaction. It's
+	// removed once closed, as only one of them can confirm, and from now
+	// on the channel ID resolves to the confirmed candidate.
+	if !channel.ChanType.HasInteractiveFunding() {
+		return
+	}
+	discoverySignal, ok := f.localDiscoverySignals.LoadAndDelete(
+		channel.WireChanID(),
+	)
+	if ok {
+		close(discoverySignal)
+	}
 }
 
 // sendChannelReady creates and sends the channelReady message.
@@ -3511,7 +3847,9 @@ func (f *Manager) sendChannelReady(completeChan *channeldb.OpenChannel,
 	if err != nil {
 		return fmt.Errorf("unable to create next revocation: %w", err)
 	}
-	channelReadyMsg := lnwire.NewChannelReady(chanID, nextRevocation)
+	channelReadyMsg := lnwire.NewChannelReady(
+		completeChan.WireChanID(), nextRevocation,
+	)
 
 	// If this is a taproot channel, then we also need to send along our
 	// set of musig2 nonces as well.
@@ -4125,7 +4463,9 @@ func (f *Manager) handleChannelReady(peer lnpeer.Peer,
 			}
 
 			f.localDiscoverySignals.Delete(msg.ChanID)
-			f.processChannelReady(peer, msg)
+			f.processChannelReady(
+				peer, f.localChannelReady(peer, msg),
+			)
 		}()
 
 		return
@@ -4134,7 +4474,8 @@ func (f *Manager) handleChannelReady(peer lnpeer.Peer,
 	// No signal wait needed. Perform a lightweight channel lookup inline
 	// to short-circuit bogus or already-established channels without
 	// blocking the coordinator on heavier processing.
-	chanID := msg.ChanID
+	localMsg := f.localChannelReady(peer, msg)
+	chanID := localMsg.ChanID
 	channel, err := f.cfg.FindChannel(peer.IdentityKey(), chanID)
 	if err != nil {
 		f.handleChannelReadyBarriers.Delete(msg.ChanID)
@@ -4185,10 +4526,43 @@ func (f *Manager) handleChannelReady(peer lnpeer.Peer,
 		defer f.wg.Done()
 		defer f.handleChannelReadyBarriers.Delete(msg.ChanID)
 
-		f.processChannelReady(peer, msg)
+		f.processChannelReady(peer, localMsg)
 	}()
 }
 
+// localChannelReady returns the channel_ready message of a dual-funded channel
+// with the channel ID derived from the outpoint of its confirmed funding
+// transaction, as the peer references the channel by its v2 channel ID. Other
+// messages are returned unchanged.
+func (f *Manager) localChannelReady(peer lnpeer.Peer,
+	msg *lnwire.ChannelReady) *lnwire.ChannelReady {
+
+	channels, err := f.cfg.ChannelDB.FetchOpenChannels(peer.IdentityKey())
+	if err != nil {
+		log.Errorf("Unable to fetch channels of peer %x: %v",
+			peer.IdentityKey().SerializeCompressed(), err)
+
+		return msg
+	}
+
+	for _, c := range channels {
+		if !c.ChanType.HasInteractiveFunding() || c.IsPending ||
+			c.WireChanID() != msg.ChanID {
+
+			continue
+		}
+
+		localMsg := *msg
+		localMsg.ChanID = lnwire.NewChanIDFromOutPoint(
+			c.FundingOutpoint,
+		)
+
+		return &localMsg
+	}
+
+	return msg
+}
+
 // processChannelReady completes the channel_ready handling after any required
 // signal waits. It looks up the channel in the database and finalizes the
 // funding flow by inserting the remote party's next revocation point and
@@ -4898,6 +5272,23 @@ func getUpfrontShutdownScript(enableUpfrontShutdown bool, peer lnpeer.Peer,
 	return getScript(taprootOK)
 }
 
+// allowUtxoForFunding returns true if the given utxo can be used to fund a
+// channel. Unconfirmed utxos which are marked by the sweeper subsystem are
+// excluded from the coin selection because they are not final and can be
+// RBFed by the sweeper subsystem.
+func (f *Manager) allowUtxoForFunding(u lnwallet.Utxo) bool {
+	// Utxos with at least 1 confirmation are safe to use for channel
+	// openings because they don't bare the risk of being replaced (BIP 125
+	// RBF).
+	if u.Confirmations > 0 {
+		return true
+	}
+
+	// Query the sweeper storage to make sure we don't use an unconfirmed
+	// utxo still in use by the sweeper subsystem.
+	return !f.cfg.IsSweeperOutpoint(u.OutPoint)
+}
+
 // handleInitFundingMsg creates a channel reservation within the daemon's
 // wallet, then sends a funding request to the remote peer kicking off the
 // funding workflow.
@@ -4922,6 +5313,15 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		maxCSV = f.cfg.MaxLocalCSVDelay
 	}
 
+	// The funding transaction of a dual-funded channel is constructed
+	// together with the peer and handed to a PSBT funding intent.
+	if msg.DualFund {
+		if err := f.prepareDualFunding(msg); err != nil {
+			msg.Err <- err
+			return
+		}
+	}
+
 	log.Infof("Initiating fundingRequest(local_amt=%v "+
 		"(subtract_fees=%v), push_amt=%v, chain_hash=%v, peer=%x, "+
 		"min_confs=%v)", localAmt, msg.SubtractFees, msg.PushAmt,
@@ -5028,6 +5428,21 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		return
 	}
 
+	// The commitment of a zero-fee channel must stay below the maximum
+	// weight of a v3 (TRUC) transaction, which limits the number of HTLCs
+	// we can accept.
+	if commitType.HasZeroFeeCommitments() &&
+		maxHtlcs > lnwallet.MaxZeroFeeAcceptedHtlcs {
+
+		err = fmt.Errorf("max htlcs of %d exceeds the maximum of %d "+
+			"for zero-fee commitment channels", maxHtlcs,
+			lnwallet.MaxZeroFeeAcceptedHtlcs)
+		log.Error(err)
+		msg.Err <- err
+
+		return
+	}
+
 	// First, we'll query the fee estimator for a fee that should get the
 	// commitment transaction confirmed by the next few blocks (conf target
 	// of 3). We target the near blocks here to ensure that we'll be able
@@ -5039,9 +5454,13 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 	}
 
 	// For anchor channels cap the initial commit fee rate at our defined
-	// maximum.
-	if commitType.HasAnchors() &&
-		commitFeePerKw > f.cfg.MaxAnchorsCommitFeeRate {
+	// maximum. Zero-fee commitments never pay a fee.
+	switch {
+	case commitType.HasZeroFeeCommitments():
+		commitFeePerKw = 0
+
+	case commitType.HasAnchors() &&
+		commitFeePerKw > f.cfg.MaxAnchorsCommitFeeRate:
 
 		commitFeePerKw = f.cfg.MaxAnchorsCommitFeeRate
 	}
@@ -5073,45 +5492,31 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 	}
 
 	req := &lnwallet.InitFundingReserveMsg{
-		ChainHash:         &msg.ChainHash,
-		PendingChanID:     chanID,
-		NodeID:            peerKey,
-		NodeAddr:          msg.Peer.Address(),
-		SubtractFees:      msg.SubtractFees,
-		LocalFundingAmt:   localAmt,
-		RemoteFundingAmt:  0,
-		FundUpToMaxAmt:    msg.FundUpToMaxAmt,
-		MinFundAmt:        msg.MinFundAmt,
-		RemoteChanReserve: chanReserve,
-		Outpoints:         outpoints,
-		CommitFeePerKw:    commitFeePerKw,
-		FundingFeePerKw:   msg.FundingFeePerKw,
-		PushMSat:          msg.PushAmt,
-		Flags:             channelFlags,
-		MinConfs:          msg.MinConfs,
-		CommitType:        commitType,
-		ChanFunder:        msg.ChanFunder,
-		// Unconfirmed Utxos which are marked by the sweeper subsystem
-		// are excluded from the coin selection because they are not
-		// final and can be RBFed by the sweeper subsystem.
-		AllowUtxoForFunding: func(u lnwallet.Utxo) bool {
-			// Utxos with at least 1 confirmation are safe to use
-			// for channel openings because they don't bare the risk
-			// of being replaced (BIP 125 RBF).
-			if u.Confirmations > 0 {
-				return true
-			}
-
-			// Query the sweeper storage to make sure we don't use
-			// an unconfirmed utxo still in use by the sweeper
-			// subsystem.
-			return !f.cfg.IsSweeperOutpoint(u.OutPoint)
-		},
-		ZeroConf:         zeroConf,
-		OptionScidAlias:  scid,
-		ScidAliasFeature: scidFeatureVal,
-		Memo:             msg.Memo,
-		TapscriptRoot:    tapscriptRoot,
+		ChainHash:           &msg.ChainHash,
+		PendingChanID:       chanID,
+		NodeID:              peerKey,
+		NodeAddr:            msg.Peer.Address(),
+		SubtractFees:        msg.SubtractFees,
+		LocalFundingAmt:     localAmt,
+		RemoteFundingAmt:    0,
+		FundUpToMaxAmt:      msg.FundUpToMaxAmt,
+		MinFundAmt:          msg.MinFundAmt,
+		RemoteChanReserve:   chanReserve,
+		Outpoints:           outpoints,
+		CommitFeePerKw:      commitFeePerKw,
+		FundingFeePerKw:     msg.FundingFeePerKw,
+		PushMSat:            msg.PushAmt,
+		Flags:               channelFlags,
+		MinConfs:            msg.MinConfs,
+		CommitType:          commitType,
+		LeaseExpiry:         msg.LeaseExpiry,
+		ChanFunder:          msg.ChanFunder,
+		AllowUtxoForFunding: f.allowUtxoForFunding,
+		ZeroConf:            zeroConf,
+		OptionScidAlias:     scid,
+		ScidAliasFeature:    scidFeatureVal,
+		Memo:                msg.Memo,
+		TapscriptRoot:       tapscriptRoot,
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -5163,6 +5568,15 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 
 	if maxHtlcs == 0 {
 		maxHtlcs = f.cfg.RequiredRemoteMaxHTLCs(capacity)
+
+		// The commitment of a zero-fee channel must stay below the
+		// maximum weight of a v3 (TRUC) transaction, which limits the
+		// number of HTLCs we can accept.
+		if commitType.HasZeroFeeCommitments() {
+			maxHtlcs = min(
+				maxHtlcs, lnwallet.MaxZeroFeeAcceptedHtlcs,
+			)
+		}
 	}
 
 	// Once the reservation has been created, and indexed, queue a funding
@@ -5221,6 +5635,18 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		updates:           msg.Updates,
 		err:               msg.Err,
 	}
+	if msg.DualFund {
+		resCtx.dualFund = &dualFundingCtx{
+			peer:          msg.Peer,
+			pendingChanID: chanID,
+			initiator:     true,
+			localAmt:      capacity,
+			feeRate:       msg.FundingFeePerKw,
+			dustLimit:     ourDustLimit,
+			requestFunds:  msg.RequestFunds,
+			leaseRates:    msg.LeaseRates,
+		}
+	}
 	f.activeReservations[peerIDKey][chanID] = resCtx
 	f.resMtx.Unlock()
 
@@ -5295,7 +5721,24 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		)
 	}
 
-	if err := msg.Peer.SendMessage(true, &fundingOpen); err != nil {
+	var openMsg lnwire.Message = &fundingOpen
+	if resCtx.dualFund != nil {
+		openMsg, err = f.newOpenChannel2(msg, resCtx, &fundingOpen)
+		if err != nil {
+			_, cancelErr := f.cancelReservationCtx(
+				peerKey, chanID, false,
+			)
+			if cancelErr != nil {
+				log.Errorf("unable to cancel reservation: %v",
+					cancelErr)
+			}
+
+			msg.Err <- err
+			return
+		}
+	}
+
+	if err := msg.Peer.SendMessage(true, openMsg); err != nil {
 		e := fmt.Errorf("unable to send funding request message: %w",
 			err)
 		log.Errorf(e.Error())
@@ -5325,6 +5768,13 @@ func (f *Manager) handleErrorMsg(peer lnpeer.Peer, msg *lnwire.Error) {
 	chanID := msg.ChanID
 	peerKey := peer.IdentityKey()
 
+	// The peer may reference a dual-funded channel by its v2 channel ID.
+	f.resMtx.RLock()
+	if dualFund, ok := f.dualFundTxs[chanID]; ok {
+		chanID = dualFund.pendingChanID
+	}
+	f.resMtx.RUnlock()
+
 	// First, we'll attempt to retrieve and cancel the funding workflow
 	// that this error was tied to. If we're unable to do so, then we'll
 	// exit early as this was an unwarranted error.
@@ -5437,6 +5887,21 @@ func (f *Manager) cancelReservationCtx(peerKey *btcec.PublicKey,
 		return nil, fmt.Errorf("unable to cancel reservation: %w", err)
 	}
 
+	// The inputs we selected for a dual-funded channel aren't part of the
+	// reservation, so they need to be released separately.
+	if ctx.dualFund != nil && ctx.dualFund.contribution != nil {
+		f.cfg.Wallet.ReleaseInteractiveContribution(
+			ctx.dualFund.contribution,
+		)
+	}
+
+	// The funding transaction of a dual-funded channel can't be
+	// constructed anymore.
+	dualFund := ctx.dualFund
+	if dualFund != nil && f.dualFundTxs[dualFund.channelID] == dualFund {
+		delete(f.dualFundTxs, dualFund.channelID)
+	}
+
 	delete(nodeReservations, pendingChanID)
 
 	// If this was the last active reservation for this peer, delete the
@@ -5009,6 +5009,7 @@ func TestCommitmentTypeFundmaxSanityCheck(t *testing.T) {
 		"SIMPLE_TAPROOT_OVERLAY":  6,
 		"TAPROOT":                 7,
 		"SIMPLE_TAPROOT_FINAL":    7,
+		"ZERO_FEE_COMMITMENTS":    8,
 	}
 
 	for commitmentType, protoValue := range lnrpc.CommitmentType_value {
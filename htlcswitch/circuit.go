@@ -3,11 +3,28 @@ package htlcswitch
 import (
 	"encoding/binary"
 	"io"
+	"time"
 
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/graph/db/models"
 	"github.com/lightningnetwork/lnd/htlcswitch/hop"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// circuitIncomingUpfrontFeeType is the tlv type of the incoming
+	// upfront fee that is stored after the error encrypter of a circuit.
+	circuitIncomingUpfrontFeeType tlv.Type = 0
+
+	// circuitOutgoingUpfrontFeeType is the tlv type of the outgoing
+	// upfront fee that is stored after the error encrypter of a circuit.
+	circuitOutgoingUpfrontFeeType tlv.Type = 1
+
+	// circuitReceivedTimeType is the tlv type of the time the incoming
+	// HTLC was received, which is stored after the error encrypter of a
+	// circuit as unix nanoseconds.
+	circuitReceivedTimeType tlv.Type = 2
 )
 
 // EmptyCircuitKey is a default value for an outgoing circuit key returned when
@@ -51,6 +68,14 @@ type PaymentCircuit struct {
 	// either as a payment or forwarded amount.
 	OutgoingAmount lnwire.MilliSatoshi
 
+	// IncomingUpfrontFee is the unconditional fee that was paid to us
+	// along with the incoming HTLC.
+	IncomingUpfrontFee lnwire.MilliSatoshi
+
+	// OutgoingUpfrontFee is the unconditional fee that we pay to the next
+	// hop along with the outgoing HTLC.
+	OutgoingUpfrontFee lnwire.MilliSatoshi
+
 	// ErrorEncrypter is used to re-encrypt the onion failure before
 	// sending it back to the originator of the payment.
 	ErrorEncrypter hop.ErrorEncrypter
@@ -83,10 +108,12 @@ func newPaymentCircuit(hash *[32]byte, pkt *htlcPacket) *PaymentCircuit {
 			ChanID: pkt.incomingChanID,
 			HtlcID: pkt.incomingHTLCID,
 		},
-		PaymentHash:    *hash,
-		IncomingAmount: pkt.incomingAmount,
-		OutgoingAmount: pkt.amount,
-		ErrorEncrypter: pkt.obfuscator,
+		PaymentHash:        *hash,
+		IncomingAmount:     pkt.incomingAmount,
+		OutgoingAmount:     pkt.amount,
+		IncomingUpfrontFee: pkt.incomingUpfrontFee,
+		OutgoingUpfrontFee: pkt.outgoingUpfrontFee(),
+		ErrorEncrypter:     pkt.obfuscator,
 	}
 }
 
@@ -104,10 +131,12 @@ func makePaymentCircuit(hash *[32]byte, pkt *htlcPacket) PaymentCircuit {
 			ChanID: pkt.incomingChanID,
 			HtlcID: pkt.incomingHTLCID,
 		},
-		PaymentHash:    *hash,
-		IncomingAmount: pkt.incomingAmount,
-		OutgoingAmount: pkt.amount,
-		ErrorEncrypter: pkt.obfuscator,
+		PaymentHash:        *hash,
+		IncomingAmount:     pkt.incomingAmount,
+		OutgoingAmount:     pkt.amount,
+		IncomingUpfrontFee: pkt.incomingUpfrontFee,
+		OutgoingUpfrontFee: pkt.outgoingUpfrontFee(),
+		ErrorEncrypter:     pkt.obfuscator,
 	}
 }
 
@@ -149,11 +178,53 @@ func (c *PaymentCircuit) Encode(w io.Writer) error {
 	}
 
 	// Skip encoding of error encrypter if this half add does not have one.
-	if encrypterType == hop.EncrypterTypeNone {
+	if encrypterType != hop.EncrypterTypeNone {
+		if err := c.ErrorEncrypter.Encode(w); err != nil {
+			return err
+		}
+	}
+
+	// The upfront fees and the time the incoming HTLC was received are
+	// appended as an optional tlv stream, which is omitted entirely for
+	// circuits that don't carry any of them.
+	var records []tlv.Record
+
+	incomingFee := uint64(c.IncomingUpfrontFee)
+	outgoingFee := uint64(c.OutgoingUpfrontFee)
+	if incomingFee != 0 || outgoingFee != 0 {
+		records = append(records,
+			tlv.MakePrimitiveRecord(
+				circuitIncomingUpfrontFeeType, &incomingFee,
+			),
+			tlv.MakePrimitiveRecord(
+				circuitOutgoingUpfrontFeeType, &outgoingFee,
+			),
+		)
+	}
+
+	var receivedAt time.Time
+	if c.ErrorEncrypter != nil {
+		receivedAt = c.ErrorEncrypter.ReceivedTime()
+	}
+
+	var receivedTime uint64
+	if !receivedAt.IsZero() {
+		receivedTime = uint64(receivedAt.UnixNano())
+		records = append(records, tlv.MakePrimitiveRecord(
+			circuitReceivedTimeType, &receivedTime,
+		))
+	}
+
+	if len(records) == 0 {
 		return nil
 	}
 
-	return c.ErrorEncrypter.Encode(w)
+	tlvStream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
 }
 
 // Decode reads a PaymentCircuit from the provided io.Reader.
@@ -195,7 +266,6 @@ func (c *PaymentCircuit) Decode(r io.Reader) error {
 	case hop.EncrypterTypeNone:
 		// No encrypter was provided, such as when the payment is
 		// locally initiated.
-		return nil
 
 	case hop.EncrypterTypeSphinx:
 		// Sphinx encrypter was used as this is a forwarded HTLC.
@@ -215,7 +285,46 @@ func (c *PaymentCircuit) Decode(r io.Reader) error {
 		return UnknownEncrypterType(encrypterType)
 	}
 
-	return c.ErrorEncrypter.Decode(r)
+	if c.ErrorEncrypter != nil {
+		if err := c.ErrorEncrypter.Decode(r); err != nil {
+			return err
+		}
+	}
+
+	// Finally, read the optional upfront fees and received time. Circuits
+	// written before these existed simply end here.
+	var incomingFee, outgoingFee, receivedTime uint64
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(
+			circuitIncomingUpfrontFeeType, &incomingFee,
+		),
+		tlv.MakePrimitiveRecord(
+			circuitOutgoingUpfrontFeeType, &outgoingFee,
+		),
+		tlv.MakePrimitiveRecord(
+			circuitReceivedTimeType, &receivedTime,
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tlvStream.Decode(r); err != nil {
+		return err
+	}
+
+	c.IncomingUpfrontFee = lnwire.MilliSatoshi(incomingFee)
+	c.OutgoingUpfrontFee = lnwire.MilliSatoshi(outgoingFee)
+
+	// Restore the time the incoming HTLC was received so that the hold
+	// time reported in failures also covers the time before a restart.
+	if c.ErrorEncrypter != nil && receivedTime != 0 {
+		c.ErrorEncrypter.SetReceivedTime(
+			time.Unix(0, int64(receivedTime)),
+		)
+	}
+
+	return nil
 }
 
 // InKey returns the primary identifier for the circuit corresponding to the
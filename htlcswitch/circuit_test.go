@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil/v2"
@@ -74,6 +75,11 @@ func initTestExtracter() {
 		panic("did not extract sphinx error encrypter")
 	}
 
+	// The time the HTLC was received is persisted with nanosecond
+	// precision and without a monotonic clock reading, so we use a fixed
+	// time to be able to compare the extracter against decoded circuits.
+	sphinxExtracter.SetReceivedTime(time.Unix(0, 1_700_000_000_123_456_789))
+
 	testExtracter = sphinxExtracter
 
 	// We also set this error extracter on startup, otherwise it will be nil
@@ -140,12 +146,14 @@ func TestCircuitMapInit(t *testing.T) {
 }
 
 var halfCircuitTests = []struct {
-	hash      [32]byte
-	inValue   btcutil.Amount
-	outValue  btcutil.Amount
-	chanID    lnwire.ShortChannelID
-	htlcID    uint64
-	encrypter hop.ErrorEncrypter
+	hash       [32]byte
+	inValue    btcutil.Amount
+	outValue   btcutil.Amount
+	chanID     lnwire.ShortChannelID
+	htlcID     uint64
+	encrypter  hop.ErrorEncrypter
+	upfrontIn  lnwire.MilliSatoshi
+	upfrontOut lnwire.MilliSatoshi
 }{
 	{
 		hash:      hash1,
@@ -174,6 +182,27 @@ var halfCircuitTests = []struct {
 		// repopulate this encrypter.
 		encrypter: testExtracter,
 	},
+	{
+		hash:      hash1,
+		inValue:   0,
+		outValue:  1000,
+		chanID:    lnwire.NewShortChanIDFromInt(4),
+		htlcID:    4,
+		encrypter: nil,
+		upfrontIn: 0,
+		// A locally initiated payment only pays an upfront fee.
+		upfrontOut: 50,
+	},
+	{
+		hash:       hash2,
+		inValue:    2100,
+		outValue:   2000,
+		chanID:     lnwire.NewShortChanIDFromInt(5),
+		htlcID:     5,
+		encrypter:  htlcswitch.NewMockObfuscator(),
+		upfrontIn:  30,
+		upfrontOut: 29,
+	},
 }
 
 // TestHalfCircuitSerialization checks that the half circuits can be properly
@@ -194,7 +223,9 @@ func TestHalfCircuitSerialization(t *testing.T) {
 				ChanID: test.chanID,
 				HtlcID: test.htlcID,
 			},
-			ErrorEncrypter: test.encrypter,
+			ErrorEncrypter:     test.encrypter,
+			IncomingUpfrontFee: test.upfrontIn,
+			OutgoingUpfrontFee: test.upfrontOut,
 		}
 
 		// Write the half circuit to our buffer.
@@ -92,6 +92,11 @@ type ForwardingError struct {
 	// be nil in the case where we fail to decode failure message sent by
 	// a peer.
 	msg lnwire.FailureMessage
+
+	// HoldTimes are the hold times reported by the hops of the route up to
+	// the failure source, in units of hop.HoldTimeUnit. Only the hold
+	// times of the hops that provided valid attribution data are present.
+	HoldTimes []uint32
 }
 
 // WireMessage extracts a valid wire failure message from an internal
@@ -133,14 +138,43 @@ func NewUnknownForwardingError(index int) *ForwardingError {
 	}
 }
 
+// UnreadableFailureError is returned when a failure could not be decrypted,
+// but its attribution data allowed us to narrow down the nodes that are
+// responsible for corrupting it.
+type UnreadableFailureError struct {
+	// AttributedIdx is the index of the node that, together with its
+	// predecessor, is responsible for the unreadable failure. The
+	// attribution data of all nodes before it is valid. Index zero is the
+	// self node.
+	AttributedIdx int
+
+	// HoldTimes are the hold times reported by the nodes before the
+	// attributed node, in units of hop.HoldTimeUnit.
+	HoldTimes []uint32
+}
+
+// Error returns the string representation of the unreadable failure.
+func (u *UnreadableFailureError) Error() string {
+	return fmt.Sprintf("%v@%v", ErrUnreadableFailureMessage,
+		u.AttributedIdx)
+}
+
+// Unwrap returns the underlying ErrUnreadableFailureMessage, so that callers
+// that don't care about the attribution can treat both alike.
+func (u *UnreadableFailureError) Unwrap() error {
+	return ErrUnreadableFailureMessage
+}
+
 // ErrorDecrypter is an interface that is used to decrypt the onion encrypted
 // failure reason an extra out a well formed error.
 type ErrorDecrypter interface {
 	// DecryptError peels off each layer of onion encryption from the first
 	// hop, to the source of the error. A fully populated
 	// lnwire.FailureMessage is returned along with the source of the
-	// error.
-	DecryptError(lnwire.OpaqueReason) (*ForwardingError, error)
+	// error. The attribution data of the failure is optional and is used
+	// to extract the hold times of the hops and to attribute failures that
+	// can't be decrypted.
+	DecryptError(lnwire.OpaqueReason, []byte) (*ForwardingError, error)
 }
 
 // UnknownEncrypterType is an error message used to signal that an unexpected
@@ -167,6 +201,11 @@ type OnionErrorDecrypter interface {
 // returned errors to concrete lnwire.FailureMessage instances.
 type SphinxErrorDecrypter struct {
 	OnionErrorDecrypter
+
+	// Circuit is the circuit of the payment. It is used to verify the
+	// attribution data of failures. If it is nil, the attribution data is
+	// ignored.
+	Circuit *sphinx.Circuit
 }
 
 // DecryptError peels off each layer of onion encryption from the first hop, to
@@ -174,23 +213,77 @@ type SphinxErrorDecrypter struct {
 // along with the source of the error.
 //
 // NOTE: Part of the ErrorDecrypter interface.
-func (s *SphinxErrorDecrypter) DecryptError(reason lnwire.OpaqueReason) (
-	*ForwardingError, error) {
+func (s *SphinxErrorDecrypter) DecryptError(reason lnwire.OpaqueReason,
+	attrData []byte) (*ForwardingError, error) {
 
 	failure, err := s.OnionErrorDecrypter.DecryptError(reason)
 	if err != nil {
-		return nil, err
+		// If the failure came with attribution data, we may still be
+		// able to tell which pair of nodes corrupted it.
+		if s.Circuit == nil {
+			return nil, err
+		}
+
+		numHops := len(s.Circuit.PaymentPath)
+		holdTimes, ok := s.verifyAttribution(reason, attrData, numHops)
+		if !ok {
+			return nil, err
+		}
+
+		// The first hop with an invalid HMAC or its predecessor is
+		// responsible. If all HMACs are valid, the final hop sent us a
+		// failure that we can't read.
+		return nil, &UnreadableFailureError{
+			AttributedIdx: min(len(holdTimes)+1, numHops),
+			HoldTimes:     holdTimes,
+		}
 	}
 
+	holdTimes, _ := s.verifyAttribution(
+		reason, attrData, failure.SenderIdx,
+	)
+
 	// Decode the failure. If an error occurs, we leave the failure message
 	// field nil.
+	var fwdErr *ForwardingError
 	r := bytes.NewReader(failure.Message)
 	failureMsg, err := lnwire.DecodeFailure(r, 0)
 	if err != nil {
-		return NewUnknownForwardingError(failure.SenderIdx), nil
+		fwdErr = NewUnknownForwardingError(failure.SenderIdx)
+	} else {
+		fwdErr = NewForwardingError(failureMsg, failure.SenderIdx)
+	}
+	fwdErr.HoldTimes = holdTimes
+
+	return fwdErr, nil
+}
+
+// verifyAttribution verifies the attribution data of a failure for at most
+// the given number of hops and returns the hold times of the hops with valid
+// attribution data. The boolean is false if the attribution data couldn't be
+// verified at all.
+func (s *SphinxErrorDecrypter) verifyAttribution(reason lnwire.OpaqueReason,
+	attrData []byte, maxHops int) ([]uint32, bool) {
+
+	if s.Circuit == nil || len(attrData) == 0 {
+		return nil, false
+	}
+
+	secrets, err := hop.SharedSecrets(s.Circuit)
+	if err != nil {
+		log.Debugf("Unable to derive shared secrets: %v", err)
+		return nil, false
+	}
+
+	holdTimes, err := hop.VerifyAttribution(
+		secrets, reason, attrData, maxHops,
+	)
+	if err != nil {
+		log.Debugf("Unable to verify attribution data: %v", err)
+		return nil, false
 	}
 
-	return NewForwardingError(failureMsg, failure.SenderIdx), nil
+	return holdTimes, true
 }
 
 // A compile time check to ensure ErrorDecrypter implements the Deobfuscator
@@ -54,6 +54,23 @@ const (
 	// OutgoingFailureForwardsDisabled is returned when the switch is
 	// configured to disallow forwards.
 	OutgoingFailureForwardsDisabled
+
+	// OutgoingFailureResourcesExhausted is returned when the resources of
+	// the outgoing channel that are available to an htlc are exhausted.
+	OutgoingFailureResourcesExhausted
+
+	// OutgoingFailurePeerHtlcBelowMin is returned when the amount of an
+	// htlc is below the minimum of its incoming peer's policy.
+	OutgoingFailurePeerHtlcBelowMin
+
+	// OutgoingFailurePeerMaxHtlcs is returned when forwarding an htlc
+	// would exceed the number of concurrent htlcs its incoming peer may
+	// have in flight.
+	OutgoingFailurePeerMaxHtlcs
+
+	// OutgoingFailurePeerMaxInFlight is returned when forwarding an htlc
+	// would exceed the value its incoming peer may have in flight.
+	OutgoingFailurePeerMaxInFlight
 )
 
 // FailureString returns the string representation of a failure detail.
@@ -91,6 +108,18 @@ func (fd OutgoingFailure) FailureString() string {
 	case OutgoingFailureForwardsDisabled:
 		return "node configured to disallow forwards"
 
+	case OutgoingFailureResourcesExhausted:
+		return "outgoing channel resources exhausted"
+
+	case OutgoingFailurePeerHtlcBelowMin:
+		return "htlc below incoming peer minimum"
+
+	case OutgoingFailurePeerMaxHtlcs:
+		return "incoming peer max htlcs exceeded"
+
+	case OutgoingFailurePeerMaxInFlight:
+		return "incoming peer max in-flight value exceeded"
+
 	default:
 		return "unknown failure detail"
 	}
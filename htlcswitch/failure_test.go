@@ -3,11 +3,14 @@ package htlcswitch
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/htlcswitch/hop"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/tlv"
 	"github.com/stretchr/testify/require"
@@ -56,7 +59,7 @@ func TestLongFailureMessage(t *testing.T) {
 	}
 
 	// Assert that the failure message can still be extracted.
-	failure, err := errorDecryptor.DecryptError(reason)
+	failure, err := errorDecryptor.DecryptError(reason, nil)
 	require.NoError(t, err)
 
 	incorrectDetails, ok := failure.msg.(*lnwire.FailIncorrectDetails)
@@ -77,6 +80,104 @@ func TestLongFailureMessage(t *testing.T) {
 	require.Equal(t, expectedValue, value.data)
 }
 
+// TestAttributableFailure tests that the attribution data added by the hops of
+// a route allows the sender to extract their hold times and to attribute
+// failures that were corrupted on their way back.
+func TestAttributableFailure(t *testing.T) {
+	t.Parallel()
+
+	const numHops = 4
+
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	path := make([]*btcec.PublicKey, numHops)
+	for i := range path {
+		privKey, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		path[i] = privKey.PubKey()
+	}
+
+	circuit := &sphinx.Circuit{
+		SessionKey:  sessionKey,
+		PaymentPath: path,
+	}
+
+	// Reconstruct the error encrypters of the hops from the shared secrets
+	// of the circuit. Each hop reports a hold time of one second.
+	secrets, err := hop.SharedSecrets(circuit)
+	require.NoError(t, err)
+
+	encrypters := make([]*hop.SphinxErrorEncrypter, numHops)
+	for i, secret := range secrets {
+		encrypters[i], err = hop.NewSphinxErrorEncrypterFromSecret(
+			secret, time.Now().Add(-time.Second),
+		)
+		require.NoError(t, err)
+	}
+
+	// relayFailure fails the payment at the final hop and relays the
+	// failure back to the sender. The reason is corrupted after it has
+	// been sent by the hop at the given index, if non-negative.
+	relayFailure := func(corruptIdx int) (lnwire.OpaqueReason, []byte) {
+		reason, attrData, err := encrypters[numHops-1].EncryptFirstHop(
+			lnwire.NewTemporaryChannelFailure(nil),
+		)
+		require.NoError(t, err)
+
+		for i := numHops - 1; i >= 0; i-- {
+			if i < numHops-1 {
+				reason, attrData = encrypters[i].
+					IntermediateEncrypt(reason, attrData)
+			}
+
+			if i == corruptIdx {
+				reason[0] ^= 1
+			}
+		}
+
+		return reason, attrData
+	}
+
+	errorDecryptor := &SphinxErrorDecrypter{
+		OnionErrorDecrypter: sphinx.NewOnionErrorDecrypter(circuit),
+		Circuit:             circuit,
+	}
+
+	// A failure relayed by honest hops can be decrypted and contains the
+	// hold times of all hops.
+	reason, attrData := relayFailure(-1)
+	require.Len(t, attrData, hop.AttrDataSize)
+
+	failure, err := errorDecryptor.DecryptError(reason, attrData)
+	require.NoError(t, err)
+	require.Equal(t, numHops, failure.FailureSourceIdx)
+	require.IsType(
+		t, &lnwire.FailTemporaryChannelFailure{}, failure.WireMessage(),
+	)
+	require.Len(t, failure.HoldTimes, numHops)
+	for _, holdTime := range failure.HoldTimes {
+		require.GreaterOrEqual(t, holdTime, uint32(10))
+	}
+
+	// If the failure is corrupted after it was sent by the third hop, the
+	// pair of the second and third hop is responsible.
+	reason, attrData = relayFailure(2)
+
+	_, err = errorDecryptor.DecryptError(reason, attrData)
+	var unreadableErr *UnreadableFailureError
+	require.ErrorAs(t, err, &unreadableErr)
+	require.ErrorIs(t, err, ErrUnreadableFailureMessage)
+	require.Equal(t, 3, unreadableErr.AttributedIdx)
+	require.Len(t, unreadableErr.HoldTimes, 2)
+
+	// Without attribution data, the failure can't be attributed.
+	_, err = errorDecryptor.DecryptError(reason, nil)
+	require.Error(t, err)
+	require.False(t, errors.As(err, &unreadableErr))
+}
+
 type varBytesRecordProducer struct {
 	data []byte
 }
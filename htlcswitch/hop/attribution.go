@@ -0,0 +1,281 @@
+package hop
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"golang.org/x/crypto/chacha20"
+)
+
+const (
+	// AttrHopCount is the maximum number of hops that attribution data
+	// holds hold times and HMACs for.
+	AttrHopCount = 20
+
+	// HoldTimeUnit is the unit of the hold times reported in the
+	// attribution data.
+	HoldTimeUnit = 100 * time.Millisecond
+
+	// holdTimeSize is the size of a single hold time in bytes.
+	holdTimeSize = 4
+
+	// attrHmacSize is the size of a single truncated HMAC in bytes.
+	attrHmacSize = 4
+
+	// numAttrHmacs is the number of HMACs in the attribution data. Each
+	// hop adds one HMAC for every position it may have in the route, and
+	// the HMACs that can no longer be valid are dropped as the failure is
+	// relayed back to the sender.
+	numAttrHmacs = AttrHopCount * (AttrHopCount + 1) / 2
+
+	// holdTimesSize is the size of the hold times part of the attribution
+	// data.
+	holdTimesSize = AttrHopCount * holdTimeSize
+
+	// AttrDataSize is the size of the attribution data of a failure.
+	AttrDataSize = holdTimesSize + numAttrHmacs*attrHmacSize
+)
+
+// HoldTimeSince returns the time since the passed time in units of
+// HoldTimeUnit. A zero time results in a zero hold time.
+func HoldTimeSince(start time.Time) uint32 {
+	if start.IsZero() {
+		return 0
+	}
+
+	holdTime := time.Since(start) / HoldTimeUnit
+	switch {
+	case holdTime < 0:
+		return 0
+
+	case holdTime > math.MaxUint32:
+		return math.MaxUint32
+	}
+
+	return uint32(holdTime)
+}
+
+// generateKey derives a key of the given type from a shared secret.
+func generateKey(keyType string, secret *sphinx.Hash256) [32]byte {
+	mac := hmac.New(sha256.New, []byte(keyType))
+	mac.Write(secret[:])
+
+	var key [32]byte
+	copy(key[:], mac.Sum(nil))
+
+	return key
+}
+
+// xorCipherStream XORs the data with the cipher stream derived from the
+// shared secret. Since this is a stream cipher, applying it twice restores
+// the original data.
+func xorCipherStream(keyType string, secret *sphinx.Hash256,
+	data []byte) []byte {
+
+	key := generateKey(keyType, secret)
+
+	var nonce [chacha20.NonceSize]byte
+	cipher, err := chacha20.NewUnauthenticatedCipher(key[:], nonce[:])
+	if err != nil {
+		// This can only happen for invalid key or nonce sizes.
+		panic(err)
+	}
+
+	out := make([]byte, len(data))
+	cipher.XORKeyStream(out, data)
+
+	return out
+}
+
+// hmacOffset returns the offset in the attribution data of the HMAC that the
+// hop at the given index committed to for the given number of upstream hops.
+// Index zero is the hop that last added its HMACs.
+func hmacOffset(hopIdx, upstreamHops int) int {
+	// The HMACs of each hop are stored in a block, where the block of the
+	// hop at index i holds AttrHopCount-i HMACs.
+	blockOffset := hopIdx*AttrHopCount - hopIdx*(hopIdx-1)/2
+
+	return holdTimesSize + (blockOffset+upstreamHops)*attrHmacSize
+}
+
+// attrHmac computes the HMAC of the hop at index zero assuming the given
+// number of hops upstream of it. It covers the failure reason sent by the hop,
+// the hold times and the HMACs of the downstream hops that are still present
+// once the failure reaches the sender.
+func attrHmac(secret *sphinx.Hash256, reason lnwire.OpaqueReason,
+	attrData []byte, upstreamHops int) []byte {
+
+	key := generateKey("um", secret)
+	mac := hmac.New(sha256.New, key[:])
+	mac.Write(reason)
+
+	numHops := AttrHopCount - upstreamHops
+	mac.Write(attrData[:numHops*holdTimeSize])
+
+	for i := 1; i < numHops; i++ {
+		offset := hmacOffset(i, upstreamHops)
+		mac.Write(attrData[offset : offset+attrHmacSize])
+	}
+
+	return mac.Sum(nil)[:attrHmacSize]
+}
+
+// shiftAttrData moves the hold times and HMACs of the downstream hops one
+// position to the right to make room for the ones of a new hop. The HMACs
+// that assumed no hops upstream of their hop are dropped.
+func shiftAttrData(attrData []byte) []byte {
+	shifted := make([]byte, AttrDataSize)
+	copy(shifted[holdTimeSize:holdTimesSize], attrData[:holdTimesSize])
+
+	for i := 0; i < AttrHopCount-1; i++ {
+		for r := 1; r < AttrHopCount-i; r++ {
+			from := hmacOffset(i, r)
+			to := hmacOffset(i+1, r-1)
+			copy(
+				shifted[to:to+attrHmacSize],
+				attrData[from:from+attrHmacSize],
+			)
+		}
+	}
+
+	return shifted
+}
+
+// unshiftAttrData reverts shiftAttrData, moving the hold times and HMACs one
+// position to the left. The data that was dropped when shifting is zeroed.
+func unshiftAttrData(attrData []byte) []byte {
+	unshifted := make([]byte, AttrDataSize)
+	copy(unshifted[:holdTimesSize-holdTimeSize], attrData[holdTimeSize:])
+
+	for i := 0; i < AttrHopCount-1; i++ {
+		for r := 1; r < AttrHopCount-i; r++ {
+			from := hmacOffset(i+1, r-1)
+			to := hmacOffset(i, r)
+			copy(
+				unshifted[to:to+attrHmacSize],
+				attrData[from:from+attrHmacSize],
+			)
+		}
+	}
+
+	return unshifted
+}
+
+// addAttribution adds our hold time and HMACs to the attribution data
+// received from downstream and encrypts the result. The reason is the failure
+// reason we send upstream. If the downstream node didn't provide valid
+// attribution data, new attribution data is created.
+func addAttribution(secret *sphinx.Hash256, reason lnwire.OpaqueReason,
+	attrData []byte, holdTime uint32) []byte {
+
+	if len(attrData) == AttrDataSize {
+		attrData = shiftAttrData(attrData)
+	} else {
+		attrData = make([]byte, AttrDataSize)
+	}
+
+	binary.BigEndian.PutUint32(attrData[:holdTimeSize], holdTime)
+
+	// As we don't know our position in the route, we add an HMAC for
+	// every possible number of hops upstream of us.
+	for r := 0; r < AttrHopCount; r++ {
+		offset := hmacOffset(0, r)
+		copy(
+			attrData[offset:offset+attrHmacSize],
+			attrHmac(secret, reason, attrData, r),
+		)
+	}
+
+	return xorCipherStream("ammagext", secret, attrData)
+}
+
+// SharedSecrets derives the shared secrets of the sender with each hop of the
+// circuit.
+func SharedSecrets(circuit *sphinx.Circuit) ([]sphinx.Hash256, error) {
+	secrets := make([]sphinx.Hash256, len(circuit.PaymentPath))
+
+	// The ephemeral key of each hop is the session key blinded by the
+	// blinding factors of all previous hops.
+	var ephemeral btcec.ModNScalar
+	ephemeral.Set(&circuit.SessionKey.Key)
+
+	for i, hopKey := range circuit.PaymentPath {
+		ephemeralKey := btcec.PrivKeyFromScalar(&ephemeral)
+		ecdh := &sphinx.PrivKeyECDH{PrivKey: ephemeralKey}
+
+		secret, err := ecdh.ECDH(hopKey)
+		if err != nil {
+			return nil, err
+		}
+		secrets[i] = secret
+
+		// The blinding factor of the hop is sha256(E || ss).
+		h := sha256.New()
+		h.Write(ephemeralKey.PubKey().SerializeCompressed())
+		h.Write(secret[:])
+
+		var blindingFactor btcec.ModNScalar
+		blindingFactor.SetByteSlice(h.Sum(nil))
+		ephemeral.Mul(&blindingFactor)
+	}
+
+	return secrets, nil
+}
+
+// VerifyAttribution verifies the attribution data of a failure that we
+// received for a payment with the given shared secrets. At most maxHops hops
+// are verified, starting from the first hop. The hold times of the hops whose
+// HMACs are valid are returned in units of HoldTimeUnit. If fewer hold times
+// than hops are returned, the HMAC of the hop following the last returned one
+// is invalid, meaning that either that hop or its predecessor corrupted the
+// failure.
+func VerifyAttribution(secrets []sphinx.Hash256, reason lnwire.OpaqueReason,
+	attrData []byte, maxHops int) ([]uint32, error) {
+
+	if len(attrData) != AttrDataSize {
+		return nil, fmt.Errorf("invalid attribution data length: "+
+			"expected %v, got %v", AttrDataSize, len(attrData))
+	}
+
+	maxHops = min(maxHops, len(secrets), AttrHopCount)
+
+	var holdTimes []uint32
+	for i := 0; i < maxHops; i++ {
+		secret := &secrets[i]
+
+		// Strip the encryption of this hop to obtain the attribution
+		// data as it was produced by the hop.
+		attrData = xorCipherStream("ammagext", secret, attrData)
+
+		// The hop has i hops upstream of it, so the HMAC it committed
+		// to for this position must be valid.
+		offset := hmacOffset(0, i)
+		expectedHmac := attrData[offset : offset+attrHmacSize]
+		if !hmac.Equal(
+			expectedHmac, attrHmac(secret, reason, attrData, i),
+		) {
+
+			break
+		}
+
+		holdTimes = append(
+			holdTimes,
+			binary.BigEndian.Uint32(attrData[:holdTimeSize]),
+		)
+
+		// Strip the legacy encryption of this hop to obtain the reason
+		// the next hop sent, and restore the attribution data it
+		// produced.
+		reason = xorCipherStream("ammag", secret, reason)
+		attrData = unshiftAttrData(attrData)
+	}
+
+	return holdTimes, nil
+}
@@ -0,0 +1,202 @@
+package hop
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// testAttrSecret returns a deterministic shared secret for the hop at the
+// given index.
+func testAttrSecret(idx int) sphinx.Hash256 {
+	return sha256.Sum256([]byte(fmt.Sprintf("attribution hop %d", idx)))
+}
+
+// testAttrReason returns a deterministic failure reason sent by the hop at the
+// given index.
+func testAttrReason(idx int) lnwire.OpaqueReason {
+	reason := make(lnwire.OpaqueReason, 292)
+	for i := range reason {
+		reason[i] = byte(idx*31 + i)
+	}
+
+	return reason
+}
+
+// expectedAttrHmac computes the truncated HMAC of a hop independently of
+// attrHmac: HMAC-SHA256 keyed with the "um" key of the hop over the reason it
+// sent, the hold times of the hop and the downstream hops and the HMACs of
+// the downstream hops for the same number of upstream hops.
+func expectedAttrHmac(secret sphinx.Hash256, reason lnwire.OpaqueReason,
+	attrData []byte, upstreamHops int) []byte {
+
+	umKey := hmac.New(sha256.New, []byte("um"))
+	umKey.Write(secret[:])
+
+	mac := hmac.New(sha256.New, umKey.Sum(nil))
+	mac.Write(reason)
+
+	numHops := AttrHopCount - upstreamHops
+	mac.Write(attrData[:numHops*4])
+
+	// The block of downstream hop i starts after the blocks of the hops
+	// before it, which hold 20, 19, ... HMACs, and the HMAC for our
+	// number of upstream hops is at the same index within each block.
+	blockStart := 80
+	for i := 1; i < numHops; i++ {
+		blockStart += (AttrHopCount - i + 1) * 4
+		offset := blockStart + upstreamHops*4
+		mac.Write(attrData[offset : offset+4])
+	}
+
+	return mac.Sum(nil)[:4]
+}
+
+// TestAttrDataLayout asserts the layout of the attribution data: 20 hold
+// times followed by 210 HMACs, where the block of the hop at index i holds
+// 20-i HMACs that are ordered by the number of hops upstream of the hop.
+func TestAttrDataLayout(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 920, AttrDataSize)
+	require.Equal(t, 210, numAttrHmacs)
+
+	// The HMACs must be laid out contiguously, block after block.
+	expectedOffset := 80
+	for i := 0; i < AttrHopCount; i++ {
+		for r := 0; r < AttrHopCount-i; r++ {
+			require.Equal(
+				t, expectedOffset, hmacOffset(i, r),
+				"hop %d, upstream hops %d", i, r,
+			)
+			expectedOffset += 4
+		}
+	}
+	require.Equal(t, AttrDataSize, expectedOffset)
+
+	// Spot check the start of some blocks.
+	require.Equal(t, 80, hmacOffset(0, 0))
+	require.Equal(t, 160, hmacOffset(1, 0))
+	require.Equal(t, 236, hmacOffset(2, 0))
+	require.Equal(t, 916, hmacOffset(19, 0))
+}
+
+// TestAttributionHmacOrdering checks the attribution data produced by a route
+// of three hops byte by byte: each hop stores its hold time first, moves the
+// hold times and HMACs of the downstream hops by one position, dropping the
+// HMACs that can no longer be valid, and commits to the hold times and
+// downstream HMACs that are still present for each possible position.
+func TestAttributionHmacOrdering(t *testing.T) {
+	t.Parallel()
+
+	const numHops = 3
+
+	var (
+		attrData  []byte
+		holdTimes = [numHops]uint32{1, 20, 300}
+	)
+
+	// The final hop creates the attribution data, after which it is
+	// relayed back to the first hop.
+	for idx := numHops - 1; idx >= 0; idx-- {
+		secret := testAttrSecret(idx)
+		reason := testAttrReason(idx)
+
+		received := attrData
+		attrData = addAttribution(
+			&secret, reason, received, holdTimes[idx],
+		)
+		require.Len(t, attrData, AttrDataSize)
+
+		plain := xorCipherStream("ammagext", &secret, attrData)
+
+		require.Equal(
+			t, holdTimes[idx], binary.BigEndian.Uint32(plain[:4]),
+		)
+
+		// The final hop starts with empty data, the other hops move
+		// the data they received by one position.
+		if received == nil {
+			received = make([]byte, AttrDataSize)
+		}
+		require.Equal(t, received[:76], plain[4:80])
+		for i := 0; i < AttrHopCount-1; i++ {
+			for r := 1; r < AttrHopCount-i; r++ {
+				from := hmacOffset(i, r)
+				to := hmacOffset(i+1, r-1)
+				require.Equal(
+					t, received[from:from+4],
+					plain[to:to+4],
+					"hop %d, block %d, upstream hops %d",
+					idx, i, r,
+				)
+			}
+		}
+
+		// The first block holds an HMAC for every possible number of
+		// upstream hops.
+		for r := 0; r < AttrHopCount; r++ {
+			offset := 80 + r*4
+			require.Equal(
+				t, expectedAttrHmac(secret, reason, plain, r),
+				plain[offset:offset+4],
+				"hop %d, upstream hops %d", idx, r,
+			)
+		}
+	}
+}
+
+// TestAttributionVector pins the attribution data that a route of five hops
+// produces for deterministic secrets, reasons and hold times, so that any
+// change to the encoding is caught, and checks that the sender recovers the
+// hold times of all hops from it.
+func TestAttributionVector(t *testing.T) {
+	t.Parallel()
+
+	const numHops = 5
+
+	var (
+		attrData []byte
+		secrets  = make([]sphinx.Hash256, numHops)
+		reason   = testAttrReason(numHops - 1)
+	)
+	for idx := numHops - 1; idx >= 0; idx-- {
+		secrets[idx] = testAttrSecret(idx)
+
+		// Every hop but the final one wraps the reason it received.
+		if idx != numHops-1 {
+			reason = xorCipherStream("ammag", &secrets[idx], reason)
+		}
+
+		attrData = addAttribution(
+			&secrets[idx], reason, attrData, uint32(idx+1)*10,
+		)
+	}
+
+	digest := sha256.Sum256(attrData)
+	require.Equal(
+		t, "de71bca65ab5c81689a3dcdeec35b435"+
+			"000e2f6f2c3bc1e0f652e6b93fc47292",
+		hex.EncodeToString(digest[:]),
+	)
+
+	holdTimes, err := VerifyAttribution(secrets, reason, attrData, numHops)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{10, 20, 30, 40, 50}, holdTimes)
+
+	// The hold time of the third hop is covered by the HMACs of all hops
+	// up to it, so modifying it after it has been relayed invalidates the
+	// HMAC of the first hop.
+	attrData[2*holdTimeSize+holdTimeSize-1] ^= 1
+
+	holdTimes, err = VerifyAttribution(secrets, reason, attrData, numHops)
+	require.NoError(t, err)
+	require.Empty(t, holdTimes)
+}
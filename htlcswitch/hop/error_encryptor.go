@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	sphinx "github.com/lightningnetwork/lightning-onion"
@@ -55,22 +56,26 @@ type ErrorEncrypterExtracter func(*btcec.PublicKey) (ErrorEncrypter,
 // back to the source of the payment.
 type ErrorEncrypter interface {
 	// EncryptFirstHop transforms a concrete failure message into an
-	// encrypted opaque failure reason. This method will be used at the
-	// source that the error occurs. It differs from IntermediateEncrypt
-	// slightly, in that it computes a proper MAC over the error.
-	EncryptFirstHop(lnwire.FailureMessage) (lnwire.OpaqueReason, error)
+	// encrypted opaque failure reason and its attribution data. This
+	// method will be used at the source that the error occurs. It differs
+	// from IntermediateEncrypt slightly, in that it computes a proper MAC
+	// over the error.
+	EncryptFirstHop(lnwire.FailureMessage) (lnwire.OpaqueReason, []byte,
+		error)
 
 	// EncryptMalformedError is similar to EncryptFirstHop (it adds the
 	// MAC), but it accepts an opaque failure reason rather than a failure
 	// message. This method is used when we receive an
 	// UpdateFailMalformedHTLC from the remote peer and then need to
 	// convert that into a proper error from only the raw bytes.
-	EncryptMalformedError(lnwire.OpaqueReason) lnwire.OpaqueReason
+	EncryptMalformedError(lnwire.OpaqueReason) (lnwire.OpaqueReason, []byte)
 
 	// IntermediateEncrypt wraps an already encrypted opaque reason error
-	// in an additional layer of onion encryption. This process repeats
-	// until the error arrives at the source of the payment.
-	IntermediateEncrypt(lnwire.OpaqueReason) lnwire.OpaqueReason
+	// in an additional layer of onion encryption and adds our hold time
+	// to the attribution data received from downstream. This process
+	// repeats until the error arrives at the source of the payment.
+	IntermediateEncrypt(lnwire.OpaqueReason, []byte) (lnwire.OpaqueReason,
+		[]byte)
 
 	// Type returns an enum indicating the underlying concrete instance
 	// backing this interface.
@@ -90,6 +95,16 @@ type ErrorEncrypter interface {
 	// NOTE: This should be called shortly after Decode to properly
 	// reinitialize the error encrypter.
 	Reextract(ErrorEncrypterExtracter) error
+
+	// ReceivedTime returns the time the HTLC this encrypter belongs to
+	// was received, which is used to report our hold time. A zero time is
+	// returned if it is unknown.
+	ReceivedTime() time.Time
+
+	// SetReceivedTime sets the time the HTLC this encrypter belongs to was
+	// received. This is used to restore the time after a restart, as it
+	// isn't part of the encoding of the encrypter.
+	SetReceivedTime(time.Time)
 }
 
 // SphinxErrorEncrypter is a concrete implementation of both the ErrorEncrypter
@@ -100,6 +115,15 @@ type SphinxErrorEncrypter struct {
 	*sphinx.OnionErrorEncrypter
 
 	EphemeralKey *btcec.PublicKey
+
+	// sharedSecret is the secret shared with the sender of the HTLC, which
+	// keys the attribution data of failures.
+	sharedSecret sphinx.Hash256
+
+	// receivedAt is the time the HTLC this encrypter belongs to was
+	// received. It is used to report our hold time in the attribution data
+	// of failures.
+	receivedAt time.Time
 }
 
 // NewSphinxErrorEncrypter initializes a blank sphinx error encrypter, that
@@ -116,23 +140,68 @@ func NewSphinxErrorEncrypter() *SphinxErrorEncrypter {
 	}
 }
 
+// newSphinxErrorEncrypter creates a sphinx error encrypter for an HTLC
+// received at the given time from the onion error encrypter derived from the
+// HTLC's ephemeral key.
+func newSphinxErrorEncrypter(encrypter *sphinx.OnionErrorEncrypter,
+	ephemeralKey *btcec.PublicKey,
+	receivedAt time.Time) (*SphinxErrorEncrypter, error) {
+
+	// The onion error encrypter only exposes its shared secret through
+	// its encoding, so we extract it once here.
+	var b bytes.Buffer
+	if err := encrypter.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	s := &SphinxErrorEncrypter{
+		OnionErrorEncrypter: encrypter,
+		EphemeralKey:        ephemeralKey,
+		receivedAt:          receivedAt,
+	}
+	copy(s.sharedSecret[:], b.Bytes())
+
+	return s, nil
+}
+
+// NewSphinxErrorEncrypterFromSecret creates a sphinx error encrypter for an
+// HTLC received at the given time from the secret shared with its sender.
+func NewSphinxErrorEncrypterFromSecret(secret sphinx.Hash256,
+	receivedAt time.Time) (*SphinxErrorEncrypter, error) {
+
+	encrypter := &sphinx.OnionErrorEncrypter{}
+	err := encrypter.Decode(bytes.NewReader(secret[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	return &SphinxErrorEncrypter{
+		OnionErrorEncrypter: encrypter,
+		EphemeralKey:        &btcec.PublicKey{},
+		sharedSecret:        secret,
+		receivedAt:          receivedAt,
+	}, nil
+}
+
 // EncryptFirstHop transforms a concrete failure message into an encrypted
-// opaque failure reason. This method will be used at the source that the error
-// occurs. It differs from BackwardObfuscate slightly, in that it computes a
-// proper MAC over the error.
+// opaque failure reason and its attribution data. This method will be used at
+// the source that the error occurs. It differs from BackwardObfuscate
+// slightly, in that it computes a proper MAC over the error.
 //
 // NOTE: Part of the ErrorEncrypter interface.
 func (s *SphinxErrorEncrypter) EncryptFirstHop(
-	failure lnwire.FailureMessage) (lnwire.OpaqueReason, error) {
+	failure lnwire.FailureMessage) (lnwire.OpaqueReason, []byte, error) {
 
 	var b bytes.Buffer
 	if err := lnwire.EncodeFailure(&b, failure, 0); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// We pass a true as the first parameter to indicate that a MAC should
 	// be added.
-	return s.EncryptError(true, b.Bytes()), nil
+	reason := s.EncryptError(true, b.Bytes())
+
+	return reason, s.attribute(reason, nil), nil
 }
 
 // EncryptMalformedError is similar to EncryptFirstHop (it adds the MAC), but
@@ -143,22 +212,53 @@ func (s *SphinxErrorEncrypter) EncryptFirstHop(
 //
 // NOTE: Part of the ErrorEncrypter interface.
 func (s *SphinxErrorEncrypter) EncryptMalformedError(
-	reason lnwire.OpaqueReason) lnwire.OpaqueReason {
+	reason lnwire.OpaqueReason) (lnwire.OpaqueReason, []byte) {
 
-	return s.EncryptError(true, reason)
+	reason = s.EncryptError(true, reason)
+
+	return reason, s.attribute(reason, nil)
 }
 
 // IntermediateEncrypt wraps an already encrypted opaque reason error in an
 // additional layer of onion encryption. This process repeats until the error
 // arrives at the source of the payment. We re-encrypt the message on the
 // backwards path to ensure that the error is indistinguishable from any other
-// error seen.
+// error seen. Our hold time and HMACs are added to the attribution data
+// received from downstream, which is created if it is missing.
+//
+// NOTE: Part of the ErrorEncrypter interface.
+func (s *SphinxErrorEncrypter) IntermediateEncrypt(reason lnwire.OpaqueReason,
+	attrData []byte) (lnwire.OpaqueReason, []byte) {
+
+	reason = s.EncryptError(false, reason)
+
+	return reason, s.attribute(reason, attrData)
+}
+
+// attribute adds our hold time and HMACs to the attribution data of a failure
+// with the given reason.
+func (s *SphinxErrorEncrypter) attribute(reason lnwire.OpaqueReason,
+	attrData []byte) []byte {
+
+	return addAttribution(
+		&s.sharedSecret, reason, attrData, HoldTimeSince(s.receivedAt),
+	)
+}
+
+// ReceivedTime returns the time the HTLC this encrypter belongs to was
+// received.
 //
 // NOTE: Part of the ErrorEncrypter interface.
-func (s *SphinxErrorEncrypter) IntermediateEncrypt(
-	reason lnwire.OpaqueReason) lnwire.OpaqueReason {
+func (s *SphinxErrorEncrypter) ReceivedTime() time.Time {
+	return s.receivedAt
+}
 
-	return s.EncryptError(false, reason)
+// SetReceivedTime sets the time the HTLC this encrypter belongs to was
+// received.
+//
+// NOTE: Part of the ErrorEncrypter interface.
+func (s *SphinxErrorEncrypter) SetReceivedTime(receivedAt time.Time) {
+	s.receivedAt = receivedAt
 }
 
 // Type returns the identifier for a sphinx error encrypter.
@@ -211,8 +311,10 @@ func (s *SphinxErrorEncrypter) Reextract(
 		return fmt.Errorf("incorrect onion error extracter")
 	}
 
-	// Copy the freshly extracted encrypter.
+	// Copy the freshly extracted encrypter. The time the HTLC was
+	// received is restored separately, so we leave it untouched.
 	s.OnionErrorEncrypter = sphinxEncrypter.OnionErrorEncrypter
+	s.sharedSecret = sphinxEncrypter.sharedSecret
 
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/chainhash/v2"
@@ -926,8 +927,13 @@ func (p *OnionProcessor) ExtractErrorEncrypter(ephemeralKey *btcec.PublicKey) (
 		}
 	}
 
-	return &SphinxErrorEncrypter{
-		OnionErrorEncrypter: onionObfuscator,
-		EphemeralKey:        ephemeralKey,
-	}, lnwire.CodeNone
+	encrypter, err := newSphinxErrorEncrypter(
+		onionObfuscator, ephemeralKey, time.Now(),
+	)
+	if err != nil {
+		log.Errorf("unable to create error encrypter: %v", err)
+		return nil, lnwire.CodeInvalidOnionKey
+	}
+
+	return encrypter, lnwire.CodeNone
 }
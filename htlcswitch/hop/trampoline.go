@@ -0,0 +1,353 @@
+package hop
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+const (
+	// TrampolineOnionPayloadSize is the size of the routing info of a
+	// trampoline onion. It is considerably smaller than a regular onion so
+	// that it fits into the final hop payload of the outer onion.
+	TrampolineOnionPayloadSize = 400
+
+	// hopHintSize is the serialized size of a single route hint hop:
+	// node_id (33), short_channel_id (8), fee_base_msat (4),
+	// fee_proportional_millionths (4) and cltv_expiry_delta (2).
+	hopHintSize = 33 + 8 + 4 + 4 + 2
+)
+
+var (
+	// ErrTrampolineNextHopUnsupported is returned when a trampoline onion
+	// instructs us to forward it to another trampoline node, which we
+	// don't support yet.
+	ErrTrampolineNextHopUnsupported = errors.New("forwarding to another " +
+		"trampoline node is not supported")
+
+	// ErrTrampolineMissingNodeID is returned when a trampoline payload
+	// doesn't specify the node the payment should be forwarded to.
+	ErrTrampolineMissingNodeID = errors.New("trampoline payload is " +
+		"missing the outgoing node id")
+)
+
+// TrampolinePayload is the payload a trampoline node finds in the trampoline
+// onion. It instructs the trampoline node to find a route to the outgoing node
+// and forward the given amount to it.
+type TrampolinePayload struct {
+	// AmtToForward is the amount the outgoing node should receive.
+	AmtToForward lnwire.MilliSatoshi
+
+	// OutgoingCltv is the cltv expiry the outgoing node should receive.
+	OutgoingCltv uint32
+
+	// OutgoingNodeID is the node the payment should be forwarded to.
+	OutgoingNodeID *btcec.PublicKey
+
+	// MPP is the payment data of the outgoing node's invoice. It is only
+	// set if the outgoing node is the final recipient.
+	MPP *record.MPP
+
+	// InvoiceFeatures are the features of the recipient's invoice. They
+	// are only set if the recipient doesn't support trampoline itself.
+	InvoiceFeatures *lnwire.RawFeatureVector
+
+	// RouteHints are the route hints of the recipient's invoice. They
+	// are only set if the recipient doesn't support trampoline itself.
+	RouteHints [][]zpay32.HopHint
+}
+
+// Encode serializes the trampoline payload as a TLV stream.
+func (t *TrampolinePayload) Encode(w io.Writer) error {
+	if t.OutgoingNodeID == nil {
+		return ErrTrampolineMissingNodeID
+	}
+
+	amt := uint64(t.AmtToForward)
+	records := []tlv.Record{
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&t.OutgoingCltv),
+		tlv.MakePrimitiveRecord(
+			record.OutgoingNodeIDOnionType, &t.OutgoingNodeID,
+		),
+	}
+
+	if t.MPP != nil {
+		records = append(records, t.MPP.Record())
+	}
+
+	if t.InvoiceFeatures != nil && !t.InvoiceFeatures.IsEmpty() {
+		var b bytes.Buffer
+		if err := t.InvoiceFeatures.EncodeBase256(&b); err != nil {
+			return err
+		}
+
+		features := b.Bytes()
+		records = append(records, tlv.MakePrimitiveRecord(
+			record.InvoiceFeaturesOnionType, &features,
+		))
+	}
+
+	if len(t.RouteHints) > 0 {
+		routingInfo, err := encodeRouteHints(t.RouteHints)
+		if err != nil {
+			return err
+		}
+
+		records = append(records, tlv.MakePrimitiveRecord(
+			record.InvoiceRoutingInfoOnionType, &routingInfo,
+		))
+	}
+
+	tlv.SortRecords(records)
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	return stream.Encode(w)
+}
+
+// ParseTrampolinePayload parses a trampoline payload from the passed reader.
+func ParseTrampolinePayload(r io.Reader) (*TrampolinePayload, error) {
+	var (
+		amt         uint64
+		cltv        uint32
+		nodeID      *btcec.PublicKey
+		mpp         = &record.MPP{}
+		features    []byte
+		routingInfo []byte
+	)
+
+	stream, err := tlv.NewStream(
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&cltv),
+		mpp.Record(),
+		tlv.MakePrimitiveRecord(
+			record.InvoiceFeaturesOnionType, &features,
+		),
+		tlv.MakePrimitiveRecord(
+			record.OutgoingNodeIDOnionType, &nodeID,
+		),
+		tlv.MakePrimitiveRecord(
+			record.InvoiceRoutingInfoOnionType, &routingInfo,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypesP2P(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// The amount, cltv and outgoing node are required for the trampoline
+	// node to be able to forward the payment.
+	for _, required := range []tlv.Type{
+		record.AmtOnionType, record.LockTimeOnionType,
+		record.OutgoingNodeIDOnionType,
+	} {
+		if _, ok := parsedTypes[required]; !ok {
+			return nil, ErrInvalidPayload{
+				Type:      required,
+				Violation: OmittedViolation,
+				FinalHop:  true,
+			}
+		}
+	}
+
+	payload := &TrampolinePayload{
+		AmtToForward:   lnwire.MilliSatoshi(amt),
+		OutgoingCltv:   cltv,
+		OutgoingNodeID: nodeID,
+	}
+
+	if _, ok := parsedTypes[record.MPPOnionType]; ok {
+		payload.MPP = mpp
+	}
+
+	if _, ok := parsedTypes[record.InvoiceFeaturesOnionType]; ok {
+		fv := lnwire.NewRawFeatureVector()
+		err := fv.DecodeBase256(
+			bytes.NewReader(features), len(features),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid invoice features: %w",
+				err)
+		}
+		payload.InvoiceFeatures = fv
+	}
+
+	if _, ok := parsedTypes[record.InvoiceRoutingInfoOnionType]; ok {
+		payload.RouteHints, err = decodeRouteHints(routingInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return payload, nil
+}
+
+// encodeRouteHints serializes the given route hints. Each route is prefixed
+// by its number of hops.
+func encodeRouteHints(routeHints [][]zpay32.HopHint) ([]byte, error) {
+	var b bytes.Buffer
+	for _, routeHint := range routeHints {
+		if len(routeHint) > 255 {
+			return nil, fmt.Errorf("route hint with %d hops too "+
+				"long", len(routeHint))
+		}
+		b.WriteByte(byte(len(routeHint)))
+
+		for _, hopHint := range routeHint {
+			var buf [hopHintSize]byte
+			copy(buf[:33], hopHint.NodeID.SerializeCompressed())
+			binary.BigEndian.PutUint64(
+				buf[33:41], hopHint.ChannelID,
+			)
+			binary.BigEndian.PutUint32(
+				buf[41:45], hopHint.FeeBaseMSat,
+			)
+			binary.BigEndian.PutUint32(
+				buf[45:49], hopHint.FeeProportionalMillionths,
+			)
+			binary.BigEndian.PutUint16(
+				buf[49:51], hopHint.CLTVExpiryDelta,
+			)
+			b.Write(buf[:])
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeRouteHints parses route hints that were serialized with
+// encodeRouteHints.
+func decodeRouteHints(b []byte) ([][]zpay32.HopHint, error) {
+	var routeHints [][]zpay32.HopHint
+	for len(b) > 0 {
+		numHops := int(b[0])
+		b = b[1:]
+
+		if numHops == 0 || len(b) < numHops*hopHintSize {
+			return nil, fmt.Errorf("invalid route hint length")
+		}
+
+		routeHint := make([]zpay32.HopHint, 0, numHops)
+		for i := 0; i < numHops; i++ {
+			nodeID, err := btcec.ParsePubKey(b[:33])
+			if err != nil {
+				return nil, err
+			}
+
+			routeHint = append(routeHint, zpay32.HopHint{
+				NodeID:    nodeID,
+				ChannelID: binary.BigEndian.Uint64(b[33:41]),
+				FeeBaseMSat: binary.BigEndian.Uint32(
+					b[41:45],
+				),
+				FeeProportionalMillionths: binary.BigEndian.
+					Uint32(b[45:49]),
+				CLTVExpiryDelta: binary.BigEndian.Uint16(
+					b[49:51],
+				),
+			})
+			b = b[hopHintSize:]
+		}
+
+		routeHints = append(routeHints, routeHint)
+	}
+
+	return routeHints, nil
+}
+
+// NewTrampolineOnion creates a trampoline onion that instructs the given
+// trampoline node to forward the payment as described by the payload. The
+// payment hash is used as associated data, binding the trampoline onion to the
+// payment.
+func NewTrampolineOnion(trampoline *btcec.PublicKey,
+	payload *TrampolinePayload, paymentHash []byte,
+	sessionKey *btcec.PrivateKey) ([]byte, error) {
+
+	var b bytes.Buffer
+	if err := payload.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	hopPayload, err := sphinx.NewTLVHopPayload(b.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	var path sphinx.PaymentPath
+	path[0] = sphinx.OnionHop{
+		NodePub:    *trampoline,
+		HopPayload: hopPayload,
+	}
+
+	pkt, err := sphinx.NewOnionPacket(
+		&path, sessionKey, paymentHash,
+		sphinx.DeterministicPacketFiller,
+		sphinx.WithMaxPayloadSize(TrampolineOnionPayloadSize),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var onion bytes.Buffer
+	if err := pkt.Encode(&onion); err != nil {
+		return nil, err
+	}
+
+	return onion.Bytes(), nil
+}
+
+// DecodeTrampolineOnion peels the trampoline onion that was included in the
+// final hop payload of an incoming HTLC and returns the payload addressed to
+// us.
+//
+// NOTE: The trampoline onion is shared by all parts of a multi-part payment
+// and may be decoded again after a restart, so no replay protection is
+// performed here. The outer onion of each HTLC is protected by the replay log
+// as usual.
+func (p *OnionProcessor) DecodeTrampolineOnion(onion []byte,
+	paymentHash []byte) (*TrampolinePayload, error) {
+
+	onionPkt := &sphinx.OnionPacket{}
+	if err := onionPkt.Decode(bytes.NewReader(onion)); err != nil {
+		return nil, err
+	}
+
+	processed, err := p.router.ReconstructOnionPacket(
+		onionPkt, paymentHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	switch processed.Action {
+	case sphinx.ExitNode:
+
+	case sphinx.MoreHops:
+		return nil, ErrTrampolineNextHopUnsupported
+
+	default:
+		return nil, fmt.Errorf("unexpected trampoline onion action: "+
+			"%v", processed.Action)
+	}
+
+	return ParseTrampolinePayload(
+		bytes.NewReader(processed.Payload.Payload),
+	)
+}
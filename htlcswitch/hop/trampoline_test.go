@@ -0,0 +1,111 @@
+package hop
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTrampolineOnion tests that a trampoline onion created by the sender can
+// be decoded by the trampoline node.
+func TestTrampolineOnion(t *testing.T) {
+	t.Parallel()
+
+	trampolineKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	recipientKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	hintKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	features := lnwire.NewRawFeatureVector(
+		lnwire.TLVOnionPayloadRequired, lnwire.PaymentAddrRequired,
+		lnwire.MPPOptional,
+	)
+
+	payload := &TrampolinePayload{
+		AmtToForward:    100_000,
+		OutgoingCltv:    800_040,
+		OutgoingNodeID:  recipientKey.PubKey(),
+		MPP:             record.NewMPP(100_000, [32]byte{1, 2, 3}),
+		InvoiceFeatures: features,
+		RouteHints: [][]zpay32.HopHint{{
+			{
+				NodeID:                    hintKey.PubKey(),
+				ChannelID:                 12345,
+				FeeBaseMSat:               1000,
+				FeeProportionalMillionths: 10,
+				CLTVExpiryDelta:           40,
+			},
+			{
+				NodeID:                    trampolineKey.PubKey(),
+				ChannelID:                 6789,
+				FeeBaseMSat:               0,
+				FeeProportionalMillionths: 1,
+				CLTVExpiryDelta:           80,
+			},
+		}},
+	}
+
+	paymentHash := bytes.Repeat([]byte{9}, 32)
+	onion, err := NewTrampolineOnion(
+		trampolineKey.PubKey(), payload, paymentHash, sessionKey,
+	)
+	require.NoError(t, err)
+
+	processor := NewOnionProcessor(sphinx.NewRouter(
+		&sphinx.PrivKeyECDH{PrivKey: trampolineKey},
+		sphinx.NewMemoryReplayLog(),
+	))
+
+	// The onion can be decoded repeatedly, as all parts of a multi-part
+	// payment carry the same trampoline onion.
+	for i := 0; i < 2; i++ {
+		decoded, err := processor.DecodeTrampolineOnion(
+			onion, paymentHash,
+		)
+		require.NoError(t, err)
+		require.Equal(t, payload, decoded)
+	}
+
+	// The onion is bound to the payment hash.
+	_, err = processor.DecodeTrampolineOnion(
+		onion, bytes.Repeat([]byte{8}, 32),
+	)
+	require.Error(t, err)
+}
+
+// TestParseTrampolinePayloadRequired tests that a trampoline payload without
+// an outgoing node is rejected.
+func TestParseTrampolinePayloadRequired(t *testing.T) {
+	t.Parallel()
+
+	// An amount and cltv alone aren't enough to forward the payment.
+	var b bytes.Buffer
+	amt, cltv := uint64(1000), uint32(100)
+	stream, err := tlv.NewStream(
+		record.NewAmtToFwdRecord(&amt), record.NewLockTimeRecord(&cltv),
+	)
+	require.NoError(t, err)
+	require.NoError(t, stream.Encode(&b))
+
+	_, err = ParseTrampolinePayload(&b)
+	require.ErrorIs(t, err, ErrInvalidPayload{
+		Type:      record.OutgoingNodeIDOnionType,
+		Violation: OmittedViolation,
+		FinalHop:  true,
+	})
+
+	// Encoding a payload without an outgoing node fails.
+	err = (&TrampolinePayload{}).Encode(&b)
+	require.ErrorIs(t, err, ErrTrampolineMissingNodeID)
+}
@@ -309,6 +309,44 @@ type FinalHtlcEvent struct {
 	Timestamp time.Time
 }
 
+// StuckHtlcInfo provides the details of a htlc of a local payment that is
+// held by a downstream node for longer than expected.
+type StuckHtlcInfo struct {
+	// PaymentHash is the hash identifying the payment the htlc belongs to.
+	PaymentHash lntypes.Hash
+
+	// AttemptID is the id of the payment attempt that sent the htlc.
+	AttemptID uint64
+
+	// Age is the time that passed since the htlc was sent.
+	Age time.Duration
+
+	// ForceClosed is true if the channel to the first hop was force closed
+	// because the expiry of the htlc came too close.
+	ForceClosed bool
+}
+
+// StuckHtlcEvent represents a htlc of a local payment that has been in flight
+// for longer than the configured threshold. The outgoing htlc id is not known
+// to the router, so only the outgoing channel of the htlc key is set. The
+// attempt id can be used to match the event with the payment attempt.
+type StuckHtlcEvent struct {
+	// HtlcKey identifies the channel the htlc was sent over.
+	HtlcKey
+
+	// HtlcInfo contains details about the htlc.
+	HtlcInfo
+
+	// StuckHtlcInfo contains details about the stuck payment attempt.
+	StuckHtlcInfo
+
+	// HtlcEventType classifies the event as part of a local send.
+	HtlcEventType
+
+	// Timestamp is the time when the htlc was detected to be stuck.
+	Timestamp time.Time
+}
+
 // NotifyForwardingEvent notifies the HtlcNotifier than a htlc has been
 // forwarded.
 //
@@ -418,6 +456,28 @@ func (h *HtlcNotifier) NotifyFinalHtlcEvent(key models.CircuitKey,
 	}
 }
 
+// NotifyStuckHtlcEvent notifies the HtlcNotifier that a htlc of a local
+// payment is stuck.
+func (h *HtlcNotifier) NotifyStuckHtlcEvent(key HtlcKey, info HtlcInfo,
+	stuckInfo StuckHtlcInfo) {
+
+	event := &StuckHtlcEvent{
+		HtlcKey:       key,
+		HtlcInfo:      info,
+		StuckHtlcInfo: stuckInfo,
+		HtlcEventType: HtlcEventTypeSend,
+		Timestamp:     h.now(),
+	}
+
+	log.Tracef("Notifying stuck htlc event: attempt %v of payment %v "+
+		"over %v, %v", stuckInfo.AttemptID, stuckInfo.PaymentHash, key,
+		info)
+
+	if err := h.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send stuck htlc event: %v", err)
+	}
+}
+
 // newHtlc key returns a htlc key for the packet provided. If the packet
 // has a zero incoming channel ID, the packet is for one of our own sends,
 // which has the payment id stashed in the incoming htlc id. If this is the
@@ -798,10 +798,13 @@ func (f *interceptedForward) ResumeModified(
 // Fail notifies the intention to Fail an existing hold forward with an
 // encrypted failure reason.
 func (f *interceptedForward) Fail(reason []byte) error {
-	obfuscatedReason := f.packet.obfuscator.IntermediateEncrypt(reason)
+	obfuscatedReason, attrData := f.packet.obfuscator.IntermediateEncrypt(
+		reason, nil,
+	)
 
 	return f.resolve(&lnwire.UpdateFailHTLC{
-		Reason: obfuscatedReason,
+		Reason:   obfuscatedReason,
+		AttrData: lnwire.NewAttrDataRecord(attrData),
 	})
 }
 
@@ -864,13 +867,16 @@ func (f *interceptedForward) FailWithCode(code lnwire.FailCode) error {
 
 	// Encrypt the failure for the first hop. This node will be the origin
 	// of the failure.
-	reason, err := f.packet.obfuscator.EncryptFirstHop(failureMsg)
+	reason, attrData, err := f.packet.obfuscator.EncryptFirstHop(
+		failureMsg,
+	)
 	if err != nil {
 		return fmt.Errorf("failed to encrypt failure reason %w", err)
 	}
 
 	return f.resolve(&lnwire.UpdateFailHTLC{
-		Reason: reason,
+		Reason:   reason,
+		AttrData: lnwire.NewAttrDataRecord(attrData),
 	})
 }
 
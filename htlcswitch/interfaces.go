@@ -184,6 +184,23 @@ type ChannelUpdateHandler interface {
 	// quiescence is a holdover until we have downstream protocols that use
 	// it.
 	InitStfu() <-chan fn.Result[lntypes.ChannelParty]
+
+	// Pause stops the link from accepting new HTLCs in either direction
+	// and waits for all HTLCs on the channel to be resolved. The channel
+	// stays paused across reconnections and restarts until it is resumed.
+	// If the context is done before the HTLCs are resolved, the link is
+	// resumed and the error of the context is returned.
+	Pause(ctx context.Context) error
+
+	// Resume allows new HTLCs on a paused link again.
+	Resume() error
+
+	// IsPaused returns true if the link was paused and hasn't been resumed
+	// since.
+	IsPaused() bool
+
+	// IsQuiescent returns true if the channel is quiescent.
+	IsQuiescent() bool
 }
 
 // CommitHookID is a value that is used to uniquely identify hooks in the
@@ -302,6 +319,10 @@ type ChannelLink interface {
 	// total sent/received milli-satoshis.
 	Stats() (uint64, lnwire.MilliSatoshi, lnwire.MilliSatoshi)
 
+	// OutgoingHtlcLimits returns the maximum number and the maximum total
+	// value of HTLCs we may offer on the channel.
+	OutgoingHtlcLimits() (uint16, lnwire.MilliSatoshi)
+
 	// PeerPubKey returns the serialized public key of remote peer with
 	// which we have the channel link opened.
 	PeerPubKey() [33]byte
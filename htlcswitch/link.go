@@ -290,6 +290,12 @@ type ChannelLinkConfig struct {
 	// quiescence protocol.
 	DisallowQuiescence bool
 
+	// UpfrontFees is set if both we and the remote peer signalled support
+	// for experimental upfront htlc fees. If it isn't set, upfront fees
+	// are stripped from outgoing htlcs and incoming htlcs carrying one are
+	// treated as a protocol violation.
+	UpfrontFees bool
+
 	// MaxFeeExposure is the threshold in milli-satoshis after which we'll
 	// restrict the flow of HTLCs and fee updates.
 	MaxFeeExposure lnwire.MilliSatoshi
@@ -402,6 +408,14 @@ type channelLink struct {
 	// UpdateAddHTLC.
 	isIncomingAddBlocked atomic.Bool
 
+	// isPaused tracks whether the link was paused. A paused link fails
+	// the HTLCs it receives instead of processing them.
+	isPaused atomic.Bool
+
+	// pauseBlockedAdds tracks whether pausing the link blocked outgoing
+	// adds, in which case they are unblocked again when it is resumed.
+	pauseBlockedAdds atomic.Bool
+
 	// flushHooks is a hookMap that is triggered when we reach a channel
 	// state with no live HTLCs.
 	flushHooks hookMap
@@ -611,6 +625,12 @@ func (l *channelLink) Start() error {
 
 	l.updateFeeTimer = time.NewTimer(l.randomFeeUpdateTimeout())
 
+	// A channel that was paused stays paused until it is resumed, even if
+	// the link was restarted in the meantime.
+	if err := l.restorePause(); err != nil {
+		return err
+	}
+
 	l.cg.WgAdd(1)
 	go l.htlcManager(context.TODO())
 
@@ -808,6 +828,148 @@ func (l *channelLink) InitStfu() <-chan fn.Result[lntypes.ChannelParty] {
 	return out
 }
 
+// Pause stops the link from accepting new HTLCs in either direction and waits
+// for all HTLCs on the channel to be resolved. Pausing is local to the link:
+// new HTLCs aren't forwarded to the peer anymore, while the HTLCs the peer adds
+// are failed back. The channel isn't quiesced with the peer, so it can be
+// resumed without a reconnection and isn't subject to the quiescence timeout.
+// The paused state is persisted, so the channel stays paused across
+// reconnections and restarts until it is resumed. If the context is done
+// before the HTLCs are resolved, the link is resumed and the error of the
+// context is returned.
+//
+// NOTE: Part of the ChannelUpdateHandler interface.
+func (l *channelLink) Pause(ctx context.Context) error {
+	if !l.isPaused.CompareAndSwap(false, true) {
+		return ErrLinkPaused
+	}
+
+	if err := l.channel.State().MarkPaused(true); err != nil {
+		l.isPaused.Store(false)
+
+		return fmt.Errorf("unable to persist paused state: %w", err)
+	}
+
+	l.log.Info("pausing link")
+
+	// We stop forwarding new HTLCs to the peer, while the HTLCs the peer
+	// adds are failed back once they are locked in. If the outgoing adds
+	// were already blocked, e.g. because the channel is being closed, we
+	// leave them blocked when we resume.
+	l.pauseBlockedAdds.Store(l.DisableAdds(Outgoing))
+
+	err := l.drain(ctx)
+	switch {
+	case err == nil:
+
+	// If the link is stopped, e.g. because the peer disconnected, the
+	// channel stays paused once the link is started again.
+	case errors.Is(err, ErrLinkShuttingDown):
+		l.log.Warnf("link stopped before its HTLCs were resolved, " +
+			"the channel stays paused")
+
+		return err
+
+	default:
+		l.log.Warnf("unable to pause link: %v", err)
+
+		if l.isPaused.CompareAndSwap(true, false) {
+			l.unpause()
+		}
+
+		return err
+	}
+
+	l.log.Info("link paused")
+
+	return nil
+}
+
+// restorePause re-applies the paused state of a channel that was paused
+// before the link was started.
+func (l *channelLink) restorePause() error {
+	paused, err := l.channel.State().IsPaused()
+	if err != nil {
+		return fmt.Errorf("unable to fetch paused state: %w", err)
+	}
+
+	if !paused {
+		return nil
+	}
+
+	l.log.Info("restoring paused state of link")
+
+	l.isPaused.Store(true)
+	l.pauseBlockedAdds.Store(l.DisableAdds(Outgoing))
+
+	return nil
+}
+
+// drain waits for all HTLCs on the channel to be resolved.
+func (l *channelLink) drain(ctx context.Context) error {
+	flushed := make(chan struct{})
+	l.OnFlushedOnce(func() {
+		close(flushed)
+	})
+
+	select {
+	case <-flushed:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-l.cg.Done():
+		return ErrLinkShuttingDown
+	}
+
+	// The link may have been resumed while we waited for the HTLCs to be
+	// resolved.
+	if !l.isPaused.Load() {
+		return ErrLinkNotPaused
+	}
+
+	return nil
+}
+
+// Resume allows new HTLCs on a paused link again.
+//
+// NOTE: Part of the ChannelUpdateHandler interface.
+func (l *channelLink) Resume() error {
+	if !l.isPaused.CompareAndSwap(true, false) {
+		return ErrLinkNotPaused
+	}
+
+	l.log.Info("resuming link")
+
+	l.unpause()
+
+	return nil
+}
+
+// unpause clears the persisted paused state of the channel and unblocks the
+// outgoing adds that were blocked by pausing the link.
+func (l *channelLink) unpause() {
+	if err := l.channel.State().MarkPaused(false); err != nil {
+		l.log.Errorf("unable to clear paused state: %v", err)
+	}
+
+	if l.pauseBlockedAdds.Swap(false) {
+		l.EnableAdds(Outgoing)
+	}
+}
+
+// IsPaused returns true if the link was paused and hasn't been resumed since.
+//
+// NOTE: Part of the ChannelUpdateHandler interface.
+func (l *channelLink) IsPaused() bool {
+	return l.isPaused.Load()
+}
+
+// IsQuiescent returns true if the channel is quiescent.
+//
+// NOTE: Part of the ChannelUpdateHandler interface.
+func (l *channelLink) IsQuiescent() bool {
+	return l.quiescer.IsQuiescent()
+}
+
 // isReestablished returns true if the link has successfully completed the
 // channel reestablishment dance.
 func (l *channelLink) isReestablished() bool {
@@ -1553,6 +1715,14 @@ func (l *channelLink) processHtlcResolution(resolution invoices.HtlcResolution,
 func getResolutionFailure(resolution *invoices.HtlcFailResolution,
 	amount lnwire.MilliSatoshi) *LinkError {
 
+	// If the resolution carries its own failure message, we return it to
+	// the sender as is.
+	if resolution.FailureMessage != nil {
+		return NewDetailedLinkError(
+			resolution.FailureMessage, resolution.Outcome,
+		)
+	}
+
 	// If the resolution has been resolved as part of a MPP timeout,
 	// we need to fail the htlc with lnwire.FailMppTimeout.
 	if resolution.Outcome == invoices.ResultMppTimeout {
@@ -1626,6 +1796,15 @@ func (l *channelLink) handleDownstreamUpdateAdd(ctx context.Context,
 		)
 	}
 
+	// If our peer doesn't understand upfront fees, we can't pay one to
+	// them. Any fee we were meant to pass on is kept by us instead.
+	if htlc.UpfrontFee.IsSome() && !l.cfg.UpfrontFees {
+		htlc.UpfrontFee = lnwire.UpfrontFeeRecord{}
+		if pkt.circuit != nil {
+			pkt.circuit.OutgoingUpfrontFee = 0
+		}
+	}
+
 	// A new payment has been initiated via the downstream channel,
 	// so we add the new HTLC to our local log, then update the
 	// commitment chains.
@@ -2767,6 +2946,22 @@ func (l *channelLink) Stats() (uint64, lnwire.MilliSatoshi, lnwire.MilliSatoshi)
 		snapshot.TotalMSatReceived
 }
 
+// OutgoingHtlcLimits returns the maximum number and the maximum total value
+// of HTLCs we may offer on the channel, as constrained by the remote party.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) OutgoingHtlcLimits() (uint16, lnwire.MilliSatoshi) {
+	state := l.channel.State()
+	bounds := state.RemoteChanCfg.ChannelStateBounds
+
+	maxInFlight := lnwire.NewMSatFromSatoshis(state.Capacity)
+	if bounds.MaxPendingAmount < maxInFlight {
+		maxInFlight = bounds.MaxPendingAmount
+	}
+
+	return bounds.MaxAcceptedHtlcs, maxInFlight
+}
+
 // String returns the string representation of channel link.
 //
 // NOTE: Part of the ChannelLink interface.
@@ -3185,6 +3380,25 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg) {
 			continue
 		}
 
+		// If the link is paused, we don't accept new HTLCs so that the
+		// channel can be drained and quiesced. Adds that were already
+		// processed are replayed as they were.
+		if l.isPaused.Load() &&
+			fwdPkg.State == channeldb.FwdStateLockedIn {
+
+			failure := NewLinkError(
+				&lnwire.FailTemporaryChannelFailure{},
+			)
+			l.sendHTLCError(
+				add, sourceRef, failure, obfuscator, false,
+			)
+
+			l.log.Debugf("rejected htlc(id=%v) on paused link",
+				add.ID)
+
+			continue
+		}
+
 		switch fwdInfo.NextHop {
 		case hop.Exit:
 			err := l.processExitHop(
@@ -3236,6 +3450,9 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg) {
 					Amount:        fwdInfo.AmountToForward,
 					PaymentHash:   add.PaymentHash,
 					BlindingPoint: fwdInfo.NextBlinding,
+					UpfrontFee: outgoingUpfrontFee(
+						&add, fwdInfo.AmountToForward,
+					),
 				}
 
 				accountableValue.WhenSome(func(e byte) {
@@ -3267,6 +3484,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg) {
 					outgoingChanID:       fwdInfo.NextHop,
 					sourceRef:            &sourceRef,
 					incomingAmount:       add.Amount,
+					incomingUpfrontFee:   add.UpfrontFee.ValOpt().UnwrapOr(0),
 					amount:               outgoingAdd.Amount,
 					htlc:                 outgoingAdd,
 					obfuscator:           obfuscator,
@@ -3294,6 +3512,9 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg) {
 				Amount:        fwdInfo.AmountToForward,
 				PaymentHash:   add.PaymentHash,
 				BlindingPoint: fwdInfo.NextBlinding,
+				UpfrontFee: outgoingUpfrontFee(
+					&add, fwdInfo.AmountToForward,
+				),
 			}
 
 			accountableValue.WhenSome(func(e byte) {
@@ -3344,6 +3565,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg) {
 					outgoingChanID:       fwdInfo.NextHop,
 					sourceRef:            &sourceRef,
 					incomingAmount:       add.Amount,
+					incomingUpfrontFee:   add.UpfrontFee.ValOpt().UnwrapOr(0),
 					amount:               addMsg.Amount,
 					htlc:                 addMsg,
 					obfuscator:           obfuscator,
@@ -3425,6 +3647,31 @@ func (l *channelLink) experimentalAccountability(
 	return fn.Some[byte](lnwire.ExperimentalUnaccountable)
 }
 
+// outgoingUpfrontFee returns the upfront fee to attach to the outgoing htlc
+// of a forward. We keep our own share of the incoming upfront fee, derived from
+// the success fee of the forward, and pass the remainder on to the next hop.
+func outgoingUpfrontFee(add *lnwire.UpdateAddHTLC,
+	amtToForward lnwire.MilliSatoshi) lnwire.UpfrontFeeRecord {
+
+	incoming := add.UpfrontFee.ValOpt().UnwrapOr(0)
+	if incoming == 0 {
+		return lnwire.UpfrontFeeRecord{}
+	}
+
+	var share lnwire.MilliSatoshi
+	if add.Amount > amtToForward {
+		share = lnwire.UpfrontFeeShare(add.Amount - amtToForward)
+	}
+
+	if incoming <= share {
+		return lnwire.UpfrontFeeRecord{}
+	}
+
+	return tlv.SomeRecordT(
+		tlv.NewRecordT[lnwire.UpfrontFeeTlvType](incoming - share),
+	)
+}
+
 // processExitHop handles an htlc for which this link is the exit hop. It
 // returns a boolean indicating whether the commitment tx needs an update.
 func (l *channelLink) processExitHop(add lnwire.UpdateAddHTLC,
@@ -3618,13 +3865,15 @@ func (l *channelLink) sendHTLCError(add lnwire.UpdateAddHTLC,
 	sourceRef channeldb.AddRef, failure *LinkError,
 	e hop.ErrorEncrypter, isReceive bool) {
 
-	reason, err := e.EncryptFirstHop(failure.WireMessage())
+	reason, attrData, err := e.EncryptFirstHop(failure.WireMessage())
 	if err != nil {
 		l.log.Errorf("unable to obfuscate error: %v", err)
 		return
 	}
 
-	err = l.channel.FailHTLC(add.ID, reason, &sourceRef, nil, nil)
+	err = l.channel.FailHTLC(
+		add.ID, reason, attrData, &sourceRef, nil, nil,
+	)
 	if err != nil {
 		l.log.Errorf("unable to cancel htlc: %v", err)
 		return
@@ -3633,7 +3882,7 @@ func (l *channelLink) sendHTLCError(add lnwire.UpdateAddHTLC,
 	// Send the appropriate failure message depending on whether we're
 	// in a blinded route or not.
 	if err := l.sendIncomingHTLCFailureMsg(
-		add.ID, e, reason,
+		add.ID, e, reason, attrData,
 	); err != nil {
 		l.log.Errorf("unable to send HTLC failure: %v", err)
 		return
@@ -3677,8 +3926,8 @@ func (l *channelLink) sendHTLCError(add lnwire.UpdateAddHTLC,
 // used if we are the introduction node and need to present an error as if
 // we're the failing party.
 func (l *channelLink) sendIncomingHTLCFailureMsg(htlcIndex uint64,
-	e hop.ErrorEncrypter,
-	originalFailure lnwire.OpaqueReason) error {
+	e hop.ErrorEncrypter, originalFailure lnwire.OpaqueReason,
+	originalAttrData []byte) error {
 
 	var msg lnwire.Message
 	switch {
@@ -3691,9 +3940,10 @@ func (l *channelLink) sendIncomingHTLCFailureMsg(htlcIndex uint64,
 	// code.
 	case e == nil:
 		msg = &lnwire.UpdateFailHTLC{
-			ChanID: l.ChanID(),
-			ID:     htlcIndex,
-			Reason: originalFailure,
+			ChanID:   l.ChanID(),
+			ID:       htlcIndex,
+			Reason:   originalFailure,
+			AttrData: lnwire.NewAttrDataRecord(originalAttrData),
 		}
 
 		l.log.Errorf("Unexpected blinded failure when "+
@@ -3704,9 +3954,10 @@ func (l *channelLink) sendIncomingHTLCFailureMsg(htlcIndex uint64,
 	// transformation on the error message and can just send the original.
 	case !e.Type().IsBlinded():
 		msg = &lnwire.UpdateFailHTLC{
-			ChanID: l.ChanID(),
-			ID:     htlcIndex,
-			Reason: originalFailure,
+			ChanID:   l.ChanID(),
+			ID:       htlcIndex,
+			Reason:   originalFailure,
+			AttrData: lnwire.NewAttrDataRecord(originalAttrData),
 		}
 
 	// When we're the introduction node, we need to convert the error to
@@ -3720,15 +3971,16 @@ func (l *channelLink) sendIncomingHTLCFailureMsg(htlcIndex uint64,
 		failureMsg := lnwire.NewInvalidBlinding(
 			fn.None[[lnwire.OnionPacketSize]byte](),
 		)
-		reason, err := e.EncryptFirstHop(failureMsg)
+		reason, attrData, err := e.EncryptFirstHop(failureMsg)
 		if err != nil {
 			return err
 		}
 
 		msg = &lnwire.UpdateFailHTLC{
-			ChanID: l.ChanID(),
-			ID:     htlcIndex,
-			Reason: reason,
+			ChanID:   l.ChanID(),
+			ID:       htlcIndex,
+			Reason:   reason,
+			AttrData: lnwire.NewAttrDataRecord(attrData),
 		}
 
 	// If we are a relaying node, we need to switch out any error that
@@ -3899,6 +4151,12 @@ func (l *channelLink) handleUpdateFee(ctx context.Context) error {
 		return nil
 	}
 
+	// Zero-fee commitments never pay a fee, so there is nothing to
+	// update. They're fee bumped through their anchor once broadcast.
+	if l.channel.State().ChanType.HasZeroFeeCommitments() {
+		return nil
+	}
+
 	// If we are the initiator, then we'll sample the current fee rate to
 	// get into the chain within 3 blocks.
 	netFee, err := l.sampleNetworkFee()
@@ -4095,6 +4353,18 @@ func (l *channelLink) processRemoteUpdateAddHTLC(
 		return err
 	}
 
+	// Likewise, the peer may only attach an upfront fee if we both
+	// negotiated the feature, as it changes the balances of the commitment
+	// we are about to sign.
+	if msg.UpfrontFee.IsSome() && !l.cfg.UpfrontFees {
+		err := errors.New("upfront fee included when upfront fees " +
+			"were not negotiated")
+
+		l.failf(LinkFailureError{code: ErrInvalidUpdate}, "%v", err)
+
+		return err
+	}
+
 	// We have to check the limit here rather than later in the switch
 	// because the counterparty can keep sending HTLC's without sending a
 	// revoke. This would mean that the switch check would only occur later.
@@ -4241,7 +4511,7 @@ func (l *channelLink) processRemoteUpdateFailMalformedHTLC(
 	// If remote side have been unable to parse the onion blob we have sent
 	// to it, than we should transform the malformed HTLC message to the
 	// usual HTLC fail message.
-	err := l.channel.ReceiveFailHTLC(msg.ID, b.Bytes())
+	err := l.channel.ReceiveFailHTLC(msg.ID, b.Bytes(), nil)
 	if err != nil {
 		l.failf(LinkFailureError{code: ErrInvalidUpdate},
 			"unable to handle upstream fail HTLC: %v", err)
@@ -4280,9 +4550,11 @@ func (l *channelLink) processRemoteUpdateFailHTLC(
 		}
 	}
 
-	// Add fail to the update log.
+	// Add fail to the update log along with the attribution data of the
+	// downstream nodes, if any.
 	idx := msg.ID
-	err := l.channel.ReceiveFailHTLC(idx, msg.Reason[:])
+	attrData := msg.AttrData.ValOpt().UnwrapOr(nil)
+	err := l.channel.ReceiveFailHTLC(idx, msg.Reason[:], attrData)
 	if err != nil {
 		l.failf(LinkFailureError{code: ErrInvalidUpdate},
 			"unable to handle upstream fail HTLC: %v", err)
@@ -4721,9 +4993,10 @@ func (l *channelLink) processLocalUpdateFailHTLC(ctx context.Context,
 	// An HTLC cancellation has been triggered somewhere upstream, we'll
 	// remove the HTLC from our local state machine.
 	inKey := pkt.inKey()
+	attrData := htlc.AttrData.ValOpt().UnwrapOr(nil)
 	err := l.channel.FailHTLC(
-		pkt.incomingHTLCID, htlc.Reason, pkt.sourceRef, pkt.destRef,
-		&inKey,
+		pkt.incomingHTLCID, htlc.Reason, attrData, pkt.sourceRef,
+		pkt.destRef, &inKey,
 	)
 	if err != nil {
 		l.log.Errorf("unable to cancel incoming HTLC for "+
@@ -4759,7 +5032,9 @@ func (l *channelLink) processLocalUpdateFailHTLC(ctx context.Context,
 	// HTLC. If the incoming blinding point is non-nil, we know that we are
 	// a relaying node in a blinded path. Otherwise, we're either an
 	// introduction node or not part of a blinded path at all.
-	err = l.sendIncomingHTLCFailureMsg(htlc.ID, pkt.obfuscator, htlc.Reason)
+	err = l.sendIncomingHTLCFailureMsg(
+		htlc.ID, pkt.obfuscator, htlc.Reason, attrData,
+	)
 	if err != nil {
 		l.log.Errorf("unable to send HTLC failure: %v", err)
 
@@ -252,7 +252,7 @@ func (l *linkTestContext) receiveFailAliceToBobWithCode(
 	l.t.Helper()
 
 	failMsg := l.receiveFailAliceToBobMsg()
-	failure, err := newMockDeobfuscator().DecryptError(failMsg.Reason)
+	failure, err := newMockDeobfuscator().DecryptError(failMsg.Reason, nil)
 	if err != nil {
 		l.t.Fatalf("unable to decrypt failure: %v", err)
 	}
@@ -280,7 +280,7 @@ func (l *linkTestContext) receiveFailAliceToBobMsg() *lnwire.UpdateFailHTLC {
 		l.t.Fatalf("expected UpdateFailHTLC, got %T", msg)
 	}
 
-	err := l.bobChannel.ReceiveFailHTLC(failMsg.ID, failMsg.Reason)
+	err := l.bobChannel.ReceiveFailHTLC(failMsg.ID, failMsg.Reason, nil)
 	if err != nil {
 		l.t.Fatalf("unable to apply received fail htlc: %v", err)
 	}
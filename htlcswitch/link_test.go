@@ -40,6 +40,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/ticker"
+	"github.com/lightningnetwork/lnd/tlv"
 	"github.com/stretchr/testify/require"
 )
 
@@ -2671,7 +2672,7 @@ func TestChannelLinkBandwidthConsistency(t *testing.T) {
 	reason := make([]byte, 292)
 	copy(reason, []byte("nop"))
 
-	err = harness.bobChannel.FailHTLC(bobIndex, reason, nil, nil, nil)
+	err = harness.bobChannel.FailHTLC(bobIndex, reason, nil, nil, nil, nil)
 	require.NoError(t, err, "unable to fail htlc")
 	failMsg := &lnwire.UpdateFailHTLC{
 		ID:     1,
@@ -2918,7 +2919,7 @@ func TestChannelLinkBandwidthConsistency(t *testing.T) {
 	if !ok {
 		t.Fatalf("expected UpdateFailHTLC, got %T", msg)
 	}
-	err = harness.bobChannel.ReceiveFailHTLC(failMsg.ID, []byte("fail"))
+	err = harness.bobChannel.ReceiveFailHTLC(failMsg.ID, []byte("fail"), nil)
 	require.NoError(t, err, "failed receiving fail htlc")
 
 	// After failing an HTLC, the link will automatically trigger
@@ -7426,7 +7427,7 @@ func TestChannelLinkShortFailureRelay(t *testing.T) {
 	// Return a short htlc failure from Bob to Alice and lock in.
 	shortReason := make([]byte, 260)
 
-	err = harness.bobChannel.FailHTLC(0, shortReason, nil, nil, nil)
+	err = harness.bobChannel.FailHTLC(0, shortReason, nil, nil, nil, nil)
 	require.NoError(t, err)
 
 	harness.aliceLink.HandleChannelUpdate(&lnwire.UpdateFailHTLC{
@@ -7764,3 +7765,194 @@ func TestLinkQuiescenceExitHopProcessingDeferred(t *testing.T) {
 
 	// TODO(proofofkeags): make sure these actions are run on resume.
 }
+
+// TestChannelLinkPause asserts that pausing a link waits for the HTLCs on the
+// channel to be resolved without quiescing it, and that a link can be resumed
+// without disconnecting the peer.
+func TestChannelLinkPause(t *testing.T) {
+	t.Parallel()
+
+	defer timeout()()
+
+	// Alice pays a hodl invoice of Bob, so that there is an HTLC on the
+	// channel until Bob settles it.
+	ctx, err := newHodlInvoiceTestCtx(t)
+	require.NoError(t, err)
+
+	aliceLink := ctx.n.aliceChannelLink
+	bobLink := ctx.n.bobChannelLink
+
+	// As the HTLC isn't resolved before the timeout, the link can't be
+	// paused and forwards HTLCs again.
+	timeoutCtx, cancel := context.WithTimeout(
+		t.Context(), 100*time.Millisecond,
+	)
+	defer cancel()
+
+	// assertPersisted asserts whether the paused state of Alice's channel
+	// is persisted.
+	assertPersisted := func(expected bool) {
+		t.Helper()
+
+		paused, err := aliceLink.channel.State().IsPaused()
+		require.NoError(t, err)
+		require.Equal(t, expected, paused)
+	}
+
+	err = aliceLink.Pause(timeoutCtx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.False(t, aliceLink.IsPaused())
+	require.False(t, aliceLink.IsQuiescent())
+	require.True(t, aliceLink.EligibleToForward())
+	assertPersisted(false)
+
+	// Once Bob settles the HTLC, the link is paused. The pause is local to
+	// Alice, so the channel isn't quiesced.
+	pauseErr := make(chan error, 1)
+	go func() {
+		pauseErr <- aliceLink.Pause(t.Context())
+	}()
+
+	err = ctx.n.bobServer.registry.SettleHodlInvoice(
+		t.Context(), ctx.preimage,
+	)
+	require.NoError(t, err)
+	require.NoError(t, <-ctx.errChan)
+	require.NoError(t, <-pauseErr)
+
+	require.True(t, aliceLink.IsPaused())
+	require.False(t, aliceLink.IsQuiescent())
+	require.False(t, bobLink.IsQuiescent())
+	require.False(t, aliceLink.EligibleToForward())
+	assertPersisted(true)
+
+	// A paused link can't be paused again.
+	require.ErrorIs(t, aliceLink.Pause(t.Context()), ErrLinkPaused)
+
+	// Resuming the link allows HTLCs again without disconnecting the
+	// peer.
+	require.NoError(t, aliceLink.Resume())
+	require.False(t, aliceLink.IsPaused())
+	require.True(t, aliceLink.EligibleToForward())
+	assertPersisted(false)
+
+	// Alice can pay Bob again right away, as the channel wasn't quiesced.
+	htlcAmt, totalTimelock, hops := generateHops(
+		ctx.amount, testStartingHeight, bobLink,
+	)
+	payment := makePayment(
+		ctx.n.aliceServer, ctx.n.bobServer, bobLink.ShortChanID(),
+		hops, ctx.amount, htlcAmt, totalTimelock,
+	)
+	<-ctx.n.bobServer.registry.settleChan
+	_, err = payment.Wait(30 * time.Second)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, aliceLink.Resume(), ErrLinkNotPaused)
+}
+
+// TestChannelLinkPauseRestart asserts that a paused channel stays paused when
+// its link is restarted, until it is resumed.
+func TestChannelLinkPauseRestart(t *testing.T) {
+	t.Parallel()
+
+	const chanAmt = btcutil.SatoshiPerBitcoin * 5
+	const chanReserve = btcutil.SatoshiPerBitcoin * 1
+	harness, err := newSingleLinkTestHarness(t, chanAmt, chanReserve)
+	require.NoError(t, err)
+
+	err = harness.start()
+	require.NoError(t, err)
+	defer harness.aliceLink.Stop()
+
+	alice := newPersistentLinkHarness(
+		t, harness.aliceSwitch, harness.aliceLink,
+		harness.aliceBatchTicker, harness.aliceRestore,
+	)
+	require.False(t, alice.coreLink.IsPaused())
+
+	// Alice's channel was paused before the link was restarted, e.g.
+	// because the peer disconnected before its HTLCs were resolved.
+	err = alice.coreLink.channel.State().MarkPaused(true)
+	require.NoError(t, err)
+
+	// Once the link is restarted, it is paused again, so it doesn't
+	// forward HTLCs to the peer.
+	alice.restart(false, false)
+	require.True(t, alice.coreLink.IsPaused())
+	require.True(t, alice.coreLink.IsFlushing(Outgoing))
+
+	// Pausing the restored link again is rejected, while resuming it
+	// clears the persisted state.
+	err = alice.coreLink.Pause(t.Context())
+	require.ErrorIs(t, err, ErrLinkPaused)
+	require.NoError(t, alice.coreLink.Resume())
+	require.False(t, alice.coreLink.IsFlushing(Outgoing))
+
+	paused, err := alice.coreLink.channel.State().IsPaused()
+	require.NoError(t, err)
+	require.False(t, paused)
+
+	alice.restart(false, false)
+	require.False(t, alice.coreLink.IsPaused())
+}
+
+// TestOutgoingUpfrontFee tests that a forwarding node keeps its share of the
+// incoming upfront fee and passes on the remainder.
+func TestOutgoingUpfrontFee(t *testing.T) {
+	t.Parallel()
+
+	upfrontFee := func(fee lnwire.MilliSatoshi) lnwire.UpfrontFeeRecord {
+		return tlv.SomeRecordT(
+			tlv.NewRecordT[lnwire.UpfrontFeeTlvType](fee),
+		)
+	}
+
+	tests := []struct {
+		name         string
+		amount       lnwire.MilliSatoshi
+		amtToForward lnwire.MilliSatoshi
+		incoming     lnwire.UpfrontFeeRecord
+		expected     lnwire.UpfrontFeeRecord
+	}{
+		{
+			name:         "no upfront fee",
+			amount:       102_000,
+			amtToForward: 100_000,
+		},
+		{
+			name:         "share deducted",
+			amount:       102_000,
+			amtToForward: 100_000,
+			incoming:     upfrontFee(50),
+			expected:     upfrontFee(30),
+		},
+		{
+			name:         "share exceeds incoming fee",
+			amount:       102_000,
+			amtToForward: 100_000,
+			incoming:     upfrontFee(20),
+		},
+		{
+			name:         "no success fee",
+			amount:       100_000,
+			amtToForward: 100_000,
+			incoming:     upfrontFee(20),
+			expected:     upfrontFee(20),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			add := &lnwire.UpdateAddHTLC{
+				Amount:     test.amount,
+				UpfrontFee: test.incoming,
+			}
+
+			require.Equal(
+				t, test.expected,
+				outgoingUpfrontFee(add, test.amtToForward),
+			)
+		})
+	}
+}
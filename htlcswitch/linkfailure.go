@@ -8,6 +8,13 @@ var (
 
 	// ErrLinkFailedShutdown signals that a requested shutdown failed.
 	ErrLinkFailedShutdown = errors.New("link failed to shutdown")
+
+	// ErrLinkPaused signals that a link was already paused.
+	ErrLinkPaused = errors.New("link already paused")
+
+	// ErrLinkNotPaused signals that a link that was expected to be paused
+	// isn't, either because it was never paused or it was resumed since.
+	ErrLinkNotPaused = errors.New("link not paused")
 )
 
 // errorCode encodes the possible types of errors that will make us fail the
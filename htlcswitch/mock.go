@@ -27,6 +27,7 @@ import (
 	"github.com/lightningnetwork/lnd/fn/v2"
 	"github.com/lightningnetwork/lnd/graph/db/models"
 	"github.com/lightningnetwork/lnd/htlcswitch/hop"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lntest/mock"
@@ -419,10 +420,16 @@ func (o *mockObfuscator) Reextract(
 	return nil
 }
 
+func (o *mockObfuscator) ReceivedTime() time.Time {
+	return time.Time{}
+}
+
+func (o *mockObfuscator) SetReceivedTime(time.Time) {}
+
 var fakeHmac = []byte("hmachmachmachmachmachmachmachmac")
 
 func (o *mockObfuscator) EncryptFirstHop(failure lnwire.FailureMessage) (
-	lnwire.OpaqueReason, error) {
+	lnwire.OpaqueReason, []byte, error) {
 
 	o.failure = failure
 
@@ -430,22 +437,26 @@ func (o *mockObfuscator) EncryptFirstHop(failure lnwire.FailureMessage) (
 	b.Write(fakeHmac)
 
 	if err := lnwire.EncodeFailure(&b, failure, 0); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return b.Bytes(), nil
+	return b.Bytes(), nil, nil
 }
 
-func (o *mockObfuscator) IntermediateEncrypt(reason lnwire.OpaqueReason) lnwire.OpaqueReason {
-	return reason
+func (o *mockObfuscator) IntermediateEncrypt(reason lnwire.OpaqueReason,
+	attrData []byte) (lnwire.OpaqueReason, []byte) {
+
+	return reason, attrData
 }
 
-func (o *mockObfuscator) EncryptMalformedError(reason lnwire.OpaqueReason) lnwire.OpaqueReason {
+func (o *mockObfuscator) EncryptMalformedError(reason lnwire.OpaqueReason) (
+	lnwire.OpaqueReason, []byte) {
+
 	var b bytes.Buffer
 	b.Write(fakeHmac)
 
 	b.Write(reason)
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
 // mockDeobfuscator mock implementation of the failure deobfuscator which
@@ -456,8 +467,8 @@ func newMockDeobfuscator() ErrorDecrypter {
 	return &mockDeobfuscator{}
 }
 
-func (o *mockDeobfuscator) DecryptError(reason lnwire.OpaqueReason) (
-	*ForwardingError, error) {
+func (o *mockDeobfuscator) DecryptError(reason lnwire.OpaqueReason,
+	_ []byte) (*ForwardingError, error) {
 
 	if !bytes.Equal(reason[:32], fakeHmac) {
 		return nil, errors.New("fake decryption error")
@@ -866,6 +877,10 @@ func (f *mockChannelLink) Stats() (
 	return 0, 0, 0
 }
 
+func (f *mockChannelLink) OutgoingHtlcLimits() (uint16, lnwire.MilliSatoshi) {
+	return input.MaxHTLCNumber / 2, 99999999
+}
+
 func (f *mockChannelLink) AttachMailBox(mailBox MailBox) {
 	f.mailBox = mailBox
 	f.packets = mailBox.PacketOutBox()
@@ -961,6 +976,18 @@ func (f *mockChannelLink) InitStfu() <-chan fn.Result[lntypes.ChannelParty] {
 
 	return c
 }
+func (f *mockChannelLink) Pause(context.Context) error {
+	return errors.New("Pause not implemented")
+}
+func (f *mockChannelLink) Resume() error {
+	return errors.New("Resume not implemented")
+}
+func (f *mockChannelLink) IsPaused() bool {
+	return false
+}
+func (f *mockChannelLink) IsQuiescent() bool {
+	return false
+}
 
 func (f *mockChannelLink) FundingCustomBlob() fn.Option[tlv.Blob] {
 	return fn.None[tlv.Blob]()
@@ -43,6 +43,10 @@ type htlcPacket struct {
 	// incoming link.
 	incomingAmount lnwire.MilliSatoshi
 
+	// incomingUpfrontFee is the unconditional fee that was paid to us
+	// along with the incoming htlc.
+	incomingUpfrontFee lnwire.MilliSatoshi
+
 	// amount is the value of the HTLC that is being created or modified.
 	amount lnwire.MilliSatoshi
 
@@ -115,6 +119,17 @@ type htlcPacket struct {
 	inboundFee models.InboundFee
 }
 
+// outgoingUpfrontFee returns the upfront fee attached to the htlc of an add
+// packet, or zero for any other packet.
+func (p *htlcPacket) outgoingUpfrontFee() lnwire.MilliSatoshi {
+	add, ok := p.htlc.(*lnwire.UpdateAddHTLC)
+	if !ok {
+		return 0
+	}
+
+	return add.UpfrontFee.ValOpt().UnwrapOr(0)
+}
+
 // inKey returns the circuit key used to identify the incoming htlc.
 func (p *htlcPacket) inKey() CircuitKey {
 	return CircuitKey{
@@ -0,0 +1,213 @@
+package htlcswitch
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrPeerHtlcBelowMinimum is returned when an incoming peer offers us
+	// an HTLC to forward whose amount is below the minimum of its policy.
+	ErrPeerHtlcBelowMinimum = errors.New("htlc amount below peer minimum")
+
+	// ErrPeerMaxHtlcsExceeded is returned when forwarding an HTLC would
+	// exceed the maximum number of concurrent HTLCs of its incoming peer.
+	ErrPeerMaxHtlcsExceeded = errors.New("peer max htlcs exceeded")
+
+	// ErrPeerMaxInFlightExceeded is returned when forwarding an HTLC would
+	// exceed the maximum in-flight value of its incoming peer.
+	ErrPeerMaxInFlightExceeded = errors.New("peer max in-flight value " +
+		"exceeded")
+)
+
+// PeerPolicy limits the HTLCs a peer may have us forward across all of its
+// channels. A zero value for any of the limits disables it.
+type PeerPolicy struct {
+	// MaxHtlcs is the maximum number of HTLCs of the peer that may be in
+	// flight at the same time.
+	MaxHtlcs uint32
+
+	// MaxInFlight is the maximum total value of the HTLCs of the peer
+	// that may be in flight at the same time.
+	MaxInFlight lnwire.MilliSatoshi
+
+	// MinHtlc is the minimum amount of an HTLC of the peer.
+	MinHtlc lnwire.MilliSatoshi
+}
+
+// admissionErr returns the error that rejects an HTLC of the given amount if
+// the peer already has the given number and value of HTLCs in flight, or nil
+// if the HTLC is admitted.
+func (p *PeerPolicy) admissionErr(amt lnwire.MilliSatoshi, numHtlcs uint32,
+	inFlight lnwire.MilliSatoshi) error {
+
+	switch {
+	case p.MinHtlc != 0 && amt < p.MinHtlc:
+		return ErrPeerHtlcBelowMinimum
+
+	case p.MaxHtlcs != 0 && numHtlcs+1 > p.MaxHtlcs:
+		return ErrPeerMaxHtlcsExceeded
+
+	case p.MaxInFlight != 0 && inFlight+amt > p.MaxInFlight:
+		return ErrPeerMaxInFlightExceeded
+
+	default:
+		return nil
+	}
+}
+
+// peerExposure tracks the HTLCs of an incoming peer that are in flight.
+type peerExposure struct {
+	numHtlcs uint32
+	inFlight lnwire.MilliSatoshi
+}
+
+// admittedHtlc is an HTLC that was admitted by the peer policy manager and
+// hasn't been resolved yet.
+type admittedHtlc struct {
+	peer   [33]byte
+	amount lnwire.MilliSatoshi
+}
+
+// PeerPolicyManager enforces a PeerPolicy on the HTLCs each incoming peer
+// offers us for forwarding. All peers share a default policy, which can be
+// overridden for individual peers at runtime.
+//
+// NOTE: Overrides and the in-flight HTLCs are only kept in memory. HTLCs that
+// were in flight before a restart aren't accounted for.
+type PeerPolicyManager struct {
+	mu sync.Mutex
+
+	defaultPolicy PeerPolicy
+	overrides     map[[33]byte]PeerPolicy
+
+	exposure map[[33]byte]*peerExposure
+	admitted map[models.CircuitKey]admittedHtlc
+}
+
+// NewPeerPolicyManager creates a new peer policy manager that applies the
+// given policy to all peers without an override.
+func NewPeerPolicyManager(defaultPolicy PeerPolicy) *PeerPolicyManager {
+	return &PeerPolicyManager{
+		defaultPolicy: defaultPolicy,
+		overrides:     make(map[[33]byte]PeerPolicy),
+		exposure:      make(map[[33]byte]*peerExposure),
+		admitted:      make(map[models.CircuitKey]admittedHtlc),
+	}
+}
+
+// SetPolicy overrides the policy of the given peer. HTLCs that are already in
+// flight aren't affected.
+func (m *PeerPolicyManager) SetPolicy(peer [33]byte, policy PeerPolicy) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.overrides[peer] = policy
+}
+
+// RemovePolicy removes the override of the given peer, so that the default
+// policy applies to it again. It returns false if the peer had no override.
+func (m *PeerPolicyManager) RemovePolicy(peer [33]byte) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, ok := m.overrides[peer]
+	delete(m.overrides, peer)
+
+	return ok
+}
+
+// Policy returns the policy that applies to the given peer.
+func (m *PeerPolicyManager) Policy(peer [33]byte) PeerPolicy {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.policy(peer)
+}
+
+// policy returns the policy that applies to the given peer.
+//
+// NOTE: The caller must hold the mutex.
+func (m *PeerPolicyManager) policy(peer [33]byte) PeerPolicy {
+	if policy, ok := m.overrides[peer]; ok {
+		return policy
+	}
+
+	return m.defaultPolicy
+}
+
+// AddHtlc decides whether the HTLC with the given incoming circuit key and
+// outgoing amount, offered by the given peer, may be forwarded. If it is
+// admitted, it counts towards the limits of the peer until it is resolved.
+// Adding an HTLC that was already admitted is a no-op.
+func (m *PeerPolicyManager) AddHtlc(peer [33]byte, key models.CircuitKey,
+	amt lnwire.MilliSatoshi) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.admitted[key]; ok {
+		return nil
+	}
+
+	exposure, ok := m.exposure[peer]
+	if !ok {
+		exposure = &peerExposure{}
+	}
+
+	policy := m.policy(peer)
+	err := policy.admissionErr(amt, exposure.numHtlcs, exposure.inFlight)
+	if err != nil {
+		return err
+	}
+
+	exposure.numHtlcs++
+	exposure.inFlight += amt
+	m.exposure[peer] = exposure
+	m.admitted[key] = admittedHtlc{
+		peer:   peer,
+		amount: amt,
+	}
+
+	return nil
+}
+
+// ResolveHtlc releases the HTLC with the given incoming circuit key from the
+// limits of its peer. Unknown HTLCs are ignored.
+func (m *PeerPolicyManager) ResolveHtlc(key models.CircuitKey) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	htlc, ok := m.admitted[key]
+	if !ok {
+		return
+	}
+	delete(m.admitted, key)
+
+	exposure := m.exposure[htlc.peer]
+	exposure.numHtlcs--
+	exposure.inFlight -= htlc.amount
+
+	if exposure.numHtlcs == 0 {
+		delete(m.exposure, htlc.peer)
+	}
+}
+
+// InFlight returns the number and the total value of the HTLCs of the given
+// peer that are currently in flight.
+func (m *PeerPolicyManager) InFlight(peer [33]byte) (uint32,
+	lnwire.MilliSatoshi) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	exposure, ok := m.exposure[peer]
+	if !ok {
+		return 0, 0
+	}
+
+	return exposure.numHtlcs, exposure.inFlight
+}
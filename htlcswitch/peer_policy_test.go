@@ -0,0 +1,106 @@
+package htlcswitch
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testPolicyPeer      = [33]byte{1}
+	testPolicyOtherPeer = [33]byte{2}
+)
+
+// testPolicyCircuit returns the incoming circuit key of a test HTLC.
+func testPolicyCircuit(id uint64) models.CircuitKey {
+	return models.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(1),
+		HtlcID: id,
+	}
+}
+
+// TestPeerPolicyLimits asserts that HTLCs are rejected once they would exceed
+// the limits of their incoming peer, and admitted again once in-flight HTLCs
+// are resolved.
+func TestPeerPolicyLimits(t *testing.T) {
+	t.Parallel()
+
+	manager := NewPeerPolicyManager(PeerPolicy{
+		MaxHtlcs:    2,
+		MaxInFlight: 3000,
+		MinHtlc:     100,
+	})
+
+	// HTLCs below the minimum are rejected without counting towards the
+	// limits of the peer.
+	err := manager.AddHtlc(testPolicyPeer, testPolicyCircuit(0), 99)
+	require.ErrorIs(t, err, ErrPeerHtlcBelowMinimum)
+
+	// The value of the HTLCs is limited.
+	err = manager.AddHtlc(testPolicyPeer, testPolicyCircuit(1), 2000)
+	require.NoError(t, err)
+	err = manager.AddHtlc(testPolicyPeer, testPolicyCircuit(2), 1001)
+	require.ErrorIs(t, err, ErrPeerMaxInFlightExceeded)
+
+	// And so is their number.
+	err = manager.AddHtlc(testPolicyPeer, testPolicyCircuit(3), 1000)
+	require.NoError(t, err)
+	err = manager.AddHtlc(testPolicyPeer, testPolicyCircuit(4), 100)
+	require.ErrorIs(t, err, ErrPeerMaxHtlcsExceeded)
+
+	numHtlcs, inFlight := manager.InFlight(testPolicyPeer)
+	require.EqualValues(t, 2, numHtlcs)
+	require.EqualValues(t, 3000, inFlight)
+
+	// Adding an HTLC that was already admitted is a no-op.
+	err = manager.AddHtlc(testPolicyPeer, testPolicyCircuit(1), 2000)
+	require.NoError(t, err)
+
+	// The limits apply to each peer separately.
+	err = manager.AddHtlc(testPolicyOtherPeer, testPolicyCircuit(5), 100)
+	require.NoError(t, err)
+
+	// Once an HTLC is resolved, the peer may use its share of the limits
+	// again. Resolving unknown HTLCs is ignored.
+	manager.ResolveHtlc(testPolicyCircuit(1))
+	manager.ResolveHtlc(testPolicyCircuit(1))
+	manager.ResolveHtlc(testPolicyCircuit(4))
+
+	err = manager.AddHtlc(testPolicyPeer, testPolicyCircuit(4), 2000)
+	require.NoError(t, err)
+
+	numHtlcs, inFlight = manager.InFlight(testPolicyPeer)
+	require.EqualValues(t, 2, numHtlcs)
+	require.EqualValues(t, 3000, inFlight)
+}
+
+// TestPeerPolicyOverride asserts that the policy of a peer can be overridden
+// at runtime and that removing the override restores the default policy.
+func TestPeerPolicyOverride(t *testing.T) {
+	t.Parallel()
+
+	defaultPolicy := PeerPolicy{MaxHtlcs: 1}
+	manager := NewPeerPolicyManager(defaultPolicy)
+
+	err := manager.AddHtlc(testPolicyPeer, testPolicyCircuit(0), 1000)
+	require.NoError(t, err)
+	err = manager.AddHtlc(testPolicyPeer, testPolicyCircuit(1), 1000)
+	require.ErrorIs(t, err, ErrPeerMaxHtlcsExceeded)
+
+	// A zero limit in the override disables it.
+	override := PeerPolicy{MaxInFlight: 2000}
+	manager.SetPolicy(testPolicyPeer, override)
+	require.Equal(t, override, manager.Policy(testPolicyPeer))
+	require.Equal(t, defaultPolicy, manager.Policy(testPolicyOtherPeer))
+
+	err = manager.AddHtlc(testPolicyPeer, testPolicyCircuit(1), 1000)
+	require.NoError(t, err)
+	err = manager.AddHtlc(testPolicyPeer, testPolicyCircuit(2), 1)
+	require.ErrorIs(t, err, ErrPeerMaxInFlightExceeded)
+
+	require.True(t, manager.RemovePolicy(testPolicyPeer))
+	require.False(t, manager.RemovePolicy(testPolicyPeer))
+	require.Equal(t, defaultPolicy, manager.Policy(testPolicyPeer))
+}
@@ -0,0 +1,833 @@
+package htlcswitch
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// DefaultProtectedPercent is the default percentage of the slots and
+	// liquidity of an outgoing channel that is reserved for accountable
+	// HTLCs of reputable peers.
+	DefaultProtectedPercent = 50
+
+	// DefaultRevenueWindow is the default window over which the forwarding
+	// revenue of an outgoing channel is averaged.
+	DefaultRevenueWindow = 14 * 24 * time.Hour
+
+	// DefaultReputationWindow is the default window over which the
+	// reputation of an incoming peer is averaged.
+	DefaultReputationWindow = 12 * DefaultRevenueWindow
+
+	// DefaultResolutionPeriod is the default time we expect a well behaved
+	// HTLC to be resolved in. HTLCs that are held longer than this are
+	// charged the opportunity cost of the resources they occupied.
+	DefaultResolutionPeriod = 90 * time.Second
+
+	// expectedBlockTime is the expected time between two blocks, used to
+	// estimate the time an HTLC may be held before it times out.
+	expectedBlockTime = 10 * time.Minute
+
+	// resourcePersistInterval is the interval at which updated averages
+	// are written to the store.
+	resourcePersistInterval = time.Minute
+)
+
+var (
+	// ErrResourcesExhausted is returned when an HTLC can't be forwarded
+	// because the resources of the outgoing channel that are available to
+	// it are exhausted.
+	ErrResourcesExhausted = errors.New("outgoing channel resources " +
+		"exhausted")
+)
+
+// ResourceBucket identifies the bucket of outgoing channel resources an HTLC
+// was admitted into.
+type ResourceBucket uint8
+
+const (
+	// GeneralBucket is the bucket of resources that is shared by all
+	// HTLCs.
+	GeneralBucket ResourceBucket = iota
+
+	// ProtectedBucket is the bucket of resources that is reserved for
+	// accountable HTLCs of reputable peers.
+	ProtectedBucket
+)
+
+// String returns a human-readable name of the bucket.
+func (b ResourceBucket) String() string {
+	switch b {
+	case GeneralBucket:
+		return "general"
+
+	case ProtectedBucket:
+		return "protected"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(b))
+	}
+}
+
+// ResourceManagerConfig holds the configuration of the resource manager.
+type ResourceManagerConfig struct {
+	// Bucketing indicates whether HTLCs are admitted into resource
+	// buckets. If it is false, the manager only tracks the reputation of
+	// peers and the revenue of channels, and all HTLCs are forwarded.
+	Bucketing bool
+
+	// ProtectedSlotPercent is the percentage of the HTLC slots of each
+	// outgoing channel that is reserved for accountable HTLCs of
+	// reputable peers.
+	ProtectedSlotPercent uint8
+
+	// ProtectedLiquidityPercent is the percentage of the in-flight
+	// liquidity of each outgoing channel that is reserved for accountable
+	// HTLCs of reputable peers.
+	ProtectedLiquidityPercent uint8
+
+	// RevenueWindow is the window over which the forwarding revenue of an
+	// outgoing channel is averaged.
+	RevenueWindow time.Duration
+
+	// ReputationWindow is the window over which the reputation of an
+	// incoming peer is averaged.
+	ReputationWindow time.Duration
+
+	// ResolutionPeriod is the time we expect a well behaved HTLC to be
+	// resolved in.
+	ResolutionPeriod time.Duration
+
+	// Clock is the time source of the manager.
+	Clock clock.Clock
+
+	// Store persists the reputation of peers and the revenue of channels.
+	// If it is nil, they are only kept in memory.
+	Store ResourceStore
+}
+
+// ProposedHtlc describes an HTLC that is about to be forwarded.
+type ProposedHtlc struct {
+	// IncomingCircuit identifies the incoming HTLC.
+	IncomingCircuit models.CircuitKey
+
+	// IncomingPeer is the peer that offered us the HTLC.
+	IncomingPeer [33]byte
+
+	// OutgoingChannel is the channel the HTLC is forwarded over.
+	OutgoingChannel lnwire.ShortChannelID
+
+	// OutgoingMaxHtlcs is the maximum number of HTLCs we may offer on the
+	// outgoing channel.
+	OutgoingMaxHtlcs uint16
+
+	// OutgoingMaxInFlight is the maximum value of HTLCs we may offer on
+	// the outgoing channel.
+	OutgoingMaxInFlight lnwire.MilliSatoshi
+
+	// Amount is the outgoing amount of the HTLC.
+	Amount lnwire.MilliSatoshi
+
+	// Fee is the fee we earn for forwarding the HTLC.
+	Fee lnwire.MilliSatoshi
+
+	// CltvDelta is the number of blocks until the incoming HTLC expires.
+	CltvDelta uint32
+
+	// Accountable indicates whether the incoming HTLC was signaled as
+	// accountable.
+	Accountable bool
+}
+
+// inFlightHtlc is an HTLC that was admitted by the resource manager and
+// hasn't been resolved yet.
+type inFlightHtlc struct {
+	incomingPeer [33]byte
+	outgoingChan lnwire.ShortChannelID
+	amount       lnwire.MilliSatoshi
+	fee          lnwire.MilliSatoshi
+	risk         float64
+	accountable  bool
+	bucket       ResourceBucket
+	added        time.Time
+}
+
+// decayingAverage is a value that decays exponentially over time, halving
+// once per window.
+type decayingAverage struct {
+	value      float64
+	lastUpdate time.Time
+	window     time.Duration
+}
+
+// valueAt returns the decayed value at the given time.
+func (d *decayingAverage) valueAt(now time.Time) float64 {
+	if d.lastUpdate.IsZero() || !now.After(d.lastUpdate) {
+		return d.value
+	}
+
+	elapsed := now.Sub(d.lastUpdate).Seconds()
+	decay := math.Pow(0.5, elapsed/d.window.Seconds())
+
+	return d.value * decay
+}
+
+// add decays the value to the given time and adds the delta to it.
+func (d *decayingAverage) add(now time.Time, delta float64) {
+	d.value = d.valueAt(now) + delta
+	d.lastUpdate = now
+}
+
+// stored returns the persisted state of the average.
+func (d *decayingAverage) stored() StoredAverage {
+	return StoredAverage{
+		Value:      d.value,
+		LastUpdate: d.lastUpdate,
+	}
+}
+
+// channelResources tracks the resources of an outgoing channel that are
+// occupied by forwarded HTLCs.
+type channelResources struct {
+	revenue decayingAverage
+
+	maxHtlcs    uint16
+	maxInFlight lnwire.MilliSatoshi
+
+	generalSlots       uint16
+	generalLiquidity   lnwire.MilliSatoshi
+	protectedSlots     uint16
+	protectedLiquidity lnwire.MilliSatoshi
+}
+
+// limits returns the number of slots and the amount of liquidity of the
+// given bucket.
+func (c *channelResources) limits(bucket ResourceBucket,
+	cfg *ResourceManagerConfig) (uint16, lnwire.MilliSatoshi) {
+
+	protectedSlots := uint16(
+		uint32(c.maxHtlcs) * uint32(cfg.ProtectedSlotPercent) / 100,
+	)
+	protectedLiquidity := c.maxInFlight *
+		lnwire.MilliSatoshi(cfg.ProtectedLiquidityPercent) / 100
+
+	if bucket == ProtectedBucket {
+		return protectedSlots, protectedLiquidity
+	}
+
+	return c.maxHtlcs - protectedSlots,
+		c.maxInFlight - protectedLiquidity
+}
+
+// fits returns whether an HTLC of the given amount fits into the bucket.
+func (c *channelResources) fits(bucket ResourceBucket,
+	amt lnwire.MilliSatoshi, cfg *ResourceManagerConfig) bool {
+
+	maxSlots, maxLiquidity := c.limits(bucket, cfg)
+
+	slots, liquidity := c.generalSlots, c.generalLiquidity
+	if bucket == ProtectedBucket {
+		slots, liquidity = c.protectedSlots, c.protectedLiquidity
+	}
+
+	return slots+1 <= maxSlots && liquidity+amt <= maxLiquidity
+}
+
+// occupy adds an HTLC of the given amount to the bucket.
+func (c *channelResources) occupy(bucket ResourceBucket,
+	amt lnwire.MilliSatoshi) {
+
+	if bucket == ProtectedBucket {
+		c.protectedSlots++
+		c.protectedLiquidity += amt
+
+		return
+	}
+
+	c.generalSlots++
+	c.generalLiquidity += amt
+}
+
+// release removes an HTLC of the given amount from the bucket.
+func (c *channelResources) release(bucket ResourceBucket,
+	amt lnwire.MilliSatoshi) {
+
+	if bucket == ProtectedBucket {
+		c.protectedSlots--
+		c.protectedLiquidity -= amt
+
+		return
+	}
+
+	c.generalSlots--
+	c.generalLiquidity -= amt
+}
+
+// PeerReputation is a snapshot of the reputation of an incoming peer.
+type PeerReputation struct {
+	// Peer is the pubkey of the peer.
+	Peer [33]byte
+
+	// Reputation is the decaying average of the fees the peer's HTLCs
+	// earned us, minus the opportunity cost of HTLCs it held too long.
+	Reputation lnwire.MilliSatoshi
+
+	// Negative indicates whether the reputation is below zero, in which
+	// case Reputation holds its absolute value.
+	Negative bool
+
+	// InFlightRisk is the worst case opportunity cost of the accountable
+	// HTLCs of the peer that are currently in flight.
+	InFlightRisk lnwire.MilliSatoshi
+
+	// InFlight is the number of HTLCs of the peer that are currently in
+	// flight.
+	InFlight int
+}
+
+// ChannelResources is a snapshot of the resources of an outgoing channel.
+type ChannelResources struct {
+	// Channel is the short channel id of the channel.
+	Channel lnwire.ShortChannelID
+
+	// Revenue is the decaying average of the fees the channel earned us.
+	Revenue lnwire.MilliSatoshi
+
+	// GeneralSlots and GeneralSlotsUsed are the size and usage of the
+	// general slot bucket.
+	GeneralSlots     uint16
+	GeneralSlotsUsed uint16
+
+	// GeneralLiquidity and GeneralLiquidityUsed are the size and usage of
+	// the general liquidity bucket.
+	GeneralLiquidity     lnwire.MilliSatoshi
+	GeneralLiquidityUsed lnwire.MilliSatoshi
+
+	// ProtectedSlots and ProtectedSlotsUsed are the size and usage of the
+	// protected slot bucket.
+	ProtectedSlots     uint16
+	ProtectedSlotsUsed uint16
+
+	// ProtectedLiquidity and ProtectedLiquidityUsed are the size and usage
+	// of the protected liquidity bucket.
+	ProtectedLiquidity     lnwire.MilliSatoshi
+	ProtectedLiquidityUsed lnwire.MilliSatoshi
+}
+
+// ResourceSnapshot is a snapshot of the state of the resource manager.
+type ResourceSnapshot struct {
+	// Bucketing indicates whether HTLCs are admitted into resource
+	// buckets.
+	Bucketing bool
+
+	// Peers is the reputation of all known incoming peers.
+	Peers []PeerReputation
+
+	// Channels is the resource usage of all known outgoing channels.
+	Channels []ChannelResources
+
+	// GeneralForwards is the number of HTLCs admitted into the general
+	// bucket.
+	GeneralForwards uint64
+
+	// ProtectedForwards is the number of HTLCs admitted into the
+	// protected bucket.
+	ProtectedForwards uint64
+
+	// Rejected is the number of HTLCs that were failed because the
+	// resources available to them were exhausted.
+	Rejected uint64
+}
+
+// ResourceManager decides whether forwarded HTLCs may use the resources of
+// their outgoing channel. It tracks the reputation of incoming peers based on
+// the fees their HTLCs earn us and the time they hold our resources, and
+// reserves a protected bucket of slots and liquidity on each outgoing channel
+// for accountable HTLCs of peers whose reputation exceeds the revenue of the
+// outgoing channel. All other HTLCs share the general bucket and are failed
+// once it is saturated.
+//
+// The reputation of peers and the revenue of channels are persisted in the
+// configured store periodically and when the manager is stopped. HTLCs that
+// were in flight before a restart aren't accounted for.
+type ResourceManager struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *ResourceManagerConfig
+
+	mu sync.Mutex
+
+	reputation map[[33]byte]*decayingAverage
+	channels   map[lnwire.ShortChannelID]*channelResources
+	inFlight   map[models.CircuitKey]*inFlightHtlc
+
+	// dirtyPeers and dirtyChannels hold the peers and channels whose
+	// averages changed since they were last persisted.
+	dirtyPeers    map[[33]byte]struct{}
+	dirtyChannels map[lnwire.ShortChannelID]struct{}
+
+	generalForwards   uint64
+	protectedForwards uint64
+	rejected          uint64
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewResourceManager creates a new resource manager.
+func NewResourceManager(cfg *ResourceManagerConfig) (*ResourceManager,
+	error) {
+
+	switch {
+	case cfg.ProtectedSlotPercent > 100:
+		return nil, fmt.Errorf("protected slot percent %d exceeds 100",
+			cfg.ProtectedSlotPercent)
+
+	case cfg.ProtectedLiquidityPercent > 100:
+		return nil, fmt.Errorf("protected liquidity percent %d "+
+			"exceeds 100", cfg.ProtectedLiquidityPercent)
+
+	case cfg.RevenueWindow <= 0:
+		return nil, errors.New("revenue window must be positive")
+
+	case cfg.ReputationWindow < cfg.RevenueWindow:
+		return nil, errors.New("reputation window must not be " +
+			"shorter than the revenue window")
+
+	case cfg.ResolutionPeriod <= 0:
+		return nil, errors.New("resolution period must be positive")
+	}
+
+	return &ResourceManager{
+		cfg:        cfg,
+		reputation: make(map[[33]byte]*decayingAverage),
+		channels: make(
+			map[lnwire.ShortChannelID]*channelResources,
+		),
+		inFlight:      make(map[models.CircuitKey]*inFlightHtlc),
+		dirtyPeers:    make(map[[33]byte]struct{}),
+		dirtyChannels: make(map[lnwire.ShortChannelID]struct{}),
+		quit:          make(chan struct{}),
+	}, nil
+}
+
+// Start restores the persisted averages and starts persisting updated ones.
+func (r *ResourceManager) Start() error {
+	var startErr error
+	r.started.Do(func() {
+		if r.cfg.Store == nil {
+			return
+		}
+
+		log.Debugf("Resource manager starting")
+
+		if err := r.loadAverages(); err != nil {
+			startErr = err
+			return
+		}
+
+		r.wg.Add(1)
+		go r.persistLoop()
+	})
+
+	return startErr
+}
+
+// Stop stops persisting averages and writes the ones that changed since they
+// were last persisted.
+func (r *ResourceManager) Stop() error {
+	var stopErr error
+	r.stopped.Do(func() {
+		if r.cfg.Store == nil {
+			return
+		}
+
+		log.Debugf("Resource manager stopping")
+
+		close(r.quit)
+		r.wg.Wait()
+
+		stopErr = r.persistAverages()
+	})
+
+	return stopErr
+}
+
+// loadAverages restores the persisted reputation of peers and revenue of
+// channels.
+func (r *ResourceManager) loadAverages() error {
+	averages, err := r.cfg.Store.FetchAverages()
+	if err != nil {
+		return fmt.Errorf("unable to fetch resource averages: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for peer, stored := range averages.Reputation {
+		r.reputation[peer] = &decayingAverage{
+			value:      stored.Value,
+			lastUpdate: stored.LastUpdate,
+			window:     r.cfg.ReputationWindow,
+		}
+	}
+
+	for scid, stored := range averages.Revenue {
+		resources := r.channel(scid)
+		resources.revenue.value = stored.Value
+		resources.revenue.lastUpdate = stored.LastUpdate
+	}
+
+	log.Debugf("Restored reputation of %d peers and revenue of %d "+
+		"channels", len(averages.Reputation), len(averages.Revenue))
+
+	return nil
+}
+
+// persistLoop periodically persists the averages that changed.
+//
+// NOTE: This MUST be run as a goroutine.
+func (r *ResourceManager) persistLoop() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(resourcePersistInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.persistAverages(); err != nil {
+				log.Errorf("Unable to persist resource "+
+					"averages: %v", err)
+			}
+
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// persistAverages writes the averages that changed since they were last
+// persisted to the store. If writing fails, they are retried on the next
+// call.
+func (r *ResourceManager) persistAverages() error {
+	r.mu.Lock()
+	if len(r.dirtyPeers) == 0 && len(r.dirtyChannels) == 0 {
+		r.mu.Unlock()
+		return nil
+	}
+
+	averages := &ResourceAverages{
+		Reputation: make(
+			map[[33]byte]StoredAverage, len(r.dirtyPeers),
+		),
+		Revenue: make(
+			map[lnwire.ShortChannelID]StoredAverage,
+			len(r.dirtyChannels),
+		),
+	}
+	for peer := range r.dirtyPeers {
+		averages.Reputation[peer] = r.reputation[peer].stored()
+	}
+	for scid := range r.dirtyChannels {
+		averages.Revenue[scid] = r.channels[scid].revenue.stored()
+	}
+
+	r.dirtyPeers = make(map[[33]byte]struct{})
+	r.dirtyChannels = make(map[lnwire.ShortChannelID]struct{})
+	r.mu.Unlock()
+
+	err := r.cfg.Store.PutAverages(averages)
+	if err == nil {
+		return nil
+	}
+
+	// Mark the averages as dirty again, so that they are written on the
+	// next attempt.
+	r.mu.Lock()
+	for peer := range averages.Reputation {
+		r.dirtyPeers[peer] = struct{}{}
+	}
+	for scid := range averages.Revenue {
+		r.dirtyChannels[scid] = struct{}{}
+	}
+	r.mu.Unlock()
+
+	return err
+}
+
+// htlcRisk returns the worst case opportunity cost of an HTLC, which is the
+// fee it would have to pay for every resolution period it may hold our
+// resources before it times out.
+func (r *ResourceManager) htlcRisk(fee lnwire.MilliSatoshi,
+	cltvDelta uint32) float64 {
+
+	maxHold := time.Duration(cltvDelta) * expectedBlockTime
+	periods := math.Ceil(
+		maxHold.Seconds() / r.cfg.ResolutionPeriod.Seconds(),
+	)
+
+	return float64(fee) * periods
+}
+
+// inFlightRisk returns the total risk of the accountable HTLCs of the peer
+// that are in flight.
+//
+// NOTE: The caller must hold the mutex.
+func (r *ResourceManager) inFlightRisk(peer [33]byte) (float64, int) {
+	var (
+		risk  float64
+		count int
+	)
+	for _, htlc := range r.inFlight {
+		if htlc.incomingPeer != peer {
+			continue
+		}
+
+		count++
+		if htlc.accountable {
+			risk += htlc.risk
+		}
+	}
+
+	return risk, count
+}
+
+// reputationAt returns the reputation of the peer at the given time.
+//
+// NOTE: The caller must hold the mutex.
+func (r *ResourceManager) reputationAt(peer [33]byte, now time.Time) float64 {
+	reputation, ok := r.reputation[peer]
+	if !ok {
+		return 0
+	}
+
+	return reputation.valueAt(now)
+}
+
+// channel returns the resources of the outgoing channel, creating them if
+// they aren't tracked yet.
+//
+// NOTE: The caller must hold the mutex.
+func (r *ResourceManager) channel(
+	scid lnwire.ShortChannelID) *channelResources {
+
+	resources, ok := r.channels[scid]
+	if !ok {
+		resources = &channelResources{
+			revenue: decayingAverage{window: r.cfg.RevenueWindow},
+		}
+		r.channels[scid] = resources
+	}
+
+	return resources
+}
+
+// AddHtlc decides whether the proposed HTLC may be forwarded and, if so,
+// returns the bucket it was admitted into. ErrResourcesExhausted is returned
+// if the HTLC must be failed. An admitted HTLC must eventually be passed to
+// ResolveHtlc or ReleaseHtlc.
+func (r *ResourceManager) AddHtlc(htlc *ProposedHtlc) (ResourceBucket,
+	error) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// An HTLC that is forwarded again keeps the resources it occupies.
+	if inFlight, ok := r.inFlight[htlc.IncomingCircuit]; ok {
+		return inFlight.bucket, nil
+	}
+
+	now := r.cfg.Clock.Now()
+
+	resources := r.channel(htlc.OutgoingChannel)
+	resources.maxHtlcs = htlc.OutgoingMaxHtlcs
+	resources.maxInFlight = htlc.OutgoingMaxInFlight
+
+	risk := r.htlcRisk(htlc.Fee, htlc.CltvDelta)
+
+	bucket := GeneralBucket
+	if r.cfg.Bucketing {
+		// A peer is reputable towards the outgoing channel if its
+		// reputation covers the revenue of the channel, even if this
+		// and all its other accountable HTLCs are held until they
+		// time out.
+		peerRisk, _ := r.inFlightRisk(htlc.IncomingPeer)
+		reputation := r.reputationAt(htlc.IncomingPeer, now)
+		reputable := reputation-peerRisk-risk >=
+			resources.revenue.valueAt(now)
+
+		protected := htlc.Accountable && reputable &&
+			resources.fits(ProtectedBucket, htlc.Amount, r.cfg)
+
+		switch {
+		case protected:
+			bucket = ProtectedBucket
+
+		case resources.fits(GeneralBucket, htlc.Amount, r.cfg):
+			bucket = GeneralBucket
+
+		default:
+			r.rejected++
+
+			return 0, ErrResourcesExhausted
+		}
+	}
+
+	resources.occupy(bucket, htlc.Amount)
+	r.inFlight[htlc.IncomingCircuit] = &inFlightHtlc{
+		incomingPeer: htlc.IncomingPeer,
+		outgoingChan: htlc.OutgoingChannel,
+		amount:       htlc.Amount,
+		fee:          htlc.Fee,
+		risk:         risk,
+		accountable:  htlc.Accountable,
+		bucket:       bucket,
+		added:        now,
+	}
+
+	if bucket == ProtectedBucket {
+		r.protectedForwards++
+	} else {
+		r.generalForwards++
+	}
+
+	return bucket, nil
+}
+
+// ReleaseHtlc frees the resources of an admitted HTLC that couldn't be
+// forwarded without updating the reputation of its peer.
+func (r *ResourceManager) ReleaseHtlc(key models.CircuitKey) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	htlc, ok := r.inFlight[key]
+	if !ok {
+		return
+	}
+
+	delete(r.inFlight, key)
+	r.channel(htlc.outgoingChan).release(htlc.bucket, htlc.amount)
+}
+
+// ResolveHtlc frees the resources of a resolved HTLC and updates the
+// reputation of its incoming peer and the revenue of its outgoing channel.
+// HTLCs that aren't known to the manager are ignored.
+func (r *ResourceManager) ResolveHtlc(key models.CircuitKey, settled bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	htlc, ok := r.inFlight[key]
+	if !ok {
+		return
+	}
+
+	delete(r.inFlight, key)
+
+	now := r.cfg.Clock.Now()
+	resources := r.channel(htlc.outgoingChan)
+	resources.release(htlc.bucket, htlc.amount)
+
+	var earned float64
+	if settled {
+		earned = float64(htlc.fee)
+		resources.revenue.add(now, earned)
+		r.dirtyChannels[htlc.outgoingChan] = struct{}{}
+	}
+
+	// Every full resolution period the HTLC held our resources costs its
+	// peer the fee of the HTLC.
+	holdTime := now.Sub(htlc.added)
+	periods := math.Floor(
+		holdTime.Seconds() / r.cfg.ResolutionPeriod.Seconds(),
+	)
+	effective := earned - float64(htlc.fee)*periods
+
+	// Unaccountable HTLCs may only improve the reputation of the peer,
+	// since the peer didn't vouch for them.
+	if !htlc.accountable && effective < 0 {
+		return
+	}
+
+	reputation, ok := r.reputation[htlc.incomingPeer]
+	if !ok {
+		reputation = &decayingAverage{
+			window: r.cfg.ReputationWindow,
+		}
+		r.reputation[htlc.incomingPeer] = reputation
+	}
+	reputation.add(now, effective)
+	r.dirtyPeers[htlc.incomingPeer] = struct{}{}
+}
+
+// Snapshot returns the current state of the resource manager.
+func (r *ResourceManager) Snapshot() *ResourceSnapshot {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.cfg.Clock.Now()
+
+	snapshot := &ResourceSnapshot{
+		Bucketing:         r.cfg.Bucketing,
+		GeneralForwards:   r.generalForwards,
+		ProtectedForwards: r.protectedForwards,
+		Rejected:          r.rejected,
+	}
+
+	peers := make(map[[33]byte]struct{}, len(r.reputation))
+	for peer := range r.reputation {
+		peers[peer] = struct{}{}
+	}
+	for _, htlc := range r.inFlight {
+		peers[htlc.incomingPeer] = struct{}{}
+	}
+
+	for peer := range peers {
+		reputation := r.reputationAt(peer, now)
+		risk, count := r.inFlightRisk(peer)
+
+		snapshot.Peers = append(snapshot.Peers, PeerReputation{
+			Peer: peer,
+			Reputation: lnwire.MilliSatoshi(
+				math.Abs(reputation),
+			),
+			Negative:     reputation < 0,
+			InFlightRisk: lnwire.MilliSatoshi(risk),
+			InFlight:     count,
+		})
+	}
+
+	for scid, resources := range r.channels {
+		generalSlots, generalLiquidity := resources.limits(
+			GeneralBucket, r.cfg,
+		)
+		protectedSlots, protectedLiquidity := resources.limits(
+			ProtectedBucket, r.cfg,
+		)
+
+		snapshot.Channels = append(snapshot.Channels, ChannelResources{
+			Channel: scid,
+			Revenue: lnwire.MilliSatoshi(
+				resources.revenue.valueAt(now),
+			),
+			GeneralSlots:           generalSlots,
+			GeneralSlotsUsed:       resources.generalSlots,
+			GeneralLiquidity:       generalLiquidity,
+			GeneralLiquidityUsed:   resources.generalLiquidity,
+			ProtectedSlots:         protectedSlots,
+			ProtectedSlotsUsed:     resources.protectedSlots,
+			ProtectedLiquidity:     protectedLiquidity,
+			ProtectedLiquidityUsed: resources.protectedLiquidity,
+		})
+	}
+
+	return snapshot
+}
@@ -0,0 +1,279 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testResourcePeer    = [33]byte{1}
+	testResourceOutChan = lnwire.NewShortChanIDFromInt(2)
+)
+
+// newTestResourceManager creates a resource manager with bucketing enabled
+// and a test clock.
+func newTestResourceManager(t *testing.T) (*ResourceManager,
+	*clock.TestClock) {
+
+	testClock := clock.NewTestClock(time.Unix(1_700_000_000, 0))
+	manager, err := NewResourceManager(&ResourceManagerConfig{
+		Bucketing:                 true,
+		ProtectedSlotPercent:      50,
+		ProtectedLiquidityPercent: 50,
+		RevenueWindow:             DefaultRevenueWindow,
+		ReputationWindow:          DefaultReputationWindow,
+		ResolutionPeriod:          DefaultResolutionPeriod,
+		Clock:                     testClock,
+	})
+	require.NoError(t, err)
+
+	return manager, testClock
+}
+
+// testProposedHtlc returns a proposed HTLC on a channel with four slots and
+// 4000 msat of in-flight liquidity.
+func testProposedHtlc(id uint64, accountable bool) *ProposedHtlc {
+	return &ProposedHtlc{
+		IncomingCircuit: models.CircuitKey{
+			ChanID: lnwire.NewShortChanIDFromInt(1),
+			HtlcID: id,
+		},
+		IncomingPeer:        testResourcePeer,
+		OutgoingChannel:     testResourceOutChan,
+		OutgoingMaxHtlcs:    4,
+		OutgoingMaxInFlight: 4000,
+		Amount:              1000,
+		Fee:                 10,
+		CltvDelta:           1,
+		Accountable:         accountable,
+	}
+}
+
+// TestResourceManagerGeneralBucket asserts that HTLCs of peers without
+// reputation share the general bucket and are rejected once it is full.
+func TestResourceManagerGeneralBucket(t *testing.T) {
+	t.Parallel()
+
+	manager, _ := newTestResourceManager(t)
+
+	for i := uint64(0); i < 2; i++ {
+		bucket, err := manager.AddHtlc(testProposedHtlc(i, true))
+		require.NoError(t, err)
+		require.Equal(t, GeneralBucket, bucket)
+	}
+
+	_, err := manager.AddHtlc(testProposedHtlc(2, true))
+	require.ErrorIs(t, err, ErrResourcesExhausted)
+
+	// Adding an HTLC that is already in flight doesn't occupy more
+	// resources.
+	bucket, err := manager.AddHtlc(testProposedHtlc(0, true))
+	require.NoError(t, err)
+	require.Equal(t, GeneralBucket, bucket)
+
+	// Once an HTLC is released, its slot can be used again.
+	manager.ReleaseHtlc(testProposedHtlc(0, true).IncomingCircuit)
+	_, err = manager.AddHtlc(testProposedHtlc(2, true))
+	require.NoError(t, err)
+
+	snapshot := manager.Snapshot()
+	require.EqualValues(t, 3, snapshot.GeneralForwards)
+	require.EqualValues(t, 1, snapshot.Rejected)
+	require.Len(t, snapshot.Channels, 1)
+	require.EqualValues(t, 2, snapshot.Channels[0].GeneralSlotsUsed)
+	require.EqualValues(t, 2000, snapshot.Channels[0].GeneralLiquidityUsed)
+}
+
+// TestResourceManagerProtectedBucket asserts that accountable HTLCs of a
+// peer whose reputation covers the revenue of the outgoing channel use the
+// protected bucket, while its unaccountable HTLCs don't.
+func TestResourceManagerProtectedBucket(t *testing.T) {
+	t.Parallel()
+
+	manager, _ := newTestResourceManager(t)
+
+	// Build up reputation by settling HTLCs quickly. The risk of an HTLC
+	// with a delta of one block is the fee of seven resolution periods.
+	for i := uint64(0); i < 10; i++ {
+		htlc := testProposedHtlc(i, true)
+		_, err := manager.AddHtlc(htlc)
+		require.NoError(t, err)
+
+		manager.ResolveHtlc(htlc.IncomingCircuit, true)
+	}
+
+	bucket, err := manager.AddHtlc(testProposedHtlc(10, false))
+	require.NoError(t, err)
+	require.Equal(t, GeneralBucket, bucket)
+
+	// The peer earned 100 msat and the channel earned the same amount, so
+	// the risk of the HTLC isn't covered yet.
+	bucket, err = manager.AddHtlc(testProposedHtlc(11, true))
+	require.NoError(t, err)
+	require.Equal(t, GeneralBucket, bucket)
+
+	// Quickly settled unaccountable HTLCs with higher fees over another
+	// channel give the peer enough reputation.
+	for i := uint64(100); i < 120; i++ {
+		htlc := testProposedHtlc(i, false)
+		htlc.OutgoingChannel = lnwire.NewShortChanIDFromInt(3)
+		htlc.Fee = 100
+		_, err := manager.AddHtlc(htlc)
+		require.NoError(t, err)
+
+		manager.ResolveHtlc(htlc.IncomingCircuit, true)
+	}
+
+	bucket, err = manager.AddHtlc(testProposedHtlc(12, true))
+	require.NoError(t, err)
+	require.Equal(t, ProtectedBucket, bucket)
+
+	// The general bucket is full now, so unaccountable HTLCs are
+	// rejected.
+	_, err = manager.AddHtlc(testProposedHtlc(13, false))
+	require.ErrorIs(t, err, ErrResourcesExhausted)
+
+	snapshot := manager.Snapshot()
+	require.EqualValues(t, 1, snapshot.ProtectedForwards)
+	require.Len(t, snapshot.Peers, 1)
+	require.False(t, snapshot.Peers[0].Negative)
+	require.Equal(t, 3, snapshot.Peers[0].InFlight)
+}
+
+// TestResourceManagerSlowHtlcs asserts that accountable HTLCs that are held
+// longer than the resolution period cost their peer reputation, while
+// unaccountable ones don't.
+func TestResourceManagerSlowHtlcs(t *testing.T) {
+	t.Parallel()
+
+	manager, testClock := newTestResourceManager(t)
+
+	unaccountable := testProposedHtlc(0, false)
+	_, err := manager.AddHtlc(unaccountable)
+	require.NoError(t, err)
+
+	accountable := testProposedHtlc(1, true)
+	_, err = manager.AddHtlc(accountable)
+	require.NoError(t, err)
+
+	testClock.SetTime(
+		testClock.Now().Add(3 * DefaultResolutionPeriod),
+	)
+	manager.ResolveHtlc(unaccountable.IncomingCircuit, false)
+	manager.ResolveHtlc(accountable.IncomingCircuit, true)
+
+	// The settled accountable HTLC earned its fee once but held our
+	// resources for three resolution periods.
+	snapshot := manager.Snapshot()
+	require.Len(t, snapshot.Peers, 1)
+	require.True(t, snapshot.Peers[0].Negative)
+	require.EqualValues(t, 20, snapshot.Peers[0].Reputation)
+	require.Zero(t, snapshot.Peers[0].InFlight)
+
+	require.Len(t, snapshot.Channels, 1)
+	require.EqualValues(t, 10, snapshot.Channels[0].Revenue)
+	require.Zero(t, snapshot.Channels[0].GeneralSlotsUsed)
+}
+
+// TestResourceManagerNoBucketing asserts that all HTLCs are forwarded if
+// bucketing is disabled.
+func TestResourceManagerNoBucketing(t *testing.T) {
+	t.Parallel()
+
+	manager, _ := newTestResourceManager(t)
+	manager.cfg.Bucketing = false
+
+	for i := uint64(0); i < 10; i++ {
+		bucket, err := manager.AddHtlc(testProposedHtlc(i, true))
+		require.NoError(t, err)
+		require.Equal(t, GeneralBucket, bucket)
+	}
+
+	require.Zero(t, manager.Snapshot().Rejected)
+}
+
+// TestDecayingAverage asserts that a decaying average halves once per
+// window.
+func TestDecayingAverage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	avg := decayingAverage{window: time.Hour}
+	avg.add(now, 100)
+
+	require.InDelta(t, 100, avg.valueAt(now), 1e-9)
+	require.InDelta(t, 50, avg.valueAt(now.Add(time.Hour)), 1e-9)
+
+	avg.add(now.Add(2*time.Hour), 75)
+	require.InDelta(t, 100, avg.valueAt(now.Add(2*time.Hour)), 1e-9)
+}
+
+// TestResourceManagerPersistence asserts that the reputation of peers and the
+// revenue of channels are restored after a restart.
+func TestResourceManagerPersistence(t *testing.T) {
+	t.Parallel()
+
+	cdb, err := channeldb.MakeTestDB(t)
+	require.NoError(t, err)
+
+	store, err := NewResourceStore(cdb)
+	require.NoError(t, err)
+
+	manager, testClock := newTestResourceManager(t)
+	manager.cfg.Store = store
+	require.NoError(t, manager.Start())
+
+	htlc := testProposedHtlc(0, true)
+	_, err = manager.AddHtlc(htlc)
+	require.NoError(t, err)
+
+	testClock.SetTime(
+		testClock.Now().Add(3 * DefaultResolutionPeriod),
+	)
+	manager.ResolveHtlc(htlc.IncomingCircuit, true)
+
+	// Nothing is written before the manager is stopped or the persist
+	// interval elapsed.
+	averages, err := store.FetchAverages()
+	require.NoError(t, err)
+	require.Empty(t, averages.Reputation)
+	require.Empty(t, averages.Revenue)
+
+	require.NoError(t, manager.Stop())
+
+	averages, err = store.FetchAverages()
+	require.NoError(t, err)
+	require.Equal(t, map[[33]byte]StoredAverage{
+		testResourcePeer: {Value: -20, LastUpdate: testClock.Now()},
+	}, averages.Reputation)
+	require.Equal(t, map[lnwire.ShortChannelID]StoredAverage{
+		testResourceOutChan: {Value: 10, LastUpdate: testClock.Now()},
+	}, averages.Revenue)
+
+	// A new manager using the same store starts with the persisted
+	// averages, decayed to the current time.
+	restarted, restartedClock := newTestResourceManager(t)
+	restarted.cfg.Store = store
+	require.NoError(t, restarted.Start())
+	t.Cleanup(func() {
+		require.NoError(t, restarted.Stop())
+	})
+
+	restartedClock.SetTime(
+		testClock.Now().Add(DefaultRevenueWindow),
+	)
+
+	snapshot := restarted.Snapshot()
+	require.Len(t, snapshot.Peers, 1)
+	require.True(t, snapshot.Peers[0].Negative)
+	require.EqualValues(t, 18, snapshot.Peers[0].Reputation)
+
+	require.Len(t, snapshot.Channels, 1)
+	require.EqualValues(t, 5, snapshot.Channels[0].Revenue)
+}
@@ -0,0 +1,265 @@
+package htlcswitch
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// resourceBucketKey is the top-level bucket that stores the decaying
+	// averages of the resource manager.
+	resourceBucketKey = []byte("resource-manager-averages")
+
+	// reputationBucketKey is the sub-bucket that stores the reputation of
+	// incoming peers.
+	//
+	// maps: peerPubKey -> StoredAverage
+	reputationBucketKey = []byte("reputation")
+
+	// revenueBucketKey is the sub-bucket that stores the revenue of
+	// outgoing channels.
+	//
+	// maps: shortChanID -> StoredAverage
+	revenueBucketKey = []byte("revenue")
+
+	// errResourceBucketMissing is returned when the buckets of the
+	// resource store weren't created.
+	errResourceBucketMissing = errors.New("resource manager bucket " +
+		"not found")
+)
+
+// StoredAverage is the persisted state of a decaying average.
+type StoredAverage struct {
+	// Value is the value of the average at the time of its last update.
+	Value float64
+
+	// LastUpdate is the time of the last update of the average.
+	LastUpdate time.Time
+}
+
+// ResourceAverages holds the reputation of incoming peers and the revenue of
+// outgoing channels tracked by the resource manager.
+type ResourceAverages struct {
+	// Reputation is the reputation of incoming peers.
+	Reputation map[[33]byte]StoredAverage
+
+	// Revenue is the revenue of outgoing channels.
+	Revenue map[lnwire.ShortChannelID]StoredAverage
+}
+
+// ResourceStore persists the decaying averages of the resource manager, so
+// that the reputation of peers and the revenue of channels survive restarts.
+type ResourceStore interface {
+	// PutAverages adds or replaces the given averages.
+	PutAverages(averages *ResourceAverages) error
+
+	// FetchAverages returns all stored averages.
+	FetchAverages() (*ResourceAverages, error)
+}
+
+// kvResourceStore is a ResourceStore backed by a kvdb backend.
+type kvResourceStore struct {
+	db kvdb.Backend
+}
+
+// A compile-time assertion to ensure that kvResourceStore implements the
+// ResourceStore interface.
+var _ ResourceStore = (*kvResourceStore)(nil)
+
+// NewResourceStore creates a new store for the averages of the resource
+// manager, creating its buckets if needed.
+func NewResourceStore(db kvdb.Backend) (ResourceStore, error) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		bucket, err := tx.CreateTopLevelBucket(resourceBucketKey)
+		if err != nil {
+			return err
+		}
+
+		_, err = bucket.CreateBucketIfNotExists(reputationBucketKey)
+		if err != nil {
+			return err
+		}
+
+		_, err = bucket.CreateBucketIfNotExists(revenueBucketKey)
+
+		return err
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &kvResourceStore{db: db}, nil
+}
+
+// averageRecord is the serialized representation of a StoredAverage.
+type averageRecord struct {
+	value      uint64
+	lastUpdate uint64
+}
+
+// toTlvStream converts an averageRecord into a tlv representation.
+func (r *averageRecord) toTlvStream() (*tlv.Stream, error) {
+	const (
+		// A set of tlv type definitions used to serialize a decaying
+		// average.
+		valueType      tlv.Type = 0
+		lastUpdateType tlv.Type = 1
+	)
+
+	return tlv.NewStream(
+		tlv.MakePrimitiveRecord(valueType, &r.value),
+		tlv.MakePrimitiveRecord(lastUpdateType, &r.lastUpdate),
+	)
+}
+
+// serializeAverage serializes a StoredAverage based on tlv format.
+func serializeAverage(average StoredAverage) ([]byte, error) {
+	r := &averageRecord{
+		value:      math.Float64bits(average.Value),
+		lastUpdate: uint64(average.LastUpdate.UnixNano()),
+	}
+
+	tlvStream, err := r.toTlvStream()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tlvStream.Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// deserializeAverage deserializes a StoredAverage based on tlv format.
+func deserializeAverage(b []byte) (StoredAverage, error) {
+	var r averageRecord
+	tlvStream, err := r.toTlvStream()
+	if err != nil {
+		return StoredAverage{}, err
+	}
+
+	if err := tlvStream.Decode(bytes.NewReader(b)); err != nil {
+		return StoredAverage{}, err
+	}
+
+	return StoredAverage{
+		Value:      math.Float64frombits(r.value),
+		LastUpdate: time.Unix(0, int64(r.lastUpdate)),
+	}, nil
+}
+
+// PutAverages adds or replaces the given averages.
+func (s *kvResourceStore) PutAverages(averages *ResourceAverages) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(resourceBucketKey)
+		if bucket == nil {
+			return errResourceBucketMissing
+		}
+
+		reputation := bucket.NestedReadWriteBucket(reputationBucketKey)
+		revenue := bucket.NestedReadWriteBucket(revenueBucketKey)
+		if reputation == nil || revenue == nil {
+			return errResourceBucketMissing
+		}
+
+		for peer, average := range averages.Reputation {
+			value, err := serializeAverage(average)
+			if err != nil {
+				return err
+			}
+
+			if err := reputation.Put(peer[:], value); err != nil {
+				return err
+			}
+		}
+
+		for scid, average := range averages.Revenue {
+			value, err := serializeAverage(average)
+			if err != nil {
+				return err
+			}
+
+			var key [8]byte
+			binary.BigEndian.PutUint64(key[:], scid.ToUint64())
+			if err := revenue.Put(key[:], value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+}
+
+// FetchAverages returns all stored averages.
+func (s *kvResourceStore) FetchAverages() (*ResourceAverages, error) {
+	var averages *ResourceAverages
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(resourceBucketKey)
+		if bucket == nil {
+			return errResourceBucketMissing
+		}
+
+		reputation := bucket.NestedReadBucket(reputationBucketKey)
+		revenue := bucket.NestedReadBucket(revenueBucketKey)
+		if reputation == nil || revenue == nil {
+			return errResourceBucketMissing
+		}
+
+		err := reputation.ForEach(func(k, v []byte) error {
+			var peer [33]byte
+			if len(k) != len(peer) {
+				return errors.New("invalid peer key length")
+			}
+			copy(peer[:], k)
+
+			average, err := deserializeAverage(v)
+			if err != nil {
+				return err
+			}
+			averages.Reputation[peer] = average
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return revenue.ForEach(func(k, v []byte) error {
+			if len(k) != 8 {
+				return errors.New("invalid channel key length")
+			}
+			scid := lnwire.NewShortChanIDFromInt(
+				binary.BigEndian.Uint64(k),
+			)
+
+			average, err := deserializeAverage(v)
+			if err != nil {
+				return err
+			}
+			averages.Revenue[scid] = average
+
+			return nil
+		})
+	}, func() {
+		averages = &ResourceAverages{
+			Reputation: make(map[[33]byte]StoredAverage),
+			Revenue: make(
+				map[lnwire.ShortChannelID]StoredAverage,
+			),
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return averages, nil
+}
@@ -229,6 +229,15 @@ type Config struct {
 
 	// IsAlias returns whether or not a given SCID is an alias.
 	IsAlias func(scid lnwire.ShortChannelID) bool
+
+	// ResourceManager is consulted before an HTLC is forwarded to decide
+	// whether it may use the resources of its outgoing channel. If nil,
+	// all HTLCs are forwarded.
+	ResourceManager *ResourceManager
+
+	// PeerPolicies limits the HTLCs each incoming peer may have us
+	// forward across all of its channels. If nil, no limits are enforced.
+	PeerPolicies *PeerPolicyManager
 }
 
 // Switch is the central messaging bus for all incoming/outgoing HTLCs.
@@ -772,8 +781,17 @@ func (s *Switch) ForwardPackets(linkQuit <-chan struct{},
 	}
 
 	// Now, forward any packets for circuits that were successfully added to
-	// the switch's circuit map.
+	// the switch's circuit map, unless the policy of their incoming peer
+	// rejects them.
 	for _, packet := range addedPackets {
+		if linkErr := s.checkPeerPolicy(packet); linkErr != nil {
+			// We don't handle the error here since this method
+			// always returns an error.
+			_ = s.failAddPacket(packet, linkErr)
+
+			continue
+		}
+
 		err := s.routeAsync(packet, fwdChan, linkQuit)
 		if err != nil {
 			return fmt.Errorf("failed to forward packet %w", err)
@@ -822,6 +840,49 @@ func (s *Switch) ForwardPackets(linkQuit <-chan struct{},
 	return nil
 }
 
+// checkPeerPolicy asks the peer policy manager whether the incoming peer of
+// the add packet may have it forwarded. Admitted packets count towards the
+// limits of the peer until they are settled or failed.
+func (s *Switch) checkPeerPolicy(packet *htlcPacket) *LinkError {
+	if s.cfg.PeerPolicies == nil {
+		return nil
+	}
+
+	// If the incoming link is gone, there's no peer to enforce a policy
+	// for. The packet will be failed once it is handled.
+	incomingLink, err := s.GetLinkByShortID(packet.incomingChanID)
+	if err != nil {
+		return nil
+	}
+
+	peer := incomingLink.PeerPubKey()
+	err = s.cfg.PeerPolicies.AddHtlc(
+		peer, packet.inKey(), packet.incomingAmount,
+	)
+	if err == nil {
+		return nil
+	}
+
+	var failureDetail OutgoingFailure
+	switch {
+	case errors.Is(err, ErrPeerHtlcBelowMinimum):
+		failureDetail = OutgoingFailurePeerHtlcBelowMin
+
+	case errors.Is(err, ErrPeerMaxHtlcsExceeded):
+		failureDetail = OutgoingFailurePeerMaxHtlcs
+
+	default:
+		failureDetail = OutgoingFailurePeerMaxInFlight
+	}
+
+	log.Debugf("Rejecting HTLC from IncomingChanID(%v) of peer %x: %v",
+		packet.incomingChanID, peer, err)
+
+	return NewDetailedLinkError(
+		&lnwire.FailTemporaryChannelFailure{}, failureDetail,
+	)
+}
+
 // logFwdErrs logs any errors received on `fwdChan`.
 func (s *Switch) logFwdErrs(num *int, wg *sync.WaitGroup, fwdChan chan error) {
 	defer s.wg.Done()
@@ -1107,15 +1168,31 @@ func (s *Switch) parseFailedPayment(deobfuscator ErrorDecrypter,
 	default:
 		// We'll attempt to fully decrypt the onion encrypted
 		// error. If we're unable to then we'll bail early.
-		failure, err := deobfuscator.DecryptError(htlc.Reason)
+		failure, err := deobfuscator.DecryptError(
+			htlc.Reason, htlc.AttrData.ValOpt().UnwrapOr(nil),
+		)
 		if err != nil {
 			log.Errorf("unable to de-obfuscate onion failure "+
 				"(hash=%v, pid=%d): %v",
 				paymentHash, attemptID, err)
 
+			// If the attribution data allowed us to narrow down
+			// the nodes that corrupted the failure, we'll pass
+			// this on to the caller.
+			var unreadableErr *UnreadableFailureError
+			if errors.As(err, &unreadableErr) {
+				return unreadableErr
+			}
+
 			return ErrUnreadableFailureMessage
 		}
 
+		if len(failure.HoldTimes) > 0 {
+			log.Debugf("Hold times of failed payment (hash=%v, "+
+				"pid=%d): %v", paymentHash, attemptID,
+				failure.HoldTimes)
+		}
+
 		return failure
 	}
 }
@@ -1229,10 +1306,18 @@ func (s *Switch) checkCircularForward(incoming, outgoing lnwire.ShortChannelID,
 // The ciphertext will be derived from the failure message proivded by context.
 // This method returns the failErr if all other steps complete successfully.
 func (s *Switch) failAddPacket(packet *htlcPacket, failure *LinkError) error {
+	// The packet never made it to its outgoing link, so it no longer
+	// counts towards the limits of its incoming peer.
+	if s.cfg.PeerPolicies != nil {
+		s.cfg.PeerPolicies.ResolveHtlc(packet.inKey())
+	}
+
 	// Encrypt the failure so that the sender will be able to read the error
 	// message. Since we failed this packet, we use EncryptFirstHop to
 	// obfuscate the failure for their eyes only.
-	reason, err := packet.obfuscator.EncryptFirstHop(failure.WireMessage())
+	reason, attrData, err := packet.obfuscator.EncryptFirstHop(
+		failure.WireMessage(),
+	)
 	if err != nil {
 		err := fmt.Errorf("unable to obfuscate "+
 			"error: %v", err)
@@ -1259,7 +1344,8 @@ func (s *Switch) failAddPacket(packet *htlcPacket, failure *LinkError) error {
 		obfuscator:      packet.obfuscator,
 		linkFailure:     failure,
 		htlc: &lnwire.UpdateFailHTLC{
-			Reason: reason,
+			Reason:   reason,
+			AttrData: lnwire.NewAttrDataRecord(attrData),
 		},
 	}
 
@@ -3017,11 +3103,93 @@ func (s *Switch) handlePacketAdd(packet *htlcPacket,
 		return s.failAddPacket(packet, linkErr)
 	}
 
+	// Finally, check whether the resources of the destination link that
+	// are available to this HTLC are exhausted.
+	admitted := false
+	if s.cfg.ResourceManager != nil {
+		linkErr := s.admitHtlc(packet, htlc, incomingLink, destination)
+		if linkErr != nil {
+			return s.failAddPacket(packet, linkErr)
+		}
+
+		admitted = true
+	}
+
 	// Send the packet to the destination channel link which manages the
 	// channel.
 	packet.outgoingChanID = destination.ShortChanID()
 
-	return destination.handleSwitchPacket(packet)
+	err = destination.handleSwitchPacket(packet)
+	if err != nil && admitted {
+		s.cfg.ResourceManager.ReleaseHtlc(packet.inKey())
+	}
+	if err != nil && s.cfg.PeerPolicies != nil {
+		s.cfg.PeerPolicies.ResolveHtlc(packet.inKey())
+	}
+
+	return err
+}
+
+// admitHtlc asks the resource manager whether the HTLC may use the resources
+// of the destination link. If resource bucketing is enabled, HTLCs that aren't
+// admitted into the protected bucket are forwarded as unaccountable.
+func (s *Switch) admitHtlc(packet *htlcPacket, htlc *lnwire.UpdateAddHTLC,
+	incomingLink, destination ChannelLink) *LinkError {
+
+	accountableType := uint64(lnwire.ExperimentalAccountableType)
+	accountable := bytes.Equal(
+		packet.inWireCustomRecords[accountableType],
+		[]byte{lnwire.ExperimentalAccountable},
+	)
+
+	var cltvDelta uint32
+	height := atomic.LoadUint32(&s.bestHeight)
+	if packet.incomingTimeout > height {
+		cltvDelta = packet.incomingTimeout - height
+	}
+
+	var fee lnwire.MilliSatoshi
+	if packet.incomingAmount > packet.amount {
+		fee = packet.incomingAmount - packet.amount
+	}
+
+	maxHtlcs, maxInFlight := destination.OutgoingHtlcLimits()
+	bucket, err := s.cfg.ResourceManager.AddHtlc(&ProposedHtlc{
+		IncomingCircuit:     packet.inKey(),
+		IncomingPeer:        incomingLink.PeerPubKey(),
+		OutgoingChannel:     destination.ShortChanID(),
+		OutgoingMaxHtlcs:    maxHtlcs,
+		OutgoingMaxInFlight: maxInFlight,
+		Amount:              packet.amount,
+		Fee:                 fee,
+		CltvDelta:           cltvDelta,
+		Accountable:         accountable,
+	})
+	if err != nil {
+		log.Debugf("Rejecting HTLC(%x) from IncomingChanID(%v) to "+
+			"OutgoingChanID(%v): %v", htlc.PaymentHash[:],
+			packet.incomingChanID, destination.ShortChanID(), err)
+
+		return NewDetailedLinkError(
+			&lnwire.FailTemporaryChannelFailure{},
+			OutgoingFailureResourcesExhausted,
+		)
+	}
+
+	// Only HTLCs that occupy the protected resources of the outgoing
+	// channel are forwarded as accountable.
+	_, setAccountable := htlc.CustomRecords[accountableType]
+	if s.cfg.ResourceManager.cfg.Bucketing && bucket != ProtectedBucket &&
+		setAccountable {
+
+		records := htlc.CustomRecords.Copy()
+		records[accountableType] = []byte{
+			lnwire.ExperimentalUnaccountable,
+		}
+		htlc.CustomRecords = records
+	}
+
+	return nil
 }
 
 // handlePacketSettle handles forwarding a settle packet.
@@ -3072,6 +3240,14 @@ func (s *Switch) handlePacketSettle(packet *htlcPacket) error {
 		return nil
 	}
 
+	if s.cfg.ResourceManager != nil {
+		s.cfg.ResourceManager.ResolveHtlc(packet.inKey(), true)
+	}
+
+	if s.cfg.PeerPolicies != nil {
+		s.cfg.PeerPolicies.ResolveHtlc(packet.inKey())
+	}
+
 	// If this is an HTLC settle, and it wasn't from a locally initiated
 	// HTLC, then we'll log a forwarding event so we can flush it to disk
 	// later.
@@ -3082,30 +3258,36 @@ func (s *Switch) handlePacketSettle(packet *htlcPacket) error {
 			circuit.IncomingAmount-circuit.OutgoingAmount,
 			circuit.Incoming.ChanID, circuit.Outgoing.ChanID)
 
-		s.fwdEventMtx.Lock()
-		s.pendingFwdingEvents = append(
-			s.pendingFwdingEvents,
-			channeldb.ForwardingEvent{
-				Timestamp:      time.Now(),
-				IncomingChanID: circuit.Incoming.ChanID,
-				OutgoingChanID: circuit.Outgoing.ChanID,
-				AmtIn:          circuit.IncomingAmount,
-				AmtOut:         circuit.OutgoingAmount,
-				IncomingHtlcID: fn.Some(
-					circuit.Incoming.HtlcID,
-				),
-				OutgoingHtlcID: fn.Some(
-					circuit.Outgoing.HtlcID,
-				),
-			},
-		)
-		s.fwdEventMtx.Unlock()
+		s.addForwardingEvent(circuit, false)
 	}
 
 	// Deliver this packet.
 	return s.mailOrchestrator.Deliver(packet.incomingChanID, packet)
 }
 
+// addForwardingEvent queues a forwarding event for the given circuit, which
+// must have its keystone set, to be flushed to disk later.
+func (s *Switch) addForwardingEvent(circuit *PaymentCircuit, failed bool) {
+	s.fwdEventMtx.Lock()
+	defer s.fwdEventMtx.Unlock()
+
+	s.pendingFwdingEvents = append(
+		s.pendingFwdingEvents,
+		channeldb.ForwardingEvent{
+			Timestamp:      time.Now(),
+			IncomingChanID: circuit.Incoming.ChanID,
+			OutgoingChanID: circuit.Outgoing.ChanID,
+			AmtIn:          circuit.IncomingAmount,
+			AmtOut:         circuit.OutgoingAmount,
+			IncomingHtlcID: fn.Some(circuit.Incoming.HtlcID),
+			OutgoingHtlcID: fn.Some(circuit.Outgoing.HtlcID),
+			UpfrontFeeIn:   circuit.IncomingUpfrontFee,
+			UpfrontFeeOut:  circuit.OutgoingUpfrontFee,
+			Failed:         failed,
+		},
+	)
+}
+
 // handlePacketFail handles forwarding a fail packet.
 func (s *Switch) handlePacketFail(packet *htlcPacket,
 	htlc *lnwire.UpdateFailHTLC) error {
@@ -3134,6 +3316,29 @@ func (s *Switch) handlePacketFail(packet *htlcPacket,
 		return nil
 	}
 
+	if s.cfg.ResourceManager != nil {
+		s.cfg.ResourceManager.ResolveHtlc(packet.inKey(), false)
+	}
+
+	if s.cfg.PeerPolicies != nil {
+		s.cfg.PeerPolicies.ResolveHtlc(packet.inKey())
+	}
+
+	// A failed forward still earns us any upfront fee that was paid to
+	// us, so we log it as a failed forwarding event to keep track of that
+	// revenue.
+	hasUpfrontFees := circuit.IncomingUpfrontFee != 0 ||
+		circuit.OutgoingUpfrontFee != 0
+	if circuit.Outgoing != nil && hasUpfrontFees {
+		log.Debugf("Failed forward of HTLC(%x) earned upfront fee of "+
+			"%v from IncomingChanID(%v) to OutgoingChanID(%v)",
+			circuit.PaymentHash[:],
+			circuit.IncomingUpfrontFee-circuit.OutgoingUpfrontFee,
+			circuit.Incoming.ChanID, circuit.Outgoing.ChanID)
+
+		s.addForwardingEvent(circuit, true)
+	}
+
 	// Exit early if this hasSource is true. This flag is only set via
 	// mailbox's `FailAdd`. This method has two callsites,
 	// - the packet has timed out after `MailboxDeliveryTimeout`, defaults
@@ -3160,10 +3365,9 @@ func (s *Switch) handlePacketFail(packet *htlcPacket,
 	// If this is a resolution message, then we'll need to encrypt it as
 	// it's actually internally sourced.
 	case packet.isResolution:
-		var err error
 		// TODO(roasbeef): don't need to pass actually?
 		failure := &lnwire.FailPermanentChannelFailure{}
-		htlc.Reason, err = circuit.ErrorEncrypter.EncryptFirstHop(
+		reason, attrData, err := circuit.ErrorEncrypter.EncryptFirstHop(
 			failure,
 		)
 		if err != nil {
@@ -3171,6 +3375,9 @@ func (s *Switch) handlePacketFail(packet *htlcPacket,
 			log.Error(err)
 		}
 
+		htlc.Reason = reason
+		htlc.AttrData = lnwire.NewAttrDataRecord(attrData)
+
 	// Alternatively, if the remote party sends us an
 	// UpdateFailMalformedHTLC, then we'll need to convert this into a
 	// proper well formatted onion error as there's no HMAC currently.
@@ -3181,16 +3388,21 @@ func (s *Switch) handlePacketFail(packet *htlcPacket,
 			packet.incomingChanID, packet.incomingHTLCID,
 			packet.outgoingChanID, packet.outgoingHTLCID)
 
-		htlc.Reason = circuit.ErrorEncrypter.EncryptMalformedError(
+		reason, attrData := circuit.ErrorEncrypter.EncryptMalformedError(
 			htlc.Reason,
 		)
+		htlc.Reason = reason
+		htlc.AttrData = lnwire.NewAttrDataRecord(attrData)
 
 	default:
 		// Otherwise, it's a forwarded error, so we'll perform a
-		// wrapper encryption as normal.
-		htlc.Reason = circuit.ErrorEncrypter.IntermediateEncrypt(
-			htlc.Reason,
+		// wrapper encryption as normal, adding our hold time to the
+		// attribution data of the downstream nodes.
+		reason, attrData := circuit.ErrorEncrypter.IntermediateEncrypt(
+			htlc.Reason, htlc.AttrData.ValOpt().UnwrapOr(nil),
 		)
+		htlc.Reason = reason
+		htlc.AttrData = lnwire.NewAttrDataRecord(attrData)
 	}
 
 	// Deliver this packet.
@@ -981,6 +981,119 @@ func TestSwitchForward(t *testing.T) {
 	}
 }
 
+// TestSwitchForwardPeerPolicy checks that adds which exceed the policy of
+// their incoming peer are failed back with a link error, and that resolved
+// HTLCs no longer count towards the limits of the peer.
+func TestSwitchForwardPeerPolicy(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(
+		t, "alice", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+	bobPeer, err := newMockServer(
+		t, "bob", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+
+	s, err := initSwitchWithTempDB(t, testStartingHeight)
+	require.NoError(t, err)
+
+	policies := NewPeerPolicyManager(PeerPolicy{MaxHtlcs: 1})
+	s.cfg.PeerPolicies = policies
+
+	require.NoError(t, s.Start())
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, emptyScid, alicePeer, true, false,
+		false, false,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, emptyScid, bobPeer, true, false, false,
+		false,
+	)
+	require.NoError(t, s.AddLink(aliceChannelLink))
+	require.NoError(t, s.AddLink(bobChannelLink))
+
+	preimage, err := genPreimage()
+	require.NoError(t, err)
+	rhash := sha256.Sum256(preimage[:])
+
+	newAddPacket := func(htlcID uint64) *htlcPacket {
+		return &htlcPacket{
+			incomingChanID: aliceChannelLink.ShortChanID(),
+			incomingHTLCID: htlcID,
+			outgoingChanID: bobChannelLink.ShortChanID(),
+			incomingAmount: 1000,
+			obfuscator:     NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: rhash,
+				Amount:      1000,
+			},
+		}
+	}
+
+	// The first add is within the policy of alice and reaches bob.
+	packet := newAddPacket(0)
+	require.NoError(t, s.ForwardPackets(nil, packet))
+
+	select {
+	case <-bobChannelLink.packets:
+		require.NoError(t, bobChannelLink.completeCircuit(packet))
+
+	case <-time.After(time.Second):
+		t.Fatal("request was not propagated to destination")
+	}
+
+	// The second add would exceed the number of HTLCs alice may have in
+	// flight, so it is failed back to her.
+	require.NoError(t, s.ForwardPackets(nil, newAddPacket(1)))
+
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		require.NotNil(t, pkt.linkFailure)
+		require.Equal(
+			t, OutgoingFailurePeerMaxHtlcs,
+			pkt.linkFailure.FailureDetail,
+		)
+		require.NoError(t, aliceChannelLink.deleteCircuit(pkt))
+
+	case <-time.After(time.Second):
+		t.Fatal("fail was not propagated to source")
+	}
+
+	numHtlcs, _ := policies.InFlight(alicePeer.PubKey())
+	require.EqualValues(t, 1, numHtlcs)
+
+	// Once the first HTLC is settled, it no longer counts towards the
+	// limits of alice.
+	settle := &htlcPacket{
+		outgoingChanID: bobChannelLink.ShortChanID(),
+		outgoingHTLCID: 0,
+		amount:         1000,
+		htlc: &lnwire.UpdateFulfillHTLC{
+			PaymentPreimage: preimage,
+		},
+	}
+	require.NoError(t, s.ForwardPackets(nil, settle))
+
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		require.NoError(t, aliceChannelLink.deleteCircuit(pkt))
+
+	case <-time.After(time.Second):
+		t.Fatal("settle was not propagated to source")
+	}
+
+	numHtlcs, _ = policies.InFlight(alicePeer.PubKey())
+	require.Zero(t, numHtlcs)
+}
+
 func TestSwitchForwardFailAfterFullAdd(t *testing.T) {
 	t.Parallel()
 
@@ -2738,7 +2851,7 @@ func TestSwitchSendPayment(t *testing.T) {
 	// back. This request should be forwarded back to alice channel link.
 	obfuscator := NewMockObfuscator()
 	failure := lnwire.NewFailIncorrectDetails(update.Amount, 100)
-	reason, err := obfuscator.EncryptFirstHop(failure)
+	reason, _, err := obfuscator.EncryptFirstHop(failure)
 	require.NoError(t, err, "unable obfuscate failure")
 
 	if s.IsForwardedHTLC(aliceChannelLink.ShortChanID(), update.ID) {
@@ -4063,7 +4176,7 @@ func TestSwitchHoldForward(t *testing.T) {
 		OnionSHA256: shaOnionBlob,
 	}
 
-	fwdErr, err := newMockDeobfuscator().DecryptError(failPacket.Reason)
+	fwdErr, err := newMockDeobfuscator().DecryptError(failPacket.Reason, nil)
 	require.NoError(t, err)
 	require.Equal(t, expectedFailure, fwdErr.WireMessage())
 
@@ -5525,7 +5638,7 @@ func testSwitchAliasInterceptFail(t *testing.T, zeroConf bool) {
 		require.True(t, ok)
 
 		fwdErr, err := newMockDeobfuscator().DecryptError(
-			failHtlc.Reason,
+			failHtlc.Reason, nil,
 		)
 		require.NoError(t, err)
 
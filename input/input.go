@@ -81,6 +81,11 @@ type TxInfo struct {
 
 	// Weight is the weight of the tx.
 	Weight lntypes.WeightUnit
+
+	// Tx is the parent tx itself. It is only set if the parent can't be
+	// relayed on its own, such as a zero-fee v3 commitment, in which case
+	// it must be published along with its child as a package.
+	Tx *wire.MsgTx
 }
 
 // String returns a human readable version of the tx info.
@@ -97,6 +97,18 @@ const (
 	//      - pkscript (p2tr): 34 bytes
 	P2TROutputSize = BaseOutputSize + P2TRSize
 
+	// P2ASize 4 bytes
+	//	- OP_1: 1 byte
+	//	- OP_DATA: 1 byte (witness program length)
+	//	- witness program: 2 bytes
+	P2ASize = 4
+
+	// P2AOutputSize 13 bytes
+	//      - value: 8 bytes
+	//      - var_int: 1 byte (pkscript_length)
+	//      - pkscript (p2a): 4 bytes
+	P2AOutputSize = BaseOutputSize + P2ASize
+
 	// P2PKHScriptSigSize 108 bytes
 	//      - OP_DATA: 1 byte (signature length)
 	//      - signature
@@ -256,12 +268,36 @@ const (
 		2*TaprootCommitmentOutput + 2*TaprootCommitmentAnchorOutput +
 		4) * witnessScaleFactor
 
+	// BaseZeroFeeCommitmentTxSize 152 + 43 * num-htlc-outputs bytes
+	//	- Version: 4 bytes
+	//	- WitnessHeader <---- part of the witness data
+	//	- CountTxIn: 1 byte
+	//	- TxIn: 41 bytes
+	//		FundingInput
+	//	- CountTxOut: 3 byte
+	//	- TxOut: 2*43 + 13 + 43 * num-htlc-outputs bytes
+	//		OutputPayingToThem,
+	//		OutputPayingToUs,
+	//		SharedAnchor,
+	//		....HTLCOutputs...
+	//	- LockTime: 4 bytes
+	BaseZeroFeeCommitmentTxSize = 4 + 1 + FundingInputSize + 3 +
+		2*CommitmentDelayOutput + P2AOutputSize + 4
+
+	// BaseZeroFeeCommitmentTxWeight 608 weight.
+	BaseZeroFeeCommitmentTxWeight = witnessScaleFactor *
+		BaseZeroFeeCommitmentTxSize
+
 	// CommitWeight 724 weight.
 	CommitWeight = BaseCommitmentTxWeight + WitnessCommitmentTxWeight
 
 	// AnchorCommitWeight 1124 weight.
 	AnchorCommitWeight = BaseAnchorCommitmentTxWeight + WitnessCommitmentTxWeight
 
+	// ZeroFeeCommitWeight 832 weight.
+	ZeroFeeCommitWeight = BaseZeroFeeCommitmentTxWeight +
+		WitnessCommitmentTxWeight
+
 	// TaprootCommitWeight 968 weight.
 	TaprootCommitWeight = (BaseTaprootCommitmentTxWeight +
 		WitnessHeaderSize + TaprootKeyPathWitnessSize)
@@ -327,6 +363,16 @@ const (
 	// weight limits.
 	MaxHTLCNumber = 966
 
+	// TrucMaxWeight 40000 weight
+	// TrucMaxWeight is the maximum weight of a v3 (TRUC) transaction that
+	// is accepted by the mempool policy, which is 10,000 vbytes.
+	TrucMaxWeight = 10_000 * witnessScaleFactor
+
+	// TrucChildMaxWeight 4000 weight
+	// TrucChildMaxWeight is the maximum weight of a v3 (TRUC) transaction
+	// that spends an unconfirmed v3 parent, which is 1,000 vbytes.
+	TrucChildMaxWeight = 1_000 * witnessScaleFactor
+
 	// ToLocalScriptSize 79 bytes
 	//      - OP_IF: 1 byte
 	//          - OP_DATA: 1 byte
@@ -592,6 +638,12 @@ const (
 	//      - witness_script (anchor_script)
 	AnchorWitnessSize = 1 + 1 + 73 + 1 + AnchorScriptSize
 
+	// P2AWitnessSize 1 byte
+	//      - number_of_witness_elements: 1 byte
+	//
+	// A pay-to-anchor output is spent with an empty witness.
+	P2AWitnessSize = 1
+
 	// TaprootSignatureWitnessSize 65 bytes
 	//	- sigLength: 1 byte
 	//	- sig: 64 bytes
@@ -302,6 +302,11 @@ const (
 	// taproot commitment transaction (using production
 	// scripts).
 	TaprootCommitmentRevokeFinal StandardWitnessType = 41
+
+	// P2AAnchorSpend is a witness that allows anyone to spend the shared
+	// pay-to-anchor output of a zero-fee commitment transaction. The
+	// output is spent with an empty witness.
+	P2AAnchorSpend StandardWitnessType = 42
 )
 
 // String returns a human readable version of the target WitnessType.
@@ -435,6 +440,9 @@ func (wt StandardWitnessType) String() string {
 	case TaprootCommitmentRevokeFinal:
 		return "TaprootCommitmentRevokeFinal"
 
+	case P2AAnchorSpend:
+		return "P2AAnchorSpend"
+
 	default:
 		return fmt.Sprintf("Unknown WitnessType: %v", uint32(wt))
 	}
@@ -490,6 +498,11 @@ func (wt StandardWitnessType) WitnessGenerator(signer Signer,
 				Witness: witness,
 			}, nil
 
+		case P2AAnchorSpend:
+			return &Script{
+				Witness: wire.TxWitness{},
+			}, nil
+
 		case CommitmentNoDelay:
 			witness, err := CommitSpendNoDelay(signer, desc, tx, false)
 			if err != nil {
@@ -860,6 +873,10 @@ func (wt StandardWitnessType) SizeUpperBound() (lntypes.WeightUnit,
 	case CommitmentAnchor:
 		return AnchorWitnessSize, false, nil
 
+	// Shared pay-to-anchor output on a zero-fee commitment transaction.
+	case P2AAnchorSpend:
+		return P2AWitnessSize, false, nil
+
 	// Outgoing second layer HTLC's that have confirmed within the
 	// chain, and the output they produced is now mature enough to
 	// sweep.
@@ -57,6 +57,11 @@ var (
 	// that already has HTLCs.
 	ErrInvoiceHasHtlcs = errors.New("cannot add invoice with htlcs")
 
+	// ErrHoldPolicyNotHodl is returned when attempting to insert an invoice
+	// with a hold policy that isn't a hodl invoice.
+	ErrHoldPolicyNotHodl = errors.New("hold policy is only supported " +
+		"for hodl invoices")
+
 	// ErrEmptyHTLCSet is returned when attempting to accept or settle and
 	// HTLC set that has no HTLCs.
 	ErrEmptyHTLCSet = errors.New("cannot settle/accept empty HTLC set")
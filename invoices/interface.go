@@ -209,6 +209,9 @@ type InvoiceUpdater interface {
 	UpdateAmpState(setID [32]byte, newState InvoiceStateAMP,
 		circuitKey models.CircuitKey) error
 
+	// UpdatePaymentRequest replaces the payment request of the invoice.
+	UpdatePaymentRequest(paymentRequest []byte) error
+
 	// Finalize finalizes the update before it is written to the database.
 	Finalize(updateType UpdateType) error
 }
@@ -28,6 +28,11 @@ type invoiceExpiryTs struct {
 	PaymentHash lntypes.Hash
 	Expiry      time.Time
 	Keysend     bool
+
+	// MaxHoldExpiry indicates that the expiry is the end of the maximum
+	// hold duration of an accepted hodl invoice, which means that the
+	// invoice needs to be force-canceled.
+	MaxHoldExpiry bool
 }
 
 // Less implements PriorityQueueItem.Less such that the top item in the
@@ -44,18 +49,47 @@ var _ invoiceExpiry = (*invoiceExpiryHeight)(nil)
 type invoiceExpiryHeight struct {
 	paymentHash  lntypes.Hash
 	expiryHeight uint32
+
+	// minCltvSlack is the number of blocks before the expiry height at
+	// which the invoice is canceled. It is raised to the expiry delta of
+	// the watcher when the entry is added to the watcher.
+	minCltvSlack uint32
+}
+
+// cancelHeight returns the height at which the invoice is canceled.
+func (b invoiceExpiryHeight) cancelHeight() uint32 {
+	if b.minCltvSlack >= b.expiryHeight {
+		return 0
+	}
+
+	return b.expiryHeight - b.minCltvSlack
 }
 
 // Less implements PriorityQueueItem.Less such that the top item in the
-// priority queue is the lowest block height.
+// priority queue is the lowest cancel height.
 func (b invoiceExpiryHeight) Less(other queue.PriorityQueueItem) bool {
-	return b.expiryHeight < other.(*invoiceExpiryHeight).expiryHeight
+	return b.cancelHeight() < other.(*invoiceExpiryHeight).cancelHeight()
 }
 
 // expired returns a boolean that indicates whether this entry has expired,
 // taking our expiry delta into account.
 func (b invoiceExpiryHeight) expired(currentHeight, delta uint32) bool {
-	return currentHeight+delta >= b.expiryHeight
+	return currentHeight+max(delta, b.minCltvSlack) >= b.expiryHeight
+}
+
+// Compile time assertion that invoiceAutoSettle implements invoiceExpiry.
+var _ invoiceExpiry = (*invoiceAutoSettle)(nil)
+
+// invoiceAutoSettle marks an accepted hodl invoice that is settled as soon as
+// its preimage becomes known. It isn't ordered in any of the expiry queues.
+type invoiceAutoSettle struct {
+	paymentHash lntypes.Hash
+}
+
+// Less implements PriorityQueueItem.Less. Auto-settle entries aren't queued,
+// so their order is irrelevant.
+func (a invoiceAutoSettle) Less(queue.PriorityQueueItem) bool {
+	return false
 }
 
 // InvoiceExpiryWatcher handles automatic invoice cancellation of expired
@@ -91,6 +125,12 @@ type InvoiceExpiryWatcher struct {
 	// cancelInvoice is a template method that cancels an expired invoice.
 	cancelInvoice func(lntypes.Hash, bool) error
 
+	// settleInvoice is a template method that attempts to settle an
+	// accepted hodl invoice with a known preimage. It returns true if the
+	// invoice no longer needs to be watched because it was settled or is
+	// no longer accepted.
+	settleInvoice func(lntypes.Hash) (bool, error)
+
 	// timestampExpiryQueue holds invoiceExpiry items and is used to find
 	// the next invoice to expire.
 	timestampExpiryQueue queue.PriorityQueue
@@ -104,6 +144,17 @@ type InvoiceExpiryWatcher struct {
 	// active htlcs.
 	blockExpiryQueue queue.PriorityQueue
 
+	// autoSettle holds the hodl invoices that are settled as soon as their
+	// preimage becomes known. We attempt to settle them when they are
+	// added and whenever their preimage is learned. The value is true if
+	// the last attempt failed, in which case it is retried with the next
+	// block.
+	autoSettle map[lntypes.Hash]bool
+
+	// preimages receives all newly learned preimages, which triggers the
+	// settlement of the matching auto-settle invoice.
+	preimages <-chan lntypes.Preimage
+
 	// newInvoices channel is used to wake up the main loop when a new
 	// invoices is added.
 	newInvoices chan []invoiceExpiry
@@ -125,6 +176,7 @@ func NewInvoiceExpiryWatcher(clock clock.Clock,
 		blockExpiryDelta: expiryDelta,
 		currentHeight:    startHeight,
 		currentHash:      startHash,
+		autoSettle:       make(map[lntypes.Hash]bool),
 		newInvoices:      make(chan []invoiceExpiry),
 		quit:             make(chan struct{}),
 	}
@@ -133,9 +185,13 @@ func NewInvoiceExpiryWatcher(clock clock.Clock,
 // Start starts the subscription handler and the main loop. Start() will
 // return with error if InvoiceExpiryWatcher is already started. Start()
 // expects a cancellation function passed that will be use to cancel expired
-// invoices by their payment hash.
+// invoices by their payment hash, a settle function that is used to settle
+// hodl invoices that are settled automatically, and a channel that receives
+// newly learned preimages which may allow such invoices to be settled.
 func (ew *InvoiceExpiryWatcher) Start(
-	cancelInvoice func(lntypes.Hash, bool) error) error {
+	cancelInvoice func(lntypes.Hash, bool) error,
+	settleInvoice func(lntypes.Hash) (bool, error),
+	preimages <-chan lntypes.Preimage) error {
 
 	ew.Lock()
 	defer ew.Unlock()
@@ -146,6 +202,8 @@ func (ew *InvoiceExpiryWatcher) Start(
 
 	ew.started = true
 	ew.cancelInvoice = cancelInvoice
+	ew.settleInvoice = settleInvoice
+	ew.preimages = preimages
 
 	ntfn, err := ew.notifier.RegisterBlockEpochNtfn(&chainntnfs.BlockEpoch{
 		Height: int32(ew.currentHeight),
@@ -210,7 +268,12 @@ func makeInvoiceExpiry(paymentHash lntypes.Hash,
 			}
 		}
 
-		return makeHeightExpiry(paymentHash, minHeight)
+		expiry := makeHeightExpiry(paymentHash, minHeight)
+		if expiry != nil {
+			expiry.minCltvSlack = invoice.HoldPolicy.MinCltvSlack
+		}
+
+		return expiry
 
 	default:
 		log.Debugf("Invoice not added to expiry watcher: %v",
@@ -220,6 +283,65 @@ func makeInvoiceExpiry(paymentHash lntypes.Hash,
 	}
 }
 
+// makeInvoiceExpiries returns the expiry entry of the invoice along with the
+// entries that enforce the hold policy of accepted hodl invoices.
+func makeInvoiceExpiries(paymentHash lntypes.Hash,
+	invoice *Invoice) []invoiceExpiry {
+
+	var expiries []invoiceExpiry
+	if expiry := makeInvoiceExpiry(paymentHash, invoice); expiry != nil {
+		expiries = append(expiries, expiry)
+	}
+
+	return append(expiries, makeHoldPolicyExpiries(paymentHash, invoice)...)
+}
+
+// makeHoldPolicyExpiries creates the entries that enforce the maximum hold
+// duration and the automatic settlement of an accepted hodl invoice.
+func makeHoldPolicyExpiries(paymentHash lntypes.Hash,
+	invoice *Invoice) []invoiceExpiry {
+
+	if invoice.State != ContractAccepted || !invoice.HodlInvoice {
+		return nil
+	}
+
+	var expiries []invoiceExpiry
+	if invoice.HoldPolicy.MaxHoldDuration > 0 {
+		// The hold duration starts once the first htlc was accepted.
+		var acceptTime time.Time
+		for _, htlc := range invoice.Htlcs {
+			if htlc.State != HtlcStateAccepted {
+				continue
+			}
+
+			if acceptTime.IsZero() || htlc.AcceptTime.Before(
+				acceptTime,
+			) {
+
+				acceptTime = htlc.AcceptTime
+			}
+		}
+
+		if !acceptTime.IsZero() {
+			expiries = append(expiries, &invoiceExpiryTs{
+				PaymentHash: paymentHash,
+				Expiry: acceptTime.Add(
+					invoice.HoldPolicy.MaxHoldDuration,
+				),
+				MaxHoldExpiry: true,
+			})
+		}
+	}
+
+	if invoice.HoldPolicy.AutoSettle {
+		expiries = append(expiries, &invoiceAutoSettle{
+			paymentHash: paymentHash,
+		})
+	}
+
+	return expiries
+}
+
 // makeTimestampExpiry creates a timestamp-based expiry entry.
 func makeTimestampExpiry(paymentHash lntypes.Hash,
 	invoice *Invoice) *invoiceExpiryTs {
@@ -317,8 +439,10 @@ func (ew *InvoiceExpiryWatcher) cancelNextExpiredInvoice() {
 		// to the Accepted state directly after being opened, the expiry
 		// field would never be used. Enabling cancellation for accepted
 		// keysend invoices creates a safety mechanism that can prevents
-		// channel force-closes.
-		ew.expireInvoice(top.PaymentHash, top.Keysend)
+		// channel force-closes. Accepted hodl invoices are also
+		// force-canceled once their maximum hold duration has passed.
+		force := top.Keysend || top.MaxHoldExpiry
+		ew.expireInvoice(top.PaymentHash, force)
 		ew.timestampExpiryQueue.Pop()
 	}
 }
@@ -345,6 +469,10 @@ func (ew *InvoiceExpiryWatcher) cancelNextHeightExpiredInvoice() {
 // expireInvoice attempts to expire an invoice and logs an error if we get an
 // unexpected error.
 func (ew *InvoiceExpiryWatcher) expireInvoice(hash lntypes.Hash, force bool) {
+	// There's no need to settle an invoice automatically once it was
+	// canceled.
+	delete(ew.autoSettle, hash)
+
 	err := ew.cancelInvoice(hash, force)
 	switch {
 	case err == nil:
@@ -363,6 +491,40 @@ func (ew *InvoiceExpiryWatcher) expireInvoice(hash lntypes.Hash, force bool) {
 	}
 }
 
+// settleAutoSettleInvoice attempts to settle the auto-settle invoice with the
+// given hash and stops watching it once it was resolved. If the attempt fails,
+// it is retried with the next block.
+func (ew *InvoiceExpiryWatcher) settleAutoSettleInvoice(hash lntypes.Hash) {
+	if ew.settleInvoice == nil {
+		delete(ew.autoSettle, hash)
+		return
+	}
+
+	resolved, err := ew.settleInvoice(hash)
+	switch {
+	case err != nil:
+		log.Errorf("Unable to auto-settle invoice %v: %v", hash, err)
+
+		ew.autoSettle[hash] = true
+
+	case resolved:
+		delete(ew.autoSettle, hash)
+
+	default:
+		ew.autoSettle[hash] = false
+	}
+}
+
+// retryAutoSettleInvoices retries to settle the auto-settle invoices whose
+// last attempt failed.
+func (ew *InvoiceExpiryWatcher) retryAutoSettleInvoices() {
+	for hash, retry := range ew.autoSettle {
+		if retry {
+			ew.settleAutoSettleInvoice(hash)
+		}
+	}
+}
+
 // pushInvoices adds invoices to be expired to their relevant queue.
 func (ew *InvoiceExpiryWatcher) pushInvoices(invoices []invoiceExpiry) {
 	for _, inv := range invoices {
@@ -377,9 +539,20 @@ func (ew *InvoiceExpiryWatcher) pushInvoices(invoices []invoiceExpiry) {
 
 		case *invoiceExpiryHeight:
 			if expiry != nil {
+				expiry.minCltvSlack = max(
+					expiry.minCltvSlack,
+					ew.blockExpiryDelta,
+				)
 				ew.blockExpiryQueue.Push(expiry)
 			}
 
+		// The preimage of a newly added auto-settle invoice may
+		// already be known, so we attempt to settle it right away.
+		case *invoiceAutoSettle:
+			if expiry != nil {
+				ew.settleAutoSettleInvoice(expiry.paymentHash)
+			}
+
 		default:
 			log.Errorf("unexpected queue item: %T", inv)
 		}
@@ -435,6 +608,23 @@ func (ew *InvoiceExpiryWatcher) mainLoop(blockNtfns *chainntnfs.BlockEpochEvent)
 				ew.currentHeight = uint32(block.Height)
 				ew.currentHash = block.Hash
 
+				ew.retryAutoSettleInvoices()
+
+			// Settle the matching auto-settle invoice as soon as
+			// we learn its preimage.
+			case preimage, ok := <-ew.preimages:
+				if !ok {
+					log.Debugf("preimage updates canceled")
+					ew.preimages = nil
+
+					continue
+				}
+
+				hash := preimage.Hash()
+				if _, ok := ew.autoSettle[hash]; ok {
+					ew.settleAutoSettleInvoice(hash)
+				}
+
 			case <-ew.quit:
 				return
 			}
@@ -46,7 +46,7 @@ func newInvoiceExpiryWatcherTest(t *testing.T, now time.Time,
 		)
 		test.wg.Done()
 		return nil
-	})
+	}, nil, nil)
 
 	require.NoError(t, err, "cannot start InvoiceExpiryWatcher")
 
@@ -96,17 +96,17 @@ func TestInvoiceExpiryWatcherStartStop(t *testing.T) {
 		return nil
 	}
 
-	if err := watcher.Start(cancel); err != nil {
+	if err := watcher.Start(cancel, nil, nil); err != nil {
 		t.Fatalf("unexpected error upon start: %v", err)
 	}
 
-	if err := watcher.Start(cancel); err == nil {
+	if err := watcher.Start(cancel, nil, nil); err == nil {
 		t.Fatalf("expected error upon second start")
 	}
 
 	watcher.Stop()
 
-	if err := watcher.Start(cancel); err != nil {
+	if err := watcher.Start(cancel, nil, nil); err != nil {
 		t.Fatalf("unexpected error upon start: %v", err)
 	}
 }
@@ -290,3 +290,89 @@ func TestExpiryHeightArrives(t *testing.T) {
 	test.announceBlock(t, htlc2-delta)
 	test.assertCanceled(t, test.hash)
 }
+
+// TestHoldPolicyMaxHoldDuration tests that an accepted hodl invoice is
+// canceled once the maximum hold duration of its policy has passed.
+func TestHoldPolicyMaxHoldDuration(t *testing.T) {
+	t.Parallel()
+
+	htlc := &InvoiceHTLC{
+		State:      HtlcStateAccepted,
+		AcceptTime: testTime,
+		Expiry:     uint32(testCurrentHeight + 100),
+	}
+	test := setupHodlPolicyExpiry(
+		t, testTime, time.Hour, 0, ContractAccepted,
+		[]*InvoiceHTLC{htlc}, HoldPolicy{
+			MaxHoldDuration: 2 * time.Hour,
+		},
+	)
+	defer test.watcher.Stop()
+
+	// The expiry of the invoice itself doesn't affect accepted invoices.
+	test.mockClock.SetTime(testTime.Add(time.Hour))
+	test.assertNotResolved(t)
+
+	test.mockClock.SetTime(testTime.Add(2*time.Hour + time.Second))
+	test.assertCanceled(t, test.hash)
+}
+
+// TestHoldPolicyMinCltvSlack tests that an accepted hodl invoice is canceled
+// once fewer blocks than the minimum cltv slack of its policy are left until
+// the expiry of its htlcs.
+func TestHoldPolicyMinCltvSlack(t *testing.T) {
+	t.Parallel()
+
+	const slack = 10
+	expiry := uint32(testCurrentHeight + 20)
+	htlc := &InvoiceHTLC{
+		State:      HtlcStateAccepted,
+		AcceptTime: testTime,
+		Expiry:     expiry,
+	}
+	test := setupHodlPolicyExpiry(
+		t, testTime, time.Hour, 1, ContractAccepted,
+		[]*InvoiceHTLC{htlc}, HoldPolicy{
+			MinCltvSlack: slack,
+		},
+	)
+	defer test.watcher.Stop()
+
+	test.announceBlock(t, expiry-slack-1)
+	test.assertNotResolved(t)
+
+	test.announceBlock(t, expiry-slack)
+	test.assertCanceled(t, test.hash)
+}
+
+// TestHoldPolicyAutoSettle tests that an accepted hodl invoice is settled as
+// soon as its preimage becomes known.
+func TestHoldPolicyAutoSettle(t *testing.T) {
+	t.Parallel()
+
+	htlc := &InvoiceHTLC{
+		State:      HtlcStateAccepted,
+		AcceptTime: testTime,
+		Expiry:     uint32(testCurrentHeight + 100),
+	}
+	test := setupHodlPolicyExpiry(
+		t, testTime, time.Hour, 0, ContractAccepted,
+		[]*InvoiceHTLC{htlc}, HoldPolicy{
+			AutoSettle: true,
+		},
+	)
+	defer test.watcher.Stop()
+
+	// The preimage isn't known yet, so nothing happens.
+	test.announceBlock(t, uint32(testCurrentHeight+1))
+	test.assertNotResolved(t)
+
+	// Learning an unrelated preimage doesn't settle the invoice.
+	test.learnPreimage(t, lntypes.Preimage{2})
+	test.assertNotResolved(t)
+
+	// The invoice is settled right away once its preimage is learned,
+	// without waiting for the next block.
+	test.learnPreimage(t, lntypes.Preimage{1})
+	test.assertSettled(t, test.hash)
+}
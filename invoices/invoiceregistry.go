@@ -79,6 +79,20 @@ type RegistryConfig struct {
 	// HtlcInterceptor is an interface that allows the invoice registry to
 	// let clients intercept invoices before they are settled.
 	HtlcInterceptor HtlcInterceptor
+
+	// LookupPreimage is used to look up the preimages of accepted hodl
+	// invoices whose hold policy requires them to be settled as soon as
+	// their preimage becomes known. If it is nil, such invoices aren't
+	// settled automatically.
+	LookupPreimage func(lntypes.Hash) (lntypes.Preimage, bool)
+
+	// SubscribePreimages returns a channel that receives every preimage
+	// that becomes known to the node, along with a function to cancel the
+	// subscription. It allows auto-settle hodl invoices to be settled as
+	// soon as their preimage is learned. If it is nil, such invoices are
+	// only settled if their preimage is already known when they are
+	// accepted.
+	SubscribePreimages func() (<-chan lntypes.Preimage, func())
 }
 
 // htlcReleaseEvent describes an htlc auto-release event. It is used to release
@@ -154,6 +168,10 @@ type InvoiceRegistry struct {
 
 	expiryWatcher *InvoiceExpiryWatcher
 
+	// cancelPreimages cancels the subscription to newly learned
+	// preimages, if any.
+	cancelPreimages func()
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -196,10 +214,9 @@ func (i *InvoiceRegistry) scanInvoicesOnStart(ctx context.Context) error {
 
 	var pending []invoiceExpiry
 	for paymentHash, invoice := range pendingInvoices {
-		expiryRef := makeInvoiceExpiry(paymentHash, &invoice)
-		if expiryRef != nil {
-			pending = append(pending, expiryRef)
-		}
+		pending = append(
+			pending, makeInvoiceExpiries(paymentHash, &invoice)...,
+		)
 	}
 
 	log.Debugf("Adding %d pending invoices to the expiry watcher",
@@ -227,6 +244,13 @@ func (i *InvoiceRegistry) Start() error {
 	if i.started.Swap(true) {
 		return fmt.Errorf("InvoiceRegistry started more than once")
 	}
+	// Subscribe to newly learned preimages, so that auto-settle invoices
+	// can be settled as soon as their preimage is known.
+	var preimages <-chan lntypes.Preimage
+	if i.cfg.SubscribePreimages != nil {
+		preimages, i.cancelPreimages = i.cfg.SubscribePreimages()
+	}
+
 	// Start InvoiceExpiryWatcher and prepopulate it with existing
 	// active invoices.
 	err = i.expiryWatcher.Start(
@@ -234,7 +258,8 @@ func (i *InvoiceRegistry) Start() error {
 			return i.cancelInvoiceImpl(
 				context.Background(), hash, force,
 			)
-		})
+		}, i.autoSettleInvoice, preimages,
+	)
 	if err != nil {
 		return err
 	}
@@ -273,6 +298,10 @@ func (i *InvoiceRegistry) Stop() error {
 		i.expiryWatcher.Stop()
 	}
 
+	if i.cancelPreimages != nil {
+		i.cancelPreimages()
+	}
+
 	close(i.quit)
 
 	i.wg.Wait()
@@ -604,10 +633,9 @@ func (i *InvoiceRegistry) AddInvoice(ctx context.Context, invoice *Invoice,
 	// InvoiceExpiryWatcher.AddInvoice must not be locked by InvoiceRegistry
 	// to avoid deadlock when a new invoice is added while an other is being
 	// canceled.
-	invoiceExpiryRef := makeInvoiceExpiry(paymentHash, invoice)
-	if invoiceExpiryRef != nil {
-		i.expiryWatcher.AddInvoices(invoiceExpiryRef)
-	}
+	i.expiryWatcher.AddInvoices(
+		makeInvoiceExpiries(paymentHash, invoice)...,
+	)
 
 	return addIndex, nil
 }
@@ -967,7 +995,7 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 
 	// Execute locked notify exit hop logic.
 	i.Lock()
-	resolution, invoiceToExpire, err := i.notifyExitHopHtlcLocked(
+	resolution, invoicesToExpire, err := i.notifyExitHopHtlcLocked(
 		&ctx, hodlChan,
 	)
 	i.Unlock()
@@ -975,9 +1003,7 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 		return nil, err
 	}
 
-	if invoiceToExpire != nil {
-		i.expiryWatcher.AddInvoices(invoiceToExpire)
-	}
+	i.expiryWatcher.AddInvoices(invoicesToExpire...)
 
 	switch r := resolution.(type) {
 	// The htlc is held. Start a timer outside the lock if the htlc should
@@ -1016,11 +1042,11 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 }
 
 // notifyExitHopHtlcLocked is the internal implementation of NotifyExitHopHtlc
-// that should be executed inside the registry lock. The returned invoiceExpiry
-// (if not nil) needs to be added to the expiry watcher outside of the lock.
+// that should be executed inside the registry lock. The returned expiries
+// need to be added to the expiry watcher outside of the lock.
 func (i *InvoiceRegistry) notifyExitHopHtlcLocked(
 	ctx *invoiceUpdateCtx, hodlChan chan<- interface{}) (
-	HtlcResolution, invoiceExpiry, error) {
+	HtlcResolution, []invoiceExpiry, error) {
 
 	invoiceRef := ctx.invoiceRef()
 
@@ -1204,7 +1230,7 @@ func (i *InvoiceRegistry) notifyExitHopHtlcLocked(
 		return nil, nil, err
 	}
 
-	var invoiceToExpire invoiceExpiry
+	var invoicesToExpire []invoiceExpiry
 
 	log.Tracef("Settlement resolution: %T %v", resolution, resolution)
 
@@ -1330,7 +1356,9 @@ func (i *InvoiceRegistry) notifyExitHopHtlcLocked(
 		// possible that we MppTimeout the htlcs, and then our relevant
 		// expiry height could change.
 		if res.outcome == resultAccepted {
-			invoiceToExpire = makeInvoiceExpiry(ctx.hash, invoice)
+			invoicesToExpire = makeInvoiceExpiries(
+				ctx.hash, invoice,
+			)
 		}
 
 		// Subscribe to the resolution if the caller specified a
@@ -1357,7 +1385,54 @@ func (i *InvoiceRegistry) notifyExitHopHtlcLocked(
 		i.notifyClients(ctx.hash, invoice, setID)
 	}
 
-	return resolution, invoiceToExpire, nil
+	return resolution, invoicesToExpire, nil
+}
+
+// autoSettleInvoice settles an accepted hodl invoice whose hold policy
+// requires it to be settled automatically once its preimage is known. It
+// returns true if the invoice no longer needs to be watched because it was
+// settled or is no longer accepted.
+func (i *InvoiceRegistry) autoSettleInvoice(hash lntypes.Hash) (bool, error) {
+	if i.cfg.LookupPreimage == nil {
+		return true, nil
+	}
+
+	ctx := context.Background()
+
+	preimage, ok := i.cfg.LookupPreimage(hash)
+	if !ok {
+		invoice, err := i.idb.LookupInvoice(
+			ctx, InvoiceRefByHash(hash),
+		)
+		switch {
+		case errors.Is(err, ErrInvoiceNotFound):
+			return true, nil
+
+		case err != nil:
+			return false, err
+		}
+
+		return invoice.State != ContractAccepted, nil
+	}
+
+	err := i.SettleHodlInvoice(ctx, preimage)
+	switch {
+	case err == nil:
+		log.Infof("Invoice(%v): settled automatically with known "+
+			"preimage", hash)
+
+		return true, nil
+
+	case errors.Is(err, ErrInvoiceAlreadySettled),
+		errors.Is(err, ErrInvoiceAlreadyCanceled),
+		errors.Is(err, ErrInvoiceStillOpen),
+		errors.Is(err, ErrInvoiceNotFound):
+
+		return true, nil
+
+	default:
+		return false, err
+	}
 }
 
 // SettleHodlInvoice sets the preimage of a hodl invoice.
@@ -1431,6 +1506,35 @@ func (i *InvoiceRegistry) SettleHodlInvoice(ctx context.Context,
 	return nil
 }
 
+// UpdatePaymentRequest replaces the payment request of the open invoice
+// corresponding to the passed payment hash. This is used to reissue an invoice
+// with new routing information, without changing its payment hash or terms.
+func (i *InvoiceRegistry) UpdatePaymentRequest(ctx context.Context,
+	payHash lntypes.Hash, paymentRequest []byte) error {
+
+	i.Lock()
+	defer i.Unlock()
+
+	ref := InvoiceRefByHash(payHash)
+	log.Debugf("Invoice%v: updating payment request", ref)
+
+	updateInvoice := func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+		return &InvoiceUpdateDesc{
+			UpdateType:     PaymentRequestUpdate,
+			PaymentRequest: paymentRequest,
+		}, nil
+	}
+
+	_, err := i.idb.UpdateInvoice(ctx, ref, nil, updateInvoice)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Invoice%v: payment request updated", ref)
+
+	return nil
+}
+
 // CancelInvoice attempts to cancel the invoice corresponding to the passed
 // payment hash.
 func (i *InvoiceRegistry) CancelInvoice(ctx context.Context,
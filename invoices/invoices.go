@@ -430,6 +430,37 @@ type Invoice struct {
 	// HodlInvoice indicates whether the invoice should be held in the
 	// Accepted state or be settled right away.
 	HodlInvoice bool
+
+	// HoldPolicy restricts how long the htlcs of a hodl invoice are held
+	// before the invoice is canceled automatically, and whether it is
+	// settled automatically once its preimage becomes known. It is only
+	// used for hodl invoices.
+	HoldPolicy HoldPolicy
+}
+
+// HoldPolicy describes when the htlcs of an accepted hodl invoice are
+// resolved without the invoice being settled or canceled manually. The zero
+// value doesn't restrict the invoice beyond the registry-wide defaults.
+type HoldPolicy struct {
+	// MaxHoldDuration is the maximum duration the htlcs of the invoice are
+	// held for after the first of them was accepted. Once it has passed,
+	// the invoice is canceled. Zero means that there is no limit.
+	MaxHoldDuration time.Duration
+
+	// MinCltvSlack is the minimum number of blocks that must be left until
+	// the earliest expiry of the accepted htlcs. Once fewer blocks are
+	// left, the invoice is canceled. If it is lower than the registry-wide
+	// expiry delta, the registry-wide delta is used instead.
+	MinCltvSlack uint32
+
+	// AutoSettle indicates that the invoice is settled as soon as its
+	// preimage is returned by the preimage lookup of the registry.
+	AutoSettle bool
+}
+
+// IsZero returns true if the policy doesn't restrict the invoice.
+func (p HoldPolicy) IsZero() bool {
+	return p == HoldPolicy{}
 }
 
 // HTLCSet returns the set of HTLCs belonging to setID and in the provided
@@ -705,6 +736,10 @@ const (
 	// CancelInvoiceUpdate indicates that this update is trying to cancel
 	// an invoice.
 	CancelInvoiceUpdate
+
+	// PaymentRequestUpdate indicates that this update replaces the payment
+	// request of an open invoice.
+	PaymentRequestUpdate
 )
 
 // String returns a human readable string for the UpdateType.
@@ -722,6 +757,9 @@ func (u UpdateType) String() string {
 	case CancelInvoiceUpdate:
 		return "CancelInvoiceUpdate"
 
+	case PaymentRequestUpdate:
+		return "PaymentRequestUpdate"
+
 	default:
 		return fmt.Sprintf("unknown invoice update type: %d", u)
 	}
@@ -746,6 +784,10 @@ type InvoiceUpdateDesc struct {
 	// entire HTLC set each timee an HTLC is to be cancelled.
 	SetID *SetID
 
+	// PaymentRequest is the new payment request of the invoice. It is only
+	// used by PaymentRequestUpdate updates.
+	PaymentRequest []byte
+
 	// UpdateType indicates what type of update is being applied.
 	UpdateType UpdateType
 }
@@ -810,6 +852,10 @@ func ValidateInvoice(i *Invoice, paymentHash lntypes.Hash) error {
 		return ErrInvoiceHasHtlcs
 	}
 
+	if !i.HodlInvoice && !i.HoldPolicy.IsZero() {
+		return ErrHoldPolicyNotHodl
+	}
+
 	return nil
 }
 
@@ -853,6 +899,7 @@ func CopyInvoice(src *Invoice) (*Invoice, error) {
 		),
 		AMPState:    make(map[SetID]InvoiceStateAMP),
 		HodlInvoice: src.HodlInvoice,
+		HoldPolicy:  src.HoldPolicy,
 	}
 
 	dest.Terms.Features = src.Terms.Features.Clone()
@@ -214,6 +214,14 @@ func TestInvoices(t *testing.T) {
 			name: "AddInvoiceInvalidFeatureDeps",
 			test: testAddInvoiceInvalidFeatureDeps,
 		},
+		{
+			name: "InvoiceHoldPolicy",
+			test: testInvoiceHoldPolicy,
+		},
+		{
+			name: "UpdatePaymentRequest",
+			test: testUpdatePaymentRequest,
+		},
 	}
 
 	makeKeyValueDB := func(t *testing.T) invpkg.InvoiceDB {
@@ -2864,3 +2872,121 @@ func testAddInvoiceInvalidFeatureDeps(t *testing.T,
 		lnwire.PaymentAddrOptional,
 	))
 }
+
+// testInvoiceHoldPolicy tests that the hold policy of a hodl invoice is
+// persisted, and that it can't be set for regular invoices.
+func testInvoiceHoldPolicy(t *testing.T,
+	makeDB func(t *testing.T) invpkg.InvoiceDB) {
+
+	t.Parallel()
+	db := makeDB(t)
+	ctxb := t.Context()
+
+	policy := invpkg.HoldPolicy{
+		MaxHoldDuration: time.Hour,
+		MinCltvSlack:    12,
+		AutoSettle:      true,
+	}
+
+	// A regular invoice can't have a hold policy.
+	invoice, err := randInvoice(1000)
+	require.NoError(t, err)
+	invoice.HoldPolicy = policy
+
+	hash := invoice.Terms.PaymentPreimage.Hash()
+	_, err = db.AddInvoice(ctxb, invoice, hash)
+	require.ErrorIs(t, err, invpkg.ErrHoldPolicyNotHodl)
+
+	// Turning it into a hodl invoice allows us to add it.
+	invoice.HodlInvoice = true
+	invoice.Terms.PaymentPreimage = nil
+	_, err = db.AddInvoice(ctxb, invoice, hash)
+	require.NoError(t, err)
+
+	dbInvoice, err := db.LookupInvoice(ctxb, invpkg.InvoiceRefByHash(hash))
+	require.NoError(t, err)
+	require.Equal(t, policy, dbInvoice.HoldPolicy)
+
+	// A hodl invoice without a policy has an empty one.
+	invoice, err = randInvoice(2000)
+	require.NoError(t, err)
+	invoice.HodlInvoice = true
+
+	hash = invoice.Terms.PaymentPreimage.Hash()
+	_, err = db.AddInvoice(ctxb, invoice, hash)
+	require.NoError(t, err)
+
+	dbInvoice, err = db.LookupInvoice(ctxb, invpkg.InvoiceRefByHash(hash))
+	require.NoError(t, err)
+	require.True(t, dbInvoice.HoldPolicy.IsZero())
+}
+
+// testUpdatePaymentRequest tests that the payment request of an open invoice
+// can be replaced, and that it can't be replaced once the invoice is no longer
+// open.
+func testUpdatePaymentRequest(t *testing.T,
+	makeDB func(t *testing.T) invpkg.InvoiceDB) {
+
+	t.Parallel()
+	db := makeDB(t)
+	ctxb := t.Context()
+
+	invoice, err := randInvoice(1000)
+	require.NoError(t, err)
+	invoice.PaymentRequest = []byte("lnbc1old")
+
+	hash := invoice.Terms.PaymentPreimage.Hash()
+	_, err = db.AddInvoice(ctxb, invoice, hash)
+	require.NoError(t, err)
+
+	updatePaymentRequest := func(paymentRequest string) error {
+		ref := invpkg.InvoiceRefByHash(hash)
+		_, err := db.UpdateInvoice(ctxb, ref, nil,
+			func(*invpkg.Invoice) (*invpkg.InvoiceUpdateDesc,
+				error) {
+
+				return &invpkg.InvoiceUpdateDesc{
+					UpdateType: invpkg.PaymentRequestUpdate,
+					PaymentRequest: []byte(
+						paymentRequest,
+					),
+				}, nil
+			},
+		)
+
+		return err
+	}
+
+	// The payment request of the open invoice is replaced.
+	require.NoError(t, updatePaymentRequest("lnbc1new"))
+
+	dbInvoice, err := db.LookupInvoice(ctxb, invpkg.InvoiceRefByHash(hash))
+	require.NoError(t, err)
+	require.Equal(t, []byte("lnbc1new"), dbInvoice.PaymentRequest)
+	require.Equal(t, invpkg.ContractOpen, dbInvoice.State)
+
+	// An empty payment request is rejected.
+	require.Error(t, updatePaymentRequest(""))
+
+	// Once the invoice is canceled, its payment request can no longer be
+	// replaced.
+	_, err = db.UpdateInvoice(
+		ctxb, invpkg.InvoiceRefByHash(hash), nil,
+		func(*invpkg.Invoice) (*invpkg.InvoiceUpdateDesc, error) {
+			return &invpkg.InvoiceUpdateDesc{
+				UpdateType: invpkg.CancelInvoiceUpdate,
+				State: &invpkg.InvoiceStateUpdateDesc{
+					NewState: invpkg.ContractCanceled,
+				},
+			}, nil
+		},
+	)
+	require.NoError(t, err)
+
+	err = updatePaymentRequest("lnbc1newer")
+	require.ErrorIs(t, err, invpkg.ErrInvoiceAlreadyCanceled)
+
+	dbInvoice, err = db.LookupInvoice(ctxb, invpkg.InvoiceRefByHash(hash))
+	require.NoError(t, err)
+	require.Equal(t, []byte("lnbc1new"), dbInvoice.PaymentRequest)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/lightningnetwork/lnd/kvdb/sqlbase"
 	"github.com/lightningnetwork/lnd/kvdb/sqlite"
 	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/sqldb"
 	"github.com/lightningnetwork/lnd/sqldb/sqlc"
 	"github.com/stretchr/testify/require"
@@ -190,6 +191,82 @@ func TestMigrationWithChannelDB(t *testing.T) {
 	}
 }
 
+// TestMigrateInvoiceHoldPolicies tests that the hold policies of KV invoices
+// are migrated once the invoices themselves were migrated to SQL.
+func TestMigrateInvoiceHoldPolicies(t *testing.T) {
+	t.Parallel()
+	ctxb := t.Context()
+
+	kvStore := channeldb.OpenForTesting(t, t.TempDir())
+
+	policy := invpkg.HoldPolicy{
+		MaxHoldDuration: time.Hour,
+		MinCltvSlack:    12,
+		AutoSettle:      true,
+	}
+
+	// Add a hodl invoice with a hold policy and one without.
+	var hashes []lntypes.Hash
+	for _, holdPolicy := range []invpkg.HoldPolicy{policy, {}} {
+		invoice, err := randInvoice(1000)
+		require.NoError(t, err)
+
+		hash := invoice.Terms.PaymentPreimage.Hash()
+		invoice.Terms.PaymentPreimage = nil
+		invoice.HodlInvoice = true
+		invoice.HoldPolicy = holdPolicy
+
+		_, err = kvStore.AddInvoice(ctxb, invoice, hash)
+		require.NoError(t, err)
+
+		hashes = append(hashes, hash)
+	}
+
+	db := sqldb.NewTestSqliteDB(t).BaseDB
+	sqlInvoiceStore := invpkg.NewSQLStore(
+		sqldb.NewTransactionExecutor(
+			db, func(tx *sql.Tx) invpkg.SQLInvoiceQueries {
+				return db.WithTx(tx)
+			},
+		), clock.NewTestClock(time.Unix(1, 0)),
+	)
+	sqlStore := sqldb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) *sqlc.Queries {
+			return db.WithTx(tx)
+		},
+	)
+
+	err := sqlStore.ExecTx(
+		ctxb, sqldb.WriteTxOpt(), func(tx *sqlc.Queries) error {
+			err := invpkg.MigrateInvoicesToSQL(
+				ctxb, kvStore.Backend, kvStore, tx, 10,
+			)
+			if err != nil {
+				return err
+			}
+
+			return invpkg.MigrateInvoiceHoldPoliciesToSQL(
+				ctxb, kvStore.Backend, kvStore, tx,
+			)
+		}, sqldb.NoOpReset,
+	)
+	require.NoError(t, err)
+
+	// Only the first invoice should have a hold policy after the
+	// migration.
+	invoice, err := sqlInvoiceStore.LookupInvoice(
+		ctxb, invpkg.InvoiceRefByHash(hashes[0]),
+	)
+	require.NoError(t, err)
+	require.Equal(t, policy, invoice.HoldPolicy)
+
+	invoice, err = sqlInvoiceStore.LookupInvoice(
+		ctxb, invpkg.InvoiceRefByHash(hashes[1]),
+	)
+	require.NoError(t, err)
+	require.True(t, invoice.HoldPolicy.IsZero())
+}
+
 func fileExists(filename string) bool {
 	info, err := os.Stat(filename)
 	if os.IsNotExist(err) {
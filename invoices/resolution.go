@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
 )
 
 // HtlcResolution describes how an htlc should be resolved.
@@ -24,6 +25,10 @@ type HtlcFailResolution struct {
 
 	// Outcome indicates the outcome of the invoice registry update.
 	Outcome FailResolutionResult
+
+	// FailureMessage is an optional failure message that is returned to
+	// the sender instead of the failure message derived from the outcome.
+	FailureMessage lnwire.FailureMessage
 }
 
 // NewFailResolution returns a htlc failure resolution.
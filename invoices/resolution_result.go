@@ -124,6 +124,10 @@ const (
 	// ExternalValidationFailed is returned when the external validation
 	// failed.
 	ExternalValidationFailed
+
+	// ResultTrampolineForwardFailed is returned when a trampoline payment
+	// could not be forwarded to its recipient.
+	ResultTrampolineForwardFailed
 )
 
 // String returns a string representation of the result.
@@ -196,6 +200,9 @@ func (f FailResolutionResult) FailureString() string {
 	case ExternalValidationFailed:
 		return "external validation failed"
 
+	case ResultTrampolineForwardFailed:
+		return "trampoline forward failed"
+
 	default:
 		return "unknown failure resolution result"
 	}
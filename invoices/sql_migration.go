@@ -3,6 +3,7 @@ package invoices
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -488,6 +489,10 @@ func MigrateInvoicesToSQL(ctx context.Context, db kvdb.Backend,
 func migrateInvoices(ctx context.Context, tx *sqlc.Queries,
 	invoices []Invoice) error {
 
+	// The invoice migration runs before the hold policy table is created,
+	// so we can neither migrate nor fetch hold policies.
+	db := &noHoldPolicyQueries{SQLInvoiceQueries: tx}
+
 	for i, invoice := range invoices {
 		var paymentHash lntypes.Hash
 		if invoice.Terms.PaymentPreimage != nil {
@@ -517,8 +522,13 @@ func migrateInvoices(ctx context.Context, tx *sqlc.Queries,
 				paymentHash, err)
 		}
 
+		// The hold policy is migrated separately by
+		// MigrateInvoiceHoldPoliciesToSQL once the hold policy table
+		// exists, so we leave it out of the comparison.
+		invoice.HoldPolicy = HoldPolicy{}
+
 		migratedInvoice, err := fetchInvoice(
-			ctx, tx, InvoiceRefByHash(paymentHash),
+			ctx, db, InvoiceRefByHash(paymentHash),
 		)
 		if err != nil {
 			return fmt.Errorf("unable to fetch migrated "+
@@ -548,3 +558,108 @@ func migrateInvoices(ctx context.Context, tx *sqlc.Queries,
 
 	return nil
 }
+
+// MigrateInvoiceHoldPoliciesToSQL migrates the hold policies of all KV
+// invoices to the SQL database. As the invoice migration runs before the hold
+// policy table is created, it can't migrate the policies itself, so this must
+// be run as a separate migration once the table exists. Invoices without a
+// hold policy are left untouched, which also makes it a no-op if the invoices
+// were migrated before hold policies existed.
+func MigrateInvoiceHoldPoliciesToSQL(ctx context.Context, db kvdb.Backend,
+	kvStore InvoiceDB, tx *sqlc.Queries) error {
+
+	log.Infof("Starting migration of invoice hold policies from KV to SQL")
+
+	// Collect the payment hashes of all KV invoices, which we'll use to
+	// look up the invoices in both databases.
+	var hashes []lntypes.Hash
+	err := db.View(func(kvTx kvdb.RTx) error {
+		invoices := kvTx.ReadBucket(invoiceBucket)
+		if invoices == nil {
+			return nil
+		}
+
+		invoiceIndex := invoices.NestedReadBucket(invoiceIndexBucket)
+		if invoiceIndex == nil {
+			return nil
+		}
+
+		return invoiceIndex.ForEach(func(k, _ []byte) error {
+			// Skip the special numInvoicesKey as that does not
+			// point to a valid invoice.
+			if bytes.Equal(k, numInvoicesKey) {
+				return nil
+			}
+
+			hash, err := lntypes.MakeHash(k)
+			if err != nil {
+				return err
+			}
+			hashes = append(hashes, hash)
+
+			return nil
+		})
+	}, func() {
+		hashes = nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to read invoice index: %w", err)
+	}
+
+	var total int
+	for _, hash := range hashes {
+		invoice, err := kvStore.LookupInvoice(
+			ctx, InvoiceRefByHash(hash),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch KV invoice(%v): %w",
+				hash, err)
+		}
+
+		if invoice.HoldPolicy.IsZero() {
+			continue
+		}
+
+		row, err := tx.GetInvoiceByHash(ctx, hash[:])
+		if err != nil {
+			return fmt.Errorf("unable to fetch migrated "+
+				"invoice(%v) for its hold policy: %w", hash,
+				err)
+		}
+
+		policy := invoice.HoldPolicy
+		err = tx.InsertInvoiceHoldPolicy(
+			ctx, sqlc.InsertInvoiceHoldPolicyParams{
+				InvoiceID:       row.ID,
+				MaxHoldDuration: int64(policy.MaxHoldDuration),
+				MinCltvSlack:    int32(policy.MinCltvSlack),
+				AutoSettle:      policy.AutoSettle,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to migrate hold policy of "+
+				"invoice(%v): %w", hash, err)
+		}
+
+		total++
+	}
+
+	log.Infof("Migration of %d invoice hold policies from KV to SQL "+
+		"completed", total)
+
+	return nil
+}
+
+// noHoldPolicyQueries wraps the queries used during the invoice migration.
+// Because the migration runs before the hold policy table is created, it
+// reports that no invoice has a hold policy.
+type noHoldPolicyQueries struct {
+	SQLInvoiceQueries
+}
+
+// GetInvoiceHoldPolicy always returns sql.ErrNoRows.
+func (q *noHoldPolicyQueries) GetInvoiceHoldPolicy(context.Context,
+	int64) (sqlc.InvoiceHoldPolicy, error) {
+
+	return sqlc.InvoiceHoldPolicy{}, sql.ErrNoRows
+}
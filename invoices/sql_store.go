@@ -67,6 +67,9 @@ type SQLInvoiceQueries interface { //nolint:interfacebloat
 	InsertInvoiceHTLCCustomRecord(ctx context.Context,
 		arg sqlc.InsertInvoiceHTLCCustomRecordParams) error
 
+	InsertInvoiceHoldPolicy(ctx context.Context,
+		arg sqlc.InsertInvoiceHoldPolicyParams) error
+
 	// FetchPendingInvoices returns all open/accepted invoices ordered by
 	// id ascending. It replaces the old catch-all FilterInvoices for the
 	// pending-only path and lets the planner use invoices_state_idx.
@@ -109,6 +112,9 @@ type SQLInvoiceQueries interface { //nolint:interfacebloat
 	GetInvoiceBySetID(ctx context.Context, setID []byte) ([]sqlc.Invoice,
 		error)
 
+	GetInvoiceHoldPolicy(ctx context.Context,
+		invoiceID int64) (sqlc.InvoiceHoldPolicy, error)
+
 	GetInvoiceFeatures(ctx context.Context,
 		invoiceID int64) ([]sqlc.InvoiceFeature, error)
 
@@ -124,6 +130,9 @@ type SQLInvoiceQueries interface { //nolint:interfacebloat
 	UpdateInvoiceAmountPaid(ctx context.Context,
 		arg sqlc.UpdateInvoiceAmountPaidParams) (sql.Result, error)
 
+	UpdateInvoicePaymentRequest(ctx context.Context,
+		arg sqlc.UpdateInvoicePaymentRequestParams) (sql.Result, error)
+
 	NextInvoiceSettleIndex(ctx context.Context) (int64, error)
 
 	UpdateInvoiceHTLC(ctx context.Context,
@@ -255,16 +264,24 @@ func NewSQLStore(db BatchedSQLInvoiceQueries,
 	}
 }
 
+// paymentRequestHash returns the hash of the given payment request that is
+// stored alongside it, or nil if the payment request is empty.
+func paymentRequestHash(paymentRequest []byte) []byte {
+	if len(paymentRequest) == 0 {
+		return nil
+	}
+
+	h := sha256.New()
+	h.Write(paymentRequest)
+
+	return h.Sum(nil)
+}
+
 func makeInsertInvoiceParams(invoice *Invoice, paymentHash lntypes.Hash) (
 	sqlc.InsertInvoiceParams, error) {
 
 	// Precompute the payment request hash so we can use it in the query.
-	var paymentRequestHash []byte
-	if len(invoice.PaymentRequest) > 0 {
-		h := sha256.New()
-		h.Write(invoice.PaymentRequest)
-		paymentRequestHash = h.Sum(nil)
-	}
+	paymentRequestHash := paymentRequestHash(invoice.PaymentRequest)
 
 	params := sqlc.InsertInvoiceParams{
 		Hash:       paymentHash[:],
@@ -365,6 +382,24 @@ func (i *SQLStore) AddInvoice(ctx context.Context,
 			}
 		}
 
+		if !newInvoice.HoldPolicy.IsZero() {
+			policy := newInvoice.HoldPolicy
+			params := sqlc.InsertInvoiceHoldPolicyParams{
+				InvoiceID: invoiceID,
+				MaxHoldDuration: int64(
+					policy.MaxHoldDuration,
+				),
+				MinCltvSlack: int32(policy.MinCltvSlack),
+				AutoSettle:   policy.AutoSettle,
+			}
+
+			err := db.InsertInvoiceHoldPolicy(ctx, params)
+			if err != nil {
+				return fmt.Errorf("unable to insert invoice "+
+					"hold policy: %w", err)
+			}
+		}
+
 		// Finally add a new event for this invoice.
 		return db.OnInvoiceCreated(ctx, sqlc.OnInvoiceCreatedParams{
 			AddedAt:   newInvoice.CreationDate.UTC(),
@@ -1450,6 +1485,32 @@ func (s *sqlInvoiceUpdater) UpdateInvoiceAmtPaid(
 	return err
 }
 
+// UpdatePaymentRequest replaces the payment request of the invoice.
+func (s *sqlInvoiceUpdater) UpdatePaymentRequest(paymentRequest []byte) error {
+	result, err := s.db.UpdateInvoicePaymentRequest(
+		s.ctx, sqlc.UpdateInvoicePaymentRequestParams{
+			ID: int64(s.invoice.AddIndex),
+			PaymentRequest: sqldb.SQLStr(
+				string(paymentRequest),
+			),
+			PaymentRequestHash: paymentRequestHash(paymentRequest),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrInvoiceNotFound
+	}
+
+	return nil
+}
+
 // UpdateAmpState updates the state of the AMP sub invoice identified by the
 // setID.
 func (s *sqlInvoiceUpdater) UpdateAmpState(setID [32]byte,
@@ -1697,6 +1758,16 @@ func fetchInvoiceData(ctx context.Context, db SQLInvoiceQueries,
 
 	invoice.Terms.Features = features
 
+	// Only hodl invoices can have a hold policy.
+	if invoice.HodlInvoice {
+		policy, err := getInvoiceHoldPolicy(ctx, db, row.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		invoice.HoldPolicy = policy
+	}
+
 	// If this is an AMP invoice, we'll need fetch the AMP state along
 	// with the HTLCs (if requested).
 	if invoice.IsAMP() {
@@ -1745,6 +1816,28 @@ func getInvoiceFeatures(ctx context.Context, db SQLInvoiceQueries,
 	return features, nil
 }
 
+// getInvoiceHoldPolicy fetches the hold policy for the given invoice id. The
+// zero policy is returned if the invoice doesn't have one.
+func getInvoiceHoldPolicy(ctx context.Context, db SQLInvoiceQueries,
+	invoiceID int64) (HoldPolicy, error) {
+
+	row, err := db.GetInvoiceHoldPolicy(ctx, invoiceID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return HoldPolicy{}, nil
+
+	case err != nil:
+		return HoldPolicy{}, fmt.Errorf("unable to get invoice hold "+
+			"policy: %w", err)
+	}
+
+	return HoldPolicy{
+		MaxHoldDuration: time.Duration(row.MaxHoldDuration),
+		MinCltvSlack:    uint32(row.MinCltvSlack),
+		AutoSettle:      row.AutoSettle,
+	}, nil
+}
+
 // getInvoiceHtlcs fetches the invoice htlcs for the given invoice id.
 func getInvoiceHtlcs(ctx context.Context, db SQLInvoiceQueries,
 	invoiceID int64) (map[CircuitKey]*InvoiceHTLC, error) {
@@ -0,0 +1,677 @@
+package subscriptions
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultPollInterval is the default interval at which the manager
+	// checks for periods that need to be invoiced and for expired
+	// invoices.
+	DefaultPollInterval = time.Minute
+
+	// DefaultInvoiceExpiry is the default expiry of the invoice of a
+	// period.
+	DefaultInvoiceExpiry = 24 * time.Hour
+
+	// MinInterval is the shortest period length of a subscription.
+	MinInterval = time.Minute
+)
+
+var (
+	// ErrManagerShuttingDown is returned when a subscription is added or
+	// canceled while the manager is shutting down.
+	ErrManagerShuttingDown = errors.New("subscription manager shutting " +
+		"down")
+
+	// ErrSubscriptionNotActive is returned when an inactive subscription
+	// is canceled.
+	ErrSubscriptionNotActive = errors.New("subscription not active")
+)
+
+// EventType is the type of a subscription event.
+type EventType uint8
+
+const (
+	// EventCreated is sent when a subscription was added.
+	EventCreated EventType = iota
+
+	// EventInvoiceCreated is sent when the invoice of a new period was
+	// created.
+	EventInvoiceCreated
+
+	// EventPeriodPaid is sent when the invoice of a period was paid.
+	EventPeriodPaid
+
+	// EventPeriodUnpaid is sent when the invoice of a period expired or
+	// was canceled without being paid.
+	EventPeriodUnpaid
+
+	// EventCanceled is sent when a subscription was canceled.
+	EventCanceled
+
+	// EventCompleted is sent when all periods of a subscription were
+	// invoiced and resolved.
+	EventCompleted
+
+	// EventPeriodSkipped is sent when a period ended before its invoice
+	// was created, e.g. because we were offline, so it won't be invoiced.
+	EventPeriodSkipped
+)
+
+// String returns a human-readable representation of the event type.
+func (e EventType) String() string {
+	switch e {
+	case EventCreated:
+		return "created"
+
+	case EventInvoiceCreated:
+		return "invoice_created"
+
+	case EventPeriodPaid:
+		return "period_paid"
+
+	case EventPeriodUnpaid:
+		return "period_unpaid"
+
+	case EventCanceled:
+		return "canceled"
+
+	case EventCompleted:
+		return "completed"
+
+	case EventPeriodSkipped:
+		return "period_skipped"
+
+	default:
+		return fmt.Sprintf("unknown<%d>", uint8(e))
+	}
+}
+
+// Event is sent to the event subscribers whenever a subscription changes.
+type Event struct {
+	// Type is the type of the event.
+	Type EventType
+
+	// Subscription is a snapshot of the subscription after the change.
+	Subscription *Subscription
+
+	// Period is the period the event refers to. It is nil for events
+	// that don't refer to a single period.
+	Period *Period
+}
+
+// Request describes a new subscription.
+type Request struct {
+	// Memo is the description of the subscription.
+	Memo string
+
+	// Amount is the amount that is invoiced per period.
+	Amount lnwire.MilliSatoshi
+
+	// Interval is the length of a period.
+	Interval time.Duration
+
+	// StartTime is the start of the first period. If it is zero, the
+	// first period starts immediately.
+	StartTime time.Time
+
+	// NumPeriods is the total number of periods. Zero means that the
+	// subscription runs until it is canceled.
+	NumPeriods uint32
+
+	// InvoiceExpiry is the expiry of the invoice of every period. Zero
+	// means DefaultInvoiceExpiry, capped to the interval.
+	InvoiceExpiry time.Duration
+}
+
+// validate checks that the request is sane.
+func (r *Request) validate() error {
+	switch {
+	case r.Amount == 0:
+		return errors.New("amount must be positive")
+
+	case r.Interval < MinInterval:
+		return fmt.Errorf("interval must be at least %v", MinInterval)
+
+	case r.InvoiceExpiry < 0:
+		return errors.New("invoice expiry must not be negative")
+
+	case r.InvoiceExpiry > r.Interval:
+		return errors.New("invoice expiry must not exceed the " +
+			"interval")
+	}
+
+	return nil
+}
+
+// Config holds the dependencies of the subscription manager.
+type Config struct {
+	// Store persists the subscriptions.
+	Store *Store
+
+	// AddInvoice creates the invoice of a period and returns its payment
+	// hash and payment request.
+	AddInvoice func(ctx context.Context, amt lnwire.MilliSatoshi,
+		memo string, expiry time.Duration) (lntypes.Hash, string,
+		error)
+
+	// LookupInvoice looks up the invoice of a period.
+	LookupInvoice func(ctx context.Context,
+		hash lntypes.Hash) (invoices.Invoice, error)
+
+	// CancelInvoice cancels the open invoice of a canceled subscription.
+	CancelInvoice func(ctx context.Context, hash lntypes.Hash) error
+
+	// SubscribeSettles returns a channel that receives all settled
+	// invoices, as well as a function to cancel the subscription.
+	SubscribeSettles func() (<-chan *invoices.Invoice, func(), error)
+
+	// PollTicker triggers the periodic check for periods that need to be
+	// invoiced and for expired invoices.
+	PollTicker ticker.Ticker
+
+	// Clock is used to determine which periods are due.
+	Clock clock.Clock
+}
+
+// Manager creates the invoices of recurring subscriptions. For every active
+// subscription, it creates an invoice at the start of each period and tracks
+// whether the invoice was paid.
+type Manager struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// subscriptions holds all subscriptions by their ID.
+	subscriptions map[uint64]*Subscription
+
+	// openInvoices maps the payment hashes of open invoices to their
+	// subscription.
+	openInvoices map[lntypes.Hash]uint64
+
+	// mu protects subscriptions and openInvoices.
+	mu sync.Mutex
+
+	ntfnServer *subscribe.Server
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewManager creates a new subscription manager.
+func NewManager(cfg *Config) *Manager {
+	return &Manager{
+		cfg:           cfg,
+		subscriptions: make(map[uint64]*Subscription),
+		openInvoices:  make(map[lntypes.Hash]uint64),
+		ntfnServer:    subscribe.NewServer(),
+		quit:          make(chan struct{}),
+	}
+}
+
+// Start loads all subscriptions and starts creating the invoices of their
+// periods.
+func (m *Manager) Start() error {
+	var startErr error
+	m.started.Do(func() {
+		log.Debugf("Subscription manager starting")
+
+		startErr = m.start()
+	})
+
+	return startErr
+}
+
+// start loads all subscriptions and starts the event loop.
+func (m *Manager) start() error {
+	subs, err := m.cfg.Store.FetchAll()
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range subs {
+		m.subscriptions[sub.ID] = sub
+
+		for _, p := range sub.Periods {
+			if p.Status == PeriodOpen {
+				m.openInvoices[p.PaymentHash] = sub.ID
+			}
+		}
+	}
+
+	if err := m.ntfnServer.Start(); err != nil {
+		return err
+	}
+
+	settles, cancelSettles, err := m.cfg.SubscribeSettles()
+	if err != nil {
+		return err
+	}
+
+	m.cfg.PollTicker.Resume()
+
+	m.wg.Add(1)
+	go m.eventLoop(settles, cancelSettles)
+
+	return nil
+}
+
+// Stop stops the subscription manager.
+func (m *Manager) Stop() error {
+	var stopErr error
+	m.stopped.Do(func() {
+		log.Debugf("Subscription manager stopping")
+
+		close(m.quit)
+		m.wg.Wait()
+
+		m.cfg.PollTicker.Stop()
+		stopErr = m.ntfnServer.Stop()
+	})
+
+	return stopErr
+}
+
+// AddSubscription validates the request and adds a new subscription. The
+// invoice of the first period is created immediately if it is already due.
+func (m *Manager) AddSubscription(req *Request) (*Subscription, error) {
+	select {
+	case <-m.quit:
+		return nil, ErrManagerShuttingDown
+	default:
+	}
+
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	now := m.cfg.Clock.Now()
+	sub := &Subscription{
+		Memo:          req.Memo,
+		Amount:        req.Amount,
+		Interval:      req.Interval,
+		StartTime:     req.StartTime,
+		NumPeriods:    req.NumPeriods,
+		InvoiceExpiry: req.InvoiceExpiry,
+		CreationTime:  now,
+		Status:        StatusActive,
+	}
+	if sub.StartTime.IsZero() {
+		sub.StartTime = now
+	}
+	if sub.InvoiceExpiry == 0 {
+		sub.InvoiceExpiry = min(DefaultInvoiceExpiry, sub.Interval)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.cfg.Store.Add(sub); err != nil {
+		return nil, err
+	}
+	m.subscriptions[sub.ID] = sub
+
+	log.Infof("Added subscription %d of %v every %v", sub.ID, sub.Amount,
+		sub.Interval)
+
+	m.notify(EventCreated, sub, nil)
+
+	m.update(sub)
+
+	return sub.copy(), nil
+}
+
+// CancelSubscription cancels the subscription with the given ID. No further
+// invoices are created for it and the open invoices of its periods are
+// canceled.
+func (m *Manager) CancelSubscription(id uint64) error {
+	select {
+	case <-m.quit:
+		return ErrManagerShuttingDown
+	default:
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sub, ok := m.subscriptions[id]
+	if !ok {
+		return ErrSubscriptionNotFound
+	}
+	if sub.Status != StatusActive {
+		return ErrSubscriptionNotActive
+	}
+
+	sub.Status = StatusCanceled
+	if err := m.cfg.Store.Update(sub); err != nil {
+		return err
+	}
+
+	log.Infof("Canceled subscription %d", sub.ID)
+
+	m.notify(EventCanceled, sub, nil)
+
+	// Cancel the open invoices, the update below marks their periods as
+	// unpaid.
+	ctx := context.TODO()
+	for _, p := range sub.Periods {
+		if p.Status != PeriodOpen {
+			continue
+		}
+
+		err := m.cfg.CancelInvoice(ctx, p.PaymentHash)
+		if err != nil {
+			log.Warnf("Unable to cancel invoice %v of subscription "+
+				"%d: %v", p.PaymentHash, sub.ID, err)
+		}
+	}
+
+	m.update(sub)
+
+	return nil
+}
+
+// ListSubscriptions returns all subscriptions ordered by their ID. If
+// activeOnly is set, only active subscriptions are returned.
+func (m *Manager) ListSubscriptions(activeOnly bool) []*Subscription {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	subs := make([]*Subscription, 0, len(m.subscriptions))
+	for _, sub := range m.subscriptions {
+		if activeOnly && sub.Status != StatusActive {
+			continue
+		}
+
+		subs = append(subs, sub.copy())
+	}
+
+	slices.SortFunc(subs, func(a, b *Subscription) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return subs
+}
+
+// SubscribeEvents returns a client that receives an Event for every change of
+// a subscription.
+func (m *Manager) SubscribeEvents() (*subscribe.Client, error) {
+	return m.ntfnServer.Subscribe()
+}
+
+// eventLoop updates the subscriptions whenever an invoice is settled or the
+// poll ticker fires.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Manager) eventLoop(settles <-chan *invoices.Invoice,
+	cancelSettles func()) {
+
+	defer m.wg.Done()
+	defer cancelSettles()
+
+	m.updateAll()
+
+	for {
+		select {
+		case invoice, ok := <-settles:
+			if !ok {
+				log.Warnf("Invoice settle subscription closed")
+				settles = nil
+
+				continue
+			}
+
+			m.handleSettle(invoice)
+
+		case <-m.cfg.PollTicker.Ticks():
+			m.updateAll()
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// handleSettle updates the subscription of a settled invoice, if any.
+func (m *Manager) handleSettle(invoice *invoices.Invoice) {
+	if invoice.Terms.PaymentPreimage == nil {
+		return
+	}
+	hash := invoice.Terms.PaymentPreimage.Hash()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id, ok := m.openInvoices[hash]
+	if !ok {
+		return
+	}
+
+	if sub, ok := m.subscriptions[id]; ok {
+		m.update(sub)
+	}
+}
+
+// updateAll updates all subscriptions that are active or have open invoices.
+func (m *Manager) updateAll() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, sub := range m.subscriptions {
+		if sub.Status == StatusActive || m.hasOpenPeriods(sub) {
+			m.update(sub)
+		}
+	}
+}
+
+// hasOpenPeriods returns true if the subscription has periods whose invoices
+// are still open.
+func (m *Manager) hasOpenPeriods(sub *Subscription) bool {
+	for _, p := range sub.Periods {
+		if p.Status == PeriodOpen {
+			return true
+		}
+	}
+
+	return false
+}
+
+// update refreshes the status of the open periods of the subscription,
+// creates the invoice of the current period, skipping any periods that
+// already ended, and completes the subscription once all of its periods were
+// resolved.
+//
+// NOTE: The caller MUST hold the mutex.
+func (m *Manager) update(sub *Subscription) {
+	ctx := context.TODO()
+
+	var events []*Event
+	for i := range sub.Periods {
+		p := &sub.Periods[i]
+		if p.Status != PeriodOpen {
+			continue
+		}
+
+		invoice, err := m.cfg.LookupInvoice(ctx, p.PaymentHash)
+		if err != nil {
+			log.Errorf("Unable to look up invoice %v of "+
+				"subscription %d: %v", p.PaymentHash, sub.ID,
+				err)
+
+			continue
+		}
+
+		switch invoice.State {
+		case invoices.ContractSettled:
+			p.Status = PeriodPaid
+			p.SettleTime = invoice.SettleDate
+			p.AmountPaid = invoice.AmtPaid
+
+			log.Infof("Period %d of subscription %d paid", p.Index,
+				sub.ID)
+
+			events = append(events, &Event{
+				Type: EventPeriodPaid, Period: copyPeriod(p),
+			})
+
+		case invoices.ContractCanceled:
+			p.Status = PeriodUnpaid
+
+			log.Infof("Period %d of subscription %d unpaid",
+				p.Index, sub.ID)
+
+			events = append(events, &Event{
+				Type: EventPeriodUnpaid, Period: copyPeriod(p),
+			})
+
+		default:
+			continue
+		}
+
+		delete(m.openInvoices, p.PaymentHash)
+	}
+
+	// Skip all periods that already ended while we were offline, as the
+	// payer shouldn't be billed for them all at once, and create the
+	// invoice of the current period.
+	now := m.cfg.Clock.Now()
+	for sub.Status == StatusActive && !sub.allPeriodsInvoiced() &&
+		!now.Before(sub.nextPeriodStart()) {
+
+		periodEnd := sub.nextPeriodStart().Add(sub.Interval)
+		if !now.Before(periodEnd) {
+			p := m.skipPeriod(sub, now)
+			events = append(events, &Event{
+				Type: EventPeriodSkipped, Period: copyPeriod(p),
+			})
+
+			continue
+		}
+
+		p, err := m.addPeriod(ctx, sub, now)
+		if err != nil {
+			log.Errorf("Unable to create invoice for subscription "+
+				"%d: %v", sub.ID, err)
+
+			break
+		}
+
+		events = append(events, &Event{
+			Type: EventInvoiceCreated, Period: copyPeriod(p),
+		})
+	}
+
+	if sub.Status == StatusActive && sub.allPeriodsInvoiced() &&
+		!m.hasOpenPeriods(sub) {
+
+		sub.Status = StatusCompleted
+
+		log.Infof("Subscription %d completed", sub.ID)
+
+		events = append(events, &Event{Type: EventCompleted})
+	}
+
+	if len(events) == 0 {
+		return
+	}
+
+	if err := m.cfg.Store.Update(sub); err != nil {
+		log.Errorf("Unable to update subscription %d: %v", sub.ID, err)
+	}
+
+	for _, event := range events {
+		m.notify(event.Type, sub, event.Period)
+	}
+}
+
+// addPeriod creates the invoice of the next period of the subscription.
+//
+// NOTE: The caller MUST hold the mutex.
+func (m *Manager) addPeriod(ctx context.Context, sub *Subscription,
+	now time.Time) (*Period, error) {
+
+	index := uint32(len(sub.Periods))
+	memo := fmt.Sprintf("%s (period %d)", sub.Memo, index+1)
+	if sub.NumPeriods != 0 {
+		memo = fmt.Sprintf("%s (period %d of %d)", sub.Memo, index+1,
+			sub.NumPeriods)
+	}
+
+	hash, payReq, err := m.cfg.AddInvoice(
+		ctx, sub.Amount, memo, sub.InvoiceExpiry,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sub.Periods = append(sub.Periods, Period{
+		Index:          index,
+		PaymentHash:    hash,
+		PaymentRequest: payReq,
+		CreationTime:   now,
+		Status:         PeriodOpen,
+	})
+	m.openInvoices[hash] = sub.ID
+
+	log.Infof("Created invoice %v for period %d of subscription %d", hash,
+		index, sub.ID)
+
+	return &sub.Periods[index], nil
+}
+
+// skipPeriod adds the next period of the subscription without creating an
+// invoice for it.
+//
+// NOTE: The caller MUST hold the mutex.
+func (m *Manager) skipPeriod(sub *Subscription, now time.Time) *Period {
+	index := uint32(len(sub.Periods))
+	sub.Periods = append(sub.Periods, Period{
+		Index:        index,
+		CreationTime: now,
+		Status:       PeriodSkipped,
+	})
+
+	log.Infof("Skipped period %d of subscription %d as it already ended",
+		index, sub.ID)
+
+	return &sub.Periods[index]
+}
+
+// copyPeriod returns a copy of the period.
+func copyPeriod(p *Period) *Period {
+	c := *p
+	return &c
+}
+
+// notify sends an event with a snapshot of the subscription to all event
+// subscribers. The period must not be modified afterwards.
+//
+// NOTE: The caller MUST hold the mutex.
+func (m *Manager) notify(eventType EventType, sub *Subscription,
+	period *Period) {
+
+	event := &Event{
+		Type:         eventType,
+		Subscription: sub.copy(),
+		Period:       period,
+	}
+
+	if err := m.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send subscription event: %v", err)
+	}
+}
@@ -0,0 +1,376 @@
+package subscriptions
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/ticker"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = 5 * time.Second
+
+var testStartTime = time.Unix(1_700_000_000, 0)
+
+// newTestStore creates a subscription store backed by a temporary database.
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+
+	db, cleanup, err := kvdb.GetTestBackend(t.TempDir(), "subscriptions")
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	store, err := NewStore(db)
+	require.NoError(t, err)
+
+	return store
+}
+
+// TestStore tests that subscriptions are persisted and updated.
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	store := newTestStore(t)
+
+	subs, err := store.FetchAll()
+	require.NoError(t, err)
+	require.Empty(t, subs)
+
+	sub1 := &Subscription{
+		Memo:          "monthly plan",
+		Amount:        1_000_000,
+		Interval:      30 * 24 * time.Hour,
+		StartTime:     time.Unix(0, 1_000),
+		NumPeriods:    12,
+		InvoiceExpiry: 24 * time.Hour,
+		CreationTime:  time.Unix(0, 500),
+		Status:        StatusActive,
+	}
+	sub2 := &Subscription{
+		Amount:        2_000,
+		Interval:      time.Hour,
+		StartTime:     time.Unix(0, 2_000),
+		InvoiceExpiry: time.Hour,
+		CreationTime:  time.Unix(0, 2_000),
+		Status:        StatusActive,
+	}
+	require.NoError(t, store.Add(sub1))
+	require.NoError(t, store.Add(sub2))
+	require.NotEqual(t, sub1.ID, sub2.ID)
+
+	sub1.Periods = []Period{{
+		Index:          0,
+		PaymentHash:    lntypes.Hash{1},
+		PaymentRequest: "lnbc1",
+		CreationTime:   time.Unix(0, 3_000),
+		Status:         PeriodPaid,
+		SettleTime:     time.Unix(0, 4_000),
+		AmountPaid:     1_000_000,
+	}, {
+		Index:          1,
+		PaymentHash:    lntypes.Hash{2},
+		PaymentRequest: "lnbc2",
+		CreationTime:   time.Unix(0, 5_000),
+		Status:         PeriodOpen,
+	}}
+	sub1.Status = StatusCanceled
+	require.NoError(t, store.Update(sub1))
+
+	subs, err = store.FetchAll()
+	require.NoError(t, err)
+	require.Equal(t, []*Subscription{sub1, sub2}, subs)
+
+	// Updating an unknown subscription fails.
+	err = store.Update(&Subscription{ID: 100})
+	require.ErrorIs(t, err, ErrSubscriptionNotFound)
+}
+
+// mockInvoices is an in-memory invoice registry.
+type mockInvoices struct {
+	mu       sync.Mutex
+	invoices map[lntypes.Hash]*invoices.Invoice
+	memos    []string
+	settles  chan *invoices.Invoice
+}
+
+func newMockInvoices() *mockInvoices {
+	return &mockInvoices{
+		invoices: make(map[lntypes.Hash]*invoices.Invoice),
+		settles:  make(chan *invoices.Invoice),
+	}
+}
+
+func (m *mockInvoices) addInvoice(_ context.Context, amt lnwire.MilliSatoshi,
+	memo string, _ time.Duration) (lntypes.Hash, string, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	preimage := lntypes.Preimage{byte(len(m.invoices) + 1)}
+	m.invoices[preimage.Hash()] = &invoices.Invoice{
+		Terms: invoices.ContractTerm{
+			PaymentPreimage: &preimage,
+			Value:           amt,
+		},
+		State: invoices.ContractOpen,
+	}
+	m.memos = append(m.memos, memo)
+
+	return preimage.Hash(), fmt.Sprintf("lnbc%d", len(m.invoices)), nil
+}
+
+func (m *mockInvoices) lookupInvoice(_ context.Context,
+	hash lntypes.Hash) (invoices.Invoice, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	invoice, ok := m.invoices[hash]
+	if !ok {
+		return invoices.Invoice{}, invoices.ErrInvoiceNotFound
+	}
+
+	return *invoice, nil
+}
+
+func (m *mockInvoices) cancelInvoice(_ context.Context,
+	hash lntypes.Hash) error {
+
+	m.setState(hash, invoices.ContractCanceled)
+
+	return nil
+}
+
+func (m *mockInvoices) setState(hash lntypes.Hash,
+	state invoices.ContractState) *invoices.Invoice {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	invoice := m.invoices[hash]
+	invoice.State = state
+	if state == invoices.ContractSettled {
+		invoice.AmtPaid = invoice.Terms.Value
+		invoice.SettleDate = testStartTime
+	}
+
+	c := *invoice
+
+	return &c
+}
+
+// managerTestContext holds a subscription manager with mocked dependencies.
+type managerTestContext struct {
+	t        *testing.T
+	manager  *Manager
+	store    *Store
+	invoices *mockInvoices
+	ticker   *ticker.Force
+	clock    *clock.TestClock
+	events   *subscribe.Client
+}
+
+func newManagerTestContext(t *testing.T, store *Store) *managerTestContext {
+	t.Helper()
+
+	ctx := &managerTestContext{
+		t:        t,
+		store:    store,
+		invoices: newMockInvoices(),
+		ticker:   ticker.NewForce(time.Hour),
+		clock:    clock.NewTestClock(testStartTime),
+	}
+
+	ctx.manager = NewManager(&Config{
+		Store:         store,
+		AddInvoice:    ctx.invoices.addInvoice,
+		LookupInvoice: ctx.invoices.lookupInvoice,
+		CancelInvoice: ctx.invoices.cancelInvoice,
+		SubscribeSettles: func() (<-chan *invoices.Invoice, func(),
+			error) {
+
+			return ctx.invoices.settles, func() {}, nil
+		},
+		PollTicker: ctx.ticker,
+		Clock:      ctx.clock,
+	})
+	require.NoError(t, ctx.manager.Start())
+	t.Cleanup(func() {
+		require.NoError(t, ctx.manager.Stop())
+	})
+
+	events, err := ctx.manager.SubscribeEvents()
+	require.NoError(t, err)
+	t.Cleanup(events.Cancel)
+	ctx.events = events
+
+	return ctx
+}
+
+// poll triggers the periodic update of the subscriptions.
+func (c *managerTestContext) poll() {
+	select {
+	case c.ticker.Force <- c.clock.Now():
+	case <-time.After(testTimeout):
+		c.t.Fatal("poll not triggered")
+	}
+}
+
+// receiveEvent returns the next subscription event.
+func (c *managerTestContext) receiveEvent(eventType EventType) *Event {
+	c.t.Helper()
+
+	select {
+	case update := <-c.events.Updates():
+		event, ok := update.(*Event)
+		require.True(c.t, ok)
+		require.Equal(c.t, eventType, event.Type)
+
+		return event
+
+	case <-time.After(testTimeout):
+		c.t.Fatalf("no %v event received", eventType)
+		return nil
+	}
+}
+
+// TestManager tests that invoices are created for every period and that
+// payments are tracked.
+func TestManager(t *testing.T) {
+	t.Parallel()
+
+	store := newTestStore(t)
+	ctx := newManagerTestContext(t, store)
+
+	// Invalid requests are rejected.
+	_, err := ctx.manager.AddSubscription(&Request{
+		Amount: 1000, Interval: time.Second,
+	})
+	require.Error(t, err)
+
+	// The invoice of the first period is created right away.
+	sub, err := ctx.manager.AddSubscription(&Request{
+		Memo:       "plan",
+		Amount:     1000,
+		Interval:   time.Hour,
+		NumPeriods: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, sub.InvoiceExpiry)
+	require.Len(t, sub.Periods, 1)
+	require.Equal(t, []string{"plan (period 1 of 2)"}, ctx.invoices.memos)
+
+	ctx.receiveEvent(EventCreated)
+	event := ctx.receiveEvent(EventInvoiceCreated)
+	require.Equal(t, sub.Periods[0], *event.Period)
+
+	// Paying the invoice marks the period as paid.
+	hash := sub.Periods[0].PaymentHash
+	ctx.invoices.settles <- ctx.invoices.setState(
+		hash, invoices.ContractSettled,
+	)
+
+	event = ctx.receiveEvent(EventPeriodPaid)
+	require.Equal(t, PeriodPaid, event.Period.Status)
+	require.EqualValues(t, 1000, event.Period.AmountPaid)
+	require.Equal(t, PeriodPaid, event.Subscription.Periods[0].Status)
+
+	// Nothing happens before the next period starts.
+	ctx.poll()
+	ctx.clock.SetTime(testStartTime.Add(time.Hour))
+	ctx.poll()
+
+	event = ctx.receiveEvent(EventInvoiceCreated)
+	require.EqualValues(t, 1, event.Period.Index)
+
+	// The second invoice expires, which completes the subscription as it
+	// only has two periods.
+	ctx.invoices.setState(
+		event.Period.PaymentHash, invoices.ContractCanceled,
+	)
+	ctx.clock.SetTime(testStartTime.Add(3 * time.Hour))
+	ctx.poll()
+
+	ctx.receiveEvent(EventPeriodUnpaid)
+	event = ctx.receiveEvent(EventCompleted)
+	require.Equal(t, StatusCompleted, event.Subscription.Status)
+
+	require.Empty(t, ctx.manager.ListSubscriptions(true))
+	subs := ctx.manager.ListSubscriptions(false)
+	require.Len(t, subs, 1)
+	require.Equal(t, event.Subscription, subs[0])
+
+	// The subscription was persisted.
+	stored, err := store.FetchAll()
+	require.NoError(t, err)
+	require.Equal(t, subs, stored)
+}
+
+// TestManagerCancel tests that canceled subscriptions don't create new
+// invoices and cancel their open invoices, and that periods that ended while
+// we were offline are skipped instead of being invoiced.
+func TestManagerCancel(t *testing.T) {
+	t.Parallel()
+
+	store := newTestStore(t)
+	ctx := newManagerTestContext(t, store)
+
+	// The subscription starts in the future.
+	sub, err := ctx.manager.AddSubscription(&Request{
+		Amount:    1000,
+		Interval:  time.Hour,
+		StartTime: testStartTime.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Empty(t, sub.Periods)
+	ctx.receiveEvent(EventCreated)
+
+	// The periods that already ended are skipped once we poll again,
+	// only the current one is invoiced.
+	ctx.clock.SetTime(testStartTime.Add(3 * time.Hour))
+	ctx.poll()
+
+	for i := 0; i < 2; i++ {
+		event := ctx.receiveEvent(EventPeriodSkipped)
+		require.EqualValues(t, i, event.Period.Index)
+		require.Equal(t, PeriodSkipped, event.Period.Status)
+		require.Empty(t, event.Period.PaymentRequest)
+	}
+	event := ctx.receiveEvent(EventInvoiceCreated)
+	require.EqualValues(t, 2, event.Period.Index)
+	require.Len(t, ctx.invoices.memos, 1)
+
+	require.ErrorIs(
+		t, ctx.manager.CancelSubscription(100), ErrSubscriptionNotFound,
+	)
+	require.NoError(t, ctx.manager.CancelSubscription(sub.ID))
+	require.ErrorIs(
+		t, ctx.manager.CancelSubscription(sub.ID),
+		ErrSubscriptionNotActive,
+	)
+
+	event = ctx.receiveEvent(EventCanceled)
+	require.Equal(t, StatusCanceled, event.Subscription.Status)
+	ctx.receiveEvent(EventPeriodUnpaid)
+
+	// No new invoices are created for the canceled subscription.
+	ctx.clock.SetTime(testStartTime.Add(10 * time.Hour))
+	ctx.poll()
+
+	subs := ctx.manager.ListSubscriptions(false)
+	require.Len(t, subs, 1)
+	require.Len(t, subs[0].Periods, 3)
+	require.Equal(t, StatusCanceled, subs[0].Status)
+	require.Equal(t, PeriodSkipped, subs[0].Periods[0].Status)
+	require.Equal(t, PeriodSkipped, subs[0].Periods[1].Status)
+	require.Equal(t, PeriodUnpaid, subs[0].Periods[2].Status)
+}
@@ -0,0 +1,437 @@
+package subscriptions
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// subscriptionBucketKey is the key of the top-level bucket that holds
+	// all subscriptions.
+	//
+	// maps: id -> Subscription
+	subscriptionBucketKey = []byte("invoice-subscriptions")
+
+	byteOrder = binary.BigEndian
+
+	// ErrSubscriptionNotFound is returned when a subscription with the
+	// given ID does not exist.
+	ErrSubscriptionNotFound = errors.New("subscription not found")
+)
+
+const (
+	// maxStringLen is the maximum length of a serialized memo or payment
+	// request.
+	maxStringLen = 4096
+
+	// maxNumPeriods is the maximum number of periods of a serialized
+	// subscription.
+	maxNumPeriods = 1 << 20
+)
+
+// Status is the status of a subscription.
+type Status uint8
+
+const (
+	// StatusActive indicates that invoices are still created for new
+	// periods.
+	StatusActive Status = 0
+
+	// StatusCanceled indicates that the subscription was canceled before
+	// all of its periods were invoiced.
+	StatusCanceled Status = 1
+
+	// StatusCompleted indicates that all periods of the subscription were
+	// invoiced and their invoices were either paid or expired.
+	StatusCompleted Status = 2
+)
+
+// String returns a human-readable representation of the status.
+func (s Status) String() string {
+	switch s {
+	case StatusActive:
+		return "active"
+
+	case StatusCanceled:
+		return "canceled"
+
+	case StatusCompleted:
+		return "completed"
+
+	default:
+		return fmt.Sprintf("unknown<%d>", uint8(s))
+	}
+}
+
+// PeriodStatus is the payment status of a single subscription period.
+type PeriodStatus uint8
+
+const (
+	// PeriodOpen indicates that the invoice of the period can still be
+	// paid.
+	PeriodOpen PeriodStatus = 0
+
+	// PeriodPaid indicates that the invoice of the period was paid.
+	PeriodPaid PeriodStatus = 1
+
+	// PeriodUnpaid indicates that the invoice of the period expired or was
+	// canceled without being paid.
+	PeriodUnpaid PeriodStatus = 2
+
+	// PeriodSkipped indicates that the period ended before its invoice
+	// could be created, e.g. because we were offline, so it was never
+	// invoiced.
+	PeriodSkipped PeriodStatus = 3
+)
+
+// String returns a human-readable representation of the period status.
+func (s PeriodStatus) String() string {
+	switch s {
+	case PeriodOpen:
+		return "open"
+
+	case PeriodPaid:
+		return "paid"
+
+	case PeriodUnpaid:
+		return "unpaid"
+
+	case PeriodSkipped:
+		return "skipped"
+
+	default:
+		return fmt.Sprintf("unknown<%d>", uint8(s))
+	}
+}
+
+// Period is a single billing period of a subscription.
+type Period struct {
+	// Index is the zero based index of the period.
+	Index uint32
+
+	// PaymentHash is the payment hash of the invoice of the period. It is
+	// not set for skipped periods.
+	PaymentHash lntypes.Hash
+
+	// PaymentRequest is the encoded invoice of the period. It is not set
+	// for skipped periods.
+	PaymentRequest string
+
+	// CreationTime is the time the invoice of the period was created, or
+	// the time the period was skipped.
+	CreationTime time.Time
+
+	// Status is the payment status of the period.
+	Status PeriodStatus
+
+	// SettleTime is the time the invoice of the period was paid. It is
+	// only set for paid periods.
+	SettleTime time.Time
+
+	// AmountPaid is the amount the invoice of the period was paid with.
+	// It is only set for paid periods.
+	AmountPaid lnwire.MilliSatoshi
+}
+
+// Subscription holds the schedule of a subscription and the invoices created
+// for its periods.
+type Subscription struct {
+	// ID is the unique identifier of the subscription.
+	ID uint64
+
+	// Memo is the description of the subscription, which is included in
+	// the memo of every invoice.
+	Memo string
+
+	// Amount is the amount that is invoiced per period.
+	Amount lnwire.MilliSatoshi
+
+	// Interval is the length of a period.
+	Interval time.Duration
+
+	// StartTime is the start of the first period.
+	StartTime time.Time
+
+	// NumPeriods is the total number of periods. Zero means that the
+	// subscription runs until it is canceled.
+	NumPeriods uint32
+
+	// InvoiceExpiry is the expiry of the invoice of every period.
+	InvoiceExpiry time.Duration
+
+	// CreationTime is the time the subscription was created.
+	CreationTime time.Time
+
+	// Status is the current status of the subscription.
+	Status Status
+
+	// Periods are all periods that were invoiced or skipped so far.
+	Periods []Period
+}
+
+// nextPeriodStart returns the start of the first period that wasn't invoiced
+// or skipped yet.
+func (s *Subscription) nextPeriodStart() time.Time {
+	return s.StartTime.Add(time.Duration(len(s.Periods)) * s.Interval)
+}
+
+// allPeriodsInvoiced returns true if invoices were created for all periods,
+// or they were skipped.
+func (s *Subscription) allPeriodsInvoiced() bool {
+	return s.NumPeriods != 0 && len(s.Periods) >= int(s.NumPeriods)
+}
+
+// copy returns a deep copy of the subscription.
+func (s *Subscription) copy() *Subscription {
+	c := *s
+	c.Periods = append([]Period(nil), s.Periods...)
+
+	return &c
+}
+
+// Store persists subscriptions.
+type Store struct {
+	db kvdb.Backend
+}
+
+// NewStore creates a new subscription store, creating its bucket if needed.
+func NewStore(db kvdb.Backend) (*Store, error) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(subscriptionBucketKey)
+		return err
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Add stores a new subscription, assigning it a fresh ID.
+func (s *Store) Add(sub *Subscription) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(subscriptionBucketKey)
+		if bucket == nil {
+			return ErrSubscriptionNotFound
+		}
+
+		id, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		sub.ID = id
+
+		return putSubscription(bucket, sub)
+	}, func() {})
+}
+
+// Update overwrites an existing subscription.
+func (s *Store) Update(sub *Subscription) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(subscriptionBucketKey)
+		if bucket == nil {
+			return ErrSubscriptionNotFound
+		}
+
+		var key [8]byte
+		byteOrder.PutUint64(key[:], sub.ID)
+		if bucket.Get(key[:]) == nil {
+			return ErrSubscriptionNotFound
+		}
+
+		return putSubscription(bucket, sub)
+	}, func() {})
+}
+
+// FetchAll returns all subscriptions ordered by their ID.
+func (s *Store) FetchAll() ([]*Subscription, error) {
+	var subs []*Subscription
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(subscriptionBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			sub, err := deserializeSubscription(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+			sub.ID = byteOrder.Uint64(k)
+			subs = append(subs, sub)
+
+			return nil
+		})
+	}, func() {
+		subs = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subs, nil
+}
+
+// putSubscription serializes the subscription and stores it under its ID.
+func putSubscription(bucket kvdb.RwBucket, sub *Subscription) error {
+	var b bytes.Buffer
+	if err := serializeSubscription(&b, sub); err != nil {
+		return err
+	}
+
+	var key [8]byte
+	byteOrder.PutUint64(key[:], sub.ID)
+
+	return bucket.Put(key[:], b.Bytes())
+}
+
+// serializeSubscription writes the subscription, except its ID which is used
+// as the key, to the given writer.
+func serializeSubscription(w io.Writer, sub *Subscription) error {
+	if len(sub.Memo) > maxStringLen {
+		return fmt.Errorf("memo too long: %d", len(sub.Memo))
+	}
+	if err := wire.WriteVarString(w, 0, sub.Memo); err != nil {
+		return err
+	}
+
+	err := binary.Write(w, byteOrder, []int64{
+		int64(sub.Amount), int64(sub.Interval),
+		sub.StartTime.UnixNano(), int64(sub.InvoiceExpiry),
+		sub.CreationTime.UnixNano(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, sub.NumPeriods); err != nil {
+		return err
+	}
+	if err := binary.Write(w, byteOrder, sub.Status); err != nil {
+		return err
+	}
+
+	if len(sub.Periods) > maxNumPeriods {
+		return fmt.Errorf("too many periods: %d", len(sub.Periods))
+	}
+	err = binary.Write(w, byteOrder, uint32(len(sub.Periods)))
+	if err != nil {
+		return err
+	}
+	for _, p := range sub.Periods {
+		if err := binary.Write(w, byteOrder, p.Index); err != nil {
+			return err
+		}
+		if _, err := w.Write(p.PaymentHash[:]); err != nil {
+			return err
+		}
+
+		if len(p.PaymentRequest) > maxStringLen {
+			return fmt.Errorf("payment request too long: %d",
+				len(p.PaymentRequest))
+		}
+		err := wire.WriteVarString(w, 0, p.PaymentRequest)
+		if err != nil {
+			return err
+		}
+
+		var settleTime int64
+		if !p.SettleTime.IsZero() {
+			settleTime = p.SettleTime.UnixNano()
+		}
+		err = binary.Write(w, byteOrder, []int64{
+			p.CreationTime.UnixNano(), settleTime,
+			int64(p.AmountPaid),
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := binary.Write(w, byteOrder, p.Status); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deserializeSubscription reads a subscription that was written by
+// serializeSubscription.
+func deserializeSubscription(r io.Reader) (*Subscription, error) {
+	var (
+		sub Subscription
+		err error
+	)
+
+	sub.Memo, err = wire.ReadVarString(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var values [5]int64
+	if err := binary.Read(r, byteOrder, &values); err != nil {
+		return nil, err
+	}
+	sub.Amount = lnwire.MilliSatoshi(values[0])
+	sub.Interval = time.Duration(values[1])
+	sub.StartTime = time.Unix(0, values[2])
+	sub.InvoiceExpiry = time.Duration(values[3])
+	sub.CreationTime = time.Unix(0, values[4])
+
+	if err := binary.Read(r, byteOrder, &sub.NumPeriods); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(r, byteOrder, &sub.Status); err != nil {
+		return nil, err
+	}
+
+	var numPeriods uint32
+	if err := binary.Read(r, byteOrder, &numPeriods); err != nil {
+		return nil, err
+	}
+	if numPeriods > maxNumPeriods {
+		return nil, fmt.Errorf("too many periods: %d", numPeriods)
+	}
+
+	for i := uint32(0); i < numPeriods; i++ {
+		var p Period
+		if err := binary.Read(r, byteOrder, &p.Index); err != nil {
+			return nil, err
+		}
+		if _, err := io.ReadFull(r, p.PaymentHash[:]); err != nil {
+			return nil, err
+		}
+
+		p.PaymentRequest, err = wire.ReadVarString(r, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		var values [3]int64
+		if err := binary.Read(r, byteOrder, &values); err != nil {
+			return nil, err
+		}
+		p.CreationTime = time.Unix(0, values[0])
+		if values[1] != 0 {
+			p.SettleTime = time.Unix(0, values[1])
+		}
+		p.AmountPaid = lnwire.MilliSatoshi(values[2])
+
+		if err := binary.Read(r, byteOrder, &p.Status); err != nil {
+			return nil, err
+		}
+
+		sub.Periods = append(sub.Periods, p)
+	}
+
+	return &sub, nil
+}
@@ -172,7 +172,33 @@ type hodlExpiryTest struct {
 	mockNotifier *mockChainNotifier
 	mockClock    *clock.TestClock
 	cancelChan   chan lntypes.Hash
+	settleChan   chan lntypes.Hash
 	watcher      *InvoiceExpiryWatcher
+
+	// preimageKnown indicates whether the preimage of the invoice is
+	// known, which allows auto-settle invoices to be settled.
+	preimageKnown bool
+
+	// preimages is used to notify the watcher of newly learned preimages.
+	preimages chan lntypes.Preimage
+}
+
+// learnPreimage marks the preimage of the invoice as known and notifies the
+// watcher about it.
+func (h *hodlExpiryTest) learnPreimage(t *testing.T,
+	preimage lntypes.Preimage) {
+
+	t.Helper()
+
+	h.stateLock.Lock()
+	h.preimageKnown = true
+	h.stateLock.Unlock()
+
+	select {
+	case h.preimages <- preimage:
+	case <-time.After(testTimeout):
+		t.Fatalf("preimage %v not consumed", preimage)
+	}
 }
 
 func (h *hodlExpiryTest) setState(state ContractState) {
@@ -207,6 +233,34 @@ func (h *hodlExpiryTest) assertCanceled(t *testing.T, expected lntypes.Hash) {
 	}
 }
 
+func (h *hodlExpiryTest) assertSettled(t *testing.T, expected lntypes.Hash) {
+	t.Helper()
+
+	select {
+	case actual := <-h.settleChan:
+		require.Equal(t, expected, actual)
+
+	case <-time.After(testTimeout):
+		t.Fatalf("invoice: %v not settled", h.hash)
+	}
+}
+
+// assertNotResolved asserts that the invoice is neither canceled nor settled
+// within a short time.
+func (h *hodlExpiryTest) assertNotResolved(t *testing.T) {
+	t.Helper()
+
+	select {
+	case <-h.cancelChan:
+		t.Fatalf("invoice: %v unexpectedly canceled", h.hash)
+
+	case <-h.settleChan:
+		t.Fatalf("invoice: %v unexpectedly settled", h.hash)
+
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 // setupHodlExpiry creates a hodl invoice in our expiry watcher and runs an
 // arbitrary update function which advances the invoices's state.
 func setupHodlExpiry(t *testing.T, creationDate time.Time,
@@ -216,6 +270,21 @@ func setupHodlExpiry(t *testing.T, creationDate time.Time,
 
 	t.Helper()
 
+	return setupHodlPolicyExpiry(
+		t, creationDate, expiry, heightDelta, startState, startHtlcs,
+		HoldPolicy{},
+	)
+}
+
+// setupHodlPolicyExpiry creates a hodl invoice with the given hold policy in
+// our expiry watcher.
+func setupHodlPolicyExpiry(t *testing.T, creationDate time.Time,
+	expiry time.Duration, heightDelta uint32,
+	startState ContractState, startHtlcs []*InvoiceHTLC,
+	policy HoldPolicy) *hodlExpiryTest {
+
+	t.Helper()
+
 	mockNotifier := newMockNotifier()
 	mockClock := clock.NewTestClock(testTime)
 
@@ -226,6 +295,8 @@ func setupHodlExpiry(t *testing.T, creationDate time.Time,
 			mockNotifier,
 		),
 		cancelChan:   make(chan lntypes.Hash),
+		settleChan:   make(chan lntypes.Hash),
+		preimages:    make(chan lntypes.Preimage),
 		mockNotifier: mockNotifier,
 		mockClock:    mockClock,
 	}
@@ -249,7 +320,26 @@ func setupHodlExpiry(t *testing.T, creationDate time.Time,
 		return nil
 	}
 
-	require.NoError(t, test.watcher.Start(cancelImpl))
+	settleImpl := func(paymentHash lntypes.Hash) (bool, error) {
+		test.stateLock.Lock()
+		preimageKnown := test.preimageKnown
+		test.stateLock.Unlock()
+
+		if !preimageKnown {
+			return false, nil
+		}
+
+		select {
+		case test.settleChan <- paymentHash:
+		case <-time.After(testTimeout):
+		}
+
+		return true, nil
+	}
+
+	require.NoError(
+		t, test.watcher.Start(cancelImpl, settleImpl, test.preimages),
+	)
 
 	// We set preimage and hash so that we can use our existing test
 	// helpers. In practice we would only have the hash, but this does not
@@ -260,6 +350,7 @@ func setupHodlExpiry(t *testing.T, creationDate time.Time,
 	invoice := newTestInvoice(t, preimage, creationDate, expiry)
 	invoice.State = startState
 	invoice.HodlInvoice = true
+	invoice.HoldPolicy = policy
 	invoice.Htlcs = make(map[CircuitKey]*InvoiceHTLC)
 
 	// If we have any htlcs, add them with unique circult keys.
@@ -273,8 +364,7 @@ func setupHodlExpiry(t *testing.T, creationDate time.Time,
 
 	// Create an expiry entry for our invoice in its starting state. This
 	// mimics adding invoices to the watcher on start.
-	entry := makeInvoiceExpiry(test.hash, invoice)
-	test.watcher.AddInvoices(entry)
+	test.watcher.AddInvoices(makeInvoiceExpiries(test.hash, invoice)...)
 
 	return test
 }
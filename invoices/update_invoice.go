@@ -144,6 +144,14 @@ func UpdateInvoice(hash *lntypes.Hash, invoice *Invoice,
 			return nil, err
 		}
 
+	case PaymentRequestUpdate:
+		err := updatePaymentRequest(
+			invoice, update.PaymentRequest, updater,
+		)
+		if err != nil {
+			return nil, err
+		}
+
 	default:
 		return nil, fmt.Errorf("unknown update type: %s",
 			update.UpdateType)
@@ -840,3 +848,35 @@ func getUpdatedHtlcState(htlc *InvoiceHTLC,
 		return false, htlc.State, errors.New("unknown state transition")
 	}
 }
+
+// updatePaymentRequest replaces the payment request of an open invoice. The
+// payment request can only be replaced while no htlcs were accepted, and only
+// for invoices that had a payment request in the first place.
+func updatePaymentRequest(invoice *Invoice, paymentRequest []byte,
+	updater InvoiceUpdater) error {
+
+	switch {
+	case invoice.State == ContractSettled:
+		return ErrInvoiceAlreadySettled
+
+	case invoice.State == ContractCanceled:
+		return ErrInvoiceAlreadyCanceled
+
+	case invoice.State == ContractAccepted:
+		return ErrInvoiceAlreadyAccepted
+
+	case len(invoice.PaymentRequest) == 0:
+		return errors.New("invoice has no payment request to replace")
+
+	case len(paymentRequest) == 0:
+		return errors.New("new payment request must not be empty")
+	}
+
+	err := updater.UpdatePaymentRequest(paymentRequest)
+	if err != nil {
+		return err
+	}
+	invoice.PaymentRequest = paymentRequest
+
+	return nil
+}
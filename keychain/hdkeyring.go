@@ -0,0 +1,125 @@
+package keychain
+
+import (
+	"errors"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/v2/hdkeychain"
+)
+
+// ErrNextKeyUnsupported is returned by HDKeyRing.DeriveNextKey as the key ring
+// doesn't keep track of any derivation state.
+var ErrNextKeyUnsupported = errors.New("deriving the next key is not " +
+	"supported by a static HD key ring")
+
+// HDKeyRing is an implementation of the KeyRing interface that derives all
+// keys directly from an extended master root key, following the exact same
+// derivation path as the BtcWalletKeyRing: m/1017'/coinType'/keyFamily'/0/index.
+// As it doesn't require an unlocked wallet, it can be used by offline tools to
+// re-derive keys from a seed, e.g. to inspect or decrypt static channel
+// backups.
+type HDKeyRing struct {
+	// coinTypeKey is the extended key at the m/1017'/coinType' level that
+	// all key families are derived from.
+	coinTypeKey *hdkeychain.ExtendedKey
+}
+
+// A compile time check to ensure HDKeyRing implements the KeyRing interface.
+var _ KeyRing = (*HDKeyRing)(nil)
+
+// NewHDKeyRing creates a new HDKeyRing from the passed extended master root
+// key and the coin type of the target chain.
+func NewHDKeyRing(rootKey *hdkeychain.ExtendedKey,
+	coinType uint32) (*HDKeyRing, error) {
+
+	purposeKey, err := rootKey.DeriveNonStandard( //nolint:staticcheck
+		hdkeychain.HardenedKeyStart + BIP0043Purpose,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	coinTypeKey, err := purposeKey.DeriveNonStandard( //nolint:staticcheck
+		hdkeychain.HardenedKeyStart + coinType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HDKeyRing{
+		coinTypeKey: coinTypeKey,
+	}, nil
+}
+
+// deriveExtendedKey derives the extended key of the external branch at the
+// passed key locator.
+func (h *HDKeyRing) deriveExtendedKey(
+	keyLoc KeyLocator) (*hdkeychain.ExtendedKey, error) {
+
+	familyKey, err := h.coinTypeKey.DeriveNonStandard( //nolint:staticcheck
+		hdkeychain.HardenedKeyStart + uint32(keyLoc.Family),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	branchKey, err := familyKey.DeriveNonStandard(0) //nolint:staticcheck
+	if err != nil {
+		return nil, err
+	}
+
+	return branchKey.DeriveNonStandard(keyLoc.Index) //nolint:staticcheck
+}
+
+// DeriveNextKey is not supported by the HDKeyRing, as it doesn't track the
+// last used index of each key family.
+//
+// NOTE: This is part of the keychain.KeyRing interface.
+func (h *HDKeyRing) DeriveNextKey(KeyFamily) (KeyDescriptor, error) {
+	return KeyDescriptor{}, ErrNextKeyUnsupported
+}
+
+// DeriveKey derives the public key specified by the passed KeyLocator.
+//
+// NOTE: This is part of the keychain.KeyRing interface.
+func (h *HDKeyRing) DeriveKey(keyLoc KeyLocator) (KeyDescriptor, error) {
+	key, err := h.deriveExtendedKey(keyLoc)
+	if err != nil {
+		return KeyDescriptor{}, err
+	}
+
+	pubKey, err := key.ECPubKey()
+	if err != nil {
+		return KeyDescriptor{}, err
+	}
+
+	return KeyDescriptor{
+		KeyLocator: keyLoc,
+		PubKey:     pubKey,
+	}, nil
+}
+
+// DerivePrivKey derives the private key at the key locator of the passed key
+// descriptor. Unlike the BtcWalletKeyRing, no scan is performed if only the
+// public key is known.
+func (h *HDKeyRing) DerivePrivKey(keyDesc KeyDescriptor) (*btcec.PrivateKey,
+	error) {
+
+	key, err := h.deriveExtendedKey(keyDesc.KeyLocator)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := key.ECPrivKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// If the caller specified a public key, make sure it matches the one
+	// we derived.
+	if keyDesc.PubKey != nil && !keyDesc.PubKey.IsEqual(privKey.PubKey()) {
+		return nil, ErrCannotDerivePrivKey
+	}
+
+	return privKey, nil
+}
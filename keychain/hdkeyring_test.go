@@ -0,0 +1,64 @@
+package keychain
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/v2/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHDKeyRingMatchesBtcWallet tests that the HDKeyRing derives the exact
+// same keys as the btcwallet backed key ring for the same seed.
+func TestHDKeyRingMatchesBtcWallet(t *testing.T) {
+	t.Parallel()
+
+	wallet, err := createTestBtcWallet(t, CoinTypeTestnet)
+	require.NoError(t, err)
+
+	walletKeyRing := NewBtcWalletKeyRing(wallet, CoinTypeTestnet)
+
+	rootKey, err := hdkeychain.NewMaster(
+		testHDSeed[:], &chaincfg.SimNetParams,
+	)
+	require.NoError(t, err)
+
+	hdKeyRing, err := NewHDKeyRing(rootKey, CoinTypeTestnet)
+	require.NoError(t, err)
+
+	// The HD key ring doesn't keep any state, so it can't derive the next
+	// key of a family.
+	_, err = hdKeyRing.DeriveNextKey(KeyFamilyMultiSig)
+	require.ErrorIs(t, err, ErrNextKeyUnsupported)
+
+	for _, keyFam := range VersionZeroKeyFamilies {
+		for index := uint32(0); index < 3; index++ {
+			keyLoc := KeyLocator{
+				Family: keyFam,
+				Index:  index,
+			}
+
+			expected, err := walletKeyRing.DeriveKey(keyLoc)
+			require.NoError(t, err)
+
+			keyDesc, err := hdKeyRing.DeriveKey(keyLoc)
+			require.NoError(t, err)
+			require.Equal(t, keyLoc, keyDesc.KeyLocator)
+			require.True(t, expected.PubKey.IsEqual(keyDesc.PubKey))
+
+			privKey, err := hdKeyRing.DerivePrivKey(keyDesc)
+			require.NoError(t, err)
+			require.True(t, privKey.PubKey().IsEqual(keyDesc.PubKey))
+		}
+	}
+
+	// A mismatching public key should result in an error when deriving
+	// the private key.
+	keyDesc, err := hdKeyRing.DeriveKey(KeyLocator{
+		Family: KeyFamilyNodeKey,
+	})
+	require.NoError(t, err)
+	keyDesc.Index = 1
+	_, err = hdKeyRing.DerivePrivKey(keyDesc)
+	require.ErrorIs(t, err, ErrCannotDerivePrivKey)
+}
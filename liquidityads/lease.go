@@ -0,0 +1,173 @@
+package liquidityads
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/v2"
+	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// LeaseDuration is the number of blocks after the block height of a
+	// lease request until which the funds of the seller are locked in the
+	// leased channel.
+	LeaseDuration = 4032
+
+	// MaxHeightDelta is the maximum number of blocks the block height of
+	// a lease request may differ from the block height of the seller.
+	MaxHeightDelta = 2
+
+	// basisPoints is the number of basis points in a whole.
+	basisPoints = 10_000
+)
+
+var (
+	// leaseTag is prepended to the signed digest of a lease to separate
+	// lease signatures from any other message signed with the node key.
+	leaseTag = []byte("option_will_fund")
+
+	// ErrInvalidLeaseSignature is returned when a lease offer wasn't
+	// signed by the seller.
+	ErrInvalidLeaseSignature = errors.New("lease not signed by seller")
+)
+
+// LeaseFee returns the fee charged for leasing the given amount at the given
+// rates, with the funding transaction paying the given fee rate.
+func LeaseFee(rates *lnwire.LeaseRates, amt btcutil.Amount,
+	fundingFeeRate chainfee.SatPerKWeight) btcutil.Amount {
+
+	baseFee := btcutil.Amount(rates.LeaseFeeBaseSat)
+	proportionalFee := amt * btcutil.Amount(rates.LeaseFeeBasis) /
+		basisPoints
+	fundingFee := fundingFeeRate.FeeForWeight(
+		lntypes.WeightUnit(rates.FundingWeight),
+	)
+
+	return baseFee + proportionalFee + fundingFee
+}
+
+// Lease describes a lease of inbound liquidity.
+type Lease struct {
+	// Amount is the leased amount, which the seller contributes to the
+	// funding output of the leased channel.
+	Amount btcutil.Amount
+
+	// LeaseExpiry is the absolute height until which the funds of the
+	// seller are locked in the leased channel.
+	LeaseExpiry uint32
+
+	// LeaseFee is the fee the buyer pays to the seller for the lease. It
+	// is deducted from the buyer's initial channel balance.
+	LeaseFee btcutil.Amount
+
+	// Rates are the rates the lease is priced at. They cap the routing
+	// fees the seller charges on the channel for the duration of the
+	// lease.
+	Rates lnwire.LeaseRates
+}
+
+// leaseMessage returns the message that the seller signs to commit to the
+// lease of the buyer with the given funding key.
+func leaseMessage(fundingKey *btcec.PublicKey, leaseExpiry uint32,
+	rates *lnwire.LeaseRates) ([]byte, error) {
+
+	var b bytes.Buffer
+	b.Write(leaseTag)
+	b.Write(fundingKey.SerializeCompressed())
+
+	if err := lnwire.WriteUint32(&b, leaseExpiry); err != nil {
+		return nil, err
+	}
+
+	err := lnwire.WriteUint32(&b, rates.ChannelFeeMaxBaseMsat)
+	if err != nil {
+		return nil, err
+	}
+
+	err = lnwire.WriteUint16(&b, rates.ChannelFeeMaxProportional)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// SignLease signs the lease of the buyer with the given funding key with the
+// node key of the seller.
+func SignLease(signer lnwallet.MessageSigner, keyLoc keychain.KeyLocator,
+	fundingKey *btcec.PublicKey, leaseExpiry uint32,
+	rates *lnwire.LeaseRates) (lnwire.Sig, error) {
+
+	msg, err := leaseMessage(fundingKey, leaseExpiry, rates)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	sig, err := signer.SignMessage(keyLoc, msg, false)
+	if err != nil {
+		return lnwire.Sig{}, fmt.Errorf("unable to sign lease: %w", err)
+	}
+
+	return lnwire.NewSigFromSignature(sig)
+}
+
+// VerifyLease checks that the given will_fund record was signed by the seller
+// for the buyer with the given funding key and lease expiry.
+func VerifyLease(seller, fundingKey *btcec.PublicKey, leaseExpiry uint32,
+	willFund *lnwire.WillFund) error {
+
+	msg, err := leaseMessage(fundingKey, leaseExpiry, &willFund.LeaseRates)
+	if err != nil {
+		return err
+	}
+
+	sig, err := willFund.Signature.ToSignature()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidLeaseSignature, err)
+	}
+
+	if !sig.Verify(chainhash.HashB(msg), seller) {
+		return ErrInvalidLeaseSignature
+	}
+
+	return nil
+}
+
+// AcceptLease checks that the will_fund record of the seller commits to the
+// lease the buyer with the given funding key requested at the rates the seller
+// advertises, and returns the lease.
+func AcceptLease(seller, fundingKey *btcec.PublicKey,
+	req *lnwire.RequestFunds, rates *lnwire.LeaseRates,
+	fundingFeeRate chainfee.SatPerKWeight,
+	willFund *lnwire.WillFund) (*Lease, error) {
+
+	if willFund == nil {
+		return nil, errors.New("seller didn't commit to the lease")
+	}
+
+	if willFund.LeaseRates != *rates {
+		return nil, fmt.Errorf("lease rates %+v don't match "+
+			"advertised rates %+v", willFund.LeaseRates, *rates)
+	}
+
+	leaseExpiry := req.BlockHeight + LeaseDuration
+	err := VerifyLease(seller, fundingKey, leaseExpiry, willFund)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Lease{
+		Amount:      req.RequestedSats,
+		LeaseExpiry: leaseExpiry,
+		LeaseFee:    LeaseFee(rates, req.RequestedSats, fundingFeeRate),
+		Rates:       *rates,
+	}, nil
+}
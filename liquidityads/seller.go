@@ -0,0 +1,126 @@
+package liquidityads
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// Config holds the terms at which the seller leases out inbound liquidity.
+type Config struct {
+	// Rates are the lease rates we advertise.
+	Rates lnwire.LeaseRates
+
+	// MinLeaseAmt is the smallest amount we lease out.
+	MinLeaseAmt btcutil.Amount
+
+	// MaxLeaseAmt is the largest amount we lease out. Zero means no
+	// limit.
+	MaxLeaseAmt btcutil.Amount
+
+	// MaxFundingFeeRate is the highest funding fee rate of a leased
+	// channel we contribute funds to. Zero means no limit.
+	MaxFundingFeeRate chainfee.SatPerKWeight
+
+	// Signer signs leases with the node key.
+	Signer lnwallet.MessageSigner
+
+	// KeyLoc is the key locator of the node key.
+	KeyLoc keychain.KeyLocator
+}
+
+// Seller leases out inbound liquidity. It advertises its lease rates in its
+// node announcement. A buyer requests funds when opening a dual funded channel
+// to the seller, who then contributes the requested amount to the funding
+// output and commits to the lease by signing it. The buyer pays the lease fee
+// out of its initial channel balance, and the funds of the seller are locked
+// in the channel until the lease expires.
+type Seller struct {
+	cfg *Config
+}
+
+// NewSeller creates a new seller of inbound liquidity.
+func NewSeller(cfg *Config) *Seller {
+	return &Seller{
+		cfg: cfg,
+	}
+}
+
+// validateRequest checks that we are willing to fulfill the lease request at
+// the given funding fee rate and block height.
+func (s *Seller) validateRequest(req *lnwire.RequestFunds,
+	feeRate chainfee.SatPerKWeight, height uint32) error {
+
+	switch {
+	case req.RequestedSats < s.cfg.MinLeaseAmt:
+		return fmt.Errorf("amount below minimum of %v",
+			s.cfg.MinLeaseAmt)
+
+	case s.cfg.MaxLeaseAmt != 0 && req.RequestedSats > s.cfg.MaxLeaseAmt:
+		return fmt.Errorf("amount above maximum of %v",
+			s.cfg.MaxLeaseAmt)
+
+	case feeRate < chainfee.FeePerKwFloor:
+		return fmt.Errorf("funding fee rate below minimum of %v",
+			chainfee.FeePerKwFloor)
+
+	case s.cfg.MaxFundingFeeRate != 0 && feeRate > s.cfg.MaxFundingFeeRate:
+		return fmt.Errorf("funding fee rate above maximum of %v",
+			s.cfg.MaxFundingFeeRate)
+
+	case req.BlockHeight+MaxHeightDelta < height ||
+		req.BlockHeight > height+MaxHeightDelta:
+
+		return fmt.Errorf("block height %d too far from %d",
+			req.BlockHeight, height)
+	}
+
+	return nil
+}
+
+// Sell checks that we are willing to fulfill the lease request of the buyer
+// with the given funding key, and returns the lease along with the will_fund
+// record that commits us to it.
+func (s *Seller) Sell(req *lnwire.RequestFunds, fundingKey *btcec.PublicKey,
+	fundingFeeRate chainfee.SatPerKWeight, height uint32) (*Lease,
+	*lnwire.WillFund, error) {
+
+	if req == nil {
+		return nil, nil, errors.New("no lease requested")
+	}
+
+	err := s.validateRequest(req, fundingFeeRate, height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rates := s.cfg.Rates
+	lease := &Lease{
+		Amount:      req.RequestedSats,
+		LeaseExpiry: req.BlockHeight + LeaseDuration,
+		LeaseFee:    LeaseFee(&rates, req.RequestedSats, fundingFeeRate),
+		Rates:       rates,
+	}
+
+	sig, err := SignLease(
+		s.cfg.Signer, s.cfg.KeyLoc, fundingKey, lease.LeaseExpiry,
+		&rates,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Infof("Leasing out %v until height %d for a fee of %v",
+		lease.Amount, lease.LeaseExpiry, lease.LeaseFee)
+
+	return lease, &lnwire.WillFund{
+		Signature:  sig,
+		LeaseRates: rates,
+	}, nil
+}
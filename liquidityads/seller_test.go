@@ -0,0 +1,229 @@
+package liquidityads
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntest/mock"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testHeight = 800_000
+
+	testFeeRate = chainfee.SatPerKWeight(1000)
+)
+
+var testRates = lnwire.LeaseRates{
+	FundingWeight:             444,
+	LeaseFeeBasis:             100,
+	ChannelFeeMaxProportional: 1000,
+	LeaseFeeBaseSat:           1000,
+	ChannelFeeMaxBaseMsat:     2000,
+}
+
+// newKey creates a new private key.
+func newKey(t *testing.T) *btcec.PrivateKey {
+	t.Helper()
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return key
+}
+
+// newTestSeller creates a seller with the test rates that signs with the
+// given key.
+func newTestSeller(key *btcec.PrivateKey) *Seller {
+	return NewSeller(&Config{
+		Rates:             testRates,
+		MinLeaseAmt:       100_000,
+		MaxLeaseAmt:       1_000_000,
+		MaxFundingFeeRate: 10_000,
+		Signer:            &mock.SecretKeyRing{RootKey: key},
+	})
+}
+
+// TestLeaseFee tests that the lease fee is made up of the base fee, the
+// proportional fee and the funding fee.
+func TestLeaseFee(t *testing.T) {
+	t.Parallel()
+
+	// 1000 sat base fee, 1% of 1M sat and 444 weight units at 1000 sat/kw.
+	fee := LeaseFee(&testRates, 1_000_000, testFeeRate)
+	require.Equal(t, btcutil.Amount(1000+10_000+444), fee)
+}
+
+// TestSignVerifyLease tests that a lease only verifies for the funding key of
+// the buyer and the terms it was signed for.
+func TestSignVerifyLease(t *testing.T) {
+	t.Parallel()
+
+	sellerKey, fundingKey := newKey(t), newKey(t)
+	signer := &mock.SecretKeyRing{RootKey: sellerKey}
+
+	sig, err := SignLease(
+		signer, keychain.KeyLocator{}, fundingKey.PubKey(), testHeight,
+		&testRates,
+	)
+	require.NoError(t, err)
+
+	willFund := &lnwire.WillFund{
+		Signature:  sig,
+		LeaseRates: testRates,
+	}
+	require.NoError(t, VerifyLease(
+		sellerKey.PubKey(), fundingKey.PubKey(), testHeight, willFund,
+	))
+
+	// A different funding key doesn't verify.
+	require.ErrorIs(t, VerifyLease(
+		sellerKey.PubKey(), newKey(t).PubKey(), testHeight, willFund,
+	), ErrInvalidLeaseSignature)
+
+	// A different expiry doesn't verify.
+	require.ErrorIs(t, VerifyLease(
+		sellerKey.PubKey(), fundingKey.PubKey(), testHeight+1,
+		willFund,
+	), ErrInvalidLeaseSignature)
+
+	// Higher routing fees don't verify.
+	willFund.LeaseRates.ChannelFeeMaxBaseMsat++
+	require.ErrorIs(t, VerifyLease(
+		sellerKey.PubKey(), fundingKey.PubKey(), testHeight, willFund,
+	), ErrInvalidLeaseSignature)
+}
+
+// TestSellValidation tests that a seller only accepts lease requests within
+// its limits.
+func TestSellValidation(t *testing.T) {
+	t.Parallel()
+
+	s := newTestSeller(newKey(t))
+	fundingKey := newKey(t).PubKey()
+
+	valid := lnwire.RequestFunds{
+		RequestedSats: 500_000,
+		BlockHeight:   testHeight,
+	}
+	_, _, err := s.Sell(&valid, fundingKey, testFeeRate, testHeight)
+	require.NoError(t, err)
+	_, _, err = s.Sell(
+		&valid, fundingKey, testFeeRate, testHeight+MaxHeightDelta,
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		modify  func(req *lnwire.RequestFunds)
+		feeRate chainfee.SatPerKWeight
+	}{
+		{
+			name: "below minimum",
+			modify: func(req *lnwire.RequestFunds) {
+				req.RequestedSats = 99_999
+			},
+			feeRate: testFeeRate,
+		},
+		{
+			name: "above maximum",
+			modify: func(req *lnwire.RequestFunds) {
+				req.RequestedSats = 1_000_001
+			},
+			feeRate: testFeeRate,
+		},
+		{
+			name:    "fee rate below floor",
+			modify:  func(req *lnwire.RequestFunds) {},
+			feeRate: 252,
+		},
+		{
+			name:    "fee rate above maximum",
+			modify:  func(req *lnwire.RequestFunds) {},
+			feeRate: 10_001,
+		},
+		{
+			name: "height too low",
+			modify: func(req *lnwire.RequestFunds) {
+				req.BlockHeight = testHeight -
+					MaxHeightDelta - 1
+			},
+			feeRate: testFeeRate,
+		},
+		{
+			name: "height too high",
+			modify: func(req *lnwire.RequestFunds) {
+				req.BlockHeight = testHeight +
+					MaxHeightDelta + 1
+			},
+			feeRate: testFeeRate,
+		},
+	}
+
+	for _, test := range tests {
+		req := valid
+		test.modify(&req)
+		_, _, err := s.Sell(&req, fundingKey, test.feeRate, testHeight)
+		require.Error(t, err, test.name)
+	}
+
+	// Without a request, there is nothing to sell.
+	_, _, err = s.Sell(nil, fundingKey, testFeeRate, testHeight)
+	require.Error(t, err)
+}
+
+// TestSellAcceptLease tests that the buyer accepts the lease the seller
+// committed to, as long as it was signed for the buyer's funding key at the
+// advertised rates.
+func TestSellAcceptLease(t *testing.T) {
+	t.Parallel()
+
+	sellerKey, fundingKey := newKey(t), newKey(t).PubKey()
+	s := newTestSeller(sellerKey)
+
+	req := &lnwire.RequestFunds{
+		RequestedSats: 500_000,
+		BlockHeight:   testHeight,
+	}
+	sold, willFund, err := s.Sell(req, fundingKey, testFeeRate, testHeight)
+	require.NoError(t, err)
+	require.Equal(t, uint32(testHeight+LeaseDuration), sold.LeaseExpiry)
+	require.Equal(
+		t, LeaseFee(&testRates, req.RequestedSats, testFeeRate),
+		sold.LeaseFee,
+	)
+
+	bought, err := AcceptLease(
+		sellerKey.PubKey(), fundingKey, req, &testRates, testFeeRate,
+		willFund,
+	)
+	require.NoError(t, err)
+	require.Equal(t, sold, bought)
+
+	// The seller must commit to the lease.
+	_, err = AcceptLease(
+		sellerKey.PubKey(), fundingKey, req, &testRates, testFeeRate,
+		nil,
+	)
+	require.Error(t, err)
+
+	// The lease must be signed by the seller.
+	_, err = AcceptLease(
+		newKey(t).PubKey(), fundingKey, req, &testRates, testFeeRate,
+		willFund,
+	)
+	require.ErrorIs(t, err, ErrInvalidLeaseSignature)
+
+	// The rates must match the advertised rates.
+	advertised := testRates
+	advertised.LeaseFeeBasis--
+	_, err = AcceptLease(
+		sellerKey.PubKey(), fundingKey, req, &advertised, testFeeRate,
+		willFund,
+	)
+	require.Error(t, err)
+}
@@ -26,6 +26,24 @@ type Htlcswitch struct {
 	MailboxDeliveryTimeout time.Duration `long:"mailboxdeliverytimeout" description:"The timeout value when delivering HTLCs to a channel link. Setting this value too small will result in local payment failures if large number of payments are sent over a short period."`
 
 	QuiescenceTimeout time.Duration `long:"quiescencetimeout" description:"The max duration that the channel can be quiesced. Any dependent protocols (dynamic commitments, splicing, etc.) must finish their operations under this timeout value, otherwise the node will disconnect."`
+
+	ResourceBucketing bool `long:"resourcebucketing" description:"If set, the slots and liquidity of each outgoing channel are split into a general bucket shared by all forwarded HTLCs and a protected bucket reserved for accountable HTLCs of peers with a good reputation. HTLCs are failed with temporary_channel_failure once the resources available to them are exhausted."`
+
+	ProtectedSlotPercent uint8 `long:"protectedslotpercent" description:"The percentage of the HTLC slots of each outgoing channel that is reserved for accountable HTLCs of reputable peers if resource bucketing is enabled."`
+
+	ProtectedLiquidityPercent uint8 `long:"protectedliquiditypercent" description:"The percentage of the in-flight liquidity of each outgoing channel that is reserved for accountable HTLCs of reputable peers if resource bucketing is enabled."`
+
+	RevenueWindow time.Duration `long:"revenuewindow" description:"The window over which the forwarding revenue of a channel is averaged. A peer is reputable towards an outgoing channel if its reputation exceeds the revenue of the channel."`
+
+	ReputationWindow time.Duration `long:"reputationwindow" description:"The window over which the reputation of a peer is averaged. Must not be shorter than the revenue window."`
+
+	ResolutionPeriod time.Duration `long:"resolutionperiod" description:"The time a well behaved HTLC is expected to be resolved in. Accountable HTLCs that are held longer reduce the reputation of the peer that offered them."`
+
+	PeerMaxHtlcs uint32 `long:"peermaxhtlcs" description:"The maximum number of HTLCs each peer may have us forward at the same time, across all of its channels. A value of 0 disables the limit."`
+
+	PeerMaxInFlightMsat uint64 `long:"peermaxinflightmsat" description:"The maximum total value in millisatoshis of the HTLCs each peer may have us forward at the same time, across all of its channels. A value of 0 disables the limit."`
+
+	PeerMinHtlcMsat uint64 `long:"peerminhtlcmsat" description:"The minimum amount in millisatoshis of the HTLCs each peer may have us forward. A value of 0 disables the limit."`
 }
 
 // Validate checks the values configured for htlcswitch.
@@ -40,6 +58,30 @@ func (h *Htlcswitch) Validate() error {
 			MaxMailboxDeliveryTimeout)
 	}
 
+	if h.ProtectedSlotPercent > 100 {
+		return fmt.Errorf("protectedslotpercent: %d exceeds 100",
+			h.ProtectedSlotPercent)
+	}
+
+	if h.ProtectedLiquidityPercent > 100 {
+		return fmt.Errorf("protectedliquiditypercent: %d exceeds 100",
+			h.ProtectedLiquidityPercent)
+	}
+
+	if h.RevenueWindow <= 0 {
+		return fmt.Errorf("revenuewindow must be positive")
+	}
+
+	if h.ReputationWindow < h.RevenueWindow {
+		return fmt.Errorf("reputationwindow: %v is shorter than "+
+			"revenuewindow: %v", h.ReputationWindow,
+			h.RevenueWindow)
+	}
+
+	if h.ResolutionPeriod <= 0 {
+		return fmt.Errorf("resolutionperiod must be positive")
+	}
+
 	// Skip the validation for integration tests so we can use a smaller
 	// timeout value to check the timeout behavior.
 	if !IsDevBuild() && h.QuiescenceTimeout < minQuiescenceTimeout {
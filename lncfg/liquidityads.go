@@ -0,0 +1,75 @@
+package lncfg
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcutil/v2"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// DefaultLeaseFundingWeight is the default weight of the inputs and
+	// outputs we add to the funding transaction of a leased channel, which
+	// is the weight of a p2tr input and a p2tr change output.
+	DefaultLeaseFundingWeight = 444
+
+	// DefaultMinLeaseAmt is the default smallest amount we lease out.
+	DefaultMinLeaseAmt btcutil.Amount = 100_000
+
+	// DefaultLeaseMaxFeeRate is the default highest funding fee rate in
+	// sat/vb a buyer may fund a leased channel with.
+	DefaultLeaseMaxFeeRate chainfee.SatPerVByte = 100
+)
+
+// LiquidityAds holds the configuration of the rates at which we lease out
+// inbound liquidity.
+//
+//nolint:ll
+type LiquidityAds struct {
+	Active                    bool                 `long:"active" description:"If true, the lease rates are advertised in our node announcement and peers can buy inbound liquidity from us. Leased channels lock our funds until the lease expires."`
+	LeaseFeeBase              btcutil.Amount       `long:"lease-fee-base" description:"The base fee in satoshis charged for a lease."`
+	LeaseFeeBasis             uint16               `long:"lease-fee-basis" description:"The fee charged for a lease in basis points of the leased amount."`
+	FundingWeight             uint16               `long:"funding-weight" description:"The weight of the inputs and outputs we add to the funding transaction of a leased channel, which the buyer pays for at the requested fee rate."`
+	ChannelFeeMaxBaseMsat     uint32               `long:"channel-fee-max-base-msat" description:"The maximum base routing fee in msat we charge on a leased channel while the lease is active."`
+	ChannelFeeMaxProportional uint16               `long:"channel-fee-max-proportional" description:"The maximum proportional routing fee in millionths we charge on a leased channel while the lease is active."`
+	MinLeaseAmt               btcutil.Amount       `long:"min-lease-amt" description:"The smallest amount in satoshis we lease out."`
+	MaxLeaseAmt               btcutil.Amount       `long:"max-lease-amt" description:"The largest amount in satoshis we lease out. Zero means no limit other than the maximum channel size."`
+	MaxFeeRate                chainfee.SatPerVByte `long:"max-fee-rate" description:"The highest funding fee rate in sat/vb a buyer may fund a leased channel with."`
+}
+
+// DefaultLiquidityAds returns the default configuration of the lease rates.
+func DefaultLiquidityAds() *LiquidityAds {
+	return &LiquidityAds{
+		FundingWeight: DefaultLeaseFundingWeight,
+		MinLeaseAmt:   DefaultMinLeaseAmt,
+		MaxFeeRate:    DefaultLeaseMaxFeeRate,
+	}
+}
+
+// Validate checks the values configured for the lease rates.
+func (l *LiquidityAds) Validate() error {
+	if !l.Active {
+		return nil
+	}
+
+	if l.LeaseFeeBase < 0 || l.LeaseFeeBase > math.MaxUint32 {
+		return fmt.Errorf("lease-fee-base must be between 0 and %d",
+			uint32(math.MaxUint32))
+	}
+
+	if l.MinLeaseAmt <= 0 {
+		return fmt.Errorf("min-lease-amt must be positive")
+	}
+
+	if l.MaxLeaseAmt != 0 && l.MaxLeaseAmt < l.MinLeaseAmt {
+		return fmt.Errorf("max-lease-amt must not be below " +
+			"min-lease-amt")
+	}
+
+	if l.MaxFeeRate < 1 {
+		return fmt.Errorf("max-fee-rate must be >= 1 sat/vb")
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package lncfg
+
+import "fmt"
+
+// PeerManager holds the configuration of the persistent peer connections and
+// the inbound connection slots.
+//
+//nolint:ll
+type PeerManager struct {
+	MaxAttempts       uint32 `long:"max-attempts" description:"The number of consecutive failed connection attempts after which we stop reconnecting to a persistent peer, unless its policy sets a different limit. A value of 0 means that we never stop."`
+	MaxInboundPeers   uint64 `long:"max-inbound-peers" description:"The number of inbound peer connections, counting peers with channels, above which we reject inbound connections from other peers. Peers with channels and high priority peers are always accepted. A value of 0 disables the limit, in which case only num-restricted-slots applies."`
+	ReservedChanSlots uint64 `long:"reserved-channel-slots" description:"The number of the max-inbound-peers slots that are reserved for peers with channels and high priority peers. Inbound connections from other peers are rejected once only the reserved slots are left."`
+}
+
+// Validate checks the values configured for the peer manager.
+func (p *PeerManager) Validate() error {
+	if p.MaxInboundPeers == 0 && p.ReservedChanSlots != 0 {
+		return fmt.Errorf("reserved-channel-slots requires " +
+			"max-inbound-peers to be set")
+	}
+
+	if p.ReservedChanSlots > p.MaxInboundPeers {
+		return fmt.Errorf("reserved-channel-slots must not exceed " +
+			"max-inbound-peers")
+	}
+
+	return nil
+}
@@ -45,6 +45,27 @@ type ProtocolOptions struct {
 	// the new experimental RBF coop close feature.
 	RbfCoopClose bool `long:"rbf-coop-close" description:"if set, then lnd will signal that it supports the new RBF based coop close protocol"`
 
+	// TrampolineRouting should be set if we want to signal that we are
+	// willing to forward trampoline payments.
+	TrampolineRouting bool `long:"trampoline-routing" description:"if set, then lnd will signal that it forwards trampoline payments and will find routes on behalf of trampoline senders"`
+
+	// DualFund should be set if we want to signal that we support the v2
+	// channel establishment protocol with dual-funded channels.
+	DualFund bool `long:"dual-fund" description:"if set, then lnd will signal that it supports dual-funded channels and will accept and open channels using the interactive funding transaction construction protocol"`
+
+	// UpfrontFees should be set if we want to signal that we support the
+	// experimental unconditional upfront htlc fees.
+	UpfrontFees bool `long:"upfront-fees" description:"if set, then lnd will signal that it supports experimental upfront htlc fees, attach an unconditional fee to each hop of payments over routes that support them and collect such fees when forwarding"`
+
+	// PeerStorage should be set if we want to store backup blobs on behalf
+	// of our channel peers and upload our own channel backups to peers
+	// that store them.
+	PeerStorage bool `long:"peer-storage" description:"if set, then lnd will signal option_provide_storage, store an encrypted backup blob for each of its channel peers, upload its own encrypted channel backups to peers that provide storage and restore channels from the backups they return"`
+
+	// ZeroFeeCommitments should be set if we want to enable support for
+	// channels using zero-fee v3 commitment transactions.
+	ZeroFeeCommitments bool `long:"zero-fee-commitments" description:"if set, then lnd will create and accept requests for channels using the zero-fee commitment type, whose v3 commitment transactions pay no fee and are bumped through a single shared anchor output"`
+
 	// ScriptEnforcedLease enables script enforced commitments for channel
 	// leases.
 	//
@@ -1,6 +1,70 @@
 package lncfg
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultDynamicFeesInterval is the default interval at which the
+	// dynamic fee policy engine recomputes channel fees.
+	DefaultDynamicFeesInterval = time.Hour
+
+	// DefaultDynamicFeesBaseFeeMsat is the default base fee set by the
+	// dynamic fee policy engine.
+	DefaultDynamicFeesBaseFeeMsat = 1000
+
+	// DefaultDynamicFeesFeeRateCurve is the default curve mapping the
+	// local balance ratio of a channel to its outgoing fee rate in ppm.
+	DefaultDynamicFeesFeeRateCurve = "0:2000,0.5:500,1:100"
+
+	// DefaultDynamicFeesVolumeWindow is the default time window of the
+	// forwarding log considered by the dynamic fee policy engine.
+	DefaultDynamicFeesVolumeWindow = 7 * 24 * time.Hour
+
+	// DefaultTrampolineFeeBaseMsat is the default base fee required for
+	// forwarding a trampoline payment.
+	DefaultTrampolineFeeBaseMsat = 1000
+
+	// DefaultTrampolineFeeRatePpm is the default proportional fee required
+	// for forwarding a trampoline payment.
+	DefaultTrampolineFeeRatePpm = 500
+
+	// DefaultTrampolineCltvDelta is the default cltv delta required for
+	// forwarding a trampoline payment.
+	DefaultTrampolineCltvDelta = 144
+
+	// DefaultProberInterval is the default interval at which the prober
+	// sends a probe.
+	DefaultProberInterval = time.Minute
+
+	// DefaultProberMinAmtMsat is the default smallest amount probed by the
+	// prober.
+	DefaultProberMinAmtMsat = 10_000_000
+
+	// DefaultProberMaxAmtMsat is the default largest amount probed by the
+	// prober.
+	DefaultProberMaxAmtMsat = 1_000_000_000
+
+	// DefaultProberMaxLockedMsat is the default maximum total amount of
+	// all probes in flight.
+	DefaultProberMaxLockedMsat = 2_000_000_000
+
+	// DefaultProberMaxFeePPM is the default maximum routing fee of a
+	// probe in parts per million of its amount.
+	DefaultProberMaxFeePPM = 10_000
+
+	// DefaultProberTimeout is the default timeout of a single probe.
+	DefaultProberTimeout = time.Minute
+
+	// DefaultStuckHTLCThreshold is the default age after which an HTLC of
+	// a local payment is considered stuck.
+	DefaultStuckHTLCThreshold = time.Hour
+
+	// DefaultStuckHTLCInterval is the default interval at which the
+	// age of the in-flight HTLCs of local payments is checked.
+	DefaultStuckHTLCInterval = time.Minute
+)
 
 // Routing holds the configuration options for routing.
 //
@@ -11,6 +75,14 @@ type Routing struct {
 	StrictZombiePruning bool `long:"strictgraphpruning" description:"If true, then the graph will be pruned more aggressively for zombies. In practice this means that edges with a single stale edge will be considered a zombie."`
 
 	BlindedPaths BlindedPaths `group:"blinding" namespace:"blinding"`
+
+	DynamicFees DynamicFees `group:"dynamicfees" namespace:"dynamicfees"`
+
+	Trampoline Trampoline `group:"trampoline" namespace:"trampoline"`
+
+	Prober Prober `group:"prober" namespace:"prober"`
+
+	StuckHTLC StuckHTLC `group:"stuckhtlc" namespace:"stuckhtlc"`
 }
 
 // BlindedPaths holds the configuration options for blinded path construction.
@@ -24,6 +96,56 @@ type BlindedPaths struct {
 	PolicyDecreaseMultiplier float64 `long:"policy-decrease-multiplier" description:"The amount by which to decrease certain policy values of hops on a blinded path in order to add a probing buffer."`
 }
 
+// DynamicFees holds the configuration options for the dynamic fee policy
+// engine.
+//
+//nolint:ll
+type DynamicFees struct {
+	Active              bool          `long:"active" description:"If true, the fees of all channels are periodically recomputed from their local balance ratio and forwarding volume."`
+	DryRun              bool          `long:"dry-run" description:"If true, recomputed fees are only logged and reported over RPC instead of being applied."`
+	Interval            time.Duration `long:"interval" description:"The interval at which channel fees are recomputed."`
+	BaseFeeMsat         uint64        `long:"base-fee-msat" description:"The outgoing base fee in milli-satoshis set on every channel."`
+	FeeRateCurve        string        `long:"fee-rate-curve" description:"A comma separated list of ratio:ppm points mapping the local balance ratio of a channel (0 to 1) to its outgoing fee rate. Fee rates between points are linearly interpolated."`
+	InboundBaseFeeMsat  int32         `long:"inbound-base-fee-msat" description:"The inbound base fee in milli-satoshis set together with the inbound fee rate. Only used if inbound-fee-rate-curve is set."`
+	InboundFeeRateCurve string        `long:"inbound-fee-rate-curve" description:"A comma separated list of ratio:ppm points mapping the local balance ratio of a channel (0 to 1) to its inbound fee rate. If empty, inbound fees are left untouched."`
+	VolumeWindow        time.Duration `long:"volume-window" description:"The time window of the forwarding history used to determine the outgoing volume of a channel."`
+	VolumeSensitivity   float64       `long:"volume-sensitivity" description:"The relative fee rate increase for a channel that forwarded its full capacity within the volume window, e.g. 0.5 for 50%. Set to 0 to ignore the forwarding volume."`
+	MaxFeeRatePpm       uint32        `long:"max-fee-rate-ppm" description:"The maximum outgoing fee rate in ppm the engine may set. Set to 0 for no limit."`
+}
+
+// Trampoline holds the policy for forwarding trampoline payments.
+//
+//nolint:ll
+type Trampoline struct {
+	FeeBaseMsat uint64 `long:"fee-base-msat" description:"The base fee in milli-satoshis required for forwarding a trampoline payment."`
+	FeeRatePpm  uint64 `long:"fee-rate-ppm" description:"The proportional fee in ppm required for forwarding a trampoline payment."`
+	CltvDelta   uint16 `long:"cltv-delta" description:"The cltv delta required for forwarding a trampoline payment."`
+}
+
+// Prober holds the configuration options for the background prober.
+//
+//nolint:ll
+type Prober struct {
+	Active        bool          `long:"active" description:"If true, probes with unknown payment hashes are continuously sent to the target nodes to discover the liquidity of the channels along the routes."`
+	Targets       []string      `long:"target" description:"The public key of a node to probe. Can be specified multiple times."`
+	Interval      time.Duration `long:"interval" description:"The interval at which a single probe is sent. The targets are probed one after the other."`
+	MinAmtMsat    uint64        `long:"min-amt-msat" description:"The smallest amount in milli-satoshis that is probed."`
+	MaxAmtMsat    uint64        `long:"max-amt-msat" description:"The largest amount in milli-satoshis that is probed."`
+	MaxLockedMsat uint64        `long:"max-locked-msat" description:"The maximum total amount in milli-satoshis of all probes in flight, including the routing fees they may pay. Probes that would exceed this budget are skipped."`
+	MaxFeePPM     uint64        `long:"max-fee-ppm" description:"The maximum routing fee of a probe in parts per million of its amount."`
+	Timeout       time.Duration `long:"timeout" description:"The timeout of a single probe."`
+}
+
+// StuckHTLC holds the configuration options for the detection of stuck HTLCs
+// of local payments.
+//
+//nolint:ll
+type StuckHTLC struct {
+	Threshold       time.Duration `long:"threshold" description:"The time after which an in-flight HTLC of a local payment is considered stuck and an alert is sent to the SubscribeHtlcEvents subscribers. Set to 0 to disable the detection."`
+	CheckInterval   time.Duration `long:"check-interval" description:"The interval at which the age of the in-flight HTLCs of local payments is checked."`
+	ForceCloseDelta uint32        `long:"force-close-delta" description:"If set, the channel to the first hop of a stuck HTLC is force closed once the HTLC expires within this number of blocks. Set to 0 to never force close."`
+}
+
 // Validate checks that the various routing config options are sane.
 //
 // NOTE: this is part of the Validator interface.
@@ -50,5 +172,66 @@ func (r *Routing) Validate() error {
 			"multiplier must be in the range (0,1]")
 	}
 
+	if r.DynamicFees.Active && r.DynamicFees.Interval <= 0 {
+		return fmt.Errorf("the dynamic fees interval must be positive")
+	}
+
+	if r.DynamicFees.VolumeWindow < 0 {
+		return fmt.Errorf("the dynamic fees volume window must not " +
+			"be negative")
+	}
+
+	if r.DynamicFees.VolumeSensitivity < 0 {
+		return fmt.Errorf("the dynamic fees volume sensitivity must " +
+			"not be negative")
+	}
+
+	if r.Trampoline.CltvDelta == 0 {
+		return fmt.Errorf("the trampoline cltv delta must be positive")
+	}
+
+	if r.StuckHTLC.Threshold < 0 {
+		return fmt.Errorf("the stuck htlc threshold must not be " +
+			"negative")
+	}
+
+	if r.StuckHTLC.Threshold > 0 && r.StuckHTLC.CheckInterval <= 0 {
+		return fmt.Errorf("the stuck htlc check interval must be " +
+			"positive")
+	}
+
+	if r.Prober.Active {
+		if err := r.Prober.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validate checks that the prober config options are sane.
+func (p *Prober) validate() error {
+	switch {
+	case len(p.Targets) == 0:
+		return fmt.Errorf("at least one prober target must be set")
+
+	case p.Interval <= 0:
+		return fmt.Errorf("the prober interval must be positive")
+
+	case p.Timeout <= 0:
+		return fmt.Errorf("the prober timeout must be positive")
+
+	case p.MinAmtMsat == 0:
+		return fmt.Errorf("the prober min amount must be positive")
+
+	case p.MinAmtMsat > p.MaxAmtMsat:
+		return fmt.Errorf("the prober min amount must not exceed the " +
+			"max amount")
+
+	case p.MaxLockedMsat < p.MinAmtMsat+p.MinAmtMsat*p.MaxFeePPM/1_000_000:
+		return fmt.Errorf("the prober max locked amount must not be " +
+			"below the min amount and its max fee")
+	}
+
 	return nil
 }
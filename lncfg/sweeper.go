@@ -27,6 +27,36 @@ type Sweeper struct {
 	NoDeadlineConfTarget uint32 `long:"nodeadlineconftarget" description:"The conf target to use when sweeping non-time-sensitive outputs. This is useful for sweeping outputs that are not time-sensitive, and can be swept at a lower fee rate."`
 
 	Budget *contractcourt.BudgetConfig `group:"sweeper.budget" namespace:"budget" long:"budget" description:"An optional config group that's used for the automatic sweep fee estimation. The Budget config gives options to limits ones fee exposure when sweeping unilateral close outputs and the fee rate calculated from budgets is capped at sweeper.maxfeerate. Check the budget config options for more details."`
+
+	AnchorReclaim *AnchorReclaim `group:"anchorreclaim" namespace:"anchorreclaim"`
+}
+
+// AnchorReclaim holds the configuration of the anchor reclaimer, which holds
+// back the anchor outputs of closed channels until they can be swept
+// economically in a single batch.
+//
+//nolint:ll
+type AnchorReclaim struct {
+	Active     bool                 `long:"active" description:"If true, the anchor outputs of closed channels that aren't needed to fee bump a commitment transaction are held back until the fee rate drops below anchorreclaim.maxfeerate and are then swept together in a single transaction."`
+	MaxFeeRate chainfee.SatPerVByte `long:"maxfeerate" description:"The fee rate in sat/vb, estimated using sweeper.nodeadlineconftarget, below which the anchors are swept."`
+	Interval   time.Duration        `long:"interval" description:"How often the fee rate is checked."`
+}
+
+// Validate checks the values configured for the anchor reclaimer.
+func (a *AnchorReclaim) Validate() error {
+	if !a.Active {
+		return nil
+	}
+
+	if a.MaxFeeRate < 1 {
+		return fmt.Errorf("maxfeerate must be >= 1 sat/vb")
+	}
+
+	if a.Interval <= 0 {
+		return fmt.Errorf("interval must be positive")
+	}
+
+	return nil
 }
 
 // Validate checks the values configured for the sweeper.
@@ -55,6 +85,11 @@ func (s *Sweeper) Validate() error {
 		return fmt.Errorf("invalid budget config: %w", err)
 	}
 
+	// Validate the anchor reclaim configuration.
+	if err := s.AnchorReclaim.Validate(); err != nil {
+		return fmt.Errorf("invalid anchorreclaim config: %w", err)
+	}
+
 	return nil
 }
 
@@ -64,5 +99,9 @@ func DefaultSweeperConfig() *Sweeper {
 		MaxFeeRate:           sweep.DefaultMaxFeeRate,
 		NoDeadlineConfTarget: uint32(sweep.DefaultDeadlineDelta),
 		Budget:               contractcourt.DefaultBudgetConfig(),
+		AnchorReclaim: &AnchorReclaim{
+			MaxFeeRate: sweep.DefaultAnchorReclaimMaxFeeRate,
+			Interval:   sweep.DefaultAnchorReclaimInterval,
+		},
 	}
 }
@@ -97,6 +97,10 @@ type AddInvoiceConfig struct {
 	// QueryBlindedRoutes can be used to generate a few routes to this node
 	// that can then be used in the construction of a blinded payment path.
 	QueryBlindedRoutes func(lnwire.MilliSatoshi) ([]*route.Route, error)
+
+	// BlindedPathRefresher is used to reissue invoices whose blinded paths
+	// should be refreshed when one of their channels is closed.
+	BlindedPathRefresher *BlindedPathRefresher
 }
 
 // AddInvoiceData contains the required data to create a new invoice.
@@ -142,6 +146,11 @@ type AddInvoiceData struct {
 	// immediately upon receiving the payment.
 	HodlInvoice bool
 
+	// HoldPolicy controls how long the accepted htlcs of a hodl invoice may
+	// be held, and whether the invoice is settled automatically once its
+	// preimage becomes known. It can only be set for hodl invoices.
+	HoldPolicy invoices.HoldPolicy
+
 	// Amp signals whether or not to create an AMP invoice.
 	//
 	// NOTE: Preimage should always be set to nil when this value is true.
@@ -183,6 +192,16 @@ type BlindedPathConfig struct {
 	// dummy hops in a blinded path in the case where they cant be derived
 	// through other means.
 	DefaultDummyHopPolicy *blindedpath.BlindedHopPolicy
+
+	// RefreshOnChannelClose indicates that the blinded paths of the
+	// invoice should be rebuilt when one of the channels they use is
+	// closed while the invoice is still open.
+	RefreshOnChannelClose bool
+
+	// RouteRestrictions are the restrictions that the routes of the
+	// blinded paths were found with. They're required to rebuild the
+	// paths when RefreshOnChannelClose is set.
+	RouteRestrictions *routing.BlindedPathRestrictions
 }
 
 // paymentHashAndPreimage returns the payment hash and preimage for this invoice
@@ -291,6 +310,20 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 			"are not yet supported")
 	}
 
+	if blind && invoice.BlindedPathCfg.RefreshOnChannelClose &&
+		cfg.BlindedPathRefresher == nil {
+
+		return nil, nil, errors.New("refreshing blinded paths is not " +
+			"supported")
+	}
+
+	if blind && invoice.BlindedPathCfg.RefreshOnChannelClose &&
+		invoice.BlindedPathCfg.RouteRestrictions == nil {
+
+		return nil, nil, errors.New("refreshing blinded paths " +
+			"requires the route restrictions of the paths")
+	}
+
 	paymentPreimage, paymentHash, err := invoice.paymentHashAndPreimage()
 	if err != nil {
 		return nil, nil, err
@@ -428,6 +461,21 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 		cltvExpiryDelta = invoice.CltvExpiry
 	}
 
+	// A hold policy is only enforced for hodl invoices, and the minimum
+	// cltv slack needs to leave room to accept htlcs in the first place.
+	if !invoice.HoldPolicy.IsZero() {
+		if !invoice.HodlInvoice {
+			return nil, nil, invoices.ErrHoldPolicyNotHodl
+		}
+
+		slack := uint64(invoice.HoldPolicy.MinCltvSlack)
+		if slack >= cltvExpiryDelta {
+			return nil, nil, fmt.Errorf("min CLTV slack of %v must "+
+				"be lower than the final CLTV delta of %v",
+				slack, cltvExpiryDelta)
+		}
+	}
+
 	// Only include a final CLTV expiry delta if this is not a blinded
 	// invoice. In a blinded invoice, this value will be added to the total
 	// blinded route CLTV delta value
@@ -503,60 +551,16 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 		return nil, nil, err
 	}
 
+	var pathChannels []uint64
 	if blind {
-		blindCfg := invoice.BlindedPathCfg
-
-		// Use the 10-min-per-block assumption to get a rough estimate
-		// of the number of blocks until the invoice expires. We want
-		// to make sure that the blinded path definitely does not expire
-		// before the invoice does, and so we add a healthy buffer.
-		invoiceExpiry := uint32(expiry.Minutes() / 10)
-		blindedPathExpiry := invoiceExpiry * 2
-
-		// Add BlockPadding to the finalCltvDelta so that the receiving
-		// node does not reject the HTLC if some blocks are mined while
-		// the payment is in-flight. Note that unlike vanilla invoices,
-		// with blinded paths, the recipient is responsible for adding
-		// this block padding instead of the sender.
-		finalCLTVDelta := uint32(cltvExpiryDelta)
-		finalCLTVDelta += uint32(routing.BlockPadding)
-
-		//nolint:ll
-		paths, err := blindedpath.BuildBlindedPaymentPaths(
-			&blindedpath.BuildBlindedPathCfg{
-				FindRoutes: cfg.QueryBlindedRoutes,
-				FetchChannelEdgesByID: func(chanID uint64) (
-					*models.ChannelEdgeInfo,
-					*models.ChannelEdgePolicy,
-					*models.ChannelEdgePolicy, error) {
-
-					return cfg.Graph.FetchChannelEdgesByID(
-						context.TODO(), chanID,
-					)
-				},
-				FetchOurOpenChannels:    cfg.ChanDB.FetchAllOpenChannels,
-				PathID:                  paymentAddr[:],
-				ValueMsat:               invoice.Value,
-				BestHeight:              cfg.BestHeight,
-				MinFinalCLTVExpiryDelta: finalCLTVDelta,
-				BlocksUntilExpiry:       blindedPathExpiry,
-				AddPolicyBuffer: func(
-					p *blindedpath.BlindedHopPolicy) (
-					*blindedpath.BlindedHopPolicy, error) {
-
-					//nolint:ll
-					return blindedpath.AddPolicyBuffer(
-						p, blindCfg.RoutePolicyIncrMultiplier,
-						blindCfg.RoutePolicyDecrMultiplier,
-					)
-				},
-				MinNumHops:            blindCfg.MinNumPathHops,
-				DefaultDummyHopPolicy: blindCfg.DefaultDummyHopPolicy,
-			},
+		paths, channels, err := buildBlindedPaths(
+			cfg, invoice.BlindedPathCfg, invoice.Value, paymentAddr,
+			expiry, cltvExpiryDelta,
 		)
 		if err != nil {
 			return nil, nil, err
 		}
+		pathChannels = channels
 
 		for _, path := range paths {
 			options = append(options, zpay32.WithBlindedPaymentPath(
@@ -576,31 +580,7 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 		return nil, nil, err
 	}
 
-	payReqString, err := payReq.Encode(zpay32.MessageSigner{
-		SignCompact: func(msg []byte) ([]byte, error) {
-			// For an invoice without a blinded path, the main node
-			// key is used to sign the invoice so that the sender
-			// can derive the true pub key of the recipient.
-			if !blind {
-				return cfg.NodeSigner.SignMessageCompact(
-					msg, false,
-				)
-			}
-
-			// For an invoice with a blinded path, we use an
-			// ephemeral key to sign the invoice since we don't want
-			// the sender to be able to know the real pub key of
-			// the recipient.
-			ephemKey, err := btcec.NewPrivateKey()
-			if err != nil {
-				return nil, err
-			}
-
-			return ecdsa.SignCompact(
-				ephemKey, chainhash.HashB(msg), true,
-			), nil
-		},
-	})
+	payReqString, err := payReq.Encode(invoiceSigner(cfg, blind))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -618,20 +598,187 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 			Features:        invoiceFeatures,
 		},
 		HodlInvoice: invoice.HodlInvoice,
+		HoldPolicy:  invoice.HoldPolicy,
 	}
 
 	log.Tracef("[addinvoice] adding new invoice %v",
 		lnutils.SpewLogClosure(newInvoice))
 
+	// If requested, the blinded paths of the invoice are rebuilt whenever
+	// one of their channels is closed. The invoice is registered before
+	// it's added, so that it isn't added if its registration can't be
+	// persisted.
+	refresh := blind && invoice.BlindedPathCfg.RefreshOnChannelClose
+	if refresh {
+		err := cfg.BlindedPathRefresher.Register(&BlindedPathRefresh{
+			Hash:            paymentHash,
+			Channels:        pathChannels,
+			Expiry:          creationDate.Add(expiry),
+			CltvExpiryDelta: cltvExpiryDelta,
+			BlindCfg:        invoice.BlindedPathCfg,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	// With all sanity checks passed, write the invoice to the database.
 	_, err = cfg.AddInvoice(ctx, newInvoice, paymentHash)
 	if err != nil {
+		if refresh {
+			cfg.BlindedPathRefresher.Unregister(paymentHash)
+		}
+
 		return nil, nil, err
 	}
 
 	return &paymentHash, newInvoice, nil
 }
 
+// buildBlindedPaths builds the blinded payment paths for an invoice of the
+// given value that expires after the given duration. It also returns the IDs
+// of the channels that the paths use.
+func buildBlindedPaths(cfg *AddInvoiceConfig, blindCfg *BlindedPathConfig,
+	value lnwire.MilliSatoshi, paymentAddr [32]byte, expiry time.Duration,
+	cltvExpiryDelta uint64) ([]*zpay32.BlindedPaymentPath, []uint64,
+	error) {
+
+	// Use the 10-min-per-block assumption to get a rough estimate of the
+	// number of blocks until the invoice expires. We want to make sure
+	// that the blinded path definitely does not expire before the invoice
+	// does, and so we add a healthy buffer.
+	invoiceExpiry := uint32(expiry.Minutes() / 10)
+	blindedPathExpiry := invoiceExpiry * 2
+
+	// Add BlockPadding to the finalCltvDelta so that the receiving node
+	// does not reject the HTLC if some blocks are mined while the payment
+	// is in-flight. Note that unlike vanilla invoices, with blinded paths,
+	// the recipient is responsible for adding this block padding instead
+	// of the sender.
+	finalCLTVDelta := uint32(cltvExpiryDelta)
+	finalCLTVDelta += uint32(routing.BlockPadding)
+
+	// Keep track of the channels of the routes that the paths are actually
+	// built from.
+	var channels []uint64
+	onRouteUsed := func(r *route.Route) {
+		for _, hop := range r.Hops {
+			channels = append(channels, hop.ChannelID)
+		}
+	}
+
+	//nolint:ll
+	paths, err := blindedpath.BuildBlindedPaymentPaths(
+		&blindedpath.BuildBlindedPathCfg{
+			FindRoutes:  cfg.QueryBlindedRoutes,
+			OnRouteUsed: onRouteUsed,
+			FetchChannelEdgesByID: func(chanID uint64) (
+				*models.ChannelEdgeInfo,
+				*models.ChannelEdgePolicy,
+				*models.ChannelEdgePolicy, error) {
+
+				return cfg.Graph.FetchChannelEdgesByID(
+					context.TODO(), chanID,
+				)
+			},
+			FetchOurOpenChannels:    cfg.ChanDB.FetchAllOpenChannels,
+			GetAlias:                cfg.GetAlias,
+			PathID:                  paymentAddr[:],
+			ValueMsat:               value,
+			BestHeight:              cfg.BestHeight,
+			MinFinalCLTVExpiryDelta: finalCLTVDelta,
+			BlocksUntilExpiry:       blindedPathExpiry,
+			AddPolicyBuffer: func(
+				p *blindedpath.BlindedHopPolicy) (
+				*blindedpath.BlindedHopPolicy, error) {
+
+				return blindedpath.AddPolicyBuffer(
+					p, blindCfg.RoutePolicyIncrMultiplier,
+					blindCfg.RoutePolicyDecrMultiplier,
+				)
+			},
+			MinNumHops:            blindCfg.MinNumPathHops,
+			DefaultDummyHopPolicy: blindCfg.DefaultDummyHopPolicy,
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return paths, channels, nil
+}
+
+// ReissueBlindedInvoice rebuilds the blinded paths of an open invoice from the
+// parameters it was registered with. It returns the new payment request along
+// with the IDs of the channels that the new paths use. All other fields of the
+// payment request, including its creation date and expiry, are kept.
+func ReissueBlindedInvoice(cfg *AddInvoiceConfig, invoice *invoices.Invoice,
+	refresh *BlindedPathRefresh) ([]byte, []uint64, error) {
+
+	payReq, err := zpay32.Decode(
+		string(invoice.PaymentRequest), cfg.ChainParams,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(payReq.BlindedPaymentPaths) == 0 {
+		return nil, nil, errors.New("invoice has no blinded paths")
+	}
+
+	remaining := time.Until(payReq.Timestamp.Add(payReq.Expiry()))
+	if remaining <= 0 {
+		return nil, nil, errors.New("invoice expired")
+	}
+
+	paths, channels, err := buildBlindedPaths(
+		cfg, refresh.BlindCfg, invoice.Terms.Value,
+		invoice.Terms.PaymentAddr, remaining, refresh.CltvExpiryDelta,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	payReq.BlindedPaymentPaths = paths
+
+	// The destination of the decoded payment request is the ephemeral key
+	// it was signed with, which is replaced by a new one below.
+	payReq.Destination = nil
+
+	payReqString, err := payReq.Encode(invoiceSigner(cfg, true))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return []byte(payReqString), channels, nil
+}
+
+// invoiceSigner returns the signer of a payment request. For an invoice
+// without a blinded path, the main node key is used to sign the invoice so
+// that the sender can derive the true pub key of the recipient. For an
+// invoice with a blinded path, we use an ephemeral key to sign the invoice
+// since we don't want the sender to be able to know the real pub key of the
+// recipient.
+func invoiceSigner(cfg *AddInvoiceConfig, blind bool) zpay32.MessageSigner {
+	return zpay32.MessageSigner{
+		SignCompact: func(msg []byte) ([]byte, error) {
+			if !blind {
+				return cfg.NodeSigner.SignMessageCompact(
+					msg, false,
+				)
+			}
+
+			ephemKey, err := btcec.NewPrivateKey()
+			if err != nil {
+				return nil, err
+			}
+
+			return ecdsa.SignCompact(
+				ephemKey, chainhash.HashB(msg), true,
+			), nil
+		},
+	}
+}
+
 // chanCanBeHopHint returns true if the target channel is eligible to be a hop
 // hint.
 func chanCanBeHopHint(channel *HopHintInfo, cfg *SelectHopHintsCfg) (
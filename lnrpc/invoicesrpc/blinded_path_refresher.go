@@ -0,0 +1,338 @@
+package invoicesrpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	graphdb "github.com/lightningnetwork/lnd/graph/db"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// BlindedPathRefresh describes an open invoice whose blinded paths are rebuilt
+// when one of the channels they use is closed. It holds all parameters the
+// paths were built with, so that it can be persisted and the invoice is still
+// refreshed after a restart.
+type BlindedPathRefresh struct {
+	// Hash is the payment hash of the invoice.
+	Hash lntypes.Hash
+
+	// Channels are the IDs of the channels that the current blinded paths
+	// of the invoice use.
+	Channels []uint64
+
+	// Expiry is the time the invoice expires, after which it is no longer
+	// refreshed.
+	Expiry time.Time
+
+	// CltvExpiryDelta is the final CLTV delta of the invoice.
+	CltvExpiryDelta uint64
+
+	// BlindCfg is the configuration the blinded paths are built with.
+	BlindCfg *BlindedPathConfig
+}
+
+// ReissueFunc rebuilds the blinded paths of the given open invoice. It returns
+// the reissued payment request along with the IDs of the channels that the new
+// paths use.
+type ReissueFunc func(invoice *invoices.Invoice,
+	refresh *BlindedPathRefresh) ([]byte, []uint64, error)
+
+// BlindedPathRefresherConfig holds the dependencies of the blinded path
+// refresher.
+type BlindedPathRefresherConfig struct {
+	// SubscribeTopology is used to get a subscription for topology changes
+	// on the network, which signal the closes of channels.
+	SubscribeTopology func() (*graphdb.TopologyClient, error)
+
+	// LookupInvoice looks up the invoice with the given payment hash.
+	LookupInvoice func(ctx context.Context,
+		hash lntypes.Hash) (invoices.Invoice, error)
+
+	// UpdatePaymentRequest replaces the payment request of the open
+	// invoice with the given payment hash.
+	UpdatePaymentRequest func(ctx context.Context, hash lntypes.Hash,
+		paymentRequest []byte) error
+
+	// Reissue rebuilds the blinded paths of an invoice.
+	Reissue ReissueFunc
+
+	// Store persists the registered invoices.
+	Store RefreshStore
+
+	// Clock is used to determine whether registered invoices expired.
+	Clock clock.Clock
+}
+
+// BlindedPathRefresher reissues open invoices with blinded paths when one of
+// the channels used by their paths is closed, so that the invoices remain
+// payable. The registrations are persisted, so invoices keep being refreshed
+// after a restart.
+type BlindedPathRefresher struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *BlindedPathRefresherConfig
+
+	// mu protects the registrations below.
+	mu sync.Mutex
+
+	// registrations holds the invoices that are refreshed, keyed by their
+	// payment hash.
+	registrations map[lntypes.Hash]*BlindedPathRefresh
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewBlindedPathRefresher creates a new blinded path refresher.
+func NewBlindedPathRefresher(
+	cfg *BlindedPathRefresherConfig) *BlindedPathRefresher {
+
+	return &BlindedPathRefresher{
+		cfg:           cfg,
+		registrations: make(map[lntypes.Hash]*BlindedPathRefresh),
+		quit:          make(chan struct{}),
+	}
+}
+
+// Start loads the persisted registrations, subscribes to channel closes and
+// starts the refresh loop.
+func (r *BlindedPathRefresher) Start() error {
+	var startErr error
+	r.started.Do(func() {
+		log.Debugf("Blinded path refresher starting")
+
+		if err := r.loadRegistrations(); err != nil {
+			startErr = err
+			return
+		}
+
+		client, err := r.cfg.SubscribeTopology()
+		if err != nil {
+			startErr = err
+			return
+		}
+
+		r.wg.Add(1)
+		go r.refreshLoop(client)
+	})
+
+	return startErr
+}
+
+// Stop stops the refresh loop.
+func (r *BlindedPathRefresher) Stop() error {
+	r.stopped.Do(func() {
+		log.Debugf("Blinded path refresher stopping")
+
+		close(r.quit)
+		r.wg.Wait()
+	})
+
+	return nil
+}
+
+// loadRegistrations restores the persisted registrations. Those of expired
+// invoices are removed.
+func (r *BlindedPathRefresher) loadRegistrations() error {
+	refreshes, err := r.cfg.Store.FetchBlindedPathRefreshes()
+	if err != nil {
+		return fmt.Errorf("unable to fetch blinded path refreshes: %w",
+			err)
+	}
+
+	now := r.cfg.Clock.Now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, refresh := range refreshes {
+		if !now.Before(refresh.Expiry) {
+			r.deleteRegistration(refresh.Hash)
+			continue
+		}
+
+		r.registrations[refresh.Hash] = refresh
+	}
+
+	log.Debugf("Restored %d blinded path refreshes", len(r.registrations))
+
+	return nil
+}
+
+// Register registers an invoice whose blinded paths are rebuilt once one of
+// their channels is closed, until the invoice expires or is no longer open.
+func (r *BlindedPathRefresher) Register(refresh *BlindedPathRefresh) error {
+	if err := r.cfg.Store.PutBlindedPathRefresh(refresh); err != nil {
+		return fmt.Errorf("unable to persist blinded path refresh: %w",
+			err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	log.Debugf("Refreshing blinded paths of invoice %v on the close of "+
+		"channels %v", refresh.Hash, refresh.Channels)
+
+	r.registrations[refresh.Hash] = refresh
+
+	return nil
+}
+
+// Unregister stops refreshing the invoice with the given payment hash.
+func (r *BlindedPathRefresher) Unregister(hash lntypes.Hash) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.registrations, hash)
+	r.deleteRegistration(hash)
+}
+
+// deleteRegistration deletes the persisted registration of an invoice.
+func (r *BlindedPathRefresher) deleteRegistration(hash lntypes.Hash) {
+	if err := r.cfg.Store.DeleteBlindedPathRefresh(hash); err != nil {
+		log.Errorf("Unable to delete blinded path refresh of invoice "+
+			"%v: %v", hash, err)
+	}
+}
+
+// updateChannels replaces the channels of a registration and persists it.
+//
+// NOTE: The mutex must be held when calling this method.
+func (r *BlindedPathRefresher) updateChannels(refresh *BlindedPathRefresh,
+	channels []uint64) {
+
+	refresh.Channels = channels
+	if err := r.cfg.Store.PutBlindedPathRefresh(refresh); err != nil {
+		log.Errorf("Unable to persist blinded path refresh of invoice "+
+			"%v: %v", refresh.Hash, err)
+	}
+}
+
+// refreshLoop refreshes the affected invoices whenever channels are closed.
+//
+// NOTE: This MUST be run as a goroutine.
+func (r *BlindedPathRefresher) refreshLoop(client *graphdb.TopologyClient) {
+	defer r.wg.Done()
+	defer client.Cancel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for {
+		select {
+		case change, ok := <-client.TopologyChanges:
+			if !ok {
+				return
+			}
+
+			if len(change.ClosedChannels) == 0 {
+				continue
+			}
+
+			closed := fn.NewSet[uint64]()
+			for _, c := range change.ClosedChannels {
+				closed.Add(c.ChanID)
+			}
+
+			r.handleClosedChannels(ctx, closed)
+
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// handleClosedChannels reissues the invoices whose blinded paths use one of the
+// closed channels. Expired invoices are removed along the way.
+func (r *BlindedPathRefresher) handleClosedChannels(ctx context.Context,
+	closed fn.Set[uint64]) {
+
+	now := r.cfg.Clock.Now()
+
+	r.mu.Lock()
+	affected := make(map[lntypes.Hash]*BlindedPathRefresh)
+	for hash, refresh := range r.registrations {
+		if !now.Before(refresh.Expiry) {
+			delete(r.registrations, hash)
+			r.deleteRegistration(hash)
+
+			continue
+		}
+
+		channels := fn.NewSet(refresh.Channels...)
+		if !channels.Intersect(closed).IsEmpty() {
+			affected[hash] = refresh
+		}
+	}
+	r.mu.Unlock()
+
+	for hash, refresh := range affected {
+		keep := r.refreshInvoice(ctx, refresh, closed)
+
+		r.mu.Lock()
+		if !keep && r.registrations[hash] == refresh {
+			delete(r.registrations, hash)
+			r.deleteRegistration(hash)
+		}
+		r.mu.Unlock()
+	}
+}
+
+// refreshInvoice reissues a single invoice. It returns false if the invoice
+// is no longer refreshed.
+func (r *BlindedPathRefresher) refreshInvoice(ctx context.Context,
+	refresh *BlindedPathRefresh, closed fn.Set[uint64]) bool {
+
+	hash := refresh.Hash
+	invoice, err := r.cfg.LookupInvoice(ctx, hash)
+	if err != nil {
+		log.Errorf("Unable to look up invoice %v for blinded path "+
+			"refresh: %v", hash, err)
+
+		return false
+	}
+
+	if invoice.State != invoices.ContractOpen {
+		log.Debugf("Invoice %v no longer open, not refreshing its "+
+			"blinded paths", hash)
+
+		return false
+	}
+
+	paymentRequest, channels, err := r.cfg.Reissue(&invoice, refresh)
+	if err != nil {
+		// The invoice is kept registered with its remaining channels,
+		// so that the refresh is retried on the next close.
+		log.Warnf("Unable to rebuild blinded paths of invoice %v: %v",
+			hash, err)
+
+		r.mu.Lock()
+		remaining := fn.NewSet(refresh.Channels...).Diff(closed)
+		r.updateChannels(refresh, remaining.ToSlice())
+		r.mu.Unlock()
+
+		return true
+	}
+
+	err = r.cfg.UpdatePaymentRequest(ctx, hash, paymentRequest)
+	if err != nil {
+		log.Errorf("Unable to update payment request of invoice %v: "+
+			"%v", hash, err)
+
+		return false
+	}
+
+	log.Infof("Reissued invoice %v with refreshed blinded paths", hash)
+
+	r.mu.Lock()
+	r.updateChannels(refresh, channels)
+	r.mu.Unlock()
+
+	return true
+}
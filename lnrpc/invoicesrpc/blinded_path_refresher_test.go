@@ -0,0 +1,273 @@
+package invoicesrpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	graphdb "github.com/lightningnetwork/lnd/graph/db"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/blindedpath"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBlindedPathRefresher tests that invoices are reissued when a channel of
+// their blinded paths is closed, and that they are no longer refreshed once
+// they expired or are no longer open.
+func TestBlindedPathRefresher(t *testing.T) {
+	t.Parallel()
+
+	var (
+		testTime = time.Unix(1_000_000, 0)
+		hashA    = lntypes.Hash{1}
+		hashB    = lntypes.Hash{2}
+		hashC    = lntypes.Hash{3}
+	)
+
+	cdb, err := channeldb.MakeTestDB(t)
+	require.NoError(t, err)
+
+	store, err := NewRefreshStore(cdb)
+	require.NoError(t, err)
+
+	testClock := clock.NewTestClock(testTime)
+	topologyChanges := make(chan *graphdb.TopologyChange)
+	states := map[lntypes.Hash]invoices.ContractState{
+		hashA: invoices.ContractOpen,
+		hashB: invoices.ContractOpen,
+		hashC: invoices.ContractCanceled,
+	}
+	updates := make(map[lntypes.Hash]string)
+
+	// reissues holds the payment request and channels each invoice is
+	// reissued with. Invoices without an entry can't be reissued.
+	type reissue struct {
+		payReq   string
+		channels []uint64
+	}
+	reissues := map[lntypes.Hash]reissue{
+		hashA: {payReq: "a1", channels: []uint64{4}},
+		hashB: {payReq: "b1", channels: []uint64{5}},
+		hashC: {payReq: "c1", channels: []uint64{6}},
+	}
+
+	cfg := &BlindedPathRefresherConfig{
+		SubscribeTopology: func() (*graphdb.TopologyClient, error) {
+			return &graphdb.TopologyClient{
+				TopologyChanges: topologyChanges,
+				Cancel:          func() {},
+			}, nil
+		},
+		LookupInvoice: func(_ context.Context,
+			hash lntypes.Hash) (invoices.Invoice, error) {
+
+			return invoices.Invoice{State: states[hash]}, nil
+		},
+		UpdatePaymentRequest: func(_ context.Context, hash lntypes.Hash,
+			paymentRequest []byte) error {
+
+			updates[hash] = string(paymentRequest)
+			return nil
+		},
+		Reissue: func(_ *invoices.Invoice,
+			refresh *BlindedPathRefresh) ([]byte, []uint64, error) {
+
+			r, ok := reissues[refresh.Hash]
+			if !ok {
+				return nil, nil, errors.New("no routes")
+			}
+
+			return []byte(r.payReq), r.channels, nil
+		},
+		Store: store,
+		Clock: testClock,
+	}
+
+	newRefresher := func() *BlindedPathRefresher {
+		refresher := NewBlindedPathRefresher(cfg)
+		require.NoError(t, refresher.Start())
+
+		return refresher
+	}
+
+	refresher := newRefresher()
+
+	// closeChannels notifies the refresher about closed channels and waits
+	// for it to process the notification, which is the case once it
+	// received the next one.
+	closeChannels := func(chanIDs ...uint64) {
+		change := &graphdb.TopologyChange{}
+		for _, chanID := range chanIDs {
+			change.ClosedChannels = append(
+				change.ClosedChannels,
+				&graphdb.ClosedChanSummary{ChanID: chanID},
+			)
+		}
+
+		topologyChanges <- change
+		topologyChanges <- &graphdb.TopologyChange{}
+	}
+
+	register := func(hash lntypes.Hash, channels []uint64,
+		expiry time.Time) {
+
+		require.NoError(t, refresher.Register(
+			newTestRefresh(hash, channels, expiry),
+		))
+	}
+
+	expiry := testTime.Add(time.Hour)
+	register(hashA, []uint64{1, 2}, expiry)
+	register(hashB, []uint64{3}, testTime.Add(time.Minute))
+	register(hashC, []uint64{1}, expiry)
+
+	// Closing a channel that isn't used by any path doesn't reissue any
+	// invoice.
+	closeChannels(7)
+	require.Empty(t, updates)
+
+	// Closing a channel of the paths of A reissues it. C uses the channel
+	// too, but is no longer refreshed as it was canceled.
+	closeChannels(1)
+	require.Equal(t, map[lntypes.Hash]string{hashA: "a1"}, updates)
+	require.NotContains(t, refresher.registrations, hashC)
+
+	// A now uses the channels of its new paths, so closing the other
+	// channel of its old paths doesn't affect it.
+	closeChannels(2)
+	require.Equal(t, map[lntypes.Hash]string{hashA: "a1"}, updates)
+
+	// Once B expired, it is no longer refreshed.
+	testClock.SetTime(testTime.Add(2 * time.Minute))
+	closeChannels(3)
+	require.Equal(t, map[lntypes.Hash]string{hashA: "a1"}, updates)
+	require.NotContains(t, refresher.registrations, hashB)
+
+	// If the paths can't be rebuilt, the invoice stays registered so that
+	// the refresh is retried on the next close.
+	delete(reissues, hashA)
+	register(hashA, []uint64{4, 8}, expiry)
+	closeChannels(4)
+	require.Equal(t, map[lntypes.Hash]string{hashA: "a1"}, updates)
+
+	reg := refresher.registrations[hashA]
+	require.NotNil(t, reg)
+	require.Equal(t, []uint64{8}, reg.Channels)
+
+	// Only the registration of A is left in the store, and it's restored
+	// with its remaining channels after a restart.
+	require.NoError(t, refresher.Stop())
+
+	refreshes, err := store.FetchBlindedPathRefreshes()
+	require.NoError(t, err)
+	require.Len(t, refreshes, 1)
+	require.Equal(t, hashA, refreshes[0].Hash)
+
+	refresher = newRefresher()
+	t.Cleanup(func() {
+		require.NoError(t, refresher.Stop())
+	})
+	require.Equal(t, []uint64{8}, refresher.registrations[hashA].Channels)
+
+	reissues[hashA] = reissue{payReq: "a2", channels: []uint64{9}}
+	closeChannels(8)
+	require.Equal(t, map[lntypes.Hash]string{hashA: "a2"}, updates)
+
+	// Once the invoice is unregistered, it's removed from the store too.
+	refresher.Unregister(hashA)
+	refreshes, err = store.FetchBlindedPathRefreshes()
+	require.NoError(t, err)
+	require.Empty(t, refreshes)
+}
+
+// newTestRefresh returns a blinded path refresh with a fully populated blinded
+// path config.
+func newTestRefresh(hash lntypes.Hash, channels []uint64,
+	expiry time.Time) *BlindedPathRefresh {
+
+	return &BlindedPathRefresh{
+		Hash:            hash,
+		Channels:        channels,
+		Expiry:          expiry,
+		CltvExpiryDelta: 80,
+		BlindCfg: &BlindedPathConfig{
+			RoutePolicyIncrMultiplier: 1.1,
+			RoutePolicyDecrMultiplier: 0.9,
+			MinNumPathHops:            2,
+			DefaultDummyHopPolicy: &blindedpath.BlindedHopPolicy{
+				CLTVExpiryDelta: 40,
+				FeeRate:         100,
+				BaseFee:         1000,
+				MinHTLCMsat:     1,
+			},
+			RefreshOnChannelClose: true,
+			RouteRestrictions: &routing.BlindedPathRestrictions{
+				MinDistanceFromIntroNode: 1,
+				NumHops:                  2,
+				MaxNumPaths:              3,
+				NodeOmissionSet: fn.NewSet(
+					route.Vertex{1}, route.Vertex{2},
+				),
+				IncomingChainedChannels: []uint64{10, 11},
+				DiverseIntroNodes:       true,
+			},
+		},
+	}
+}
+
+// TestRefreshStore tests that blinded path refreshes are stored, replaced and
+// deleted, and that they survive recreating the store.
+func TestRefreshStore(t *testing.T) {
+	t.Parallel()
+
+	cdb, err := channeldb.MakeTestDB(t)
+	require.NoError(t, err)
+
+	store, err := NewRefreshStore(cdb)
+	require.NoError(t, err)
+
+	expiry := time.Unix(1_000_000, 0)
+	refresh1 := newTestRefresh(lntypes.Hash{1}, []uint64{1, 2}, expiry)
+	refresh2 := newTestRefresh(lntypes.Hash{2}, nil, expiry)
+	restrictions := refresh2.BlindCfg.RouteRestrictions
+	restrictions.NodeOmissionSet = fn.NewSet[route.Vertex]()
+	restrictions.IncomingChainedChannels = nil
+	refresh2.BlindCfg.DefaultDummyHopPolicy = nil
+
+	require.NoError(t, store.PutBlindedPathRefresh(refresh1))
+	require.NoError(t, store.PutBlindedPathRefresh(refresh2))
+
+	// A stored refresh is replaced by the next one.
+	refresh1.Channels = []uint64{3}
+	require.NoError(t, store.PutBlindedPathRefresh(refresh1))
+
+	// A refresh without route restrictions can't be stored.
+	invalid := newTestRefresh(lntypes.Hash{3}, nil, expiry)
+	invalid.BlindCfg.RouteRestrictions = nil
+	require.Error(t, store.PutBlindedPathRefresh(invalid))
+
+	// The refreshes survive recreating the store.
+	store, err = NewRefreshStore(cdb)
+	require.NoError(t, err)
+
+	refreshes, err := store.FetchBlindedPathRefreshes()
+	require.NoError(t, err)
+	require.ElementsMatch(
+		t, []*BlindedPathRefresh{refresh1, refresh2}, refreshes,
+	)
+
+	// Deleting a refresh leaves the others untouched.
+	require.NoError(t, store.DeleteBlindedPathRefresh(refresh1.Hash))
+	require.NoError(t, store.DeleteBlindedPathRefresh(refresh1.Hash))
+
+	refreshes, err = store.FetchBlindedPathRefreshes()
+	require.NoError(t, err)
+	require.Equal(t, []*BlindedPathRefresh{refresh2}, refreshes)
+}
@@ -0,0 +1,382 @@
+package invoicesrpc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/blindedpath"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// blindedPathRefreshBucket is the top-level bucket that stores the
+	// invoices whose blinded paths are refreshed on channel closes.
+	//
+	// maps: paymentHash -> BlindedPathRefresh
+	blindedPathRefreshBucket = []byte("blinded-path-refreshes")
+)
+
+// RefreshStore persists the invoices registered with the blinded path
+// refresher.
+type RefreshStore interface {
+	// PutBlindedPathRefresh adds or replaces the registration of an
+	// invoice.
+	PutBlindedPathRefresh(refresh *BlindedPathRefresh) error
+
+	// FetchBlindedPathRefreshes returns all registrations.
+	FetchBlindedPathRefreshes() ([]*BlindedPathRefresh, error)
+
+	// DeleteBlindedPathRefresh deletes the registration of the invoice
+	// with the given payment hash.
+	DeleteBlindedPathRefresh(hash lntypes.Hash) error
+}
+
+// kvRefreshStore is a RefreshStore backed by a kvdb backend.
+type kvRefreshStore struct {
+	db kvdb.Backend
+}
+
+// A compile-time assertion to ensure that kvRefreshStore implements the
+// RefreshStore interface.
+var _ RefreshStore = (*kvRefreshStore)(nil)
+
+// NewRefreshStore creates a new store for blinded path refreshes, creating its
+// bucket if needed.
+func NewRefreshStore(db kvdb.Backend) (RefreshStore, error) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(blindedPathRefreshBucket)
+		return err
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &kvRefreshStore{db: db}, nil
+}
+
+// refreshRecord is the serialized representation of a BlindedPathRefresh.
+type refreshRecord struct {
+	channels             []byte
+	expiry               uint64
+	cltvExpiryDelta      uint64
+	policyIncrMultiplier uint64
+	policyDecrMultiplier uint64
+	minNumPathHops       uint8
+	hasDummyHopPolicy    uint8
+	dummyCltvExpiryDelta uint16
+	dummyFeeRate         uint32
+	dummyBaseFee         uint64
+	dummyMinHTLC         uint64
+	dummyMaxHTLC         uint64
+	minDistance          uint8
+	numHops              uint8
+	maxNumPaths          uint8
+	omittedNodes         []byte
+	chainedChannels      []byte
+	diverseIntroNodes    uint8
+}
+
+// toTlvStream converts a refreshRecord into a tlv representation.
+func (r *refreshRecord) toTlvStream() (*tlv.Stream, error) {
+	const (
+		// A set of tlv type definitions used to serialize a blinded
+		// path refresh.
+		//
+		// NOTE: The payment hash is stored as the key, so it's not
+		// included here.
+		channelsType             tlv.Type = 0
+		expiryType               tlv.Type = 1
+		cltvExpiryDeltaType      tlv.Type = 2
+		policyIncrMultiplierType tlv.Type = 3
+		policyDecrMultiplierType tlv.Type = 4
+		minNumPathHopsType       tlv.Type = 5
+		hasDummyHopPolicyType    tlv.Type = 6
+		dummyCltvExpiryDeltaType tlv.Type = 7
+		dummyFeeRateType         tlv.Type = 8
+		dummyBaseFeeType         tlv.Type = 9
+		dummyMinHTLCType         tlv.Type = 10
+		dummyMaxHTLCType         tlv.Type = 11
+		minDistanceType          tlv.Type = 12
+		numHopsType              tlv.Type = 13
+		maxNumPathsType          tlv.Type = 14
+		omittedNodesType         tlv.Type = 15
+		chainedChannelsType      tlv.Type = 16
+		diverseIntroNodesType    tlv.Type = 17
+	)
+
+	return tlv.NewStream(
+		tlv.MakePrimitiveRecord(channelsType, &r.channels),
+		tlv.MakePrimitiveRecord(expiryType, &r.expiry),
+		tlv.MakePrimitiveRecord(
+			cltvExpiryDeltaType, &r.cltvExpiryDelta,
+		),
+		tlv.MakePrimitiveRecord(
+			policyIncrMultiplierType, &r.policyIncrMultiplier,
+		),
+		tlv.MakePrimitiveRecord(
+			policyDecrMultiplierType, &r.policyDecrMultiplier,
+		),
+		tlv.MakePrimitiveRecord(minNumPathHopsType, &r.minNumPathHops),
+		tlv.MakePrimitiveRecord(
+			hasDummyHopPolicyType, &r.hasDummyHopPolicy,
+		),
+		tlv.MakePrimitiveRecord(
+			dummyCltvExpiryDeltaType, &r.dummyCltvExpiryDelta,
+		),
+		tlv.MakePrimitiveRecord(dummyFeeRateType, &r.dummyFeeRate),
+		tlv.MakePrimitiveRecord(dummyBaseFeeType, &r.dummyBaseFee),
+		tlv.MakePrimitiveRecord(dummyMinHTLCType, &r.dummyMinHTLC),
+		tlv.MakePrimitiveRecord(dummyMaxHTLCType, &r.dummyMaxHTLC),
+		tlv.MakePrimitiveRecord(minDistanceType, &r.minDistance),
+		tlv.MakePrimitiveRecord(numHopsType, &r.numHops),
+		tlv.MakePrimitiveRecord(maxNumPathsType, &r.maxNumPaths),
+		tlv.MakePrimitiveRecord(omittedNodesType, &r.omittedNodes),
+		tlv.MakePrimitiveRecord(
+			chainedChannelsType, &r.chainedChannels,
+		),
+		tlv.MakePrimitiveRecord(
+			diverseIntroNodesType, &r.diverseIntroNodes,
+		),
+	)
+}
+
+// encodeChanIDs encodes a list of channel IDs as concatenated big-endian
+// integers.
+func encodeChanIDs(chanIDs []uint64) []byte {
+	b := make([]byte, 0, 8*len(chanIDs))
+	for _, chanID := range chanIDs {
+		b = binary.BigEndian.AppendUint64(b, chanID)
+	}
+
+	return b
+}
+
+// decodeChanIDs decodes a list of channel IDs encoded by encodeChanIDs.
+func decodeChanIDs(b []byte) ([]uint64, error) {
+	if len(b)%8 != 0 {
+		return nil, fmt.Errorf("invalid channel list length %d", len(b))
+	}
+
+	var chanIDs []uint64
+	for i := 0; i < len(b); i += 8 {
+		chanIDs = append(chanIDs, binary.BigEndian.Uint64(b[i:]))
+	}
+
+	return chanIDs, nil
+}
+
+// boolToUint8 converts a bool into its serialized representation.
+func boolToUint8(b bool) uint8 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+// serializeBlindedPathRefresh serializes a BlindedPathRefresh based on tlv
+// format.
+func serializeBlindedPathRefresh(refresh *BlindedPathRefresh) ([]byte, error) {
+	blindCfg := refresh.BlindCfg
+	if blindCfg == nil || blindCfg.RouteRestrictions == nil {
+		return nil, fmt.Errorf("blinded path refresh of invoice %v "+
+			"has no route restrictions", refresh.Hash)
+	}
+
+	restrictions := blindCfg.RouteRestrictions
+
+	r := &refreshRecord{
+		channels:        encodeChanIDs(refresh.Channels),
+		expiry:          uint64(refresh.Expiry.UnixNano()),
+		cltvExpiryDelta: refresh.CltvExpiryDelta,
+		policyIncrMultiplier: math.Float64bits(
+			blindCfg.RoutePolicyIncrMultiplier,
+		),
+		policyDecrMultiplier: math.Float64bits(
+			blindCfg.RoutePolicyDecrMultiplier,
+		),
+		minNumPathHops: blindCfg.MinNumPathHops,
+		minDistance:    restrictions.MinDistanceFromIntroNode,
+		numHops:        restrictions.NumHops,
+		maxNumPaths:    restrictions.MaxNumPaths,
+		chainedChannels: encodeChanIDs(
+			restrictions.IncomingChainedChannels,
+		),
+		diverseIntroNodes: boolToUint8(
+			restrictions.DiverseIntroNodes,
+		),
+	}
+
+	if policy := blindCfg.DefaultDummyHopPolicy; policy != nil {
+		r.hasDummyHopPolicy = 1
+		r.dummyCltvExpiryDelta = policy.CLTVExpiryDelta
+		r.dummyFeeRate = policy.FeeRate
+		r.dummyBaseFee = uint64(policy.BaseFee)
+		r.dummyMinHTLC = uint64(policy.MinHTLCMsat)
+		r.dummyMaxHTLC = uint64(policy.MaxHTLCMsat)
+	}
+
+	for node := range restrictions.NodeOmissionSet {
+		r.omittedNodes = append(r.omittedNodes, node[:]...)
+	}
+
+	tlvStream, err := r.toTlvStream()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tlvStream.Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// deserializeBlindedPathRefresh deserializes the BlindedPathRefresh of the
+// invoice with the given payment hash based on tlv format.
+func deserializeBlindedPathRefresh(hash lntypes.Hash,
+	b []byte) (*BlindedPathRefresh, error) {
+
+	var r refreshRecord
+	tlvStream, err := r.toTlvStream()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Decode(bytes.NewReader(b)); err != nil {
+		return nil, err
+	}
+
+	channels, err := decodeChanIDs(r.channels)
+	if err != nil {
+		return nil, err
+	}
+
+	chainedChannels, err := decodeChanIDs(r.chainedChannels)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(r.omittedNodes)%route.VertexSize != 0 {
+		return nil, fmt.Errorf("invalid omitted node list length %d",
+			len(r.omittedNodes))
+	}
+
+	omissionSet := fn.NewSet[route.Vertex]()
+	for i := 0; i < len(r.omittedNodes); i += route.VertexSize {
+		node, err := route.NewVertexFromBytes(
+			r.omittedNodes[i : i+route.VertexSize],
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		omissionSet.Add(node)
+	}
+
+	blindCfg := &BlindedPathConfig{
+		RoutePolicyIncrMultiplier: math.Float64frombits(
+			r.policyIncrMultiplier,
+		),
+		RoutePolicyDecrMultiplier: math.Float64frombits(
+			r.policyDecrMultiplier,
+		),
+		MinNumPathHops:        r.minNumPathHops,
+		RefreshOnChannelClose: true,
+		RouteRestrictions: &routing.BlindedPathRestrictions{
+			MinDistanceFromIntroNode: r.minDistance,
+			NumHops:                  r.numHops,
+			MaxNumPaths:              r.maxNumPaths,
+			NodeOmissionSet:          omissionSet,
+			IncomingChainedChannels:  chainedChannels,
+			DiverseIntroNodes:        r.diverseIntroNodes == 1,
+		},
+	}
+
+	if r.hasDummyHopPolicy == 1 {
+		blindCfg.DefaultDummyHopPolicy = &blindedpath.BlindedHopPolicy{
+			CLTVExpiryDelta: r.dummyCltvExpiryDelta,
+			FeeRate:         r.dummyFeeRate,
+			BaseFee:         lnwire.MilliSatoshi(r.dummyBaseFee),
+			MinHTLCMsat:     lnwire.MilliSatoshi(r.dummyMinHTLC),
+			MaxHTLCMsat:     lnwire.MilliSatoshi(r.dummyMaxHTLC),
+		}
+	}
+
+	return &BlindedPathRefresh{
+		Hash:            hash,
+		Channels:        channels,
+		Expiry:          time.Unix(0, int64(r.expiry)),
+		CltvExpiryDelta: r.cltvExpiryDelta,
+		BlindCfg:        blindCfg,
+	}, nil
+}
+
+// PutBlindedPathRefresh adds or replaces the registration of an invoice.
+func (s *kvRefreshStore) PutBlindedPathRefresh(
+	refresh *BlindedPathRefresh) error {
+
+	value, err := serializeBlindedPathRefresh(refresh)
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(blindedPathRefreshBucket)
+
+		return bucket.Put(refresh.Hash[:], value)
+	}, func() {})
+}
+
+// FetchBlindedPathRefreshes returns all registrations.
+func (s *kvRefreshStore) FetchBlindedPathRefreshes() ([]*BlindedPathRefresh,
+	error) {
+
+	var refreshes []*BlindedPathRefresh
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(blindedPathRefreshBucket)
+
+		return bucket.ForEach(func(k, v []byte) error {
+			hash, err := lntypes.MakeHash(k)
+			if err != nil {
+				return err
+			}
+
+			refresh, err := deserializeBlindedPathRefresh(hash, v)
+			if err != nil {
+				return err
+			}
+
+			refreshes = append(refreshes, refresh)
+
+			return nil
+		})
+	}, func() {
+		refreshes = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return refreshes, nil
+}
+
+// DeleteBlindedPathRefresh deletes the registration of the invoice with the
+// given payment hash. Deleting an unknown registration is a no-op.
+func (s *kvRefreshStore) DeleteBlindedPathRefresh(hash lntypes.Hash) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(blindedPathRefreshBucket)
+
+		return bucket.Delete(hash[:])
+	}, func() {})
+}
@@ -78,6 +78,183 @@ func (LookupModifier) EnumDescriptor() ([]byte, []int) {
 	return file_invoicesrpc_invoices_proto_rawDescGZIP(), []int{0}
 }
 
+type SubscriptionState int32
+
+const (
+	// Invoices are still created for new periods.
+	SubscriptionState_SUBSCRIPTION_ACTIVE SubscriptionState = 0
+	// The subscription was canceled.
+	SubscriptionState_SUBSCRIPTION_CANCELED SubscriptionState = 1
+	// All periods of the subscription were invoiced and resolved.
+	SubscriptionState_SUBSCRIPTION_COMPLETED SubscriptionState = 2
+)
+
+// Enum value maps for SubscriptionState.
+var (
+	SubscriptionState_name = map[int32]string{
+		0: "SUBSCRIPTION_ACTIVE",
+		1: "SUBSCRIPTION_CANCELED",
+		2: "SUBSCRIPTION_COMPLETED",
+	}
+	SubscriptionState_value = map[string]int32{
+		"SUBSCRIPTION_ACTIVE":    0,
+		"SUBSCRIPTION_CANCELED":  1,
+		"SUBSCRIPTION_COMPLETED": 2,
+	}
+)
+
+func (x SubscriptionState) Enum() *SubscriptionState {
+	p := new(SubscriptionState)
+	*p = x
+	return p
+}
+
+func (x SubscriptionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubscriptionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_invoicesrpc_invoices_proto_enumTypes[1].Descriptor()
+}
+
+func (SubscriptionState) Type() protoreflect.EnumType {
+	return &file_invoicesrpc_invoices_proto_enumTypes[1]
+}
+
+func (x SubscriptionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubscriptionState.Descriptor instead.
+func (SubscriptionState) EnumDescriptor() ([]byte, []int) {
+	return file_invoicesrpc_invoices_proto_rawDescGZIP(), []int{1}
+}
+
+type PeriodState int32
+
+const (
+	// The invoice of the period can still be paid.
+	PeriodState_PERIOD_OPEN PeriodState = 0
+	// The invoice of the period was paid.
+	PeriodState_PERIOD_PAID PeriodState = 1
+	// The invoice of the period expired or was canceled without being paid.
+	PeriodState_PERIOD_UNPAID PeriodState = 2
+	// The period ended before its invoice was created, e.g. because the node
+	// was offline, so it was never invoiced.
+	PeriodState_PERIOD_SKIPPED PeriodState = 3
+)
+
+// Enum value maps for PeriodState.
+var (
+	PeriodState_name = map[int32]string{
+		0: "PERIOD_OPEN",
+		1: "PERIOD_PAID",
+		2: "PERIOD_UNPAID",
+		3: "PERIOD_SKIPPED",
+	}
+	PeriodState_value = map[string]int32{
+		"PERIOD_OPEN":    0,
+		"PERIOD_PAID":    1,
+		"PERIOD_UNPAID":  2,
+		"PERIOD_SKIPPED": 3,
+	}
+)
+
+func (x PeriodState) Enum() *PeriodState {
+	p := new(PeriodState)
+	*p = x
+	return p
+}
+
+func (x PeriodState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeriodState) Descriptor() protoreflect.EnumDescriptor {
+	return file_invoicesrpc_invoices_proto_enumTypes[2].Descriptor()
+}
+
+func (PeriodState) Type() protoreflect.EnumType {
+	return &file_invoicesrpc_invoices_proto_enumTypes[2]
+}
+
+func (x PeriodState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeriodState.Descriptor instead.
+func (PeriodState) EnumDescriptor() ([]byte, []int) {
+	return file_invoicesrpc_invoices_proto_rawDescGZIP(), []int{2}
+}
+
+type SubscriptionEvent_EventType int32
+
+const (
+	// The subscription was added.
+	SubscriptionEvent_CREATED SubscriptionEvent_EventType = 0
+	// The invoice of a new period was created.
+	SubscriptionEvent_INVOICE_CREATED SubscriptionEvent_EventType = 1
+	// The invoice of a period was paid.
+	SubscriptionEvent_PERIOD_PAID SubscriptionEvent_EventType = 2
+	// The invoice of a period expired or was canceled.
+	SubscriptionEvent_PERIOD_UNPAID SubscriptionEvent_EventType = 3
+	// The subscription was canceled.
+	SubscriptionEvent_CANCELED SubscriptionEvent_EventType = 4
+	// All periods of the subscription were invoiced and resolved.
+	SubscriptionEvent_COMPLETED SubscriptionEvent_EventType = 5
+	// A period ended before its invoice was created and was skipped.
+	SubscriptionEvent_PERIOD_SKIPPED SubscriptionEvent_EventType = 6
+)
+
+// Enum value maps for SubscriptionEvent_EventType.
+var (
+	SubscriptionEvent_EventType_name = map[int32]string{
+		0: "CREATED",
+		1: "INVOICE_CREATED",
+		2: "PERIOD_PAID",
+		3: "PERIOD_UNPAID",
+		4: "CANCELED",
+		5: "COMPLETED",
+		6: "PERIOD_SKIPPED",
+	}
+	SubscriptionEvent_EventType_value = map[string]int32{
+		"CREATED":         0,
+		"INVOICE_CREATED": 1,
+		"PERIOD_PAID":     2,
+		"PERIOD_UNPAID":   3,
+		"CANCELED":        4,
+		"COMPLETED":       5,
+		"PERIOD_SKIPPED":  6,
+	}
+)
+
+func (x SubscriptionEvent_EventType) Enum() *SubscriptionEvent_EventType {
+	p := new(SubscriptionEvent_EventType)
+	*p = x
+	return p
+}
+
+func (x SubscriptionEvent_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubscriptionEvent_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_invoicesrpc_invoices_proto_enumTypes[3].Descriptor()
+}
+
+func (SubscriptionEvent_EventType) Type() protoreflect.EnumType {
+	return &file_invoicesrpc_invoices_proto_enumTypes[3]
+}
+
+func (x SubscriptionEvent_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubscriptionEvent_EventType.Descriptor instead.
+func (SubscriptionEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_invoicesrpc_invoices_proto_rawDescGZIP(), []int{19, 0}
+}
+
 type CancelInvoiceMsg struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Hash corresponding to the (hold) invoice to cancel. When using
@@ -191,7 +368,20 @@ type AddHoldInvoiceRequest struct {
 	// invoice's destination.
 	RouteHints []*lnrpc.RouteHint `protobuf:"bytes,8,rep,name=route_hints,json=routeHints,proto3" json:"route_hints,omitempty"`
 	// Whether this invoice should include routing hints for private channels.
-	Private       bool `protobuf:"varint,9,opt,name=private,proto3" json:"private,omitempty"`
+	Private bool `protobuf:"varint,9,opt,name=private,proto3" json:"private,omitempty"`
+	// The maximum number of seconds the HTLCs of the invoice may be held after
+	// the first one was accepted. If this time is exceeded, the invoice is
+	// canceled. Zero means that there is no limit.
+	MaxHoldSeconds uint64 `protobuf:"varint,11,opt,name=max_hold_seconds,json=maxHoldSeconds,proto3" json:"max_hold_seconds,omitempty"`
+	// The minimum number of blocks that need to be left before the HTLCs of the
+	// invoice expire. If fewer blocks are left, the invoice is canceled. Must be
+	// lower than the final CLTV delta of the invoice. Zero means that the default
+	// of the node is used.
+	MinCltvSlack uint32 `protobuf:"varint,12,opt,name=min_cltv_slack,json=minCltvSlack,proto3" json:"min_cltv_slack,omitempty"`
+	// Whether the invoice should be settled automatically as soon as its
+	// preimage becomes known to the node, for example because it was revealed
+	// by a payment of the same hash.
+	AutoSettle    bool `protobuf:"varint,13,opt,name=auto_settle,json=autoSettle,proto3" json:"auto_settle,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -296,6 +486,27 @@ func (x *AddHoldInvoiceRequest) GetPrivate() bool {
 	return false
 }
 
+func (x *AddHoldInvoiceRequest) GetMaxHoldSeconds() uint64 {
+	if x != nil {
+		return x.MaxHoldSeconds
+	}
+	return 0
+}
+
+func (x *AddHoldInvoiceRequest) GetMinCltvSlack() uint32 {
+	if x != nil {
+		return x.MinCltvSlack
+	}
+	return 0
+}
+
+func (x *AddHoldInvoiceRequest) GetAutoSettle() bool {
+	if x != nil {
+		return x.AutoSettle
+	}
+	return false
+}
+
 type AddHoldInvoiceResp struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// A bare-bones invoice for a payment within the Lightning Network. With the
@@ -818,6 +1029,624 @@ func (x *HtlcModifyResponse) GetCancelSet() bool {
 	return false
 }
 
+type AddSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The description of the subscription, which is included in the memo of
+	// every invoice.
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The amount in millisatoshis that is invoiced per period.
+	ValueMsat uint64 `protobuf:"varint,2,opt,name=value_msat,json=valueMsat,proto3" json:"value_msat,omitempty"`
+	// The length of a period in seconds. Must be at least 60 seconds.
+	IntervalSeconds uint64 `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// The unix timestamp in seconds of the start of the first period. If not
+	// set, the first period starts immediately.
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The total number of periods. If not set, the subscription runs until it
+	// is canceled.
+	NumPeriods uint32 `protobuf:"varint,5,opt,name=num_periods,json=numPeriods,proto3" json:"num_periods,omitempty"`
+	// The expiry of the invoice of every period in seconds. Must not exceed the
+	// interval. If not set, a default of 24 hours capped to the interval is
+	// used.
+	InvoiceExpirySeconds uint64 `protobuf:"varint,6,opt,name=invoice_expiry_seconds,json=invoiceExpirySeconds,proto3" json:"invoice_expiry_seconds,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AddSubscriptionRequest) Reset() {
+	*x = AddSubscriptionRequest{}
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSubscriptionRequest) ProtoMessage() {}
+
+func (x *AddSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*AddSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_invoicesrpc_invoices_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddSubscriptionRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *AddSubscriptionRequest) GetValueMsat() uint64 {
+	if x != nil {
+		return x.ValueMsat
+	}
+	return 0
+}
+
+func (x *AddSubscriptionRequest) GetIntervalSeconds() uint64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *AddSubscriptionRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *AddSubscriptionRequest) GetNumPeriods() uint32 {
+	if x != nil {
+		return x.NumPeriods
+	}
+	return 0
+}
+
+func (x *AddSubscriptionRequest) GetInvoiceExpirySeconds() uint64 {
+	if x != nil {
+		return x.InvoiceExpirySeconds
+	}
+	return 0
+}
+
+type SubscriptionPeriod struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The zero based index of the period.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// The payment hash of the invoice of the period, unset if it was skipped.
+	PaymentHash []byte `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	// The encoded invoice of the period, unset if it was skipped.
+	PaymentRequest string `protobuf:"bytes,3,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	// The unix timestamp in seconds the invoice was created at, or the period
+	// was skipped at.
+	CreationDate int64 `protobuf:"varint,4,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	// The payment state of the period.
+	State PeriodState `protobuf:"varint,5,opt,name=state,proto3,enum=invoicesrpc.PeriodState" json:"state,omitempty"`
+	// The unix timestamp in seconds the invoice was paid at.
+	SettleDate int64 `protobuf:"varint,6,opt,name=settle_date,json=settleDate,proto3" json:"settle_date,omitempty"`
+	// The amount in millisatoshis the invoice was paid with.
+	AmtPaidMsat   uint64 `protobuf:"varint,7,opt,name=amt_paid_msat,json=amtPaidMsat,proto3" json:"amt_paid_msat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionPeriod) Reset() {
+	*x = SubscriptionPeriod{}
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionPeriod) ProtoMessage() {}
+
+func (x *SubscriptionPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionPeriod.ProtoReflect.Descriptor instead.
+func (*SubscriptionPeriod) Descriptor() ([]byte, []int) {
+	return file_invoicesrpc_invoices_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SubscriptionPeriod) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SubscriptionPeriod) GetPaymentHash() []byte {
+	if x != nil {
+		return x.PaymentHash
+	}
+	return nil
+}
+
+func (x *SubscriptionPeriod) GetPaymentRequest() string {
+	if x != nil {
+		return x.PaymentRequest
+	}
+	return ""
+}
+
+func (x *SubscriptionPeriod) GetCreationDate() int64 {
+	if x != nil {
+		return x.CreationDate
+	}
+	return 0
+}
+
+func (x *SubscriptionPeriod) GetState() PeriodState {
+	if x != nil {
+		return x.State
+	}
+	return PeriodState_PERIOD_OPEN
+}
+
+func (x *SubscriptionPeriod) GetSettleDate() int64 {
+	if x != nil {
+		return x.SettleDate
+	}
+	return 0
+}
+
+func (x *SubscriptionPeriod) GetAmtPaidMsat() uint64 {
+	if x != nil {
+		return x.AmtPaidMsat
+	}
+	return 0
+}
+
+type Subscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the subscription.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The description of the subscription.
+	Memo string `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The amount in millisatoshis that is invoiced per period.
+	ValueMsat uint64 `protobuf:"varint,3,opt,name=value_msat,json=valueMsat,proto3" json:"value_msat,omitempty"`
+	// The length of a period in seconds.
+	IntervalSeconds uint64 `protobuf:"varint,4,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// The unix timestamp in seconds of the start of the first period.
+	StartTime int64 `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The total number of periods, zero if the subscription is unlimited.
+	NumPeriods uint32 `protobuf:"varint,6,opt,name=num_periods,json=numPeriods,proto3" json:"num_periods,omitempty"`
+	// The expiry of the invoice of every period in seconds.
+	InvoiceExpirySeconds uint64 `protobuf:"varint,7,opt,name=invoice_expiry_seconds,json=invoiceExpirySeconds,proto3" json:"invoice_expiry_seconds,omitempty"`
+	// The unix timestamp in seconds the subscription was created at.
+	CreationDate int64 `protobuf:"varint,8,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	// The state of the subscription.
+	State SubscriptionState `protobuf:"varint,9,opt,name=state,proto3,enum=invoicesrpc.SubscriptionState" json:"state,omitempty"`
+	// All periods that were invoiced or skipped so far.
+	Periods []*SubscriptionPeriod `protobuf:"bytes,10,rep,name=periods,proto3" json:"periods,omitempty"`
+	// The number of paid periods.
+	NumPaid uint32 `protobuf:"varint,11,opt,name=num_paid,json=numPaid,proto3" json:"num_paid,omitempty"`
+	// The number of unpaid periods.
+	NumUnpaid uint32 `protobuf:"varint,12,opt,name=num_unpaid,json=numUnpaid,proto3" json:"num_unpaid,omitempty"`
+	// The number of periods that were skipped without being invoiced.
+	NumSkipped    uint32 `protobuf:"varint,13,opt,name=num_skipped,json=numSkipped,proto3" json:"num_skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_invoicesrpc_invoices_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Subscription) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Subscription) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *Subscription) GetValueMsat() uint64 {
+	if x != nil {
+		return x.ValueMsat
+	}
+	return 0
+}
+
+func (x *Subscription) GetIntervalSeconds() uint64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *Subscription) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *Subscription) GetNumPeriods() uint32 {
+	if x != nil {
+		return x.NumPeriods
+	}
+	return 0
+}
+
+func (x *Subscription) GetInvoiceExpirySeconds() uint64 {
+	if x != nil {
+		return x.InvoiceExpirySeconds
+	}
+	return 0
+}
+
+func (x *Subscription) GetCreationDate() int64 {
+	if x != nil {
+		return x.CreationDate
+	}
+	return 0
+}
+
+func (x *Subscription) GetState() SubscriptionState {
+	if x != nil {
+		return x.State
+	}
+	return SubscriptionState_SUBSCRIPTION_ACTIVE
+}
+
+func (x *Subscription) GetPeriods() []*SubscriptionPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *Subscription) GetNumPaid() uint32 {
+	if x != nil {
+		return x.NumPaid
+	}
+	return 0
+}
+
+func (x *Subscription) GetNumUnpaid() uint32 {
+	if x != nil {
+		return x.NumUnpaid
+	}
+	return 0
+}
+
+func (x *Subscription) GetNumSkipped() uint32 {
+	if x != nil {
+		return x.NumSkipped
+	}
+	return 0
+}
+
+type ListSubscriptionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// If set, only active subscriptions are returned.
+	ActiveOnly    bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsRequest) Reset() {
+	*x = ListSubscriptionsRequest{}
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_invoicesrpc_invoices_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListSubscriptionsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListSubscriptionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The subscriptions, ordered by their id.
+	Subscriptions []*Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsResponse) Reset() {
+	*x = ListSubscriptionsResponse{}
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_invoicesrpc_invoices_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type CancelSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the subscription to cancel.
+	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSubscriptionRequest) Reset() {
+	*x = CancelSubscriptionRequest{}
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionRequest) ProtoMessage() {}
+
+func (x *CancelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_invoicesrpc_invoices_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CancelSubscriptionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSubscriptionResponse) Reset() {
+	*x = CancelSubscriptionResponse{}
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionResponse) ProtoMessage() {}
+
+func (x *CancelSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_invoicesrpc_invoices_proto_rawDescGZIP(), []int{17}
+}
+
+type SubscribeSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeSubscriptionsRequest) Reset() {
+	*x = SubscribeSubscriptionsRequest{}
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeSubscriptionsRequest) ProtoMessage() {}
+
+func (x *SubscribeSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_invoicesrpc_invoices_proto_rawDescGZIP(), []int{18}
+}
+
+type SubscriptionEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The type of the event.
+	Type SubscriptionEvent_EventType `protobuf:"varint,1,opt,name=type,proto3,enum=invoicesrpc.SubscriptionEvent_EventType" json:"type,omitempty"`
+	// The subscription after the change.
+	Subscription *Subscription `protobuf:"bytes,2,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// The period the event refers to, if any.
+	Period        *SubscriptionPeriod `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionEvent) Reset() {
+	*x = SubscriptionEvent{}
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionEvent) ProtoMessage() {}
+
+func (x *SubscriptionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicesrpc_invoices_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionEvent.ProtoReflect.Descriptor instead.
+func (*SubscriptionEvent) Descriptor() ([]byte, []int) {
+	return file_invoicesrpc_invoices_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SubscriptionEvent) GetType() SubscriptionEvent_EventType {
+	if x != nil {
+		return x.Type
+	}
+	return SubscriptionEvent_CREATED
+}
+
+func (x *SubscriptionEvent) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *SubscriptionEvent) GetPeriod() *SubscriptionPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
 var File_invoicesrpc_invoices_proto protoreflect.FileDescriptor
 
 const file_invoicesrpc_invoices_proto_rawDesc = "" +
@@ -825,7 +1654,7 @@ const file_invoicesrpc_invoices_proto_rawDesc = "" +
 	"\x1ainvoicesrpc/invoices.proto\x12\vinvoicesrpc\x1a\x0flightning.proto\"5\n" +
 	"\x10CancelInvoiceMsg\x12!\n" +
 	"\fpayment_hash\x18\x01 \x01(\fR\vpaymentHash\"\x13\n" +
-	"\x11CancelInvoiceResp\"\xca\x02\n" +
+	"\x11CancelInvoiceResp\"\xbb\x03\n" +
 	"\x15AddHoldInvoiceRequest\x12\x12\n" +
 	"\x04memo\x18\x01 \x01(\tR\x04memo\x12\x12\n" +
 	"\x04hash\x18\x02 \x01(\fR\x04hash\x12\x14\n" +
@@ -840,7 +1669,11 @@ const file_invoicesrpc_invoices_proto_rawDesc = "" +
 	"cltvExpiry\x121\n" +
 	"\vroute_hints\x18\b \x03(\v2\x10.lnrpc.RouteHintR\n" +
 	"routeHints\x12\x18\n" +
-	"\aprivate\x18\t \x01(\bR\aprivate\"}\n" +
+	"\aprivate\x18\t \x01(\bR\aprivate\x12(\n" +
+	"\x10max_hold_seconds\x18\v \x01(\x04R\x0emaxHoldSeconds\x12$\n" +
+	"\x0emin_cltv_slack\x18\f \x01(\rR\fminCltvSlack\x12\x1f\n" +
+	"\vauto_settle\x18\r \x01(\bR\n" +
+	"autoSettle\"}\n" +
 	"\x12AddHoldInvoiceResp\x12'\n" +
 	"\x0fpayment_request\x18\x01 \x01(\tR\x0epaymentRequest\x12\x1b\n" +
 	"\tadd_index\x18\x02 \x01(\x04R\baddIndex\x12!\n" +
@@ -876,18 +1709,91 @@ const file_invoicesrpc_invoices_proto_rawDesc = "" +
 	"\bamt_paid\x18\x02 \x01(\x04H\x00R\aamtPaid\x88\x01\x01\x12\x1d\n" +
 	"\n" +
 	"cancel_set\x18\x03 \x01(\bR\tcancelSetB\v\n" +
-	"\t_amt_paid*D\n" +
+	"\t_amt_paid\"\xec\x01\n" +
+	"\x16AddSubscriptionRequest\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12\x1d\n" +
+	"\n" +
+	"value_msat\x18\x02 \x01(\x04R\tvalueMsat\x12)\n" +
+	"\x10interval_seconds\x18\x03 \x01(\x04R\x0fintervalSeconds\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\x03R\tstartTime\x12\x1f\n" +
+	"\vnum_periods\x18\x05 \x01(\rR\n" +
+	"numPeriods\x124\n" +
+	"\x16invoice_expiry_seconds\x18\x06 \x01(\x04R\x14invoiceExpirySeconds\"\x90\x02\n" +
+	"\x12SubscriptionPeriod\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x12!\n" +
+	"\fpayment_hash\x18\x02 \x01(\fR\vpaymentHash\x12'\n" +
+	"\x0fpayment_request\x18\x03 \x01(\tR\x0epaymentRequest\x12#\n" +
+	"\rcreation_date\x18\x04 \x01(\x03R\fcreationDate\x12.\n" +
+	"\x05state\x18\x05 \x01(\x0e2\x18.invoicesrpc.PeriodStateR\x05state\x12\x1f\n" +
+	"\vsettle_date\x18\x06 \x01(\x03R\n" +
+	"settleDate\x12\"\n" +
+	"\ramt_paid_msat\x18\a \x01(\x04R\vamtPaidMsat\"\xe3\x03\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04memo\x18\x02 \x01(\tR\x04memo\x12\x1d\n" +
+	"\n" +
+	"value_msat\x18\x03 \x01(\x04R\tvalueMsat\x12)\n" +
+	"\x10interval_seconds\x18\x04 \x01(\x04R\x0fintervalSeconds\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\x03R\tstartTime\x12\x1f\n" +
+	"\vnum_periods\x18\x06 \x01(\rR\n" +
+	"numPeriods\x124\n" +
+	"\x16invoice_expiry_seconds\x18\a \x01(\x04R\x14invoiceExpirySeconds\x12#\n" +
+	"\rcreation_date\x18\b \x01(\x03R\fcreationDate\x124\n" +
+	"\x05state\x18\t \x01(\x0e2\x1e.invoicesrpc.SubscriptionStateR\x05state\x129\n" +
+	"\aperiods\x18\n" +
+	" \x03(\v2\x1f.invoicesrpc.SubscriptionPeriodR\aperiods\x12\x19\n" +
+	"\bnum_paid\x18\v \x01(\rR\anumPaid\x12\x1d\n" +
+	"\n" +
+	"num_unpaid\x18\f \x01(\rR\tnumUnpaid\x12\x1f\n" +
+	"\vnum_skipped\x18\r \x01(\rR\n" +
+	"numSkipped\";\n" +
+	"\x18ListSubscriptionsRequest\x12\x1f\n" +
+	"\vactive_only\x18\x01 \x01(\bR\n" +
+	"activeOnly\"\\\n" +
+	"\x19ListSubscriptionsResponse\x12?\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x19.invoicesrpc.SubscriptionR\rsubscriptions\"+\n" +
+	"\x19CancelSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\x1c\n" +
+	"\x1aCancelSubscriptionResponse\"\x1f\n" +
+	"\x1dSubscribeSubscriptionsRequest\"\xce\x02\n" +
+	"\x11SubscriptionEvent\x12<\n" +
+	"\x04type\x18\x01 \x01(\x0e2(.invoicesrpc.SubscriptionEvent.EventTypeR\x04type\x12=\n" +
+	"\fsubscription\x18\x02 \x01(\v2\x19.invoicesrpc.SubscriptionR\fsubscription\x127\n" +
+	"\x06period\x18\x03 \x01(\v2\x1f.invoicesrpc.SubscriptionPeriodR\x06period\"\x82\x01\n" +
+	"\tEventType\x12\v\n" +
+	"\aCREATED\x10\x00\x12\x13\n" +
+	"\x0fINVOICE_CREATED\x10\x01\x12\x0f\n" +
+	"\vPERIOD_PAID\x10\x02\x12\x11\n" +
+	"\rPERIOD_UNPAID\x10\x03\x12\f\n" +
+	"\bCANCELED\x10\x04\x12\r\n" +
+	"\tCOMPLETED\x10\x05\x12\x12\n" +
+	"\x0ePERIOD_SKIPPED\x10\x06*D\n" +
 	"\x0eLookupModifier\x12\v\n" +
 	"\aDEFAULT\x10\x00\x12\x11\n" +
 	"\rHTLC_SET_ONLY\x10\x01\x12\x12\n" +
-	"\x0eHTLC_SET_BLANK\x10\x022\xf0\x03\n" +
+	"\x0eHTLC_SET_BLANK\x10\x02*c\n" +
+	"\x11SubscriptionState\x12\x17\n" +
+	"\x13SUBSCRIPTION_ACTIVE\x10\x00\x12\x19\n" +
+	"\x15SUBSCRIPTION_CANCELED\x10\x01\x12\x1a\n" +
+	"\x16SUBSCRIPTION_COMPLETED\x10\x02*V\n" +
+	"\vPeriodState\x12\x0f\n" +
+	"\vPERIOD_OPEN\x10\x00\x12\x0f\n" +
+	"\vPERIOD_PAID\x10\x01\x12\x11\n" +
+	"\rPERIOD_UNPAID\x10\x02\x12\x12\n" +
+	"\x0ePERIOD_SKIPPED\x10\x032\xf6\x06\n" +
 	"\bInvoices\x12V\n" +
 	"\x16SubscribeSingleInvoice\x12*.invoicesrpc.SubscribeSingleInvoiceRequest\x1a\x0e.lnrpc.Invoice0\x01\x12N\n" +
 	"\rCancelInvoice\x12\x1d.invoicesrpc.CancelInvoiceMsg\x1a\x1e.invoicesrpc.CancelInvoiceResp\x12U\n" +
 	"\x0eAddHoldInvoice\x12\".invoicesrpc.AddHoldInvoiceRequest\x1a\x1f.invoicesrpc.AddHoldInvoiceResp\x12N\n" +
 	"\rSettleInvoice\x12\x1d.invoicesrpc.SettleInvoiceMsg\x1a\x1e.invoicesrpc.SettleInvoiceResp\x12@\n" +
 	"\x0fLookupInvoiceV2\x12\x1d.invoicesrpc.LookupInvoiceMsg\x1a\x0e.lnrpc.Invoice\x12S\n" +
-	"\fHtlcModifier\x12\x1f.invoicesrpc.HtlcModifyResponse\x1a\x1e.invoicesrpc.HtlcModifyRequest(\x010\x01B3Z1github.com/lightningnetwork/lnd/lnrpc/invoicesrpcb\x06proto3"
+	"\fHtlcModifier\x12\x1f.invoicesrpc.HtlcModifyResponse\x1a\x1e.invoicesrpc.HtlcModifyRequest(\x010\x01\x12Q\n" +
+	"\x0fAddSubscription\x12#.invoicesrpc.AddSubscriptionRequest\x1a\x19.invoicesrpc.Subscription\x12b\n" +
+	"\x11ListSubscriptions\x12%.invoicesrpc.ListSubscriptionsRequest\x1a&.invoicesrpc.ListSubscriptionsResponse\x12e\n" +
+	"\x12CancelSubscription\x12&.invoicesrpc.CancelSubscriptionRequest\x1a'.invoicesrpc.CancelSubscriptionResponse\x12f\n" +
+	"\x16SubscribeSubscriptions\x12*.invoicesrpc.SubscribeSubscriptionsRequest\x1a\x1e.invoicesrpc.SubscriptionEvent0\x01B3Z1github.com/lightningnetwork/lnd/lnrpc/invoicesrpcb\x06proto3"
 
 var (
 	file_invoicesrpc_invoices_proto_rawDescOnce sync.Once
@@ -901,49 +1807,76 @@ func file_invoicesrpc_invoices_proto_rawDescGZIP() []byte {
 	return file_invoicesrpc_invoices_proto_rawDescData
 }
 
-var file_invoicesrpc_invoices_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_invoicesrpc_invoices_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_invoicesrpc_invoices_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_invoicesrpc_invoices_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_invoicesrpc_invoices_proto_goTypes = []any{
 	(LookupModifier)(0),                   // 0: invoicesrpc.LookupModifier
-	(*CancelInvoiceMsg)(nil),              // 1: invoicesrpc.CancelInvoiceMsg
-	(*CancelInvoiceResp)(nil),             // 2: invoicesrpc.CancelInvoiceResp
-	(*AddHoldInvoiceRequest)(nil),         // 3: invoicesrpc.AddHoldInvoiceRequest
-	(*AddHoldInvoiceResp)(nil),            // 4: invoicesrpc.AddHoldInvoiceResp
-	(*SettleInvoiceMsg)(nil),              // 5: invoicesrpc.SettleInvoiceMsg
-	(*SettleInvoiceResp)(nil),             // 6: invoicesrpc.SettleInvoiceResp
-	(*SubscribeSingleInvoiceRequest)(nil), // 7: invoicesrpc.SubscribeSingleInvoiceRequest
-	(*LookupInvoiceMsg)(nil),              // 8: invoicesrpc.LookupInvoiceMsg
-	(*CircuitKey)(nil),                    // 9: invoicesrpc.CircuitKey
-	(*HtlcModifyRequest)(nil),             // 10: invoicesrpc.HtlcModifyRequest
-	(*HtlcModifyResponse)(nil),            // 11: invoicesrpc.HtlcModifyResponse
-	nil,                                   // 12: invoicesrpc.HtlcModifyRequest.ExitHtlcWireCustomRecordsEntry
-	(*lnrpc.RouteHint)(nil),               // 13: lnrpc.RouteHint
-	(*lnrpc.Invoice)(nil),                 // 14: lnrpc.Invoice
+	(SubscriptionState)(0),                // 1: invoicesrpc.SubscriptionState
+	(PeriodState)(0),                      // 2: invoicesrpc.PeriodState
+	(SubscriptionEvent_EventType)(0),      // 3: invoicesrpc.SubscriptionEvent.EventType
+	(*CancelInvoiceMsg)(nil),              // 4: invoicesrpc.CancelInvoiceMsg
+	(*CancelInvoiceResp)(nil),             // 5: invoicesrpc.CancelInvoiceResp
+	(*AddHoldInvoiceRequest)(nil),         // 6: invoicesrpc.AddHoldInvoiceRequest
+	(*AddHoldInvoiceResp)(nil),            // 7: invoicesrpc.AddHoldInvoiceResp
+	(*SettleInvoiceMsg)(nil),              // 8: invoicesrpc.SettleInvoiceMsg
+	(*SettleInvoiceResp)(nil),             // 9: invoicesrpc.SettleInvoiceResp
+	(*SubscribeSingleInvoiceRequest)(nil), // 10: invoicesrpc.SubscribeSingleInvoiceRequest
+	(*LookupInvoiceMsg)(nil),              // 11: invoicesrpc.LookupInvoiceMsg
+	(*CircuitKey)(nil),                    // 12: invoicesrpc.CircuitKey
+	(*HtlcModifyRequest)(nil),             // 13: invoicesrpc.HtlcModifyRequest
+	(*HtlcModifyResponse)(nil),            // 14: invoicesrpc.HtlcModifyResponse
+	(*AddSubscriptionRequest)(nil),        // 15: invoicesrpc.AddSubscriptionRequest
+	(*SubscriptionPeriod)(nil),            // 16: invoicesrpc.SubscriptionPeriod
+	(*Subscription)(nil),                  // 17: invoicesrpc.Subscription
+	(*ListSubscriptionsRequest)(nil),      // 18: invoicesrpc.ListSubscriptionsRequest
+	(*ListSubscriptionsResponse)(nil),     // 19: invoicesrpc.ListSubscriptionsResponse
+	(*CancelSubscriptionRequest)(nil),     // 20: invoicesrpc.CancelSubscriptionRequest
+	(*CancelSubscriptionResponse)(nil),    // 21: invoicesrpc.CancelSubscriptionResponse
+	(*SubscribeSubscriptionsRequest)(nil), // 22: invoicesrpc.SubscribeSubscriptionsRequest
+	(*SubscriptionEvent)(nil),             // 23: invoicesrpc.SubscriptionEvent
+	nil,                                   // 24: invoicesrpc.HtlcModifyRequest.ExitHtlcWireCustomRecordsEntry
+	(*lnrpc.RouteHint)(nil),               // 25: lnrpc.RouteHint
+	(*lnrpc.Invoice)(nil),                 // 26: lnrpc.Invoice
 }
 var file_invoicesrpc_invoices_proto_depIdxs = []int32{
-	13, // 0: invoicesrpc.AddHoldInvoiceRequest.route_hints:type_name -> lnrpc.RouteHint
+	25, // 0: invoicesrpc.AddHoldInvoiceRequest.route_hints:type_name -> lnrpc.RouteHint
 	0,  // 1: invoicesrpc.LookupInvoiceMsg.lookup_modifier:type_name -> invoicesrpc.LookupModifier
-	14, // 2: invoicesrpc.HtlcModifyRequest.invoice:type_name -> lnrpc.Invoice
-	9,  // 3: invoicesrpc.HtlcModifyRequest.exit_htlc_circuit_key:type_name -> invoicesrpc.CircuitKey
-	12, // 4: invoicesrpc.HtlcModifyRequest.exit_htlc_wire_custom_records:type_name -> invoicesrpc.HtlcModifyRequest.ExitHtlcWireCustomRecordsEntry
-	9,  // 5: invoicesrpc.HtlcModifyResponse.circuit_key:type_name -> invoicesrpc.CircuitKey
-	7,  // 6: invoicesrpc.Invoices.SubscribeSingleInvoice:input_type -> invoicesrpc.SubscribeSingleInvoiceRequest
-	1,  // 7: invoicesrpc.Invoices.CancelInvoice:input_type -> invoicesrpc.CancelInvoiceMsg
-	3,  // 8: invoicesrpc.Invoices.AddHoldInvoice:input_type -> invoicesrpc.AddHoldInvoiceRequest
-	5,  // 9: invoicesrpc.Invoices.SettleInvoice:input_type -> invoicesrpc.SettleInvoiceMsg
-	8,  // 10: invoicesrpc.Invoices.LookupInvoiceV2:input_type -> invoicesrpc.LookupInvoiceMsg
-	11, // 11: invoicesrpc.Invoices.HtlcModifier:input_type -> invoicesrpc.HtlcModifyResponse
-	14, // 12: invoicesrpc.Invoices.SubscribeSingleInvoice:output_type -> lnrpc.Invoice
-	2,  // 13: invoicesrpc.Invoices.CancelInvoice:output_type -> invoicesrpc.CancelInvoiceResp
-	4,  // 14: invoicesrpc.Invoices.AddHoldInvoice:output_type -> invoicesrpc.AddHoldInvoiceResp
-	6,  // 15: invoicesrpc.Invoices.SettleInvoice:output_type -> invoicesrpc.SettleInvoiceResp
-	14, // 16: invoicesrpc.Invoices.LookupInvoiceV2:output_type -> lnrpc.Invoice
-	10, // 17: invoicesrpc.Invoices.HtlcModifier:output_type -> invoicesrpc.HtlcModifyRequest
-	12, // [12:18] is the sub-list for method output_type
-	6,  // [6:12] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	26, // 2: invoicesrpc.HtlcModifyRequest.invoice:type_name -> lnrpc.Invoice
+	12, // 3: invoicesrpc.HtlcModifyRequest.exit_htlc_circuit_key:type_name -> invoicesrpc.CircuitKey
+	24, // 4: invoicesrpc.HtlcModifyRequest.exit_htlc_wire_custom_records:type_name -> invoicesrpc.HtlcModifyRequest.ExitHtlcWireCustomRecordsEntry
+	12, // 5: invoicesrpc.HtlcModifyResponse.circuit_key:type_name -> invoicesrpc.CircuitKey
+	2,  // 6: invoicesrpc.SubscriptionPeriod.state:type_name -> invoicesrpc.PeriodState
+	1,  // 7: invoicesrpc.Subscription.state:type_name -> invoicesrpc.SubscriptionState
+	16, // 8: invoicesrpc.Subscription.periods:type_name -> invoicesrpc.SubscriptionPeriod
+	17, // 9: invoicesrpc.ListSubscriptionsResponse.subscriptions:type_name -> invoicesrpc.Subscription
+	3,  // 10: invoicesrpc.SubscriptionEvent.type:type_name -> invoicesrpc.SubscriptionEvent.EventType
+	17, // 11: invoicesrpc.SubscriptionEvent.subscription:type_name -> invoicesrpc.Subscription
+	16, // 12: invoicesrpc.SubscriptionEvent.period:type_name -> invoicesrpc.SubscriptionPeriod
+	10, // 13: invoicesrpc.Invoices.SubscribeSingleInvoice:input_type -> invoicesrpc.SubscribeSingleInvoiceRequest
+	4,  // 14: invoicesrpc.Invoices.CancelInvoice:input_type -> invoicesrpc.CancelInvoiceMsg
+	6,  // 15: invoicesrpc.Invoices.AddHoldInvoice:input_type -> invoicesrpc.AddHoldInvoiceRequest
+	8,  // 16: invoicesrpc.Invoices.SettleInvoice:input_type -> invoicesrpc.SettleInvoiceMsg
+	11, // 17: invoicesrpc.Invoices.LookupInvoiceV2:input_type -> invoicesrpc.LookupInvoiceMsg
+	14, // 18: invoicesrpc.Invoices.HtlcModifier:input_type -> invoicesrpc.HtlcModifyResponse
+	15, // 19: invoicesrpc.Invoices.AddSubscription:input_type -> invoicesrpc.AddSubscriptionRequest
+	18, // 20: invoicesrpc.Invoices.ListSubscriptions:input_type -> invoicesrpc.ListSubscriptionsRequest
+	20, // 21: invoicesrpc.Invoices.CancelSubscription:input_type -> invoicesrpc.CancelSubscriptionRequest
+	22, // 22: invoicesrpc.Invoices.SubscribeSubscriptions:input_type -> invoicesrpc.SubscribeSubscriptionsRequest
+	26, // 23: invoicesrpc.Invoices.SubscribeSingleInvoice:output_type -> lnrpc.Invoice
+	5,  // 24: invoicesrpc.Invoices.CancelInvoice:output_type -> invoicesrpc.CancelInvoiceResp
+	7,  // 25: invoicesrpc.Invoices.AddHoldInvoice:output_type -> invoicesrpc.AddHoldInvoiceResp
+	9,  // 26: invoicesrpc.Invoices.SettleInvoice:output_type -> invoicesrpc.SettleInvoiceResp
+	26, // 27: invoicesrpc.Invoices.LookupInvoiceV2:output_type -> lnrpc.Invoice
+	13, // 28: invoicesrpc.Invoices.HtlcModifier:output_type -> invoicesrpc.HtlcModifyRequest
+	17, // 29: invoicesrpc.Invoices.AddSubscription:output_type -> invoicesrpc.Subscription
+	19, // 30: invoicesrpc.Invoices.ListSubscriptions:output_type -> invoicesrpc.ListSubscriptionsResponse
+	21, // 31: invoicesrpc.Invoices.CancelSubscription:output_type -> invoicesrpc.CancelSubscriptionResponse
+	23, // 32: invoicesrpc.Invoices.SubscribeSubscriptions:output_type -> invoicesrpc.SubscriptionEvent
+	23, // [23:33] is the sub-list for method output_type
+	13, // [13:23] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_invoicesrpc_invoices_proto_init() }
@@ -962,8 +1895,8 @@ func file_invoicesrpc_invoices_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_invoicesrpc_invoices_proto_rawDesc), len(file_invoicesrpc_invoices_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   12,
+			NumEnums:      4,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
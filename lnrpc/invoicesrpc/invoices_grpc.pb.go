@@ -44,6 +44,23 @@ type InvoicesClient interface {
 	// server will send HTLCs of invoices to the client and the client can modify
 	// some aspects of the HTLC in order to pass the invoice acceptance tests.
 	HtlcModifier(ctx context.Context, opts ...grpc.CallOption) (Invoices_HtlcModifierClient, error)
+	// lncli: `addsubscription`
+	// AddSubscription adds a recurring subscription. An invoice over the
+	// subscription amount is created at the start of every period and tracked
+	// until it is paid or expires.
+	AddSubscription(ctx context.Context, in *AddSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+	// lncli: `listsubscriptions`
+	// ListSubscriptions returns all subscriptions along with the invoices of
+	// their periods.
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
+	// lncli: `cancelsubscription`
+	// CancelSubscription cancels an active subscription. No further invoices are
+	// created for it and the open invoices of its periods are canceled.
+	CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*CancelSubscriptionResponse, error)
+	// SubscribeSubscriptions returns a uni-directional stream (server -> client)
+	// of subscription events, such as the creation of the invoice of a new
+	// period or the payment of a period.
+	SubscribeSubscriptions(ctx context.Context, in *SubscribeSubscriptionsRequest, opts ...grpc.CallOption) (Invoices_SubscribeSubscriptionsClient, error)
 }
 
 type invoicesClient struct {
@@ -153,6 +170,65 @@ func (x *invoicesHtlcModifierClient) Recv() (*HtlcModifyRequest, error) {
 	return m, nil
 }
 
+func (c *invoicesClient) AddSubscription(ctx context.Context, in *AddSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, "/invoicesrpc.Invoices/AddSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoicesClient) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error) {
+	out := new(ListSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/invoicesrpc.Invoices/ListSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoicesClient) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*CancelSubscriptionResponse, error) {
+	out := new(CancelSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/invoicesrpc.Invoices/CancelSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoicesClient) SubscribeSubscriptions(ctx context.Context, in *SubscribeSubscriptionsRequest, opts ...grpc.CallOption) (Invoices_SubscribeSubscriptionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Invoices_ServiceDesc.Streams[2], "/invoicesrpc.Invoices/SubscribeSubscriptions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &invoicesSubscribeSubscriptionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Invoices_SubscribeSubscriptionsClient interface {
+	Recv() (*SubscriptionEvent, error)
+	grpc.ClientStream
+}
+
+type invoicesSubscribeSubscriptionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *invoicesSubscribeSubscriptionsClient) Recv() (*SubscriptionEvent, error) {
+	m := new(SubscriptionEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // InvoicesServer is the server API for Invoices service.
 // All implementations must embed UnimplementedInvoicesServer
 // for forward compatibility
@@ -182,6 +258,23 @@ type InvoicesServer interface {
 	// server will send HTLCs of invoices to the client and the client can modify
 	// some aspects of the HTLC in order to pass the invoice acceptance tests.
 	HtlcModifier(Invoices_HtlcModifierServer) error
+	// lncli: `addsubscription`
+	// AddSubscription adds a recurring subscription. An invoice over the
+	// subscription amount is created at the start of every period and tracked
+	// until it is paid or expires.
+	AddSubscription(context.Context, *AddSubscriptionRequest) (*Subscription, error)
+	// lncli: `listsubscriptions`
+	// ListSubscriptions returns all subscriptions along with the invoices of
+	// their periods.
+	ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error)
+	// lncli: `cancelsubscription`
+	// CancelSubscription cancels an active subscription. No further invoices are
+	// created for it and the open invoices of its periods are canceled.
+	CancelSubscription(context.Context, *CancelSubscriptionRequest) (*CancelSubscriptionResponse, error)
+	// SubscribeSubscriptions returns a uni-directional stream (server -> client)
+	// of subscription events, such as the creation of the invoice of a new
+	// period or the payment of a period.
+	SubscribeSubscriptions(*SubscribeSubscriptionsRequest, Invoices_SubscribeSubscriptionsServer) error
 	mustEmbedUnimplementedInvoicesServer()
 }
 
@@ -207,6 +300,18 @@ func (UnimplementedInvoicesServer) LookupInvoiceV2(context.Context, *LookupInvoi
 func (UnimplementedInvoicesServer) HtlcModifier(Invoices_HtlcModifierServer) error {
 	return status.Errorf(codes.Unimplemented, "method HtlcModifier not implemented")
 }
+func (UnimplementedInvoicesServer) AddSubscription(context.Context, *AddSubscriptionRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSubscription not implemented")
+}
+func (UnimplementedInvoicesServer) ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptions not implemented")
+}
+func (UnimplementedInvoicesServer) CancelSubscription(context.Context, *CancelSubscriptionRequest) (*CancelSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSubscription not implemented")
+}
+func (UnimplementedInvoicesServer) SubscribeSubscriptions(*SubscribeSubscriptionsRequest, Invoices_SubscribeSubscriptionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeSubscriptions not implemented")
+}
 func (UnimplementedInvoicesServer) mustEmbedUnimplementedInvoicesServer() {}
 
 // UnsafeInvoicesServer may be embedded to opt out of forward compatibility for this service.
@@ -339,6 +444,81 @@ func (x *invoicesHtlcModifierServer) Recv() (*HtlcModifyResponse, error) {
 	return m, nil
 }
 
+func _Invoices_AddSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).AddSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicesrpc.Invoices/AddSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).AddSubscription(ctx, req.(*AddSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invoices_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).ListSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicesrpc.Invoices/ListSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).ListSubscriptions(ctx, req.(*ListSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invoices_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicesrpc.Invoices/CancelSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).CancelSubscription(ctx, req.(*CancelSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invoices_SubscribeSubscriptions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeSubscriptionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InvoicesServer).SubscribeSubscriptions(m, &invoicesSubscribeSubscriptionsServer{stream})
+}
+
+type Invoices_SubscribeSubscriptionsServer interface {
+	Send(*SubscriptionEvent) error
+	grpc.ServerStream
+}
+
+type invoicesSubscribeSubscriptionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *invoicesSubscribeSubscriptionsServer) Send(m *SubscriptionEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Invoices_ServiceDesc is the grpc.ServiceDesc for Invoices service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -362,6 +542,18 @@ var Invoices_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LookupInvoiceV2",
 			Handler:    _Invoices_LookupInvoiceV2_Handler,
 		},
+		{
+			MethodName: "AddSubscription",
+			Handler:    _Invoices_AddSubscription_Handler,
+		},
+		{
+			MethodName: "ListSubscriptions",
+			Handler:    _Invoices_ListSubscriptions_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _Invoices_CancelSubscription_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -375,6 +567,11 @@ var Invoices_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "SubscribeSubscriptions",
+			Handler:       _Invoices_SubscribeSubscriptions_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "invoicesrpc/invoices.proto",
 }
@@ -9,11 +9,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/invoices/subscriptions"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -72,6 +75,22 @@ var (
 			Entity: "invoices",
 			Action: "write",
 		}},
+		"/invoicesrpc.Invoices/AddSubscription": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
+		"/invoicesrpc.Invoices/ListSubscriptions": {{
+			Entity: "invoices",
+			Action: "read",
+		}},
+		"/invoicesrpc.Invoices/CancelSubscription": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
+		"/invoicesrpc.Invoices/SubscribeSubscriptions": {{
+			Entity: "invoices",
+			Action: "read",
+		}},
 	}
 
 	// DefaultInvoicesMacFilename is the default name of the invoices
@@ -377,8 +396,15 @@ func (s *Server) AddHoldInvoice(ctx context.Context,
 		CltvExpiry:      invoice.CltvExpiry,
 		Private:         invoice.Private,
 		HodlInvoice:     true,
-		Preimage:        nil,
-		RouteHints:      routeHints,
+		HoldPolicy: invoices.HoldPolicy{
+			MaxHoldDuration: time.Duration(
+				invoice.MaxHoldSeconds,
+			) * time.Second,
+			MinCltvSlack: invoice.MinCltvSlack,
+			AutoSettle:   invoice.AutoSettle,
+		},
+		Preimage:   nil,
+		RouteHints: routeHints,
 	}
 
 	_, dbInvoice, err := AddInvoice(ctx, addInvoiceCfg, addInvoiceData)
@@ -507,3 +533,224 @@ func (s *Server) HtlcModifier(
 		}
 	}
 }
+
+// AddSubscription creates a recurring subscription that invoices a fixed
+// amount once per interval.
+func (s *Server) AddSubscription(_ context.Context,
+	req *AddSubscriptionRequest) (*Subscription, error) {
+
+	subReq := &subscriptions.Request{
+		Memo:       req.Memo,
+		Amount:     lnwire.MilliSatoshi(req.ValueMsat),
+		Interval:   time.Duration(req.IntervalSeconds) * time.Second,
+		NumPeriods: req.NumPeriods,
+		InvoiceExpiry: time.Duration(req.InvoiceExpirySeconds) *
+			time.Second,
+	}
+	if req.StartTime < 0 {
+		return nil, status.Error(codes.InvalidArgument,
+			"start_time must not be negative")
+	}
+	if req.StartTime != 0 {
+		subReq.StartTime = time.Unix(req.StartTime, 0)
+	}
+
+	sub, err := s.cfg.Subscriptions.AddSubscription(subReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshallSubscription(sub), nil
+}
+
+// ListSubscriptions returns all recurring subscriptions along with the
+// payment state of their periods.
+func (s *Server) ListSubscriptions(_ context.Context,
+	req *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+
+	subs := s.cfg.Subscriptions.ListSubscriptions(req.ActiveOnly)
+
+	resp := &ListSubscriptionsResponse{
+		Subscriptions: make([]*Subscription, 0, len(subs)),
+	}
+	for _, sub := range subs {
+		resp.Subscriptions = append(
+			resp.Subscriptions, marshallSubscription(sub),
+		)
+	}
+
+	return resp, nil
+}
+
+// CancelSubscription stops a recurring subscription from creating new
+// invoices and cancels its open invoices.
+func (s *Server) CancelSubscription(_ context.Context,
+	req *CancelSubscriptionRequest) (*CancelSubscriptionResponse, error) {
+
+	err := s.cfg.Subscriptions.CancelSubscription(req.Id)
+	switch {
+	case errors.Is(err, subscriptions.ErrSubscriptionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, err
+	}
+
+	log.Infof("Canceled subscription %d", req.Id)
+
+	return &CancelSubscriptionResponse{}, nil
+}
+
+// SubscribeSubscriptions returns a uni-directional stream (server -> client)
+// of the lifecycle events of all recurring subscriptions.
+func (s *Server) SubscribeSubscriptions(_ *SubscribeSubscriptionsRequest,
+	updateStream Invoices_SubscribeSubscriptionsServer) error {
+
+	client, err := s.cfg.Subscriptions.SubscribeEvents()
+	if err != nil {
+		return err
+	}
+	defer client.Cancel()
+
+	for {
+		select {
+		case update := <-client.Updates():
+			event, ok := update.(*subscriptions.Event)
+			if !ok {
+				return fmt.Errorf("unexpected subscription "+
+					"event: %T", update)
+			}
+
+			rpcEvent := marshallSubscriptionEvent(event)
+			if err := updateStream.Send(rpcEvent); err != nil {
+				return err
+			}
+
+		case <-client.Quit():
+			return ErrServerShuttingDown
+
+		case <-updateStream.Context().Done():
+			return updateStream.Context().Err()
+
+		case <-s.quit:
+			return nil
+		}
+	}
+}
+
+// marshallSubscription converts a subscription to its rpc representation.
+func marshallSubscription(sub *subscriptions.Subscription) *Subscription {
+	rpcSub := &Subscription{
+		Id:                   sub.ID,
+		Memo:                 sub.Memo,
+		ValueMsat:            uint64(sub.Amount),
+		IntervalSeconds:      uint64(sub.Interval / time.Second),
+		StartTime:            sub.StartTime.Unix(),
+		NumPeriods:           sub.NumPeriods,
+		InvoiceExpirySeconds: uint64(sub.InvoiceExpiry / time.Second),
+		CreationDate:         sub.CreationTime.Unix(),
+		Periods: make(
+			[]*SubscriptionPeriod, 0, len(sub.Periods),
+		),
+	}
+
+	switch sub.Status {
+	case subscriptions.StatusCanceled:
+		rpcSub.State = SubscriptionState_SUBSCRIPTION_CANCELED
+
+	case subscriptions.StatusCompleted:
+		rpcSub.State = SubscriptionState_SUBSCRIPTION_COMPLETED
+
+	default:
+		rpcSub.State = SubscriptionState_SUBSCRIPTION_ACTIVE
+	}
+
+	for i := range sub.Periods {
+		period := marshallSubscriptionPeriod(&sub.Periods[i])
+		rpcSub.Periods = append(rpcSub.Periods, period)
+
+		switch period.State {
+		case PeriodState_PERIOD_PAID:
+			rpcSub.NumPaid++
+
+		case PeriodState_PERIOD_UNPAID:
+			rpcSub.NumUnpaid++
+
+		case PeriodState_PERIOD_SKIPPED:
+			rpcSub.NumSkipped++
+		}
+	}
+
+	return rpcSub
+}
+
+// marshallSubscriptionPeriod converts a subscription period to its rpc
+// representation.
+func marshallSubscriptionPeriod(p *subscriptions.Period) *SubscriptionPeriod {
+	rpcPeriod := &SubscriptionPeriod{
+		Index:          p.Index,
+		PaymentRequest: p.PaymentRequest,
+		CreationDate:   p.CreationTime.Unix(),
+		AmtPaidMsat:    uint64(p.AmountPaid),
+	}
+	if p.Status != subscriptions.PeriodSkipped {
+		rpcPeriod.PaymentHash = p.PaymentHash[:]
+	}
+	if !p.SettleTime.IsZero() {
+		rpcPeriod.SettleDate = p.SettleTime.Unix()
+	}
+
+	switch p.Status {
+	case subscriptions.PeriodPaid:
+		rpcPeriod.State = PeriodState_PERIOD_PAID
+
+	case subscriptions.PeriodUnpaid:
+		rpcPeriod.State = PeriodState_PERIOD_UNPAID
+
+	case subscriptions.PeriodSkipped:
+		rpcPeriod.State = PeriodState_PERIOD_SKIPPED
+
+	default:
+		rpcPeriod.State = PeriodState_PERIOD_OPEN
+	}
+
+	return rpcPeriod
+}
+
+// marshallSubscriptionEvent converts a subscription event to its rpc
+// representation.
+func marshallSubscriptionEvent(
+	event *subscriptions.Event) *SubscriptionEvent {
+
+	rpcEvent := &SubscriptionEvent{
+		Subscription: marshallSubscription(event.Subscription),
+	}
+	if event.Period != nil {
+		rpcEvent.Period = marshallSubscriptionPeriod(event.Period)
+	}
+
+	switch event.Type {
+	case subscriptions.EventInvoiceCreated:
+		rpcEvent.Type = SubscriptionEvent_INVOICE_CREATED
+
+	case subscriptions.EventPeriodPaid:
+		rpcEvent.Type = SubscriptionEvent_PERIOD_PAID
+
+	case subscriptions.EventPeriodUnpaid:
+		rpcEvent.Type = SubscriptionEvent_PERIOD_UNPAID
+
+	case subscriptions.EventCanceled:
+		rpcEvent.Type = SubscriptionEvent_CANCELED
+
+	case subscriptions.EventCompleted:
+		rpcEvent.Type = SubscriptionEvent_COMPLETED
+
+	case subscriptions.EventPeriodSkipped:
+		rpcEvent.Type = SubscriptionEvent_PERIOD_SKIPPED
+
+	default:
+		rpcEvent.Type = SubscriptionEvent_CREATED
+	}
+
+	return rpcEvent
+}
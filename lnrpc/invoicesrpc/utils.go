@@ -234,6 +234,15 @@ func CreateRPCInvoice(invoice *invoices.Invoice,
 		rpcInvoice.RPreimage = preimage[:]
 	}
 
+	if !invoice.HoldPolicy.IsZero() {
+		policy := invoice.HoldPolicy
+		rpcInvoice.HoldPolicy = &lnrpc.InvoiceHoldPolicy{
+			MaxHoldSeconds: uint64(policy.MaxHoldDuration.Seconds()),
+			MinCltvSlack:   policy.MinCltvSlack,
+			AutoSettle:     policy.AutoSettle,
+		}
+	}
+
 	return rpcInvoice, nil
 }
 
@@ -248,6 +248,10 @@ const (
 	// This channel type also commits to additional meta data in the tapscript
 	// leaves for the scripts in a channel.
 	CommitmentType_SIMPLE_TAPROOT_OVERLAY CommitmentType = 6
+	// A channel that uses a zero-fee v3 commitment transaction with a single
+	// shared pay-to-anchor output. The commitment transaction pays no fee and
+	// must be fee bumped with a child transaction spending the shared anchor.
+	CommitmentType_ZERO_FEE_COMMITMENTS CommitmentType = 8
 )
 
 // Enum value maps for CommitmentType.
@@ -262,6 +266,7 @@ var (
 		// Duplicate value: 7: "SIMPLE_TAPROOT_FINAL",
 		5: "SIMPLE_TAPROOT",
 		6: "SIMPLE_TAPROOT_OVERLAY",
+		8: "ZERO_FEE_COMMITMENTS",
 	}
 	CommitmentType_value = map[string]int32{
 		"UNKNOWN_COMMITMENT_TYPE": 0,
@@ -273,6 +278,7 @@ var (
 		"SIMPLE_TAPROOT_FINAL":    7,
 		"SIMPLE_TAPROOT":          5,
 		"SIMPLE_TAPROOT_OVERLAY":  6,
+		"ZERO_FEE_COMMITMENTS":    8,
 	}
 )
 
@@ -880,6 +886,115 @@ func (UpdateFailure) EnumDescriptor() ([]byte, []int) {
 	return file_lightning_proto_rawDescGZIP(), []int{12}
 }
 
+type PeerConnPolicy_Priority int32
+
+const (
+	// Peers without a policy have normal priority.
+	PeerConnPolicy_NORMAL PeerConnPolicy_Priority = 0
+	// Low priority peers are reconnected to last on startup and back off
+	// twice as long as normal peers.
+	PeerConnPolicy_LOW PeerConnPolicy_Priority = 1
+	// High priority peers are reconnected to first and without
+	// staggering on startup, back off half as long as normal peers and
+	// may use the inbound slots reserved for channel peers.
+	PeerConnPolicy_HIGH PeerConnPolicy_Priority = 2
+)
+
+// Enum value maps for PeerConnPolicy_Priority.
+var (
+	PeerConnPolicy_Priority_name = map[int32]string{
+		0: "NORMAL",
+		1: "LOW",
+		2: "HIGH",
+	}
+	PeerConnPolicy_Priority_value = map[string]int32{
+		"NORMAL": 0,
+		"LOW":    1,
+		"HIGH":   2,
+	}
+)
+
+func (x PeerConnPolicy_Priority) Enum() *PeerConnPolicy_Priority {
+	p := new(PeerConnPolicy_Priority)
+	*p = x
+	return p
+}
+
+func (x PeerConnPolicy_Priority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeerConnPolicy_Priority) Descriptor() protoreflect.EnumDescriptor {
+	return file_lightning_proto_enumTypes[13].Descriptor()
+}
+
+func (PeerConnPolicy_Priority) Type() protoreflect.EnumType {
+	return &file_lightning_proto_enumTypes[13]
+}
+
+func (x PeerConnPolicy_Priority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeerConnPolicy_Priority.Descriptor instead.
+func (PeerConnPolicy_Priority) EnumDescriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{56, 0}
+}
+
+type PeerConnPolicy_AddrPreference int32
+
+const (
+	// Connect to all addresses of the peer.
+	PeerConnPolicy_ANY PeerConnPolicy_AddrPreference = 0
+	// Only connect to the clearnet addresses of the peer, unless it has
+	// none.
+	PeerConnPolicy_CLEARNET PeerConnPolicy_AddrPreference = 1
+	// Only connect to the onion addresses of the peer, unless it has
+	// none.
+	PeerConnPolicy_TOR PeerConnPolicy_AddrPreference = 2
+)
+
+// Enum value maps for PeerConnPolicy_AddrPreference.
+var (
+	PeerConnPolicy_AddrPreference_name = map[int32]string{
+		0: "ANY",
+		1: "CLEARNET",
+		2: "TOR",
+	}
+	PeerConnPolicy_AddrPreference_value = map[string]int32{
+		"ANY":      0,
+		"CLEARNET": 1,
+		"TOR":      2,
+	}
+)
+
+func (x PeerConnPolicy_AddrPreference) Enum() *PeerConnPolicy_AddrPreference {
+	p := new(PeerConnPolicy_AddrPreference)
+	*p = x
+	return p
+}
+
+func (x PeerConnPolicy_AddrPreference) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeerConnPolicy_AddrPreference) Descriptor() protoreflect.EnumDescriptor {
+	return file_lightning_proto_enumTypes[14].Descriptor()
+}
+
+func (PeerConnPolicy_AddrPreference) Type() protoreflect.EnumType {
+	return &file_lightning_proto_enumTypes[14]
+}
+
+func (x PeerConnPolicy_AddrPreference) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeerConnPolicy_AddrPreference.Descriptor instead.
+func (PeerConnPolicy_AddrPreference) EnumDescriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{56, 1}
+}
+
 type ChannelCloseSummary_ClosureType int32
 
 const (
@@ -922,11 +1037,11 @@ func (x ChannelCloseSummary_ClosureType) String() string {
 }
 
 func (ChannelCloseSummary_ClosureType) Descriptor() protoreflect.EnumDescriptor {
-	return file_lightning_proto_enumTypes[13].Descriptor()
+	return file_lightning_proto_enumTypes[15].Descriptor()
 }
 
 func (ChannelCloseSummary_ClosureType) Type() protoreflect.EnumType {
-	return &file_lightning_proto_enumTypes[13]
+	return &file_lightning_proto_enumTypes[15]
 }
 
 func (x ChannelCloseSummary_ClosureType) Number() protoreflect.EnumNumber {
@@ -935,7 +1050,7 @@ func (x ChannelCloseSummary_ClosureType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ChannelCloseSummary_ClosureType.Descriptor instead.
 func (ChannelCloseSummary_ClosureType) EnumDescriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{48, 0}
+	return file_lightning_proto_rawDescGZIP(), []int{72, 0}
 }
 
 type Peer_SyncType int32
@@ -978,11 +1093,11 @@ func (x Peer_SyncType) String() string {
 }
 
 func (Peer_SyncType) Descriptor() protoreflect.EnumDescriptor {
-	return file_lightning_proto_enumTypes[14].Descriptor()
+	return file_lightning_proto_enumTypes[16].Descriptor()
 }
 
 func (Peer_SyncType) Type() protoreflect.EnumType {
-	return &file_lightning_proto_enumTypes[14]
+	return &file_lightning_proto_enumTypes[16]
 }
 
 func (x Peer_SyncType) Number() protoreflect.EnumNumber {
@@ -991,7 +1106,7 @@ func (x Peer_SyncType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Peer_SyncType.Descriptor instead.
 func (Peer_SyncType) EnumDescriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{52, 0}
+	return file_lightning_proto_rawDescGZIP(), []int{76, 0}
 }
 
 type PeerEvent_EventType int32
@@ -1024,11 +1139,11 @@ func (x PeerEvent_EventType) String() string {
 }
 
 func (PeerEvent_EventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_lightning_proto_enumTypes[15].Descriptor()
+	return file_lightning_proto_enumTypes[17].Descriptor()
 }
 
 func (PeerEvent_EventType) Type() protoreflect.EnumType {
-	return &file_lightning_proto_enumTypes[15]
+	return &file_lightning_proto_enumTypes[17]
 }
 
 func (x PeerEvent_EventType) Number() protoreflect.EnumNumber {
@@ -1037,7 +1152,7 @@ func (x PeerEvent_EventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PeerEvent_EventType.Descriptor instead.
 func (PeerEvent_EventType) EnumDescriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{57, 0}
+	return file_lightning_proto_rawDescGZIP(), []int{81, 0}
 }
 
 // There are three resolution states for the anchor:
@@ -1079,11 +1194,11 @@ func (x PendingChannelsResponse_ForceClosedChannel_AnchorState) String() string
 }
 
 func (PendingChannelsResponse_ForceClosedChannel_AnchorState) Descriptor() protoreflect.EnumDescriptor {
-	return file_lightning_proto_enumTypes[16].Descriptor()
+	return file_lightning_proto_enumTypes[18].Descriptor()
 }
 
 func (PendingChannelsResponse_ForceClosedChannel_AnchorState) Type() protoreflect.EnumType {
-	return &file_lightning_proto_enumTypes[16]
+	return &file_lightning_proto_enumTypes[18]
 }
 
 func (x PendingChannelsResponse_ForceClosedChannel_AnchorState) Number() protoreflect.EnumNumber {
@@ -1092,7 +1207,55 @@ func (x PendingChannelsResponse_ForceClosedChannel_AnchorState) Number() protore
 
 // Deprecated: Use PendingChannelsResponse_ForceClosedChannel_AnchorState.Descriptor instead.
 func (PendingChannelsResponse_ForceClosedChannel_AnchorState) EnumDescriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{90, 5, 0}
+	return file_lightning_proto_rawDescGZIP(), []int{114, 5, 0}
+}
+
+type PendingChannelsResponse_ScheduledClose_State int32
+
+const (
+	// The close waits for its deadline or target fee rate.
+	PendingChannelsResponse_ScheduledClose_WAITING PendingChannelsResponse_ScheduledClose_State = 0
+	// The close of the channel was initiated.
+	PendingChannelsResponse_ScheduledClose_CLOSING PendingChannelsResponse_ScheduledClose_State = 1
+)
+
+// Enum value maps for PendingChannelsResponse_ScheduledClose_State.
+var (
+	PendingChannelsResponse_ScheduledClose_State_name = map[int32]string{
+		0: "WAITING",
+		1: "CLOSING",
+	}
+	PendingChannelsResponse_ScheduledClose_State_value = map[string]int32{
+		"WAITING": 0,
+		"CLOSING": 1,
+	}
+)
+
+func (x PendingChannelsResponse_ScheduledClose_State) Enum() *PendingChannelsResponse_ScheduledClose_State {
+	p := new(PendingChannelsResponse_ScheduledClose_State)
+	*p = x
+	return p
+}
+
+func (x PendingChannelsResponse_ScheduledClose_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PendingChannelsResponse_ScheduledClose_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_lightning_proto_enumTypes[19].Descriptor()
+}
+
+func (PendingChannelsResponse_ScheduledClose_State) Type() protoreflect.EnumType {
+	return &file_lightning_proto_enumTypes[19]
+}
+
+func (x PendingChannelsResponse_ScheduledClose_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PendingChannelsResponse_ScheduledClose_State.Descriptor instead.
+func (PendingChannelsResponse_ScheduledClose_State) EnumDescriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{114, 6, 0}
 }
 
 type ChannelEventUpdate_UpdateType int32
@@ -1143,11 +1306,11 @@ func (x ChannelEventUpdate_UpdateType) String() string {
 }
 
 func (ChannelEventUpdate_UpdateType) Descriptor() protoreflect.EnumDescriptor {
-	return file_lightning_proto_enumTypes[17].Descriptor()
+	return file_lightning_proto_enumTypes[20].Descriptor()
 }
 
 func (ChannelEventUpdate_UpdateType) Type() protoreflect.EnumType {
-	return &file_lightning_proto_enumTypes[17]
+	return &file_lightning_proto_enumTypes[20]
 }
 
 func (x ChannelEventUpdate_UpdateType) Number() protoreflect.EnumNumber {
@@ -1156,7 +1319,7 @@ func (x ChannelEventUpdate_UpdateType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ChannelEventUpdate_UpdateType.Descriptor instead.
 func (ChannelEventUpdate_UpdateType) EnumDescriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{93, 0}
+	return file_lightning_proto_rawDescGZIP(), []int{117, 0}
 }
 
 type Invoice_InvoiceState int32
@@ -1195,11 +1358,11 @@ func (x Invoice_InvoiceState) String() string {
 }
 
 func (Invoice_InvoiceState) Descriptor() protoreflect.EnumDescriptor {
-	return file_lightning_proto_enumTypes[18].Descriptor()
+	return file_lightning_proto_enumTypes[21].Descriptor()
 }
 
 func (Invoice_InvoiceState) Type() protoreflect.EnumType {
-	return &file_lightning_proto_enumTypes[18]
+	return &file_lightning_proto_enumTypes[21]
 }
 
 func (x Invoice_InvoiceState) Number() protoreflect.EnumNumber {
@@ -1208,7 +1371,7 @@ func (x Invoice_InvoiceState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Invoice_InvoiceState.Descriptor instead.
 func (Invoice_InvoiceState) EnumDescriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{137, 0}
+	return file_lightning_proto_rawDescGZIP(), []int{161, 0}
 }
 
 type Payment_PaymentStatus int32
@@ -1257,11 +1420,11 @@ func (x Payment_PaymentStatus) String() string {
 }
 
 func (Payment_PaymentStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_lightning_proto_enumTypes[19].Descriptor()
+	return file_lightning_proto_enumTypes[22].Descriptor()
 }
 
 func (Payment_PaymentStatus) Type() protoreflect.EnumType {
-	return &file_lightning_proto_enumTypes[19]
+	return &file_lightning_proto_enumTypes[22]
 }
 
 func (x Payment_PaymentStatus) Number() protoreflect.EnumNumber {
@@ -1270,7 +1433,7 @@ func (x Payment_PaymentStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Payment_PaymentStatus.Descriptor instead.
 func (Payment_PaymentStatus) EnumDescriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{148, 0}
+	return file_lightning_proto_rawDescGZIP(), []int{173, 0}
 }
 
 type HTLCAttempt_HTLCStatus int32
@@ -1306,11 +1469,11 @@ func (x HTLCAttempt_HTLCStatus) String() string {
 }
 
 func (HTLCAttempt_HTLCStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_lightning_proto_enumTypes[20].Descriptor()
+	return file_lightning_proto_enumTypes[23].Descriptor()
 }
 
 func (HTLCAttempt_HTLCStatus) Type() protoreflect.EnumType {
-	return &file_lightning_proto_enumTypes[20]
+	return &file_lightning_proto_enumTypes[23]
 }
 
 func (x HTLCAttempt_HTLCStatus) Number() protoreflect.EnumNumber {
@@ -1319,7 +1482,7 @@ func (x HTLCAttempt_HTLCStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HTLCAttempt_HTLCStatus.Descriptor instead.
 func (HTLCAttempt_HTLCStatus) EnumDescriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{149, 0}
+	return file_lightning_proto_rawDescGZIP(), []int{175, 0}
 }
 
 type Failure_FailureCode int32
@@ -1328,32 +1491,34 @@ const (
 	// The numbers assigned in this enumeration match the failure codes as
 	// defined in BOLT #4. Because protobuf 3 requires enums to start with 0,
 	// a RESERVED value is added.
-	Failure_RESERVED                             Failure_FailureCode = 0
-	Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS Failure_FailureCode = 1
-	Failure_INCORRECT_PAYMENT_AMOUNT             Failure_FailureCode = 2
-	Failure_FINAL_INCORRECT_CLTV_EXPIRY          Failure_FailureCode = 3
-	Failure_FINAL_INCORRECT_HTLC_AMOUNT          Failure_FailureCode = 4
-	Failure_FINAL_EXPIRY_TOO_SOON                Failure_FailureCode = 5
-	Failure_INVALID_REALM                        Failure_FailureCode = 6
-	Failure_EXPIRY_TOO_SOON                      Failure_FailureCode = 7
-	Failure_INVALID_ONION_VERSION                Failure_FailureCode = 8
-	Failure_INVALID_ONION_HMAC                   Failure_FailureCode = 9
-	Failure_INVALID_ONION_KEY                    Failure_FailureCode = 10
-	Failure_AMOUNT_BELOW_MINIMUM                 Failure_FailureCode = 11
-	Failure_FEE_INSUFFICIENT                     Failure_FailureCode = 12
-	Failure_INCORRECT_CLTV_EXPIRY                Failure_FailureCode = 13
-	Failure_CHANNEL_DISABLED                     Failure_FailureCode = 14
-	Failure_TEMPORARY_CHANNEL_FAILURE            Failure_FailureCode = 15
-	Failure_REQUIRED_NODE_FEATURE_MISSING        Failure_FailureCode = 16
-	Failure_REQUIRED_CHANNEL_FEATURE_MISSING     Failure_FailureCode = 17
-	Failure_UNKNOWN_NEXT_PEER                    Failure_FailureCode = 18
-	Failure_TEMPORARY_NODE_FAILURE               Failure_FailureCode = 19
-	Failure_PERMANENT_NODE_FAILURE               Failure_FailureCode = 20
-	Failure_PERMANENT_CHANNEL_FAILURE            Failure_FailureCode = 21
-	Failure_EXPIRY_TOO_FAR                       Failure_FailureCode = 22
-	Failure_MPP_TIMEOUT                          Failure_FailureCode = 23
-	Failure_INVALID_ONION_PAYLOAD                Failure_FailureCode = 24
-	Failure_INVALID_ONION_BLINDING               Failure_FailureCode = 25
+	Failure_RESERVED                              Failure_FailureCode = 0
+	Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS  Failure_FailureCode = 1
+	Failure_INCORRECT_PAYMENT_AMOUNT              Failure_FailureCode = 2
+	Failure_FINAL_INCORRECT_CLTV_EXPIRY           Failure_FailureCode = 3
+	Failure_FINAL_INCORRECT_HTLC_AMOUNT           Failure_FailureCode = 4
+	Failure_FINAL_EXPIRY_TOO_SOON                 Failure_FailureCode = 5
+	Failure_INVALID_REALM                         Failure_FailureCode = 6
+	Failure_EXPIRY_TOO_SOON                       Failure_FailureCode = 7
+	Failure_INVALID_ONION_VERSION                 Failure_FailureCode = 8
+	Failure_INVALID_ONION_HMAC                    Failure_FailureCode = 9
+	Failure_INVALID_ONION_KEY                     Failure_FailureCode = 10
+	Failure_AMOUNT_BELOW_MINIMUM                  Failure_FailureCode = 11
+	Failure_FEE_INSUFFICIENT                      Failure_FailureCode = 12
+	Failure_INCORRECT_CLTV_EXPIRY                 Failure_FailureCode = 13
+	Failure_CHANNEL_DISABLED                      Failure_FailureCode = 14
+	Failure_TEMPORARY_CHANNEL_FAILURE             Failure_FailureCode = 15
+	Failure_REQUIRED_NODE_FEATURE_MISSING         Failure_FailureCode = 16
+	Failure_REQUIRED_CHANNEL_FEATURE_MISSING      Failure_FailureCode = 17
+	Failure_UNKNOWN_NEXT_PEER                     Failure_FailureCode = 18
+	Failure_TEMPORARY_NODE_FAILURE                Failure_FailureCode = 19
+	Failure_PERMANENT_NODE_FAILURE                Failure_FailureCode = 20
+	Failure_PERMANENT_CHANNEL_FAILURE             Failure_FailureCode = 21
+	Failure_EXPIRY_TOO_FAR                        Failure_FailureCode = 22
+	Failure_MPP_TIMEOUT                           Failure_FailureCode = 23
+	Failure_INVALID_ONION_PAYLOAD                 Failure_FailureCode = 24
+	Failure_INVALID_ONION_BLINDING                Failure_FailureCode = 25
+	Failure_TEMPORARY_TRAMPOLINE_FAILURE          Failure_FailureCode = 26
+	Failure_TRAMPOLINE_FEE_OR_EXPIRY_INSUFFICIENT Failure_FailureCode = 27
 	// An internal error occurred.
 	Failure_INTERNAL_FAILURE Failure_FailureCode = 997
 	// The error source is known, but the failure itself couldn't be decoded.
@@ -1392,40 +1557,44 @@ var (
 		23:  "MPP_TIMEOUT",
 		24:  "INVALID_ONION_PAYLOAD",
 		25:  "INVALID_ONION_BLINDING",
+		26:  "TEMPORARY_TRAMPOLINE_FAILURE",
+		27:  "TRAMPOLINE_FEE_OR_EXPIRY_INSUFFICIENT",
 		997: "INTERNAL_FAILURE",
 		998: "UNKNOWN_FAILURE",
 		999: "UNREADABLE_FAILURE",
 	}
 	Failure_FailureCode_value = map[string]int32{
-		"RESERVED":                             0,
-		"INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS": 1,
-		"INCORRECT_PAYMENT_AMOUNT":             2,
-		"FINAL_INCORRECT_CLTV_EXPIRY":          3,
-		"FINAL_INCORRECT_HTLC_AMOUNT":          4,
-		"FINAL_EXPIRY_TOO_SOON":                5,
-		"INVALID_REALM":                        6,
-		"EXPIRY_TOO_SOON":                      7,
-		"INVALID_ONION_VERSION":                8,
-		"INVALID_ONION_HMAC":                   9,
-		"INVALID_ONION_KEY":                    10,
-		"AMOUNT_BELOW_MINIMUM":                 11,
-		"FEE_INSUFFICIENT":                     12,
-		"INCORRECT_CLTV_EXPIRY":                13,
-		"CHANNEL_DISABLED":                     14,
-		"TEMPORARY_CHANNEL_FAILURE":            15,
-		"REQUIRED_NODE_FEATURE_MISSING":        16,
-		"REQUIRED_CHANNEL_FEATURE_MISSING":     17,
-		"UNKNOWN_NEXT_PEER":                    18,
-		"TEMPORARY_NODE_FAILURE":               19,
-		"PERMANENT_NODE_FAILURE":               20,
-		"PERMANENT_CHANNEL_FAILURE":            21,
-		"EXPIRY_TOO_FAR":                       22,
-		"MPP_TIMEOUT":                          23,
-		"INVALID_ONION_PAYLOAD":                24,
-		"INVALID_ONION_BLINDING":               25,
-		"INTERNAL_FAILURE":                     997,
-		"UNKNOWN_FAILURE":                      998,
-		"UNREADABLE_FAILURE":                   999,
+		"RESERVED":                              0,
+		"INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS":  1,
+		"INCORRECT_PAYMENT_AMOUNT":              2,
+		"FINAL_INCORRECT_CLTV_EXPIRY":           3,
+		"FINAL_INCORRECT_HTLC_AMOUNT":           4,
+		"FINAL_EXPIRY_TOO_SOON":                 5,
+		"INVALID_REALM":                         6,
+		"EXPIRY_TOO_SOON":                       7,
+		"INVALID_ONION_VERSION":                 8,
+		"INVALID_ONION_HMAC":                    9,
+		"INVALID_ONION_KEY":                     10,
+		"AMOUNT_BELOW_MINIMUM":                  11,
+		"FEE_INSUFFICIENT":                      12,
+		"INCORRECT_CLTV_EXPIRY":                 13,
+		"CHANNEL_DISABLED":                      14,
+		"TEMPORARY_CHANNEL_FAILURE":             15,
+		"REQUIRED_NODE_FEATURE_MISSING":         16,
+		"REQUIRED_CHANNEL_FEATURE_MISSING":      17,
+		"UNKNOWN_NEXT_PEER":                     18,
+		"TEMPORARY_NODE_FAILURE":                19,
+		"PERMANENT_NODE_FAILURE":                20,
+		"PERMANENT_CHANNEL_FAILURE":             21,
+		"EXPIRY_TOO_FAR":                        22,
+		"MPP_TIMEOUT":                           23,
+		"INVALID_ONION_PAYLOAD":                 24,
+		"INVALID_ONION_BLINDING":                25,
+		"TEMPORARY_TRAMPOLINE_FAILURE":          26,
+		"TRAMPOLINE_FEE_OR_EXPIRY_INSUFFICIENT": 27,
+		"INTERNAL_FAILURE":                      997,
+		"UNKNOWN_FAILURE":                       998,
+		"UNREADABLE_FAILURE":                    999,
 	}
 )
 
@@ -1440,11 +1609,11 @@ func (x Failure_FailureCode) String() string {
 }
 
 func (Failure_FailureCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_lightning_proto_enumTypes[21].Descriptor()
+	return file_lightning_proto_enumTypes[24].Descriptor()
 }
 
 func (Failure_FailureCode) Type() protoreflect.EnumType {
-	return &file_lightning_proto_enumTypes[21]
+	return &file_lightning_proto_enumTypes[24]
 }
 
 func (x Failure_FailureCode) Number() protoreflect.EnumNumber {
@@ -1453,31 +1622,47 @@ func (x Failure_FailureCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Failure_FailureCode.Descriptor instead.
 func (Failure_FailureCode) EnumDescriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{193, 0}
+	return file_lightning_proto_rawDescGZIP(), []int{219, 0}
 }
 
-type LookupHtlcResolutionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChanId        uint64                 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
-	HtlcIndex     uint64                 `protobuf:"varint,2,opt,name=htlc_index,json=htlcIndex,proto3" json:"htlc_index,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ScheduleChannelCloseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The channel point of the channel to close.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// The block height at which the close is initiated regardless of the fee
+	// rate. Either this or target_sat_per_vbyte must be set.
+	DeadlineHeight uint32 `protobuf:"varint,2,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+	// The fee rate in sat/vbyte at or below which the close is initiated. Either
+	// this or deadline_height must be set.
+	TargetSatPerVbyte uint64 `protobuf:"varint,3,opt,name=target_sat_per_vbyte,json=targetSatPerVbyte,proto3" json:"target_sat_per_vbyte,omitempty"`
+	// The confirmation target used to estimate the fee rate of the closing
+	// transaction. Defaults to 6 blocks if not set.
+	TargetConf uint32 `protobuf:"varint,4,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// The maximum absolute fee in satoshis we're willing to pay for the closing
+	// transaction. If not set, the closing transaction is never bumped.
+	BudgetSat uint64 `protobuf:"varint,5,opt,name=budget_sat,json=budgetSat,proto3" json:"budget_sat,omitempty"`
+	// An optional address to send our funds to. If the channel was opened with
+	// an upfront shutdown script and this field is set, the close will fail
+	// because the channel must pay out to the upfront shutdown address.
+	DeliveryAddress string `protobuf:"bytes,6,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *LookupHtlcResolutionRequest) Reset() {
-	*x = LookupHtlcResolutionRequest{}
+func (x *ScheduleChannelCloseRequest) Reset() {
+	*x = ScheduleChannelCloseRequest{}
 	mi := &file_lightning_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LookupHtlcResolutionRequest) String() string {
+func (x *ScheduleChannelCloseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupHtlcResolutionRequest) ProtoMessage() {}
+func (*ScheduleChannelCloseRequest) ProtoMessage() {}
 
-func (x *LookupHtlcResolutionRequest) ProtoReflect() protoreflect.Message {
+func (x *ScheduleChannelCloseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lightning_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1489,49 +1674,73 @@ func (x *LookupHtlcResolutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupHtlcResolutionRequest.ProtoReflect.Descriptor instead.
-func (*LookupHtlcResolutionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduleChannelCloseRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleChannelCloseRequest) Descriptor() ([]byte, []int) {
 	return file_lightning_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *LookupHtlcResolutionRequest) GetChanId() uint64 {
+func (x *ScheduleChannelCloseRequest) GetChannelPoint() *ChannelPoint {
 	if x != nil {
-		return x.ChanId
+		return x.ChannelPoint
+	}
+	return nil
+}
+
+func (x *ScheduleChannelCloseRequest) GetDeadlineHeight() uint32 {
+	if x != nil {
+		return x.DeadlineHeight
 	}
 	return 0
 }
 
-func (x *LookupHtlcResolutionRequest) GetHtlcIndex() uint64 {
+func (x *ScheduleChannelCloseRequest) GetTargetSatPerVbyte() uint64 {
 	if x != nil {
-		return x.HtlcIndex
+		return x.TargetSatPerVbyte
 	}
 	return 0
 }
 
-type LookupHtlcResolutionResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Settled is true is the htlc was settled. If false, the htlc was failed.
-	Settled bool `protobuf:"varint,1,opt,name=settled,proto3" json:"settled,omitempty"`
-	// Offchain indicates whether the htlc was resolved off-chain or on-chain.
-	Offchain      bool `protobuf:"varint,2,opt,name=offchain,proto3" json:"offchain,omitempty"`
+func (x *ScheduleChannelCloseRequest) GetTargetConf() uint32 {
+	if x != nil {
+		return x.TargetConf
+	}
+	return 0
+}
+
+func (x *ScheduleChannelCloseRequest) GetBudgetSat() uint64 {
+	if x != nil {
+		return x.BudgetSat
+	}
+	return 0
+}
+
+func (x *ScheduleChannelCloseRequest) GetDeliveryAddress() string {
+	if x != nil {
+		return x.DeliveryAddress
+	}
+	return ""
+}
+
+type ScheduleChannelCloseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LookupHtlcResolutionResponse) Reset() {
-	*x = LookupHtlcResolutionResponse{}
+func (x *ScheduleChannelCloseResponse) Reset() {
+	*x = ScheduleChannelCloseResponse{}
 	mi := &file_lightning_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LookupHtlcResolutionResponse) String() string {
+func (x *ScheduleChannelCloseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupHtlcResolutionResponse) ProtoMessage() {}
+func (*ScheduleChannelCloseResponse) ProtoMessage() {}
 
-func (x *LookupHtlcResolutionResponse) ProtoReflect() protoreflect.Message {
+func (x *ScheduleChannelCloseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lightning_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1543,46 +1752,77 @@ func (x *LookupHtlcResolutionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupHtlcResolutionResponse.ProtoReflect.Descriptor instead.
-func (*LookupHtlcResolutionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduleChannelCloseResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleChannelCloseResponse) Descriptor() ([]byte, []int) {
 	return file_lightning_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *LookupHtlcResolutionResponse) GetSettled() bool {
+type CancelScheduledCloseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The channel point of the channel whose scheduled close to cancel.
+	ChannelPoint  *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledCloseRequest) Reset() {
+	*x = CancelScheduledCloseRequest{}
+	mi := &file_lightning_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledCloseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledCloseRequest) ProtoMessage() {}
+
+func (x *CancelScheduledCloseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[2]
 	if x != nil {
-		return x.Settled
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *LookupHtlcResolutionResponse) GetOffchain() bool {
+// Deprecated: Use CancelScheduledCloseRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledCloseRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CancelScheduledCloseRequest) GetChannelPoint() *ChannelPoint {
 	if x != nil {
-		return x.Offchain
+		return x.ChannelPoint
 	}
-	return false
+	return nil
 }
 
-type SubscribeCustomMessagesRequest struct {
+type CancelScheduledCloseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubscribeCustomMessagesRequest) Reset() {
-	*x = SubscribeCustomMessagesRequest{}
-	mi := &file_lightning_proto_msgTypes[2]
+func (x *CancelScheduledCloseResponse) Reset() {
+	*x = CancelScheduledCloseResponse{}
+	mi := &file_lightning_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeCustomMessagesRequest) String() string {
+func (x *CancelScheduledCloseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeCustomMessagesRequest) ProtoMessage() {}
+func (*CancelScheduledCloseResponse) ProtoMessage() {}
 
-func (x *SubscribeCustomMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[2]
+func (x *CancelScheduledCloseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1593,38 +1833,37 @@ func (x *SubscribeCustomMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeCustomMessagesRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeCustomMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CancelScheduledCloseResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledCloseResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{3}
 }
 
-type CustomMessage struct {
+type PauseChannelRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Peer from which the message originates
-	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
-	// Message type. This value will be in the custom range (>= 32768).
-	Type uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	// Raw message data
-	Data          []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The channel point of the channel to pause.
+	ChanPoint *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	// The maximum number of seconds to wait for the in-flight HTLCs of the
+	// channel to be resolved. Defaults to 60 seconds if not set.
+	TimeoutSeconds uint32 `protobuf:"varint,2,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CustomMessage) Reset() {
-	*x = CustomMessage{}
-	mi := &file_lightning_proto_msgTypes[3]
+func (x *PauseChannelRequest) Reset() {
+	*x = PauseChannelRequest{}
+	mi := &file_lightning_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CustomMessage) String() string {
+func (x *PauseChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CustomMessage) ProtoMessage() {}
+func (*PauseChannelRequest) ProtoMessage() {}
 
-func (x *CustomMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[3]
+func (x *PauseChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,63 +1874,46 @@ func (x *CustomMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CustomMessage.ProtoReflect.Descriptor instead.
-func (*CustomMessage) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use PauseChannelRequest.ProtoReflect.Descriptor instead.
+func (*PauseChannelRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CustomMessage) GetPeer() []byte {
+func (x *PauseChannelRequest) GetChanPoint() *ChannelPoint {
 	if x != nil {
-		return x.Peer
+		return x.ChanPoint
 	}
 	return nil
 }
 
-func (x *CustomMessage) GetType() uint32 {
+func (x *PauseChannelRequest) GetTimeoutSeconds() uint32 {
 	if x != nil {
-		return x.Type
+		return x.TimeoutSeconds
 	}
 	return 0
 }
 
-func (x *CustomMessage) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+type PauseChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type SendCustomMessageRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Peer to which the message will be sent. Represented as a byte-encoded
-	// public key
-	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
-	// Message type. This value needs to be in the custom range (>= 32768).
-	// To send a type < custom range, lnd needs to be compiled with the `dev`
-	// build tag, and the message type to override should be specified in lnd's
-	// experimental protocol configuration.
-	Type uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	// Raw message data.
-	Data          []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SendCustomMessageRequest) Reset() {
-	*x = SendCustomMessageRequest{}
-	mi := &file_lightning_proto_msgTypes[4]
+func (x *PauseChannelResponse) Reset() {
+	*x = PauseChannelResponse{}
+	mi := &file_lightning_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendCustomMessageRequest) String() string {
+func (x *PauseChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendCustomMessageRequest) ProtoMessage() {}
+func (*PauseChannelResponse) ProtoMessage() {}
 
-func (x *SendCustomMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[4]
+func (x *PauseChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1702,55 +1924,34 @@ func (x *SendCustomMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendCustomMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendCustomMessageRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *SendCustomMessageRequest) GetPeer() []byte {
-	if x != nil {
-		return x.Peer
-	}
-	return nil
-}
-
-func (x *SendCustomMessageRequest) GetType() uint32 {
-	if x != nil {
-		return x.Type
-	}
-	return 0
-}
-
-func (x *SendCustomMessageRequest) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+// Deprecated: Use PauseChannelResponse.ProtoReflect.Descriptor instead.
+func (*PauseChannelResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{5}
 }
 
-type SendCustomMessageResponse struct {
+type ResumeChannelRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The status of the send operation.
-	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The channel point of the paused channel to resume.
+	ChanPoint     *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendCustomMessageResponse) Reset() {
-	*x = SendCustomMessageResponse{}
-	mi := &file_lightning_proto_msgTypes[5]
+func (x *ResumeChannelRequest) Reset() {
+	*x = ResumeChannelRequest{}
+	mi := &file_lightning_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendCustomMessageResponse) String() string {
+func (x *ResumeChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendCustomMessageResponse) ProtoMessage() {}
+func (*ResumeChannelRequest) ProtoMessage() {}
 
-func (x *SendCustomMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[5]
+func (x *ResumeChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1761,39 +1962,39 @@ func (x *SendCustomMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendCustomMessageResponse.ProtoReflect.Descriptor instead.
-func (*SendCustomMessageResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ResumeChannelRequest.ProtoReflect.Descriptor instead.
+func (*ResumeChannelRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SendCustomMessageResponse) GetStatus() string {
+func (x *ResumeChannelRequest) GetChanPoint() *ChannelPoint {
 	if x != nil {
-		return x.Status
+		return x.ChanPoint
 	}
-	return ""
+	return nil
 }
 
-type SubscribeOnionMessagesRequest struct {
+type ResumeChannelResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubscribeOnionMessagesRequest) Reset() {
-	*x = SubscribeOnionMessagesRequest{}
-	mi := &file_lightning_proto_msgTypes[6]
+func (x *ResumeChannelResponse) Reset() {
+	*x = ResumeChannelResponse{}
+	mi := &file_lightning_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeOnionMessagesRequest) String() string {
+func (x *ResumeChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeOnionMessagesRequest) ProtoMessage() {}
+func (*ResumeChannelResponse) ProtoMessage() {}
 
-func (x *SubscribeOnionMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[6]
+func (x *ResumeChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1804,63 +2005,38 @@ func (x *SubscribeOnionMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeOnionMessagesRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeOnionMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ResumeChannelResponse.ProtoReflect.Descriptor instead.
+func (*ResumeChannelResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{7}
 }
 
-type OnionMessageUpdate struct {
+type BumpFundingFeeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Peer from which this message originates. Represented as a byte-encoded
-	// public key.
-	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
-	// PathKey is used to derive the blinded node id by tweaking the hop's
-	// static public key. The hop uses the corresponding blinded private key
-	// together with the sender's ephemeral key to perform ECDH and obtain the
-	// shared secret for decrypting the onion payload. Separately, for
-	// decrypting `encrypted_recipient_data`, the recipient performs ECDH
-	// between its static node private key and the path_key to derive the
-	// decryption key.
-	PathKey []byte `protobuf:"bytes,2,opt,name=path_key,json=pathKey,proto3" json:"path_key,omitempty"`
-	// Serialized Sphinx onion packet (BOLT 4) containing the layered, per-hop
-	// encrypted payloads and routing instructions used to forward this message
-	// along its designated path.
-	Onion []byte `protobuf:"bytes,3,opt,name=onion,proto3" json:"onion,omitempty"`
-	// reply_path is the blinded path that should be used when replying to a
-	// received message. The introduction_node field is passed through verbatim
-	// from the wire. It may carry either the 33-byte SEC1 compressed pubkey
-	// form or the 9-byte sciddir form. The sciddir form consists of a 1-byte
-	// direction selector (0x00 or 0x01) followed by an 8-byte short channel ID.
-	// Subscribers that intend to reply resolve the sciddir form against their
-	// local channel graph.
-	ReplyPath *BlindedPath `protobuf:"bytes,4,opt,name=reply_path,json=replyPath,proto3" json:"reply_path,omitempty"`
-	// encrypted_recipient_data is the encrypted data that contains the
-	// forwarding information for an onion message. It contains either
-	// next_node_id or short_channel_id for each non-final node. It MAY contain
-	// the path_id for the final node.
-	EncryptedRecipientData []byte `protobuf:"bytes,5,opt,name=encrypted_recipient_data,json=encryptedRecipientData,proto3" json:"encrypted_recipient_data,omitempty"`
-	// Custom onion message tlv records. These are customized fields that are
-	// not defined by LND and cannot be extracted.
-	CustomRecords map[uint64][]byte `protobuf:"bytes,6,rep,name=custom_records,json=customRecords,proto3" json:"custom_records,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The channel point of the pending dual-funded channel.
+	ChanPoint *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	// The fee rate in sat/vbyte of the replacement funding transaction. It
+	// must be at least 25/24 of the fee rate of the funding transaction it
+	// replaces.
+	SatPerVbyte   uint64 `protobuf:"varint,2,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OnionMessageUpdate) Reset() {
-	*x = OnionMessageUpdate{}
-	mi := &file_lightning_proto_msgTypes[7]
+func (x *BumpFundingFeeRequest) Reset() {
+	*x = BumpFundingFeeRequest{}
+	mi := &file_lightning_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OnionMessageUpdate) String() string {
+func (x *BumpFundingFeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OnionMessageUpdate) ProtoMessage() {}
+func (*BumpFundingFeeRequest) ProtoMessage() {}
 
-func (x *OnionMessageUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[7]
+func (x *BumpFundingFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1871,88 +2047,108 @@ func (x *OnionMessageUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OnionMessageUpdate.ProtoReflect.Descriptor instead.
-func (*OnionMessageUpdate) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BumpFundingFeeRequest.ProtoReflect.Descriptor instead.
+func (*BumpFundingFeeRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *OnionMessageUpdate) GetPeer() []byte {
+func (x *BumpFundingFeeRequest) GetChanPoint() *ChannelPoint {
 	if x != nil {
-		return x.Peer
+		return x.ChanPoint
 	}
 	return nil
 }
 
-func (x *OnionMessageUpdate) GetPathKey() []byte {
+func (x *BumpFundingFeeRequest) GetSatPerVbyte() uint64 {
 	if x != nil {
-		return x.PathKey
+		return x.SatPerVbyte
 	}
-	return nil
+	return 0
 }
 
-func (x *OnionMessageUpdate) GetOnion() []byte {
-	if x != nil {
-		return x.Onion
-	}
-	return nil
+type BumpFundingFeeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The channel point of the replacement funding transaction.
+	ChanPoint     *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OnionMessageUpdate) GetReplyPath() *BlindedPath {
-	if x != nil {
-		return x.ReplyPath
-	}
-	return nil
+func (x *BumpFundingFeeResponse) Reset() {
+	*x = BumpFundingFeeResponse{}
+	mi := &file_lightning_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OnionMessageUpdate) GetEncryptedRecipientData() []byte {
+func (x *BumpFundingFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpFundingFeeResponse) ProtoMessage() {}
+
+func (x *BumpFundingFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[9]
 	if x != nil {
-		return x.EncryptedRecipientData
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *OnionMessageUpdate) GetCustomRecords() map[uint64][]byte {
+// Deprecated: Use BumpFundingFeeResponse.ProtoReflect.Descriptor instead.
+func (*BumpFundingFeeResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BumpFundingFeeResponse) GetChanPoint() *ChannelPoint {
 	if x != nil {
-		return x.CustomRecords
+		return x.ChanPoint
 	}
 	return nil
 }
 
-type SendOnionMessageRequest struct {
+type BuyLiquidityRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Peer to send the message to
-	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
-	// PathKey is used to derive the blinded node id by tweaking the hop's
-	// static public key. The hop uses the corresponding blinded private key
-	// together with the sender's ephemeral key to perform ECDH and obtain the
-	// shared secret for decrypting the onion payload. Separately, for
-	// decrypting `encrypted_recipient_data`, the recipient performs ECDH
-	// between its static node private key and the path_key to derive the
-	// decryption key.
-	PathKey []byte `protobuf:"bytes,2,opt,name=path_key,json=pathKey,proto3" json:"path_key,omitempty"`
-	// Serialized Sphinx onion packet (BOLT 4) containing the layered, per-hop
-	// encrypted payloads and routing instructions used to forward this message
-	// along its designated path.
-	Onion         []byte `protobuf:"bytes,3,opt,name=onion,proto3" json:"onion,omitempty"`
+	// The public key of the node to buy the liquidity from. We must be
+	// connected to it.
+	NodePubkey []byte `protobuf:"bytes,1,opt,name=node_pubkey,json=nodePubkey,proto3" json:"node_pubkey,omitempty"`
+	// The amount of inbound liquidity to lease in satoshis, which the seller
+	// contributes to the channel.
+	AmtSat int64 `protobuf:"varint,2,opt,name=amt_sat,json=amtSat,proto3" json:"amt_sat,omitempty"`
+	// The fee rate in sat/vbyte of the funding transaction of the leased
+	// channel.
+	SatPerVbyte uint64 `protobuf:"varint,3,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	// The maximum lease fee in satoshis.
+	MaxFeeSat int64 `protobuf:"varint,4,opt,name=max_fee_sat,json=maxFeeSat,proto3" json:"max_fee_sat,omitempty"`
+	// The amount in satoshis we contribute to the channel. It must cover the
+	// lease fee, which is paid out of our initial channel balance.
+	LocalFundingAmount int64 `protobuf:"varint,5,opt,name=local_funding_amount,json=localFundingAmount,proto3" json:"local_funding_amount,omitempty"`
+	// Whether the leased channel should be private, and not announced to the
+	// greater network.
+	Private       bool `protobuf:"varint,6,opt,name=private,proto3" json:"private,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendOnionMessageRequest) Reset() {
-	*x = SendOnionMessageRequest{}
-	mi := &file_lightning_proto_msgTypes[8]
+func (x *BuyLiquidityRequest) Reset() {
+	*x = BuyLiquidityRequest{}
+	mi := &file_lightning_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendOnionMessageRequest) String() string {
+func (x *BuyLiquidityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendOnionMessageRequest) ProtoMessage() {}
+func (*BuyLiquidityRequest) ProtoMessage() {}
 
-func (x *SendOnionMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[8]
+func (x *BuyLiquidityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1963,55 +2159,80 @@ func (x *SendOnionMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendOnionMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendOnionMessageRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use BuyLiquidityRequest.ProtoReflect.Descriptor instead.
+func (*BuyLiquidityRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SendOnionMessageRequest) GetPeer() []byte {
+func (x *BuyLiquidityRequest) GetNodePubkey() []byte {
 	if x != nil {
-		return x.Peer
+		return x.NodePubkey
 	}
 	return nil
 }
 
-func (x *SendOnionMessageRequest) GetPathKey() []byte {
+func (x *BuyLiquidityRequest) GetAmtSat() int64 {
 	if x != nil {
-		return x.PathKey
+		return x.AmtSat
 	}
-	return nil
+	return 0
 }
 
-func (x *SendOnionMessageRequest) GetOnion() []byte {
+func (x *BuyLiquidityRequest) GetSatPerVbyte() uint64 {
 	if x != nil {
-		return x.Onion
+		return x.SatPerVbyte
 	}
-	return nil
+	return 0
 }
 
-type SendOnionMessageResponse struct {
+func (x *BuyLiquidityRequest) GetMaxFeeSat() int64 {
+	if x != nil {
+		return x.MaxFeeSat
+	}
+	return 0
+}
+
+func (x *BuyLiquidityRequest) GetLocalFundingAmount() int64 {
+	if x != nil {
+		return x.LocalFundingAmount
+	}
+	return 0
+}
+
+func (x *BuyLiquidityRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+type BuyLiquidityResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The status of the onion message send operation.
-	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The channel point of the leased channel.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,5,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// The absolute block height at which the lease expires.
+	LeaseExpiry uint32 `protobuf:"varint,2,opt,name=lease_expiry,json=leaseExpiry,proto3" json:"lease_expiry,omitempty"`
+	// The lease fee paid to the seller in satoshis.
+	LeaseFeeSat   int64 `protobuf:"varint,3,opt,name=lease_fee_sat,json=leaseFeeSat,proto3" json:"lease_fee_sat,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendOnionMessageResponse) Reset() {
-	*x = SendOnionMessageResponse{}
-	mi := &file_lightning_proto_msgTypes[9]
+func (x *BuyLiquidityResponse) Reset() {
+	*x = BuyLiquidityResponse{}
+	mi := &file_lightning_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendOnionMessageResponse) String() string {
+func (x *BuyLiquidityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendOnionMessageResponse) ProtoMessage() {}
+func (*BuyLiquidityResponse) ProtoMessage() {}
 
-func (x *SendOnionMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[9]
+func (x *BuyLiquidityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2022,51 +2243,53 @@ func (x *SendOnionMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendOnionMessageResponse.ProtoReflect.Descriptor instead.
-func (*SendOnionMessageResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use BuyLiquidityResponse.ProtoReflect.Descriptor instead.
+func (*BuyLiquidityResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SendOnionMessageResponse) GetStatus() string {
+func (x *BuyLiquidityResponse) GetChannelPoint() *ChannelPoint {
 	if x != nil {
-		return x.Status
+		return x.ChannelPoint
 	}
-	return ""
+	return nil
 }
 
-type Utxo struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The type of address
-	AddressType AddressType `protobuf:"varint,1,opt,name=address_type,json=addressType,proto3,enum=lnrpc.AddressType" json:"address_type,omitempty"`
-	// The address
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// The value of the unspent coin in satoshis
-	AmountSat int64 `protobuf:"varint,3,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
-	// The pkscript in hex
-	PkScript string `protobuf:"bytes,4,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
-	// The outpoint in format txid:n
-	Outpoint *OutPoint `protobuf:"bytes,5,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	// The number of confirmations for the Utxo
-	Confirmations int64 `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+func (x *BuyLiquidityResponse) GetLeaseExpiry() uint32 {
+	if x != nil {
+		return x.LeaseExpiry
+	}
+	return 0
+}
+
+func (x *BuyLiquidityResponse) GetLeaseFeeSat() int64 {
+	if x != nil {
+		return x.LeaseFeeSat
+	}
+	return 0
+}
+
+type ListLiquidityAdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Utxo) Reset() {
-	*x = Utxo{}
-	mi := &file_lightning_proto_msgTypes[10]
+func (x *ListLiquidityAdsRequest) Reset() {
+	*x = ListLiquidityAdsRequest{}
+	mi := &file_lightning_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Utxo) String() string {
+func (x *ListLiquidityAdsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Utxo) ProtoMessage() {}
+func (*ListLiquidityAdsRequest) ProtoMessage() {}
 
-func (x *Utxo) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[10]
+func (x *ListLiquidityAdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2077,86 +2300,122 @@ func (x *Utxo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Utxo.ProtoReflect.Descriptor instead.
-func (*Utxo) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListLiquidityAdsRequest.ProtoReflect.Descriptor instead.
+func (*ListLiquidityAdsRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Utxo) GetAddressType() AddressType {
+type LeaseRates struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The weight of the inputs and outputs the seller adds to the funding
+	// transaction, which the buyer pays for at the requested fee rate.
+	FundingWeight uint32 `protobuf:"varint,1,opt,name=funding_weight,json=fundingWeight,proto3" json:"funding_weight,omitempty"`
+	// The fee charged for a lease in basis points of the leased amount.
+	LeaseFeeBasis uint32 `protobuf:"varint,2,opt,name=lease_fee_basis,json=leaseFeeBasis,proto3" json:"lease_fee_basis,omitempty"`
+	// The base fee in satoshis charged for a lease.
+	LeaseFeeBaseSat uint32 `protobuf:"varint,3,opt,name=lease_fee_base_sat,json=leaseFeeBaseSat,proto3" json:"lease_fee_base_sat,omitempty"`
+	// The maximum base routing fee in msat the seller charges on a leased
+	// channel while the lease is active.
+	ChannelFeeMaxBaseMsat uint32 `protobuf:"varint,4,opt,name=channel_fee_max_base_msat,json=channelFeeMaxBaseMsat,proto3" json:"channel_fee_max_base_msat,omitempty"`
+	// The maximum proportional routing fee in millionths the seller charges on
+	// a leased channel while the lease is active.
+	ChannelFeeMaxProportional uint32 `protobuf:"varint,5,opt,name=channel_fee_max_proportional,json=channelFeeMaxProportional,proto3" json:"channel_fee_max_proportional,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *LeaseRates) Reset() {
+	*x = LeaseRates{}
+	mi := &file_lightning_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaseRates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseRates) ProtoMessage() {}
+
+func (x *LeaseRates) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[13]
 	if x != nil {
-		return x.AddressType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return AddressType_WITNESS_PUBKEY_HASH
+	return mi.MessageOf(x)
 }
 
-func (x *Utxo) GetAddress() string {
+// Deprecated: Use LeaseRates.ProtoReflect.Descriptor instead.
+func (*LeaseRates) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LeaseRates) GetFundingWeight() uint32 {
 	if x != nil {
-		return x.Address
+		return x.FundingWeight
 	}
-	return ""
+	return 0
 }
 
-func (x *Utxo) GetAmountSat() int64 {
+func (x *LeaseRates) GetLeaseFeeBasis() uint32 {
 	if x != nil {
-		return x.AmountSat
+		return x.LeaseFeeBasis
 	}
 	return 0
 }
 
-func (x *Utxo) GetPkScript() string {
+func (x *LeaseRates) GetLeaseFeeBaseSat() uint32 {
 	if x != nil {
-		return x.PkScript
+		return x.LeaseFeeBaseSat
 	}
-	return ""
+	return 0
 }
 
-func (x *Utxo) GetOutpoint() *OutPoint {
+func (x *LeaseRates) GetChannelFeeMaxBaseMsat() uint32 {
 	if x != nil {
-		return x.Outpoint
+		return x.ChannelFeeMaxBaseMsat
 	}
-	return nil
+	return 0
 }
 
-func (x *Utxo) GetConfirmations() int64 {
+func (x *LeaseRates) GetChannelFeeMaxProportional() uint32 {
 	if x != nil {
-		return x.Confirmations
+		return x.ChannelFeeMaxProportional
 	}
 	return 0
 }
 
-type OutputDetail struct {
+type LiquidityAd struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The type of the output
-	OutputType OutputScriptType `protobuf:"varint,1,opt,name=output_type,json=outputType,proto3,enum=lnrpc.OutputScriptType" json:"output_type,omitempty"`
-	// The address
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// The pkscript in hex
-	PkScript string `protobuf:"bytes,3,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
-	// The output index used in the raw transaction
-	OutputIndex int64 `protobuf:"varint,4,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
-	// The value of the output coin in satoshis
-	Amount int64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	// Denotes if the output is controlled by the internal wallet
-	IsOurAddress  bool `protobuf:"varint,6,opt,name=is_our_address,json=isOurAddress,proto3" json:"is_our_address,omitempty"`
+	// The public key of the advertising node.
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// The alias of the advertising node.
+	Alias string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	// The advertised lease rates.
+	Rates         *LeaseRates `protobuf:"bytes,3,opt,name=rates,proto3" json:"rates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OutputDetail) Reset() {
-	*x = OutputDetail{}
-	mi := &file_lightning_proto_msgTypes[11]
+func (x *LiquidityAd) Reset() {
+	*x = LiquidityAd{}
+	mi := &file_lightning_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OutputDetail) String() string {
+func (x *LiquidityAd) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OutputDetail) ProtoMessage() {}
+func (*LiquidityAd) ProtoMessage() {}
 
-func (x *OutputDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[11]
+func (x *LiquidityAd) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2167,101 +2426,55 @@ func (x *OutputDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OutputDetail.ProtoReflect.Descriptor instead.
-func (*OutputDetail) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *OutputDetail) GetOutputType() OutputScriptType {
-	if x != nil {
-		return x.OutputType
-	}
-	return OutputScriptType_SCRIPT_TYPE_PUBKEY_HASH
+// Deprecated: Use LiquidityAd.ProtoReflect.Descriptor instead.
+func (*LiquidityAd) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *OutputDetail) GetAddress() string {
+func (x *LiquidityAd) GetPubKey() string {
 	if x != nil {
-		return x.Address
+		return x.PubKey
 	}
 	return ""
 }
 
-func (x *OutputDetail) GetPkScript() string {
+func (x *LiquidityAd) GetAlias() string {
 	if x != nil {
-		return x.PkScript
+		return x.Alias
 	}
 	return ""
 }
 
-func (x *OutputDetail) GetOutputIndex() int64 {
-	if x != nil {
-		return x.OutputIndex
-	}
-	return 0
-}
-
-func (x *OutputDetail) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *OutputDetail) GetIsOurAddress() bool {
+func (x *LiquidityAd) GetRates() *LeaseRates {
 	if x != nil {
-		return x.IsOurAddress
+		return x.Rates
 	}
-	return false
+	return nil
 }
 
-type Transaction struct {
+type ListLiquidityAdsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The transaction hash
-	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	// The transaction amount, denominated in satoshis
-	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	// The number of confirmations
-	NumConfirmations int32 `protobuf:"varint,3,opt,name=num_confirmations,json=numConfirmations,proto3" json:"num_confirmations,omitempty"`
-	// The hash of the block this transaction was included in
-	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	// The height of the block this transaction was included in
-	BlockHeight int32 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	// Timestamp of this transaction
-	TimeStamp int64 `protobuf:"varint,6,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
-	// Fees paid for this transaction
-	TotalFees int64 `protobuf:"varint,7,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
-	// Addresses that received funds for this transaction. Deprecated as it is
-	// now incorporated in the output_details field.
-	//
-	// Deprecated: Marked as deprecated in lightning.proto.
-	DestAddresses []string `protobuf:"bytes,8,rep,name=dest_addresses,json=destAddresses,proto3" json:"dest_addresses,omitempty"`
-	// Outputs that received funds for this transaction
-	OutputDetails []*OutputDetail `protobuf:"bytes,11,rep,name=output_details,json=outputDetails,proto3" json:"output_details,omitempty"`
-	// The raw transaction hex.
-	RawTxHex string `protobuf:"bytes,9,opt,name=raw_tx_hex,json=rawTxHex,proto3" json:"raw_tx_hex,omitempty"`
-	// A label that was optionally set on transaction broadcast.
-	Label string `protobuf:"bytes,10,opt,name=label,proto3" json:"label,omitempty"`
-	// PreviousOutpoints/Inputs of this transaction.
-	PreviousOutpoints []*PreviousOutPoint `protobuf:"bytes,12,rep,name=previous_outpoints,json=previousOutpoints,proto3" json:"previous_outpoints,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// The nodes that advertise lease rates.
+	Ads           []*LiquidityAd `protobuf:"bytes,1,rep,name=ads,proto3" json:"ads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transaction) Reset() {
-	*x = Transaction{}
-	mi := &file_lightning_proto_msgTypes[12]
+func (x *ListLiquidityAdsResponse) Reset() {
+	*x = ListLiquidityAdsResponse{}
+	mi := &file_lightning_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) String() string {
+func (x *ListLiquidityAdsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction) ProtoMessage() {}
+func (*ListLiquidityAdsResponse) ProtoMessage() {}
 
-func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[12]
+func (x *ListLiquidityAdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2272,134 +2485,145 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
-func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListLiquidityAdsResponse.ProtoReflect.Descriptor instead.
+func (*ListLiquidityAdsResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Transaction) GetTxHash() string {
+func (x *ListLiquidityAdsResponse) GetAds() []*LiquidityAd {
 	if x != nil {
-		return x.TxHash
+		return x.Ads
 	}
-	return ""
+	return nil
 }
 
-func (x *Transaction) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+type LookupHtlcResolutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChanId        uint64                 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	HtlcIndex     uint64                 `protobuf:"varint,2,opt,name=htlc_index,json=htlcIndex,proto3" json:"htlc_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transaction) GetNumConfirmations() int32 {
-	if x != nil {
-		return x.NumConfirmations
-	}
-	return 0
+func (x *LookupHtlcResolutionRequest) Reset() {
+	*x = LookupHtlcResolutionRequest{}
+	mi := &file_lightning_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) GetBlockHash() string {
-	if x != nil {
-		return x.BlockHash
-	}
-	return ""
+func (x *LookupHtlcResolutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Transaction) GetBlockHeight() int32 {
+func (*LookupHtlcResolutionRequest) ProtoMessage() {}
+
+func (x *LookupHtlcResolutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[16]
 	if x != nil {
-		return x.BlockHeight
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Transaction) GetTimeStamp() int64 {
+// Deprecated: Use LookupHtlcResolutionRequest.ProtoReflect.Descriptor instead.
+func (*LookupHtlcResolutionRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LookupHtlcResolutionRequest) GetChanId() uint64 {
 	if x != nil {
-		return x.TimeStamp
+		return x.ChanId
 	}
 	return 0
 }
 
-func (x *Transaction) GetTotalFees() int64 {
+func (x *LookupHtlcResolutionRequest) GetHtlcIndex() uint64 {
 	if x != nil {
-		return x.TotalFees
+		return x.HtlcIndex
 	}
 	return 0
 }
 
-// Deprecated: Marked as deprecated in lightning.proto.
-func (x *Transaction) GetDestAddresses() []string {
-	if x != nil {
-		return x.DestAddresses
-	}
-	return nil
+type LookupHtlcResolutionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Settled is true is the htlc was settled. If false, the htlc was failed.
+	Settled bool `protobuf:"varint,1,opt,name=settled,proto3" json:"settled,omitempty"`
+	// Offchain indicates whether the htlc was resolved off-chain or on-chain.
+	Offchain      bool `protobuf:"varint,2,opt,name=offchain,proto3" json:"offchain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transaction) GetOutputDetails() []*OutputDetail {
-	if x != nil {
-		return x.OutputDetails
-	}
-	return nil
+func (x *LookupHtlcResolutionResponse) Reset() {
+	*x = LookupHtlcResolutionResponse{}
+	mi := &file_lightning_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) GetRawTxHex() string {
+func (x *LookupHtlcResolutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupHtlcResolutionResponse) ProtoMessage() {}
+
+func (x *LookupHtlcResolutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[17]
 	if x != nil {
-		return x.RawTxHex
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Transaction) GetLabel() string {
+// Deprecated: Use LookupHtlcResolutionResponse.ProtoReflect.Descriptor instead.
+func (*LookupHtlcResolutionResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LookupHtlcResolutionResponse) GetSettled() bool {
 	if x != nil {
-		return x.Label
+		return x.Settled
 	}
-	return ""
+	return false
 }
 
-func (x *Transaction) GetPreviousOutpoints() []*PreviousOutPoint {
+func (x *LookupHtlcResolutionResponse) GetOffchain() bool {
 	if x != nil {
-		return x.PreviousOutpoints
+		return x.Offchain
 	}
-	return nil
+	return false
 }
 
-type GetTransactionsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The height from which to list transactions, inclusive. If this value is
-	// greater than end_height, transactions will be read in reverse.
-	StartHeight int32 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
-	// The height until which to list transactions, inclusive. To include
-	// unconfirmed transactions, this value should be set to -1, which will
-	// return transactions from start_height until the current chain tip and
-	// unconfirmed transactions. If no end_height is provided, the call will
-	// default to this option.
-	EndHeight int32 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
-	// An optional filter to only include transactions relevant to an account.
-	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
-	// The index of a transaction that will be used in a query to determine which
-	// transaction should be returned in the response.
-	IndexOffset uint32 `protobuf:"varint,4,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
-	// The maximal number of transactions returned in the response to this query.
-	// This value should be set to 0 to return all transactions.
-	MaxTransactions uint32 `protobuf:"varint,5,opt,name=max_transactions,json=maxTransactions,proto3" json:"max_transactions,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type SubscribeCustomMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionsRequest) Reset() {
-	*x = GetTransactionsRequest{}
-	mi := &file_lightning_proto_msgTypes[13]
+func (x *SubscribeCustomMessagesRequest) Reset() {
+	*x = SubscribeCustomMessagesRequest{}
+	mi := &file_lightning_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionsRequest) String() string {
+func (x *SubscribeCustomMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionsRequest) ProtoMessage() {}
+func (*SubscribeCustomMessagesRequest) ProtoMessage() {}
 
-func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[13]
+func (x *SubscribeCustomMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2410,75 +2634,105 @@ func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SubscribeCustomMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeCustomMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetTransactionsRequest) GetStartHeight() int32 {
-	if x != nil {
-		return x.StartHeight
-	}
-	return 0
+type CustomMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Peer from which the message originates
+	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// Message type. This value will be in the custom range (>= 32768).
+	Type uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Raw message data
+	Data          []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionsRequest) GetEndHeight() int32 {
+func (x *CustomMessage) Reset() {
+	*x = CustomMessage{}
+	mi := &file_lightning_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomMessage) ProtoMessage() {}
+
+func (x *CustomMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[19]
 	if x != nil {
-		return x.EndHeight
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetTransactionsRequest) GetAccount() string {
+// Deprecated: Use CustomMessage.ProtoReflect.Descriptor instead.
+func (*CustomMessage) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CustomMessage) GetPeer() []byte {
 	if x != nil {
-		return x.Account
+		return x.Peer
 	}
-	return ""
+	return nil
 }
 
-func (x *GetTransactionsRequest) GetIndexOffset() uint32 {
+func (x *CustomMessage) GetType() uint32 {
 	if x != nil {
-		return x.IndexOffset
+		return x.Type
 	}
 	return 0
 }
 
-func (x *GetTransactionsRequest) GetMaxTransactions() uint32 {
+func (x *CustomMessage) GetData() []byte {
 	if x != nil {
-		return x.MaxTransactions
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type TransactionDetails struct {
+type SendCustomMessageRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of transactions relevant to the wallet.
-	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	// The index of the last item in the set of returned transactions. This can be
-	// used to seek further, pagination style.
-	LastIndex uint64 `protobuf:"varint,2,opt,name=last_index,json=lastIndex,proto3" json:"last_index,omitempty"`
-	// The index of the last item in the set of returned transactions. This can be
-	// used to seek backwards, pagination style.
-	FirstIndex    uint64 `protobuf:"varint,3,opt,name=first_index,json=firstIndex,proto3" json:"first_index,omitempty"`
+	// Peer to which the message will be sent. Represented as a byte-encoded
+	// public key
+	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// Message type. This value needs to be in the custom range (>= 32768).
+	// To send a type < custom range, lnd needs to be compiled with the `dev`
+	// build tag, and the message type to override should be specified in lnd's
+	// experimental protocol configuration.
+	Type uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Raw message data.
+	Data          []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransactionDetails) Reset() {
-	*x = TransactionDetails{}
-	mi := &file_lightning_proto_msgTypes[14]
+func (x *SendCustomMessageRequest) Reset() {
+	*x = SendCustomMessageRequest{}
+	mi := &file_lightning_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionDetails) String() string {
+func (x *SendCustomMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionDetails) ProtoMessage() {}
+func (*SendCustomMessageRequest) ProtoMessage() {}
 
-func (x *TransactionDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[14]
+func (x *SendCustomMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2489,59 +2743,55 @@ func (x *TransactionDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionDetails.ProtoReflect.Descriptor instead.
-func (*TransactionDetails) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SendCustomMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendCustomMessageRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *TransactionDetails) GetTransactions() []*Transaction {
+func (x *SendCustomMessageRequest) GetPeer() []byte {
 	if x != nil {
-		return x.Transactions
+		return x.Peer
 	}
 	return nil
 }
 
-func (x *TransactionDetails) GetLastIndex() uint64 {
+func (x *SendCustomMessageRequest) GetType() uint32 {
 	if x != nil {
-		return x.LastIndex
+		return x.Type
 	}
 	return 0
 }
 
-func (x *TransactionDetails) GetFirstIndex() uint64 {
+func (x *SendCustomMessageRequest) GetData() []byte {
 	if x != nil {
-		return x.FirstIndex
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type FeeLimit struct {
+type SendCustomMessageResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Limit:
-	//
-	//	*FeeLimit_Fixed
-	//	*FeeLimit_FixedMsat
-	//	*FeeLimit_Percent
-	Limit         isFeeLimit_Limit `protobuf_oneof:"limit"`
+	// The status of the send operation.
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FeeLimit) Reset() {
-	*x = FeeLimit{}
-	mi := &file_lightning_proto_msgTypes[15]
+func (x *SendCustomMessageResponse) Reset() {
+	*x = SendCustomMessageResponse{}
+	mi := &file_lightning_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FeeLimit) String() string {
+func (x *SendCustomMessageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeeLimit) ProtoMessage() {}
+func (*SendCustomMessageResponse) ProtoMessage() {}
 
-func (x *FeeLimit) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[15]
+func (x *SendCustomMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2552,135 +2802,1462 @@ func (x *FeeLimit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeeLimit.ProtoReflect.Descriptor instead.
-func (*FeeLimit) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SendCustomMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendCustomMessageResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *FeeLimit) GetLimit() isFeeLimit_Limit {
+func (x *SendCustomMessageResponse) GetStatus() string {
 	if x != nil {
-		return x.Limit
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *FeeLimit) GetFixed() int64 {
-	if x != nil {
-		if x, ok := x.Limit.(*FeeLimit_Fixed); ok {
-			return x.Fixed
-		}
-	}
-	return 0
+type SubscribeOnionMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FeeLimit) GetFixedMsat() int64 {
-	if x != nil {
-		if x, ok := x.Limit.(*FeeLimit_FixedMsat); ok {
-			return x.FixedMsat
-		}
-	}
+func (x *SubscribeOnionMessagesRequest) Reset() {
+	*x = SubscribeOnionMessagesRequest{}
+	mi := &file_lightning_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeOnionMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeOnionMessagesRequest) ProtoMessage() {}
+
+func (x *SubscribeOnionMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeOnionMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeOnionMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{22}
+}
+
+type OnionMessageUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Peer from which this message originates. Represented as a byte-encoded
+	// public key.
+	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// PathKey is used to derive the blinded node id by tweaking the hop's
+	// static public key. The hop uses the corresponding blinded private key
+	// together with the sender's ephemeral key to perform ECDH and obtain the
+	// shared secret for decrypting the onion payload. Separately, for
+	// decrypting `encrypted_recipient_data`, the recipient performs ECDH
+	// between its static node private key and the path_key to derive the
+	// decryption key.
+	PathKey []byte `protobuf:"bytes,2,opt,name=path_key,json=pathKey,proto3" json:"path_key,omitempty"`
+	// Serialized Sphinx onion packet (BOLT 4) containing the layered, per-hop
+	// encrypted payloads and routing instructions used to forward this message
+	// along its designated path.
+	Onion []byte `protobuf:"bytes,3,opt,name=onion,proto3" json:"onion,omitempty"`
+	// reply_path is the blinded path that should be used when replying to a
+	// received message. The introduction_node field is passed through verbatim
+	// from the wire. It may carry either the 33-byte SEC1 compressed pubkey
+	// form or the 9-byte sciddir form. The sciddir form consists of a 1-byte
+	// direction selector (0x00 or 0x01) followed by an 8-byte short channel ID.
+	// Subscribers that intend to reply resolve the sciddir form against their
+	// local channel graph.
+	ReplyPath *BlindedPath `protobuf:"bytes,4,opt,name=reply_path,json=replyPath,proto3" json:"reply_path,omitempty"`
+	// encrypted_recipient_data is the encrypted data that contains the
+	// forwarding information for an onion message. It contains either
+	// next_node_id or short_channel_id for each non-final node. It MAY contain
+	// the path_id for the final node.
+	EncryptedRecipientData []byte `protobuf:"bytes,5,opt,name=encrypted_recipient_data,json=encryptedRecipientData,proto3" json:"encrypted_recipient_data,omitempty"`
+	// Custom onion message tlv records. These are customized fields that are
+	// not defined by LND and cannot be extracted.
+	CustomRecords map[uint64][]byte `protobuf:"bytes,6,rep,name=custom_records,json=customRecords,proto3" json:"custom_records,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnionMessageUpdate) Reset() {
+	*x = OnionMessageUpdate{}
+	mi := &file_lightning_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnionMessageUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnionMessageUpdate) ProtoMessage() {}
+
+func (x *OnionMessageUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnionMessageUpdate.ProtoReflect.Descriptor instead.
+func (*OnionMessageUpdate) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *OnionMessageUpdate) GetPeer() []byte {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+func (x *OnionMessageUpdate) GetPathKey() []byte {
+	if x != nil {
+		return x.PathKey
+	}
+	return nil
+}
+
+func (x *OnionMessageUpdate) GetOnion() []byte {
+	if x != nil {
+		return x.Onion
+	}
+	return nil
+}
+
+func (x *OnionMessageUpdate) GetReplyPath() *BlindedPath {
+	if x != nil {
+		return x.ReplyPath
+	}
+	return nil
+}
+
+func (x *OnionMessageUpdate) GetEncryptedRecipientData() []byte {
+	if x != nil {
+		return x.EncryptedRecipientData
+	}
+	return nil
+}
+
+func (x *OnionMessageUpdate) GetCustomRecords() map[uint64][]byte {
+	if x != nil {
+		return x.CustomRecords
+	}
+	return nil
+}
+
+type SendOnionMessageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Peer to send the message to
+	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// PathKey is used to derive the blinded node id by tweaking the hop's
+	// static public key. The hop uses the corresponding blinded private key
+	// together with the sender's ephemeral key to perform ECDH and obtain the
+	// shared secret for decrypting the onion payload. Separately, for
+	// decrypting `encrypted_recipient_data`, the recipient performs ECDH
+	// between its static node private key and the path_key to derive the
+	// decryption key.
+	PathKey []byte `protobuf:"bytes,2,opt,name=path_key,json=pathKey,proto3" json:"path_key,omitempty"`
+	// Serialized Sphinx onion packet (BOLT 4) containing the layered, per-hop
+	// encrypted payloads and routing instructions used to forward this message
+	// along its designated path.
+	Onion         []byte `protobuf:"bytes,3,opt,name=onion,proto3" json:"onion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendOnionMessageRequest) Reset() {
+	*x = SendOnionMessageRequest{}
+	mi := &file_lightning_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendOnionMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendOnionMessageRequest) ProtoMessage() {}
+
+func (x *SendOnionMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendOnionMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendOnionMessageRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SendOnionMessageRequest) GetPeer() []byte {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+func (x *SendOnionMessageRequest) GetPathKey() []byte {
+	if x != nil {
+		return x.PathKey
+	}
+	return nil
+}
+
+func (x *SendOnionMessageRequest) GetOnion() []byte {
+	if x != nil {
+		return x.Onion
+	}
+	return nil
+}
+
+type SendOnionMessageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The status of the onion message send operation.
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendOnionMessageResponse) Reset() {
+	*x = SendOnionMessageResponse{}
+	mi := &file_lightning_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendOnionMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendOnionMessageResponse) ProtoMessage() {}
+
+func (x *SendOnionMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendOnionMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendOnionMessageResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SendOnionMessageResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type Utxo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The type of address
+	AddressType AddressType `protobuf:"varint,1,opt,name=address_type,json=addressType,proto3,enum=lnrpc.AddressType" json:"address_type,omitempty"`
+	// The address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The value of the unspent coin in satoshis
+	AmountSat int64 `protobuf:"varint,3,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
+	// The pkscript in hex
+	PkScript string `protobuf:"bytes,4,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
+	// The outpoint in format txid:n
+	Outpoint *OutPoint `protobuf:"bytes,5,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// The number of confirmations for the Utxo
+	Confirmations int64 `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Utxo) Reset() {
+	*x = Utxo{}
+	mi := &file_lightning_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Utxo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Utxo) ProtoMessage() {}
+
+func (x *Utxo) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Utxo.ProtoReflect.Descriptor instead.
+func (*Utxo) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Utxo) GetAddressType() AddressType {
+	if x != nil {
+		return x.AddressType
+	}
+	return AddressType_WITNESS_PUBKEY_HASH
+}
+
+func (x *Utxo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Utxo) GetAmountSat() int64 {
+	if x != nil {
+		return x.AmountSat
+	}
+	return 0
+}
+
+func (x *Utxo) GetPkScript() string {
+	if x != nil {
+		return x.PkScript
+	}
+	return ""
+}
+
+func (x *Utxo) GetOutpoint() *OutPoint {
+	if x != nil {
+		return x.Outpoint
+	}
+	return nil
+}
+
+func (x *Utxo) GetConfirmations() int64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+type OutputDetail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The type of the output
+	OutputType OutputScriptType `protobuf:"varint,1,opt,name=output_type,json=outputType,proto3,enum=lnrpc.OutputScriptType" json:"output_type,omitempty"`
+	// The address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The pkscript in hex
+	PkScript string `protobuf:"bytes,3,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
+	// The output index used in the raw transaction
+	OutputIndex int64 `protobuf:"varint,4,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	// The value of the output coin in satoshis
+	Amount int64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Denotes if the output is controlled by the internal wallet
+	IsOurAddress  bool `protobuf:"varint,6,opt,name=is_our_address,json=isOurAddress,proto3" json:"is_our_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutputDetail) Reset() {
+	*x = OutputDetail{}
+	mi := &file_lightning_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutputDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputDetail) ProtoMessage() {}
+
+func (x *OutputDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputDetail.ProtoReflect.Descriptor instead.
+func (*OutputDetail) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OutputDetail) GetOutputType() OutputScriptType {
+	if x != nil {
+		return x.OutputType
+	}
+	return OutputScriptType_SCRIPT_TYPE_PUBKEY_HASH
+}
+
+func (x *OutputDetail) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *OutputDetail) GetPkScript() string {
+	if x != nil {
+		return x.PkScript
+	}
+	return ""
+}
+
+func (x *OutputDetail) GetOutputIndex() int64 {
+	if x != nil {
+		return x.OutputIndex
+	}
+	return 0
+}
+
+func (x *OutputDetail) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *OutputDetail) GetIsOurAddress() bool {
+	if x != nil {
+		return x.IsOurAddress
+	}
+	return false
+}
+
+type Transaction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The transaction hash
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// The transaction amount, denominated in satoshis
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The number of confirmations
+	NumConfirmations int32 `protobuf:"varint,3,opt,name=num_confirmations,json=numConfirmations,proto3" json:"num_confirmations,omitempty"`
+	// The hash of the block this transaction was included in
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// The height of the block this transaction was included in
+	BlockHeight int32 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Timestamp of this transaction
+	TimeStamp int64 `protobuf:"varint,6,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
+	// Fees paid for this transaction
+	TotalFees int64 `protobuf:"varint,7,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
+	// Addresses that received funds for this transaction. Deprecated as it is
+	// now incorporated in the output_details field.
+	//
+	// Deprecated: Marked as deprecated in lightning.proto.
+	DestAddresses []string `protobuf:"bytes,8,rep,name=dest_addresses,json=destAddresses,proto3" json:"dest_addresses,omitempty"`
+	// Outputs that received funds for this transaction
+	OutputDetails []*OutputDetail `protobuf:"bytes,11,rep,name=output_details,json=outputDetails,proto3" json:"output_details,omitempty"`
+	// The raw transaction hex.
+	RawTxHex string `protobuf:"bytes,9,opt,name=raw_tx_hex,json=rawTxHex,proto3" json:"raw_tx_hex,omitempty"`
+	// A label that was optionally set on transaction broadcast.
+	Label string `protobuf:"bytes,10,opt,name=label,proto3" json:"label,omitempty"`
+	// PreviousOutpoints/Inputs of this transaction.
+	PreviousOutpoints []*PreviousOutPoint `protobuf:"bytes,12,rep,name=previous_outpoints,json=previousOutpoints,proto3" json:"previous_outpoints,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_lightning_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Transaction) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *Transaction) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transaction) GetNumConfirmations() int32 {
+	if x != nil {
+		return x.NumConfirmations
+	}
+	return 0
+}
+
+func (x *Transaction) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *Transaction) GetBlockHeight() int32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *Transaction) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+func (x *Transaction) GetTotalFees() int64 {
+	if x != nil {
+		return x.TotalFees
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in lightning.proto.
+func (x *Transaction) GetDestAddresses() []string {
+	if x != nil {
+		return x.DestAddresses
+	}
+	return nil
+}
+
+func (x *Transaction) GetOutputDetails() []*OutputDetail {
+	if x != nil {
+		return x.OutputDetails
+	}
+	return nil
+}
+
+func (x *Transaction) GetRawTxHex() string {
+	if x != nil {
+		return x.RawTxHex
+	}
+	return ""
+}
+
+func (x *Transaction) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Transaction) GetPreviousOutpoints() []*PreviousOutPoint {
+	if x != nil {
+		return x.PreviousOutpoints
+	}
+	return nil
+}
+
+type GetTransactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The height from which to list transactions, inclusive. If this value is
+	// greater than end_height, transactions will be read in reverse.
+	StartHeight int32 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// The height until which to list transactions, inclusive. To include
+	// unconfirmed transactions, this value should be set to -1, which will
+	// return transactions from start_height until the current chain tip and
+	// unconfirmed transactions. If no end_height is provided, the call will
+	// default to this option.
+	EndHeight int32 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// An optional filter to only include transactions relevant to an account.
+	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	// The index of a transaction that will be used in a query to determine which
+	// transaction should be returned in the response.
+	IndexOffset uint32 `protobuf:"varint,4,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// The maximal number of transactions returned in the response to this query.
+	// This value should be set to 0 to return all transactions.
+	MaxTransactions uint32 `protobuf:"varint,5,opt,name=max_transactions,json=maxTransactions,proto3" json:"max_transactions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTransactionsRequest) Reset() {
+	*x = GetTransactionsRequest{}
+	mi := &file_lightning_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsRequest) ProtoMessage() {}
+
+func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetTransactionsRequest) GetStartHeight() int32 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *GetTransactionsRequest) GetEndHeight() int32 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+func (x *GetTransactionsRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *GetTransactionsRequest) GetIndexOffset() uint32 {
+	if x != nil {
+		return x.IndexOffset
+	}
+	return 0
+}
+
+func (x *GetTransactionsRequest) GetMaxTransactions() uint32 {
+	if x != nil {
+		return x.MaxTransactions
+	}
+	return 0
+}
+
+type TransactionDetails struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of transactions relevant to the wallet.
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// The index of the last item in the set of returned transactions. This can be
+	// used to seek further, pagination style.
+	LastIndex uint64 `protobuf:"varint,2,opt,name=last_index,json=lastIndex,proto3" json:"last_index,omitempty"`
+	// The index of the last item in the set of returned transactions. This can be
+	// used to seek backwards, pagination style.
+	FirstIndex    uint64 `protobuf:"varint,3,opt,name=first_index,json=firstIndex,proto3" json:"first_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionDetails) Reset() {
+	*x = TransactionDetails{}
+	mi := &file_lightning_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionDetails) ProtoMessage() {}
+
+func (x *TransactionDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionDetails.ProtoReflect.Descriptor instead.
+func (*TransactionDetails) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TransactionDetails) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *TransactionDetails) GetLastIndex() uint64 {
+	if x != nil {
+		return x.LastIndex
+	}
+	return 0
+}
+
+func (x *TransactionDetails) GetFirstIndex() uint64 {
+	if x != nil {
+		return x.FirstIndex
+	}
+	return 0
+}
+
+type FeeLimit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Limit:
+	//
+	//	*FeeLimit_Fixed
+	//	*FeeLimit_FixedMsat
+	//	*FeeLimit_Percent
+	Limit         isFeeLimit_Limit `protobuf_oneof:"limit"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeeLimit) Reset() {
+	*x = FeeLimit{}
+	mi := &file_lightning_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeLimit) ProtoMessage() {}
+
+func (x *FeeLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeLimit.ProtoReflect.Descriptor instead.
+func (*FeeLimit) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FeeLimit) GetLimit() isFeeLimit_Limit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *FeeLimit) GetFixed() int64 {
+	if x != nil {
+		if x, ok := x.Limit.(*FeeLimit_Fixed); ok {
+			return x.Fixed
+		}
+	}
+	return 0
+}
+
+func (x *FeeLimit) GetFixedMsat() int64 {
+	if x != nil {
+		if x, ok := x.Limit.(*FeeLimit_FixedMsat); ok {
+			return x.FixedMsat
+		}
+	}
+	return 0
+}
+
+func (x *FeeLimit) GetPercent() int64 {
+	if x != nil {
+		if x, ok := x.Limit.(*FeeLimit_Percent); ok {
+			return x.Percent
+		}
+	}
+	return 0
+}
+
+type isFeeLimit_Limit interface {
+	isFeeLimit_Limit()
+}
+
+type FeeLimit_Fixed struct {
+	// The fee limit expressed as a fixed amount of satoshis.
+	//
+	// The fields fixed and fixed_msat are mutually exclusive.
+	Fixed int64 `protobuf:"varint,1,opt,name=fixed,proto3,oneof"`
+}
+
+type FeeLimit_FixedMsat struct {
+	// The fee limit expressed as a fixed amount of millisatoshis.
+	//
+	// The fields fixed and fixed_msat are mutually exclusive.
+	FixedMsat int64 `protobuf:"varint,3,opt,name=fixed_msat,json=fixedMsat,proto3,oneof"`
+}
+
+type FeeLimit_Percent struct {
+	// The fee limit expressed as a percentage of the payment amount.
+	Percent int64 `protobuf:"varint,2,opt,name=percent,proto3,oneof"`
+}
+
+func (*FeeLimit_Fixed) isFeeLimit_Limit() {}
+
+func (*FeeLimit_FixedMsat) isFeeLimit_Limit() {}
+
+func (*FeeLimit_Percent) isFeeLimit_Limit() {}
+
+type ChannelAcceptRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pubkey of the node that wishes to open an inbound channel.
+	NodePubkey []byte `protobuf:"bytes,1,opt,name=node_pubkey,json=nodePubkey,proto3" json:"node_pubkey,omitempty"`
+	// The hash of the genesis block that the proposed channel resides in.
+	ChainHash []byte `protobuf:"bytes,2,opt,name=chain_hash,json=chainHash,proto3" json:"chain_hash,omitempty"`
+	// The pending channel id.
+	PendingChanId []byte `protobuf:"bytes,3,opt,name=pending_chan_id,json=pendingChanId,proto3" json:"pending_chan_id,omitempty"`
+	// The funding amount in satoshis that initiator wishes to use in the
+	// channel.
+	FundingAmt uint64 `protobuf:"varint,4,opt,name=funding_amt,json=fundingAmt,proto3" json:"funding_amt,omitempty"`
+	// The push amount of the proposed channel in millisatoshis.
+	PushAmt uint64 `protobuf:"varint,5,opt,name=push_amt,json=pushAmt,proto3" json:"push_amt,omitempty"`
+	// The dust limit of the initiator's commitment tx.
+	DustLimit uint64 `protobuf:"varint,6,opt,name=dust_limit,json=dustLimit,proto3" json:"dust_limit,omitempty"`
+	// The maximum amount of coins in millisatoshis that can be pending in this
+	// channel.
+	MaxValueInFlight uint64 `protobuf:"varint,7,opt,name=max_value_in_flight,json=maxValueInFlight,proto3" json:"max_value_in_flight,omitempty"`
+	// The minimum amount of satoshis the initiator requires us to have at all
+	// times.
+	ChannelReserve uint64 `protobuf:"varint,8,opt,name=channel_reserve,json=channelReserve,proto3" json:"channel_reserve,omitempty"`
+	// The smallest HTLC in millisatoshis that the initiator will accept.
+	MinHtlc uint64 `protobuf:"varint,9,opt,name=min_htlc,json=minHtlc,proto3" json:"min_htlc,omitempty"`
+	// The initial fee rate that the initiator suggests for both commitment
+	// transactions.
+	FeePerKw uint64 `protobuf:"varint,10,opt,name=fee_per_kw,json=feePerKw,proto3" json:"fee_per_kw,omitempty"`
+	// The number of blocks to use for the relative time lock in the pay-to-self
+	// output of both commitment transactions.
+	CsvDelay uint32 `protobuf:"varint,11,opt,name=csv_delay,json=csvDelay,proto3" json:"csv_delay,omitempty"`
+	// The total number of incoming HTLC's that the initiator will accept.
+	MaxAcceptedHtlcs uint32 `protobuf:"varint,12,opt,name=max_accepted_htlcs,json=maxAcceptedHtlcs,proto3" json:"max_accepted_htlcs,omitempty"`
+	// A bit-field which the initiator uses to specify proposed channel
+	// behavior.
+	ChannelFlags uint32 `protobuf:"varint,13,opt,name=channel_flags,json=channelFlags,proto3" json:"channel_flags,omitempty"`
+	// The commitment type the initiator wishes to use for the proposed channel.
+	CommitmentType CommitmentType `protobuf:"varint,14,opt,name=commitment_type,json=commitmentType,proto3,enum=lnrpc.CommitmentType" json:"commitment_type,omitempty"`
+	// Whether the initiator wants to open a zero-conf channel via the channel
+	// type.
+	WantsZeroConf bool `protobuf:"varint,15,opt,name=wants_zero_conf,json=wantsZeroConf,proto3" json:"wants_zero_conf,omitempty"`
+	// Whether the initiator wants to use the scid-alias channel type. This is
+	// separate from the feature bit.
+	WantsScidAlias bool `protobuf:"varint,16,opt,name=wants_scid_alias,json=wantsScidAlias,proto3" json:"wants_scid_alias,omitempty"`
+	// Whether the channel is dual-funded. If set, the funding_contribution_sat
+	// of the response determines how much we contribute to the channel.
+	DualFunded    bool `protobuf:"varint,17,opt,name=dual_funded,json=dualFunded,proto3" json:"dual_funded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelAcceptRequest) Reset() {
+	*x = ChannelAcceptRequest{}
+	mi := &file_lightning_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelAcceptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelAcceptRequest) ProtoMessage() {}
+
+func (x *ChannelAcceptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelAcceptRequest.ProtoReflect.Descriptor instead.
+func (*ChannelAcceptRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ChannelAcceptRequest) GetNodePubkey() []byte {
+	if x != nil {
+		return x.NodePubkey
+	}
+	return nil
+}
+
+func (x *ChannelAcceptRequest) GetChainHash() []byte {
+	if x != nil {
+		return x.ChainHash
+	}
+	return nil
+}
+
+func (x *ChannelAcceptRequest) GetPendingChanId() []byte {
+	if x != nil {
+		return x.PendingChanId
+	}
+	return nil
+}
+
+func (x *ChannelAcceptRequest) GetFundingAmt() uint64 {
+	if x != nil {
+		return x.FundingAmt
+	}
+	return 0
+}
+
+func (x *ChannelAcceptRequest) GetPushAmt() uint64 {
+	if x != nil {
+		return x.PushAmt
+	}
+	return 0
+}
+
+func (x *ChannelAcceptRequest) GetDustLimit() uint64 {
+	if x != nil {
+		return x.DustLimit
+	}
+	return 0
+}
+
+func (x *ChannelAcceptRequest) GetMaxValueInFlight() uint64 {
+	if x != nil {
+		return x.MaxValueInFlight
+	}
+	return 0
+}
+
+func (x *ChannelAcceptRequest) GetChannelReserve() uint64 {
+	if x != nil {
+		return x.ChannelReserve
+	}
+	return 0
+}
+
+func (x *ChannelAcceptRequest) GetMinHtlc() uint64 {
+	if x != nil {
+		return x.MinHtlc
+	}
+	return 0
+}
+
+func (x *ChannelAcceptRequest) GetFeePerKw() uint64 {
+	if x != nil {
+		return x.FeePerKw
+	}
+	return 0
+}
+
+func (x *ChannelAcceptRequest) GetCsvDelay() uint32 {
+	if x != nil {
+		return x.CsvDelay
+	}
+	return 0
+}
+
+func (x *ChannelAcceptRequest) GetMaxAcceptedHtlcs() uint32 {
+	if x != nil {
+		return x.MaxAcceptedHtlcs
+	}
+	return 0
+}
+
+func (x *ChannelAcceptRequest) GetChannelFlags() uint32 {
+	if x != nil {
+		return x.ChannelFlags
+	}
+	return 0
+}
+
+func (x *ChannelAcceptRequest) GetCommitmentType() CommitmentType {
+	if x != nil {
+		return x.CommitmentType
+	}
+	return CommitmentType_UNKNOWN_COMMITMENT_TYPE
+}
+
+func (x *ChannelAcceptRequest) GetWantsZeroConf() bool {
+	if x != nil {
+		return x.WantsZeroConf
+	}
+	return false
+}
+
+func (x *ChannelAcceptRequest) GetWantsScidAlias() bool {
+	if x != nil {
+		return x.WantsScidAlias
+	}
+	return false
+}
+
+func (x *ChannelAcceptRequest) GetDualFunded() bool {
+	if x != nil {
+		return x.DualFunded
+	}
+	return false
+}
+
+type ChannelAcceptResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether or not the client accepts the channel.
+	Accept bool `protobuf:"varint,1,opt,name=accept,proto3" json:"accept,omitempty"`
+	// The pending channel id to which this response applies.
+	PendingChanId []byte `protobuf:"bytes,2,opt,name=pending_chan_id,json=pendingChanId,proto3" json:"pending_chan_id,omitempty"`
+	// An optional error to send the initiating party to indicate why the channel
+	// was rejected. This field *should not* contain sensitive information, it will
+	// be sent to the initiating party. This field should only be set if accept is
+	// false, the channel will be rejected if an error is set with accept=true
+	// because the meaning of this response is ambiguous. Limited to 500
+	// characters.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// The upfront shutdown address to use if the initiating peer supports option
+	// upfront shutdown script (see ListPeers for the features supported). Note
+	// that the channel open will fail if this value is set for a peer that does
+	// not support this feature bit.
+	UpfrontShutdown string `protobuf:"bytes,4,opt,name=upfront_shutdown,json=upfrontShutdown,proto3" json:"upfront_shutdown,omitempty"`
+	// The csv delay (in blocks) that we require for the remote party.
+	CsvDelay uint32 `protobuf:"varint,5,opt,name=csv_delay,json=csvDelay,proto3" json:"csv_delay,omitempty"`
+	// The reserve amount in satoshis that we require the remote peer to adhere to.
+	// We require that the remote peer always have some reserve amount allocated to
+	// them so that there is always a disincentive to broadcast old state (if they
+	// hold 0 sats on their side of the channel, there is nothing to lose).
+	ReserveSat uint64 `protobuf:"varint,6,opt,name=reserve_sat,json=reserveSat,proto3" json:"reserve_sat,omitempty"`
+	// The maximum amount of funds in millisatoshis that we allow the remote peer
+	// to have in outstanding htlcs.
+	InFlightMaxMsat uint64 `protobuf:"varint,7,opt,name=in_flight_max_msat,json=inFlightMaxMsat,proto3" json:"in_flight_max_msat,omitempty"`
+	// The maximum number of htlcs that the remote peer can offer us.
+	MaxHtlcCount uint32 `protobuf:"varint,8,opt,name=max_htlc_count,json=maxHtlcCount,proto3" json:"max_htlc_count,omitempty"`
+	// The minimum value in millisatoshis for incoming htlcs on the channel.
+	MinHtlcIn uint64 `protobuf:"varint,9,opt,name=min_htlc_in,json=minHtlcIn,proto3" json:"min_htlc_in,omitempty"`
+	// The number of confirmations we require before we consider the channel open.
+	MinAcceptDepth uint32 `protobuf:"varint,10,opt,name=min_accept_depth,json=minAcceptDepth,proto3" json:"min_accept_depth,omitempty"`
+	// Whether the responder wants this to be a zero-conf channel. This will fail
+	// if either side does not have the scid-alias feature bit set. The minimum
+	// depth field must be zero if this is true.
+	ZeroConf bool `protobuf:"varint,11,opt,name=zero_conf,json=zeroConf,proto3" json:"zero_conf,omitempty"`
+	// The amount in satoshis we contribute to a dual-funded channel. It is funded
+	// from the wallet and becomes our initial balance in the channel. This is
+	// ignored for channels that aren't dual-funded.
+	FundingContributionSat uint64 `protobuf:"varint,12,opt,name=funding_contribution_sat,json=fundingContributionSat,proto3" json:"funding_contribution_sat,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ChannelAcceptResponse) Reset() {
+	*x = ChannelAcceptResponse{}
+	mi := &file_lightning_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelAcceptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelAcceptResponse) ProtoMessage() {}
+
+func (x *ChannelAcceptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelAcceptResponse.ProtoReflect.Descriptor instead.
+func (*ChannelAcceptResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ChannelAcceptResponse) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+func (x *ChannelAcceptResponse) GetPendingChanId() []byte {
+	if x != nil {
+		return x.PendingChanId
+	}
+	return nil
+}
+
+func (x *ChannelAcceptResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ChannelAcceptResponse) GetUpfrontShutdown() string {
+	if x != nil {
+		return x.UpfrontShutdown
+	}
+	return ""
+}
+
+func (x *ChannelAcceptResponse) GetCsvDelay() uint32 {
+	if x != nil {
+		return x.CsvDelay
+	}
 	return 0
 }
 
-func (x *FeeLimit) GetPercent() int64 {
+func (x *ChannelAcceptResponse) GetReserveSat() uint64 {
 	if x != nil {
-		if x, ok := x.Limit.(*FeeLimit_Percent); ok {
-			return x.Percent
+		return x.ReserveSat
+	}
+	return 0
+}
+
+func (x *ChannelAcceptResponse) GetInFlightMaxMsat() uint64 {
+	if x != nil {
+		return x.InFlightMaxMsat
+	}
+	return 0
+}
+
+func (x *ChannelAcceptResponse) GetMaxHtlcCount() uint32 {
+	if x != nil {
+		return x.MaxHtlcCount
+	}
+	return 0
+}
+
+func (x *ChannelAcceptResponse) GetMinHtlcIn() uint64 {
+	if x != nil {
+		return x.MinHtlcIn
+	}
+	return 0
+}
+
+func (x *ChannelAcceptResponse) GetMinAcceptDepth() uint32 {
+	if x != nil {
+		return x.MinAcceptDepth
+	}
+	return 0
+}
+
+func (x *ChannelAcceptResponse) GetZeroConf() bool {
+	if x != nil {
+		return x.ZeroConf
+	}
+	return false
+}
+
+func (x *ChannelAcceptResponse) GetFundingContributionSat() uint64 {
+	if x != nil {
+		return x.FundingContributionSat
+	}
+	return 0
+}
+
+type ChannelPoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to FundingTxid:
+	//
+	//	*ChannelPoint_FundingTxidBytes
+	//	*ChannelPoint_FundingTxidStr
+	FundingTxid isChannelPoint_FundingTxid `protobuf_oneof:"funding_txid"`
+	// The index of the output of the funding transaction
+	OutputIndex   uint32 `protobuf:"varint,3,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelPoint) Reset() {
+	*x = ChannelPoint{}
+	mi := &file_lightning_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPoint) ProtoMessage() {}
+
+func (x *ChannelPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPoint.ProtoReflect.Descriptor instead.
+func (*ChannelPoint) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ChannelPoint) GetFundingTxid() isChannelPoint_FundingTxid {
+	if x != nil {
+		return x.FundingTxid
+	}
+	return nil
+}
+
+func (x *ChannelPoint) GetFundingTxidBytes() []byte {
+	if x != nil {
+		if x, ok := x.FundingTxid.(*ChannelPoint_FundingTxidBytes); ok {
+			return x.FundingTxidBytes
+		}
+	}
+	return nil
+}
+
+func (x *ChannelPoint) GetFundingTxidStr() string {
+	if x != nil {
+		if x, ok := x.FundingTxid.(*ChannelPoint_FundingTxidStr); ok {
+			return x.FundingTxidStr
+		}
+	}
+	return ""
+}
+
+func (x *ChannelPoint) GetOutputIndex() uint32 {
+	if x != nil {
+		return x.OutputIndex
+	}
+	return 0
+}
+
+type isChannelPoint_FundingTxid interface {
+	isChannelPoint_FundingTxid()
+}
+
+type ChannelPoint_FundingTxidBytes struct {
+	// Txid of the funding transaction. When using REST, this field must be
+	// encoded as base64.
+	FundingTxidBytes []byte `protobuf:"bytes,1,opt,name=funding_txid_bytes,json=fundingTxidBytes,proto3,oneof"`
+}
+
+type ChannelPoint_FundingTxidStr struct {
+	// Hex-encoded string representing the byte-reversed hash of the funding
+	// transaction.
+	FundingTxidStr string `protobuf:"bytes,2,opt,name=funding_txid_str,json=fundingTxidStr,proto3,oneof"`
+}
+
+func (*ChannelPoint_FundingTxidBytes) isChannelPoint_FundingTxid() {}
+
+func (*ChannelPoint_FundingTxidStr) isChannelPoint_FundingTxid() {}
+
+type OutPoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Raw bytes representing the transaction id.
+	TxidBytes []byte `protobuf:"bytes,1,opt,name=txid_bytes,json=txidBytes,proto3" json:"txid_bytes,omitempty"`
+	// Reversed, hex-encoded string representing the transaction id.
+	TxidStr string `protobuf:"bytes,2,opt,name=txid_str,json=txidStr,proto3" json:"txid_str,omitempty"`
+	// The index of the output on the transaction.
+	OutputIndex   uint32 `protobuf:"varint,3,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutPoint) Reset() {
+	*x = OutPoint{}
+	mi := &file_lightning_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutPoint) ProtoMessage() {}
+
+func (x *OutPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutPoint.ProtoReflect.Descriptor instead.
+func (*OutPoint) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *OutPoint) GetTxidBytes() []byte {
+	if x != nil {
+		return x.TxidBytes
+	}
+	return nil
+}
+
+func (x *OutPoint) GetTxidStr() string {
+	if x != nil {
+		return x.TxidStr
+	}
+	return ""
+}
+
+func (x *OutPoint) GetOutputIndex() uint32 {
+	if x != nil {
+		return x.OutputIndex
+	}
+	return 0
+}
+
+type PreviousOutPoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The outpoint in format txid:n.
+	Outpoint string `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// Denotes if the outpoint is controlled by the internal wallet.
+	// The flag will only detect p2wkh, np2wkh and p2tr inputs as its own.
+	IsOurOutput   bool `protobuf:"varint,2,opt,name=is_our_output,json=isOurOutput,proto3" json:"is_our_output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviousOutPoint) Reset() {
+	*x = PreviousOutPoint{}
+	mi := &file_lightning_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviousOutPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviousOutPoint) ProtoMessage() {}
+
+func (x *PreviousOutPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return 0
-}
-
-type isFeeLimit_Limit interface {
-	isFeeLimit_Limit()
+	return mi.MessageOf(x)
 }
 
-type FeeLimit_Fixed struct {
-	// The fee limit expressed as a fixed amount of satoshis.
-	//
-	// The fields fixed and fixed_msat are mutually exclusive.
-	Fixed int64 `protobuf:"varint,1,opt,name=fixed,proto3,oneof"`
+// Deprecated: Use PreviousOutPoint.ProtoReflect.Descriptor instead.
+func (*PreviousOutPoint) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{36}
 }
 
-type FeeLimit_FixedMsat struct {
-	// The fee limit expressed as a fixed amount of millisatoshis.
-	//
-	// The fields fixed and fixed_msat are mutually exclusive.
-	FixedMsat int64 `protobuf:"varint,3,opt,name=fixed_msat,json=fixedMsat,proto3,oneof"`
+func (x *PreviousOutPoint) GetOutpoint() string {
+	if x != nil {
+		return x.Outpoint
+	}
+	return ""
 }
 
-type FeeLimit_Percent struct {
-	// The fee limit expressed as a percentage of the payment amount.
-	Percent int64 `protobuf:"varint,2,opt,name=percent,proto3,oneof"`
+func (x *PreviousOutPoint) GetIsOurOutput() bool {
+	if x != nil {
+		return x.IsOurOutput
+	}
+	return false
 }
 
-func (*FeeLimit_Fixed) isFeeLimit_Limit() {}
-
-func (*FeeLimit_FixedMsat) isFeeLimit_Limit() {}
-
-func (*FeeLimit_Percent) isFeeLimit_Limit() {}
-
-type ChannelAcceptRequest struct {
+type LightningAddress struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The pubkey of the node that wishes to open an inbound channel.
-	NodePubkey []byte `protobuf:"bytes,1,opt,name=node_pubkey,json=nodePubkey,proto3" json:"node_pubkey,omitempty"`
-	// The hash of the genesis block that the proposed channel resides in.
-	ChainHash []byte `protobuf:"bytes,2,opt,name=chain_hash,json=chainHash,proto3" json:"chain_hash,omitempty"`
-	// The pending channel id.
-	PendingChanId []byte `protobuf:"bytes,3,opt,name=pending_chan_id,json=pendingChanId,proto3" json:"pending_chan_id,omitempty"`
-	// The funding amount in satoshis that initiator wishes to use in the
-	// channel.
-	FundingAmt uint64 `protobuf:"varint,4,opt,name=funding_amt,json=fundingAmt,proto3" json:"funding_amt,omitempty"`
-	// The push amount of the proposed channel in millisatoshis.
-	PushAmt uint64 `protobuf:"varint,5,opt,name=push_amt,json=pushAmt,proto3" json:"push_amt,omitempty"`
-	// The dust limit of the initiator's commitment tx.
-	DustLimit uint64 `protobuf:"varint,6,opt,name=dust_limit,json=dustLimit,proto3" json:"dust_limit,omitempty"`
-	// The maximum amount of coins in millisatoshis that can be pending in this
-	// channel.
-	MaxValueInFlight uint64 `protobuf:"varint,7,opt,name=max_value_in_flight,json=maxValueInFlight,proto3" json:"max_value_in_flight,omitempty"`
-	// The minimum amount of satoshis the initiator requires us to have at all
-	// times.
-	ChannelReserve uint64 `protobuf:"varint,8,opt,name=channel_reserve,json=channelReserve,proto3" json:"channel_reserve,omitempty"`
-	// The smallest HTLC in millisatoshis that the initiator will accept.
-	MinHtlc uint64 `protobuf:"varint,9,opt,name=min_htlc,json=minHtlc,proto3" json:"min_htlc,omitempty"`
-	// The initial fee rate that the initiator suggests for both commitment
-	// transactions.
-	FeePerKw uint64 `protobuf:"varint,10,opt,name=fee_per_kw,json=feePerKw,proto3" json:"fee_per_kw,omitempty"`
-	// The number of blocks to use for the relative time lock in the pay-to-self
-	// output of both commitment transactions.
-	CsvDelay uint32 `protobuf:"varint,11,opt,name=csv_delay,json=csvDelay,proto3" json:"csv_delay,omitempty"`
-	// The total number of incoming HTLC's that the initiator will accept.
-	MaxAcceptedHtlcs uint32 `protobuf:"varint,12,opt,name=max_accepted_htlcs,json=maxAcceptedHtlcs,proto3" json:"max_accepted_htlcs,omitempty"`
-	// A bit-field which the initiator uses to specify proposed channel
-	// behavior.
-	ChannelFlags uint32 `protobuf:"varint,13,opt,name=channel_flags,json=channelFlags,proto3" json:"channel_flags,omitempty"`
-	// The commitment type the initiator wishes to use for the proposed channel.
-	CommitmentType CommitmentType `protobuf:"varint,14,opt,name=commitment_type,json=commitmentType,proto3,enum=lnrpc.CommitmentType" json:"commitment_type,omitempty"`
-	// Whether the initiator wants to open a zero-conf channel via the channel
-	// type.
-	WantsZeroConf bool `protobuf:"varint,15,opt,name=wants_zero_conf,json=wantsZeroConf,proto3" json:"wants_zero_conf,omitempty"`
-	// Whether the initiator wants to use the scid-alias channel type. This is
-	// separate from the feature bit.
-	WantsScidAlias bool `protobuf:"varint,16,opt,name=wants_scid_alias,json=wantsScidAlias,proto3" json:"wants_scid_alias,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// The identity pubkey of the Lightning node.
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// The network location of the lightning node, e.g. `69.69.69.69:1337` or
+	// `localhost:10011`.
+	Host          string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChannelAcceptRequest) Reset() {
-	*x = ChannelAcceptRequest{}
-	mi := &file_lightning_proto_msgTypes[16]
+func (x *LightningAddress) Reset() {
+	*x = LightningAddress{}
+	mi := &file_lightning_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChannelAcceptRequest) String() string {
+func (x *LightningAddress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelAcceptRequest) ProtoMessage() {}
+func (*LightningAddress) ProtoMessage() {}
 
-func (x *ChannelAcceptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[16]
+func (x *LightningAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2691,180 +4268,237 @@ func (x *ChannelAcceptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelAcceptRequest.ProtoReflect.Descriptor instead.
-func (*ChannelAcceptRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use LightningAddress.ProtoReflect.Descriptor instead.
+func (*LightningAddress) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ChannelAcceptRequest) GetNodePubkey() []byte {
+func (x *LightningAddress) GetPubkey() string {
 	if x != nil {
-		return x.NodePubkey
+		return x.Pubkey
 	}
-	return nil
+	return ""
 }
 
-func (x *ChannelAcceptRequest) GetChainHash() []byte {
+func (x *LightningAddress) GetHost() string {
 	if x != nil {
-		return x.ChainHash
+		return x.Host
 	}
-	return nil
+	return ""
 }
 
-func (x *ChannelAcceptRequest) GetPendingChanId() []byte {
-	if x != nil {
-		return x.PendingChanId
-	}
-	return nil
+type EstimateFeeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The map from addresses to amounts for the transaction.
+	AddrToAmount map[string]int64 `protobuf:"bytes,1,rep,name=AddrToAmount,proto3" json:"AddrToAmount,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// The target number of blocks that this transaction should be confirmed
+	// by.
+	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// The minimum number of confirmations each one of your outputs used for
+	// the transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,3,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
+	// Whether unconfirmed outputs should be used as inputs for the transaction.
+	SpendUnconfirmed bool `protobuf:"varint,4,opt,name=spend_unconfirmed,json=spendUnconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	// The strategy to use for selecting coins during fees estimation.
+	CoinSelectionStrategy CoinSelectionStrategy `protobuf:"varint,5,opt,name=coin_selection_strategy,json=coinSelectionStrategy,proto3,enum=lnrpc.CoinSelectionStrategy" json:"coin_selection_strategy,omitempty"`
+	// A list of selected inputs for the transaction.
+	Inputs        []*OutPoint `protobuf:"bytes,6,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChannelAcceptRequest) GetFundingAmt() uint64 {
-	if x != nil {
-		return x.FundingAmt
-	}
-	return 0
+func (x *EstimateFeeRequest) Reset() {
+	*x = EstimateFeeRequest{}
+	mi := &file_lightning_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChannelAcceptRequest) GetPushAmt() uint64 {
+func (x *EstimateFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateFeeRequest) ProtoMessage() {}
+
+func (x *EstimateFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[38]
 	if x != nil {
-		return x.PushAmt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ChannelAcceptRequest) GetDustLimit() uint64 {
+// Deprecated: Use EstimateFeeRequest.ProtoReflect.Descriptor instead.
+func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *EstimateFeeRequest) GetAddrToAmount() map[string]int64 {
 	if x != nil {
-		return x.DustLimit
+		return x.AddrToAmount
 	}
-	return 0
+	return nil
 }
 
-func (x *ChannelAcceptRequest) GetMaxValueInFlight() uint64 {
+func (x *EstimateFeeRequest) GetTargetConf() int32 {
 	if x != nil {
-		return x.MaxValueInFlight
+		return x.TargetConf
 	}
 	return 0
 }
 
-func (x *ChannelAcceptRequest) GetChannelReserve() uint64 {
+func (x *EstimateFeeRequest) GetMinConfs() int32 {
 	if x != nil {
-		return x.ChannelReserve
+		return x.MinConfs
 	}
 	return 0
 }
 
-func (x *ChannelAcceptRequest) GetMinHtlc() uint64 {
+func (x *EstimateFeeRequest) GetSpendUnconfirmed() bool {
 	if x != nil {
-		return x.MinHtlc
+		return x.SpendUnconfirmed
 	}
-	return 0
+	return false
 }
 
-func (x *ChannelAcceptRequest) GetFeePerKw() uint64 {
+func (x *EstimateFeeRequest) GetCoinSelectionStrategy() CoinSelectionStrategy {
 	if x != nil {
-		return x.FeePerKw
+		return x.CoinSelectionStrategy
 	}
-	return 0
+	return CoinSelectionStrategy_STRATEGY_USE_GLOBAL_CONFIG
 }
 
-func (x *ChannelAcceptRequest) GetCsvDelay() uint32 {
+func (x *EstimateFeeRequest) GetInputs() []*OutPoint {
 	if x != nil {
-		return x.CsvDelay
+		return x.Inputs
 	}
-	return 0
+	return nil
 }
 
-func (x *ChannelAcceptRequest) GetMaxAcceptedHtlcs() uint32 {
+type EstimateFeeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The total fee in satoshis.
+	FeeSat int64 `protobuf:"varint,1,opt,name=fee_sat,json=feeSat,proto3" json:"fee_sat,omitempty"`
+	// Deprecated, use sat_per_vbyte.
+	// The fee rate in satoshi/vbyte.
+	//
+	// Deprecated: Marked as deprecated in lightning.proto.
+	FeerateSatPerByte int64 `protobuf:"varint,2,opt,name=feerate_sat_per_byte,json=feerateSatPerByte,proto3" json:"feerate_sat_per_byte,omitempty"`
+	// The fee rate in satoshi/vbyte.
+	SatPerVbyte uint64 `protobuf:"varint,3,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	// A list of selected inputs for the transaction the estimate is for.
+	Inputs        []*OutPoint `protobuf:"bytes,4,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EstimateFeeResponse) Reset() {
+	*x = EstimateFeeResponse{}
+	mi := &file_lightning_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimateFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateFeeResponse) ProtoMessage() {}
+
+func (x *EstimateFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[39]
 	if x != nil {
-		return x.MaxAcceptedHtlcs
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateFeeResponse.ProtoReflect.Descriptor instead.
+func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ChannelAcceptRequest) GetChannelFlags() uint32 {
+func (x *EstimateFeeResponse) GetFeeSat() int64 {
 	if x != nil {
-		return x.ChannelFlags
+		return x.FeeSat
 	}
 	return 0
 }
 
-func (x *ChannelAcceptRequest) GetCommitmentType() CommitmentType {
+// Deprecated: Marked as deprecated in lightning.proto.
+func (x *EstimateFeeResponse) GetFeerateSatPerByte() int64 {
 	if x != nil {
-		return x.CommitmentType
+		return x.FeerateSatPerByte
 	}
-	return CommitmentType_UNKNOWN_COMMITMENT_TYPE
+	return 0
 }
 
-func (x *ChannelAcceptRequest) GetWantsZeroConf() bool {
+func (x *EstimateFeeResponse) GetSatPerVbyte() uint64 {
 	if x != nil {
-		return x.WantsZeroConf
+		return x.SatPerVbyte
 	}
-	return false
+	return 0
 }
 
-func (x *ChannelAcceptRequest) GetWantsScidAlias() bool {
+func (x *EstimateFeeResponse) GetInputs() []*OutPoint {
 	if x != nil {
-		return x.WantsScidAlias
+		return x.Inputs
 	}
-	return false
+	return nil
 }
 
-type ChannelAcceptResponse struct {
+type SendManyRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether or not the client accepts the channel.
-	Accept bool `protobuf:"varint,1,opt,name=accept,proto3" json:"accept,omitempty"`
-	// The pending channel id to which this response applies.
-	PendingChanId []byte `protobuf:"bytes,2,opt,name=pending_chan_id,json=pendingChanId,proto3" json:"pending_chan_id,omitempty"`
-	// An optional error to send the initiating party to indicate why the channel
-	// was rejected. This field *should not* contain sensitive information, it will
-	// be sent to the initiating party. This field should only be set if accept is
-	// false, the channel will be rejected if an error is set with accept=true
-	// because the meaning of this response is ambiguous. Limited to 500
-	// characters.
-	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
-	// The upfront shutdown address to use if the initiating peer supports option
-	// upfront shutdown script (see ListPeers for the features supported). Note
-	// that the channel open will fail if this value is set for a peer that does
-	// not support this feature bit.
-	UpfrontShutdown string `protobuf:"bytes,4,opt,name=upfront_shutdown,json=upfrontShutdown,proto3" json:"upfront_shutdown,omitempty"`
-	// The csv delay (in blocks) that we require for the remote party.
-	CsvDelay uint32 `protobuf:"varint,5,opt,name=csv_delay,json=csvDelay,proto3" json:"csv_delay,omitempty"`
-	// The reserve amount in satoshis that we require the remote peer to adhere to.
-	// We require that the remote peer always have some reserve amount allocated to
-	// them so that there is always a disincentive to broadcast old state (if they
-	// hold 0 sats on their side of the channel, there is nothing to lose).
-	ReserveSat uint64 `protobuf:"varint,6,opt,name=reserve_sat,json=reserveSat,proto3" json:"reserve_sat,omitempty"`
-	// The maximum amount of funds in millisatoshis that we allow the remote peer
-	// to have in outstanding htlcs.
-	InFlightMaxMsat uint64 `protobuf:"varint,7,opt,name=in_flight_max_msat,json=inFlightMaxMsat,proto3" json:"in_flight_max_msat,omitempty"`
-	// The maximum number of htlcs that the remote peer can offer us.
-	MaxHtlcCount uint32 `protobuf:"varint,8,opt,name=max_htlc_count,json=maxHtlcCount,proto3" json:"max_htlc_count,omitempty"`
-	// The minimum value in millisatoshis for incoming htlcs on the channel.
-	MinHtlcIn uint64 `protobuf:"varint,9,opt,name=min_htlc_in,json=minHtlcIn,proto3" json:"min_htlc_in,omitempty"`
-	// The number of confirmations we require before we consider the channel open.
-	MinAcceptDepth uint32 `protobuf:"varint,10,opt,name=min_accept_depth,json=minAcceptDepth,proto3" json:"min_accept_depth,omitempty"`
-	// Whether the responder wants this to be a zero-conf channel. This will fail
-	// if either side does not have the scid-alias feature bit set. The minimum
-	// depth field must be zero if this is true.
-	ZeroConf      bool `protobuf:"varint,11,opt,name=zero_conf,json=zeroConf,proto3" json:"zero_conf,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The map from addresses to amounts
+	AddrToAmount map[string]int64 `protobuf:"bytes,1,rep,name=AddrToAmount,proto3" json:"AddrToAmount,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// The target number of blocks that this transaction should be confirmed
+	// by.
+	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// A manual fee rate set in sat/vbyte that should be used when crafting the
+	// transaction.
+	SatPerVbyte uint64 `protobuf:"varint,4,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	// Deprecated, use sat_per_vbyte.
+	// A manual fee rate set in sat/vbyte that should be used when crafting the
+	// transaction.
+	//
+	// Deprecated: Marked as deprecated in lightning.proto.
+	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	// An optional label for the transaction, limited to 500 characters.
+	Label string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	// The minimum number of confirmations each one of your outputs used for
+	// the transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,7,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
+	// Whether unconfirmed outputs should be used as inputs for the transaction.
+	SpendUnconfirmed bool `protobuf:"varint,8,opt,name=spend_unconfirmed,json=spendUnconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	// The strategy to use for selecting coins during sending many requests.
+	CoinSelectionStrategy CoinSelectionStrategy `protobuf:"varint,9,opt,name=coin_selection_strategy,json=coinSelectionStrategy,proto3,enum=lnrpc.CoinSelectionStrategy" json:"coin_selection_strategy,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *ChannelAcceptResponse) Reset() {
-	*x = ChannelAcceptResponse{}
-	mi := &file_lightning_proto_msgTypes[17]
+func (x *SendManyRequest) Reset() {
+	*x = SendManyRequest{}
+	mi := &file_lightning_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChannelAcceptResponse) String() string {
+func (x *SendManyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelAcceptResponse) ProtoMessage() {}
+func (*SendManyRequest) ProtoMessage() {}
 
-func (x *ChannelAcceptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[17]
+func (x *SendManyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2875,116 +4509,91 @@ func (x *ChannelAcceptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelAcceptResponse.ProtoReflect.Descriptor instead.
-func (*ChannelAcceptResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *ChannelAcceptResponse) GetAccept() bool {
-	if x != nil {
-		return x.Accept
-	}
-	return false
+// Deprecated: Use SendManyRequest.ProtoReflect.Descriptor instead.
+func (*SendManyRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ChannelAcceptResponse) GetPendingChanId() []byte {
+func (x *SendManyRequest) GetAddrToAmount() map[string]int64 {
 	if x != nil {
-		return x.PendingChanId
+		return x.AddrToAmount
 	}
 	return nil
 }
 
-func (x *ChannelAcceptResponse) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
-func (x *ChannelAcceptResponse) GetUpfrontShutdown() string {
-	if x != nil {
-		return x.UpfrontShutdown
-	}
-	return ""
-}
-
-func (x *ChannelAcceptResponse) GetCsvDelay() uint32 {
+func (x *SendManyRequest) GetTargetConf() int32 {
 	if x != nil {
-		return x.CsvDelay
+		return x.TargetConf
 	}
 	return 0
 }
 
-func (x *ChannelAcceptResponse) GetReserveSat() uint64 {
+func (x *SendManyRequest) GetSatPerVbyte() uint64 {
 	if x != nil {
-		return x.ReserveSat
+		return x.SatPerVbyte
 	}
 	return 0
 }
 
-func (x *ChannelAcceptResponse) GetInFlightMaxMsat() uint64 {
+// Deprecated: Marked as deprecated in lightning.proto.
+func (x *SendManyRequest) GetSatPerByte() int64 {
 	if x != nil {
-		return x.InFlightMaxMsat
+		return x.SatPerByte
 	}
 	return 0
 }
 
-func (x *ChannelAcceptResponse) GetMaxHtlcCount() uint32 {
+func (x *SendManyRequest) GetLabel() string {
 	if x != nil {
-		return x.MaxHtlcCount
+		return x.Label
 	}
-	return 0
+	return ""
 }
 
-func (x *ChannelAcceptResponse) GetMinHtlcIn() uint64 {
+func (x *SendManyRequest) GetMinConfs() int32 {
 	if x != nil {
-		return x.MinHtlcIn
+		return x.MinConfs
 	}
 	return 0
 }
 
-func (x *ChannelAcceptResponse) GetMinAcceptDepth() uint32 {
+func (x *SendManyRequest) GetSpendUnconfirmed() bool {
 	if x != nil {
-		return x.MinAcceptDepth
+		return x.SpendUnconfirmed
 	}
-	return 0
+	return false
 }
 
-func (x *ChannelAcceptResponse) GetZeroConf() bool {
+func (x *SendManyRequest) GetCoinSelectionStrategy() CoinSelectionStrategy {
 	if x != nil {
-		return x.ZeroConf
+		return x.CoinSelectionStrategy
 	}
-	return false
+	return CoinSelectionStrategy_STRATEGY_USE_GLOBAL_CONFIG
 }
 
-type ChannelPoint struct {
+type SendManyResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to FundingTxid:
-	//
-	//	*ChannelPoint_FundingTxidBytes
-	//	*ChannelPoint_FundingTxidStr
-	FundingTxid isChannelPoint_FundingTxid `protobuf_oneof:"funding_txid"`
-	// The index of the output of the funding transaction
-	OutputIndex   uint32 `protobuf:"varint,3,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	// The id of the transaction
+	Txid          string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChannelPoint) Reset() {
-	*x = ChannelPoint{}
-	mi := &file_lightning_proto_msgTypes[18]
+func (x *SendManyResponse) Reset() {
+	*x = SendManyResponse{}
+	mi := &file_lightning_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChannelPoint) String() string {
+func (x *SendManyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelPoint) ProtoMessage() {}
+func (*SendManyResponse) ProtoMessage() {}
 
-func (x *ChannelPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[18]
+func (x *SendManyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2995,90 +4604,69 @@ func (x *ChannelPoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelPoint.ProtoReflect.Descriptor instead.
-func (*ChannelPoint) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *ChannelPoint) GetFundingTxid() isChannelPoint_FundingTxid {
-	if x != nil {
-		return x.FundingTxid
-	}
-	return nil
-}
-
-func (x *ChannelPoint) GetFundingTxidBytes() []byte {
-	if x != nil {
-		if x, ok := x.FundingTxid.(*ChannelPoint_FundingTxidBytes); ok {
-			return x.FundingTxidBytes
-		}
-	}
-	return nil
+// Deprecated: Use SendManyResponse.ProtoReflect.Descriptor instead.
+func (*SendManyResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ChannelPoint) GetFundingTxidStr() string {
+func (x *SendManyResponse) GetTxid() string {
 	if x != nil {
-		if x, ok := x.FundingTxid.(*ChannelPoint_FundingTxidStr); ok {
-			return x.FundingTxidStr
-		}
+		return x.Txid
 	}
 	return ""
 }
 
-func (x *ChannelPoint) GetOutputIndex() uint32 {
-	if x != nil {
-		return x.OutputIndex
-	}
-	return 0
-}
-
-type isChannelPoint_FundingTxid interface {
-	isChannelPoint_FundingTxid()
-}
-
-type ChannelPoint_FundingTxidBytes struct {
-	// Txid of the funding transaction. When using REST, this field must be
-	// encoded as base64.
-	FundingTxidBytes []byte `protobuf:"bytes,1,opt,name=funding_txid_bytes,json=fundingTxidBytes,proto3,oneof"`
-}
-
-type ChannelPoint_FundingTxidStr struct {
-	// Hex-encoded string representing the byte-reversed hash of the funding
-	// transaction.
-	FundingTxidStr string `protobuf:"bytes,2,opt,name=funding_txid_str,json=fundingTxidStr,proto3,oneof"`
-}
-
-func (*ChannelPoint_FundingTxidBytes) isChannelPoint_FundingTxid() {}
-
-func (*ChannelPoint_FundingTxidStr) isChannelPoint_FundingTxid() {}
-
-type OutPoint struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Raw bytes representing the transaction id.
-	TxidBytes []byte `protobuf:"bytes,1,opt,name=txid_bytes,json=txidBytes,proto3" json:"txid_bytes,omitempty"`
-	// Reversed, hex-encoded string representing the transaction id.
-	TxidStr string `protobuf:"bytes,2,opt,name=txid_str,json=txidStr,proto3" json:"txid_str,omitempty"`
-	// The index of the output on the transaction.
-	OutputIndex   uint32 `protobuf:"varint,3,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+type SendCoinsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The address to send coins to
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// The amount in satoshis to send
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The target number of blocks that this transaction should be confirmed
+	// by.
+	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// A manual fee rate set in sat/vbyte that should be used when crafting the
+	// transaction.
+	SatPerVbyte uint64 `protobuf:"varint,4,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	// Deprecated, use sat_per_vbyte.
+	// A manual fee rate set in sat/vbyte that should be used when crafting the
+	// transaction.
+	//
+	// Deprecated: Marked as deprecated in lightning.proto.
+	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	// If set, the amount field should be unset. It indicates lnd will send all
+	// wallet coins or all selected coins to the specified address.
+	SendAll bool `protobuf:"varint,6,opt,name=send_all,json=sendAll,proto3" json:"send_all,omitempty"`
+	// An optional label for the transaction, limited to 500 characters.
+	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	// The minimum number of confirmations each one of your outputs used for
+	// the transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,8,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
+	// Whether unconfirmed outputs should be used as inputs for the transaction.
+	SpendUnconfirmed bool `protobuf:"varint,9,opt,name=spend_unconfirmed,json=spendUnconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	// The strategy to use for selecting coins.
+	CoinSelectionStrategy CoinSelectionStrategy `protobuf:"varint,10,opt,name=coin_selection_strategy,json=coinSelectionStrategy,proto3,enum=lnrpc.CoinSelectionStrategy" json:"coin_selection_strategy,omitempty"`
+	// A list of selected outpoints as inputs for the transaction.
+	Outpoints     []*OutPoint `protobuf:"bytes,11,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OutPoint) Reset() {
-	*x = OutPoint{}
-	mi := &file_lightning_proto_msgTypes[19]
+func (x *SendCoinsRequest) Reset() {
+	*x = SendCoinsRequest{}
+	mi := &file_lightning_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OutPoint) String() string {
+func (x *SendCoinsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OutPoint) ProtoMessage() {}
+func (*SendCoinsRequest) ProtoMessage() {}
 
-func (x *OutPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[19]
+func (x *SendCoinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3089,113 +4677,112 @@ func (x *OutPoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OutPoint.ProtoReflect.Descriptor instead.
-func (*OutPoint) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SendCoinsRequest.ProtoReflect.Descriptor instead.
+func (*SendCoinsRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *OutPoint) GetTxidBytes() []byte {
+func (x *SendCoinsRequest) GetAddr() string {
 	if x != nil {
-		return x.TxidBytes
+		return x.Addr
 	}
-	return nil
+	return ""
 }
 
-func (x *OutPoint) GetTxidStr() string {
+func (x *SendCoinsRequest) GetAmount() int64 {
 	if x != nil {
-		return x.TxidStr
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *OutPoint) GetOutputIndex() uint32 {
+func (x *SendCoinsRequest) GetTargetConf() int32 {
 	if x != nil {
-		return x.OutputIndex
+		return x.TargetConf
 	}
 	return 0
 }
 
-type PreviousOutPoint struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The outpoint in format txid:n.
-	Outpoint string `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	// Denotes if the outpoint is controlled by the internal wallet.
-	// The flag will only detect p2wkh, np2wkh and p2tr inputs as its own.
-	IsOurOutput   bool `protobuf:"varint,2,opt,name=is_our_output,json=isOurOutput,proto3" json:"is_our_output,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SendCoinsRequest) GetSatPerVbyte() uint64 {
+	if x != nil {
+		return x.SatPerVbyte
+	}
+	return 0
 }
 
-func (x *PreviousOutPoint) Reset() {
-	*x = PreviousOutPoint{}
-	mi := &file_lightning_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+// Deprecated: Marked as deprecated in lightning.proto.
+func (x *SendCoinsRequest) GetSatPerByte() int64 {
+	if x != nil {
+		return x.SatPerByte
+	}
+	return 0
 }
 
-func (x *PreviousOutPoint) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SendCoinsRequest) GetSendAll() bool {
+	if x != nil {
+		return x.SendAll
+	}
+	return false
 }
 
-func (*PreviousOutPoint) ProtoMessage() {}
+func (x *SendCoinsRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
 
-func (x *PreviousOutPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[20]
+func (x *SendCoinsRequest) GetMinConfs() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MinConfs
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use PreviousOutPoint.ProtoReflect.Descriptor instead.
-func (*PreviousOutPoint) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{20}
+func (x *SendCoinsRequest) GetSpendUnconfirmed() bool {
+	if x != nil {
+		return x.SpendUnconfirmed
+	}
+	return false
 }
 
-func (x *PreviousOutPoint) GetOutpoint() string {
+func (x *SendCoinsRequest) GetCoinSelectionStrategy() CoinSelectionStrategy {
 	if x != nil {
-		return x.Outpoint
+		return x.CoinSelectionStrategy
 	}
-	return ""
+	return CoinSelectionStrategy_STRATEGY_USE_GLOBAL_CONFIG
 }
 
-func (x *PreviousOutPoint) GetIsOurOutput() bool {
+func (x *SendCoinsRequest) GetOutpoints() []*OutPoint {
 	if x != nil {
-		return x.IsOurOutput
+		return x.Outpoints
 	}
-	return false
+	return nil
 }
 
-type LightningAddress struct {
+type SendCoinsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The identity pubkey of the Lightning node.
-	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	// The network location of the lightning node, e.g. `69.69.69.69:1337` or
-	// `localhost:10011`.
-	Host          string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	// The transaction ID of the transaction
+	Txid          string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LightningAddress) Reset() {
-	*x = LightningAddress{}
-	mi := &file_lightning_proto_msgTypes[21]
+func (x *SendCoinsResponse) Reset() {
+	*x = SendCoinsResponse{}
+	mi := &file_lightning_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LightningAddress) String() string {
+func (x *SendCoinsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LightningAddress) ProtoMessage() {}
+func (*SendCoinsResponse) ProtoMessage() {}
 
-func (x *LightningAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[21]
+func (x *SendCoinsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3206,60 +4793,45 @@ func (x *LightningAddress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LightningAddress.ProtoReflect.Descriptor instead.
-func (*LightningAddress) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *LightningAddress) GetPubkey() string {
-	if x != nil {
-		return x.Pubkey
-	}
-	return ""
+// Deprecated: Use SendCoinsResponse.ProtoReflect.Descriptor instead.
+func (*SendCoinsResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *LightningAddress) GetHost() string {
+func (x *SendCoinsResponse) GetTxid() string {
 	if x != nil {
-		return x.Host
+		return x.Txid
 	}
 	return ""
 }
 
-type EstimateFeeRequest struct {
+type ListUnspentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The map from addresses to amounts for the transaction.
-	AddrToAmount map[string]int64 `protobuf:"bytes,1,rep,name=AddrToAmount,proto3" json:"AddrToAmount,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	// The target number of blocks that this transaction should be confirmed
-	// by.
-	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
-	// The minimum number of confirmations each one of your outputs used for
-	// the transaction must satisfy.
-	MinConfs int32 `protobuf:"varint,3,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
-	// Whether unconfirmed outputs should be used as inputs for the transaction.
-	SpendUnconfirmed bool `protobuf:"varint,4,opt,name=spend_unconfirmed,json=spendUnconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
-	// The strategy to use for selecting coins during fees estimation.
-	CoinSelectionStrategy CoinSelectionStrategy `protobuf:"varint,5,opt,name=coin_selection_strategy,json=coinSelectionStrategy,proto3,enum=lnrpc.CoinSelectionStrategy" json:"coin_selection_strategy,omitempty"`
-	// A list of selected inputs for the transaction.
-	Inputs        []*OutPoint `protobuf:"bytes,6,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// The minimum number of confirmations to be included.
+	MinConfs int32 `protobuf:"varint,1,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
+	// The maximum number of confirmations to be included.
+	MaxConfs int32 `protobuf:"varint,2,opt,name=max_confs,json=maxConfs,proto3" json:"max_confs,omitempty"`
+	// An optional filter to only include outputs belonging to an account.
+	Account       string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EstimateFeeRequest) Reset() {
-	*x = EstimateFeeRequest{}
-	mi := &file_lightning_proto_msgTypes[22]
+func (x *ListUnspentRequest) Reset() {
+	*x = ListUnspentRequest{}
+	mi := &file_lightning_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EstimateFeeRequest) String() string {
+func (x *ListUnspentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstimateFeeRequest) ProtoMessage() {}
+func (*ListUnspentRequest) ProtoMessage() {}
 
-func (x *EstimateFeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[22]
+func (x *ListUnspentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3270,85 +4842,55 @@ func (x *EstimateFeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstimateFeeRequest.ProtoReflect.Descriptor instead.
-func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *EstimateFeeRequest) GetAddrToAmount() map[string]int64 {
-	if x != nil {
-		return x.AddrToAmount
-	}
-	return nil
-}
-
-func (x *EstimateFeeRequest) GetTargetConf() int32 {
-	if x != nil {
-		return x.TargetConf
-	}
-	return 0
+// Deprecated: Use ListUnspentRequest.ProtoReflect.Descriptor instead.
+func (*ListUnspentRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *EstimateFeeRequest) GetMinConfs() int32 {
+func (x *ListUnspentRequest) GetMinConfs() int32 {
 	if x != nil {
 		return x.MinConfs
 	}
 	return 0
 }
 
-func (x *EstimateFeeRequest) GetSpendUnconfirmed() bool {
-	if x != nil {
-		return x.SpendUnconfirmed
-	}
-	return false
-}
-
-func (x *EstimateFeeRequest) GetCoinSelectionStrategy() CoinSelectionStrategy {
+func (x *ListUnspentRequest) GetMaxConfs() int32 {
 	if x != nil {
-		return x.CoinSelectionStrategy
+		return x.MaxConfs
 	}
-	return CoinSelectionStrategy_STRATEGY_USE_GLOBAL_CONFIG
+	return 0
 }
 
-func (x *EstimateFeeRequest) GetInputs() []*OutPoint {
+func (x *ListUnspentRequest) GetAccount() string {
 	if x != nil {
-		return x.Inputs
+		return x.Account
 	}
-	return nil
+	return ""
 }
 
-type EstimateFeeResponse struct {
+type ListUnspentResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The total fee in satoshis.
-	FeeSat int64 `protobuf:"varint,1,opt,name=fee_sat,json=feeSat,proto3" json:"fee_sat,omitempty"`
-	// Deprecated, use sat_per_vbyte.
-	// The fee rate in satoshi/vbyte.
-	//
-	// Deprecated: Marked as deprecated in lightning.proto.
-	FeerateSatPerByte int64 `protobuf:"varint,2,opt,name=feerate_sat_per_byte,json=feerateSatPerByte,proto3" json:"feerate_sat_per_byte,omitempty"`
-	// The fee rate in satoshi/vbyte.
-	SatPerVbyte uint64 `protobuf:"varint,3,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
-	// A list of selected inputs for the transaction the estimate is for.
-	Inputs        []*OutPoint `protobuf:"bytes,4,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// A list of utxos
+	Utxos         []*Utxo `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EstimateFeeResponse) Reset() {
-	*x = EstimateFeeResponse{}
-	mi := &file_lightning_proto_msgTypes[23]
+func (x *ListUnspentResponse) Reset() {
+	*x = ListUnspentResponse{}
+	mi := &file_lightning_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EstimateFeeResponse) String() string {
+func (x *ListUnspentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstimateFeeResponse) ProtoMessage() {}
+func (*ListUnspentResponse) ProtoMessage() {}
 
-func (x *EstimateFeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[23]
+func (x *ListUnspentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3359,84 +4901,44 @@ func (x *EstimateFeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstimateFeeResponse.ProtoReflect.Descriptor instead.
-func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *EstimateFeeResponse) GetFeeSat() int64 {
-	if x != nil {
-		return x.FeeSat
-	}
-	return 0
-}
-
-// Deprecated: Marked as deprecated in lightning.proto.
-func (x *EstimateFeeResponse) GetFeerateSatPerByte() int64 {
-	if x != nil {
-		return x.FeerateSatPerByte
-	}
-	return 0
-}
-
-func (x *EstimateFeeResponse) GetSatPerVbyte() uint64 {
-	if x != nil {
-		return x.SatPerVbyte
-	}
-	return 0
+// Deprecated: Use ListUnspentResponse.ProtoReflect.Descriptor instead.
+func (*ListUnspentResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *EstimateFeeResponse) GetInputs() []*OutPoint {
+func (x *ListUnspentResponse) GetUtxos() []*Utxo {
 	if x != nil {
-		return x.Inputs
+		return x.Utxos
 	}
 	return nil
 }
 
-type SendManyRequest struct {
+type NewAddressRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The map from addresses to amounts
-	AddrToAmount map[string]int64 `protobuf:"bytes,1,rep,name=AddrToAmount,proto3" json:"AddrToAmount,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	// The target number of blocks that this transaction should be confirmed
-	// by.
-	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
-	// A manual fee rate set in sat/vbyte that should be used when crafting the
-	// transaction.
-	SatPerVbyte uint64 `protobuf:"varint,4,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
-	// Deprecated, use sat_per_vbyte.
-	// A manual fee rate set in sat/vbyte that should be used when crafting the
-	// transaction.
-	//
-	// Deprecated: Marked as deprecated in lightning.proto.
-	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
-	// An optional label for the transaction, limited to 500 characters.
-	Label string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
-	// The minimum number of confirmations each one of your outputs used for
-	// the transaction must satisfy.
-	MinConfs int32 `protobuf:"varint,7,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
-	// Whether unconfirmed outputs should be used as inputs for the transaction.
-	SpendUnconfirmed bool `protobuf:"varint,8,opt,name=spend_unconfirmed,json=spendUnconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
-	// The strategy to use for selecting coins during sending many requests.
-	CoinSelectionStrategy CoinSelectionStrategy `protobuf:"varint,9,opt,name=coin_selection_strategy,json=coinSelectionStrategy,proto3,enum=lnrpc.CoinSelectionStrategy" json:"coin_selection_strategy,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// The type of address to generate.
+	Type AddressType `protobuf:"varint,1,opt,name=type,proto3,enum=lnrpc.AddressType" json:"type,omitempty"`
+	// The name of the account to generate a new address for. If empty, the
+	// default wallet account is used.
+	Account       string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendManyRequest) Reset() {
-	*x = SendManyRequest{}
-	mi := &file_lightning_proto_msgTypes[24]
+func (x *NewAddressRequest) Reset() {
+	*x = NewAddressRequest{}
+	mi := &file_lightning_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendManyRequest) String() string {
+func (x *NewAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendManyRequest) ProtoMessage() {}
+func (*NewAddressRequest) ProtoMessage() {}
 
-func (x *SendManyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[24]
+func (x *NewAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3447,91 +4949,97 @@ func (x *SendManyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendManyRequest.ProtoReflect.Descriptor instead.
-func (*SendManyRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use NewAddressRequest.ProtoReflect.Descriptor instead.
+func (*NewAddressRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *SendManyRequest) GetAddrToAmount() map[string]int64 {
+func (x *NewAddressRequest) GetType() AddressType {
 	if x != nil {
-		return x.AddrToAmount
+		return x.Type
 	}
-	return nil
+	return AddressType_WITNESS_PUBKEY_HASH
 }
 
-func (x *SendManyRequest) GetTargetConf() int32 {
+func (x *NewAddressRequest) GetAccount() string {
 	if x != nil {
-		return x.TargetConf
+		return x.Account
 	}
-	return 0
+	return ""
 }
 
-func (x *SendManyRequest) GetSatPerVbyte() uint64 {
-	if x != nil {
-		return x.SatPerVbyte
-	}
-	return 0
+type NewAddressResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The newly generated wallet address
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-// Deprecated: Marked as deprecated in lightning.proto.
-func (x *SendManyRequest) GetSatPerByte() int64 {
-	if x != nil {
-		return x.SatPerByte
-	}
-	return 0
+func (x *NewAddressResponse) Reset() {
+	*x = NewAddressResponse{}
+	mi := &file_lightning_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendManyRequest) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
+func (x *NewAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SendManyRequest) GetMinConfs() int32 {
+func (*NewAddressResponse) ProtoMessage() {}
+
+func (x *NewAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[47]
 	if x != nil {
-		return x.MinConfs
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SendManyRequest) GetSpendUnconfirmed() bool {
-	if x != nil {
-		return x.SpendUnconfirmed
-	}
-	return false
+// Deprecated: Use NewAddressResponse.ProtoReflect.Descriptor instead.
+func (*NewAddressResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *SendManyRequest) GetCoinSelectionStrategy() CoinSelectionStrategy {
+func (x *NewAddressResponse) GetAddress() string {
 	if x != nil {
-		return x.CoinSelectionStrategy
+		return x.Address
 	}
-	return CoinSelectionStrategy_STRATEGY_USE_GLOBAL_CONFIG
+	return ""
 }
 
-type SendManyResponse struct {
+type SignMessageRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The id of the transaction
-	Txid          string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// The message to be signed. When using REST, this field must be encoded as
+	// base64.
+	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Instead of the default double-SHA256 hashing of the message before signing,
+	// only use one round of hashing instead.
+	SingleHash    bool `protobuf:"varint,2,opt,name=single_hash,json=singleHash,proto3" json:"single_hash,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendManyResponse) Reset() {
-	*x = SendManyResponse{}
-	mi := &file_lightning_proto_msgTypes[25]
+func (x *SignMessageRequest) Reset() {
+	*x = SignMessageRequest{}
+	mi := &file_lightning_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendManyResponse) String() string {
+func (x *SignMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendManyResponse) ProtoMessage() {}
+func (*SignMessageRequest) ProtoMessage() {}
 
-func (x *SendManyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[25]
+func (x *SignMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3542,69 +5050,48 @@ func (x *SendManyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendManyResponse.ProtoReflect.Descriptor instead.
-func (*SendManyResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use SignMessageRequest.ProtoReflect.Descriptor instead.
+func (*SignMessageRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *SendManyResponse) GetTxid() string {
+func (x *SignMessageRequest) GetMsg() []byte {
 	if x != nil {
-		return x.Txid
+		return x.Msg
 	}
-	return ""
+	return nil
 }
 
-type SendCoinsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The address to send coins to
-	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	// The amount in satoshis to send
-	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	// The target number of blocks that this transaction should be confirmed
-	// by.
-	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
-	// A manual fee rate set in sat/vbyte that should be used when crafting the
-	// transaction.
-	SatPerVbyte uint64 `protobuf:"varint,4,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
-	// Deprecated, use sat_per_vbyte.
-	// A manual fee rate set in sat/vbyte that should be used when crafting the
-	// transaction.
-	//
-	// Deprecated: Marked as deprecated in lightning.proto.
-	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
-	// If set, the amount field should be unset. It indicates lnd will send all
-	// wallet coins or all selected coins to the specified address.
-	SendAll bool `protobuf:"varint,6,opt,name=send_all,json=sendAll,proto3" json:"send_all,omitempty"`
-	// An optional label for the transaction, limited to 500 characters.
-	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
-	// The minimum number of confirmations each one of your outputs used for
-	// the transaction must satisfy.
-	MinConfs int32 `protobuf:"varint,8,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
-	// Whether unconfirmed outputs should be used as inputs for the transaction.
-	SpendUnconfirmed bool `protobuf:"varint,9,opt,name=spend_unconfirmed,json=spendUnconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
-	// The strategy to use for selecting coins.
-	CoinSelectionStrategy CoinSelectionStrategy `protobuf:"varint,10,opt,name=coin_selection_strategy,json=coinSelectionStrategy,proto3,enum=lnrpc.CoinSelectionStrategy" json:"coin_selection_strategy,omitempty"`
-	// A list of selected outpoints as inputs for the transaction.
-	Outpoints     []*OutPoint `protobuf:"bytes,11,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+func (x *SignMessageRequest) GetSingleHash() bool {
+	if x != nil {
+		return x.SingleHash
+	}
+	return false
+}
+
+type SignMessageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The signature for the given message
+	Signature     string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendCoinsRequest) Reset() {
-	*x = SendCoinsRequest{}
-	mi := &file_lightning_proto_msgTypes[26]
+func (x *SignMessageResponse) Reset() {
+	*x = SignMessageResponse{}
+	mi := &file_lightning_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendCoinsRequest) String() string {
+func (x *SignMessageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendCoinsRequest) ProtoMessage() {}
+func (*SignMessageResponse) ProtoMessage() {}
 
-func (x *SendCoinsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[26]
+func (x *SignMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3615,112 +5102,156 @@ func (x *SendCoinsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendCoinsRequest.ProtoReflect.Descriptor instead.
-func (*SendCoinsRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use SignMessageResponse.ProtoReflect.Descriptor instead.
+func (*SignMessageResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *SendCoinsRequest) GetAddr() string {
+func (x *SignMessageResponse) GetSignature() string {
 	if x != nil {
-		return x.Addr
+		return x.Signature
 	}
 	return ""
 }
 
-func (x *SendCoinsRequest) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+type VerifyMessageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The message over which the signature is to be verified. When using REST,
+	// this field must be encoded as base64.
+	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// The signature to be verified over the given message
+	Signature     string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendCoinsRequest) GetTargetConf() int32 {
-	if x != nil {
-		return x.TargetConf
-	}
-	return 0
+func (x *VerifyMessageRequest) Reset() {
+	*x = VerifyMessageRequest{}
+	mi := &file_lightning_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendCoinsRequest) GetSatPerVbyte() uint64 {
-	if x != nil {
-		return x.SatPerVbyte
-	}
-	return 0
+func (x *VerifyMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Deprecated: Marked as deprecated in lightning.proto.
-func (x *SendCoinsRequest) GetSatPerByte() int64 {
+func (*VerifyMessageRequest) ProtoMessage() {}
+
+func (x *VerifyMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[50]
 	if x != nil {
-		return x.SatPerByte
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SendCoinsRequest) GetSendAll() bool {
+// Deprecated: Use VerifyMessageRequest.ProtoReflect.Descriptor instead.
+func (*VerifyMessageRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *VerifyMessageRequest) GetMsg() []byte {
 	if x != nil {
-		return x.SendAll
+		return x.Msg
 	}
-	return false
+	return nil
 }
 
-func (x *SendCoinsRequest) GetLabel() string {
+func (x *VerifyMessageRequest) GetSignature() string {
 	if x != nil {
-		return x.Label
+		return x.Signature
 	}
 	return ""
 }
 
-func (x *SendCoinsRequest) GetMinConfs() int32 {
-	if x != nil {
-		return x.MinConfs
-	}
-	return 0
+type VerifyMessageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the signature was valid over the given message
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// The pubkey recovered from the signature
+	Pubkey        string `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendCoinsRequest) GetSpendUnconfirmed() bool {
+func (x *VerifyMessageResponse) Reset() {
+	*x = VerifyMessageResponse{}
+	mi := &file_lightning_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMessageResponse) ProtoMessage() {}
+
+func (x *VerifyMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[51]
 	if x != nil {
-		return x.SpendUnconfirmed
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *SendCoinsRequest) GetCoinSelectionStrategy() CoinSelectionStrategy {
+// Deprecated: Use VerifyMessageResponse.ProtoReflect.Descriptor instead.
+func (*VerifyMessageResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *VerifyMessageResponse) GetValid() bool {
 	if x != nil {
-		return x.CoinSelectionStrategy
+		return x.Valid
 	}
-	return CoinSelectionStrategy_STRATEGY_USE_GLOBAL_CONFIG
+	return false
 }
 
-func (x *SendCoinsRequest) GetOutpoints() []*OutPoint {
+func (x *VerifyMessageResponse) GetPubkey() string {
 	if x != nil {
-		return x.Outpoints
+		return x.Pubkey
 	}
-	return nil
+	return ""
 }
 
-type SendCoinsResponse struct {
+type ConnectPeerRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The transaction ID of the transaction
-	Txid          string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// Lightning address of the peer to connect to.
+	Addr *LightningAddress `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// If set, the daemon will attempt to persistently connect to the target
+	// peer. Otherwise, the call will be synchronous.
+	Perm bool `protobuf:"varint,2,opt,name=perm,proto3" json:"perm,omitempty"`
+	// The connection timeout value (in seconds) for this request. It won't affect
+	// other requests.
+	Timeout       uint64 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendCoinsResponse) Reset() {
-	*x = SendCoinsResponse{}
-	mi := &file_lightning_proto_msgTypes[27]
+func (x *ConnectPeerRequest) Reset() {
+	*x = ConnectPeerRequest{}
+	mi := &file_lightning_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendCoinsResponse) String() string {
+func (x *ConnectPeerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendCoinsResponse) ProtoMessage() {}
+func (*ConnectPeerRequest) ProtoMessage() {}
 
-func (x *SendCoinsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[27]
+func (x *ConnectPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3731,45 +5262,55 @@ func (x *SendCoinsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendCoinsResponse.ProtoReflect.Descriptor instead.
-func (*SendCoinsResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ConnectPeerRequest.ProtoReflect.Descriptor instead.
+func (*ConnectPeerRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *SendCoinsResponse) GetTxid() string {
+func (x *ConnectPeerRequest) GetAddr() *LightningAddress {
 	if x != nil {
-		return x.Txid
+		return x.Addr
 	}
-	return ""
+	return nil
 }
 
-type ListUnspentRequest struct {
+func (x *ConnectPeerRequest) GetPerm() bool {
+	if x != nil {
+		return x.Perm
+	}
+	return false
+}
+
+func (x *ConnectPeerRequest) GetTimeout() uint64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type ConnectPeerResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The minimum number of confirmations to be included.
-	MinConfs int32 `protobuf:"varint,1,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
-	// The maximum number of confirmations to be included.
-	MaxConfs int32 `protobuf:"varint,2,opt,name=max_confs,json=maxConfs,proto3" json:"max_confs,omitempty"`
-	// An optional filter to only include outputs belonging to an account.
-	Account       string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	// The status of the connect operation.
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUnspentRequest) Reset() {
-	*x = ListUnspentRequest{}
-	mi := &file_lightning_proto_msgTypes[28]
+func (x *ConnectPeerResponse) Reset() {
+	*x = ConnectPeerResponse{}
+	mi := &file_lightning_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUnspentRequest) String() string {
+func (x *ConnectPeerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUnspentRequest) ProtoMessage() {}
+func (*ConnectPeerResponse) ProtoMessage() {}
 
-func (x *ListUnspentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[28]
+func (x *ConnectPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3780,55 +5321,41 @@ func (x *ListUnspentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUnspentRequest.ProtoReflect.Descriptor instead.
-func (*ListUnspentRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *ListUnspentRequest) GetMinConfs() int32 {
-	if x != nil {
-		return x.MinConfs
-	}
-	return 0
-}
-
-func (x *ListUnspentRequest) GetMaxConfs() int32 {
-	if x != nil {
-		return x.MaxConfs
-	}
-	return 0
+// Deprecated: Use ConnectPeerResponse.ProtoReflect.Descriptor instead.
+func (*ConnectPeerResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ListUnspentRequest) GetAccount() string {
+func (x *ConnectPeerResponse) GetStatus() string {
 	if x != nil {
-		return x.Account
+		return x.Status
 	}
 	return ""
 }
 
-type ListUnspentResponse struct {
+type DisconnectPeerRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// A list of utxos
-	Utxos         []*Utxo `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	// The pubkey of the node to disconnect from
+	PubKey        string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUnspentResponse) Reset() {
-	*x = ListUnspentResponse{}
-	mi := &file_lightning_proto_msgTypes[29]
+func (x *DisconnectPeerRequest) Reset() {
+	*x = DisconnectPeerRequest{}
+	mi := &file_lightning_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUnspentResponse) String() string {
+func (x *DisconnectPeerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUnspentResponse) ProtoMessage() {}
+func (*DisconnectPeerRequest) ProtoMessage() {}
 
-func (x *ListUnspentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[29]
+func (x *DisconnectPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3839,44 +5366,41 @@ func (x *ListUnspentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUnspentResponse.ProtoReflect.Descriptor instead.
-func (*ListUnspentResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use DisconnectPeerRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ListUnspentResponse) GetUtxos() []*Utxo {
+func (x *DisconnectPeerRequest) GetPubKey() string {
 	if x != nil {
-		return x.Utxos
+		return x.PubKey
 	}
-	return nil
+	return ""
 }
 
-type NewAddressRequest struct {
+type DisconnectPeerResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The type of address to generate.
-	Type AddressType `protobuf:"varint,1,opt,name=type,proto3,enum=lnrpc.AddressType" json:"type,omitempty"`
-	// The name of the account to generate a new address for. If empty, the
-	// default wallet account is used.
-	Account       string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// The status of the disconnect operation.
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NewAddressRequest) Reset() {
-	*x = NewAddressRequest{}
-	mi := &file_lightning_proto_msgTypes[30]
+func (x *DisconnectPeerResponse) Reset() {
+	*x = DisconnectPeerResponse{}
+	mi := &file_lightning_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NewAddressRequest) String() string {
+func (x *DisconnectPeerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewAddressRequest) ProtoMessage() {}
+func (*DisconnectPeerResponse) ProtoMessage() {}
 
-func (x *NewAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[30]
+func (x *DisconnectPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3887,48 +5411,46 @@ func (x *NewAddressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewAddressRequest.ProtoReflect.Descriptor instead.
-func (*NewAddressRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *NewAddressRequest) GetType() AddressType {
-	if x != nil {
-		return x.Type
-	}
-	return AddressType_WITNESS_PUBKEY_HASH
+// Deprecated: Use DisconnectPeerResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *NewAddressRequest) GetAccount() string {
+func (x *DisconnectPeerResponse) GetStatus() string {
 	if x != nil {
-		return x.Account
+		return x.Status
 	}
 	return ""
 }
 
-type NewAddressResponse struct {
+type PeerConnPolicy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The newly generated wallet address
-	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The reconnection priority of the peer.
+	Priority PeerConnPolicy_Priority `protobuf:"varint,1,opt,name=priority,proto3,enum=lnrpc.PeerConnPolicy_Priority" json:"priority,omitempty"`
+	// The number of consecutive failed connection attempts after which we stop
+	// reconnecting to the peer. Zero means the configured default applies.
+	MaxAttempts uint32 `protobuf:"varint,2,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// Which of the addresses of the peer we connect to.
+	AddrPreference PeerConnPolicy_AddrPreference `protobuf:"varint,3,opt,name=addr_preference,json=addrPreference,proto3,enum=lnrpc.PeerConnPolicy_AddrPreference" json:"addr_preference,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *NewAddressResponse) Reset() {
-	*x = NewAddressResponse{}
-	mi := &file_lightning_proto_msgTypes[31]
+func (x *PeerConnPolicy) Reset() {
+	*x = PeerConnPolicy{}
+	mi := &file_lightning_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NewAddressResponse) String() string {
+func (x *PeerConnPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewAddressResponse) ProtoMessage() {}
+func (*PeerConnPolicy) ProtoMessage() {}
 
-func (x *NewAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[31]
+func (x *PeerConnPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3939,45 +5461,60 @@ func (x *NewAddressResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewAddressResponse.ProtoReflect.Descriptor instead.
-func (*NewAddressResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use PeerConnPolicy.ProtoReflect.Descriptor instead.
+func (*PeerConnPolicy) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *NewAddressResponse) GetAddress() string {
+func (x *PeerConnPolicy) GetPriority() PeerConnPolicy_Priority {
 	if x != nil {
-		return x.Address
+		return x.Priority
 	}
-	return ""
+	return PeerConnPolicy_NORMAL
 }
 
-type SignMessageRequest struct {
+func (x *PeerConnPolicy) GetMaxAttempts() uint32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *PeerConnPolicy) GetAddrPreference() PeerConnPolicy_AddrPreference {
+	if x != nil {
+		return x.AddrPreference
+	}
+	return PeerConnPolicy_ANY
+}
+
+type SetPeerConnPolicyRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The message to be signed. When using REST, this field must be encoded as
-	// base64.
-	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
-	// Instead of the default double-SHA256 hashing of the message before signing,
-	// only use one round of hashing instead.
-	SingleHash    bool `protobuf:"varint,2,opt,name=single_hash,json=singleHash,proto3" json:"single_hash,omitempty"`
+	// The hex-encoded pubkey of the peer to set the policy for.
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// The new connection policy of the peer.
+	Policy *PeerConnPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	// If true, the policy of the peer is removed so that the defaults apply to
+	// it again. The policy field is ignored.
+	Remove        bool `protobuf:"varint,3,opt,name=remove,proto3" json:"remove,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SignMessageRequest) Reset() {
-	*x = SignMessageRequest{}
-	mi := &file_lightning_proto_msgTypes[32]
+func (x *SetPeerConnPolicyRequest) Reset() {
+	*x = SetPeerConnPolicyRequest{}
+	mi := &file_lightning_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SignMessageRequest) String() string {
+func (x *SetPeerConnPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignMessageRequest) ProtoMessage() {}
+func (*SetPeerConnPolicyRequest) ProtoMessage() {}
 
-func (x *SignMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[32]
+func (x *SetPeerConnPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3988,48 +5525,53 @@ func (x *SignMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignMessageRequest.ProtoReflect.Descriptor instead.
-func (*SignMessageRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use SetPeerConnPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetPeerConnPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *SignMessageRequest) GetMsg() []byte {
+func (x *SetPeerConnPolicyRequest) GetPubKey() string {
 	if x != nil {
-		return x.Msg
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *SetPeerConnPolicyRequest) GetPolicy() *PeerConnPolicy {
+	if x != nil {
+		return x.Policy
 	}
 	return nil
 }
 
-func (x *SignMessageRequest) GetSingleHash() bool {
+func (x *SetPeerConnPolicyRequest) GetRemove() bool {
 	if x != nil {
-		return x.SingleHash
+		return x.Remove
 	}
 	return false
 }
 
-type SignMessageResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The signature for the given message
-	Signature     string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+type SetPeerConnPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SignMessageResponse) Reset() {
-	*x = SignMessageResponse{}
-	mi := &file_lightning_proto_msgTypes[33]
+func (x *SetPeerConnPolicyResponse) Reset() {
+	*x = SetPeerConnPolicyResponse{}
+	mi := &file_lightning_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SignMessageResponse) String() string {
+func (x *SetPeerConnPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignMessageResponse) ProtoMessage() {}
+func (*SetPeerConnPolicyResponse) ProtoMessage() {}
 
-func (x *SignMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[33]
+func (x *SetPeerConnPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4040,44 +5582,32 @@ func (x *SignMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignMessageResponse.ProtoReflect.Descriptor instead.
-func (*SignMessageResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *SignMessageResponse) GetSignature() string {
-	if x != nil {
-		return x.Signature
-	}
-	return ""
+// Deprecated: Use SetPeerConnPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetPeerConnPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{58}
 }
 
-type VerifyMessageRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The message over which the signature is to be verified. When using REST,
-	// this field must be encoded as base64.
-	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
-	// The signature to be verified over the given message
-	Signature     string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+type ListPersistentPeersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyMessageRequest) Reset() {
-	*x = VerifyMessageRequest{}
-	mi := &file_lightning_proto_msgTypes[34]
+func (x *ListPersistentPeersRequest) Reset() {
+	*x = ListPersistentPeersRequest{}
+	mi := &file_lightning_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyMessageRequest) String() string {
+func (x *ListPersistentPeersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyMessageRequest) ProtoMessage() {}
+func (*ListPersistentPeersRequest) ProtoMessage() {}
 
-func (x *VerifyMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[34]
+func (x *ListPersistentPeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4088,50 +5618,53 @@ func (x *VerifyMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyMessageRequest.ProtoReflect.Descriptor instead.
-func (*VerifyMessageRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *VerifyMessageRequest) GetMsg() []byte {
-	if x != nil {
-		return x.Msg
-	}
-	return nil
-}
-
-func (x *VerifyMessageRequest) GetSignature() string {
-	if x != nil {
-		return x.Signature
-	}
-	return ""
+// Deprecated: Use ListPersistentPeersRequest.ProtoReflect.Descriptor instead.
+func (*ListPersistentPeersRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{59}
 }
 
-type VerifyMessageResponse struct {
+type PersistentPeer struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the signature was valid over the given message
-	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	// The pubkey recovered from the signature
-	Pubkey        string `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// The hex-encoded pubkey of the peer.
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// Whether we're currently connected to the peer.
+	Connected bool `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
+	// Whether the persistent connection was requested by the user, in which
+	// case we reconnect to the peer even if we have no channels with it.
+	Perm bool `protobuf:"varint,3,opt,name=perm,proto3" json:"perm,omitempty"`
+	// The connection policy of the peer.
+	Policy *PeerConnPolicy `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
+	// The delay of the latest scheduled reconnection in milliseconds.
+	BackoffMs uint64 `protobuf:"varint,5,opt,name=backoff_ms,json=backoffMs,proto3" json:"backoff_ms,omitempty"`
+	// The unix timestamp in seconds of the latest scheduled reconnection, or
+	// zero if no reconnection was scheduled since we last connected.
+	NextAttemptTime int64 `protobuf:"varint,6,opt,name=next_attempt_time,json=nextAttemptTime,proto3" json:"next_attempt_time,omitempty"`
+	// The number of consecutive failed connection attempts.
+	FailedAttempts uint32 `protobuf:"varint,7,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	// Whether we stopped reconnecting to the peer because it reached its maximum
+	// number of connection attempts.
+	Exhausted bool `protobuf:"varint,8,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	// The addresses we reconnect to the peer with.
+	Addresses     []string `protobuf:"bytes,9,rep,name=addresses,proto3" json:"addresses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyMessageResponse) Reset() {
-	*x = VerifyMessageResponse{}
-	mi := &file_lightning_proto_msgTypes[35]
+func (x *PersistentPeer) Reset() {
+	*x = PersistentPeer{}
+	mi := &file_lightning_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyMessageResponse) String() string {
+func (x *PersistentPeer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyMessageResponse) ProtoMessage() {}
+func (*PersistentPeer) ProtoMessage() {}
 
-func (x *VerifyMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[35]
+func (x *PersistentPeer) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4142,113 +5675,97 @@ func (x *VerifyMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyMessageResponse.ProtoReflect.Descriptor instead.
-func (*VerifyMessageResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use PersistentPeer.ProtoReflect.Descriptor instead.
+func (*PersistentPeer) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *VerifyMessageResponse) GetValid() bool {
+func (x *PersistentPeer) GetPubKey() string {
 	if x != nil {
-		return x.Valid
+		return x.PubKey
 	}
-	return false
+	return ""
 }
 
-func (x *VerifyMessageResponse) GetPubkey() string {
+func (x *PersistentPeer) GetConnected() bool {
 	if x != nil {
-		return x.Pubkey
+		return x.Connected
 	}
-	return ""
-}
-
-type ConnectPeerRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Lightning address of the peer to connect to.
-	Addr *LightningAddress `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	// If set, the daemon will attempt to persistently connect to the target
-	// peer. Otherwise, the call will be synchronous.
-	Perm bool `protobuf:"varint,2,opt,name=perm,proto3" json:"perm,omitempty"`
-	// The connection timeout value (in seconds) for this request. It won't affect
-	// other requests.
-	Timeout       uint64 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return false
 }
 
-func (x *ConnectPeerRequest) Reset() {
-	*x = ConnectPeerRequest{}
-	mi := &file_lightning_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PersistentPeer) GetPerm() bool {
+	if x != nil {
+		return x.Perm
+	}
+	return false
 }
 
-func (x *ConnectPeerRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PersistentPeer) GetPolicy() *PeerConnPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
 }
 
-func (*ConnectPeerRequest) ProtoMessage() {}
-
-func (x *ConnectPeerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[36]
+func (x *PersistentPeer) GetBackoffMs() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.BackoffMs
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ConnectPeerRequest.ProtoReflect.Descriptor instead.
-func (*ConnectPeerRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{36}
+func (x *PersistentPeer) GetNextAttemptTime() int64 {
+	if x != nil {
+		return x.NextAttemptTime
+	}
+	return 0
 }
 
-func (x *ConnectPeerRequest) GetAddr() *LightningAddress {
+func (x *PersistentPeer) GetFailedAttempts() uint32 {
 	if x != nil {
-		return x.Addr
+		return x.FailedAttempts
 	}
-	return nil
+	return 0
 }
 
-func (x *ConnectPeerRequest) GetPerm() bool {
+func (x *PersistentPeer) GetExhausted() bool {
 	if x != nil {
-		return x.Perm
+		return x.Exhausted
 	}
 	return false
 }
 
-func (x *ConnectPeerRequest) GetTimeout() uint64 {
+func (x *PersistentPeer) GetAddresses() []string {
 	if x != nil {
-		return x.Timeout
+		return x.Addresses
 	}
-	return 0
+	return nil
 }
 
-type ConnectPeerResponse struct {
+type ListPersistentPeersResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The status of the connect operation.
-	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The peers we maintain a persistent connection with.
+	Peers         []*PersistentPeer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConnectPeerResponse) Reset() {
-	*x = ConnectPeerResponse{}
-	mi := &file_lightning_proto_msgTypes[37]
+func (x *ListPersistentPeersResponse) Reset() {
+	*x = ListPersistentPeersResponse{}
+	mi := &file_lightning_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectPeerResponse) String() string {
+func (x *ListPersistentPeersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectPeerResponse) ProtoMessage() {}
+func (*ListPersistentPeersResponse) ProtoMessage() {}
 
-func (x *ConnectPeerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[37]
+func (x *ListPersistentPeersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4259,41 +5776,41 @@ func (x *ConnectPeerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectPeerResponse.ProtoReflect.Descriptor instead.
-func (*ConnectPeerResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ListPersistentPeersResponse.ProtoReflect.Descriptor instead.
+func (*ListPersistentPeersResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ConnectPeerResponse) GetStatus() string {
+func (x *ListPersistentPeersResponse) GetPeers() []*PersistentPeer {
 	if x != nil {
-		return x.Status
+		return x.Peers
 	}
-	return ""
+	return nil
 }
 
-type DisconnectPeerRequest struct {
+type ReconnectPeerRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The pubkey of the node to disconnect from
+	// The hex-encoded pubkey of the peer to reconnect to.
 	PubKey        string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DisconnectPeerRequest) Reset() {
-	*x = DisconnectPeerRequest{}
-	mi := &file_lightning_proto_msgTypes[38]
+func (x *ReconnectPeerRequest) Reset() {
+	*x = ReconnectPeerRequest{}
+	mi := &file_lightning_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisconnectPeerRequest) String() string {
+func (x *ReconnectPeerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectPeerRequest) ProtoMessage() {}
+func (*ReconnectPeerRequest) ProtoMessage() {}
 
-func (x *DisconnectPeerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[38]
+func (x *ReconnectPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4304,41 +5821,39 @@ func (x *DisconnectPeerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectPeerRequest.ProtoReflect.Descriptor instead.
-func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ReconnectPeerRequest.ProtoReflect.Descriptor instead.
+func (*ReconnectPeerRequest) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *DisconnectPeerRequest) GetPubKey() string {
+func (x *ReconnectPeerRequest) GetPubKey() string {
 	if x != nil {
 		return x.PubKey
 	}
 	return ""
 }
 
-type DisconnectPeerResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The status of the disconnect operation.
-	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+type ReconnectPeerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DisconnectPeerResponse) Reset() {
-	*x = DisconnectPeerResponse{}
-	mi := &file_lightning_proto_msgTypes[39]
+func (x *ReconnectPeerResponse) Reset() {
+	*x = ReconnectPeerResponse{}
+	mi := &file_lightning_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisconnectPeerResponse) String() string {
+func (x *ReconnectPeerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectPeerResponse) ProtoMessage() {}
+func (*ReconnectPeerResponse) ProtoMessage() {}
 
-func (x *DisconnectPeerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[39]
+func (x *ReconnectPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4349,16 +5864,9 @@ func (x *DisconnectPeerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectPeerResponse.ProtoReflect.Descriptor instead.
-func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *DisconnectPeerResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use ReconnectPeerResponse.ProtoReflect.Descriptor instead.
+func (*ReconnectPeerResponse) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{63}
 }
 
 type HTLC struct {
@@ -4389,7 +5897,7 @@ type HTLC struct {
 
 func (x *HTLC) Reset() {
 	*x = HTLC{}
-	mi := &file_lightning_proto_msgTypes[40]
+	mi := &file_lightning_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4401,7 +5909,7 @@ func (x *HTLC) String() string {
 func (*HTLC) ProtoMessage() {}
 
 func (x *HTLC) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[40]
+	mi := &file_lightning_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4414,7 +5922,7 @@ func (x *HTLC) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTLC.ProtoReflect.Descriptor instead.
 func (*HTLC) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{40}
+	return file_lightning_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *HTLC) GetIncoming() bool {
@@ -4495,7 +6003,7 @@ type ChannelConstraints struct {
 
 func (x *ChannelConstraints) Reset() {
 	*x = ChannelConstraints{}
-	mi := &file_lightning_proto_msgTypes[41]
+	mi := &file_lightning_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4507,7 +6015,7 @@ func (x *ChannelConstraints) String() string {
 func (*ChannelConstraints) ProtoMessage() {}
 
 func (x *ChannelConstraints) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[41]
+	mi := &file_lightning_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4520,7 +6028,7 @@ func (x *ChannelConstraints) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelConstraints.ProtoReflect.Descriptor instead.
 func (*ChannelConstraints) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{41}
+	return file_lightning_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *ChannelConstraints) GetCsvDelay() uint32 {
@@ -4683,13 +6191,20 @@ type Channel struct {
 	Memo string `protobuf:"bytes,36,opt,name=memo,proto3" json:"memo,omitempty"`
 	// Custom channel data that might be populated in custom channels.
 	CustomChannelData []byte `protobuf:"bytes,37,opt,name=custom_channel_data,json=customChannelData,proto3" json:"custom_channel_data,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Whether the channel was paused with PauseChannel. A paused channel doesn't
+	// accept new HTLCs in either direction and stays paused across reconnections
+	// and restarts until it is resumed.
+	Paused bool `protobuf:"varint,38,opt,name=paused,proto3" json:"paused,omitempty"`
+	// Whether the channel is quiescent, meaning that neither peer may update the
+	// channel until the quiescence is terminated.
+	Quiescent     bool `protobuf:"varint,39,opt,name=quiescent,proto3" json:"quiescent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Channel) Reset() {
 	*x = Channel{}
-	mi := &file_lightning_proto_msgTypes[42]
+	mi := &file_lightning_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4701,7 +6216,7 @@ func (x *Channel) String() string {
 func (*Channel) ProtoMessage() {}
 
 func (x *Channel) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[42]
+	mi := &file_lightning_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4714,7 +6229,7 @@ func (x *Channel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Channel.ProtoReflect.Descriptor instead.
 func (*Channel) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{42}
+	return file_lightning_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *Channel) GetActive() bool {
@@ -4980,6 +6495,20 @@ func (x *Channel) GetCustomChannelData() []byte {
 	return nil
 }
 
+func (x *Channel) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *Channel) GetQuiescent() bool {
+	if x != nil {
+		return x.Quiescent
+	}
+	return false
+}
+
 type ListChannelsRequest struct {
 	state        protoimpl.MessageState `protogen:"open.v1"`
 	ActiveOnly   bool                   `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
@@ -4999,7 +6528,7 @@ type ListChannelsRequest struct {
 
 func (x *ListChannelsRequest) Reset() {
 	*x = ListChannelsRequest{}
-	mi := &file_lightning_proto_msgTypes[43]
+	mi := &file_lightning_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5011,7 +6540,7 @@ func (x *ListChannelsRequest) String() string {
 func (*ListChannelsRequest) ProtoMessage() {}
 
 func (x *ListChannelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[43]
+	mi := &file_lightning_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5024,7 +6553,7 @@ func (x *ListChannelsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChannelsRequest.ProtoReflect.Descriptor instead.
 func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{43}
+	return file_lightning_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *ListChannelsRequest) GetActiveOnly() bool {
@@ -5079,7 +6608,7 @@ type ListChannelsResponse struct {
 
 func (x *ListChannelsResponse) Reset() {
 	*x = ListChannelsResponse{}
-	mi := &file_lightning_proto_msgTypes[44]
+	mi := &file_lightning_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5091,7 +6620,7 @@ func (x *ListChannelsResponse) String() string {
 func (*ListChannelsResponse) ProtoMessage() {}
 
 func (x *ListChannelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[44]
+	mi := &file_lightning_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5104,7 +6633,7 @@ func (x *ListChannelsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChannelsResponse.ProtoReflect.Descriptor instead.
 func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{44}
+	return file_lightning_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *ListChannelsResponse) GetChannels() []*Channel {
@@ -5127,7 +6656,7 @@ type AliasMap struct {
 
 func (x *AliasMap) Reset() {
 	*x = AliasMap{}
-	mi := &file_lightning_proto_msgTypes[45]
+	mi := &file_lightning_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5139,7 +6668,7 @@ func (x *AliasMap) String() string {
 func (*AliasMap) ProtoMessage() {}
 
 func (x *AliasMap) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[45]
+	mi := &file_lightning_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5152,7 +6681,7 @@ func (x *AliasMap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AliasMap.ProtoReflect.Descriptor instead.
 func (*AliasMap) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{45}
+	return file_lightning_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *AliasMap) GetBaseScid() uint64 {
@@ -5177,7 +6706,7 @@ type ListAliasesRequest struct {
 
 func (x *ListAliasesRequest) Reset() {
 	*x = ListAliasesRequest{}
-	mi := &file_lightning_proto_msgTypes[46]
+	mi := &file_lightning_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5189,7 +6718,7 @@ func (x *ListAliasesRequest) String() string {
 func (*ListAliasesRequest) ProtoMessage() {}
 
 func (x *ListAliasesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[46]
+	mi := &file_lightning_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5202,7 +6731,7 @@ func (x *ListAliasesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAliasesRequest.ProtoReflect.Descriptor instead.
 func (*ListAliasesRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{46}
+	return file_lightning_proto_rawDescGZIP(), []int{70}
 }
 
 type ListAliasesResponse struct {
@@ -5214,7 +6743,7 @@ type ListAliasesResponse struct {
 
 func (x *ListAliasesResponse) Reset() {
 	*x = ListAliasesResponse{}
-	mi := &file_lightning_proto_msgTypes[47]
+	mi := &file_lightning_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5226,7 +6755,7 @@ func (x *ListAliasesResponse) String() string {
 func (*ListAliasesResponse) ProtoMessage() {}
 
 func (x *ListAliasesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[47]
+	mi := &file_lightning_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5239,7 +6768,7 @@ func (x *ListAliasesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAliasesResponse.ProtoReflect.Descriptor instead.
 func (*ListAliasesResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{47}
+	return file_lightning_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *ListAliasesResponse) GetAliasMaps() []*AliasMap {
@@ -5296,7 +6825,7 @@ type ChannelCloseSummary struct {
 
 func (x *ChannelCloseSummary) Reset() {
 	*x = ChannelCloseSummary{}
-	mi := &file_lightning_proto_msgTypes[48]
+	mi := &file_lightning_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5308,7 +6837,7 @@ func (x *ChannelCloseSummary) String() string {
 func (*ChannelCloseSummary) ProtoMessage() {}
 
 func (x *ChannelCloseSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[48]
+	mi := &file_lightning_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5321,7 +6850,7 @@ func (x *ChannelCloseSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelCloseSummary.ProtoReflect.Descriptor instead.
 func (*ChannelCloseSummary) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{48}
+	return file_lightning_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *ChannelCloseSummary) GetChannelPoint() string {
@@ -5455,7 +6984,7 @@ type Resolution struct {
 
 func (x *Resolution) Reset() {
 	*x = Resolution{}
-	mi := &file_lightning_proto_msgTypes[49]
+	mi := &file_lightning_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5467,7 +6996,7 @@ func (x *Resolution) String() string {
 func (*Resolution) ProtoMessage() {}
 
 func (x *Resolution) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[49]
+	mi := &file_lightning_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5480,7 +7009,7 @@ func (x *Resolution) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Resolution.ProtoReflect.Descriptor instead.
 func (*Resolution) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{49}
+	return file_lightning_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *Resolution) GetResolutionType() ResolutionType {
@@ -5532,7 +7061,7 @@ type ClosedChannelsRequest struct {
 
 func (x *ClosedChannelsRequest) Reset() {
 	*x = ClosedChannelsRequest{}
-	mi := &file_lightning_proto_msgTypes[50]
+	mi := &file_lightning_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5544,7 +7073,7 @@ func (x *ClosedChannelsRequest) String() string {
 func (*ClosedChannelsRequest) ProtoMessage() {}
 
 func (x *ClosedChannelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[50]
+	mi := &file_lightning_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5557,7 +7086,7 @@ func (x *ClosedChannelsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClosedChannelsRequest.ProtoReflect.Descriptor instead.
 func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{50}
+	return file_lightning_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *ClosedChannelsRequest) GetCooperative() bool {
@@ -5611,7 +7140,7 @@ type ClosedChannelsResponse struct {
 
 func (x *ClosedChannelsResponse) Reset() {
 	*x = ClosedChannelsResponse{}
-	mi := &file_lightning_proto_msgTypes[51]
+	mi := &file_lightning_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5623,7 +7152,7 @@ func (x *ClosedChannelsResponse) String() string {
 func (*ClosedChannelsResponse) ProtoMessage() {}
 
 func (x *ClosedChannelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[51]
+	mi := &file_lightning_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5636,7 +7165,7 @@ func (x *ClosedChannelsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClosedChannelsResponse.ProtoReflect.Descriptor instead.
 func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{51}
+	return file_lightning_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *ClosedChannelsResponse) GetChannels() []*ChannelCloseSummary {
@@ -5692,7 +7221,7 @@ type Peer struct {
 
 func (x *Peer) Reset() {
 	*x = Peer{}
-	mi := &file_lightning_proto_msgTypes[52]
+	mi := &file_lightning_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5704,7 +7233,7 @@ func (x *Peer) String() string {
 func (*Peer) ProtoMessage() {}
 
 func (x *Peer) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[52]
+	mi := &file_lightning_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5717,7 +7246,7 @@ func (x *Peer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Peer.ProtoReflect.Descriptor instead.
 func (*Peer) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{52}
+	return file_lightning_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *Peer) GetPubKey() string {
@@ -5830,7 +7359,7 @@ type TimestampedError struct {
 
 func (x *TimestampedError) Reset() {
 	*x = TimestampedError{}
-	mi := &file_lightning_proto_msgTypes[53]
+	mi := &file_lightning_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5842,7 +7371,7 @@ func (x *TimestampedError) String() string {
 func (*TimestampedError) ProtoMessage() {}
 
 func (x *TimestampedError) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[53]
+	mi := &file_lightning_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5855,7 +7384,7 @@ func (x *TimestampedError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimestampedError.ProtoReflect.Descriptor instead.
 func (*TimestampedError) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{53}
+	return file_lightning_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *TimestampedError) GetTimestamp() uint64 {
@@ -5884,7 +7413,7 @@ type ListPeersRequest struct {
 
 func (x *ListPeersRequest) Reset() {
 	*x = ListPeersRequest{}
-	mi := &file_lightning_proto_msgTypes[54]
+	mi := &file_lightning_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5896,7 +7425,7 @@ func (x *ListPeersRequest) String() string {
 func (*ListPeersRequest) ProtoMessage() {}
 
 func (x *ListPeersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[54]
+	mi := &file_lightning_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5909,7 +7438,7 @@ func (x *ListPeersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPeersRequest.ProtoReflect.Descriptor instead.
 func (*ListPeersRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{54}
+	return file_lightning_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *ListPeersRequest) GetLatestError() bool {
@@ -5929,7 +7458,7 @@ type ListPeersResponse struct {
 
 func (x *ListPeersResponse) Reset() {
 	*x = ListPeersResponse{}
-	mi := &file_lightning_proto_msgTypes[55]
+	mi := &file_lightning_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5941,7 +7470,7 @@ func (x *ListPeersResponse) String() string {
 func (*ListPeersResponse) ProtoMessage() {}
 
 func (x *ListPeersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[55]
+	mi := &file_lightning_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5954,7 +7483,7 @@ func (x *ListPeersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPeersResponse.ProtoReflect.Descriptor instead.
 func (*ListPeersResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{55}
+	return file_lightning_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *ListPeersResponse) GetPeers() []*Peer {
@@ -5972,7 +7501,7 @@ type PeerEventSubscription struct {
 
 func (x *PeerEventSubscription) Reset() {
 	*x = PeerEventSubscription{}
-	mi := &file_lightning_proto_msgTypes[56]
+	mi := &file_lightning_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5984,7 +7513,7 @@ func (x *PeerEventSubscription) String() string {
 func (*PeerEventSubscription) ProtoMessage() {}
 
 func (x *PeerEventSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[56]
+	mi := &file_lightning_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5997,7 +7526,7 @@ func (x *PeerEventSubscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeerEventSubscription.ProtoReflect.Descriptor instead.
 func (*PeerEventSubscription) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{56}
+	return file_lightning_proto_rawDescGZIP(), []int{80}
 }
 
 type PeerEvent struct {
@@ -6011,7 +7540,7 @@ type PeerEvent struct {
 
 func (x *PeerEvent) Reset() {
 	*x = PeerEvent{}
-	mi := &file_lightning_proto_msgTypes[57]
+	mi := &file_lightning_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6023,7 +7552,7 @@ func (x *PeerEvent) String() string {
 func (*PeerEvent) ProtoMessage() {}
 
 func (x *PeerEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[57]
+	mi := &file_lightning_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6036,7 +7565,7 @@ func (x *PeerEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeerEvent.ProtoReflect.Descriptor instead.
 func (*PeerEvent) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{57}
+	return file_lightning_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *PeerEvent) GetPubKey() string {
@@ -6061,7 +7590,7 @@ type GetInfoRequest struct {
 
 func (x *GetInfoRequest) Reset() {
 	*x = GetInfoRequest{}
-	mi := &file_lightning_proto_msgTypes[58]
+	mi := &file_lightning_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6073,7 +7602,7 @@ func (x *GetInfoRequest) String() string {
 func (*GetInfoRequest) ProtoMessage() {}
 
 func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[58]
+	mi := &file_lightning_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6086,7 +7615,7 @@ func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{58}
+	return file_lightning_proto_rawDescGZIP(), []int{82}
 }
 
 type GetInfoResponse struct {
@@ -6148,7 +7677,7 @@ type GetInfoResponse struct {
 
 func (x *GetInfoResponse) Reset() {
 	*x = GetInfoResponse{}
-	mi := &file_lightning_proto_msgTypes[59]
+	mi := &file_lightning_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6160,7 +7689,7 @@ func (x *GetInfoResponse) String() string {
 func (*GetInfoResponse) ProtoMessage() {}
 
 func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[59]
+	mi := &file_lightning_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6173,7 +7702,7 @@ func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{59}
+	return file_lightning_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *GetInfoResponse) GetVersion() string {
@@ -6342,7 +7871,7 @@ type GetDebugInfoRequest struct {
 
 func (x *GetDebugInfoRequest) Reset() {
 	*x = GetDebugInfoRequest{}
-	mi := &file_lightning_proto_msgTypes[60]
+	mi := &file_lightning_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6354,7 +7883,7 @@ func (x *GetDebugInfoRequest) String() string {
 func (*GetDebugInfoRequest) ProtoMessage() {}
 
 func (x *GetDebugInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[60]
+	mi := &file_lightning_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6367,7 +7896,7 @@ func (x *GetDebugInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDebugInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetDebugInfoRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{60}
+	return file_lightning_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *GetDebugInfoRequest) GetIncludeLog() bool {
@@ -6387,7 +7916,7 @@ type GetDebugInfoResponse struct {
 
 func (x *GetDebugInfoResponse) Reset() {
 	*x = GetDebugInfoResponse{}
-	mi := &file_lightning_proto_msgTypes[61]
+	mi := &file_lightning_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6399,7 +7928,7 @@ func (x *GetDebugInfoResponse) String() string {
 func (*GetDebugInfoResponse) ProtoMessage() {}
 
 func (x *GetDebugInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[61]
+	mi := &file_lightning_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6412,7 +7941,7 @@ func (x *GetDebugInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDebugInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetDebugInfoResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{61}
+	return file_lightning_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *GetDebugInfoResponse) GetConfig() map[string]string {
@@ -6437,7 +7966,7 @@ type GetRecoveryInfoRequest struct {
 
 func (x *GetRecoveryInfoRequest) Reset() {
 	*x = GetRecoveryInfoRequest{}
-	mi := &file_lightning_proto_msgTypes[62]
+	mi := &file_lightning_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6449,7 +7978,7 @@ func (x *GetRecoveryInfoRequest) String() string {
 func (*GetRecoveryInfoRequest) ProtoMessage() {}
 
 func (x *GetRecoveryInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[62]
+	mi := &file_lightning_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6462,7 +7991,7 @@ func (x *GetRecoveryInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRecoveryInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{62}
+	return file_lightning_proto_rawDescGZIP(), []int{86}
 }
 
 type GetRecoveryInfoResponse struct {
@@ -6479,7 +8008,7 @@ type GetRecoveryInfoResponse struct {
 
 func (x *GetRecoveryInfoResponse) Reset() {
 	*x = GetRecoveryInfoResponse{}
-	mi := &file_lightning_proto_msgTypes[63]
+	mi := &file_lightning_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6491,7 +8020,7 @@ func (x *GetRecoveryInfoResponse) String() string {
 func (*GetRecoveryInfoResponse) ProtoMessage() {}
 
 func (x *GetRecoveryInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[63]
+	mi := &file_lightning_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6504,7 +8033,7 @@ func (x *GetRecoveryInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRecoveryInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetRecoveryInfoResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{63}
+	return file_lightning_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *GetRecoveryInfoResponse) GetRecoveryMode() bool {
@@ -6543,7 +8072,7 @@ type Chain struct {
 
 func (x *Chain) Reset() {
 	*x = Chain{}
-	mi := &file_lightning_proto_msgTypes[64]
+	mi := &file_lightning_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6555,7 +8084,7 @@ func (x *Chain) String() string {
 func (*Chain) ProtoMessage() {}
 
 func (x *Chain) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[64]
+	mi := &file_lightning_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6568,7 +8097,7 @@ func (x *Chain) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Chain.ProtoReflect.Descriptor instead.
 func (*Chain) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{64}
+	return file_lightning_proto_rawDescGZIP(), []int{88}
 }
 
 // Deprecated: Marked as deprecated in lightning.proto.
@@ -6595,7 +8124,7 @@ type ChannelOpenUpdate struct {
 
 func (x *ChannelOpenUpdate) Reset() {
 	*x = ChannelOpenUpdate{}
-	mi := &file_lightning_proto_msgTypes[65]
+	mi := &file_lightning_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6607,7 +8136,7 @@ func (x *ChannelOpenUpdate) String() string {
 func (*ChannelOpenUpdate) ProtoMessage() {}
 
 func (x *ChannelOpenUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[65]
+	mi := &file_lightning_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6620,7 +8149,7 @@ func (x *ChannelOpenUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelOpenUpdate.ProtoReflect.Descriptor instead.
 func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{65}
+	return file_lightning_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *ChannelOpenUpdate) GetChannelPoint() *ChannelPoint {
@@ -6649,7 +8178,7 @@ type CloseOutput struct {
 
 func (x *CloseOutput) Reset() {
 	*x = CloseOutput{}
-	mi := &file_lightning_proto_msgTypes[66]
+	mi := &file_lightning_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6661,7 +8190,7 @@ func (x *CloseOutput) String() string {
 func (*CloseOutput) ProtoMessage() {}
 
 func (x *CloseOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[66]
+	mi := &file_lightning_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6674,7 +8203,7 @@ func (x *CloseOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseOutput.ProtoReflect.Descriptor instead.
 func (*CloseOutput) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{66}
+	return file_lightning_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *CloseOutput) GetAmountSat() int64 {
@@ -6723,7 +8252,7 @@ type ChannelCloseUpdate struct {
 
 func (x *ChannelCloseUpdate) Reset() {
 	*x = ChannelCloseUpdate{}
-	mi := &file_lightning_proto_msgTypes[67]
+	mi := &file_lightning_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6735,7 +8264,7 @@ func (x *ChannelCloseUpdate) String() string {
 func (*ChannelCloseUpdate) ProtoMessage() {}
 
 func (x *ChannelCloseUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[67]
+	mi := &file_lightning_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6748,7 +8277,7 @@ func (x *ChannelCloseUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelCloseUpdate.ProtoReflect.Descriptor instead.
 func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{67}
+	return file_lightning_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *ChannelCloseUpdate) GetClosingTxid() []byte {
@@ -6830,7 +8359,7 @@ type CloseChannelRequest struct {
 
 func (x *CloseChannelRequest) Reset() {
 	*x = CloseChannelRequest{}
-	mi := &file_lightning_proto_msgTypes[68]
+	mi := &file_lightning_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6842,7 +8371,7 @@ func (x *CloseChannelRequest) String() string {
 func (*CloseChannelRequest) ProtoMessage() {}
 
 func (x *CloseChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[68]
+	mi := &file_lightning_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6855,7 +8384,7 @@ func (x *CloseChannelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseChannelRequest.ProtoReflect.Descriptor instead.
 func (*CloseChannelRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{68}
+	return file_lightning_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *CloseChannelRequest) GetChannelPoint() *ChannelPoint {
@@ -6929,7 +8458,7 @@ type CloseStatusUpdate struct {
 
 func (x *CloseStatusUpdate) Reset() {
 	*x = CloseStatusUpdate{}
-	mi := &file_lightning_proto_msgTypes[69]
+	mi := &file_lightning_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6941,7 +8470,7 @@ func (x *CloseStatusUpdate) String() string {
 func (*CloseStatusUpdate) ProtoMessage() {}
 
 func (x *CloseStatusUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[69]
+	mi := &file_lightning_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6954,7 +8483,7 @@ func (x *CloseStatusUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseStatusUpdate.ProtoReflect.Descriptor instead.
 func (*CloseStatusUpdate) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{69}
+	return file_lightning_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *CloseStatusUpdate) GetUpdate() isCloseStatusUpdate_Update {
@@ -7025,7 +8554,7 @@ type PendingUpdate struct {
 
 func (x *PendingUpdate) Reset() {
 	*x = PendingUpdate{}
-	mi := &file_lightning_proto_msgTypes[70]
+	mi := &file_lightning_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7037,7 +8566,7 @@ func (x *PendingUpdate) String() string {
 func (*PendingUpdate) ProtoMessage() {}
 
 func (x *PendingUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[70]
+	mi := &file_lightning_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7050,7 +8579,7 @@ func (x *PendingUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PendingUpdate.ProtoReflect.Descriptor instead.
 func (*PendingUpdate) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{70}
+	return file_lightning_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *PendingUpdate) GetTxid() []byte {
@@ -7093,7 +8622,7 @@ type InstantUpdate struct {
 
 func (x *InstantUpdate) Reset() {
 	*x = InstantUpdate{}
-	mi := &file_lightning_proto_msgTypes[71]
+	mi := &file_lightning_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7105,7 +8634,7 @@ func (x *InstantUpdate) String() string {
 func (*InstantUpdate) ProtoMessage() {}
 
 func (x *InstantUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[71]
+	mi := &file_lightning_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7118,7 +8647,7 @@ func (x *InstantUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstantUpdate.ProtoReflect.Descriptor instead.
 func (*InstantUpdate) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{71}
+	return file_lightning_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *InstantUpdate) GetNumPendingHtlcs() int32 {
@@ -7147,7 +8676,7 @@ type ReadyForPsbtFunding struct {
 
 func (x *ReadyForPsbtFunding) Reset() {
 	*x = ReadyForPsbtFunding{}
-	mi := &file_lightning_proto_msgTypes[72]
+	mi := &file_lightning_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7159,7 +8688,7 @@ func (x *ReadyForPsbtFunding) String() string {
 func (*ReadyForPsbtFunding) ProtoMessage() {}
 
 func (x *ReadyForPsbtFunding) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[72]
+	mi := &file_lightning_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7172,7 +8701,7 @@ func (x *ReadyForPsbtFunding) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadyForPsbtFunding.ProtoReflect.Descriptor instead.
 func (*ReadyForPsbtFunding) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{72}
+	return file_lightning_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *ReadyForPsbtFunding) GetFundingAddress() string {
@@ -7222,7 +8751,7 @@ type BatchOpenChannelRequest struct {
 
 func (x *BatchOpenChannelRequest) Reset() {
 	*x = BatchOpenChannelRequest{}
-	mi := &file_lightning_proto_msgTypes[73]
+	mi := &file_lightning_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7234,7 +8763,7 @@ func (x *BatchOpenChannelRequest) String() string {
 func (*BatchOpenChannelRequest) ProtoMessage() {}
 
 func (x *BatchOpenChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[73]
+	mi := &file_lightning_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7247,7 +8776,7 @@ func (x *BatchOpenChannelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchOpenChannelRequest.ProtoReflect.Descriptor instead.
 func (*BatchOpenChannelRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{73}
+	return file_lightning_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *BatchOpenChannelRequest) GetChannels() []*BatchOpenChannel {
@@ -7377,7 +8906,7 @@ type BatchOpenChannel struct {
 
 func (x *BatchOpenChannel) Reset() {
 	*x = BatchOpenChannel{}
-	mi := &file_lightning_proto_msgTypes[74]
+	mi := &file_lightning_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7389,7 +8918,7 @@ func (x *BatchOpenChannel) String() string {
 func (*BatchOpenChannel) ProtoMessage() {}
 
 func (x *BatchOpenChannel) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[74]
+	mi := &file_lightning_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7402,7 +8931,7 @@ func (x *BatchOpenChannel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchOpenChannel.ProtoReflect.Descriptor instead.
 func (*BatchOpenChannel) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{74}
+	return file_lightning_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *BatchOpenChannel) GetNodePubkey() []byte {
@@ -7554,7 +9083,7 @@ type BatchOpenChannelResponse struct {
 
 func (x *BatchOpenChannelResponse) Reset() {
 	*x = BatchOpenChannelResponse{}
-	mi := &file_lightning_proto_msgTypes[75]
+	mi := &file_lightning_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7566,7 +9095,7 @@ func (x *BatchOpenChannelResponse) String() string {
 func (*BatchOpenChannelResponse) ProtoMessage() {}
 
 func (x *BatchOpenChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[75]
+	mi := &file_lightning_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7579,7 +9108,7 @@ func (x *BatchOpenChannelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchOpenChannelResponse.ProtoReflect.Descriptor instead.
 func (*BatchOpenChannelResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{75}
+	return file_lightning_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *BatchOpenChannelResponse) GetPendingChannels() []*PendingUpdate {
@@ -7691,14 +9220,21 @@ type OpenChannelRequest struct {
 	// the channel's operation.
 	Memo string `protobuf:"bytes,27,opt,name=memo,proto3" json:"memo,omitempty"`
 	// A list of selected outpoints that are allocated for channel funding.
-	Outpoints     []*OutPoint `protobuf:"bytes,28,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	Outpoints []*OutPoint `protobuf:"bytes,28,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	// If set, the channel is opened as a dual-funded channel, whose funding
+	// transaction is constructed interactively with the peer, which may
+	// contribute to the channel as well. The funding transaction is funded from
+	// the internal wallet. This requires the peer to support dual funding and
+	// can't be combined with push_sat, funding_shim, fund_max, outpoints or
+	// zero-conf, taproot and lease channel types.
+	DualFund      bool `protobuf:"varint,29,opt,name=dual_fund,json=dualFund,proto3" json:"dual_fund,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OpenChannelRequest) Reset() {
 	*x = OpenChannelRequest{}
-	mi := &file_lightning_proto_msgTypes[76]
+	mi := &file_lightning_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7710,7 +9246,7 @@ func (x *OpenChannelRequest) String() string {
 func (*OpenChannelRequest) ProtoMessage() {}
 
 func (x *OpenChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[76]
+	mi := &file_lightning_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7723,7 +9259,7 @@ func (x *OpenChannelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenChannelRequest.ProtoReflect.Descriptor instead.
 func (*OpenChannelRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{76}
+	return file_lightning_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *OpenChannelRequest) GetSatPerVbyte() uint64 {
@@ -7924,6 +9460,13 @@ func (x *OpenChannelRequest) GetOutpoints() []*OutPoint {
 	return nil
 }
 
+func (x *OpenChannelRequest) GetDualFund() bool {
+	if x != nil {
+		return x.DualFund
+	}
+	return false
+}
+
 type OpenStatusUpdate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Update:
@@ -7941,7 +9484,7 @@ type OpenStatusUpdate struct {
 
 func (x *OpenStatusUpdate) Reset() {
 	*x = OpenStatusUpdate{}
-	mi := &file_lightning_proto_msgTypes[77]
+	mi := &file_lightning_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7953,7 +9496,7 @@ func (x *OpenStatusUpdate) String() string {
 func (*OpenStatusUpdate) ProtoMessage() {}
 
 func (x *OpenStatusUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[77]
+	mi := &file_lightning_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7966,7 +9509,7 @@ func (x *OpenStatusUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenStatusUpdate.ProtoReflect.Descriptor instead.
 func (*OpenStatusUpdate) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{77}
+	return file_lightning_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *OpenStatusUpdate) GetUpdate() isOpenStatusUpdate_Update {
@@ -8050,7 +9593,7 @@ type KeyLocator struct {
 
 func (x *KeyLocator) Reset() {
 	*x = KeyLocator{}
-	mi := &file_lightning_proto_msgTypes[78]
+	mi := &file_lightning_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8062,7 +9605,7 @@ func (x *KeyLocator) String() string {
 func (*KeyLocator) ProtoMessage() {}
 
 func (x *KeyLocator) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[78]
+	mi := &file_lightning_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8075,7 +9618,7 @@ func (x *KeyLocator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyLocator.ProtoReflect.Descriptor instead.
 func (*KeyLocator) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{78}
+	return file_lightning_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *KeyLocator) GetKeyFamily() int32 {
@@ -8104,7 +9647,7 @@ type KeyDescriptor struct {
 
 func (x *KeyDescriptor) Reset() {
 	*x = KeyDescriptor{}
-	mi := &file_lightning_proto_msgTypes[79]
+	mi := &file_lightning_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8116,7 +9659,7 @@ func (x *KeyDescriptor) String() string {
 func (*KeyDescriptor) ProtoMessage() {}
 
 func (x *KeyDescriptor) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[79]
+	mi := &file_lightning_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8129,7 +9672,7 @@ func (x *KeyDescriptor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyDescriptor.ProtoReflect.Descriptor instead.
 func (*KeyDescriptor) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{79}
+	return file_lightning_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *KeyDescriptor) GetRawKeyBytes() []byte {
@@ -8176,7 +9719,7 @@ type ChanPointShim struct {
 
 func (x *ChanPointShim) Reset() {
 	*x = ChanPointShim{}
-	mi := &file_lightning_proto_msgTypes[80]
+	mi := &file_lightning_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8188,7 +9731,7 @@ func (x *ChanPointShim) String() string {
 func (*ChanPointShim) ProtoMessage() {}
 
 func (x *ChanPointShim) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[80]
+	mi := &file_lightning_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8201,7 +9744,7 @@ func (x *ChanPointShim) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChanPointShim.ProtoReflect.Descriptor instead.
 func (*ChanPointShim) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{80}
+	return file_lightning_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *ChanPointShim) GetAmt() int64 {
@@ -8275,7 +9818,7 @@ type PsbtShim struct {
 
 func (x *PsbtShim) Reset() {
 	*x = PsbtShim{}
-	mi := &file_lightning_proto_msgTypes[81]
+	mi := &file_lightning_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8287,7 +9830,7 @@ func (x *PsbtShim) String() string {
 func (*PsbtShim) ProtoMessage() {}
 
 func (x *PsbtShim) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[81]
+	mi := &file_lightning_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8300,7 +9843,7 @@ func (x *PsbtShim) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PsbtShim.ProtoReflect.Descriptor instead.
 func (*PsbtShim) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{81}
+	return file_lightning_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *PsbtShim) GetPendingChanId() []byte {
@@ -8337,7 +9880,7 @@ type FundingShim struct {
 
 func (x *FundingShim) Reset() {
 	*x = FundingShim{}
-	mi := &file_lightning_proto_msgTypes[82]
+	mi := &file_lightning_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8349,7 +9892,7 @@ func (x *FundingShim) String() string {
 func (*FundingShim) ProtoMessage() {}
 
 func (x *FundingShim) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[82]
+	mi := &file_lightning_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8362,7 +9905,7 @@ func (x *FundingShim) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FundingShim.ProtoReflect.Descriptor instead.
 func (*FundingShim) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{82}
+	return file_lightning_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *FundingShim) GetShim() isFundingShim_Shim {
@@ -8420,7 +9963,7 @@ type FundingShimCancel struct {
 
 func (x *FundingShimCancel) Reset() {
 	*x = FundingShimCancel{}
-	mi := &file_lightning_proto_msgTypes[83]
+	mi := &file_lightning_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8432,7 +9975,7 @@ func (x *FundingShimCancel) String() string {
 func (*FundingShimCancel) ProtoMessage() {}
 
 func (x *FundingShimCancel) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[83]
+	mi := &file_lightning_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8445,7 +9988,7 @@ func (x *FundingShimCancel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FundingShimCancel.ProtoReflect.Descriptor instead.
 func (*FundingShimCancel) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{83}
+	return file_lightning_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *FundingShimCancel) GetPendingChanId() []byte {
@@ -8480,7 +10023,7 @@ type FundingPsbtVerify struct {
 
 func (x *FundingPsbtVerify) Reset() {
 	*x = FundingPsbtVerify{}
-	mi := &file_lightning_proto_msgTypes[84]
+	mi := &file_lightning_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8492,7 +10035,7 @@ func (x *FundingPsbtVerify) String() string {
 func (*FundingPsbtVerify) ProtoMessage() {}
 
 func (x *FundingPsbtVerify) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[84]
+	mi := &file_lightning_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8505,7 +10048,7 @@ func (x *FundingPsbtVerify) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FundingPsbtVerify.ProtoReflect.Descriptor instead.
 func (*FundingPsbtVerify) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{84}
+	return file_lightning_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *FundingPsbtVerify) GetFundedPsbt() []byte {
@@ -8547,7 +10090,7 @@ type FundingPsbtFinalize struct {
 
 func (x *FundingPsbtFinalize) Reset() {
 	*x = FundingPsbtFinalize{}
-	mi := &file_lightning_proto_msgTypes[85]
+	mi := &file_lightning_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8559,7 +10102,7 @@ func (x *FundingPsbtFinalize) String() string {
 func (*FundingPsbtFinalize) ProtoMessage() {}
 
 func (x *FundingPsbtFinalize) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[85]
+	mi := &file_lightning_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8572,7 +10115,7 @@ func (x *FundingPsbtFinalize) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FundingPsbtFinalize.ProtoReflect.Descriptor instead.
 func (*FundingPsbtFinalize) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{85}
+	return file_lightning_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *FundingPsbtFinalize) GetSignedPsbt() []byte {
@@ -8611,7 +10154,7 @@ type FundingTransitionMsg struct {
 
 func (x *FundingTransitionMsg) Reset() {
 	*x = FundingTransitionMsg{}
-	mi := &file_lightning_proto_msgTypes[86]
+	mi := &file_lightning_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8623,7 +10166,7 @@ func (x *FundingTransitionMsg) String() string {
 func (*FundingTransitionMsg) ProtoMessage() {}
 
 func (x *FundingTransitionMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[86]
+	mi := &file_lightning_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8636,7 +10179,7 @@ func (x *FundingTransitionMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FundingTransitionMsg.ProtoReflect.Descriptor instead.
 func (*FundingTransitionMsg) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{86}
+	return file_lightning_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *FundingTransitionMsg) GetTrigger() isFundingTransitionMsg_Trigger {
@@ -8729,7 +10272,7 @@ type FundingStateStepResp struct {
 
 func (x *FundingStateStepResp) Reset() {
 	*x = FundingStateStepResp{}
-	mi := &file_lightning_proto_msgTypes[87]
+	mi := &file_lightning_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8741,7 +10284,7 @@ func (x *FundingStateStepResp) String() string {
 func (*FundingStateStepResp) ProtoMessage() {}
 
 func (x *FundingStateStepResp) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[87]
+	mi := &file_lightning_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8754,7 +10297,7 @@ func (x *FundingStateStepResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FundingStateStepResp.ProtoReflect.Descriptor instead.
 func (*FundingStateStepResp) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{87}
+	return file_lightning_proto_rawDescGZIP(), []int{111}
 }
 
 type PendingHTLC struct {
@@ -8779,7 +10322,7 @@ type PendingHTLC struct {
 
 func (x *PendingHTLC) Reset() {
 	*x = PendingHTLC{}
-	mi := &file_lightning_proto_msgTypes[88]
+	mi := &file_lightning_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8791,7 +10334,7 @@ func (x *PendingHTLC) String() string {
 func (*PendingHTLC) ProtoMessage() {}
 
 func (x *PendingHTLC) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[88]
+	mi := &file_lightning_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8804,7 +10347,7 @@ func (x *PendingHTLC) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PendingHTLC.ProtoReflect.Descriptor instead.
 func (*PendingHTLC) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{88}
+	return file_lightning_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *PendingHTLC) GetIncoming() bool {
@@ -8860,7 +10403,7 @@ type PendingChannelsRequest struct {
 
 func (x *PendingChannelsRequest) Reset() {
 	*x = PendingChannelsRequest{}
-	mi := &file_lightning_proto_msgTypes[89]
+	mi := &file_lightning_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8872,7 +10415,7 @@ func (x *PendingChannelsRequest) String() string {
 func (*PendingChannelsRequest) ProtoMessage() {}
 
 func (x *PendingChannelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[89]
+	mi := &file_lightning_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8885,7 +10428,7 @@ func (x *PendingChannelsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PendingChannelsRequest.ProtoReflect.Descriptor instead.
 func (*PendingChannelsRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{89}
+	return file_lightning_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *PendingChannelsRequest) GetIncludeRawTx() bool {
@@ -8911,13 +10454,16 @@ type PendingChannelsResponse struct {
 	PendingForceClosingChannels []*PendingChannelsResponse_ForceClosedChannel `protobuf:"bytes,4,rep,name=pending_force_closing_channels,json=pendingForceClosingChannels,proto3" json:"pending_force_closing_channels,omitempty"`
 	// Channels waiting for closing tx to confirm
 	WaitingCloseChannels []*PendingChannelsResponse_WaitingCloseChannel `protobuf:"bytes,5,rep,name=waiting_close_channels,json=waitingCloseChannels,proto3" json:"waiting_close_channels,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Channels with a close scheduled with ScheduleChannelClose. They are
+	// removed once their closing transaction confirmed.
+	ScheduledCloses []*PendingChannelsResponse_ScheduledClose `protobuf:"bytes,6,rep,name=scheduled_closes,json=scheduledCloses,proto3" json:"scheduled_closes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *PendingChannelsResponse) Reset() {
 	*x = PendingChannelsResponse{}
-	mi := &file_lightning_proto_msgTypes[90]
+	mi := &file_lightning_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8929,7 +10475,7 @@ func (x *PendingChannelsResponse) String() string {
 func (*PendingChannelsResponse) ProtoMessage() {}
 
 func (x *PendingChannelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[90]
+	mi := &file_lightning_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8942,7 +10488,7 @@ func (x *PendingChannelsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PendingChannelsResponse.ProtoReflect.Descriptor instead.
 func (*PendingChannelsResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{90}
+	return file_lightning_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *PendingChannelsResponse) GetTotalLimboBalance() int64 {
@@ -8981,6 +10527,13 @@ func (x *PendingChannelsResponse) GetWaitingCloseChannels() []*PendingChannelsRe
 	return nil
 }
 
+func (x *PendingChannelsResponse) GetScheduledCloses() []*PendingChannelsResponse_ScheduledClose {
+	if x != nil {
+		return x.ScheduledCloses
+	}
+	return nil
+}
+
 type ChannelEventSubscription struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -8989,7 +10542,7 @@ type ChannelEventSubscription struct {
 
 func (x *ChannelEventSubscription) Reset() {
 	*x = ChannelEventSubscription{}
-	mi := &file_lightning_proto_msgTypes[91]
+	mi := &file_lightning_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9001,7 +10554,7 @@ func (x *ChannelEventSubscription) String() string {
 func (*ChannelEventSubscription) ProtoMessage() {}
 
 func (x *ChannelEventSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[91]
+	mi := &file_lightning_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9014,7 +10567,7 @@ func (x *ChannelEventSubscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelEventSubscription.ProtoReflect.Descriptor instead.
 func (*ChannelEventSubscription) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{91}
+	return file_lightning_proto_rawDescGZIP(), []int{115}
 }
 
 type ChannelCommitUpdate struct {
@@ -9026,7 +10579,7 @@ type ChannelCommitUpdate struct {
 
 func (x *ChannelCommitUpdate) Reset() {
 	*x = ChannelCommitUpdate{}
-	mi := &file_lightning_proto_msgTypes[92]
+	mi := &file_lightning_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9038,7 +10591,7 @@ func (x *ChannelCommitUpdate) String() string {
 func (*ChannelCommitUpdate) ProtoMessage() {}
 
 func (x *ChannelCommitUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[92]
+	mi := &file_lightning_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9051,7 +10604,7 @@ func (x *ChannelCommitUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelCommitUpdate.ProtoReflect.Descriptor instead.
 func (*ChannelCommitUpdate) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{92}
+	return file_lightning_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *ChannelCommitUpdate) GetChannel() *Channel {
@@ -9081,7 +10634,7 @@ type ChannelEventUpdate struct {
 
 func (x *ChannelEventUpdate) Reset() {
 	*x = ChannelEventUpdate{}
-	mi := &file_lightning_proto_msgTypes[93]
+	mi := &file_lightning_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9093,7 +10646,7 @@ func (x *ChannelEventUpdate) String() string {
 func (*ChannelEventUpdate) ProtoMessage() {}
 
 func (x *ChannelEventUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[93]
+	mi := &file_lightning_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9106,7 +10659,7 @@ func (x *ChannelEventUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelEventUpdate.ProtoReflect.Descriptor instead.
 func (*ChannelEventUpdate) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{93}
+	return file_lightning_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *ChannelEventUpdate) GetChannel() isChannelEventUpdate_Channel {
@@ -9259,7 +10812,7 @@ type WalletAccountBalance struct {
 
 func (x *WalletAccountBalance) Reset() {
 	*x = WalletAccountBalance{}
-	mi := &file_lightning_proto_msgTypes[94]
+	mi := &file_lightning_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9271,7 +10824,7 @@ func (x *WalletAccountBalance) String() string {
 func (*WalletAccountBalance) ProtoMessage() {}
 
 func (x *WalletAccountBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[94]
+	mi := &file_lightning_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9284,7 +10837,7 @@ func (x *WalletAccountBalance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletAccountBalance.ProtoReflect.Descriptor instead.
 func (*WalletAccountBalance) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{94}
+	return file_lightning_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *WalletAccountBalance) GetConfirmedBalance() int64 {
@@ -9316,7 +10869,7 @@ type WalletBalanceRequest struct {
 
 func (x *WalletBalanceRequest) Reset() {
 	*x = WalletBalanceRequest{}
-	mi := &file_lightning_proto_msgTypes[95]
+	mi := &file_lightning_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9328,7 +10881,7 @@ func (x *WalletBalanceRequest) String() string {
 func (*WalletBalanceRequest) ProtoMessage() {}
 
 func (x *WalletBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[95]
+	mi := &file_lightning_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9341,7 +10894,7 @@ func (x *WalletBalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletBalanceRequest.ProtoReflect.Descriptor instead.
 func (*WalletBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{95}
+	return file_lightning_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *WalletBalanceRequest) GetAccount() string {
@@ -9379,7 +10932,7 @@ type WalletBalanceResponse struct {
 
 func (x *WalletBalanceResponse) Reset() {
 	*x = WalletBalanceResponse{}
-	mi := &file_lightning_proto_msgTypes[96]
+	mi := &file_lightning_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9391,7 +10944,7 @@ func (x *WalletBalanceResponse) String() string {
 func (*WalletBalanceResponse) ProtoMessage() {}
 
 func (x *WalletBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[96]
+	mi := &file_lightning_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9404,7 +10957,7 @@ func (x *WalletBalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletBalanceResponse.ProtoReflect.Descriptor instead.
 func (*WalletBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{96}
+	return file_lightning_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *WalletBalanceResponse) GetTotalBalance() int64 {
@@ -9461,7 +11014,7 @@ type Amount struct {
 
 func (x *Amount) Reset() {
 	*x = Amount{}
-	mi := &file_lightning_proto_msgTypes[97]
+	mi := &file_lightning_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9473,7 +11026,7 @@ func (x *Amount) String() string {
 func (*Amount) ProtoMessage() {}
 
 func (x *Amount) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[97]
+	mi := &file_lightning_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9486,7 +11039,7 @@ func (x *Amount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Amount.ProtoReflect.Descriptor instead.
 func (*Amount) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{97}
+	return file_lightning_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *Amount) GetSat() uint64 {
@@ -9511,7 +11064,7 @@ type ChannelBalanceRequest struct {
 
 func (x *ChannelBalanceRequest) Reset() {
 	*x = ChannelBalanceRequest{}
-	mi := &file_lightning_proto_msgTypes[98]
+	mi := &file_lightning_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9523,7 +11076,7 @@ func (x *ChannelBalanceRequest) String() string {
 func (*ChannelBalanceRequest) ProtoMessage() {}
 
 func (x *ChannelBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[98]
+	mi := &file_lightning_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9536,7 +11089,7 @@ func (x *ChannelBalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelBalanceRequest.ProtoReflect.Descriptor instead.
 func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{98}
+	return file_lightning_proto_rawDescGZIP(), []int{122}
 }
 
 type ChannelBalanceResponse struct {
@@ -9570,7 +11123,7 @@ type ChannelBalanceResponse struct {
 
 func (x *ChannelBalanceResponse) Reset() {
 	*x = ChannelBalanceResponse{}
-	mi := &file_lightning_proto_msgTypes[99]
+	mi := &file_lightning_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9582,7 +11135,7 @@ func (x *ChannelBalanceResponse) String() string {
 func (*ChannelBalanceResponse) ProtoMessage() {}
 
 func (x *ChannelBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[99]
+	mi := &file_lightning_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9595,7 +11148,7 @@ func (x *ChannelBalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelBalanceResponse.ProtoReflect.Descriptor instead.
 func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{99}
+	return file_lightning_proto_rawDescGZIP(), []int{123}
 }
 
 // Deprecated: Marked as deprecated in lightning.proto.
@@ -9744,7 +11297,7 @@ type QueryRoutesRequest struct {
 
 func (x *QueryRoutesRequest) Reset() {
 	*x = QueryRoutesRequest{}
-	mi := &file_lightning_proto_msgTypes[100]
+	mi := &file_lightning_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9756,7 +11309,7 @@ func (x *QueryRoutesRequest) String() string {
 func (*QueryRoutesRequest) ProtoMessage() {}
 
 func (x *QueryRoutesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[100]
+	mi := &file_lightning_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9769,7 +11322,7 @@ func (x *QueryRoutesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRoutesRequest.ProtoReflect.Descriptor instead.
 func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{100}
+	return file_lightning_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *QueryRoutesRequest) GetPubKey() string {
@@ -9913,7 +11466,7 @@ type NodePair struct {
 
 func (x *NodePair) Reset() {
 	*x = NodePair{}
-	mi := &file_lightning_proto_msgTypes[101]
+	mi := &file_lightning_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9925,7 +11478,7 @@ func (x *NodePair) String() string {
 func (*NodePair) ProtoMessage() {}
 
 func (x *NodePair) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[101]
+	mi := &file_lightning_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9938,7 +11491,7 @@ func (x *NodePair) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodePair.ProtoReflect.Descriptor instead.
 func (*NodePair) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{101}
+	return file_lightning_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *NodePair) GetFrom() []byte {
@@ -9970,7 +11523,7 @@ type EdgeLocator struct {
 
 func (x *EdgeLocator) Reset() {
 	*x = EdgeLocator{}
-	mi := &file_lightning_proto_msgTypes[102]
+	mi := &file_lightning_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9982,7 +11535,7 @@ func (x *EdgeLocator) String() string {
 func (*EdgeLocator) ProtoMessage() {}
 
 func (x *EdgeLocator) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[102]
+	mi := &file_lightning_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9995,7 +11548,7 @@ func (x *EdgeLocator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EdgeLocator.ProtoReflect.Descriptor instead.
 func (*EdgeLocator) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{102}
+	return file_lightning_proto_rawDescGZIP(), []int{126}
 }
 
 func (x *EdgeLocator) GetChannelId() uint64 {
@@ -10026,7 +11579,7 @@ type QueryRoutesResponse struct {
 
 func (x *QueryRoutesResponse) Reset() {
 	*x = QueryRoutesResponse{}
-	mi := &file_lightning_proto_msgTypes[103]
+	mi := &file_lightning_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10038,7 +11591,7 @@ func (x *QueryRoutesResponse) String() string {
 func (*QueryRoutesResponse) ProtoMessage() {}
 
 func (x *QueryRoutesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[103]
+	mi := &file_lightning_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10051,7 +11604,7 @@ func (x *QueryRoutesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRoutesResponse.ProtoReflect.Descriptor instead.
 func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{103}
+	return file_lightning_proto_rawDescGZIP(), []int{127}
 }
 
 func (x *QueryRoutesResponse) GetRoutes() []*Route {
@@ -10133,7 +11686,7 @@ type Hop struct {
 
 func (x *Hop) Reset() {
 	*x = Hop{}
-	mi := &file_lightning_proto_msgTypes[104]
+	mi := &file_lightning_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10145,7 +11698,7 @@ func (x *Hop) String() string {
 func (*Hop) ProtoMessage() {}
 
 func (x *Hop) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[104]
+	mi := &file_lightning_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10158,7 +11711,7 @@ func (x *Hop) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Hop.ProtoReflect.Descriptor instead.
 func (*Hop) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{104}
+	return file_lightning_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *Hop) GetChanId() uint64 {
@@ -10296,7 +11849,7 @@ type MPPRecord struct {
 
 func (x *MPPRecord) Reset() {
 	*x = MPPRecord{}
-	mi := &file_lightning_proto_msgTypes[105]
+	mi := &file_lightning_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10308,7 +11861,7 @@ func (x *MPPRecord) String() string {
 func (*MPPRecord) ProtoMessage() {}
 
 func (x *MPPRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[105]
+	mi := &file_lightning_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10321,7 +11874,7 @@ func (x *MPPRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MPPRecord.ProtoReflect.Descriptor instead.
 func (*MPPRecord) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{105}
+	return file_lightning_proto_rawDescGZIP(), []int{129}
 }
 
 func (x *MPPRecord) GetPaymentAddr() []byte {
@@ -10349,7 +11902,7 @@ type AMPRecord struct {
 
 func (x *AMPRecord) Reset() {
 	*x = AMPRecord{}
-	mi := &file_lightning_proto_msgTypes[106]
+	mi := &file_lightning_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10361,7 +11914,7 @@ func (x *AMPRecord) String() string {
 func (*AMPRecord) ProtoMessage() {}
 
 func (x *AMPRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[106]
+	mi := &file_lightning_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10374,7 +11927,7 @@ func (x *AMPRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AMPRecord.ProtoReflect.Descriptor instead.
 func (*AMPRecord) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{106}
+	return file_lightning_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *AMPRecord) GetRootShare() []byte {
@@ -10444,7 +11997,7 @@ type Route struct {
 
 func (x *Route) Reset() {
 	*x = Route{}
-	mi := &file_lightning_proto_msgTypes[107]
+	mi := &file_lightning_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10456,7 +12009,7 @@ func (x *Route) String() string {
 func (*Route) ProtoMessage() {}
 
 func (x *Route) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[107]
+	mi := &file_lightning_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10469,7 +12022,7 @@ func (x *Route) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Route.ProtoReflect.Descriptor instead.
 func (*Route) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{107}
+	return file_lightning_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *Route) GetTotalTimeLock() uint32 {
@@ -10545,7 +12098,7 @@ type NodeInfoRequest struct {
 
 func (x *NodeInfoRequest) Reset() {
 	*x = NodeInfoRequest{}
-	mi := &file_lightning_proto_msgTypes[108]
+	mi := &file_lightning_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10557,7 +12110,7 @@ func (x *NodeInfoRequest) String() string {
 func (*NodeInfoRequest) ProtoMessage() {}
 
 func (x *NodeInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[108]
+	mi := &file_lightning_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10570,7 +12123,7 @@ func (x *NodeInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeInfoRequest.ProtoReflect.Descriptor instead.
 func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{108}
+	return file_lightning_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *NodeInfoRequest) GetPubKey() string {
@@ -10613,7 +12166,7 @@ type NodeInfo struct {
 
 func (x *NodeInfo) Reset() {
 	*x = NodeInfo{}
-	mi := &file_lightning_proto_msgTypes[109]
+	mi := &file_lightning_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10625,7 +12178,7 @@ func (x *NodeInfo) String() string {
 func (*NodeInfo) ProtoMessage() {}
 
 func (x *NodeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[109]
+	mi := &file_lightning_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10638,7 +12191,7 @@ func (x *NodeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeInfo.ProtoReflect.Descriptor instead.
 func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{109}
+	return file_lightning_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *NodeInfo) GetNode() *LightningNode {
@@ -10689,7 +12242,7 @@ type LightningNode struct {
 
 func (x *LightningNode) Reset() {
 	*x = LightningNode{}
-	mi := &file_lightning_proto_msgTypes[110]
+	mi := &file_lightning_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10701,7 +12254,7 @@ func (x *LightningNode) String() string {
 func (*LightningNode) ProtoMessage() {}
 
 func (x *LightningNode) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[110]
+	mi := &file_lightning_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10714,7 +12267,7 @@ func (x *LightningNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LightningNode.ProtoReflect.Descriptor instead.
 func (*LightningNode) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{110}
+	return file_lightning_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *LightningNode) GetLastUpdate() uint32 {
@@ -10776,7 +12329,7 @@ type NodeAddress struct {
 
 func (x *NodeAddress) Reset() {
 	*x = NodeAddress{}
-	mi := &file_lightning_proto_msgTypes[111]
+	mi := &file_lightning_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10788,7 +12341,7 @@ func (x *NodeAddress) String() string {
 func (*NodeAddress) ProtoMessage() {}
 
 func (x *NodeAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[111]
+	mi := &file_lightning_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10801,7 +12354,7 @@ func (x *NodeAddress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeAddress.ProtoReflect.Descriptor instead.
 func (*NodeAddress) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{111}
+	return file_lightning_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *NodeAddress) GetNetwork() string {
@@ -10838,7 +12391,7 @@ type RoutingPolicy struct {
 
 func (x *RoutingPolicy) Reset() {
 	*x = RoutingPolicy{}
-	mi := &file_lightning_proto_msgTypes[112]
+	mi := &file_lightning_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10850,7 +12403,7 @@ func (x *RoutingPolicy) String() string {
 func (*RoutingPolicy) ProtoMessage() {}
 
 func (x *RoutingPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[112]
+	mi := &file_lightning_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10863,7 +12416,7 @@ func (x *RoutingPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoutingPolicy.ProtoReflect.Descriptor instead.
 func (*RoutingPolicy) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{112}
+	return file_lightning_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *RoutingPolicy) GetTimeLockDelta() uint32 {
@@ -10961,7 +12514,7 @@ type ChannelAuthProof struct {
 
 func (x *ChannelAuthProof) Reset() {
 	*x = ChannelAuthProof{}
-	mi := &file_lightning_proto_msgTypes[113]
+	mi := &file_lightning_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10973,7 +12526,7 @@ func (x *ChannelAuthProof) String() string {
 func (*ChannelAuthProof) ProtoMessage() {}
 
 func (x *ChannelAuthProof) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[113]
+	mi := &file_lightning_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10986,7 +12539,7 @@ func (x *ChannelAuthProof) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelAuthProof.ProtoReflect.Descriptor instead.
 func (*ChannelAuthProof) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{113}
+	return file_lightning_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *ChannelAuthProof) GetNodeSig1() []byte {
@@ -11050,7 +12603,7 @@ type ChannelEdge struct {
 
 func (x *ChannelEdge) Reset() {
 	*x = ChannelEdge{}
-	mi := &file_lightning_proto_msgTypes[114]
+	mi := &file_lightning_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11062,7 +12615,7 @@ func (x *ChannelEdge) String() string {
 func (*ChannelEdge) ProtoMessage() {}
 
 func (x *ChannelEdge) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[114]
+	mi := &file_lightning_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11075,7 +12628,7 @@ func (x *ChannelEdge) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelEdge.ProtoReflect.Descriptor instead.
 func (*ChannelEdge) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{114}
+	return file_lightning_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *ChannelEdge) GetChannelId() uint64 {
@@ -11163,7 +12716,7 @@ type ChannelGraphRequest struct {
 
 func (x *ChannelGraphRequest) Reset() {
 	*x = ChannelGraphRequest{}
-	mi := &file_lightning_proto_msgTypes[115]
+	mi := &file_lightning_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11175,7 +12728,7 @@ func (x *ChannelGraphRequest) String() string {
 func (*ChannelGraphRequest) ProtoMessage() {}
 
 func (x *ChannelGraphRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[115]
+	mi := &file_lightning_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11188,7 +12741,7 @@ func (x *ChannelGraphRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelGraphRequest.ProtoReflect.Descriptor instead.
 func (*ChannelGraphRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{115}
+	return file_lightning_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *ChannelGraphRequest) GetIncludeUnannounced() bool {
@@ -11218,7 +12771,7 @@ type ChannelGraph struct {
 
 func (x *ChannelGraph) Reset() {
 	*x = ChannelGraph{}
-	mi := &file_lightning_proto_msgTypes[116]
+	mi := &file_lightning_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11230,7 +12783,7 @@ func (x *ChannelGraph) String() string {
 func (*ChannelGraph) ProtoMessage() {}
 
 func (x *ChannelGraph) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[116]
+	mi := &file_lightning_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11243,7 +12796,7 @@ func (x *ChannelGraph) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelGraph.ProtoReflect.Descriptor instead.
 func (*ChannelGraph) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{116}
+	return file_lightning_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *ChannelGraph) GetNodes() []*LightningNode {
@@ -11270,7 +12823,7 @@ type NodeMetricsRequest struct {
 
 func (x *NodeMetricsRequest) Reset() {
 	*x = NodeMetricsRequest{}
-	mi := &file_lightning_proto_msgTypes[117]
+	mi := &file_lightning_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11282,7 +12835,7 @@ func (x *NodeMetricsRequest) String() string {
 func (*NodeMetricsRequest) ProtoMessage() {}
 
 func (x *NodeMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[117]
+	mi := &file_lightning_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11295,7 +12848,7 @@ func (x *NodeMetricsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeMetricsRequest.ProtoReflect.Descriptor instead.
 func (*NodeMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{117}
+	return file_lightning_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *NodeMetricsRequest) GetTypes() []NodeMetricType {
@@ -11319,7 +12872,7 @@ type NodeMetricsResponse struct {
 
 func (x *NodeMetricsResponse) Reset() {
 	*x = NodeMetricsResponse{}
-	mi := &file_lightning_proto_msgTypes[118]
+	mi := &file_lightning_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11331,7 +12884,7 @@ func (x *NodeMetricsResponse) String() string {
 func (*NodeMetricsResponse) ProtoMessage() {}
 
 func (x *NodeMetricsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[118]
+	mi := &file_lightning_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11344,7 +12897,7 @@ func (x *NodeMetricsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeMetricsResponse.ProtoReflect.Descriptor instead.
 func (*NodeMetricsResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{118}
+	return file_lightning_proto_rawDescGZIP(), []int{142}
 }
 
 func (x *NodeMetricsResponse) GetBetweennessCentrality() map[string]*FloatMetric {
@@ -11366,7 +12919,7 @@ type FloatMetric struct {
 
 func (x *FloatMetric) Reset() {
 	*x = FloatMetric{}
-	mi := &file_lightning_proto_msgTypes[119]
+	mi := &file_lightning_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11378,7 +12931,7 @@ func (x *FloatMetric) String() string {
 func (*FloatMetric) ProtoMessage() {}
 
 func (x *FloatMetric) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[119]
+	mi := &file_lightning_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11391,7 +12944,7 @@ func (x *FloatMetric) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FloatMetric.ProtoReflect.Descriptor instead.
 func (*FloatMetric) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{119}
+	return file_lightning_proto_rawDescGZIP(), []int{143}
 }
 
 func (x *FloatMetric) GetValue() float64 {
@@ -11425,7 +12978,7 @@ type ChanInfoRequest struct {
 
 func (x *ChanInfoRequest) Reset() {
 	*x = ChanInfoRequest{}
-	mi := &file_lightning_proto_msgTypes[120]
+	mi := &file_lightning_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11437,7 +12990,7 @@ func (x *ChanInfoRequest) String() string {
 func (*ChanInfoRequest) ProtoMessage() {}
 
 func (x *ChanInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[120]
+	mi := &file_lightning_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11450,7 +13003,7 @@ func (x *ChanInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChanInfoRequest.ProtoReflect.Descriptor instead.
 func (*ChanInfoRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{120}
+	return file_lightning_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *ChanInfoRequest) GetChanId() uint64 {
@@ -11482,7 +13035,7 @@ type NetworkInfoRequest struct {
 
 func (x *NetworkInfoRequest) Reset() {
 	*x = NetworkInfoRequest{}
-	mi := &file_lightning_proto_msgTypes[121]
+	mi := &file_lightning_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11494,7 +13047,7 @@ func (x *NetworkInfoRequest) String() string {
 func (*NetworkInfoRequest) ProtoMessage() {}
 
 func (x *NetworkInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[121]
+	mi := &file_lightning_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11507,7 +13060,7 @@ func (x *NetworkInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkInfoRequest.ProtoReflect.Descriptor instead.
 func (*NetworkInfoRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{121}
+	return file_lightning_proto_rawDescGZIP(), []int{145}
 }
 
 type NetworkInfo struct {
@@ -11530,7 +13083,7 @@ type NetworkInfo struct {
 
 func (x *NetworkInfo) Reset() {
 	*x = NetworkInfo{}
-	mi := &file_lightning_proto_msgTypes[122]
+	mi := &file_lightning_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11542,7 +13095,7 @@ func (x *NetworkInfo) String() string {
 func (*NetworkInfo) ProtoMessage() {}
 
 func (x *NetworkInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[122]
+	mi := &file_lightning_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11555,7 +13108,7 @@ func (x *NetworkInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkInfo.ProtoReflect.Descriptor instead.
 func (*NetworkInfo) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{122}
+	return file_lightning_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *NetworkInfo) GetGraphDiameter() uint32 {
@@ -11643,7 +13196,7 @@ type StopRequest struct {
 
 func (x *StopRequest) Reset() {
 	*x = StopRequest{}
-	mi := &file_lightning_proto_msgTypes[123]
+	mi := &file_lightning_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11655,7 +13208,7 @@ func (x *StopRequest) String() string {
 func (*StopRequest) ProtoMessage() {}
 
 func (x *StopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[123]
+	mi := &file_lightning_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11668,7 +13221,7 @@ func (x *StopRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
 func (*StopRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{123}
+	return file_lightning_proto_rawDescGZIP(), []int{147}
 }
 
 type StopResponse struct {
@@ -11681,7 +13234,7 @@ type StopResponse struct {
 
 func (x *StopResponse) Reset() {
 	*x = StopResponse{}
-	mi := &file_lightning_proto_msgTypes[124]
+	mi := &file_lightning_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11693,7 +13246,7 @@ func (x *StopResponse) String() string {
 func (*StopResponse) ProtoMessage() {}
 
 func (x *StopResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[124]
+	mi := &file_lightning_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11706,7 +13259,7 @@ func (x *StopResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
 func (*StopResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{124}
+	return file_lightning_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *StopResponse) GetStatus() string {
@@ -11724,7 +13277,7 @@ type GraphTopologySubscription struct {
 
 func (x *GraphTopologySubscription) Reset() {
 	*x = GraphTopologySubscription{}
-	mi := &file_lightning_proto_msgTypes[125]
+	mi := &file_lightning_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11736,7 +13289,7 @@ func (x *GraphTopologySubscription) String() string {
 func (*GraphTopologySubscription) ProtoMessage() {}
 
 func (x *GraphTopologySubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[125]
+	mi := &file_lightning_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11749,7 +13302,7 @@ func (x *GraphTopologySubscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphTopologySubscription.ProtoReflect.Descriptor instead.
 func (*GraphTopologySubscription) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{125}
+	return file_lightning_proto_rawDescGZIP(), []int{149}
 }
 
 type GraphTopologyUpdate struct {
@@ -11763,7 +13316,7 @@ type GraphTopologyUpdate struct {
 
 func (x *GraphTopologyUpdate) Reset() {
 	*x = GraphTopologyUpdate{}
-	mi := &file_lightning_proto_msgTypes[126]
+	mi := &file_lightning_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11775,7 +13328,7 @@ func (x *GraphTopologyUpdate) String() string {
 func (*GraphTopologyUpdate) ProtoMessage() {}
 
 func (x *GraphTopologyUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[126]
+	mi := &file_lightning_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11788,7 +13341,7 @@ func (x *GraphTopologyUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphTopologyUpdate.ProtoReflect.Descriptor instead.
 func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{126}
+	return file_lightning_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *GraphTopologyUpdate) GetNodeUpdates() []*NodeUpdate {
@@ -11835,7 +13388,7 @@ type NodeUpdate struct {
 
 func (x *NodeUpdate) Reset() {
 	*x = NodeUpdate{}
-	mi := &file_lightning_proto_msgTypes[127]
+	mi := &file_lightning_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11847,7 +13400,7 @@ func (x *NodeUpdate) String() string {
 func (*NodeUpdate) ProtoMessage() {}
 
 func (x *NodeUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[127]
+	mi := &file_lightning_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11860,7 +13413,7 @@ func (x *NodeUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeUpdate.ProtoReflect.Descriptor instead.
 func (*NodeUpdate) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{127}
+	return file_lightning_proto_rawDescGZIP(), []int{151}
 }
 
 // Deprecated: Marked as deprecated in lightning.proto.
@@ -11931,7 +13484,7 @@ type ChannelEdgeUpdate struct {
 
 func (x *ChannelEdgeUpdate) Reset() {
 	*x = ChannelEdgeUpdate{}
-	mi := &file_lightning_proto_msgTypes[128]
+	mi := &file_lightning_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11943,7 +13496,7 @@ func (x *ChannelEdgeUpdate) String() string {
 func (*ChannelEdgeUpdate) ProtoMessage() {}
 
 func (x *ChannelEdgeUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[128]
+	mi := &file_lightning_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11956,7 +13509,7 @@ func (x *ChannelEdgeUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelEdgeUpdate.ProtoReflect.Descriptor instead.
 func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{128}
+	return file_lightning_proto_rawDescGZIP(), []int{152}
 }
 
 func (x *ChannelEdgeUpdate) GetChanId() uint64 {
@@ -12016,7 +13569,7 @@ type ClosedChannelUpdate struct {
 
 func (x *ClosedChannelUpdate) Reset() {
 	*x = ClosedChannelUpdate{}
-	mi := &file_lightning_proto_msgTypes[129]
+	mi := &file_lightning_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12028,7 +13581,7 @@ func (x *ClosedChannelUpdate) String() string {
 func (*ClosedChannelUpdate) ProtoMessage() {}
 
 func (x *ClosedChannelUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[129]
+	mi := &file_lightning_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12041,7 +13594,7 @@ func (x *ClosedChannelUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClosedChannelUpdate.ProtoReflect.Descriptor instead.
 func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{129}
+	return file_lightning_proto_rawDescGZIP(), []int{153}
 }
 
 func (x *ClosedChannelUpdate) GetChanId() uint64 {
@@ -12091,7 +13644,7 @@ type HopHint struct {
 
 func (x *HopHint) Reset() {
 	*x = HopHint{}
-	mi := &file_lightning_proto_msgTypes[130]
+	mi := &file_lightning_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12103,7 +13656,7 @@ func (x *HopHint) String() string {
 func (*HopHint) ProtoMessage() {}
 
 func (x *HopHint) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[130]
+	mi := &file_lightning_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12116,7 +13669,7 @@ func (x *HopHint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HopHint.ProtoReflect.Descriptor instead.
 func (*HopHint) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{130}
+	return file_lightning_proto_rawDescGZIP(), []int{154}
 }
 
 func (x *HopHint) GetNodeId() string {
@@ -12163,7 +13716,7 @@ type SetID struct {
 
 func (x *SetID) Reset() {
 	*x = SetID{}
-	mi := &file_lightning_proto_msgTypes[131]
+	mi := &file_lightning_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12175,7 +13728,7 @@ func (x *SetID) String() string {
 func (*SetID) ProtoMessage() {}
 
 func (x *SetID) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[131]
+	mi := &file_lightning_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12188,7 +13741,7 @@ func (x *SetID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetID.ProtoReflect.Descriptor instead.
 func (*SetID) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{131}
+	return file_lightning_proto_rawDescGZIP(), []int{155}
 }
 
 func (x *SetID) GetSetId() []byte {
@@ -12209,7 +13762,7 @@ type RouteHint struct {
 
 func (x *RouteHint) Reset() {
 	*x = RouteHint{}
-	mi := &file_lightning_proto_msgTypes[132]
+	mi := &file_lightning_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12221,7 +13774,7 @@ func (x *RouteHint) String() string {
 func (*RouteHint) ProtoMessage() {}
 
 func (x *RouteHint) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[132]
+	mi := &file_lightning_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12234,7 +13787,7 @@ func (x *RouteHint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteHint.ProtoReflect.Descriptor instead.
 func (*RouteHint) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{132}
+	return file_lightning_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *RouteHint) GetHopHints() []*HopHint {
@@ -12270,7 +13823,7 @@ type BlindedPaymentPath struct {
 
 func (x *BlindedPaymentPath) Reset() {
 	*x = BlindedPaymentPath{}
-	mi := &file_lightning_proto_msgTypes[133]
+	mi := &file_lightning_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12282,7 +13835,7 @@ func (x *BlindedPaymentPath) String() string {
 func (*BlindedPaymentPath) ProtoMessage() {}
 
 func (x *BlindedPaymentPath) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[133]
+	mi := &file_lightning_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12295,7 +13848,7 @@ func (x *BlindedPaymentPath) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlindedPaymentPath.ProtoReflect.Descriptor instead.
 func (*BlindedPaymentPath) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{133}
+	return file_lightning_proto_rawDescGZIP(), []int{157}
 }
 
 func (x *BlindedPaymentPath) GetBlindedPath() *BlindedPath {
@@ -12363,7 +13916,7 @@ type BlindedPath struct {
 
 func (x *BlindedPath) Reset() {
 	*x = BlindedPath{}
-	mi := &file_lightning_proto_msgTypes[134]
+	mi := &file_lightning_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12375,7 +13928,7 @@ func (x *BlindedPath) String() string {
 func (*BlindedPath) ProtoMessage() {}
 
 func (x *BlindedPath) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[134]
+	mi := &file_lightning_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12388,7 +13941,7 @@ func (x *BlindedPath) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlindedPath.ProtoReflect.Descriptor instead.
 func (*BlindedPath) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{134}
+	return file_lightning_proto_rawDescGZIP(), []int{158}
 }
 
 func (x *BlindedPath) GetIntroductionNode() []byte {
@@ -12424,7 +13977,7 @@ type BlindedHop struct {
 
 func (x *BlindedHop) Reset() {
 	*x = BlindedHop{}
-	mi := &file_lightning_proto_msgTypes[135]
+	mi := &file_lightning_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12436,7 +13989,7 @@ func (x *BlindedHop) String() string {
 func (*BlindedHop) ProtoMessage() {}
 
 func (x *BlindedHop) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[135]
+	mi := &file_lightning_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12449,7 +14002,7 @@ func (x *BlindedHop) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlindedHop.ProtoReflect.Descriptor instead.
 func (*BlindedHop) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{135}
+	return file_lightning_proto_rawDescGZIP(), []int{159}
 }
 
 func (x *BlindedHop) GetBlindedNode() []byte {
@@ -12482,7 +14035,7 @@ type AMPInvoiceState struct {
 
 func (x *AMPInvoiceState) Reset() {
 	*x = AMPInvoiceState{}
-	mi := &file_lightning_proto_msgTypes[136]
+	mi := &file_lightning_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12494,7 +14047,7 @@ func (x *AMPInvoiceState) String() string {
 func (*AMPInvoiceState) ProtoMessage() {}
 
 func (x *AMPInvoiceState) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[136]
+	mi := &file_lightning_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12507,7 +14060,7 @@ func (x *AMPInvoiceState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AMPInvoiceState.ProtoReflect.Descriptor instead.
 func (*AMPInvoiceState) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{136}
+	return file_lightning_proto_rawDescGZIP(), []int{160}
 }
 
 func (x *AMPInvoiceState) GetState() InvoiceHTLCState {
@@ -12666,13 +14219,18 @@ type Invoice struct {
 	// can be used to override the defaults config values provided in by the
 	// global config. This field is only used if is_blinded is true.
 	BlindedPathConfig *BlindedPathConfig `protobuf:"bytes,30,opt,name=blinded_path_config,json=blindedPathConfig,proto3" json:"blinded_path_config,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// The hold policy of a hold invoice, which controls for how long the
+	// accepted HTLCs of the invoice may be held before they are canceled or
+	// settled automatically.
+	// Note: Output only, don't specify for creating an invoice.
+	HoldPolicy    *InvoiceHoldPolicy `protobuf:"bytes,31,opt,name=hold_policy,json=holdPolicy,proto3" json:"hold_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Invoice) Reset() {
 	*x = Invoice{}
-	mi := &file_lightning_proto_msgTypes[137]
+	mi := &file_lightning_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12684,7 +14242,7 @@ func (x *Invoice) String() string {
 func (*Invoice) ProtoMessage() {}
 
 func (x *Invoice) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[137]
+	mi := &file_lightning_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12697,7 +14255,7 @@ func (x *Invoice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
 func (*Invoice) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{137}
+	return file_lightning_proto_rawDescGZIP(), []int{161}
 }
 
 func (x *Invoice) GetMemo() string {
@@ -12905,6 +14463,81 @@ func (x *Invoice) GetBlindedPathConfig() *BlindedPathConfig {
 	return nil
 }
 
+func (x *Invoice) GetHoldPolicy() *InvoiceHoldPolicy {
+	if x != nil {
+		return x.HoldPolicy
+	}
+	return nil
+}
+
+type InvoiceHoldPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of seconds the HTLCs of the invoice may be held after
+	// the first one was accepted. If this time is exceeded, the invoice is
+	// canceled. Zero means that there is no limit.
+	MaxHoldSeconds uint64 `protobuf:"varint,1,opt,name=max_hold_seconds,json=maxHoldSeconds,proto3" json:"max_hold_seconds,omitempty"`
+	// The minimum number of blocks that need to be left before the HTLCs of the
+	// invoice expire. If fewer blocks are left, the invoice is canceled. Zero
+	// means that the default of the node is used.
+	MinCltvSlack uint32 `protobuf:"varint,2,opt,name=min_cltv_slack,json=minCltvSlack,proto3" json:"min_cltv_slack,omitempty"`
+	// Whether the invoice is settled automatically as soon as its preimage
+	// becomes known to the node.
+	AutoSettle    bool `protobuf:"varint,3,opt,name=auto_settle,json=autoSettle,proto3" json:"auto_settle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoiceHoldPolicy) Reset() {
+	*x = InvoiceHoldPolicy{}
+	mi := &file_lightning_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceHoldPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceHoldPolicy) ProtoMessage() {}
+
+func (x *InvoiceHoldPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceHoldPolicy.ProtoReflect.Descriptor instead.
+func (*InvoiceHoldPolicy) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *InvoiceHoldPolicy) GetMaxHoldSeconds() uint64 {
+	if x != nil {
+		return x.MaxHoldSeconds
+	}
+	return 0
+}
+
+func (x *InvoiceHoldPolicy) GetMinCltvSlack() uint32 {
+	if x != nil {
+		return x.MinCltvSlack
+	}
+	return 0
+}
+
+func (x *InvoiceHoldPolicy) GetAutoSettle() bool {
+	if x != nil {
+		return x.AutoSettle
+	}
+	return false
+}
+
 type BlindedPathConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The minimum number of real hops to include in a blinded path. This doesn't
@@ -12925,13 +14558,25 @@ type BlindedPathConfig struct {
 	// The chained channels list specified via channel id (separated by commas),
 	// starting from a channel owned by the receiver node.
 	IncomingChannelList []uint64 `protobuf:"varint,5,rep,packed,name=incoming_channel_list,json=incomingChannelList,proto3" json:"incoming_channel_list,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// If set, the blinded paths use distinct introduction nodes where possible,
+	// so that a single unreliable introduction node can't render all paths of the
+	// invoice unusable. Paths are ranked by their success probability and the
+	// liquidity of their channels.
+	DiverseIntroNodes bool `protobuf:"varint,6,opt,name=diverse_intro_nodes,json=diverseIntroNodes,proto3" json:"diverse_intro_nodes,omitempty"`
+	// If set, the blinded paths of the invoice are rebuilt in the background
+	// when one of the channels they use is closed, while the invoice is still
+	// open. The payment request of the invoice is replaced with a reissued one
+	// that keeps the payment hash, amount and expiry of the original. The new
+	// payment request can be obtained with LookupInvoice or ListInvoices. The
+	// refresh is not persisted across restarts.
+	RefreshOnChannelClose bool `protobuf:"varint,7,opt,name=refresh_on_channel_close,json=refreshOnChannelClose,proto3" json:"refresh_on_channel_close,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *BlindedPathConfig) Reset() {
 	*x = BlindedPathConfig{}
-	mi := &file_lightning_proto_msgTypes[138]
+	mi := &file_lightning_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12943,7 +14588,7 @@ func (x *BlindedPathConfig) String() string {
 func (*BlindedPathConfig) ProtoMessage() {}
 
 func (x *BlindedPathConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[138]
+	mi := &file_lightning_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12956,7 +14601,7 @@ func (x *BlindedPathConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlindedPathConfig.ProtoReflect.Descriptor instead.
 func (*BlindedPathConfig) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{138}
+	return file_lightning_proto_rawDescGZIP(), []int{163}
 }
 
 func (x *BlindedPathConfig) GetMinNumRealHops() uint32 {
@@ -12994,6 +14639,20 @@ func (x *BlindedPathConfig) GetIncomingChannelList() []uint64 {
 	return nil
 }
 
+func (x *BlindedPathConfig) GetDiverseIntroNodes() bool {
+	if x != nil {
+		return x.DiverseIntroNodes
+	}
+	return false
+}
+
+func (x *BlindedPathConfig) GetRefreshOnChannelClose() bool {
+	if x != nil {
+		return x.RefreshOnChannelClose
+	}
+	return false
+}
+
 // Details of an HTLC that paid to an invoice
 type InvoiceHTLC struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -13027,7 +14686,7 @@ type InvoiceHTLC struct {
 
 func (x *InvoiceHTLC) Reset() {
 	*x = InvoiceHTLC{}
-	mi := &file_lightning_proto_msgTypes[139]
+	mi := &file_lightning_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13039,7 +14698,7 @@ func (x *InvoiceHTLC) String() string {
 func (*InvoiceHTLC) ProtoMessage() {}
 
 func (x *InvoiceHTLC) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[139]
+	mi := &file_lightning_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13052,7 +14711,7 @@ func (x *InvoiceHTLC) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvoiceHTLC.ProtoReflect.Descriptor instead.
 func (*InvoiceHTLC) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{139}
+	return file_lightning_proto_rawDescGZIP(), []int{164}
 }
 
 func (x *InvoiceHTLC) GetChanId() uint64 {
@@ -13162,7 +14821,7 @@ type AMP struct {
 
 func (x *AMP) Reset() {
 	*x = AMP{}
-	mi := &file_lightning_proto_msgTypes[140]
+	mi := &file_lightning_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13174,7 +14833,7 @@ func (x *AMP) String() string {
 func (*AMP) ProtoMessage() {}
 
 func (x *AMP) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[140]
+	mi := &file_lightning_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13187,7 +14846,7 @@ func (x *AMP) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AMP.ProtoReflect.Descriptor instead.
 func (*AMP) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{140}
+	return file_lightning_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *AMP) GetRootShare() []byte {
@@ -13247,7 +14906,7 @@ type AddInvoiceResponse struct {
 
 func (x *AddInvoiceResponse) Reset() {
 	*x = AddInvoiceResponse{}
-	mi := &file_lightning_proto_msgTypes[141]
+	mi := &file_lightning_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13259,7 +14918,7 @@ func (x *AddInvoiceResponse) String() string {
 func (*AddInvoiceResponse) ProtoMessage() {}
 
 func (x *AddInvoiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[141]
+	mi := &file_lightning_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13272,7 +14931,7 @@ func (x *AddInvoiceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddInvoiceResponse.ProtoReflect.Descriptor instead.
 func (*AddInvoiceResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{141}
+	return file_lightning_proto_rawDescGZIP(), []int{166}
 }
 
 func (x *AddInvoiceResponse) GetRHash() []byte {
@@ -13321,7 +14980,7 @@ type PaymentHash struct {
 
 func (x *PaymentHash) Reset() {
 	*x = PaymentHash{}
-	mi := &file_lightning_proto_msgTypes[142]
+	mi := &file_lightning_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13333,7 +14992,7 @@ func (x *PaymentHash) String() string {
 func (*PaymentHash) ProtoMessage() {}
 
 func (x *PaymentHash) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[142]
+	mi := &file_lightning_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13346,7 +15005,7 @@ func (x *PaymentHash) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentHash.ProtoReflect.Descriptor instead.
 func (*PaymentHash) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{142}
+	return file_lightning_proto_rawDescGZIP(), []int{167}
 }
 
 // Deprecated: Marked as deprecated in lightning.proto.
@@ -13389,7 +15048,7 @@ type ListInvoiceRequest struct {
 
 func (x *ListInvoiceRequest) Reset() {
 	*x = ListInvoiceRequest{}
-	mi := &file_lightning_proto_msgTypes[143]
+	mi := &file_lightning_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13401,7 +15060,7 @@ func (x *ListInvoiceRequest) String() string {
 func (*ListInvoiceRequest) ProtoMessage() {}
 
 func (x *ListInvoiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[143]
+	mi := &file_lightning_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13414,7 +15073,7 @@ func (x *ListInvoiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInvoiceRequest.ProtoReflect.Descriptor instead.
 func (*ListInvoiceRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{143}
+	return file_lightning_proto_rawDescGZIP(), []int{168}
 }
 
 func (x *ListInvoiceRequest) GetPendingOnly() bool {
@@ -13476,7 +15135,7 @@ type ListInvoiceResponse struct {
 
 func (x *ListInvoiceResponse) Reset() {
 	*x = ListInvoiceResponse{}
-	mi := &file_lightning_proto_msgTypes[144]
+	mi := &file_lightning_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13488,7 +15147,7 @@ func (x *ListInvoiceResponse) String() string {
 func (*ListInvoiceResponse) ProtoMessage() {}
 
 func (x *ListInvoiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[144]
+	mi := &file_lightning_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13501,7 +15160,7 @@ func (x *ListInvoiceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInvoiceResponse.ProtoReflect.Descriptor instead.
 func (*ListInvoiceResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{144}
+	return file_lightning_proto_rawDescGZIP(), []int{169}
 }
 
 func (x *ListInvoiceResponse) GetInvoices() []*Invoice {
@@ -13543,7 +15202,7 @@ type InvoiceSubscription struct {
 
 func (x *InvoiceSubscription) Reset() {
 	*x = InvoiceSubscription{}
-	mi := &file_lightning_proto_msgTypes[145]
+	mi := &file_lightning_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13555,7 +15214,7 @@ func (x *InvoiceSubscription) String() string {
 func (*InvoiceSubscription) ProtoMessage() {}
 
 func (x *InvoiceSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[145]
+	mi := &file_lightning_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13568,7 +15227,7 @@ func (x *InvoiceSubscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvoiceSubscription.ProtoReflect.Descriptor instead.
 func (*InvoiceSubscription) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{145}
+	return file_lightning_proto_rawDescGZIP(), []int{170}
 }
 
 func (x *InvoiceSubscription) GetAddIndex() uint64 {
@@ -13595,7 +15254,7 @@ type DelCanceledInvoiceReq struct {
 
 func (x *DelCanceledInvoiceReq) Reset() {
 	*x = DelCanceledInvoiceReq{}
-	mi := &file_lightning_proto_msgTypes[146]
+	mi := &file_lightning_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13607,7 +15266,7 @@ func (x *DelCanceledInvoiceReq) String() string {
 func (*DelCanceledInvoiceReq) ProtoMessage() {}
 
 func (x *DelCanceledInvoiceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[146]
+	mi := &file_lightning_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13620,7 +15279,7 @@ func (x *DelCanceledInvoiceReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelCanceledInvoiceReq.ProtoReflect.Descriptor instead.
 func (*DelCanceledInvoiceReq) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{146}
+	return file_lightning_proto_rawDescGZIP(), []int{171}
 }
 
 func (x *DelCanceledInvoiceReq) GetInvoiceHash() string {
@@ -13640,7 +15299,7 @@ type DelCanceledInvoiceResp struct {
 
 func (x *DelCanceledInvoiceResp) Reset() {
 	*x = DelCanceledInvoiceResp{}
-	mi := &file_lightning_proto_msgTypes[147]
+	mi := &file_lightning_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13652,7 +15311,7 @@ func (x *DelCanceledInvoiceResp) String() string {
 func (*DelCanceledInvoiceResp) ProtoMessage() {}
 
 func (x *DelCanceledInvoiceResp) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[147]
+	mi := &file_lightning_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13665,7 +15324,7 @@ func (x *DelCanceledInvoiceResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelCanceledInvoiceResp.ProtoReflect.Descriptor instead.
 func (*DelCanceledInvoiceResp) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{147}
+	return file_lightning_proto_rawDescGZIP(), []int{172}
 }
 
 func (x *DelCanceledInvoiceResp) GetStatus() string {
@@ -13717,13 +15376,16 @@ type Payment struct {
 	// The custom TLV records that were sent to the first hop as part of the HTLC
 	// wire message for this payment.
 	FirstHopCustomRecords map[uint64][]byte `protobuf:"bytes,17,rep,name=first_hop_custom_records,json=firstHopCustomRecords,proto3" json:"first_hop_custom_records,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// The receipt returned by the receiver of a keysend or AMP payment that
+	// requested one. It is only set once the receipt was received and verified.
+	Receipt       *PaymentReceipt `protobuf:"bytes,18,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Payment) Reset() {
 	*x = Payment{}
-	mi := &file_lightning_proto_msgTypes[148]
+	mi := &file_lightning_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13735,7 +15397,7 @@ func (x *Payment) String() string {
 func (*Payment) ProtoMessage() {}
 
 func (x *Payment) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[148]
+	mi := &file_lightning_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13748,7 +15410,7 @@ func (x *Payment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Payment.ProtoReflect.Descriptor instead.
 func (*Payment) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{148}
+	return file_lightning_proto_rawDescGZIP(), []int{173}
 }
 
 func (x *Payment) GetPaymentHash() string {
@@ -13866,6 +15528,69 @@ func (x *Payment) GetFirstHopCustomRecords() map[uint64][]byte {
 	return nil
 }
 
+func (x *Payment) GetReceipt() *PaymentReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+type PaymentReceipt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pubkey-recoverable signature of the receiver over the sha256 of the
+	// tag "lnd-payment-receipt", the payment identifier and the serialized
+	// custom records of the final hop of the payment.
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	// The time in UNIX nanoseconds at which the receipt was received.
+	ReceivedTimeNs int64 `protobuf:"varint,2,opt,name=received_time_ns,json=receivedTimeNs,proto3" json:"received_time_ns,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PaymentReceipt) Reset() {
+	*x = PaymentReceipt{}
+	mi := &file_lightning_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentReceipt) ProtoMessage() {}
+
+func (x *PaymentReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentReceipt.ProtoReflect.Descriptor instead.
+func (*PaymentReceipt) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *PaymentReceipt) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *PaymentReceipt) GetReceivedTimeNs() int64 {
+	if x != nil {
+		return x.ReceivedTimeNs
+	}
+	return 0
+}
+
 type HTLCAttempt struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The unique ID that is used for this attempt.
@@ -13889,7 +15614,7 @@ type HTLCAttempt struct {
 
 func (x *HTLCAttempt) Reset() {
 	*x = HTLCAttempt{}
-	mi := &file_lightning_proto_msgTypes[149]
+	mi := &file_lightning_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13901,7 +15626,7 @@ func (x *HTLCAttempt) String() string {
 func (*HTLCAttempt) ProtoMessage() {}
 
 func (x *HTLCAttempt) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[149]
+	mi := &file_lightning_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13914,7 +15639,7 @@ func (x *HTLCAttempt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTLCAttempt.ProtoReflect.Descriptor instead.
 func (*HTLCAttempt) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{149}
+	return file_lightning_proto_rawDescGZIP(), []int{175}
 }
 
 func (x *HTLCAttempt) GetAttemptId() uint64 {
@@ -14005,7 +15730,7 @@ type ListPaymentsRequest struct {
 
 func (x *ListPaymentsRequest) Reset() {
 	*x = ListPaymentsRequest{}
-	mi := &file_lightning_proto_msgTypes[150]
+	mi := &file_lightning_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14017,7 +15742,7 @@ func (x *ListPaymentsRequest) String() string {
 func (*ListPaymentsRequest) ProtoMessage() {}
 
 func (x *ListPaymentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[150]
+	mi := &file_lightning_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14030,7 +15755,7 @@ func (x *ListPaymentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPaymentsRequest.ProtoReflect.Descriptor instead.
 func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{150}
+	return file_lightning_proto_rawDescGZIP(), []int{176}
 }
 
 func (x *ListPaymentsRequest) GetIncludeIncomplete() bool {
@@ -14110,7 +15835,7 @@ type ListPaymentsResponse struct {
 
 func (x *ListPaymentsResponse) Reset() {
 	*x = ListPaymentsResponse{}
-	mi := &file_lightning_proto_msgTypes[151]
+	mi := &file_lightning_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14122,7 +15847,7 @@ func (x *ListPaymentsResponse) String() string {
 func (*ListPaymentsResponse) ProtoMessage() {}
 
 func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[151]
+	mi := &file_lightning_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14135,7 +15860,7 @@ func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPaymentsResponse.ProtoReflect.Descriptor instead.
 func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{151}
+	return file_lightning_proto_rawDescGZIP(), []int{177}
 }
 
 func (x *ListPaymentsResponse) GetPayments() []*Payment {
@@ -14178,7 +15903,7 @@ type DeletePaymentRequest struct {
 
 func (x *DeletePaymentRequest) Reset() {
 	*x = DeletePaymentRequest{}
-	mi := &file_lightning_proto_msgTypes[152]
+	mi := &file_lightning_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14190,7 +15915,7 @@ func (x *DeletePaymentRequest) String() string {
 func (*DeletePaymentRequest) ProtoMessage() {}
 
 func (x *DeletePaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[152]
+	mi := &file_lightning_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14203,7 +15928,7 @@ func (x *DeletePaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePaymentRequest.ProtoReflect.Descriptor instead.
 func (*DeletePaymentRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{152}
+	return file_lightning_proto_rawDescGZIP(), []int{178}
 }
 
 func (x *DeletePaymentRequest) GetPaymentHash() []byte {
@@ -14235,7 +15960,7 @@ type DeleteAllPaymentsRequest struct {
 
 func (x *DeleteAllPaymentsRequest) Reset() {
 	*x = DeleteAllPaymentsRequest{}
-	mi := &file_lightning_proto_msgTypes[153]
+	mi := &file_lightning_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14247,7 +15972,7 @@ func (x *DeleteAllPaymentsRequest) String() string {
 func (*DeleteAllPaymentsRequest) ProtoMessage() {}
 
 func (x *DeleteAllPaymentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[153]
+	mi := &file_lightning_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14260,7 +15985,7 @@ func (x *DeleteAllPaymentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAllPaymentsRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{153}
+	return file_lightning_proto_rawDescGZIP(), []int{179}
 }
 
 func (x *DeleteAllPaymentsRequest) GetFailedPaymentsOnly() bool {
@@ -14294,7 +16019,7 @@ type DeletePaymentResponse struct {
 
 func (x *DeletePaymentResponse) Reset() {
 	*x = DeletePaymentResponse{}
-	mi := &file_lightning_proto_msgTypes[154]
+	mi := &file_lightning_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14306,7 +16031,7 @@ func (x *DeletePaymentResponse) String() string {
 func (*DeletePaymentResponse) ProtoMessage() {}
 
 func (x *DeletePaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[154]
+	mi := &file_lightning_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14319,7 +16044,7 @@ func (x *DeletePaymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePaymentResponse.ProtoReflect.Descriptor instead.
 func (*DeletePaymentResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{154}
+	return file_lightning_proto_rawDescGZIP(), []int{180}
 }
 
 func (x *DeletePaymentResponse) GetStatus() string {
@@ -14339,7 +16064,7 @@ type DeleteAllPaymentsResponse struct {
 
 func (x *DeleteAllPaymentsResponse) Reset() {
 	*x = DeleteAllPaymentsResponse{}
-	mi := &file_lightning_proto_msgTypes[155]
+	mi := &file_lightning_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14351,7 +16076,7 @@ func (x *DeleteAllPaymentsResponse) String() string {
 func (*DeleteAllPaymentsResponse) ProtoMessage() {}
 
 func (x *DeleteAllPaymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[155]
+	mi := &file_lightning_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14364,7 +16089,7 @@ func (x *DeleteAllPaymentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAllPaymentsResponse.ProtoReflect.Descriptor instead.
 func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{155}
+	return file_lightning_proto_rawDescGZIP(), []int{181}
 }
 
 func (x *DeleteAllPaymentsResponse) GetStatus() string {
@@ -14388,7 +16113,7 @@ type AbandonChannelRequest struct {
 
 func (x *AbandonChannelRequest) Reset() {
 	*x = AbandonChannelRequest{}
-	mi := &file_lightning_proto_msgTypes[156]
+	mi := &file_lightning_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14400,7 +16125,7 @@ func (x *AbandonChannelRequest) String() string {
 func (*AbandonChannelRequest) ProtoMessage() {}
 
 func (x *AbandonChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[156]
+	mi := &file_lightning_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14413,7 +16138,7 @@ func (x *AbandonChannelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbandonChannelRequest.ProtoReflect.Descriptor instead.
 func (*AbandonChannelRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{156}
+	return file_lightning_proto_rawDescGZIP(), []int{182}
 }
 
 func (x *AbandonChannelRequest) GetChannelPoint() *ChannelPoint {
@@ -14447,7 +16172,7 @@ type AbandonChannelResponse struct {
 
 func (x *AbandonChannelResponse) Reset() {
 	*x = AbandonChannelResponse{}
-	mi := &file_lightning_proto_msgTypes[157]
+	mi := &file_lightning_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14459,7 +16184,7 @@ func (x *AbandonChannelResponse) String() string {
 func (*AbandonChannelResponse) ProtoMessage() {}
 
 func (x *AbandonChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[157]
+	mi := &file_lightning_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14472,7 +16197,7 @@ func (x *AbandonChannelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbandonChannelResponse.ProtoReflect.Descriptor instead.
 func (*AbandonChannelResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{157}
+	return file_lightning_proto_rawDescGZIP(), []int{183}
 }
 
 func (x *AbandonChannelResponse) GetStatus() string {
@@ -14492,7 +16217,7 @@ type DebugLevelRequest struct {
 
 func (x *DebugLevelRequest) Reset() {
 	*x = DebugLevelRequest{}
-	mi := &file_lightning_proto_msgTypes[158]
+	mi := &file_lightning_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14504,7 +16229,7 @@ func (x *DebugLevelRequest) String() string {
 func (*DebugLevelRequest) ProtoMessage() {}
 
 func (x *DebugLevelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[158]
+	mi := &file_lightning_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14517,7 +16242,7 @@ func (x *DebugLevelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebugLevelRequest.ProtoReflect.Descriptor instead.
 func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{158}
+	return file_lightning_proto_rawDescGZIP(), []int{184}
 }
 
 func (x *DebugLevelRequest) GetShow() bool {
@@ -14543,7 +16268,7 @@ type DebugLevelResponse struct {
 
 func (x *DebugLevelResponse) Reset() {
 	*x = DebugLevelResponse{}
-	mi := &file_lightning_proto_msgTypes[159]
+	mi := &file_lightning_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14555,7 +16280,7 @@ func (x *DebugLevelResponse) String() string {
 func (*DebugLevelResponse) ProtoMessage() {}
 
 func (x *DebugLevelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[159]
+	mi := &file_lightning_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14568,7 +16293,7 @@ func (x *DebugLevelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebugLevelResponse.ProtoReflect.Descriptor instead.
 func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{159}
+	return file_lightning_proto_rawDescGZIP(), []int{185}
 }
 
 func (x *DebugLevelResponse) GetSubSystems() string {
@@ -14588,7 +16313,7 @@ type PayReqString struct {
 
 func (x *PayReqString) Reset() {
 	*x = PayReqString{}
-	mi := &file_lightning_proto_msgTypes[160]
+	mi := &file_lightning_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14600,7 +16325,7 @@ func (x *PayReqString) String() string {
 func (*PayReqString) ProtoMessage() {}
 
 func (x *PayReqString) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[160]
+	mi := &file_lightning_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14613,7 +16338,7 @@ func (x *PayReqString) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayReqString.ProtoReflect.Descriptor instead.
 func (*PayReqString) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{160}
+	return file_lightning_proto_rawDescGZIP(), []int{186}
 }
 
 func (x *PayReqString) GetPayReq() string {
@@ -14645,7 +16370,7 @@ type PayReq struct {
 
 func (x *PayReq) Reset() {
 	*x = PayReq{}
-	mi := &file_lightning_proto_msgTypes[161]
+	mi := &file_lightning_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14657,7 +16382,7 @@ func (x *PayReq) String() string {
 func (*PayReq) ProtoMessage() {}
 
 func (x *PayReq) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[161]
+	mi := &file_lightning_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14670,7 +16395,7 @@ func (x *PayReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayReq.ProtoReflect.Descriptor instead.
 func (*PayReq) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{161}
+	return file_lightning_proto_rawDescGZIP(), []int{187}
 }
 
 func (x *PayReq) GetDestination() string {
@@ -14782,7 +16507,7 @@ type Feature struct {
 
 func (x *Feature) Reset() {
 	*x = Feature{}
-	mi := &file_lightning_proto_msgTypes[162]
+	mi := &file_lightning_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14794,7 +16519,7 @@ func (x *Feature) String() string {
 func (*Feature) ProtoMessage() {}
 
 func (x *Feature) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[162]
+	mi := &file_lightning_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14807,7 +16532,7 @@ func (x *Feature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Feature.ProtoReflect.Descriptor instead.
 func (*Feature) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{162}
+	return file_lightning_proto_rawDescGZIP(), []int{188}
 }
 
 func (x *Feature) GetName() string {
@@ -14839,7 +16564,7 @@ type FeeReportRequest struct {
 
 func (x *FeeReportRequest) Reset() {
 	*x = FeeReportRequest{}
-	mi := &file_lightning_proto_msgTypes[163]
+	mi := &file_lightning_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14851,7 +16576,7 @@ func (x *FeeReportRequest) String() string {
 func (*FeeReportRequest) ProtoMessage() {}
 
 func (x *FeeReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[163]
+	mi := &file_lightning_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14864,7 +16589,7 @@ func (x *FeeReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeeReportRequest.ProtoReflect.Descriptor instead.
 func (*FeeReportRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{163}
+	return file_lightning_proto_rawDescGZIP(), []int{189}
 }
 
 type ChannelFeeReport struct {
@@ -14892,7 +16617,7 @@ type ChannelFeeReport struct {
 
 func (x *ChannelFeeReport) Reset() {
 	*x = ChannelFeeReport{}
-	mi := &file_lightning_proto_msgTypes[164]
+	mi := &file_lightning_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14904,7 +16629,7 @@ func (x *ChannelFeeReport) String() string {
 func (*ChannelFeeReport) ProtoMessage() {}
 
 func (x *ChannelFeeReport) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[164]
+	mi := &file_lightning_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14917,7 +16642,7 @@ func (x *ChannelFeeReport) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelFeeReport.ProtoReflect.Descriptor instead.
 func (*ChannelFeeReport) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{164}
+	return file_lightning_proto_rawDescGZIP(), []int{190}
 }
 
 func (x *ChannelFeeReport) GetChanId() uint64 {
@@ -14982,14 +16707,26 @@ type FeeReportResponse struct {
 	WeekFeeSum uint64 `protobuf:"varint,3,opt,name=week_fee_sum,json=weekFeeSum,proto3" json:"week_fee_sum,omitempty"`
 	// The total amount of fee revenue (in satoshis) the switch has collected
 	// over the past 1 month.
-	MonthFeeSum   uint64 `protobuf:"varint,4,opt,name=month_fee_sum,json=monthFeeSum,proto3" json:"month_fee_sum,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	MonthFeeSum uint64 `protobuf:"varint,4,opt,name=month_fee_sum,json=monthFeeSum,proto3" json:"month_fee_sum,omitempty"`
+	// The total amount of net upfront fee revenue (in milli-satoshis) the
+	// switch has collected over the past 24 hrs, including the upfront fees
+	// of failed forwards. This is not included in day_fee_sum.
+	DayUpfrontFeeSumMsat uint64 `protobuf:"varint,5,opt,name=day_upfront_fee_sum_msat,json=dayUpfrontFeeSumMsat,proto3" json:"day_upfront_fee_sum_msat,omitempty"`
+	// The total amount of net upfront fee revenue (in milli-satoshis) the
+	// switch has collected over the past 1 week, including the upfront fees
+	// of failed forwards. This is not included in week_fee_sum.
+	WeekUpfrontFeeSumMsat uint64 `protobuf:"varint,6,opt,name=week_upfront_fee_sum_msat,json=weekUpfrontFeeSumMsat,proto3" json:"week_upfront_fee_sum_msat,omitempty"`
+	// The total amount of net upfront fee revenue (in milli-satoshis) the
+	// switch has collected over the past 1 month, including the upfront fees
+	// of failed forwards. This is not included in month_fee_sum.
+	MonthUpfrontFeeSumMsat uint64 `protobuf:"varint,7,opt,name=month_upfront_fee_sum_msat,json=monthUpfrontFeeSumMsat,proto3" json:"month_upfront_fee_sum_msat,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *FeeReportResponse) Reset() {
 	*x = FeeReportResponse{}
-	mi := &file_lightning_proto_msgTypes[165]
+	mi := &file_lightning_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15001,7 +16738,7 @@ func (x *FeeReportResponse) String() string {
 func (*FeeReportResponse) ProtoMessage() {}
 
 func (x *FeeReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[165]
+	mi := &file_lightning_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15014,7 +16751,7 @@ func (x *FeeReportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeeReportResponse.ProtoReflect.Descriptor instead.
 func (*FeeReportResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{165}
+	return file_lightning_proto_rawDescGZIP(), []int{191}
 }
 
 func (x *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
@@ -15045,6 +16782,27 @@ func (x *FeeReportResponse) GetMonthFeeSum() uint64 {
 	return 0
 }
 
+func (x *FeeReportResponse) GetDayUpfrontFeeSumMsat() uint64 {
+	if x != nil {
+		return x.DayUpfrontFeeSumMsat
+	}
+	return 0
+}
+
+func (x *FeeReportResponse) GetWeekUpfrontFeeSumMsat() uint64 {
+	if x != nil {
+		return x.WeekUpfrontFeeSumMsat
+	}
+	return 0
+}
+
+func (x *FeeReportResponse) GetMonthUpfrontFeeSumMsat() uint64 {
+	if x != nil {
+		return x.MonthUpfrontFeeSumMsat
+	}
+	return 0
+}
+
 type InboundFee struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The inbound base fee charged regardless of the number of milli-satoshis
@@ -15059,7 +16817,7 @@ type InboundFee struct {
 
 func (x *InboundFee) Reset() {
 	*x = InboundFee{}
-	mi := &file_lightning_proto_msgTypes[166]
+	mi := &file_lightning_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15071,7 +16829,7 @@ func (x *InboundFee) String() string {
 func (*InboundFee) ProtoMessage() {}
 
 func (x *InboundFee) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[166]
+	mi := &file_lightning_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15084,7 +16842,7 @@ func (x *InboundFee) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InboundFee.ProtoReflect.Descriptor instead.
 func (*InboundFee) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{166}
+	return file_lightning_proto_rawDescGZIP(), []int{192}
 }
 
 func (x *InboundFee) GetBaseFeeMsat() int32 {
@@ -15142,7 +16900,7 @@ type PolicyUpdateRequest struct {
 
 func (x *PolicyUpdateRequest) Reset() {
 	*x = PolicyUpdateRequest{}
-	mi := &file_lightning_proto_msgTypes[167]
+	mi := &file_lightning_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15154,7 +16912,7 @@ func (x *PolicyUpdateRequest) String() string {
 func (*PolicyUpdateRequest) ProtoMessage() {}
 
 func (x *PolicyUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[167]
+	mi := &file_lightning_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15167,7 +16925,7 @@ func (x *PolicyUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyUpdateRequest.ProtoReflect.Descriptor instead.
 func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{167}
+	return file_lightning_proto_rawDescGZIP(), []int{193}
 }
 
 func (x *PolicyUpdateRequest) GetScope() isPolicyUpdateRequest_Scope {
@@ -15290,7 +17048,7 @@ type FailedUpdate struct {
 
 func (x *FailedUpdate) Reset() {
 	*x = FailedUpdate{}
-	mi := &file_lightning_proto_msgTypes[168]
+	mi := &file_lightning_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15302,7 +17060,7 @@ func (x *FailedUpdate) String() string {
 func (*FailedUpdate) ProtoMessage() {}
 
 func (x *FailedUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[168]
+	mi := &file_lightning_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15315,7 +17073,7 @@ func (x *FailedUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FailedUpdate.ProtoReflect.Descriptor instead.
 func (*FailedUpdate) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{168}
+	return file_lightning_proto_rawDescGZIP(), []int{194}
 }
 
 func (x *FailedUpdate) GetOutpoint() *OutPoint {
@@ -15349,7 +17107,7 @@ type PolicyUpdateResponse struct {
 
 func (x *PolicyUpdateResponse) Reset() {
 	*x = PolicyUpdateResponse{}
-	mi := &file_lightning_proto_msgTypes[169]
+	mi := &file_lightning_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15361,7 +17119,7 @@ func (x *PolicyUpdateResponse) String() string {
 func (*PolicyUpdateResponse) ProtoMessage() {}
 
 func (x *PolicyUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[169]
+	mi := &file_lightning_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15374,7 +17132,7 @@ func (x *PolicyUpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyUpdateResponse.ProtoReflect.Descriptor instead.
 func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{169}
+	return file_lightning_proto_rawDescGZIP(), []int{195}
 }
 
 func (x *PolicyUpdateResponse) GetFailedUpdates() []*FailedUpdate {
@@ -15409,13 +17167,15 @@ type ForwardingHistoryRequest struct {
 	// List of outgoing channel ids to filter htlcs being forwarded to a
 	// particular channel
 	OutgoingChanIds []uint64 `protobuf:"varint,7,rep,packed,name=outgoing_chan_ids,json=outgoingChanIds,proto3" json:"outgoing_chan_ids,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// If set, failed forwards that earned upfront fees are returned as well.
+	IncludeFailed bool `protobuf:"varint,8,opt,name=include_failed,json=includeFailed,proto3" json:"include_failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ForwardingHistoryRequest) Reset() {
 	*x = ForwardingHistoryRequest{}
-	mi := &file_lightning_proto_msgTypes[170]
+	mi := &file_lightning_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15427,7 +17187,7 @@ func (x *ForwardingHistoryRequest) String() string {
 func (*ForwardingHistoryRequest) ProtoMessage() {}
 
 func (x *ForwardingHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[170]
+	mi := &file_lightning_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15440,7 +17200,7 @@ func (x *ForwardingHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForwardingHistoryRequest.ProtoReflect.Descriptor instead.
 func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{170}
+	return file_lightning_proto_rawDescGZIP(), []int{196}
 }
 
 func (x *ForwardingHistoryRequest) GetStartTime() uint64 {
@@ -15487,9 +17247,16 @@ func (x *ForwardingHistoryRequest) GetIncomingChanIds() []uint64 {
 
 func (x *ForwardingHistoryRequest) GetOutgoingChanIds() []uint64 {
 	if x != nil {
-		return x.OutgoingChanIds
+		return x.OutgoingChanIds
+	}
+	return nil
+}
+
+func (x *ForwardingHistoryRequest) GetIncludeFailed() bool {
+	if x != nil {
+		return x.IncludeFailed
 	}
-	return nil
+	return false
 }
 
 type ForwardingEvent struct {
@@ -15533,13 +17300,21 @@ type ForwardingEvent struct {
 	// The ID of the outgoing HTLC in the payment circuit. This field is
 	// optional and may be unset for legacy forwarding events.
 	OutgoingHtlcId *uint64 `protobuf:"varint,15,opt,name=outgoing_htlc_id,json=outgoingHtlcId,proto3,oneof" json:"outgoing_htlc_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// The net upfront fee (in milli-satoshis) that this payment circuit
+	// earned, which is the upfront fee received on the incoming HTLC minus
+	// the one paid on the outgoing HTLC. It is earned regardless of whether
+	// the circuit was settled or failed and is not included in fee_msat.
+	UpfrontFeeMsat uint64 `protobuf:"varint,16,opt,name=upfront_fee_msat,json=upfrontFeeMsat,proto3" json:"upfront_fee_msat,omitempty"`
+	// Whether the payment circuit was failed rather than settled. Failed
+	// forwards are only recorded if they earned upfront fees.
+	Failed        bool `protobuf:"varint,17,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ForwardingEvent) Reset() {
 	*x = ForwardingEvent{}
-	mi := &file_lightning_proto_msgTypes[171]
+	mi := &file_lightning_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15551,7 +17326,7 @@ func (x *ForwardingEvent) String() string {
 func (*ForwardingEvent) ProtoMessage() {}
 
 func (x *ForwardingEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[171]
+	mi := &file_lightning_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15564,7 +17339,7 @@ func (x *ForwardingEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForwardingEvent.ProtoReflect.Descriptor instead.
 func (*ForwardingEvent) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{171}
+	return file_lightning_proto_rawDescGZIP(), []int{197}
 }
 
 // Deprecated: Marked as deprecated in lightning.proto.
@@ -15666,6 +17441,20 @@ func (x *ForwardingEvent) GetOutgoingHtlcId() uint64 {
 	return 0
 }
 
+func (x *ForwardingEvent) GetUpfrontFeeMsat() uint64 {
+	if x != nil {
+		return x.UpfrontFeeMsat
+	}
+	return 0
+}
+
+func (x *ForwardingEvent) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
 type ForwardingHistoryResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// A list of forwarding events from the time slice of the time series
@@ -15680,7 +17469,7 @@ type ForwardingHistoryResponse struct {
 
 func (x *ForwardingHistoryResponse) Reset() {
 	*x = ForwardingHistoryResponse{}
-	mi := &file_lightning_proto_msgTypes[172]
+	mi := &file_lightning_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15692,7 +17481,7 @@ func (x *ForwardingHistoryResponse) String() string {
 func (*ForwardingHistoryResponse) ProtoMessage() {}
 
 func (x *ForwardingHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[172]
+	mi := &file_lightning_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15705,7 +17494,7 @@ func (x *ForwardingHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForwardingHistoryResponse.ProtoReflect.Descriptor instead.
 func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{172}
+	return file_lightning_proto_rawDescGZIP(), []int{198}
 }
 
 func (x *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
@@ -15732,7 +17521,7 @@ type ExportChannelBackupRequest struct {
 
 func (x *ExportChannelBackupRequest) Reset() {
 	*x = ExportChannelBackupRequest{}
-	mi := &file_lightning_proto_msgTypes[173]
+	mi := &file_lightning_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15744,7 +17533,7 @@ func (x *ExportChannelBackupRequest) String() string {
 func (*ExportChannelBackupRequest) ProtoMessage() {}
 
 func (x *ExportChannelBackupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[173]
+	mi := &file_lightning_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15757,7 +17546,7 @@ func (x *ExportChannelBackupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportChannelBackupRequest.ProtoReflect.Descriptor instead.
 func (*ExportChannelBackupRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{173}
+	return file_lightning_proto_rawDescGZIP(), []int{199}
 }
 
 func (x *ExportChannelBackupRequest) GetChanPoint() *ChannelPoint {
@@ -15782,7 +17571,7 @@ type ChannelBackup struct {
 
 func (x *ChannelBackup) Reset() {
 	*x = ChannelBackup{}
-	mi := &file_lightning_proto_msgTypes[174]
+	mi := &file_lightning_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15794,7 +17583,7 @@ func (x *ChannelBackup) String() string {
 func (*ChannelBackup) ProtoMessage() {}
 
 func (x *ChannelBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[174]
+	mi := &file_lightning_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15807,7 +17596,7 @@ func (x *ChannelBackup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelBackup.ProtoReflect.Descriptor instead.
 func (*ChannelBackup) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{174}
+	return file_lightning_proto_rawDescGZIP(), []int{200}
 }
 
 func (x *ChannelBackup) GetChanPoint() *ChannelPoint {
@@ -15839,7 +17628,7 @@ type MultiChanBackup struct {
 
 func (x *MultiChanBackup) Reset() {
 	*x = MultiChanBackup{}
-	mi := &file_lightning_proto_msgTypes[175]
+	mi := &file_lightning_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15851,7 +17640,7 @@ func (x *MultiChanBackup) String() string {
 func (*MultiChanBackup) ProtoMessage() {}
 
 func (x *MultiChanBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[175]
+	mi := &file_lightning_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15864,7 +17653,7 @@ func (x *MultiChanBackup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultiChanBackup.ProtoReflect.Descriptor instead.
 func (*MultiChanBackup) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{175}
+	return file_lightning_proto_rawDescGZIP(), []int{201}
 }
 
 func (x *MultiChanBackup) GetChanPoints() []*ChannelPoint {
@@ -15889,7 +17678,7 @@ type ChanBackupExportRequest struct {
 
 func (x *ChanBackupExportRequest) Reset() {
 	*x = ChanBackupExportRequest{}
-	mi := &file_lightning_proto_msgTypes[176]
+	mi := &file_lightning_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15901,7 +17690,7 @@ func (x *ChanBackupExportRequest) String() string {
 func (*ChanBackupExportRequest) ProtoMessage() {}
 
 func (x *ChanBackupExportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[176]
+	mi := &file_lightning_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15914,7 +17703,7 @@ func (x *ChanBackupExportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChanBackupExportRequest.ProtoReflect.Descriptor instead.
 func (*ChanBackupExportRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{176}
+	return file_lightning_proto_rawDescGZIP(), []int{202}
 }
 
 type ChanBackupSnapshot struct {
@@ -15931,7 +17720,7 @@ type ChanBackupSnapshot struct {
 
 func (x *ChanBackupSnapshot) Reset() {
 	*x = ChanBackupSnapshot{}
-	mi := &file_lightning_proto_msgTypes[177]
+	mi := &file_lightning_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15943,7 +17732,7 @@ func (x *ChanBackupSnapshot) String() string {
 func (*ChanBackupSnapshot) ProtoMessage() {}
 
 func (x *ChanBackupSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[177]
+	mi := &file_lightning_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15956,7 +17745,7 @@ func (x *ChanBackupSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChanBackupSnapshot.ProtoReflect.Descriptor instead.
 func (*ChanBackupSnapshot) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{177}
+	return file_lightning_proto_rawDescGZIP(), []int{203}
 }
 
 func (x *ChanBackupSnapshot) GetSingleChanBackups() *ChannelBackups {
@@ -15983,7 +17772,7 @@ type ChannelBackups struct {
 
 func (x *ChannelBackups) Reset() {
 	*x = ChannelBackups{}
-	mi := &file_lightning_proto_msgTypes[178]
+	mi := &file_lightning_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15995,7 +17784,7 @@ func (x *ChannelBackups) String() string {
 func (*ChannelBackups) ProtoMessage() {}
 
 func (x *ChannelBackups) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[178]
+	mi := &file_lightning_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16008,7 +17797,7 @@ func (x *ChannelBackups) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelBackups.ProtoReflect.Descriptor instead.
 func (*ChannelBackups) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{178}
+	return file_lightning_proto_rawDescGZIP(), []int{204}
 }
 
 func (x *ChannelBackups) GetChanBackups() []*ChannelBackup {
@@ -16031,7 +17820,7 @@ type RestoreChanBackupRequest struct {
 
 func (x *RestoreChanBackupRequest) Reset() {
 	*x = RestoreChanBackupRequest{}
-	mi := &file_lightning_proto_msgTypes[179]
+	mi := &file_lightning_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16043,7 +17832,7 @@ func (x *RestoreChanBackupRequest) String() string {
 func (*RestoreChanBackupRequest) ProtoMessage() {}
 
 func (x *RestoreChanBackupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[179]
+	mi := &file_lightning_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16056,7 +17845,7 @@ func (x *RestoreChanBackupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreChanBackupRequest.ProtoReflect.Descriptor instead.
 func (*RestoreChanBackupRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{179}
+	return file_lightning_proto_rawDescGZIP(), []int{205}
 }
 
 func (x *RestoreChanBackupRequest) GetBackup() isRestoreChanBackupRequest_Backup {
@@ -16113,7 +17902,7 @@ type RestoreBackupResponse struct {
 
 func (x *RestoreBackupResponse) Reset() {
 	*x = RestoreBackupResponse{}
-	mi := &file_lightning_proto_msgTypes[180]
+	mi := &file_lightning_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16125,7 +17914,7 @@ func (x *RestoreBackupResponse) String() string {
 func (*RestoreBackupResponse) ProtoMessage() {}
 
 func (x *RestoreBackupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[180]
+	mi := &file_lightning_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16138,7 +17927,7 @@ func (x *RestoreBackupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreBackupResponse.ProtoReflect.Descriptor instead.
 func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{180}
+	return file_lightning_proto_rawDescGZIP(), []int{206}
 }
 
 func (x *RestoreBackupResponse) GetNumRestored() uint32 {
@@ -16156,7 +17945,7 @@ type ChannelBackupSubscription struct {
 
 func (x *ChannelBackupSubscription) Reset() {
 	*x = ChannelBackupSubscription{}
-	mi := &file_lightning_proto_msgTypes[181]
+	mi := &file_lightning_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16168,7 +17957,7 @@ func (x *ChannelBackupSubscription) String() string {
 func (*ChannelBackupSubscription) ProtoMessage() {}
 
 func (x *ChannelBackupSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[181]
+	mi := &file_lightning_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16181,7 +17970,7 @@ func (x *ChannelBackupSubscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelBackupSubscription.ProtoReflect.Descriptor instead.
 func (*ChannelBackupSubscription) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{181}
+	return file_lightning_proto_rawDescGZIP(), []int{207}
 }
 
 type VerifyChanBackupResponse struct {
@@ -16193,7 +17982,7 @@ type VerifyChanBackupResponse struct {
 
 func (x *VerifyChanBackupResponse) Reset() {
 	*x = VerifyChanBackupResponse{}
-	mi := &file_lightning_proto_msgTypes[182]
+	mi := &file_lightning_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16205,7 +17994,7 @@ func (x *VerifyChanBackupResponse) String() string {
 func (*VerifyChanBackupResponse) ProtoMessage() {}
 
 func (x *VerifyChanBackupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[182]
+	mi := &file_lightning_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16218,7 +18007,7 @@ func (x *VerifyChanBackupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyChanBackupResponse.ProtoReflect.Descriptor instead.
 func (*VerifyChanBackupResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{182}
+	return file_lightning_proto_rawDescGZIP(), []int{208}
 }
 
 func (x *VerifyChanBackupResponse) GetChanPoints() []string {
@@ -16240,7 +18029,7 @@ type MacaroonPermission struct {
 
 func (x *MacaroonPermission) Reset() {
 	*x = MacaroonPermission{}
-	mi := &file_lightning_proto_msgTypes[183]
+	mi := &file_lightning_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16252,7 +18041,7 @@ func (x *MacaroonPermission) String() string {
 func (*MacaroonPermission) ProtoMessage() {}
 
 func (x *MacaroonPermission) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[183]
+	mi := &file_lightning_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16265,7 +18054,7 @@ func (x *MacaroonPermission) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MacaroonPermission.ProtoReflect.Descriptor instead.
 func (*MacaroonPermission) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{183}
+	return file_lightning_proto_rawDescGZIP(), []int{209}
 }
 
 func (x *MacaroonPermission) GetEntity() string {
@@ -16297,7 +18086,7 @@ type BakeMacaroonRequest struct {
 
 func (x *BakeMacaroonRequest) Reset() {
 	*x = BakeMacaroonRequest{}
-	mi := &file_lightning_proto_msgTypes[184]
+	mi := &file_lightning_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16309,7 +18098,7 @@ func (x *BakeMacaroonRequest) String() string {
 func (*BakeMacaroonRequest) ProtoMessage() {}
 
 func (x *BakeMacaroonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[184]
+	mi := &file_lightning_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16322,7 +18111,7 @@ func (x *BakeMacaroonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BakeMacaroonRequest.ProtoReflect.Descriptor instead.
 func (*BakeMacaroonRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{184}
+	return file_lightning_proto_rawDescGZIP(), []int{210}
 }
 
 func (x *BakeMacaroonRequest) GetPermissions() []*MacaroonPermission {
@@ -16356,7 +18145,7 @@ type BakeMacaroonResponse struct {
 
 func (x *BakeMacaroonResponse) Reset() {
 	*x = BakeMacaroonResponse{}
-	mi := &file_lightning_proto_msgTypes[185]
+	mi := &file_lightning_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16368,7 +18157,7 @@ func (x *BakeMacaroonResponse) String() string {
 func (*BakeMacaroonResponse) ProtoMessage() {}
 
 func (x *BakeMacaroonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[185]
+	mi := &file_lightning_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16381,7 +18170,7 @@ func (x *BakeMacaroonResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BakeMacaroonResponse.ProtoReflect.Descriptor instead.
 func (*BakeMacaroonResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{185}
+	return file_lightning_proto_rawDescGZIP(), []int{211}
 }
 
 func (x *BakeMacaroonResponse) GetMacaroon() string {
@@ -16399,7 +18188,7 @@ type ListMacaroonIDsRequest struct {
 
 func (x *ListMacaroonIDsRequest) Reset() {
 	*x = ListMacaroonIDsRequest{}
-	mi := &file_lightning_proto_msgTypes[186]
+	mi := &file_lightning_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16411,7 +18200,7 @@ func (x *ListMacaroonIDsRequest) String() string {
 func (*ListMacaroonIDsRequest) ProtoMessage() {}
 
 func (x *ListMacaroonIDsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[186]
+	mi := &file_lightning_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16424,7 +18213,7 @@ func (x *ListMacaroonIDsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMacaroonIDsRequest.ProtoReflect.Descriptor instead.
 func (*ListMacaroonIDsRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{186}
+	return file_lightning_proto_rawDescGZIP(), []int{212}
 }
 
 type ListMacaroonIDsResponse struct {
@@ -16437,7 +18226,7 @@ type ListMacaroonIDsResponse struct {
 
 func (x *ListMacaroonIDsResponse) Reset() {
 	*x = ListMacaroonIDsResponse{}
-	mi := &file_lightning_proto_msgTypes[187]
+	mi := &file_lightning_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16449,7 +18238,7 @@ func (x *ListMacaroonIDsResponse) String() string {
 func (*ListMacaroonIDsResponse) ProtoMessage() {}
 
 func (x *ListMacaroonIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[187]
+	mi := &file_lightning_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16462,7 +18251,7 @@ func (x *ListMacaroonIDsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMacaroonIDsResponse.ProtoReflect.Descriptor instead.
 func (*ListMacaroonIDsResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{187}
+	return file_lightning_proto_rawDescGZIP(), []int{213}
 }
 
 func (x *ListMacaroonIDsResponse) GetRootKeyIds() []uint64 {
@@ -16482,7 +18271,7 @@ type DeleteMacaroonIDRequest struct {
 
 func (x *DeleteMacaroonIDRequest) Reset() {
 	*x = DeleteMacaroonIDRequest{}
-	mi := &file_lightning_proto_msgTypes[188]
+	mi := &file_lightning_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16494,7 +18283,7 @@ func (x *DeleteMacaroonIDRequest) String() string {
 func (*DeleteMacaroonIDRequest) ProtoMessage() {}
 
 func (x *DeleteMacaroonIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[188]
+	mi := &file_lightning_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16507,7 +18296,7 @@ func (x *DeleteMacaroonIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMacaroonIDRequest.ProtoReflect.Descriptor instead.
 func (*DeleteMacaroonIDRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{188}
+	return file_lightning_proto_rawDescGZIP(), []int{214}
 }
 
 func (x *DeleteMacaroonIDRequest) GetRootKeyId() uint64 {
@@ -16527,7 +18316,7 @@ type DeleteMacaroonIDResponse struct {
 
 func (x *DeleteMacaroonIDResponse) Reset() {
 	*x = DeleteMacaroonIDResponse{}
-	mi := &file_lightning_proto_msgTypes[189]
+	mi := &file_lightning_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16539,7 +18328,7 @@ func (x *DeleteMacaroonIDResponse) String() string {
 func (*DeleteMacaroonIDResponse) ProtoMessage() {}
 
 func (x *DeleteMacaroonIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[189]
+	mi := &file_lightning_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16552,7 +18341,7 @@ func (x *DeleteMacaroonIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMacaroonIDResponse.ProtoReflect.Descriptor instead.
 func (*DeleteMacaroonIDResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{189}
+	return file_lightning_proto_rawDescGZIP(), []int{215}
 }
 
 func (x *DeleteMacaroonIDResponse) GetDeleted() bool {
@@ -16572,7 +18361,7 @@ type MacaroonPermissionList struct {
 
 func (x *MacaroonPermissionList) Reset() {
 	*x = MacaroonPermissionList{}
-	mi := &file_lightning_proto_msgTypes[190]
+	mi := &file_lightning_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16584,7 +18373,7 @@ func (x *MacaroonPermissionList) String() string {
 func (*MacaroonPermissionList) ProtoMessage() {}
 
 func (x *MacaroonPermissionList) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[190]
+	mi := &file_lightning_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16597,7 +18386,7 @@ func (x *MacaroonPermissionList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MacaroonPermissionList.ProtoReflect.Descriptor instead.
 func (*MacaroonPermissionList) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{190}
+	return file_lightning_proto_rawDescGZIP(), []int{216}
 }
 
 func (x *MacaroonPermissionList) GetPermissions() []*MacaroonPermission {
@@ -16615,7 +18404,7 @@ type ListPermissionsRequest struct {
 
 func (x *ListPermissionsRequest) Reset() {
 	*x = ListPermissionsRequest{}
-	mi := &file_lightning_proto_msgTypes[191]
+	mi := &file_lightning_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16627,7 +18416,7 @@ func (x *ListPermissionsRequest) String() string {
 func (*ListPermissionsRequest) ProtoMessage() {}
 
 func (x *ListPermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[191]
+	mi := &file_lightning_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16640,7 +18429,7 @@ func (x *ListPermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPermissionsRequest.ProtoReflect.Descriptor instead.
 func (*ListPermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{191}
+	return file_lightning_proto_rawDescGZIP(), []int{217}
 }
 
 type ListPermissionsResponse struct {
@@ -16654,7 +18443,7 @@ type ListPermissionsResponse struct {
 
 func (x *ListPermissionsResponse) Reset() {
 	*x = ListPermissionsResponse{}
-	mi := &file_lightning_proto_msgTypes[192]
+	mi := &file_lightning_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16666,7 +18455,7 @@ func (x *ListPermissionsResponse) String() string {
 func (*ListPermissionsResponse) ProtoMessage() {}
 
 func (x *ListPermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[192]
+	mi := &file_lightning_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16679,7 +18468,7 @@ func (x *ListPermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPermissionsResponse.ProtoReflect.Descriptor instead.
 func (*ListPermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{192}
+	return file_lightning_proto_rawDescGZIP(), []int{218}
 }
 
 func (x *ListPermissionsResponse) GetMethodPermissions() map[string]*MacaroonPermissionList {
@@ -16714,7 +18503,7 @@ type Failure struct {
 
 func (x *Failure) Reset() {
 	*x = Failure{}
-	mi := &file_lightning_proto_msgTypes[193]
+	mi := &file_lightning_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16726,7 +18515,7 @@ func (x *Failure) String() string {
 func (*Failure) ProtoMessage() {}
 
 func (x *Failure) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[193]
+	mi := &file_lightning_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16739,7 +18528,7 @@ func (x *Failure) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Failure.ProtoReflect.Descriptor instead.
 func (*Failure) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{193}
+	return file_lightning_proto_rawDescGZIP(), []int{219}
 }
 
 func (x *Failure) GetCode() Failure_FailureCode {
@@ -16851,7 +18640,7 @@ type ChannelUpdate struct {
 
 func (x *ChannelUpdate) Reset() {
 	*x = ChannelUpdate{}
-	mi := &file_lightning_proto_msgTypes[194]
+	mi := &file_lightning_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16863,7 +18652,7 @@ func (x *ChannelUpdate) String() string {
 func (*ChannelUpdate) ProtoMessage() {}
 
 func (x *ChannelUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[194]
+	mi := &file_lightning_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16876,7 +18665,7 @@ func (x *ChannelUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelUpdate.ProtoReflect.Descriptor instead.
 func (*ChannelUpdate) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{194}
+	return file_lightning_proto_rawDescGZIP(), []int{220}
 }
 
 func (x *ChannelUpdate) GetSignature() []byte {
@@ -16974,7 +18763,7 @@ type MacaroonId struct {
 
 func (x *MacaroonId) Reset() {
 	*x = MacaroonId{}
-	mi := &file_lightning_proto_msgTypes[195]
+	mi := &file_lightning_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16986,7 +18775,7 @@ func (x *MacaroonId) String() string {
 func (*MacaroonId) ProtoMessage() {}
 
 func (x *MacaroonId) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[195]
+	mi := &file_lightning_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16999,7 +18788,7 @@ func (x *MacaroonId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MacaroonId.ProtoReflect.Descriptor instead.
 func (*MacaroonId) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{195}
+	return file_lightning_proto_rawDescGZIP(), []int{221}
 }
 
 func (x *MacaroonId) GetNonce() []byte {
@@ -17033,7 +18822,7 @@ type Op struct {
 
 func (x *Op) Reset() {
 	*x = Op{}
-	mi := &file_lightning_proto_msgTypes[196]
+	mi := &file_lightning_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17045,7 +18834,7 @@ func (x *Op) String() string {
 func (*Op) ProtoMessage() {}
 
 func (x *Op) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[196]
+	mi := &file_lightning_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17058,7 +18847,7 @@ func (x *Op) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Op.ProtoReflect.Descriptor instead.
 func (*Op) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{196}
+	return file_lightning_proto_rawDescGZIP(), []int{222}
 }
 
 func (x *Op) GetEntity() string {
@@ -17111,7 +18900,7 @@ type CheckMacPermRequest struct {
 
 func (x *CheckMacPermRequest) Reset() {
 	*x = CheckMacPermRequest{}
-	mi := &file_lightning_proto_msgTypes[197]
+	mi := &file_lightning_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17123,7 +18912,7 @@ func (x *CheckMacPermRequest) String() string {
 func (*CheckMacPermRequest) ProtoMessage() {}
 
 func (x *CheckMacPermRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[197]
+	mi := &file_lightning_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17136,7 +18925,7 @@ func (x *CheckMacPermRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckMacPermRequest.ProtoReflect.Descriptor instead.
 func (*CheckMacPermRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{197}
+	return file_lightning_proto_rawDescGZIP(), []int{223}
 }
 
 func (x *CheckMacPermRequest) GetMacaroon() []byte {
@@ -17176,7 +18965,7 @@ type CheckMacPermResponse struct {
 
 func (x *CheckMacPermResponse) Reset() {
 	*x = CheckMacPermResponse{}
-	mi := &file_lightning_proto_msgTypes[198]
+	mi := &file_lightning_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17188,7 +18977,7 @@ func (x *CheckMacPermResponse) String() string {
 func (*CheckMacPermResponse) ProtoMessage() {}
 
 func (x *CheckMacPermResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[198]
+	mi := &file_lightning_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17201,7 +18990,7 @@ func (x *CheckMacPermResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckMacPermResponse.ProtoReflect.Descriptor instead.
 func (*CheckMacPermResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{198}
+	return file_lightning_proto_rawDescGZIP(), []int{224}
 }
 
 func (x *CheckMacPermResponse) GetValid() bool {
@@ -17259,7 +19048,7 @@ type RPCMiddlewareRequest struct {
 
 func (x *RPCMiddlewareRequest) Reset() {
 	*x = RPCMiddlewareRequest{}
-	mi := &file_lightning_proto_msgTypes[199]
+	mi := &file_lightning_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17271,7 +19060,7 @@ func (x *RPCMiddlewareRequest) String() string {
 func (*RPCMiddlewareRequest) ProtoMessage() {}
 
 func (x *RPCMiddlewareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[199]
+	mi := &file_lightning_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17284,7 +19073,7 @@ func (x *RPCMiddlewareRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RPCMiddlewareRequest.ProtoReflect.Descriptor instead.
 func (*RPCMiddlewareRequest) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{199}
+	return file_lightning_proto_rawDescGZIP(), []int{225}
 }
 
 func (x *RPCMiddlewareRequest) GetRequestId() uint64 {
@@ -17423,7 +19212,7 @@ type MetadataValues struct {
 
 func (x *MetadataValues) Reset() {
 	*x = MetadataValues{}
-	mi := &file_lightning_proto_msgTypes[200]
+	mi := &file_lightning_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17435,7 +19224,7 @@ func (x *MetadataValues) String() string {
 func (*MetadataValues) ProtoMessage() {}
 
 func (x *MetadataValues) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[200]
+	mi := &file_lightning_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17448,7 +19237,7 @@ func (x *MetadataValues) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetadataValues.ProtoReflect.Descriptor instead.
 func (*MetadataValues) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{200}
+	return file_lightning_proto_rawDescGZIP(), []int{226}
 }
 
 func (x *MetadataValues) GetValues() []string {
@@ -17470,7 +19259,7 @@ type StreamAuth struct {
 
 func (x *StreamAuth) Reset() {
 	*x = StreamAuth{}
-	mi := &file_lightning_proto_msgTypes[201]
+	mi := &file_lightning_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17482,7 +19271,7 @@ func (x *StreamAuth) String() string {
 func (*StreamAuth) ProtoMessage() {}
 
 func (x *StreamAuth) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[201]
+	mi := &file_lightning_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17495,7 +19284,7 @@ func (x *StreamAuth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamAuth.ProtoReflect.Descriptor instead.
 func (*StreamAuth) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{201}
+	return file_lightning_proto_rawDescGZIP(), []int{227}
 }
 
 func (x *StreamAuth) GetMethodFullUri() string {
@@ -17530,7 +19319,7 @@ type RPCMessage struct {
 
 func (x *RPCMessage) Reset() {
 	*x = RPCMessage{}
-	mi := &file_lightning_proto_msgTypes[202]
+	mi := &file_lightning_proto_msgTypes[228]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17542,7 +19331,7 @@ func (x *RPCMessage) String() string {
 func (*RPCMessage) ProtoMessage() {}
 
 func (x *RPCMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[202]
+	mi := &file_lightning_proto_msgTypes[228]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17555,7 +19344,7 @@ func (x *RPCMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RPCMessage.ProtoReflect.Descriptor instead.
 func (*RPCMessage) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{202}
+	return file_lightning_proto_rawDescGZIP(), []int{228}
 }
 
 func (x *RPCMessage) GetMethodFullUri() string {
@@ -17614,7 +19403,7 @@ type RPCMiddlewareResponse struct {
 
 func (x *RPCMiddlewareResponse) Reset() {
 	*x = RPCMiddlewareResponse{}
-	mi := &file_lightning_proto_msgTypes[203]
+	mi := &file_lightning_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17626,7 +19415,7 @@ func (x *RPCMiddlewareResponse) String() string {
 func (*RPCMiddlewareResponse) ProtoMessage() {}
 
 func (x *RPCMiddlewareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[203]
+	mi := &file_lightning_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17639,7 +19428,7 @@ func (x *RPCMiddlewareResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RPCMiddlewareResponse.ProtoReflect.Descriptor instead.
 func (*RPCMiddlewareResponse) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{203}
+	return file_lightning_proto_rawDescGZIP(), []int{229}
 }
 
 func (x *RPCMiddlewareResponse) GetRefMsgId() uint64 {
@@ -17727,7 +19516,7 @@ type MiddlewareRegistration struct {
 
 func (x *MiddlewareRegistration) Reset() {
 	*x = MiddlewareRegistration{}
-	mi := &file_lightning_proto_msgTypes[204]
+	mi := &file_lightning_proto_msgTypes[230]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17739,7 +19528,7 @@ func (x *MiddlewareRegistration) String() string {
 func (*MiddlewareRegistration) ProtoMessage() {}
 
 func (x *MiddlewareRegistration) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[204]
+	mi := &file_lightning_proto_msgTypes[230]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17752,7 +19541,7 @@ func (x *MiddlewareRegistration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MiddlewareRegistration.ProtoReflect.Descriptor instead.
 func (*MiddlewareRegistration) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{204}
+	return file_lightning_proto_rawDescGZIP(), []int{230}
 }
 
 func (x *MiddlewareRegistration) GetMiddlewareName() string {
@@ -17797,7 +19586,7 @@ type InterceptFeedback struct {
 
 func (x *InterceptFeedback) Reset() {
 	*x = InterceptFeedback{}
-	mi := &file_lightning_proto_msgTypes[205]
+	mi := &file_lightning_proto_msgTypes[231]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17809,7 +19598,7 @@ func (x *InterceptFeedback) String() string {
 func (*InterceptFeedback) ProtoMessage() {}
 
 func (x *InterceptFeedback) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[205]
+	mi := &file_lightning_proto_msgTypes[231]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17822,7 +19611,7 @@ func (x *InterceptFeedback) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InterceptFeedback.ProtoReflect.Descriptor instead.
 func (*InterceptFeedback) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{205}
+	return file_lightning_proto_rawDescGZIP(), []int{231}
 }
 
 func (x *InterceptFeedback) GetError() string {
@@ -17881,7 +19670,7 @@ type PendingChannelsResponse_PendingChannel struct {
 
 func (x *PendingChannelsResponse_PendingChannel) Reset() {
 	*x = PendingChannelsResponse_PendingChannel{}
-	mi := &file_lightning_proto_msgTypes[212]
+	mi := &file_lightning_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17893,7 +19682,7 @@ func (x *PendingChannelsResponse_PendingChannel) String() string {
 func (*PendingChannelsResponse_PendingChannel) ProtoMessage() {}
 
 func (x *PendingChannelsResponse_PendingChannel) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[212]
+	mi := &file_lightning_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17906,7 +19695,7 @@ func (x *PendingChannelsResponse_PendingChannel) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use PendingChannelsResponse_PendingChannel.ProtoReflect.Descriptor instead.
 func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{90, 0}
+	return file_lightning_proto_rawDescGZIP(), []int{114, 0}
 }
 
 func (x *PendingChannelsResponse_PendingChannel) GetRemoteNodePub() string {
@@ -18056,7 +19845,7 @@ type PendingChannelsResponse_PendingOpenChannel struct {
 
 func (x *PendingChannelsResponse_PendingOpenChannel) Reset() {
 	*x = PendingChannelsResponse_PendingOpenChannel{}
-	mi := &file_lightning_proto_msgTypes[213]
+	mi := &file_lightning_proto_msgTypes[239]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -18068,7 +19857,7 @@ func (x *PendingChannelsResponse_PendingOpenChannel) String() string {
 func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
 
 func (x *PendingChannelsResponse_PendingOpenChannel) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[213]
+	mi := &file_lightning_proto_msgTypes[239]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18081,7 +19870,7 @@ func (x *PendingChannelsResponse_PendingOpenChannel) ProtoReflect() protoreflect
 
 // Deprecated: Use PendingChannelsResponse_PendingOpenChannel.ProtoReflect.Descriptor instead.
 func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{90, 1}
+	return file_lightning_proto_rawDescGZIP(), []int{114, 1}
 }
 
 func (x *PendingChannelsResponse_PendingOpenChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
@@ -18165,7 +19954,7 @@ type PendingChannelsResponse_WaitingCloseChannel struct {
 
 func (x *PendingChannelsResponse_WaitingCloseChannel) Reset() {
 	*x = PendingChannelsResponse_WaitingCloseChannel{}
-	mi := &file_lightning_proto_msgTypes[214]
+	mi := &file_lightning_proto_msgTypes[240]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -18177,7 +19966,7 @@ func (x *PendingChannelsResponse_WaitingCloseChannel) String() string {
 func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
 
 func (x *PendingChannelsResponse_WaitingCloseChannel) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[214]
+	mi := &file_lightning_proto_msgTypes[240]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18190,7 +19979,7 @@ func (x *PendingChannelsResponse_WaitingCloseChannel) ProtoReflect() protoreflec
 
 // Deprecated: Use PendingChannelsResponse_WaitingCloseChannel.ProtoReflect.Descriptor instead.
 func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{90, 2}
+	return file_lightning_proto_rawDescGZIP(), []int{114, 2}
 }
 
 func (x *PendingChannelsResponse_WaitingCloseChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
@@ -18265,7 +20054,7 @@ type PendingChannelsResponse_Commitments struct {
 
 func (x *PendingChannelsResponse_Commitments) Reset() {
 	*x = PendingChannelsResponse_Commitments{}
-	mi := &file_lightning_proto_msgTypes[215]
+	mi := &file_lightning_proto_msgTypes[241]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -18277,7 +20066,7 @@ func (x *PendingChannelsResponse_Commitments) String() string {
 func (*PendingChannelsResponse_Commitments) ProtoMessage() {}
 
 func (x *PendingChannelsResponse_Commitments) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[215]
+	mi := &file_lightning_proto_msgTypes[241]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18290,7 +20079,7 @@ func (x *PendingChannelsResponse_Commitments) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use PendingChannelsResponse_Commitments.ProtoReflect.Descriptor instead.
 func (*PendingChannelsResponse_Commitments) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{90, 3}
+	return file_lightning_proto_rawDescGZIP(), []int{114, 3}
 }
 
 func (x *PendingChannelsResponse_Commitments) GetLocalTxid() string {
@@ -18347,7 +20136,7 @@ type PendingChannelsResponse_ClosedChannel struct {
 
 func (x *PendingChannelsResponse_ClosedChannel) Reset() {
 	*x = PendingChannelsResponse_ClosedChannel{}
-	mi := &file_lightning_proto_msgTypes[216]
+	mi := &file_lightning_proto_msgTypes[242]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -18359,7 +20148,7 @@ func (x *PendingChannelsResponse_ClosedChannel) String() string {
 func (*PendingChannelsResponse_ClosedChannel) ProtoMessage() {}
 
 func (x *PendingChannelsResponse_ClosedChannel) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[216]
+	mi := &file_lightning_proto_msgTypes[242]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18372,7 +20161,7 @@ func (x *PendingChannelsResponse_ClosedChannel) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use PendingChannelsResponse_ClosedChannel.ProtoReflect.Descriptor instead.
 func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{90, 4}
+	return file_lightning_proto_rawDescGZIP(), []int{114, 4}
 }
 
 func (x *PendingChannelsResponse_ClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
@@ -18413,7 +20202,7 @@ type PendingChannelsResponse_ForceClosedChannel struct {
 
 func (x *PendingChannelsResponse_ForceClosedChannel) Reset() {
 	*x = PendingChannelsResponse_ForceClosedChannel{}
-	mi := &file_lightning_proto_msgTypes[217]
+	mi := &file_lightning_proto_msgTypes[243]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -18425,7 +20214,7 @@ func (x *PendingChannelsResponse_ForceClosedChannel) String() string {
 func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
 
 func (x *PendingChannelsResponse_ForceClosedChannel) ProtoReflect() protoreflect.Message {
-	mi := &file_lightning_proto_msgTypes[217]
+	mi := &file_lightning_proto_msgTypes[243]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18438,7 +20227,7 @@ func (x *PendingChannelsResponse_ForceClosedChannel) ProtoReflect() protoreflect
 
 // Deprecated: Use PendingChannelsResponse_ForceClosedChannel.ProtoReflect.Descriptor instead.
 func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
-	return file_lightning_proto_rawDescGZIP(), []int{90, 5}
+	return file_lightning_proto_rawDescGZIP(), []int{114, 5}
 }
 
 func (x *PendingChannelsResponse_ForceClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
@@ -18497,11 +20286,179 @@ func (x *PendingChannelsResponse_ForceClosedChannel) GetAnchor() PendingChannels
 	return PendingChannelsResponse_ForceClosedChannel_LIMBO
 }
 
+type PendingChannelsResponse_ScheduledClose struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The channel point of the channel to close.
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// The block height at which the close is initiated. Zero means no
+	// deadline.
+	DeadlineHeight uint32 `protobuf:"varint,2,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+	// The fee rate in sat/vbyte at or below which the close is initiated.
+	// Zero means no target fee rate.
+	TargetSatPerVbyte uint64 `protobuf:"varint,3,opt,name=target_sat_per_vbyte,json=targetSatPerVbyte,proto3" json:"target_sat_per_vbyte,omitempty"`
+	// The confirmation target used to estimate the fee rate of the closing
+	// transaction.
+	TargetConf uint32 `protobuf:"varint,4,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// The maximum absolute fee in satoshis we're willing to pay for the
+	// closing transaction.
+	BudgetSat uint64 `protobuf:"varint,5,opt,name=budget_sat,json=budgetSat,proto3" json:"budget_sat,omitempty"`
+	// The state of the scheduled close.
+	State PendingChannelsResponse_ScheduledClose_State `protobuf:"varint,6,opt,name=state,proto3,enum=lnrpc.PendingChannelsResponse_ScheduledClose_State" json:"state,omitempty"`
+	// The fee rate in sat/vbyte of our latest closing attempt.
+	FeePerVbyte uint64 `protobuf:"varint,7,opt,name=fee_per_vbyte,json=feePerVbyte,proto3" json:"fee_per_vbyte,omitempty"`
+	// The transaction id of our latest closing transaction, if it was
+	// broadcast already.
+	ClosingTxid   string `protobuf:"bytes,8,opt,name=closing_txid,json=closingTxid,proto3" json:"closing_txid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingChannelsResponse_ScheduledClose) Reset() {
+	*x = PendingChannelsResponse_ScheduledClose{}
+	mi := &file_lightning_proto_msgTypes[244]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingChannelsResponse_ScheduledClose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingChannelsResponse_ScheduledClose) ProtoMessage() {}
+
+func (x *PendingChannelsResponse_ScheduledClose) ProtoReflect() protoreflect.Message {
+	mi := &file_lightning_proto_msgTypes[244]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingChannelsResponse_ScheduledClose.ProtoReflect.Descriptor instead.
+func (*PendingChannelsResponse_ScheduledClose) Descriptor() ([]byte, []int) {
+	return file_lightning_proto_rawDescGZIP(), []int{114, 6}
+}
+
+func (x *PendingChannelsResponse_ScheduledClose) GetChannelPoint() string {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return ""
+}
+
+func (x *PendingChannelsResponse_ScheduledClose) GetDeadlineHeight() uint32 {
+	if x != nil {
+		return x.DeadlineHeight
+	}
+	return 0
+}
+
+func (x *PendingChannelsResponse_ScheduledClose) GetTargetSatPerVbyte() uint64 {
+	if x != nil {
+		return x.TargetSatPerVbyte
+	}
+	return 0
+}
+
+func (x *PendingChannelsResponse_ScheduledClose) GetTargetConf() uint32 {
+	if x != nil {
+		return x.TargetConf
+	}
+	return 0
+}
+
+func (x *PendingChannelsResponse_ScheduledClose) GetBudgetSat() uint64 {
+	if x != nil {
+		return x.BudgetSat
+	}
+	return 0
+}
+
+func (x *PendingChannelsResponse_ScheduledClose) GetState() PendingChannelsResponse_ScheduledClose_State {
+	if x != nil {
+		return x.State
+	}
+	return PendingChannelsResponse_ScheduledClose_WAITING
+}
+
+func (x *PendingChannelsResponse_ScheduledClose) GetFeePerVbyte() uint64 {
+	if x != nil {
+		return x.FeePerVbyte
+	}
+	return 0
+}
+
+func (x *PendingChannelsResponse_ScheduledClose) GetClosingTxid() string {
+	if x != nil {
+		return x.ClosingTxid
+	}
+	return ""
+}
+
 var File_lightning_proto protoreflect.FileDescriptor
 
 const file_lightning_proto_rawDesc = "" +
 	"\n" +
-	"\x0flightning.proto\x12\x05lnrpc\"U\n" +
+	"\x0flightning.proto\x12\x05lnrpc\"\x9c\x02\n" +
+	"\x1bScheduleChannelCloseRequest\x128\n" +
+	"\rchannel_point\x18\x01 \x01(\v2\x13.lnrpc.ChannelPointR\fchannelPoint\x12'\n" +
+	"\x0fdeadline_height\x18\x02 \x01(\rR\x0edeadlineHeight\x12/\n" +
+	"\x14target_sat_per_vbyte\x18\x03 \x01(\x04R\x11targetSatPerVbyte\x12\x1f\n" +
+	"\vtarget_conf\x18\x04 \x01(\rR\n" +
+	"targetConf\x12\x1d\n" +
+	"\n" +
+	"budget_sat\x18\x05 \x01(\x04R\tbudgetSat\x12)\n" +
+	"\x10delivery_address\x18\x06 \x01(\tR\x0fdeliveryAddress\"\x1e\n" +
+	"\x1cScheduleChannelCloseResponse\"W\n" +
+	"\x1bCancelScheduledCloseRequest\x128\n" +
+	"\rchannel_point\x18\x01 \x01(\v2\x13.lnrpc.ChannelPointR\fchannelPoint\"\x1e\n" +
+	"\x1cCancelScheduledCloseResponse\"r\n" +
+	"\x13PauseChannelRequest\x122\n" +
+	"\n" +
+	"chan_point\x18\x01 \x01(\v2\x13.lnrpc.ChannelPointR\tchanPoint\x12'\n" +
+	"\x0ftimeout_seconds\x18\x02 \x01(\rR\x0etimeoutSeconds\"\x16\n" +
+	"\x14PauseChannelResponse\"J\n" +
+	"\x14ResumeChannelRequest\x122\n" +
+	"\n" +
+	"chan_point\x18\x01 \x01(\v2\x13.lnrpc.ChannelPointR\tchanPoint\"\x1d\n" +
+	"\x15ResumeChannelResponseJ\x04\b\x01\x10\x02\"o\n" +
+	"\x15BumpFundingFeeRequest\x122\n" +
+	"\n" +
+	"chan_point\x18\x01 \x01(\v2\x13.lnrpc.ChannelPointR\tchanPoint\x12\"\n" +
+	"\rsat_per_vbyte\x18\x02 \x01(\x04R\vsatPerVbyte\"L\n" +
+	"\x16BumpFundingFeeResponse\x122\n" +
+	"\n" +
+	"chan_point\x18\x01 \x01(\v2\x13.lnrpc.ChannelPointR\tchanPoint\"\xdf\x01\n" +
+	"\x13BuyLiquidityRequest\x12\x1f\n" +
+	"\vnode_pubkey\x18\x01 \x01(\fR\n" +
+	"nodePubkey\x12\x17\n" +
+	"\aamt_sat\x18\x02 \x01(\x03R\x06amtSat\x12\"\n" +
+	"\rsat_per_vbyte\x18\x03 \x01(\x04R\vsatPerVbyte\x12\x1e\n" +
+	"\vmax_fee_sat\x18\x04 \x01(\x03R\tmaxFeeSat\x120\n" +
+	"\x14local_funding_amount\x18\x05 \x01(\x03R\x12localFundingAmount\x12\x18\n" +
+	"\aprivate\x18\x06 \x01(\bR\aprivate\"\xa3\x01\n" +
+	"\x14BuyLiquidityResponse\x128\n" +
+	"\rchannel_point\x18\x05 \x01(\v2\x13.lnrpc.ChannelPointR\fchannelPoint\x12!\n" +
+	"\flease_expiry\x18\x02 \x01(\rR\vleaseExpiry\x12\"\n" +
+	"\rlease_fee_sat\x18\x03 \x01(\x03R\vleaseFeeSatJ\x04\b\x01\x10\x02J\x04\b\x04\x10\x05\"\x19\n" +
+	"\x17ListLiquidityAdsRequest\"\x83\x02\n" +
+	"\n" +
+	"LeaseRates\x12%\n" +
+	"\x0efunding_weight\x18\x01 \x01(\rR\rfundingWeight\x12&\n" +
+	"\x0flease_fee_basis\x18\x02 \x01(\rR\rleaseFeeBasis\x12+\n" +
+	"\x12lease_fee_base_sat\x18\x03 \x01(\rR\x0fleaseFeeBaseSat\x128\n" +
+	"\x19channel_fee_max_base_msat\x18\x04 \x01(\rR\x15channelFeeMaxBaseMsat\x12?\n" +
+	"\x1cchannel_fee_max_proportional\x18\x05 \x01(\rR\x19channelFeeMaxProportional\"e\n" +
+	"\vLiquidityAd\x12\x17\n" +
+	"\apub_key\x18\x01 \x01(\tR\x06pubKey\x12\x14\n" +
+	"\x05alias\x18\x02 \x01(\tR\x05alias\x12'\n" +
+	"\x05rates\x18\x03 \x01(\v2\x11.lnrpc.LeaseRatesR\x05rates\"@\n" +
+	"\x18ListLiquidityAdsResponse\x12$\n" +
+	"\x03ads\x18\x01 \x03(\v2\x12.lnrpc.LiquidityAdR\x03ads\"U\n" +
 	"\x1bLookupHtlcResolutionRequest\x12\x17\n" +
 	"\achan_id\x18\x01 \x01(\x04R\x06chanId\x12\x1d\n" +
 	"\n" +
@@ -18590,7 +20547,7 @@ const file_lightning_proto_rawDesc = "" +
 	"\n" +
 	"fixed_msat\x18\x03 \x01(\x03H\x00R\tfixedMsat\x12\x1a\n" +
 	"\apercent\x18\x02 \x01(\x03H\x00R\apercentB\a\n" +
-	"\x05limit\"\xec\x04\n" +
+	"\x05limit\"\x8d\x05\n" +
 	"\x14ChannelAcceptRequest\x12\x1f\n" +
 	"\vnode_pubkey\x18\x01 \x01(\fR\n" +
 	"nodePubkey\x12\x1d\n" +
@@ -18613,7 +20570,9 @@ const file_lightning_proto_rawDesc = "" +
 	"\rchannel_flags\x18\r \x01(\rR\fchannelFlags\x12>\n" +
 	"\x0fcommitment_type\x18\x0e \x01(\x0e2\x15.lnrpc.CommitmentTypeR\x0ecommitmentType\x12&\n" +
 	"\x0fwants_zero_conf\x18\x0f \x01(\bR\rwantsZeroConf\x12(\n" +
-	"\x10wants_scid_alias\x18\x10 \x01(\bR\x0ewantsScidAlias\"\x90\x03\n" +
+	"\x10wants_scid_alias\x18\x10 \x01(\bR\x0ewantsScidAlias\x12\x1f\n" +
+	"\vdual_funded\x18\x11 \x01(\bR\n" +
+	"dualFunded\"\xca\x03\n" +
 	"\x15ChannelAcceptResponse\x12\x16\n" +
 	"\x06accept\x18\x01 \x01(\bR\x06accept\x12&\n" +
 	"\x0fpending_chan_id\x18\x02 \x01(\fR\rpendingChanId\x12\x14\n" +
@@ -18627,7 +20586,8 @@ const file_lightning_proto_rawDesc = "" +
 	"\vmin_htlc_in\x18\t \x01(\x04R\tminHtlcIn\x12(\n" +
 	"\x10min_accept_depth\x18\n" +
 	" \x01(\rR\x0eminAcceptDepth\x12\x1b\n" +
-	"\tzero_conf\x18\v \x01(\bR\bzeroConf\"\x9d\x01\n" +
+	"\tzero_conf\x18\v \x01(\bR\bzeroConf\x128\n" +
+	"\x18funding_contribution_sat\x18\f \x01(\x04R\x16fundingContributionSat\"\x9d\x01\n" +
 	"\fChannelPoint\x12.\n" +
 	"\x12funding_txid_bytes\x18\x01 \x01(\fH\x00R\x10fundingTxidBytes\x12*\n" +
 	"\x10funding_txid_str\x18\x02 \x01(\tH\x00R\x0efundingTxidStr\x12!\n" +
@@ -18725,7 +20685,42 @@ const file_lightning_proto_rawDesc = "" +
 	"\x15DisconnectPeerRequest\x12\x17\n" +
 	"\apub_key\x18\x01 \x01(\tR\x06pubKey\"0\n" +
 	"\x16DisconnectPeerResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\"\xa3\x02\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\x9b\x02\n" +
+	"\x0ePeerConnPolicy\x12:\n" +
+	"\bpriority\x18\x01 \x01(\x0e2\x1e.lnrpc.PeerConnPolicy.PriorityR\bpriority\x12!\n" +
+	"\fmax_attempts\x18\x02 \x01(\rR\vmaxAttempts\x12M\n" +
+	"\x0faddr_preference\x18\x03 \x01(\x0e2$.lnrpc.PeerConnPolicy.AddrPreferenceR\x0eaddrPreference\")\n" +
+	"\bPriority\x12\n" +
+	"\n" +
+	"\x06NORMAL\x10\x00\x12\a\n" +
+	"\x03LOW\x10\x01\x12\b\n" +
+	"\x04HIGH\x10\x02\"0\n" +
+	"\x0eAddrPreference\x12\a\n" +
+	"\x03ANY\x10\x00\x12\f\n" +
+	"\bCLEARNET\x10\x01\x12\a\n" +
+	"\x03TOR\x10\x02\"z\n" +
+	"\x18SetPeerConnPolicyRequest\x12\x17\n" +
+	"\apub_key\x18\x01 \x01(\tR\x06pubKey\x12-\n" +
+	"\x06policy\x18\x02 \x01(\v2\x15.lnrpc.PeerConnPolicyR\x06policy\x12\x16\n" +
+	"\x06remove\x18\x03 \x01(\bR\x06remove\"\x1b\n" +
+	"\x19SetPeerConnPolicyResponse\"\x1c\n" +
+	"\x1aListPersistentPeersRequest\"\xba\x02\n" +
+	"\x0ePersistentPeer\x12\x17\n" +
+	"\apub_key\x18\x01 \x01(\tR\x06pubKey\x12\x1c\n" +
+	"\tconnected\x18\x02 \x01(\bR\tconnected\x12\x12\n" +
+	"\x04perm\x18\x03 \x01(\bR\x04perm\x12-\n" +
+	"\x06policy\x18\x04 \x01(\v2\x15.lnrpc.PeerConnPolicyR\x06policy\x12\x1d\n" +
+	"\n" +
+	"backoff_ms\x18\x05 \x01(\x04R\tbackoffMs\x12*\n" +
+	"\x11next_attempt_time\x18\x06 \x01(\x03R\x0fnextAttemptTime\x12'\n" +
+	"\x0ffailed_attempts\x18\a \x01(\rR\x0efailedAttempts\x12\x1c\n" +
+	"\texhausted\x18\b \x01(\bR\texhausted\x12\x1c\n" +
+	"\taddresses\x18\t \x03(\tR\taddresses\"J\n" +
+	"\x1bListPersistentPeersResponse\x12+\n" +
+	"\x05peers\x18\x01 \x03(\v2\x15.lnrpc.PersistentPeerR\x05peers\"/\n" +
+	"\x14ReconnectPeerRequest\x12\x17\n" +
+	"\apub_key\x18\x01 \x01(\tR\x06pubKey\"\x17\n" +
+	"\x15ReconnectPeerResponse\"\xa3\x02\n" +
 	"\x04HTLC\x12\x1a\n" +
 	"\bincoming\x18\x01 \x01(\bR\bincoming\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1b\n" +
@@ -18742,7 +20737,7 @@ const file_lightning_proto_rawDesc = "" +
 	"\x0edust_limit_sat\x18\x03 \x01(\x04R\fdustLimitSat\x12/\n" +
 	"\x14max_pending_amt_msat\x18\x04 \x01(\x04R\x11maxPendingAmtMsat\x12\"\n" +
 	"\rmin_htlc_msat\x18\x05 \x01(\x04R\vminHtlcMsat\x12,\n" +
-	"\x12max_accepted_htlcs\x18\x06 \x01(\rR\x10maxAcceptedHtlcs\"\xdd\v\n" +
+	"\x12max_accepted_htlcs\x18\x06 \x01(\rR\x10maxAcceptedHtlcs\"\x93\f\n" +
 	"\aChannel\x12\x16\n" +
 	"\x06active\x18\x01 \x01(\bR\x06active\x12#\n" +
 	"\rremote_pubkey\x18\x02 \x01(\tR\fremotePubkey\x12#\n" +
@@ -18787,7 +20782,9 @@ const file_lightning_proto_rawDesc = "" +
 	"peer_alias\x18\" \x01(\tR\tpeerAlias\x12*\n" +
 	"\x0fpeer_scid_alias\x18# \x01(\x04B\x020\x01R\rpeerScidAlias\x12\x12\n" +
 	"\x04memo\x18$ \x01(\tR\x04memo\x12.\n" +
-	"\x13custom_channel_data\x18% \x01(\fR\x11customChannelData\"\xdf\x01\n" +
+	"\x13custom_channel_data\x18% \x01(\fR\x11customChannelData\x12\x16\n" +
+	"\x06paused\x18& \x01(\bR\x06paused\x12\x1c\n" +
+	"\tquiescent\x18' \x01(\bR\tquiescent\"\xdf\x01\n" +
 	"\x13ListChannelsRequest\x12\x1f\n" +
 	"\vactive_only\x18\x01 \x01(\bR\n" +
 	"activeOnly\x12#\n" +
@@ -19020,7 +21017,7 @@ const file_lightning_proto_rawDesc = "" +
 	"\x17remote_chan_reserve_sat\x18\x13 \x01(\x04R\x14remoteChanReserveSat\x12\x12\n" +
 	"\x04memo\x18\x14 \x01(\tR\x04memo\"[\n" +
 	"\x18BatchOpenChannelResponse\x12?\n" +
-	"\x10pending_channels\x18\x01 \x03(\v2\x14.lnrpc.PendingUpdateR\x0fpendingChannels\"\xcb\b\n" +
+	"\x10pending_channels\x18\x01 \x03(\v2\x14.lnrpc.PendingUpdateR\x0fpendingChannels\"\xe8\b\n" +
 	"\x12OpenChannelRequest\x12\"\n" +
 	"\rsat_per_vbyte\x18\x01 \x01(\x04R\vsatPerVbyte\x12\x1f\n" +
 	"\vnode_pubkey\x18\x02 \x01(\fR\n" +
@@ -19056,7 +21053,8 @@ const file_lightning_proto_rawDesc = "" +
 	"\x17remote_chan_reserve_sat\x18\x19 \x01(\x04R\x14remoteChanReserveSat\x12\x19\n" +
 	"\bfund_max\x18\x1a \x01(\bR\afundMax\x12\x12\n" +
 	"\x04memo\x18\x1b \x01(\tR\x04memo\x12-\n" +
-	"\toutpoints\x18\x1c \x03(\v2\x0f.lnrpc.OutPointR\toutpoints\"\xf3\x01\n" +
+	"\toutpoints\x18\x1c \x03(\v2\x0f.lnrpc.OutPointR\toutpoints\x12\x1b\n" +
+	"\tdual_fund\x18\x1d \x01(\bR\bdualFund\"\xf3\x01\n" +
 	"\x10OpenStatusUpdate\x129\n" +
 	"\fchan_pending\x18\x01 \x01(\v2\x14.lnrpc.PendingUpdateH\x00R\vchanPending\x127\n" +
 	"\tchan_open\x18\x03 \x01(\v2\x18.lnrpc.ChannelOpenUpdateH\x00R\bchanOpen\x129\n" +
@@ -19121,13 +21119,14 @@ const file_lightning_proto_rawDesc = "" +
 	"\x13blocks_til_maturity\x18\x05 \x01(\x05R\x11blocksTilMaturity\x12\x14\n" +
 	"\x05stage\x18\x06 \x01(\rR\x05stage\">\n" +
 	"\x16PendingChannelsRequest\x12$\n" +
-	"\x0einclude_raw_tx\x18\x01 \x01(\bR\fincludeRawTx\"\xe0\x15\n" +
+	"\x0einclude_raw_tx\x18\x01 \x01(\bR\fincludeRawTx\"\xc1\x19\n" +
 	"\x17PendingChannelsResponse\x12.\n" +
 	"\x13total_limbo_balance\x18\x01 \x01(\x03R\x11totalLimboBalance\x12e\n" +
 	"\x15pending_open_channels\x18\x02 \x03(\v21.lnrpc.PendingChannelsResponse.PendingOpenChannelR\x13pendingOpenChannels\x12j\n" +
 	"\x18pending_closing_channels\x18\x03 \x03(\v2,.lnrpc.PendingChannelsResponse.ClosedChannelB\x02\x18\x01R\x16pendingClosingChannels\x12v\n" +
 	"\x1epending_force_closing_channels\x18\x04 \x03(\v21.lnrpc.PendingChannelsResponse.ForceClosedChannelR\x1bpendingForceClosingChannels\x12h\n" +
-	"\x16waiting_close_channels\x18\x05 \x03(\v22.lnrpc.PendingChannelsResponse.WaitingCloseChannelR\x14waitingCloseChannels\x1a\xe3\x04\n" +
+	"\x16waiting_close_channels\x18\x05 \x03(\v22.lnrpc.PendingChannelsResponse.WaitingCloseChannelR\x14waitingCloseChannels\x12X\n" +
+	"\x10scheduled_closes\x18\x06 \x03(\v2-.lnrpc.PendingChannelsResponse.ScheduledCloseR\x0fscheduledCloses\x1a\xe3\x04\n" +
 	"\x0ePendingChannel\x12&\n" +
 	"\x0fremote_node_pub\x18\x01 \x01(\tR\rremoteNodePub\x12#\n" +
 	"\rchannel_point\x18\x02 \x01(\tR\fchannelPoint\x12\x1a\n" +
@@ -19186,7 +21185,21 @@ const file_lightning_proto_rawDesc = "" +
 	"\vAnchorState\x12\t\n" +
 	"\x05LIMBO\x10\x00\x12\r\n" +
 	"\tRECOVERED\x10\x01\x12\b\n" +
-	"\x04LOST\x10\x02\"\x1a\n" +
+	"\x04LOST\x10\x02\x1a\x84\x03\n" +
+	"\x0eScheduledClose\x12#\n" +
+	"\rchannel_point\x18\x01 \x01(\tR\fchannelPoint\x12'\n" +
+	"\x0fdeadline_height\x18\x02 \x01(\rR\x0edeadlineHeight\x12/\n" +
+	"\x14target_sat_per_vbyte\x18\x03 \x01(\x04R\x11targetSatPerVbyte\x12\x1f\n" +
+	"\vtarget_conf\x18\x04 \x01(\rR\n" +
+	"targetConf\x12\x1d\n" +
+	"\n" +
+	"budget_sat\x18\x05 \x01(\x04R\tbudgetSat\x12I\n" +
+	"\x05state\x18\x06 \x01(\x0e23.lnrpc.PendingChannelsResponse.ScheduledClose.StateR\x05state\x12\"\n" +
+	"\rfee_per_vbyte\x18\a \x01(\x04R\vfeePerVbyte\x12!\n" +
+	"\fclosing_txid\x18\b \x01(\tR\vclosingTxid\"!\n" +
+	"\x05State\x12\v\n" +
+	"\aWAITING\x10\x00\x12\v\n" +
+	"\aCLOSING\x10\x01\"\x1a\n" +
 	"\x18ChannelEventSubscription\"?\n" +
 	"\x13ChannelCommitUpdate\x12(\n" +
 	"\achannel\x18\x01 \x01(\v2\x0e.lnrpc.ChannelR\achannel\"\xc6\x06\n" +
@@ -19488,7 +21501,7 @@ const file_lightning_proto_rawDesc = "" +
 	"\fsettle_index\x18\x02 \x01(\x04R\vsettleIndex\x12\x1f\n" +
 	"\vsettle_time\x18\x03 \x01(\x03R\n" +
 	"settleTime\x12\"\n" +
-	"\ramt_paid_msat\x18\x05 \x01(\x03R\vamtPaidMsat\"\xac\n" +
+	"\ramt_paid_msat\x18\x05 \x01(\x03R\vamtPaidMsat\"\xe7\n" +
 	"\n" +
 	"\aInvoice\x12\x12\n" +
 	"\x04memo\x18\x01 \x01(\tR\x04memo\x12\x1d\n" +
@@ -19528,7 +21541,9 @@ const file_lightning_proto_rawDesc = "" +
 	"\x11amp_invoice_state\x18\x1c \x03(\v2#.lnrpc.Invoice.AmpInvoiceStateEntryR\x0fampInvoiceState\x12\x1d\n" +
 	"\n" +
 	"is_blinded\x18\x1d \x01(\bR\tisBlinded\x12H\n" +
-	"\x13blinded_path_config\x18\x1e \x01(\v2\x18.lnrpc.BlindedPathConfigR\x11blindedPathConfig\x1aK\n" +
+	"\x13blinded_path_config\x18\x1e \x01(\v2\x18.lnrpc.BlindedPathConfigR\x11blindedPathConfig\x129\n" +
+	"\vhold_policy\x18\x1f \x01(\v2\x18.lnrpc.InvoiceHoldPolicyR\n" +
+	"holdPolicy\x1aK\n" +
 	"\rFeaturesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\rR\x03key\x12$\n" +
 	"\x05value\x18\x02 \x01(\v2\x0e.lnrpc.FeatureR\x05value:\x028\x01\x1aZ\n" +
@@ -19539,13 +21554,20 @@ const file_lightning_proto_rawDesc = "" +
 	"\x04OPEN\x10\x00\x12\v\n" +
 	"\aSETTLED\x10\x01\x12\f\n" +
 	"\bCANCELED\x10\x02\x12\f\n" +
-	"\bACCEPTED\x10\x03J\x04\b\x02\x10\x03\"\xa3\x02\n" +
+	"\bACCEPTED\x10\x03J\x04\b\x02\x10\x03\"\x84\x01\n" +
+	"\x11InvoiceHoldPolicy\x12(\n" +
+	"\x10max_hold_seconds\x18\x01 \x01(\x04R\x0emaxHoldSeconds\x12$\n" +
+	"\x0emin_cltv_slack\x18\x02 \x01(\rR\fminCltvSlack\x12\x1f\n" +
+	"\vauto_settle\x18\x03 \x01(\bR\n" +
+	"autoSettle\"\x8c\x03\n" +
 	"\x11BlindedPathConfig\x12.\n" +
 	"\x11min_num_real_hops\x18\x01 \x01(\rH\x00R\x0eminNumRealHops\x88\x01\x01\x12\x1e\n" +
 	"\bnum_hops\x18\x02 \x01(\rH\x01R\anumHops\x88\x01\x01\x12'\n" +
 	"\rmax_num_paths\x18\x03 \x01(\rH\x02R\vmaxNumPaths\x88\x01\x01\x12,\n" +
 	"\x12node_omission_list\x18\x04 \x03(\fR\x10nodeOmissionList\x122\n" +
-	"\x15incoming_channel_list\x18\x05 \x03(\x04R\x13incomingChannelListB\x14\n" +
+	"\x15incoming_channel_list\x18\x05 \x03(\x04R\x13incomingChannelList\x12.\n" +
+	"\x13diverse_intro_nodes\x18\x06 \x01(\bR\x11diverseIntroNodes\x127\n" +
+	"\x18refresh_on_channel_close\x18\a \x01(\bR\x15refreshOnChannelCloseB\x14\n" +
 	"\x12_min_num_real_hopsB\v\n" +
 	"\t_num_hopsB\x10\n" +
 	"\x0e_max_num_paths\"\xac\x04\n" +
@@ -19603,7 +21625,7 @@ const file_lightning_proto_rawDesc = "" +
 	"\x15DelCanceledInvoiceReq\x12!\n" +
 	"\finvoice_hash\x18\x01 \x01(\tR\vinvoiceHash\"0\n" +
 	"\x16DelCanceledInvoiceResp\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\"\xcb\x06\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\xfc\x06\n" +
 	"\aPayment\x12!\n" +
 	"\fpayment_hash\x18\x01 \x01(\tR\vpaymentHash\x12\x18\n" +
 	"\x05value\x18\x02 \x01(\x03B\x02\x18\x01R\x05value\x12'\n" +
@@ -19622,7 +21644,8 @@ const file_lightning_proto_rawDesc = "" +
 	"\x05htlcs\x18\x0e \x03(\v2\x12.lnrpc.HTLCAttemptR\x05htlcs\x12#\n" +
 	"\rpayment_index\x18\x0f \x01(\x04R\fpaymentIndex\x12B\n" +
 	"\x0efailure_reason\x18\x10 \x01(\x0e2\x1b.lnrpc.PaymentFailureReasonR\rfailureReason\x12b\n" +
-	"\x18first_hop_custom_records\x18\x11 \x03(\v2).lnrpc.Payment.FirstHopCustomRecordsEntryR\x15firstHopCustomRecords\x1aH\n" +
+	"\x18first_hop_custom_records\x18\x11 \x03(\v2).lnrpc.Payment.FirstHopCustomRecordsEntryR\x15firstHopCustomRecords\x12/\n" +
+	"\areceipt\x18\x12 \x01(\v2\x15.lnrpc.PaymentReceiptR\areceipt\x1aH\n" +
 	"\x1aFirstHopCustomRecordsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x04R\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"Y\n" +
@@ -19632,7 +21655,10 @@ const file_lightning_proto_rawDesc = "" +
 	"\tSUCCEEDED\x10\x02\x12\n" +
 	"\n" +
 	"\x06FAILED\x10\x03\x12\r\n" +
-	"\tINITIATED\x10\x04J\x04\b\x04\x10\x05\"\xd5\x02\n" +
+	"\tINITIATED\x10\x04J\x04\b\x04\x10\x05\"X\n" +
+	"\x0ePaymentReceipt\x12\x1c\n" +
+	"\tsignature\x18\x01 \x01(\fR\tsignature\x12(\n" +
+	"\x10received_time_ns\x18\x02 \x01(\x03R\x0ereceivedTimeNs\"\xd5\x02\n" +
 	"\vHTLCAttempt\x12\x1d\n" +
 	"\n" +
 	"attempt_id\x18\a \x01(\x04R\tattemptId\x125\n" +
@@ -19722,13 +21748,16 @@ const file_lightning_proto_rawDesc = "" +
 	"\vfee_per_mil\x18\x03 \x01(\x03R\tfeePerMil\x12\x19\n" +
 	"\bfee_rate\x18\x04 \x01(\x01R\afeeRate\x121\n" +
 	"\x15inbound_base_fee_msat\x18\x06 \x01(\x05R\x12inboundBaseFeeMsat\x12-\n" +
-	"\x13inbound_fee_per_mil\x18\a \x01(\x05R\x10inboundFeePerMil\"\xb5\x01\n" +
+	"\x13inbound_fee_per_mil\x18\a \x01(\x05R\x10inboundFeePerMil\"\xe3\x02\n" +
 	"\x11FeeReportResponse\x12:\n" +
 	"\fchannel_fees\x18\x01 \x03(\v2\x17.lnrpc.ChannelFeeReportR\vchannelFees\x12\x1e\n" +
 	"\vday_fee_sum\x18\x02 \x01(\x04R\tdayFeeSum\x12 \n" +
 	"\fweek_fee_sum\x18\x03 \x01(\x04R\n" +
 	"weekFeeSum\x12\"\n" +
-	"\rmonth_fee_sum\x18\x04 \x01(\x04R\vmonthFeeSum\"R\n" +
+	"\rmonth_fee_sum\x18\x04 \x01(\x04R\vmonthFeeSum\x126\n" +
+	"\x18day_upfront_fee_sum_msat\x18\x05 \x01(\x04R\x14dayUpfrontFeeSumMsat\x128\n" +
+	"\x19week_upfront_fee_sum_msat\x18\x06 \x01(\x04R\x15weekUpfrontFeeSumMsat\x12:\n" +
+	"\x1amonth_upfront_fee_sum_msat\x18\a \x01(\x04R\x16monthUpfrontFeeSumMsat\"R\n" +
 	"\n" +
 	"InboundFee\x12\"\n" +
 	"\rbase_fee_msat\x18\x01 \x01(\x05R\vbaseFeeMsat\x12 \n" +
@@ -19756,7 +21785,7 @@ const file_lightning_proto_rawDesc = "" +
 	"\x06reason\x18\x02 \x01(\x0e2\x14.lnrpc.UpdateFailureR\x06reason\x12!\n" +
 	"\fupdate_error\x18\x03 \x01(\tR\vupdateError\"R\n" +
 	"\x14PolicyUpdateResponse\x12:\n" +
-	"\x0efailed_updates\x18\x01 \x03(\v2\x13.lnrpc.FailedUpdateR\rfailedUpdates\"\xa1\x02\n" +
+	"\x0efailed_updates\x18\x01 \x03(\v2\x13.lnrpc.FailedUpdateR\rfailedUpdates\"\xc8\x02\n" +
 	"\x18ForwardingHistoryRequest\x12\x1d\n" +
 	"\n" +
 	"start_time\x18\x01 \x01(\x04R\tstartTime\x12\x19\n" +
@@ -19765,7 +21794,8 @@ const file_lightning_proto_rawDesc = "" +
 	"\x0enum_max_events\x18\x04 \x01(\rR\fnumMaxEvents\x12*\n" +
 	"\x11peer_alias_lookup\x18\x05 \x01(\bR\x0fpeerAliasLookup\x12*\n" +
 	"\x11incoming_chan_ids\x18\x06 \x03(\x04R\x0fincomingChanIds\x12*\n" +
-	"\x11outgoing_chan_ids\x18\a \x03(\x04R\x0foutgoingChanIds\"\x8d\x04\n" +
+	"\x11outgoing_chan_ids\x18\a \x03(\x04R\x0foutgoingChanIds\x12%\n" +
+	"\x0einclude_failed\x18\b \x01(\bR\rincludeFailed\"\xcf\x04\n" +
 	"\x0fForwardingEvent\x12 \n" +
 	"\ttimestamp\x18\x01 \x01(\x04B\x02\x18\x01R\ttimestamp\x12 \n" +
 	"\n" +
@@ -19783,7 +21813,9 @@ const file_lightning_proto_rawDesc = "" +
 	"\rpeer_alias_in\x18\f \x01(\tR\vpeerAliasIn\x12$\n" +
 	"\x0epeer_alias_out\x18\r \x01(\tR\fpeerAliasOut\x12-\n" +
 	"\x10incoming_htlc_id\x18\x0e \x01(\x04H\x00R\x0eincomingHtlcId\x88\x01\x01\x12-\n" +
-	"\x10outgoing_htlc_id\x18\x0f \x01(\x04H\x01R\x0eoutgoingHtlcId\x88\x01\x01B\x13\n" +
+	"\x10outgoing_htlc_id\x18\x0f \x01(\x04H\x01R\x0eoutgoingHtlcId\x88\x01\x01\x12(\n" +
+	"\x10upfront_fee_msat\x18\x10 \x01(\x04R\x0eupfrontFeeMsat\x12\x16\n" +
+	"\x06failed\x18\x11 \x01(\bR\x06failedB\x13\n" +
 	"\x11_incoming_htlc_idB\x13\n" +
 	"\x11_outgoing_htlc_id\"\x8c\x01\n" +
 	"\x19ForwardingHistoryResponse\x12C\n" +
@@ -19841,7 +21873,7 @@ const file_lightning_proto_rawDesc = "" +
 	"\x12method_permissions\x18\x01 \x03(\v25.lnrpc.ListPermissionsResponse.MethodPermissionsEntryR\x11methodPermissions\x1ac\n" +
 	"\x16MethodPermissionsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x123\n" +
-	"\x05value\x18\x02 \x01(\v2\x1d.lnrpc.MacaroonPermissionListR\x05value:\x028\x01\"\xcc\b\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.lnrpc.MacaroonPermissionListR\x05value:\x028\x01\"\x99\t\n" +
 	"\aFailure\x12.\n" +
 	"\x04code\x18\x01 \x01(\x0e2\x1a.lnrpc.Failure.FailureCodeR\x04code\x12;\n" +
 	"\x0echannel_update\x18\x03 \x01(\v2\x14.lnrpc.ChannelUpdateR\rchannelUpdate\x12\x1b\n" +
@@ -19851,7 +21883,7 @@ const file_lightning_proto_rawDesc = "" +
 	"cltvExpiry\x12\x14\n" +
 	"\x05flags\x18\a \x01(\rR\x05flags\x120\n" +
 	"\x14failure_source_index\x18\b \x01(\rR\x12failureSourceIndex\x12\x16\n" +
-	"\x06height\x18\t \x01(\rR\x06height\"\x8b\x06\n" +
+	"\x06height\x18\t \x01(\rR\x06height\"\xd8\x06\n" +
 	"\vFailureCode\x12\f\n" +
 	"\bRESERVED\x10\x00\x12(\n" +
 	"$INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS\x10\x01\x12\x1c\n" +
@@ -19879,7 +21911,9 @@ const file_lightning_proto_rawDesc = "" +
 	"\x0eEXPIRY_TOO_FAR\x10\x16\x12\x0f\n" +
 	"\vMPP_TIMEOUT\x10\x17\x12\x19\n" +
 	"\x15INVALID_ONION_PAYLOAD\x10\x18\x12\x1a\n" +
-	"\x16INVALID_ONION_BLINDING\x10\x19\x12\x15\n" +
+	"\x16INVALID_ONION_BLINDING\x10\x19\x12 \n" +
+	"\x1cTEMPORARY_TRAMPOLINE_FAILURE\x10\x1a\x12)\n" +
+	"%TRAMPOLINE_FEE_OR_EXPIRY_INSUFFICIENT\x10\x1b\x12\x15\n" +
 	"\x10INTERNAL_FAILURE\x10\xe5\a\x12\x14\n" +
 	"\x0fUNKNOWN_FAILURE\x10\xe6\a\x12\x17\n" +
 	"\x12UNREADABLE_FAILURE\x10\xe7\aJ\x04\b\x02\x10\x03\"\xb3\x03\n" +
@@ -19981,7 +22015,7 @@ const file_lightning_proto_rawDesc = "" +
 	"\x1aUNUSED_WITNESS_PUBKEY_HASH\x10\x02\x12\x1d\n" +
 	"\x19UNUSED_NESTED_PUBKEY_HASH\x10\x03\x12\x12\n" +
 	"\x0eTAPROOT_PUBKEY\x10\x04\x12\x19\n" +
-	"\x15UNUSED_TAPROOT_PUBKEY\x10\x05*\xd3\x01\n" +
+	"\x15UNUSED_TAPROOT_PUBKEY\x10\x05*\xed\x01\n" +
 	"\x0eCommitmentType\x12\x1b\n" +
 	"\x17UNKNOWN_COMMITMENT_TYPE\x10\x00\x12\n" +
 	"\n" +
@@ -19992,7 +22026,8 @@ const file_lightning_proto_rawDesc = "" +
 	"\aTAPROOT\x10\a\x12\x18\n" +
 	"\x14SIMPLE_TAPROOT_FINAL\x10\a\x12\x12\n" +
 	"\x0eSIMPLE_TAPROOT\x10\x05\x12\x1a\n" +
-	"\x16SIMPLE_TAPROOT_OVERLAY\x10\x06\x1a\x02\x10\x01*a\n" +
+	"\x16SIMPLE_TAPROOT_OVERLAY\x10\x06\x12\x18\n" +
+	"\x14ZERO_FEE_COMMITMENTS\x10\b\x1a\x02\x10\x01*a\n" +
 	"\tInitiator\x12\x15\n" +
 	"\x11INITIATOR_UNKNOWN\x10\x00\x12\x13\n" +
 	"\x0fINITIATOR_LOCAL\x10\x01\x12\x14\n" +
@@ -20068,7 +22103,7 @@ const file_lightning_proto_rawDesc = "" +
 	"\x16UPDATE_FAILURE_PENDING\x10\x01\x12\x1c\n" +
 	"\x18UPDATE_FAILURE_NOT_FOUND\x10\x02\x12\x1f\n" +
 	"\x1bUPDATE_FAILURE_INTERNAL_ERR\x10\x03\x12$\n" +
-	" UPDATE_FAILURE_INVALID_PARAMETER\x10\x042\xb9'\n" +
+	" UPDATE_FAILURE_INVALID_PARAMETER\x10\x042\xff-\n" +
 	"\tLightning\x12J\n" +
 	"\rWalletBalance\x12\x1b.lnrpc.WalletBalanceRequest\x1a\x1c.lnrpc.WalletBalanceResponse\x12M\n" +
 	"\x0eChannelBalance\x12\x1c.lnrpc.ChannelBalanceRequest\x1a\x1d.lnrpc.ChannelBalanceResponse\x12K\n" +
@@ -20140,7 +22175,17 @@ const file_lightning_proto_rawDesc = "" +
 	"\x10SendOnionMessage\x12\x1e.lnrpc.SendOnionMessageRequest\x1a\x1f.lnrpc.SendOnionMessageResponse\x12[\n" +
 	"\x16SubscribeOnionMessages\x12$.lnrpc.SubscribeOnionMessagesRequest\x1a\x19.lnrpc.OnionMessageUpdate0\x01\x12D\n" +
 	"\vListAliases\x12\x19.lnrpc.ListAliasesRequest\x1a\x1a.lnrpc.ListAliasesResponse\x12_\n" +
-	"\x14LookupHtlcResolution\x12\".lnrpc.LookupHtlcResolutionRequest\x1a#.lnrpc.LookupHtlcResolutionResponseB'Z%github.com/lightningnetwork/lnd/lnrpcb\x06proto3"
+	"\x14LookupHtlcResolution\x12\".lnrpc.LookupHtlcResolutionRequest\x1a#.lnrpc.LookupHtlcResolutionResponse\x12G\n" +
+	"\fBuyLiquidity\x12\x1a.lnrpc.BuyLiquidityRequest\x1a\x1b.lnrpc.BuyLiquidityResponse\x12S\n" +
+	"\x10ListLiquidityAds\x12\x1e.lnrpc.ListLiquidityAdsRequest\x1a\x1f.lnrpc.ListLiquidityAdsResponse\x12M\n" +
+	"\x0eBumpFundingFee\x12\x1c.lnrpc.BumpFundingFeeRequest\x1a\x1d.lnrpc.BumpFundingFeeResponse\x12G\n" +
+	"\fPauseChannel\x12\x1a.lnrpc.PauseChannelRequest\x1a\x1b.lnrpc.PauseChannelResponse\x12J\n" +
+	"\rResumeChannel\x12\x1b.lnrpc.ResumeChannelRequest\x1a\x1c.lnrpc.ResumeChannelResponse\x12_\n" +
+	"\x14ScheduleChannelClose\x12\".lnrpc.ScheduleChannelCloseRequest\x1a#.lnrpc.ScheduleChannelCloseResponse\x12_\n" +
+	"\x14CancelScheduledClose\x12\".lnrpc.CancelScheduledCloseRequest\x1a#.lnrpc.CancelScheduledCloseResponse\x12\\\n" +
+	"\x13ListPersistentPeers\x12!.lnrpc.ListPersistentPeersRequest\x1a\".lnrpc.ListPersistentPeersResponse\x12V\n" +
+	"\x11SetPeerConnPolicy\x12\x1f.lnrpc.SetPeerConnPolicyRequest\x1a .lnrpc.SetPeerConnPolicyResponse\x12J\n" +
+	"\rReconnectPeer\x12\x1b.lnrpc.ReconnectPeerRequest\x1a\x1c.lnrpc.ReconnectPeerResponseB'Z%github.com/lightningnetwork/lnd/lnrpcb\x06proto3"
 
 var (
 	file_lightning_proto_rawDescOnce sync.Once
@@ -20154,8 +22199,8 @@ func file_lightning_proto_rawDescGZIP() []byte {
 	return file_lightning_proto_rawDescData
 }
 
-var file_lightning_proto_enumTypes = make([]protoimpl.EnumInfo, 22)
-var file_lightning_proto_msgTypes = make([]protoimpl.MessageInfo, 234)
+var file_lightning_proto_enumTypes = make([]protoimpl.EnumInfo, 25)
+var file_lightning_proto_msgTypes = make([]protoimpl.MessageInfo, 261)
 var file_lightning_proto_goTypes = []any{
 	(OutputScriptType)(0),                // 0: lnrpc.OutputScriptType
 	(CoinSelectionStrategy)(0),           // 1: lnrpc.CoinSelectionStrategy
@@ -20170,589 +22215,657 @@ var file_lightning_proto_goTypes = []any{
 	(PaymentFailureReason)(0),            // 10: lnrpc.PaymentFailureReason
 	(FeatureBit)(0),                      // 11: lnrpc.FeatureBit
 	(UpdateFailure)(0),                   // 12: lnrpc.UpdateFailure
-	(ChannelCloseSummary_ClosureType)(0), // 13: lnrpc.ChannelCloseSummary.ClosureType
-	(Peer_SyncType)(0),                   // 14: lnrpc.Peer.SyncType
-	(PeerEvent_EventType)(0),             // 15: lnrpc.PeerEvent.EventType
-	(PendingChannelsResponse_ForceClosedChannel_AnchorState)(0), // 16: lnrpc.PendingChannelsResponse.ForceClosedChannel.AnchorState
-	(ChannelEventUpdate_UpdateType)(0),                          // 17: lnrpc.ChannelEventUpdate.UpdateType
-	(Invoice_InvoiceState)(0),                                   // 18: lnrpc.Invoice.InvoiceState
-	(Payment_PaymentStatus)(0),                                  // 19: lnrpc.Payment.PaymentStatus
-	(HTLCAttempt_HTLCStatus)(0),                                 // 20: lnrpc.HTLCAttempt.HTLCStatus
-	(Failure_FailureCode)(0),                                    // 21: lnrpc.Failure.FailureCode
-	(*LookupHtlcResolutionRequest)(nil),                         // 22: lnrpc.LookupHtlcResolutionRequest
-	(*LookupHtlcResolutionResponse)(nil),                        // 23: lnrpc.LookupHtlcResolutionResponse
-	(*SubscribeCustomMessagesRequest)(nil),                      // 24: lnrpc.SubscribeCustomMessagesRequest
-	(*CustomMessage)(nil),                                       // 25: lnrpc.CustomMessage
-	(*SendCustomMessageRequest)(nil),                            // 26: lnrpc.SendCustomMessageRequest
-	(*SendCustomMessageResponse)(nil),                           // 27: lnrpc.SendCustomMessageResponse
-	(*SubscribeOnionMessagesRequest)(nil),                       // 28: lnrpc.SubscribeOnionMessagesRequest
-	(*OnionMessageUpdate)(nil),                                  // 29: lnrpc.OnionMessageUpdate
-	(*SendOnionMessageRequest)(nil),                             // 30: lnrpc.SendOnionMessageRequest
-	(*SendOnionMessageResponse)(nil),                            // 31: lnrpc.SendOnionMessageResponse
-	(*Utxo)(nil),                                                // 32: lnrpc.Utxo
-	(*OutputDetail)(nil),                                        // 33: lnrpc.OutputDetail
-	(*Transaction)(nil),                                         // 34: lnrpc.Transaction
-	(*GetTransactionsRequest)(nil),                              // 35: lnrpc.GetTransactionsRequest
-	(*TransactionDetails)(nil),                                  // 36: lnrpc.TransactionDetails
-	(*FeeLimit)(nil),                                            // 37: lnrpc.FeeLimit
-	(*ChannelAcceptRequest)(nil),                                // 38: lnrpc.ChannelAcceptRequest
-	(*ChannelAcceptResponse)(nil),                               // 39: lnrpc.ChannelAcceptResponse
-	(*ChannelPoint)(nil),                                        // 40: lnrpc.ChannelPoint
-	(*OutPoint)(nil),                                            // 41: lnrpc.OutPoint
-	(*PreviousOutPoint)(nil),                                    // 42: lnrpc.PreviousOutPoint
-	(*LightningAddress)(nil),                                    // 43: lnrpc.LightningAddress
-	(*EstimateFeeRequest)(nil),                                  // 44: lnrpc.EstimateFeeRequest
-	(*EstimateFeeResponse)(nil),                                 // 45: lnrpc.EstimateFeeResponse
-	(*SendManyRequest)(nil),                                     // 46: lnrpc.SendManyRequest
-	(*SendManyResponse)(nil),                                    // 47: lnrpc.SendManyResponse
-	(*SendCoinsRequest)(nil),                                    // 48: lnrpc.SendCoinsRequest
-	(*SendCoinsResponse)(nil),                                   // 49: lnrpc.SendCoinsResponse
-	(*ListUnspentRequest)(nil),                                  // 50: lnrpc.ListUnspentRequest
-	(*ListUnspentResponse)(nil),                                 // 51: lnrpc.ListUnspentResponse
-	(*NewAddressRequest)(nil),                                   // 52: lnrpc.NewAddressRequest
-	(*NewAddressResponse)(nil),                                  // 53: lnrpc.NewAddressResponse
-	(*SignMessageRequest)(nil),                                  // 54: lnrpc.SignMessageRequest
-	(*SignMessageResponse)(nil),                                 // 55: lnrpc.SignMessageResponse
-	(*VerifyMessageRequest)(nil),                                // 56: lnrpc.VerifyMessageRequest
-	(*VerifyMessageResponse)(nil),                               // 57: lnrpc.VerifyMessageResponse
-	(*ConnectPeerRequest)(nil),                                  // 58: lnrpc.ConnectPeerRequest
-	(*ConnectPeerResponse)(nil),                                 // 59: lnrpc.ConnectPeerResponse
-	(*DisconnectPeerRequest)(nil),                               // 60: lnrpc.DisconnectPeerRequest
-	(*DisconnectPeerResponse)(nil),                              // 61: lnrpc.DisconnectPeerResponse
-	(*HTLC)(nil),                                                // 62: lnrpc.HTLC
-	(*ChannelConstraints)(nil),                                  // 63: lnrpc.ChannelConstraints
-	(*Channel)(nil),                                             // 64: lnrpc.Channel
-	(*ListChannelsRequest)(nil),                                 // 65: lnrpc.ListChannelsRequest
-	(*ListChannelsResponse)(nil),                                // 66: lnrpc.ListChannelsResponse
-	(*AliasMap)(nil),                                            // 67: lnrpc.AliasMap
-	(*ListAliasesRequest)(nil),                                  // 68: lnrpc.ListAliasesRequest
-	(*ListAliasesResponse)(nil),                                 // 69: lnrpc.ListAliasesResponse
-	(*ChannelCloseSummary)(nil),                                 // 70: lnrpc.ChannelCloseSummary
-	(*Resolution)(nil),                                          // 71: lnrpc.Resolution
-	(*ClosedChannelsRequest)(nil),                               // 72: lnrpc.ClosedChannelsRequest
-	(*ClosedChannelsResponse)(nil),                              // 73: lnrpc.ClosedChannelsResponse
-	(*Peer)(nil),                                                // 74: lnrpc.Peer
-	(*TimestampedError)(nil),                                    // 75: lnrpc.TimestampedError
-	(*ListPeersRequest)(nil),                                    // 76: lnrpc.ListPeersRequest
-	(*ListPeersResponse)(nil),                                   // 77: lnrpc.ListPeersResponse
-	(*PeerEventSubscription)(nil),                               // 78: lnrpc.PeerEventSubscription
-	(*PeerEvent)(nil),                                           // 79: lnrpc.PeerEvent
-	(*GetInfoRequest)(nil),                                      // 80: lnrpc.GetInfoRequest
-	(*GetInfoResponse)(nil),                                     // 81: lnrpc.GetInfoResponse
-	(*GetDebugInfoRequest)(nil),                                 // 82: lnrpc.GetDebugInfoRequest
-	(*GetDebugInfoResponse)(nil),                                // 83: lnrpc.GetDebugInfoResponse
-	(*GetRecoveryInfoRequest)(nil),                              // 84: lnrpc.GetRecoveryInfoRequest
-	(*GetRecoveryInfoResponse)(nil),                             // 85: lnrpc.GetRecoveryInfoResponse
-	(*Chain)(nil),                                               // 86: lnrpc.Chain
-	(*ChannelOpenUpdate)(nil),                                   // 87: lnrpc.ChannelOpenUpdate
-	(*CloseOutput)(nil),                                         // 88: lnrpc.CloseOutput
-	(*ChannelCloseUpdate)(nil),                                  // 89: lnrpc.ChannelCloseUpdate
-	(*CloseChannelRequest)(nil),                                 // 90: lnrpc.CloseChannelRequest
-	(*CloseStatusUpdate)(nil),                                   // 91: lnrpc.CloseStatusUpdate
-	(*PendingUpdate)(nil),                                       // 92: lnrpc.PendingUpdate
-	(*InstantUpdate)(nil),                                       // 93: lnrpc.InstantUpdate
-	(*ReadyForPsbtFunding)(nil),                                 // 94: lnrpc.ReadyForPsbtFunding
-	(*BatchOpenChannelRequest)(nil),                             // 95: lnrpc.BatchOpenChannelRequest
-	(*BatchOpenChannel)(nil),                                    // 96: lnrpc.BatchOpenChannel
-	(*BatchOpenChannelResponse)(nil),                            // 97: lnrpc.BatchOpenChannelResponse
-	(*OpenChannelRequest)(nil),                                  // 98: lnrpc.OpenChannelRequest
-	(*OpenStatusUpdate)(nil),                                    // 99: lnrpc.OpenStatusUpdate
-	(*KeyLocator)(nil),                                          // 100: lnrpc.KeyLocator
-	(*KeyDescriptor)(nil),                                       // 101: lnrpc.KeyDescriptor
-	(*ChanPointShim)(nil),                                       // 102: lnrpc.ChanPointShim
-	(*PsbtShim)(nil),                                            // 103: lnrpc.PsbtShim
-	(*FundingShim)(nil),                                         // 104: lnrpc.FundingShim
-	(*FundingShimCancel)(nil),                                   // 105: lnrpc.FundingShimCancel
-	(*FundingPsbtVerify)(nil),                                   // 106: lnrpc.FundingPsbtVerify
-	(*FundingPsbtFinalize)(nil),                                 // 107: lnrpc.FundingPsbtFinalize
-	(*FundingTransitionMsg)(nil),                                // 108: lnrpc.FundingTransitionMsg
-	(*FundingStateStepResp)(nil),                                // 109: lnrpc.FundingStateStepResp
-	(*PendingHTLC)(nil),                                         // 110: lnrpc.PendingHTLC
-	(*PendingChannelsRequest)(nil),                              // 111: lnrpc.PendingChannelsRequest
-	(*PendingChannelsResponse)(nil),                             // 112: lnrpc.PendingChannelsResponse
-	(*ChannelEventSubscription)(nil),                            // 113: lnrpc.ChannelEventSubscription
-	(*ChannelCommitUpdate)(nil),                                 // 114: lnrpc.ChannelCommitUpdate
-	(*ChannelEventUpdate)(nil),                                  // 115: lnrpc.ChannelEventUpdate
-	(*WalletAccountBalance)(nil),                                // 116: lnrpc.WalletAccountBalance
-	(*WalletBalanceRequest)(nil),                                // 117: lnrpc.WalletBalanceRequest
-	(*WalletBalanceResponse)(nil),                               // 118: lnrpc.WalletBalanceResponse
-	(*Amount)(nil),                                              // 119: lnrpc.Amount
-	(*ChannelBalanceRequest)(nil),                               // 120: lnrpc.ChannelBalanceRequest
-	(*ChannelBalanceResponse)(nil),                              // 121: lnrpc.ChannelBalanceResponse
-	(*QueryRoutesRequest)(nil),                                  // 122: lnrpc.QueryRoutesRequest
-	(*NodePair)(nil),                                            // 123: lnrpc.NodePair
-	(*EdgeLocator)(nil),                                         // 124: lnrpc.EdgeLocator
-	(*QueryRoutesResponse)(nil),                                 // 125: lnrpc.QueryRoutesResponse
-	(*Hop)(nil),                                                 // 126: lnrpc.Hop
-	(*MPPRecord)(nil),                                           // 127: lnrpc.MPPRecord
-	(*AMPRecord)(nil),                                           // 128: lnrpc.AMPRecord
-	(*Route)(nil),                                               // 129: lnrpc.Route
-	(*NodeInfoRequest)(nil),                                     // 130: lnrpc.NodeInfoRequest
-	(*NodeInfo)(nil),                                            // 131: lnrpc.NodeInfo
-	(*LightningNode)(nil),                                       // 132: lnrpc.LightningNode
-	(*NodeAddress)(nil),                                         // 133: lnrpc.NodeAddress
-	(*RoutingPolicy)(nil),                                       // 134: lnrpc.RoutingPolicy
-	(*ChannelAuthProof)(nil),                                    // 135: lnrpc.ChannelAuthProof
-	(*ChannelEdge)(nil),                                         // 136: lnrpc.ChannelEdge
-	(*ChannelGraphRequest)(nil),                                 // 137: lnrpc.ChannelGraphRequest
-	(*ChannelGraph)(nil),                                        // 138: lnrpc.ChannelGraph
-	(*NodeMetricsRequest)(nil),                                  // 139: lnrpc.NodeMetricsRequest
-	(*NodeMetricsResponse)(nil),                                 // 140: lnrpc.NodeMetricsResponse
-	(*FloatMetric)(nil),                                         // 141: lnrpc.FloatMetric
-	(*ChanInfoRequest)(nil),                                     // 142: lnrpc.ChanInfoRequest
-	(*NetworkInfoRequest)(nil),                                  // 143: lnrpc.NetworkInfoRequest
-	(*NetworkInfo)(nil),                                         // 144: lnrpc.NetworkInfo
-	(*StopRequest)(nil),                                         // 145: lnrpc.StopRequest
-	(*StopResponse)(nil),                                        // 146: lnrpc.StopResponse
-	(*GraphTopologySubscription)(nil),                           // 147: lnrpc.GraphTopologySubscription
-	(*GraphTopologyUpdate)(nil),                                 // 148: lnrpc.GraphTopologyUpdate
-	(*NodeUpdate)(nil),                                          // 149: lnrpc.NodeUpdate
-	(*ChannelEdgeUpdate)(nil),                                   // 150: lnrpc.ChannelEdgeUpdate
-	(*ClosedChannelUpdate)(nil),                                 // 151: lnrpc.ClosedChannelUpdate
-	(*HopHint)(nil),                                             // 152: lnrpc.HopHint
-	(*SetID)(nil),                                               // 153: lnrpc.SetID
-	(*RouteHint)(nil),                                           // 154: lnrpc.RouteHint
-	(*BlindedPaymentPath)(nil),                                  // 155: lnrpc.BlindedPaymentPath
-	(*BlindedPath)(nil),                                         // 156: lnrpc.BlindedPath
-	(*BlindedHop)(nil),                                          // 157: lnrpc.BlindedHop
-	(*AMPInvoiceState)(nil),                                     // 158: lnrpc.AMPInvoiceState
-	(*Invoice)(nil),                                             // 159: lnrpc.Invoice
-	(*BlindedPathConfig)(nil),                                   // 160: lnrpc.BlindedPathConfig
-	(*InvoiceHTLC)(nil),                                         // 161: lnrpc.InvoiceHTLC
-	(*AMP)(nil),                                                 // 162: lnrpc.AMP
-	(*AddInvoiceResponse)(nil),                                  // 163: lnrpc.AddInvoiceResponse
-	(*PaymentHash)(nil),                                         // 164: lnrpc.PaymentHash
-	(*ListInvoiceRequest)(nil),                                  // 165: lnrpc.ListInvoiceRequest
-	(*ListInvoiceResponse)(nil),                                 // 166: lnrpc.ListInvoiceResponse
-	(*InvoiceSubscription)(nil),                                 // 167: lnrpc.InvoiceSubscription
-	(*DelCanceledInvoiceReq)(nil),                               // 168: lnrpc.DelCanceledInvoiceReq
-	(*DelCanceledInvoiceResp)(nil),                              // 169: lnrpc.DelCanceledInvoiceResp
-	(*Payment)(nil),                                             // 170: lnrpc.Payment
-	(*HTLCAttempt)(nil),                                         // 171: lnrpc.HTLCAttempt
-	(*ListPaymentsRequest)(nil),                                 // 172: lnrpc.ListPaymentsRequest
-	(*ListPaymentsResponse)(nil),                                // 173: lnrpc.ListPaymentsResponse
-	(*DeletePaymentRequest)(nil),                                // 174: lnrpc.DeletePaymentRequest
-	(*DeleteAllPaymentsRequest)(nil),                            // 175: lnrpc.DeleteAllPaymentsRequest
-	(*DeletePaymentResponse)(nil),                               // 176: lnrpc.DeletePaymentResponse
-	(*DeleteAllPaymentsResponse)(nil),                           // 177: lnrpc.DeleteAllPaymentsResponse
-	(*AbandonChannelRequest)(nil),                               // 178: lnrpc.AbandonChannelRequest
-	(*AbandonChannelResponse)(nil),                              // 179: lnrpc.AbandonChannelResponse
-	(*DebugLevelRequest)(nil),                                   // 180: lnrpc.DebugLevelRequest
-	(*DebugLevelResponse)(nil),                                  // 181: lnrpc.DebugLevelResponse
-	(*PayReqString)(nil),                                        // 182: lnrpc.PayReqString
-	(*PayReq)(nil),                                              // 183: lnrpc.PayReq
-	(*Feature)(nil),                                             // 184: lnrpc.Feature
-	(*FeeReportRequest)(nil),                                    // 185: lnrpc.FeeReportRequest
-	(*ChannelFeeReport)(nil),                                    // 186: lnrpc.ChannelFeeReport
-	(*FeeReportResponse)(nil),                                   // 187: lnrpc.FeeReportResponse
-	(*InboundFee)(nil),                                          // 188: lnrpc.InboundFee
-	(*PolicyUpdateRequest)(nil),                                 // 189: lnrpc.PolicyUpdateRequest
-	(*FailedUpdate)(nil),                                        // 190: lnrpc.FailedUpdate
-	(*PolicyUpdateResponse)(nil),                                // 191: lnrpc.PolicyUpdateResponse
-	(*ForwardingHistoryRequest)(nil),                            // 192: lnrpc.ForwardingHistoryRequest
-	(*ForwardingEvent)(nil),                                     // 193: lnrpc.ForwardingEvent
-	(*ForwardingHistoryResponse)(nil),                           // 194: lnrpc.ForwardingHistoryResponse
-	(*ExportChannelBackupRequest)(nil),                          // 195: lnrpc.ExportChannelBackupRequest
-	(*ChannelBackup)(nil),                                       // 196: lnrpc.ChannelBackup
-	(*MultiChanBackup)(nil),                                     // 197: lnrpc.MultiChanBackup
-	(*ChanBackupExportRequest)(nil),                             // 198: lnrpc.ChanBackupExportRequest
-	(*ChanBackupSnapshot)(nil),                                  // 199: lnrpc.ChanBackupSnapshot
-	(*ChannelBackups)(nil),                                      // 200: lnrpc.ChannelBackups
-	(*RestoreChanBackupRequest)(nil),                            // 201: lnrpc.RestoreChanBackupRequest
-	(*RestoreBackupResponse)(nil),                               // 202: lnrpc.RestoreBackupResponse
-	(*ChannelBackupSubscription)(nil),                           // 203: lnrpc.ChannelBackupSubscription
-	(*VerifyChanBackupResponse)(nil),                            // 204: lnrpc.VerifyChanBackupResponse
-	(*MacaroonPermission)(nil),                                  // 205: lnrpc.MacaroonPermission
-	(*BakeMacaroonRequest)(nil),                                 // 206: lnrpc.BakeMacaroonRequest
-	(*BakeMacaroonResponse)(nil),                                // 207: lnrpc.BakeMacaroonResponse
-	(*ListMacaroonIDsRequest)(nil),                              // 208: lnrpc.ListMacaroonIDsRequest
-	(*ListMacaroonIDsResponse)(nil),                             // 209: lnrpc.ListMacaroonIDsResponse
-	(*DeleteMacaroonIDRequest)(nil),                             // 210: lnrpc.DeleteMacaroonIDRequest
-	(*DeleteMacaroonIDResponse)(nil),                            // 211: lnrpc.DeleteMacaroonIDResponse
-	(*MacaroonPermissionList)(nil),                              // 212: lnrpc.MacaroonPermissionList
-	(*ListPermissionsRequest)(nil),                              // 213: lnrpc.ListPermissionsRequest
-	(*ListPermissionsResponse)(nil),                             // 214: lnrpc.ListPermissionsResponse
-	(*Failure)(nil),                                             // 215: lnrpc.Failure
-	(*ChannelUpdate)(nil),                                       // 216: lnrpc.ChannelUpdate
-	(*MacaroonId)(nil),                                          // 217: lnrpc.MacaroonId
-	(*Op)(nil),                                                  // 218: lnrpc.Op
-	(*CheckMacPermRequest)(nil),                                 // 219: lnrpc.CheckMacPermRequest
-	(*CheckMacPermResponse)(nil),                                // 220: lnrpc.CheckMacPermResponse
-	(*RPCMiddlewareRequest)(nil),                                // 221: lnrpc.RPCMiddlewareRequest
-	(*MetadataValues)(nil),                                      // 222: lnrpc.MetadataValues
-	(*StreamAuth)(nil),                                          // 223: lnrpc.StreamAuth
-	(*RPCMessage)(nil),                                          // 224: lnrpc.RPCMessage
-	(*RPCMiddlewareResponse)(nil),                               // 225: lnrpc.RPCMiddlewareResponse
-	(*MiddlewareRegistration)(nil),                              // 226: lnrpc.MiddlewareRegistration
-	(*InterceptFeedback)(nil),                                   // 227: lnrpc.InterceptFeedback
-	nil,                                                         // 228: lnrpc.OnionMessageUpdate.CustomRecordsEntry
-	nil,                                                         // 229: lnrpc.EstimateFeeRequest.AddrToAmountEntry
-	nil,                                                         // 230: lnrpc.SendManyRequest.AddrToAmountEntry
-	nil,                                                         // 231: lnrpc.Peer.FeaturesEntry
-	nil,                                                         // 232: lnrpc.GetInfoResponse.FeaturesEntry
-	nil,                                                         // 233: lnrpc.GetDebugInfoResponse.ConfigEntry
-	(*PendingChannelsResponse_PendingChannel)(nil),              // 234: lnrpc.PendingChannelsResponse.PendingChannel
-	(*PendingChannelsResponse_PendingOpenChannel)(nil),          // 235: lnrpc.PendingChannelsResponse.PendingOpenChannel
-	(*PendingChannelsResponse_WaitingCloseChannel)(nil),         // 236: lnrpc.PendingChannelsResponse.WaitingCloseChannel
-	(*PendingChannelsResponse_Commitments)(nil),                 // 237: lnrpc.PendingChannelsResponse.Commitments
-	(*PendingChannelsResponse_ClosedChannel)(nil),               // 238: lnrpc.PendingChannelsResponse.ClosedChannel
-	(*PendingChannelsResponse_ForceClosedChannel)(nil),          // 239: lnrpc.PendingChannelsResponse.ForceClosedChannel
-	nil, // 240: lnrpc.WalletBalanceResponse.AccountBalanceEntry
-	nil, // 241: lnrpc.QueryRoutesRequest.DestCustomRecordsEntry
-	nil, // 242: lnrpc.Hop.CustomRecordsEntry
-	nil, // 243: lnrpc.LightningNode.FeaturesEntry
-	nil, // 244: lnrpc.LightningNode.CustomRecordsEntry
-	nil, // 245: lnrpc.RoutingPolicy.CustomRecordsEntry
-	nil, // 246: lnrpc.ChannelEdge.CustomRecordsEntry
-	nil, // 247: lnrpc.NodeMetricsResponse.BetweennessCentralityEntry
-	nil, // 248: lnrpc.NodeUpdate.FeaturesEntry
-	nil, // 249: lnrpc.Invoice.FeaturesEntry
-	nil, // 250: lnrpc.Invoice.AmpInvoiceStateEntry
-	nil, // 251: lnrpc.InvoiceHTLC.CustomRecordsEntry
-	nil, // 252: lnrpc.Payment.FirstHopCustomRecordsEntry
-	nil, // 253: lnrpc.PayReq.FeaturesEntry
-	nil, // 254: lnrpc.ListPermissionsResponse.MethodPermissionsEntry
-	nil, // 255: lnrpc.RPCMiddlewareRequest.MetadataPairsEntry
+	(PeerConnPolicy_Priority)(0),         // 13: lnrpc.PeerConnPolicy.Priority
+	(PeerConnPolicy_AddrPreference)(0),   // 14: lnrpc.PeerConnPolicy.AddrPreference
+	(ChannelCloseSummary_ClosureType)(0), // 15: lnrpc.ChannelCloseSummary.ClosureType
+	(Peer_SyncType)(0),                   // 16: lnrpc.Peer.SyncType
+	(PeerEvent_EventType)(0),             // 17: lnrpc.PeerEvent.EventType
+	(PendingChannelsResponse_ForceClosedChannel_AnchorState)(0), // 18: lnrpc.PendingChannelsResponse.ForceClosedChannel.AnchorState
+	(PendingChannelsResponse_ScheduledClose_State)(0),           // 19: lnrpc.PendingChannelsResponse.ScheduledClose.State
+	(ChannelEventUpdate_UpdateType)(0),                          // 20: lnrpc.ChannelEventUpdate.UpdateType
+	(Invoice_InvoiceState)(0),                                   // 21: lnrpc.Invoice.InvoiceState
+	(Payment_PaymentStatus)(0),                                  // 22: lnrpc.Payment.PaymentStatus
+	(HTLCAttempt_HTLCStatus)(0),                                 // 23: lnrpc.HTLCAttempt.HTLCStatus
+	(Failure_FailureCode)(0),                                    // 24: lnrpc.Failure.FailureCode
+	(*ScheduleChannelCloseRequest)(nil),                         // 25: lnrpc.ScheduleChannelCloseRequest
+	(*ScheduleChannelCloseResponse)(nil),                        // 26: lnrpc.ScheduleChannelCloseResponse
+	(*CancelScheduledCloseRequest)(nil),                         // 27: lnrpc.CancelScheduledCloseRequest
+	(*CancelScheduledCloseResponse)(nil),                        // 28: lnrpc.CancelScheduledCloseResponse
+	(*PauseChannelRequest)(nil),                                 // 29: lnrpc.PauseChannelRequest
+	(*PauseChannelResponse)(nil),                                // 30: lnrpc.PauseChannelResponse
+	(*ResumeChannelRequest)(nil),                                // 31: lnrpc.ResumeChannelRequest
+	(*ResumeChannelResponse)(nil),                               // 32: lnrpc.ResumeChannelResponse
+	(*BumpFundingFeeRequest)(nil),                               // 33: lnrpc.BumpFundingFeeRequest
+	(*BumpFundingFeeResponse)(nil),                              // 34: lnrpc.BumpFundingFeeResponse
+	(*BuyLiquidityRequest)(nil),                                 // 35: lnrpc.BuyLiquidityRequest
+	(*BuyLiquidityResponse)(nil),                                // 36: lnrpc.BuyLiquidityResponse
+	(*ListLiquidityAdsRequest)(nil),                             // 37: lnrpc.ListLiquidityAdsRequest
+	(*LeaseRates)(nil),                                          // 38: lnrpc.LeaseRates
+	(*LiquidityAd)(nil),                                         // 39: lnrpc.LiquidityAd
+	(*ListLiquidityAdsResponse)(nil),                            // 40: lnrpc.ListLiquidityAdsResponse
+	(*LookupHtlcResolutionRequest)(nil),                         // 41: lnrpc.LookupHtlcResolutionRequest
+	(*LookupHtlcResolutionResponse)(nil),                        // 42: lnrpc.LookupHtlcResolutionResponse
+	(*SubscribeCustomMessagesRequest)(nil),                      // 43: lnrpc.SubscribeCustomMessagesRequest
+	(*CustomMessage)(nil),                                       // 44: lnrpc.CustomMessage
+	(*SendCustomMessageRequest)(nil),                            // 45: lnrpc.SendCustomMessageRequest
+	(*SendCustomMessageResponse)(nil),                           // 46: lnrpc.SendCustomMessageResponse
+	(*SubscribeOnionMessagesRequest)(nil),                       // 47: lnrpc.SubscribeOnionMessagesRequest
+	(*OnionMessageUpdate)(nil),                                  // 48: lnrpc.OnionMessageUpdate
+	(*SendOnionMessageRequest)(nil),                             // 49: lnrpc.SendOnionMessageRequest
+	(*SendOnionMessageResponse)(nil),                            // 50: lnrpc.SendOnionMessageResponse
+	(*Utxo)(nil),                                                // 51: lnrpc.Utxo
+	(*OutputDetail)(nil),                                        // 52: lnrpc.OutputDetail
+	(*Transaction)(nil),                                         // 53: lnrpc.Transaction
+	(*GetTransactionsRequest)(nil),                              // 54: lnrpc.GetTransactionsRequest
+	(*TransactionDetails)(nil),                                  // 55: lnrpc.TransactionDetails
+	(*FeeLimit)(nil),                                            // 56: lnrpc.FeeLimit
+	(*ChannelAcceptRequest)(nil),                                // 57: lnrpc.ChannelAcceptRequest
+	(*ChannelAcceptResponse)(nil),                               // 58: lnrpc.ChannelAcceptResponse
+	(*ChannelPoint)(nil),                                        // 59: lnrpc.ChannelPoint
+	(*OutPoint)(nil),                                            // 60: lnrpc.OutPoint
+	(*PreviousOutPoint)(nil),                                    // 61: lnrpc.PreviousOutPoint
+	(*LightningAddress)(nil),                                    // 62: lnrpc.LightningAddress
+	(*EstimateFeeRequest)(nil),                                  // 63: lnrpc.EstimateFeeRequest
+	(*EstimateFeeResponse)(nil),                                 // 64: lnrpc.EstimateFeeResponse
+	(*SendManyRequest)(nil),                                     // 65: lnrpc.SendManyRequest
+	(*SendManyResponse)(nil),                                    // 66: lnrpc.SendManyResponse
+	(*SendCoinsRequest)(nil),                                    // 67: lnrpc.SendCoinsRequest
+	(*SendCoinsResponse)(nil),                                   // 68: lnrpc.SendCoinsResponse
+	(*ListUnspentRequest)(nil),                                  // 69: lnrpc.ListUnspentRequest
+	(*ListUnspentResponse)(nil),                                 // 70: lnrpc.ListUnspentResponse
+	(*NewAddressRequest)(nil),                                   // 71: lnrpc.NewAddressRequest
+	(*NewAddressResponse)(nil),                                  // 72: lnrpc.NewAddressResponse
+	(*SignMessageRequest)(nil),                                  // 73: lnrpc.SignMessageRequest
+	(*SignMessageResponse)(nil),                                 // 74: lnrpc.SignMessageResponse
+	(*VerifyMessageRequest)(nil),                                // 75: lnrpc.VerifyMessageRequest
+	(*VerifyMessageResponse)(nil),                               // 76: lnrpc.VerifyMessageResponse
+	(*ConnectPeerRequest)(nil),                                  // 77: lnrpc.ConnectPeerRequest
+	(*ConnectPeerResponse)(nil),                                 // 78: lnrpc.ConnectPeerResponse
+	(*DisconnectPeerRequest)(nil),                               // 79: lnrpc.DisconnectPeerRequest
+	(*DisconnectPeerResponse)(nil),                              // 80: lnrpc.DisconnectPeerResponse
+	(*PeerConnPolicy)(nil),                                      // 81: lnrpc.PeerConnPolicy
+	(*SetPeerConnPolicyRequest)(nil),                            // 82: lnrpc.SetPeerConnPolicyRequest
+	(*SetPeerConnPolicyResponse)(nil),                           // 83: lnrpc.SetPeerConnPolicyResponse
+	(*ListPersistentPeersRequest)(nil),                          // 84: lnrpc.ListPersistentPeersRequest
+	(*PersistentPeer)(nil),                                      // 85: lnrpc.PersistentPeer
+	(*ListPersistentPeersResponse)(nil),                         // 86: lnrpc.ListPersistentPeersResponse
+	(*ReconnectPeerRequest)(nil),                                // 87: lnrpc.ReconnectPeerRequest
+	(*ReconnectPeerResponse)(nil),                               // 88: lnrpc.ReconnectPeerResponse
+	(*HTLC)(nil),                                                // 89: lnrpc.HTLC
+	(*ChannelConstraints)(nil),                                  // 90: lnrpc.ChannelConstraints
+	(*Channel)(nil),                                             // 91: lnrpc.Channel
+	(*ListChannelsRequest)(nil),                                 // 92: lnrpc.ListChannelsRequest
+	(*ListChannelsResponse)(nil),                                // 93: lnrpc.ListChannelsResponse
+	(*AliasMap)(nil),                                            // 94: lnrpc.AliasMap
+	(*ListAliasesRequest)(nil),                                  // 95: lnrpc.ListAliasesRequest
+	(*ListAliasesResponse)(nil),                                 // 96: lnrpc.ListAliasesResponse
+	(*ChannelCloseSummary)(nil),                                 // 97: lnrpc.ChannelCloseSummary
+	(*Resolution)(nil),                                          // 98: lnrpc.Resolution
+	(*ClosedChannelsRequest)(nil),                               // 99: lnrpc.ClosedChannelsRequest
+	(*ClosedChannelsResponse)(nil),                              // 100: lnrpc.ClosedChannelsResponse
+	(*Peer)(nil),                                                // 101: lnrpc.Peer
+	(*TimestampedError)(nil),                                    // 102: lnrpc.TimestampedError
+	(*ListPeersRequest)(nil),                                    // 103: lnrpc.ListPeersRequest
+	(*ListPeersResponse)(nil),                                   // 104: lnrpc.ListPeersResponse
+	(*PeerEventSubscription)(nil),                               // 105: lnrpc.PeerEventSubscription
+	(*PeerEvent)(nil),                                           // 106: lnrpc.PeerEvent
+	(*GetInfoRequest)(nil),                                      // 107: lnrpc.GetInfoRequest
+	(*GetInfoResponse)(nil),                                     // 108: lnrpc.GetInfoResponse
+	(*GetDebugInfoRequest)(nil),                                 // 109: lnrpc.GetDebugInfoRequest
+	(*GetDebugInfoResponse)(nil),                                // 110: lnrpc.GetDebugInfoResponse
+	(*GetRecoveryInfoRequest)(nil),                              // 111: lnrpc.GetRecoveryInfoRequest
+	(*GetRecoveryInfoResponse)(nil),                             // 112: lnrpc.GetRecoveryInfoResponse
+	(*Chain)(nil),                                               // 113: lnrpc.Chain
+	(*ChannelOpenUpdate)(nil),                                   // 114: lnrpc.ChannelOpenUpdate
+	(*CloseOutput)(nil),                                         // 115: lnrpc.CloseOutput
+	(*ChannelCloseUpdate)(nil),                                  // 116: lnrpc.ChannelCloseUpdate
+	(*CloseChannelRequest)(nil),                                 // 117: lnrpc.CloseChannelRequest
+	(*CloseStatusUpdate)(nil),                                   // 118: lnrpc.CloseStatusUpdate
+	(*PendingUpdate)(nil),                                       // 119: lnrpc.PendingUpdate
+	(*InstantUpdate)(nil),                                       // 120: lnrpc.InstantUpdate
+	(*ReadyForPsbtFunding)(nil),                                 // 121: lnrpc.ReadyForPsbtFunding
+	(*BatchOpenChannelRequest)(nil),                             // 122: lnrpc.BatchOpenChannelRequest
+	(*BatchOpenChannel)(nil),                                    // 123: lnrpc.BatchOpenChannel
+	(*BatchOpenChannelResponse)(nil),                            // 124: lnrpc.BatchOpenChannelResponse
+	(*OpenChannelRequest)(nil),                                  // 125: lnrpc.OpenChannelRequest
+	(*OpenStatusUpdate)(nil),                                    // 126: lnrpc.OpenStatusUpdate
+	(*KeyLocator)(nil),                                          // 127: lnrpc.KeyLocator
+	(*KeyDescriptor)(nil),                                       // 128: lnrpc.KeyDescriptor
+	(*ChanPointShim)(nil),                                       // 129: lnrpc.ChanPointShim
+	(*PsbtShim)(nil),                                            // 130: lnrpc.PsbtShim
+	(*FundingShim)(nil),                                         // 131: lnrpc.FundingShim
+	(*FundingShimCancel)(nil),                                   // 132: lnrpc.FundingShimCancel
+	(*FundingPsbtVerify)(nil),                                   // 133: lnrpc.FundingPsbtVerify
+	(*FundingPsbtFinalize)(nil),                                 // 134: lnrpc.FundingPsbtFinalize
+	(*FundingTransitionMsg)(nil),                                // 135: lnrpc.FundingTransitionMsg
+	(*FundingStateStepResp)(nil),                                // 136: lnrpc.FundingStateStepResp
+	(*PendingHTLC)(nil),                                         // 137: lnrpc.PendingHTLC
+	(*PendingChannelsRequest)(nil),                              // 138: lnrpc.PendingChannelsRequest
+	(*PendingChannelsResponse)(nil),                             // 139: lnrpc.PendingChannelsResponse
+	(*ChannelEventSubscription)(nil),                            // 140: lnrpc.ChannelEventSubscription
+	(*ChannelCommitUpdate)(nil),                                 // 141: lnrpc.ChannelCommitUpdate
+	(*ChannelEventUpdate)(nil),                                  // 142: lnrpc.ChannelEventUpdate
+	(*WalletAccountBalance)(nil),                                // 143: lnrpc.WalletAccountBalance
+	(*WalletBalanceRequest)(nil),                                // 144: lnrpc.WalletBalanceRequest
+	(*WalletBalanceResponse)(nil),                               // 145: lnrpc.WalletBalanceResponse
+	(*Amount)(nil),                                              // 146: lnrpc.Amount
+	(*ChannelBalanceRequest)(nil),                               // 147: lnrpc.ChannelBalanceRequest
+	(*ChannelBalanceResponse)(nil),                              // 148: lnrpc.ChannelBalanceResponse
+	(*QueryRoutesRequest)(nil),                                  // 149: lnrpc.QueryRoutesRequest
+	(*NodePair)(nil),                                            // 150: lnrpc.NodePair
+	(*EdgeLocator)(nil),                                         // 151: lnrpc.EdgeLocator
+	(*QueryRoutesResponse)(nil),                                 // 152: lnrpc.QueryRoutesResponse
+	(*Hop)(nil),                                                 // 153: lnrpc.Hop
+	(*MPPRecord)(nil),                                           // 154: lnrpc.MPPRecord
+	(*AMPRecord)(nil),                                           // 155: lnrpc.AMPRecord
+	(*Route)(nil),                                               // 156: lnrpc.Route
+	(*NodeInfoRequest)(nil),                                     // 157: lnrpc.NodeInfoRequest
+	(*NodeInfo)(nil),                                            // 158: lnrpc.NodeInfo
+	(*LightningNode)(nil),                                       // 159: lnrpc.LightningNode
+	(*NodeAddress)(nil),                                         // 160: lnrpc.NodeAddress
+	(*RoutingPolicy)(nil),                                       // 161: lnrpc.RoutingPolicy
+	(*ChannelAuthProof)(nil),                                    // 162: lnrpc.ChannelAuthProof
+	(*ChannelEdge)(nil),                                         // 163: lnrpc.ChannelEdge
+	(*ChannelGraphRequest)(nil),                                 // 164: lnrpc.ChannelGraphRequest
+	(*ChannelGraph)(nil),                                        // 165: lnrpc.ChannelGraph
+	(*NodeMetricsRequest)(nil),                                  // 166: lnrpc.NodeMetricsRequest
+	(*NodeMetricsResponse)(nil),                                 // 167: lnrpc.NodeMetricsResponse
+	(*FloatMetric)(nil),                                         // 168: lnrpc.FloatMetric
+	(*ChanInfoRequest)(nil),                                     // 169: lnrpc.ChanInfoRequest
+	(*NetworkInfoRequest)(nil),                                  // 170: lnrpc.NetworkInfoRequest
+	(*NetworkInfo)(nil),                                         // 171: lnrpc.NetworkInfo
+	(*StopRequest)(nil),                                         // 172: lnrpc.StopRequest
+	(*StopResponse)(nil),                                        // 173: lnrpc.StopResponse
+	(*GraphTopologySubscription)(nil),                           // 174: lnrpc.GraphTopologySubscription
+	(*GraphTopologyUpdate)(nil),                                 // 175: lnrpc.GraphTopologyUpdate
+	(*NodeUpdate)(nil),                                          // 176: lnrpc.NodeUpdate
+	(*ChannelEdgeUpdate)(nil),                                   // 177: lnrpc.ChannelEdgeUpdate
+	(*ClosedChannelUpdate)(nil),                                 // 178: lnrpc.ClosedChannelUpdate
+	(*HopHint)(nil),                                             // 179: lnrpc.HopHint
+	(*SetID)(nil),                                               // 180: lnrpc.SetID
+	(*RouteHint)(nil),                                           // 181: lnrpc.RouteHint
+	(*BlindedPaymentPath)(nil),                                  // 182: lnrpc.BlindedPaymentPath
+	(*BlindedPath)(nil),                                         // 183: lnrpc.BlindedPath
+	(*BlindedHop)(nil),                                          // 184: lnrpc.BlindedHop
+	(*AMPInvoiceState)(nil),                                     // 185: lnrpc.AMPInvoiceState
+	(*Invoice)(nil),                                             // 186: lnrpc.Invoice
+	(*InvoiceHoldPolicy)(nil),                                   // 187: lnrpc.InvoiceHoldPolicy
+	(*BlindedPathConfig)(nil),                                   // 188: lnrpc.BlindedPathConfig
+	(*InvoiceHTLC)(nil),                                         // 189: lnrpc.InvoiceHTLC
+	(*AMP)(nil),                                                 // 190: lnrpc.AMP
+	(*AddInvoiceResponse)(nil),                                  // 191: lnrpc.AddInvoiceResponse
+	(*PaymentHash)(nil),                                         // 192: lnrpc.PaymentHash
+	(*ListInvoiceRequest)(nil),                                  // 193: lnrpc.ListInvoiceRequest
+	(*ListInvoiceResponse)(nil),                                 // 194: lnrpc.ListInvoiceResponse
+	(*InvoiceSubscription)(nil),                                 // 195: lnrpc.InvoiceSubscription
+	(*DelCanceledInvoiceReq)(nil),                               // 196: lnrpc.DelCanceledInvoiceReq
+	(*DelCanceledInvoiceResp)(nil),                              // 197: lnrpc.DelCanceledInvoiceResp
+	(*Payment)(nil),                                             // 198: lnrpc.Payment
+	(*PaymentReceipt)(nil),                                      // 199: lnrpc.PaymentReceipt
+	(*HTLCAttempt)(nil),                                         // 200: lnrpc.HTLCAttempt
+	(*ListPaymentsRequest)(nil),                                 // 201: lnrpc.ListPaymentsRequest
+	(*ListPaymentsResponse)(nil),                                // 202: lnrpc.ListPaymentsResponse
+	(*DeletePaymentRequest)(nil),                                // 203: lnrpc.DeletePaymentRequest
+	(*DeleteAllPaymentsRequest)(nil),                            // 204: lnrpc.DeleteAllPaymentsRequest
+	(*DeletePaymentResponse)(nil),                               // 205: lnrpc.DeletePaymentResponse
+	(*DeleteAllPaymentsResponse)(nil),                           // 206: lnrpc.DeleteAllPaymentsResponse
+	(*AbandonChannelRequest)(nil),                               // 207: lnrpc.AbandonChannelRequest
+	(*AbandonChannelResponse)(nil),                              // 208: lnrpc.AbandonChannelResponse
+	(*DebugLevelRequest)(nil),                                   // 209: lnrpc.DebugLevelRequest
+	(*DebugLevelResponse)(nil),                                  // 210: lnrpc.DebugLevelResponse
+	(*PayReqString)(nil),                                        // 211: lnrpc.PayReqString
+	(*PayReq)(nil),                                              // 212: lnrpc.PayReq
+	(*Feature)(nil),                                             // 213: lnrpc.Feature
+	(*FeeReportRequest)(nil),                                    // 214: lnrpc.FeeReportRequest
+	(*ChannelFeeReport)(nil),                                    // 215: lnrpc.ChannelFeeReport
+	(*FeeReportResponse)(nil),                                   // 216: lnrpc.FeeReportResponse
+	(*InboundFee)(nil),                                          // 217: lnrpc.InboundFee
+	(*PolicyUpdateRequest)(nil),                                 // 218: lnrpc.PolicyUpdateRequest
+	(*FailedUpdate)(nil),                                        // 219: lnrpc.FailedUpdate
+	(*PolicyUpdateResponse)(nil),                                // 220: lnrpc.PolicyUpdateResponse
+	(*ForwardingHistoryRequest)(nil),                            // 221: lnrpc.ForwardingHistoryRequest
+	(*ForwardingEvent)(nil),                                     // 222: lnrpc.ForwardingEvent
+	(*ForwardingHistoryResponse)(nil),                           // 223: lnrpc.ForwardingHistoryResponse
+	(*ExportChannelBackupRequest)(nil),                          // 224: lnrpc.ExportChannelBackupRequest
+	(*ChannelBackup)(nil),                                       // 225: lnrpc.ChannelBackup
+	(*MultiChanBackup)(nil),                                     // 226: lnrpc.MultiChanBackup
+	(*ChanBackupExportRequest)(nil),                             // 227: lnrpc.ChanBackupExportRequest
+	(*ChanBackupSnapshot)(nil),                                  // 228: lnrpc.ChanBackupSnapshot
+	(*ChannelBackups)(nil),                                      // 229: lnrpc.ChannelBackups
+	(*RestoreChanBackupRequest)(nil),                            // 230: lnrpc.RestoreChanBackupRequest
+	(*RestoreBackupResponse)(nil),                               // 231: lnrpc.RestoreBackupResponse
+	(*ChannelBackupSubscription)(nil),                           // 232: lnrpc.ChannelBackupSubscription
+	(*VerifyChanBackupResponse)(nil),                            // 233: lnrpc.VerifyChanBackupResponse
+	(*MacaroonPermission)(nil),                                  // 234: lnrpc.MacaroonPermission
+	(*BakeMacaroonRequest)(nil),                                 // 235: lnrpc.BakeMacaroonRequest
+	(*BakeMacaroonResponse)(nil),                                // 236: lnrpc.BakeMacaroonResponse
+	(*ListMacaroonIDsRequest)(nil),                              // 237: lnrpc.ListMacaroonIDsRequest
+	(*ListMacaroonIDsResponse)(nil),                             // 238: lnrpc.ListMacaroonIDsResponse
+	(*DeleteMacaroonIDRequest)(nil),                             // 239: lnrpc.DeleteMacaroonIDRequest
+	(*DeleteMacaroonIDResponse)(nil),                            // 240: lnrpc.DeleteMacaroonIDResponse
+	(*MacaroonPermissionList)(nil),                              // 241: lnrpc.MacaroonPermissionList
+	(*ListPermissionsRequest)(nil),                              // 242: lnrpc.ListPermissionsRequest
+	(*ListPermissionsResponse)(nil),                             // 243: lnrpc.ListPermissionsResponse
+	(*Failure)(nil),                                             // 244: lnrpc.Failure
+	(*ChannelUpdate)(nil),                                       // 245: lnrpc.ChannelUpdate
+	(*MacaroonId)(nil),                                          // 246: lnrpc.MacaroonId
+	(*Op)(nil),                                                  // 247: lnrpc.Op
+	(*CheckMacPermRequest)(nil),                                 // 248: lnrpc.CheckMacPermRequest
+	(*CheckMacPermResponse)(nil),                                // 249: lnrpc.CheckMacPermResponse
+	(*RPCMiddlewareRequest)(nil),                                // 250: lnrpc.RPCMiddlewareRequest
+	(*MetadataValues)(nil),                                      // 251: lnrpc.MetadataValues
+	(*StreamAuth)(nil),                                          // 252: lnrpc.StreamAuth
+	(*RPCMessage)(nil),                                          // 253: lnrpc.RPCMessage
+	(*RPCMiddlewareResponse)(nil),                               // 254: lnrpc.RPCMiddlewareResponse
+	(*MiddlewareRegistration)(nil),                              // 255: lnrpc.MiddlewareRegistration
+	(*InterceptFeedback)(nil),                                   // 256: lnrpc.InterceptFeedback
+	nil,                                                         // 257: lnrpc.OnionMessageUpdate.CustomRecordsEntry
+	nil,                                                         // 258: lnrpc.EstimateFeeRequest.AddrToAmountEntry
+	nil,                                                         // 259: lnrpc.SendManyRequest.AddrToAmountEntry
+	nil,                                                         // 260: lnrpc.Peer.FeaturesEntry
+	nil,                                                         // 261: lnrpc.GetInfoResponse.FeaturesEntry
+	nil,                                                         // 262: lnrpc.GetDebugInfoResponse.ConfigEntry
+	(*PendingChannelsResponse_PendingChannel)(nil),              // 263: lnrpc.PendingChannelsResponse.PendingChannel
+	(*PendingChannelsResponse_PendingOpenChannel)(nil),          // 264: lnrpc.PendingChannelsResponse.PendingOpenChannel
+	(*PendingChannelsResponse_WaitingCloseChannel)(nil),         // 265: lnrpc.PendingChannelsResponse.WaitingCloseChannel
+	(*PendingChannelsResponse_Commitments)(nil),                 // 266: lnrpc.PendingChannelsResponse.Commitments
+	(*PendingChannelsResponse_ClosedChannel)(nil),               // 267: lnrpc.PendingChannelsResponse.ClosedChannel
+	(*PendingChannelsResponse_ForceClosedChannel)(nil),          // 268: lnrpc.PendingChannelsResponse.ForceClosedChannel
+	(*PendingChannelsResponse_ScheduledClose)(nil),              // 269: lnrpc.PendingChannelsResponse.ScheduledClose
+	nil, // 270: lnrpc.WalletBalanceResponse.AccountBalanceEntry
+	nil, // 271: lnrpc.QueryRoutesRequest.DestCustomRecordsEntry
+	nil, // 272: lnrpc.Hop.CustomRecordsEntry
+	nil, // 273: lnrpc.LightningNode.FeaturesEntry
+	nil, // 274: lnrpc.LightningNode.CustomRecordsEntry
+	nil, // 275: lnrpc.RoutingPolicy.CustomRecordsEntry
+	nil, // 276: lnrpc.ChannelEdge.CustomRecordsEntry
+	nil, // 277: lnrpc.NodeMetricsResponse.BetweennessCentralityEntry
+	nil, // 278: lnrpc.NodeUpdate.FeaturesEntry
+	nil, // 279: lnrpc.Invoice.FeaturesEntry
+	nil, // 280: lnrpc.Invoice.AmpInvoiceStateEntry
+	nil, // 281: lnrpc.InvoiceHTLC.CustomRecordsEntry
+	nil, // 282: lnrpc.Payment.FirstHopCustomRecordsEntry
+	nil, // 283: lnrpc.PayReq.FeaturesEntry
+	nil, // 284: lnrpc.ListPermissionsResponse.MethodPermissionsEntry
+	nil, // 285: lnrpc.RPCMiddlewareRequest.MetadataPairsEntry
 }
 var file_lightning_proto_depIdxs = []int32{
-	156, // 0: lnrpc.OnionMessageUpdate.reply_path:type_name -> lnrpc.BlindedPath
-	228, // 1: lnrpc.OnionMessageUpdate.custom_records:type_name -> lnrpc.OnionMessageUpdate.CustomRecordsEntry
-	2,   // 2: lnrpc.Utxo.address_type:type_name -> lnrpc.AddressType
-	41,  // 3: lnrpc.Utxo.outpoint:type_name -> lnrpc.OutPoint
-	0,   // 4: lnrpc.OutputDetail.output_type:type_name -> lnrpc.OutputScriptType
-	33,  // 5: lnrpc.Transaction.output_details:type_name -> lnrpc.OutputDetail
-	42,  // 6: lnrpc.Transaction.previous_outpoints:type_name -> lnrpc.PreviousOutPoint
-	34,  // 7: lnrpc.TransactionDetails.transactions:type_name -> lnrpc.Transaction
-	3,   // 8: lnrpc.ChannelAcceptRequest.commitment_type:type_name -> lnrpc.CommitmentType
-	229, // 9: lnrpc.EstimateFeeRequest.AddrToAmount:type_name -> lnrpc.EstimateFeeRequest.AddrToAmountEntry
-	1,   // 10: lnrpc.EstimateFeeRequest.coin_selection_strategy:type_name -> lnrpc.CoinSelectionStrategy
-	41,  // 11: lnrpc.EstimateFeeRequest.inputs:type_name -> lnrpc.OutPoint
-	41,  // 12: lnrpc.EstimateFeeResponse.inputs:type_name -> lnrpc.OutPoint
-	230, // 13: lnrpc.SendManyRequest.AddrToAmount:type_name -> lnrpc.SendManyRequest.AddrToAmountEntry
-	1,   // 14: lnrpc.SendManyRequest.coin_selection_strategy:type_name -> lnrpc.CoinSelectionStrategy
-	1,   // 15: lnrpc.SendCoinsRequest.coin_selection_strategy:type_name -> lnrpc.CoinSelectionStrategy
-	41,  // 16: lnrpc.SendCoinsRequest.outpoints:type_name -> lnrpc.OutPoint
-	32,  // 17: lnrpc.ListUnspentResponse.utxos:type_name -> lnrpc.Utxo
-	2,   // 18: lnrpc.NewAddressRequest.type:type_name -> lnrpc.AddressType
-	43,  // 19: lnrpc.ConnectPeerRequest.addr:type_name -> lnrpc.LightningAddress
-	62,  // 20: lnrpc.Channel.pending_htlcs:type_name -> lnrpc.HTLC
-	3,   // 21: lnrpc.Channel.commitment_type:type_name -> lnrpc.CommitmentType
-	63,  // 22: lnrpc.Channel.local_constraints:type_name -> lnrpc.ChannelConstraints
-	63,  // 23: lnrpc.Channel.remote_constraints:type_name -> lnrpc.ChannelConstraints
-	64,  // 24: lnrpc.ListChannelsResponse.channels:type_name -> lnrpc.Channel
-	67,  // 25: lnrpc.ListAliasesResponse.alias_maps:type_name -> lnrpc.AliasMap
-	13,  // 26: lnrpc.ChannelCloseSummary.close_type:type_name -> lnrpc.ChannelCloseSummary.ClosureType
-	4,   // 27: lnrpc.ChannelCloseSummary.open_initiator:type_name -> lnrpc.Initiator
-	4,   // 28: lnrpc.ChannelCloseSummary.close_initiator:type_name -> lnrpc.Initiator
-	71,  // 29: lnrpc.ChannelCloseSummary.resolutions:type_name -> lnrpc.Resolution
-	5,   // 30: lnrpc.Resolution.resolution_type:type_name -> lnrpc.ResolutionType
-	6,   // 31: lnrpc.Resolution.outcome:type_name -> lnrpc.ResolutionOutcome
-	41,  // 32: lnrpc.Resolution.outpoint:type_name -> lnrpc.OutPoint
-	70,  // 33: lnrpc.ClosedChannelsResponse.channels:type_name -> lnrpc.ChannelCloseSummary
-	14,  // 34: lnrpc.Peer.sync_type:type_name -> lnrpc.Peer.SyncType
-	231, // 35: lnrpc.Peer.features:type_name -> lnrpc.Peer.FeaturesEntry
-	75,  // 36: lnrpc.Peer.errors:type_name -> lnrpc.TimestampedError
-	74,  // 37: lnrpc.ListPeersResponse.peers:type_name -> lnrpc.Peer
-	15,  // 38: lnrpc.PeerEvent.type:type_name -> lnrpc.PeerEvent.EventType
-	86,  // 39: lnrpc.GetInfoResponse.chains:type_name -> lnrpc.Chain
-	232, // 40: lnrpc.GetInfoResponse.features:type_name -> lnrpc.GetInfoResponse.FeaturesEntry
-	7,   // 41: lnrpc.GetInfoResponse.graph_cache_status:type_name -> lnrpc.GraphCacheStatus
-	233, // 42: lnrpc.GetDebugInfoResponse.config:type_name -> lnrpc.GetDebugInfoResponse.ConfigEntry
-	40,  // 43: lnrpc.ChannelOpenUpdate.channel_point:type_name -> lnrpc.ChannelPoint
-	88,  // 44: lnrpc.ChannelCloseUpdate.local_close_output:type_name -> lnrpc.CloseOutput
-	88,  // 45: lnrpc.ChannelCloseUpdate.remote_close_output:type_name -> lnrpc.CloseOutput
-	88,  // 46: lnrpc.ChannelCloseUpdate.additional_outputs:type_name -> lnrpc.CloseOutput
-	40,  // 47: lnrpc.CloseChannelRequest.channel_point:type_name -> lnrpc.ChannelPoint
-	92,  // 48: lnrpc.CloseStatusUpdate.close_pending:type_name -> lnrpc.PendingUpdate
-	89,  // 49: lnrpc.CloseStatusUpdate.chan_close:type_name -> lnrpc.ChannelCloseUpdate
-	93,  // 50: lnrpc.CloseStatusUpdate.close_instant:type_name -> lnrpc.InstantUpdate
-	96,  // 51: lnrpc.BatchOpenChannelRequest.channels:type_name -> lnrpc.BatchOpenChannel
-	1,   // 52: lnrpc.BatchOpenChannelRequest.coin_selection_strategy:type_name -> lnrpc.CoinSelectionStrategy
-	3,   // 53: lnrpc.BatchOpenChannel.commitment_type:type_name -> lnrpc.CommitmentType
-	92,  // 54: lnrpc.BatchOpenChannelResponse.pending_channels:type_name -> lnrpc.PendingUpdate
-	104, // 55: lnrpc.OpenChannelRequest.funding_shim:type_name -> lnrpc.FundingShim
-	3,   // 56: lnrpc.OpenChannelRequest.commitment_type:type_name -> lnrpc.CommitmentType
-	41,  // 57: lnrpc.OpenChannelRequest.outpoints:type_name -> lnrpc.OutPoint
-	92,  // 58: lnrpc.OpenStatusUpdate.chan_pending:type_name -> lnrpc.PendingUpdate
-	87,  // 59: lnrpc.OpenStatusUpdate.chan_open:type_name -> lnrpc.ChannelOpenUpdate
-	94,  // 60: lnrpc.OpenStatusUpdate.psbt_fund:type_name -> lnrpc.ReadyForPsbtFunding
-	100, // 61: lnrpc.KeyDescriptor.key_loc:type_name -> lnrpc.KeyLocator
-	40,  // 62: lnrpc.ChanPointShim.chan_point:type_name -> lnrpc.ChannelPoint
-	101, // 63: lnrpc.ChanPointShim.local_key:type_name -> lnrpc.KeyDescriptor
-	102, // 64: lnrpc.FundingShim.chan_point_shim:type_name -> lnrpc.ChanPointShim
-	103, // 65: lnrpc.FundingShim.psbt_shim:type_name -> lnrpc.PsbtShim
-	104, // 66: lnrpc.FundingTransitionMsg.shim_register:type_name -> lnrpc.FundingShim
-	105, // 67: lnrpc.FundingTransitionMsg.shim_cancel:type_name -> lnrpc.FundingShimCancel
-	106, // 68: lnrpc.FundingTransitionMsg.psbt_verify:type_name -> lnrpc.FundingPsbtVerify
-	107, // 69: lnrpc.FundingTransitionMsg.psbt_finalize:type_name -> lnrpc.FundingPsbtFinalize
-	235, // 70: lnrpc.PendingChannelsResponse.pending_open_channels:type_name -> lnrpc.PendingChannelsResponse.PendingOpenChannel
-	238, // 71: lnrpc.PendingChannelsResponse.pending_closing_channels:type_name -> lnrpc.PendingChannelsResponse.ClosedChannel
-	239, // 72: lnrpc.PendingChannelsResponse.pending_force_closing_channels:type_name -> lnrpc.PendingChannelsResponse.ForceClosedChannel
-	236, // 73: lnrpc.PendingChannelsResponse.waiting_close_channels:type_name -> lnrpc.PendingChannelsResponse.WaitingCloseChannel
-	64,  // 74: lnrpc.ChannelCommitUpdate.channel:type_name -> lnrpc.Channel
-	64,  // 75: lnrpc.ChannelEventUpdate.open_channel:type_name -> lnrpc.Channel
-	70,  // 76: lnrpc.ChannelEventUpdate.closed_channel:type_name -> lnrpc.ChannelCloseSummary
-	40,  // 77: lnrpc.ChannelEventUpdate.active_channel:type_name -> lnrpc.ChannelPoint
-	40,  // 78: lnrpc.ChannelEventUpdate.inactive_channel:type_name -> lnrpc.ChannelPoint
-	92,  // 79: lnrpc.ChannelEventUpdate.pending_open_channel:type_name -> lnrpc.PendingUpdate
-	40,  // 80: lnrpc.ChannelEventUpdate.fully_resolved_channel:type_name -> lnrpc.ChannelPoint
-	40,  // 81: lnrpc.ChannelEventUpdate.channel_funding_timeout:type_name -> lnrpc.ChannelPoint
-	114, // 82: lnrpc.ChannelEventUpdate.updated_channel:type_name -> lnrpc.ChannelCommitUpdate
-	17,  // 83: lnrpc.ChannelEventUpdate.type:type_name -> lnrpc.ChannelEventUpdate.UpdateType
-	240, // 84: lnrpc.WalletBalanceResponse.account_balance:type_name -> lnrpc.WalletBalanceResponse.AccountBalanceEntry
-	119, // 85: lnrpc.ChannelBalanceResponse.local_balance:type_name -> lnrpc.Amount
-	119, // 86: lnrpc.ChannelBalanceResponse.remote_balance:type_name -> lnrpc.Amount
-	119, // 87: lnrpc.ChannelBalanceResponse.unsettled_local_balance:type_name -> lnrpc.Amount
-	119, // 88: lnrpc.ChannelBalanceResponse.unsettled_remote_balance:type_name -> lnrpc.Amount
-	119, // 89: lnrpc.ChannelBalanceResponse.pending_open_local_balance:type_name -> lnrpc.Amount
-	119, // 90: lnrpc.ChannelBalanceResponse.pending_open_remote_balance:type_name -> lnrpc.Amount
-	37,  // 91: lnrpc.QueryRoutesRequest.fee_limit:type_name -> lnrpc.FeeLimit
-	124, // 92: lnrpc.QueryRoutesRequest.ignored_edges:type_name -> lnrpc.EdgeLocator
-	123, // 93: lnrpc.QueryRoutesRequest.ignored_pairs:type_name -> lnrpc.NodePair
-	241, // 94: lnrpc.QueryRoutesRequest.dest_custom_records:type_name -> lnrpc.QueryRoutesRequest.DestCustomRecordsEntry
-	154, // 95: lnrpc.QueryRoutesRequest.route_hints:type_name -> lnrpc.RouteHint
-	155, // 96: lnrpc.QueryRoutesRequest.blinded_payment_paths:type_name -> lnrpc.BlindedPaymentPath
-	11,  // 97: lnrpc.QueryRoutesRequest.dest_features:type_name -> lnrpc.FeatureBit
-	129, // 98: lnrpc.QueryRoutesResponse.routes:type_name -> lnrpc.Route
-	127, // 99: lnrpc.Hop.mpp_record:type_name -> lnrpc.MPPRecord
-	128, // 100: lnrpc.Hop.amp_record:type_name -> lnrpc.AMPRecord
-	242, // 101: lnrpc.Hop.custom_records:type_name -> lnrpc.Hop.CustomRecordsEntry
-	126, // 102: lnrpc.Route.hops:type_name -> lnrpc.Hop
-	132, // 103: lnrpc.NodeInfo.node:type_name -> lnrpc.LightningNode
-	136, // 104: lnrpc.NodeInfo.channels:type_name -> lnrpc.ChannelEdge
-	133, // 105: lnrpc.LightningNode.addresses:type_name -> lnrpc.NodeAddress
-	243, // 106: lnrpc.LightningNode.features:type_name -> lnrpc.LightningNode.FeaturesEntry
-	244, // 107: lnrpc.LightningNode.custom_records:type_name -> lnrpc.LightningNode.CustomRecordsEntry
-	245, // 108: lnrpc.RoutingPolicy.custom_records:type_name -> lnrpc.RoutingPolicy.CustomRecordsEntry
-	134, // 109: lnrpc.ChannelEdge.node1_policy:type_name -> lnrpc.RoutingPolicy
-	134, // 110: lnrpc.ChannelEdge.node2_policy:type_name -> lnrpc.RoutingPolicy
-	246, // 111: lnrpc.ChannelEdge.custom_records:type_name -> lnrpc.ChannelEdge.CustomRecordsEntry
-	135, // 112: lnrpc.ChannelEdge.auth_proof:type_name -> lnrpc.ChannelAuthProof
-	132, // 113: lnrpc.ChannelGraph.nodes:type_name -> lnrpc.LightningNode
-	136, // 114: lnrpc.ChannelGraph.edges:type_name -> lnrpc.ChannelEdge
-	8,   // 115: lnrpc.NodeMetricsRequest.types:type_name -> lnrpc.NodeMetricType
-	247, // 116: lnrpc.NodeMetricsResponse.betweenness_centrality:type_name -> lnrpc.NodeMetricsResponse.BetweennessCentralityEntry
-	149, // 117: lnrpc.GraphTopologyUpdate.node_updates:type_name -> lnrpc.NodeUpdate
-	150, // 118: lnrpc.GraphTopologyUpdate.channel_updates:type_name -> lnrpc.ChannelEdgeUpdate
-	151, // 119: lnrpc.GraphTopologyUpdate.closed_chans:type_name -> lnrpc.ClosedChannelUpdate
-	133, // 120: lnrpc.NodeUpdate.node_addresses:type_name -> lnrpc.NodeAddress
-	248, // 121: lnrpc.NodeUpdate.features:type_name -> lnrpc.NodeUpdate.FeaturesEntry
-	40,  // 122: lnrpc.ChannelEdgeUpdate.chan_point:type_name -> lnrpc.ChannelPoint
-	134, // 123: lnrpc.ChannelEdgeUpdate.routing_policy:type_name -> lnrpc.RoutingPolicy
-	40,  // 124: lnrpc.ClosedChannelUpdate.chan_point:type_name -> lnrpc.ChannelPoint
-	152, // 125: lnrpc.RouteHint.hop_hints:type_name -> lnrpc.HopHint
-	156, // 126: lnrpc.BlindedPaymentPath.blinded_path:type_name -> lnrpc.BlindedPath
-	11,  // 127: lnrpc.BlindedPaymentPath.features:type_name -> lnrpc.FeatureBit
-	157, // 128: lnrpc.BlindedPath.blinded_hops:type_name -> lnrpc.BlindedHop
-	9,   // 129: lnrpc.AMPInvoiceState.state:type_name -> lnrpc.InvoiceHTLCState
-	154, // 130: lnrpc.Invoice.route_hints:type_name -> lnrpc.RouteHint
-	18,  // 131: lnrpc.Invoice.state:type_name -> lnrpc.Invoice.InvoiceState
-	161, // 132: lnrpc.Invoice.htlcs:type_name -> lnrpc.InvoiceHTLC
-	249, // 133: lnrpc.Invoice.features:type_name -> lnrpc.Invoice.FeaturesEntry
-	250, // 134: lnrpc.Invoice.amp_invoice_state:type_name -> lnrpc.Invoice.AmpInvoiceStateEntry
-	160, // 135: lnrpc.Invoice.blinded_path_config:type_name -> lnrpc.BlindedPathConfig
-	9,   // 136: lnrpc.InvoiceHTLC.state:type_name -> lnrpc.InvoiceHTLCState
-	251, // 137: lnrpc.InvoiceHTLC.custom_records:type_name -> lnrpc.InvoiceHTLC.CustomRecordsEntry
-	162, // 138: lnrpc.InvoiceHTLC.amp:type_name -> lnrpc.AMP
-	159, // 139: lnrpc.ListInvoiceResponse.invoices:type_name -> lnrpc.Invoice
-	19,  // 140: lnrpc.Payment.status:type_name -> lnrpc.Payment.PaymentStatus
-	171, // 141: lnrpc.Payment.htlcs:type_name -> lnrpc.HTLCAttempt
-	10,  // 142: lnrpc.Payment.failure_reason:type_name -> lnrpc.PaymentFailureReason
-	252, // 143: lnrpc.Payment.first_hop_custom_records:type_name -> lnrpc.Payment.FirstHopCustomRecordsEntry
-	20,  // 144: lnrpc.HTLCAttempt.status:type_name -> lnrpc.HTLCAttempt.HTLCStatus
-	129, // 145: lnrpc.HTLCAttempt.route:type_name -> lnrpc.Route
-	215, // 146: lnrpc.HTLCAttempt.failure:type_name -> lnrpc.Failure
-	170, // 147: lnrpc.ListPaymentsResponse.payments:type_name -> lnrpc.Payment
-	40,  // 148: lnrpc.AbandonChannelRequest.channel_point:type_name -> lnrpc.ChannelPoint
-	154, // 149: lnrpc.PayReq.route_hints:type_name -> lnrpc.RouteHint
-	253, // 150: lnrpc.PayReq.features:type_name -> lnrpc.PayReq.FeaturesEntry
-	155, // 151: lnrpc.PayReq.blinded_paths:type_name -> lnrpc.BlindedPaymentPath
-	186, // 152: lnrpc.FeeReportResponse.channel_fees:type_name -> lnrpc.ChannelFeeReport
-	40,  // 153: lnrpc.PolicyUpdateRequest.chan_point:type_name -> lnrpc.ChannelPoint
-	188, // 154: lnrpc.PolicyUpdateRequest.inbound_fee:type_name -> lnrpc.InboundFee
-	41,  // 155: lnrpc.FailedUpdate.outpoint:type_name -> lnrpc.OutPoint
-	12,  // 156: lnrpc.FailedUpdate.reason:type_name -> lnrpc.UpdateFailure
-	190, // 157: lnrpc.PolicyUpdateResponse.failed_updates:type_name -> lnrpc.FailedUpdate
-	193, // 158: lnrpc.ForwardingHistoryResponse.forwarding_events:type_name -> lnrpc.ForwardingEvent
-	40,  // 159: lnrpc.ExportChannelBackupRequest.chan_point:type_name -> lnrpc.ChannelPoint
-	40,  // 160: lnrpc.ChannelBackup.chan_point:type_name -> lnrpc.ChannelPoint
-	40,  // 161: lnrpc.MultiChanBackup.chan_points:type_name -> lnrpc.ChannelPoint
-	200, // 162: lnrpc.ChanBackupSnapshot.single_chan_backups:type_name -> lnrpc.ChannelBackups
-	197, // 163: lnrpc.ChanBackupSnapshot.multi_chan_backup:type_name -> lnrpc.MultiChanBackup
-	196, // 164: lnrpc.ChannelBackups.chan_backups:type_name -> lnrpc.ChannelBackup
-	200, // 165: lnrpc.RestoreChanBackupRequest.chan_backups:type_name -> lnrpc.ChannelBackups
-	205, // 166: lnrpc.BakeMacaroonRequest.permissions:type_name -> lnrpc.MacaroonPermission
-	205, // 167: lnrpc.MacaroonPermissionList.permissions:type_name -> lnrpc.MacaroonPermission
-	254, // 168: lnrpc.ListPermissionsResponse.method_permissions:type_name -> lnrpc.ListPermissionsResponse.MethodPermissionsEntry
-	21,  // 169: lnrpc.Failure.code:type_name -> lnrpc.Failure.FailureCode
-	216, // 170: lnrpc.Failure.channel_update:type_name -> lnrpc.ChannelUpdate
-	218, // 171: lnrpc.MacaroonId.ops:type_name -> lnrpc.Op
-	205, // 172: lnrpc.CheckMacPermRequest.permissions:type_name -> lnrpc.MacaroonPermission
-	223, // 173: lnrpc.RPCMiddlewareRequest.stream_auth:type_name -> lnrpc.StreamAuth
-	224, // 174: lnrpc.RPCMiddlewareRequest.request:type_name -> lnrpc.RPCMessage
-	224, // 175: lnrpc.RPCMiddlewareRequest.response:type_name -> lnrpc.RPCMessage
-	255, // 176: lnrpc.RPCMiddlewareRequest.metadata_pairs:type_name -> lnrpc.RPCMiddlewareRequest.MetadataPairsEntry
-	226, // 177: lnrpc.RPCMiddlewareResponse.register:type_name -> lnrpc.MiddlewareRegistration
-	227, // 178: lnrpc.RPCMiddlewareResponse.feedback:type_name -> lnrpc.InterceptFeedback
-	184, // 179: lnrpc.Peer.FeaturesEntry.value:type_name -> lnrpc.Feature
-	184, // 180: lnrpc.GetInfoResponse.FeaturesEntry.value:type_name -> lnrpc.Feature
-	4,   // 181: lnrpc.PendingChannelsResponse.PendingChannel.initiator:type_name -> lnrpc.Initiator
-	3,   // 182: lnrpc.PendingChannelsResponse.PendingChannel.commitment_type:type_name -> lnrpc.CommitmentType
-	234, // 183: lnrpc.PendingChannelsResponse.PendingOpenChannel.channel:type_name -> lnrpc.PendingChannelsResponse.PendingChannel
-	234, // 184: lnrpc.PendingChannelsResponse.WaitingCloseChannel.channel:type_name -> lnrpc.PendingChannelsResponse.PendingChannel
-	237, // 185: lnrpc.PendingChannelsResponse.WaitingCloseChannel.commitments:type_name -> lnrpc.PendingChannelsResponse.Commitments
-	234, // 186: lnrpc.PendingChannelsResponse.ClosedChannel.channel:type_name -> lnrpc.PendingChannelsResponse.PendingChannel
-	234, // 187: lnrpc.PendingChannelsResponse.ForceClosedChannel.channel:type_name -> lnrpc.PendingChannelsResponse.PendingChannel
-	110, // 188: lnrpc.PendingChannelsResponse.ForceClosedChannel.pending_htlcs:type_name -> lnrpc.PendingHTLC
-	16,  // 189: lnrpc.PendingChannelsResponse.ForceClosedChannel.anchor:type_name -> lnrpc.PendingChannelsResponse.ForceClosedChannel.AnchorState
-	116, // 190: lnrpc.WalletBalanceResponse.AccountBalanceEntry.value:type_name -> lnrpc.WalletAccountBalance
-	184, // 191: lnrpc.LightningNode.FeaturesEntry.value:type_name -> lnrpc.Feature
-	141, // 192: lnrpc.NodeMetricsResponse.BetweennessCentralityEntry.value:type_name -> lnrpc.FloatMetric
-	184, // 193: lnrpc.NodeUpdate.FeaturesEntry.value:type_name -> lnrpc.Feature
-	184, // 194: lnrpc.Invoice.FeaturesEntry.value:type_name -> lnrpc.Feature
-	158, // 195: lnrpc.Invoice.AmpInvoiceStateEntry.value:type_name -> lnrpc.AMPInvoiceState
-	184, // 196: lnrpc.PayReq.FeaturesEntry.value:type_name -> lnrpc.Feature
-	212, // 197: lnrpc.ListPermissionsResponse.MethodPermissionsEntry.value:type_name -> lnrpc.MacaroonPermissionList
-	222, // 198: lnrpc.RPCMiddlewareRequest.MetadataPairsEntry.value:type_name -> lnrpc.MetadataValues
-	117, // 199: lnrpc.Lightning.WalletBalance:input_type -> lnrpc.WalletBalanceRequest
-	120, // 200: lnrpc.Lightning.ChannelBalance:input_type -> lnrpc.ChannelBalanceRequest
-	35,  // 201: lnrpc.Lightning.GetTransactions:input_type -> lnrpc.GetTransactionsRequest
-	44,  // 202: lnrpc.Lightning.EstimateFee:input_type -> lnrpc.EstimateFeeRequest
-	48,  // 203: lnrpc.Lightning.SendCoins:input_type -> lnrpc.SendCoinsRequest
-	50,  // 204: lnrpc.Lightning.ListUnspent:input_type -> lnrpc.ListUnspentRequest
-	35,  // 205: lnrpc.Lightning.SubscribeTransactions:input_type -> lnrpc.GetTransactionsRequest
-	46,  // 206: lnrpc.Lightning.SendMany:input_type -> lnrpc.SendManyRequest
-	52,  // 207: lnrpc.Lightning.NewAddress:input_type -> lnrpc.NewAddressRequest
-	54,  // 208: lnrpc.Lightning.SignMessage:input_type -> lnrpc.SignMessageRequest
-	56,  // 209: lnrpc.Lightning.VerifyMessage:input_type -> lnrpc.VerifyMessageRequest
-	58,  // 210: lnrpc.Lightning.ConnectPeer:input_type -> lnrpc.ConnectPeerRequest
-	60,  // 211: lnrpc.Lightning.DisconnectPeer:input_type -> lnrpc.DisconnectPeerRequest
-	76,  // 212: lnrpc.Lightning.ListPeers:input_type -> lnrpc.ListPeersRequest
-	78,  // 213: lnrpc.Lightning.SubscribePeerEvents:input_type -> lnrpc.PeerEventSubscription
-	80,  // 214: lnrpc.Lightning.GetInfo:input_type -> lnrpc.GetInfoRequest
-	82,  // 215: lnrpc.Lightning.GetDebugInfo:input_type -> lnrpc.GetDebugInfoRequest
-	84,  // 216: lnrpc.Lightning.GetRecoveryInfo:input_type -> lnrpc.GetRecoveryInfoRequest
-	111, // 217: lnrpc.Lightning.PendingChannels:input_type -> lnrpc.PendingChannelsRequest
-	65,  // 218: lnrpc.Lightning.ListChannels:input_type -> lnrpc.ListChannelsRequest
-	113, // 219: lnrpc.Lightning.SubscribeChannelEvents:input_type -> lnrpc.ChannelEventSubscription
-	72,  // 220: lnrpc.Lightning.ClosedChannels:input_type -> lnrpc.ClosedChannelsRequest
-	98,  // 221: lnrpc.Lightning.OpenChannelSync:input_type -> lnrpc.OpenChannelRequest
-	98,  // 222: lnrpc.Lightning.OpenChannel:input_type -> lnrpc.OpenChannelRequest
-	95,  // 223: lnrpc.Lightning.BatchOpenChannel:input_type -> lnrpc.BatchOpenChannelRequest
-	108, // 224: lnrpc.Lightning.FundingStateStep:input_type -> lnrpc.FundingTransitionMsg
-	39,  // 225: lnrpc.Lightning.ChannelAcceptor:input_type -> lnrpc.ChannelAcceptResponse
-	90,  // 226: lnrpc.Lightning.CloseChannel:input_type -> lnrpc.CloseChannelRequest
-	178, // 227: lnrpc.Lightning.AbandonChannel:input_type -> lnrpc.AbandonChannelRequest
-	159, // 228: lnrpc.Lightning.AddInvoice:input_type -> lnrpc.Invoice
-	165, // 229: lnrpc.Lightning.ListInvoices:input_type -> lnrpc.ListInvoiceRequest
-	164, // 230: lnrpc.Lightning.LookupInvoice:input_type -> lnrpc.PaymentHash
-	167, // 231: lnrpc.Lightning.SubscribeInvoices:input_type -> lnrpc.InvoiceSubscription
-	168, // 232: lnrpc.Lightning.DeleteCanceledInvoice:input_type -> lnrpc.DelCanceledInvoiceReq
-	182, // 233: lnrpc.Lightning.DecodePayReq:input_type -> lnrpc.PayReqString
-	172, // 234: lnrpc.Lightning.ListPayments:input_type -> lnrpc.ListPaymentsRequest
-	174, // 235: lnrpc.Lightning.DeletePayment:input_type -> lnrpc.DeletePaymentRequest
-	175, // 236: lnrpc.Lightning.DeleteAllPayments:input_type -> lnrpc.DeleteAllPaymentsRequest
-	137, // 237: lnrpc.Lightning.DescribeGraph:input_type -> lnrpc.ChannelGraphRequest
-	139, // 238: lnrpc.Lightning.GetNodeMetrics:input_type -> lnrpc.NodeMetricsRequest
-	142, // 239: lnrpc.Lightning.GetChanInfo:input_type -> lnrpc.ChanInfoRequest
-	130, // 240: lnrpc.Lightning.GetNodeInfo:input_type -> lnrpc.NodeInfoRequest
-	122, // 241: lnrpc.Lightning.QueryRoutes:input_type -> lnrpc.QueryRoutesRequest
-	143, // 242: lnrpc.Lightning.GetNetworkInfo:input_type -> lnrpc.NetworkInfoRequest
-	145, // 243: lnrpc.Lightning.StopDaemon:input_type -> lnrpc.StopRequest
-	147, // 244: lnrpc.Lightning.SubscribeChannelGraph:input_type -> lnrpc.GraphTopologySubscription
-	180, // 245: lnrpc.Lightning.DebugLevel:input_type -> lnrpc.DebugLevelRequest
-	185, // 246: lnrpc.Lightning.FeeReport:input_type -> lnrpc.FeeReportRequest
-	189, // 247: lnrpc.Lightning.UpdateChannelPolicy:input_type -> lnrpc.PolicyUpdateRequest
-	192, // 248: lnrpc.Lightning.ForwardingHistory:input_type -> lnrpc.ForwardingHistoryRequest
-	195, // 249: lnrpc.Lightning.ExportChannelBackup:input_type -> lnrpc.ExportChannelBackupRequest
-	198, // 250: lnrpc.Lightning.ExportAllChannelBackups:input_type -> lnrpc.ChanBackupExportRequest
-	199, // 251: lnrpc.Lightning.VerifyChanBackup:input_type -> lnrpc.ChanBackupSnapshot
-	201, // 252: lnrpc.Lightning.RestoreChannelBackups:input_type -> lnrpc.RestoreChanBackupRequest
-	203, // 253: lnrpc.Lightning.SubscribeChannelBackups:input_type -> lnrpc.ChannelBackupSubscription
-	206, // 254: lnrpc.Lightning.BakeMacaroon:input_type -> lnrpc.BakeMacaroonRequest
-	208, // 255: lnrpc.Lightning.ListMacaroonIDs:input_type -> lnrpc.ListMacaroonIDsRequest
-	210, // 256: lnrpc.Lightning.DeleteMacaroonID:input_type -> lnrpc.DeleteMacaroonIDRequest
-	213, // 257: lnrpc.Lightning.ListPermissions:input_type -> lnrpc.ListPermissionsRequest
-	219, // 258: lnrpc.Lightning.CheckMacaroonPermissions:input_type -> lnrpc.CheckMacPermRequest
-	225, // 259: lnrpc.Lightning.RegisterRPCMiddleware:input_type -> lnrpc.RPCMiddlewareResponse
-	26,  // 260: lnrpc.Lightning.SendCustomMessage:input_type -> lnrpc.SendCustomMessageRequest
-	24,  // 261: lnrpc.Lightning.SubscribeCustomMessages:input_type -> lnrpc.SubscribeCustomMessagesRequest
-	30,  // 262: lnrpc.Lightning.SendOnionMessage:input_type -> lnrpc.SendOnionMessageRequest
-	28,  // 263: lnrpc.Lightning.SubscribeOnionMessages:input_type -> lnrpc.SubscribeOnionMessagesRequest
-	68,  // 264: lnrpc.Lightning.ListAliases:input_type -> lnrpc.ListAliasesRequest
-	22,  // 265: lnrpc.Lightning.LookupHtlcResolution:input_type -> lnrpc.LookupHtlcResolutionRequest
-	118, // 266: lnrpc.Lightning.WalletBalance:output_type -> lnrpc.WalletBalanceResponse
-	121, // 267: lnrpc.Lightning.ChannelBalance:output_type -> lnrpc.ChannelBalanceResponse
-	36,  // 268: lnrpc.Lightning.GetTransactions:output_type -> lnrpc.TransactionDetails
-	45,  // 269: lnrpc.Lightning.EstimateFee:output_type -> lnrpc.EstimateFeeResponse
-	49,  // 270: lnrpc.Lightning.SendCoins:output_type -> lnrpc.SendCoinsResponse
-	51,  // 271: lnrpc.Lightning.ListUnspent:output_type -> lnrpc.ListUnspentResponse
-	34,  // 272: lnrpc.Lightning.SubscribeTransactions:output_type -> lnrpc.Transaction
-	47,  // 273: lnrpc.Lightning.SendMany:output_type -> lnrpc.SendManyResponse
-	53,  // 274: lnrpc.Lightning.NewAddress:output_type -> lnrpc.NewAddressResponse
-	55,  // 275: lnrpc.Lightning.SignMessage:output_type -> lnrpc.SignMessageResponse
-	57,  // 276: lnrpc.Lightning.VerifyMessage:output_type -> lnrpc.VerifyMessageResponse
-	59,  // 277: lnrpc.Lightning.ConnectPeer:output_type -> lnrpc.ConnectPeerResponse
-	61,  // 278: lnrpc.Lightning.DisconnectPeer:output_type -> lnrpc.DisconnectPeerResponse
-	77,  // 279: lnrpc.Lightning.ListPeers:output_type -> lnrpc.ListPeersResponse
-	79,  // 280: lnrpc.Lightning.SubscribePeerEvents:output_type -> lnrpc.PeerEvent
-	81,  // 281: lnrpc.Lightning.GetInfo:output_type -> lnrpc.GetInfoResponse
-	83,  // 282: lnrpc.Lightning.GetDebugInfo:output_type -> lnrpc.GetDebugInfoResponse
-	85,  // 283: lnrpc.Lightning.GetRecoveryInfo:output_type -> lnrpc.GetRecoveryInfoResponse
-	112, // 284: lnrpc.Lightning.PendingChannels:output_type -> lnrpc.PendingChannelsResponse
-	66,  // 285: lnrpc.Lightning.ListChannels:output_type -> lnrpc.ListChannelsResponse
-	115, // 286: lnrpc.Lightning.SubscribeChannelEvents:output_type -> lnrpc.ChannelEventUpdate
-	73,  // 287: lnrpc.Lightning.ClosedChannels:output_type -> lnrpc.ClosedChannelsResponse
-	40,  // 288: lnrpc.Lightning.OpenChannelSync:output_type -> lnrpc.ChannelPoint
-	99,  // 289: lnrpc.Lightning.OpenChannel:output_type -> lnrpc.OpenStatusUpdate
-	97,  // 290: lnrpc.Lightning.BatchOpenChannel:output_type -> lnrpc.BatchOpenChannelResponse
-	109, // 291: lnrpc.Lightning.FundingStateStep:output_type -> lnrpc.FundingStateStepResp
-	38,  // 292: lnrpc.Lightning.ChannelAcceptor:output_type -> lnrpc.ChannelAcceptRequest
-	91,  // 293: lnrpc.Lightning.CloseChannel:output_type -> lnrpc.CloseStatusUpdate
-	179, // 294: lnrpc.Lightning.AbandonChannel:output_type -> lnrpc.AbandonChannelResponse
-	163, // 295: lnrpc.Lightning.AddInvoice:output_type -> lnrpc.AddInvoiceResponse
-	166, // 296: lnrpc.Lightning.ListInvoices:output_type -> lnrpc.ListInvoiceResponse
-	159, // 297: lnrpc.Lightning.LookupInvoice:output_type -> lnrpc.Invoice
-	159, // 298: lnrpc.Lightning.SubscribeInvoices:output_type -> lnrpc.Invoice
-	169, // 299: lnrpc.Lightning.DeleteCanceledInvoice:output_type -> lnrpc.DelCanceledInvoiceResp
-	183, // 300: lnrpc.Lightning.DecodePayReq:output_type -> lnrpc.PayReq
-	173, // 301: lnrpc.Lightning.ListPayments:output_type -> lnrpc.ListPaymentsResponse
-	176, // 302: lnrpc.Lightning.DeletePayment:output_type -> lnrpc.DeletePaymentResponse
-	177, // 303: lnrpc.Lightning.DeleteAllPayments:output_type -> lnrpc.DeleteAllPaymentsResponse
-	138, // 304: lnrpc.Lightning.DescribeGraph:output_type -> lnrpc.ChannelGraph
-	140, // 305: lnrpc.Lightning.GetNodeMetrics:output_type -> lnrpc.NodeMetricsResponse
-	136, // 306: lnrpc.Lightning.GetChanInfo:output_type -> lnrpc.ChannelEdge
-	131, // 307: lnrpc.Lightning.GetNodeInfo:output_type -> lnrpc.NodeInfo
-	125, // 308: lnrpc.Lightning.QueryRoutes:output_type -> lnrpc.QueryRoutesResponse
-	144, // 309: lnrpc.Lightning.GetNetworkInfo:output_type -> lnrpc.NetworkInfo
-	146, // 310: lnrpc.Lightning.StopDaemon:output_type -> lnrpc.StopResponse
-	148, // 311: lnrpc.Lightning.SubscribeChannelGraph:output_type -> lnrpc.GraphTopologyUpdate
-	181, // 312: lnrpc.Lightning.DebugLevel:output_type -> lnrpc.DebugLevelResponse
-	187, // 313: lnrpc.Lightning.FeeReport:output_type -> lnrpc.FeeReportResponse
-	191, // 314: lnrpc.Lightning.UpdateChannelPolicy:output_type -> lnrpc.PolicyUpdateResponse
-	194, // 315: lnrpc.Lightning.ForwardingHistory:output_type -> lnrpc.ForwardingHistoryResponse
-	196, // 316: lnrpc.Lightning.ExportChannelBackup:output_type -> lnrpc.ChannelBackup
-	199, // 317: lnrpc.Lightning.ExportAllChannelBackups:output_type -> lnrpc.ChanBackupSnapshot
-	204, // 318: lnrpc.Lightning.VerifyChanBackup:output_type -> lnrpc.VerifyChanBackupResponse
-	202, // 319: lnrpc.Lightning.RestoreChannelBackups:output_type -> lnrpc.RestoreBackupResponse
-	199, // 320: lnrpc.Lightning.SubscribeChannelBackups:output_type -> lnrpc.ChanBackupSnapshot
-	207, // 321: lnrpc.Lightning.BakeMacaroon:output_type -> lnrpc.BakeMacaroonResponse
-	209, // 322: lnrpc.Lightning.ListMacaroonIDs:output_type -> lnrpc.ListMacaroonIDsResponse
-	211, // 323: lnrpc.Lightning.DeleteMacaroonID:output_type -> lnrpc.DeleteMacaroonIDResponse
-	214, // 324: lnrpc.Lightning.ListPermissions:output_type -> lnrpc.ListPermissionsResponse
-	220, // 325: lnrpc.Lightning.CheckMacaroonPermissions:output_type -> lnrpc.CheckMacPermResponse
-	221, // 326: lnrpc.Lightning.RegisterRPCMiddleware:output_type -> lnrpc.RPCMiddlewareRequest
-	27,  // 327: lnrpc.Lightning.SendCustomMessage:output_type -> lnrpc.SendCustomMessageResponse
-	25,  // 328: lnrpc.Lightning.SubscribeCustomMessages:output_type -> lnrpc.CustomMessage
-	31,  // 329: lnrpc.Lightning.SendOnionMessage:output_type -> lnrpc.SendOnionMessageResponse
-	29,  // 330: lnrpc.Lightning.SubscribeOnionMessages:output_type -> lnrpc.OnionMessageUpdate
-	69,  // 331: lnrpc.Lightning.ListAliases:output_type -> lnrpc.ListAliasesResponse
-	23,  // 332: lnrpc.Lightning.LookupHtlcResolution:output_type -> lnrpc.LookupHtlcResolutionResponse
-	266, // [266:333] is the sub-list for method output_type
-	199, // [199:266] is the sub-list for method input_type
-	199, // [199:199] is the sub-list for extension type_name
-	199, // [199:199] is the sub-list for extension extendee
-	0,   // [0:199] is the sub-list for field type_name
+	59,  // 0: lnrpc.ScheduleChannelCloseRequest.channel_point:type_name -> lnrpc.ChannelPoint
+	59,  // 1: lnrpc.CancelScheduledCloseRequest.channel_point:type_name -> lnrpc.ChannelPoint
+	59,  // 2: lnrpc.PauseChannelRequest.chan_point:type_name -> lnrpc.ChannelPoint
+	59,  // 3: lnrpc.ResumeChannelRequest.chan_point:type_name -> lnrpc.ChannelPoint
+	59,  // 4: lnrpc.BumpFundingFeeRequest.chan_point:type_name -> lnrpc.ChannelPoint
+	59,  // 5: lnrpc.BumpFundingFeeResponse.chan_point:type_name -> lnrpc.ChannelPoint
+	59,  // 6: lnrpc.BuyLiquidityResponse.channel_point:type_name -> lnrpc.ChannelPoint
+	38,  // 7: lnrpc.LiquidityAd.rates:type_name -> lnrpc.LeaseRates
+	39,  // 8: lnrpc.ListLiquidityAdsResponse.ads:type_name -> lnrpc.LiquidityAd
+	183, // 9: lnrpc.OnionMessageUpdate.reply_path:type_name -> lnrpc.BlindedPath
+	257, // 10: lnrpc.OnionMessageUpdate.custom_records:type_name -> lnrpc.OnionMessageUpdate.CustomRecordsEntry
+	2,   // 11: lnrpc.Utxo.address_type:type_name -> lnrpc.AddressType
+	60,  // 12: lnrpc.Utxo.outpoint:type_name -> lnrpc.OutPoint
+	0,   // 13: lnrpc.OutputDetail.output_type:type_name -> lnrpc.OutputScriptType
+	52,  // 14: lnrpc.Transaction.output_details:type_name -> lnrpc.OutputDetail
+	61,  // 15: lnrpc.Transaction.previous_outpoints:type_name -> lnrpc.PreviousOutPoint
+	53,  // 16: lnrpc.TransactionDetails.transactions:type_name -> lnrpc.Transaction
+	3,   // 17: lnrpc.ChannelAcceptRequest.commitment_type:type_name -> lnrpc.CommitmentType
+	258, // 18: lnrpc.EstimateFeeRequest.AddrToAmount:type_name -> lnrpc.EstimateFeeRequest.AddrToAmountEntry
+	1,   // 19: lnrpc.EstimateFeeRequest.coin_selection_strategy:type_name -> lnrpc.CoinSelectionStrategy
+	60,  // 20: lnrpc.EstimateFeeRequest.inputs:type_name -> lnrpc.OutPoint
+	60,  // 21: lnrpc.EstimateFeeResponse.inputs:type_name -> lnrpc.OutPoint
+	259, // 22: lnrpc.SendManyRequest.AddrToAmount:type_name -> lnrpc.SendManyRequest.AddrToAmountEntry
+	1,   // 23: lnrpc.SendManyRequest.coin_selection_strategy:type_name -> lnrpc.CoinSelectionStrategy
+	1,   // 24: lnrpc.SendCoinsRequest.coin_selection_strategy:type_name -> lnrpc.CoinSelectionStrategy
+	60,  // 25: lnrpc.SendCoinsRequest.outpoints:type_name -> lnrpc.OutPoint
+	51,  // 26: lnrpc.ListUnspentResponse.utxos:type_name -> lnrpc.Utxo
+	2,   // 27: lnrpc.NewAddressRequest.type:type_name -> lnrpc.AddressType
+	62,  // 28: lnrpc.ConnectPeerRequest.addr:type_name -> lnrpc.LightningAddress
+	13,  // 29: lnrpc.PeerConnPolicy.priority:type_name -> lnrpc.PeerConnPolicy.Priority
+	14,  // 30: lnrpc.PeerConnPolicy.addr_preference:type_name -> lnrpc.PeerConnPolicy.AddrPreference
+	81,  // 31: lnrpc.SetPeerConnPolicyRequest.policy:type_name -> lnrpc.PeerConnPolicy
+	81,  // 32: lnrpc.PersistentPeer.policy:type_name -> lnrpc.PeerConnPolicy
+	85,  // 33: lnrpc.ListPersistentPeersResponse.peers:type_name -> lnrpc.PersistentPeer
+	89,  // 34: lnrpc.Channel.pending_htlcs:type_name -> lnrpc.HTLC
+	3,   // 35: lnrpc.Channel.commitment_type:type_name -> lnrpc.CommitmentType
+	90,  // 36: lnrpc.Channel.local_constraints:type_name -> lnrpc.ChannelConstraints
+	90,  // 37: lnrpc.Channel.remote_constraints:type_name -> lnrpc.ChannelConstraints
+	91,  // 38: lnrpc.ListChannelsResponse.channels:type_name -> lnrpc.Channel
+	94,  // 39: lnrpc.ListAliasesResponse.alias_maps:type_name -> lnrpc.AliasMap
+	15,  // 40: lnrpc.ChannelCloseSummary.close_type:type_name -> lnrpc.ChannelCloseSummary.ClosureType
+	4,   // 41: lnrpc.ChannelCloseSummary.open_initiator:type_name -> lnrpc.Initiator
+	4,   // 42: lnrpc.ChannelCloseSummary.close_initiator:type_name -> lnrpc.Initiator
+	98,  // 43: lnrpc.ChannelCloseSummary.resolutions:type_name -> lnrpc.Resolution
+	5,   // 44: lnrpc.Resolution.resolution_type:type_name -> lnrpc.ResolutionType
+	6,   // 45: lnrpc.Resolution.outcome:type_name -> lnrpc.ResolutionOutcome
+	60,  // 46: lnrpc.Resolution.outpoint:type_name -> lnrpc.OutPoint
+	97,  // 47: lnrpc.ClosedChannelsResponse.channels:type_name -> lnrpc.ChannelCloseSummary
+	16,  // 48: lnrpc.Peer.sync_type:type_name -> lnrpc.Peer.SyncType
+	260, // 49: lnrpc.Peer.features:type_name -> lnrpc.Peer.FeaturesEntry
+	102, // 50: lnrpc.Peer.errors:type_name -> lnrpc.TimestampedError
+	101, // 51: lnrpc.ListPeersResponse.peers:type_name -> lnrpc.Peer
+	17,  // 52: lnrpc.PeerEvent.type:type_name -> lnrpc.PeerEvent.EventType
+	113, // 53: lnrpc.GetInfoResponse.chains:type_name -> lnrpc.Chain
+	261, // 54: lnrpc.GetInfoResponse.features:type_name -> lnrpc.GetInfoResponse.FeaturesEntry
+	7,   // 55: lnrpc.GetInfoResponse.graph_cache_status:type_name -> lnrpc.GraphCacheStatus
+	262, // 56: lnrpc.GetDebugInfoResponse.config:type_name -> lnrpc.GetDebugInfoResponse.ConfigEntry
+	59,  // 57: lnrpc.ChannelOpenUpdate.channel_point:type_name -> lnrpc.ChannelPoint
+	115, // 58: lnrpc.ChannelCloseUpdate.local_close_output:type_name -> lnrpc.CloseOutput
+	115, // 59: lnrpc.ChannelCloseUpdate.remote_close_output:type_name -> lnrpc.CloseOutput
+	115, // 60: lnrpc.ChannelCloseUpdate.additional_outputs:type_name -> lnrpc.CloseOutput
+	59,  // 61: lnrpc.CloseChannelRequest.channel_point:type_name -> lnrpc.ChannelPoint
+	119, // 62: lnrpc.CloseStatusUpdate.close_pending:type_name -> lnrpc.PendingUpdate
+	116, // 63: lnrpc.CloseStatusUpdate.chan_close:type_name -> lnrpc.ChannelCloseUpdate
+	120, // 64: lnrpc.CloseStatusUpdate.close_instant:type_name -> lnrpc.InstantUpdate
+	123, // 65: lnrpc.BatchOpenChannelRequest.channels:type_name -> lnrpc.BatchOpenChannel
+	1,   // 66: lnrpc.BatchOpenChannelRequest.coin_selection_strategy:type_name -> lnrpc.CoinSelectionStrategy
+	3,   // 67: lnrpc.BatchOpenChannel.commitment_type:type_name -> lnrpc.CommitmentType
+	119, // 68: lnrpc.BatchOpenChannelResponse.pending_channels:type_name -> lnrpc.PendingUpdate
+	131, // 69: lnrpc.OpenChannelRequest.funding_shim:type_name -> lnrpc.FundingShim
+	3,   // 70: lnrpc.OpenChannelRequest.commitment_type:type_name -> lnrpc.CommitmentType
+	60,  // 71: lnrpc.OpenChannelRequest.outpoints:type_name -> lnrpc.OutPoint
+	119, // 72: lnrpc.OpenStatusUpdate.chan_pending:type_name -> lnrpc.PendingUpdate
+	114, // 73: lnrpc.OpenStatusUpdate.chan_open:type_name -> lnrpc.ChannelOpenUpdate
+	121, // 74: lnrpc.OpenStatusUpdate.psbt_fund:type_name -> lnrpc.ReadyForPsbtFunding
+	127, // 75: lnrpc.KeyDescriptor.key_loc:type_name -> lnrpc.KeyLocator
+	59,  // 76: lnrpc.ChanPointShim.chan_point:type_name -> lnrpc.ChannelPoint
+	128, // 77: lnrpc.ChanPointShim.local_key:type_name -> lnrpc.KeyDescriptor
+	129, // 78: lnrpc.FundingShim.chan_point_shim:type_name -> lnrpc.ChanPointShim
+	130, // 79: lnrpc.FundingShim.psbt_shim:type_name -> lnrpc.PsbtShim
+	131, // 80: lnrpc.FundingTransitionMsg.shim_register:type_name -> lnrpc.FundingShim
+	132, // 81: lnrpc.FundingTransitionMsg.shim_cancel:type_name -> lnrpc.FundingShimCancel
+	133, // 82: lnrpc.FundingTransitionMsg.psbt_verify:type_name -> lnrpc.FundingPsbtVerify
+	134, // 83: lnrpc.FundingTransitionMsg.psbt_finalize:type_name -> lnrpc.FundingPsbtFinalize
+	264, // 84: lnrpc.PendingChannelsResponse.pending_open_channels:type_name -> lnrpc.PendingChannelsResponse.PendingOpenChannel
+	267, // 85: lnrpc.PendingChannelsResponse.pending_closing_channels:type_name -> lnrpc.PendingChannelsResponse.ClosedChannel
+	268, // 86: lnrpc.PendingChannelsResponse.pending_force_closing_channels:type_name -> lnrpc.PendingChannelsResponse.ForceClosedChannel
+	265, // 87: lnrpc.PendingChannelsResponse.waiting_close_channels:type_name -> lnrpc.PendingChannelsResponse.WaitingCloseChannel
+	269, // 88: lnrpc.PendingChannelsResponse.scheduled_closes:type_name -> lnrpc.PendingChannelsResponse.ScheduledClose
+	91,  // 89: lnrpc.ChannelCommitUpdate.channel:type_name -> lnrpc.Channel
+	91,  // 90: lnrpc.ChannelEventUpdate.open_channel:type_name -> lnrpc.Channel
+	97,  // 91: lnrpc.ChannelEventUpdate.closed_channel:type_name -> lnrpc.ChannelCloseSummary
+	59,  // 92: lnrpc.ChannelEventUpdate.active_channel:type_name -> lnrpc.ChannelPoint
+	59,  // 93: lnrpc.ChannelEventUpdate.inactive_channel:type_name -> lnrpc.ChannelPoint
+	119, // 94: lnrpc.ChannelEventUpdate.pending_open_channel:type_name -> lnrpc.PendingUpdate
+	59,  // 95: lnrpc.ChannelEventUpdate.fully_resolved_channel:type_name -> lnrpc.ChannelPoint
+	59,  // 96: lnrpc.ChannelEventUpdate.channel_funding_timeout:type_name -> lnrpc.ChannelPoint
+	141, // 97: lnrpc.ChannelEventUpdate.updated_channel:type_name -> lnrpc.ChannelCommitUpdate
+	20,  // 98: lnrpc.ChannelEventUpdate.type:type_name -> lnrpc.ChannelEventUpdate.UpdateType
+	270, // 99: lnrpc.WalletBalanceResponse.account_balance:type_name -> lnrpc.WalletBalanceResponse.AccountBalanceEntry
+	146, // 100: lnrpc.ChannelBalanceResponse.local_balance:type_name -> lnrpc.Amount
+	146, // 101: lnrpc.ChannelBalanceResponse.remote_balance:type_name -> lnrpc.Amount
+	146, // 102: lnrpc.ChannelBalanceResponse.unsettled_local_balance:type_name -> lnrpc.Amount
+	146, // 103: lnrpc.ChannelBalanceResponse.unsettled_remote_balance:type_name -> lnrpc.Amount
+	146, // 104: lnrpc.ChannelBalanceResponse.pending_open_local_balance:type_name -> lnrpc.Amount
+	146, // 105: lnrpc.ChannelBalanceResponse.pending_open_remote_balance:type_name -> lnrpc.Amount
+	56,  // 106: lnrpc.QueryRoutesRequest.fee_limit:type_name -> lnrpc.FeeLimit
+	151, // 107: lnrpc.QueryRoutesRequest.ignored_edges:type_name -> lnrpc.EdgeLocator
+	150, // 108: lnrpc.QueryRoutesRequest.ignored_pairs:type_name -> lnrpc.NodePair
+	271, // 109: lnrpc.QueryRoutesRequest.dest_custom_records:type_name -> lnrpc.QueryRoutesRequest.DestCustomRecordsEntry
+	181, // 110: lnrpc.QueryRoutesRequest.route_hints:type_name -> lnrpc.RouteHint
+	182, // 111: lnrpc.QueryRoutesRequest.blinded_payment_paths:type_name -> lnrpc.BlindedPaymentPath
+	11,  // 112: lnrpc.QueryRoutesRequest.dest_features:type_name -> lnrpc.FeatureBit
+	156, // 113: lnrpc.QueryRoutesResponse.routes:type_name -> lnrpc.Route
+	154, // 114: lnrpc.Hop.mpp_record:type_name -> lnrpc.MPPRecord
+	155, // 115: lnrpc.Hop.amp_record:type_name -> lnrpc.AMPRecord
+	272, // 116: lnrpc.Hop.custom_records:type_name -> lnrpc.Hop.CustomRecordsEntry
+	153, // 117: lnrpc.Route.hops:type_name -> lnrpc.Hop
+	159, // 118: lnrpc.NodeInfo.node:type_name -> lnrpc.LightningNode
+	163, // 119: lnrpc.NodeInfo.channels:type_name -> lnrpc.ChannelEdge
+	160, // 120: lnrpc.LightningNode.addresses:type_name -> lnrpc.NodeAddress
+	273, // 121: lnrpc.LightningNode.features:type_name -> lnrpc.LightningNode.FeaturesEntry
+	274, // 122: lnrpc.LightningNode.custom_records:type_name -> lnrpc.LightningNode.CustomRecordsEntry
+	275, // 123: lnrpc.RoutingPolicy.custom_records:type_name -> lnrpc.RoutingPolicy.CustomRecordsEntry
+	161, // 124: lnrpc.ChannelEdge.node1_policy:type_name -> lnrpc.RoutingPolicy
+	161, // 125: lnrpc.ChannelEdge.node2_policy:type_name -> lnrpc.RoutingPolicy
+	276, // 126: lnrpc.ChannelEdge.custom_records:type_name -> lnrpc.ChannelEdge.CustomRecordsEntry
+	162, // 127: lnrpc.ChannelEdge.auth_proof:type_name -> lnrpc.ChannelAuthProof
+	159, // 128: lnrpc.ChannelGraph.nodes:type_name -> lnrpc.LightningNode
+	163, // 129: lnrpc.ChannelGraph.edges:type_name -> lnrpc.ChannelEdge
+	8,   // 130: lnrpc.NodeMetricsRequest.types:type_name -> lnrpc.NodeMetricType
+	277, // 131: lnrpc.NodeMetricsResponse.betweenness_centrality:type_name -> lnrpc.NodeMetricsResponse.BetweennessCentralityEntry
+	176, // 132: lnrpc.GraphTopologyUpdate.node_updates:type_name -> lnrpc.NodeUpdate
+	177, // 133: lnrpc.GraphTopologyUpdate.channel_updates:type_name -> lnrpc.ChannelEdgeUpdate
+	178, // 134: lnrpc.GraphTopologyUpdate.closed_chans:type_name -> lnrpc.ClosedChannelUpdate
+	160, // 135: lnrpc.NodeUpdate.node_addresses:type_name -> lnrpc.NodeAddress
+	278, // 136: lnrpc.NodeUpdate.features:type_name -> lnrpc.NodeUpdate.FeaturesEntry
+	59,  // 137: lnrpc.ChannelEdgeUpdate.chan_point:type_name -> lnrpc.ChannelPoint
+	161, // 138: lnrpc.ChannelEdgeUpdate.routing_policy:type_name -> lnrpc.RoutingPolicy
+	59,  // 139: lnrpc.ClosedChannelUpdate.chan_point:type_name -> lnrpc.ChannelPoint
+	179, // 140: lnrpc.RouteHint.hop_hints:type_name -> lnrpc.HopHint
+	183, // 141: lnrpc.BlindedPaymentPath.blinded_path:type_name -> lnrpc.BlindedPath
+	11,  // 142: lnrpc.BlindedPaymentPath.features:type_name -> lnrpc.FeatureBit
+	184, // 143: lnrpc.BlindedPath.blinded_hops:type_name -> lnrpc.BlindedHop
+	9,   // 144: lnrpc.AMPInvoiceState.state:type_name -> lnrpc.InvoiceHTLCState
+	181, // 145: lnrpc.Invoice.route_hints:type_name -> lnrpc.RouteHint
+	21,  // 146: lnrpc.Invoice.state:type_name -> lnrpc.Invoice.InvoiceState
+	189, // 147: lnrpc.Invoice.htlcs:type_name -> lnrpc.InvoiceHTLC
+	279, // 148: lnrpc.Invoice.features:type_name -> lnrpc.Invoice.FeaturesEntry
+	280, // 149: lnrpc.Invoice.amp_invoice_state:type_name -> lnrpc.Invoice.AmpInvoiceStateEntry
+	188, // 150: lnrpc.Invoice.blinded_path_config:type_name -> lnrpc.BlindedPathConfig
+	187, // 151: lnrpc.Invoice.hold_policy:type_name -> lnrpc.InvoiceHoldPolicy
+	9,   // 152: lnrpc.InvoiceHTLC.state:type_name -> lnrpc.InvoiceHTLCState
+	281, // 153: lnrpc.InvoiceHTLC.custom_records:type_name -> lnrpc.InvoiceHTLC.CustomRecordsEntry
+	190, // 154: lnrpc.InvoiceHTLC.amp:type_name -> lnrpc.AMP
+	186, // 155: lnrpc.ListInvoiceResponse.invoices:type_name -> lnrpc.Invoice
+	22,  // 156: lnrpc.Payment.status:type_name -> lnrpc.Payment.PaymentStatus
+	200, // 157: lnrpc.Payment.htlcs:type_name -> lnrpc.HTLCAttempt
+	10,  // 158: lnrpc.Payment.failure_reason:type_name -> lnrpc.PaymentFailureReason
+	282, // 159: lnrpc.Payment.first_hop_custom_records:type_name -> lnrpc.Payment.FirstHopCustomRecordsEntry
+	199, // 160: lnrpc.Payment.receipt:type_name -> lnrpc.PaymentReceipt
+	23,  // 161: lnrpc.HTLCAttempt.status:type_name -> lnrpc.HTLCAttempt.HTLCStatus
+	156, // 162: lnrpc.HTLCAttempt.route:type_name -> lnrpc.Route
+	244, // 163: lnrpc.HTLCAttempt.failure:type_name -> lnrpc.Failure
+	198, // 164: lnrpc.ListPaymentsResponse.payments:type_name -> lnrpc.Payment
+	59,  // 165: lnrpc.AbandonChannelRequest.channel_point:type_name -> lnrpc.ChannelPoint
+	181, // 166: lnrpc.PayReq.route_hints:type_name -> lnrpc.RouteHint
+	283, // 167: lnrpc.PayReq.features:type_name -> lnrpc.PayReq.FeaturesEntry
+	182, // 168: lnrpc.PayReq.blinded_paths:type_name -> lnrpc.BlindedPaymentPath
+	215, // 169: lnrpc.FeeReportResponse.channel_fees:type_name -> lnrpc.ChannelFeeReport
+	59,  // 170: lnrpc.PolicyUpdateRequest.chan_point:type_name -> lnrpc.ChannelPoint
+	217, // 171: lnrpc.PolicyUpdateRequest.inbound_fee:type_name -> lnrpc.InboundFee
+	60,  // 172: lnrpc.FailedUpdate.outpoint:type_name -> lnrpc.OutPoint
+	12,  // 173: lnrpc.FailedUpdate.reason:type_name -> lnrpc.UpdateFailure
+	219, // 174: lnrpc.PolicyUpdateResponse.failed_updates:type_name -> lnrpc.FailedUpdate
+	222, // 175: lnrpc.ForwardingHistoryResponse.forwarding_events:type_name -> lnrpc.ForwardingEvent
+	59,  // 176: lnrpc.ExportChannelBackupRequest.chan_point:type_name -> lnrpc.ChannelPoint
+	59,  // 177: lnrpc.ChannelBackup.chan_point:type_name -> lnrpc.ChannelPoint
+	59,  // 178: lnrpc.MultiChanBackup.chan_points:type_name -> lnrpc.ChannelPoint
+	229, // 179: lnrpc.ChanBackupSnapshot.single_chan_backups:type_name -> lnrpc.ChannelBackups
+	226, // 180: lnrpc.ChanBackupSnapshot.multi_chan_backup:type_name -> lnrpc.MultiChanBackup
+	225, // 181: lnrpc.ChannelBackups.chan_backups:type_name -> lnrpc.ChannelBackup
+	229, // 182: lnrpc.RestoreChanBackupRequest.chan_backups:type_name -> lnrpc.ChannelBackups
+	234, // 183: lnrpc.BakeMacaroonRequest.permissions:type_name -> lnrpc.MacaroonPermission
+	234, // 184: lnrpc.MacaroonPermissionList.permissions:type_name -> lnrpc.MacaroonPermission
+	284, // 185: lnrpc.ListPermissionsResponse.method_permissions:type_name -> lnrpc.ListPermissionsResponse.MethodPermissionsEntry
+	24,  // 186: lnrpc.Failure.code:type_name -> lnrpc.Failure.FailureCode
+	245, // 187: lnrpc.Failure.channel_update:type_name -> lnrpc.ChannelUpdate
+	247, // 188: lnrpc.MacaroonId.ops:type_name -> lnrpc.Op
+	234, // 189: lnrpc.CheckMacPermRequest.permissions:type_name -> lnrpc.MacaroonPermission
+	252, // 190: lnrpc.RPCMiddlewareRequest.stream_auth:type_name -> lnrpc.StreamAuth
+	253, // 191: lnrpc.RPCMiddlewareRequest.request:type_name -> lnrpc.RPCMessage
+	253, // 192: lnrpc.RPCMiddlewareRequest.response:type_name -> lnrpc.RPCMessage
+	285, // 193: lnrpc.RPCMiddlewareRequest.metadata_pairs:type_name -> lnrpc.RPCMiddlewareRequest.MetadataPairsEntry
+	255, // 194: lnrpc.RPCMiddlewareResponse.register:type_name -> lnrpc.MiddlewareRegistration
+	256, // 195: lnrpc.RPCMiddlewareResponse.feedback:type_name -> lnrpc.InterceptFeedback
+	213, // 196: lnrpc.Peer.FeaturesEntry.value:type_name -> lnrpc.Feature
+	213, // 197: lnrpc.GetInfoResponse.FeaturesEntry.value:type_name -> lnrpc.Feature
+	4,   // 198: lnrpc.PendingChannelsResponse.PendingChannel.initiator:type_name -> lnrpc.Initiator
+	3,   // 199: lnrpc.PendingChannelsResponse.PendingChannel.commitment_type:type_name -> lnrpc.CommitmentType
+	263, // 200: lnrpc.PendingChannelsResponse.PendingOpenChannel.channel:type_name -> lnrpc.PendingChannelsResponse.PendingChannel
+	263, // 201: lnrpc.PendingChannelsResponse.WaitingCloseChannel.channel:type_name -> lnrpc.PendingChannelsResponse.PendingChannel
+	266, // 202: lnrpc.PendingChannelsResponse.WaitingCloseChannel.commitments:type_name -> lnrpc.PendingChannelsResponse.Commitments
+	263, // 203: lnrpc.PendingChannelsResponse.ClosedChannel.channel:type_name -> lnrpc.PendingChannelsResponse.PendingChannel
+	263, // 204: lnrpc.PendingChannelsResponse.ForceClosedChannel.channel:type_name -> lnrpc.PendingChannelsResponse.PendingChannel
+	137, // 205: lnrpc.PendingChannelsResponse.ForceClosedChannel.pending_htlcs:type_name -> lnrpc.PendingHTLC
+	18,  // 206: lnrpc.PendingChannelsResponse.ForceClosedChannel.anchor:type_name -> lnrpc.PendingChannelsResponse.ForceClosedChannel.AnchorState
+	19,  // 207: lnrpc.PendingChannelsResponse.ScheduledClose.state:type_name -> lnrpc.PendingChannelsResponse.ScheduledClose.State
+	143, // 208: lnrpc.WalletBalanceResponse.AccountBalanceEntry.value:type_name -> lnrpc.WalletAccountBalance
+	213, // 209: lnrpc.LightningNode.FeaturesEntry.value:type_name -> lnrpc.Feature
+	168, // 210: lnrpc.NodeMetricsResponse.BetweennessCentralityEntry.value:type_name -> lnrpc.FloatMetric
+	213, // 211: lnrpc.NodeUpdate.FeaturesEntry.value:type_name -> lnrpc.Feature
+	213, // 212: lnrpc.Invoice.FeaturesEntry.value:type_name -> lnrpc.Feature
+	185, // 213: lnrpc.Invoice.AmpInvoiceStateEntry.value:type_name -> lnrpc.AMPInvoiceState
+	213, // 214: lnrpc.PayReq.FeaturesEntry.value:type_name -> lnrpc.Feature
+	241, // 215: lnrpc.ListPermissionsResponse.MethodPermissionsEntry.value:type_name -> lnrpc.MacaroonPermissionList
+	251, // 216: lnrpc.RPCMiddlewareRequest.MetadataPairsEntry.value:type_name -> lnrpc.MetadataValues
+	144, // 217: lnrpc.Lightning.WalletBalance:input_type -> lnrpc.WalletBalanceRequest
+	147, // 218: lnrpc.Lightning.ChannelBalance:input_type -> lnrpc.ChannelBalanceRequest
+	54,  // 219: lnrpc.Lightning.GetTransactions:input_type -> lnrpc.GetTransactionsRequest
+	63,  // 220: lnrpc.Lightning.EstimateFee:input_type -> lnrpc.EstimateFeeRequest
+	67,  // 221: lnrpc.Lightning.SendCoins:input_type -> lnrpc.SendCoinsRequest
+	69,  // 222: lnrpc.Lightning.ListUnspent:input_type -> lnrpc.ListUnspentRequest
+	54,  // 223: lnrpc.Lightning.SubscribeTransactions:input_type -> lnrpc.GetTransactionsRequest
+	65,  // 224: lnrpc.Lightning.SendMany:input_type -> lnrpc.SendManyRequest
+	71,  // 225: lnrpc.Lightning.NewAddress:input_type -> lnrpc.NewAddressRequest
+	73,  // 226: lnrpc.Lightning.SignMessage:input_type -> lnrpc.SignMessageRequest
+	75,  // 227: lnrpc.Lightning.VerifyMessage:input_type -> lnrpc.VerifyMessageRequest
+	77,  // 228: lnrpc.Lightning.ConnectPeer:input_type -> lnrpc.ConnectPeerRequest
+	79,  // 229: lnrpc.Lightning.DisconnectPeer:input_type -> lnrpc.DisconnectPeerRequest
+	103, // 230: lnrpc.Lightning.ListPeers:input_type -> lnrpc.ListPeersRequest
+	105, // 231: lnrpc.Lightning.SubscribePeerEvents:input_type -> lnrpc.PeerEventSubscription
+	107, // 232: lnrpc.Lightning.GetInfo:input_type -> lnrpc.GetInfoRequest
+	109, // 233: lnrpc.Lightning.GetDebugInfo:input_type -> lnrpc.GetDebugInfoRequest
+	111, // 234: lnrpc.Lightning.GetRecoveryInfo:input_type -> lnrpc.GetRecoveryInfoRequest
+	138, // 235: lnrpc.Lightning.PendingChannels:input_type -> lnrpc.PendingChannelsRequest
+	92,  // 236: lnrpc.Lightning.ListChannels:input_type -> lnrpc.ListChannelsRequest
+	140, // 237: lnrpc.Lightning.SubscribeChannelEvents:input_type -> lnrpc.ChannelEventSubscription
+	99,  // 238: lnrpc.Lightning.ClosedChannels:input_type -> lnrpc.ClosedChannelsRequest
+	125, // 239: lnrpc.Lightning.OpenChannelSync:input_type -> lnrpc.OpenChannelRequest
+	125, // 240: lnrpc.Lightning.OpenChannel:input_type -> lnrpc.OpenChannelRequest
+	122, // 241: lnrpc.Lightning.BatchOpenChannel:input_type -> lnrpc.BatchOpenChannelRequest
+	135, // 242: lnrpc.Lightning.FundingStateStep:input_type -> lnrpc.FundingTransitionMsg
+	58,  // 243: lnrpc.Lightning.ChannelAcceptor:input_type -> lnrpc.ChannelAcceptResponse
+	117, // 244: lnrpc.Lightning.CloseChannel:input_type -> lnrpc.CloseChannelRequest
+	207, // 245: lnrpc.Lightning.AbandonChannel:input_type -> lnrpc.AbandonChannelRequest
+	186, // 246: lnrpc.Lightning.AddInvoice:input_type -> lnrpc.Invoice
+	193, // 247: lnrpc.Lightning.ListInvoices:input_type -> lnrpc.ListInvoiceRequest
+	192, // 248: lnrpc.Lightning.LookupInvoice:input_type -> lnrpc.PaymentHash
+	195, // 249: lnrpc.Lightning.SubscribeInvoices:input_type -> lnrpc.InvoiceSubscription
+	196, // 250: lnrpc.Lightning.DeleteCanceledInvoice:input_type -> lnrpc.DelCanceledInvoiceReq
+	211, // 251: lnrpc.Lightning.DecodePayReq:input_type -> lnrpc.PayReqString
+	201, // 252: lnrpc.Lightning.ListPayments:input_type -> lnrpc.ListPaymentsRequest
+	203, // 253: lnrpc.Lightning.DeletePayment:input_type -> lnrpc.DeletePaymentRequest
+	204, // 254: lnrpc.Lightning.DeleteAllPayments:input_type -> lnrpc.DeleteAllPaymentsRequest
+	164, // 255: lnrpc.Lightning.DescribeGraph:input_type -> lnrpc.ChannelGraphRequest
+	166, // 256: lnrpc.Lightning.GetNodeMetrics:input_type -> lnrpc.NodeMetricsRequest
+	169, // 257: lnrpc.Lightning.GetChanInfo:input_type -> lnrpc.ChanInfoRequest
+	157, // 258: lnrpc.Lightning.GetNodeInfo:input_type -> lnrpc.NodeInfoRequest
+	149, // 259: lnrpc.Lightning.QueryRoutes:input_type -> lnrpc.QueryRoutesRequest
+	170, // 260: lnrpc.Lightning.GetNetworkInfo:input_type -> lnrpc.NetworkInfoRequest
+	172, // 261: lnrpc.Lightning.StopDaemon:input_type -> lnrpc.StopRequest
+	174, // 262: lnrpc.Lightning.SubscribeChannelGraph:input_type -> lnrpc.GraphTopologySubscription
+	209, // 263: lnrpc.Lightning.DebugLevel:input_type -> lnrpc.DebugLevelRequest
+	214, // 264: lnrpc.Lightning.FeeReport:input_type -> lnrpc.FeeReportRequest
+	218, // 265: lnrpc.Lightning.UpdateChannelPolicy:input_type -> lnrpc.PolicyUpdateRequest
+	221, // 266: lnrpc.Lightning.ForwardingHistory:input_type -> lnrpc.ForwardingHistoryRequest
+	224, // 267: lnrpc.Lightning.ExportChannelBackup:input_type -> lnrpc.ExportChannelBackupRequest
+	227, // 268: lnrpc.Lightning.ExportAllChannelBackups:input_type -> lnrpc.ChanBackupExportRequest
+	228, // 269: lnrpc.Lightning.VerifyChanBackup:input_type -> lnrpc.ChanBackupSnapshot
+	230, // 270: lnrpc.Lightning.RestoreChannelBackups:input_type -> lnrpc.RestoreChanBackupRequest
+	232, // 271: lnrpc.Lightning.SubscribeChannelBackups:input_type -> lnrpc.ChannelBackupSubscription
+	235, // 272: lnrpc.Lightning.BakeMacaroon:input_type -> lnrpc.BakeMacaroonRequest
+	237, // 273: lnrpc.Lightning.ListMacaroonIDs:input_type -> lnrpc.ListMacaroonIDsRequest
+	239, // 274: lnrpc.Lightning.DeleteMacaroonID:input_type -> lnrpc.DeleteMacaroonIDRequest
+	242, // 275: lnrpc.Lightning.ListPermissions:input_type -> lnrpc.ListPermissionsRequest
+	248, // 276: lnrpc.Lightning.CheckMacaroonPermissions:input_type -> lnrpc.CheckMacPermRequest
+	254, // 277: lnrpc.Lightning.RegisterRPCMiddleware:input_type -> lnrpc.RPCMiddlewareResponse
+	45,  // 278: lnrpc.Lightning.SendCustomMessage:input_type -> lnrpc.SendCustomMessageRequest
+	43,  // 279: lnrpc.Lightning.SubscribeCustomMessages:input_type -> lnrpc.SubscribeCustomMessagesRequest
+	49,  // 280: lnrpc.Lightning.SendOnionMessage:input_type -> lnrpc.SendOnionMessageRequest
+	47,  // 281: lnrpc.Lightning.SubscribeOnionMessages:input_type -> lnrpc.SubscribeOnionMessagesRequest
+	95,  // 282: lnrpc.Lightning.ListAliases:input_type -> lnrpc.ListAliasesRequest
+	41,  // 283: lnrpc.Lightning.LookupHtlcResolution:input_type -> lnrpc.LookupHtlcResolutionRequest
+	35,  // 284: lnrpc.Lightning.BuyLiquidity:input_type -> lnrpc.BuyLiquidityRequest
+	37,  // 285: lnrpc.Lightning.ListLiquidityAds:input_type -> lnrpc.ListLiquidityAdsRequest
+	33,  // 286: lnrpc.Lightning.BumpFundingFee:input_type -> lnrpc.BumpFundingFeeRequest
+	29,  // 287: lnrpc.Lightning.PauseChannel:input_type -> lnrpc.PauseChannelRequest
+	31,  // 288: lnrpc.Lightning.ResumeChannel:input_type -> lnrpc.ResumeChannelRequest
+	25,  // 289: lnrpc.Lightning.ScheduleChannelClose:input_type -> lnrpc.ScheduleChannelCloseRequest
+	27,  // 290: lnrpc.Lightning.CancelScheduledClose:input_type -> lnrpc.CancelScheduledCloseRequest
+	84,  // 291: lnrpc.Lightning.ListPersistentPeers:input_type -> lnrpc.ListPersistentPeersRequest
+	82,  // 292: lnrpc.Lightning.SetPeerConnPolicy:input_type -> lnrpc.SetPeerConnPolicyRequest
+	87,  // 293: lnrpc.Lightning.ReconnectPeer:input_type -> lnrpc.ReconnectPeerRequest
+	145, // 294: lnrpc.Lightning.WalletBalance:output_type -> lnrpc.WalletBalanceResponse
+	148, // 295: lnrpc.Lightning.ChannelBalance:output_type -> lnrpc.ChannelBalanceResponse
+	55,  // 296: lnrpc.Lightning.GetTransactions:output_type -> lnrpc.TransactionDetails
+	64,  // 297: lnrpc.Lightning.EstimateFee:output_type -> lnrpc.EstimateFeeResponse
+	68,  // 298: lnrpc.Lightning.SendCoins:output_type -> lnrpc.SendCoinsResponse
+	70,  // 299: lnrpc.Lightning.ListUnspent:output_type -> lnrpc.ListUnspentResponse
+	53,  // 300: lnrpc.Lightning.SubscribeTransactions:output_type -> lnrpc.Transaction
+	66,  // 301: lnrpc.Lightning.SendMany:output_type -> lnrpc.SendManyResponse
+	72,  // 302: lnrpc.Lightning.NewAddress:output_type -> lnrpc.NewAddressResponse
+	74,  // 303: lnrpc.Lightning.SignMessage:output_type -> lnrpc.SignMessageResponse
+	76,  // 304: lnrpc.Lightning.VerifyMessage:output_type -> lnrpc.VerifyMessageResponse
+	78,  // 305: lnrpc.Lightning.ConnectPeer:output_type -> lnrpc.ConnectPeerResponse
+	80,  // 306: lnrpc.Lightning.DisconnectPeer:output_type -> lnrpc.DisconnectPeerResponse
+	104, // 307: lnrpc.Lightning.ListPeers:output_type -> lnrpc.ListPeersResponse
+	106, // 308: lnrpc.Lightning.SubscribePeerEvents:output_type -> lnrpc.PeerEvent
+	108, // 309: lnrpc.Lightning.GetInfo:output_type -> lnrpc.GetInfoResponse
+	110, // 310: lnrpc.Lightning.GetDebugInfo:output_type -> lnrpc.GetDebugInfoResponse
+	112, // 311: lnrpc.Lightning.GetRecoveryInfo:output_type -> lnrpc.GetRecoveryInfoResponse
+	139, // 312: lnrpc.Lightning.PendingChannels:output_type -> lnrpc.PendingChannelsResponse
+	93,  // 313: lnrpc.Lightning.ListChannels:output_type -> lnrpc.ListChannelsResponse
+	142, // 314: lnrpc.Lightning.SubscribeChannelEvents:output_type -> lnrpc.ChannelEventUpdate
+	100, // 315: lnrpc.Lightning.ClosedChannels:output_type -> lnrpc.ClosedChannelsResponse
+	59,  // 316: lnrpc.Lightning.OpenChannelSync:output_type -> lnrpc.ChannelPoint
+	126, // 317: lnrpc.Lightning.OpenChannel:output_type -> lnrpc.OpenStatusUpdate
+	124, // 318: lnrpc.Lightning.BatchOpenChannel:output_type -> lnrpc.BatchOpenChannelResponse
+	136, // 319: lnrpc.Lightning.FundingStateStep:output_type -> lnrpc.FundingStateStepResp
+	57,  // 320: lnrpc.Lightning.ChannelAcceptor:output_type -> lnrpc.ChannelAcceptRequest
+	118, // 321: lnrpc.Lightning.CloseChannel:output_type -> lnrpc.CloseStatusUpdate
+	208, // 322: lnrpc.Lightning.AbandonChannel:output_type -> lnrpc.AbandonChannelResponse
+	191, // 323: lnrpc.Lightning.AddInvoice:output_type -> lnrpc.AddInvoiceResponse
+	194, // 324: lnrpc.Lightning.ListInvoices:output_type -> lnrpc.ListInvoiceResponse
+	186, // 325: lnrpc.Lightning.LookupInvoice:output_type -> lnrpc.Invoice
+	186, // 326: lnrpc.Lightning.SubscribeInvoices:output_type -> lnrpc.Invoice
+	197, // 327: lnrpc.Lightning.DeleteCanceledInvoice:output_type -> lnrpc.DelCanceledInvoiceResp
+	212, // 328: lnrpc.Lightning.DecodePayReq:output_type -> lnrpc.PayReq
+	202, // 329: lnrpc.Lightning.ListPayments:output_type -> lnrpc.ListPaymentsResponse
+	205, // 330: lnrpc.Lightning.DeletePayment:output_type -> lnrpc.DeletePaymentResponse
+	206, // 331: lnrpc.Lightning.DeleteAllPayments:output_type -> lnrpc.DeleteAllPaymentsResponse
+	165, // 332: lnrpc.Lightning.DescribeGraph:output_type -> lnrpc.ChannelGraph
+	167, // 333: lnrpc.Lightning.GetNodeMetrics:output_type -> lnrpc.NodeMetricsResponse
+	163, // 334: lnrpc.Lightning.GetChanInfo:output_type -> lnrpc.ChannelEdge
+	158, // 335: lnrpc.Lightning.GetNodeInfo:output_type -> lnrpc.NodeInfo
+	152, // 336: lnrpc.Lightning.QueryRoutes:output_type -> lnrpc.QueryRoutesResponse
+	171, // 337: lnrpc.Lightning.GetNetworkInfo:output_type -> lnrpc.NetworkInfo
+	173, // 338: lnrpc.Lightning.StopDaemon:output_type -> lnrpc.StopResponse
+	175, // 339: lnrpc.Lightning.SubscribeChannelGraph:output_type -> lnrpc.GraphTopologyUpdate
+	210, // 340: lnrpc.Lightning.DebugLevel:output_type -> lnrpc.DebugLevelResponse
+	216, // 341: lnrpc.Lightning.FeeReport:output_type -> lnrpc.FeeReportResponse
+	220, // 342: lnrpc.Lightning.UpdateChannelPolicy:output_type -> lnrpc.PolicyUpdateResponse
+	223, // 343: lnrpc.Lightning.ForwardingHistory:output_type -> lnrpc.ForwardingHistoryResponse
+	225, // 344: lnrpc.Lightning.ExportChannelBackup:output_type -> lnrpc.ChannelBackup
+	228, // 345: lnrpc.Lightning.ExportAllChannelBackups:output_type -> lnrpc.ChanBackupSnapshot
+	233, // 346: lnrpc.Lightning.VerifyChanBackup:output_type -> lnrpc.VerifyChanBackupResponse
+	231, // 347: lnrpc.Lightning.RestoreChannelBackups:output_type -> lnrpc.RestoreBackupResponse
+	228, // 348: lnrpc.Lightning.SubscribeChannelBackups:output_type -> lnrpc.ChanBackupSnapshot
+	236, // 349: lnrpc.Lightning.BakeMacaroon:output_type -> lnrpc.BakeMacaroonResponse
+	238, // 350: lnrpc.Lightning.ListMacaroonIDs:output_type -> lnrpc.ListMacaroonIDsResponse
+	240, // 351: lnrpc.Lightning.DeleteMacaroonID:output_type -> lnrpc.DeleteMacaroonIDResponse
+	243, // 352: lnrpc.Lightning.ListPermissions:output_type -> lnrpc.ListPermissionsResponse
+	249, // 353: lnrpc.Lightning.CheckMacaroonPermissions:output_type -> lnrpc.CheckMacPermResponse
+	250, // 354: lnrpc.Lightning.RegisterRPCMiddleware:output_type -> lnrpc.RPCMiddlewareRequest
+	46,  // 355: lnrpc.Lightning.SendCustomMessage:output_type -> lnrpc.SendCustomMessageResponse
+	44,  // 356: lnrpc.Lightning.SubscribeCustomMessages:output_type -> lnrpc.CustomMessage
+	50,  // 357: lnrpc.Lightning.SendOnionMessage:output_type -> lnrpc.SendOnionMessageResponse
+	48,  // 358: lnrpc.Lightning.SubscribeOnionMessages:output_type -> lnrpc.OnionMessageUpdate
+	96,  // 359: lnrpc.Lightning.ListAliases:output_type -> lnrpc.ListAliasesResponse
+	42,  // 360: lnrpc.Lightning.LookupHtlcResolution:output_type -> lnrpc.LookupHtlcResolutionResponse
+	36,  // 361: lnrpc.Lightning.BuyLiquidity:output_type -> lnrpc.BuyLiquidityResponse
+	40,  // 362: lnrpc.Lightning.ListLiquidityAds:output_type -> lnrpc.ListLiquidityAdsResponse
+	34,  // 363: lnrpc.Lightning.BumpFundingFee:output_type -> lnrpc.BumpFundingFeeResponse
+	30,  // 364: lnrpc.Lightning.PauseChannel:output_type -> lnrpc.PauseChannelResponse
+	32,  // 365: lnrpc.Lightning.ResumeChannel:output_type -> lnrpc.ResumeChannelResponse
+	26,  // 366: lnrpc.Lightning.ScheduleChannelClose:output_type -> lnrpc.ScheduleChannelCloseResponse
+	28,  // 367: lnrpc.Lightning.CancelScheduledClose:output_type -> lnrpc.CancelScheduledCloseResponse
+	86,  // 368: lnrpc.Lightning.ListPersistentPeers:output_type -> lnrpc.ListPersistentPeersResponse
+	83,  // 369: lnrpc.Lightning.SetPeerConnPolicy:output_type -> lnrpc.SetPeerConnPolicyResponse
+	88,  // 370: lnrpc.Lightning.ReconnectPeer:output_type -> lnrpc.ReconnectPeerResponse
+	294, // [294:371] is the sub-list for method output_type
+	217, // [217:294] is the sub-list for method input_type
+	217, // [217:217] is the sub-list for extension type_name
+	217, // [217:217] is the sub-list for extension extendee
+	0,   // [0:217] is the sub-list for field type_name
 }
 
 func init() { file_lightning_proto_init() }
@@ -20760,36 +22873,36 @@ func file_lightning_proto_init() {
 	if File_lightning_proto != nil {
 		return
 	}
-	file_lightning_proto_msgTypes[15].OneofWrappers = []any{
+	file_lightning_proto_msgTypes[31].OneofWrappers = []any{
 		(*FeeLimit_Fixed)(nil),
 		(*FeeLimit_FixedMsat)(nil),
 		(*FeeLimit_Percent)(nil),
 	}
-	file_lightning_proto_msgTypes[18].OneofWrappers = []any{
+	file_lightning_proto_msgTypes[34].OneofWrappers = []any{
 		(*ChannelPoint_FundingTxidBytes)(nil),
 		(*ChannelPoint_FundingTxidStr)(nil),
 	}
-	file_lightning_proto_msgTypes[69].OneofWrappers = []any{
+	file_lightning_proto_msgTypes[93].OneofWrappers = []any{
 		(*CloseStatusUpdate_ClosePending)(nil),
 		(*CloseStatusUpdate_ChanClose)(nil),
 		(*CloseStatusUpdate_CloseInstant)(nil),
 	}
-	file_lightning_proto_msgTypes[77].OneofWrappers = []any{
+	file_lightning_proto_msgTypes[101].OneofWrappers = []any{
 		(*OpenStatusUpdate_ChanPending)(nil),
 		(*OpenStatusUpdate_ChanOpen)(nil),
 		(*OpenStatusUpdate_PsbtFund)(nil),
 	}
-	file_lightning_proto_msgTypes[82].OneofWrappers = []any{
+	file_lightning_proto_msgTypes[106].OneofWrappers = []any{
 		(*FundingShim_ChanPointShim)(nil),
 		(*FundingShim_PsbtShim)(nil),
 	}
-	file_lightning_proto_msgTypes[86].OneofWrappers = []any{
+	file_lightning_proto_msgTypes[110].OneofWrappers = []any{
 		(*FundingTransitionMsg_ShimRegister)(nil),
 		(*FundingTransitionMsg_ShimCancel)(nil),
 		(*FundingTransitionMsg_PsbtVerify)(nil),
 		(*FundingTransitionMsg_PsbtFinalize)(nil),
 	}
-	file_lightning_proto_msgTypes[93].OneofWrappers = []any{
+	file_lightning_proto_msgTypes[117].OneofWrappers = []any{
 		(*ChannelEventUpdate_OpenChannel)(nil),
 		(*ChannelEventUpdate_ClosedChannel)(nil),
 		(*ChannelEventUpdate_ActiveChannel)(nil),
@@ -20799,23 +22912,23 @@ func file_lightning_proto_init() {
 		(*ChannelEventUpdate_ChannelFundingTimeout)(nil),
 		(*ChannelEventUpdate_UpdatedChannel)(nil),
 	}
-	file_lightning_proto_msgTypes[138].OneofWrappers = []any{}
-	file_lightning_proto_msgTypes[167].OneofWrappers = []any{
+	file_lightning_proto_msgTypes[163].OneofWrappers = []any{}
+	file_lightning_proto_msgTypes[193].OneofWrappers = []any{
 		(*PolicyUpdateRequest_Global)(nil),
 		(*PolicyUpdateRequest_ChanPoint)(nil),
 	}
-	file_lightning_proto_msgTypes[171].OneofWrappers = []any{}
-	file_lightning_proto_msgTypes[179].OneofWrappers = []any{
+	file_lightning_proto_msgTypes[197].OneofWrappers = []any{}
+	file_lightning_proto_msgTypes[205].OneofWrappers = []any{
 		(*RestoreChanBackupRequest_ChanBackups)(nil),
 		(*RestoreChanBackupRequest_MultiChanBackup)(nil),
 	}
-	file_lightning_proto_msgTypes[199].OneofWrappers = []any{
+	file_lightning_proto_msgTypes[225].OneofWrappers = []any{
 		(*RPCMiddlewareRequest_StreamAuth)(nil),
 		(*RPCMiddlewareRequest_Request)(nil),
 		(*RPCMiddlewareRequest_Response)(nil),
 		(*RPCMiddlewareRequest_RegComplete)(nil),
 	}
-	file_lightning_proto_msgTypes[203].OneofWrappers = []any{
+	file_lightning_proto_msgTypes[229].OneofWrappers = []any{
 		(*RPCMiddlewareResponse_Register)(nil),
 		(*RPCMiddlewareResponse_Feedback)(nil),
 	}
@@ -20824,8 +22937,8 @@ func file_lightning_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lightning_proto_rawDesc), len(file_lightning_proto_rawDesc)),
-			NumEnums:      22,
-			NumMessages:   234,
+			NumEnums:      25,
+			NumMessages:   261,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -403,6 +403,70 @@ type LightningClient interface {
 	// If the htlc has no final resolution yet, a NotFound grpc status code is
 	// returned.
 	LookupHtlcResolution(ctx context.Context, in *LookupHtlcResolutionRequest, opts ...grpc.CallOption) (*LookupHtlcResolutionResponse, error)
+	// lncli: `buyliquidity`
+	// BuyLiquidity buys inbound liquidity from a node that advertises lease
+	// rates. It opens a dual-funded script enforced lease channel to the node
+	// that requests the liquidity, which the node contributes to the channel in
+	// return for the lease fee. The lease fee is paid out of our initial channel
+	// balance. The call returns once the funding transaction is published.
+	BuyLiquidity(ctx context.Context, in *BuyLiquidityRequest, opts ...grpc.CallOption) (*BuyLiquidityResponse, error)
+	// lncli: `listliquidityads`
+	// ListLiquidityAds lists the nodes in the graph that advertise lease rates
+	// for inbound liquidity.
+	ListLiquidityAds(ctx context.Context, in *ListLiquidityAdsRequest, opts ...grpc.CallOption) (*ListLiquidityAdsResponse, error)
+	// lncli: `bumpfundingfee`
+	// BumpFundingFee replaces the funding transaction of a pending dual-funded
+	// channel we opened with one that pays a higher fee rate. The replacement
+	// is negotiated with the peer and spends the same inputs. The call returns
+	// the channel point of the replacement once it was published. The channel
+	// is opened with whichever funding transaction confirms first.
+	BumpFundingFee(ctx context.Context, in *BumpFundingFeeRequest, opts ...grpc.CallOption) (*BumpFundingFeeResponse, error)
+	// lncli: `pausechannel`
+	// PauseChannel stops a channel from accepting new HTLCs in either direction
+	// and waits for its in-flight HTLCs to be resolved. New HTLCs aren't
+	// forwarded to the peer anymore, while the HTLCs the peer adds are failed
+	// back. The call returns once the channel has no HTLCs, which allows
+	// maintenance that requires a consistent channel state, e.g. taking a
+	// backup. The pause is local to our node, so the channel isn't quiesced with
+	// the peer and can be resumed without a reconnection. The channel stays
+	// paused until it is resumed, also across reconnections and restarts. If
+	// the HTLCs aren't resolved in time, the channel accepts HTLCs again and an
+	// error is returned.
+	PauseChannel(ctx context.Context, in *PauseChannelRequest, opts ...grpc.CallOption) (*PauseChannelResponse, error)
+	// lncli: `resumechannel`
+	// ResumeChannel allows HTLCs on a channel that was paused with PauseChannel
+	// again.
+	ResumeChannel(ctx context.Context, in *ResumeChannelRequest, opts ...grpc.CallOption) (*ResumeChannelResponse, error)
+	// lncli: `scheduleclose`
+	// ScheduleChannelClose schedules the cooperative close of a channel. The
+	// close is initiated once the deadline height is reached or the estimated
+	// fee rate drops to the target fee rate, whichever happens first. As long as
+	// the closing transaction stays unconfirmed, its fee is bumped each block up
+	// to the given budget if the channel uses the RBF cooperative close
+	// protocol. Scheduled closes survive restarts and are listed by
+	// PendingChannels until the closing transaction confirmed.
+	ScheduleChannelClose(ctx context.Context, in *ScheduleChannelCloseRequest, opts ...grpc.CallOption) (*ScheduleChannelCloseResponse, error)
+	// lncli: `cancelscheduledclose`
+	// CancelScheduledClose cancels the scheduled close of a channel. A scheduled
+	// close can only be canceled before the close of the channel was initiated.
+	CancelScheduledClose(ctx context.Context, in *CancelScheduledCloseRequest, opts ...grpc.CallOption) (*CancelScheduledCloseResponse, error)
+	// lncli: `listpersistentpeers`
+	// ListPersistentPeers lists the peers we maintain a persistent connection
+	// with, together with their connection policy, their reconnection backoff
+	// and their failed connection attempts.
+	ListPersistentPeers(ctx context.Context, in *ListPersistentPeersRequest, opts ...grpc.CallOption) (*ListPersistentPeersResponse, error)
+	// lncli: `setpeerconnpolicy`
+	// SetPeerConnPolicy sets the connection policy of a peer, which determines
+	// its reconnection priority, the number of failed connection attempts after
+	// which we stop reconnecting to it and which of its addresses we connect to.
+	// Policies are persisted and survive restarts.
+	SetPeerConnPolicy(ctx context.Context, in *SetPeerConnPolicyRequest, opts ...grpc.CallOption) (*SetPeerConnPolicyResponse, error)
+	// lncli: `reconnectpeer`
+	// ReconnectPeer immediately reconnects to a persistent peer we're not
+	// connected to, resetting its backoff and its failed connection attempts.
+	// This also resumes the connection attempts to a peer that reached its
+	// maximum number of connection attempts.
+	ReconnectPeer(ctx context.Context, in *ReconnectPeerRequest, opts ...grpc.CallOption) (*ReconnectPeerResponse, error)
 }
 
 type lightningClient struct {
@@ -1290,6 +1354,96 @@ func (c *lightningClient) LookupHtlcResolution(ctx context.Context, in *LookupHt
 	return out, nil
 }
 
+func (c *lightningClient) BuyLiquidity(ctx context.Context, in *BuyLiquidityRequest, opts ...grpc.CallOption) (*BuyLiquidityResponse, error) {
+	out := new(BuyLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/BuyLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListLiquidityAds(ctx context.Context, in *ListLiquidityAdsRequest, opts ...grpc.CallOption) (*ListLiquidityAdsResponse, error) {
+	out := new(ListLiquidityAdsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListLiquidityAds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) BumpFundingFee(ctx context.Context, in *BumpFundingFeeRequest, opts ...grpc.CallOption) (*BumpFundingFeeResponse, error) {
+	out := new(BumpFundingFeeResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/BumpFundingFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) PauseChannel(ctx context.Context, in *PauseChannelRequest, opts ...grpc.CallOption) (*PauseChannelResponse, error) {
+	out := new(PauseChannelResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/PauseChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ResumeChannel(ctx context.Context, in *ResumeChannelRequest, opts ...grpc.CallOption) (*ResumeChannelResponse, error) {
+	out := new(ResumeChannelResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ResumeChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ScheduleChannelClose(ctx context.Context, in *ScheduleChannelCloseRequest, opts ...grpc.CallOption) (*ScheduleChannelCloseResponse, error) {
+	out := new(ScheduleChannelCloseResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ScheduleChannelClose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) CancelScheduledClose(ctx context.Context, in *CancelScheduledCloseRequest, opts ...grpc.CallOption) (*CancelScheduledCloseResponse, error) {
+	out := new(CancelScheduledCloseResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/CancelScheduledClose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListPersistentPeers(ctx context.Context, in *ListPersistentPeersRequest, opts ...grpc.CallOption) (*ListPersistentPeersResponse, error) {
+	out := new(ListPersistentPeersResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListPersistentPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SetPeerConnPolicy(ctx context.Context, in *SetPeerConnPolicyRequest, opts ...grpc.CallOption) (*SetPeerConnPolicyResponse, error) {
+	out := new(SetPeerConnPolicyResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SetPeerConnPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ReconnectPeer(ctx context.Context, in *ReconnectPeerRequest, opts ...grpc.CallOption) (*ReconnectPeerResponse, error) {
+	out := new(ReconnectPeerResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ReconnectPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LightningServer is the server API for Lightning service.
 // All implementations must embed UnimplementedLightningServer
 // for forward compatibility
@@ -1679,6 +1833,70 @@ type LightningServer interface {
 	// If the htlc has no final resolution yet, a NotFound grpc status code is
 	// returned.
 	LookupHtlcResolution(context.Context, *LookupHtlcResolutionRequest) (*LookupHtlcResolutionResponse, error)
+	// lncli: `buyliquidity`
+	// BuyLiquidity buys inbound liquidity from a node that advertises lease
+	// rates. It opens a dual-funded script enforced lease channel to the node
+	// that requests the liquidity, which the node contributes to the channel in
+	// return for the lease fee. The lease fee is paid out of our initial channel
+	// balance. The call returns once the funding transaction is published.
+	BuyLiquidity(context.Context, *BuyLiquidityRequest) (*BuyLiquidityResponse, error)
+	// lncli: `listliquidityads`
+	// ListLiquidityAds lists the nodes in the graph that advertise lease rates
+	// for inbound liquidity.
+	ListLiquidityAds(context.Context, *ListLiquidityAdsRequest) (*ListLiquidityAdsResponse, error)
+	// lncli: `bumpfundingfee`
+	// BumpFundingFee replaces the funding transaction of a pending dual-funded
+	// channel we opened with one that pays a higher fee rate. The replacement
+	// is negotiated with the peer and spends the same inputs. The call returns
+	// the channel point of the replacement once it was published. The channel
+	// is opened with whichever funding transaction confirms first.
+	BumpFundingFee(context.Context, *BumpFundingFeeRequest) (*BumpFundingFeeResponse, error)
+	// lncli: `pausechannel`
+	// PauseChannel stops a channel from accepting new HTLCs in either direction
+	// and waits for its in-flight HTLCs to be resolved. New HTLCs aren't
+	// forwarded to the peer anymore, while the HTLCs the peer adds are failed
+	// back. The call returns once the channel has no HTLCs, which allows
+	// maintenance that requires a consistent channel state, e.g. taking a
+	// backup. The pause is local to our node, so the channel isn't quiesced with
+	// the peer and can be resumed without a reconnection. The channel stays
+	// paused until it is resumed, also across reconnections and restarts. If
+	// the HTLCs aren't resolved in time, the channel accepts HTLCs again and an
+	// error is returned.
+	PauseChannel(context.Context, *PauseChannelRequest) (*PauseChannelResponse, error)
+	// lncli: `resumechannel`
+	// ResumeChannel allows HTLCs on a channel that was paused with PauseChannel
+	// again.
+	ResumeChannel(context.Context, *ResumeChannelRequest) (*ResumeChannelResponse, error)
+	// lncli: `scheduleclose`
+	// ScheduleChannelClose schedules the cooperative close of a channel. The
+	// close is initiated once the deadline height is reached or the estimated
+	// fee rate drops to the target fee rate, whichever happens first. As long as
+	// the closing transaction stays unconfirmed, its fee is bumped each block up
+	// to the given budget if the channel uses the RBF cooperative close
+	// protocol. Scheduled closes survive restarts and are listed by
+	// PendingChannels until the closing transaction confirmed.
+	ScheduleChannelClose(context.Context, *ScheduleChannelCloseRequest) (*ScheduleChannelCloseResponse, error)
+	// lncli: `cancelscheduledclose`
+	// CancelScheduledClose cancels the scheduled close of a channel. A scheduled
+	// close can only be canceled before the close of the channel was initiated.
+	CancelScheduledClose(context.Context, *CancelScheduledCloseRequest) (*CancelScheduledCloseResponse, error)
+	// lncli: `listpersistentpeers`
+	// ListPersistentPeers lists the peers we maintain a persistent connection
+	// with, together with their connection policy, their reconnection backoff
+	// and their failed connection attempts.
+	ListPersistentPeers(context.Context, *ListPersistentPeersRequest) (*ListPersistentPeersResponse, error)
+	// lncli: `setpeerconnpolicy`
+	// SetPeerConnPolicy sets the connection policy of a peer, which determines
+	// its reconnection priority, the number of failed connection attempts after
+	// which we stop reconnecting to it and which of its addresses we connect to.
+	// Policies are persisted and survive restarts.
+	SetPeerConnPolicy(context.Context, *SetPeerConnPolicyRequest) (*SetPeerConnPolicyResponse, error)
+	// lncli: `reconnectpeer`
+	// ReconnectPeer immediately reconnects to a persistent peer we're not
+	// connected to, resetting its backoff and its failed connection attempts.
+	// This also resumes the connection attempts to a peer that reached its
+	// maximum number of connection attempts.
+	ReconnectPeer(context.Context, *ReconnectPeerRequest) (*ReconnectPeerResponse, error)
 	mustEmbedUnimplementedLightningServer()
 }
 
@@ -1887,6 +2105,36 @@ func (UnimplementedLightningServer) ListAliases(context.Context, *ListAliasesReq
 func (UnimplementedLightningServer) LookupHtlcResolution(context.Context, *LookupHtlcResolutionRequest) (*LookupHtlcResolutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LookupHtlcResolution not implemented")
 }
+func (UnimplementedLightningServer) BuyLiquidity(context.Context, *BuyLiquidityRequest) (*BuyLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyLiquidity not implemented")
+}
+func (UnimplementedLightningServer) ListLiquidityAds(context.Context, *ListLiquidityAdsRequest) (*ListLiquidityAdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLiquidityAds not implemented")
+}
+func (UnimplementedLightningServer) BumpFundingFee(context.Context, *BumpFundingFeeRequest) (*BumpFundingFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpFundingFee not implemented")
+}
+func (UnimplementedLightningServer) PauseChannel(context.Context, *PauseChannelRequest) (*PauseChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseChannel not implemented")
+}
+func (UnimplementedLightningServer) ResumeChannel(context.Context, *ResumeChannelRequest) (*ResumeChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeChannel not implemented")
+}
+func (UnimplementedLightningServer) ScheduleChannelClose(context.Context, *ScheduleChannelCloseRequest) (*ScheduleChannelCloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleChannelClose not implemented")
+}
+func (UnimplementedLightningServer) CancelScheduledClose(context.Context, *CancelScheduledCloseRequest) (*CancelScheduledCloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledClose not implemented")
+}
+func (UnimplementedLightningServer) ListPersistentPeers(context.Context, *ListPersistentPeersRequest) (*ListPersistentPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPersistentPeers not implemented")
+}
+func (UnimplementedLightningServer) SetPeerConnPolicy(context.Context, *SetPeerConnPolicyRequest) (*SetPeerConnPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPeerConnPolicy not implemented")
+}
+func (UnimplementedLightningServer) ReconnectPeer(context.Context, *ReconnectPeerRequest) (*ReconnectPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconnectPeer not implemented")
+}
 func (UnimplementedLightningServer) mustEmbedUnimplementedLightningServer() {}
 
 // UnsafeLightningServer may be embedded to opt out of forward compatibility for this service.
@@ -3152,6 +3400,186 @@ func _Lightning_LookupHtlcResolution_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_BuyLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BuyLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/BuyLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).BuyLiquidity(ctx, req.(*BuyLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListLiquidityAds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLiquidityAdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListLiquidityAds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListLiquidityAds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListLiquidityAds(ctx, req.(*ListLiquidityAdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_BumpFundingFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFundingFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BumpFundingFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/BumpFundingFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).BumpFundingFee(ctx, req.(*BumpFundingFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_PauseChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).PauseChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/PauseChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).PauseChannel(ctx, req.(*PauseChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ResumeChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ResumeChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ResumeChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ResumeChannel(ctx, req.(*ResumeChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ScheduleChannelClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleChannelCloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ScheduleChannelClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ScheduleChannelClose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ScheduleChannelClose(ctx, req.(*ScheduleChannelCloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_CancelScheduledClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledCloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).CancelScheduledClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/CancelScheduledClose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).CancelScheduledClose(ctx, req.(*CancelScheduledCloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListPersistentPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPersistentPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListPersistentPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListPersistentPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListPersistentPeers(ctx, req.(*ListPersistentPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SetPeerConnPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPeerConnPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SetPeerConnPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SetPeerConnPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SetPeerConnPolicy(ctx, req.(*SetPeerConnPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ReconnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconnectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ReconnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ReconnectPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ReconnectPeer(ctx, req.(*ReconnectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Lightning_ServiceDesc is the grpc.ServiceDesc for Lightning service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3379,6 +3807,46 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LookupHtlcResolution",
 			Handler:    _Lightning_LookupHtlcResolution_Handler,
 		},
+		{
+			MethodName: "BuyLiquidity",
+			Handler:    _Lightning_BuyLiquidity_Handler,
+		},
+		{
+			MethodName: "ListLiquidityAds",
+			Handler:    _Lightning_ListLiquidityAds_Handler,
+		},
+		{
+			MethodName: "BumpFundingFee",
+			Handler:    _Lightning_BumpFundingFee_Handler,
+		},
+		{
+			MethodName: "PauseChannel",
+			Handler:    _Lightning_PauseChannel_Handler,
+		},
+		{
+			MethodName: "ResumeChannel",
+			Handler:    _Lightning_ResumeChannel_Handler,
+		},
+		{
+			MethodName: "ScheduleChannelClose",
+			Handler:    _Lightning_ScheduleChannelClose_Handler,
+		},
+		{
+			MethodName: "CancelScheduledClose",
+			Handler:    _Lightning_CancelScheduledClose_Handler,
+		},
+		{
+			MethodName: "ListPersistentPeers",
+			Handler:    _Lightning_ListPersistentPeers_Handler,
+		},
+		{
+			MethodName: "SetPeerConnPolicy",
+			Handler:    _Lightning_SetPeerConnPolicy_Handler,
+		},
+		{
+			MethodName: "ReconnectPeer",
+			Handler:    _Lightning_ReconnectPeer_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -74,6 +74,10 @@ func DefaultConfig() *Config {
 			NodeWeight: routing.DefaultBimodalNodeWeight,
 			DecayTime:  routing.DefaultBimodalDecayTime,
 		},
+		ExternalConfig: &ExternalConfig{
+			CacheTTL:     routing.DefaultExternalCacheTTL,
+			QueryTimeout: routing.DefaultExternalQueryTimeout,
+		},
 		FeeEstimationTimeout: routing.DefaultFeeEstimationTimeout,
 	}
 
@@ -102,6 +106,10 @@ func GetRoutingConfig(cfg *Config) *RoutingConfig {
 			NodeWeight: cfg.BimodalConfig.NodeWeight,
 			DecayTime:  cfg.BimodalConfig.DecayTime,
 		},
+		ExternalConfig: &ExternalConfig{
+			CacheTTL:     cfg.ExternalConfig.CacheTTL,
+			QueryTimeout: cfg.ExternalConfig.QueryTimeout,
+		},
 		FeeEstimationTimeout: cfg.FeeEstimationTimeout,
 	}
 }
@@ -0,0 +1,162 @@
+package routerrpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// ErrExternalEstimatorInactive is returned when a client connects to
+	// the external estimator stream while another estimator is in use.
+	ErrExternalEstimatorInactive = errors.New("external estimator not " +
+		"active, set routerrpc.estimator=external or switch the " +
+		"mission control config to the external model")
+
+	// errEstimatorClientDisconnected is returned for pending queries when
+	// the external estimator client disconnects.
+	errEstimatorClientDisconnected = errors.New("external estimator " +
+		"client disconnected")
+)
+
+// externalEstimatorClient is a helper struct that handles the lifecycle of an
+// external estimator streaming session. It forwards the probability queries
+// of the external estimator to the stream and matches the estimates of the
+// client with the pending queries.
+type externalEstimatorClient struct {
+	// stream is the bidirectional RPC stream.
+	stream Router_ExternalEstimatorServer
+
+	// sendMtx serializes the sends on the stream.
+	sendMtx sync.Mutex
+
+	// nextID is the identifier of the next query.
+	nextID uint64
+
+	// pending holds a channel for every query that wasn't answered yet.
+	pending map[uint64]chan float64
+
+	// quit is closed when the stream terminates.
+	quit chan struct{}
+
+	mtx sync.Mutex
+}
+
+// newExternalEstimatorClient creates a new externalEstimatorClient.
+func newExternalEstimatorClient(
+	stream Router_ExternalEstimatorServer) *externalEstimatorClient {
+
+	return &externalEstimatorClient{
+		stream:  stream,
+		pending: make(map[uint64]chan float64),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Compile-time check that the client implements the interface expected by the
+// external estimator.
+var _ routing.ExternalEstimatorClient = (*externalEstimatorClient)(nil)
+
+// run registers the client with the estimator and receives the estimates from
+// the stream until the stream terminates.
+func (c *externalEstimatorClient) run(
+	estimator *routing.ExternalEstimator) error {
+
+	if err := estimator.RegisterClient(c); err != nil {
+		return err
+	}
+	defer estimator.UnregisterClient(c)
+	defer close(c.quit)
+
+	for {
+		resp, err := c.stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		c.mtx.Lock()
+		respChan, ok := c.pending[resp.QueryId]
+		delete(c.pending, resp.QueryId)
+		c.mtx.Unlock()
+
+		// The query may have timed out already, in which case the
+		// estimate is dropped.
+		if !ok {
+			log.Debugf("Dropping estimate for unknown query %v",
+				resp.QueryId)
+
+			continue
+		}
+
+		respChan <- resp.Probability
+	}
+}
+
+// QueryProbability sends the query to the client and waits for its estimate.
+//
+// NOTE: This is part of the routing.ExternalEstimatorClient interface.
+func (c *externalEstimatorClient) QueryProbability(ctx context.Context,
+	query *routing.ProbabilityQuery) (float64, error) {
+
+	respChan := make(chan float64, 1)
+
+	c.mtx.Lock()
+	queryID := c.nextID
+	c.nextID++
+	c.pending[queryID] = respChan
+	c.mtx.Unlock()
+
+	defer func() {
+		c.mtx.Lock()
+		delete(c.pending, queryID)
+		c.mtx.Unlock()
+	}()
+
+	req := &ProbabilityEstimateRequest{
+		QueryId:     queryID,
+		FromNode:    query.FromNode[:],
+		ToNode:      query.ToNode[:],
+		AmtMsat:     uint64(query.Amount),
+		CapacitySat: int64(query.Capacity),
+		Local:       query.Local,
+		Results:     marshallNodeResults(query.FromNode, query.Results),
+	}
+
+	c.sendMtx.Lock()
+	err := c.stream.Send(req)
+	c.sendMtx.Unlock()
+	if err != nil {
+		return 0, err
+	}
+
+	select {
+	case probability := <-respChan:
+		return probability, nil
+
+	case <-ctx.Done():
+		return 0, ctx.Err()
+
+	case <-c.quit:
+		return 0, errEstimatorClientDisconnected
+	}
+}
+
+// marshallNodeResults marshalls the mission control results of a node to the
+// rpc pair history.
+func marshallNodeResults(fromNode route.Vertex,
+	results routing.NodeResults) []*PairHistory {
+
+	rpcPairs := make([]*PairHistory, 0, len(results))
+	for toNode, result := range results {
+		rpcPairs = append(rpcPairs, &PairHistory{
+			NodeFrom: fromNode[:],
+			NodeTo:   toNode[:],
+			History:  toRPCPairData(&result),
+		})
+	}
+
+	return rpcPairs
+}
@@ -0,0 +1,109 @@
+package routerrpc
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// estimatorStreamMock is a mock of the external estimator stream.
+type estimatorStreamMock struct {
+	grpc.ServerStream
+	requests  chan *ProbabilityEstimateRequest
+	estimates chan *ProbabilityEstimate
+}
+
+func (m *estimatorStreamMock) Send(req *ProbabilityEstimateRequest) error {
+	m.requests <- req
+	return nil
+}
+
+func (m *estimatorStreamMock) Recv() (*ProbabilityEstimate, error) {
+	estimate, ok := <-m.estimates
+	if !ok {
+		return nil, io.EOF
+	}
+
+	return estimate, nil
+}
+
+// TestExternalEstimatorClient tests that probability queries are forwarded to
+// the stream and answered with the estimates of the client.
+func TestExternalEstimatorClient(t *testing.T) {
+	t.Parallel()
+
+	estimator, err := routing.NewExternalEstimator(
+		routing.DefaultExternalConfig(),
+	)
+	require.NoError(t, err)
+
+	stream := &estimatorStreamMock{
+		requests:  make(chan *ProbabilityEstimateRequest, 1),
+		estimates: make(chan *ProbabilityEstimate),
+	}
+	client := newExternalEstimatorClient(stream)
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- client.run(estimator)
+	}()
+
+	fromNode := route.Vertex{1}
+	toNode := route.Vertex{2}
+	results := routing.NodeResults{
+		toNode: routing.TimedPairResult{
+			SuccessAmt:  1000,
+			SuccessTime: time.Unix(1000, 0),
+		},
+	}
+
+	// Answer the query from the stream.
+	go func() {
+		req := <-stream.requests
+		stream.estimates <- &ProbabilityEstimate{
+			QueryId:     req.QueryId,
+			Probability: 0.3,
+		}
+	}()
+
+	probability, err := client.QueryProbability(
+		t.Context(), &routing.ProbabilityQuery{
+			FromNode: fromNode,
+			ToNode:   toNode,
+			Amount:   1000,
+			Capacity: 100,
+			Results:  results,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 0.3, probability)
+
+	// A query that isn't answered times out.
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = client.QueryProbability(ctx, &routing.ProbabilityQuery{
+		FromNode: fromNode,
+		ToNode:   toNode,
+		Results:  results,
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The request contains the mission control results of the source.
+	req := <-stream.requests
+	require.Equal(t, fromNode[:], req.FromNode)
+	require.Equal(t, toNode[:], req.ToNode)
+	require.Len(t, req.Results, 1)
+	require.EqualValues(t, 1000, req.Results[0].History.SuccessAmtMsat)
+
+	// Once the stream closes, the client is unregistered.
+	close(stream.estimates)
+	require.ErrorIs(t, <-errChan, io.EOF)
+	require.NoError(t, estimator.RegisterClient(client))
+}
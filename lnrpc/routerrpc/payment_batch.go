@@ -0,0 +1,387 @@
+package routerrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultBatchMaxParallel is the default maximum number of payments of
+	// a batch that are in flight at the same time.
+	DefaultBatchMaxParallel = 10
+)
+
+var (
+	// errFeeBudgetExhausted is reported for the payments of a batch that
+	// were skipped because the fee budget of the batch was exhausted.
+	errFeeBudgetExhausted = errors.New("fee budget of batch exhausted")
+)
+
+// batchSendFunc sends the payment of a batch with the given index and fee
+// limit, and passes its state updates to the notify callback until the
+// payment completed.
+type batchSendFunc func(ctx context.Context, index int,
+	feeLimit lnwire.MilliSatoshi, notify func(*lnrpc.Payment) error) error
+
+// batchPaymentUpdate is a state update of a single payment of a batch.
+type batchPaymentUpdate struct {
+	// index is the index of the payment in the batch.
+	index int
+
+	// payment is the latest state of the payment. It is nil for the
+	// update that signals the completion of the payment.
+	payment *lnrpc.Payment
+
+	// done is set once the payment completed or couldn't be started.
+	done bool
+
+	// err is the reason the payment couldn't be started or tracked, if
+	// any.
+	err error
+}
+
+// paymentBatch schedules the payments of a batch. It limits the number of
+// payments in flight and reserves their fee limits from a shared fee budget.
+type paymentBatch struct {
+	// feeLimits are the fee limits of the individual payments.
+	feeLimits []lnwire.MilliSatoshi
+
+	// maxParallel is the maximum number of payments in flight.
+	maxParallel int
+
+	// feeBudget is the maximum total fee of all payments, if any.
+	feeBudget fn.Option[lnwire.MilliSatoshi]
+
+	// send sends a single payment.
+	send batchSendFunc
+
+	// notify delivers the updates of the batch to the client.
+	notify func(*PaymentBatchUpdate) error
+
+	// quit is closed when the server shuts down.
+	quit <-chan struct{}
+}
+
+// run sends all payments of the batch and notifies the client about their
+// state updates. Once all payments completed, the summary of the batch is
+// sent.
+func (b *paymentBatch) run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		numPayments = len(b.feeLimits)
+		updates     = make(chan *batchPaymentUpdate)
+		reserved    = make([]lnwire.MilliSatoshi, numPayments)
+		latest      = make([]*lnrpc.Payment, numPayments)
+		remaining   = b.feeBudget
+		summary     = &PaymentBatchSummary{}
+		next        int
+		inFlight    int
+	)
+
+	// reserve reserves the whole fee limit of the given payment from the
+	// remaining budget. It returns an error if the remaining budget
+	// doesn't cover the fee limit.
+	reserve := func(index int) error {
+		if remaining.IsNone() {
+			return nil
+		}
+
+		feeLimit := b.feeLimits[index]
+		budget := remaining.UnwrapOr(0)
+		if feeLimit > budget {
+			return fmt.Errorf("%w: fee limit %v exceeds remaining "+
+				"budget %v", errFeeBudgetExhausted, feeLimit,
+				budget)
+		}
+
+		remaining = fn.Some(budget - feeLimit)
+
+		return nil
+	}
+
+	start := func(index int, feeLimit lnwire.MilliSatoshi) {
+		sendUpdate := func(update *batchPaymentUpdate) error {
+			select {
+			case updates <- update:
+				return nil
+
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := b.send(ctx, index, feeLimit,
+				func(payment *lnrpc.Payment) error {
+					return sendUpdate(&batchPaymentUpdate{
+						index:   index,
+						payment: payment,
+					})
+				},
+			)
+
+			_ = sendUpdate(&batchPaymentUpdate{
+				index: index,
+				done:  true,
+				err:   err,
+			})
+		}()
+	}
+
+	notifyPayment := func(status *BatchPaymentStatus) error {
+		return b.notify(&PaymentBatchUpdate{
+			Update: &PaymentBatchUpdate_Payment{
+				Payment: status,
+			},
+		})
+	}
+
+	// complete updates the summary once a payment completed and returns
+	// the unused part of its fee limit to the budget.
+	complete := func(update *batchPaymentUpdate) error {
+		index := update.index
+		payment := latest[index]
+
+		var feePaid lnwire.MilliSatoshi
+		switch {
+		case update.err != nil:
+			summary.NumFailed++
+
+			err := notifyPayment(&BatchPaymentStatus{
+				Index: uint32(index),
+				Error: update.err.Error(),
+			})
+			if err != nil {
+				return err
+			}
+
+		case payment != nil &&
+			payment.Status == lnrpc.Payment_SUCCEEDED:
+
+			summary.NumSucceeded++
+			summary.AmtPaidMsat += payment.ValueMsat
+			summary.FeePaidMsat += payment.FeeMsat
+			feePaid = lnwire.MilliSatoshi(payment.FeeMsat)
+
+		default:
+			summary.NumFailed++
+		}
+
+		remaining = fn.MapOption(func(
+			budget lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+
+			return budget + reserved[index] -
+				min(feePaid, reserved[index])
+		})(remaining)
+
+		return nil
+	}
+
+	for next < numPayments || inFlight > 0 {
+		var reserveErr error
+		for next < numPayments && inFlight < b.maxParallel {
+			reserveErr = reserve(next)
+			if reserveErr != nil {
+				break
+			}
+
+			reserved[next] = b.feeLimits[next]
+			start(next, b.feeLimits[next])
+			next++
+			inFlight++
+		}
+
+		// If the budget is exhausted while no payment is in flight
+		// that could return a part of it, the remaining payments are
+		// skipped.
+		if inFlight == 0 {
+			for ; next < numPayments; next++ {
+				summary.NumSkipped++
+
+				err := notifyPayment(&BatchPaymentStatus{
+					Index: uint32(next),
+					Error: reserveErr.Error(),
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			break
+		}
+
+		select {
+		case update := <-updates:
+			if update.done {
+				inFlight--
+				if err := complete(update); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			latest[update.index] = update.payment
+
+			err := notifyPayment(&BatchPaymentStatus{
+				Index:   uint32(update.index),
+				Payment: update.payment,
+			})
+			if err != nil {
+				return err
+			}
+
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-b.quit:
+			return errServerShuttingDown
+		}
+	}
+
+	return b.notify(&PaymentBatchUpdate{
+		Update: &PaymentBatchUpdate_Summary{
+			Summary: summary,
+		},
+	})
+}
+
+// SendPaymentBatch attempts to route a batch of payments. The payments are
+// sent with a limited concurrency and a shared fee budget, and the state
+// updates of all payments are streamed to the client, followed by a summary
+// of the batch.
+func (s *Server) SendPaymentBatch(req *SendPaymentBatchRequest,
+	stream Router_SendPaymentBatchServer) error {
+
+	if len(req.Payments) == 0 {
+		return errors.New("no payments specified")
+	}
+
+	if req.FeeBudgetMsat < 0 {
+		return errors.New("fee budget must not be negative")
+	}
+
+	var feeBudget fn.Option[lnwire.MilliSatoshi]
+	if req.FeeBudgetMsat > 0 {
+		feeBudget = fn.Some(lnwire.MilliSatoshi(req.FeeBudgetMsat))
+	}
+
+	payments := make([]*routing.LightningPayment, len(req.Payments))
+	feeLimits := make([]lnwire.MilliSatoshi, len(req.Payments))
+	indices := make(map[lntypes.Hash]int, len(req.Payments))
+	backend := s.cfg.RouterBackend
+	for i, payReq := range req.Payments {
+		// Copy the request, so that setting the default timeout doesn't
+		// modify the request of the client.
+		if payReq.TimeoutSeconds == 0 {
+			payReq = proto.Clone(payReq).(*SendPaymentRequest)
+			payReq.TimeoutSeconds = DefaultPaymentTimeout
+		}
+
+		payment, err := backend.extractIntentFromSendRequest(payReq)
+		if err != nil {
+			return fmt.Errorf("invalid payment %d: %w", i, err)
+		}
+
+		hash := lntypes.Hash(payment.Identifier())
+		if j, ok := indices[hash]; ok {
+			return fmt.Errorf("payments %d and %d have the same "+
+				"payment hash %v", j, i, hash)
+		}
+		indices[hash] = i
+
+		// A payment whose fee limit exceeds the whole budget could
+		// never be started.
+		budget := feeBudget.UnwrapOr(payment.FeeLimit)
+		if payment.FeeLimit > budget {
+			return fmt.Errorf("fee limit %v of payment %d exceeds "+
+				"fee budget %v", payment.FeeLimit, i, budget)
+		}
+
+		payments[i] = payment
+		feeLimits[i] = payment.FeeLimit
+	}
+
+	maxParallel := int(req.MaxParallel)
+	if maxParallel == 0 {
+		maxParallel = DefaultBatchMaxParallel
+	}
+
+	batch := &paymentBatch{
+		feeLimits:   feeLimits,
+		maxParallel: maxParallel,
+		feeBudget:   feeBudget,
+		send: func(ctx context.Context, index int,
+			feeLimit lnwire.MilliSatoshi,
+			notify func(*lnrpc.Payment) error) error {
+
+			payment := payments[index]
+			payment.FeeLimit = feeLimit
+
+			return s.sendBatchPayment(
+				ctx, payment, req.Payments[index].Cancelable,
+				req.NoInflightUpdates, notify,
+			)
+		},
+		notify: stream.Send,
+		quit:   s.quit,
+	}
+
+	log.Infof("Sending batch of %d payments with max parallel %d and fee "+
+		"budget %v msat", len(payments), maxParallel, req.FeeBudgetMsat)
+
+	err := batch.run(stream.Context())
+	if errors.Is(err, context.Canceled) {
+		log.Infof("Payment batch stream canceled")
+
+		return nil
+	}
+
+	return err
+}
+
+// sendBatchPayment sends a single payment of a batch and passes its state
+// updates to the notify callback until the payment completed. If the payment
+// is cancelable, it is canceled together with the passed context.
+func (s *Server) sendBatchPayment(ctx context.Context,
+	payment *routing.LightningPayment, cancelable, noInflightUpdates bool,
+	notify func(*lnrpc.Payment) error) error {
+
+	paySession, shardTracker, err := s.cfg.Router.PreparePayment(payment)
+	if err != nil {
+		return err
+	}
+
+	// Subscribe to the payment before sending it to make sure we won't
+	// miss events.
+	sub, err := s.subscribePayment(payment.Identifier())
+	if err != nil {
+		return err
+	}
+
+	payCtx := context.Background()
+	if cancelable {
+		payCtx = ctx
+	}
+
+	s.cfg.Router.SendPaymentAsync(payCtx, payment, paySession, shardTracker)
+
+	return s.trackPaymentStream(ctx, sub, noInflightUpdates, notify)
+}
@@ -0,0 +1,315 @@
+package routerrpc
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// batchSendMock is a mock of the payments of a batch. Every payment reports
+// an in-flight update when it is started and completes once the test
+// releases it.
+type batchSendMock struct {
+	// started receives the index and fee limit of every started payment.
+	started chan batchStart
+
+	// results are the outcomes of the payments, keyed by their index.
+	results map[int]chan batchResult
+}
+
+type batchStart struct {
+	index    int
+	feeLimit lnwire.MilliSatoshi
+}
+
+type batchResult struct {
+	fee lnwire.MilliSatoshi
+	err error
+}
+
+func newBatchSendMock(numPayments int) *batchSendMock {
+	m := &batchSendMock{
+		started: make(chan batchStart, numPayments),
+		results: make(map[int]chan batchResult, numPayments),
+	}
+	for i := 0; i < numPayments; i++ {
+		m.results[i] = make(chan batchResult, 1)
+	}
+
+	return m
+}
+
+func (m *batchSendMock) send(ctx context.Context, index int,
+	feeLimit lnwire.MilliSatoshi,
+	notify func(*lnrpc.Payment) error) error {
+
+	m.started <- batchStart{index: index, feeLimit: feeLimit}
+
+	err := notify(&lnrpc.Payment{
+		Status:    lnrpc.Payment_IN_FLIGHT,
+		ValueMsat: 10_000,
+	})
+	if err != nil {
+		return err
+	}
+
+	var result batchResult
+	select {
+	case result = <-m.results[index]:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if result.err != nil {
+		return result.err
+	}
+
+	status := lnrpc.Payment_SUCCEEDED
+	if result.fee > feeLimit {
+		status = lnrpc.Payment_FAILED
+	}
+
+	return notify(&lnrpc.Payment{
+		Status:    status,
+		ValueMsat: 10_000,
+		FeeMsat:   int64(result.fee),
+	})
+}
+
+// expectStarts asserts that the given payments are started in any order.
+func (m *batchSendMock) expectStarts(t *testing.T, expected ...batchStart) {
+	t.Helper()
+
+	started := make([]batchStart, 0, len(expected))
+	for range expected {
+		select {
+		case start := <-m.started:
+			started = append(started, start)
+
+		case <-time.After(time.Second):
+			t.Fatalf("payments not started, expected %v, got %v",
+				expected, started)
+		}
+	}
+
+	require.ElementsMatch(t, expected, started)
+}
+
+// expectNoStart asserts that no further payment is started.
+func (m *batchSendMock) expectNoStart(t *testing.T) {
+	t.Helper()
+
+	select {
+	case start := <-m.started:
+		t.Fatalf("unexpected start of payment %d", start.index)
+
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// runBatch runs the batch in the background and returns the channel that
+// receives its updates along with the channel of its result.
+func runBatch(t *testing.T, batch *paymentBatch) (chan *PaymentBatchUpdate,
+	chan error) {
+
+	updates := make(chan *PaymentBatchUpdate, 100)
+	batch.notify = func(update *PaymentBatchUpdate) error {
+		updates <- update
+		return nil
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- batch.run(t.Context())
+	}()
+
+	return updates, errChan
+}
+
+// collectSummary waits for the batch to complete and returns its summary
+// along with the final states of all payments.
+func collectSummary(t *testing.T, updates chan *PaymentBatchUpdate,
+	errChan chan error) (*PaymentBatchSummary,
+	map[uint32]*BatchPaymentStatus) {
+
+	t.Helper()
+
+	require.NoError(t, <-errChan)
+	close(updates)
+
+	final := make(map[uint32]*BatchPaymentStatus)
+	var summary *PaymentBatchSummary
+	for update := range updates {
+		switch u := update.Update.(type) {
+		case *PaymentBatchUpdate_Payment:
+			final[u.Payment.Index] = u.Payment
+
+		case *PaymentBatchUpdate_Summary:
+			summary = u.Summary
+		}
+	}
+	require.NotNil(t, summary)
+
+	return summary, final
+}
+
+// TestPaymentBatchMaxParallel tests that no more than the maximum number of
+// payments of a batch are in flight at the same time.
+func TestPaymentBatchMaxParallel(t *testing.T) {
+	t.Parallel()
+
+	mock := newBatchSendMock(3)
+	updates, errChan := runBatch(t, &paymentBatch{
+		feeLimits:   []lnwire.MilliSatoshi{100, 100, 100},
+		maxParallel: 2,
+		send:        mock.send,
+	})
+
+	mock.expectStarts(t, batchStart{0, 100}, batchStart{1, 100})
+	mock.expectNoStart(t)
+
+	// Once a payment completed, the next one is started.
+	mock.results[1] <- batchResult{fee: 10}
+	mock.expectStarts(t, batchStart{2, 100})
+
+	mock.results[0] <- batchResult{err: errors.New("already paid")}
+	mock.results[2] <- batchResult{fee: 200}
+
+	summary, final := collectSummary(t, updates, errChan)
+	require.Equal(t, &PaymentBatchSummary{
+		NumSucceeded: 1,
+		NumFailed:    2,
+		AmtPaidMsat:  10_000,
+		FeePaidMsat:  10,
+	}, summary)
+
+	require.Equal(t, "already paid", final[0].Error)
+	require.Equal(t, lnrpc.Payment_SUCCEEDED, final[1].Payment.Status)
+	require.Equal(t, lnrpc.Payment_FAILED, final[2].Payment.Status)
+}
+
+// TestPaymentBatchFeeBudget tests that the whole fee limits of the payments
+// are reserved from the fee budget of the batch, and that payments are skipped
+// once the budget is exhausted.
+func TestPaymentBatchFeeBudget(t *testing.T) {
+	t.Parallel()
+
+	mock := newBatchSendMock(4)
+	updates, errChan := runBatch(t, &paymentBatch{
+		feeLimits:   []lnwire.MilliSatoshi{1000, 1000, 1000, 1000},
+		maxParallel: 10,
+		feeBudget:   fn.Some[lnwire.MilliSatoshi](2500),
+		send:        mock.send,
+	})
+
+	// The remainder of the budget doesn't cover the fee limit of the
+	// third payment, so it needs to wait instead of being started with a
+	// smaller fee limit.
+	mock.expectStarts(t, batchStart{0, 1000}, batchStart{1, 1000})
+	mock.expectNoStart(t)
+
+	// The unused part of the fee limit of the first payment is returned
+	// to the budget.
+	mock.results[0] <- batchResult{fee: 100}
+	mock.expectStarts(t, batchStart{2, 1000})
+
+	// The remaining payments use up too much of the budget for the last
+	// payment, so it is skipped.
+	mock.results[1] <- batchResult{fee: 500}
+	mock.expectNoStart(t)
+	mock.results[2] <- batchResult{fee: 1000}
+
+	summary, final := collectSummary(t, updates, errChan)
+	require.Equal(t, &PaymentBatchSummary{
+		NumSucceeded: 3,
+		NumSkipped:   1,
+		AmtPaidMsat:  30_000,
+		FeePaidMsat:  1600,
+	}, summary)
+	require.Contains(t, final[3].Error, errFeeBudgetExhausted.Error())
+}
+
+// TestSendPaymentBatchValidation tests that a batch is rejected if the fee
+// limit of a payment exceeds the fee budget, and that the requests of the
+// client aren't modified.
+func TestSendPaymentBatchValidation(t *testing.T) {
+	t.Parallel()
+
+	destNodeBytes, err := hex.DecodeString(destKey)
+	require.NoError(t, err)
+
+	server := &Server{
+		cfg: &Config{
+			RouterBackend: &RouterBackend{
+				MaxTotalTimelock: 1000,
+				ShouldSetExpAccountability: func() bool {
+					return false
+				},
+			},
+		},
+	}
+
+	newRequest := func(hash byte, feeLimit int64) *SendPaymentRequest {
+		paymentHash := make([]byte, 32)
+		paymentHash[0] = hash
+
+		return &SendPaymentRequest{
+			Dest:         destNodeBytes,
+			Amt:          10_000,
+			PaymentHash:  paymentHash,
+			FeeLimitMsat: feeLimit,
+		}
+	}
+
+	req := &SendPaymentBatchRequest{
+		Payments: []*SendPaymentRequest{
+			newRequest(1, 1000),
+			newRequest(2, 1001),
+		},
+		FeeBudgetMsat: 1000,
+	}
+
+	err = server.SendPaymentBatch(req, nil)
+	require.ErrorContains(t, err, "fee limit 1001 mSAT of payment 1 "+
+		"exceeds fee budget 1000 mSAT")
+
+	for _, payReq := range req.Payments {
+		require.Zero(t, payReq.TimeoutSeconds)
+	}
+}
+
+// TestPaymentBatchCanceled tests that a batch stops once its context is
+// canceled.
+func TestPaymentBatchCanceled(t *testing.T) {
+	t.Parallel()
+
+	mock := newBatchSendMock(2)
+	ctx, cancel := context.WithCancel(t.Context())
+
+	batch := &paymentBatch{
+		feeLimits:   []lnwire.MilliSatoshi{100, 100},
+		maxParallel: 1,
+		send:        mock.send,
+		notify: func(*PaymentBatchUpdate) error {
+			return nil
+		},
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- batch.run(ctx)
+	}()
+
+	mock.expectStarts(t, batchStart{0, 100})
+	cancel()
+
+	require.ErrorIs(t, <-errChan, context.Canceled)
+	mock.expectNoStart(t)
+}
@@ -25,34 +25,39 @@ const (
 type FailureDetail int32
 
 const (
-	FailureDetail_UNKNOWN                    FailureDetail = 0
-	FailureDetail_NO_DETAIL                  FailureDetail = 1
-	FailureDetail_ONION_DECODE               FailureDetail = 2
-	FailureDetail_LINK_NOT_ELIGIBLE          FailureDetail = 3
-	FailureDetail_ON_CHAIN_TIMEOUT           FailureDetail = 4
-	FailureDetail_HTLC_EXCEEDS_MAX           FailureDetail = 5
-	FailureDetail_INSUFFICIENT_BALANCE       FailureDetail = 6
-	FailureDetail_INCOMPLETE_FORWARD         FailureDetail = 7
-	FailureDetail_HTLC_ADD_FAILED            FailureDetail = 8
-	FailureDetail_FORWARDS_DISABLED          FailureDetail = 9
-	FailureDetail_INVOICE_CANCELED           FailureDetail = 10
-	FailureDetail_INVOICE_UNDERPAID          FailureDetail = 11
-	FailureDetail_INVOICE_EXPIRY_TOO_SOON    FailureDetail = 12
-	FailureDetail_INVOICE_NOT_OPEN           FailureDetail = 13
-	FailureDetail_MPP_INVOICE_TIMEOUT        FailureDetail = 14
-	FailureDetail_ADDRESS_MISMATCH           FailureDetail = 15
-	FailureDetail_SET_TOTAL_MISMATCH         FailureDetail = 16
-	FailureDetail_SET_TOTAL_TOO_LOW          FailureDetail = 17
-	FailureDetail_SET_OVERPAID               FailureDetail = 18
-	FailureDetail_UNKNOWN_INVOICE            FailureDetail = 19
-	FailureDetail_INVALID_KEYSEND            FailureDetail = 20
-	FailureDetail_MPP_IN_PROGRESS            FailureDetail = 21
-	FailureDetail_CIRCULAR_ROUTE             FailureDetail = 22
-	FailureDetail_INVOICE_ALREADY_SETTLED    FailureDetail = 23
-	FailureDetail_HTLC_INVOICE_TYPE_MISMATCH FailureDetail = 24
-	FailureDetail_AMP_ERROR                  FailureDetail = 25
-	FailureDetail_AMP_RECONSTRUCTION         FailureDetail = 26
-	FailureDetail_EXTERNAL_VALIDATION_FAILED FailureDetail = 27
+	FailureDetail_UNKNOWN                     FailureDetail = 0
+	FailureDetail_NO_DETAIL                   FailureDetail = 1
+	FailureDetail_ONION_DECODE                FailureDetail = 2
+	FailureDetail_LINK_NOT_ELIGIBLE           FailureDetail = 3
+	FailureDetail_ON_CHAIN_TIMEOUT            FailureDetail = 4
+	FailureDetail_HTLC_EXCEEDS_MAX            FailureDetail = 5
+	FailureDetail_INSUFFICIENT_BALANCE        FailureDetail = 6
+	FailureDetail_INCOMPLETE_FORWARD          FailureDetail = 7
+	FailureDetail_HTLC_ADD_FAILED             FailureDetail = 8
+	FailureDetail_FORWARDS_DISABLED           FailureDetail = 9
+	FailureDetail_INVOICE_CANCELED            FailureDetail = 10
+	FailureDetail_INVOICE_UNDERPAID           FailureDetail = 11
+	FailureDetail_INVOICE_EXPIRY_TOO_SOON     FailureDetail = 12
+	FailureDetail_INVOICE_NOT_OPEN            FailureDetail = 13
+	FailureDetail_MPP_INVOICE_TIMEOUT         FailureDetail = 14
+	FailureDetail_ADDRESS_MISMATCH            FailureDetail = 15
+	FailureDetail_SET_TOTAL_MISMATCH          FailureDetail = 16
+	FailureDetail_SET_TOTAL_TOO_LOW           FailureDetail = 17
+	FailureDetail_SET_OVERPAID                FailureDetail = 18
+	FailureDetail_UNKNOWN_INVOICE             FailureDetail = 19
+	FailureDetail_INVALID_KEYSEND             FailureDetail = 20
+	FailureDetail_MPP_IN_PROGRESS             FailureDetail = 21
+	FailureDetail_CIRCULAR_ROUTE              FailureDetail = 22
+	FailureDetail_INVOICE_ALREADY_SETTLED     FailureDetail = 23
+	FailureDetail_HTLC_INVOICE_TYPE_MISMATCH  FailureDetail = 24
+	FailureDetail_AMP_ERROR                   FailureDetail = 25
+	FailureDetail_AMP_RECONSTRUCTION          FailureDetail = 26
+	FailureDetail_EXTERNAL_VALIDATION_FAILED  FailureDetail = 27
+	FailureDetail_TRAMPOLINE_FORWARD_FAILED   FailureDetail = 28
+	FailureDetail_RESOURCES_EXHAUSTED         FailureDetail = 29
+	FailureDetail_PEER_HTLC_BELOW_MINIMUM     FailureDetail = 30
+	FailureDetail_PEER_MAX_HTLCS_EXCEEDED     FailureDetail = 31
+	FailureDetail_PEER_MAX_IN_FLIGHT_EXCEEDED FailureDetail = 32
 )
 
 // Enum value maps for FailureDetail.
@@ -86,36 +91,46 @@ var (
 		25: "AMP_ERROR",
 		26: "AMP_RECONSTRUCTION",
 		27: "EXTERNAL_VALIDATION_FAILED",
+		28: "TRAMPOLINE_FORWARD_FAILED",
+		29: "RESOURCES_EXHAUSTED",
+		30: "PEER_HTLC_BELOW_MINIMUM",
+		31: "PEER_MAX_HTLCS_EXCEEDED",
+		32: "PEER_MAX_IN_FLIGHT_EXCEEDED",
 	}
 	FailureDetail_value = map[string]int32{
-		"UNKNOWN":                    0,
-		"NO_DETAIL":                  1,
-		"ONION_DECODE":               2,
-		"LINK_NOT_ELIGIBLE":          3,
-		"ON_CHAIN_TIMEOUT":           4,
-		"HTLC_EXCEEDS_MAX":           5,
-		"INSUFFICIENT_BALANCE":       6,
-		"INCOMPLETE_FORWARD":         7,
-		"HTLC_ADD_FAILED":            8,
-		"FORWARDS_DISABLED":          9,
-		"INVOICE_CANCELED":           10,
-		"INVOICE_UNDERPAID":          11,
-		"INVOICE_EXPIRY_TOO_SOON":    12,
-		"INVOICE_NOT_OPEN":           13,
-		"MPP_INVOICE_TIMEOUT":        14,
-		"ADDRESS_MISMATCH":           15,
-		"SET_TOTAL_MISMATCH":         16,
-		"SET_TOTAL_TOO_LOW":          17,
-		"SET_OVERPAID":               18,
-		"UNKNOWN_INVOICE":            19,
-		"INVALID_KEYSEND":            20,
-		"MPP_IN_PROGRESS":            21,
-		"CIRCULAR_ROUTE":             22,
-		"INVOICE_ALREADY_SETTLED":    23,
-		"HTLC_INVOICE_TYPE_MISMATCH": 24,
-		"AMP_ERROR":                  25,
-		"AMP_RECONSTRUCTION":         26,
-		"EXTERNAL_VALIDATION_FAILED": 27,
+		"UNKNOWN":                     0,
+		"NO_DETAIL":                   1,
+		"ONION_DECODE":                2,
+		"LINK_NOT_ELIGIBLE":           3,
+		"ON_CHAIN_TIMEOUT":            4,
+		"HTLC_EXCEEDS_MAX":            5,
+		"INSUFFICIENT_BALANCE":        6,
+		"INCOMPLETE_FORWARD":          7,
+		"HTLC_ADD_FAILED":             8,
+		"FORWARDS_DISABLED":           9,
+		"INVOICE_CANCELED":            10,
+		"INVOICE_UNDERPAID":           11,
+		"INVOICE_EXPIRY_TOO_SOON":     12,
+		"INVOICE_NOT_OPEN":            13,
+		"MPP_INVOICE_TIMEOUT":         14,
+		"ADDRESS_MISMATCH":            15,
+		"SET_TOTAL_MISMATCH":          16,
+		"SET_TOTAL_TOO_LOW":           17,
+		"SET_OVERPAID":                18,
+		"UNKNOWN_INVOICE":             19,
+		"INVALID_KEYSEND":             20,
+		"MPP_IN_PROGRESS":             21,
+		"CIRCULAR_ROUTE":              22,
+		"INVOICE_ALREADY_SETTLED":     23,
+		"HTLC_INVOICE_TYPE_MISMATCH":  24,
+		"AMP_ERROR":                   25,
+		"AMP_RECONSTRUCTION":          26,
+		"EXTERNAL_VALIDATION_FAILED":  27,
+		"TRAMPOLINE_FORWARD_FAILED":   28,
+		"RESOURCES_EXHAUSTED":         29,
+		"PEER_HTLC_BELOW_MINIMUM":     30,
+		"PEER_MAX_HTLCS_EXCEEDED":     31,
+		"PEER_MAX_IN_FLIGHT_EXCEEDED": 32,
 	}
 )
 
@@ -253,11 +268,68 @@ func (ChanStatusAction) EnumDescriptor() ([]byte, []int) {
 	return file_routerrpc_router_proto_rawDescGZIP(), []int{2}
 }
 
+type RebalanceStatus int32
+
+const (
+	// The rebalance is still running.
+	RebalanceStatus_REBALANCE_IN_PROGRESS RebalanceStatus = 0
+	// The full amount was rebalanced.
+	RebalanceStatus_REBALANCE_SUCCEEDED RebalanceStatus = 1
+	// Only a part of the amount was rebalanced.
+	RebalanceStatus_REBALANCE_PARTIALLY_SUCCEEDED RebalanceStatus = 2
+	// Nothing could be rebalanced.
+	RebalanceStatus_REBALANCE_FAILED RebalanceStatus = 3
+)
+
+// Enum value maps for RebalanceStatus.
+var (
+	RebalanceStatus_name = map[int32]string{
+		0: "REBALANCE_IN_PROGRESS",
+		1: "REBALANCE_SUCCEEDED",
+		2: "REBALANCE_PARTIALLY_SUCCEEDED",
+		3: "REBALANCE_FAILED",
+	}
+	RebalanceStatus_value = map[string]int32{
+		"REBALANCE_IN_PROGRESS":         0,
+		"REBALANCE_SUCCEEDED":           1,
+		"REBALANCE_PARTIALLY_SUCCEEDED": 2,
+		"REBALANCE_FAILED":              3,
+	}
+)
+
+func (x RebalanceStatus) Enum() *RebalanceStatus {
+	p := new(RebalanceStatus)
+	*p = x
+	return p
+}
+
+func (x RebalanceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RebalanceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_routerrpc_router_proto_enumTypes[3].Descriptor()
+}
+
+func (RebalanceStatus) Type() protoreflect.EnumType {
+	return &file_routerrpc_router_proto_enumTypes[3]
+}
+
+func (x RebalanceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RebalanceStatus.Descriptor instead.
+func (RebalanceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{3}
+}
+
 type MissionControlConfig_ProbabilityModel int32
 
 const (
-	MissionControlConfig_APRIORI MissionControlConfig_ProbabilityModel = 0
-	MissionControlConfig_BIMODAL MissionControlConfig_ProbabilityModel = 1
+	MissionControlConfig_APRIORI  MissionControlConfig_ProbabilityModel = 0
+	MissionControlConfig_BIMODAL  MissionControlConfig_ProbabilityModel = 1
+	MissionControlConfig_EXTERNAL MissionControlConfig_ProbabilityModel = 2
 )
 
 // Enum value maps for MissionControlConfig_ProbabilityModel.
@@ -265,10 +337,12 @@ var (
 	MissionControlConfig_ProbabilityModel_name = map[int32]string{
 		0: "APRIORI",
 		1: "BIMODAL",
+		2: "EXTERNAL",
 	}
 	MissionControlConfig_ProbabilityModel_value = map[string]int32{
-		"APRIORI": 0,
-		"BIMODAL": 1,
+		"APRIORI":  0,
+		"BIMODAL":  1,
+		"EXTERNAL": 2,
 	}
 )
 
@@ -283,11 +357,11 @@ func (x MissionControlConfig_ProbabilityModel) String() string {
 }
 
 func (MissionControlConfig_ProbabilityModel) Descriptor() protoreflect.EnumDescriptor {
-	return file_routerrpc_router_proto_enumTypes[3].Descriptor()
+	return file_routerrpc_router_proto_enumTypes[4].Descriptor()
 }
 
 func (MissionControlConfig_ProbabilityModel) Type() protoreflect.EnumType {
-	return &file_routerrpc_router_proto_enumTypes[3]
+	return &file_routerrpc_router_proto_enumTypes[4]
 }
 
 func (x MissionControlConfig_ProbabilityModel) Number() protoreflect.EnumNumber {
@@ -335,11 +409,11 @@ func (x HtlcEvent_EventType) String() string {
 }
 
 func (HtlcEvent_EventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_routerrpc_router_proto_enumTypes[4].Descriptor()
+	return file_routerrpc_router_proto_enumTypes[5].Descriptor()
 }
 
 func (HtlcEvent_EventType) Type() protoreflect.EnumType {
-	return &file_routerrpc_router_proto_enumTypes[4]
+	return &file_routerrpc_router_proto_enumTypes[5]
 }
 
 func (x HtlcEvent_EventType) Number() protoreflect.EnumNumber {
@@ -348,7 +422,7 @@ func (x HtlcEvent_EventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HtlcEvent_EventType.Descriptor instead.
 func (HtlcEvent_EventType) EnumDescriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{26, 0}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{27, 0}
 }
 
 type SendPaymentRequest struct {
@@ -451,8 +525,28 @@ type SendPaymentRequest struct {
 	// the custom range >= 65536. When using REST, the values must be encoded as
 	// base64.
 	FirstHopCustomRecords map[uint64][]byte `protobuf:"bytes,25,rep,name=first_hop_custom_records,json=firstHopCustomRecords,proto3" json:"first_hop_custom_records,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// An optional list of trampoline nodes, in order of preference. If set, the
+	// payment is routed to the first reachable trampoline node, which finds the
+	// route to the recipient on our behalf. The next trampoline node is only
+	// tried if the current one can't be reached or fails the payment. Can't be
+	// combined with AMP, blinded paths or destination custom records.
+	TrampolineNodes [][]byte `protobuf:"bytes,26,rep,name=trampoline_nodes,json=trampolineNodes,proto3" json:"trampoline_nodes,omitempty"`
+	// The fee budget, in milli-satoshis, the trampoline node may use to route
+	// the payment to the recipient, including its own fee. It is paid on top of
+	// the payment amount and the fee limit. If not set, a default budget based
+	// on the payment amount is used.
+	TrampolineFeeBudgetMsat int64 `protobuf:"varint,27,opt,name=trampoline_fee_budget_msat,json=trampolineFeeBudgetMsat,proto3" json:"trampoline_fee_budget_msat,omitempty"`
+	// The cltv delta the trampoline node may use to route the payment to the
+	// recipient, including its own cltv delta. If not set, a default of 576
+	// blocks is used.
+	TrampolineCltvBudget uint32 `protobuf:"varint,28,opt,name=trampoline_cltv_budget,json=trampolineCltvBudget,proto3" json:"trampoline_cltv_budget,omitempty"`
+	// If set, the receiver of a keysend or AMP payment is asked to return a
+	// receipt signed over the payment identifier and the custom records of the
+	// payment. The receipt is returned in an onion message and stored alongside
+	// the payment once verified. Requires onion messages to be enabled.
+	RequestReceipt bool `protobuf:"varint,29,opt,name=request_receipt,json=requestReceipt,proto3" json:"request_receipt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SendPaymentRequest) Reset() {
@@ -653,6 +747,34 @@ func (x *SendPaymentRequest) GetFirstHopCustomRecords() map[uint64][]byte {
 	return nil
 }
 
+func (x *SendPaymentRequest) GetTrampolineNodes() [][]byte {
+	if x != nil {
+		return x.TrampolineNodes
+	}
+	return nil
+}
+
+func (x *SendPaymentRequest) GetTrampolineFeeBudgetMsat() int64 {
+	if x != nil {
+		return x.TrampolineFeeBudgetMsat
+	}
+	return 0
+}
+
+func (x *SendPaymentRequest) GetTrampolineCltvBudget() uint32 {
+	if x != nil {
+		return x.TrampolineCltvBudget
+	}
+	return 0
+}
+
+func (x *SendPaymentRequest) GetRequestReceipt() bool {
+	if x != nil {
+		return x.RequestReceipt
+	}
+	return false
+}
+
 type TrackPaymentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The hash of the payment to look up.
@@ -1605,6 +1727,7 @@ type MissionControlConfig struct {
 	//
 	//	*MissionControlConfig_Apriori
 	//	*MissionControlConfig_Bimodal
+	//	*MissionControlConfig_External
 	EstimatorConfig isMissionControlConfig_EstimatorConfig `protobuf_oneof:"EstimatorConfig"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
@@ -1710,6 +1833,15 @@ func (x *MissionControlConfig) GetBimodal() *BimodalParameters {
 	return nil
 }
 
+func (x *MissionControlConfig) GetExternal() *ExternalParameters {
+	if x != nil {
+		if x, ok := x.EstimatorConfig.(*MissionControlConfig_External); ok {
+			return x.External
+		}
+	}
+	return nil
+}
+
 type isMissionControlConfig_EstimatorConfig interface {
 	isMissionControlConfig_EstimatorConfig()
 }
@@ -1722,10 +1854,82 @@ type MissionControlConfig_Bimodal struct {
 	Bimodal *BimodalParameters `protobuf:"bytes,8,opt,name=bimodal,proto3,oneof"`
 }
 
+type MissionControlConfig_External struct {
+	External *ExternalParameters `protobuf:"bytes,9,opt,name=external,proto3,oneof"`
+}
+
 func (*MissionControlConfig_Apriori) isMissionControlConfig_EstimatorConfig() {}
 
 func (*MissionControlConfig_Bimodal) isMissionControlConfig_EstimatorConfig() {}
 
+func (*MissionControlConfig_External) isMissionControlConfig_EstimatorConfig() {}
+
+type ExternalParameters struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of milliseconds for which an estimate of the external client is
+	// reused for identical queries. A value of zero disables the cache.
+	CacheTtlMs uint64 `protobuf:"varint,1,opt,name=cache_ttl_ms,json=cacheTtlMs,proto3" json:"cache_ttl_ms,omitempty"`
+	// The maximum number of milliseconds to wait for the external client to
+	// answer a query before the fallback estimator is used instead.
+	QueryTimeoutMs uint64 `protobuf:"varint,2,opt,name=query_timeout_ms,json=queryTimeoutMs,proto3" json:"query_timeout_ms,omitempty"`
+	// The parameters of the bimodal estimator that is used while no external
+	// client is connected, or if the client fails to answer a query.
+	Fallback      *BimodalParameters `protobuf:"bytes,3,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalParameters) Reset() {
+	*x = ExternalParameters{}
+	mi := &file_routerrpc_router_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalParameters) ProtoMessage() {}
+
+func (x *ExternalParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalParameters.ProtoReflect.Descriptor instead.
+func (*ExternalParameters) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExternalParameters) GetCacheTtlMs() uint64 {
+	if x != nil {
+		return x.CacheTtlMs
+	}
+	return 0
+}
+
+func (x *ExternalParameters) GetQueryTimeoutMs() uint64 {
+	if x != nil {
+		return x.QueryTimeoutMs
+	}
+	return 0
+}
+
+func (x *ExternalParameters) GetFallback() *BimodalParameters {
+	if x != nil {
+		return x.Fallback
+	}
+	return nil
+}
+
 type BimodalParameters struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// NodeWeight defines how strongly other previous forwardings on channels of a
@@ -1750,7 +1954,7 @@ type BimodalParameters struct {
 
 func (x *BimodalParameters) Reset() {
 	*x = BimodalParameters{}
-	mi := &file_routerrpc_router_proto_msgTypes[19]
+	mi := &file_routerrpc_router_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1762,7 +1966,7 @@ func (x *BimodalParameters) String() string {
 func (*BimodalParameters) ProtoMessage() {}
 
 func (x *BimodalParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[19]
+	mi := &file_routerrpc_router_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1775,7 +1979,7 @@ func (x *BimodalParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BimodalParameters.ProtoReflect.Descriptor instead.
 func (*BimodalParameters) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{19}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *BimodalParameters) GetNodeWeight() float64 {
@@ -1830,7 +2034,7 @@ type AprioriParameters struct {
 
 func (x *AprioriParameters) Reset() {
 	*x = AprioriParameters{}
-	mi := &file_routerrpc_router_proto_msgTypes[20]
+	mi := &file_routerrpc_router_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1842,7 +2046,7 @@ func (x *AprioriParameters) String() string {
 func (*AprioriParameters) ProtoMessage() {}
 
 func (x *AprioriParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[20]
+	mi := &file_routerrpc_router_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1855,7 +2059,7 @@ func (x *AprioriParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AprioriParameters.ProtoReflect.Descriptor instead.
 func (*AprioriParameters) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{20}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *AprioriParameters) GetHalfLifeSeconds() uint64 {
@@ -1900,7 +2104,7 @@ type QueryProbabilityRequest struct {
 
 func (x *QueryProbabilityRequest) Reset() {
 	*x = QueryProbabilityRequest{}
-	mi := &file_routerrpc_router_proto_msgTypes[21]
+	mi := &file_routerrpc_router_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1912,7 +2116,7 @@ func (x *QueryProbabilityRequest) String() string {
 func (*QueryProbabilityRequest) ProtoMessage() {}
 
 func (x *QueryProbabilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[21]
+	mi := &file_routerrpc_router_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1925,7 +2129,7 @@ func (x *QueryProbabilityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryProbabilityRequest.ProtoReflect.Descriptor instead.
 func (*QueryProbabilityRequest) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{21}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *QueryProbabilityRequest) GetFromNode() []byte {
@@ -1961,7 +2165,7 @@ type QueryProbabilityResponse struct {
 
 func (x *QueryProbabilityResponse) Reset() {
 	*x = QueryProbabilityResponse{}
-	mi := &file_routerrpc_router_proto_msgTypes[22]
+	mi := &file_routerrpc_router_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1973,7 +2177,7 @@ func (x *QueryProbabilityResponse) String() string {
 func (*QueryProbabilityResponse) ProtoMessage() {}
 
 func (x *QueryProbabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[22]
+	mi := &file_routerrpc_router_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1986,7 +2190,7 @@ func (x *QueryProbabilityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryProbabilityResponse.ProtoReflect.Descriptor instead.
 func (*QueryProbabilityResponse) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{22}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *QueryProbabilityResponse) GetProbability() float64 {
@@ -2032,7 +2236,7 @@ type BuildRouteRequest struct {
 
 func (x *BuildRouteRequest) Reset() {
 	*x = BuildRouteRequest{}
-	mi := &file_routerrpc_router_proto_msgTypes[23]
+	mi := &file_routerrpc_router_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2044,7 +2248,7 @@ func (x *BuildRouteRequest) String() string {
 func (*BuildRouteRequest) ProtoMessage() {}
 
 func (x *BuildRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[23]
+	mi := &file_routerrpc_router_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2057,7 +2261,7 @@ func (x *BuildRouteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildRouteRequest.ProtoReflect.Descriptor instead.
 func (*BuildRouteRequest) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{23}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *BuildRouteRequest) GetAmtMsat() int64 {
@@ -2112,7 +2316,7 @@ type BuildRouteResponse struct {
 
 func (x *BuildRouteResponse) Reset() {
 	*x = BuildRouteResponse{}
-	mi := &file_routerrpc_router_proto_msgTypes[24]
+	mi := &file_routerrpc_router_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2124,7 +2328,7 @@ func (x *BuildRouteResponse) String() string {
 func (*BuildRouteResponse) ProtoMessage() {}
 
 func (x *BuildRouteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[24]
+	mi := &file_routerrpc_router_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2137,7 +2341,7 @@ func (x *BuildRouteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildRouteResponse.ProtoReflect.Descriptor instead.
 func (*BuildRouteResponse) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{24}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *BuildRouteResponse) GetRoute() *lnrpc.Route {
@@ -2155,7 +2359,7 @@ type SubscribeHtlcEventsRequest struct {
 
 func (x *SubscribeHtlcEventsRequest) Reset() {
 	*x = SubscribeHtlcEventsRequest{}
-	mi := &file_routerrpc_router_proto_msgTypes[25]
+	mi := &file_routerrpc_router_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2167,7 +2371,7 @@ func (x *SubscribeHtlcEventsRequest) String() string {
 func (*SubscribeHtlcEventsRequest) ProtoMessage() {}
 
 func (x *SubscribeHtlcEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[25]
+	mi := &file_routerrpc_router_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2180,7 +2384,7 @@ func (x *SubscribeHtlcEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeHtlcEventsRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeHtlcEventsRequest) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{25}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{26}
 }
 
 // HtlcEvent contains the htlc event that was processed. These are served on a
@@ -2216,6 +2420,7 @@ type HtlcEvent struct {
 	//	*HtlcEvent_LinkFailEvent
 	//	*HtlcEvent_SubscribedEvent
 	//	*HtlcEvent_FinalHtlcEvent
+	//	*HtlcEvent_StuckHtlcEvent
 	Event         isHtlcEvent_Event `protobuf_oneof:"event"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -2223,7 +2428,7 @@ type HtlcEvent struct {
 
 func (x *HtlcEvent) Reset() {
 	*x = HtlcEvent{}
-	mi := &file_routerrpc_router_proto_msgTypes[26]
+	mi := &file_routerrpc_router_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2235,7 +2440,7 @@ func (x *HtlcEvent) String() string {
 func (*HtlcEvent) ProtoMessage() {}
 
 func (x *HtlcEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[26]
+	mi := &file_routerrpc_router_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2248,7 +2453,7 @@ func (x *HtlcEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HtlcEvent.ProtoReflect.Descriptor instead.
 func (*HtlcEvent) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{26}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *HtlcEvent) GetIncomingChannelId() uint64 {
@@ -2354,6 +2559,15 @@ func (x *HtlcEvent) GetFinalHtlcEvent() *FinalHtlcEvent {
 	return nil
 }
 
+func (x *HtlcEvent) GetStuckHtlcEvent() *StuckHtlcEvent {
+	if x != nil {
+		if x, ok := x.Event.(*HtlcEvent_StuckHtlcEvent); ok {
+			return x.StuckHtlcEvent
+		}
+	}
+	return nil
+}
+
 type isHtlcEvent_Event interface {
 	isHtlcEvent_Event()
 }
@@ -2382,6 +2596,10 @@ type HtlcEvent_FinalHtlcEvent struct {
 	FinalHtlcEvent *FinalHtlcEvent `protobuf:"bytes,12,opt,name=final_htlc_event,json=finalHtlcEvent,proto3,oneof"`
 }
 
+type HtlcEvent_StuckHtlcEvent struct {
+	StuckHtlcEvent *StuckHtlcEvent `protobuf:"bytes,13,opt,name=stuck_htlc_event,json=stuckHtlcEvent,proto3,oneof"`
+}
+
 func (*HtlcEvent_ForwardEvent) isHtlcEvent_Event() {}
 
 func (*HtlcEvent_ForwardFailEvent) isHtlcEvent_Event() {}
@@ -2394,6 +2612,8 @@ func (*HtlcEvent_SubscribedEvent) isHtlcEvent_Event() {}
 
 func (*HtlcEvent_FinalHtlcEvent) isHtlcEvent_Event() {}
 
+func (*HtlcEvent_StuckHtlcEvent) isHtlcEvent_Event() {}
+
 type HtlcInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The timelock on the incoming htlc.
@@ -2410,7 +2630,7 @@ type HtlcInfo struct {
 
 func (x *HtlcInfo) Reset() {
 	*x = HtlcInfo{}
-	mi := &file_routerrpc_router_proto_msgTypes[27]
+	mi := &file_routerrpc_router_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2422,7 +2642,7 @@ func (x *HtlcInfo) String() string {
 func (*HtlcInfo) ProtoMessage() {}
 
 func (x *HtlcInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[27]
+	mi := &file_routerrpc_router_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2435,7 +2655,7 @@ func (x *HtlcInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HtlcInfo.ProtoReflect.Descriptor instead.
 func (*HtlcInfo) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{27}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *HtlcInfo) GetIncomingTimelock() uint32 {
@@ -2476,7 +2696,7 @@ type ForwardEvent struct {
 
 func (x *ForwardEvent) Reset() {
 	*x = ForwardEvent{}
-	mi := &file_routerrpc_router_proto_msgTypes[28]
+	mi := &file_routerrpc_router_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2488,7 +2708,7 @@ func (x *ForwardEvent) String() string {
 func (*ForwardEvent) ProtoMessage() {}
 
 func (x *ForwardEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[28]
+	mi := &file_routerrpc_router_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2501,7 +2721,7 @@ func (x *ForwardEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForwardEvent.ProtoReflect.Descriptor instead.
 func (*ForwardEvent) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{28}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ForwardEvent) GetInfo() *HtlcInfo {
@@ -2519,7 +2739,7 @@ type ForwardFailEvent struct {
 
 func (x *ForwardFailEvent) Reset() {
 	*x = ForwardFailEvent{}
-	mi := &file_routerrpc_router_proto_msgTypes[29]
+	mi := &file_routerrpc_router_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2531,7 +2751,7 @@ func (x *ForwardFailEvent) String() string {
 func (*ForwardFailEvent) ProtoMessage() {}
 
 func (x *ForwardFailEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[29]
+	mi := &file_routerrpc_router_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2544,7 +2764,7 @@ func (x *ForwardFailEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForwardFailEvent.ProtoReflect.Descriptor instead.
 func (*ForwardFailEvent) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{29}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{30}
 }
 
 type SettleEvent struct {
@@ -2557,7 +2777,7 @@ type SettleEvent struct {
 
 func (x *SettleEvent) Reset() {
 	*x = SettleEvent{}
-	mi := &file_routerrpc_router_proto_msgTypes[30]
+	mi := &file_routerrpc_router_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2569,7 +2789,7 @@ func (x *SettleEvent) String() string {
 func (*SettleEvent) ProtoMessage() {}
 
 func (x *SettleEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[30]
+	mi := &file_routerrpc_router_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2582,7 +2802,7 @@ func (x *SettleEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SettleEvent.ProtoReflect.Descriptor instead.
 func (*SettleEvent) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{30}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *SettleEvent) GetPreimage() []byte {
@@ -2602,7 +2822,7 @@ type FinalHtlcEvent struct {
 
 func (x *FinalHtlcEvent) Reset() {
 	*x = FinalHtlcEvent{}
-	mi := &file_routerrpc_router_proto_msgTypes[31]
+	mi := &file_routerrpc_router_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2614,7 +2834,7 @@ func (x *FinalHtlcEvent) String() string {
 func (*FinalHtlcEvent) ProtoMessage() {}
 
 func (x *FinalHtlcEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[31]
+	mi := &file_routerrpc_router_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2627,7 +2847,7 @@ func (x *FinalHtlcEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalHtlcEvent.ProtoReflect.Descriptor instead.
 func (*FinalHtlcEvent) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{31}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *FinalHtlcEvent) GetSettled() bool {
@@ -2652,7 +2872,7 @@ type SubscribedEvent struct {
 
 func (x *SubscribedEvent) Reset() {
 	*x = SubscribedEvent{}
-	mi := &file_routerrpc_router_proto_msgTypes[32]
+	mi := &file_routerrpc_router_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2664,7 +2884,7 @@ func (x *SubscribedEvent) String() string {
 func (*SubscribedEvent) ProtoMessage() {}
 
 func (x *SubscribedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[32]
+	mi := &file_routerrpc_router_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2677,40 +2897,44 @@ func (x *SubscribedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribedEvent.ProtoReflect.Descriptor instead.
 func (*SubscribedEvent) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{32}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{33}
 }
 
-type LinkFailEvent struct {
+// StuckHtlcEvent is sent when an htlc of a local payment has been in flight for
+// longer than the configured stuck htlc threshold, and again when the channel to
+// its first hop was force closed because the htlc expiry came too close. Only
+// the outgoing channel id of the event is set.
+type StuckHtlcEvent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Info contains details about the htlc that we failed.
+	// Info contains details about the stuck htlc.
 	Info *HtlcInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
-	// FailureCode is the BOLT error code for the failure.
-	WireFailure lnrpc.Failure_FailureCode `protobuf:"varint,2,opt,name=wire_failure,json=wireFailure,proto3,enum=lnrpc.Failure_FailureCode" json:"wire_failure,omitempty"`
-	// FailureDetail provides additional information about the reason for the
-	// failure. This detail enriches the information provided by the wire message
-	// and may be 'no detail' if the wire message requires no additional metadata.
-	FailureDetail FailureDetail `protobuf:"varint,3,opt,name=failure_detail,json=failureDetail,proto3,enum=routerrpc.FailureDetail" json:"failure_detail,omitempty"`
-	// A string representation of the link failure.
-	FailureString string `protobuf:"bytes,4,opt,name=failure_string,json=failureString,proto3" json:"failure_string,omitempty"`
+	// The hash of the payment the htlc belongs to.
+	PaymentHash []byte `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	// The id of the htlc attempt of the payment.
+	AttemptId uint64 `protobuf:"varint,3,opt,name=attempt_id,json=attemptId,proto3" json:"attempt_id,omitempty"`
+	// The time in seconds that passed since the htlc was sent.
+	AgeSec uint64 `protobuf:"varint,4,opt,name=age_sec,json=ageSec,proto3" json:"age_sec,omitempty"`
+	// Whether the channel to the first hop was force closed.
+	ForceClosed   bool `protobuf:"varint,5,opt,name=force_closed,json=forceClosed,proto3" json:"force_closed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LinkFailEvent) Reset() {
-	*x = LinkFailEvent{}
-	mi := &file_routerrpc_router_proto_msgTypes[33]
+func (x *StuckHtlcEvent) Reset() {
+	*x = StuckHtlcEvent{}
+	mi := &file_routerrpc_router_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LinkFailEvent) String() string {
+func (x *StuckHtlcEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LinkFailEvent) ProtoMessage() {}
+func (*StuckHtlcEvent) ProtoMessage() {}
 
-func (x *LinkFailEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[33]
+func (x *StuckHtlcEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2721,54 +2945,135 @@ func (x *LinkFailEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LinkFailEvent.ProtoReflect.Descriptor instead.
-func (*LinkFailEvent) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use StuckHtlcEvent.ProtoReflect.Descriptor instead.
+func (*StuckHtlcEvent) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *LinkFailEvent) GetInfo() *HtlcInfo {
+func (x *StuckHtlcEvent) GetInfo() *HtlcInfo {
 	if x != nil {
 		return x.Info
 	}
 	return nil
 }
 
-func (x *LinkFailEvent) GetWireFailure() lnrpc.Failure_FailureCode {
+func (x *StuckHtlcEvent) GetPaymentHash() []byte {
 	if x != nil {
-		return x.WireFailure
+		return x.PaymentHash
 	}
-	return lnrpc.Failure_FailureCode(0)
+	return nil
 }
 
-func (x *LinkFailEvent) GetFailureDetail() FailureDetail {
+func (x *StuckHtlcEvent) GetAttemptId() uint64 {
 	if x != nil {
-		return x.FailureDetail
+		return x.AttemptId
 	}
-	return FailureDetail_UNKNOWN
+	return 0
 }
 
-func (x *LinkFailEvent) GetFailureString() string {
+func (x *StuckHtlcEvent) GetAgeSec() uint64 {
 	if x != nil {
-		return x.FailureString
+		return x.AgeSec
 	}
-	return ""
+	return 0
 }
 
-type CircuitKey struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// / The id of the channel that the is part of this circuit.
-	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
-	// / The index of the incoming htlc in the incoming channel.
-	HtlcId        uint64 `protobuf:"varint,2,opt,name=htlc_id,json=htlcId,proto3" json:"htlc_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StuckHtlcEvent) GetForceClosed() bool {
+	if x != nil {
+		return x.ForceClosed
+	}
+	return false
 }
 
-func (x *CircuitKey) Reset() {
-	*x = CircuitKey{}
-	mi := &file_routerrpc_router_proto_msgTypes[34]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+type LinkFailEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Info contains details about the htlc that we failed.
+	Info *HtlcInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	// FailureCode is the BOLT error code for the failure.
+	WireFailure lnrpc.Failure_FailureCode `protobuf:"varint,2,opt,name=wire_failure,json=wireFailure,proto3,enum=lnrpc.Failure_FailureCode" json:"wire_failure,omitempty"`
+	// FailureDetail provides additional information about the reason for the
+	// failure. This detail enriches the information provided by the wire message
+	// and may be 'no detail' if the wire message requires no additional metadata.
+	FailureDetail FailureDetail `protobuf:"varint,3,opt,name=failure_detail,json=failureDetail,proto3,enum=routerrpc.FailureDetail" json:"failure_detail,omitempty"`
+	// A string representation of the link failure.
+	FailureString string `protobuf:"bytes,4,opt,name=failure_string,json=failureString,proto3" json:"failure_string,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkFailEvent) Reset() {
+	*x = LinkFailEvent{}
+	mi := &file_routerrpc_router_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkFailEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkFailEvent) ProtoMessage() {}
+
+func (x *LinkFailEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkFailEvent.ProtoReflect.Descriptor instead.
+func (*LinkFailEvent) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LinkFailEvent) GetInfo() *HtlcInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *LinkFailEvent) GetWireFailure() lnrpc.Failure_FailureCode {
+	if x != nil {
+		return x.WireFailure
+	}
+	return lnrpc.Failure_FailureCode(0)
+}
+
+func (x *LinkFailEvent) GetFailureDetail() FailureDetail {
+	if x != nil {
+		return x.FailureDetail
+	}
+	return FailureDetail_UNKNOWN
+}
+
+func (x *LinkFailEvent) GetFailureString() string {
+	if x != nil {
+		return x.FailureString
+	}
+	return ""
+}
+
+type CircuitKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// / The id of the channel that the is part of this circuit.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	// / The index of the incoming htlc in the incoming channel.
+	HtlcId        uint64 `protobuf:"varint,2,opt,name=htlc_id,json=htlcId,proto3" json:"htlc_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CircuitKey) Reset() {
+	*x = CircuitKey{}
+	mi := &file_routerrpc_router_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CircuitKey) String() string {
@@ -2778,7 +3083,7 @@ func (x *CircuitKey) String() string {
 func (*CircuitKey) ProtoMessage() {}
 
 func (x *CircuitKey) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[34]
+	mi := &file_routerrpc_router_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2791,7 +3096,7 @@ func (x *CircuitKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CircuitKey.ProtoReflect.Descriptor instead.
 func (*CircuitKey) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{34}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CircuitKey) GetChanId() uint64 {
@@ -2849,7 +3154,7 @@ type ForwardHtlcInterceptRequest struct {
 
 func (x *ForwardHtlcInterceptRequest) Reset() {
 	*x = ForwardHtlcInterceptRequest{}
-	mi := &file_routerrpc_router_proto_msgTypes[35]
+	mi := &file_routerrpc_router_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2861,7 +3166,7 @@ func (x *ForwardHtlcInterceptRequest) String() string {
 func (*ForwardHtlcInterceptRequest) ProtoMessage() {}
 
 func (x *ForwardHtlcInterceptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[35]
+	mi := &file_routerrpc_router_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2874,7 +3179,7 @@ func (x *ForwardHtlcInterceptRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForwardHtlcInterceptRequest.ProtoReflect.Descriptor instead.
 func (*ForwardHtlcInterceptRequest) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{35}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ForwardHtlcInterceptRequest) GetIncomingCircuitKey() *CircuitKey {
@@ -3014,7 +3319,7 @@ type ForwardHtlcInterceptResponse struct {
 
 func (x *ForwardHtlcInterceptResponse) Reset() {
 	*x = ForwardHtlcInterceptResponse{}
-	mi := &file_routerrpc_router_proto_msgTypes[36]
+	mi := &file_routerrpc_router_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3026,7 +3331,7 @@ func (x *ForwardHtlcInterceptResponse) String() string {
 func (*ForwardHtlcInterceptResponse) ProtoMessage() {}
 
 func (x *ForwardHtlcInterceptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[36]
+	mi := &file_routerrpc_router_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3039,7 +3344,7 @@ func (x *ForwardHtlcInterceptResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForwardHtlcInterceptResponse.ProtoReflect.Descriptor instead.
 func (*ForwardHtlcInterceptResponse) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{36}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ForwardHtlcInterceptResponse) GetIncomingCircuitKey() *CircuitKey {
@@ -3108,7 +3413,7 @@ type UpdateChanStatusRequest struct {
 
 func (x *UpdateChanStatusRequest) Reset() {
 	*x = UpdateChanStatusRequest{}
-	mi := &file_routerrpc_router_proto_msgTypes[37]
+	mi := &file_routerrpc_router_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3120,7 +3425,7 @@ func (x *UpdateChanStatusRequest) String() string {
 func (*UpdateChanStatusRequest) ProtoMessage() {}
 
 func (x *UpdateChanStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[37]
+	mi := &file_routerrpc_router_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3133,7 +3438,7 @@ func (x *UpdateChanStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateChanStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateChanStatusRequest) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{37}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *UpdateChanStatusRequest) GetChanPoint() *lnrpc.ChannelPoint {
@@ -3158,7 +3463,7 @@ type UpdateChanStatusResponse struct {
 
 func (x *UpdateChanStatusResponse) Reset() {
 	*x = UpdateChanStatusResponse{}
-	mi := &file_routerrpc_router_proto_msgTypes[38]
+	mi := &file_routerrpc_router_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3170,7 +3475,7 @@ func (x *UpdateChanStatusResponse) String() string {
 func (*UpdateChanStatusResponse) ProtoMessage() {}
 
 func (x *UpdateChanStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[38]
+	mi := &file_routerrpc_router_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3183,7 +3488,7 @@ func (x *UpdateChanStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateChanStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdateChanStatusResponse) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{38}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{40}
 }
 
 type AddAliasesRequest struct {
@@ -3195,7 +3500,7 @@ type AddAliasesRequest struct {
 
 func (x *AddAliasesRequest) Reset() {
 	*x = AddAliasesRequest{}
-	mi := &file_routerrpc_router_proto_msgTypes[39]
+	mi := &file_routerrpc_router_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3207,7 +3512,7 @@ func (x *AddAliasesRequest) String() string {
 func (*AddAliasesRequest) ProtoMessage() {}
 
 func (x *AddAliasesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[39]
+	mi := &file_routerrpc_router_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3220,7 +3525,7 @@ func (x *AddAliasesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddAliasesRequest.ProtoReflect.Descriptor instead.
 func (*AddAliasesRequest) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{39}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *AddAliasesRequest) GetAliasMaps() []*lnrpc.AliasMap {
@@ -3239,7 +3544,7 @@ type AddAliasesResponse struct {
 
 func (x *AddAliasesResponse) Reset() {
 	*x = AddAliasesResponse{}
-	mi := &file_routerrpc_router_proto_msgTypes[40]
+	mi := &file_routerrpc_router_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3251,7 +3556,7 @@ func (x *AddAliasesResponse) String() string {
 func (*AddAliasesResponse) ProtoMessage() {}
 
 func (x *AddAliasesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[40]
+	mi := &file_routerrpc_router_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3264,7 +3569,7 @@ func (x *AddAliasesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddAliasesResponse.ProtoReflect.Descriptor instead.
 func (*AddAliasesResponse) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{40}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *AddAliasesResponse) GetAliasMaps() []*lnrpc.AliasMap {
@@ -3283,7 +3588,7 @@ type DeleteAliasesRequest struct {
 
 func (x *DeleteAliasesRequest) Reset() {
 	*x = DeleteAliasesRequest{}
-	mi := &file_routerrpc_router_proto_msgTypes[41]
+	mi := &file_routerrpc_router_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3295,7 +3600,7 @@ func (x *DeleteAliasesRequest) String() string {
 func (*DeleteAliasesRequest) ProtoMessage() {}
 
 func (x *DeleteAliasesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[41]
+	mi := &file_routerrpc_router_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3308,7 +3613,7 @@ func (x *DeleteAliasesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAliasesRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAliasesRequest) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{41}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *DeleteAliasesRequest) GetAliasMaps() []*lnrpc.AliasMap {
@@ -3327,7 +3632,7 @@ type DeleteAliasesResponse struct {
 
 func (x *DeleteAliasesResponse) Reset() {
 	*x = DeleteAliasesResponse{}
-	mi := &file_routerrpc_router_proto_msgTypes[42]
+	mi := &file_routerrpc_router_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3339,7 +3644,7 @@ func (x *DeleteAliasesResponse) String() string {
 func (*DeleteAliasesResponse) ProtoMessage() {}
 
 func (x *DeleteAliasesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[42]
+	mi := &file_routerrpc_router_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3352,7 +3657,7 @@ func (x *DeleteAliasesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAliasesResponse.ProtoReflect.Descriptor instead.
 func (*DeleteAliasesResponse) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{42}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *DeleteAliasesResponse) GetAliasMaps() []*lnrpc.AliasMap {
@@ -3372,7 +3677,7 @@ type FindBaseAliasRequest struct {
 
 func (x *FindBaseAliasRequest) Reset() {
 	*x = FindBaseAliasRequest{}
-	mi := &file_routerrpc_router_proto_msgTypes[43]
+	mi := &file_routerrpc_router_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3384,7 +3689,7 @@ func (x *FindBaseAliasRequest) String() string {
 func (*FindBaseAliasRequest) ProtoMessage() {}
 
 func (x *FindBaseAliasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[43]
+	mi := &file_routerrpc_router_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3397,7 +3702,7 @@ func (x *FindBaseAliasRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindBaseAliasRequest.ProtoReflect.Descriptor instead.
 func (*FindBaseAliasRequest) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{43}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *FindBaseAliasRequest) GetAlias() uint64 {
@@ -3417,7 +3722,7 @@ type FindBaseAliasResponse struct {
 
 func (x *FindBaseAliasResponse) Reset() {
 	*x = FindBaseAliasResponse{}
-	mi := &file_routerrpc_router_proto_msgTypes[44]
+	mi := &file_routerrpc_router_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3429,7 +3734,7 @@ func (x *FindBaseAliasResponse) String() string {
 func (*FindBaseAliasResponse) ProtoMessage() {}
 
 func (x *FindBaseAliasResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[44]
+	mi := &file_routerrpc_router_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3442,7 +3747,7 @@ func (x *FindBaseAliasResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindBaseAliasResponse.ProtoReflect.Descriptor instead.
 func (*FindBaseAliasResponse) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{44}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *FindBaseAliasResponse) GetBase() uint64 {
@@ -3468,7 +3773,7 @@ type DeleteForwardingHistoryRequest struct {
 
 func (x *DeleteForwardingHistoryRequest) Reset() {
 	*x = DeleteForwardingHistoryRequest{}
-	mi := &file_routerrpc_router_proto_msgTypes[45]
+	mi := &file_routerrpc_router_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3480,7 +3785,7 @@ func (x *DeleteForwardingHistoryRequest) String() string {
 func (*DeleteForwardingHistoryRequest) ProtoMessage() {}
 
 func (x *DeleteForwardingHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[45]
+	mi := &file_routerrpc_router_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3493,7 +3798,7 @@ func (x *DeleteForwardingHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteForwardingHistoryRequest.ProtoReflect.Descriptor instead.
 func (*DeleteForwardingHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{45}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *DeleteForwardingHistoryRequest) GetTimeSpec() isDeleteForwardingHistoryRequest_TimeSpec {
@@ -3563,7 +3868,7 @@ type DeleteForwardingHistoryResponse struct {
 
 func (x *DeleteForwardingHistoryResponse) Reset() {
 	*x = DeleteForwardingHistoryResponse{}
-	mi := &file_routerrpc_router_proto_msgTypes[46]
+	mi := &file_routerrpc_router_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3575,7 +3880,7 @@ func (x *DeleteForwardingHistoryResponse) String() string {
 func (*DeleteForwardingHistoryResponse) ProtoMessage() {}
 
 func (x *DeleteForwardingHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_routerrpc_router_proto_msgTypes[46]
+	mi := &file_routerrpc_router_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3588,7 +3893,7 @@ func (x *DeleteForwardingHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteForwardingHistoryResponse.ProtoReflect.Descriptor instead.
 func (*DeleteForwardingHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_routerrpc_router_proto_rawDescGZIP(), []int{46}
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *DeleteForwardingHistoryResponse) GetEventsDeleted() uint64 {
@@ -3612,110 +3917,2816 @@ func (x *DeleteForwardingHistoryResponse) GetStatus() string {
 	return ""
 }
 
-var File_routerrpc_router_proto protoreflect.FileDescriptor
+type FeeCurvePoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The share of the channel capacity that is on our side of the channel,
+	// between 0 and 1.
+	LocalRatio float64 `protobuf:"fixed64,1,opt,name=local_ratio,json=localRatio,proto3" json:"local_ratio,omitempty"`
+	// The fee rate in parts per million charged at the local ratio. Negative
+	// values are only valid for inbound fee rate curves.
+	FeeRatePpm    int64 `protobuf:"varint,2,opt,name=fee_rate_ppm,json=feeRatePpm,proto3" json:"fee_rate_ppm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_routerrpc_router_proto_rawDesc = "" +
-	"\n" +
-	"\x16routerrpc/router.proto\x12\trouterrpc\x1a\x0flightning.proto\"\xa7\t\n" +
-	"\x12SendPaymentRequest\x12\x12\n" +
-	"\x04dest\x18\x01 \x01(\fR\x04dest\x12\x10\n" +
-	"\x03amt\x18\x02 \x01(\x03R\x03amt\x12!\n" +
-	"\fpayment_hash\x18\x03 \x01(\fR\vpaymentHash\x12(\n" +
-	"\x10final_cltv_delta\x18\x04 \x01(\x05R\x0efinalCltvDelta\x12'\n" +
-	"\x0fpayment_request\x18\x05 \x01(\tR\x0epaymentRequest\x12'\n" +
-	"\x0ftimeout_seconds\x18\x06 \x01(\x05R\x0etimeoutSeconds\x12\"\n" +
-	"\rfee_limit_sat\x18\a \x01(\x03R\vfeeLimitSat\x12\x1d\n" +
-	"\n" +
-	"cltv_limit\x18\t \x01(\x05R\tcltvLimit\x121\n" +
-	"\vroute_hints\x18\n" +
-	" \x03(\v2\x10.lnrpc.RouteHintR\n" +
-	"routeHints\x12d\n" +
-	"\x13dest_custom_records\x18\v \x03(\v24.routerrpc.SendPaymentRequest.DestCustomRecordsEntryR\x11destCustomRecords\x12\x19\n" +
-	"\bamt_msat\x18\f \x01(\x03R\aamtMsat\x12$\n" +
-	"\x0efee_limit_msat\x18\r \x01(\x03R\ffeeLimitMsat\x12&\n" +
-	"\x0flast_hop_pubkey\x18\x0e \x01(\fR\rlastHopPubkey\x12,\n" +
-	"\x12allow_self_payment\x18\x0f \x01(\bR\x10allowSelfPayment\x126\n" +
-	"\rdest_features\x18\x10 \x03(\x0e2\x11.lnrpc.FeatureBitR\fdestFeatures\x12\x1b\n" +
-	"\tmax_parts\x18\x11 \x01(\rR\bmaxParts\x12.\n" +
-	"\x13no_inflight_updates\x18\x12 \x01(\bR\x11noInflightUpdates\x12*\n" +
-	"\x11outgoing_chan_ids\x18\x13 \x03(\x04R\x0foutgoingChanIds\x12!\n" +
-	"\fpayment_addr\x18\x14 \x01(\fR\vpaymentAddr\x12-\n" +
-	"\x13max_shard_size_msat\x18\x15 \x01(\x04R\x10maxShardSizeMsat\x12\x10\n" +
-	"\x03amp\x18\x16 \x01(\bR\x03amp\x12\x1b\n" +
-	"\ttime_pref\x18\x17 \x01(\x01R\btimePref\x12\x1e\n" +
-	"\n" +
-	"cancelable\x18\x18 \x01(\bR\n" +
-	"cancelable\x12q\n" +
-	"\x18first_hop_custom_records\x18\x19 \x03(\v28.routerrpc.SendPaymentRequest.FirstHopCustomRecordsEntryR\x15firstHopCustomRecords\x1aD\n" +
-	"\x16DestCustomRecordsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\x04R\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\x1aH\n" +
-	"\x1aFirstHopCustomRecordsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\x04R\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01J\x04\b\b\x10\t\"h\n" +
-	"\x13TrackPaymentRequest\x12!\n" +
-	"\fpayment_hash\x18\x01 \x01(\fR\vpaymentHash\x12.\n" +
-	"\x13no_inflight_updates\x18\x02 \x01(\bR\x11noInflightUpdates\"F\n" +
-	"\x14TrackPaymentsRequest\x12.\n" +
-	"\x13no_inflight_updates\x18\x01 \x01(\bR\x11noInflightUpdates\"\xad\x01\n" +
-	"\x0fRouteFeeRequest\x12\x12\n" +
-	"\x04dest\x18\x01 \x01(\fR\x04dest\x12\x17\n" +
-	"\aamt_sat\x18\x02 \x01(\x03R\x06amtSat\x12'\n" +
-	"\x0fpayment_request\x18\x03 \x01(\tR\x0epaymentRequest\x12\x18\n" +
-	"\atimeout\x18\x04 \x01(\rR\atimeout\x12*\n" +
-	"\x11outgoing_chan_ids\x18\x05 \x03(\x04R\x0foutgoingChanIds\"\xa8\x01\n" +
-	"\x10RouteFeeResponse\x12(\n" +
-	"\x10routing_fee_msat\x18\x01 \x01(\x03R\x0eroutingFeeMsat\x12&\n" +
-	"\x0ftime_lock_delay\x18\x02 \x01(\x03R\rtimeLockDelay\x12B\n" +
-	"\x0efailure_reason\x18\x05 \x01(\x0e2\x1b.lnrpc.PaymentFailureReasonR\rfailureReason\"\xbc\x02\n" +
-	"\x12SendToRouteRequest\x12!\n" +
-	"\fpayment_hash\x18\x01 \x01(\fR\vpaymentHash\x12\"\n" +
-	"\x05route\x18\x02 \x01(\v2\f.lnrpc.RouteR\x05route\x12\"\n" +
-	"\rskip_temp_err\x18\x03 \x01(\bR\vskipTempErr\x12q\n" +
-	"\x18first_hop_custom_records\x18\x04 \x03(\v28.routerrpc.SendToRouteRequest.FirstHopCustomRecordsEntryR\x15firstHopCustomRecords\x1aH\n" +
-	"\x1aFirstHopCustomRecordsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\x04R\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\x1c\n" +
-	"\x1aResetMissionControlRequest\"\x1d\n" +
-	"\x1bResetMissionControlResponse\"\x1c\n" +
-	"\x1aQueryMissionControlRequest\"Q\n" +
-	"\x1bQueryMissionControlResponse\x12,\n" +
-	"\x05pairs\x18\x02 \x03(\v2\x16.routerrpc.PairHistoryR\x05pairsJ\x04\b\x01\x10\x02\"b\n" +
-	"\x1cXImportMissionControlRequest\x12,\n" +
-	"\x05pairs\x18\x01 \x03(\v2\x16.routerrpc.PairHistoryR\x05pairs\x12\x14\n" +
-	"\x05force\x18\x02 \x01(\bR\x05force\"\x1f\n" +
-	"\x1dXImportMissionControlResponse\"\x8a\x01\n" +
-	"\vPairHistory\x12\x1b\n" +
-	"\tnode_from\x18\x01 \x01(\fR\bnodeFrom\x12\x17\n" +
-	"\anode_to\x18\x02 \x01(\fR\x06nodeTo\x12-\n" +
-	"\ahistory\x18\a \x01(\v2\x13.routerrpc.PairDataR\ahistoryJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06J\x04\b\x06\x10\a\"\xe8\x01\n" +
-	"\bPairData\x12\x1b\n" +
-	"\tfail_time\x18\x01 \x01(\x03R\bfailTime\x12 \n" +
-	"\ffail_amt_sat\x18\x02 \x01(\x03R\n" +
-	"failAmtSat\x12\"\n" +
-	"\rfail_amt_msat\x18\x04 \x01(\x03R\vfailAmtMsat\x12!\n" +
-	"\fsuccess_time\x18\x05 \x01(\x03R\vsuccessTime\x12&\n" +
-	"\x0fsuccess_amt_sat\x18\x06 \x01(\x03R\rsuccessAmtSat\x12(\n" +
-	"\x10success_amt_msat\x18\a \x01(\x03R\x0esuccessAmtMsatJ\x04\b\x03\x10\x04\" \n" +
-	"\x1eGetMissionControlConfigRequest\"Z\n" +
-	"\x1fGetMissionControlConfigResponse\x127\n" +
-	"\x06config\x18\x01 \x01(\v2\x1f.routerrpc.MissionControlConfigR\x06config\"Y\n" +
-	"\x1eSetMissionControlConfigRequest\x127\n" +
-	"\x06config\x18\x01 \x01(\v2\x1f.routerrpc.MissionControlConfigR\x06config\"!\n" +
-	"\x1fSetMissionControlConfigResponse\"\x89\x04\n" +
-	"\x14MissionControlConfig\x12.\n" +
-	"\x11half_life_seconds\x18\x01 \x01(\x04B\x02\x18\x01R\x0fhalfLifeSeconds\x12+\n" +
-	"\x0fhop_probability\x18\x02 \x01(\x02B\x02\x18\x01R\x0ehopProbability\x12\x1a\n" +
-	"\x06weight\x18\x03 \x01(\x02B\x02\x18\x01R\x06weight\x126\n" +
-	"\x17maximum_payment_results\x18\x04 \x01(\rR\x15maximumPaymentResults\x12C\n" +
-	"\x1eminimum_failure_relax_interval\x18\x05 \x01(\x04R\x1bminimumFailureRelaxInterval\x12F\n" +
+func (x *FeeCurvePoint) Reset() {
+	*x = FeeCurvePoint{}
+	mi := &file_routerrpc_router_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeCurvePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeCurvePoint) ProtoMessage() {}
+
+func (x *FeeCurvePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeCurvePoint.ProtoReflect.Descriptor instead.
+func (*FeeCurvePoint) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FeeCurvePoint) GetLocalRatio() float64 {
+	if x != nil {
+		return x.LocalRatio
+	}
+	return 0
+}
+
+func (x *FeeCurvePoint) GetFeeRatePpm() int64 {
+	if x != nil {
+		return x.FeeRatePpm
+	}
+	return 0
+}
+
+type DynamicFeeParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The outgoing base fee in milli-satoshis set on every channel.
+	BaseFeeMsat uint64 `protobuf:"varint,1,opt,name=base_fee_msat,json=baseFeeMsat,proto3" json:"base_fee_msat,omitempty"`
+	// The curve mapping the local balance ratio of a channel to its outgoing
+	// fee rate. Fee rates between two points are linearly interpolated. The
+	// points must be sorted by strictly increasing local ratio.
+	FeeRateCurve []*FeeCurvePoint `protobuf:"bytes,2,rep,name=fee_rate_curve,json=feeRateCurve,proto3" json:"fee_rate_curve,omitempty"`
+	// The inbound base fee in milli-satoshis. Only used if an inbound fee rate
+	// curve is set.
+	InboundBaseFeeMsat int32 `protobuf:"varint,3,opt,name=inbound_base_fee_msat,json=inboundBaseFeeMsat,proto3" json:"inbound_base_fee_msat,omitempty"`
+	// The curve mapping the local balance ratio of a channel to its inbound
+	// fee rate. If empty, inbound fees are left untouched.
+	InboundFeeRateCurve []*FeeCurvePoint `protobuf:"bytes,4,rep,name=inbound_fee_rate_curve,json=inboundFeeRateCurve,proto3" json:"inbound_fee_rate_curve,omitempty"`
+	// The time window of the forwarding history in seconds that is used to
+	// determine the outgoing volume of a channel.
+	VolumeWindowSec uint64 `protobuf:"varint,5,opt,name=volume_window_sec,json=volumeWindowSec,proto3" json:"volume_window_sec,omitempty"`
+	// The relative fee rate increase for a channel that forwarded its full
+	// capacity within the volume window, e.g. 0.5 for 50%.
+	VolumeSensitivity float64 `protobuf:"fixed64,6,opt,name=volume_sensitivity,json=volumeSensitivity,proto3" json:"volume_sensitivity,omitempty"`
+	// The maximum outgoing fee rate in ppm. Zero means no limit.
+	MaxFeeRatePpm uint32 `protobuf:"varint,7,opt,name=max_fee_rate_ppm,json=maxFeeRatePpm,proto3" json:"max_fee_rate_ppm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DynamicFeeParams) Reset() {
+	*x = DynamicFeeParams{}
+	mi := &file_routerrpc_router_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DynamicFeeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicFeeParams) ProtoMessage() {}
+
+func (x *DynamicFeeParams) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicFeeParams.ProtoReflect.Descriptor instead.
+func (*DynamicFeeParams) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DynamicFeeParams) GetBaseFeeMsat() uint64 {
+	if x != nil {
+		return x.BaseFeeMsat
+	}
+	return 0
+}
+
+func (x *DynamicFeeParams) GetFeeRateCurve() []*FeeCurvePoint {
+	if x != nil {
+		return x.FeeRateCurve
+	}
+	return nil
+}
+
+func (x *DynamicFeeParams) GetInboundBaseFeeMsat() int32 {
+	if x != nil {
+		return x.InboundBaseFeeMsat
+	}
+	return 0
+}
+
+func (x *DynamicFeeParams) GetInboundFeeRateCurve() []*FeeCurvePoint {
+	if x != nil {
+		return x.InboundFeeRateCurve
+	}
+	return nil
+}
+
+func (x *DynamicFeeParams) GetVolumeWindowSec() uint64 {
+	if x != nil {
+		return x.VolumeWindowSec
+	}
+	return 0
+}
+
+func (x *DynamicFeeParams) GetVolumeSensitivity() float64 {
+	if x != nil {
+		return x.VolumeSensitivity
+	}
+	return 0
+}
+
+func (x *DynamicFeeParams) GetMaxFeeRatePpm() uint32 {
+	if x != nil {
+		return x.MaxFeeRatePpm
+	}
+	return 0
+}
+
+type FeePolicyOverride struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The channel point of the channel in the form funding_txid:output_index.
+	// Empty for the override of a peer.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	// Whether the channels are excluded from dynamic fees.
+	Exclude bool `protobuf:"varint,2,opt,name=exclude,proto3" json:"exclude,omitempty"`
+	// The custom parameters used for the channels, if set.
+	Params *DynamicFeeParams `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+	// The hex encoded public key of the peer. Empty for the override of a
+	// channel.
+	PeerPubkey    string `protobuf:"bytes,4,opt,name=peer_pubkey,json=peerPubkey,proto3" json:"peer_pubkey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeePolicyOverride) Reset() {
+	*x = FeePolicyOverride{}
+	mi := &file_routerrpc_router_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeePolicyOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeePolicyOverride) ProtoMessage() {}
+
+func (x *FeePolicyOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeePolicyOverride.ProtoReflect.Descriptor instead.
+func (*FeePolicyOverride) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *FeePolicyOverride) GetChanPoint() string {
+	if x != nil {
+		return x.ChanPoint
+	}
+	return ""
+}
+
+func (x *FeePolicyOverride) GetExclude() bool {
+	if x != nil {
+		return x.Exclude
+	}
+	return false
+}
+
+func (x *FeePolicyOverride) GetParams() *DynamicFeeParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *FeePolicyOverride) GetPeerPubkey() string {
+	if x != nil {
+		return x.PeerPubkey
+	}
+	return ""
+}
+
+type FeeRecommendation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The channel point of the channel in the form funding_txid:output_index.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	// The short channel ID of the channel.
+	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	// The share of the channel capacity that is on our side.
+	LocalRatio float64 `protobuf:"fixed64,3,opt,name=local_ratio,json=localRatio,proto3" json:"local_ratio,omitempty"`
+	// The outgoing volume of the channel in milli-satoshis within the volume
+	// window.
+	ForwardedVolumeMsat uint64 `protobuf:"varint,4,opt,name=forwarded_volume_msat,json=forwardedVolumeMsat,proto3" json:"forwarded_volume_msat,omitempty"`
+	// The outgoing base fee the channel currently advertises.
+	CurrentBaseFeeMsat uint64 `protobuf:"varint,5,opt,name=current_base_fee_msat,json=currentBaseFeeMsat,proto3" json:"current_base_fee_msat,omitempty"`
+	// The outgoing fee rate the channel currently advertises.
+	CurrentFeeRatePpm uint32 `protobuf:"varint,6,opt,name=current_fee_rate_ppm,json=currentFeeRatePpm,proto3" json:"current_fee_rate_ppm,omitempty"`
+	// The inbound fee the channel currently advertises, if any.
+	CurrentInboundFee *lnrpc.InboundFee `protobuf:"bytes,7,opt,name=current_inbound_fee,json=currentInboundFee,proto3" json:"current_inbound_fee,omitempty"`
+	// The recommended outgoing base fee.
+	BaseFeeMsat uint64 `protobuf:"varint,8,opt,name=base_fee_msat,json=baseFeeMsat,proto3" json:"base_fee_msat,omitempty"`
+	// The recommended outgoing fee rate.
+	FeeRatePpm uint32 `protobuf:"varint,9,opt,name=fee_rate_ppm,json=feeRatePpm,proto3" json:"fee_rate_ppm,omitempty"`
+	// The recommended inbound fee. If not set, the inbound fee of the channel
+	// is left untouched.
+	InboundFee *lnrpc.InboundFee `protobuf:"bytes,10,opt,name=inbound_fee,json=inboundFee,proto3" json:"inbound_fee,omitempty"`
+	// Whether the recommendation was derived from a per-channel or per-peer
+	// override.
+	Overridden bool `protobuf:"varint,11,opt,name=overridden,proto3" json:"overridden,omitempty"`
+	// Whether the recommended fees were applied to the channel.
+	Applied bool `protobuf:"varint,12,opt,name=applied,proto3" json:"applied,omitempty"`
+	// The reason the recommended fees couldn't be applied, if any.
+	Failure       string `protobuf:"bytes,13,opt,name=failure,proto3" json:"failure,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeeRecommendation) Reset() {
+	*x = FeeRecommendation{}
+	mi := &file_routerrpc_router_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRecommendation) ProtoMessage() {}
+
+func (x *FeeRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeRecommendation.ProtoReflect.Descriptor instead.
+func (*FeeRecommendation) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *FeeRecommendation) GetChanPoint() string {
+	if x != nil {
+		return x.ChanPoint
+	}
+	return ""
+}
+
+func (x *FeeRecommendation) GetChanId() uint64 {
+	if x != nil {
+		return x.ChanId
+	}
+	return 0
+}
+
+func (x *FeeRecommendation) GetLocalRatio() float64 {
+	if x != nil {
+		return x.LocalRatio
+	}
+	return 0
+}
+
+func (x *FeeRecommendation) GetForwardedVolumeMsat() uint64 {
+	if x != nil {
+		return x.ForwardedVolumeMsat
+	}
+	return 0
+}
+
+func (x *FeeRecommendation) GetCurrentBaseFeeMsat() uint64 {
+	if x != nil {
+		return x.CurrentBaseFeeMsat
+	}
+	return 0
+}
+
+func (x *FeeRecommendation) GetCurrentFeeRatePpm() uint32 {
+	if x != nil {
+		return x.CurrentFeeRatePpm
+	}
+	return 0
+}
+
+func (x *FeeRecommendation) GetCurrentInboundFee() *lnrpc.InboundFee {
+	if x != nil {
+		return x.CurrentInboundFee
+	}
+	return nil
+}
+
+func (x *FeeRecommendation) GetBaseFeeMsat() uint64 {
+	if x != nil {
+		return x.BaseFeeMsat
+	}
+	return 0
+}
+
+func (x *FeeRecommendation) GetFeeRatePpm() uint32 {
+	if x != nil {
+		return x.FeeRatePpm
+	}
+	return 0
+}
+
+func (x *FeeRecommendation) GetInboundFee() *lnrpc.InboundFee {
+	if x != nil {
+		return x.InboundFee
+	}
+	return nil
+}
+
+func (x *FeeRecommendation) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+func (x *FeeRecommendation) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *FeeRecommendation) GetFailure() string {
+	if x != nil {
+		return x.Failure
+	}
+	return ""
+}
+
+type GetDynamicFeePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDynamicFeePolicyRequest) Reset() {
+	*x = GetDynamicFeePolicyRequest{}
+	mi := &file_routerrpc_router_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDynamicFeePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDynamicFeePolicyRequest) ProtoMessage() {}
+
+func (x *GetDynamicFeePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDynamicFeePolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetDynamicFeePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{53}
+}
+
+type GetDynamicFeePolicyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether fees are recomputed periodically.
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// The interval in seconds at which fees are recomputed.
+	IntervalSec uint64 `protobuf:"varint,2,opt,name=interval_sec,json=intervalSec,proto3" json:"interval_sec,omitempty"`
+	// Whether periodic recomputations only report their recommendations
+	// instead of applying them.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// The parameters used for all channels without an override.
+	Params *DynamicFeeParams `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
+	// All per-channel and per-peer overrides.
+	Overrides []*FeePolicyOverride `protobuf:"bytes,5,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	// The unix timestamp in seconds of the last recomputation, or zero if no
+	// recomputation happened yet.
+	LastRunTimestamp int64 `protobuf:"varint,6,opt,name=last_run_timestamp,json=lastRunTimestamp,proto3" json:"last_run_timestamp,omitempty"`
+	// The fee recommendations of the last recomputation.
+	LastRecommendations []*FeeRecommendation `protobuf:"bytes,7,rep,name=last_recommendations,json=lastRecommendations,proto3" json:"last_recommendations,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetDynamicFeePolicyResponse) Reset() {
+	*x = GetDynamicFeePolicyResponse{}
+	mi := &file_routerrpc_router_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDynamicFeePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDynamicFeePolicyResponse) ProtoMessage() {}
+
+func (x *GetDynamicFeePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDynamicFeePolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetDynamicFeePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetDynamicFeePolicyResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *GetDynamicFeePolicyResponse) GetIntervalSec() uint64 {
+	if x != nil {
+		return x.IntervalSec
+	}
+	return 0
+}
+
+func (x *GetDynamicFeePolicyResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *GetDynamicFeePolicyResponse) GetParams() *DynamicFeeParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GetDynamicFeePolicyResponse) GetOverrides() []*FeePolicyOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *GetDynamicFeePolicyResponse) GetLastRunTimestamp() int64 {
+	if x != nil {
+		return x.LastRunTimestamp
+	}
+	return 0
+}
+
+func (x *GetDynamicFeePolicyResponse) GetLastRecommendations() []*FeeRecommendation {
+	if x != nil {
+		return x.LastRecommendations
+	}
+	return nil
+}
+
+type SetDynamicFeePolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new parameters used for all channels without an override.
+	Params *DynamicFeeParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// Whether periodic recomputations should only report their
+	// recommendations instead of applying them.
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDynamicFeePolicyRequest) Reset() {
+	*x = SetDynamicFeePolicyRequest{}
+	mi := &file_routerrpc_router_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDynamicFeePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDynamicFeePolicyRequest) ProtoMessage() {}
+
+func (x *SetDynamicFeePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDynamicFeePolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetDynamicFeePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SetDynamicFeePolicyRequest) GetParams() *DynamicFeeParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *SetDynamicFeePolicyRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type SetDynamicFeePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDynamicFeePolicyResponse) Reset() {
+	*x = SetDynamicFeePolicyResponse{}
+	mi := &file_routerrpc_router_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDynamicFeePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDynamicFeePolicyResponse) ProtoMessage() {}
+
+func (x *SetDynamicFeePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDynamicFeePolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetDynamicFeePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{56}
+}
+
+type SetFeePolicyOverrideRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The channel to set the override for. Either chan_point or peer_pubkey
+	// must be set.
+	ChanPoint *lnrpc.ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	// If set, the channels are excluded from dynamic fees.
+	Exclude bool `protobuf:"varint,2,opt,name=exclude,proto3" json:"exclude,omitempty"`
+	// The custom parameters to use for the channels. Ignored if exclude is
+	// set.
+	Params *DynamicFeeParams `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+	// If set, the existing override of the channel or peer is removed and
+	// exclude and params are ignored.
+	Remove bool `protobuf:"varint,4,opt,name=remove,proto3" json:"remove,omitempty"`
+	// The public key of the peer to set the override for, which applies to
+	// all its channels without a per-channel override.
+	PeerPubkey    []byte `protobuf:"bytes,5,opt,name=peer_pubkey,json=peerPubkey,proto3" json:"peer_pubkey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFeePolicyOverrideRequest) Reset() {
+	*x = SetFeePolicyOverrideRequest{}
+	mi := &file_routerrpc_router_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFeePolicyOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeePolicyOverrideRequest) ProtoMessage() {}
+
+func (x *SetFeePolicyOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeePolicyOverrideRequest.ProtoReflect.Descriptor instead.
+func (*SetFeePolicyOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetFeePolicyOverrideRequest) GetChanPoint() *lnrpc.ChannelPoint {
+	if x != nil {
+		return x.ChanPoint
+	}
+	return nil
+}
+
+func (x *SetFeePolicyOverrideRequest) GetExclude() bool {
+	if x != nil {
+		return x.Exclude
+	}
+	return false
+}
+
+func (x *SetFeePolicyOverrideRequest) GetParams() *DynamicFeeParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *SetFeePolicyOverrideRequest) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+func (x *SetFeePolicyOverrideRequest) GetPeerPubkey() []byte {
+	if x != nil {
+		return x.PeerPubkey
+	}
+	return nil
+}
+
+type SetFeePolicyOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFeePolicyOverrideResponse) Reset() {
+	*x = SetFeePolicyOverrideResponse{}
+	mi := &file_routerrpc_router_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFeePolicyOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeePolicyOverrideResponse) ProtoMessage() {}
+
+func (x *SetFeePolicyOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeePolicyOverrideResponse.ProtoReflect.Descriptor instead.
+func (*SetFeePolicyOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{58}
+}
+
+type RecomputeFeesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// If set, the recommended fees are only returned and not applied.
+	DryRun        bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeFeesRequest) Reset() {
+	*x = RecomputeFeesRequest{}
+	mi := &file_routerrpc_router_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeFeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeFeesRequest) ProtoMessage() {}
+
+func (x *RecomputeFeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeFeesRequest.ProtoReflect.Descriptor instead.
+func (*RecomputeFeesRequest) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RecomputeFeesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RecomputeFeesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The fee recommendations for all channels that aren't excluded.
+	Recommendations []*FeeRecommendation `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RecomputeFeesResponse) Reset() {
+	*x = RecomputeFeesResponse{}
+	mi := &file_routerrpc_router_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeFeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeFeesResponse) ProtoMessage() {}
+
+func (x *RecomputeFeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeFeesResponse.ProtoReflect.Descriptor instead.
+func (*RecomputeFeesResponse) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RecomputeFeesResponse) GetRecommendations() []*FeeRecommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+type StartRebalanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The channels the funds are moved out of. The circular payments may
+	// leave through any of them.
+	SourceChanIds []uint64 `protobuf:"varint,1,rep,packed,name=source_chan_ids,json=sourceChanIds,proto3" json:"source_chan_ids,omitempty"`
+	// The channels the funds are moved into.
+	TargetChanIds []uint64 `protobuf:"varint,2,rep,packed,name=target_chan_ids,json=targetChanIds,proto3" json:"target_chan_ids,omitempty"`
+	// The total amount to rebalance in satoshis.
+	AmtSat int64 `protobuf:"varint,3,opt,name=amt_sat,json=amtSat,proto3" json:"amt_sat,omitempty"`
+	// The maximum total routing fee in satoshis that may be paid. At least
+	// one of max_fee_sat and max_fee_rate_ppm must be set. If both are set,
+	// the lower resulting limit applies.
+	MaxFeeSat int64 `protobuf:"varint,4,opt,name=max_fee_sat,json=maxFeeSat,proto3" json:"max_fee_sat,omitempty"`
+	// The maximum total routing fee in parts per million of the amount.
+	MaxFeeRatePpm uint32 `protobuf:"varint,5,opt,name=max_fee_rate_ppm,json=maxFeeRatePpm,proto3" json:"max_fee_rate_ppm,omitempty"`
+	// The smallest amount in satoshis a single circular payment may carry.
+	// Failed payments are retried with half the amount until this threshold
+	// is reached. If zero, only the full remaining amount is tried.
+	MinAmtSat int64 `protobuf:"varint,6,opt,name=min_amt_sat,json=minAmtSat,proto3" json:"min_amt_sat,omitempty"`
+	// The maximum number of shards of a single circular payment. Defaults to
+	// 16.
+	MaxParts uint32 `protobuf:"varint,7,opt,name=max_parts,json=maxParts,proto3" json:"max_parts,omitempty"`
+	// The timeout in seconds of a single circular payment. Defaults to 60.
+	TimeoutSeconds int32 `protobuf:"varint,8,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartRebalanceRequest) Reset() {
+	*x = StartRebalanceRequest{}
+	mi := &file_routerrpc_router_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartRebalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRebalanceRequest) ProtoMessage() {}
+
+func (x *StartRebalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRebalanceRequest.ProtoReflect.Descriptor instead.
+func (*StartRebalanceRequest) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *StartRebalanceRequest) GetSourceChanIds() []uint64 {
+	if x != nil {
+		return x.SourceChanIds
+	}
+	return nil
+}
+
+func (x *StartRebalanceRequest) GetTargetChanIds() []uint64 {
+	if x != nil {
+		return x.TargetChanIds
+	}
+	return nil
+}
+
+func (x *StartRebalanceRequest) GetAmtSat() int64 {
+	if x != nil {
+		return x.AmtSat
+	}
+	return 0
+}
+
+func (x *StartRebalanceRequest) GetMaxFeeSat() int64 {
+	if x != nil {
+		return x.MaxFeeSat
+	}
+	return 0
+}
+
+func (x *StartRebalanceRequest) GetMaxFeeRatePpm() uint32 {
+	if x != nil {
+		return x.MaxFeeRatePpm
+	}
+	return 0
+}
+
+func (x *StartRebalanceRequest) GetMinAmtSat() int64 {
+	if x != nil {
+		return x.MinAmtSat
+	}
+	return 0
+}
+
+func (x *StartRebalanceRequest) GetMaxParts() uint32 {
+	if x != nil {
+		return x.MaxParts
+	}
+	return 0
+}
+
+func (x *StartRebalanceRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type StartRebalanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The identifier of the started rebalance.
+	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartRebalanceResponse) Reset() {
+	*x = StartRebalanceResponse{}
+	mi := &file_routerrpc_router_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartRebalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRebalanceResponse) ProtoMessage() {}
+
+func (x *StartRebalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRebalanceResponse.ProtoReflect.Descriptor instead.
+func (*StartRebalanceResponse) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *StartRebalanceResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListRebalancesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// If set, only rebalances that are still in progress are returned.
+	ActiveOnly    bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRebalancesRequest) Reset() {
+	*x = ListRebalancesRequest{}
+	mi := &file_routerrpc_router_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRebalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRebalancesRequest) ProtoMessage() {}
+
+func (x *ListRebalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRebalancesRequest.ProtoReflect.Descriptor instead.
+func (*ListRebalancesRequest) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListRebalancesRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListRebalancesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The rebalances, ordered by their identifier.
+	Rebalances    []*Rebalance `protobuf:"bytes,1,rep,name=rebalances,proto3" json:"rebalances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRebalancesResponse) Reset() {
+	*x = ListRebalancesResponse{}
+	mi := &file_routerrpc_router_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRebalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRebalancesResponse) ProtoMessage() {}
+
+func (x *ListRebalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRebalancesResponse.ProtoReflect.Descriptor instead.
+func (*ListRebalancesResponse) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListRebalancesResponse) GetRebalances() []*Rebalance {
+	if x != nil {
+		return x.Rebalances
+	}
+	return nil
+}
+
+type Rebalance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The identifier of the rebalance.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The unix timestamp in seconds at which the rebalance was started.
+	CreationTime int64 `protobuf:"varint,2,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	// The channels the funds are moved out of.
+	SourceChanIds []uint64 `protobuf:"varint,3,rep,packed,name=source_chan_ids,json=sourceChanIds,proto3" json:"source_chan_ids,omitempty"`
+	// The channels the funds are moved into.
+	TargetChanIds []uint64 `protobuf:"varint,4,rep,packed,name=target_chan_ids,json=targetChanIds,proto3" json:"target_chan_ids,omitempty"`
+	// The amount that should be rebalanced in milli-satoshis.
+	AmtMsat uint64 `protobuf:"varint,5,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	// The maximum total routing fee in milli-satoshis.
+	FeeLimitMsat uint64 `protobuf:"varint,6,opt,name=fee_limit_msat,json=feeLimitMsat,proto3" json:"fee_limit_msat,omitempty"`
+	// The status of the rebalance.
+	Status RebalanceStatus `protobuf:"varint,7,opt,name=status,proto3,enum=routerrpc.RebalanceStatus" json:"status,omitempty"`
+	// The amount that was rebalanced in milli-satoshis.
+	AmtRebalancedMsat uint64 `protobuf:"varint,8,opt,name=amt_rebalanced_msat,json=amtRebalancedMsat,proto3" json:"amt_rebalanced_msat,omitempty"`
+	// The total routing fee paid in milli-satoshis.
+	FeePaidMsat uint64 `protobuf:"varint,9,opt,name=fee_paid_msat,json=feePaidMsat,proto3" json:"fee_paid_msat,omitempty"`
+	// The circular payments made as part of the rebalance.
+	Attempts []*RebalanceAttempt `protobuf:"bytes,10,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	// The reason the rebalance didn't complete, if any.
+	FailureReason string `protobuf:"bytes,11,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rebalance) Reset() {
+	*x = Rebalance{}
+	mi := &file_routerrpc_router_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rebalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rebalance) ProtoMessage() {}
+
+func (x *Rebalance) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rebalance.ProtoReflect.Descriptor instead.
+func (*Rebalance) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Rebalance) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Rebalance) GetCreationTime() int64 {
+	if x != nil {
+		return x.CreationTime
+	}
+	return 0
+}
+
+func (x *Rebalance) GetSourceChanIds() []uint64 {
+	if x != nil {
+		return x.SourceChanIds
+	}
+	return nil
+}
+
+func (x *Rebalance) GetTargetChanIds() []uint64 {
+	if x != nil {
+		return x.TargetChanIds
+	}
+	return nil
+}
+
+func (x *Rebalance) GetAmtMsat() uint64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
+}
+
+func (x *Rebalance) GetFeeLimitMsat() uint64 {
+	if x != nil {
+		return x.FeeLimitMsat
+	}
+	return 0
+}
+
+func (x *Rebalance) GetStatus() RebalanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RebalanceStatus_REBALANCE_IN_PROGRESS
+}
+
+func (x *Rebalance) GetAmtRebalancedMsat() uint64 {
+	if x != nil {
+		return x.AmtRebalancedMsat
+	}
+	return 0
+}
+
+func (x *Rebalance) GetFeePaidMsat() uint64 {
+	if x != nil {
+		return x.FeePaidMsat
+	}
+	return 0
+}
+
+func (x *Rebalance) GetAttempts() []*RebalanceAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *Rebalance) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+type RebalanceAttempt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The hash of the circular payment.
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	// The peer the payment was routed back through.
+	LastHopPubkey []byte `protobuf:"bytes,2,opt,name=last_hop_pubkey,json=lastHopPubkey,proto3" json:"last_hop_pubkey,omitempty"`
+	// The amount of the payment in milli-satoshis, excluding fees.
+	AmtMsat uint64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	// The routing fee paid in milli-satoshis.
+	FeeMsat uint64 `protobuf:"varint,4,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
+	// Whether the payment succeeded.
+	Succeeded bool `protobuf:"varint,5,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	// The reason the payment failed, if any.
+	Failure       string `protobuf:"bytes,6,opt,name=failure,proto3" json:"failure,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebalanceAttempt) Reset() {
+	*x = RebalanceAttempt{}
+	mi := &file_routerrpc_router_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebalanceAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceAttempt) ProtoMessage() {}
+
+func (x *RebalanceAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceAttempt.ProtoReflect.Descriptor instead.
+func (*RebalanceAttempt) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RebalanceAttempt) GetPaymentHash() []byte {
+	if x != nil {
+		return x.PaymentHash
+	}
+	return nil
+}
+
+func (x *RebalanceAttempt) GetLastHopPubkey() []byte {
+	if x != nil {
+		return x.LastHopPubkey
+	}
+	return nil
+}
+
+func (x *RebalanceAttempt) GetAmtMsat() uint64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
+}
+
+func (x *RebalanceAttempt) GetFeeMsat() uint64 {
+	if x != nil {
+		return x.FeeMsat
+	}
+	return 0
+}
+
+func (x *RebalanceAttempt) GetSucceeded() bool {
+	if x != nil {
+		return x.Succeeded
+	}
+	return false
+}
+
+func (x *RebalanceAttempt) GetFailure() string {
+	if x != nil {
+		return x.Failure
+	}
+	return ""
+}
+
+type ProbabilityEstimateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the query, which needs to be passed back in
+	// the estimate.
+	QueryId uint64 `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	// The source node pubkey of the pair.
+	FromNode []byte `protobuf:"bytes,2,opt,name=from_node,json=fromNode,proto3" json:"from_node,omitempty"`
+	// The target node pubkey of the pair.
+	ToNode []byte `protobuf:"bytes,3,opt,name=to_node,json=toNode,proto3" json:"to_node,omitempty"`
+	// The amount to send over the pair in milli-satoshis.
+	AmtMsat uint64 `protobuf:"varint,4,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	// The capacity of the channel between the nodes in satoshis.
+	CapacitySat int64 `protobuf:"varint,5,opt,name=capacity_sat,json=capacitySat,proto3" json:"capacity_sat,omitempty"`
+	// Whether the source node is our own node.
+	Local bool `protobuf:"varint,6,opt,name=local,proto3" json:"local,omitempty"`
+	// The mission control results of the source node.
+	Results       []*PairHistory `protobuf:"bytes,7,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProbabilityEstimateRequest) Reset() {
+	*x = ProbabilityEstimateRequest{}
+	mi := &file_routerrpc_router_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProbabilityEstimateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbabilityEstimateRequest) ProtoMessage() {}
+
+func (x *ProbabilityEstimateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbabilityEstimateRequest.ProtoReflect.Descriptor instead.
+func (*ProbabilityEstimateRequest) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ProbabilityEstimateRequest) GetQueryId() uint64 {
+	if x != nil {
+		return x.QueryId
+	}
+	return 0
+}
+
+func (x *ProbabilityEstimateRequest) GetFromNode() []byte {
+	if x != nil {
+		return x.FromNode
+	}
+	return nil
+}
+
+func (x *ProbabilityEstimateRequest) GetToNode() []byte {
+	if x != nil {
+		return x.ToNode
+	}
+	return nil
+}
+
+func (x *ProbabilityEstimateRequest) GetAmtMsat() uint64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
+}
+
+func (x *ProbabilityEstimateRequest) GetCapacitySat() int64 {
+	if x != nil {
+		return x.CapacitySat
+	}
+	return 0
+}
+
+func (x *ProbabilityEstimateRequest) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
+func (x *ProbabilityEstimateRequest) GetResults() []*PairHistory {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ProbabilityEstimate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The identifier of the query this estimate answers.
+	QueryId uint64 `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	// The estimated success probability of the pair in the range [0, 1].
+	Probability   float64 `protobuf:"fixed64,2,opt,name=probability,proto3" json:"probability,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProbabilityEstimate) Reset() {
+	*x = ProbabilityEstimate{}
+	mi := &file_routerrpc_router_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProbabilityEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbabilityEstimate) ProtoMessage() {}
+
+func (x *ProbabilityEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbabilityEstimate.ProtoReflect.Descriptor instead.
+func (*ProbabilityEstimate) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ProbabilityEstimate) GetQueryId() uint64 {
+	if x != nil {
+		return x.QueryId
+	}
+	return 0
+}
+
+func (x *ProbabilityEstimate) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+type GetLiquidityMapRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// If set, only the liquidity bounds of the channel directions from or to
+	// the node with this pubkey are returned.
+	Node          []byte `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLiquidityMapRequest) Reset() {
+	*x = GetLiquidityMapRequest{}
+	mi := &file_routerrpc_router_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLiquidityMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLiquidityMapRequest) ProtoMessage() {}
+
+func (x *GetLiquidityMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLiquidityMapRequest.ProtoReflect.Descriptor instead.
+func (*GetLiquidityMapRequest) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetLiquidityMapRequest) GetNode() []byte {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type GetLiquidityMapResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The liquidity bounds of the channel directions between node pairs.
+	Bounds []*LiquidityBound `protobuf:"bytes,1,rep,name=bounds,proto3" json:"bounds,omitempty"`
+	// The status of the background prober.
+	Prober        *ProberStatus `protobuf:"bytes,2,opt,name=prober,proto3" json:"prober,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLiquidityMapResponse) Reset() {
+	*x = GetLiquidityMapResponse{}
+	mi := &file_routerrpc_router_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLiquidityMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLiquidityMapResponse) ProtoMessage() {}
+
+func (x *GetLiquidityMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLiquidityMapResponse.ProtoReflect.Descriptor instead.
+func (*GetLiquidityMapResponse) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetLiquidityMapResponse) GetBounds() []*LiquidityBound {
+	if x != nil {
+		return x.Bounds
+	}
+	return nil
+}
+
+func (x *GetLiquidityMapResponse) GetProber() *ProberStatus {
+	if x != nil {
+		return x.Prober
+	}
+	return nil
+}
+
+type LiquidityBound struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The source node pubkey of the pair.
+	NodeFrom []byte `protobuf:"bytes,1,opt,name=node_from,json=nodeFrom,proto3" json:"node_from,omitempty"`
+	// The destination node pubkey of the pair.
+	NodeTo []byte `protobuf:"bytes,2,opt,name=node_to,json=nodeTo,proto3" json:"node_to,omitempty"`
+	// The highest amount in milli-satoshis that was forwarded successfully,
+	// which is a lower bound of the available liquidity. Zero if unknown.
+	MinLiquidityMsat uint64 `protobuf:"varint,3,opt,name=min_liquidity_msat,json=minLiquidityMsat,proto3" json:"min_liquidity_msat,omitempty"`
+	// The lowest amount in milli-satoshis that failed to be forwarded, which is
+	// an upper bound of the available liquidity. Unset if no failure bounds the
+	// liquidity, in which case it is only bounded by the channel capacity.
+	MaxLiquidityMsat *uint64 `protobuf:"varint,4,opt,name=max_liquidity_msat,json=maxLiquidityMsat,proto3,oneof" json:"max_liquidity_msat,omitempty"`
+	// The unix timestamp in seconds of the last success, if any.
+	SuccessTime int64 `protobuf:"varint,5,opt,name=success_time,json=successTime,proto3" json:"success_time,omitempty"`
+	// The unix timestamp in seconds of the last failure, if any.
+	FailTime      int64 `protobuf:"varint,6,opt,name=fail_time,json=failTime,proto3" json:"fail_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiquidityBound) Reset() {
+	*x = LiquidityBound{}
+	mi := &file_routerrpc_router_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiquidityBound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityBound) ProtoMessage() {}
+
+func (x *LiquidityBound) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityBound.ProtoReflect.Descriptor instead.
+func (*LiquidityBound) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *LiquidityBound) GetNodeFrom() []byte {
+	if x != nil {
+		return x.NodeFrom
+	}
+	return nil
+}
+
+func (x *LiquidityBound) GetNodeTo() []byte {
+	if x != nil {
+		return x.NodeTo
+	}
+	return nil
+}
+
+func (x *LiquidityBound) GetMinLiquidityMsat() uint64 {
+	if x != nil {
+		return x.MinLiquidityMsat
+	}
+	return 0
+}
+
+func (x *LiquidityBound) GetMaxLiquidityMsat() uint64 {
+	if x != nil && x.MaxLiquidityMsat != nil {
+		return *x.MaxLiquidityMsat
+	}
+	return 0
+}
+
+func (x *LiquidityBound) GetSuccessTime() int64 {
+	if x != nil {
+		return x.SuccessTime
+	}
+	return 0
+}
+
+func (x *LiquidityBound) GetFailTime() int64 {
+	if x != nil {
+		return x.FailTime
+	}
+	return 0
+}
+
+type ProberStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the background prober is active.
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// The probed target nodes.
+	Targets []*ProbeTarget `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"`
+	// The total amount of all probes in flight in milli-satoshis, including the
+	// maximum routing fees they may pay.
+	LockedMsat uint64 `protobuf:"varint,3,opt,name=locked_msat,json=lockedMsat,proto3" json:"locked_msat,omitempty"`
+	// The number of probes that were sent.
+	ProbesSent uint64 `protobuf:"varint,4,opt,name=probes_sent,json=probesSent,proto3" json:"probes_sent,omitempty"`
+	// The number of probes that reached their target.
+	ProbesReached uint64 `protobuf:"varint,5,opt,name=probes_reached,json=probesReached,proto3" json:"probes_reached,omitempty"`
+	// The number of probes that failed before reaching their target.
+	ProbesFailed uint64 `protobuf:"varint,6,opt,name=probes_failed,json=probesFailed,proto3" json:"probes_failed,omitempty"`
+	// The number of probes that weren't sent because they would have exceeded
+	// the locked liquidity budget.
+	ProbesSkipped uint64 `protobuf:"varint,7,opt,name=probes_skipped,json=probesSkipped,proto3" json:"probes_skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProberStatus) Reset() {
+	*x = ProberStatus{}
+	mi := &file_routerrpc_router_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProberStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProberStatus) ProtoMessage() {}
+
+func (x *ProberStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProberStatus.ProtoReflect.Descriptor instead.
+func (*ProberStatus) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ProberStatus) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *ProberStatus) GetTargets() []*ProbeTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *ProberStatus) GetLockedMsat() uint64 {
+	if x != nil {
+		return x.LockedMsat
+	}
+	return 0
+}
+
+func (x *ProberStatus) GetProbesSent() uint64 {
+	if x != nil {
+		return x.ProbesSent
+	}
+	return 0
+}
+
+func (x *ProberStatus) GetProbesReached() uint64 {
+	if x != nil {
+		return x.ProbesReached
+	}
+	return 0
+}
+
+func (x *ProberStatus) GetProbesFailed() uint64 {
+	if x != nil {
+		return x.ProbesFailed
+	}
+	return 0
+}
+
+func (x *ProberStatus) GetProbesSkipped() uint64 {
+	if x != nil {
+		return x.ProbesSkipped
+	}
+	return 0
+}
+
+type ProbeTarget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pubkey of the target node.
+	Pubkey []byte `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// The amount of the next probe to the target in milli-satoshis.
+	NextAmtMsat   uint64 `protobuf:"varint,2,opt,name=next_amt_msat,json=nextAmtMsat,proto3" json:"next_amt_msat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProbeTarget) Reset() {
+	*x = ProbeTarget{}
+	mi := &file_routerrpc_router_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProbeTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeTarget) ProtoMessage() {}
+
+func (x *ProbeTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeTarget.ProtoReflect.Descriptor instead.
+func (*ProbeTarget) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ProbeTarget) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *ProbeTarget) GetNextAmtMsat() uint64 {
+	if x != nil {
+		return x.NextAmtMsat
+	}
+	return 0
+}
+
+type SendPaymentBatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The payments of the batch. The payments are started in the given order,
+	// and no two payments may have the same payment hash.
+	Payments []*SendPaymentRequest `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	// The maximum number of payments that are in flight at the same time. If
+	// zero, a default of 10 is used.
+	MaxParallel uint32 `protobuf:"varint,2,opt,name=max_parallel,json=maxParallel,proto3" json:"max_parallel,omitempty"`
+	// The maximum total routing fee in milli-satoshis of all payments. A
+	// payment is only started once the remaining budget covers its whole fee
+	// limit, and the unused part of its limit is returned to the budget once it
+	// completed. Payments that can't be started because the budget is
+	// exhausted are skipped. The fee limit of every payment must not exceed the
+	// budget. If zero, only the fee limits of the individual payments apply.
+	FeeBudgetMsat int64 `protobuf:"varint,3,opt,name=fee_budget_msat,json=feeBudgetMsat,proto3" json:"fee_budget_msat,omitempty"`
+	// If set, only the final state update of every payment is streamed.
+	NoInflightUpdates bool `protobuf:"varint,4,opt,name=no_inflight_updates,json=noInflightUpdates,proto3" json:"no_inflight_updates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SendPaymentBatchRequest) Reset() {
+	*x = SendPaymentBatchRequest{}
+	mi := &file_routerrpc_router_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendPaymentBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPaymentBatchRequest) ProtoMessage() {}
+
+func (x *SendPaymentBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPaymentBatchRequest.ProtoReflect.Descriptor instead.
+func (*SendPaymentBatchRequest) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SendPaymentBatchRequest) GetPayments() []*SendPaymentRequest {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *SendPaymentBatchRequest) GetMaxParallel() uint32 {
+	if x != nil {
+		return x.MaxParallel
+	}
+	return 0
+}
+
+func (x *SendPaymentBatchRequest) GetFeeBudgetMsat() int64 {
+	if x != nil {
+		return x.FeeBudgetMsat
+	}
+	return 0
+}
+
+func (x *SendPaymentBatchRequest) GetNoInflightUpdates() bool {
+	if x != nil {
+		return x.NoInflightUpdates
+	}
+	return false
+}
+
+type PaymentBatchUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Update:
+	//
+	//	*PaymentBatchUpdate_Payment
+	//	*PaymentBatchUpdate_Summary
+	Update        isPaymentBatchUpdate_Update `protobuf_oneof:"update"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentBatchUpdate) Reset() {
+	*x = PaymentBatchUpdate{}
+	mi := &file_routerrpc_router_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentBatchUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentBatchUpdate) ProtoMessage() {}
+
+func (x *PaymentBatchUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentBatchUpdate.ProtoReflect.Descriptor instead.
+func (*PaymentBatchUpdate) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PaymentBatchUpdate) GetUpdate() isPaymentBatchUpdate_Update {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *PaymentBatchUpdate) GetPayment() *BatchPaymentStatus {
+	if x != nil {
+		if x, ok := x.Update.(*PaymentBatchUpdate_Payment); ok {
+			return x.Payment
+		}
+	}
+	return nil
+}
+
+func (x *PaymentBatchUpdate) GetSummary() *PaymentBatchSummary {
+	if x != nil {
+		if x, ok := x.Update.(*PaymentBatchUpdate_Summary); ok {
+			return x.Summary
+		}
+	}
+	return nil
+}
+
+type isPaymentBatchUpdate_Update interface {
+	isPaymentBatchUpdate_Update()
+}
+
+type PaymentBatchUpdate_Payment struct {
+	// A state update of a single payment of the batch.
+	Payment *BatchPaymentStatus `protobuf:"bytes,1,opt,name=payment,proto3,oneof"`
+}
+
+type PaymentBatchUpdate_Summary struct {
+	// The summary of the batch, which is sent as the last update once all
+	// payments completed.
+	Summary *PaymentBatchSummary `protobuf:"bytes,2,opt,name=summary,proto3,oneof"`
+}
+
+func (*PaymentBatchUpdate_Payment) isPaymentBatchUpdate_Update() {}
+
+func (*PaymentBatchUpdate_Summary) isPaymentBatchUpdate_Update() {}
+
+type BatchPaymentStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The index of the payment in the request.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// The latest state of the payment. Not set if the payment couldn't be
+	// started.
+	Payment *lnrpc.Payment `protobuf:"bytes,2,opt,name=payment,proto3" json:"payment,omitempty"`
+	// The reason the payment couldn't be started, for example because it was
+	// already paid or the fee budget was exhausted.
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchPaymentStatus) Reset() {
+	*x = BatchPaymentStatus{}
+	mi := &file_routerrpc_router_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchPaymentStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPaymentStatus) ProtoMessage() {}
+
+func (x *BatchPaymentStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPaymentStatus.ProtoReflect.Descriptor instead.
+func (*BatchPaymentStatus) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *BatchPaymentStatus) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchPaymentStatus) GetPayment() *lnrpc.Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *BatchPaymentStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PaymentBatchSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of payments that succeeded.
+	NumSucceeded uint32 `protobuf:"varint,1,opt,name=num_succeeded,json=numSucceeded,proto3" json:"num_succeeded,omitempty"`
+	// The number of payments that failed or couldn't be started.
+	NumFailed uint32 `protobuf:"varint,2,opt,name=num_failed,json=numFailed,proto3" json:"num_failed,omitempty"`
+	// The number of payments that were skipped because the fee budget was
+	// exhausted or the stream was canceled.
+	NumSkipped uint32 `protobuf:"varint,3,opt,name=num_skipped,json=numSkipped,proto3" json:"num_skipped,omitempty"`
+	// The total amount of the succeeded payments in milli-satoshis.
+	AmtPaidMsat int64 `protobuf:"varint,4,opt,name=amt_paid_msat,json=amtPaidMsat,proto3" json:"amt_paid_msat,omitempty"`
+	// The total routing fee paid in milli-satoshis.
+	FeePaidMsat   int64 `protobuf:"varint,5,opt,name=fee_paid_msat,json=feePaidMsat,proto3" json:"fee_paid_msat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentBatchSummary) Reset() {
+	*x = PaymentBatchSummary{}
+	mi := &file_routerrpc_router_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentBatchSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentBatchSummary) ProtoMessage() {}
+
+func (x *PaymentBatchSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentBatchSummary.ProtoReflect.Descriptor instead.
+func (*PaymentBatchSummary) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *PaymentBatchSummary) GetNumSucceeded() uint32 {
+	if x != nil {
+		return x.NumSucceeded
+	}
+	return 0
+}
+
+func (x *PaymentBatchSummary) GetNumFailed() uint32 {
+	if x != nil {
+		return x.NumFailed
+	}
+	return 0
+}
+
+func (x *PaymentBatchSummary) GetNumSkipped() uint32 {
+	if x != nil {
+		return x.NumSkipped
+	}
+	return 0
+}
+
+func (x *PaymentBatchSummary) GetAmtPaidMsat() int64 {
+	if x != nil {
+		return x.AmtPaidMsat
+	}
+	return 0
+}
+
+func (x *PaymentBatchSummary) GetFeePaidMsat() int64 {
+	if x != nil {
+		return x.FeePaidMsat
+	}
+	return 0
+}
+
+type CancelPaymentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The hash of the payment to cancel.
+	PaymentHash   []byte `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPaymentRequest) Reset() {
+	*x = CancelPaymentRequest{}
+	mi := &file_routerrpc_router_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPaymentRequest) ProtoMessage() {}
+
+func (x *CancelPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPaymentRequest.ProtoReflect.Descriptor instead.
+func (*CancelPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CancelPaymentRequest) GetPaymentHash() []byte {
+	if x != nil {
+		return x.PaymentHash
+	}
+	return nil
+}
+
+type CancelPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPaymentResponse) Reset() {
+	*x = CancelPaymentResponse{}
+	mi := &file_routerrpc_router_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPaymentResponse) ProtoMessage() {}
+
+func (x *CancelPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPaymentResponse.ProtoReflect.Descriptor instead.
+func (*CancelPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{79}
+}
+
+type GetResourceStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceStatsRequest) Reset() {
+	*x = GetResourceStatsRequest{}
+	mi := &file_routerrpc_router_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceStatsRequest) ProtoMessage() {}
+
+func (x *GetResourceStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceStatsRequest) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{80}
+}
+
+type GetResourceStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether resource bucketing is enabled. If it is disabled, all HTLCs are
+	// forwarded and only the reputation and revenue are tracked.
+	BucketingEnabled bool `protobuf:"varint,1,opt,name=bucketing_enabled,json=bucketingEnabled,proto3" json:"bucketing_enabled,omitempty"`
+	// The reputation of the peers that forwarded HTLCs to us.
+	Peers []*PeerReputation `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+	// The resources of the outgoing channels of forwarded HTLCs.
+	Channels []*ChannelResources `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"`
+	// The number of HTLCs that were admitted into the general bucket.
+	GeneralForwards uint64 `protobuf:"varint,4,opt,name=general_forwards,json=generalForwards,proto3" json:"general_forwards,omitempty"`
+	// The number of HTLCs that were admitted into the protected bucket.
+	ProtectedForwards uint64 `protobuf:"varint,5,opt,name=protected_forwards,json=protectedForwards,proto3" json:"protected_forwards,omitempty"`
+	// The number of HTLCs that were failed because the resources available to
+	// them were exhausted.
+	Rejected      uint64 `protobuf:"varint,6,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceStatsResponse) Reset() {
+	*x = GetResourceStatsResponse{}
+	mi := &file_routerrpc_router_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceStatsResponse) ProtoMessage() {}
+
+func (x *GetResourceStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceStatsResponse) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetResourceStatsResponse) GetBucketingEnabled() bool {
+	if x != nil {
+		return x.BucketingEnabled
+	}
+	return false
+}
+
+func (x *GetResourceStatsResponse) GetPeers() []*PeerReputation {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *GetResourceStatsResponse) GetChannels() []*ChannelResources {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *GetResourceStatsResponse) GetGeneralForwards() uint64 {
+	if x != nil {
+		return x.GeneralForwards
+	}
+	return 0
+}
+
+func (x *GetResourceStatsResponse) GetProtectedForwards() uint64 {
+	if x != nil {
+		return x.ProtectedForwards
+	}
+	return 0
+}
+
+func (x *GetResourceStatsResponse) GetRejected() uint64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+type PeerReputation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pubkey of the peer.
+	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// The decaying average of the fees that the HTLCs of the peer earned us,
+	// minus the opportunity cost of its accountable HTLCs that were held longer
+	// than the resolution period, in milli-satoshis.
+	ReputationMsat int64 `protobuf:"varint,2,opt,name=reputation_msat,json=reputationMsat,proto3" json:"reputation_msat,omitempty"`
+	// The worst case opportunity cost of the accountable HTLCs of the peer that
+	// are in flight, in milli-satoshis.
+	InFlightRiskMsat uint64 `protobuf:"varint,3,opt,name=in_flight_risk_msat,json=inFlightRiskMsat,proto3" json:"in_flight_risk_msat,omitempty"`
+	// The number of HTLCs of the peer that are in flight.
+	NumInFlight   uint32 `protobuf:"varint,4,opt,name=num_in_flight,json=numInFlight,proto3" json:"num_in_flight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerReputation) Reset() {
+	*x = PeerReputation{}
+	mi := &file_routerrpc_router_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerReputation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReputation) ProtoMessage() {}
+
+func (x *PeerReputation) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReputation.ProtoReflect.Descriptor instead.
+func (*PeerReputation) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *PeerReputation) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+func (x *PeerReputation) GetReputationMsat() int64 {
+	if x != nil {
+		return x.ReputationMsat
+	}
+	return 0
+}
+
+func (x *PeerReputation) GetInFlightRiskMsat() uint64 {
+	if x != nil {
+		return x.InFlightRiskMsat
+	}
+	return 0
+}
+
+func (x *PeerReputation) GetNumInFlight() uint32 {
+	if x != nil {
+		return x.NumInFlight
+	}
+	return 0
+}
+
+type ChannelResources struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The short channel id of the outgoing channel.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	// The decaying average of the fees forwards over the channel earned us, in
+	// milli-satoshis.
+	RevenueMsat uint64 `protobuf:"varint,2,opt,name=revenue_msat,json=revenueMsat,proto3" json:"revenue_msat,omitempty"`
+	// The number of slots in the general bucket.
+	GeneralSlots uint32 `protobuf:"varint,3,opt,name=general_slots,json=generalSlots,proto3" json:"general_slots,omitempty"`
+	// The number of slots in the general bucket that are in use.
+	GeneralSlotsUsed uint32 `protobuf:"varint,4,opt,name=general_slots_used,json=generalSlotsUsed,proto3" json:"general_slots_used,omitempty"`
+	// The liquidity of the general bucket in milli-satoshis.
+	GeneralLiquidityMsat uint64 `protobuf:"varint,5,opt,name=general_liquidity_msat,json=generalLiquidityMsat,proto3" json:"general_liquidity_msat,omitempty"`
+	// The liquidity of the general bucket that is in use in milli-satoshis.
+	GeneralLiquidityUsedMsat uint64 `protobuf:"varint,6,opt,name=general_liquidity_used_msat,json=generalLiquidityUsedMsat,proto3" json:"general_liquidity_used_msat,omitempty"`
+	// The number of slots in the protected bucket.
+	ProtectedSlots uint32 `protobuf:"varint,7,opt,name=protected_slots,json=protectedSlots,proto3" json:"protected_slots,omitempty"`
+	// The number of slots in the protected bucket that are in use.
+	ProtectedSlotsUsed uint32 `protobuf:"varint,8,opt,name=protected_slots_used,json=protectedSlotsUsed,proto3" json:"protected_slots_used,omitempty"`
+	// The liquidity of the protected bucket in milli-satoshis.
+	ProtectedLiquidityMsat uint64 `protobuf:"varint,9,opt,name=protected_liquidity_msat,json=protectedLiquidityMsat,proto3" json:"protected_liquidity_msat,omitempty"`
+	// The liquidity of the protected bucket that is in use in milli-satoshis.
+	ProtectedLiquidityUsedMsat uint64 `protobuf:"varint,10,opt,name=protected_liquidity_used_msat,json=protectedLiquidityUsedMsat,proto3" json:"protected_liquidity_used_msat,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *ChannelResources) Reset() {
+	*x = ChannelResources{}
+	mi := &file_routerrpc_router_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelResources) ProtoMessage() {}
+
+func (x *ChannelResources) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelResources.ProtoReflect.Descriptor instead.
+func (*ChannelResources) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ChannelResources) GetChanId() uint64 {
+	if x != nil {
+		return x.ChanId
+	}
+	return 0
+}
+
+func (x *ChannelResources) GetRevenueMsat() uint64 {
+	if x != nil {
+		return x.RevenueMsat
+	}
+	return 0
+}
+
+func (x *ChannelResources) GetGeneralSlots() uint32 {
+	if x != nil {
+		return x.GeneralSlots
+	}
+	return 0
+}
+
+func (x *ChannelResources) GetGeneralSlotsUsed() uint32 {
+	if x != nil {
+		return x.GeneralSlotsUsed
+	}
+	return 0
+}
+
+func (x *ChannelResources) GetGeneralLiquidityMsat() uint64 {
+	if x != nil {
+		return x.GeneralLiquidityMsat
+	}
+	return 0
+}
+
+func (x *ChannelResources) GetGeneralLiquidityUsedMsat() uint64 {
+	if x != nil {
+		return x.GeneralLiquidityUsedMsat
+	}
+	return 0
+}
+
+func (x *ChannelResources) GetProtectedSlots() uint32 {
+	if x != nil {
+		return x.ProtectedSlots
+	}
+	return 0
+}
+
+func (x *ChannelResources) GetProtectedSlotsUsed() uint32 {
+	if x != nil {
+		return x.ProtectedSlotsUsed
+	}
+	return 0
+}
+
+func (x *ChannelResources) GetProtectedLiquidityMsat() uint64 {
+	if x != nil {
+		return x.ProtectedLiquidityMsat
+	}
+	return 0
+}
+
+func (x *ChannelResources) GetProtectedLiquidityUsedMsat() uint64 {
+	if x != nil {
+		return x.ProtectedLiquidityUsedMsat
+	}
+	return 0
+}
+
+type SetPeerPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pubkey of the peer to set the policy for.
+	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// The maximum number of HTLCs the peer may have us forward at the same time.
+	// A value of 0 disables the limit.
+	MaxHtlcs uint32 `protobuf:"varint,2,opt,name=max_htlcs,json=maxHtlcs,proto3" json:"max_htlcs,omitempty"`
+	// The maximum total value of the HTLCs the peer may have us forward at the
+	// same time, in milli-satoshis. A value of 0 disables the limit.
+	MaxInFlightMsat uint64 `protobuf:"varint,3,opt,name=max_in_flight_msat,json=maxInFlightMsat,proto3" json:"max_in_flight_msat,omitempty"`
+	// The minimum amount of the HTLCs the peer may have us forward, in
+	// milli-satoshis. A value of 0 disables the limit.
+	MinHtlcMsat uint64 `protobuf:"varint,4,opt,name=min_htlc_msat,json=minHtlcMsat,proto3" json:"min_htlc_msat,omitempty"`
+	// If set, the override of the peer is removed, so that the configured
+	// default policy applies to it again. All other limits are ignored.
+	Remove        bool `protobuf:"varint,5,opt,name=remove,proto3" json:"remove,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPeerPolicyRequest) Reset() {
+	*x = SetPeerPolicyRequest{}
+	mi := &file_routerrpc_router_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPeerPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPeerPolicyRequest) ProtoMessage() {}
+
+func (x *SetPeerPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPeerPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetPeerPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SetPeerPolicyRequest) GetPeer() []byte {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+func (x *SetPeerPolicyRequest) GetMaxHtlcs() uint32 {
+	if x != nil {
+		return x.MaxHtlcs
+	}
+	return 0
+}
+
+func (x *SetPeerPolicyRequest) GetMaxInFlightMsat() uint64 {
+	if x != nil {
+		return x.MaxInFlightMsat
+	}
+	return 0
+}
+
+func (x *SetPeerPolicyRequest) GetMinHtlcMsat() uint64 {
+	if x != nil {
+		return x.MinHtlcMsat
+	}
+	return 0
+}
+
+func (x *SetPeerPolicyRequest) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+type SetPeerPolicyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of HTLCs the peer may now have us forward.
+	MaxHtlcs uint32 `protobuf:"varint,1,opt,name=max_htlcs,json=maxHtlcs,proto3" json:"max_htlcs,omitempty"`
+	// The maximum total value of the HTLCs the peer may now have us forward.
+	MaxInFlightMsat uint64 `protobuf:"varint,2,opt,name=max_in_flight_msat,json=maxInFlightMsat,proto3" json:"max_in_flight_msat,omitempty"`
+	// The minimum amount of the HTLCs the peer may now have us forward.
+	MinHtlcMsat uint64 `protobuf:"varint,3,opt,name=min_htlc_msat,json=minHtlcMsat,proto3" json:"min_htlc_msat,omitempty"`
+	// The number of HTLCs of the peer that are currently in flight.
+	NumInFlight uint32 `protobuf:"varint,4,opt,name=num_in_flight,json=numInFlight,proto3" json:"num_in_flight,omitempty"`
+	// The total value of the HTLCs of the peer that are currently in flight.
+	InFlightMsat  uint64 `protobuf:"varint,5,opt,name=in_flight_msat,json=inFlightMsat,proto3" json:"in_flight_msat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPeerPolicyResponse) Reset() {
+	*x = SetPeerPolicyResponse{}
+	mi := &file_routerrpc_router_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPeerPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPeerPolicyResponse) ProtoMessage() {}
+
+func (x *SetPeerPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_routerrpc_router_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPeerPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetPeerPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_routerrpc_router_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SetPeerPolicyResponse) GetMaxHtlcs() uint32 {
+	if x != nil {
+		return x.MaxHtlcs
+	}
+	return 0
+}
+
+func (x *SetPeerPolicyResponse) GetMaxInFlightMsat() uint64 {
+	if x != nil {
+		return x.MaxInFlightMsat
+	}
+	return 0
+}
+
+func (x *SetPeerPolicyResponse) GetMinHtlcMsat() uint64 {
+	if x != nil {
+		return x.MinHtlcMsat
+	}
+	return 0
+}
+
+func (x *SetPeerPolicyResponse) GetNumInFlight() uint32 {
+	if x != nil {
+		return x.NumInFlight
+	}
+	return 0
+}
+
+func (x *SetPeerPolicyResponse) GetInFlightMsat() uint64 {
+	if x != nil {
+		return x.InFlightMsat
+	}
+	return 0
+}
+
+var File_routerrpc_router_proto protoreflect.FileDescriptor
+
+const file_routerrpc_router_proto_rawDesc = "" +
+	"\n" +
+	"\x16routerrpc/router.proto\x12\trouterrpc\x1a\x0flightning.proto\"\xee\n" +
+	"\n" +
+	"\x12SendPaymentRequest\x12\x12\n" +
+	"\x04dest\x18\x01 \x01(\fR\x04dest\x12\x10\n" +
+	"\x03amt\x18\x02 \x01(\x03R\x03amt\x12!\n" +
+	"\fpayment_hash\x18\x03 \x01(\fR\vpaymentHash\x12(\n" +
+	"\x10final_cltv_delta\x18\x04 \x01(\x05R\x0efinalCltvDelta\x12'\n" +
+	"\x0fpayment_request\x18\x05 \x01(\tR\x0epaymentRequest\x12'\n" +
+	"\x0ftimeout_seconds\x18\x06 \x01(\x05R\x0etimeoutSeconds\x12\"\n" +
+	"\rfee_limit_sat\x18\a \x01(\x03R\vfeeLimitSat\x12\x1d\n" +
+	"\n" +
+	"cltv_limit\x18\t \x01(\x05R\tcltvLimit\x121\n" +
+	"\vroute_hints\x18\n" +
+	" \x03(\v2\x10.lnrpc.RouteHintR\n" +
+	"routeHints\x12d\n" +
+	"\x13dest_custom_records\x18\v \x03(\v24.routerrpc.SendPaymentRequest.DestCustomRecordsEntryR\x11destCustomRecords\x12\x19\n" +
+	"\bamt_msat\x18\f \x01(\x03R\aamtMsat\x12$\n" +
+	"\x0efee_limit_msat\x18\r \x01(\x03R\ffeeLimitMsat\x12&\n" +
+	"\x0flast_hop_pubkey\x18\x0e \x01(\fR\rlastHopPubkey\x12,\n" +
+	"\x12allow_self_payment\x18\x0f \x01(\bR\x10allowSelfPayment\x126\n" +
+	"\rdest_features\x18\x10 \x03(\x0e2\x11.lnrpc.FeatureBitR\fdestFeatures\x12\x1b\n" +
+	"\tmax_parts\x18\x11 \x01(\rR\bmaxParts\x12.\n" +
+	"\x13no_inflight_updates\x18\x12 \x01(\bR\x11noInflightUpdates\x12*\n" +
+	"\x11outgoing_chan_ids\x18\x13 \x03(\x04R\x0foutgoingChanIds\x12!\n" +
+	"\fpayment_addr\x18\x14 \x01(\fR\vpaymentAddr\x12-\n" +
+	"\x13max_shard_size_msat\x18\x15 \x01(\x04R\x10maxShardSizeMsat\x12\x10\n" +
+	"\x03amp\x18\x16 \x01(\bR\x03amp\x12\x1b\n" +
+	"\ttime_pref\x18\x17 \x01(\x01R\btimePref\x12\x1e\n" +
+	"\n" +
+	"cancelable\x18\x18 \x01(\bR\n" +
+	"cancelable\x12q\n" +
+	"\x18first_hop_custom_records\x18\x19 \x03(\v28.routerrpc.SendPaymentRequest.FirstHopCustomRecordsEntryR\x15firstHopCustomRecords\x12)\n" +
+	"\x10trampoline_nodes\x18\x1a \x03(\fR\x0ftrampolineNodes\x12;\n" +
+	"\x1atrampoline_fee_budget_msat\x18\x1b \x01(\x03R\x17trampolineFeeBudgetMsat\x124\n" +
+	"\x16trampoline_cltv_budget\x18\x1c \x01(\rR\x14trampolineCltvBudget\x12'\n" +
+	"\x0frequest_receipt\x18\x1d \x01(\bR\x0erequestReceipt\x1aD\n" +
+	"\x16DestCustomRecordsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x04R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\x1aH\n" +
+	"\x1aFirstHopCustomRecordsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x04R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01J\x04\b\b\x10\t\"h\n" +
+	"\x13TrackPaymentRequest\x12!\n" +
+	"\fpayment_hash\x18\x01 \x01(\fR\vpaymentHash\x12.\n" +
+	"\x13no_inflight_updates\x18\x02 \x01(\bR\x11noInflightUpdates\"F\n" +
+	"\x14TrackPaymentsRequest\x12.\n" +
+	"\x13no_inflight_updates\x18\x01 \x01(\bR\x11noInflightUpdates\"\xad\x01\n" +
+	"\x0fRouteFeeRequest\x12\x12\n" +
+	"\x04dest\x18\x01 \x01(\fR\x04dest\x12\x17\n" +
+	"\aamt_sat\x18\x02 \x01(\x03R\x06amtSat\x12'\n" +
+	"\x0fpayment_request\x18\x03 \x01(\tR\x0epaymentRequest\x12\x18\n" +
+	"\atimeout\x18\x04 \x01(\rR\atimeout\x12*\n" +
+	"\x11outgoing_chan_ids\x18\x05 \x03(\x04R\x0foutgoingChanIds\"\xa8\x01\n" +
+	"\x10RouteFeeResponse\x12(\n" +
+	"\x10routing_fee_msat\x18\x01 \x01(\x03R\x0eroutingFeeMsat\x12&\n" +
+	"\x0ftime_lock_delay\x18\x02 \x01(\x03R\rtimeLockDelay\x12B\n" +
+	"\x0efailure_reason\x18\x05 \x01(\x0e2\x1b.lnrpc.PaymentFailureReasonR\rfailureReason\"\xbc\x02\n" +
+	"\x12SendToRouteRequest\x12!\n" +
+	"\fpayment_hash\x18\x01 \x01(\fR\vpaymentHash\x12\"\n" +
+	"\x05route\x18\x02 \x01(\v2\f.lnrpc.RouteR\x05route\x12\"\n" +
+	"\rskip_temp_err\x18\x03 \x01(\bR\vskipTempErr\x12q\n" +
+	"\x18first_hop_custom_records\x18\x04 \x03(\v28.routerrpc.SendToRouteRequest.FirstHopCustomRecordsEntryR\x15firstHopCustomRecords\x1aH\n" +
+	"\x1aFirstHopCustomRecordsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x04R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\x1c\n" +
+	"\x1aResetMissionControlRequest\"\x1d\n" +
+	"\x1bResetMissionControlResponse\"\x1c\n" +
+	"\x1aQueryMissionControlRequest\"Q\n" +
+	"\x1bQueryMissionControlResponse\x12,\n" +
+	"\x05pairs\x18\x02 \x03(\v2\x16.routerrpc.PairHistoryR\x05pairsJ\x04\b\x01\x10\x02\"b\n" +
+	"\x1cXImportMissionControlRequest\x12,\n" +
+	"\x05pairs\x18\x01 \x03(\v2\x16.routerrpc.PairHistoryR\x05pairs\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"\x1f\n" +
+	"\x1dXImportMissionControlResponse\"\x8a\x01\n" +
+	"\vPairHistory\x12\x1b\n" +
+	"\tnode_from\x18\x01 \x01(\fR\bnodeFrom\x12\x17\n" +
+	"\anode_to\x18\x02 \x01(\fR\x06nodeTo\x12-\n" +
+	"\ahistory\x18\a \x01(\v2\x13.routerrpc.PairDataR\ahistoryJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06J\x04\b\x06\x10\a\"\xe8\x01\n" +
+	"\bPairData\x12\x1b\n" +
+	"\tfail_time\x18\x01 \x01(\x03R\bfailTime\x12 \n" +
+	"\ffail_amt_sat\x18\x02 \x01(\x03R\n" +
+	"failAmtSat\x12\"\n" +
+	"\rfail_amt_msat\x18\x04 \x01(\x03R\vfailAmtMsat\x12!\n" +
+	"\fsuccess_time\x18\x05 \x01(\x03R\vsuccessTime\x12&\n" +
+	"\x0fsuccess_amt_sat\x18\x06 \x01(\x03R\rsuccessAmtSat\x12(\n" +
+	"\x10success_amt_msat\x18\a \x01(\x03R\x0esuccessAmtMsatJ\x04\b\x03\x10\x04\" \n" +
+	"\x1eGetMissionControlConfigRequest\"Z\n" +
+	"\x1fGetMissionControlConfigResponse\x127\n" +
+	"\x06config\x18\x01 \x01(\v2\x1f.routerrpc.MissionControlConfigR\x06config\"Y\n" +
+	"\x1eSetMissionControlConfigRequest\x127\n" +
+	"\x06config\x18\x01 \x01(\v2\x1f.routerrpc.MissionControlConfigR\x06config\"!\n" +
+	"\x1fSetMissionControlConfigResponse\"\xd4\x04\n" +
+	"\x14MissionControlConfig\x12.\n" +
+	"\x11half_life_seconds\x18\x01 \x01(\x04B\x02\x18\x01R\x0fhalfLifeSeconds\x12+\n" +
+	"\x0fhop_probability\x18\x02 \x01(\x02B\x02\x18\x01R\x0ehopProbability\x12\x1a\n" +
+	"\x06weight\x18\x03 \x01(\x02B\x02\x18\x01R\x06weight\x126\n" +
+	"\x17maximum_payment_results\x18\x04 \x01(\rR\x15maximumPaymentResults\x12C\n" +
+	"\x1eminimum_failure_relax_interval\x18\x05 \x01(\x04R\x1bminimumFailureRelaxInterval\x12F\n" +
 	"\x05model\x18\x06 \x01(\x0e20.routerrpc.MissionControlConfig.ProbabilityModelR\x05model\x128\n" +
 	"\aapriori\x18\a \x01(\v2\x1c.routerrpc.AprioriParametersH\x00R\aapriori\x128\n" +
-	"\abimodal\x18\b \x01(\v2\x1c.routerrpc.BimodalParametersH\x00R\abimodal\",\n" +
+	"\abimodal\x18\b \x01(\v2\x1c.routerrpc.BimodalParametersH\x00R\abimodal\x12;\n" +
+	"\bexternal\x18\t \x01(\v2\x1d.routerrpc.ExternalParametersH\x00R\bexternal\":\n" +
 	"\x10ProbabilityModel\x12\v\n" +
 	"\aAPRIORI\x10\x00\x12\v\n" +
-	"\aBIMODAL\x10\x01B\x11\n" +
-	"\x0fEstimatorConfig\"r\n" +
+	"\aBIMODAL\x10\x01\x12\f\n" +
+	"\bEXTERNAL\x10\x02B\x11\n" +
+	"\x0fEstimatorConfig\"\x9a\x01\n" +
+	"\x12ExternalParameters\x12 \n" +
+	"\fcache_ttl_ms\x18\x01 \x01(\x04R\n" +
+	"cacheTtlMs\x12(\n" +
+	"\x10query_timeout_ms\x18\x02 \x01(\x04R\x0equeryTimeoutMs\x128\n" +
+	"\bfallback\x18\x03 \x01(\v2\x1c.routerrpc.BimodalParametersR\bfallback\"r\n" +
 	"\x11BimodalParameters\x12\x1f\n" +
 	"\vnode_weight\x18\x01 \x01(\x01R\n" +
 	"nodeWeight\x12\x1d\n" +
@@ -3748,7 +6759,7 @@ const file_routerrpc_router_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"8\n" +
 	"\x12BuildRouteResponse\x12\"\n" +
 	"\x05route\x18\x01 \x01(\v2\f.lnrpc.RouteR\x05route\"\x1c\n" +
-	"\x1aSubscribeHtlcEventsRequest\"\x86\x06\n" +
+	"\x1aSubscribeHtlcEventsRequest\"\xcd\x06\n" +
 	"\tHtlcEvent\x12.\n" +
 	"\x13incoming_channel_id\x18\x01 \x01(\x04R\x11incomingChannelId\x12.\n" +
 	"\x13outgoing_channel_id\x18\x02 \x01(\x04R\x11outgoingChannelId\x12(\n" +
@@ -3763,7 +6774,8 @@ const file_routerrpc_router_proto_rawDesc = "" +
 	"\x0flink_fail_event\x18\n" +
 	" \x01(\v2\x18.routerrpc.LinkFailEventH\x00R\rlinkFailEvent\x12G\n" +
 	"\x10subscribed_event\x18\v \x01(\v2\x1a.routerrpc.SubscribedEventH\x00R\x0fsubscribedEvent\x12E\n" +
-	"\x10final_htlc_event\x18\f \x01(\v2\x19.routerrpc.FinalHtlcEventH\x00R\x0efinalHtlcEvent\"<\n" +
+	"\x10final_htlc_event\x18\f \x01(\v2\x19.routerrpc.FinalHtlcEventH\x00R\x0efinalHtlcEvent\x12E\n" +
+	"\x10stuck_htlc_event\x18\r \x01(\v2\x19.routerrpc.StuckHtlcEventH\x00R\x0estuckHtlcEvent\"<\n" +
 	"\tEventType\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\b\n" +
 	"\x04SEND\x10\x01\x12\v\n" +
@@ -3783,7 +6795,14 @@ const file_routerrpc_router_proto_rawDesc = "" +
 	"\x0eFinalHtlcEvent\x12\x18\n" +
 	"\asettled\x18\x01 \x01(\bR\asettled\x12\x1a\n" +
 	"\boffchain\x18\x02 \x01(\bR\boffchain\"\x11\n" +
-	"\x0fSubscribedEvent\"\xdf\x01\n" +
+	"\x0fSubscribedEvent\"\xb7\x01\n" +
+	"\x0eStuckHtlcEvent\x12'\n" +
+	"\x04info\x18\x01 \x01(\v2\x13.routerrpc.HtlcInfoR\x04info\x12!\n" +
+	"\fpayment_hash\x18\x02 \x01(\fR\vpaymentHash\x12\x1d\n" +
+	"\n" +
+	"attempt_id\x18\x03 \x01(\x04R\tattemptId\x12\x17\n" +
+	"\aage_sec\x18\x04 \x01(\x04R\x06ageSec\x12!\n" +
+	"\fforce_closed\x18\x05 \x01(\bR\vforceClosed\"\xdf\x01\n" +
 	"\rLinkFailEvent\x12'\n" +
 	"\x04info\x18\x01 \x01(\v2\x13.routerrpc.HtlcInfoR\x04info\x12=\n" +
 	"\fwire_failure\x18\x02 \x01(\x0e2\x1a.lnrpc.Failure.FailureCodeR\vwireFailure\x12?\n" +
@@ -3853,7 +6872,210 @@ const file_routerrpc_router_proto_rawDesc = "" +
 	"\x1fDeleteForwardingHistoryResponse\x12%\n" +
 	"\x0eevents_deleted\x18\x01 \x01(\x04R\reventsDeleted\x12$\n" +
 	"\x0etotal_fee_msat\x18\x02 \x01(\x03R\ftotalFeeMsat\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status*\x85\x05\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"R\n" +
+	"\rFeeCurvePoint\x12\x1f\n" +
+	"\vlocal_ratio\x18\x01 \x01(\x01R\n" +
+	"localRatio\x12 \n" +
+	"\ffee_rate_ppm\x18\x02 \x01(\x03R\n" +
+	"feeRatePpm\"\xfc\x02\n" +
+	"\x10DynamicFeeParams\x12\"\n" +
+	"\rbase_fee_msat\x18\x01 \x01(\x04R\vbaseFeeMsat\x12>\n" +
+	"\x0efee_rate_curve\x18\x02 \x03(\v2\x18.routerrpc.FeeCurvePointR\ffeeRateCurve\x121\n" +
+	"\x15inbound_base_fee_msat\x18\x03 \x01(\x05R\x12inboundBaseFeeMsat\x12M\n" +
+	"\x16inbound_fee_rate_curve\x18\x04 \x03(\v2\x18.routerrpc.FeeCurvePointR\x13inboundFeeRateCurve\x12*\n" +
+	"\x11volume_window_sec\x18\x05 \x01(\x04R\x0fvolumeWindowSec\x12-\n" +
+	"\x12volume_sensitivity\x18\x06 \x01(\x01R\x11volumeSensitivity\x12'\n" +
+	"\x10max_fee_rate_ppm\x18\a \x01(\rR\rmaxFeeRatePpm\"\xa2\x01\n" +
+	"\x11FeePolicyOverride\x12\x1d\n" +
+	"\n" +
+	"chan_point\x18\x01 \x01(\tR\tchanPoint\x12\x18\n" +
+	"\aexclude\x18\x02 \x01(\bR\aexclude\x123\n" +
+	"\x06params\x18\x03 \x01(\v2\x1b.routerrpc.DynamicFeeParamsR\x06params\x12\x1f\n" +
+	"\vpeer_pubkey\x18\x04 \x01(\tR\n" +
+	"peerPubkey\"\x99\x04\n" +
+	"\x11FeeRecommendation\x12\x1d\n" +
+	"\n" +
+	"chan_point\x18\x01 \x01(\tR\tchanPoint\x12\x1b\n" +
+	"\achan_id\x18\x02 \x01(\x04B\x020\x01R\x06chanId\x12\x1f\n" +
+	"\vlocal_ratio\x18\x03 \x01(\x01R\n" +
+	"localRatio\x122\n" +
+	"\x15forwarded_volume_msat\x18\x04 \x01(\x04R\x13forwardedVolumeMsat\x121\n" +
+	"\x15current_base_fee_msat\x18\x05 \x01(\x04R\x12currentBaseFeeMsat\x12/\n" +
+	"\x14current_fee_rate_ppm\x18\x06 \x01(\rR\x11currentFeeRatePpm\x12A\n" +
+	"\x13current_inbound_fee\x18\a \x01(\v2\x11.lnrpc.InboundFeeR\x11currentInboundFee\x12\"\n" +
+	"\rbase_fee_msat\x18\b \x01(\x04R\vbaseFeeMsat\x12 \n" +
+	"\ffee_rate_ppm\x18\t \x01(\rR\n" +
+	"feeRatePpm\x122\n" +
+	"\vinbound_fee\x18\n" +
+	" \x01(\v2\x11.lnrpc.InboundFeeR\n" +
+	"inboundFee\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\v \x01(\bR\n" +
+	"overridden\x12\x18\n" +
+	"\aapplied\x18\f \x01(\bR\aapplied\x12\x18\n" +
+	"\afailure\x18\r \x01(\tR\afailure\"\x1c\n" +
+	"\x1aGetDynamicFeePolicyRequest\"\xe1\x02\n" +
+	"\x1bGetDynamicFeePolicyResponse\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\x12!\n" +
+	"\finterval_sec\x18\x02 \x01(\x04R\vintervalSec\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x123\n" +
+	"\x06params\x18\x04 \x01(\v2\x1b.routerrpc.DynamicFeeParamsR\x06params\x12:\n" +
+	"\toverrides\x18\x05 \x03(\v2\x1c.routerrpc.FeePolicyOverrideR\toverrides\x12,\n" +
+	"\x12last_run_timestamp\x18\x06 \x01(\x03R\x10lastRunTimestamp\x12O\n" +
+	"\x14last_recommendations\x18\a \x03(\v2\x1c.routerrpc.FeeRecommendationR\x13lastRecommendations\"j\n" +
+	"\x1aSetDynamicFeePolicyRequest\x123\n" +
+	"\x06params\x18\x01 \x01(\v2\x1b.routerrpc.DynamicFeeParamsR\x06params\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\x1d\n" +
+	"\x1bSetDynamicFeePolicyResponse\"\xd9\x01\n" +
+	"\x1bSetFeePolicyOverrideRequest\x122\n" +
+	"\n" +
+	"chan_point\x18\x01 \x01(\v2\x13.lnrpc.ChannelPointR\tchanPoint\x12\x18\n" +
+	"\aexclude\x18\x02 \x01(\bR\aexclude\x123\n" +
+	"\x06params\x18\x03 \x01(\v2\x1b.routerrpc.DynamicFeeParamsR\x06params\x12\x16\n" +
+	"\x06remove\x18\x04 \x01(\bR\x06remove\x12\x1f\n" +
+	"\vpeer_pubkey\x18\x05 \x01(\fR\n" +
+	"peerPubkey\"\x1e\n" +
+	"\x1cSetFeePolicyOverrideResponse\"/\n" +
+	"\x14RecomputeFeesRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\"_\n" +
+	"\x15RecomputeFeesResponse\x12F\n" +
+	"\x0frecommendations\x18\x01 \x03(\v2\x1c.routerrpc.FeeRecommendationR\x0frecommendations\"\xb7\x02\n" +
+	"\x15StartRebalanceRequest\x12*\n" +
+	"\x0fsource_chan_ids\x18\x01 \x03(\x04B\x020\x01R\rsourceChanIds\x12*\n" +
+	"\x0ftarget_chan_ids\x18\x02 \x03(\x04B\x020\x01R\rtargetChanIds\x12\x17\n" +
+	"\aamt_sat\x18\x03 \x01(\x03R\x06amtSat\x12\x1e\n" +
+	"\vmax_fee_sat\x18\x04 \x01(\x03R\tmaxFeeSat\x12'\n" +
+	"\x10max_fee_rate_ppm\x18\x05 \x01(\rR\rmaxFeeRatePpm\x12\x1e\n" +
+	"\vmin_amt_sat\x18\x06 \x01(\x03R\tminAmtSat\x12\x1b\n" +
+	"\tmax_parts\x18\a \x01(\rR\bmaxParts\x12'\n" +
+	"\x0ftimeout_seconds\x18\b \x01(\x05R\x0etimeoutSeconds\"(\n" +
+	"\x16StartRebalanceResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"8\n" +
+	"\x15ListRebalancesRequest\x12\x1f\n" +
+	"\vactive_only\x18\x01 \x01(\bR\n" +
+	"activeOnly\"N\n" +
+	"\x16ListRebalancesResponse\x124\n" +
+	"\n" +
+	"rebalances\x18\x01 \x03(\v2\x14.routerrpc.RebalanceR\n" +
+	"rebalances\"\xc1\x03\n" +
+	"\tRebalance\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12#\n" +
+	"\rcreation_time\x18\x02 \x01(\x03R\fcreationTime\x12*\n" +
+	"\x0fsource_chan_ids\x18\x03 \x03(\x04B\x020\x01R\rsourceChanIds\x12*\n" +
+	"\x0ftarget_chan_ids\x18\x04 \x03(\x04B\x020\x01R\rtargetChanIds\x12\x19\n" +
+	"\bamt_msat\x18\x05 \x01(\x04R\aamtMsat\x12$\n" +
+	"\x0efee_limit_msat\x18\x06 \x01(\x04R\ffeeLimitMsat\x122\n" +
+	"\x06status\x18\a \x01(\x0e2\x1a.routerrpc.RebalanceStatusR\x06status\x12.\n" +
+	"\x13amt_rebalanced_msat\x18\b \x01(\x04R\x11amtRebalancedMsat\x12\"\n" +
+	"\rfee_paid_msat\x18\t \x01(\x04R\vfeePaidMsat\x127\n" +
+	"\battempts\x18\n" +
+	" \x03(\v2\x1b.routerrpc.RebalanceAttemptR\battempts\x12%\n" +
+	"\x0efailure_reason\x18\v \x01(\tR\rfailureReason\"\xcb\x01\n" +
+	"\x10RebalanceAttempt\x12!\n" +
+	"\fpayment_hash\x18\x01 \x01(\fR\vpaymentHash\x12&\n" +
+	"\x0flast_hop_pubkey\x18\x02 \x01(\fR\rlastHopPubkey\x12\x19\n" +
+	"\bamt_msat\x18\x03 \x01(\x04R\aamtMsat\x12\x19\n" +
+	"\bfee_msat\x18\x04 \x01(\x04R\afeeMsat\x12\x1c\n" +
+	"\tsucceeded\x18\x05 \x01(\bR\tsucceeded\x12\x18\n" +
+	"\afailure\x18\x06 \x01(\tR\afailure\"\xf3\x01\n" +
+	"\x1aProbabilityEstimateRequest\x12\x19\n" +
+	"\bquery_id\x18\x01 \x01(\x04R\aqueryId\x12\x1b\n" +
+	"\tfrom_node\x18\x02 \x01(\fR\bfromNode\x12\x17\n" +
+	"\ato_node\x18\x03 \x01(\fR\x06toNode\x12\x19\n" +
+	"\bamt_msat\x18\x04 \x01(\x04R\aamtMsat\x12!\n" +
+	"\fcapacity_sat\x18\x05 \x01(\x03R\vcapacitySat\x12\x14\n" +
+	"\x05local\x18\x06 \x01(\bR\x05local\x120\n" +
+	"\aresults\x18\a \x03(\v2\x16.routerrpc.PairHistoryR\aresults\"R\n" +
+	"\x13ProbabilityEstimate\x12\x19\n" +
+	"\bquery_id\x18\x01 \x01(\x04R\aqueryId\x12 \n" +
+	"\vprobability\x18\x02 \x01(\x01R\vprobability\",\n" +
+	"\x16GetLiquidityMapRequest\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\fR\x04node\"}\n" +
+	"\x17GetLiquidityMapResponse\x121\n" +
+	"\x06bounds\x18\x01 \x03(\v2\x19.routerrpc.LiquidityBoundR\x06bounds\x12/\n" +
+	"\x06prober\x18\x02 \x01(\v2\x17.routerrpc.ProberStatusR\x06prober\"\xfe\x01\n" +
+	"\x0eLiquidityBound\x12\x1b\n" +
+	"\tnode_from\x18\x01 \x01(\fR\bnodeFrom\x12\x17\n" +
+	"\anode_to\x18\x02 \x01(\fR\x06nodeTo\x12,\n" +
+	"\x12min_liquidity_msat\x18\x03 \x01(\x04R\x10minLiquidityMsat\x121\n" +
+	"\x12max_liquidity_msat\x18\x04 \x01(\x04H\x00R\x10maxLiquidityMsat\x88\x01\x01\x12!\n" +
+	"\fsuccess_time\x18\x05 \x01(\x03R\vsuccessTime\x12\x1b\n" +
+	"\tfail_time\x18\x06 \x01(\x03R\bfailTimeB\x15\n" +
+	"\x13_max_liquidity_msat\"\x8d\x02\n" +
+	"\fProberStatus\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\x120\n" +
+	"\atargets\x18\x02 \x03(\v2\x16.routerrpc.ProbeTargetR\atargets\x12\x1f\n" +
+	"\vlocked_msat\x18\x03 \x01(\x04R\n" +
+	"lockedMsat\x12\x1f\n" +
+	"\vprobes_sent\x18\x04 \x01(\x04R\n" +
+	"probesSent\x12%\n" +
+	"\x0eprobes_reached\x18\x05 \x01(\x04R\rprobesReached\x12#\n" +
+	"\rprobes_failed\x18\x06 \x01(\x04R\fprobesFailed\x12%\n" +
+	"\x0eprobes_skipped\x18\a \x01(\x04R\rprobesSkipped\"I\n" +
+	"\vProbeTarget\x12\x16\n" +
+	"\x06pubkey\x18\x01 \x01(\fR\x06pubkey\x12\"\n" +
+	"\rnext_amt_msat\x18\x02 \x01(\x04R\vnextAmtMsat\"\xcf\x01\n" +
+	"\x17SendPaymentBatchRequest\x129\n" +
+	"\bpayments\x18\x01 \x03(\v2\x1d.routerrpc.SendPaymentRequestR\bpayments\x12!\n" +
+	"\fmax_parallel\x18\x02 \x01(\rR\vmaxParallel\x12&\n" +
+	"\x0ffee_budget_msat\x18\x03 \x01(\x03R\rfeeBudgetMsat\x12.\n" +
+	"\x13no_inflight_updates\x18\x04 \x01(\bR\x11noInflightUpdates\"\x95\x01\n" +
+	"\x12PaymentBatchUpdate\x129\n" +
+	"\apayment\x18\x01 \x01(\v2\x1d.routerrpc.BatchPaymentStatusH\x00R\apayment\x12:\n" +
+	"\asummary\x18\x02 \x01(\v2\x1e.routerrpc.PaymentBatchSummaryH\x00R\asummaryB\b\n" +
+	"\x06update\"j\n" +
+	"\x12BatchPaymentStatus\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x12(\n" +
+	"\apayment\x18\x02 \x01(\v2\x0e.lnrpc.PaymentR\apayment\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xc2\x01\n" +
+	"\x13PaymentBatchSummary\x12#\n" +
+	"\rnum_succeeded\x18\x01 \x01(\rR\fnumSucceeded\x12\x1d\n" +
+	"\n" +
+	"num_failed\x18\x02 \x01(\rR\tnumFailed\x12\x1f\n" +
+	"\vnum_skipped\x18\x03 \x01(\rR\n" +
+	"numSkipped\x12\"\n" +
+	"\ramt_paid_msat\x18\x04 \x01(\x03R\vamtPaidMsat\x12\"\n" +
+	"\rfee_paid_msat\x18\x05 \x01(\x03R\vfeePaidMsat\"9\n" +
+	"\x14CancelPaymentRequest\x12!\n" +
+	"\fpayment_hash\x18\x01 \x01(\fR\vpaymentHash\"\x17\n" +
+	"\x15CancelPaymentResponse\"\x19\n" +
+	"\x17GetResourceStatsRequest\"\xa7\x02\n" +
+	"\x18GetResourceStatsResponse\x12+\n" +
+	"\x11bucketing_enabled\x18\x01 \x01(\bR\x10bucketingEnabled\x12/\n" +
+	"\x05peers\x18\x02 \x03(\v2\x19.routerrpc.PeerReputationR\x05peers\x127\n" +
+	"\bchannels\x18\x03 \x03(\v2\x1b.routerrpc.ChannelResourcesR\bchannels\x12)\n" +
+	"\x10general_forwards\x18\x04 \x01(\x04R\x0fgeneralForwards\x12-\n" +
+	"\x12protected_forwards\x18\x05 \x01(\x04R\x11protectedForwards\x12\x1a\n" +
+	"\brejected\x18\x06 \x01(\x04R\brejected\"\xa5\x01\n" +
+	"\x0ePeerReputation\x12\x17\n" +
+	"\apub_key\x18\x01 \x01(\fR\x06pubKey\x12'\n" +
+	"\x0freputation_msat\x18\x02 \x01(\x03R\x0ereputationMsat\x12-\n" +
+	"\x13in_flight_risk_msat\x18\x03 \x01(\x04R\x10inFlightRiskMsat\x12\"\n" +
+	"\rnum_in_flight\x18\x04 \x01(\rR\vnumInFlight\"\xee\x03\n" +
+	"\x10ChannelResources\x12\x17\n" +
+	"\achan_id\x18\x01 \x01(\x04R\x06chanId\x12!\n" +
+	"\frevenue_msat\x18\x02 \x01(\x04R\vrevenueMsat\x12#\n" +
+	"\rgeneral_slots\x18\x03 \x01(\rR\fgeneralSlots\x12,\n" +
+	"\x12general_slots_used\x18\x04 \x01(\rR\x10generalSlotsUsed\x124\n" +
+	"\x16general_liquidity_msat\x18\x05 \x01(\x04R\x14generalLiquidityMsat\x12=\n" +
+	"\x1bgeneral_liquidity_used_msat\x18\x06 \x01(\x04R\x18generalLiquidityUsedMsat\x12'\n" +
+	"\x0fprotected_slots\x18\a \x01(\rR\x0eprotectedSlots\x120\n" +
+	"\x14protected_slots_used\x18\b \x01(\rR\x12protectedSlotsUsed\x128\n" +
+	"\x18protected_liquidity_msat\x18\t \x01(\x04R\x16protectedLiquidityMsat\x12A\n" +
+	"\x1dprotected_liquidity_used_msat\x18\n" +
+	" \x01(\x04R\x1aprotectedLiquidityUsedMsat\"\xb0\x01\n" +
+	"\x14SetPeerPolicyRequest\x12\x12\n" +
+	"\x04peer\x18\x01 \x01(\fR\x04peer\x12\x1b\n" +
+	"\tmax_htlcs\x18\x02 \x01(\rR\bmaxHtlcs\x12+\n" +
+	"\x12max_in_flight_msat\x18\x03 \x01(\x04R\x0fmaxInFlightMsat\x12\"\n" +
+	"\rmin_htlc_msat\x18\x04 \x01(\x04R\vminHtlcMsat\x12\x16\n" +
+	"\x06remove\x18\x05 \x01(\bR\x06remove\"\xcf\x01\n" +
+	"\x15SetPeerPolicyResponse\x12\x1b\n" +
+	"\tmax_htlcs\x18\x01 \x01(\rR\bmaxHtlcs\x12+\n" +
+	"\x12max_in_flight_msat\x18\x02 \x01(\x04R\x0fmaxInFlightMsat\x12\"\n" +
+	"\rmin_htlc_msat\x18\x03 \x01(\x04R\vminHtlcMsat\x12\"\n" +
+	"\rnum_in_flight\x18\x04 \x01(\rR\vnumInFlight\x12$\n" +
+	"\x0ein_flight_msat\x18\x05 \x01(\x04R\finFlightMsat*\x98\x06\n" +
 	"\rFailureDetail\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\r\n" +
 	"\tNO_DETAIL\x10\x01\x12\x10\n" +
@@ -3883,7 +7105,12 @@ const file_routerrpc_router_proto_rawDesc = "" +
 	"\x1aHTLC_INVOICE_TYPE_MISMATCH\x10\x18\x12\r\n" +
 	"\tAMP_ERROR\x10\x19\x12\x16\n" +
 	"\x12AMP_RECONSTRUCTION\x10\x1a\x12\x1e\n" +
-	"\x1aEXTERNAL_VALIDATION_FAILED\x10\x1b*Q\n" +
+	"\x1aEXTERNAL_VALIDATION_FAILED\x10\x1b\x12\x1d\n" +
+	"\x19TRAMPOLINE_FORWARD_FAILED\x10\x1c\x12\x17\n" +
+	"\x13RESOURCES_EXHAUSTED\x10\x1d\x12\x1b\n" +
+	"\x17PEER_HTLC_BELOW_MINIMUM\x10\x1e\x12\x1b\n" +
+	"\x17PEER_MAX_HTLCS_EXCEEDED\x10\x1f\x12\x1f\n" +
+	"\x1bPEER_MAX_IN_FLIGHT_EXCEEDED\x10 *Q\n" +
 	"\x18ResolveHoldForwardAction\x12\n" +
 	"\n" +
 	"\x06SETTLE\x10\x00\x12\b\n" +
@@ -3895,7 +7122,12 @@ const file_routerrpc_router_proto_rawDesc = "" +
 	"\n" +
 	"\x06ENABLE\x10\x00\x12\v\n" +
 	"\aDISABLE\x10\x01\x12\b\n" +
-	"\x04AUTO\x10\x022\xc5\r\n" +
+	"\x04AUTO\x10\x02*~\n" +
+	"\x0fRebalanceStatus\x12\x19\n" +
+	"\x15REBALANCE_IN_PROGRESS\x10\x00\x12\x17\n" +
+	"\x13REBALANCE_SUCCEEDED\x10\x01\x12!\n" +
+	"\x1dREBALANCE_PARTIALLY_SUCCEEDED\x10\x02\x12\x14\n" +
+	"\x10REBALANCE_FAILED\x10\x032\x94\x16\n" +
 	"\x06Router\x12@\n" +
 	"\rSendPaymentV2\x12\x1d.routerrpc.SendPaymentRequest\x1a\x0e.lnrpc.Payment0\x01\x12B\n" +
 	"\x0eTrackPaymentV2\x12\x1e.routerrpc.TrackPaymentRequest\x1a\x0e.lnrpc.Payment0\x01\x12B\n" +
@@ -3916,7 +7148,19 @@ const file_routerrpc_router_proto_rawDesc = "" +
 	"\x14XAddLocalChanAliases\x12\x1c.routerrpc.AddAliasesRequest\x1a\x1d.routerrpc.AddAliasesResponse\x12\\\n" +
 	"\x17XDeleteLocalChanAliases\x12\x1f.routerrpc.DeleteAliasesRequest\x1a .routerrpc.DeleteAliasesResponse\x12\\\n" +
 	"\x17XFindBaseLocalChanAlias\x12\x1f.routerrpc.FindBaseAliasRequest\x1a .routerrpc.FindBaseAliasResponse\x12p\n" +
-	"\x17DeleteForwardingHistory\x12).routerrpc.DeleteForwardingHistoryRequest\x1a*.routerrpc.DeleteForwardingHistoryResponseB1Z/github.com/lightningnetwork/lnd/lnrpc/routerrpcb\x06proto3"
+	"\x17DeleteForwardingHistory\x12).routerrpc.DeleteForwardingHistoryRequest\x1a*.routerrpc.DeleteForwardingHistoryResponse\x12d\n" +
+	"\x13GetDynamicFeePolicy\x12%.routerrpc.GetDynamicFeePolicyRequest\x1a&.routerrpc.GetDynamicFeePolicyResponse\x12d\n" +
+	"\x13SetDynamicFeePolicy\x12%.routerrpc.SetDynamicFeePolicyRequest\x1a&.routerrpc.SetDynamicFeePolicyResponse\x12g\n" +
+	"\x14SetFeePolicyOverride\x12&.routerrpc.SetFeePolicyOverrideRequest\x1a'.routerrpc.SetFeePolicyOverrideResponse\x12R\n" +
+	"\rRecomputeFees\x12\x1f.routerrpc.RecomputeFeesRequest\x1a .routerrpc.RecomputeFeesResponse\x12U\n" +
+	"\x0eStartRebalance\x12 .routerrpc.StartRebalanceRequest\x1a!.routerrpc.StartRebalanceResponse\x12U\n" +
+	"\x0eListRebalances\x12 .routerrpc.ListRebalancesRequest\x1a!.routerrpc.ListRebalancesResponse\x12^\n" +
+	"\x11ExternalEstimator\x12\x1e.routerrpc.ProbabilityEstimate\x1a%.routerrpc.ProbabilityEstimateRequest(\x010\x01\x12X\n" +
+	"\x0fGetLiquidityMap\x12!.routerrpc.GetLiquidityMapRequest\x1a\".routerrpc.GetLiquidityMapResponse\x12W\n" +
+	"\x10SendPaymentBatch\x12\".routerrpc.SendPaymentBatchRequest\x1a\x1d.routerrpc.PaymentBatchUpdate0\x01\x12R\n" +
+	"\rCancelPayment\x12\x1f.routerrpc.CancelPaymentRequest\x1a .routerrpc.CancelPaymentResponse\x12[\n" +
+	"\x10GetResourceStats\x12\".routerrpc.GetResourceStatsRequest\x1a#.routerrpc.GetResourceStatsResponse\x12R\n" +
+	"\rSetPeerPolicy\x12\x1f.routerrpc.SetPeerPolicyRequest\x1a .routerrpc.SetPeerPolicyResponseB1Z/github.com/lightningnetwork/lnd/lnrpc/routerrpcb\x06proto3"
 
 var (
 	file_routerrpc_router_proto_rawDescOnce sync.Once
@@ -3930,164 +7174,258 @@ func file_routerrpc_router_proto_rawDescGZIP() []byte {
 	return file_routerrpc_router_proto_rawDescData
 }
 
-var file_routerrpc_router_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_routerrpc_router_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
+var file_routerrpc_router_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_routerrpc_router_proto_msgTypes = make([]protoimpl.MessageInfo, 93)
 var file_routerrpc_router_proto_goTypes = []any{
 	(FailureDetail)(0),                         // 0: routerrpc.FailureDetail
 	(ResolveHoldForwardAction)(0),              // 1: routerrpc.ResolveHoldForwardAction
 	(ChanStatusAction)(0),                      // 2: routerrpc.ChanStatusAction
-	(MissionControlConfig_ProbabilityModel)(0), // 3: routerrpc.MissionControlConfig.ProbabilityModel
-	(HtlcEvent_EventType)(0),                   // 4: routerrpc.HtlcEvent.EventType
-	(*SendPaymentRequest)(nil),                 // 5: routerrpc.SendPaymentRequest
-	(*TrackPaymentRequest)(nil),                // 6: routerrpc.TrackPaymentRequest
-	(*TrackPaymentsRequest)(nil),               // 7: routerrpc.TrackPaymentsRequest
-	(*RouteFeeRequest)(nil),                    // 8: routerrpc.RouteFeeRequest
-	(*RouteFeeResponse)(nil),                   // 9: routerrpc.RouteFeeResponse
-	(*SendToRouteRequest)(nil),                 // 10: routerrpc.SendToRouteRequest
-	(*ResetMissionControlRequest)(nil),         // 11: routerrpc.ResetMissionControlRequest
-	(*ResetMissionControlResponse)(nil),        // 12: routerrpc.ResetMissionControlResponse
-	(*QueryMissionControlRequest)(nil),         // 13: routerrpc.QueryMissionControlRequest
-	(*QueryMissionControlResponse)(nil),        // 14: routerrpc.QueryMissionControlResponse
-	(*XImportMissionControlRequest)(nil),       // 15: routerrpc.XImportMissionControlRequest
-	(*XImportMissionControlResponse)(nil),      // 16: routerrpc.XImportMissionControlResponse
-	(*PairHistory)(nil),                        // 17: routerrpc.PairHistory
-	(*PairData)(nil),                           // 18: routerrpc.PairData
-	(*GetMissionControlConfigRequest)(nil),     // 19: routerrpc.GetMissionControlConfigRequest
-	(*GetMissionControlConfigResponse)(nil),    // 20: routerrpc.GetMissionControlConfigResponse
-	(*SetMissionControlConfigRequest)(nil),     // 21: routerrpc.SetMissionControlConfigRequest
-	(*SetMissionControlConfigResponse)(nil),    // 22: routerrpc.SetMissionControlConfigResponse
-	(*MissionControlConfig)(nil),               // 23: routerrpc.MissionControlConfig
-	(*BimodalParameters)(nil),                  // 24: routerrpc.BimodalParameters
-	(*AprioriParameters)(nil),                  // 25: routerrpc.AprioriParameters
-	(*QueryProbabilityRequest)(nil),            // 26: routerrpc.QueryProbabilityRequest
-	(*QueryProbabilityResponse)(nil),           // 27: routerrpc.QueryProbabilityResponse
-	(*BuildRouteRequest)(nil),                  // 28: routerrpc.BuildRouteRequest
-	(*BuildRouteResponse)(nil),                 // 29: routerrpc.BuildRouteResponse
-	(*SubscribeHtlcEventsRequest)(nil),         // 30: routerrpc.SubscribeHtlcEventsRequest
-	(*HtlcEvent)(nil),                          // 31: routerrpc.HtlcEvent
-	(*HtlcInfo)(nil),                           // 32: routerrpc.HtlcInfo
-	(*ForwardEvent)(nil),                       // 33: routerrpc.ForwardEvent
-	(*ForwardFailEvent)(nil),                   // 34: routerrpc.ForwardFailEvent
-	(*SettleEvent)(nil),                        // 35: routerrpc.SettleEvent
-	(*FinalHtlcEvent)(nil),                     // 36: routerrpc.FinalHtlcEvent
-	(*SubscribedEvent)(nil),                    // 37: routerrpc.SubscribedEvent
-	(*LinkFailEvent)(nil),                      // 38: routerrpc.LinkFailEvent
-	(*CircuitKey)(nil),                         // 39: routerrpc.CircuitKey
-	(*ForwardHtlcInterceptRequest)(nil),        // 40: routerrpc.ForwardHtlcInterceptRequest
-	(*ForwardHtlcInterceptResponse)(nil),       // 41: routerrpc.ForwardHtlcInterceptResponse
-	(*UpdateChanStatusRequest)(nil),            // 42: routerrpc.UpdateChanStatusRequest
-	(*UpdateChanStatusResponse)(nil),           // 43: routerrpc.UpdateChanStatusResponse
-	(*AddAliasesRequest)(nil),                  // 44: routerrpc.AddAliasesRequest
-	(*AddAliasesResponse)(nil),                 // 45: routerrpc.AddAliasesResponse
-	(*DeleteAliasesRequest)(nil),               // 46: routerrpc.DeleteAliasesRequest
-	(*DeleteAliasesResponse)(nil),              // 47: routerrpc.DeleteAliasesResponse
-	(*FindBaseAliasRequest)(nil),               // 48: routerrpc.FindBaseAliasRequest
-	(*FindBaseAliasResponse)(nil),              // 49: routerrpc.FindBaseAliasResponse
-	(*DeleteForwardingHistoryRequest)(nil),     // 50: routerrpc.DeleteForwardingHistoryRequest
-	(*DeleteForwardingHistoryResponse)(nil),    // 51: routerrpc.DeleteForwardingHistoryResponse
-	nil,                                        // 52: routerrpc.SendPaymentRequest.DestCustomRecordsEntry
-	nil,                                        // 53: routerrpc.SendPaymentRequest.FirstHopCustomRecordsEntry
-	nil,                                        // 54: routerrpc.SendToRouteRequest.FirstHopCustomRecordsEntry
-	nil,                                        // 55: routerrpc.BuildRouteRequest.FirstHopCustomRecordsEntry
-	nil,                                        // 56: routerrpc.ForwardHtlcInterceptRequest.CustomRecordsEntry
-	nil,                                        // 57: routerrpc.ForwardHtlcInterceptRequest.InWireCustomRecordsEntry
-	nil,                                        // 58: routerrpc.ForwardHtlcInterceptResponse.OutWireCustomRecordsEntry
-	(*lnrpc.RouteHint)(nil),                    // 59: lnrpc.RouteHint
-	(lnrpc.FeatureBit)(0),                      // 60: lnrpc.FeatureBit
-	(lnrpc.PaymentFailureReason)(0),            // 61: lnrpc.PaymentFailureReason
-	(*lnrpc.Route)(nil),                        // 62: lnrpc.Route
-	(lnrpc.Failure_FailureCode)(0),             // 63: lnrpc.Failure.FailureCode
-	(*lnrpc.ChannelPoint)(nil),                 // 64: lnrpc.ChannelPoint
-	(*lnrpc.AliasMap)(nil),                     // 65: lnrpc.AliasMap
-	(*lnrpc.Payment)(nil),                      // 66: lnrpc.Payment
-	(*lnrpc.HTLCAttempt)(nil),                  // 67: lnrpc.HTLCAttempt
+	(RebalanceStatus)(0),                       // 3: routerrpc.RebalanceStatus
+	(MissionControlConfig_ProbabilityModel)(0), // 4: routerrpc.MissionControlConfig.ProbabilityModel
+	(HtlcEvent_EventType)(0),                   // 5: routerrpc.HtlcEvent.EventType
+	(*SendPaymentRequest)(nil),                 // 6: routerrpc.SendPaymentRequest
+	(*TrackPaymentRequest)(nil),                // 7: routerrpc.TrackPaymentRequest
+	(*TrackPaymentsRequest)(nil),               // 8: routerrpc.TrackPaymentsRequest
+	(*RouteFeeRequest)(nil),                    // 9: routerrpc.RouteFeeRequest
+	(*RouteFeeResponse)(nil),                   // 10: routerrpc.RouteFeeResponse
+	(*SendToRouteRequest)(nil),                 // 11: routerrpc.SendToRouteRequest
+	(*ResetMissionControlRequest)(nil),         // 12: routerrpc.ResetMissionControlRequest
+	(*ResetMissionControlResponse)(nil),        // 13: routerrpc.ResetMissionControlResponse
+	(*QueryMissionControlRequest)(nil),         // 14: routerrpc.QueryMissionControlRequest
+	(*QueryMissionControlResponse)(nil),        // 15: routerrpc.QueryMissionControlResponse
+	(*XImportMissionControlRequest)(nil),       // 16: routerrpc.XImportMissionControlRequest
+	(*XImportMissionControlResponse)(nil),      // 17: routerrpc.XImportMissionControlResponse
+	(*PairHistory)(nil),                        // 18: routerrpc.PairHistory
+	(*PairData)(nil),                           // 19: routerrpc.PairData
+	(*GetMissionControlConfigRequest)(nil),     // 20: routerrpc.GetMissionControlConfigRequest
+	(*GetMissionControlConfigResponse)(nil),    // 21: routerrpc.GetMissionControlConfigResponse
+	(*SetMissionControlConfigRequest)(nil),     // 22: routerrpc.SetMissionControlConfigRequest
+	(*SetMissionControlConfigResponse)(nil),    // 23: routerrpc.SetMissionControlConfigResponse
+	(*MissionControlConfig)(nil),               // 24: routerrpc.MissionControlConfig
+	(*ExternalParameters)(nil),                 // 25: routerrpc.ExternalParameters
+	(*BimodalParameters)(nil),                  // 26: routerrpc.BimodalParameters
+	(*AprioriParameters)(nil),                  // 27: routerrpc.AprioriParameters
+	(*QueryProbabilityRequest)(nil),            // 28: routerrpc.QueryProbabilityRequest
+	(*QueryProbabilityResponse)(nil),           // 29: routerrpc.QueryProbabilityResponse
+	(*BuildRouteRequest)(nil),                  // 30: routerrpc.BuildRouteRequest
+	(*BuildRouteResponse)(nil),                 // 31: routerrpc.BuildRouteResponse
+	(*SubscribeHtlcEventsRequest)(nil),         // 32: routerrpc.SubscribeHtlcEventsRequest
+	(*HtlcEvent)(nil),                          // 33: routerrpc.HtlcEvent
+	(*HtlcInfo)(nil),                           // 34: routerrpc.HtlcInfo
+	(*ForwardEvent)(nil),                       // 35: routerrpc.ForwardEvent
+	(*ForwardFailEvent)(nil),                   // 36: routerrpc.ForwardFailEvent
+	(*SettleEvent)(nil),                        // 37: routerrpc.SettleEvent
+	(*FinalHtlcEvent)(nil),                     // 38: routerrpc.FinalHtlcEvent
+	(*SubscribedEvent)(nil),                    // 39: routerrpc.SubscribedEvent
+	(*StuckHtlcEvent)(nil),                     // 40: routerrpc.StuckHtlcEvent
+	(*LinkFailEvent)(nil),                      // 41: routerrpc.LinkFailEvent
+	(*CircuitKey)(nil),                         // 42: routerrpc.CircuitKey
+	(*ForwardHtlcInterceptRequest)(nil),        // 43: routerrpc.ForwardHtlcInterceptRequest
+	(*ForwardHtlcInterceptResponse)(nil),       // 44: routerrpc.ForwardHtlcInterceptResponse
+	(*UpdateChanStatusRequest)(nil),            // 45: routerrpc.UpdateChanStatusRequest
+	(*UpdateChanStatusResponse)(nil),           // 46: routerrpc.UpdateChanStatusResponse
+	(*AddAliasesRequest)(nil),                  // 47: routerrpc.AddAliasesRequest
+	(*AddAliasesResponse)(nil),                 // 48: routerrpc.AddAliasesResponse
+	(*DeleteAliasesRequest)(nil),               // 49: routerrpc.DeleteAliasesRequest
+	(*DeleteAliasesResponse)(nil),              // 50: routerrpc.DeleteAliasesResponse
+	(*FindBaseAliasRequest)(nil),               // 51: routerrpc.FindBaseAliasRequest
+	(*FindBaseAliasResponse)(nil),              // 52: routerrpc.FindBaseAliasResponse
+	(*DeleteForwardingHistoryRequest)(nil),     // 53: routerrpc.DeleteForwardingHistoryRequest
+	(*DeleteForwardingHistoryResponse)(nil),    // 54: routerrpc.DeleteForwardingHistoryResponse
+	(*FeeCurvePoint)(nil),                      // 55: routerrpc.FeeCurvePoint
+	(*DynamicFeeParams)(nil),                   // 56: routerrpc.DynamicFeeParams
+	(*FeePolicyOverride)(nil),                  // 57: routerrpc.FeePolicyOverride
+	(*FeeRecommendation)(nil),                  // 58: routerrpc.FeeRecommendation
+	(*GetDynamicFeePolicyRequest)(nil),         // 59: routerrpc.GetDynamicFeePolicyRequest
+	(*GetDynamicFeePolicyResponse)(nil),        // 60: routerrpc.GetDynamicFeePolicyResponse
+	(*SetDynamicFeePolicyRequest)(nil),         // 61: routerrpc.SetDynamicFeePolicyRequest
+	(*SetDynamicFeePolicyResponse)(nil),        // 62: routerrpc.SetDynamicFeePolicyResponse
+	(*SetFeePolicyOverrideRequest)(nil),        // 63: routerrpc.SetFeePolicyOverrideRequest
+	(*SetFeePolicyOverrideResponse)(nil),       // 64: routerrpc.SetFeePolicyOverrideResponse
+	(*RecomputeFeesRequest)(nil),               // 65: routerrpc.RecomputeFeesRequest
+	(*RecomputeFeesResponse)(nil),              // 66: routerrpc.RecomputeFeesResponse
+	(*StartRebalanceRequest)(nil),              // 67: routerrpc.StartRebalanceRequest
+	(*StartRebalanceResponse)(nil),             // 68: routerrpc.StartRebalanceResponse
+	(*ListRebalancesRequest)(nil),              // 69: routerrpc.ListRebalancesRequest
+	(*ListRebalancesResponse)(nil),             // 70: routerrpc.ListRebalancesResponse
+	(*Rebalance)(nil),                          // 71: routerrpc.Rebalance
+	(*RebalanceAttempt)(nil),                   // 72: routerrpc.RebalanceAttempt
+	(*ProbabilityEstimateRequest)(nil),         // 73: routerrpc.ProbabilityEstimateRequest
+	(*ProbabilityEstimate)(nil),                // 74: routerrpc.ProbabilityEstimate
+	(*GetLiquidityMapRequest)(nil),             // 75: routerrpc.GetLiquidityMapRequest
+	(*GetLiquidityMapResponse)(nil),            // 76: routerrpc.GetLiquidityMapResponse
+	(*LiquidityBound)(nil),                     // 77: routerrpc.LiquidityBound
+	(*ProberStatus)(nil),                       // 78: routerrpc.ProberStatus
+	(*ProbeTarget)(nil),                        // 79: routerrpc.ProbeTarget
+	(*SendPaymentBatchRequest)(nil),            // 80: routerrpc.SendPaymentBatchRequest
+	(*PaymentBatchUpdate)(nil),                 // 81: routerrpc.PaymentBatchUpdate
+	(*BatchPaymentStatus)(nil),                 // 82: routerrpc.BatchPaymentStatus
+	(*PaymentBatchSummary)(nil),                // 83: routerrpc.PaymentBatchSummary
+	(*CancelPaymentRequest)(nil),               // 84: routerrpc.CancelPaymentRequest
+	(*CancelPaymentResponse)(nil),              // 85: routerrpc.CancelPaymentResponse
+	(*GetResourceStatsRequest)(nil),            // 86: routerrpc.GetResourceStatsRequest
+	(*GetResourceStatsResponse)(nil),           // 87: routerrpc.GetResourceStatsResponse
+	(*PeerReputation)(nil),                     // 88: routerrpc.PeerReputation
+	(*ChannelResources)(nil),                   // 89: routerrpc.ChannelResources
+	(*SetPeerPolicyRequest)(nil),               // 90: routerrpc.SetPeerPolicyRequest
+	(*SetPeerPolicyResponse)(nil),              // 91: routerrpc.SetPeerPolicyResponse
+	nil,                                        // 92: routerrpc.SendPaymentRequest.DestCustomRecordsEntry
+	nil,                                        // 93: routerrpc.SendPaymentRequest.FirstHopCustomRecordsEntry
+	nil,                                        // 94: routerrpc.SendToRouteRequest.FirstHopCustomRecordsEntry
+	nil,                                        // 95: routerrpc.BuildRouteRequest.FirstHopCustomRecordsEntry
+	nil,                                        // 96: routerrpc.ForwardHtlcInterceptRequest.CustomRecordsEntry
+	nil,                                        // 97: routerrpc.ForwardHtlcInterceptRequest.InWireCustomRecordsEntry
+	nil,                                        // 98: routerrpc.ForwardHtlcInterceptResponse.OutWireCustomRecordsEntry
+	(*lnrpc.RouteHint)(nil),                    // 99: lnrpc.RouteHint
+	(lnrpc.FeatureBit)(0),                      // 100: lnrpc.FeatureBit
+	(lnrpc.PaymentFailureReason)(0),            // 101: lnrpc.PaymentFailureReason
+	(*lnrpc.Route)(nil),                        // 102: lnrpc.Route
+	(lnrpc.Failure_FailureCode)(0),             // 103: lnrpc.Failure.FailureCode
+	(*lnrpc.ChannelPoint)(nil),                 // 104: lnrpc.ChannelPoint
+	(*lnrpc.AliasMap)(nil),                     // 105: lnrpc.AliasMap
+	(*lnrpc.InboundFee)(nil),                   // 106: lnrpc.InboundFee
+	(*lnrpc.Payment)(nil),                      // 107: lnrpc.Payment
+	(*lnrpc.HTLCAttempt)(nil),                  // 108: lnrpc.HTLCAttempt
 }
 var file_routerrpc_router_proto_depIdxs = []int32{
-	59, // 0: routerrpc.SendPaymentRequest.route_hints:type_name -> lnrpc.RouteHint
-	52, // 1: routerrpc.SendPaymentRequest.dest_custom_records:type_name -> routerrpc.SendPaymentRequest.DestCustomRecordsEntry
-	60, // 2: routerrpc.SendPaymentRequest.dest_features:type_name -> lnrpc.FeatureBit
-	53, // 3: routerrpc.SendPaymentRequest.first_hop_custom_records:type_name -> routerrpc.SendPaymentRequest.FirstHopCustomRecordsEntry
-	61, // 4: routerrpc.RouteFeeResponse.failure_reason:type_name -> lnrpc.PaymentFailureReason
-	62, // 5: routerrpc.SendToRouteRequest.route:type_name -> lnrpc.Route
-	54, // 6: routerrpc.SendToRouteRequest.first_hop_custom_records:type_name -> routerrpc.SendToRouteRequest.FirstHopCustomRecordsEntry
-	17, // 7: routerrpc.QueryMissionControlResponse.pairs:type_name -> routerrpc.PairHistory
-	17, // 8: routerrpc.XImportMissionControlRequest.pairs:type_name -> routerrpc.PairHistory
-	18, // 9: routerrpc.PairHistory.history:type_name -> routerrpc.PairData
-	23, // 10: routerrpc.GetMissionControlConfigResponse.config:type_name -> routerrpc.MissionControlConfig
-	23, // 11: routerrpc.SetMissionControlConfigRequest.config:type_name -> routerrpc.MissionControlConfig
-	3,  // 12: routerrpc.MissionControlConfig.model:type_name -> routerrpc.MissionControlConfig.ProbabilityModel
-	25, // 13: routerrpc.MissionControlConfig.apriori:type_name -> routerrpc.AprioriParameters
-	24, // 14: routerrpc.MissionControlConfig.bimodal:type_name -> routerrpc.BimodalParameters
-	18, // 15: routerrpc.QueryProbabilityResponse.history:type_name -> routerrpc.PairData
-	55, // 16: routerrpc.BuildRouteRequest.first_hop_custom_records:type_name -> routerrpc.BuildRouteRequest.FirstHopCustomRecordsEntry
-	62, // 17: routerrpc.BuildRouteResponse.route:type_name -> lnrpc.Route
-	4,  // 18: routerrpc.HtlcEvent.event_type:type_name -> routerrpc.HtlcEvent.EventType
-	33, // 19: routerrpc.HtlcEvent.forward_event:type_name -> routerrpc.ForwardEvent
-	34, // 20: routerrpc.HtlcEvent.forward_fail_event:type_name -> routerrpc.ForwardFailEvent
-	35, // 21: routerrpc.HtlcEvent.settle_event:type_name -> routerrpc.SettleEvent
-	38, // 22: routerrpc.HtlcEvent.link_fail_event:type_name -> routerrpc.LinkFailEvent
-	37, // 23: routerrpc.HtlcEvent.subscribed_event:type_name -> routerrpc.SubscribedEvent
-	36, // 24: routerrpc.HtlcEvent.final_htlc_event:type_name -> routerrpc.FinalHtlcEvent
-	32, // 25: routerrpc.ForwardEvent.info:type_name -> routerrpc.HtlcInfo
-	32, // 26: routerrpc.LinkFailEvent.info:type_name -> routerrpc.HtlcInfo
-	63, // 27: routerrpc.LinkFailEvent.wire_failure:type_name -> lnrpc.Failure.FailureCode
-	0,  // 28: routerrpc.LinkFailEvent.failure_detail:type_name -> routerrpc.FailureDetail
-	39, // 29: routerrpc.ForwardHtlcInterceptRequest.incoming_circuit_key:type_name -> routerrpc.CircuitKey
-	56, // 30: routerrpc.ForwardHtlcInterceptRequest.custom_records:type_name -> routerrpc.ForwardHtlcInterceptRequest.CustomRecordsEntry
-	57, // 31: routerrpc.ForwardHtlcInterceptRequest.in_wire_custom_records:type_name -> routerrpc.ForwardHtlcInterceptRequest.InWireCustomRecordsEntry
-	39, // 32: routerrpc.ForwardHtlcInterceptResponse.incoming_circuit_key:type_name -> routerrpc.CircuitKey
-	1,  // 33: routerrpc.ForwardHtlcInterceptResponse.action:type_name -> routerrpc.ResolveHoldForwardAction
-	63, // 34: routerrpc.ForwardHtlcInterceptResponse.failure_code:type_name -> lnrpc.Failure.FailureCode
-	58, // 35: routerrpc.ForwardHtlcInterceptResponse.out_wire_custom_records:type_name -> routerrpc.ForwardHtlcInterceptResponse.OutWireCustomRecordsEntry
-	64, // 36: routerrpc.UpdateChanStatusRequest.chan_point:type_name -> lnrpc.ChannelPoint
-	2,  // 37: routerrpc.UpdateChanStatusRequest.action:type_name -> routerrpc.ChanStatusAction
-	65, // 38: routerrpc.AddAliasesRequest.alias_maps:type_name -> lnrpc.AliasMap
-	65, // 39: routerrpc.AddAliasesResponse.alias_maps:type_name -> lnrpc.AliasMap
-	65, // 40: routerrpc.DeleteAliasesRequest.alias_maps:type_name -> lnrpc.AliasMap
-	65, // 41: routerrpc.DeleteAliasesResponse.alias_maps:type_name -> lnrpc.AliasMap
-	5,  // 42: routerrpc.Router.SendPaymentV2:input_type -> routerrpc.SendPaymentRequest
-	6,  // 43: routerrpc.Router.TrackPaymentV2:input_type -> routerrpc.TrackPaymentRequest
-	7,  // 44: routerrpc.Router.TrackPayments:input_type -> routerrpc.TrackPaymentsRequest
-	8,  // 45: routerrpc.Router.EstimateRouteFee:input_type -> routerrpc.RouteFeeRequest
-	10, // 46: routerrpc.Router.SendToRouteV2:input_type -> routerrpc.SendToRouteRequest
-	11, // 47: routerrpc.Router.ResetMissionControl:input_type -> routerrpc.ResetMissionControlRequest
-	13, // 48: routerrpc.Router.QueryMissionControl:input_type -> routerrpc.QueryMissionControlRequest
-	15, // 49: routerrpc.Router.XImportMissionControl:input_type -> routerrpc.XImportMissionControlRequest
-	19, // 50: routerrpc.Router.GetMissionControlConfig:input_type -> routerrpc.GetMissionControlConfigRequest
-	21, // 51: routerrpc.Router.SetMissionControlConfig:input_type -> routerrpc.SetMissionControlConfigRequest
-	26, // 52: routerrpc.Router.QueryProbability:input_type -> routerrpc.QueryProbabilityRequest
-	28, // 53: routerrpc.Router.BuildRoute:input_type -> routerrpc.BuildRouteRequest
-	30, // 54: routerrpc.Router.SubscribeHtlcEvents:input_type -> routerrpc.SubscribeHtlcEventsRequest
-	41, // 55: routerrpc.Router.HtlcInterceptor:input_type -> routerrpc.ForwardHtlcInterceptResponse
-	42, // 56: routerrpc.Router.UpdateChanStatus:input_type -> routerrpc.UpdateChanStatusRequest
-	44, // 57: routerrpc.Router.XAddLocalChanAliases:input_type -> routerrpc.AddAliasesRequest
-	46, // 58: routerrpc.Router.XDeleteLocalChanAliases:input_type -> routerrpc.DeleteAliasesRequest
-	48, // 59: routerrpc.Router.XFindBaseLocalChanAlias:input_type -> routerrpc.FindBaseAliasRequest
-	50, // 60: routerrpc.Router.DeleteForwardingHistory:input_type -> routerrpc.DeleteForwardingHistoryRequest
-	66, // 61: routerrpc.Router.SendPaymentV2:output_type -> lnrpc.Payment
-	66, // 62: routerrpc.Router.TrackPaymentV2:output_type -> lnrpc.Payment
-	66, // 63: routerrpc.Router.TrackPayments:output_type -> lnrpc.Payment
-	9,  // 64: routerrpc.Router.EstimateRouteFee:output_type -> routerrpc.RouteFeeResponse
-	67, // 65: routerrpc.Router.SendToRouteV2:output_type -> lnrpc.HTLCAttempt
-	12, // 66: routerrpc.Router.ResetMissionControl:output_type -> routerrpc.ResetMissionControlResponse
-	14, // 67: routerrpc.Router.QueryMissionControl:output_type -> routerrpc.QueryMissionControlResponse
-	16, // 68: routerrpc.Router.XImportMissionControl:output_type -> routerrpc.XImportMissionControlResponse
-	20, // 69: routerrpc.Router.GetMissionControlConfig:output_type -> routerrpc.GetMissionControlConfigResponse
-	22, // 70: routerrpc.Router.SetMissionControlConfig:output_type -> routerrpc.SetMissionControlConfigResponse
-	27, // 71: routerrpc.Router.QueryProbability:output_type -> routerrpc.QueryProbabilityResponse
-	29, // 72: routerrpc.Router.BuildRoute:output_type -> routerrpc.BuildRouteResponse
-	31, // 73: routerrpc.Router.SubscribeHtlcEvents:output_type -> routerrpc.HtlcEvent
-	40, // 74: routerrpc.Router.HtlcInterceptor:output_type -> routerrpc.ForwardHtlcInterceptRequest
-	43, // 75: routerrpc.Router.UpdateChanStatus:output_type -> routerrpc.UpdateChanStatusResponse
-	45, // 76: routerrpc.Router.XAddLocalChanAliases:output_type -> routerrpc.AddAliasesResponse
-	47, // 77: routerrpc.Router.XDeleteLocalChanAliases:output_type -> routerrpc.DeleteAliasesResponse
-	49, // 78: routerrpc.Router.XFindBaseLocalChanAlias:output_type -> routerrpc.FindBaseAliasResponse
-	51, // 79: routerrpc.Router.DeleteForwardingHistory:output_type -> routerrpc.DeleteForwardingHistoryResponse
-	61, // [61:80] is the sub-list for method output_type
-	42, // [42:61] is the sub-list for method input_type
-	42, // [42:42] is the sub-list for extension type_name
-	42, // [42:42] is the sub-list for extension extendee
-	0,  // [0:42] is the sub-list for field type_name
+	99,  // 0: routerrpc.SendPaymentRequest.route_hints:type_name -> lnrpc.RouteHint
+	92,  // 1: routerrpc.SendPaymentRequest.dest_custom_records:type_name -> routerrpc.SendPaymentRequest.DestCustomRecordsEntry
+	100, // 2: routerrpc.SendPaymentRequest.dest_features:type_name -> lnrpc.FeatureBit
+	93,  // 3: routerrpc.SendPaymentRequest.first_hop_custom_records:type_name -> routerrpc.SendPaymentRequest.FirstHopCustomRecordsEntry
+	101, // 4: routerrpc.RouteFeeResponse.failure_reason:type_name -> lnrpc.PaymentFailureReason
+	102, // 5: routerrpc.SendToRouteRequest.route:type_name -> lnrpc.Route
+	94,  // 6: routerrpc.SendToRouteRequest.first_hop_custom_records:type_name -> routerrpc.SendToRouteRequest.FirstHopCustomRecordsEntry
+	18,  // 7: routerrpc.QueryMissionControlResponse.pairs:type_name -> routerrpc.PairHistory
+	18,  // 8: routerrpc.XImportMissionControlRequest.pairs:type_name -> routerrpc.PairHistory
+	19,  // 9: routerrpc.PairHistory.history:type_name -> routerrpc.PairData
+	24,  // 10: routerrpc.GetMissionControlConfigResponse.config:type_name -> routerrpc.MissionControlConfig
+	24,  // 11: routerrpc.SetMissionControlConfigRequest.config:type_name -> routerrpc.MissionControlConfig
+	4,   // 12: routerrpc.MissionControlConfig.model:type_name -> routerrpc.MissionControlConfig.ProbabilityModel
+	27,  // 13: routerrpc.MissionControlConfig.apriori:type_name -> routerrpc.AprioriParameters
+	26,  // 14: routerrpc.MissionControlConfig.bimodal:type_name -> routerrpc.BimodalParameters
+	25,  // 15: routerrpc.MissionControlConfig.external:type_name -> routerrpc.ExternalParameters
+	26,  // 16: routerrpc.ExternalParameters.fallback:type_name -> routerrpc.BimodalParameters
+	19,  // 17: routerrpc.QueryProbabilityResponse.history:type_name -> routerrpc.PairData
+	95,  // 18: routerrpc.BuildRouteRequest.first_hop_custom_records:type_name -> routerrpc.BuildRouteRequest.FirstHopCustomRecordsEntry
+	102, // 19: routerrpc.BuildRouteResponse.route:type_name -> lnrpc.Route
+	5,   // 20: routerrpc.HtlcEvent.event_type:type_name -> routerrpc.HtlcEvent.EventType
+	35,  // 21: routerrpc.HtlcEvent.forward_event:type_name -> routerrpc.ForwardEvent
+	36,  // 22: routerrpc.HtlcEvent.forward_fail_event:type_name -> routerrpc.ForwardFailEvent
+	37,  // 23: routerrpc.HtlcEvent.settle_event:type_name -> routerrpc.SettleEvent
+	41,  // 24: routerrpc.HtlcEvent.link_fail_event:type_name -> routerrpc.LinkFailEvent
+	39,  // 25: routerrpc.HtlcEvent.subscribed_event:type_name -> routerrpc.SubscribedEvent
+	38,  // 26: routerrpc.HtlcEvent.final_htlc_event:type_name -> routerrpc.FinalHtlcEvent
+	40,  // 27: routerrpc.HtlcEvent.stuck_htlc_event:type_name -> routerrpc.StuckHtlcEvent
+	34,  // 28: routerrpc.ForwardEvent.info:type_name -> routerrpc.HtlcInfo
+	34,  // 29: routerrpc.StuckHtlcEvent.info:type_name -> routerrpc.HtlcInfo
+	34,  // 30: routerrpc.LinkFailEvent.info:type_name -> routerrpc.HtlcInfo
+	103, // 31: routerrpc.LinkFailEvent.wire_failure:type_name -> lnrpc.Failure.FailureCode
+	0,   // 32: routerrpc.LinkFailEvent.failure_detail:type_name -> routerrpc.FailureDetail
+	42,  // 33: routerrpc.ForwardHtlcInterceptRequest.incoming_circuit_key:type_name -> routerrpc.CircuitKey
+	96,  // 34: routerrpc.ForwardHtlcInterceptRequest.custom_records:type_name -> routerrpc.ForwardHtlcInterceptRequest.CustomRecordsEntry
+	97,  // 35: routerrpc.ForwardHtlcInterceptRequest.in_wire_custom_records:type_name -> routerrpc.ForwardHtlcInterceptRequest.InWireCustomRecordsEntry
+	42,  // 36: routerrpc.ForwardHtlcInterceptResponse.incoming_circuit_key:type_name -> routerrpc.CircuitKey
+	1,   // 37: routerrpc.ForwardHtlcInterceptResponse.action:type_name -> routerrpc.ResolveHoldForwardAction
+	103, // 38: routerrpc.ForwardHtlcInterceptResponse.failure_code:type_name -> lnrpc.Failure.FailureCode
+	98,  // 39: routerrpc.ForwardHtlcInterceptResponse.out_wire_custom_records:type_name -> routerrpc.ForwardHtlcInterceptResponse.OutWireCustomRecordsEntry
+	104, // 40: routerrpc.UpdateChanStatusRequest.chan_point:type_name -> lnrpc.ChannelPoint
+	2,   // 41: routerrpc.UpdateChanStatusRequest.action:type_name -> routerrpc.ChanStatusAction
+	105, // 42: routerrpc.AddAliasesRequest.alias_maps:type_name -> lnrpc.AliasMap
+	105, // 43: routerrpc.AddAliasesResponse.alias_maps:type_name -> lnrpc.AliasMap
+	105, // 44: routerrpc.DeleteAliasesRequest.alias_maps:type_name -> lnrpc.AliasMap
+	105, // 45: routerrpc.DeleteAliasesResponse.alias_maps:type_name -> lnrpc.AliasMap
+	55,  // 46: routerrpc.DynamicFeeParams.fee_rate_curve:type_name -> routerrpc.FeeCurvePoint
+	55,  // 47: routerrpc.DynamicFeeParams.inbound_fee_rate_curve:type_name -> routerrpc.FeeCurvePoint
+	56,  // 48: routerrpc.FeePolicyOverride.params:type_name -> routerrpc.DynamicFeeParams
+	106, // 49: routerrpc.FeeRecommendation.current_inbound_fee:type_name -> lnrpc.InboundFee
+	106, // 50: routerrpc.FeeRecommendation.inbound_fee:type_name -> lnrpc.InboundFee
+	56,  // 51: routerrpc.GetDynamicFeePolicyResponse.params:type_name -> routerrpc.DynamicFeeParams
+	57,  // 52: routerrpc.GetDynamicFeePolicyResponse.overrides:type_name -> routerrpc.FeePolicyOverride
+	58,  // 53: routerrpc.GetDynamicFeePolicyResponse.last_recommendations:type_name -> routerrpc.FeeRecommendation
+	56,  // 54: routerrpc.SetDynamicFeePolicyRequest.params:type_name -> routerrpc.DynamicFeeParams
+	104, // 55: routerrpc.SetFeePolicyOverrideRequest.chan_point:type_name -> lnrpc.ChannelPoint
+	56,  // 56: routerrpc.SetFeePolicyOverrideRequest.params:type_name -> routerrpc.DynamicFeeParams
+	58,  // 57: routerrpc.RecomputeFeesResponse.recommendations:type_name -> routerrpc.FeeRecommendation
+	71,  // 58: routerrpc.ListRebalancesResponse.rebalances:type_name -> routerrpc.Rebalance
+	3,   // 59: routerrpc.Rebalance.status:type_name -> routerrpc.RebalanceStatus
+	72,  // 60: routerrpc.Rebalance.attempts:type_name -> routerrpc.RebalanceAttempt
+	18,  // 61: routerrpc.ProbabilityEstimateRequest.results:type_name -> routerrpc.PairHistory
+	77,  // 62: routerrpc.GetLiquidityMapResponse.bounds:type_name -> routerrpc.LiquidityBound
+	78,  // 63: routerrpc.GetLiquidityMapResponse.prober:type_name -> routerrpc.ProberStatus
+	79,  // 64: routerrpc.ProberStatus.targets:type_name -> routerrpc.ProbeTarget
+	6,   // 65: routerrpc.SendPaymentBatchRequest.payments:type_name -> routerrpc.SendPaymentRequest
+	82,  // 66: routerrpc.PaymentBatchUpdate.payment:type_name -> routerrpc.BatchPaymentStatus
+	83,  // 67: routerrpc.PaymentBatchUpdate.summary:type_name -> routerrpc.PaymentBatchSummary
+	107, // 68: routerrpc.BatchPaymentStatus.payment:type_name -> lnrpc.Payment
+	88,  // 69: routerrpc.GetResourceStatsResponse.peers:type_name -> routerrpc.PeerReputation
+	89,  // 70: routerrpc.GetResourceStatsResponse.channels:type_name -> routerrpc.ChannelResources
+	6,   // 71: routerrpc.Router.SendPaymentV2:input_type -> routerrpc.SendPaymentRequest
+	7,   // 72: routerrpc.Router.TrackPaymentV2:input_type -> routerrpc.TrackPaymentRequest
+	8,   // 73: routerrpc.Router.TrackPayments:input_type -> routerrpc.TrackPaymentsRequest
+	9,   // 74: routerrpc.Router.EstimateRouteFee:input_type -> routerrpc.RouteFeeRequest
+	11,  // 75: routerrpc.Router.SendToRouteV2:input_type -> routerrpc.SendToRouteRequest
+	12,  // 76: routerrpc.Router.ResetMissionControl:input_type -> routerrpc.ResetMissionControlRequest
+	14,  // 77: routerrpc.Router.QueryMissionControl:input_type -> routerrpc.QueryMissionControlRequest
+	16,  // 78: routerrpc.Router.XImportMissionControl:input_type -> routerrpc.XImportMissionControlRequest
+	20,  // 79: routerrpc.Router.GetMissionControlConfig:input_type -> routerrpc.GetMissionControlConfigRequest
+	22,  // 80: routerrpc.Router.SetMissionControlConfig:input_type -> routerrpc.SetMissionControlConfigRequest
+	28,  // 81: routerrpc.Router.QueryProbability:input_type -> routerrpc.QueryProbabilityRequest
+	30,  // 82: routerrpc.Router.BuildRoute:input_type -> routerrpc.BuildRouteRequest
+	32,  // 83: routerrpc.Router.SubscribeHtlcEvents:input_type -> routerrpc.SubscribeHtlcEventsRequest
+	44,  // 84: routerrpc.Router.HtlcInterceptor:input_type -> routerrpc.ForwardHtlcInterceptResponse
+	45,  // 85: routerrpc.Router.UpdateChanStatus:input_type -> routerrpc.UpdateChanStatusRequest
+	47,  // 86: routerrpc.Router.XAddLocalChanAliases:input_type -> routerrpc.AddAliasesRequest
+	49,  // 87: routerrpc.Router.XDeleteLocalChanAliases:input_type -> routerrpc.DeleteAliasesRequest
+	51,  // 88: routerrpc.Router.XFindBaseLocalChanAlias:input_type -> routerrpc.FindBaseAliasRequest
+	53,  // 89: routerrpc.Router.DeleteForwardingHistory:input_type -> routerrpc.DeleteForwardingHistoryRequest
+	59,  // 90: routerrpc.Router.GetDynamicFeePolicy:input_type -> routerrpc.GetDynamicFeePolicyRequest
+	61,  // 91: routerrpc.Router.SetDynamicFeePolicy:input_type -> routerrpc.SetDynamicFeePolicyRequest
+	63,  // 92: routerrpc.Router.SetFeePolicyOverride:input_type -> routerrpc.SetFeePolicyOverrideRequest
+	65,  // 93: routerrpc.Router.RecomputeFees:input_type -> routerrpc.RecomputeFeesRequest
+	67,  // 94: routerrpc.Router.StartRebalance:input_type -> routerrpc.StartRebalanceRequest
+	69,  // 95: routerrpc.Router.ListRebalances:input_type -> routerrpc.ListRebalancesRequest
+	74,  // 96: routerrpc.Router.ExternalEstimator:input_type -> routerrpc.ProbabilityEstimate
+	75,  // 97: routerrpc.Router.GetLiquidityMap:input_type -> routerrpc.GetLiquidityMapRequest
+	80,  // 98: routerrpc.Router.SendPaymentBatch:input_type -> routerrpc.SendPaymentBatchRequest
+	84,  // 99: routerrpc.Router.CancelPayment:input_type -> routerrpc.CancelPaymentRequest
+	86,  // 100: routerrpc.Router.GetResourceStats:input_type -> routerrpc.GetResourceStatsRequest
+	90,  // 101: routerrpc.Router.SetPeerPolicy:input_type -> routerrpc.SetPeerPolicyRequest
+	107, // 102: routerrpc.Router.SendPaymentV2:output_type -> lnrpc.Payment
+	107, // 103: routerrpc.Router.TrackPaymentV2:output_type -> lnrpc.Payment
+	107, // 104: routerrpc.Router.TrackPayments:output_type -> lnrpc.Payment
+	10,  // 105: routerrpc.Router.EstimateRouteFee:output_type -> routerrpc.RouteFeeResponse
+	108, // 106: routerrpc.Router.SendToRouteV2:output_type -> lnrpc.HTLCAttempt
+	13,  // 107: routerrpc.Router.ResetMissionControl:output_type -> routerrpc.ResetMissionControlResponse
+	15,  // 108: routerrpc.Router.QueryMissionControl:output_type -> routerrpc.QueryMissionControlResponse
+	17,  // 109: routerrpc.Router.XImportMissionControl:output_type -> routerrpc.XImportMissionControlResponse
+	21,  // 110: routerrpc.Router.GetMissionControlConfig:output_type -> routerrpc.GetMissionControlConfigResponse
+	23,  // 111: routerrpc.Router.SetMissionControlConfig:output_type -> routerrpc.SetMissionControlConfigResponse
+	29,  // 112: routerrpc.Router.QueryProbability:output_type -> routerrpc.QueryProbabilityResponse
+	31,  // 113: routerrpc.Router.BuildRoute:output_type -> routerrpc.BuildRouteResponse
+	33,  // 114: routerrpc.Router.SubscribeHtlcEvents:output_type -> routerrpc.HtlcEvent
+	43,  // 115: routerrpc.Router.HtlcInterceptor:output_type -> routerrpc.ForwardHtlcInterceptRequest
+	46,  // 116: routerrpc.Router.UpdateChanStatus:output_type -> routerrpc.UpdateChanStatusResponse
+	48,  // 117: routerrpc.Router.XAddLocalChanAliases:output_type -> routerrpc.AddAliasesResponse
+	50,  // 118: routerrpc.Router.XDeleteLocalChanAliases:output_type -> routerrpc.DeleteAliasesResponse
+	52,  // 119: routerrpc.Router.XFindBaseLocalChanAlias:output_type -> routerrpc.FindBaseAliasResponse
+	54,  // 120: routerrpc.Router.DeleteForwardingHistory:output_type -> routerrpc.DeleteForwardingHistoryResponse
+	60,  // 121: routerrpc.Router.GetDynamicFeePolicy:output_type -> routerrpc.GetDynamicFeePolicyResponse
+	62,  // 122: routerrpc.Router.SetDynamicFeePolicy:output_type -> routerrpc.SetDynamicFeePolicyResponse
+	64,  // 123: routerrpc.Router.SetFeePolicyOverride:output_type -> routerrpc.SetFeePolicyOverrideResponse
+	66,  // 124: routerrpc.Router.RecomputeFees:output_type -> routerrpc.RecomputeFeesResponse
+	68,  // 125: routerrpc.Router.StartRebalance:output_type -> routerrpc.StartRebalanceResponse
+	70,  // 126: routerrpc.Router.ListRebalances:output_type -> routerrpc.ListRebalancesResponse
+	73,  // 127: routerrpc.Router.ExternalEstimator:output_type -> routerrpc.ProbabilityEstimateRequest
+	76,  // 128: routerrpc.Router.GetLiquidityMap:output_type -> routerrpc.GetLiquidityMapResponse
+	81,  // 129: routerrpc.Router.SendPaymentBatch:output_type -> routerrpc.PaymentBatchUpdate
+	85,  // 130: routerrpc.Router.CancelPayment:output_type -> routerrpc.CancelPaymentResponse
+	87,  // 131: routerrpc.Router.GetResourceStats:output_type -> routerrpc.GetResourceStatsResponse
+	91,  // 132: routerrpc.Router.SetPeerPolicy:output_type -> routerrpc.SetPeerPolicyResponse
+	102, // [102:133] is the sub-list for method output_type
+	71,  // [71:102] is the sub-list for method input_type
+	71,  // [71:71] is the sub-list for extension type_name
+	71,  // [71:71] is the sub-list for extension extendee
+	0,   // [0:71] is the sub-list for field type_name
 }
 
 func init() { file_routerrpc_router_proto_init() }
@@ -4098,26 +7436,33 @@ func file_routerrpc_router_proto_init() {
 	file_routerrpc_router_proto_msgTypes[18].OneofWrappers = []any{
 		(*MissionControlConfig_Apriori)(nil),
 		(*MissionControlConfig_Bimodal)(nil),
+		(*MissionControlConfig_External)(nil),
 	}
-	file_routerrpc_router_proto_msgTypes[26].OneofWrappers = []any{
+	file_routerrpc_router_proto_msgTypes[27].OneofWrappers = []any{
 		(*HtlcEvent_ForwardEvent)(nil),
 		(*HtlcEvent_ForwardFailEvent)(nil),
 		(*HtlcEvent_SettleEvent)(nil),
 		(*HtlcEvent_LinkFailEvent)(nil),
 		(*HtlcEvent_SubscribedEvent)(nil),
 		(*HtlcEvent_FinalHtlcEvent)(nil),
+		(*HtlcEvent_StuckHtlcEvent)(nil),
 	}
-	file_routerrpc_router_proto_msgTypes[45].OneofWrappers = []any{
+	file_routerrpc_router_proto_msgTypes[47].OneofWrappers = []any{
 		(*DeleteForwardingHistoryRequest_DeleteBeforeTime)(nil),
 		(*DeleteForwardingHistoryRequest_DeleteBeforeDuration)(nil),
 	}
+	file_routerrpc_router_proto_msgTypes[71].OneofWrappers = []any{}
+	file_routerrpc_router_proto_msgTypes[75].OneofWrappers = []any{
+		(*PaymentBatchUpdate_Payment)(nil),
+		(*PaymentBatchUpdate_Summary)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_routerrpc_router_proto_rawDesc), len(file_routerrpc_router_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   54,
+			NumEnums:      6,
+			NumMessages:   93,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,9 @@ import (
 	paymentsdb "github.com/lightningnetwork/lnd/payments/db"
 	"github.com/lightningnetwork/lnd/record"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/localchans"
+	"github.com/lightningnetwork/lnd/routing/probe"
+	"github.com/lightningnetwork/lnd/routing/rebalance"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/subscribe"
 	"github.com/lightningnetwork/lnd/zpay32"
@@ -144,6 +147,32 @@ type RouterBackend struct {
 	// default (10 000). Exposed here so operators can tune the value via
 	// lnd.conf on resource-constrained nodes.
 	FwdHistoryDeleteBatchSize int
+
+	// FeePolicyEngine is the engine that dynamically adjusts the fees of
+	// our channels.
+	FeePolicyEngine *localchans.FeePolicyEngine
+
+	// Rebalancer moves liquidity between our channels with circular
+	// payments.
+	Rebalancer *rebalance.Rebalancer
+
+	// Prober continuously probes the liquidity towards a set of target
+	// nodes. It is nil if the prober is disabled.
+	Prober *probe.Prober
+
+	// ResourceManager decides whether forwarded HTLCs may use the
+	// resources of their outgoing channel and tracks the reputation of our
+	// peers.
+	ResourceManager *htlcswitch.ResourceManager
+
+	// PeerPolicies limits the HTLCs each peer may have us forward across
+	// all of its channels.
+	PeerPolicies *htlcswitch.PeerPolicyManager
+
+	// NewReceiptReplyPath creates the encoded blinded path over which the
+	// receiver of a spontaneous payment returns its receipt. It is nil if
+	// onion messages are disabled.
+	NewReceiptReplyPath func() ([]byte, error)
 }
 
 // ForwardingLogDB defines the interface for forwarding log database operations.
@@ -1271,15 +1300,39 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		}
 	}
 
+	// If trampoline nodes are set, the payment is delegated to one of
+	// them, which also requires a budget for its route to the recipient.
+	finalCltvDelta := payIntent.FinalCLTVDelta
+	if len(rpcPayReq.TrampolineNodes) > 0 {
+		trampoline, err := unmarshallTrampolineParams(
+			rpcPayReq, payIntent,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		payIntent.Trampoline = trampoline
+		finalCltvDelta += trampoline.CltvBudget
+	}
+
 	// Do bounds checking with the block padding so the router isn't
 	// left with a zombie payment in case the user messes up.
 	err = routing.ValidateCLTVLimit(
-		payIntent.CltvLimit, payIntent.FinalCLTVDelta, true,
+		payIntent.CltvLimit, finalCltvDelta, true,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// If a receipt is requested, the receiver is asked to return it over
+	// a blinded path to our own node.
+	if rpcPayReq.RequestReceipt {
+		err := r.addReceiptRequest(rpcPayReq, payIntent)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Check for disallowed payments to self.
 	if !rpcPayReq.AllowSelfPayment && payIntent.Target == r.SelfNode {
 		return nil, errors.New("self-payments not allowed")
@@ -1288,6 +1341,91 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	return payIntent, nil
 }
 
+// addReceiptRequest adds the custom record that requests a receipt from the
+// receiver of a keysend or AMP payment.
+func (r *RouterBackend) addReceiptRequest(rpcPayReq *SendPaymentRequest,
+	payIntent *routing.LightningPayment) error {
+
+	if !rpcPayReq.Amp && !payIntent.DestCustomRecords.IsKeysend() {
+		return errors.New("receipts can only be requested for " +
+			"keysend and AMP payments")
+	}
+
+	if r.NewReceiptReplyPath == nil {
+		return errors.New("receipts require onion messages to be " +
+			"enabled")
+	}
+
+	replyPath, err := r.NewReceiptReplyPath()
+	if err != nil {
+		return fmt.Errorf("unable to create receipt reply path: %w",
+			err)
+	}
+
+	if payIntent.DestCustomRecords == nil {
+		payIntent.DestCustomRecords = make(record.CustomSet)
+	}
+	payIntent.DestCustomRecords[record.ReceiptRequestType] = replyPath
+
+	return nil
+}
+
+// unmarshallTrampolineParams parses the trampoline parameters of a payment
+// request.
+func unmarshallTrampolineParams(rpcPayReq *SendPaymentRequest,
+	payIntent *routing.LightningPayment) (*routing.TrampolineParams,
+	error) {
+
+	switch {
+	case rpcPayReq.Amp:
+		return nil, errors.New("trampoline payments can't be AMP " +
+			"payments")
+
+	case payIntent.BlindedPathSet != nil:
+		return nil, errors.New("trampoline payments to blinded paths " +
+			"are not supported")
+
+	case len(payIntent.DestCustomRecords) != 0:
+		return nil, errors.New("trampoline payments can't carry " +
+			"custom records")
+
+	case rpcPayReq.TrampolineFeeBudgetMsat < 0:
+		return nil, errors.New("trampoline fee budget must not be " +
+			"negative")
+
+	case rpcPayReq.TrampolineCltvBudget > math.MaxUint16:
+		return nil, fmt.Errorf("trampoline cltv budget must not "+
+			"exceed %v", math.MaxUint16)
+	}
+
+	trampoline := &routing.TrampolineParams{
+		FeeBudget: lnwire.MilliSatoshi(
+			rpcPayReq.TrampolineFeeBudgetMsat,
+		),
+		CltvBudget: uint16(rpcPayReq.TrampolineCltvBudget),
+	}
+	if trampoline.FeeBudget == 0 {
+		trampoline.FeeBudget = routing.DefaultTrampolineFeeBudget(
+			payIntent.Amount,
+		)
+	}
+	if trampoline.CltvBudget == 0 {
+		trampoline.CltvBudget = routing.DefaultTrampolineCltvBudget
+	}
+
+	for _, node := range rpcPayReq.TrampolineNodes {
+		vertex, err := route.NewVertexFromBytes(node)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trampoline node: %w",
+				err)
+		}
+
+		trampoline.Nodes = append(trampoline.Nodes, vertex)
+	}
+
+	return trampoline, nil
+}
+
 // BuildBlindedPathSet marshals a set of zpay32.BlindedPaymentPath and uses
 // the result to build a new routing.BlindedPaymentPathSet.
 func BuildBlindedPathSet(paths []*zpay32.BlindedPaymentPath) (
@@ -1593,7 +1731,7 @@ func MarshalTimeNano(t time.Time) int64 {
 func marshallError(sendError error) (*lnrpc.Failure, error) {
 	response := &lnrpc.Failure{}
 
-	if sendError == htlcswitch.ErrUnreadableFailureMessage {
+	if errors.Is(sendError, htlcswitch.ErrUnreadableFailureMessage) {
 		response.Code = lnrpc.Failure_UNREADABLE_FAILURE
 		return response, nil
 	}
@@ -1724,6 +1862,12 @@ func marshallWireError(msg lnwire.FailureMessage,
 		response.Code = lnrpc.Failure_INVALID_ONION_BLINDING
 		response.OnionSha_256 = onionErr.OnionSHA256[:]
 
+	case *lnwire.FailTemporaryTrampolineFailure:
+		response.Code = lnrpc.Failure_TEMPORARY_TRAMPOLINE_FAILURE
+
+	case *lnwire.FailTrampolineFeeOrExpiryInsufficient:
+		response.Code = lnrpc.Failure_TRAMPOLINE_FEE_OR_EXPIRY_INSUFFICIENT
+
 	case nil:
 		response.Code = lnrpc.Failure_UNKNOWN_FAILURE
 
@@ -1809,6 +1953,16 @@ func (r *RouterBackend) MarshallPayment(payment *paymentsdb.MPPayment) (
 		return nil, err
 	}
 
+	var receipt *lnrpc.PaymentReceipt
+	if payment.Receipt != nil {
+		receipt = &lnrpc.PaymentReceipt{
+			Signature: payment.Receipt.Signature,
+			ReceivedTimeNs: MarshalTimeNano(
+				payment.Receipt.ReceivedTime,
+			),
+		}
+	}
+
 	return &lnrpc.Payment{
 		// TODO: set this to setID for AMP-payments?
 		PaymentHash:           hex.EncodeToString(paymentID[:]),
@@ -1827,6 +1981,7 @@ func (r *RouterBackend) MarshallPayment(payment *paymentsdb.MPPayment) (
 		PaymentIndex:          payment.SequenceNum,
 		FailureReason:         failureReason,
 		FirstHopCustomRecords: payment.Info.FirstHopCustomRecords,
+		Receipt:               receipt,
 	}, nil
 }
 
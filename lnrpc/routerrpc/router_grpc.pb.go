@@ -124,6 +124,74 @@ type RouterClient interface {
 	// deletion is performed in a transaction-safe manner with configurable batch
 	// sizes to avoid holding large database locks.
 	DeleteForwardingHistory(ctx context.Context, in *DeleteForwardingHistoryRequest, opts ...grpc.CallOption) (*DeleteForwardingHistoryResponse, error)
+	// GetDynamicFeePolicy returns the parameters of the dynamic fee policy
+	// engine, all per-channel and per-peer overrides and the fee recommendations
+	// of the last recomputation.
+	GetDynamicFeePolicy(ctx context.Context, in *GetDynamicFeePolicyRequest, opts ...grpc.CallOption) (*GetDynamicFeePolicyResponse, error)
+	// SetDynamicFeePolicy replaces the parameters of the dynamic fee policy
+	// engine for all subsequent recomputations. The new parameters are not
+	// persisted and are reset to the configured values on restart.
+	SetDynamicFeePolicy(ctx context.Context, in *SetDynamicFeePolicyRequest, opts ...grpc.CallOption) (*SetDynamicFeePolicyResponse, error)
+	// SetFeePolicyOverride sets or removes a per-channel or per-peer override of
+	// the dynamic fee policy, either excluding the channels from dynamic fees
+	// entirely or using custom parameters for them. The override of a peer
+	// applies to all its channels without a per-channel override. Overrides are
+	// persisted.
+	SetFeePolicyOverride(ctx context.Context, in *SetFeePolicyOverrideRequest, opts ...grpc.CallOption) (*SetFeePolicyOverrideResponse, error)
+	// RecomputeFees immediately recomputes the fees of all channels with the
+	// dynamic fee policy engine. In dry-run mode, the recommended fees are only
+	// returned and not applied.
+	RecomputeFees(ctx context.Context, in *RecomputeFeesRequest, opts ...grpc.CallOption) (*RecomputeFeesResponse, error)
+	// StartRebalance starts a circular rebalance in the background that moves
+	// funds out of a set of source channels and back into a set of target
+	// channels by paying ourselves. The peers of the target channels are tried
+	// one after the other as the last hop of the circular payments, which are
+	// sent as multi-part payments. Failed payments are retried with smaller
+	// amounts down to the given minimum.
+	StartRebalance(ctx context.Context, in *StartRebalanceRequest, opts ...grpc.CallOption) (*StartRebalanceResponse, error)
+	// ListRebalances returns all rebalances along with their circular payments
+	// and the fees paid for them.
+	ListRebalances(ctx context.Context, in *ListRebalancesRequest, opts ...grpc.CallOption) (*ListRebalancesResponse, error)
+	// ExternalEstimator dispatches a bi-directional streaming RPC in which
+	// pathfinding probability queries are sent to the client along with the
+	// mission control results of the source node, and the client responds with
+	// its estimate of the success probability. Only a single client can be
+	// registered at a time. The external estimator needs to be active, and its
+	// fallback estimator is used while no client is connected or if the client
+	// doesn't answer a query in time.
+	ExternalEstimator(ctx context.Context, opts ...grpc.CallOption) (Router_ExternalEstimatorClient, error)
+	// GetLiquidityMap returns the liquidity bounds of the channel directions
+	// that were discovered by payments and probes, along with the status of the
+	// background prober. The bounds are derived from the mission control
+	// results.
+	GetLiquidityMap(ctx context.Context, in *GetLiquidityMapRequest, opts ...grpc.CallOption) (*GetLiquidityMapResponse, error)
+	// SendPaymentBatch attempts to route a batch of payments described by the
+	// passed SendPaymentRequests. Up to max_parallel payments are in flight at
+	// the same time, and their fee limits are reserved from a shared fee budget.
+	// As all payments use the same mission control, later payments benefit from
+	// the results of earlier ones. The stream returns the state updates of every
+	// payment, followed by a summary of the whole batch once all payments
+	// completed.
+	SendPaymentBatch(ctx context.Context, in *SendPaymentBatchRequest, opts ...grpc.CallOption) (Router_SendPaymentBatchClient, error)
+	// lncli: `cancelpayment`
+	// CancelPayment stops an in-flight payment from sending new HTLC attempts.
+	// HTLCs that are already in flight are not affected. Once they are
+	// resolved, the payment fails with the FAILURE_REASON_CANCELED reason,
+	// unless one of them settles.
+	CancelPayment(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*CancelPaymentResponse, error)
+	// lncli: `resourcestats`
+	// GetResourceStats returns the state of the HTLC resource manager. This
+	// includes the reputation of the peers that forwarded HTLCs to us, the
+	// revenue and resource bucket usage of our outgoing channels and the number
+	// of HTLCs that were admitted or rejected.
+	GetResourceStats(ctx context.Context, in *GetResourceStatsRequest, opts ...grpc.CallOption) (*GetResourceStatsResponse, error)
+	// lncli: `setpeerpolicy`
+	// SetPeerPolicy overrides the limits on the HTLCs a peer may have us forward
+	// across all of its channels, or removes the override so that the configured
+	// default limits apply again. HTLCs that would exceed the limits are failed
+	// back with a link failure. Overrides are not persisted and are reset on
+	// restart.
+	SetPeerPolicy(ctx context.Context, in *SetPeerPolicyRequest, opts ...grpc.CallOption) (*SetPeerPolicyResponse, error)
 }
 
 type routerClient struct {
@@ -419,6 +487,159 @@ func (c *routerClient) DeleteForwardingHistory(ctx context.Context, in *DeleteFo
 	return out, nil
 }
 
+func (c *routerClient) GetDynamicFeePolicy(ctx context.Context, in *GetDynamicFeePolicyRequest, opts ...grpc.CallOption) (*GetDynamicFeePolicyResponse, error) {
+	out := new(GetDynamicFeePolicyResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/GetDynamicFeePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) SetDynamicFeePolicy(ctx context.Context, in *SetDynamicFeePolicyRequest, opts ...grpc.CallOption) (*SetDynamicFeePolicyResponse, error) {
+	out := new(SetDynamicFeePolicyResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/SetDynamicFeePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) SetFeePolicyOverride(ctx context.Context, in *SetFeePolicyOverrideRequest, opts ...grpc.CallOption) (*SetFeePolicyOverrideResponse, error) {
+	out := new(SetFeePolicyOverrideResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/SetFeePolicyOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) RecomputeFees(ctx context.Context, in *RecomputeFeesRequest, opts ...grpc.CallOption) (*RecomputeFeesResponse, error) {
+	out := new(RecomputeFeesResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/RecomputeFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) StartRebalance(ctx context.Context, in *StartRebalanceRequest, opts ...grpc.CallOption) (*StartRebalanceResponse, error) {
+	out := new(StartRebalanceResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/StartRebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) ListRebalances(ctx context.Context, in *ListRebalancesRequest, opts ...grpc.CallOption) (*ListRebalancesResponse, error) {
+	out := new(ListRebalancesResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ListRebalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) ExternalEstimator(ctx context.Context, opts ...grpc.CallOption) (Router_ExternalEstimatorClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[5], "/routerrpc.Router/ExternalEstimator", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerExternalEstimatorClient{stream}
+	return x, nil
+}
+
+type Router_ExternalEstimatorClient interface {
+	Send(*ProbabilityEstimate) error
+	Recv() (*ProbabilityEstimateRequest, error)
+	grpc.ClientStream
+}
+
+type routerExternalEstimatorClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerExternalEstimatorClient) Send(m *ProbabilityEstimate) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *routerExternalEstimatorClient) Recv() (*ProbabilityEstimateRequest, error) {
+	m := new(ProbabilityEstimateRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *routerClient) GetLiquidityMap(ctx context.Context, in *GetLiquidityMapRequest, opts ...grpc.CallOption) (*GetLiquidityMapResponse, error) {
+	out := new(GetLiquidityMapResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/GetLiquidityMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) SendPaymentBatch(ctx context.Context, in *SendPaymentBatchRequest, opts ...grpc.CallOption) (Router_SendPaymentBatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[6], "/routerrpc.Router/SendPaymentBatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerSendPaymentBatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Router_SendPaymentBatchClient interface {
+	Recv() (*PaymentBatchUpdate, error)
+	grpc.ClientStream
+}
+
+type routerSendPaymentBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerSendPaymentBatchClient) Recv() (*PaymentBatchUpdate, error) {
+	m := new(PaymentBatchUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *routerClient) CancelPayment(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*CancelPaymentResponse, error) {
+	out := new(CancelPaymentResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/CancelPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) GetResourceStats(ctx context.Context, in *GetResourceStatsRequest, opts ...grpc.CallOption) (*GetResourceStatsResponse, error) {
+	out := new(GetResourceStatsResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/GetResourceStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) SetPeerPolicy(ctx context.Context, in *SetPeerPolicyRequest, opts ...grpc.CallOption) (*SetPeerPolicyResponse, error) {
+	out := new(SetPeerPolicyResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/SetPeerPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RouterServer is the server API for Router service.
 // All implementations must embed UnimplementedRouterServer
 // for forward compatibility
@@ -528,6 +749,74 @@ type RouterServer interface {
 	// deletion is performed in a transaction-safe manner with configurable batch
 	// sizes to avoid holding large database locks.
 	DeleteForwardingHistory(context.Context, *DeleteForwardingHistoryRequest) (*DeleteForwardingHistoryResponse, error)
+	// GetDynamicFeePolicy returns the parameters of the dynamic fee policy
+	// engine, all per-channel and per-peer overrides and the fee recommendations
+	// of the last recomputation.
+	GetDynamicFeePolicy(context.Context, *GetDynamicFeePolicyRequest) (*GetDynamicFeePolicyResponse, error)
+	// SetDynamicFeePolicy replaces the parameters of the dynamic fee policy
+	// engine for all subsequent recomputations. The new parameters are not
+	// persisted and are reset to the configured values on restart.
+	SetDynamicFeePolicy(context.Context, *SetDynamicFeePolicyRequest) (*SetDynamicFeePolicyResponse, error)
+	// SetFeePolicyOverride sets or removes a per-channel or per-peer override of
+	// the dynamic fee policy, either excluding the channels from dynamic fees
+	// entirely or using custom parameters for them. The override of a peer
+	// applies to all its channels without a per-channel override. Overrides are
+	// persisted.
+	SetFeePolicyOverride(context.Context, *SetFeePolicyOverrideRequest) (*SetFeePolicyOverrideResponse, error)
+	// RecomputeFees immediately recomputes the fees of all channels with the
+	// dynamic fee policy engine. In dry-run mode, the recommended fees are only
+	// returned and not applied.
+	RecomputeFees(context.Context, *RecomputeFeesRequest) (*RecomputeFeesResponse, error)
+	// StartRebalance starts a circular rebalance in the background that moves
+	// funds out of a set of source channels and back into a set of target
+	// channels by paying ourselves. The peers of the target channels are tried
+	// one after the other as the last hop of the circular payments, which are
+	// sent as multi-part payments. Failed payments are retried with smaller
+	// amounts down to the given minimum.
+	StartRebalance(context.Context, *StartRebalanceRequest) (*StartRebalanceResponse, error)
+	// ListRebalances returns all rebalances along with their circular payments
+	// and the fees paid for them.
+	ListRebalances(context.Context, *ListRebalancesRequest) (*ListRebalancesResponse, error)
+	// ExternalEstimator dispatches a bi-directional streaming RPC in which
+	// pathfinding probability queries are sent to the client along with the
+	// mission control results of the source node, and the client responds with
+	// its estimate of the success probability. Only a single client can be
+	// registered at a time. The external estimator needs to be active, and its
+	// fallback estimator is used while no client is connected or if the client
+	// doesn't answer a query in time.
+	ExternalEstimator(Router_ExternalEstimatorServer) error
+	// GetLiquidityMap returns the liquidity bounds of the channel directions
+	// that were discovered by payments and probes, along with the status of the
+	// background prober. The bounds are derived from the mission control
+	// results.
+	GetLiquidityMap(context.Context, *GetLiquidityMapRequest) (*GetLiquidityMapResponse, error)
+	// SendPaymentBatch attempts to route a batch of payments described by the
+	// passed SendPaymentRequests. Up to max_parallel payments are in flight at
+	// the same time, and their fee limits are reserved from a shared fee budget.
+	// As all payments use the same mission control, later payments benefit from
+	// the results of earlier ones. The stream returns the state updates of every
+	// payment, followed by a summary of the whole batch once all payments
+	// completed.
+	SendPaymentBatch(*SendPaymentBatchRequest, Router_SendPaymentBatchServer) error
+	// lncli: `cancelpayment`
+	// CancelPayment stops an in-flight payment from sending new HTLC attempts.
+	// HTLCs that are already in flight are not affected. Once they are
+	// resolved, the payment fails with the FAILURE_REASON_CANCELED reason,
+	// unless one of them settles.
+	CancelPayment(context.Context, *CancelPaymentRequest) (*CancelPaymentResponse, error)
+	// lncli: `resourcestats`
+	// GetResourceStats returns the state of the HTLC resource manager. This
+	// includes the reputation of the peers that forwarded HTLCs to us, the
+	// revenue and resource bucket usage of our outgoing channels and the number
+	// of HTLCs that were admitted or rejected.
+	GetResourceStats(context.Context, *GetResourceStatsRequest) (*GetResourceStatsResponse, error)
+	// lncli: `setpeerpolicy`
+	// SetPeerPolicy overrides the limits on the HTLCs a peer may have us forward
+	// across all of its channels, or removes the override so that the configured
+	// default limits apply again. HTLCs that would exceed the limits are failed
+	// back with a link failure. Overrides are not persisted and are reset on
+	// restart.
+	SetPeerPolicy(context.Context, *SetPeerPolicyRequest) (*SetPeerPolicyResponse, error)
 	mustEmbedUnimplementedRouterServer()
 }
 
@@ -592,6 +881,42 @@ func (UnimplementedRouterServer) XFindBaseLocalChanAlias(context.Context, *FindB
 func (UnimplementedRouterServer) DeleteForwardingHistory(context.Context, *DeleteForwardingHistoryRequest) (*DeleteForwardingHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteForwardingHistory not implemented")
 }
+func (UnimplementedRouterServer) GetDynamicFeePolicy(context.Context, *GetDynamicFeePolicyRequest) (*GetDynamicFeePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDynamicFeePolicy not implemented")
+}
+func (UnimplementedRouterServer) SetDynamicFeePolicy(context.Context, *SetDynamicFeePolicyRequest) (*SetDynamicFeePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDynamicFeePolicy not implemented")
+}
+func (UnimplementedRouterServer) SetFeePolicyOverride(context.Context, *SetFeePolicyOverrideRequest) (*SetFeePolicyOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeePolicyOverride not implemented")
+}
+func (UnimplementedRouterServer) RecomputeFees(context.Context, *RecomputeFeesRequest) (*RecomputeFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeFees not implemented")
+}
+func (UnimplementedRouterServer) StartRebalance(context.Context, *StartRebalanceRequest) (*StartRebalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRebalance not implemented")
+}
+func (UnimplementedRouterServer) ListRebalances(context.Context, *ListRebalancesRequest) (*ListRebalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRebalances not implemented")
+}
+func (UnimplementedRouterServer) ExternalEstimator(Router_ExternalEstimatorServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExternalEstimator not implemented")
+}
+func (UnimplementedRouterServer) GetLiquidityMap(context.Context, *GetLiquidityMapRequest) (*GetLiquidityMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityMap not implemented")
+}
+func (UnimplementedRouterServer) SendPaymentBatch(*SendPaymentBatchRequest, Router_SendPaymentBatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method SendPaymentBatch not implemented")
+}
+func (UnimplementedRouterServer) CancelPayment(context.Context, *CancelPaymentRequest) (*CancelPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPayment not implemented")
+}
+func (UnimplementedRouterServer) GetResourceStats(context.Context, *GetResourceStatsRequest) (*GetResourceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceStats not implemented")
+}
+func (UnimplementedRouterServer) SetPeerPolicy(context.Context, *SetPeerPolicyRequest) (*SetPeerPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPeerPolicy not implemented")
+}
 func (UnimplementedRouterServer) mustEmbedUnimplementedRouterServer() {}
 
 // UnsafeRouterServer may be embedded to opt out of forward compatibility for this service.
@@ -967,6 +1292,233 @@ func _Router_DeleteForwardingHistory_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Router_GetDynamicFeePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynamicFeePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).GetDynamicFeePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/GetDynamicFeePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).GetDynamicFeePolicy(ctx, req.(*GetDynamicFeePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_SetDynamicFeePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDynamicFeePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).SetDynamicFeePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/SetDynamicFeePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).SetDynamicFeePolicy(ctx, req.(*SetDynamicFeePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_SetFeePolicyOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFeePolicyOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).SetFeePolicyOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/SetFeePolicyOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).SetFeePolicyOverride(ctx, req.(*SetFeePolicyOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_RecomputeFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).RecomputeFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/RecomputeFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).RecomputeFees(ctx, req.(*RecomputeFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_StartRebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).StartRebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/StartRebalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).StartRebalance(ctx, req.(*StartRebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_ListRebalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRebalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ListRebalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ListRebalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ListRebalances(ctx, req.(*ListRebalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_ExternalEstimator_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RouterServer).ExternalEstimator(&routerExternalEstimatorServer{stream})
+}
+
+type Router_ExternalEstimatorServer interface {
+	Send(*ProbabilityEstimateRequest) error
+	Recv() (*ProbabilityEstimate, error)
+	grpc.ServerStream
+}
+
+type routerExternalEstimatorServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerExternalEstimatorServer) Send(m *ProbabilityEstimateRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *routerExternalEstimatorServer) Recv() (*ProbabilityEstimate, error) {
+	m := new(ProbabilityEstimate)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Router_GetLiquidityMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLiquidityMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).GetLiquidityMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/GetLiquidityMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).GetLiquidityMap(ctx, req.(*GetLiquidityMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_SendPaymentBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SendPaymentBatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouterServer).SendPaymentBatch(m, &routerSendPaymentBatchServer{stream})
+}
+
+type Router_SendPaymentBatchServer interface {
+	Send(*PaymentBatchUpdate) error
+	grpc.ServerStream
+}
+
+type routerSendPaymentBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerSendPaymentBatchServer) Send(m *PaymentBatchUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Router_CancelPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).CancelPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/CancelPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).CancelPayment(ctx, req.(*CancelPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_GetResourceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).GetResourceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/GetResourceStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).GetResourceStats(ctx, req.(*GetResourceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_SetPeerPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPeerPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).SetPeerPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/SetPeerPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).SetPeerPolicy(ctx, req.(*SetPeerPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Router_ServiceDesc is the grpc.ServiceDesc for Router service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1030,6 +1582,46 @@ var Router_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteForwardingHistory",
 			Handler:    _Router_DeleteForwardingHistory_Handler,
 		},
+		{
+			MethodName: "GetDynamicFeePolicy",
+			Handler:    _Router_GetDynamicFeePolicy_Handler,
+		},
+		{
+			MethodName: "SetDynamicFeePolicy",
+			Handler:    _Router_SetDynamicFeePolicy_Handler,
+		},
+		{
+			MethodName: "SetFeePolicyOverride",
+			Handler:    _Router_SetFeePolicyOverride_Handler,
+		},
+		{
+			MethodName: "RecomputeFees",
+			Handler:    _Router_RecomputeFees_Handler,
+		},
+		{
+			MethodName: "StartRebalance",
+			Handler:    _Router_StartRebalance_Handler,
+		},
+		{
+			MethodName: "ListRebalances",
+			Handler:    _Router_ListRebalances_Handler,
+		},
+		{
+			MethodName: "GetLiquidityMap",
+			Handler:    _Router_GetLiquidityMap_Handler,
+		},
+		{
+			MethodName: "CancelPayment",
+			Handler:    _Router_CancelPayment_Handler,
+		},
+		{
+			MethodName: "GetResourceStats",
+			Handler:    _Router_GetResourceStats_Handler,
+		},
+		{
+			MethodName: "SetPeerPolicy",
+			Handler:    _Router_SetPeerPolicy_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1058,6 +1650,17 @@ var Router_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ExternalEstimator",
+			Handler:       _Router_ExternalEstimator_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SendPaymentBatch",
+			Handler:       _Router_SendPaymentBatch_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "routerrpc/router.proto",
 }
@@ -1,12 +1,15 @@
 package routerrpc
 
 import (
+	"bytes"
+	"cmp"
 	"context"
 	crand "crypto/rand"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"sync/atomic"
 	"time"
 
@@ -15,6 +18,8 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/lightningnetwork/lnd/aliasmgr"
 	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -22,6 +27,9 @@ import (
 	"github.com/lightningnetwork/lnd/macaroons"
 	paymentsdb "github.com/lightningnetwork/lnd/payments/db"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/localchans"
+	"github.com/lightningnetwork/lnd/routing/probe"
+	"github.com/lightningnetwork/lnd/routing/rebalance"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
 	"google.golang.org/grpc"
@@ -144,6 +152,10 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/routerrpc.Router/ExternalEstimator": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/routerrpc.Router/UpdateChanStatus": {{
 			Entity: "offchain",
 			Action: "write",
@@ -160,6 +172,50 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/routerrpc.Router/GetDynamicFeePolicy": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/SetDynamicFeePolicy": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/SetFeePolicyOverride": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/RecomputeFees": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/StartRebalance": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/ListRebalances": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/GetLiquidityMap": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/SendPaymentBatch": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/CancelPayment": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/GetResourceStats": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/SetPeerPolicy": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 	}
 
 	// DefaultRouterMacFilename is the default name of the router macaroon
@@ -1215,6 +1271,25 @@ func (s *Server) GetMissionControlConfig(ctx context.Context,
 			Bimodal: &bCfg,
 		}
 
+	case routing.ExternalConfig:
+		resp.Config.Model = MissionControlConfig_EXTERNAL
+		fallback := v.FallbackConfig
+		eCfg := ExternalParameters{
+			CacheTtlMs:     uint64(v.CacheTTL.Milliseconds()),
+			QueryTimeoutMs: uint64(v.QueryTimeout.Milliseconds()),
+			Fallback: &BimodalParameters{
+				NodeWeight: fallback.BimodalNodeWeight,
+				ScaleMsat:  uint64(fallback.BimodalScaleMsat),
+				DecayTime: uint64(
+					fallback.BimodalDecayTime.Seconds(),
+				),
+			},
+		}
+
+		resp.Config.EstimatorConfig = &MissionControlConfig_External{
+			External: &eCfg,
+		}
+
 	default:
 		return nil, fmt.Errorf("unknown estimator config type %T", v)
 	}
@@ -1298,6 +1373,40 @@ func (s *Server) SetMissionControlConfig(ctx context.Context,
 		}
 		mcCfg.Estimator = estimator
 
+	case MissionControlConfig_EXTERNAL:
+		cfg, ok := req.Config.
+			EstimatorConfig.(*MissionControlConfig_External)
+		if !ok || cfg.External.Fallback == nil {
+			return nil, fmt.Errorf("external estimator requested " +
+				"but corresponding config not set")
+		}
+		eCfg := cfg.External
+		fallback := eCfg.Fallback
+
+		externalConfig := routing.ExternalConfig{
+			CacheTTL: time.Duration(
+				eCfg.CacheTtlMs,
+			) * time.Millisecond,
+			QueryTimeout: time.Duration(
+				eCfg.QueryTimeoutMs,
+			) * time.Millisecond,
+			FallbackConfig: routing.BimodalConfig{
+				BimodalDecayTime: time.Duration(
+					fallback.DecayTime,
+				) * time.Second,
+				BimodalScaleMsat: lnwire.MilliSatoshi(
+					fallback.ScaleMsat,
+				),
+				BimodalNodeWeight: fallback.NodeWeight,
+			},
+		}
+
+		estimator, err := routing.NewExternalEstimator(externalConfig)
+		if err != nil {
+			return nil, err
+		}
+		mcCfg.Estimator = estimator
+
 	default:
 		return nil, fmt.Errorf("unknown estimator type %v",
 			req.Config.Model)
@@ -1540,6 +1649,29 @@ func (s *Server) TrackPaymentV2(request *TrackPaymentRequest,
 	return s.trackPayment(sub, payHash, stream, request.NoInflightUpdates)
 }
 
+// CancelPayment stops an in-flight payment from sending new HTLC attempts.
+// HTLCs that are already in flight are resolved as usual.
+func (s *Server) CancelPayment(_ context.Context,
+	req *CancelPaymentRequest) (*CancelPaymentResponse, error) {
+
+	payHash, err := lntypes.MakeHash(req.PaymentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("CancelPayment called for payment %v", payHash)
+
+	err = s.cfg.Router.CancelPayment(payHash)
+	if errors.Is(err, routing.ErrPaymentNotActive) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &CancelPaymentResponse{}, nil
+}
+
 // subscribePayment subscribes to the payment updates for the given payment
 // hash.
 func (s *Server) subscribePayment(identifier lntypes.Hash) (
@@ -1822,6 +1954,22 @@ func (s *Server) HtlcInterceptor(stream Router_HtlcInterceptorServer) error {
 	).run()
 }
 
+// ExternalEstimator is a bidirectional stream in which the probability queries
+// of pathfinding are forwarded to the client, which answers them with its own
+// estimates. The external estimator needs to be active for the client to be
+// registered.
+func (s *Server) ExternalEstimator(
+	stream Router_ExternalEstimatorServer) error {
+
+	mcCfg := s.cfg.RouterBackend.MissionControl.GetConfig()
+	estimator, ok := mcCfg.Estimator.(*routing.ExternalEstimator)
+	if !ok {
+		return ErrExternalEstimatorInactive
+	}
+
+	return newExternalEstimatorClient(stream).run(estimator)
+}
+
 // XAddLocalChanAliases is an experimental API that creates a set of new
 // channel SCID alias mappings. The final total set of aliases in the manager
 // after the add operation is returned. This is only a locally stored alias, and
@@ -2089,3 +2237,662 @@ func (s *Server) DeleteForwardingHistory(ctx context.Context,
 			stats.NumEventsDeleted),
 	}, nil
 }
+
+// errFeePolicyEngineUnavailable is returned by the dynamic fee RPCs if the fee
+// policy engine isn't available.
+var errFeePolicyEngineUnavailable = errors.New("dynamic fee policy engine " +
+	"not available")
+
+// GetDynamicFeePolicy returns the parameters of the dynamic fee policy engine,
+// all per-channel overrides and the recommendations of the last
+// recomputation.
+func (s *Server) GetDynamicFeePolicy(_ context.Context,
+	_ *GetDynamicFeePolicyRequest) (*GetDynamicFeePolicyResponse, error) {
+
+	engine := s.cfg.RouterBackend.FeePolicyEngine
+	if engine == nil {
+		return nil, errFeePolicyEngineUnavailable
+	}
+
+	active, interval := engine.Active()
+	params, dryRun := engine.Params()
+
+	resp := &GetDynamicFeePolicyResponse{
+		Active:      active,
+		IntervalSec: uint64(interval.Seconds()),
+		DryRun:      dryRun,
+		Params:      marshallDynamicFeeParams(params),
+	}
+
+	chanOverrides, peerOverrides := engine.Overrides()
+	for chanPoint, override := range chanOverrides {
+		rpcOverride := marshallFeePolicyOverride(override)
+		rpcOverride.ChanPoint = chanPoint.String()
+
+		resp.Overrides = append(resp.Overrides, rpcOverride)
+	}
+	for peer, override := range peerOverrides {
+		rpcOverride := marshallFeePolicyOverride(override)
+		rpcOverride.PeerPubkey = peer.String()
+
+		resp.Overrides = append(resp.Overrides, rpcOverride)
+	}
+
+	lastRun, recs := engine.LastRecommendations()
+	if !lastRun.IsZero() {
+		resp.LastRunTimestamp = lastRun.Unix()
+	}
+	resp.LastRecommendations = marshallFeeRecommendations(recs)
+
+	return resp, nil
+}
+
+// SetDynamicFeePolicy replaces the parameters of the dynamic fee policy
+// engine.
+func (s *Server) SetDynamicFeePolicy(_ context.Context,
+	req *SetDynamicFeePolicyRequest) (*SetDynamicFeePolicyResponse, error) {
+
+	engine := s.cfg.RouterBackend.FeePolicyEngine
+	if engine == nil {
+		return nil, errFeePolicyEngineUnavailable
+	}
+
+	if req.Params == nil {
+		return nil, status.Error(codes.InvalidArgument, "params "+
+			"must be set")
+	}
+
+	params := unmarshallDynamicFeeParams(req.Params)
+	if err := engine.SetParams(params, req.DryRun); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"params: %v", err)
+	}
+
+	log.Infof("Updated dynamic fee policy params: fee_rate_curve=%v, "+
+		"dry_run=%v", params.FeeRateCurve, req.DryRun)
+
+	return &SetDynamicFeePolicyResponse{}, nil
+}
+
+// marshallFeePolicyOverride converts a fee policy override into its RPC
+// representation, leaving the channel point and peer unset.
+func marshallFeePolicyOverride(
+	override localchans.FeeOverride) *FeePolicyOverride {
+
+	rpcOverride := &FeePolicyOverride{
+		Exclude: override.Exclude,
+	}
+	override.Params.WhenSome(func(p localchans.FeePolicyParams) {
+		rpcOverride.Params = marshallDynamicFeeParams(p)
+	})
+
+	return rpcOverride
+}
+
+// SetFeePolicyOverride sets or removes a per-channel or per-peer override of
+// the dynamic fee policy.
+func (s *Server) SetFeePolicyOverride(_ context.Context,
+	req *SetFeePolicyOverrideRequest) (*SetFeePolicyOverrideResponse,
+	error) {
+
+	engine := s.cfg.RouterBackend.FeePolicyEngine
+	if engine == nil {
+		return nil, errFeePolicyEngineUnavailable
+	}
+
+	var (
+		chanPoint wire.OutPoint
+		peer      route.Vertex
+		isPeer    bool
+	)
+	switch {
+	case req.ChanPoint != nil && len(req.PeerPubkey) != 0:
+		return nil, status.Error(codes.InvalidArgument, "only one of "+
+			"chan_point and peer_pubkey may be set")
+
+	case req.ChanPoint != nil:
+		txid, err := lnrpc.GetChanPointFundingTxid(req.ChanPoint)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid channel point: %v", err)
+		}
+		chanPoint = wire.OutPoint{
+			Hash:  *txid,
+			Index: req.ChanPoint.OutputIndex,
+		}
+
+	case len(req.PeerPubkey) != 0:
+		var err error
+		peer, err = route.NewVertexFromBytes(req.PeerPubkey)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid peer pubkey: %v", err)
+		}
+		isPeer = true
+
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either "+
+			"chan_point or peer_pubkey must be set")
+	}
+
+	if req.Remove {
+		var (
+			removed bool
+			err     error
+			target  fmt.Stringer = chanPoint
+		)
+		if isPeer {
+			removed, err = engine.RemovePeerOverride(peer)
+			target = peer
+		} else {
+			removed, err = engine.RemoveOverride(chanPoint)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !removed {
+			return nil, status.Errorf(codes.NotFound, "no fee "+
+				"policy override for %v", target)
+		}
+
+		return &SetFeePolicyOverrideResponse{}, nil
+	}
+
+	var override localchans.FeeOverride
+	switch {
+	case req.Exclude:
+		override.Exclude = true
+
+	case req.Params != nil:
+		params := unmarshallDynamicFeeParams(req.Params)
+		override.Params = fn.Some(params)
+
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either "+
+			"exclude, params or remove must be set")
+	}
+
+	var err error
+	if isPeer {
+		err = engine.SetPeerOverride(peer, override)
+	} else {
+		err = engine.SetOverride(chanPoint, override)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to "+
+			"set override: %v", err)
+	}
+
+	return &SetFeePolicyOverrideResponse{}, nil
+}
+
+// RecomputeFees immediately recomputes the fees of all channels.
+func (s *Server) RecomputeFees(ctx context.Context,
+	req *RecomputeFeesRequest) (*RecomputeFeesResponse, error) {
+
+	engine := s.cfg.RouterBackend.FeePolicyEngine
+	if engine == nil {
+		return nil, errFeePolicyEngineUnavailable
+	}
+
+	recs, err := engine.Recompute(ctx, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecomputeFeesResponse{
+		Recommendations: marshallFeeRecommendations(recs),
+	}, nil
+}
+
+// marshallFeeCurve converts a fee curve to its rpc representation.
+func marshallFeeCurve(curve localchans.FeeCurve) []*FeeCurvePoint {
+	rpcCurve := make([]*FeeCurvePoint, 0, len(curve))
+	for _, p := range curve {
+		rpcCurve = append(rpcCurve, &FeeCurvePoint{
+			LocalRatio: p.LocalRatio,
+			FeeRatePpm: p.FeeRate,
+		})
+	}
+
+	return rpcCurve
+}
+
+// unmarshallFeeCurve converts an rpc fee curve to its native representation.
+func unmarshallFeeCurve(rpcCurve []*FeeCurvePoint) localchans.FeeCurve {
+	var curve localchans.FeeCurve
+	for _, p := range rpcCurve {
+		curve = append(curve, localchans.FeeCurvePoint{
+			LocalRatio: p.LocalRatio,
+			FeeRate:    p.FeeRatePpm,
+		})
+	}
+
+	return curve
+}
+
+// marshallDynamicFeeParams converts fee policy params to their rpc
+// representation.
+func marshallDynamicFeeParams(
+	params localchans.FeePolicyParams) *DynamicFeeParams {
+
+	return &DynamicFeeParams{
+		BaseFeeMsat:        uint64(params.BaseFee),
+		FeeRateCurve:       marshallFeeCurve(params.FeeRateCurve),
+		InboundBaseFeeMsat: params.InboundBaseFee,
+		InboundFeeRateCurve: marshallFeeCurve(
+			params.InboundFeeRateCurve,
+		),
+		VolumeWindowSec:   uint64(params.VolumeWindow.Seconds()),
+		VolumeSensitivity: params.VolumeSensitivity,
+		MaxFeeRatePpm:     params.MaxFeeRate,
+	}
+}
+
+// unmarshallDynamicFeeParams converts rpc fee policy params to their native
+// representation. The params still need to be validated.
+func unmarshallDynamicFeeParams(
+	rpcParams *DynamicFeeParams) localchans.FeePolicyParams {
+
+	return localchans.FeePolicyParams{
+		BaseFee:        lnwire.MilliSatoshi(rpcParams.BaseFeeMsat),
+		FeeRateCurve:   unmarshallFeeCurve(rpcParams.FeeRateCurve),
+		InboundBaseFee: rpcParams.InboundBaseFeeMsat,
+		InboundFeeRateCurve: unmarshallFeeCurve(
+			rpcParams.InboundFeeRateCurve,
+		),
+		VolumeWindow: time.Duration(rpcParams.VolumeWindowSec) *
+			time.Second,
+		VolumeSensitivity: rpcParams.VolumeSensitivity,
+		MaxFeeRate:        rpcParams.MaxFeeRatePpm,
+	}
+}
+
+// marshallInboundFee converts an optional inbound fee to its rpc
+// representation, returning nil if the fee isn't set.
+func marshallInboundFee(fee fn.Option[models.InboundFee]) *lnrpc.InboundFee {
+	return fn.MapOptionZ(fee, func(f models.InboundFee) *lnrpc.InboundFee {
+		return &lnrpc.InboundFee{
+			BaseFeeMsat: f.Base,
+			FeeRatePpm:  f.Rate,
+		}
+	})
+}
+
+// marshallFeeRecommendations converts fee recommendations to their rpc
+// representation.
+func marshallFeeRecommendations(
+	recs []localchans.FeeRecommendation) []*FeeRecommendation {
+
+	rpcRecs := make([]*FeeRecommendation, 0, len(recs))
+	for _, rec := range recs {
+		rpcRecs = append(rpcRecs, &FeeRecommendation{
+			ChanPoint:           rec.ChanPoint.String(),
+			ChanId:              rec.ChannelID,
+			LocalRatio:          rec.LocalRatio,
+			ForwardedVolumeMsat: uint64(rec.ForwardedVolume),
+			CurrentBaseFeeMsat:  uint64(rec.Current.BaseFee),
+			CurrentFeeRatePpm:   rec.Current.FeeRate,
+			CurrentInboundFee: marshallInboundFee(
+				rec.Current.InboundFee,
+			),
+			BaseFeeMsat: uint64(rec.Recommended.BaseFee),
+			FeeRatePpm:  rec.Recommended.FeeRate,
+			InboundFee: marshallInboundFee(
+				rec.Recommended.InboundFee,
+			),
+			Overridden: rec.Overridden,
+			Applied:    rec.Applied,
+			Failure:    rec.Failure,
+		})
+	}
+
+	return rpcRecs
+}
+
+// StartRebalance starts a circular rebalance in the background.
+func (s *Server) StartRebalance(_ context.Context,
+	req *StartRebalanceRequest) (*StartRebalanceResponse, error) {
+
+	rebalancer := s.cfg.RouterBackend.Rebalancer
+	if rebalancer == nil {
+		return nil, errors.New("rebalancer not available")
+	}
+
+	if req.AmtSat <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must "+
+			"be positive")
+	}
+	if req.MaxFeeSat < 0 || req.MinAmtSat < 0 || req.TimeoutSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max fee, "+
+			"min amount and timeout must not be negative")
+	}
+
+	id, err := rebalancer.StartRebalance(&rebalance.Request{
+		SourceChannels: req.SourceChanIds,
+		TargetChannels: req.TargetChanIds,
+		Amount: lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.AmtSat),
+		),
+		MaxFee: lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.MaxFeeSat),
+		),
+		MaxFeeRate: req.MaxFeeRatePpm,
+		MinAmount: lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.MinAmtSat),
+		),
+		MaxParts: req.MaxParts,
+		Timeout:  time.Duration(req.TimeoutSeconds) * time.Second,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to "+
+			"start rebalance: %v", err)
+	}
+
+	return &StartRebalanceResponse{
+		Id: id,
+	}, nil
+}
+
+// ListRebalances returns all rebalances along with their costs.
+func (s *Server) ListRebalances(_ context.Context,
+	req *ListRebalancesRequest) (*ListRebalancesResponse, error) {
+
+	rebalancer := s.cfg.RouterBackend.Rebalancer
+	if rebalancer == nil {
+		return nil, errors.New("rebalancer not available")
+	}
+
+	records, err := rebalancer.ListRebalances(req.ActiveOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListRebalancesResponse{
+		Rebalances: make([]*Rebalance, 0, len(records)),
+	}
+	for _, rec := range records {
+		resp.Rebalances = append(
+			resp.Rebalances, marshallRebalance(rec),
+		)
+	}
+
+	return resp, nil
+}
+
+// marshallRebalance converts a rebalance record to its rpc representation.
+func marshallRebalance(rec *rebalance.Record) *Rebalance {
+	var rpcStatus RebalanceStatus
+	switch rec.Status {
+	case rebalance.StatusInProgress:
+		rpcStatus = RebalanceStatus_REBALANCE_IN_PROGRESS
+
+	case rebalance.StatusSucceeded:
+		rpcStatus = RebalanceStatus_REBALANCE_SUCCEEDED
+
+	case rebalance.StatusPartiallySucceeded:
+		rpcStatus = RebalanceStatus_REBALANCE_PARTIALLY_SUCCEEDED
+
+	default:
+		rpcStatus = RebalanceStatus_REBALANCE_FAILED
+	}
+
+	rpcRebalance := &Rebalance{
+		Id:                rec.ID,
+		CreationTime:      rec.CreationTime.Unix(),
+		SourceChanIds:     rec.SourceChannels,
+		TargetChanIds:     rec.TargetChannels,
+		AmtMsat:           uint64(rec.Amount),
+		FeeLimitMsat:      uint64(rec.FeeLimit),
+		Status:            rpcStatus,
+		AmtRebalancedMsat: uint64(rec.AmountRebalanced),
+		FeePaidMsat:       uint64(rec.FeesPaid),
+		FailureReason:     rec.FailureReason,
+	}
+	for _, attempt := range rec.Attempts {
+		rpcRebalance.Attempts = append(
+			rpcRebalance.Attempts, &RebalanceAttempt{
+				PaymentHash:   attempt.PaymentHash[:],
+				LastHopPubkey: attempt.LastHop[:],
+				AmtMsat:       uint64(attempt.Amount),
+				FeeMsat:       uint64(attempt.Fee),
+				Succeeded:     attempt.Succeeded,
+				Failure:       attempt.Failure,
+			},
+		)
+	}
+
+	return rpcRebalance
+}
+
+// GetLiquidityMap returns the liquidity bounds of the channel directions that
+// are known to mission control, along with the status of the prober.
+func (s *Server) GetLiquidityMap(_ context.Context,
+	req *GetLiquidityMapRequest) (*GetLiquidityMapResponse, error) {
+
+	var filter fn.Option[route.Vertex]
+	if len(req.Node) != 0 {
+		node, err := route.NewVertexFromBytes(req.Node)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node: %w", err)
+		}
+
+		filter = fn.Some(node)
+	}
+
+	snapshot := s.cfg.RouterBackend.MissionControl.GetHistorySnapshot()
+
+	resp := &GetLiquidityMapResponse{
+		Bounds: make([]*LiquidityBound, 0, len(snapshot.Pairs)),
+		Prober: &ProberStatus{},
+	}
+	for _, pair := range snapshot.Pairs {
+		// Without a filter, all pairs match.
+		from, to := pair.Pair.From, pair.Pair.To
+		matches := filter.UnwrapOr(from) == from ||
+			filter.UnwrapOr(to) == to
+		if !matches {
+			continue
+		}
+
+		// Results that are independent of the amount don't reveal
+		// anything about the liquidity.
+		if pair.SuccessAmt == 0 && pair.FailAmt == 0 {
+			continue
+		}
+
+		resp.Bounds = append(
+			resp.Bounds, marshallLiquidityBound(from, to, pair),
+		)
+	}
+
+	if prober := s.cfg.RouterBackend.Prober; prober != nil {
+		resp.Prober = marshallProberStatus(prober.Status())
+	}
+
+	return resp, nil
+}
+
+// marshallLiquidityBound converts the mission control result of a pair to the
+// liquidity bounds of its channel direction.
+func marshallLiquidityBound(from, to route.Vertex,
+	pair routing.MissionControlPairSnapshot) *LiquidityBound {
+
+	bound := &LiquidityBound{
+		NodeFrom:         from[:],
+		NodeTo:           to[:],
+		MinLiquidityMsat: uint64(pair.SuccessAmt),
+	}
+
+	// A zero fail amount means that no failure bounds the liquidity,
+	// rather than that there is none.
+	if pair.FailAmt != 0 {
+		maxLiquidity := uint64(pair.FailAmt)
+		bound.MaxLiquidityMsat = &maxLiquidity
+	}
+
+	if !pair.SuccessTime.IsZero() {
+		bound.SuccessTime = pair.SuccessTime.Unix()
+	}
+
+	if !pair.FailTime.IsZero() {
+		bound.FailTime = pair.FailTime.Unix()
+	}
+
+	return bound
+}
+
+// marshallProberStatus converts the status of an active prober to its rpc
+// representation.
+func marshallProberStatus(status probe.Status) *ProberStatus {
+	rpcStatus := &ProberStatus{
+		Active:        true,
+		Targets:       make([]*ProbeTarget, 0, len(status.Targets)),
+		LockedMsat:    uint64(status.Locked),
+		ProbesSent:    status.ProbesSent,
+		ProbesReached: status.ProbesReached,
+		ProbesFailed:  status.ProbesFailed,
+		ProbesSkipped: status.ProbesSkipped,
+	}
+	for target, amt := range status.Targets {
+		rpcStatus.Targets = append(rpcStatus.Targets, &ProbeTarget{
+			Pubkey:      target[:],
+			NextAmtMsat: uint64(amt),
+		})
+	}
+	slices.SortFunc(rpcStatus.Targets, func(a, b *ProbeTarget) int {
+		return bytes.Compare(a.Pubkey, b.Pubkey)
+	})
+
+	return rpcStatus
+}
+
+// GetResourceStats returns the reputation of our incoming peers and the
+// resource usage of our outgoing channels as tracked by the HTLC resource
+// manager.
+func (s *Server) GetResourceStats(_ context.Context,
+	_ *GetResourceStatsRequest) (*GetResourceStatsResponse, error) {
+
+	manager := s.cfg.RouterBackend.ResourceManager
+	if manager == nil {
+		return nil, status.Error(
+			codes.Unavailable, "resource manager not available",
+		)
+	}
+
+	snapshot := manager.Snapshot()
+
+	resp := &GetResourceStatsResponse{
+		BucketingEnabled:  snapshot.Bucketing,
+		Peers:             make([]*PeerReputation, 0, len(snapshot.Peers)),
+		GeneralForwards:   snapshot.GeneralForwards,
+		ProtectedForwards: snapshot.ProtectedForwards,
+		Rejected:          snapshot.Rejected,
+	}
+	for _, peer := range snapshot.Peers {
+		resp.Peers = append(resp.Peers, marshallPeerReputation(peer))
+	}
+	slices.SortFunc(resp.Peers, func(a, b *PeerReputation) int {
+		return bytes.Compare(a.PubKey, b.PubKey)
+	})
+
+	resp.Channels = make([]*ChannelResources, 0, len(snapshot.Channels))
+	for _, channel := range snapshot.Channels {
+		resp.Channels = append(resp.Channels, &ChannelResources{
+			ChanId:      channel.Channel.ToUint64(),
+			RevenueMsat: uint64(channel.Revenue),
+			GeneralSlots: uint32(
+				channel.GeneralSlots,
+			),
+			GeneralSlotsUsed: uint32(
+				channel.GeneralSlotsUsed,
+			),
+			GeneralLiquidityMsat: uint64(
+				channel.GeneralLiquidity,
+			),
+			GeneralLiquidityUsedMsat: uint64(
+				channel.GeneralLiquidityUsed,
+			),
+			ProtectedSlots: uint32(
+				channel.ProtectedSlots,
+			),
+			ProtectedSlotsUsed: uint32(
+				channel.ProtectedSlotsUsed,
+			),
+			ProtectedLiquidityMsat: uint64(
+				channel.ProtectedLiquidity,
+			),
+			ProtectedLiquidityUsedMsat: uint64(
+				channel.ProtectedLiquidityUsed,
+			),
+		})
+	}
+	slices.SortFunc(resp.Channels, func(a, b *ChannelResources) int {
+		return cmp.Compare(a.ChanId, b.ChanId)
+	})
+
+	return resp, nil
+}
+
+// marshallPeerReputation converts the reputation of a peer to its rpc
+// representation.
+func marshallPeerReputation(peer htlcswitch.PeerReputation) *PeerReputation {
+	reputation := int64(peer.Reputation)
+	if peer.Negative {
+		reputation = -reputation
+	}
+
+	return &PeerReputation{
+		PubKey:           peer.Peer[:],
+		ReputationMsat:   reputation,
+		InFlightRiskMsat: uint64(peer.InFlightRisk),
+		NumInFlight:      uint32(peer.InFlight),
+	}
+}
+
+// SetPeerPolicy overrides the limits on the HTLCs a peer may have us forward,
+// or removes the override of the peer.
+func (s *Server) SetPeerPolicy(_ context.Context,
+	req *SetPeerPolicyRequest) (*SetPeerPolicyResponse, error) {
+
+	policies := s.cfg.RouterBackend.PeerPolicies
+	if policies == nil {
+		return nil, status.Error(
+			codes.Unavailable, "peer policies not available",
+		)
+	}
+
+	peer, err := route.NewVertexFromBytes(req.Peer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"peer: %v", err)
+	}
+
+	if req.Remove {
+		if !policies.RemovePolicy(peer) {
+			return nil, status.Errorf(codes.NotFound, "no policy "+
+				"override for peer %v", peer)
+		}
+	} else {
+		policies.SetPolicy(peer, htlcswitch.PeerPolicy{
+			MaxHtlcs:    req.MaxHtlcs,
+			MaxInFlight: lnwire.MilliSatoshi(req.MaxInFlightMsat),
+			MinHtlc:     lnwire.MilliSatoshi(req.MinHtlcMsat),
+		})
+	}
+
+	policy := policies.Policy(peer)
+	numInFlight, inFlight := policies.InFlight(peer)
+
+	log.Infof("Updated policy of peer %v: max_htlcs=%v, "+
+		"max_in_flight=%v, min_htlc=%v", peer, policy.MaxHtlcs,
+		policy.MaxInFlight, policy.MinHtlc)
+
+	return &SetPeerPolicyResponse{
+		MaxHtlcs:        policy.MaxHtlcs,
+		MaxInFlightMsat: uint64(policy.MaxInFlight),
+		MinHtlcMsat:     uint64(policy.MinHtlc),
+		NumInFlight:     numInFlight,
+		InFlightMsat:    uint64(inFlight),
+	}, nil
+}
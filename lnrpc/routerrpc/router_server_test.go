@@ -901,3 +901,100 @@ func TestProbePaymentRequestUsesUniqueHashPerLSP(t *testing.T) {
 	require.Contains(t, probedDests, eveVertex)
 	require.Contains(t, probedDests, daveVertex)
 }
+
+// mcSnapshotMock is a mission control mock that returns a fixed
+// history snapshot.
+type mcSnapshotMock struct {
+	MissionControl
+	snapshot *routing.MissionControlSnapshot
+}
+
+func (m *mcSnapshotMock) GetHistorySnapshot() *routing.MissionControlSnapshot {
+	return m.snapshot
+}
+
+// TestGetLiquidityMap tests that the liquidity bounds are derived from the
+// mission control results and filtered by node.
+func TestGetLiquidityMap(t *testing.T) {
+	t.Parallel()
+
+	nodeA, nodeB, nodeC := route.Vertex{1}, route.Vertex{2}, route.Vertex{3}
+	successTime := time.Unix(1000, 0)
+	failTime := time.Unix(2000, 0)
+
+	mc := &mcSnapshotMock{
+		snapshot: &routing.MissionControlSnapshot{
+			Pairs: []routing.MissionControlPairSnapshot{{
+				Pair: routing.NewDirectedNodePair(nodeA, nodeB),
+				TimedPairResult: routing.TimedPairResult{
+					SuccessAmt:  1_000,
+					SuccessTime: successTime,
+					FailAmt:     5_000,
+					FailTime:    failTime,
+				},
+			}, {
+				// An amount independent failure doesn't
+				// reveal any liquidity.
+				Pair: routing.NewDirectedNodePair(nodeB, nodeC),
+				TimedPairResult: routing.TimedPairResult{
+					FailTime: failTime,
+				},
+			}, {
+				Pair: routing.NewDirectedNodePair(nodeC, nodeA),
+				TimedPairResult: routing.TimedPairResult{
+					SuccessAmt:  2_000,
+					SuccessTime: successTime,
+				},
+			}},
+		},
+	}
+	server := &Server{
+		cfg: &Config{
+			RouterBackend: &RouterBackend{
+				MissionControl: mc,
+			},
+		},
+	}
+
+	resp, err := server.GetLiquidityMap(
+		t.Context(), &GetLiquidityMapRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Bounds, 2)
+	require.False(t, resp.Prober.Active)
+
+	maxLiquidity := uint64(5_000)
+	require.Equal(t, &LiquidityBound{
+		NodeFrom:         nodeA[:],
+		NodeTo:           nodeB[:],
+		MinLiquidityMsat: 1_000,
+		MaxLiquidityMsat: &maxLiquidity,
+		SuccessTime:      successTime.Unix(),
+		FailTime:         failTime.Unix(),
+	}, resp.Bounds[0])
+
+	// Only the pairs of the filtered node are returned.
+	resp, err = server.GetLiquidityMap(
+		t.Context(), &GetLiquidityMapRequest{Node: nodeB[:]},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Bounds, 1)
+	require.Equal(t, nodeA[:], resp.Bounds[0].NodeFrom)
+
+	resp, err = server.GetLiquidityMap(
+		t.Context(), &GetLiquidityMapRequest{Node: nodeC[:]},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Bounds, 1)
+	require.Equal(t, nodeC[:], resp.Bounds[0].NodeFrom)
+	require.Zero(t, resp.Bounds[0].FailTime)
+
+	// Without a failure, the maximum liquidity is unknown rather than
+	// zero.
+	require.Nil(t, resp.Bounds[0].MaxLiquidityMsat)
+
+	_, err = server.GetLiquidityMap(
+		t.Context(), &GetLiquidityMapRequest{Node: []byte{1}},
+	)
+	require.Error(t, err)
+}
@@ -11,7 +11,7 @@ import (
 //nolint:ll
 type RoutingConfig struct {
 	// ProbabilityEstimatorType sets the estimator to use.
-	ProbabilityEstimatorType string `long:"estimator" choice:"apriori" choice:"bimodal" description:"Probability estimator used for pathfinding." `
+	ProbabilityEstimatorType string `long:"estimator" choice:"apriori" choice:"bimodal" choice:"external" description:"Probability estimator used for pathfinding." `
 
 	// MinRouteProbability is the minimum required route success probability
 	// to attempt the payment.
@@ -42,6 +42,10 @@ type RoutingConfig struct {
 	// BimodalConfig defines parameters for the bimodal probability.
 	BimodalConfig *BimodalConfig `group:"bimodal" namespace:"bimodal" description:"configuration for the bimodal pathfinding probability estimator"`
 
+	// ExternalConfig defines parameters for the external probability
+	// estimator. Its fallback estimator uses the BimodalConfig.
+	ExternalConfig *ExternalConfig `group:"external" namespace:"external" description:"configuration for the external pathfinding probability estimator"`
+
 	// FeeEstimationTimeout is the maximum time to wait for routing fees to be estimated.
 	FeeEstimationTimeout time.Duration `long:"fee-estimation-timeout" description:"the maximum time to wait for routing fees to be estimated by payment probes"`
 }
@@ -89,3 +93,16 @@ type BimodalConfig struct {
 	// time for previous successes or failures.
 	DecayTime time.Duration `long:"decaytime" description:"Describes the information decay of knowledge about previous successes and failures in channels."`
 }
+
+// ExternalConfig defines parameters for the external probability estimator.
+//
+//nolint:ll
+type ExternalConfig struct {
+	// CacheTTL is the duration for which an estimate of the external
+	// client is reused for identical queries.
+	CacheTTL time.Duration `long:"cachettl" description:"The duration for which an estimate of the external client is reused for identical queries. A value of zero disables the cache."`
+
+	// QueryTimeout is the maximum time to wait for the external client to
+	// answer a query.
+	QueryTimeout time.Duration `long:"querytimeout" description:"The maximum time to wait for the external client to answer a probability query before the fallback bimodal estimator is used."`
+}
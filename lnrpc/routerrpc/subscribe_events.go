@@ -71,6 +71,21 @@ func rpcHtlcEvent(htlcEvent interface{}) (*HtlcEvent, error) {
 		eventType = &e.HtlcEventType
 		timestamp = e.Timestamp
 
+	case *htlcswitch.StuckHtlcEvent:
+		event = &HtlcEvent_StuckHtlcEvent{
+			StuckHtlcEvent: &StuckHtlcEvent{
+				Info:        rpcInfo(e.HtlcInfo),
+				PaymentHash: e.PaymentHash[:],
+				AttemptId:   e.AttemptID,
+				AgeSec:      uint64(e.Age.Seconds()),
+				ForceClosed: e.ForceClosed,
+			},
+		}
+
+		key = e.HtlcKey
+		eventType = &e.HtlcEventType
+		timestamp = e.Timestamp
+
 	case *htlcswitch.FinalHtlcEvent:
 		event = &HtlcEvent_FinalHtlcEvent{
 			FinalHtlcEvent: &FinalHtlcEvent{
@@ -225,6 +240,9 @@ func rpcFailureResolution(invoiceFailure invoices.FailResolutionResult) (
 	case invoices.ExternalValidationFailed:
 		return FailureDetail_EXTERNAL_VALIDATION_FAILED, nil
 
+	case invoices.ResultTrampolineForwardFailed:
+		return FailureDetail_TRAMPOLINE_FORWARD_FAILED, nil
+
 	default:
 		return 0, fmt.Errorf("unknown fail resolution: %v",
 			invoiceFailure.FailureString())
@@ -269,6 +287,18 @@ func rpcOutgoingFailure(failureDetail htlcswitch.OutgoingFailure) (
 	case htlcswitch.OutgoingFailureForwardsDisabled:
 		return FailureDetail_FORWARDS_DISABLED, nil
 
+	case htlcswitch.OutgoingFailureResourcesExhausted:
+		return FailureDetail_RESOURCES_EXHAUSTED, nil
+
+	case htlcswitch.OutgoingFailurePeerHtlcBelowMin:
+		return FailureDetail_PEER_HTLC_BELOW_MINIMUM, nil
+
+	case htlcswitch.OutgoingFailurePeerMaxHtlcs:
+		return FailureDetail_PEER_MAX_HTLCS_EXCEEDED, nil
+
+	case htlcswitch.OutgoingFailurePeerMaxInFlight:
+		return FailureDetail_PEER_MAX_IN_FLIGHT_EXCEEDED, nil
+
 	default:
 		return 0, fmt.Errorf("unknown outgoing failure "+
 			"detail: %v", failureDetail.FailureString())
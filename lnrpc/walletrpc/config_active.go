@@ -63,6 +63,10 @@ type Config struct {
 	// sweeping inputs in batches back into the wallet.
 	Sweeper *sweep.UtxoSweeper
 
+	// AnchorReclaimer holds back the anchors of closed channels until fees
+	// are low. It is nil if the reclaimer isn't active.
+	AnchorReclaimer *sweep.AnchorReclaimer
+
 	// Chain is an interface that the WalletKit will use to determine state
 	// about the backing chain of the wallet.
 	Chain lnwallet.BlockChainIO
@@ -247,6 +247,9 @@ const (
 	// counterparty's who broadcasts a revoked production taproot commitment
 	// transaction.
 	WitnessType_TAPROOT_COMMITMENT_REVOKE_FINAL WitnessType = 42
+	// A witness type that allows anyone to spend the keyless pay-to-anchor
+	// output of a zero-fee commitment transaction.
+	WitnessType_P2A_ANCHOR_SPEND WitnessType = 43
 )
 
 // Enum value maps for WitnessType.
@@ -295,6 +298,7 @@ var (
 		40: "TAPROOT_HTLC_OFFERED_REMOTE_TIMEOUT_FINAL",
 		41: "TAPROOT_HTLC_ACCEPTED_REMOTE_SUCCESS_FINAL",
 		42: "TAPROOT_COMMITMENT_REVOKE_FINAL",
+		43: "P2A_ANCHOR_SPEND",
 	}
 	WitnessType_value = map[string]int32{
 		"UNKNOWN_WITNESS":                                    0,
@@ -340,6 +344,7 @@ var (
 		"TAPROOT_HTLC_OFFERED_REMOTE_TIMEOUT_FINAL":          40,
 		"TAPROOT_HTLC_ACCEPTED_REMOTE_SUCCESS_FINAL":         41,
 		"TAPROOT_COMMITMENT_REVOKE_FINAL":                    42,
+		"P2A_ANCHOR_SPEND":                                   43,
 	}
 )
 
@@ -2986,8 +2991,13 @@ type PendingSweep struct {
 	// The block height which the input's locktime will expire at. Zero if the
 	// input has no locktime.
 	MaturityHeight uint32 `protobuf:"varint,15,opt,name=maturity_height,json=maturityHeight,proto3" json:"maturity_height,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Whether this is an anchor output of a closed channel that is held back by
+	// the anchor reclaimer until the fee rate drops below the configured
+	// threshold. Such outputs are not yet known to the sweeper and will be swept
+	// together in a single batch once fees are low enough.
+	AwaitingLowFees bool `protobuf:"varint,16,opt,name=awaiting_low_fees,json=awaitingLowFees,proto3" json:"awaiting_low_fees,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *PendingSweep) Reset() {
@@ -3130,6 +3140,13 @@ func (x *PendingSweep) GetMaturityHeight() uint32 {
 	return 0
 }
 
+func (x *PendingSweep) GetAwaitingLowFees() bool {
+	if x != nil {
+		return x.AwaitingLowFees
+	}
+	return false
+}
+
 type PendingSweepsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -4868,7 +4885,7 @@ const file_walletrpc_walletkit_proto_rawDesc = "" +
 	"\x13EstimateFeeResponse\x12\x1c\n" +
 	"\n" +
 	"sat_per_kw\x18\x01 \x01(\x03R\bsatPerKw\x125\n" +
-	"\x18min_relay_fee_sat_per_kw\x18\x02 \x01(\x03R\x13minRelayFeeSatPerKw\"\x90\x05\n" +
+	"\x18min_relay_fee_sat_per_kw\x18\x02 \x01(\x03R\x13minRelayFeeSatPerKw\"\xbc\x05\n" +
 	"\fPendingSweep\x12+\n" +
 	"\boutpoint\x18\x01 \x01(\v2\x0f.lnrpc.OutPointR\boutpoint\x129\n" +
 	"\fwitness_type\x18\x02 \x01(\x0e2\x16.walletrpc.WitnessTypeR\vwitnessType\x12\x1d\n" +
@@ -4887,7 +4904,8 @@ const file_walletrpc_walletkit_proto_rawDesc = "" +
 	"\timmediate\x18\f \x01(\bR\timmediate\x12\x16\n" +
 	"\x06budget\x18\r \x01(\x04R\x06budget\x12'\n" +
 	"\x0fdeadline_height\x18\x0e \x01(\rR\x0edeadlineHeight\x12'\n" +
-	"\x0fmaturity_height\x18\x0f \x01(\rR\x0ematurityHeight\"\x16\n" +
+	"\x0fmaturity_height\x18\x0f \x01(\rR\x0ematurityHeight\x12*\n" +
+	"\x11awaiting_low_fees\x18\x10 \x01(\bR\x0fawaitingLowFees\"\x16\n" +
 	"\x14PendingSweepsRequest\"W\n" +
 	"\x15PendingSweepsResponse\x12>\n" +
 	"\x0epending_sweeps\x18\x01 \x03(\v2\x17.walletrpc.PendingSweepR\rpendingSweeps\"\x9f\x02\n" +
@@ -5002,7 +5020,7 @@ const file_walletrpc_walletkit_proto_rawDesc = "" +
 	"\x13WITNESS_PUBKEY_HASH\x10\x01\x12\x1e\n" +
 	"\x1aNESTED_WITNESS_PUBKEY_HASH\x10\x02\x12%\n" +
 	"!HYBRID_NESTED_WITNESS_PUBKEY_HASH\x10\x03\x12\x12\n" +
-	"\x0eTAPROOT_PUBKEY\x10\x04*\xb7\f\n" +
+	"\x0eTAPROOT_PUBKEY\x10\x04*\xcd\f\n" +
 	"\vWitnessType\x12\x13\n" +
 	"\x0fUNKNOWN_WITNESS\x10\x00\x12\x18\n" +
 	"\x14COMMITMENT_TIME_LOCK\x10\x01\x12\x17\n" +
@@ -5047,7 +5065,8 @@ const file_walletrpc_walletkit_proto_rawDesc = "" +
 	"0TAPROOT_HTLC_ACCEPTED_SUCCESS_SECOND_LEVEL_FINAL\x10'\x12-\n" +
 	")TAPROOT_HTLC_OFFERED_REMOTE_TIMEOUT_FINAL\x10(\x12.\n" +
 	"*TAPROOT_HTLC_ACCEPTED_REMOTE_SUCCESS_FINAL\x10)\x12#\n" +
-	"\x1fTAPROOT_COMMITMENT_REVOKE_FINAL\x10**V\n" +
+	"\x1fTAPROOT_COMMITMENT_REVOKE_FINAL\x10*\x12\x14\n" +
+	"\x10P2A_ANCHOR_SPEND\x10+*V\n" +
 	"\x11ChangeAddressType\x12#\n" +
 	"\x1fCHANGE_ADDRESS_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18CHANGE_ADDRESS_TYPE_P2TR\x10\x012\xaa\x12\n" +
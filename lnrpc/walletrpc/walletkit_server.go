@@ -249,6 +249,7 @@ var (
 		input.TaprootHtlcOfferedRemoteTimeoutFinal:         WitnessType_TAPROOT_HTLC_OFFERED_REMOTE_TIMEOUT_FINAL,
 		input.TaprootHtlcAcceptedRemoteSuccessFinal:        WitnessType_TAPROOT_HTLC_ACCEPTED_REMOTE_SUCCESS_FINAL,
 		input.TaprootCommitmentRevokeFinal:                 WitnessType_TAPROOT_COMMITMENT_REVOKE_FINAL,
+		input.P2AAnchorSpend:                               WitnessType_P2A_ANCHOR_SPEND,
 	}
 )
 
@@ -1008,6 +1009,29 @@ func (w *WalletKit) PendingSweeps(ctx context.Context,
 		rpcPendingSweeps = append(rpcPendingSweeps, ps)
 	}
 
+	// Also include the anchors of closed channels that are held back until
+	// fees are low.
+	if w.cfg.AnchorReclaimer != nil {
+		for _, inp := range w.cfg.AnchorReclaimer.PendingAnchors() {
+			witnessType, ok := allWitnessTypes[inp.WitnessType]
+			if !ok {
+				return nil, fmt.Errorf("unhandled witness type "+
+					"%v for input %v", inp.WitnessType,
+					inp.OutPoint)
+			}
+
+			op := lnrpc.MarshalOutPoint(&inp.OutPoint)
+			ps := &PendingSweep{
+				Outpoint:        op,
+				WitnessType:     witnessType,
+				AmountSat:       uint32(inp.Amount),
+				Budget:          uint64(inp.Params.Budget),
+				AwaitingLowFees: true,
+			}
+			rpcPendingSweeps = append(rpcPendingSweeps, ps)
+		}
+	}
+
 	return &PendingSweepsResponse{
 		PendingSweeps: rpcPendingSweeps,
 	}, nil
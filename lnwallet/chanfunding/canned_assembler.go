@@ -143,6 +143,14 @@ func (s *ShimIntent) RemoteFundingAmt() btcutil.Amount {
 	return s.remoteFundingAmt
 }
 
+// AddRemoteFunding increases the amount the remote party puts into the
+// channel. This is used when the funding transaction is constructed together
+// with the remote party, which only announces its contribution after the
+// intent was created.
+func (s *ShimIntent) AddRemoteFunding(amt btcutil.Amount) {
+	s.remoteFundingAmt += amt
+}
+
 // ChanPoint returns the final outpoint that will create the funding output
 // described above.
 //
@@ -131,6 +131,12 @@ var (
 	ErrForceCloseLocalDataLoss = errors.New("cannot force close " +
 		"channel with local data loss")
 
+	// ErrZeroFeeCommitment is returned when a fee update is sent or
+	// received on a channel using zero-fee commitments, whose commitment
+	// transactions never pay a fee.
+	ErrZeroFeeCommitment = errors.New("fee updates are not allowed on " +
+		"zero-fee commitment channels")
+
 	// errNoNonce is returned when a nonce is required, but none is found.
 	errNoNonce = errors.New("no nonce found")
 
@@ -440,6 +446,7 @@ func (c *commitment) toDiskCommit(
 			Incoming:      false,
 			OnionBlob:     htlc.OnionBlob,
 			BlindingPoint: htlc.BlindingPoint,
+			UpfrontFee:    htlc.UpfrontFee,
 			CustomRecords: htlc.CustomRecords.Copy(),
 		}
 
@@ -466,6 +473,7 @@ func (c *commitment) toDiskCommit(
 			Incoming:      true,
 			OnionBlob:     htlc.OnionBlob,
 			BlindingPoint: htlc.BlindingPoint,
+			UpfrontFee:    htlc.UpfrontFee,
 			CustomRecords: htlc.CustomRecords.Copy(),
 		}
 		if whoseCommit.IsLocal() && htlc.sig != nil {
@@ -576,6 +584,7 @@ func (lc *LightningChannel) diskHtlcToPayDesc(feeRate chainfee.SatPerKWeight,
 		theirPkScript:      theirP2WSH,
 		theirWitnessScript: theirWitnessScript,
 		BlindingPoint:      htlc.BlindingPoint,
+		UpfrontFee:         htlc.UpfrontFee,
 		CustomRecords:      customRecords,
 	}, nil
 }
@@ -1145,6 +1154,7 @@ func (lc *LightningChannel) logUpdateToPayDesc(logUpdate *channeldb.LogUpdate,
 			LogIndex:      logUpdate.LogIndex,
 			OnionBlob:     wireMsg.OnionBlob,
 			BlindingPoint: wireMsg.BlindingPoint,
+			UpfrontFee:    wireMsg.UpfrontFee,
 			CustomRecords: wireMsg.CustomRecords.Copy(),
 			addCommitHeights: lntypes.Dual[uint64]{
 				Remote: commitHeight,
@@ -1214,6 +1224,9 @@ func (lc *LightningChannel) logUpdateToPayDesc(logUpdate *channeldb.LogUpdate,
 			LogIndex:    logUpdate.LogIndex,
 			EntryType:   Fail,
 			FailReason:  wireMsg.Reason[:],
+			FailAttrData: wireMsg.AttrData.ValOpt().UnwrapOr(
+				nil,
+			),
 			removeCommitHeights: lntypes.Dual[uint64]{
 				Remote: commitHeight,
 			},
@@ -1314,6 +1327,9 @@ func (lc *LightningChannel) localLogUpdateToPayDesc(logUpdate *channeldb.LogUpda
 			LogIndex:    logUpdate.LogIndex,
 			EntryType:   Fail,
 			FailReason:  wireMsg.Reason[:],
+			FailAttrData: wireMsg.AttrData.ValOpt().UnwrapOr(
+				nil,
+			),
 			removeCommitHeights: lntypes.Dual[uint64]{
 				Remote: commitHeight,
 			},
@@ -1385,6 +1401,7 @@ func (lc *LightningChannel) remoteLogUpdateToPayDesc(logUpdate *channeldb.LogUpd
 			LogIndex:      logUpdate.LogIndex,
 			OnionBlob:     wireMsg.OnionBlob,
 			BlindingPoint: wireMsg.BlindingPoint,
+			UpfrontFee:    wireMsg.UpfrontFee,
 			CustomRecords: wireMsg.CustomRecords.Copy(),
 			addCommitHeights: lntypes.Dual[uint64]{
 				Local: commitHeight,
@@ -1433,6 +1450,9 @@ func (lc *LightningChannel) remoteLogUpdateToPayDesc(logUpdate *channeldb.LogUpd
 			LogIndex:    logUpdate.LogIndex,
 			EntryType:   Fail,
 			FailReason:  wireMsg.Reason[:],
+			FailAttrData: wireMsg.AttrData.ValOpt().UnwrapOr(
+				nil,
+			),
 			removeCommitHeights: lntypes.Dual[uint64]{
 				Local: commitHeight,
 			},
@@ -2156,9 +2176,9 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 			return l.RemoteAuxLeaf
 		},
 	)(auxResult.AuxLeaves)
-	isRemoteInitiator := !chanState.IsInitiator
+	isRemoteLeaseSeller := !chanState.IsLeaseSeller()
 	ourScript, ourDelay, err := CommitScriptToRemote(
-		chanState.ChanType, isRemoteInitiator, keyRing.ToRemoteKey,
+		chanState.ChanType, isRemoteLeaseSeller, keyRing.ToRemoteKey,
 		leaseExpiry, remoteAuxLeaf,
 	)
 	if err != nil {
@@ -2172,7 +2192,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 	)(auxResult.AuxLeaves)
 	theirDelay := uint32(chanState.RemoteChanCfg.CsvDelay)
 	theirScript, err := CommitScriptToSelf(
-		chanState.ChanType, isRemoteInitiator, keyRing.ToLocalKey,
+		chanState.ChanType, isRemoteLeaseSeller, keyRing.ToLocalKey,
 		keyRing.RevocationKey, theirDelay, leaseExpiry, localAuxLeaf,
 	)
 	if err != nil {
@@ -2402,7 +2422,7 @@ func createHtlcRetribution(chanState *channeldb.OpenChannel,
 	var emptyRetribution HtlcRetribution
 
 	theirDelay := uint32(chanState.RemoteChanCfg.CsvDelay)
-	isRemoteInitiator := !chanState.IsInitiator
+	isRemoteLeaseSeller := !chanState.IsLeaseSeller()
 
 	// We'll generate the original second level witness script now, as
 	// we'll need it if we're revoking an HTLC output on the remote
@@ -2425,7 +2445,7 @@ func createHtlcRetribution(chanState *channeldb.OpenChannel,
 		},
 	)(auxLeaves)
 	secondLevelScript, err := SecondLevelHtlcScript(
-		chanState.ChanType, isRemoteInitiator,
+		chanState.ChanType, isRemoteLeaseSeller,
 		keyRing.RevocationKey, keyRing.ToLocalKey, theirDelay,
 		leaseExpiry, fn.FlattenOption(secondLevelAuxLeaf),
 	)
@@ -2892,7 +2912,8 @@ func (lc *LightningChannel) fetchCommitmentView(
 	}
 
 	// We'll assert that there hasn't been a mistake during fee calculation
-	// leading to a fee too low.
+	// leading to a fee too low. Zero-fee commitments don't pay any fee by
+	// design.
 	var totalOut btcutil.Amount
 	for _, txOut := range commitTx.txn.TxOut {
 		totalOut += btcutil.Amount(txOut.Value)
@@ -2913,7 +2934,9 @@ func (lc *LightningChannel) fetchCommitmentView(
 
 	effFeeRate := chainfee.SatPerKWeight(fee) * 1000 /
 		chainfee.SatPerKWeight(weight)
-	if effFeeRate < chainfee.AbsoluteFeePerKwFloor {
+	if !lc.channelState.ChanType.HasZeroFeeCommitments() &&
+		effFeeRate < chainfee.AbsoluteFeePerKwFloor {
+
 		return nil, fmt.Errorf("height=%v, for ChannelPoint(%v) "+
 			"attempts to create commitment with feerate %v: %v",
 			nextHeight, lc.channelState.FundingOutpoint,
@@ -3155,6 +3178,24 @@ func (lc *LightningChannel) evaluateHTLCView(view *HtlcView,
 						return acc - int64(entry.Amount)
 					},
 				)
+
+				// Any upfront fee attached to the HTLC is paid
+				// out unconditionally the moment the HTLC is
+				// added, so we move it from the adding party's
+				// balance to its counterparty's.
+				upfrontFee := int64(entry.upfrontFee())
+				balanceDeltas.ModifyForParty(
+					party,
+					func(acc int64) int64 {
+						return acc - upfrontFee
+					},
+				)
+				balanceDeltas.ModifyForParty(
+					party.CounterParty(),
+					func(acc int64) int64 {
+						return acc + upfrontFee
+					},
+				)
 			}
 		}
 
@@ -3341,10 +3382,10 @@ func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
 	chan struct{}, error) {
 
 	var (
-		isRemoteInitiator = !chanState.IsInitiator
-		localChanCfg      = chanState.LocalChanCfg
-		remoteChanCfg     = chanState.RemoteChanCfg
-		chanType          = chanState.ChanType
+		isRemoteLeaseSeller = !chanState.IsLeaseSeller()
+		localChanCfg        = chanState.LocalChanCfg
+		remoteChanCfg       = chanState.RemoteChanCfg
+		chanType            = chanState.ChanType
 	)
 
 	txHash := remoteCommitView.txn.TxHash()
@@ -3417,7 +3458,7 @@ func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
 			Index: uint32(htlc.remoteOutputIndex),
 		}
 		sigJob.Tx, err = CreateHtlcTimeoutTx(
-			chanType, isRemoteInitiator, op, outputAmt,
+			chanType, isRemoteLeaseSeller, op, outputAmt,
 			htlc.Timeout, uint32(remoteChanCfg.CsvDelay),
 			leaseExpiry, keyRing.RevocationKey, keyRing.ToLocalKey,
 			auxLeaf,
@@ -3499,7 +3540,7 @@ func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
 			Index: uint32(htlc.remoteOutputIndex),
 		}
 		sigJob.Tx, err = CreateHtlcSuccessTx(
-			chanType, isRemoteInitiator, op, outputAmt,
+			chanType, isRemoteLeaseSeller, op, outputAmt,
 			uint32(remoteChanCfg.CsvDelay), leaseExpiry,
 			keyRing.RevocationKey, keyRing.ToLocalKey,
 			auxLeaf,
@@ -3914,8 +3955,11 @@ func (lc *LightningChannel) validateCommitmentSanity(theirLogCounter,
 	feePerKw := filteredView.FeePerKw
 
 	// Ensure that the fee being applied is enough to be relayed across the
-	// network in a reasonable time frame.
-	if feePerKw < chainfee.FeePerKwFloor {
+	// network in a reasonable time frame. Zero-fee commitments are relayed
+	// as a package together with a child paying for them instead.
+	if !lc.channelState.ChanType.HasZeroFeeCommitments() &&
+		feePerKw < chainfee.FeePerKwFloor {
+
 		return fmt.Errorf("commitment fee per kw %v below fee floor %v",
 			feePerKw, chainfee.FeePerKwFloor)
 	}
@@ -4972,9 +5016,9 @@ func genHtlcSigValidationJobs(chanState *channeldb.OpenChannel,
 	sigBlob fn.Option[tlv.Blob]) ([]VerifyJob, []AuxVerifyJob, error) {
 
 	var (
-		isLocalInitiator = chanState.IsInitiator
-		localChanCfg     = chanState.LocalChanCfg
-		chanType         = chanState.ChanType
+		isLocalLeaseSeller = chanState.IsLeaseSeller()
+		localChanCfg       = chanState.LocalChanCfg
+		chanType           = chanState.ChanType
 	)
 
 	txHash := localCommitmentView.txn.TxHash()
@@ -5063,7 +5107,7 @@ func genHtlcSigValidationJobs(chanState *channeldb.OpenChannel,
 				})(auxResult.AuxLeaves)
 
 				successTx, err := CreateHtlcSuccessTx(
-					chanType, isLocalInitiator, op,
+					chanType, isLocalLeaseSeller, op,
 					outputAmt, uint32(localChanCfg.CsvDelay),
 					leaseExpiry, keyRing.RevocationKey,
 					keyRing.ToLocalKey, auxLeaf,
@@ -5156,7 +5200,7 @@ func genHtlcSigValidationJobs(chanState *channeldb.OpenChannel,
 				})(auxResult.AuxLeaves)
 
 				timeoutTx, err := CreateHtlcTimeoutTx(
-					chanType, isLocalInitiator, op,
+					chanType, isLocalLeaseSeller, op,
 					outputAmt, htlc.Timeout,
 					uint32(localChanCfg.CsvDelay),
 					leaseExpiry, keyRing.RevocationKey,
@@ -6387,6 +6431,7 @@ func (lc *LightningChannel) htlcAddDescriptor(htlc *lnwire.UpdateAddHTLC,
 		OnionBlob:      htlc.OnionBlob,
 		OpenCircuitKey: openKey,
 		BlindingPoint:  htlc.BlindingPoint,
+		UpfrontFee:     htlc.UpfrontFee,
 		CustomRecords:  customRecords,
 	}
 }
@@ -6456,6 +6501,7 @@ func (lc *LightningChannel) ReceiveHTLC(htlc *lnwire.UpdateAddHTLC) (uint64,
 		HtlcIndex:     lc.updateLogs.Remote.htlcCounter,
 		OnionBlob:     htlc.OnionBlob,
 		BlindingPoint: htlc.BlindingPoint,
+		UpfrontFee:    htlc.UpfrontFee,
 		CustomRecords: customRecords,
 	}
 
@@ -6617,9 +6663,9 @@ func (lc *LightningChannel) ReceiveHTLCSettle(preimage [32]byte, htlcIndex uint6
 //
 // NOTE: It is okay for sourceRef, destRef, and closeKey to be nil when unit
 // testing the wallet.
-func (lc *LightningChannel) FailHTLC(htlcIndex uint64, reason []byte,
-	sourceRef *channeldb.AddRef, destRef *channeldb.SettleFailRef,
-	closeKey *models.CircuitKey) error {
+func (lc *LightningChannel) FailHTLC(htlcIndex uint64, reason,
+	attrData []byte, sourceRef *channeldb.AddRef,
+	destRef *channeldb.SettleFailRef, closeKey *models.CircuitKey) error {
 
 	lc.Lock()
 	defer lc.Unlock()
@@ -6643,6 +6689,7 @@ func (lc *LightningChannel) FailHTLC(htlcIndex uint64, reason []byte,
 		LogIndex:         lc.updateLogs.Local.logIndex,
 		EntryType:        Fail,
 		FailReason:       reason,
+		FailAttrData:     attrData,
 		SourceRef:        sourceRef,
 		DestRef:          destRef,
 		ClosedCircuitKey: closeKey,
@@ -6712,8 +6759,8 @@ func (lc *LightningChannel) MalformedFailHTLC(htlcIndex uint64,
 // inserting an entry which will remove the target log entry within the next
 // commitment update. This method should be called in response to the upstream
 // party cancelling an outgoing HTLC.
-func (lc *LightningChannel) ReceiveFailHTLC(htlcIndex uint64, reason []byte,
-) error {
+func (lc *LightningChannel) ReceiveFailHTLC(htlcIndex uint64, reason,
+	attrData []byte) error {
 
 	lc.Lock()
 	defer lc.Unlock()
@@ -6730,13 +6777,14 @@ func (lc *LightningChannel) ReceiveFailHTLC(htlcIndex uint64, reason []byte,
 	}
 
 	pd := &paymentDescriptor{
-		ChanID:      lc.ChannelID(),
-		Amount:      htlc.Amount,
-		RHash:       htlc.RHash,
-		ParentIndex: htlc.HtlcIndex,
-		LogIndex:    lc.updateLogs.Remote.logIndex,
-		EntryType:   Fail,
-		FailReason:  reason,
+		ChanID:       lc.ChannelID(),
+		Amount:       htlc.Amount,
+		RHash:        htlc.RHash,
+		ParentIndex:  htlc.HtlcIndex,
+		LogIndex:     lc.updateLogs.Remote.logIndex,
+		EntryType:    Fail,
+		FailReason:   reason,
+		FailAttrData: attrData,
 	}
 
 	lc.updateLogs.Remote.appendUpdate(pd)
@@ -7100,11 +7148,11 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, //nolint:funlen
 	// Next, we'll obtain HTLC resolutions for all the outgoing HTLC's we
 	// had on their commitment transaction.
 	var (
-		leaseExpiry       uint32
-		selfPoint         *wire.OutPoint
-		localBalance      int64
-		isRemoteInitiator = !chanState.IsInitiator
-		commitTxBroadcast = commitSpend.SpendingTx
+		leaseExpiry         uint32
+		selfPoint           *wire.OutPoint
+		localBalance        int64
+		isRemoteLeaseSeller = !chanState.IsLeaseSeller()
+		commitTxBroadcast   = commitSpend.SpendingTx
 	)
 
 	if chanState.ChanType.HasLeaseExpiration() {
@@ -7115,7 +7163,7 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, //nolint:funlen
 		signer, remoteCommit.Htlcs, keyRing, &chanState.LocalChanCfg,
 		&chanState.RemoteChanCfg, commitSpend.SpendingTx,
 		commitTxHeight, chanState.ChanType,
-		isRemoteInitiator, leaseExpiry, chanState, auxResult.AuxLeaves,
+		isRemoteLeaseSeller, leaseExpiry, chanState, auxResult.AuxLeaves,
 		auxResolver,
 	)
 	if err != nil {
@@ -7132,7 +7180,7 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, //nolint:funlen
 		},
 	)(auxResult.AuxLeaves)
 	selfScript, maturityDelay, err := CommitScriptToRemote(
-		chanState.ChanType, isRemoteInitiator, keyRing.ToRemoteKey,
+		chanState.ChanType, isRemoteLeaseSeller, keyRing.ToRemoteKey,
 		leaseExpiry, remoteAuxLeaf,
 	)
 	if err != nil {
@@ -8250,6 +8298,11 @@ type AnchorResolution struct {
 
 	// CommitWeight is the weight of the commit tx.
 	CommitWeight lntypes.WeightUnit
+
+	// CommitTx is the signed commit tx. It is only set for our own
+	// broadcast zero-fee commitment, which pays no fee and therefore must
+	// be published along with the anchor spend as a package.
+	CommitTx *wire.MsgTx
 }
 
 // LocalForceCloseSummary describes the final commitment state before the
@@ -8432,7 +8485,7 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel,
 		},
 	)(auxResult.AuxLeaves)
 	toLocalScript, err := CommitScriptToSelf(
-		chanState.ChanType, chanState.IsInitiator, keyRing.ToLocalKey,
+		chanState.ChanType, chanState.IsLeaseSeller(), keyRing.ToLocalKey,
 		keyRing.RevocationKey, csvTimeout, leaseExpiry, localAuxLeaf,
 	)
 	if err != nil {
@@ -8555,7 +8608,7 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel,
 		chainfee.SatPerKWeight(localCommit.FeePerKw), lntypes.Local,
 		signer, localCommit.Htlcs, keyRing, &chanState.LocalChanCfg,
 		&chanState.RemoteChanCfg, commitTx, commitTxHeight,
-		chanState.ChanType, chanState.IsInitiator, leaseExpiry,
+		chanState.ChanType, chanState.IsLeaseSeller(), leaseExpiry,
 		chanState, auxResult.AuxLeaves, auxResolver,
 	)
 	if err != nil {
@@ -8993,6 +9046,22 @@ func (lc *LightningChannel) NewAnchorResolutions() (*AnchorResolutions,
 	if err != nil {
 		return nil, err
 	}
+
+	// A zero-fee commitment can't enter the mempool on its own, so if we
+	// broadcast it, we attach it to the resolution to publish it along
+	// with the anchor spend.
+	if localRes != nil && lc.channelState.ChanType.HasZeroFeeCommitments() {
+		closeTx, err := lc.channelState.BroadcastedCommitment()
+		switch {
+		case err == nil &&
+			closeTx.TxHash() == localRes.CommitAnchor.Hash:
+
+			localRes.CommitTx = closeTx
+
+		case err != nil && !errors.Is(err, channeldb.ErrNoCloseTx):
+			return nil, err
+		}
+	}
 	resolutions.Local = localRes
 
 	// Add anchor for remote commitment tx, if any.
@@ -9047,6 +9116,12 @@ func NewAnchorResolution(chanState *channeldb.OpenChannel,
 		return nil, nil
 	}
 
+	// Zero-fee commitments have a single shared anchor instead of keyed
+	// ones.
+	if chanState.ChanType.HasZeroFeeCommitments() {
+		return newSharedAnchorResolution(chanState, commitTx), nil
+	}
+
 	// Derive our local anchor script. For taproot channels, rather than
 	// use the same multi-sig key for both commitments, the anchor script
 	// will differ depending on if this is our local or remote
@@ -9161,6 +9236,49 @@ func NewAnchorResolution(chanState *channeldb.OpenChannel,
 	}, nil
 }
 
+// newSharedAnchorResolution returns the information that is required to sweep
+// the shared anchor of a zero-fee commitment. The shared anchor is a
+// pay-to-anchor output, so it can be spent by anyone with an empty witness.
+func newSharedAnchorResolution(chanState *channeldb.OpenChannel,
+	commitTx *wire.MsgTx) *AnchorResolution {
+
+	found, index := input.FindScriptOutputIndex(
+		commitTx, SharedAnchorScript(),
+	)
+	if !found {
+		return nil
+	}
+	anchorOut := commitTx.TxOut[index]
+
+	// The commit tx doesn't yet include the witness spending the funding
+	// output, so we add the (worst case) weight for that too.
+	utx := btcutil.NewTx(commitTx)
+	weight := blockchain.GetTransactionWeight(utx) +
+		input.WitnessCommitmentTxWeight
+
+	// The commitment doesn't pay a fee, unless the value of trimmed
+	// outputs exceeded the maximum value of the shared anchor.
+	fee := chanState.Capacity
+	for _, out := range commitTx.TxOut {
+		fee -= btcutil.Amount(out.Value)
+	}
+
+	return &AnchorResolution{
+		CommitAnchor: wire.OutPoint{
+			Hash:  commitTx.TxHash(),
+			Index: index,
+		},
+		AnchorSignDescriptor: input.SignDescriptor{
+			Output: &wire.TxOut{
+				PkScript: anchorOut.PkScript,
+				Value:    anchorOut.Value,
+			},
+		},
+		CommitWeight: lntypes.WeightUnit(weight),
+		CommitFee:    fee,
+	}
+}
+
 // AvailableBalance returns the current balance available for sending within
 // the channel. By available balance, we mean that if at this very instance a
 // new commitment were to be created which evals all the log entries, what
@@ -9416,6 +9534,10 @@ func (lc *LightningChannel) UpdateFee(feePerKw chainfee.SatPerKWeight) error {
 		return fmt.Errorf("local fee update as non-initiator")
 	}
 
+	if lc.channelState.ChanType.HasZeroFeeCommitments() {
+		return ErrZeroFeeCommitment
+	}
+
 	// Ensure that the passed fee rate meets our current requirements.
 	if err := lc.validateFeeRate(feePerKw); err != nil {
 		return err
@@ -9493,6 +9615,10 @@ func (lc *LightningChannel) ReceiveUpdateFee(feePerKw chainfee.SatPerKWeight) er
 		return fmt.Errorf("received fee update as initiator")
 	}
 
+	if lc.channelState.ChanType.HasZeroFeeCommitments() {
+		return ErrZeroFeeCommitment
+	}
+
 	// TODO(roasbeef): or just modify to use the other balance?
 	pd := &paymentDescriptor{
 		ChanID:    lc.ChannelID(),
@@ -9842,8 +9968,8 @@ func (lc *LightningChannel) LocalBalanceDust() (bool, btcutil.Amount) {
 	// If this is an anchor channel, and we're the initiator, then we'll
 	// regain the stats allocated to the anchor outputs with the co-op
 	// close transaction.
-	if chanState.ChanType.HasAnchors() && chanState.IsInitiator {
-		localBalance += 2 * AnchorSize
+	if chanState.IsInitiator {
+		localBalance += AnchorsValue(chanState.ChanType)
 	}
 
 	localDust := chanState.LocalChanCfg.DustLimit
@@ -9864,8 +9990,8 @@ func (lc *LightningChannel) RemoteBalanceDust() (bool, btcutil.Amount) {
 	// If this is an anchor channel, and they're the initiator, then we'll
 	// regain the stats allocated to the anchor outputs with the co-op
 	// close transaction.
-	if chanState.ChanType.HasAnchors() && !chanState.IsInitiator {
-		remoteBalance += 2 * AnchorSize
+	if !chanState.IsInitiator {
+		remoteBalance += AnchorsValue(chanState.ChanType)
 	}
 
 	remoteDust := chanState.RemoteChanCfg.DustLimit
@@ -9885,12 +10011,10 @@ func (lc *LightningChannel) CommitBalances() (btcutil.Amount, btcutil.Amount) {
 	localBalance := localCommit.LocalBalance.ToSatoshis()
 	remoteBalance := localCommit.RemoteBalance.ToSatoshis()
 
-	if chanState.ChanType.HasAnchors() {
-		if chanState.IsInitiator {
-			localBalance += 2 * AnchorSize
-		} else {
-			remoteBalance += 2 * AnchorSize
-		}
+	if chanState.IsInitiator {
+		localBalance += AnchorsValue(chanState.ChanType)
+	} else {
+		remoteBalance += AnchorsValue(chanState.ChanType)
 	}
 
 	return localBalance, remoteBalance
@@ -465,9 +465,9 @@ func TestChannelZeroAddLocalHeight(t *testing.T) {
 
 	// Now Bob should fail the htlc back to Alice.
 	// <----fail-----
-	err = bobChannel.FailHTLC(0, []byte("failreason"), nil, nil, nil)
+	err = bobChannel.FailHTLC(0, []byte("failreason"), nil, nil, nil, nil)
 	require.NoError(t, err)
-	err = aliceChannel.ReceiveFailHTLC(0, []byte("bad"))
+	err = aliceChannel.ReceiveFailHTLC(0, []byte("bad"), nil)
 	require.NoError(t, err)
 
 	// Bob should send a commitment signature to Alice.
@@ -2219,9 +2219,9 @@ func TestCancelHTLC(t *testing.T) {
 
 	// Now, with the HTLC committed on both sides, trigger a cancellation
 	// from Bob to Alice, removing the HTLC.
-	err = bobChannel.FailHTLC(bobHtlcIndex, []byte("failreason"), nil, nil, nil)
+	err = bobChannel.FailHTLC(bobHtlcIndex, []byte("failreason"), nil, nil, nil, nil)
 	require.NoError(t, err, "unable to cancel HTLC")
-	err = aliceChannel.ReceiveFailHTLC(aliceHtlcIndex, []byte("bad"))
+	err = aliceChannel.ReceiveFailHTLC(aliceHtlcIndex, []byte("bad"), nil)
 	require.NoError(t, err, "unable to recv htlc cancel")
 
 	// Now trigger another state transition, the HTLC should now be removed
@@ -2285,6 +2285,71 @@ func TestCancelHTLC(t *testing.T) {
 	}
 }
 
+// TestUpfrontFeeHTLC tests that the upfront fee attached to an HTLC is moved
+// to the receiver's balance as soon as the HTLC is locked in, that it is kept
+// by the receiver when the HTLC is failed and that it survives a restart.
+func TestUpfrontFeeHTLC(t *testing.T) {
+	t.Parallel()
+
+	// Create a test channel which will be used for the duration of this
+	// unittest. The channel will be funded evenly with Alice having 5 BTC,
+	// and Bob having 5 BTC.
+	aliceChannel, bobChannel, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err, "unable to create test channels")
+
+	const upfrontFee = btcutil.Amount(1000)
+
+	htlcAmt := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin)
+	htlc, _ := createHTLC(0, htlcAmt)
+	htlc.UpfrontFee = tlv.SomeRecordT(
+		tlv.NewRecordT[lnwire.UpfrontFeeTlvType](
+			lnwire.NewMSatFromSatoshis(upfrontFee),
+		),
+	)
+
+	aliceHtlcIndex, err := aliceChannel.AddHTLC(htlc, nil)
+	require.NoError(t, err, "unable to add alice htlc")
+	bobHtlcIndex, err := bobChannel.ReceiveHTLC(htlc)
+	require.NoError(t, err, "unable to add bob htlc")
+	require.NoError(t, ForceStateTransition(aliceChannel, bobChannel))
+
+	// With the HTLC locked in, Alice should have paid both the HTLC amount
+	// and the upfront fee, while Bob has already been credited the fee.
+	fiveBTC := btcutil.Amount(btcutil.SatoshiPerBitcoin * 5)
+	assertChannelBalances(
+		t, aliceChannel, bobChannel,
+		fiveBTC-btcutil.SatoshiPerBitcoin-upfrontFee-
+			calcStaticFee(channeldb.SingleFunderTweaklessBit, 1),
+		fiveBTC+upfrontFee,
+	)
+
+	// The upfront fee should be persisted along with the HTLC.
+	bobChannel, err = restartChannel(bobChannel)
+	require.NoError(t, err)
+	bobHtlcs := bobChannel.channelState.LocalCommitment.Htlcs
+	require.Len(t, bobHtlcs, 1)
+	require.Equal(t, htlc.UpfrontFee, bobHtlcs[0].UpfrontFee)
+
+	// Bob now fails the HTLC back. Only the HTLC amount should be returned
+	// to Alice, Bob keeps the upfront fee.
+	err = bobChannel.FailHTLC(
+		bobHtlcIndex, []byte("failreason"), nil, nil, nil, nil,
+	)
+	require.NoError(t, err, "unable to cancel HTLC")
+	err = aliceChannel.ReceiveFailHTLC(aliceHtlcIndex, []byte("bad"), nil)
+	require.NoError(t, err, "unable to recv htlc cancel")
+	require.NoError(t, ForceStateTransition(bobChannel, aliceChannel))
+
+	assertChannelBalances(
+		t, aliceChannel, bobChannel,
+		fiveBTC-upfrontFee-
+			calcStaticFee(channeldb.SingleFunderTweaklessBit, 0),
+		fiveBTC+upfrontFee,
+	)
+}
+
 func TestCooperativeCloseDustAdherence(t *testing.T) {
 	t.Parallel()
 
@@ -2599,6 +2664,158 @@ func TestUpdateFeeFail(t *testing.T) {
 
 }
 
+// TestZeroFeeCommitment tests that a zero-fee commitment channel creates v3
+// commitment transactions with a single shared P2A anchor holding the trimmed
+// amounts up to its maximum size, and that fee updates are rejected.
+func TestZeroFeeCommitment(t *testing.T) {
+	t.Parallel()
+
+	chanType := channeldb.SingleFunderTweaklessBit |
+		channeldb.AnchorOutputsBit | channeldb.ZeroHtlcTxFeeBit |
+		channeldb.ZeroFeeCommitmentsBit
+
+	aliceChannel, bobChannel, err := CreateTestChannels(t, chanType)
+	require.NoError(t, err, "unable to create test channels")
+
+	// assertCommitTx asserts that the commitment transaction is a v3
+	// transaction that has a single P2A anchor of the given value and
+	// pays the given fee.
+	assertCommitTx := func(commitTx *wire.MsgTx, anchorValue,
+		fee btcutil.Amount) {
+
+		t.Helper()
+
+		require.EqualValues(t, 3, commitTx.Version)
+
+		var (
+			numAnchors int
+			outputSum  btcutil.Amount
+		)
+		for _, txOut := range commitTx.TxOut {
+			outputSum += btcutil.Amount(txOut.Value)
+
+			if !txscript.IsPayToAnchorScript(txOut.PkScript) {
+				continue
+			}
+
+			numAnchors++
+			require.EqualValues(t, anchorValue, txOut.Value)
+		}
+
+		require.Equal(t, 1, numAnchors)
+		require.Equal(
+			t, aliceChannel.channelState.Capacity-fee, outputSum,
+		)
+	}
+
+	// Without any trimmed amounts, the shared anchor has no value and the
+	// commitments pay no fee.
+	assertCommitTx(aliceChannel.channelState.LocalCommitment.CommitTx, 0, 0)
+	assertCommitTx(bobChannel.channelState.LocalCommitment.CommitTx, 0, 0)
+
+	// Fee updates aren't allowed in either direction.
+	err = aliceChannel.UpdateFee(chainfee.SatPerKWeight(1000))
+	require.ErrorIs(t, err, ErrZeroFeeCommitment)
+
+	err = bobChannel.ReceiveUpdateFee(chainfee.SatPerKWeight(1000))
+	require.ErrorIs(t, err, ErrZeroFeeCommitment)
+
+	// Alice adds an HTLC that is above her dust limit, but below Bob's, and
+	// one that is below both. The trimmed HTLCs go to the shared anchor
+	// instead.
+	const (
+		htlcAmt     = btcutil.Amount(220)
+		dustHtlcAmt = btcutil.Amount(100)
+	)
+	htlc, _ := createHTLC(0, lnwire.NewMSatFromSatoshis(htlcAmt))
+	addAndReceiveHTLC(t, aliceChannel, bobChannel, htlc, nil)
+
+	dustHtlc, _ := createHTLC(1, lnwire.NewMSatFromSatoshis(dustHtlcAmt))
+	addAndReceiveHTLC(t, aliceChannel, bobChannel, dustHtlc, nil)
+
+	err = ForceStateTransition(aliceChannel, bobChannel)
+	require.NoError(t, err, "unable to complete state update")
+
+	// On Alice's commitment only the dust HTLC is trimmed. On Bob's
+	// commitment both are, and the amount exceeding the maximum anchor
+	// size goes to fees.
+	assertCommitTx(
+		aliceChannel.channelState.LocalCommitment.CommitTx,
+		dustHtlcAmt, 0,
+	)
+	assertCommitTx(
+		bobChannel.channelState.LocalCommitment.CommitTx,
+		MaxSharedAnchorSize,
+		htlcAmt+dustHtlcAmt-MaxSharedAnchorSize,
+	)
+}
+
+// TestZeroFeeCommitConstraints tests that the number of HTLCs a zero-fee
+// commitment channel accepts is limited so that the commitment stays below the
+// maximum weight of a v3 (TRUC) transaction.
+func TestZeroFeeCommitConstraints(t *testing.T) {
+	t.Parallel()
+
+	// A commitment with the maximum number of HTLCs of both sides must
+	// not exceed the maximum TRUC weight, while one more HTLC on each side
+	// would.
+	maxWeight := func(numHtlcs int) int {
+		return input.ZeroFeeCommitWeight + 2*numHtlcs*input.HTLCWeight
+	}
+	require.LessOrEqual(
+		t, maxWeight(MaxZeroFeeAcceptedHtlcs), input.TrucMaxWeight,
+	)
+	require.Greater(
+		t, maxWeight(MaxZeroFeeAcceptedHtlcs+1), input.TrucMaxWeight,
+	)
+
+	newReservation := func(
+		chanType channeldb.ChannelType) *ChannelReservation {
+
+		return &ChannelReservation{
+			partialState: &channeldb.OpenChannel{
+				ChanType: chanType,
+				Capacity: 1_000_000,
+			},
+			ourContribution: &ChannelContribution{
+				ChannelConfig: &channeldb.ChannelConfig{},
+			},
+		}
+	}
+
+	bounds := &channeldb.ChannelStateBounds{
+		ChanReserve:      10_000,
+		MaxPendingAmount: lnwire.NewMSatFromSatoshis(500_000),
+		MinHTLC:          1,
+		MaxAcceptedHtlcs: MaxZeroFeeAcceptedHtlcs + 1,
+	}
+	commitParams := &channeldb.CommitmentParams{
+		DustLimit: DustLimitForSize(input.UnknownWitnessSize),
+		CsvDelay:  144,
+	}
+
+	anchorType := channeldb.SingleFunderTweaklessBit |
+		channeldb.AnchorOutputsBit | channeldb.ZeroHtlcTxFeeBit
+	zeroFeeType := anchorType | channeldb.ZeroFeeCommitmentsBit
+
+	// The limit only applies to zero-fee commitment channels.
+	err := newReservation(anchorType).CommitConstraints(
+		bounds, commitParams, 1000, false,
+	)
+	require.NoError(t, err)
+
+	err = newReservation(zeroFeeType).CommitConstraints(
+		bounds, commitParams, 1000, false,
+	)
+	require.ErrorContains(t, err, "maxHtlcs is too large")
+
+	bounds.MaxAcceptedHtlcs = MaxZeroFeeAcceptedHtlcs
+	err = newReservation(zeroFeeType).CommitConstraints(
+		bounds, commitParams, 1000, false,
+	)
+	require.NoError(t, err)
+}
+
 // TestUpdateFeeConcurrentSig tests that the channel can properly handle a fee
 // update that it receives concurrently with signing its next commitment.
 func TestUpdateFeeConcurrentSig(t *testing.T) {
@@ -5716,9 +5933,9 @@ func TestChanAvailableBandwidth(t *testing.T) {
 	}
 
 	htlcIndex := uint64((numHtlcs * 2) - 1)
-	err = bobChannel.FailHTLC(htlcIndex, []byte("f"), nil, nil, nil)
+	err = bobChannel.FailHTLC(htlcIndex, []byte("f"), nil, nil, nil, nil)
 	require.NoError(t, err, "unable to cancel HTLC")
-	err = aliceChannel.ReceiveFailHTLC(htlcIndex, []byte("bad"))
+	err = aliceChannel.ReceiveFailHTLC(htlcIndex, []byte("bad"), nil)
 	require.NoError(t, err, "unable to recv htlc cancel")
 
 	// We must do a state transition before the balance is available
@@ -6172,9 +6389,9 @@ func TestLockedInHtlcForwardingSkipAfterRestart(t *testing.T) {
 
 	// With both nodes restarted, Bob will now attempt to cancel one of
 	// Alice's HTLC's.
-	err = bobChannel.FailHTLC(htlc.ID, []byte("failreason"), nil, nil, nil)
+	err = bobChannel.FailHTLC(htlc.ID, []byte("failreason"), nil, nil, nil, nil)
 	require.NoError(t, err, "unable to cancel HTLC")
-	err = aliceChannel.ReceiveFailHTLC(htlc.ID, []byte("bad"))
+	err = aliceChannel.ReceiveFailHTLC(htlc.ID, []byte("bad"), nil)
 	require.NoError(t, err, "unable to recv htlc cancel")
 
 	// We'll now initiate another state transition, but this time Bob will
@@ -6225,9 +6442,9 @@ func TestLockedInHtlcForwardingSkipAfterRestart(t *testing.T) {
 
 	// Failing the HTLC here will cause the update to be included in Alice's
 	// remote log, but it should not be committed by this transition.
-	err = bobChannel.FailHTLC(htlc2.ID, []byte("failreason"), nil, nil, nil)
+	err = bobChannel.FailHTLC(htlc2.ID, []byte("failreason"), nil, nil, nil, nil)
 	require.NoError(t, err, "unable to cancel HTLC")
-	err = aliceChannel.ReceiveFailHTLC(htlc2.ID, []byte("bad"))
+	err = aliceChannel.ReceiveFailHTLC(htlc2.ID, []byte("bad"), nil)
 	require.NoError(t, err, "unable to recv htlc cancel")
 
 	bobRevocation, _, finalHtlcs, err := bobChannel.
@@ -6280,9 +6497,9 @@ func TestLockedInHtlcForwardingSkipAfterRestart(t *testing.T) {
 
 	// Re-add the Fail to both Alice and Bob's channels, as the non-committed
 	// update will not have survived the restart.
-	err = bobChannel.FailHTLC(htlc2.ID, []byte("failreason"), nil, nil, nil)
+	err = bobChannel.FailHTLC(htlc2.ID, []byte("failreason"), nil, nil, nil, nil)
 	require.NoError(t, err, "unable to cancel HTLC")
-	err = aliceChannel.ReceiveFailHTLC(htlc2.ID, []byte("bad"))
+	err = aliceChannel.ReceiveFailHTLC(htlc2.ID, []byte("bad"), nil)
 	require.NoError(t, err, "unable to recv htlc cancel")
 
 	// Have Alice initiate a state transition, which does not include the
@@ -6727,9 +6944,9 @@ func TestDesyncHTLCs(t *testing.T) {
 	}
 
 	// Now let Bob fail this HTLC.
-	err = bobChannel.FailHTLC(bobIndex, []byte("failreason"), nil, nil, nil)
+	err = bobChannel.FailHTLC(bobIndex, []byte("failreason"), nil, nil, nil, nil)
 	require.NoError(t, err, "unable to cancel HTLC")
-	if err := aliceChannel.ReceiveFailHTLC(aliceIndex, []byte("bad")); err != nil {
+	if err := aliceChannel.ReceiveFailHTLC(aliceIndex, []byte("bad"), nil); err != nil {
 		t.Fatalf("unable to recv htlc cancel: %v", err)
 	}
 
@@ -6819,10 +7036,10 @@ func TestMaxAcceptedHTLCs(t *testing.T) {
 
 	// Bob will fail the htlc specified by htlcID and then force a state
 	// transition.
-	err = bobChannel.FailHTLC(htlcID, []byte{}, nil, nil, nil)
+	err = bobChannel.FailHTLC(htlcID, []byte{}, nil, nil, nil, nil)
 	require.NoError(t, err, "unable to fail htlc")
 
-	if err := aliceChannel.ReceiveFailHTLC(htlcID, []byte{}); err != nil {
+	if err := aliceChannel.ReceiveFailHTLC(htlcID, []byte{}, nil); err != nil {
 		t.Fatalf("unable to receive fail htlc: %v", err)
 	}
 
@@ -6925,10 +7142,10 @@ func TestMaxAsynchronousHtlcs(t *testing.T) {
 	addAndReceiveHTLC(t, aliceChannel, bobChannel, htlc, nil)
 
 	// Fail back an HTLC and sign a commitment as in steps 1 & 2.
-	err = bobChannel.FailHTLC(htlcID, []byte{}, nil, nil, nil)
+	err = bobChannel.FailHTLC(htlcID, []byte{}, nil, nil, nil, nil)
 	require.NoError(t, err, "unable to fail htlc")
 
-	if err := aliceChannel.ReceiveFailHTLC(htlcID, []byte{}); err != nil {
+	if err := aliceChannel.ReceiveFailHTLC(htlcID, []byte{}, nil); err != nil {
 		t.Fatalf("unable to receive fail htlc: %v", err)
 	}
 
@@ -7747,10 +7964,10 @@ func TestChannelRestoreUpdateLogsFailedHTLC(t *testing.T) {
 	restoreAndAssert(t, aliceChannel, 1, 0, 0, 0)
 
 	// Now we make Bob fail this HTLC.
-	err = bobChannel.FailHTLC(0, []byte("failreason"), nil, nil, nil)
+	err = bobChannel.FailHTLC(0, []byte("failreason"), nil, nil, nil, nil)
 	require.NoError(t, err, "unable to cancel HTLC")
 
-	err = aliceChannel.ReceiveFailHTLC(0, []byte("failreason"))
+	err = aliceChannel.ReceiveFailHTLC(0, []byte("failreason"), nil)
 	require.NoError(t, err, "unable to recv htlc cancel")
 
 	// This Fail update should have been added to Alice's remote update log.
@@ -7833,19 +8050,19 @@ func TestDuplicateFailRejection(t *testing.T) {
 
 	// With the HTLC locked in, we'll now have Bob fail the HTLC back to
 	// Alice.
-	err = bobChannel.FailHTLC(0, []byte("failreason"), nil, nil, nil)
+	err = bobChannel.FailHTLC(0, []byte("failreason"), nil, nil, nil, nil)
 	require.NoError(t, err, "unable to cancel HTLC")
-	if err := aliceChannel.ReceiveFailHTLC(0, []byte("bad")); err != nil {
+	if err := aliceChannel.ReceiveFailHTLC(0, []byte("bad"), nil); err != nil {
 		t.Fatalf("unable to recv htlc cancel: %v", err)
 	}
 
 	// If we attempt to fail it AGAIN, then both sides should reject this
 	// second failure attempt.
-	err = bobChannel.FailHTLC(0, []byte("failreason"), nil, nil, nil)
+	err = bobChannel.FailHTLC(0, []byte("failreason"), nil, nil, nil, nil)
 	if err == nil {
 		t.Fatalf("duplicate HTLC failure attempt should have failed")
 	}
-	if err := aliceChannel.ReceiveFailHTLC(0, []byte("bad")); err == nil {
+	if err := aliceChannel.ReceiveFailHTLC(0, []byte("bad"), nil); err == nil {
 		t.Fatalf("duplicate HTLC failure attempt should have failed")
 	}
 
@@ -7862,14 +8079,14 @@ func TestDuplicateFailRejection(t *testing.T) {
 	require.NoError(t, err, "unable to restart channel")
 
 	// If we try to fail the same HTLC again, then we should get an error.
-	err = bobChannel.FailHTLC(0, []byte("failreason"), nil, nil, nil)
+	err = bobChannel.FailHTLC(0, []byte("failreason"), nil, nil, nil, nil)
 	if err == nil {
 		t.Fatalf("duplicate HTLC failure attempt should have failed")
 	}
 
 	// Alice on the other hand should accept the failure again, as she
 	// dropped all items in the logs which weren't committed.
-	if err := aliceChannel.ReceiveFailHTLC(0, []byte("bad")); err != nil {
+	if err := aliceChannel.ReceiveFailHTLC(0, []byte("bad"), nil); err != nil {
 		t.Fatalf("unable to recv htlc cancel: %v", err)
 	}
 }
@@ -8130,9 +8347,9 @@ func TestChannelRestoreCommitHeight(t *testing.T) {
 	bobChannel = restoreAndAssertCommitHeights(t, bobChannel, true, 1, 2, 2)
 
 	// Bob now fails back the htlc that was just locked in.
-	err = bobChannel.FailHTLC(0, []byte("failreason"), nil, nil, nil)
+	err = bobChannel.FailHTLC(0, []byte("failreason"), nil, nil, nil, nil)
 	require.NoError(t, err, "unable to cancel HTLC")
-	err = aliceChannel.ReceiveFailHTLC(0, []byte("bad"))
+	err = aliceChannel.ReceiveFailHTLC(0, []byte("bad"), nil)
 	require.NoError(t, err, "unable to recv htlc cancel")
 
 	// Now Bob signs for the fail update.
@@ -9451,9 +9668,9 @@ func TestChannelUnsignedAckedFailure(t *testing.T) {
 
 	// Now Bob should fail the htlc back to Alice.
 	// <----fail-----
-	err = bobChannel.FailHTLC(0, []byte("failreason"), nil, nil, nil)
+	err = bobChannel.FailHTLC(0, []byte("failreason"), nil, nil, nil, nil)
 	require.NoError(t, err)
-	err = aliceChannel.ReceiveFailHTLC(0, []byte("bad"))
+	err = aliceChannel.ReceiveFailHTLC(0, []byte("bad"), nil)
 	require.NoError(t, err)
 
 	// Bob should send a commitment signature to Alice.
@@ -9555,9 +9772,9 @@ func TestChannelLocalUnsignedUpdatesFailure(t *testing.T) {
 
 	// Now Alice should fail the htlc back to Bob.
 	// -----fail--->
-	err = aliceChannel.FailHTLC(0, []byte("failreason"), nil, nil, nil)
+	err = aliceChannel.FailHTLC(0, []byte("failreason"), nil, nil, nil, nil)
 	require.NoError(t, err)
-	err = bobChannel.ReceiveFailHTLC(0, []byte("bad"))
+	err = bobChannel.ReceiveFailHTLC(0, []byte("bad"), nil)
 	require.NoError(t, err)
 
 	// Alice should send a commitment signature to Bob.
@@ -10998,10 +11215,10 @@ func TestAsynchronousSendingWithFeeBuffer(t *testing.T) {
 	//	<----rev-------	|---------------
 	//	<----sig-------	|---------------
 	//	---------------	|-----rev------>
-	err = aliceChannel.FailHTLC(0, []byte{}, nil, nil, nil)
+	err = aliceChannel.FailHTLC(0, []byte{}, nil, nil, nil, nil)
 	require.NoError(t, err)
 
-	err = bobChannel.ReceiveFailHTLC(0, []byte{})
+	err = bobChannel.ReceiveFailHTLC(0, []byte{}, nil)
 	require.NoError(t, err)
 
 	err = ForceStateTransition(aliceChannel, bobChannel)
@@ -21,6 +21,41 @@ import (
 // AnchorSize is the constant anchor output size.
 const AnchorSize = btcutil.Amount(330)
 
+// MaxSharedAnchorSize is the maximum value of the shared pay-to-anchor output
+// of a zero-fee commitment transaction. The shared anchor collects the value
+// of trimmed outputs up to this amount, anything above it goes to miners.
+const MaxSharedAnchorSize = btcutil.Amount(240)
+
+// AnchorsValue returns the total value of the keyed anchor outputs that is
+// deducted from the balance of the channel initiator for the given channel
+// type. Zero-fee commitments don't have keyed anchors, their shared anchor is
+// funded by trimmed outputs only.
+func AnchorsValue(chanType channeldb.ChannelType) btcutil.Amount {
+	if !chanType.HasAnchors() || chanType.HasZeroFeeCommitments() {
+		return 0
+	}
+
+	return 2 * AnchorSize
+}
+
+// CommitTxVersion returns the transaction version used for the commitment
+// transactions of the given channel type. Zero-fee commitments are v3 (TRUC)
+// transactions so they can be relayed without fee as part of a package.
+func CommitTxVersion(chanType channeldb.ChannelType) int32 {
+	if chanType.HasZeroFeeCommitments() {
+		return 3
+	}
+
+	return 2
+}
+
+// MaxZeroFeeAcceptedHtlcs is the maximum number of HTLCs either side of a
+// zero-fee commitment channel may accept. As both sides may have this many
+// HTLCs in flight, the commitment transaction with all of them stays below the
+// maximum weight of a v3 (TRUC) transaction.
+const MaxZeroFeeAcceptedHtlcs = (input.TrucMaxWeight -
+	input.ZeroFeeCommitWeight) / input.HTLCWeight / 2
+
 // DefaultAnchorsCommitMaxFeeRateSatPerVByte is the default max fee rate in
 // sat/vbyte the initiator will use for anchor channels. This should be enough
 // to ensure propagation before anchoring down the commitment transaction.
@@ -221,10 +256,11 @@ func (w *WitnessScriptDesc) WitnessScriptForPath(
 
 // CommitScriptToSelf constructs the public key script for the output on the
 // commitment transaction paying to the "owner" of said commitment transaction.
-// The `initiator` argument should correspond to the owner of the commitment
-// transaction which we are generating the to_local script for. If the other
-// party learns of the preimage to the revocation hash, then they can claim all
-// the settled funds in the channel, plus the unsettled funds.
+// The `initiator` argument should be true if the owner of the commitment
+// transaction which we are generating the to_local script for is the seller
+// of a leased channel, see OpenChannel.IsLeaseSeller. If the other party learns
+// of the preimage to the revocation hash, then they can claim all the settled
+// funds in the channel, plus the unsettled funds.
 func CommitScriptToSelf(chanType channeldb.ChannelType, initiator bool,
 	selfKey, revokeKey *btcec.PublicKey, csvDelay, leaseExpiry uint32,
 	auxLeaf input.AuxTapLeaf) (input.ScriptDescriptor, error) {
@@ -292,10 +328,11 @@ func CommitScriptToSelf(chanType channeldb.ChannelType, initiator bool,
 }
 
 // CommitScriptToRemote derives the appropriate to_remote script based on the
-// channel's commitment type. The `initiator` argument should correspond to the
+// channel's commitment type. The `initiator` argument should be true if the
 // owner of the commitment transaction which we are generating the to_remote
-// script for. The second return value is the CSV delay of the output script,
-// what must be satisfied in order to spend the output.
+// script for is the seller of a leased channel. The second return value is the
+// CSV delay of the output script, what must be satisfied in order to spend the
+// output.
 func CommitScriptToRemote(chanType channeldb.ChannelType, initiator bool,
 	remoteKey *btcec.PublicKey, leaseExpiry uint32,
 	auxLeaf input.AuxTapLeaf) (input.ScriptDescriptor, uint32, error) {
@@ -429,8 +466,8 @@ func sweepSigHash(chanType channeldb.ChannelType) txscript.SigHashType {
 // output for the second-level HTLC transactions. The second level transaction
 // act as a sort of covenant, ensuring that a 2-of-2 multi-sig output can only
 // be spent in a particular way, and to a particular output. The `initiator`
-// argument should correspond to the owner of the commitment transaction which
-// we are generating the to_local script for.
+// argument should be true if the owner of the commitment transaction which we
+// are generating the to_local script for is the seller of a leased channel.
 func SecondLevelHtlcScript(chanType channeldb.ChannelType, initiator bool,
 	revocationKey, delayKey *btcec.PublicKey, csvDelay, leaseExpiry uint32,
 	auxLeaf input.AuxTapLeaf) (input.ScriptDescriptor, error) {
@@ -493,6 +530,11 @@ func SecondLevelHtlcScript(chanType channeldb.ChannelType, initiator bool,
 // CommitWeight returns the base commitment weight before adding HTLCs.
 func CommitWeight(chanType channeldb.ChannelType) lntypes.WeightUnit {
 	switch {
+	// Zero-fee commitments replace the two keyed anchors with a single
+	// shared one.
+	case chanType.HasZeroFeeCommitments():
+		return input.ZeroFeeCommitWeight
+
 	case chanType.IsTaproot():
 		return input.TaprootCommitWeight
 
@@ -720,13 +762,17 @@ func (cb *CommitmentBuilder) createUnsignedCommitmentTx(ourBalance,
 		dustLimit = cb.chanState.RemoteChanCfg.DustLimit
 	}
 
-	numHTLCs := int64(0)
+	var (
+		numHTLCs     int64
+		trimmedHTLCs btcutil.Amount
+	)
 	for _, htlc := range filteredHTLCView.Updates.Local {
 		if HtlcIsDust(
 			cb.chanState.ChanType, false, whoseCommit, feePerKw,
 			htlc.Amount.ToSatoshis(), dustLimit,
 		) {
 
+			trimmedHTLCs += htlc.Amount.ToSatoshis()
 			continue
 		}
 
@@ -738,6 +784,7 @@ func (cb *CommitmentBuilder) createUnsignedCommitmentTx(ourBalance,
 			htlc.Amount.ToSatoshis(), dustLimit,
 		) {
 
+			trimmedHTLCs += htlc.Amount.ToSatoshis()
 			continue
 		}
 
@@ -751,6 +798,16 @@ func (cb *CommitmentBuilder) createUnsignedCommitmentTx(ourBalance,
 	totalCommitWeight := CommitWeight(cb.chanState.ChanType) +
 		lntypes.WeightUnit(input.HTLCWeight*numHTLCs)
 
+	// A zero-fee commitment is a v3 (TRUC) transaction, which won't be
+	// relayed if it exceeds the maximum weight of such transactions.
+	if cb.chanState.ChanType.HasZeroFeeCommitments() &&
+		totalCommitWeight > input.TrucMaxWeight {
+
+		return nil, fmt.Errorf("zero-fee commitment with %d htlcs "+
+			"has weight %v, exceeding the max TRUC weight of %v",
+			numHTLCs, totalCommitWeight, input.TrucMaxWeight)
+	}
+
 	// With the weight known, we can now calculate the commitment fee,
 	// ensuring that we account for any dust outputs trimmed above.
 	commitFee := feePerKw.FeeForWeight(totalCommitWeight)
@@ -806,7 +863,7 @@ func (cb *CommitmentBuilder) createUnsignedCommitmentTx(ourBalance,
 			cb.chanState.ChanType, fundingTxIn(cb.chanState), keyRing,
 			&cb.chanState.LocalChanCfg, &cb.chanState.RemoteChanCfg,
 			ourBalance.ToSatoshis(), theirBalance.ToSatoshis(),
-			numHTLCs, cb.chanState.IsInitiator, leaseExpiry,
+			numHTLCs, cb.chanState.IsLeaseSeller(), leaseExpiry,
 			auxResult.AuxLeaves,
 		)
 	} else {
@@ -814,7 +871,7 @@ func (cb *CommitmentBuilder) createUnsignedCommitmentTx(ourBalance,
 			cb.chanState.ChanType, fundingTxIn(cb.chanState), keyRing,
 			&cb.chanState.RemoteChanCfg, &cb.chanState.LocalChanCfg,
 			theirBalance.ToSatoshis(), ourBalance.ToSatoshis(),
-			numHTLCs, !cb.chanState.IsInitiator, leaseExpiry,
+			numHTLCs, !cb.chanState.IsLeaseSeller(), leaseExpiry,
 			auxResult.AuxLeaves,
 		)
 	}
@@ -903,6 +960,12 @@ func (cb *CommitmentBuilder) createUnsignedCommitmentTx(ourBalance,
 		htlcIndexes = append(htlcIndexes, htlc.HtlcIndex) //nolint
 	}
 
+	// For zero-fee commitments, the value of trimmed HTLCs goes to the
+	// shared anchor rather than to miners, up to its maximum size.
+	if cb.chanState.ChanType.HasZeroFeeCommitments() {
+		addToSharedAnchor(commitTx, trimmedHTLCs)
+	}
+
 	// Set the state hint of the commitment transaction to facilitate
 	// quickly recovering the necessary penalty state in the case of an
 	// uncooperative broadcast.
@@ -958,7 +1021,8 @@ func (cb *CommitmentBuilder) createUnsignedCommitmentTx(ourBalance,
 // spent after a relative block delay or revocation event, and a remote output
 // paying the counterparty within the channel, which can be spent immediately
 // or after a delay depending on the commitment type. The `initiator` argument
-// should correspond to the owner of the commitment transaction we are creating.
+// should be true if the owner of the commitment transaction we are creating is
+// the seller of a leased channel.
 func CreateCommitTx(chanType channeldb.ChannelType,
 	fundingOutput wire.TxIn, keyRing *CommitmentKeyRing,
 	localChanCfg, remoteChanCfg *channeldb.ChannelConfig,
@@ -996,9 +1060,9 @@ func CreateCommitTx(chanType channeldb.ChannelType,
 	}
 
 	// Now that both output scripts have been created, we can finally create
-	// the transaction itself. We use a transaction version of 2 since CSV
-	// will fail unless the tx version is >= 2.
-	commitTx := wire.NewMsgTx(2)
+	// the transaction itself. We use a transaction version of at least 2
+	// since CSV will fail unless the tx version is >= 2.
+	commitTx := wire.NewMsgTx(CommitTxVersion(chanType))
 	commitTx.AddTxIn(&fundingOutput)
 
 	// Avoid creating dust outputs within the commitment transaction.
@@ -1018,6 +1082,26 @@ func CreateCommitTx(chanType channeldb.ChannelType,
 		})
 	}
 
+	// Zero-fee commitments carry a single shared anchor that anyone can
+	// spend instead of the two keyed anchors. As the commitment pays no
+	// fee, the value of any trimmed balance is allotted to it.
+	if chanType.HasZeroFeeCommitments() {
+		commitTx.AddTxOut(&wire.TxOut{
+			PkScript: SharedAnchorScript(),
+		})
+
+		var trimmed btcutil.Amount
+		if !localOutput {
+			trimmed += amountToLocal
+		}
+		if !remoteOutput {
+			trimmed += amountToRemote
+		}
+		addToSharedAnchor(commitTx, trimmed)
+
+		return commitTx, nil
+	}
+
 	// If this channel type has anchors, we'll also add those.
 	if chanType.HasAnchors() {
 		localAnchor, remoteAnchor, err := CommitScriptAnchors(
@@ -1049,6 +1133,27 @@ func CreateCommitTx(chanType channeldb.ChannelType,
 	return commitTx, nil
 }
 
+// SharedAnchorScript returns the pay-to-anchor script of the shared anchor
+// output of zero-fee commitment transactions.
+func SharedAnchorScript() []byte {
+	return bytes.Clone(txscript.PayToAnchorScript)
+}
+
+// addToSharedAnchor adds the given amount to the shared anchor output of a
+// zero-fee commitment transaction, capping it at MaxSharedAnchorSize.
+func addToSharedAnchor(commitTx *wire.MsgTx, amt btcutil.Amount) {
+	for _, txOut := range commitTx.TxOut {
+		if !txscript.IsPayToAnchorScript(txOut.PkScript) {
+			continue
+		}
+
+		value := btcutil.Amount(txOut.Value) + amt
+		txOut.Value = int64(min(value, MaxSharedAnchorSize))
+
+		return
+	}
+}
+
 // CoopCloseBalance returns the final balances that should be used to create
 // the cooperative close tx, given the channel type and transaction fee.
 func CoopCloseBalance(chanType channeldb.ChannelType, isInitiator bool,
@@ -1062,9 +1167,7 @@ func CoopCloseBalance(chanType channeldb.ChannelType, isInitiator bool,
 
 	// Since the initiator's balance also is stored after subtracting the
 	// anchor values, add that back in case this was an anchor commitment.
-	if chanType.HasAnchors() {
-		initiatorDelta += 2 * AnchorSize
-	}
+	initiatorDelta += AnchorsValue(chanType)
 
 	// To start with, we'll add the anchor and/or commitment fee to the
 	// balance of the initiator.
@@ -1342,9 +1445,9 @@ func findOutputIndexesFromRemote(revocationPreimage *chainhash.Hash,
 	// We use the remote's channel config for the csv delay.
 	theirDelay := uint32(chanState.RemoteChanCfg.CsvDelay)
 
-	// If we are the initiator of this channel, then it's be false from the
+	// If we are the seller of a leased channel, then it's be false from the
 	// remote's PoV.
-	isRemoteInitiator := !chanState.IsInitiator
+	isRemoteLeaseSeller := !chanState.IsLeaseSeller()
 
 	var leaseExpiry uint32
 	if chanState.ChanType.HasLeaseExpiration() {
@@ -1379,7 +1482,7 @@ func findOutputIndexesFromRemote(revocationPreimage *chainhash.Hash,
 		},
 	)(auxResult.AuxLeaves)
 	theirScript, err := CommitScriptToSelf(
-		chanState.ChanType, isRemoteInitiator, keyRing.ToLocalKey,
+		chanState.ChanType, isRemoteLeaseSeller, keyRing.ToLocalKey,
 		keyRing.RevocationKey, theirDelay, leaseExpiry, localAuxLeaf,
 	)
 	if err != nil {
@@ -1394,7 +1497,7 @@ func findOutputIndexesFromRemote(revocationPreimage *chainhash.Hash,
 		},
 	)(auxResult.AuxLeaves)
 	ourScript, _, err := CommitScriptToRemote(
-		chanState.ChanType, isRemoteInitiator, keyRing.ToRemoteKey,
+		chanState.ChanType, isRemoteLeaseSeller, keyRing.ToRemoteKey,
 		leaseExpiry, remoteAuxLeaf,
 	)
 	if err != nil {
@@ -0,0 +1,456 @@
+package lnwallet
+
+import (
+	"fmt"
+	"math"
+	"net"
+
+	"github.com/btcsuite/btcd/btcutil/v2"
+	"github.com/btcsuite/btcd/btcutil/v2/txsort"
+	"github.com/btcsuite/btcd/txscript/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/btcsuite/btcwallet/wallet"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
+	"github.com/lightningnetwork/lnd/lnwallet/interactivetx"
+)
+
+// InteractiveContribution describes the coins we add to a funding transaction
+// that is constructed interactively with the remote party.
+type InteractiveContribution struct {
+	// Amount is the amount we contribute to the funding output.
+	Amount btcutil.Amount
+
+	// Inputs are the wallet inputs we add to the transaction.
+	Inputs []*interactivetx.Input
+
+	// Change is our change output. This is nil if the excess value of our
+	// inputs is too small to create a change output.
+	Change *interactivetx.Output
+
+	// changeScript is the script of our change output, which is kept
+	// around so the change can be re-created when the fee rate changes.
+	changeScript []byte
+}
+
+// inputAmount returns the total value of our inputs.
+func (c *InteractiveContribution) inputAmount() btcutil.Amount {
+	var total btcutil.Amount
+	for _, in := range c.Inputs {
+		total += btcutil.Amount(in.PrevOut().Value)
+	}
+
+	return total
+}
+
+// Outputs returns the outputs we add to the transaction, excluding the
+// funding output.
+func (c *InteractiveContribution) Outputs() []*interactivetx.Output {
+	if c.Change == nil {
+		return nil
+	}
+
+	return []*interactivetx.Output{{
+		Amount:   c.Change.Amount,
+		PkScript: c.Change.PkScript,
+	}}
+}
+
+// Copy returns a deep copy of the contribution. The inputs are copied as well,
+// as a construction session assigns their serial IDs.
+func (c *InteractiveContribution) Copy() *InteractiveContribution {
+	inputs := make([]*interactivetx.Input, 0, len(c.Inputs))
+	for _, in := range c.Inputs {
+		inCopy := *in
+		inputs = append(inputs, &inCopy)
+	}
+
+	var change *interactivetx.Output
+	if c.Change != nil {
+		changeCopy := *c.Change
+		change = &changeCopy
+	}
+
+	return &InteractiveContribution{
+		Amount:       c.Amount,
+		Inputs:       inputs,
+		Change:       change,
+		changeScript: c.changeScript,
+	}
+}
+
+// interactiveWeight returns a weight estimate of our part of an interactively
+// constructed funding transaction. The initiator also pays for the funding
+// output.
+func interactiveWeight(coins []wallet.Coin,
+	initiator bool) (input.TxWeightEstimator, error) {
+
+	var estimator input.TxWeightEstimator
+	if initiator {
+		estimator.AddP2WSHOutput()
+	}
+
+	for _, coin := range coins {
+		switch {
+		case txscript.IsPayToWitnessPubKeyHash(coin.PkScript):
+			estimator.AddP2WKHInput()
+
+		case txscript.IsPayToTaproot(coin.PkScript):
+			estimator.AddTaprootKeySpendInput(
+				txscript.SigHashDefault,
+			)
+
+		default:
+			return estimator, fmt.Errorf("unsupported input "+
+				"script %x", coin.PkScript)
+		}
+	}
+
+	return estimator, nil
+}
+
+// FundInteractiveContribution selects coins from the wallet to contribute the
+// given amount to a funding transaction that is constructed interactively,
+// paying for our inputs and outputs at the given fee rate. As the remote party
+// learns about our inputs before the transaction is signed, only native segwit
+// coins are used. The selected coins are leased until either the transaction
+// is published or ReleaseInteractiveContribution is called.
+func (l *LightningWallet) FundInteractiveContribution(amt btcutil.Amount,
+	feeRate chainfee.SatPerKWeight, minConfs int32, initiator bool,
+	allowUtxo func(Utxo) bool) (*InteractiveContribution, error) {
+
+	contribution := &InteractiveContribution{
+		Amount: amt,
+	}
+	err := l.WithCoinSelectLock(func() error {
+		coins, err := NewCoinSource(l, allowUtxo).ListCoins(
+			minConfs, math.MaxInt32,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Nested segwit inputs would reveal their witness program
+		// only in the signature script, which isn't allowed in an
+		// interactively constructed transaction.
+		var eligible []wallet.Coin
+		for _, coin := range coins {
+			pkScript := coin.PkScript
+			if txscript.IsPayToWitnessPubKeyHash(pkScript) ||
+				txscript.IsPayToTaproot(pkScript) {
+
+				eligible = append(eligible, coin)
+			}
+		}
+
+		var baseWeight input.TxWeightEstimator
+		if initiator {
+			baseWeight.AddP2WSHOutput()
+		}
+
+		selected, changeAmt, err := chanfunding.CoinSelect(
+			feeRate, amt, DustLimitForSize(input.P2TRSize),
+			eligible, l.Cfg.CoinSelectionStrategy, baseWeight,
+			chanfunding.P2TRChangeAddress,
+			chanfunding.DefaultMaxFeeRatio,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, coin := range selected {
+			_, err := l.LeaseOutput(
+				chanfunding.LndInternalLockID, coin.OutPoint,
+				chanfunding.DefaultLockDuration,
+			)
+			if err != nil {
+				l.releaseInputs(contribution.Inputs)
+				return err
+			}
+
+			prevTx, err := l.FetchTx(coin.OutPoint.Hash)
+			if err != nil {
+				_ = l.ReleaseOutput(
+					chanfunding.LndInternalLockID,
+					coin.OutPoint,
+				)
+				l.releaseInputs(contribution.Inputs)
+
+				return fmt.Errorf("unable to fetch previous "+
+					"tx of %v: %w", coin.OutPoint, err)
+			}
+
+			contribution.Inputs = append(
+				contribution.Inputs, &interactivetx.Input{
+					PrevTx:     prevTx,
+					PrevTxVout: coin.OutPoint.Index,
+					Sequence:   interactivetx.MaxInputSequence,
+				},
+			)
+		}
+
+		changeAddr, err := l.NewAddress(
+			TaprootPubkey, true, DefaultAccountName,
+		)
+		if err != nil {
+			l.releaseInputs(contribution.Inputs)
+			return err
+		}
+		contribution.changeScript, err = txscript.PayToAddrScript(
+			changeAddr,
+		)
+		if err != nil {
+			l.releaseInputs(contribution.Inputs)
+			return err
+		}
+
+		if changeAmt != 0 {
+			contribution.Change = &interactivetx.Output{
+				Amount:   changeAmt,
+				PkScript: contribution.changeScript,
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return contribution, nil
+}
+
+// ReleaseInteractiveContribution releases the coins of a contribution that
+// won't be used anymore.
+func (l *LightningWallet) ReleaseInteractiveContribution(
+	c *InteractiveContribution) {
+
+	l.releaseInputs(c.Inputs)
+}
+
+// releaseInputs releases the leases of the given inputs.
+func (l *LightningWallet) releaseInputs(inputs []*interactivetx.Input) {
+	for _, in := range inputs {
+		err := l.ReleaseOutput(
+			chanfunding.LndInternalLockID, in.OutPoint(),
+		)
+		if err != nil {
+			walletLog.Warnf("Unable to release input %v: %v",
+				in.OutPoint(), err)
+		}
+	}
+}
+
+// UpdateFeeRate re-creates the change output of the contribution for a new
+// fee rate, spending the same inputs. This is used to replace a funding
+// transaction with one that pays a higher fee.
+func (c *InteractiveContribution) UpdateFeeRate(
+	feeRate chainfee.SatPerKWeight, initiator bool) error {
+
+	// Without any inputs there's nothing we pay for.
+	if len(c.Inputs) == 0 {
+		return nil
+	}
+
+	coins := make([]wallet.Coin, 0, len(c.Inputs))
+	for _, in := range c.Inputs {
+		coins = append(coins, wallet.Coin{
+			TxOut:    *in.PrevOut(),
+			OutPoint: in.OutPoint(),
+		})
+	}
+
+	estimator, err := interactiveWeight(coins, initiator)
+	if err != nil {
+		return err
+	}
+	feeNoChange := feeRate.FeeForWeight(estimator.Weight())
+
+	estimator.AddP2TROutput()
+	feeWithChange := feeRate.FeeForWeight(estimator.Weight())
+
+	total := c.inputAmount()
+	switch {
+	case total-c.Amount-feeWithChange >= DustLimitForSize(input.P2TRSize):
+		c.Change = &interactivetx.Output{
+			Amount:   total - c.Amount - feeWithChange,
+			PkScript: c.changeScript,
+		}
+
+	case total-c.Amount >= feeNoChange:
+		c.Change = nil
+
+	default:
+		return fmt.Errorf("inputs of %v can't pay the fee of %v for "+
+			"a contribution of %v", total, feeNoChange, c.Amount)
+	}
+
+	return nil
+}
+
+// SignInteractiveTx signs our inputs of an interactively constructed
+// transaction.
+func (l *LightningWallet) SignInteractiveTx(tx *wire.MsgTx,
+	session *interactivetx.Session) error {
+
+	fetcher := session.PrevOutFetcher()
+	sigHashes := txscript.NewTxSigHashes(tx, fetcher)
+	for i, in := range session.Inputs() {
+		if !in.Local {
+			continue
+		}
+
+		hashType := txscript.SigHashAll
+		if txscript.IsPayToTaproot(in.PrevOut().PkScript) {
+			hashType = txscript.SigHashDefault
+		}
+
+		signDesc := &input.SignDescriptor{
+			Output:            in.PrevOut(),
+			HashType:          hashType,
+			SigHashes:         sigHashes,
+			PrevOutputFetcher: fetcher,
+			InputIndex:        i,
+		}
+		script, err := l.Cfg.Signer.ComputeInputScript(tx, signDesc)
+		if err != nil {
+			return fmt.Errorf("unable to sign input %v: %w",
+				in.OutPoint(), err)
+		}
+
+		tx.TxIn[i].Witness = script.Witness
+	}
+
+	return nil
+}
+
+// NewFundingCandidate creates the state of a channel that replaces the
+// funding transaction of the given pending channel, and signs the remote
+// party's initial commitment spending the new funding outpoint. The channel
+// parameters and balances stay the same. The candidate is only written to
+// disk by CompleteFundingCandidate once we've received the remote party's
+// signature.
+func (l *LightningWallet) NewFundingCandidate(channel *channeldb.OpenChannel,
+	fundingPoint wire.OutPoint) (*channeldb.OpenChannel, input.Signature,
+	error) {
+
+	if channel.ChanType.IsTaproot() {
+		return nil, nil, fmt.Errorf("replacing the funding " +
+			"transaction of taproot channels is not supported")
+	}
+
+	candidate := channel.Copy()
+	candidate.Db = channel.Db
+	candidate.FundingOutpoint = fundingPoint
+
+	firstPreimage, err := candidate.RevocationProducer.AtIndex(0)
+	if err != nil {
+		return nil, nil, err
+	}
+	ourCommitPoint := input.ComputeCommitmentPoint(firstPreimage[:])
+
+	var leaseExpiry uint32
+	if candidate.ChanType.HasLeaseExpiration() {
+		leaseExpiry = candidate.ThawHeight
+	}
+
+	fundingTxIn := wire.TxIn{
+		PreviousOutPoint: fundingPoint,
+	}
+	ourCommitTx, theirCommitTx, err := CreateCommitmentTxns(
+		candidate.LocalCommitment.LocalBalance.ToSatoshis(),
+		candidate.LocalCommitment.RemoteBalance.ToSatoshis(),
+		&candidate.LocalChanCfg, &candidate.RemoteChanCfg,
+		ourCommitPoint, candidate.RemoteCurrentRevocation,
+		fundingTxIn, candidate.ChanType, candidate.IsLeaseSeller(),
+		leaseExpiry,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The state hint obfuscator is derived from the payment base points
+	// with the initiator's one first, the same way as for the original
+	// funding transaction.
+	localBase := candidate.LocalChanCfg.PaymentBasePoint.PubKey
+	remoteBase := candidate.RemoteChanCfg.PaymentBasePoint.PubKey
+	stateObfuscator := DeriveStateHintObfuscator(remoteBase, localBase)
+	if candidate.IsInitiator {
+		stateObfuscator = DeriveStateHintObfuscator(
+			localBase, remoteBase,
+		)
+	}
+	err = initStateHints(ourCommitTx, theirCommitTx, stateObfuscator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txsort.InPlaceSort(ourCommitTx)
+	txsort.InPlaceSort(theirCommitTx)
+
+	candidate.LocalCommitment.CommitTx = ourCommitTx
+	candidate.LocalCommitment.CommitSig = nil
+	candidate.RemoteCommitment.CommitTx = theirCommitTx
+
+	witnessScript, fundingOutput, err := input.GenFundingPkScript(
+		candidate.LocalChanCfg.MultiSigKey.PubKey.SerializeCompressed(),
+		candidate.RemoteChanCfg.MultiSigKey.PubKey.SerializeCompressed(),
+		int64(candidate.Capacity),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signDesc := input.SignDescriptor{
+		WitnessScript: witnessScript,
+		KeyDesc:       candidate.LocalChanCfg.MultiSigKey,
+		Output:        fundingOutput,
+		HashType:      txscript.SigHashAll,
+		SigHashes:     input.NewTxSigHashesV0Only(theirCommitTx),
+		InputIndex:    0,
+	}
+	sig, err := l.Cfg.Signer.SignOutputRaw(theirCommitTx, &signDesc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return candidate, sig, nil
+}
+
+// CompleteFundingCandidate verifies the remote party's signature for our
+// initial commitment of a funding candidate created by NewFundingCandidate and
+// writes the candidate to disk as a pending channel.
+func (l *LightningWallet) CompleteFundingCandidate(
+	candidate *channeldb.OpenChannel, theirSig input.Signature,
+	nodeAddr net.Addr, broadcastHeight uint32) error {
+
+	commitTx := candidate.LocalCommitment.CommitTx
+	channelValue := int64(candidate.Capacity)
+	witnessScript, _, err := input.GenFundingPkScript(
+		candidate.LocalChanCfg.MultiSigKey.PubKey.SerializeCompressed(),
+		candidate.RemoteChanCfg.MultiSigKey.PubKey.SerializeCompressed(),
+		channelValue,
+	)
+	if err != nil {
+		return err
+	}
+
+	sigHash, err := txscript.CalcWitnessSigHash(
+		witnessScript, input.NewTxSigHashesV0Only(commitTx),
+		txscript.SigHashAll, commitTx, 0, channelValue,
+	)
+	if err != nil {
+		return err
+	}
+	remoteKey := candidate.RemoteChanCfg.MultiSigKey.PubKey
+	if !theirSig.Verify(sigHash, remoteKey) {
+		return fmt.Errorf("counterparty's commitment signature is " +
+			"invalid")
+	}
+
+	candidate.LocalCommitment.CommitSig = theirSig.Serialize()
+
+	return candidate.SyncPending(nodeAddr, broadcastHeight)
+}
@@ -0,0 +1,674 @@
+package interactivetx
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcutil/v2"
+	"github.com/btcsuite/btcd/txscript/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// MaxReceivedAdds is the maximum number of tx_add_input and
+	// tx_add_output messages we accept from the remote party during a
+	// single construction.
+	MaxReceivedAdds = 4096
+
+	// MaxInputsOutputs is the maximum number of inputs and the maximum
+	// number of outputs the constructed transaction may have.
+	MaxInputsOutputs = 252
+
+	// MaxTransactionWeight is the largest weight a constructed transaction
+	// may have, which is the largest weight that is standard to relay.
+	MaxTransactionWeight = 400_000
+
+	// MaxInputSequence is the highest sequence number an input of the
+	// constructed transaction may signal, which ensures the transaction
+	// signals replaceability.
+	MaxInputSequence = wire.MaxTxInSequenceNum - 2
+
+	// txVersion is the version of the constructed transaction.
+	txVersion = 2
+
+	// commonFieldsWeight is the weight of the fields of the transaction
+	// that are paid for by the initiator: the version, the locktime, the
+	// input and output counts as well as the segwit marker and flag.
+	commonFieldsWeight = 4*(4+4+1+1) + 2
+)
+
+var (
+	// ErrNotOurTurn is returned when a message is sent or received out of
+	// turn.
+	ErrNotOurTurn = errors.New("message out of turn")
+
+	// ErrConstructionComplete is returned when a message is sent or
+	// received after both parties have completed the construction.
+	ErrConstructionComplete = errors.New("construction already complete")
+
+	// ErrConstructionIncomplete is returned when the transaction is
+	// requested before both parties completed the construction.
+	ErrConstructionIncomplete = errors.New("construction not complete")
+)
+
+// Input is an input of the constructed transaction.
+type Input struct {
+	// SerialID uniquely identifies the input and determines its position
+	// within the transaction.
+	SerialID uint64
+
+	// PrevTx is the transaction whose output is spent.
+	PrevTx *wire.MsgTx
+
+	// PrevTxVout is the index of the spent output within PrevTx.
+	PrevTxVout uint32
+
+	// Sequence is the sequence number of the input.
+	Sequence uint32
+
+	// Local is true if the input was contributed by us.
+	Local bool
+}
+
+// OutPoint returns the outpoint spent by the input.
+func (i *Input) OutPoint() wire.OutPoint {
+	return wire.OutPoint{
+		Hash:  i.PrevTx.TxHash(),
+		Index: i.PrevTxVout,
+	}
+}
+
+// PrevOut returns the output spent by the input.
+func (i *Input) PrevOut() *wire.TxOut {
+	return i.PrevTx.TxOut[i.PrevTxVout]
+}
+
+// Output is an output of the constructed transaction.
+type Output struct {
+	// SerialID uniquely identifies the output and determines its position
+	// within the transaction.
+	SerialID uint64
+
+	// Amount is the value of the output.
+	Amount btcutil.Amount
+
+	// PkScript is the script of the output.
+	PkScript []byte
+
+	// Local is true if the output was contributed by us.
+	Local bool
+}
+
+// Config holds the parameters of an interactive transaction construction.
+type Config struct {
+	// ChanID is the channel ID sent in all messages of the construction.
+	ChanID lnwire.ChannelID
+
+	// Initiator is true if we initiated the construction. The initiator
+	// sends the first message, uses even serial IDs, adds the shared
+	// output and pays for the common fields of the transaction.
+	Initiator bool
+
+	// LockTime is the nLockTime of the constructed transaction.
+	LockTime uint32
+
+	// FeeRate is the fee rate both parties pay for their contributions.
+	FeeRate chainfee.SatPerKWeight
+
+	// DustLimit is the smallest amount of an output we accept.
+	DustLimit btcutil.Amount
+
+	// SharedScript is the script of the shared output.
+	SharedScript []byte
+
+	// LocalContribution is the amount we contribute to the shared output.
+	LocalContribution btcutil.Amount
+
+	// RemoteContribution is the amount the remote party contributes to the
+	// shared output.
+	RemoteContribution btcutil.Amount
+
+	// LocalInputs are the inputs we add to the transaction. Their serial
+	// IDs are assigned by the session.
+	LocalInputs []*Input
+
+	// LocalOutputs are the outputs we add to the transaction, excluding
+	// the shared output. Their serial IDs are assigned by the session.
+	LocalOutputs []*Output
+}
+
+// Session drives one side of the interactive construction of a transaction.
+// Both parties take turns sending messages, starting with the initiator,
+// until both of them have sent tx_complete consecutively.
+type Session struct {
+	cfg *Config
+
+	// pending are the messages we still need to send.
+	pending []lnwire.Message
+
+	inputs  map[uint64]*Input
+	outputs map[uint64]*Output
+
+	// nextSerialID is the serial ID assigned to our next input or output.
+	nextSerialID uint64
+
+	// receivedAdds is the number of inputs and outputs the remote party
+	// added so far.
+	receivedAdds int
+
+	ourTurn        bool
+	localComplete  bool
+	remoteComplete bool
+}
+
+// NewSession creates a new construction session for the given config.
+func NewSession(cfg *Config) (*Session, error) {
+	if len(cfg.SharedScript) == 0 {
+		return nil, fmt.Errorf("shared output script missing")
+	}
+
+	s := &Session{
+		cfg:     cfg,
+		inputs:  make(map[uint64]*Input),
+		outputs: make(map[uint64]*Output),
+		ourTurn: cfg.Initiator,
+	}
+	if !cfg.Initiator {
+		s.nextSerialID = 1
+	}
+
+	for _, in := range cfg.LocalInputs {
+		if int(in.PrevTxVout) >= len(in.PrevTx.TxOut) {
+			return nil, fmt.Errorf("invalid local input %v",
+				in.OutPoint())
+		}
+
+		var prevTx bytes.Buffer
+		if err := in.PrevTx.Serialize(&prevTx); err != nil {
+			return nil, err
+		}
+
+		in.SerialID = s.assignSerialID()
+		in.Local = true
+		s.pending = append(s.pending, &lnwire.TxAddInput{
+			ChanID:     cfg.ChanID,
+			SerialID:   in.SerialID,
+			PrevTx:     prevTx.Bytes(),
+			PrevTxVout: in.PrevTxVout,
+			Sequence:   in.Sequence,
+		})
+	}
+
+	outputs := cfg.LocalOutputs
+	if cfg.Initiator {
+		outputs = append([]*Output{{
+			Amount:   s.SharedAmount(),
+			PkScript: cfg.SharedScript,
+		}}, outputs...)
+	}
+	for _, out := range outputs {
+		out.SerialID = s.assignSerialID()
+		out.Local = true
+		s.pending = append(s.pending, &lnwire.TxAddOutput{
+			ChanID:   cfg.ChanID,
+			SerialID: out.SerialID,
+			Amount:   out.Amount,
+			PkScript: out.PkScript,
+		})
+	}
+
+	return s, nil
+}
+
+// assignSerialID returns the next serial ID for one of our inputs or outputs,
+// keeping the parity that belongs to our role.
+func (s *Session) assignSerialID() uint64 {
+	id := s.nextSerialID
+	s.nextSerialID += 2
+
+	return id
+}
+
+// SharedAmount returns the value of the shared output.
+func (s *Session) SharedAmount() btcutil.Amount {
+	return s.cfg.LocalContribution + s.cfg.RemoteContribution
+}
+
+// OurTurn returns true if it's our turn to send a message.
+func (s *Session) OurTurn() bool {
+	return s.ourTurn && !s.Complete()
+}
+
+// Complete returns true once both parties sent tx_complete consecutively.
+func (s *Session) Complete() bool {
+	return s.localComplete && s.remoteComplete
+}
+
+// Next returns the next message we send to the remote party. Once we've added
+// all our inputs and outputs this is a tx_complete message. If the remote
+// party's tx_complete was the last message, sending ours completes the
+// construction, at which point the final transaction is validated.
+func (s *Session) Next() (lnwire.Message, error) {
+	switch {
+	case s.Complete():
+		return nil, ErrConstructionComplete
+
+	case !s.ourTurn:
+		return nil, ErrNotOurTurn
+	}
+
+	s.ourTurn = false
+
+	if len(s.pending) > 0 {
+		msg := s.pending[0]
+		s.pending = s.pending[1:]
+
+		switch m := msg.(type) {
+		case *lnwire.TxAddInput:
+			for _, in := range s.cfg.LocalInputs {
+				if in.SerialID == m.SerialID {
+					s.inputs[in.SerialID] = in
+				}
+			}
+
+		case *lnwire.TxAddOutput:
+			s.outputs[m.SerialID] = &Output{
+				SerialID: m.SerialID,
+				Amount:   m.Amount,
+				PkScript: m.PkScript,
+				Local:    true,
+			}
+		}
+
+		s.localComplete = false
+		s.remoteComplete = false
+
+		return msg, nil
+	}
+
+	s.localComplete = true
+	if s.Complete() {
+		if err := s.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &lnwire.TxComplete{ChanID: s.cfg.ChanID}, nil
+}
+
+// Receive processes a message sent by the remote party. If it completes the
+// construction, the final transaction is validated.
+func (s *Session) Receive(msg lnwire.Message) error {
+	switch {
+	case s.Complete():
+		return ErrConstructionComplete
+
+	case s.ourTurn:
+		return ErrNotOurTurn
+	}
+
+	var err error
+	switch m := msg.(type) {
+	case *lnwire.TxAddInput:
+		err = s.receiveAddInput(m)
+
+	case *lnwire.TxAddOutput:
+		err = s.receiveAddOutput(m)
+
+	case *lnwire.TxRemoveInput:
+		err = s.receiveRemove(m.ChanID, m.SerialID, true)
+
+	case *lnwire.TxRemoveOutput:
+		err = s.receiveRemove(m.ChanID, m.SerialID, false)
+
+	case *lnwire.TxComplete:
+		if m.ChanID != s.cfg.ChanID {
+			return fmt.Errorf("unexpected channel ID %v", m.ChanID)
+		}
+
+		s.remoteComplete = true
+		s.ourTurn = true
+		if s.Complete() {
+			return s.validate()
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unexpected message %T", msg)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.ourTurn = true
+	s.localComplete = false
+	s.remoteComplete = false
+
+	return nil
+}
+
+// isRemoteSerialID returns true if the given serial ID has the parity of the
+// remote party's serial IDs.
+func (s *Session) isRemoteSerialID(serialID uint64) bool {
+	initiatorID := serialID%2 == 0
+
+	return initiatorID != s.cfg.Initiator
+}
+
+// checkNewSerialID makes sure a serial ID added by the remote party has the
+// right parity and isn't in use yet.
+func (s *Session) checkNewSerialID(chanID lnwire.ChannelID,
+	serialID uint64) error {
+
+	if chanID != s.cfg.ChanID {
+		return fmt.Errorf("unexpected channel ID %v", chanID)
+	}
+
+	if !s.isRemoteSerialID(serialID) {
+		return fmt.Errorf("serial ID %d has wrong parity", serialID)
+	}
+
+	_, isInput := s.inputs[serialID]
+	_, isOutput := s.outputs[serialID]
+	if isInput || isOutput {
+		return fmt.Errorf("duplicate serial ID %d", serialID)
+	}
+
+	s.receivedAdds++
+	if s.receivedAdds > MaxReceivedAdds {
+		return fmt.Errorf("received more than %d inputs and outputs",
+			MaxReceivedAdds)
+	}
+
+	return nil
+}
+
+// receiveAddInput validates and records an input added by the remote party.
+func (s *Session) receiveAddInput(m *lnwire.TxAddInput) error {
+	if err := s.checkNewSerialID(m.ChanID, m.SerialID); err != nil {
+		return err
+	}
+
+	prevTx := &wire.MsgTx{}
+	if err := prevTx.Deserialize(bytes.NewReader(m.PrevTx)); err != nil {
+		return fmt.Errorf("invalid prev tx: %w", err)
+	}
+
+	if int(m.PrevTxVout) >= len(prevTx.TxOut) {
+		return fmt.Errorf("prev tx output %d out of range",
+			m.PrevTxVout)
+	}
+
+	in := &Input{
+		SerialID:   m.SerialID,
+		PrevTx:     prevTx,
+		PrevTxVout: m.PrevTxVout,
+		Sequence:   m.Sequence,
+	}
+
+	// We can only estimate the weight of inputs spending the script types
+	// we support, so any other input is rejected up front rather than
+	// failing the fee check at the end of the negotiation.
+	if _, err := inputWeight(in.PrevOut().PkScript); err != nil {
+		return fmt.Errorf("input %v: %w", in.OutPoint(), err)
+	}
+
+	if m.Sequence > MaxInputSequence {
+		return fmt.Errorf("input %v does not signal replaceability",
+			in.OutPoint())
+	}
+
+	outPoint := in.OutPoint()
+	for _, other := range s.inputs {
+		if other.OutPoint() == outPoint {
+			return fmt.Errorf("duplicate input %v", outPoint)
+		}
+	}
+
+	s.inputs[m.SerialID] = in
+
+	return nil
+}
+
+// receiveAddOutput validates and records an output added by the remote party.
+func (s *Session) receiveAddOutput(m *lnwire.TxAddOutput) error {
+	if err := s.checkNewSerialID(m.ChanID, m.SerialID); err != nil {
+		return err
+	}
+
+	if m.Amount < s.cfg.DustLimit {
+		return fmt.Errorf("output amount %v below dust limit %v",
+			m.Amount, s.cfg.DustLimit)
+	}
+
+	if m.Amount > btcutil.MaxSatoshi {
+		return fmt.Errorf("output amount %v too large", m.Amount)
+	}
+
+	if txscript.GetScriptClass(m.PkScript) == txscript.NonStandardTy {
+		return fmt.Errorf("non-standard output script %x", m.PkScript)
+	}
+
+	s.outputs[m.SerialID] = &Output{
+		SerialID: m.SerialID,
+		Amount:   m.Amount,
+		PkScript: m.PkScript,
+	}
+
+	return nil
+}
+
+// receiveRemove removes an input or output the remote party added before.
+func (s *Session) receiveRemove(chanID lnwire.ChannelID, serialID uint64,
+	isInput bool) error {
+
+	if chanID != s.cfg.ChanID {
+		return fmt.Errorf("unexpected channel ID %v", chanID)
+	}
+
+	if !s.isRemoteSerialID(serialID) {
+		return fmt.Errorf("cannot remove serial ID %d of other party",
+			serialID)
+	}
+
+	if isInput {
+		if _, ok := s.inputs[serialID]; !ok {
+			return fmt.Errorf("unknown input serial ID %d",
+				serialID)
+		}
+		delete(s.inputs, serialID)
+
+		return nil
+	}
+
+	if _, ok := s.outputs[serialID]; !ok {
+		return fmt.Errorf("unknown output serial ID %d", serialID)
+	}
+	delete(s.outputs, serialID)
+
+	return nil
+}
+
+// Inputs returns all inputs of the transaction sorted by serial ID.
+func (s *Session) Inputs() []*Input {
+	inputs := make([]*Input, 0, len(s.inputs))
+	for _, in := range s.inputs {
+		inputs = append(inputs, in)
+	}
+	sort.Slice(inputs, func(i, j int) bool {
+		return inputs[i].SerialID < inputs[j].SerialID
+	})
+
+	return inputs
+}
+
+// Outputs returns all outputs of the transaction sorted by serial ID.
+func (s *Session) Outputs() []*Output {
+	outputs := make([]*Output, 0, len(s.outputs))
+	for _, out := range s.outputs {
+		outputs = append(outputs, out)
+	}
+	sort.Slice(outputs, func(i, j int) bool {
+		return outputs[i].SerialID < outputs[j].SerialID
+	})
+
+	return outputs
+}
+
+// isShared returns true if the output is the shared output. It's always added
+// by the initiator.
+func (s *Session) isShared(out *Output) bool {
+	initiatorID := out.SerialID%2 == 0
+
+	return initiatorID && bytes.Equal(out.PkScript, s.cfg.SharedScript)
+}
+
+// validate checks the transaction both parties agreed on.
+func (s *Session) validate() error {
+	inputs, outputs := s.Inputs(), s.Outputs()
+
+	if len(inputs) > MaxInputsOutputs {
+		return fmt.Errorf("too many inputs: %d", len(inputs))
+	}
+	if len(outputs) > MaxInputsOutputs {
+		return fmt.Errorf("too many outputs: %d", len(outputs))
+	}
+
+	var (
+		numShared    int
+		weight       = commonFieldsWeight
+		remoteWeight int
+		remoteIn     btcutil.Amount
+		remoteOut    btcutil.Amount
+	)
+	for _, in := range inputs {
+		w, err := inputWeight(in.PrevOut().PkScript)
+		if err != nil {
+			return fmt.Errorf("input %v: %w", in.OutPoint(), err)
+		}
+
+		weight += w
+		if !in.Local {
+			remoteWeight += w
+			remoteIn += btcutil.Amount(in.PrevOut().Value)
+		}
+	}
+	for _, out := range outputs {
+		w := outputWeight(out.PkScript)
+		weight += w
+
+		if s.isShared(out) {
+			numShared++
+			if out.Amount != s.SharedAmount() {
+				return fmt.Errorf("shared output amount %v, "+
+					"expected %v", out.Amount,
+					s.SharedAmount())
+			}
+		}
+
+		if out.Local {
+			continue
+		}
+
+		remoteWeight += w
+		if !s.isShared(out) {
+			remoteOut += out.Amount
+		}
+	}
+
+	if numShared != 1 {
+		return fmt.Errorf("expected exactly one shared output, found %d",
+			numShared)
+	}
+
+	if weight > MaxTransactionWeight {
+		return fmt.Errorf("transaction weight %d exceeds %d", weight,
+			MaxTransactionWeight)
+	}
+
+	// The initiator pays for the common fields of the transaction, so if
+	// the remote party is the initiator, that weight is theirs.
+	if !s.cfg.Initiator {
+		remoteWeight += commonFieldsWeight
+	}
+
+	remoteFee := remoteIn - remoteOut - s.cfg.RemoteContribution
+	requiredFee := s.cfg.FeeRate.FeeForWeight(
+		lntypes.WeightUnit(remoteWeight),
+	)
+	if remoteFee < requiredFee {
+		return fmt.Errorf("remote contribution pays fee %v, "+
+			"expected at least %v", remoteFee, requiredFee)
+	}
+
+	return nil
+}
+
+// Tx returns the unsigned transaction both parties agreed on, with its inputs
+// and outputs ordered by serial ID.
+func (s *Session) Tx() (*wire.MsgTx, error) {
+	if !s.Complete() {
+		return nil, ErrConstructionIncomplete
+	}
+
+	tx := wire.NewMsgTx(txVersion)
+	tx.LockTime = s.cfg.LockTime
+	for _, in := range s.Inputs() {
+		outPoint := in.OutPoint()
+		txIn := wire.NewTxIn(&outPoint, nil, nil)
+		txIn.Sequence = in.Sequence
+		tx.AddTxIn(txIn)
+	}
+	for _, out := range s.Outputs() {
+		tx.AddTxOut(wire.NewTxOut(int64(out.Amount), out.PkScript))
+	}
+
+	return tx, nil
+}
+
+// SharedOutputIndex returns the index of the shared output within the
+// constructed transaction.
+func (s *Session) SharedOutputIndex() (uint32, error) {
+	if !s.Complete() {
+		return 0, ErrConstructionIncomplete
+	}
+
+	for i, out := range s.Outputs() {
+		if s.isShared(out) {
+			return uint32(i), nil
+		}
+	}
+
+	return 0, fmt.Errorf("shared output not found")
+}
+
+// inputWeight returns the weight of an input spending the given script,
+// including its witness. Only key spends of witness programs we know the
+// witness size of are supported.
+func inputWeight(pkScript []byte) (int, error) {
+	const baseWeight = 4 * input.InputSize
+
+	switch {
+	case txscript.IsPayToWitnessPubKeyHash(pkScript):
+		return baseWeight + input.P2WKHWitnessSize, nil
+
+	case txscript.IsPayToTaproot(pkScript):
+		return baseWeight + input.TaprootKeyPathWitnessSize, nil
+
+	default:
+		return 0, fmt.Errorf("unsupported input script %x", pkScript)
+	}
+}
+
+// outputWeight returns the weight of an output with the given script.
+func outputWeight(pkScript []byte) int {
+	return 4 * (8 + wire.VarIntSerializeSize(uint64(len(pkScript))) +
+		len(pkScript))
+}
@@ -0,0 +1,398 @@
+package interactivetx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/address/v2"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/v2"
+	"github.com/btcsuite/btcd/txscript/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testChanID = lnwire.ChannelID{1, 2, 3}
+
+	testSharedScript = append(
+		[]byte{txscript.OP_0, txscript.OP_DATA_32}, make([]byte, 32)...,
+	)
+
+	testFeeRate = chainfee.SatPerKWeight(1000)
+)
+
+// testWallet is a single key that owns the inputs of one party.
+type testWallet struct {
+	priv     *btcec.PrivateKey
+	pkScript []byte
+}
+
+func newTestWallet(t *testing.T) *testWallet {
+	priv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	pkHash := address.Hash160(priv.PubKey().SerializeCompressed())
+	pkScript, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+		AddData(pkHash).Script()
+	require.NoError(t, err)
+
+	return &testWallet{priv: priv, pkScript: pkScript}
+}
+
+// input creates an input of the given value spendable by the wallet.
+func (w *testWallet) input(value btcutil.Amount) *Input {
+	prevTx := wire.NewMsgTx(2)
+	prevTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: uint32(value)},
+		nil, nil))
+	prevTx.AddTxOut(wire.NewTxOut(int64(value), w.pkScript))
+
+	return &Input{
+		PrevTx:   prevTx,
+		Sequence: MaxInputSequence,
+	}
+}
+
+// sign signs all our inputs of the transaction.
+func (w *testWallet) sign(t *testing.T, s *Session, tx *wire.MsgTx) {
+	fetcher := s.PrevOutFetcher()
+	sigHashes := txscript.NewTxSigHashes(tx, fetcher)
+	for i, in := range s.Inputs() {
+		if !in.Local {
+			continue
+		}
+
+		witness, err := txscript.WitnessSignature(
+			tx, sigHashes, i, in.PrevOut().Value,
+			in.PrevOut().PkScript, txscript.SigHashAll, w.priv,
+			true,
+		)
+		require.NoError(t, err)
+		tx.TxIn[i].Witness = witness
+	}
+}
+
+// testConfigs returns the configs of an initiator contributing 600k sats and a
+// responder contributing 400k sats to a shared output.
+func testConfigs(initWallet, respWallet *testWallet) (*Config, *Config) {
+	initCfg := &Config{
+		ChanID:             testChanID,
+		Initiator:          true,
+		FeeRate:            testFeeRate,
+		DustLimit:          354,
+		SharedScript:       testSharedScript,
+		LocalContribution:  600_000,
+		RemoteContribution: 400_000,
+		LocalInputs: []*Input{
+			initWallet.input(300_000),
+			initWallet.input(400_000),
+		},
+		LocalOutputs: []*Output{{
+			Amount:   99_000,
+			PkScript: initWallet.pkScript,
+		}},
+	}
+	respCfg := &Config{
+		ChanID:             testChanID,
+		FeeRate:            testFeeRate,
+		DustLimit:          354,
+		SharedScript:       testSharedScript,
+		LocalContribution:  400_000,
+		RemoteContribution: 600_000,
+		LocalInputs: []*Input{
+			respWallet.input(500_000),
+		},
+		LocalOutputs: []*Output{{
+			Amount:   99_500,
+			PkScript: respWallet.pkScript,
+		}},
+	}
+
+	return initCfg, respCfg
+}
+
+// runConstruction exchanges messages between both sessions until the
+// construction is complete or fails.
+func runConstruction(initiator, responder *Session) error {
+	sender, receiver := initiator, responder
+	for !initiator.Complete() || !responder.Complete() {
+		msg, err := sender.Next()
+		if err != nil {
+			return err
+		}
+
+		if err := receiver.Receive(msg); err != nil {
+			return err
+		}
+
+		sender, receiver = receiver, sender
+	}
+
+	return nil
+}
+
+// TestConstruction tests that both parties construct and sign the same
+// transaction.
+func TestConstruction(t *testing.T) {
+	t.Parallel()
+
+	initWallet, respWallet := newTestWallet(t), newTestWallet(t)
+	initCfg, respCfg := testConfigs(initWallet, respWallet)
+
+	initiator, err := NewSession(initCfg)
+	require.NoError(t, err)
+	responder, err := NewSession(respCfg)
+	require.NoError(t, err)
+
+	require.NoError(t, runConstruction(initiator, responder))
+
+	initTx, err := initiator.Tx()
+	require.NoError(t, err)
+	respTx, err := responder.Tx()
+	require.NoError(t, err)
+	require.Equal(t, initTx.TxHash(), respTx.TxHash())
+	require.Len(t, initTx.TxIn, 3)
+	require.Len(t, initTx.TxOut, 3)
+
+	sharedIdx, err := initiator.SharedOutputIndex()
+	require.NoError(t, err)
+	require.EqualValues(t, 1_000_000, initTx.TxOut[sharedIdx].Value)
+	require.Equal(t, testSharedScript, initTx.TxOut[sharedIdx].PkScript)
+
+	// The responder contributed less input value, so it sends its
+	// signatures first.
+	initIn, respIn := initiator.InputAmounts()
+	require.EqualValues(t, 700_000, initIn)
+	require.EqualValues(t, 500_000, respIn)
+	require.False(t, SendsSignaturesFirst(
+		initIn, respIn, initWallet.priv.PubKey(),
+		respWallet.priv.PubKey(),
+	))
+
+	respWallet.sign(t, responder, respTx)
+	respWitnesses, err := responder.LocalWitnesses(respTx)
+	require.NoError(t, err)
+	require.Len(t, respWitnesses, 1)
+	require.NoError(t, initiator.AddRemoteWitnesses(initTx, respWitnesses))
+
+	initWallet.sign(t, initiator, initTx)
+	initWitnesses, err := initiator.LocalWitnesses(initTx)
+	require.NoError(t, err)
+	require.Len(t, initWitnesses, 2)
+	require.NoError(t, responder.AddRemoteWitnesses(respTx, initWitnesses))
+
+	require.Equal(t, initTx.WitnessHash(), respTx.WitnessHash())
+
+	// A witness for the wrong input is rejected.
+	require.Error(t, initiator.AddRemoteWitnesses(
+		initTx, []wire.TxWitness{initWitnesses[0]},
+	))
+}
+
+// TestConstructionRemoteFee tests that a construction fails if the remote
+// party doesn't pay enough fees for its contribution.
+func TestConstructionRemoteFee(t *testing.T) {
+	t.Parallel()
+
+	initWallet, respWallet := newTestWallet(t), newTestWallet(t)
+	initCfg, respCfg := testConfigs(initWallet, respWallet)
+
+	// The responder keeps all the excess value as change, so it doesn't
+	// pay any fees.
+	respCfg.LocalOutputs[0].Amount = 100_000
+
+	initiator, err := NewSession(initCfg)
+	require.NoError(t, err)
+	responder, err := NewSession(respCfg)
+	require.NoError(t, err)
+
+	err = runConstruction(initiator, responder)
+	require.ErrorContains(t, err, "remote contribution pays fee")
+}
+
+// TestConstructionSharedOutput tests that the responder rejects a transaction
+// without the expected shared output.
+func TestConstructionSharedOutput(t *testing.T) {
+	t.Parallel()
+
+	initWallet, respWallet := newTestWallet(t), newTestWallet(t)
+	initCfg, respCfg := testConfigs(initWallet, respWallet)
+	respCfg.RemoteContribution = 500_000
+
+	initiator, err := NewSession(initCfg)
+	require.NoError(t, err)
+	responder, err := NewSession(respCfg)
+	require.NoError(t, err)
+
+	err = runConstruction(initiator, responder)
+	require.ErrorContains(t, err, "shared output amount")
+}
+
+// TestReceiveValidation tests the validation of the messages received from
+// the remote party.
+func TestReceiveValidation(t *testing.T) {
+	t.Parallel()
+
+	wallet := newTestWallet(t)
+	prevTx := wallet.input(100_000).PrevTx
+
+	var prevTxBuf bytes.Buffer
+	require.NoError(t, prevTx.Serialize(&prevTxBuf))
+	buf := prevTxBuf.Bytes()
+
+	// A P2WSH input can't be accepted, as we can't estimate the weight of
+	// its witness.
+	p2wshTx := wire.NewMsgTx(2)
+	p2wshTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	p2wshTx.AddTxOut(wire.NewTxOut(100_000, testSharedScript))
+
+	var p2wshBuf bytes.Buffer
+	require.NoError(t, p2wshTx.Serialize(&p2wshBuf))
+
+	addInput := func(serialID uint64, seq uint32) *lnwire.TxAddInput {
+		return &lnwire.TxAddInput{
+			ChanID:   testChanID,
+			SerialID: serialID,
+			PrevTx:   buf,
+			Sequence: seq,
+		}
+	}
+
+	testCases := []struct {
+		name string
+		msgs []lnwire.Message
+		err  string
+	}{{
+		name: "wrong parity",
+		msgs: []lnwire.Message{addInput(1, 0)},
+		err:  "wrong parity",
+	}, {
+		name: "duplicate serial id",
+		msgs: []lnwire.Message{
+			addInput(0, 0),
+			&lnwire.TxAddOutput{
+				ChanID:   testChanID,
+				SerialID: 0,
+				Amount:   1000,
+				PkScript: wallet.pkScript,
+			},
+		},
+		err: "duplicate serial ID",
+	}, {
+		name: "duplicate input",
+		msgs: []lnwire.Message{addInput(0, 0), addInput(2, 0)},
+		err:  "duplicate input",
+	}, {
+		name: "not replaceable",
+		msgs: []lnwire.Message{addInput(0, wire.MaxTxInSequenceNum)},
+		err:  "replaceability",
+	}, {
+		name: "unsupported input script",
+		msgs: []lnwire.Message{&lnwire.TxAddInput{
+			ChanID:   testChanID,
+			PrevTx:   p2wshBuf.Bytes(),
+			Sequence: 0,
+		}},
+		err: "unsupported input script",
+	}, {
+		name: "dust output",
+		msgs: []lnwire.Message{&lnwire.TxAddOutput{
+			ChanID:   testChanID,
+			SerialID: 0,
+			Amount:   100,
+			PkScript: wallet.pkScript,
+		}},
+		err: "dust limit",
+	}, {
+		name: "remove unknown",
+		msgs: []lnwire.Message{&lnwire.TxRemoveInput{
+			ChanID:   testChanID,
+			SerialID: 4,
+		}},
+		err: "unknown input",
+	}, {
+		name: "wrong channel",
+		msgs: []lnwire.Message{&lnwire.TxComplete{}},
+		err:  "unexpected channel ID",
+	}, {
+		name: "remove and re-add",
+		msgs: []lnwire.Message{
+			addInput(0, 0),
+			&lnwire.TxRemoveInput{
+				ChanID:   testChanID,
+				SerialID: 0,
+			},
+			addInput(2, 0),
+		},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := NewSession(&Config{
+				ChanID:       testChanID,
+				DustLimit:    354,
+				SharedScript: testSharedScript,
+			})
+			require.NoError(t, err)
+
+			for _, msg := range tc.msgs {
+				err = s.Receive(msg)
+				if err != nil {
+					break
+				}
+
+				// Answer with tx_complete to give the turn
+				// back to the remote party.
+				_, err = s.Next()
+				require.NoError(t, err)
+			}
+
+			if tc.err == "" {
+				require.NoError(t, err)
+				require.Len(t, s.Inputs(), 1)
+
+				return
+			}
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+// TestTurns tests that messages are only accepted in turn.
+func TestTurns(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewSession(&Config{
+		ChanID:       testChanID,
+		Initiator:    true,
+		SharedScript: testSharedScript,
+	})
+	require.NoError(t, err)
+
+	require.ErrorIs(t, s.Receive(&lnwire.TxComplete{
+		ChanID: testChanID,
+	}), ErrNotOurTurn)
+
+	msg, err := s.Next()
+	require.NoError(t, err)
+	require.IsType(t, &lnwire.TxAddOutput{}, msg)
+
+	_, err = s.Next()
+	require.ErrorIs(t, err, ErrNotOurTurn)
+
+	_, err = s.Tx()
+	require.ErrorIs(t, err, ErrConstructionIncomplete)
+}
+
+// TestValidateRbfFeeRate tests the minimum fee rate of a replacement.
+func TestValidateRbfFeeRate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateRbfFeeRate(2400, 2500))
+	require.NoError(t, ValidateRbfFeeRate(2400, 3000))
+	require.Error(t, ValidateRbfFeeRate(2400, 2499))
+}
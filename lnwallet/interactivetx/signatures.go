@@ -0,0 +1,132 @@
+package interactivetx
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/v2"
+	"github.com/btcsuite/btcd/txscript/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// ValidateRbfFeeRate checks that the fee rate of a replacement transaction is
+// at least 25/24 of the fee rate of the transaction it replaces.
+func ValidateRbfFeeRate(prev, next chainfee.SatPerKWeight) error {
+	if next*24 < prev*25 {
+		return fmt.Errorf("replacement fee rate %v must be at least "+
+			"25/24 of the previous fee rate %v", next, prev)
+	}
+
+	return nil
+}
+
+// SendsSignaturesFirst returns true if we need to send our tx_signatures
+// before the remote party. The party that contributed the smaller total input
+// amount signs first, ties are broken by the lower node key.
+func SendsSignaturesFirst(localInputs, remoteInputs btcutil.Amount,
+	localKey, remoteKey *btcec.PublicKey) bool {
+
+	if localInputs != remoteInputs {
+		return localInputs < remoteInputs
+	}
+
+	return bytes.Compare(
+		localKey.SerializeCompressed(),
+		remoteKey.SerializeCompressed(),
+	) < 0
+}
+
+// InputAmounts returns the total value of our inputs and the total value of
+// the remote party's inputs.
+func (s *Session) InputAmounts() (btcutil.Amount, btcutil.Amount) {
+	var local, remote btcutil.Amount
+	for _, in := range s.inputs {
+		if in.Local {
+			local += btcutil.Amount(in.PrevOut().Value)
+		} else {
+			remote += btcutil.Amount(in.PrevOut().Value)
+		}
+	}
+
+	return local, remote
+}
+
+// PrevOutFetcher returns a fetcher for the outputs spent by the constructed
+// transaction.
+func (s *Session) PrevOutFetcher() txscript.PrevOutputFetcher {
+	fetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for _, in := range s.inputs {
+		fetcher.AddPrevOut(in.OutPoint(), in.PrevOut())
+	}
+
+	return fetcher
+}
+
+// LocalWitnesses returns the witnesses of our inputs of the signed transaction
+// in the order of their serial IDs, as sent in tx_signatures.
+func (s *Session) LocalWitnesses(tx *wire.MsgTx) ([]wire.TxWitness, error) {
+	inputs := s.Inputs()
+	if len(inputs) != len(tx.TxIn) {
+		return nil, fmt.Errorf("transaction does not match session")
+	}
+
+	var witnesses []wire.TxWitness
+	for i, in := range inputs {
+		if !in.Local {
+			continue
+		}
+
+		if len(tx.TxIn[i].Witness) == 0 {
+			return nil, fmt.Errorf("input %v not signed",
+				in.OutPoint())
+		}
+		witnesses = append(witnesses, tx.TxIn[i].Witness)
+	}
+
+	return witnesses, nil
+}
+
+// AddRemoteWitnesses attaches the witnesses the remote party sent in
+// tx_signatures to its inputs of the transaction and verifies them.
+func (s *Session) AddRemoteWitnesses(tx *wire.MsgTx,
+	witnesses []wire.TxWitness) error {
+
+	inputs := s.Inputs()
+	if len(inputs) != len(tx.TxIn) {
+		return fmt.Errorf("transaction does not match session")
+	}
+
+	var remoteIdx []int
+	for i, in := range inputs {
+		if !in.Local {
+			remoteIdx = append(remoteIdx, i)
+		}
+	}
+	if len(witnesses) != len(remoteIdx) {
+		return fmt.Errorf("expected %d witnesses, got %d",
+			len(remoteIdx), len(witnesses))
+	}
+
+	fetcher := s.PrevOutFetcher()
+	sigHashes := txscript.NewTxSigHashes(tx, fetcher)
+	for i, idx := range remoteIdx {
+		tx.TxIn[idx].Witness = witnesses[i]
+
+		prevOut := inputs[idx].PrevOut()
+		vm, err := txscript.NewEngine(
+			prevOut.PkScript, tx, idx, txscript.StandardVerifyFlags,
+			nil, sigHashes, prevOut.Value, fetcher,
+		)
+		if err != nil {
+			return err
+		}
+		if err := vm.Execute(); err != nil {
+			return fmt.Errorf("invalid witness for input %v: %w",
+				inputs[idx].OutPoint(), err)
+		}
+	}
+
+	return nil
+}
@@ -200,6 +200,12 @@ type paymentDescriptor struct {
 	// NOTE: Populate only in fail payment descriptor entry types.
 	FailReason []byte
 
+	// FailAttrData stores the attribution data of the failure, which holds
+	// the hold times and HMACs of the nodes that relayed it.
+	//
+	// NOTE: Populate only in fail payment descriptor entry types.
+	FailAttrData []byte
+
 	// FailCode stores the code why a particular payment was canceled.
 	//
 	// NOTE: Populated only in payment descriptor with MalformedFail type.
@@ -243,11 +249,23 @@ type paymentDescriptor struct {
 	// TLVs.
 	BlindingPoint lnwire.BlindingPointRecord
 
+	// UpfrontFee is the optional unconditional fee attached to an Add
+	// entry. The fee moves from the adding party's balance to its
+	// counterparty's balance as soon as the add is included in a
+	// commitment, and is not returned when the htlc is later failed.
+	UpfrontFee lnwire.UpfrontFeeRecord
+
 	// CustomRecords also stores the set of optional custom records that
 	// may have been attached to a sent HTLC.
 	CustomRecords lnwire.CustomRecords
 }
 
+// upfrontFee returns the unconditional fee attached to the entry, or zero if
+// it carries none.
+func (pd *paymentDescriptor) upfrontFee() lnwire.MilliSatoshi {
+	return pd.UpfrontFee.ValOpt().UnwrapOr(0)
+}
+
 // toLogUpdate recovers the underlying LogUpdate from the paymentDescriptor.
 // This operation is lossy and will forget some extra information tracked by the
 // paymentDescriptor but the function is total in that all paymentDescriptors
@@ -264,6 +282,7 @@ func (pd *paymentDescriptor) toLogUpdate() channeldb.LogUpdate {
 			Expiry:        pd.Timeout,
 			OnionBlob:     pd.OnionBlob,
 			BlindingPoint: pd.BlindingPoint,
+			UpfrontFee:    pd.UpfrontFee,
 			CustomRecords: pd.CustomRecords.Copy(),
 		}
 	case Settle:
@@ -274,9 +293,10 @@ func (pd *paymentDescriptor) toLogUpdate() channeldb.LogUpdate {
 		}
 	case Fail:
 		msg = &lnwire.UpdateFailHTLC{
-			ChanID: pd.ChanID,
-			ID:     pd.ParentIndex,
-			Reason: pd.FailReason,
+			ChanID:   pd.ChanID,
+			ID:       pd.ParentIndex,
+			Reason:   pd.FailReason,
+			AttrData: lnwire.NewAttrDataRecord(pd.FailAttrData),
 		}
 	case MalformedFail:
 		msg = &lnwire.UpdateFailMalformedHTLC{
@@ -64,6 +64,13 @@ const (
 	// CommitmentTypeSimpleTaproot type but layers on a special overlay
 	// protocol.
 	CommitmentTypeSimpleTaprootOverlay
+
+	// CommitmentTypeZeroFeeCommitments is a commitment type that builds
+	// upon CommitmentTypeAnchorsZeroFeeHtlcTx, but uses v3 (TRUC)
+	// commitment transactions that pay no fee and replaces the two keyed
+	// anchors with a single shared pay-to-anchor output. The commitment is
+	// fee bumped with a CPFP child published along with it as a package.
+	CommitmentTypeZeroFeeCommitments
 )
 
 // HasStaticRemoteKey returns whether the commitment type supports remote
@@ -75,7 +82,8 @@ func (c CommitmentType) HasStaticRemoteKey() bool {
 		CommitmentTypeScriptEnforcedLease,
 		CommitmentTypeSimpleTaproot,
 		CommitmentTypeSimpleTaprootFinal,
-		CommitmentTypeSimpleTaprootOverlay:
+		CommitmentTypeSimpleTaprootOverlay,
+		CommitmentTypeZeroFeeCommitments:
 
 		return true
 
@@ -91,7 +99,8 @@ func (c CommitmentType) HasAnchors() bool {
 		CommitmentTypeScriptEnforcedLease,
 		CommitmentTypeSimpleTaproot,
 		CommitmentTypeSimpleTaprootFinal,
-		CommitmentTypeSimpleTaprootOverlay:
+		CommitmentTypeSimpleTaprootOverlay,
+		CommitmentTypeZeroFeeCommitments:
 
 		return true
 
@@ -100,6 +109,12 @@ func (c CommitmentType) HasAnchors() bool {
 	}
 }
 
+// HasZeroFeeCommitments returns true if the commitment type uses zero-fee v3
+// commitment transactions with a single shared pay-to-anchor output.
+func (c CommitmentType) HasZeroFeeCommitments() bool {
+	return c == CommitmentTypeZeroFeeCommitments
+}
+
 // IsTaproot returns true if the channel type is a taproot channel.
 func (c CommitmentType) IsTaproot() bool {
 	return c == CommitmentTypeSimpleTaproot ||
@@ -124,6 +139,8 @@ func (c CommitmentType) String() string {
 		return "simple-taproot-final"
 	case CommitmentTypeSimpleTaprootOverlay:
 		return "simple-taproot-overlay"
+	case CommitmentTypeZeroFeeCommitments:
+		return "zero-fee-commitments"
 	default:
 		return "invalid"
 	}
@@ -296,9 +313,12 @@ func NewChannelReservation(capacity, localFundingAmt btcutil.Amount,
 	// Based on the channel type, we determine the initial commit weight
 	// and fee.
 	commitWeight := input.CommitWeight
-	if req.CommitType.IsTaproot() {
+	switch {
+	case req.CommitType.HasZeroFeeCommitments():
+		commitWeight = input.ZeroFeeCommitWeight
+	case req.CommitType.IsTaproot():
 		commitWeight = input.TaprootCommitWeight
-	} else if req.CommitType.HasAnchors() {
+	case req.CommitType.HasAnchors():
 		commitWeight = input.AnchorCommitWeight
 	}
 	commitFee := req.CommitFeePerKw.FeeForWeight(
@@ -311,9 +331,9 @@ func NewChannelReservation(capacity, localFundingAmt btcutil.Amount,
 	capacityMSat := lnwire.NewMSatFromSatoshis(capacity)
 
 	// The total fee paid by the initiator will be the commitment fee in
-	// addition to the two anchor outputs.
+	// addition to the two anchor outputs. Zero-fee commitments have neither.
 	feeMSat := lnwire.NewMSatFromSatoshis(commitFee)
-	if req.CommitType.HasAnchors() {
+	if req.CommitType.HasAnchors() && !req.CommitType.HasZeroFeeCommitments() {
 		feeMSat += 2 * lnwire.NewMSatFromSatoshis(AnchorSize)
 	}
 
@@ -461,6 +481,10 @@ func NewChannelReservation(capacity, localFundingAmt btcutil.Amount,
 		}
 	}
 
+	if req.CommitType.HasZeroFeeCommitments() {
+		chanType |= channeldb.ZeroFeeCommitmentsBit
+	}
+
 	if req.ZeroConf {
 		chanType |= channeldb.ZeroConfBit
 	}
@@ -606,6 +630,17 @@ func (r *ChannelReservation) CommitConstraints(
 		return err
 	}
 
+	// The commitment of a zero-fee channel is a v3 (TRUC) transaction, so
+	// the number of HTLCs must be limited further to keep it below the
+	// maximum weight of such transactions.
+	if r.partialState.ChanType.HasZeroFeeCommitments() &&
+		bounds.MaxAcceptedHtlcs > MaxZeroFeeAcceptedHtlcs {
+
+		return ErrMaxHtlcNumTooLarge(
+			bounds.MaxAcceptedHtlcs, MaxZeroFeeAcceptedHtlcs,
+		)
+	}
+
 	// Our dust limit should always be less than or equal to our proposed
 	// channel reserve.
 	if responder && r.ourContribution.DustLimit > bounds.ChanReserve {
@@ -692,6 +727,80 @@ func (r *ChannelReservation) IsCannedShim() bool {
 	return ok
 }
 
+// AddRemoteFunding adds the amount the remote party contributes to the funding
+// output of a channel we initiated. The contribution increases the capacity
+// of the channel and is credited to the remote balance of both initial
+// commitments, while we keep paying the commitment fees. This is only
+// possible for PSBT funded reservations, where the funding transaction is
+// constructed outside the wallet, and must be done before the remote
+// contribution is processed.
+func (r *ChannelReservation) AddRemoteFunding(amt btcutil.Amount) error {
+	r.Lock()
+	defer r.Unlock()
+
+	psbtIntent, ok := r.fundingIntent.(*chanfunding.PsbtIntent)
+	if !ok {
+		return fmt.Errorf("remote funding requires a PSBT funding " +
+			"intent")
+	}
+
+	if !r.partialState.IsInitiator {
+		return fmt.Errorf("remote funding can only be added by the " +
+			"initiator")
+	}
+
+	amtMSat := lnwire.NewMSatFromSatoshis(amt)
+	r.partialState.Capacity += amt
+	r.partialState.LocalCommitment.RemoteBalance += amtMSat
+	r.partialState.RemoteCommitment.RemoteBalance += amtMSat
+	r.partialState.InitialRemoteBalance += amtMSat
+	psbtIntent.AddRemoteFunding(amt)
+
+	return nil
+}
+
+// PayLeaseFee pays the fee of a lease of inbound liquidity that the remote
+// party contributes to a dual-funded channel we initiated. The fee is moved
+// from our initial balance to the remote party's, and must be paid after the
+// remote funding was added to the reservation.
+func (r *ChannelReservation) PayLeaseFee(fee btcutil.Amount) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if !r.partialState.IsInitiator {
+		return fmt.Errorf("lease fee can only be paid by the " +
+			"initiator")
+	}
+
+	feeMSat := lnwire.NewMSatFromSatoshis(fee)
+	if feeMSat > r.partialState.LocalCommitment.LocalBalance {
+		return fmt.Errorf("lease fee of %v exceeds local balance "+
+			"of %v", fee,
+			r.partialState.LocalCommitment.LocalBalance.ToSatoshis())
+	}
+
+	r.partialState.LocalCommitment.LocalBalance -= feeMSat
+	r.partialState.LocalCommitment.RemoteBalance += feeMSat
+	r.partialState.RemoteCommitment.LocalBalance -= feeMSat
+	r.partialState.RemoteCommitment.RemoteBalance += feeMSat
+	r.partialState.InitialLocalBalance -= feeMSat
+	r.partialState.InitialRemoteBalance += feeMSat
+
+	return nil
+}
+
+// SetInteractiveFundingTx marks the channel as funded by a transaction that
+// was constructed interactively with the remote party, and records the
+// transaction so that it's stored with the channel. This must be done before
+// the reservation is completed.
+func (r *ChannelReservation) SetInteractiveFundingTx(tx *wire.MsgTx) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.partialState.ChanType |= channeldb.InteractiveFundingBit
+	r.partialState.FundingTxn = tx
+}
+
 // ProcessPsbt continues a previously paused funding flow that involves PSBT to
 // construct the funding transaction. This method can be called once the PSBT
 // is finalized and the signed transaction is available.
@@ -251,11 +251,13 @@ func CreateTestChannels(t *testing.T, chanType channeldb.ChannelType,
 	if err != nil {
 		return nil, nil, err
 	}
-	commitFee := calcStaticFee(chanType, 0)
-	var anchorAmt btcutil.Amount
-	if chanType.HasAnchors() {
-		anchorAmt += 2 * AnchorSize
+
+	// Zero-fee commitments never carry a fee.
+	if chanType.HasZeroFeeCommitments() {
+		feePerKw = 0
 	}
+	commitFee := calcStaticFee(chanType, 0)
+	anchorAmt := AnchorsValue(chanType)
 
 	aliceBalance := lnwire.NewMSatFromSatoshis(
 		channelBal - commitFee - anchorAmt,
@@ -532,6 +534,10 @@ func txFromHex(txHex string) (*btcutil.Tx, error) {
 //
 // TODO(bvu): Refactor when dynamic fee estimation is added.
 func calcStaticFee(chanType channeldb.ChannelType, numHTLCs int) btcutil.Amount {
+	if chanType.HasZeroFeeCommitments() {
+		return 0
+	}
+
 	const (
 		htlcWeight = 172
 		feePerKw   = btcutil.Amount(24/4) * 1000
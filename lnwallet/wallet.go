@@ -209,6 +209,11 @@ type InitFundingReserveMsg struct {
 	// be using, like tweakless or anchors.
 	CommitType CommitmentType
 
+	// LeaseExpiry is the absolute expiration height of a script enforced
+	// lease channel that isn't funded through a funding shim. It is
+	// ignored if the shim of the channel provides its own thaw height.
+	LeaseExpiry uint32
+
 	// ChanFunder is an optional channel funder that allows the caller to
 	// control exactly how the channel funding is carried out. If not
 	// specified, then the default chanfunding.WalletAssembler will be
@@ -1039,6 +1044,13 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 		thawHeight = shimIntent.ThawHeight()
 	}
 
+	// A script enforced lease that isn't funded through a shim carries its
+	// expiration height in the request itself.
+	isLease := req.CommitType == CommitmentTypeScriptEnforcedLease
+	if thawHeight == 0 && isLease {
+		thawHeight = req.LeaseExpiry
+	}
+
 	// Now that we have a funding intent, we'll check whether funding a
 	// channel using it would violate our reserved value for anchor channel
 	// fee bumping.
@@ -1956,7 +1968,7 @@ func (l *LightningWallet) handleChanPointReady(req *continueContributionMsg) {
 		ourContribution.FirstCommitmentPoint,
 		theirContribution.FirstCommitmentPoint, fundingTxIn,
 		pendingReservation.partialState.ChanType,
-		pendingReservation.partialState.IsInitiator, leaseExpiry,
+		pendingReservation.partialState.IsLeaseSeller(), leaseExpiry,
 		WithAuxLeaves(localAuxLeaves, remoteAuxLeaves),
 	)
 	if err != nil {
@@ -2323,8 +2335,11 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	res.partialState.RemoteChanCfg = res.theirContribution.toChanConfig()
 
 	// We'll also record the finalized funding txn, which will allow us to
-	// rebroadcast on startup in case we fail.
-	res.partialState.FundingTxn = fundingTx
+	// rebroadcast on startup in case we fail. The transaction of an
+	// interactively funded channel is recorded by the funding manager.
+	if fundingTx != nil {
+		res.partialState.FundingTxn = fundingTx
+	}
 
 	// Set optional upfront shutdown scripts on the channel state so that they
 	// are persisted. These values may be nil.
@@ -2418,7 +2433,7 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 		pendingReservation.ourContribution.FirstCommitmentPoint,
 		pendingReservation.theirContribution.FirstCommitmentPoint,
 		*fundingTxIn, chanType,
-		pendingReservation.partialState.IsInitiator, leaseExpiry,
+		pendingReservation.partialState.IsLeaseSeller(), leaseExpiry,
 		WithAuxLeaves(localAuxLeaves, remoteAuxLeaves),
 	)
 	if err != nil {
@@ -1,11 +1,14 @@
 package lnwire
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
 	"io"
 	"math"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/chainhash/v2"
 	"github.com/btcsuite/btcd/wire/v2"
 	"github.com/lightningnetwork/lnd/tlv"
@@ -88,6 +91,29 @@ func NewChanIDFromOutPoint(op wire.OutPoint) ChannelID {
 	return cid
 }
 
+// NewChanIDFromRevocationBasepoints derives the ChannelID of a channel that is
+// opened using the v2 channel establishment protocol. As defined in BOLT 2, it
+// is the SHA256 hash of the lesser and the greater of both revocation
+// basepoints in their compressed serialization. Unlike a ChannelID derived from
+// the funding outpoint, it stays the same if the funding transaction is
+// replaced.
+func NewChanIDFromRevocationBasepoints(a, b *btcec.PublicKey) ChannelID {
+	lesser := a.SerializeCompressed()
+	greater := b.SerializeCompressed()
+	if bytes.Compare(lesser, greater) > 0 {
+		lesser, greater = greater, lesser
+	}
+
+	h := sha256.New()
+	h.Write(lesser)
+	h.Write(greater)
+
+	var cid ChannelID
+	copy(cid[:], h.Sum(nil))
+
+	return cid
+}
+
 // xorTxid performs the transformation needed to transform an OutPoint into a
 // ChannelID. To do this, we expect the cid parameter to contain the txid
 // unaltered and the outputIndex to be the output index
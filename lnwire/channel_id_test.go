@@ -1,6 +1,13 @@
 package lnwire
 
-import "testing"
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
 
 // TestChannelIDOutPointConversion ensures that the IsChanPoint always
 // recognizes its seed OutPoint for all possible values of an output index.
@@ -70,3 +77,25 @@ func TestGenPossibleOutPoints(t *testing.T) {
 		t.Fatalf("possible outpoints did not contain the root outpoint")
 	}
 }
+
+// TestChanIDFromRevocationBasepoints checks that the v2 channel ID is the hash
+// of the ordered revocation basepoints, regardless of which peer derives it.
+func TestChanIDFromRevocationBasepoints(t *testing.T) {
+	t.Parallel()
+
+	_, a := btcec.PrivKeyFromBytes(bytes.Repeat([]byte{1}, 32))
+	_, b := btcec.PrivKeyFromBytes(bytes.Repeat([]byte{2}, 32))
+
+	lesser, greater := a.SerializeCompressed(), b.SerializeCompressed()
+	if bytes.Compare(lesser, greater) > 0 {
+		lesser, greater = greater, lesser
+	}
+	expected := sha256.Sum256(append(lesser, greater...))
+
+	require.Equal(
+		t, ChannelID(expected), NewChanIDFromRevocationBasepoints(a, b),
+	)
+	require.Equal(
+		t, ChannelID(expected), NewChanIDFromRevocationBasepoints(b, a),
+	)
+}
@@ -161,6 +161,18 @@ const (
 	// addresses for cooperative closure addresses.
 	ShutdownAnySegwitOptional FeatureBit = 27
 
+	// DualFundRequired is a required feature bit that signals that the
+	// sender supports the v2 channel establishment protocol, in which both
+	// peers may contribute inputs to an interactively constructed funding
+	// transaction.
+	DualFundRequired FeatureBit = 28
+
+	// DualFundOptional is an optional feature bit that signals that the
+	// sender supports the v2 channel establishment protocol, in which both
+	// peers may contribute inputs to an interactively constructed funding
+	// transaction.
+	DualFundOptional FeatureBit = 29
+
 	// AMPRequired is a required feature bit that signals that the receiver
 	// of a payment supports accepts spontaneous payments, i.e.
 	// sender-generated preimages according to BOLT XX.
@@ -181,6 +193,28 @@ const (
 	// quiescence protocol.
 	QuiescenceOptional FeatureBit = 35
 
+	// ZeroFeeCommitmentsRequired is a required feature bit that signals
+	// that the node supports channels whose commitment transactions are v3
+	// (TRUC) transactions that pay no fee and carry a single shared
+	// pay-to-anchor output.
+	ZeroFeeCommitmentsRequired FeatureBit = 40
+
+	// ZeroFeeCommitmentsOptional is an optional feature bit that signals
+	// that the node supports channels whose commitment transactions are v3
+	// (TRUC) transactions that pay no fee and carry a single shared
+	// pay-to-anchor output.
+	ZeroFeeCommitmentsOptional FeatureBit = 41
+
+	// ProvideStorageRequired is a required feature bit that signals that
+	// the node stores a small blob on behalf of its channel peers and
+	// returns it to them when they reconnect.
+	ProvideStorageRequired FeatureBit = 42
+
+	// ProvideStorageOptional is an optional feature bit that signals that
+	// the node stores a small blob on behalf of its channel peers and
+	// returns it to them when they reconnect.
+	ProvideStorageOptional FeatureBit = 43
+
 	// ExplicitChannelTypeRequired is a required bit that denotes that a
 	// connection established with this node is to use explicit channel
 	// commitment types for negotiation instead of the existing implicit
@@ -309,6 +343,16 @@ const (
 	// a BOLT 11 invoice.
 	Bolt11BlindedPathsOptional = 263
 
+	// ExperimentalUpfrontFeesRequired is a required feature bit that
+	// indicates that the node understands and pays out experimental
+	// unconditional upfront fees on htlcs.
+	ExperimentalUpfrontFeesRequired FeatureBit = 264
+
+	// ExperimentalUpfrontFeesOptional is an optional feature bit that
+	// indicates that the node understands and pays out experimental
+	// unconditional upfront fees on htlcs.
+	ExperimentalUpfrontFeesOptional FeatureBit = 265
+
 	// SimpleTaprootOverlayChansRequired is a required bit that indicates
 	// support for the special custom taproot overlay channel.
 	SimpleTaprootOverlayChansOptional = 2025
@@ -325,6 +369,18 @@ const (
 	// that the node can forward onion messages.
 	OnionMessagesOptional = 39
 
+	// TrampolineRoutingRequiredStaging is a required feature bit that
+	// indicates that the node is able to forward trampoline payments. This
+	// is the feature bit used in the wild while trampoline routing is
+	// still being specified.
+	TrampolineRoutingRequiredStaging FeatureBit = 148
+
+	// TrampolineRoutingOptionalStaging is an optional feature bit that
+	// indicates that the node is able to forward trampoline payments. This
+	// is the feature bit used in the wild while trampoline routing is
+	// still being specified.
+	TrampolineRoutingOptionalStaging FeatureBit = 149
+
 	// MaxBolt11Feature is the maximum feature bit value allowed in bolt 11
 	// invoices.
 	//
@@ -375,6 +431,10 @@ var Features = map[FeatureBit]string{
 	QuiescenceOptional:                   "quiescence",
 	PaymentMetadataOptional:              "payment-metadata",
 	PaymentMetadataRequired:              "payment-metadata",
+	ZeroFeeCommitmentsOptional:           "zero-fee-commitments",
+	ZeroFeeCommitmentsRequired:           "zero-fee-commitments",
+	ProvideStorageOptional:               "provide-storage",
+	ProvideStorageRequired:               "provide-storage",
 	ExplicitChannelTypeOptional:          "explicit-commitment-type",
 	ExplicitChannelTypeRequired:          "explicit-commitment-type",
 	KeysendOptional:                      "keysend",
@@ -389,6 +449,8 @@ var Features = map[FeatureBit]string{
 	RouteBlindingOptional:                "route-blinding",
 	ShutdownAnySegwitRequired:            "shutdown-any-segwit",
 	ShutdownAnySegwitOptional:            "shutdown-any-segwit",
+	DualFundRequired:                     "dual-fund",
+	DualFundOptional:                     "dual-fund",
 	SimpleTaprootChannelsRequiredFinal:   "simple-taproot-chans",
 	SimpleTaprootChannelsOptionalFinal:   "simple-taproot-chans",
 	SimpleTaprootChannelsRequiredStaging: "simple-taproot-chans-x",
@@ -399,12 +461,16 @@ var Features = map[FeatureBit]string{
 	ExperimentalAccountabilityOptional:   "accountable-x",
 	Bolt11BlindedPathsOptional:           "bolt-11-blinded-paths",
 	Bolt11BlindedPathsRequired:           "bolt-11-blinded-paths",
+	ExperimentalUpfrontFeesRequired:      "upfront-fees-x",
+	ExperimentalUpfrontFeesOptional:      "upfront-fees-x",
 	RbfCoopCloseOptional:                 "rbf-coop-close",
 	RbfCoopCloseRequired:                 "rbf-coop-close",
 	RbfCoopCloseOptionalStaging:          "rbf-coop-close-x",
 	RbfCoopCloseRequiredStaging:          "rbf-coop-close-x",
 	OnionMessagesOptional:                "onion-messages",
 	OnionMessagesRequired:                "onion-messages",
+	TrampolineRoutingOptionalStaging:     "trampoline-routing-x",
+	TrampolineRoutingRequiredStaging:     "trampoline-routing-x",
 }
 
 // RawFeatureVector represents a set of feature bits as defined in BOLT-09.  A
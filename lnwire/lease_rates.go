@@ -0,0 +1,126 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// LeaseRatesRecordType is the type of the option_will_fund record of a
+	// node announcement, through which a node advertises the rates at
+	// which it leases out inbound liquidity.
+	LeaseRatesRecordType tlv.Type = 1
+
+	// leaseRatesLen is the length of the encoded lease rates.
+	leaseRatesLen = 14
+)
+
+// LeaseRates are the rates at which a node leases out inbound liquidity, as
+// advertised in its node announcement.
+type LeaseRates struct {
+	// FundingWeight is the weight of the inputs and outputs the seller
+	// adds to the funding transaction, which the buyer pays for at the
+	// requested funding fee rate.
+	FundingWeight uint16
+
+	// LeaseFeeBasis is the fee charged for a lease in basis points of the
+	// leased amount.
+	LeaseFeeBasis uint16
+
+	// ChannelFeeMaxProportional is the maximum proportional routing fee
+	// in millionths the seller charges on a leased channel for the
+	// duration of the lease.
+	ChannelFeeMaxProportional uint16
+
+	// LeaseFeeBaseSat is the base fee in satoshis charged for a lease.
+	LeaseFeeBaseSat uint32
+
+	// ChannelFeeMaxBaseMsat is the maximum base routing fee in
+	// milli-satoshis the seller charges on a leased channel for the
+	// duration of the lease.
+	ChannelFeeMaxBaseMsat uint32
+}
+
+// Record returns a TLV record that can be used to encode/decode the lease
+// rates of a node announcement.
+func (l *LeaseRates) Record() tlv.Record {
+	return tlv.MakeStaticRecord(
+		LeaseRatesRecordType, l, leaseRatesLen, leaseRatesEncoder,
+		leaseRatesDecoder,
+	)
+}
+
+// leaseRatesEncoder is a custom TLV encoder for the LeaseRates record.
+func leaseRatesEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*LeaseRates); ok {
+		var b bytes.Buffer
+		if err := WriteLeaseRates(&b, *v); err != nil {
+			return err
+		}
+
+		_, err := w.Write(b.Bytes())
+
+		return err
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.LeaseRates")
+}
+
+// leaseRatesDecoder is a custom TLV decoder for the LeaseRates record.
+func leaseRatesDecoder(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*LeaseRates); ok && l == leaseRatesLen {
+		return ReadElements(r,
+			&v.FundingWeight,
+			&v.LeaseFeeBasis,
+			&v.ChannelFeeMaxProportional,
+			&v.LeaseFeeBaseSat,
+			&v.ChannelFeeMaxBaseMsat,
+		)
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "lnwire.LeaseRates", l,
+		leaseRatesLen)
+}
+
+// WriteLeaseRates appends the encoded lease rates to the provided buffer.
+func WriteLeaseRates(buf *bytes.Buffer, rates LeaseRates) error {
+	if err := WriteUint16(buf, rates.FundingWeight); err != nil {
+		return err
+	}
+
+	if err := WriteUint16(buf, rates.LeaseFeeBasis); err != nil {
+		return err
+	}
+
+	err := WriteUint16(buf, rates.ChannelFeeMaxProportional)
+	if err != nil {
+		return err
+	}
+
+	if err := WriteUint32(buf, rates.LeaseFeeBaseSat); err != nil {
+		return err
+	}
+
+	return WriteUint32(buf, rates.ChannelFeeMaxBaseMsat)
+}
+
+// ExtractLeaseRates returns the lease rates advertised in the extra opaque
+// data of a node announcement. Nil is returned if the node doesn't advertise
+// any lease rates.
+func ExtractLeaseRates(extraData ExtraOpaqueData) (*LeaseRates, error) {
+	var rates LeaseRates
+	typeMap, err := extraData.ExtractRecords(&rates)
+	if err != nil {
+		return nil, err
+	}
+
+	if val, ok := typeMap[LeaseRatesRecordType]; !ok || val != nil {
+		return nil, nil
+	}
+
+	return &rates, nil
+}
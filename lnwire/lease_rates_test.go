@@ -0,0 +1,30 @@
+package lnwire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+// TestExtractLeaseRates tests that lease rates packed into the extra opaque
+// data of a node announcement can be extracted again.
+func TestExtractLeaseRates(t *testing.T) {
+	t.Parallel()
+
+	// Without lease rates, nothing is extracted.
+	rates, err := ExtractLeaseRates(nil)
+	require.NoError(t, err)
+	require.Nil(t, rates)
+
+	rapid.Check(t, func(t *rapid.T) {
+		advertised := RandLeaseRates(t)
+
+		var extraData ExtraOpaqueData
+		require.NoError(t, extraData.PackRecords(&advertised))
+
+		rates, err := ExtractLeaseRates(extraData)
+		require.NoError(t, err)
+		require.Equal(t, &advertised, rates)
+	})
+}
@@ -547,7 +547,7 @@ func newMsgUpdateFailHTLC(t testing.TB, r *rand.Rand) *lnwire.UpdateFailHTLC {
 
 	msg := &lnwire.UpdateFailHTLC{
 		ID:        r.Uint64(),
-		ExtraData: createExtraData(t, r),
+		ExtraData: createValidTLVExtraData(t, r),
 	}
 
 	_, err := r.Read(msg.ChanID[:])
@@ -81,6 +81,12 @@ const (
 	CodeInvalidOnionPayload                       = FlagPerm | 22
 	CodeMPPTimeout                       FailCode = 23
 	CodeInvalidBlinding                           = FlagBadOnion | FlagPerm | 24 //nolint:ll
+
+	// The following failure codes are used by trampoline nodes. They
+	// haven't been assigned by the spec yet, so we use the values that are
+	// already deployed on the network.
+	CodeTemporaryTrampolineFailure        = FlagNode | 25
+	CodeTrampolineFeeOrExpiryInsufficient = FlagNode | 26
 )
 
 // String returns the string representation of the failure code.
@@ -161,6 +167,12 @@ func (c FailCode) String() string {
 	case CodeInvalidBlinding:
 		return "InvalidBlinding"
 
+	case CodeTemporaryTrampolineFailure:
+		return "TemporaryTrampolineFailure"
+
+	case CodeTrampolineFeeOrExpiryInsufficient:
+		return "TrampolineFeeOrExpiryInsufficient"
+
 	default:
 		return "<unknown>"
 	}
@@ -1290,6 +1302,103 @@ func NewInvalidBlinding(
 	return &FailInvalidBlinding{OnionSHA256: shaSum}
 }
 
+// FailTemporaryTrampolineFailure is returned by a trampoline node if it was
+// unable to forward the payment to the next trampoline node or the final
+// recipient for a reason that may be temporary.
+//
+// NOTE: May only be returned by trampoline nodes.
+type FailTemporaryTrampolineFailure struct{}
+
+// Code returns the failure unique code.
+//
+// NOTE: Part of the FailureMessage interface.
+func (f *FailTemporaryTrampolineFailure) Code() FailCode {
+	return CodeTemporaryTrampolineFailure
+}
+
+// Returns a human readable string describing the target FailureMessage.
+//
+// NOTE: Implements the error interface.
+func (f *FailTemporaryTrampolineFailure) Error() string {
+	return f.Code().String()
+}
+
+// FailTrampolineFeeOrExpiryInsufficient is returned by a trampoline node if
+// the fee or the cltv expiry delta that was left for it by the sender is too
+// low to forward the payment. The trampoline node includes the fee and cltv
+// delta it requires so that the sender can retry with a larger budget.
+//
+// NOTE: May only be returned by trampoline nodes.
+type FailTrampolineFeeOrExpiryInsufficient struct {
+	// FeeBaseMsat is the base fee required by the trampoline node.
+	FeeBaseMsat uint32
+
+	// FeeProportionalMillionths is the proportional fee required by the
+	// trampoline node.
+	FeeProportionalMillionths uint32
+
+	// CltvExpiryDelta is the cltv expiry delta required by the trampoline
+	// node.
+	CltvExpiryDelta uint16
+}
+
+// NewTrampolineFeeOrExpiryInsufficient creates new instance of the
+// FailTrampolineFeeOrExpiryInsufficient.
+func NewTrampolineFeeOrExpiryInsufficient(feeBase, feeRate uint32,
+	cltvDelta uint16) *FailTrampolineFeeOrExpiryInsufficient {
+
+	return &FailTrampolineFeeOrExpiryInsufficient{
+		FeeBaseMsat:               feeBase,
+		FeeProportionalMillionths: feeRate,
+		CltvExpiryDelta:           cltvDelta,
+	}
+}
+
+// Code returns the failure unique code.
+//
+// NOTE: Part of the FailureMessage interface.
+func (f *FailTrampolineFeeOrExpiryInsufficient) Code() FailCode {
+	return CodeTrampolineFeeOrExpiryInsufficient
+}
+
+// Returns a human readable string describing the target FailureMessage.
+//
+// NOTE: Implements the error interface.
+func (f *FailTrampolineFeeOrExpiryInsufficient) Error() string {
+	return fmt.Sprintf("TrampolineFeeOrExpiryInsufficient(base_fee=%v, "+
+		"fee_rate=%v, cltv_delta=%v)", f.FeeBaseMsat,
+		f.FeeProportionalMillionths, f.CltvExpiryDelta)
+}
+
+// Decode decodes the failure from bytes stream.
+//
+// NOTE: Part of the Serializable interface.
+func (f *FailTrampolineFeeOrExpiryInsufficient) Decode(r io.Reader,
+	_ uint32) error {
+
+	return ReadElements(
+		r, &f.FeeBaseMsat, &f.FeeProportionalMillionths,
+		&f.CltvExpiryDelta,
+	)
+}
+
+// Encode writes the failure in bytes stream.
+//
+// NOTE: Part of the Serializable interface.
+func (f *FailTrampolineFeeOrExpiryInsufficient) Encode(w *bytes.Buffer,
+	_ uint32) error {
+
+	if err := WriteUint32(w, f.FeeBaseMsat); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, f.FeeProportionalMillionths); err != nil {
+		return err
+	}
+
+	return WriteUint16(w, f.CltvExpiryDelta)
+}
+
 // DecodeFailure decodes, validates, and parses the lnwire onion failure, for
 // the provided protocol version.
 func DecodeFailure(r io.Reader, pver uint32) (FailureMessage, error) {
@@ -1511,6 +1620,12 @@ func makeEmptyOnionError(code FailCode) (FailureMessage, error) {
 	case CodeInvalidBlinding:
 		return &FailInvalidBlinding{}, nil
 
+	case CodeTemporaryTrampolineFailure:
+		return &FailTemporaryTrampolineFailure{}, nil
+
+	case CodeTrampolineFeeOrExpiryInsufficient:
+		return &FailTrampolineFeeOrExpiryInsufficient{}, nil
+
 	default:
 		return nil, fmt.Errorf("unknown error code: %v", code)
 	}
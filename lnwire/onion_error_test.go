@@ -45,6 +45,7 @@ var onionFailures = []FailureMessage{
 	&FailIncorrectPaymentAmount{},
 	&FailFinalExpiryTooSoon{},
 	&FailMPPTimeout{},
+	&FailTemporaryTrampolineFailure{},
 
 	NewFailIncorrectDetails(99, 100),
 	NewInvalidOnionVersion(testOnionHash[:]),
@@ -61,6 +62,7 @@ var onionFailures = []FailureMessage{
 	NewFinalIncorrectHtlcAmount(testAmount),
 	NewInvalidOnionPayload(testType, testOffset),
 	NewInvalidBlinding(fn.Some(testOnionHash)),
+	NewTrampolineFeeOrExpiryInsufficient(1000, 500, 144),
 }
 
 // TestEncodeDecodeCode tests the ability of onion errors to be properly encoded
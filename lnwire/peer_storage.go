@@ -0,0 +1,191 @@
+package lnwire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// MaxPeerStorageBlobSize is the largest blob a peer can ask us to store. It
+// is the largest message body minus the two byte length prefix of the blob.
+const MaxPeerStorageBlobSize = MaxMsgBody - 2
+
+// writePeerStorageBlob writes the length prefixed blob of a peer storage
+// message.
+func writePeerStorageBlob(w *bytes.Buffer, blob []byte) error {
+	if len(blob) > MaxPeerStorageBlobSize {
+		return fmt.Errorf("peer storage blob of %d bytes exceeds "+
+			"maximum of %d bytes", len(blob),
+			MaxPeerStorageBlobSize)
+	}
+
+	if err := WriteUint16(w, uint16(len(blob))); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, blob)
+}
+
+// readPeerStorageBlob reads the length prefixed blob of a peer storage
+// message. An empty blob is returned as nil.
+func readPeerStorageBlob(r io.Reader) ([]byte, error) {
+	var l [2]byte
+	if _, err := io.ReadFull(r, l[:]); err != nil {
+		return nil, err
+	}
+
+	blobLen := binary.BigEndian.Uint16(l[:])
+	if blobLen == 0 {
+		return nil, nil
+	}
+
+	blob := make([]byte, blobLen)
+	if _, err := io.ReadFull(r, blob); err != nil {
+		return nil, err
+	}
+
+	return blob, nil
+}
+
+// PeerStorage is sent to a peer that signals option_provide_storage to ask it
+// to store the given blob on our behalf. Each new PeerStorage message replaces
+// the blob the peer stored for us before. The peer returns the latest blob in
+// a PeerStorageRetrieval message whenever we reconnect.
+type PeerStorage struct {
+	// Blob is the opaque data the peer should store for us. The sender is
+	// expected to encrypt it, as the peer can read it.
+	Blob []byte
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure PeerStorage implements the lnwire.Message
+// interface.
+var _ Message = (*PeerStorage)(nil)
+
+// A compile time check to ensure PeerStorage implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*PeerStorage)(nil)
+
+// Encode serializes the target PeerStorage into the passed io.Writer.
+//
+// This is part of the lnwire.Message interface.
+func (p *PeerStorage) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := writePeerStorageBlob(w, p.Blob); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, p.ExtraData)
+}
+
+// Decode deserializes the serialized PeerStorage stored in the passed
+// io.Reader.
+//
+// This is part of the lnwire.Message interface.
+func (p *PeerStorage) Decode(r io.Reader, _ uint32) error {
+	blob, err := readPeerStorageBlob(r)
+	if err != nil {
+		return err
+	}
+	p.Blob = blob
+
+	if err := ReadElement(r, &p.ExtraData); err != nil {
+		return err
+	}
+
+	// This is required to pass the fuzz test round trip equality check.
+	if len(p.ExtraData) == 0 {
+		p.ExtraData = nil
+	}
+
+	return nil
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a PeerStorage on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (p *PeerStorage) MsgType() MessageType {
+	return MsgPeerStorage
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (p *PeerStorage) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(p)
+}
+
+// PeerStorageRetrieval is sent to a peer after it reconnected and carries the
+// latest blob that the peer asked us to store in a PeerStorage message.
+type PeerStorageRetrieval struct {
+	// Blob is the opaque data the peer asked us to store.
+	Blob []byte
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure PeerStorageRetrieval implements the
+// lnwire.Message interface.
+var _ Message = (*PeerStorageRetrieval)(nil)
+
+// A compile time check to ensure PeerStorageRetrieval implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*PeerStorageRetrieval)(nil)
+
+// Encode serializes the target PeerStorageRetrieval into the passed
+// io.Writer.
+//
+// This is part of the lnwire.Message interface.
+func (p *PeerStorageRetrieval) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := writePeerStorageBlob(w, p.Blob); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, p.ExtraData)
+}
+
+// Decode deserializes the serialized PeerStorageRetrieval stored in the
+// passed io.Reader.
+//
+// This is part of the lnwire.Message interface.
+func (p *PeerStorageRetrieval) Decode(r io.Reader, _ uint32) error {
+	blob, err := readPeerStorageBlob(r)
+	if err != nil {
+		return err
+	}
+	p.Blob = blob
+
+	if err := ReadElement(r, &p.ExtraData); err != nil {
+		return err
+	}
+
+	// This is required to pass the fuzz test round trip equality check.
+	if len(p.ExtraData) == 0 {
+		p.ExtraData = nil
+	}
+
+	return nil
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a PeerStorageRetrieval on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (p *PeerStorageRetrieval) MsgType() MessageType {
+	return MsgPeerStorageRetrieval
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (p *PeerStorageRetrieval) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(p)
+}
@@ -0,0 +1,141 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/btcutil/v2"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// RequestFundsRecordType is the type of the request_funds record of an
+	// open_channel2 message, through which the opener asks the accepter
+	// to lease it inbound liquidity.
+	RequestFundsRecordType tlv.Type = 3
+
+	// WillFundRecordType is the type of the will_fund record of an
+	// accept_channel2 message, through which the accepter commits to
+	// lease the requested inbound liquidity.
+	WillFundRecordType tlv.Type = 3
+
+	// requestFundsLen is the length of the encoded request_funds record.
+	requestFundsLen = 12
+
+	// willFundLen is the length of the encoded will_fund record.
+	willFundLen = 64 + leaseRatesLen
+)
+
+// RequestFunds is the request of the opener of a dual funded channel to lease
+// inbound liquidity from the accepter, who advertises its lease rates in its
+// node announcement.
+type RequestFunds struct {
+	// RequestedSats is the amount of inbound liquidity requested, which
+	// the accepter contributes to the funding output.
+	RequestedSats btcutil.Amount
+
+	// BlockHeight is the current block height of the opener. The lease
+	// expires a fixed number of blocks after this height.
+	BlockHeight uint32
+}
+
+// Record returns a TLV record that can be used to encode/decode the
+// request_funds record of an open_channel2 message.
+func (r *RequestFunds) Record() tlv.Record {
+	return tlv.MakeStaticRecord(
+		RequestFundsRecordType, r, requestFundsLen,
+		requestFundsEncoder, requestFundsDecoder,
+	)
+}
+
+// requestFundsEncoder is a custom TLV encoder for the RequestFunds record.
+func requestFundsEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*RequestFunds); ok {
+		var b bytes.Buffer
+		if err := WriteSatoshi(&b, v.RequestedSats); err != nil {
+			return err
+		}
+
+		if err := WriteUint32(&b, v.BlockHeight); err != nil {
+			return err
+		}
+
+		_, err := w.Write(b.Bytes())
+
+		return err
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.RequestFunds")
+}
+
+// requestFundsDecoder is a custom TLV decoder for the RequestFunds record.
+func requestFundsDecoder(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*RequestFunds); ok && l == requestFundsLen {
+		return ReadElements(r, &v.RequestedSats, &v.BlockHeight)
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "lnwire.RequestFunds", l,
+		requestFundsLen)
+}
+
+// WillFund is the commitment of the accepter of a dual funded channel to lease
+// the inbound liquidity requested by the opener at the given rates.
+type WillFund struct {
+	// Signature is the signature of the accepter's node key over the
+	// lease, which commits it to the lease expiry and the maximum routing
+	// fees it charges on the channel for the duration of the lease.
+	Signature Sig
+
+	// LeaseRates are the rates the lease is priced at.
+	LeaseRates LeaseRates
+}
+
+// Record returns a TLV record that can be used to encode/decode the will_fund
+// record of an accept_channel2 message.
+func (w *WillFund) Record() tlv.Record {
+	return tlv.MakeStaticRecord(
+		WillFundRecordType, w, willFundLen, willFundEncoder,
+		willFundDecoder,
+	)
+}
+
+// willFundEncoder is a custom TLV encoder for the WillFund record.
+func willFundEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*WillFund); ok {
+		var b bytes.Buffer
+		if err := WriteSig(&b, v.Signature); err != nil {
+			return err
+		}
+
+		if err := WriteLeaseRates(&b, v.LeaseRates); err != nil {
+			return err
+		}
+
+		_, err := w.Write(b.Bytes())
+
+		return err
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.WillFund")
+}
+
+// willFundDecoder is a custom TLV decoder for the WillFund record.
+func willFundDecoder(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*WillFund); ok && l == willFundLen {
+		return ReadElements(r,
+			&v.Signature,
+			&v.LeaseRates.FundingWeight,
+			&v.LeaseRates.LeaseFeeBasis,
+			&v.LeaseRates.ChannelFeeMaxProportional,
+			&v.LeaseRates.LeaseFeeBaseSat,
+			&v.LeaseRates.ChannelFeeMaxBaseMsat,
+		)
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "lnwire.WillFund", l,
+		willFundLen)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil/v2"
 	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/btcsuite/btcd/wire/v2"
 	"github.com/lightningnetwork/lnd/fn/v2"
 	"github.com/lightningnetwork/lnd/tlv"
 	"github.com/lightningnetwork/lnd/tor"
@@ -104,6 +105,67 @@ func (a *AcceptChannel) RandTestMessage(t *rapid.T) Message {
 	}
 }
 
+// A compile time check to ensure AcceptChannel2 implements the TestMessage
+// interface.
+var _ TestMessage = (*AcceptChannel2)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (a *AcceptChannel2) RandTestMessage(t *rapid.T) Message {
+	var channelType *ChannelType
+	if rapid.Bool().Draw(t, "includeChannelType") {
+		channelType = RandChannelType(t)
+	}
+
+	var willFund *WillFund
+	if rapid.Bool().Draw(t, "includeWillFund") {
+		willFund = &WillFund{
+			Signature:  RandSignature(t),
+			LeaseRates: RandLeaseRates(t),
+		}
+	}
+
+	return &AcceptChannel2{
+		PendingChannelID: [32]byte(RandChannelID(t)),
+		FundingAmount: btcutil.Amount(
+			rapid.IntRange(0, 10000000).Draw(t, "fundingAmount"),
+		),
+		DustLimit: btcutil.Amount(
+			rapid.IntRange(100, 1000).Draw(t, "dustLimit"),
+		),
+		MaxValueInFlight: MilliSatoshi(
+			rapid.IntRange(10000, 1000000).Draw(
+				t, "maxValueInFlight",
+			),
+		),
+		HtlcMinimum: MilliSatoshi(
+			rapid.IntRange(1, 1000).Draw(t, "htlcMinimum"),
+		),
+		MinAcceptDepth: uint32(
+			rapid.IntRange(1, 10).Draw(t, "minAcceptDepth"),
+		),
+		CsvDelay: uint16(
+			rapid.IntRange(144, 1000).Draw(t, "csvDelay"),
+		),
+		MaxAcceptedHTLCs: uint16(
+			rapid.IntRange(10, 500).Draw(t, "maxAcceptedHTLCs"),
+		),
+		FundingKey:            RandPubKey(t),
+		RevocationPoint:       RandPubKey(t),
+		PaymentPoint:          RandPubKey(t),
+		DelayedPaymentPoint:   RandPubKey(t),
+		HtlcPoint:             RandPubKey(t),
+		FirstCommitmentPoint:  RandPubKey(t),
+		SecondCommitmentPoint: RandPubKey(t),
+		UpfrontShutdownScript: RandDeliveryAddress(t),
+		ChannelType:           channelType,
+		WillFund:              willFund,
+		ExtraData:             RandExtraOpaqueData(t, nil),
+	}
+}
+
 // A compile time check to ensure AnnounceSignatures1 implements the
 // lnwire.TestMessage interface.
 var _ TestMessage = (*AnnounceSignatures1)(nil)
@@ -1613,6 +1675,132 @@ func (o *OpenChannel) RandTestMessage(t *rapid.T) Message {
 	}
 }
 
+// A compile time check to ensure OpenChannel2 implements the
+// lnwire.TestMessage interface.
+var _ TestMessage = (*OpenChannel2)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (o *OpenChannel2) RandTestMessage(t *rapid.T) Message {
+	var channelFlags FundingFlag
+	if rapid.Bool().Draw(t, "announceChannel") {
+		channelFlags |= FFAnnounceChannel
+	}
+
+	var channelType *ChannelType
+	if rapid.Bool().Draw(t, "includeChannelType") {
+		channelType = RandChannelType(t)
+	}
+
+	var requestFunds *RequestFunds
+	if rapid.Bool().Draw(t, "includeRequestFunds") {
+		requestFunds = &RequestFunds{
+			RequestedSats: btcutil.Amount(
+				rapid.Int64Min(0).Draw(t, "requestedSats"),
+			),
+			BlockHeight: rapid.Uint32().Draw(t, "blockHeight"),
+		}
+	}
+
+	return &OpenChannel2{
+		ChainHash:        RandChainHash(t),
+		PendingChannelID: [32]byte(RandChannelID(t)),
+		FundingFeePerKw: uint32(
+			rapid.IntRange(253, 10000).Draw(t, "fundingFeePerKw"),
+		),
+		CommitFeePerKw: uint32(
+			rapid.IntRange(253, 10000).Draw(t, "commitFeePerKw"),
+		),
+		FundingAmount: btcutil.Amount(
+			rapid.IntRange(5000, 10000000).Draw(t, "fundingAmount"),
+		),
+		DustLimit: btcutil.Amount(
+			rapid.IntRange(100, 1000).Draw(t, "dustLimit"),
+		),
+		MaxValueInFlight: MilliSatoshi(
+			rapid.IntRange(10000, 1000000).Draw(
+				t, "maxValueInFlight",
+			),
+		),
+		HtlcMinimum: MilliSatoshi(
+			rapid.IntRange(1, 1000).Draw(t, "htlcMinimum"),
+		),
+		CsvDelay: uint16(
+			rapid.IntRange(144, 1000).Draw(t, "csvDelay"),
+		),
+		MaxAcceptedHTLCs: uint16(
+			rapid.IntRange(10, 500).Draw(t, "maxAcceptedHTLCs"),
+		),
+		LockTime:              rapid.Uint32().Draw(t, "lockTime"),
+		FundingKey:            RandPubKey(t),
+		RevocationPoint:       RandPubKey(t),
+		PaymentPoint:          RandPubKey(t),
+		DelayedPaymentPoint:   RandPubKey(t),
+		HtlcPoint:             RandPubKey(t),
+		FirstCommitmentPoint:  RandPubKey(t),
+		SecondCommitmentPoint: RandPubKey(t),
+		ChannelFlags:          channelFlags,
+		UpfrontShutdownScript: RandDeliveryAddress(t),
+		ChannelType:           channelType,
+		RequestFunds:          requestFunds,
+		ExtraData:             RandExtraOpaqueData(t, nil),
+	}
+}
+
+// randPeerStorageBlob generates a random peer storage blob.
+func randPeerStorageBlob(t *rapid.T) []byte {
+	blobLen := rapid.IntRange(0, 1000).Draw(t, "blobLen")
+	if blobLen == 0 {
+		return nil
+	}
+
+	return rapid.SliceOfN(rapid.Byte(), blobLen, blobLen).Draw(t, "blob")
+}
+
+// A compile time check to ensure PeerStorage implements the
+// lnwire.TestMessage interface.
+var _ TestMessage = (*PeerStorage)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (p *PeerStorage) RandTestMessage(t *rapid.T) Message {
+	m := &PeerStorage{
+		Blob: randPeerStorageBlob(t),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// A compile time check to ensure PeerStorageRetrieval implements the
+// lnwire.TestMessage interface.
+var _ TestMessage = (*PeerStorageRetrieval)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (p *PeerStorageRetrieval) RandTestMessage(t *rapid.T) Message {
+	m := &PeerStorageRetrieval{
+		Blob: randPeerStorageBlob(t),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
 // A compile time check to ensure Ping implements the lnwire.TestMessage
 // interface.
 var _ TestMessage = (*Ping)(nil)
@@ -1957,6 +2145,236 @@ func (s *Stfu) RandTestMessage(t *rapid.T) Message {
 	return m
 }
 
+// randBytes returns a random byte slice of at most maxLen bytes that is nil if
+// empty.
+func randBytes(t *rapid.T, maxLen int, label string) []byte {
+	n := rapid.IntRange(0, maxLen).Draw(t, label+"Len")
+	if n == 0 {
+		return nil
+	}
+
+	return rapid.SliceOfN(rapid.Byte(), n, n).Draw(t, label)
+}
+
+// randFundingContribution returns an optional random funding contribution.
+func randFundingContribution(t *rapid.T) FundingContributionRecord {
+	var contribution FundingContributionRecord
+	if rapid.Bool().Draw(t, "includeContribution") {
+		contribution = tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType0](
+				rapid.Uint64().Draw(t, "contribution"),
+			),
+		)
+	}
+
+	return contribution
+}
+
+// A compile time check to ensure TxAbort implements the lnwire.TestMessage
+// interface.
+var _ TestMessage = (*TxAbort)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (c *TxAbort) RandTestMessage(t *rapid.T) Message {
+	m := &TxAbort{
+		ChanID: RandChannelID(t),
+		Data:   randBytes(t, 100, "data"),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// A compile time check to ensure TxAckRbf implements the lnwire.TestMessage
+// interface.
+var _ TestMessage = (*TxAckRbf)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (c *TxAckRbf) RandTestMessage(t *rapid.T) Message {
+	return &TxAckRbf{
+		ChanID:              RandChannelID(t),
+		FundingContribution: randFundingContribution(t),
+	}
+}
+
+// A compile time check to ensure TxAddInput implements the lnwire.TestMessage
+// interface.
+var _ TestMessage = (*TxAddInput)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (c *TxAddInput) RandTestMessage(t *rapid.T) Message {
+	m := &TxAddInput{
+		ChanID:     RandChannelID(t),
+		SerialID:   rapid.Uint64().Draw(t, "serialID"),
+		PrevTx:     randBytes(t, 1000, "prevTx"),
+		PrevTxVout: rapid.Uint32().Draw(t, "prevTxVout"),
+		Sequence:   rapid.Uint32().Draw(t, "sequence"),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// A compile time check to ensure TxAddOutput implements the
+// lnwire.TestMessage interface.
+var _ TestMessage = (*TxAddOutput)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (c *TxAddOutput) RandTestMessage(t *rapid.T) Message {
+	m := &TxAddOutput{
+		ChanID:   RandChannelID(t),
+		SerialID: rapid.Uint64().Draw(t, "serialID"),
+		Amount: btcutil.Amount(
+			rapid.Int64Min(0).Draw(t, "amount"),
+		),
+		PkScript: randBytes(t, 100, "pkScript"),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// A compile time check to ensure TxComplete implements the lnwire.TestMessage
+// interface.
+var _ TestMessage = (*TxComplete)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (c *TxComplete) RandTestMessage(t *rapid.T) Message {
+	m := &TxComplete{
+		ChanID: RandChannelID(t),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// A compile time check to ensure TxInitRbf implements the lnwire.TestMessage
+// interface.
+var _ TestMessage = (*TxInitRbf)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (c *TxInitRbf) RandTestMessage(t *rapid.T) Message {
+	return &TxInitRbf{
+		ChanID:              RandChannelID(t),
+		LockTime:            rapid.Uint32().Draw(t, "lockTime"),
+		FeeRate:             rapid.Uint32().Draw(t, "feeRate"),
+		FundingContribution: randFundingContribution(t),
+	}
+}
+
+// A compile time check to ensure TxRemoveInput implements the
+// lnwire.TestMessage interface.
+var _ TestMessage = (*TxRemoveInput)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (c *TxRemoveInput) RandTestMessage(t *rapid.T) Message {
+	m := &TxRemoveInput{
+		ChanID:   RandChannelID(t),
+		SerialID: rapid.Uint64().Draw(t, "serialID"),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// A compile time check to ensure TxRemoveOutput implements the
+// lnwire.TestMessage interface.
+var _ TestMessage = (*TxRemoveOutput)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (c *TxRemoveOutput) RandTestMessage(t *rapid.T) Message {
+	m := &TxRemoveOutput{
+		ChanID:   RandChannelID(t),
+		SerialID: rapid.Uint64().Draw(t, "serialID"),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// A compile time check to ensure TxSignatures implements the
+// lnwire.TestMessage interface.
+var _ TestMessage = (*TxSignatures)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (c *TxSignatures) RandTestMessage(t *rapid.T) Message {
+	m := &TxSignatures{
+		ChanID: RandChannelID(t),
+		TxID:   RandChainHash(t),
+	}
+
+	numWitnesses := rapid.IntRange(0, 5).Draw(t, "numWitnesses")
+	for i := 0; i < numWitnesses; i++ {
+		numItems := rapid.IntRange(0, 4).Draw(t, "numItems")
+		witness := make(wire.TxWitness, numItems)
+		for j := range witness {
+			itemLen := rapid.IntRange(0, 80).Draw(t, "itemLen")
+			witness[j] = rapid.SliceOfN(
+				rapid.Byte(), itemLen, itemLen,
+			).Draw(t, "item")
+		}
+		m.Witnesses = append(m.Witnesses, witness)
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
 // A compile time check to ensure UpdateAddHTLC implements the
 // lnwire.TestMessage interface.
 var _ TestMessage = (*UpdateAddHTLC)(nil)
@@ -1994,6 +2412,15 @@ func (c *UpdateAddHTLC) RandTestMessage(t *rapid.T) Message {
 		)
 	}
 
+	// 50/50 chance to add an upfront fee.
+	if rapid.Bool().Draw(t, "includeUpfrontFee") {
+		fee := MilliSatoshi(rapid.Uint64().Draw(t, "upfrontFee"))
+
+		msg.UpfrontFee = tlv.SomeRecordT(
+			tlv.NewRecordT[UpfrontFeeTlvType](fee),
+		)
+	}
+
 	return msg
 }
 
@@ -2006,12 +2433,25 @@ var _ TestMessage = (*UpdateFailHTLC)(nil)
 //
 // This is part of the TestMessage interface.
 func (c *UpdateFailHTLC) RandTestMessage(t *rapid.T) Message {
-	return &UpdateFailHTLC{
-		ChanID:    RandChannelID(t),
-		ID:        rapid.Uint64().Draw(t, "id"),
-		Reason:    RandOpaqueReason(t),
-		ExtraData: RandExtraOpaqueData(t, nil),
+	msg := &UpdateFailHTLC{
+		ChanID: RandChannelID(t),
+		ID:     rapid.Uint64().Draw(t, "id"),
+		Reason: RandOpaqueReason(t),
+	}
+
+	// 50/50 chance to add attribution data.
+	if rapid.Bool().Draw(t, "includeAttrData") {
+		attrData := rapid.SliceOfN(rapid.Byte(), 1, 1000).Draw(
+			t, "attrData",
+		)
+		msg.AttrData = NewAttrDataRecord(attrData)
 	}
+
+	// Ignore the attribution data record when creating ExtraData records.
+	ignoreRecords := fn.NewSet(uint64(msg.AttrData.TlvType()))
+	msg.ExtraData = RandExtraOpaqueData(t, ignoreRecords)
+
+	return msg
 }
 
 // A compile time check to ensure UpdateFailMalformedHTLC implements the
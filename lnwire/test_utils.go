@@ -415,6 +415,21 @@ func RandChannelType(t *rapid.T) *ChannelType {
 	return &chanType
 }
 
+// RandLeaseRates generates random lease rates.
+func RandLeaseRates(t *rapid.T) LeaseRates {
+	return LeaseRates{
+		FundingWeight: rapid.Uint16().Draw(t, "fundingWeight"),
+		LeaseFeeBasis: rapid.Uint16().Draw(t, "leaseFeeBasis"),
+		ChannelFeeMaxProportional: rapid.Uint16().Draw(
+			t, "channelFeeMaxProportional",
+		),
+		LeaseFeeBaseSat: rapid.Uint32().Draw(t, "leaseFeeBaseSat"),
+		ChannelFeeMaxBaseMsat: rapid.Uint32().Draw(
+			t, "channelFeeMaxBaseMsat",
+		),
+	}
+}
+
 // RandLeaseExpiry generates a random lease expiry.
 func RandLeaseExpiry(t *rapid.T) *LeaseExpiry {
 	exp := LeaseExpiry(
@@ -0,0 +1,92 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+)
+
+// TxAckRbf is sent in response to a TxInitRbf message to accept the
+// construction of a replacement transaction.
+type TxAckRbf struct {
+	// ChanID is the channel ID of the channel the transaction is
+	// constructed for.
+	ChanID ChannelID
+
+	// FundingContribution is the amount the sender contributes to the
+	// shared output of the replacement transaction.
+	FundingContribution FundingContributionRecord
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxAckRbf implements the lnwire.Message
+// interface.
+var _ Message = (*TxAckRbf)(nil)
+
+// A compile time check to ensure TxAckRbf implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*TxAckRbf)(nil)
+
+// Encode serializes the target TxAckRbf into the passed io.Writer.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAckRbf) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, t.ChanID); err != nil {
+		return err
+	}
+
+	err := EncodeMessageExtraData(
+		&t.ExtraData, fundingContributionProducers(
+			t.FundingContribution,
+		)...,
+	)
+	if err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes the serialized TxAckRbf stored in the passed io.Reader.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAckRbf) Decode(r io.Reader, _ uint32) error {
+	if err := ReadElements(r, &t.ChanID); err != nil {
+		return err
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := ReadElements(r, &tlvRecords); err != nil {
+		return err
+	}
+
+	var err error
+	t.FundingContribution, err = extractFundingContribution(tlvRecords)
+	if err != nil {
+		return err
+	}
+
+	if len(tlvRecords) != 0 {
+		t.ExtraData = tlvRecords
+	}
+
+	return nil
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a TxAckRbf on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAckRbf) MsgType() MessageType {
+	return MsgTxAckRbf
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (t *TxAckRbf) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(t)
+}
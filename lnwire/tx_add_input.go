@@ -0,0 +1,113 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+)
+
+// TxAddInput is sent during the interactive construction of a transaction to
+// add an input to it. The previous transaction is sent in full so that the
+// receiver can verify the amount and the script of the spent output.
+type TxAddInput struct {
+	// ChanID is the channel ID of the channel the transaction is
+	// constructed for.
+	ChanID ChannelID
+
+	// SerialID uniquely identifies the input within the transaction and
+	// determines its position. The initiator of the construction MUST use
+	// even serial IDs, the other party odd ones.
+	SerialID uint64
+
+	// PrevTx is the serialized transaction whose output is spent.
+	PrevTx []byte
+
+	// PrevTxVout is the index of the spent output within PrevTx.
+	PrevTxVout uint32
+
+	// Sequence is the sequence number of the input.
+	Sequence uint32
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxAddInput implements the lnwire.Message
+// interface.
+var _ Message = (*TxAddInput)(nil)
+
+// A compile time check to ensure TxAddInput implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*TxAddInput)(nil)
+
+// Encode serializes the target TxAddInput into the passed io.Writer.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, t.ChanID); err != nil {
+		return err
+	}
+
+	if err := WriteUint64(w, t.SerialID); err != nil {
+		return err
+	}
+
+	if err := WriteErrorData(w, ErrorData(t.PrevTx)); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, t.PrevTxVout); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, t.Sequence); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes the serialized TxAddInput stored in the passed
+// io.Reader.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) Decode(r io.Reader, _ uint32) error {
+	var prevTx ErrorData
+	err := ReadElements(r,
+		&t.ChanID,
+		&t.SerialID,
+		&prevTx,
+		&t.PrevTxVout,
+		&t.Sequence,
+		&t.ExtraData,
+	)
+	if err != nil {
+		return err
+	}
+
+	// This is required to pass the fuzz test round trip equality check.
+	if len(prevTx) != 0 {
+		t.PrevTx = prevTx
+	}
+	if len(t.ExtraData) == 0 {
+		t.ExtraData = nil
+	}
+
+	return nil
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a TxAddInput on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) MsgType() MessageType {
+	return MsgTxAddInput
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (t *TxAddInput) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(t)
+}
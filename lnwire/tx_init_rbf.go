@@ -0,0 +1,151 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// FundingContributionRecord is the TLV record that carries the amount a peer
+// contributes to the shared output of a replacement transaction. The amount
+// is signed and encoded as an eight byte two's complement integer.
+type FundingContributionRecord = tlv.OptionalRecordT[tlv.TlvType0, uint64]
+
+// TxInitRbf is sent by the initiator of an interactively constructed
+// transaction to start the construction of a replacement transaction with a
+// higher fee rate.
+type TxInitRbf struct {
+	// ChanID is the channel ID of the channel the transaction is
+	// constructed for.
+	ChanID ChannelID
+
+	// LockTime is the nLockTime of the replacement transaction.
+	LockTime uint32
+
+	// FeeRate is the fee rate in sat/kw of the replacement transaction.
+	FeeRate uint32
+
+	// FundingContribution is the amount the sender contributes to the
+	// shared output of the replacement transaction.
+	FundingContribution FundingContributionRecord
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxInitRbf implements the lnwire.Message
+// interface.
+var _ Message = (*TxInitRbf)(nil)
+
+// A compile time check to ensure TxInitRbf implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*TxInitRbf)(nil)
+
+// Encode serializes the target TxInitRbf into the passed io.Writer.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxInitRbf) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, t.ChanID); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, t.LockTime); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, t.FeeRate); err != nil {
+		return err
+	}
+
+	err := EncodeMessageExtraData(
+		&t.ExtraData, fundingContributionProducers(
+			t.FundingContribution,
+		)...,
+	)
+	if err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes the serialized TxInitRbf stored in the passed
+// io.Reader.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxInitRbf) Decode(r io.Reader, _ uint32) error {
+	err := ReadElements(r, &t.ChanID, &t.LockTime, &t.FeeRate)
+	if err != nil {
+		return err
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := ReadElements(r, &tlvRecords); err != nil {
+		return err
+	}
+
+	t.FundingContribution, err = extractFundingContribution(tlvRecords)
+	if err != nil {
+		return err
+	}
+
+	if len(tlvRecords) != 0 {
+		t.ExtraData = tlvRecords
+	}
+
+	return nil
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a TxInitRbf on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxInitRbf) MsgType() MessageType {
+	return MsgTxInitRbf
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (t *TxInitRbf) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(t)
+}
+
+// fundingContributionProducers returns the record producers to encode the
+// given funding contribution, if it is set.
+func fundingContributionProducers(
+	contribution FundingContributionRecord) []tlv.RecordProducer {
+
+	var producers []tlv.RecordProducer
+	contribution.WhenSome(
+		func(r tlv.RecordT[tlv.TlvType0, uint64]) {
+			producers = append(producers, &r)
+		},
+	)
+
+	return producers
+}
+
+// extractFundingContribution parses the funding contribution out of the given
+// TLV records.
+func extractFundingContribution(
+	tlvRecords ExtraOpaqueData) (FundingContributionRecord, error) {
+
+	var (
+		contribution FundingContributionRecord
+		record       = contribution.Zero()
+	)
+	typeMap, err := tlvRecords.ExtractRecords(&record)
+	if err != nil {
+		return contribution, err
+	}
+
+	if val, ok := typeMap[contribution.TlvType()]; ok && val == nil {
+		contribution = tlv.SomeRecordT(record)
+	}
+
+	return contribution, nil
+}
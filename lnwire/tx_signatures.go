@@ -0,0 +1,178 @@
+package lnwire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+)
+
+// MaxTxSignaturesWitnesses is the maximum number of witnesses a TxSignatures
+// message can carry.
+const MaxTxSignaturesWitnesses = 1000
+
+// TxSignatures is sent once an interactively constructed transaction is
+// complete and the commitment signatures were exchanged. It carries the
+// witnesses of all the inputs the sender contributed, ordered by the serial
+// IDs of the inputs.
+type TxSignatures struct {
+	// ChanID is the channel ID of the channel the transaction was
+	// constructed for.
+	ChanID ChannelID
+
+	// TxID is the ID of the transaction the witnesses are for.
+	TxID chainhash.Hash
+
+	// Witnesses are the witnesses of the inputs of the sender.
+	Witnesses []wire.TxWitness
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxSignatures implements the lnwire.Message
+// interface.
+var _ Message = (*TxSignatures)(nil)
+
+// A compile time check to ensure TxSignatures implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*TxSignatures)(nil)
+
+// Encode serializes the target TxSignatures into the passed io.Writer.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, t.ChanID); err != nil {
+		return err
+	}
+
+	if err := WriteBytes(w, t.TxID[:]); err != nil {
+		return err
+	}
+
+	if len(t.Witnesses) > MaxTxSignaturesWitnesses {
+		return fmt.Errorf("too many witnesses: %d", len(t.Witnesses))
+	}
+
+	if err := WriteUint16(w, uint16(len(t.Witnesses))); err != nil {
+		return err
+	}
+
+	// Each witness is prefixed with its length and serialized the same
+	// way as within a transaction.
+	for _, witness := range t.Witnesses {
+		var b bytes.Buffer
+		err := wire.WriteVarInt(&b, 0, uint64(len(witness)))
+		if err != nil {
+			return err
+		}
+		for _, item := range witness {
+			if err := wire.WriteVarBytes(&b, 0, item); err != nil {
+				return err
+			}
+		}
+
+		if err := WriteErrorData(w, b.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes the serialized TxSignatures stored in the passed
+// io.Reader.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) Decode(r io.Reader, _ uint32) error {
+	var numWitnesses uint16
+	err := ReadElements(r, &t.ChanID, t.TxID[:], &numWitnesses)
+	if err != nil {
+		return err
+	}
+
+	if numWitnesses > MaxTxSignaturesWitnesses {
+		return fmt.Errorf("too many witnesses: %d", numWitnesses)
+	}
+
+	t.Witnesses = nil
+	for i := uint16(0); i < numWitnesses; i++ {
+		var data ErrorData
+		if err := ReadElement(r, &data); err != nil {
+			return err
+		}
+
+		witness, err := decodeWitness(data)
+		if err != nil {
+			return err
+		}
+
+		t.Witnesses = append(t.Witnesses, witness)
+	}
+
+	if err := ReadElement(r, &t.ExtraData); err != nil {
+		return err
+	}
+
+	// This is required to pass the fuzz test round trip equality check.
+	if len(t.ExtraData) == 0 {
+		t.ExtraData = nil
+	}
+
+	return nil
+}
+
+// decodeWitness parses a witness serialized the same way as within a
+// transaction. The whole data must be consumed by the witness.
+func decodeWitness(data []byte) (wire.TxWitness, error) {
+	r := bytes.NewReader(data)
+
+	numItems, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every witness item takes at least one byte, which bounds the number
+	// of items we need to allocate for.
+	if numItems > uint64(len(data)) {
+		return nil, fmt.Errorf("invalid witness item count: %d",
+			numItems)
+	}
+
+	witness := make(wire.TxWitness, 0, numItems)
+	for i := uint64(0); i < numItems; i++ {
+		item, err := wire.ReadVarBytes(
+			r, 0, uint32(len(data)), "witness item",
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		witness = append(witness, item)
+	}
+
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("%d trailing bytes in witness", r.Len())
+	}
+
+	return witness, nil
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a TxSignatures on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) MsgType() MessageType {
+	return MsgTxSignatures
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (t *TxSignatures) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(t)
+}
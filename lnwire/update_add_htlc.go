@@ -31,6 +31,11 @@ const (
 	// a uint8 (an alias for byte in go), we can just define this constant
 	// as 7.
 	ExperimentalAccountable = 7
+
+	// UpfrontFeeRate is the share, in parts per million of a hop's
+	// success fee, that is paid unconditionally to that hop when upfront
+	// fees are negotiated.
+	UpfrontFeeRate = 10_000
 )
 
 type (
@@ -42,8 +47,21 @@ type (
 	// htlc.
 	//nolint:ll
 	BlindingPointRecord = tlv.OptionalRecordT[BlindingPointTlvType, *btcec.PublicKey]
+
+	// UpfrontFeeTlvType is the experimental type for the unconditional
+	// fee carried by an htlc.
+	UpfrontFeeTlvType = tlv.TlvType21
+
+	// UpfrontFeeRecord holds an optional upfront fee on update add htlc.
+	UpfrontFeeRecord = tlv.OptionalRecordT[UpfrontFeeTlvType, MilliSatoshi]
 )
 
+// UpfrontFeeShare returns the unconditional portion of the given success fee
+// that a forwarding node is paid when upfront fees are in use.
+func UpfrontFeeShare(successFee MilliSatoshi) MilliSatoshi {
+	return successFee * UpfrontFeeRate / 1_000_000
+}
+
 // UpdateAddHTLC is the message sent by Alice to Bob when she wishes to add an
 // HTLC to his remote commitment transaction. In addition to information
 // detailing the value, the ID, expiry, and the onion blob is also included
@@ -91,6 +109,12 @@ type UpdateAddHTLC struct {
 	// next hop for this htlc.
 	BlindingPoint BlindingPointRecord
 
+	// UpfrontFee is the experimental unconditional fee that the sender
+	// pays to the receiver of this htlc as soon as it is locked in,
+	// regardless of whether the htlc is later settled or failed. The fee
+	// is deducted from the sender's balance on top of Amount.
+	UpfrontFee UpfrontFeeRecord
+
 	// CustomRecords maps TLV types to byte slices, storing arbitrary data
 	// intended for inclusion in the ExtraData field of the UpdateAddHTLC
 	// message.
@@ -134,9 +158,10 @@ func (c *UpdateAddHTLC) Decode(r io.Reader, pver uint32) error {
 
 	// Extract TLV records from the extra data field.
 	blindingRecord := c.BlindingPoint.Zero()
+	upfrontFeeRecord := c.UpfrontFee.Zero()
 
 	customRecords, parsed, extraData, err := ParseAndExtractCustomRecords(
-		msgExtraData, &blindingRecord,
+		msgExtraData, &blindingRecord, &upfrontFeeRecord,
 	)
 	if err != nil {
 		return err
@@ -147,6 +172,10 @@ func (c *UpdateAddHTLC) Decode(r io.Reader, pver uint32) error {
 		c.BlindingPoint = tlv.SomeRecordT(blindingRecord)
 	}
 
+	if parsed.Contains(upfrontFeeRecord.TlvType()) {
+		c.UpfrontFee = tlv.SomeRecordT(upfrontFeeRecord)
+	}
+
 	c.CustomRecords = customRecords
 	c.ExtraData = extraData
 
@@ -182,13 +211,19 @@ func (c *UpdateAddHTLC) Encode(w *bytes.Buffer, pver uint32) error {
 		return err
 	}
 
-	// Only include blinding point in extra data if present.
+	// Only include blinding point and upfront fee in extra data if
+	// present.
 	var records []tlv.RecordProducer
 	c.BlindingPoint.WhenSome(
 		func(b tlv.RecordT[BlindingPointTlvType, *btcec.PublicKey]) {
 			records = append(records, &b)
 		},
 	)
+	c.UpfrontFee.WhenSome(
+		func(f tlv.RecordT[UpfrontFeeTlvType, MilliSatoshi]) {
+			records = append(records, &f)
+		},
+	)
 
 	extraData, err := MergeAndEncode(records, c.ExtraData, c.CustomRecords)
 	if err != nil {
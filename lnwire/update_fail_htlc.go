@@ -3,6 +3,8 @@ package lnwire
 import (
 	"bytes"
 	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
 )
 
 // OpaqueReason is an opaque encrypted byte slice that encodes the exact
@@ -10,6 +12,31 @@ import (
 // slice can only be decrypted by the sender of the original HTLC.
 type OpaqueReason []byte
 
+type (
+	// AttrDataTlvType is the experimental type for the attribution data
+	// of a failure. The encoding of the data hasn't been verified against
+	// the test vectors of BOLT 4, so an odd type outside of the one
+	// assigned by the spec is used to avoid sending data that other
+	// implementations can't verify.
+	AttrDataTlvType = tlv.TlvType101
+
+	// AttrDataRecord holds the optional attribution data on update fail
+	// htlc.
+	AttrDataRecord = tlv.OptionalRecordT[AttrDataTlvType, tlv.Blob]
+)
+
+// NewAttrDataRecord returns an attribution data record holding the given
+// data. If the data is empty, an empty record is returned.
+func NewAttrDataRecord(attrData []byte) AttrDataRecord {
+	if len(attrData) == 0 {
+		return AttrDataRecord{}
+	}
+
+	return tlv.SomeRecordT(
+		tlv.NewPrimitiveRecord[AttrDataTlvType](tlv.Blob(attrData)),
+	)
+}
+
 // UpdateFailHTLC is sent by Alice to Bob in order to remove a previously added
 // HTLC. Upon receipt of an UpdateFailHTLC the HTLC should be removed from the
 // next commitment transaction, with the UpdateFailHTLC propagated backwards in
@@ -28,6 +55,12 @@ type UpdateFailHTLC struct {
 	// HTLC message.
 	Reason OpaqueReason
 
+	// AttrData is the optional attribution data of the failure. It holds
+	// the hold times reported by the nodes along the route and a chain of
+	// HMACs that allows the sender to attribute a corrupted failure to a
+	// pair of nodes.
+	AttrData AttrDataRecord
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -47,12 +80,35 @@ var _ SizeableMessage = (*UpdateFailHTLC)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (c *UpdateFailHTLC) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	// msgExtraData is a temporary variable used to read the message extra
+	// data field from the reader.
+	var msgExtraData ExtraOpaqueData
+
+	if err := ReadElements(r,
 		&c.ChanID,
 		&c.ID,
 		&c.Reason,
-		&c.ExtraData,
+		&msgExtraData,
+	); err != nil {
+		return err
+	}
+
+	// Extract the attribution data from the extra data field.
+	attrData := c.AttrData.Zero()
+	knownRecords, extraData, err := ParseAndExtractExtraData(
+		msgExtraData, &attrData,
 	)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := knownRecords[c.AttrData.TlvType()]; ok {
+		c.AttrData = tlv.SomeRecordT(attrData)
+	}
+
+	c.ExtraData = extraData
+
+	return nil
 }
 
 // Encode serializes the target UpdateFailHTLC into the passed io.Writer
@@ -72,7 +128,20 @@ func (c *UpdateFailHTLC) Encode(w *bytes.Buffer, pver uint32) error {
 		return err
 	}
 
-	return WriteBytes(w, c.ExtraData)
+	// Only include the attribution data in extra data if present.
+	var records []tlv.RecordProducer
+	c.AttrData.WhenSome(
+		func(a tlv.RecordT[AttrDataTlvType, tlv.Blob]) {
+			records = append(records, &a)
+		},
+	)
+
+	extraData, err := MergeAndEncode(records, c.ExtraData, nil)
+	if err != nil {
+		return err
+	}
+
+	return WriteBytes(w, extraData)
 }
 
 // MsgType returns the integer uniquely identifying this message type on the
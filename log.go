@@ -18,6 +18,7 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/channelnotifier"
 	"github.com/lightningnetwork/lnd/chanstate"
+	"github.com/lightningnetwork/lnd/closescheduler"
 	"github.com/lightningnetwork/lnd/cluster"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
@@ -27,7 +28,9 @@ import (
 	"github.com/lightningnetwork/lnd/healthcheck"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/invoices/subscriptions"
 	"github.com/lightningnetwork/lnd/kvdb/sqlbase"
+	"github.com/lightningnetwork/lnd/liquidityads"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc/autopilotrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/chainrpc"
@@ -49,12 +52,18 @@ import (
 	"github.com/lightningnetwork/lnd/netann"
 	"github.com/lightningnetwork/lnd/onionmessage"
 	paymentsdb "github.com/lightningnetwork/lnd/payments/db"
+	"github.com/lightningnetwork/lnd/payments/receipt"
 	"github.com/lightningnetwork/lnd/peer"
+	"github.com/lightningnetwork/lnd/peermgr"
 	"github.com/lightningnetwork/lnd/peernotifier"
+	"github.com/lightningnetwork/lnd/peerstorage"
 	"github.com/lightningnetwork/lnd/protofsm"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/routing/blindedpath"
 	"github.com/lightningnetwork/lnd/routing/localchans"
+	"github.com/lightningnetwork/lnd/routing/probe"
+	"github.com/lightningnetwork/lnd/routing/rebalance"
+	"github.com/lightningnetwork/lnd/routing/trampoline"
 	"github.com/lightningnetwork/lnd/rpcperms"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/lightningnetwork/lnd/sqldb"
@@ -186,6 +195,13 @@ func SetupLoggers(root *build.SubLoggerManager, interceptor signal.Interceptor)
 	AddSubLogger(root, "PEER", interceptor, peer.UseLogger)
 	AddSubLogger(root, "CHCL", interceptor, chancloser.UseLogger)
 	AddSubLogger(root, "LCHN", interceptor, localchans.UseLogger)
+	AddSubLogger(root, rebalance.Subsystem, interceptor, rebalance.UseLogger)
+	AddSubLogger(root, probe.Subsystem, interceptor, probe.UseLogger)
+	AddSubLogger(
+		root, subscriptions.Subsystem, interceptor,
+		subscriptions.UseLogger,
+	)
+	AddSubLogger(root, trampoline.Subsystem, interceptor, trampoline.UseLogger)
 	AddSubLogger(root, "PFSM", interceptor, protofsm.UseLogger)
 
 	AddSubLogger(root, routing.Subsystem, interceptor, routing.UseLogger)
@@ -214,6 +230,18 @@ func SetupLoggers(root *build.SubLoggerManager, interceptor signal.Interceptor)
 	AddSubLogger(
 		root, paymentsdb.Subsystem, interceptor, paymentsdb.UseLogger,
 	)
+	AddSubLogger(root, receipt.Subsystem, interceptor, receipt.UseLogger)
+	AddSubLogger(
+		root, liquidityads.Subsystem, interceptor, liquidityads.UseLogger,
+	)
+	AddSubLogger(
+		root, peerstorage.Subsystem, interceptor, peerstorage.UseLogger,
+	)
+	AddSubLogger(
+		root, closescheduler.Subsystem, interceptor,
+		closescheduler.UseLogger,
+	)
+	AddSubLogger(root, peermgr.Subsystem, interceptor, peermgr.UseLogger)
 
 	AddSubLogger(root, onionmessage.Subsystem, interceptor, onionmessage.UseLogger)
 }
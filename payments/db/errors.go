@@ -32,6 +32,10 @@ var (
 	// a failed payment.
 	ErrPaymentAlreadyFailed = errors.New("payment has already failed")
 
+	// ErrPaymentNotSucceeded is returned when we attempt to store a receipt
+	// for a payment that hasn't succeeded.
+	ErrPaymentNotSucceeded = errors.New("payment hasn't succeeded")
+
 	// ErrUnknownPaymentStatus is returned when we do not recognize the
 	// existing state of a payment.
 	ErrUnknownPaymentStatus = errors.New("unknown payment status")
@@ -40,6 +40,12 @@ type PaymentWriter interface {
 	DeletePayments(ctx context.Context, failedOnly,
 		failedAttemptsOnly bool) (int, error)
 
+	// StoreReceipt stores the receipt returned by the receiver of the
+	// succeeded payment with the given payment hash. Any previously stored
+	// receipt is replaced.
+	StoreReceipt(ctx context.Context, paymentHash lntypes.Hash,
+		receipt *PaymentReceipt) error
+
 	PaymentControl
 }
 
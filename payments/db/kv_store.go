@@ -108,6 +108,10 @@ var (
 	// store information about the reason a payment failed.
 	paymentFailInfoKey = []byte("payment-fail-info")
 
+	// paymentReceiptKey is a key used in the payment's sub-bucket to store
+	// the receipt returned by the receiver of a spontaneous payment.
+	paymentReceiptKey = []byte("payment-receipt")
+
 	// paymentsIndexBucket is the name of the top-level bucket within the
 	// database that stores an index of payment sequence numbers to its
 	// payment hash.
@@ -576,6 +580,35 @@ func (p *KVStore) Fail(_ context.Context, paymentHash lntypes.Hash,
 	return payment, updateErr
 }
 
+// StoreReceipt stores the receipt returned by the receiver of the succeeded
+// payment with the given payment hash.
+func (p *KVStore) StoreReceipt(_ context.Context, paymentHash lntypes.Hash,
+	receipt *PaymentReceipt) error {
+
+	var b bytes.Buffer
+	if err := serializePaymentReceipt(&b, receipt); err != nil {
+		return err
+	}
+
+	return kvdb.Update(p.db, func(tx kvdb.RwTx) error {
+		bucket, err := fetchPaymentBucketUpdate(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		payment, err := fetchPayment(bucket)
+		if err != nil {
+			return err
+		}
+
+		if payment.Status != StatusSucceeded {
+			return ErrPaymentNotSucceeded
+		}
+
+		return bucket.Put(paymentReceiptKey, b.Bytes())
+	}, func() {})
+}
+
 // FetchPayment returns information about a payment from the database.
 func (p *KVStore) FetchPayment(_ context.Context,
 	paymentHash lntypes.Hash) (*MPPayment, error) {
@@ -904,12 +937,22 @@ func fetchPayment(bucket kvdb.RBucket) (*MPPayment, error) {
 		failureReason = &reason
 	}
 
+	// Get the receipt if available.
+	var receipt *PaymentReceipt
+	if b := bucket.Get(paymentReceiptKey); b != nil {
+		receipt, err = deserializePaymentReceipt(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Create a new payment.
 	payment := &MPPayment{
 		SequenceNum:   sequenceNum,
 		Info:          creationInfo,
 		HTLCs:         htlcs,
 		FailureReason: failureReason,
+		Receipt:       receipt,
 	}
 
 	// Set its state and status.
@@ -1720,6 +1763,12 @@ func deserializeHTLCAttemptInfo(r io.Reader) (*HTLCAttemptInfo, error) {
 	return a, nil
 }
 
+// hopUpfrontFeeType is the type under which the upfront fee of a hop is stored
+// along with the records of its onion payload. The upfront fee isn't part of
+// the onion payload, so a type below the custom record range that no onion
+// payload record uses is chosen.
+const hopUpfrontFeeType tlv.Type = 65535
+
 func serializeHop(w io.Writer, h *route.Hop) error {
 	if err := WriteElements(w,
 		h.PubKeyBytes[:],
@@ -1781,6 +1830,13 @@ func serializeHop(w io.Writer, h *route.Hop) error {
 		)
 	}
 
+	if h.UpfrontFee != 0 {
+		upfrontFee := uint64(h.UpfrontFee)
+		records = append(records, tlv.MakePrimitiveRecord(
+			hopUpfrontFeeType, &upfrontFee,
+		))
+	}
+
 	// Final sanity check to absolutely rule out custom records that are not
 	// custom and write into the standard range.
 	if err := h.CustomRecords.Validate(); err != nil {
@@ -1959,6 +2015,25 @@ func deserializeHop(r io.Reader) (*route.Hop, error) {
 		h.TotalAmtMsat = lnwire.MilliSatoshi(totalAmtMsatInt)
 	}
 
+	if upfrontFee, ok := tlvMap[uint64(hopUpfrontFeeType)]; ok {
+		delete(tlvMap, uint64(hopUpfrontFeeType))
+
+		var (
+			upfrontFeeInt uint64
+			upfrontFeeRec = tlv.MakePrimitiveRecord(
+				hopUpfrontFeeType, &upfrontFeeInt,
+			)
+		)
+		err := upfrontFeeRec.Decode(
+			bytes.NewReader(upfrontFee), uint64(len(upfrontFee)),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		h.UpfrontFee = lnwire.MilliSatoshi(upfrontFeeInt)
+	}
+
 	h.CustomRecords = tlvMap
 
 	return h, nil
@@ -2051,6 +2126,33 @@ func deserializeHTLCSettleInfo(r io.Reader) (*HTLCSettleInfo, error) {
 	return s, nil
 }
 
+// serializePaymentReceipt serializes the receipt of a payment.
+func serializePaymentReceipt(w io.Writer, r *PaymentReceipt) error {
+	if err := wire.WriteVarBytes(w, 0, r.Signature); err != nil {
+		return err
+	}
+
+	return serializeTime(w, r.ReceivedTime)
+}
+
+// deserializePaymentReceipt deserializes the receipt of a payment.
+func deserializePaymentReceipt(r io.Reader) (*PaymentReceipt, error) {
+	sig, err := wire.ReadVarBytes(r, 0, 1024, "signature")
+	if err != nil {
+		return nil, err
+	}
+
+	receivedTime, err := deserializeTime(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PaymentReceipt{
+		Signature:    sig,
+		ReceivedTime: receivedTime,
+	}, nil
+}
+
 // serializeHTLCFailInfo serializes the details of a failed htlc including the
 // wire failure.
 func serializeHTLCFailInfo(w io.Writer, f *HTLCFailInfo) error {
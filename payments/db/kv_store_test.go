@@ -224,6 +224,27 @@ func TestRouteSerialization(t *testing.T) {
 	testSerializeRoute(t, testBlindedRoute)
 }
 
+// TestRouteSerializationUpfrontFees tests that the upfront fees of the hops of
+// a route are serialized and aren't mistaken for custom records.
+func TestRouteSerializationUpfrontFees(t *testing.T) {
+	t.Parallel()
+
+	rt := testRoute.Copy()
+	rt.Hops[0].UpfrontFee = 7
+
+	var b bytes.Buffer
+	require.NoError(t, SerializeRoute(&b, *rt))
+
+	rt2, err := DeserializeRoute(bytes.NewReader(b.Bytes()))
+	require.NoError(t, err)
+
+	require.Equal(t, lnwire.MilliSatoshi(7), rt2.Hops[0].UpfrontFee)
+	require.Empty(t, rt2.Hops[0].CustomRecords)
+	require.Zero(t, rt2.Hops[1].UpfrontFee)
+	require.Equal(t, rt.Hops[1].CustomRecords, rt2.Hops[1].CustomRecords)
+	require.Equal(t, rt.TotalUpfrontFee(), rt2.TotalUpfrontFee())
+}
+
 func testSerializeRoute(t *testing.T, route route.Route) {
 	var b bytes.Buffer
 	err := SerializeRoute(&b, route)
@@ -315,6 +315,11 @@ type MPPaymentState struct {
 	// calculate remaining fee budget.
 	FeesPaid lnwire.MilliSatoshi
 
+	// UpfrontFeesPaid specifies the total upfront fees paid so far. As
+	// upfront fees are paid whether an HTLC settles or fails, this
+	// includes the upfront fees of failed HTLCs.
+	UpfrontFeesPaid lnwire.MilliSatoshi
+
 	// HasSettledHTLC is true if at least one of the payment's HTLCs is
 	// settled.
 	HasSettledHTLC bool
@@ -357,6 +362,21 @@ type MPPayment struct {
 	// insights and is used to determine what to do on each payment loop
 	// iteration.
 	State *MPPaymentState
+
+	// Receipt is the receipt signed by the receiver of a spontaneous
+	// payment, if one was requested and received.
+	Receipt *PaymentReceipt
+}
+
+// PaymentReceipt is a proof of payment for a spontaneous payment. It is
+// signed by the receiver over the payment identifier and the custom records
+// of the settled payment.
+type PaymentReceipt struct {
+	// Signature is the pubkey-recoverable signature of the receiver.
+	Signature []byte
+
+	// ReceivedTime is the time at which the receipt was received.
+	ReceivedTime time.Time
 }
 
 // Terminated returns a bool to specify whether the payment is in a terminal
@@ -397,6 +417,18 @@ func (m *MPPayment) SentAmt() (lnwire.MilliSatoshi, lnwire.MilliSatoshi) {
 	return sent, fees
 }
 
+// UpfrontFeesPaid returns the sum of the upfront fees of all HTLC attempts of
+// the payment. Upfront fees are paid unconditionally, so the attempts that
+// failed are included.
+func (m *MPPayment) UpfrontFeesPaid() lnwire.MilliSatoshi {
+	var fees lnwire.MilliSatoshi
+	for _, h := range m.HTLCs {
+		fees += h.Route.TotalUpfrontFee()
+	}
+
+	return fees
+}
+
 // InFlightHTLCs returns the HTLCs that are still in-flight, meaning they have
 // not been settled or failed.
 func (m *MPPayment) InFlightHTLCs() []HTLCAttempt {
@@ -485,6 +517,7 @@ func (m *MPPayment) setState() error {
 		NumAttemptsInFlight: len(m.InFlightHTLCs()),
 		RemainingAmt:        totalAmt - sentAmt,
 		FeesPaid:            fees,
+		UpfrontFeesPaid:     m.UpfrontFeesPaid(),
 		HasSettledHTLC:      settle != nil,
 		PaymentFailed:       failure != nil,
 	}
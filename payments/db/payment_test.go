@@ -3287,6 +3287,53 @@ func TestRegisterAttemptWithBlindedRoute(t *testing.T) {
 	require.Equal(t, lnwire.MilliSatoshi(1000), hop2.TotalAmtMsat)
 }
 
+// TestRegisterAttemptWithUpfrontFees tests that the upfront fees of a route
+// are persisted and that the upfront fees of failed attempts are accounted for
+// in the payment state.
+func TestRegisterAttemptWithUpfrontFees(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	paymentDB, _ := NewTestDB(t)
+
+	preimg := genPreimage(t)
+	rhash := sha256.Sum256(preimg[:])
+	info := genPaymentCreationInfo(t, rhash)
+
+	err := paymentDB.InitPayment(ctx, info.PaymentIdentifier, info)
+	require.NoError(t, err)
+
+	// Register an attempt over a route that pays an upfront fee to its
+	// first hop only.
+	attempt := genAttemptWithHash(t, 0, genSessionKey(t), rhash)
+	attempt.Route.Hops[0].UpfrontFee = 7
+
+	_, err = paymentDB.RegisterAttempt(ctx, info.PaymentIdentifier, attempt)
+	require.NoError(t, err)
+
+	payment, err := paymentDB.FetchPayment(ctx, info.PaymentIdentifier)
+	require.NoError(t, err)
+
+	require.Len(t, payment.HTLCs, 1)
+	hops := payment.HTLCs[0].Route.Hops
+	require.Equal(t, lnwire.MilliSatoshi(7), hops[0].UpfrontFee)
+	require.Zero(t, hops[1].UpfrontFee)
+	require.Equal(t, lnwire.MilliSatoshi(7), payment.State.UpfrontFeesPaid)
+
+	// The upfront fee was paid even though the attempt failed, so it
+	// must still be accounted for.
+	payment, err = paymentDB.FailAttempt(
+		ctx, info.PaymentIdentifier, attempt.AttemptID,
+		&HTLCFailInfo{
+			Reason: HTLCFailUnreadable,
+		},
+	)
+	require.NoError(t, err)
+	require.Zero(t, payment.State.FeesPaid)
+	require.Equal(t, lnwire.MilliSatoshi(7), payment.State.UpfrontFeesPaid)
+}
+
 // TestFailAttemptWithoutMessage tests that FailAttempt works correctly when
 // no failure message is provided.
 func TestFailAttemptWithoutMessage(t *testing.T) {
@@ -3453,3 +3500,73 @@ func TestFetchPaymentWithNoAttempts(t *testing.T) {
 	require.Equal(t, info.Value, payment.Info.Value)
 	require.Equal(t, StatusInitiated, payment.Status)
 }
+
+// TestStoreReceipt tests that a receipt can only be stored for a succeeded
+// payment, and that it is returned when fetching and querying the payment.
+func TestStoreReceipt(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	paymentDB, _ := NewTestDB(t)
+
+	preimg := genPreimage(t)
+	rhash := sha256.Sum256(preimg[:])
+	info := genPaymentCreationInfo(t, rhash)
+
+	receipt := &PaymentReceipt{
+		Signature:    []byte{1, 2, 3},
+		ReceivedTime: time.Unix(1_000_000, 0),
+	}
+
+	// A receipt can't be stored for an unknown payment.
+	err := paymentDB.StoreReceipt(ctx, info.PaymentIdentifier, receipt)
+	require.ErrorIs(t, err, ErrPaymentNotInitiated)
+
+	err = paymentDB.InitPayment(ctx, info.PaymentIdentifier, info)
+	require.NoError(t, err)
+
+	attempt := genAttemptWithHash(t, 0, genSessionKey(t), rhash)
+	_, err = paymentDB.RegisterAttempt(ctx, info.PaymentIdentifier, attempt)
+	require.NoError(t, err)
+
+	// A receipt can't be stored while the payment is in flight.
+	err = paymentDB.StoreReceipt(ctx, info.PaymentIdentifier, receipt)
+	require.ErrorIs(t, err, ErrPaymentNotSucceeded)
+
+	_, err = paymentDB.SettleAttempt(
+		ctx, info.PaymentIdentifier, attempt.AttemptID,
+		&HTLCSettleInfo{Preimage: preimg},
+	)
+	require.NoError(t, err)
+
+	payment, err := paymentDB.FetchPayment(ctx, info.PaymentIdentifier)
+	require.NoError(t, err)
+	require.Nil(t, payment.Receipt)
+
+	// Once the payment succeeded, the receipt is stored. Storing a new
+	// receipt replaces the existing one.
+	err = paymentDB.StoreReceipt(ctx, info.PaymentIdentifier, receipt)
+	require.NoError(t, err)
+
+	receipt = &PaymentReceipt{
+		Signature:    []byte{4, 5, 6},
+		ReceivedTime: time.Unix(2_000_000, 0),
+	}
+	err = paymentDB.StoreReceipt(ctx, info.PaymentIdentifier, receipt)
+	require.NoError(t, err)
+
+	payment, err = paymentDB.FetchPayment(ctx, info.PaymentIdentifier)
+	require.NoError(t, err)
+	require.Equal(t, receipt.Signature, payment.Receipt.Signature)
+	require.True(t, receipt.ReceivedTime.Equal(
+		payment.Receipt.ReceivedTime,
+	))
+
+	resp, err := paymentDB.QueryPayments(ctx, Query{
+		MaxPayments: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Payments, 1)
+	require.Equal(t, receipt.Signature, resp.Payments[0].Receipt.Signature)
+}
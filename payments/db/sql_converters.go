@@ -259,6 +259,13 @@ func dbDataToRoute(hops []sqlc.FetchHopsForAttemptsRow,
 			)
 		}
 
+		// Add the upfront fee if the hop was paid one.
+		if hop.UpfrontFeeMsat.Valid {
+			routeHop.UpfrontFee = lnwire.MilliSatoshi(
+				hop.UpfrontFeeMsat.Int64,
+			)
+		}
+
 		// Add hop-level custom records.
 		if records, ok := hopCustomRecords[hop.ID]; ok {
 			routeHop.CustomRecords = make(
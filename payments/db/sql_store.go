@@ -63,6 +63,8 @@ type SQLQueries interface {
 	FetchRouteLevelFirstHopCustomRecords(ctx context.Context, htlcAttemptIndices []int64) ([]sqlc.PaymentAttemptFirstHopCustomRecord, error)
 	FetchHopLevelCustomRecords(ctx context.Context, hopIDs []int64) ([]sqlc.PaymentHopCustomRecord, error)
 
+	FetchPaymentReceipts(ctx context.Context, paymentIDs []int64) ([]sqlc.PaymentReceipt, error)
+
 	/*
 		Payment DB write operations.
 	*/
@@ -75,6 +77,7 @@ type SQLQueries interface {
 	InsertRouteHopMpp(ctx context.Context, arg sqlc.InsertRouteHopMppParams) error
 	InsertRouteHopAmp(ctx context.Context, arg sqlc.InsertRouteHopAmpParams) error
 	InsertRouteHopBlinded(ctx context.Context, arg sqlc.InsertRouteHopBlindedParams) error
+	InsertRouteHopUpfrontFee(ctx context.Context, arg sqlc.InsertRouteHopUpfrontFeeParams) error
 
 	InsertPaymentAttemptFirstHopCustomRecord(ctx context.Context, arg sqlc.InsertPaymentAttemptFirstHopCustomRecordParams) error
 	InsertPaymentHopCustomRecord(ctx context.Context, arg sqlc.InsertPaymentHopCustomRecordParams) error
@@ -84,6 +87,8 @@ type SQLQueries interface {
 
 	FailPayment(ctx context.Context, arg sqlc.FailPaymentParams) (sql.Result, error)
 
+	InsertPaymentReceipt(ctx context.Context, arg sqlc.InsertPaymentReceiptParams) error
+
 	DeletePayment(ctx context.Context, paymentID int64) error
 
 	// DeleteFailedAttempts removes all failed HTLCs from the db for a
@@ -201,6 +206,9 @@ type paymentsDetailsData struct {
 	// routeCustomRecords maps attempt index to its route-level custom
 	// records.
 	routeCustomRecords map[int64][]sqlc.PaymentAttemptFirstHopCustomRecord
+
+	// receipts maps payment ID to its receipt.
+	receipts map[int64]sqlc.PaymentReceipt
 }
 
 // batchLoadPaymentCustomRecords loads payment-level custom records for a given
@@ -237,6 +245,27 @@ func batchLoadPaymentCustomRecords(ctx context.Context,
 	)
 }
 
+// batchLoadPaymentReceipts loads the receipts for a given set of payment IDs.
+func batchLoadPaymentReceipts(ctx context.Context, cfg *sqldb.QueryConfig,
+	db SQLQueries, paymentIDs []int64,
+	batchData *paymentsDetailsData) error {
+
+	return sqldb.ExecuteBatchQuery(
+		ctx, cfg, paymentIDs,
+		func(id int64) int64 { return id },
+		func(ctx context.Context, ids []int64) ([]sqlc.PaymentReceipt,
+			error) {
+
+			return db.FetchPaymentReceipts(ctx, ids)
+		},
+		func(ctx context.Context, receipt sqlc.PaymentReceipt) error {
+			batchData.receipts[receipt.PaymentID] = receipt
+
+			return nil
+		},
+	)
+}
+
 // batchLoadHtlcAttempts loads HTLC attempts for all payments and returns all
 // attempt indices. It uses a batch query to fetch all attempts for the given
 // payment IDs.
@@ -507,6 +536,7 @@ func batchLoadPaymentDetailsData(ctx context.Context, cfg *sqldb.QueryConfig,
 		routeCustomRecords: make(
 			map[int64][]sqlc.PaymentAttemptFirstHopCustomRecord,
 		),
+		receipts: make(map[int64]sqlc.PaymentReceipt),
 	}
 
 	if len(paymentIDs) == 0 {
@@ -522,6 +552,13 @@ func batchLoadPaymentDetailsData(ctx context.Context, cfg *sqldb.QueryConfig,
 			"records: %w", err)
 	}
 
+	// Load the receipts of the payments.
+	err = batchLoadPaymentReceipts(ctx, cfg, db, paymentIDs, batchData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch payment receipts: %w",
+			err)
+	}
+
 	// Load HTLC attempts and collect attempt indices.
 	allAttemptIndices, err := batchLoadHtlcAttempts(
 		ctx, cfg, db, paymentIDs, batchData,
@@ -639,6 +676,13 @@ func buildPaymentFromBatchData(dbPayment sqlc.PaymentAndIntent,
 		FailureReason: failureReason,
 	}
 
+	if receipt, ok := batchData.receipts[payment.ID]; ok {
+		mpPayment.Receipt = &PaymentReceipt{
+			Signature:    receipt.Signature,
+			ReceivedTime: receipt.ReceivedAt.Local(),
+		}
+	}
+
 	// The status and state will be determined by calling
 	// SetState after construction.
 	if err := mpPayment.SetState(); err != nil {
@@ -1380,6 +1424,19 @@ func (s *SQLStore) insertRouteHops(ctx context.Context, db SQLQueries,
 			}
 		}
 
+		// Insert the upfront fee of the hop if it is paid one.
+		if hop.UpfrontFee != 0 {
+			err = db.InsertRouteHopUpfrontFee(
+				ctx, sqlc.InsertRouteHopUpfrontFeeParams{
+					HopID:          hopID,
+					UpfrontFeeMsat: int64(hop.UpfrontFee),
+				})
+			if err != nil {
+				return fmt.Errorf("failed to insert "+
+					"route hop upfront fee: %w", err)
+			}
+		}
+
 		// Insert blinded route data if present. Every hop in the
 		// blinded path must have an encrypted data record. If the
 		// encrypted data is not present, we skip the insertion.
@@ -1621,6 +1678,46 @@ func (s *SQLStore) SettleAttempt(ctx context.Context, paymentHash lntypes.Hash,
 	return mpPayment, nil
 }
 
+// StoreReceipt stores the receipt returned by the receiver of the succeeded
+// payment with the given payment hash, replacing any existing receipt.
+//
+// This is part of the DB interface.
+func (s *SQLStore) StoreReceipt(ctx context.Context, paymentHash lntypes.Hash,
+	receipt *PaymentReceipt) error {
+
+	err := s.db.ExecTx(ctx, sqldb.WriteTxOpt(), func(db SQLQueries) error {
+		dbPayment, err := fetchPaymentByHash(ctx, db, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		paymentStatus, err := computePaymentStatusFromDB(
+			ctx, s.cfg.QueryCfg, db, dbPayment,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to compute payment "+
+				"status: %w", err)
+		}
+
+		if paymentStatus != StatusSucceeded {
+			return ErrPaymentNotSucceeded
+		}
+
+		return db.InsertPaymentReceipt(
+			ctx, sqlc.InsertPaymentReceiptParams{
+				PaymentID:  dbPayment.GetPayment().ID,
+				Signature:  receipt.Signature,
+				ReceivedAt: receipt.ReceivedTime.UTC(),
+			},
+		)
+	}, sqldb.NoOpReset)
+	if err != nil {
+		return fmt.Errorf("failed to store receipt: %w", err)
+	}
+
+	return nil
+}
+
 // FailAttempt marks the specified HTLC attempt as failed, recording the
 // failure reason, failure time, optional failure message, and the index of the
 // node in the route that generated the failure. This information is atomically
@@ -0,0 +1,181 @@
+package receipt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/onionmessage"
+	paymentsdb "github.com/lightningnetwork/lnd/payments/db"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+var (
+	// ErrReceiptNotRequested is returned when a receipt is received for a
+	// payment that didn't request one.
+	ErrReceiptNotRequested = errors.New("payment didn't request a receipt")
+)
+
+// CollectorConfig holds the dependencies of the collector.
+type CollectorConfig struct {
+	// SubscribeOnionMessages subscribes to the onion messages that are
+	// delivered to our node.
+	SubscribeOnionMessages func() (*subscribe.Client, error)
+
+	// FetchPayment fetches the payment with the given identifier.
+	FetchPayment func(ctx context.Context,
+		paymentID lntypes.Hash) (*paymentsdb.MPPayment, error)
+
+	// StoreReceipt stores the receipt of the payment with the given
+	// identifier.
+	StoreReceipt func(ctx context.Context, paymentID lntypes.Hash,
+		receipt *paymentsdb.PaymentReceipt) error
+
+	// Clock is the clock used to timestamp received receipts.
+	Clock clock.Clock
+}
+
+// Collector verifies the receipts returned by the receivers of our
+// spontaneous payments and stores them alongside the payments.
+type Collector struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *CollectorConfig
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewCollector creates a new receipt collector.
+func NewCollector(cfg *CollectorConfig) *Collector {
+	return &Collector{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start subscribes to onion messages and starts collecting receipts.
+func (c *Collector) Start() error {
+	var startErr error
+	c.started.Do(func() {
+		log.Info("Receipt collector starting")
+
+		client, err := c.cfg.SubscribeOnionMessages()
+		if err != nil {
+			startErr = fmt.Errorf("unable to subscribe to onion "+
+				"messages: %w", err)
+
+			return
+		}
+
+		c.wg.Add(1)
+		go c.collectLoop(client)
+	})
+
+	return startErr
+}
+
+// Stop stops collecting receipts.
+func (c *Collector) Stop() error {
+	c.stopped.Do(func() {
+		log.Debug("Receipt collector stopping")
+
+		close(c.quit)
+		c.wg.Wait()
+	})
+
+	return nil
+}
+
+// collectLoop handles every onion message that carries a receipt.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *Collector) collectLoop(client *subscribe.Client) {
+	defer c.wg.Done()
+	defer client.Cancel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for {
+		select {
+		case update := <-client.Updates():
+			msg, ok := update.(*onionmessage.OnionMessageUpdate)
+			if !ok {
+				continue
+			}
+
+			value, ok := msg.CustomRecords[uint64(ReceiptType)]
+			if !ok {
+				continue
+			}
+
+			receipt, err := Decode(value)
+			if err != nil {
+				log.Debugf("Unable to decode receipt: %v", err)
+				continue
+			}
+
+			err = c.HandleReceipt(ctx, receipt)
+			if err != nil {
+				log.Warnf("Unable to handle receipt for "+
+					"payment %v: %v", receipt.PaymentID,
+					err)
+
+				continue
+			}
+
+			log.Infof("Stored receipt for payment %v",
+				receipt.PaymentID)
+
+		case <-client.Quit():
+			return
+
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// HandleReceipt verifies the given receipt against the settled payment it
+// belongs to and stores it alongside the payment.
+func (c *Collector) HandleReceipt(ctx context.Context,
+	receipt *Receipt) error {
+
+	payment, err := c.cfg.FetchPayment(ctx, receipt.PaymentID)
+	if err != nil {
+		return err
+	}
+
+	settled, _ := payment.TerminalInfo()
+	if settled == nil {
+		return paymentsdb.ErrPaymentNotSucceeded
+	}
+
+	finalHop := settled.Route.FinalHop()
+	if finalHop == nil {
+		return errors.New("settled route has no hops")
+	}
+
+	_, ok := finalHop.CustomRecords[record.ReceiptRequestType]
+	if !ok {
+		return ErrReceiptNotRequested
+	}
+
+	err = receipt.Verify(finalHop.PubKeyBytes, finalHop.CustomRecords)
+	if err != nil {
+		return err
+	}
+
+	return c.cfg.StoreReceipt(
+		ctx, receipt.PaymentID, &paymentsdb.PaymentReceipt{
+			Signature:    receipt.Signature,
+			ReceivedTime: c.cfg.Clock.Now(),
+		},
+	)
+}
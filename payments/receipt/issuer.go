@@ -0,0 +1,250 @@
+package receipt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// MaxRouteHops is the maximum number of hops of the route from the receiver
+// to the introduction node of a reply path.
+const MaxRouteHops = 10
+
+// IssuerConfig holds the dependencies of the issuer.
+type IssuerConfig struct {
+	// SubscribeSettledInvoices subscribes to the invoices that are
+	// settled from now on.
+	SubscribeSettledInvoices func(
+		ctx context.Context) (*invoices.InvoiceSubscription, error)
+
+	// Signer signs receipts with the node key.
+	Signer MessageSigner
+
+	// FindPath finds a route for onion messages from our node to the given
+	// node. The route is ordered from our first hop peer to the target.
+	FindPath func(ctx context.Context,
+		target route.Vertex) ([]route.Vertex, error)
+
+	// SendOnionMessage sends an onion message to the given peer.
+	SendOnionMessage func(ctx context.Context, peer [33]byte,
+		pathKey *btcec.PublicKey, onion []byte) error
+}
+
+// Issuer returns signed receipts to the senders of spontaneous payments that
+// request one.
+type Issuer struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *IssuerConfig
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewIssuer creates a new receipt issuer.
+func NewIssuer(cfg *IssuerConfig) *Issuer {
+	return &Issuer{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start subscribes to settled invoices and starts issuing receipts.
+func (i *Issuer) Start() error {
+	var startErr error
+	i.started.Do(func() {
+		log.Info("Receipt issuer starting")
+
+		sub, err := i.cfg.SubscribeSettledInvoices(
+			context.Background(),
+		)
+		if err != nil {
+			startErr = fmt.Errorf("unable to subscribe to "+
+				"invoices: %w", err)
+
+			return
+		}
+
+		i.wg.Add(1)
+		go i.issueLoop(sub)
+	})
+
+	return startErr
+}
+
+// Stop stops issuing receipts and waits for all pending receipts to be sent.
+func (i *Issuer) Stop() error {
+	i.stopped.Do(func() {
+		log.Debug("Receipt issuer stopping")
+
+		close(i.quit)
+		i.wg.Wait()
+	})
+
+	return nil
+}
+
+// issueLoop issues a receipt for every settled invoice that requested one.
+//
+// NOTE: This MUST be run as a goroutine.
+func (i *Issuer) issueLoop(sub *invoices.InvoiceSubscription) {
+	defer i.wg.Done()
+	defer sub.Cancel()
+
+	for {
+		select {
+		case invoice := <-sub.SettledInvoices:
+			request, ok := receiptRequest(invoice)
+			if !ok {
+				continue
+			}
+
+			i.wg.Add(1)
+			go i.issue(request)
+
+		// New invoices are of no interest, but must be consumed so
+		// that the settled invoices keep being delivered.
+		case <-sub.NewInvoices:
+
+		case <-i.quit:
+			return
+		}
+	}
+}
+
+// issue signs the receipt for the given request and sends it back over the
+// requested reply path.
+//
+// NOTE: This MUST be run as a goroutine.
+func (i *Issuer) issue(request *receiptRequestInfo) {
+	defer i.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-i.quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	err := i.sendReceipt(ctx, request)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		log.Errorf("Unable to send receipt for payment %v: %v",
+			request.paymentID, err)
+
+		return
+	}
+
+	log.Debugf("Sent receipt for payment %v", request.paymentID)
+}
+
+// sendReceipt signs the receipt for the given request and sends it back over
+// the requested reply path.
+func (i *Issuer) sendReceipt(ctx context.Context,
+	request *receiptRequestInfo) error {
+
+	replyPath, err := DecodeReplyPath(
+		request.customRecords[record.ReceiptRequestType],
+	)
+	if err != nil {
+		return fmt.Errorf("invalid reply path: %w", err)
+	}
+
+	intro, err := pubKeyIntro(replyPath)
+	if err != nil {
+		return err
+	}
+
+	receipt, err := Sign(
+		i.cfg.Signer, request.paymentID, request.customRecords,
+	)
+	if err != nil {
+		return err
+	}
+
+	path, err := i.cfg.FindPath(ctx, route.NewVertex(intro))
+	if err != nil {
+		return fmt.Errorf("unable to find route to %x: %w",
+			intro.SerializeCompressed(), err)
+	}
+
+	msg, err := BuildOnionMessage(path, replyPath, receipt)
+	if err != nil {
+		return err
+	}
+
+	return i.cfg.SendOnionMessage(
+		ctx, msg.FirstHop, msg.PathKey, msg.Onion,
+	)
+}
+
+// receiptRequestInfo holds the information needed to issue the receipt of a
+// settled spontaneous payment.
+type receiptRequestInfo struct {
+	// paymentID is the identifier of the payment.
+	paymentID lntypes.Hash
+
+	// customRecords are the custom records of a settled HTLC of the
+	// payment, which include the receipt request.
+	customRecords record.CustomSet
+}
+
+// receiptRequest returns the receipt request of the given settled invoice, if
+// it is a spontaneous payment that requested a receipt. For AMP invoices, the
+// request of the most recently settled HTLC set is returned.
+func receiptRequest(invoice *invoices.Invoice) (*receiptRequestInfo, bool) {
+	var (
+		paymentID lntypes.Hash
+		setID     *[32]byte
+	)
+	switch {
+	case invoice.IsAMP():
+		var settleIndex uint64
+		for id, state := range invoice.AMPState {
+			if state.State != invoices.HtlcStateSettled ||
+				state.SettleIndex < settleIndex {
+
+				continue
+			}
+
+			settleIndex = state.SettleIndex
+			paymentID = lntypes.Hash(id)
+			setID = (*[32]byte)(&id)
+		}
+		if setID == nil {
+			return nil, false
+		}
+
+	case invoice.IsKeysend() && invoice.Terms.PaymentPreimage != nil:
+		paymentID = invoice.Terms.PaymentPreimage.Hash()
+
+	default:
+		return nil, false
+	}
+
+	htlcs := invoice.HTLCSet(setID, invoices.HtlcStateSettled)
+	for _, htlc := range htlcs {
+		_, ok := htlc.CustomRecords[record.ReceiptRequestType]
+		if !ok {
+			continue
+		}
+
+		return &receiptRequestInfo{
+			paymentID:     paymentID,
+			customRecords: htlc.CustomRecords,
+		}, true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,216 @@
+package receipt
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// pathIDLen is the length of the random path ID of a reply path.
+const pathIDLen = 32
+
+// OnionMessage is an onion message that is ready to be sent to its first hop.
+type OnionMessage struct {
+	// FirstHop is the peer the onion message is sent to.
+	FirstHop route.Vertex
+
+	// PathKey is the blinding point of the first hop.
+	PathKey *btcec.PublicKey
+
+	// Onion is the serialized onion packet.
+	Onion []byte
+}
+
+// NewReplyPath creates a blinded path that leads to our own node, which is
+// also its introduction node. The path is included in a receipt request so
+// that the receiver can return the receipt without learning who paid it.
+func NewReplyPath(ourPubKey *btcec.PublicKey) (*lnwire.BlindedPath, error) {
+	pathID := make([]byte, pathIDLen)
+	if _, err := rand.Read(pathID); err != nil {
+		return nil, err
+	}
+
+	data, err := record.EncodeBlindedRouteData(
+		record.NewFinalHopBlindedRouteData(nil, pathID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := sphinx.BuildBlindedPath(sessionKey, []*sphinx.HopInfo{{
+		NodePub:   ourPubKey,
+		PlainText: data,
+	}})
+	if err != nil {
+		return nil, err
+	}
+
+	intro, err := lnwire.NewPubkeyIntro(ourPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	replyPath := &lnwire.BlindedPath{
+		IntroductionNode: intro,
+		BlindingPoint:    path.Path.BlindingPoint,
+	}
+	for _, hop := range path.Path.BlindedHops {
+		replyPath.Hops = append(replyPath.Hops, lnwire.BlindedHop{
+			BlindedNodeID: hop.BlindedNodePub,
+			EncryptedData: hop.CipherText,
+		})
+	}
+
+	return replyPath, nil
+}
+
+// BuildOnionMessage builds an onion message that carries the receipt along
+// the given route to the introduction node of the reply path and then along
+// the reply path itself. The route is ordered from our first hop peer to the
+// introduction node.
+func BuildOnionMessage(path []route.Vertex, replyPath *lnwire.BlindedPath,
+	receipt *Receipt) (*OnionMessage, error) {
+
+	if len(path) == 0 {
+		return nil, errors.New("empty route to reply path")
+	}
+	if len(replyPath.Hops) == 0 {
+		return nil, lnwire.ErrEmptyBlindedPath
+	}
+
+	intro, err := pubKeyIntro(replyPath)
+	if err != nil {
+		return nil, err
+	}
+	if path[len(path)-1] != route.NewVertex(intro) {
+		return nil, errors.New("route doesn't end at introduction " +
+			"node of reply path")
+	}
+
+	// The nodes in front of the introduction node are reached through a
+	// blinded path of our own. Its last hop hands the message over to the
+	// introduction node along with the blinding point of the reply path.
+	blindedPath := &sphinx.BlindedPath{
+		IntroductionPoint: intro,
+		BlindingPoint:     replyPath.BlindingPoint,
+	}
+	if len(path) > 1 {
+		blindedPath, err = buildPrefixPath(path, replyPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, hop := range replyPath.Hops {
+		blindedPath.BlindedHops = append(
+			blindedPath.BlindedHops, &sphinx.BlindedHopInfo{
+				BlindedNodePub: hop.BlindedNodeID,
+				CipherText:     hop.EncryptedData,
+			},
+		)
+	}
+
+	value, err := receipt.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	sphinxPath, err := route.OnionMessageBlindedPathToSphinxPath(
+		blindedPath, nil, []*lnwire.FinalHopTLV{{
+			TLVType: ReceiptType,
+			Value:   value,
+		}},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	onionSessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	onionPkt, err := sphinx.NewOnionPacket(
+		sphinxPath, onionSessionKey, nil,
+		sphinx.DeterministicPacketFiller,
+		sphinx.WithMaxPayloadSize(sphinx.MaxRoutingPayloadSize),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create onion: %w", err)
+	}
+
+	var b bytes.Buffer
+	if err := onionPkt.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return &OnionMessage{
+		FirstHop: path[0],
+		PathKey:  blindedPath.BlindingPoint,
+		Onion:    b.Bytes(),
+	}, nil
+}
+
+// buildPrefixPath builds a blinded path over the nodes of the route that come
+// before the introduction node of the reply path.
+func buildPrefixPath(path []route.Vertex,
+	replyPath *lnwire.BlindedPath) (*sphinx.BlindedPath, error) {
+
+	hops := make([]*sphinx.HopInfo, 0, len(path)-1)
+	for i := 0; i < len(path)-1; i++ {
+		nodePub, err := btcec.ParsePubKey(path[i][:])
+		if err != nil {
+			return nil, err
+		}
+
+		nextNode, err := btcec.ParsePubKey(path[i+1][:])
+		if err != nil {
+			return nil, err
+		}
+
+		var blindingOverride *btcec.PublicKey
+		if i == len(path)-2 {
+			blindingOverride = replyPath.BlindingPoint
+		}
+
+		data, err := record.EncodeBlindedRouteData(
+			record.NewNonFinalBlindedRouteDataOnionMessage(
+				fn.NewLeft[*btcec.PublicKey,
+					lnwire.ShortChannelID](nextNode),
+				blindingOverride, nil,
+			),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		hops = append(hops, &sphinx.HopInfo{
+			NodePub:   nodePub,
+			PlainText: data,
+		})
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	prefix, err := sphinx.BuildBlindedPath(sessionKey, hops)
+	if err != nil {
+		return nil, err
+	}
+
+	return prefix.Path, nil
+}
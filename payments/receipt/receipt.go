@@ -0,0 +1,226 @@
+package receipt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// ReceiptType is the type of the final hop TLV of the onion message
+	// through which the receiver of a spontaneous payment returns the
+	// receipt to the sender.
+	ReceiptType tlv.Type = 5482373489
+
+	// paymentIDType is the TLV type of the payment identifier within an
+	// encoded receipt.
+	paymentIDType tlv.Type = 0
+
+	// signatureType is the TLV type of the signature within an encoded
+	// receipt.
+	signatureType tlv.Type = 2
+)
+
+var (
+	// receiptTag is prepended to the signed digest to separate receipt
+	// signatures from any other message signed with the node key.
+	receiptTag = []byte("lnd-payment-receipt")
+
+	// ErrInvalidSignature is returned when a receipt wasn't signed by the
+	// receiver of the payment.
+	ErrInvalidSignature = errors.New("receipt not signed by receiver")
+)
+
+// MessageSigner signs messages with the node key.
+type MessageSigner interface {
+	// SignMessageCompact signs the single or double sha256 digest of the
+	// given message and returns a pubkey-recoverable signature.
+	SignMessageCompact(msg []byte, doubleHash bool) ([]byte, error)
+}
+
+// Receipt is returned by the receiver of a spontaneous payment as a proof that
+// it received the payment along with its custom records.
+type Receipt struct {
+	// PaymentID is the identifier of the payment. This is the payment
+	// hash for keysend payments and the set ID for AMP payments.
+	PaymentID lntypes.Hash
+
+	// Signature is the pubkey-recoverable signature of the receiver over
+	// the digest of the payment.
+	Signature []byte
+}
+
+// Digest returns the digest that the receiver signs for the payment with the
+// given identifier and final hop custom records.
+func Digest(paymentID lntypes.Hash,
+	customRecords record.CustomSet) ([]byte, error) {
+
+	records, err := lnwire.CustomRecords(customRecords).Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	h.Write(receiptTag)
+	h.Write(paymentID[:])
+	h.Write(records)
+
+	return h.Sum(nil), nil
+}
+
+// Sign creates a receipt for the payment with the given identifier and final
+// hop custom records.
+func Sign(signer MessageSigner, paymentID lntypes.Hash,
+	customRecords record.CustomSet) (*Receipt, error) {
+
+	digest, err := Digest(paymentID, customRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := signer.SignMessageCompact(digest, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign receipt: %w", err)
+	}
+
+	return &Receipt{
+		PaymentID: paymentID,
+		Signature: sig,
+	}, nil
+}
+
+// Verify checks that the receipt was signed by the given receiver over the
+// given final hop custom records.
+func (r *Receipt) Verify(receiver route.Vertex,
+	customRecords record.CustomSet) error {
+
+	digest, err := Digest(r.PaymentID, customRecords)
+	if err != nil {
+		return err
+	}
+
+	pubKey, _, err := ecdsa.RecoverCompact(
+		r.Signature, chainhash.HashB(digest),
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+	}
+
+	if route.NewVertex(pubKey) != receiver {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Encode encodes the receipt into the value of the final hop TLV of an onion
+// message.
+func (r *Receipt) Encode() ([]byte, error) {
+	paymentID := [32]byte(r.PaymentID)
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(paymentIDType, &paymentID),
+		tlv.MakePrimitiveRecord(signatureType, &r.Signature),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// Decode decodes a receipt from the value of the final hop TLV of an onion
+// message.
+func Decode(b []byte) (*Receipt, error) {
+	var (
+		paymentID [32]byte
+		sig       []byte
+	)
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(paymentIDType, &paymentID),
+		tlv.MakePrimitiveRecord(signatureType, &sig),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	typeMap, err := stream.DecodeWithParsedTypes(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range []tlv.Type{paymentIDType, signatureType} {
+		if _, ok := typeMap[t]; !ok {
+			return nil, fmt.Errorf("receipt is missing type %d", t)
+		}
+	}
+
+	return &Receipt{
+		PaymentID: paymentID,
+		Signature: sig,
+	}, nil
+}
+
+// EncodeReplyPath encodes the blinded path over which the receipt is to be
+// returned into the value of a receipt request custom record.
+func EncodeReplyPath(path *lnwire.BlindedPath) ([]byte, error) {
+	stream, err := tlv.NewStream(path.Record())
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodeReplyPath decodes the blinded path over which the receipt is to be
+// returned from the value of a receipt request custom record.
+func DecodeReplyPath(b []byte) (*lnwire.BlindedPath, error) {
+	var path lnwire.BlindedPath
+	pathRecord := path.Record()
+	stream, err := tlv.NewStream(pathRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	typeMap, err := stream.DecodeWithParsedTypes(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := typeMap[pathRecord.Type()]; !ok {
+		return nil, errors.New("receipt request has no reply path")
+	}
+
+	return &path, nil
+}
+
+// pubKeyIntro returns the public key of the introduction node of the given
+// reply path.
+func pubKeyIntro(path *lnwire.BlindedPath) (*btcec.PublicKey, error) {
+	intro, ok := path.IntroductionNode.(lnwire.PubkeyIntro)
+	if !ok {
+		return nil, fmt.Errorf("unsupported introduction node %T",
+			path.IntroductionNode)
+	}
+
+	return intro.Pubkey, nil
+}
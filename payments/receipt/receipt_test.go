@@ -0,0 +1,270 @@
+package receipt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/onionmessage"
+	paymentsdb "github.com/lightningnetwork/lnd/payments/db"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// privKeySigner signs messages with a private key.
+type privKeySigner struct {
+	key *btcec.PrivateKey
+}
+
+// SignMessageCompact signs the single sha256 digest of the message.
+func (s *privKeySigner) SignMessageCompact(msg []byte, _ bool) ([]byte,
+	error) {
+
+	return ecdsa.SignCompact(s.key, chainhash.HashB(msg), true), nil
+}
+
+// newKey creates a new private key.
+func newKey(t *testing.T) *btcec.PrivateKey {
+	t.Helper()
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return key
+}
+
+// TestSignVerify tests that a receipt only verifies for the receiver that
+// signed it and the custom records it was signed over.
+func TestSignVerify(t *testing.T) {
+	t.Parallel()
+
+	receiverKey := newKey(t)
+	receiver := route.NewVertex(receiverKey.PubKey())
+
+	paymentID := lntypes.Hash{1}
+	customRecords := record.CustomSet{
+		record.KeySendType:        {1, 2, 3},
+		record.ReceiptRequestType: {4, 5, 6},
+		70_000:                    []byte("order-1"),
+	}
+
+	receipt, err := Sign(
+		&privKeySigner{key: receiverKey}, paymentID, customRecords,
+	)
+	require.NoError(t, err)
+	require.NoError(t, receipt.Verify(receiver, customRecords))
+
+	// The receipt survives an encoding round trip.
+	b, err := receipt.Encode()
+	require.NoError(t, err)
+	decoded, err := Decode(b)
+	require.NoError(t, err)
+	require.Equal(t, receipt, decoded)
+
+	// A different receiver doesn't verify.
+	other := route.NewVertex(newKey(t).PubKey())
+	require.ErrorIs(
+		t, receipt.Verify(other, customRecords), ErrInvalidSignature,
+	)
+
+	// Modified custom records don't verify.
+	modified := record.CustomSet{
+		record.KeySendType:        {1, 2, 3},
+		record.ReceiptRequestType: {4, 5, 6},
+		70_000:                    []byte("order-2"),
+	}
+	require.ErrorIs(
+		t, receipt.Verify(receiver, modified), ErrInvalidSignature,
+	)
+
+	// A different payment doesn't verify.
+	receipt.PaymentID = lntypes.Hash{2}
+	require.ErrorIs(
+		t, receipt.Verify(receiver, customRecords), ErrInvalidSignature,
+	)
+}
+
+// TestBuildOnionMessage tests that a receipt is carried along the route to
+// the introduction node of the reply path and delivered to the sender.
+func TestBuildOnionMessage(t *testing.T) {
+	t.Parallel()
+
+	// The receipt travels from the receiver over alice and bob to the
+	// sender, which is the introduction node of its own reply path.
+	var (
+		aliceKey  = newKey(t)
+		bobKey    = newKey(t)
+		senderKey = newKey(t)
+	)
+
+	replyPath, err := NewReplyPath(senderKey.PubKey())
+	require.NoError(t, err)
+
+	// The reply path survives the encoding in a custom record.
+	b, err := EncodeReplyPath(replyPath)
+	require.NoError(t, err)
+	replyPath, err = DecodeReplyPath(b)
+	require.NoError(t, err)
+
+	receipt := &Receipt{
+		PaymentID: lntypes.Hash{1},
+		Signature: []byte{1, 2, 3},
+	}
+
+	path := []route.Vertex{
+		route.NewVertex(aliceKey.PubKey()),
+		route.NewVertex(bobKey.PubKey()),
+		route.NewVertex(senderKey.PubKey()),
+	}
+
+	// A route that doesn't end at the introduction node is rejected.
+	_, err = BuildOnionMessage(path[:2], replyPath, receipt)
+	require.Error(t, err)
+
+	for _, path := range [][]route.Vertex{path, path[2:]} {
+		msg, err := BuildOnionMessage(path, replyPath, receipt)
+		require.NoError(t, err)
+		require.Equal(t, path[0], msg.FirstHop)
+
+		keys := []*btcec.PrivateKey{aliceKey, bobKey, senderKey}
+		keys = keys[len(keys)-len(path):]
+
+		hops := onionmessage.PeelOnionLayers(
+			t, keys, lnwire.NewOnionMessage(msg.PathKey, msg.Onion),
+		)
+		require.Len(t, hops, len(path))
+
+		final := hops[len(hops)-1]
+		require.True(t, final.IsFinal)
+		require.Len(t, final.Payload.FinalHopTLVs, 1)
+		require.Equal(
+			t, ReceiptType, final.Payload.FinalHopTLVs[0].TLVType,
+		)
+
+		decoded, err := Decode(final.Payload.FinalHopTLVs[0].Value)
+		require.NoError(t, err)
+		require.Equal(t, receipt, decoded)
+	}
+}
+
+// TestReceiptRequest tests that receipt requests are only extracted from
+// settled spontaneous payments that requested one.
+func TestReceiptRequest(t *testing.T) {
+	t.Parallel()
+
+	preimage := lntypes.Preimage{1}
+	customRecords := record.CustomSet{
+		record.KeySendType:        preimage[:],
+		record.ReceiptRequestType: {1},
+	}
+
+	invoice := &invoices.Invoice{
+		Terms: invoices.ContractTerm{
+			PaymentPreimage: &preimage,
+		},
+		State: invoices.ContractSettled,
+		Htlcs: map[invoices.CircuitKey]*invoices.InvoiceHTLC{
+			{HtlcID: 1}: {
+				State:         invoices.HtlcStateSettled,
+				CustomRecords: customRecords,
+			},
+		},
+	}
+
+	request, ok := receiptRequest(invoice)
+	require.True(t, ok)
+	require.Equal(t, preimage.Hash(), request.paymentID)
+	require.Equal(t, customRecords, request.customRecords)
+
+	// Without a receipt request, no receipt is issued.
+	delete(customRecords, record.ReceiptRequestType)
+	_, ok = receiptRequest(invoice)
+	require.False(t, ok)
+
+	// Invoices with a payment request aren't spontaneous payments.
+	customRecords[record.ReceiptRequestType] = []byte{1}
+	invoice.PaymentRequest = []byte("lnbc")
+	_, ok = receiptRequest(invoice)
+	require.False(t, ok)
+}
+
+// TestHandleReceipt tests that the collector only stores receipts that were
+// requested and signed by the receiver of the settled payment.
+func TestHandleReceipt(t *testing.T) {
+	t.Parallel()
+
+	receiverKey := newKey(t)
+	paymentID := lntypes.Hash{1}
+	customRecords := record.CustomSet{
+		record.ReceiptRequestType: {1},
+	}
+
+	payment := &paymentsdb.MPPayment{
+		HTLCs: []paymentsdb.HTLCAttempt{{
+			HTLCAttemptInfo: paymentsdb.HTLCAttemptInfo{
+				Route: route.Route{
+					Hops: []*route.Hop{{
+						PubKeyBytes: route.NewVertex(
+							receiverKey.PubKey(),
+						),
+						CustomRecords: customRecords,
+					}},
+				},
+			},
+			Settle: &paymentsdb.HTLCSettleInfo{},
+		}},
+	}
+
+	var stored *paymentsdb.PaymentReceipt
+	now := time.Unix(1_000_000, 0)
+	c := NewCollector(&CollectorConfig{
+		FetchPayment: func(_ context.Context,
+			hash lntypes.Hash) (*paymentsdb.MPPayment, error) {
+
+			require.Equal(t, paymentID, hash)
+
+			return payment, nil
+		},
+		StoreReceipt: func(_ context.Context, hash lntypes.Hash,
+			receipt *paymentsdb.PaymentReceipt) error {
+
+			stored = receipt
+
+			return nil
+		},
+		Clock: clock.NewTestClock(now),
+	})
+
+	// A receipt signed by someone else is rejected.
+	receipt, err := Sign(
+		&privKeySigner{key: newKey(t)}, paymentID, customRecords,
+	)
+	require.NoError(t, err)
+	err = c.HandleReceipt(t.Context(), receipt)
+	require.ErrorIs(t, err, ErrInvalidSignature)
+	require.Nil(t, stored)
+
+	// The receipt of the receiver is stored.
+	receipt, err = Sign(
+		&privKeySigner{key: receiverKey}, paymentID, customRecords,
+	)
+	require.NoError(t, err)
+	require.NoError(t, c.HandleReceipt(t.Context(), receipt))
+	require.Equal(t, &paymentsdb.PaymentReceipt{
+		Signature:    receipt.Signature,
+		ReceivedTime: now,
+	}, stored)
+
+	// A receipt for a payment that didn't request one is rejected.
+	delete(customRecords, record.ReceiptRequestType)
+	err = c.HandleReceipt(t.Context(), receipt)
+	require.ErrorIs(t, err, ErrReceiptNotRequested)
+}
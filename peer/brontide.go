@@ -38,7 +38,6 @@ import (
 	"github.com/lightningnetwork/lnd/htlcswitch/hodl"
 	"github.com/lightningnetwork/lnd/htlcswitch/hop"
 	"github.com/lightningnetwork/lnd/input"
-	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -354,7 +353,7 @@ type Config struct {
 
 	// Invoices is passed to the ChannelLink on creation and handles all
 	// invoice-related logic.
-	Invoices *invoices.InvoiceRegistry
+	Invoices htlcswitch.InvoiceDatabase
 
 	// ChannelNotifier is used by the link to notify other sub-systems about
 	// channel-related events and by the Brontide to subscribe to
@@ -447,6 +446,12 @@ type Config struct {
 	// from the peer.
 	HandleCustomMessage func(peer [33]byte, msg *lnwire.Custom) error
 
+	// HandlePeerStorageMessage is called whenever a peer storage message
+	// is received from the peer. This may be nil, in which case peer
+	// storage messages are ignored.
+	HandlePeerStorageMessage func(peer *btcec.PublicKey,
+		msg lnwire.Message)
+
 	// GetAliases is passed to created links so the Switch and link can be
 	// aware of the channel's aliases.
 	GetAliases func(base lnwire.ShortChannelID) []lnwire.ShortChannelID
@@ -623,6 +628,13 @@ type Brontide struct {
 	activeChannels *lnutils.SyncMap[
 		lnwire.ChannelID, *lnwallet.LightningChannel]
 
+	// wireChanIDs maps the channel IDs of active channels the peer knows
+	// by a different channel ID to that ID, and localChanIDs maps them
+	// back. This is the case for dual-funded channels, which the peer
+	// references by their v2 channel ID.
+	wireChanIDs  *lnutils.SyncMap[lnwire.ChannelID, lnwire.ChannelID]
+	localChanIDs *lnutils.SyncMap[lnwire.ChannelID, lnwire.ChannelID]
+
 	// numActiveChans shadows the count of non-pending entries in
 	// activeChannels as an atomic integer. It exists so that hot-path
 	// callers — notably the onion message ingress gate, which runs on
@@ -742,6 +754,12 @@ func NewBrontide(cfg Config) *Brontide {
 		activeChannels: &lnutils.SyncMap[
 			lnwire.ChannelID, *lnwallet.LightningChannel,
 		]{},
+		wireChanIDs: &lnutils.SyncMap[
+			lnwire.ChannelID, lnwire.ChannelID,
+		]{},
+		localChanIDs: &lnutils.SyncMap[
+			lnwire.ChannelID, lnwire.ChannelID,
+		]{},
 		newActiveChannel:     make(chan *newChannelMsg, 1),
 		newPendingChannel:    make(chan *newChannelMsg, 1),
 		removePendingChannel: make(chan *newChannelMsg),
@@ -1089,6 +1107,16 @@ func (p *Brontide) taprootShutdownAllowed() bool {
 		p.LocalFeatures().HasFeature(lnwire.ShutdownAnySegwitOptional)
 }
 
+// upfrontFeesAllowed returns true if both parties have negotiated the
+// experimental upfront fee feature.
+func (p *Brontide) upfrontFeesAllowed() bool {
+	return p.RemoteFeatures().HasFeature(
+		lnwire.ExperimentalUpfrontFeesOptional,
+	) && p.LocalFeatures().HasFeature(
+		lnwire.ExperimentalUpfrontFeesOptional,
+	)
+}
+
 // rbfCoopCloseAllowed returns true if both parties have negotiated the new RBF
 // coop close feature.
 func (p *Brontide) rbfCoopCloseAllowed() bool {
@@ -1572,6 +1600,7 @@ func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 		ShouldFwdExpAccountability: p.cfg.ShouldFwdExpAccountability,
 		DisallowQuiescence: p.cfg.DisallowQuiescence ||
 			!p.remoteFeatures.HasFeature(lnwire.QuiescenceOptional),
+		UpfrontFees:          p.upfrontFeesAllowed(),
 		AuxTrafficShaper:     p.cfg.AuxTrafficShaper,
 		AuxChannelNegotiator: p.cfg.AuxChannelNegotiator,
 		QuiescenceTimeout:    p.cfg.QuiescenceTimeout,
@@ -2295,6 +2324,10 @@ out:
 			}
 		}
 
+		// The peer may know a channel by a different channel ID than
+		// we do internally.
+		p.toLocalChanID(nextMsg)
+
 		// If a message router is active, then we'll try to have it
 		// handle this message. If it can, then we're able to skip the
 		// rest of the message handling logic.
@@ -2343,10 +2376,35 @@ out:
 			*lnwire.AcceptChannel,
 			*lnwire.FundingCreated,
 			*lnwire.FundingSigned,
-			*lnwire.ChannelReady:
+			*lnwire.ChannelReady,
+			*lnwire.OpenChannel2,
+			*lnwire.AcceptChannel2,
+			*lnwire.TxAddInput,
+			*lnwire.TxAddOutput,
+			*lnwire.TxRemoveInput,
+			*lnwire.TxRemoveOutput,
+			*lnwire.TxComplete,
+			*lnwire.TxSignatures,
+			*lnwire.TxInitRbf,
+			*lnwire.TxAckRbf,
+			*lnwire.TxAbort:
 
 			p.cfg.FundingManager.ProcessFundingMsg(msg, p)
 
+		case *lnwire.PeerStorage,
+			*lnwire.PeerStorageRetrieval:
+
+			// Peer storage is optional, so we ignore its messages
+			// if we don't support it.
+			if p.cfg.HandlePeerStorageMessage == nil {
+				p.log.Debugf("Ignoring %v, peer storage is "+
+					"disabled", msg.MsgType())
+
+				break
+			}
+
+			p.cfg.HandlePeerStorageMessage(p.IdentityKey(), msg)
+
 		case *lnwire.Shutdown:
 			select {
 			case p.chanCloseMsgs <- &closeMsg{msg.ChannelID, msg}:
@@ -2584,6 +2642,8 @@ func (p *Brontide) markPendingChannel(chanID lnwire.ChannelID) {
 func (p *Brontide) storeActiveChannel(chanID lnwire.ChannelID,
 	lnChan *lnwallet.LightningChannel) {
 
+	p.trackWireChanID(chanID, lnChan)
+
 	prev, loaded := p.activeChannels.Swap(chanID, lnChan)
 	if !loaded || prev == nil {
 		p.numActiveChans.Add(1)
@@ -2597,6 +2657,8 @@ func (p *Brontide) removeActiveChannel(chanID lnwire.ChannelID) {
 	if loaded && prev != nil {
 		p.numActiveChans.Add(-1)
 	}
+
+	p.untrackWireChanID(chanID)
 }
 
 // deletePendingChannel deletes a pending entry owned by the
@@ -2700,6 +2762,52 @@ func messageSummary(msg lnwire.Message) string {
 			msg.PendingChannelID[:], msg.ChannelReserve, msg.CsvDelay,
 			msg.MinAcceptDepth)
 
+	case *lnwire.OpenChannel2:
+		return fmt.Sprintf("temp_chan_id=%x, chain=%v, csv=%v, amt=%v, "+
+			"funding_fee=%v, locktime=%v, flags=%v",
+			msg.PendingChannelID[:], msg.ChainHash, msg.CsvDelay,
+			msg.FundingAmount, msg.FundingFeePerKw, msg.LockTime,
+			msg.ChannelFlags)
+
+	case *lnwire.AcceptChannel2:
+		return fmt.Sprintf("temp_chan_id=%x, amt=%v, csv=%v, "+
+			"num_confs=%v", msg.PendingChannelID[:],
+			msg.FundingAmount, msg.CsvDelay, msg.MinAcceptDepth)
+
+	case *lnwire.TxAddInput:
+		return fmt.Sprintf("chan_id=%v, serial_id=%v", msg.ChanID,
+			msg.SerialID)
+
+	case *lnwire.TxAddOutput:
+		return fmt.Sprintf("chan_id=%v, serial_id=%v, amt=%v",
+			msg.ChanID, msg.SerialID, msg.Amount)
+
+	case *lnwire.TxRemoveInput:
+		return fmt.Sprintf("chan_id=%v, serial_id=%v", msg.ChanID,
+			msg.SerialID)
+
+	case *lnwire.TxRemoveOutput:
+		return fmt.Sprintf("chan_id=%v, serial_id=%v", msg.ChanID,
+			msg.SerialID)
+
+	case *lnwire.TxComplete:
+		return fmt.Sprintf("chan_id=%v", msg.ChanID)
+
+	case *lnwire.TxSignatures:
+		return fmt.Sprintf("chan_id=%v, txid=%v, num_witnesses=%v",
+			msg.ChanID, msg.TxID, len(msg.Witnesses))
+
+	case *lnwire.TxInitRbf:
+		return fmt.Sprintf("chan_id=%v, fee_rate=%v, locktime=%v",
+			msg.ChanID, msg.FeeRate, msg.LockTime)
+
+	case *lnwire.TxAckRbf:
+		return fmt.Sprintf("chan_id=%v", msg.ChanID)
+
+	case *lnwire.TxAbort:
+		return fmt.Sprintf("chan_id=%v, data=%q", msg.ChanID,
+			string(msg.Data))
+
 	case *lnwire.FundingCreated:
 		return fmt.Sprintf("temp_chan_id=%x, chan_point=%v",
 			msg.PendingChannelID[:], msg.FundingPoint)
@@ -2794,6 +2902,12 @@ func messageSummary(msg lnwire.Message) string {
 	case *lnwire.Pong:
 		return fmt.Sprintf("len(pong_bytes)=%d", len(msg.PongBytes[:]))
 
+	case *lnwire.PeerStorage:
+		return fmt.Sprintf("len(blob)=%d", len(msg.Blob))
+
+	case *lnwire.PeerStorageRetrieval:
+		return fmt.Sprintf("len(blob)=%d", len(msg.Blob))
+
 	case *lnwire.UpdateFee:
 		return fmt.Sprintf("chan_id=%v, fee_update_sat=%v",
 			msg.ChanID, int64(msg.FeePerKw))
@@ -2902,8 +3016,10 @@ func (p *Brontide) logWireMessage(msg lnwire.Message, read bool) {
 // time, panics can occur because WriteMessage and Flush don't use any locking
 // internally.
 func (p *Brontide) writeMessage(msg lnwire.Message) error {
-	// Only log the message on the first attempt.
+	// Only log the message on the first attempt. Before that, we
+	// reference the channel by the channel ID the peer knows it by.
 	if msg != nil {
+		p.toWireChanID(msg)
 		p.logWireMessage(msg, false)
 	}
 
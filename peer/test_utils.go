@@ -2,8 +2,10 @@ package peer
 
 import (
 	"bytes"
+	"context"
 	crand "crypto/rand"
 	"encoding/binary"
+	"errors"
 	"io"
 	"math/rand"
 	"net"
@@ -480,6 +482,22 @@ func (m *mockUpdateHandler) InitStfu() <-chan fn.Result[lntypes.ChannelParty] {
 	return c
 }
 
+func (m *mockUpdateHandler) Pause(context.Context) error {
+	return errors.New("Pause not yet implemented")
+}
+
+func (m *mockUpdateHandler) Resume() error {
+	return errors.New("Resume not yet implemented")
+}
+
+func (m *mockUpdateHandler) IsPaused() bool {
+	return false
+}
+
+func (m *mockUpdateHandler) IsQuiescent() bool {
+	return false
+}
+
 func newMockConn(t *testing.T, expectedMessages int) *mockMessageConn {
 	return &mockMessageConn{
 		t:               t,
@@ -0,0 +1,111 @@
+package peer
+
+import (
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// messageChanID returns a pointer to the channel ID of a message exchanged
+// over an established channel, or nil if the message doesn't reference one.
+func messageChanID(msg lnwire.Message) *lnwire.ChannelID {
+	switch msg := msg.(type) {
+	case *lnwire.AnnounceSignatures1:
+		return &msg.ChannelID
+	case *lnwire.ChannelReady:
+		return &msg.ChanID
+	case *lnwire.ChannelReestablish:
+		return &msg.ChanID
+	case *lnwire.ClosingComplete:
+		return &msg.ChannelID
+	case *lnwire.ClosingSig:
+		return &msg.ChannelID
+	case *lnwire.ClosingSigned:
+		return &msg.ChannelID
+	case *lnwire.CommitSig:
+		return &msg.ChanID
+	case *lnwire.DynAck:
+		return &msg.ChanID
+	case *lnwire.DynPropose:
+		return &msg.ChanID
+	case *lnwire.DynReject:
+		return &msg.ChanID
+	case *lnwire.Error:
+		return &msg.ChanID
+	case *lnwire.KickoffSig:
+		return &msg.ChanID
+	case *lnwire.RevokeAndAck:
+		return &msg.ChanID
+	case *lnwire.Shutdown:
+		return &msg.ChannelID
+	case *lnwire.Stfu:
+		return &msg.ChanID
+	case *lnwire.UpdateAddHTLC:
+		return &msg.ChanID
+	case *lnwire.UpdateFailHTLC:
+		return &msg.ChanID
+	case *lnwire.UpdateFailMalformedHTLC:
+		return &msg.ChanID
+	case *lnwire.UpdateFee:
+		return &msg.ChanID
+	case *lnwire.UpdateFulfillHTLC:
+		return &msg.ChanID
+	case *lnwire.Warning:
+		return &msg.ChanID
+	default:
+		return nil
+	}
+}
+
+// trackWireChanID records the channel ID the peer knows an active channel by,
+// if it differs from the channel ID derived from its funding outpoint, which
+// is used internally. This is the case for dual-funded channels, which are
+// referenced by their v2 channel ID on the wire.
+func (p *Brontide) trackWireChanID(chanID lnwire.ChannelID,
+	lnChan *lnwallet.LightningChannel) {
+
+	if lnChan == nil {
+		return
+	}
+
+	wireID := lnChan.State().WireChanID()
+	if wireID == chanID {
+		return
+	}
+
+	p.wireChanIDs.Store(chanID, wireID)
+	p.localChanIDs.Store(wireID, chanID)
+}
+
+// untrackWireChanID forgets the wire channel ID of a channel that is no longer
+// active.
+func (p *Brontide) untrackWireChanID(chanID lnwire.ChannelID) {
+	if wireID, ok := p.wireChanIDs.LoadAndDelete(chanID); ok {
+		p.localChanIDs.Delete(wireID)
+	}
+}
+
+// toLocalChanID replaces the wire channel ID of a received message with the
+// channel ID the channel is known by internally.
+func (p *Brontide) toLocalChanID(msg lnwire.Message) {
+	chanID := messageChanID(msg)
+	if chanID == nil {
+		return
+	}
+
+	if localID, ok := p.localChanIDs.Load(*chanID); ok {
+		*chanID = localID
+	}
+}
+
+// toWireChanID replaces the internal channel ID of a message we're about to
+// send with the channel ID the peer knows the channel by.
+func (p *Brontide) toWireChanID(msg lnwire.Message) {
+	chanID := messageChanID(msg)
+	if chanID == nil {
+		return
+	}
+
+	if wireID, ok := p.wireChanIDs.Load(*chanID); ok {
+		*chanID = wireID
+	}
+}
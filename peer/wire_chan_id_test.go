@@ -0,0 +1,64 @@
+package peer
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnutils"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWireChanIDTranslation tests that the channel IDs of messages of channels
+// the peer knows by a different channel ID are translated in both directions,
+// while the channel IDs of other channels are left untouched.
+func TestWireChanIDTranslation(t *testing.T) {
+	t.Parallel()
+
+	p := &Brontide{
+		wireChanIDs: &lnutils.SyncMap[
+			lnwire.ChannelID, lnwire.ChannelID,
+		]{},
+		localChanIDs: &lnutils.SyncMap[
+			lnwire.ChannelID, lnwire.ChannelID,
+		]{},
+	}
+
+	localID := lnwire.ChannelID{1}
+	wireID := lnwire.ChannelID{2}
+	otherID := lnwire.ChannelID{3}
+	p.wireChanIDs.Store(localID, wireID)
+	p.localChanIDs.Store(wireID, localID)
+
+	// Messages we send reference the channel by its wire channel ID.
+	out := &lnwire.UpdateAddHTLC{ChanID: localID}
+	p.toWireChanID(out)
+	require.Equal(t, wireID, out.ChanID)
+
+	shutdown := &lnwire.Shutdown{ChannelID: localID}
+	p.toWireChanID(shutdown)
+	require.Equal(t, wireID, shutdown.ChannelID)
+
+	// Messages we receive are translated back to the internal channel ID.
+	in := &lnwire.RevokeAndAck{ChanID: wireID}
+	p.toLocalChanID(in)
+	require.Equal(t, localID, in.ChanID)
+
+	// Channels without a different wire channel ID are left untouched.
+	other := &lnwire.CommitSig{ChanID: otherID}
+	p.toWireChanID(other)
+	require.Equal(t, otherID, other.ChanID)
+	p.toLocalChanID(other)
+	require.Equal(t, otherID, other.ChanID)
+
+	// Messages of the funding flow are never translated.
+	txComplete := &lnwire.TxComplete{ChanID: wireID}
+	p.toLocalChanID(txComplete)
+	require.Equal(t, wireID, txComplete.ChanID)
+
+	// Once the channel is no longer active, its IDs aren't translated
+	// anymore.
+	p.untrackWireChanID(localID)
+	in = &lnwire.RevokeAndAck{ChanID: wireID}
+	p.toLocalChanID(in)
+	require.Equal(t, wireID, in.ChanID)
+}
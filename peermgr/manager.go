@@ -0,0 +1,398 @@
+package peermgr
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// Config holds the dependencies and settings of the peer manager.
+type Config struct {
+	// Store persists the connection policies of our peers.
+	Store Store
+
+	// MinBackoff is the shortest backoff when reconnecting to a peer.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the longest backoff when reconnecting to a peer.
+	MaxBackoff time.Duration
+
+	// StableConnDuration is the duration after which a connection is
+	// considered stable. The backoff of peers with stable connections is
+	// relaxed by the duration of the connection.
+	StableConnDuration time.Duration
+
+	// MaxAttempts is the number of consecutive failed connection attempts
+	// after which we stop reconnecting to peers whose policy doesn't set
+	// a limit. Zero means that we never stop.
+	MaxAttempts uint32
+
+	// Clock is used to determine the time of the next connection attempt.
+	Clock clock.Clock
+}
+
+// PeerState is a snapshot of the reconnection state of a peer.
+type PeerState struct {
+	// Peer is the public key of the peer.
+	Peer route.Vertex
+
+	// Policy is the connection policy of the peer.
+	Policy Policy
+
+	// Backoff is the delay of the latest scheduled reconnection.
+	Backoff time.Duration
+
+	// NextAttempt is the time of the latest scheduled reconnection. It's
+	// zero if no reconnection was scheduled since we last connected.
+	NextAttempt time.Time
+
+	// FailedAttempts is the number of consecutive failed connection
+	// attempts.
+	FailedAttempts uint32
+
+	// Exhausted is true if we stopped reconnecting to the peer because it
+	// reached its maximum number of connection attempts.
+	Exhausted bool
+}
+
+// peerState is the reconnection state we track for a persistent peer.
+type peerState struct {
+	// backoff is the backoff before it's scaled by the priority of the
+	// peer. It grows exponentially with each reconnection.
+	backoff time.Duration
+
+	// delay is the backoff of the latest scheduled reconnection, scaled by
+	// the priority of the peer.
+	delay time.Duration
+
+	nextAttempt    time.Time
+	failedAttempts uint32
+	exhausted      bool
+}
+
+// Manager keeps track of the connection policies of our peers and of the
+// reconnection state of the peers we maintain a persistent connection with.
+type Manager struct {
+	cfg *Config
+
+	mu       sync.Mutex
+	policies map[route.Vertex]*Policy
+	peers    map[route.Vertex]*peerState
+}
+
+// NewManager creates a new peer manager, loading the persisted policies.
+func NewManager(cfg *Config) (*Manager, error) {
+	policies, err := cfg.Store.FetchPolicies()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Loaded connection policies of %d peers", len(policies))
+
+	return &Manager{
+		cfg:      cfg,
+		policies: policies,
+		peers:    make(map[route.Vertex]*peerState),
+	}, nil
+}
+
+// Policy returns the connection policy of the given peer, or the default
+// policy if it has none.
+func (m *Manager) Policy(peer route.Vertex) Policy {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.policyUnsafe(peer)
+}
+
+// policyUnsafe returns the connection policy of the given peer.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (m *Manager) policyUnsafe(peer route.Vertex) Policy {
+	p, ok := m.policies[peer]
+	if !ok {
+		return Policy{}
+	}
+
+	return *p
+}
+
+// SetPolicy persists and applies the connection policy of the given peer.
+func (m *Manager) SetPolicy(peer route.Vertex, p Policy) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.cfg.Store.PutPolicy(peer, &p); err != nil {
+		return err
+	}
+	m.policies[peer] = &p
+
+	log.Infof("Set connection policy of peer %v: priority=%v, "+
+		"max_attempts=%d, addr_preference=%v", peer, p.Priority,
+		p.MaxAttempts, p.AddrPreference)
+
+	return nil
+}
+
+// DeletePolicy deletes the connection policy of the given peer, so that the
+// default policy applies to it again.
+func (m *Manager) DeletePolicy(peer route.Vertex) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.cfg.Store.DeletePolicy(peer); err != nil {
+		return err
+	}
+	delete(m.policies, peer)
+
+	log.Infof("Deleted connection policy of peer %v", peer)
+
+	return nil
+}
+
+// SortByPriority sorts the given peers in the order they should be connected
+// to, with high priority peers first. Peers of the same priority keep their
+// order.
+func (m *Manager) SortByPriority(peers []route.Vertex) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	slices.SortStableFunc(peers, func(a, b route.Vertex) int {
+		return m.policyUnsafe(a).Priority.rank() -
+			m.policyUnsafe(b).Priority.rank()
+	})
+}
+
+// Track starts tracking the reconnection state of a persistent peer. It's a
+// no-op if the peer is already tracked.
+func (m *Manager) Track(peer route.Vertex) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.trackUnsafe(peer)
+}
+
+// trackUnsafe returns the reconnection state of the given peer, tracking it
+// if needed.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (m *Manager) trackUnsafe(peer route.Vertex) *peerState {
+	state, ok := m.peers[peer]
+	if !ok {
+		state = &peerState{backoff: m.cfg.MinBackoff}
+		m.peers[peer] = state
+	}
+
+	return state
+}
+
+// Forget stops tracking the reconnection state of the given peer.
+func (m *Manager) Forget(peer route.Vertex) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.peers, peer)
+}
+
+// NextBackoff computes the backoff before reconnecting to the given peer after
+// a connection that started at the given time ended, and records the time of
+// the reconnection. A zero start time means that the peer failed to start.
+func (m *Manager) NextBackoff(peer route.Vertex,
+	startTime time.Time) time.Duration {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// If an existing backoff was unknown, use the default.
+	state, ok := m.peers[peer]
+	if !ok {
+		state = m.trackUnsafe(peer)
+	} else {
+		state.backoff = m.nextBackoff(state.backoff, startTime)
+	}
+
+	// The backoff is scaled by the priority of the peer, without leaving
+	// the configured bounds.
+	state.delay = state.backoff
+	switch m.policyUnsafe(peer).Priority {
+	case PriorityHigh:
+		state.delay = max(state.delay/2, m.cfg.MinBackoff)
+
+	case PriorityLow:
+		state.delay = min(state.delay*2, m.cfg.MaxBackoff)
+	}
+	state.nextAttempt = m.cfg.Clock.Now().Add(state.delay)
+
+	return state.delay
+}
+
+// nextBackoff computes the next backoff using exponential backoff, given the
+// current backoff and the start time of the connection that ended.
+func (m *Manager) nextBackoff(backoff time.Duration,
+	startTime time.Time) time.Duration {
+
+	// If the peer failed to start properly, we'll just use the previous
+	// backoff to compute the subsequent randomized exponential backoff
+	// duration. This will roughly double on average.
+	if startTime.IsZero() {
+		return computeNextBackoff(backoff, m.cfg.MaxBackoff)
+	}
+
+	// The peer succeeded in starting. If the connection didn't last long
+	// enough to be considered stable, we'll continue to back off retries
+	// with this peer.
+	connDuration := m.cfg.Clock.Now().Sub(startTime)
+	if connDuration < m.cfg.StableConnDuration {
+		return computeNextBackoff(backoff, m.cfg.MaxBackoff)
+	}
+
+	// The peer succeed in starting and this was stable peer, so we'll
+	// reduce the timeout duration by the length of the connection after
+	// applying randomized exponential backoff. We'll only apply this in the
+	// case that:
+	//   reb(curBackoff) - connDuration > cfg.MinBackoff
+	relaxedBackoff := computeNextBackoff(backoff, m.cfg.MaxBackoff) -
+		connDuration
+	if relaxedBackoff > m.cfg.MinBackoff {
+		return relaxedBackoff
+	}
+
+	// Lastly, if reb(currBackoff) - connDuration <= cfg.MinBackoff, meaning
+	// the stable connection lasted much longer than our previous backoff.
+	// To reward such good behavior, we'll reconnect after the default
+	// timeout.
+	return m.cfg.MinBackoff
+}
+
+// ConnFailed records a failed connection attempt to the given peer. It
+// returns true if the peer just reached its maximum number of connection
+// attempts, in which case the caller should stop reconnecting to it.
+func (m *Manager) ConnFailed(peer route.Vertex) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state, ok := m.peers[peer]
+	if !ok || state.exhausted {
+		return false
+	}
+	state.failedAttempts++
+
+	maxAttempts := m.policyUnsafe(peer).MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = m.cfg.MaxAttempts
+	}
+	if maxAttempts == 0 || state.failedAttempts < maxAttempts {
+		return false
+	}
+
+	log.Infof("Peer %v reached its maximum of %d connection attempts",
+		peer, maxAttempts)
+
+	state.exhausted = true
+
+	return true
+}
+
+// Exhausted returns true if we stopped reconnecting to the given peer because
+// it reached its maximum number of connection attempts.
+func (m *Manager) Exhausted(peer route.Vertex) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state, ok := m.peers[peer]
+
+	return ok && state.exhausted
+}
+
+// Connected records that we're connected to the given peer, which resets its
+// failed connection attempts.
+func (m *Manager) Connected(peer route.Vertex) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state, ok := m.peers[peer]
+	if !ok {
+		return
+	}
+
+	state.failedAttempts = 0
+	state.exhausted = false
+	state.nextAttempt = time.Time{}
+}
+
+// Reset resets the backoff and the failed connection attempts of the given
+// peer, as if we never connected to it before.
+func (m *Manager) Reset(peer route.Vertex) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state, ok := m.peers[peer]
+	if !ok {
+		return
+	}
+
+	*state = peerState{backoff: m.cfg.MinBackoff}
+}
+
+// PeerStates returns the reconnection state of all tracked peers, ordered by
+// their public key.
+func (m *Manager) PeerStates() []PeerState {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	states := make([]PeerState, 0, len(m.peers))
+	for peer, state := range m.peers {
+		states = append(states, PeerState{
+			Peer:           peer,
+			Policy:         m.policyUnsafe(peer),
+			Backoff:        state.delay,
+			NextAttempt:    state.nextAttempt,
+			FailedAttempts: state.failedAttempts,
+			Exhausted:      state.exhausted,
+		})
+	}
+
+	slices.SortFunc(states, func(a, b PeerState) int {
+		return bytes.Compare(a.Peer[:], b.Peer[:])
+	})
+
+	return states
+}
+
+// computeNextBackoff uses a truncated exponential backoff to compute the next
+// backoff using the value of the exiting backoff. The returned duration is
+// randomized in either direction by 1/20 to prevent tight loops from
+// stabilizing.
+func computeNextBackoff(currBackoff, maxBackoff time.Duration) time.Duration {
+	// Double the current backoff, truncating if it exceeds our maximum.
+	nextBackoff := 2 * currBackoff
+	if nextBackoff > maxBackoff {
+		nextBackoff = maxBackoff
+	}
+
+	// Using 1/10 of our duration as a margin, compute a random offset to
+	// avoid the nodes entering connection cycles.
+	margin := nextBackoff / 10
+
+	var wiggle big.Int
+	wiggle.SetUint64(uint64(margin))
+	if _, err := rand.Int(rand.Reader, &wiggle); err != nil {
+		// Randomizing is not mission critical, so we'll just return the
+		// current backoff.
+		return nextBackoff
+	}
+
+	// Otherwise add in our wiggle, but subtract out half of the margin so
+	// that the backoff can tweaked by 1/20 in either direction.
+	return nextBackoff + (time.Duration(wiggle.Uint64()) - margin/2)
+}
@@ -0,0 +1,226 @@
+package peermgr
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tor"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMinBackoff = time.Second
+	testMaxBackoff = time.Minute
+)
+
+var testTime = time.Unix(1_700_000_000, 0)
+
+// newTestManager creates a peer manager backed by the given database.
+func newTestManager(t *testing.T, cdb *channeldb.DB,
+	testClock clock.Clock) *Manager {
+
+	store, err := NewStore(cdb)
+	require.NoError(t, err)
+
+	m, err := NewManager(&Config{
+		Store:              store,
+		MinBackoff:         testMinBackoff,
+		MaxBackoff:         testMaxBackoff,
+		StableConnDuration: 10 * time.Minute,
+		MaxAttempts:        3,
+		Clock:              testClock,
+	})
+	require.NoError(t, err)
+
+	return m
+}
+
+// TestManagerPolicies tests that policies are validated, persisted and
+// determine the order in which peers are connected to.
+func TestManagerPolicies(t *testing.T) {
+	t.Parallel()
+
+	cdb, err := channeldb.MakeTestDB(t)
+	require.NoError(t, err)
+
+	testClock := clock.NewTestClock(testTime)
+	m := newTestManager(t, cdb, testClock)
+
+	low, normal, high := route.Vertex{1}, route.Vertex{2}, route.Vertex{3}
+
+	// Peers without a policy have the default policy.
+	require.Equal(t, Policy{}, m.Policy(low))
+
+	err = m.SetPolicy(low, Policy{Priority: PriorityHigh + 1})
+	require.ErrorContains(t, err, "unknown priority")
+
+	err = m.SetPolicy(low, Policy{AddrPreference: AddrPreferenceTor + 1})
+	require.ErrorContains(t, err, "unknown address preference")
+
+	require.NoError(t, m.SetPolicy(low, Policy{Priority: PriorityLow}))
+	require.NoError(t, m.SetPolicy(high, Policy{Priority: PriorityHigh}))
+
+	peers := []route.Vertex{low, normal, high}
+	m.SortByPriority(peers)
+	require.Equal(t, []route.Vertex{high, normal, low}, peers)
+
+	// The policies survive a restart.
+	m = newTestManager(t, cdb, testClock)
+	require.Equal(t, PriorityHigh, m.Policy(high).Priority)
+
+	require.NoError(t, m.DeletePolicy(high))
+	require.Equal(t, Policy{}, m.Policy(high))
+
+	m = newTestManager(t, cdb, testClock)
+	require.Equal(t, Policy{}, m.Policy(high))
+	require.Equal(t, PriorityLow, m.Policy(low).Priority)
+}
+
+// TestManagerBackoff tests that the backoff grows exponentially, is scaled by
+// the priority of the peer and is relaxed after stable connections.
+func TestManagerBackoff(t *testing.T) {
+	t.Parallel()
+
+	cdb, err := channeldb.MakeTestDB(t)
+	require.NoError(t, err)
+
+	testClock := clock.NewTestClock(testTime)
+	m := newTestManager(t, cdb, testClock)
+
+	normal, high, low := route.Vertex{1}, route.Vertex{2}, route.Vertex{3}
+	require.NoError(t, m.SetPolicy(high, Policy{Priority: PriorityHigh}))
+	require.NoError(t, m.SetPolicy(low, Policy{Priority: PriorityLow}))
+
+	// The backoff of an untracked peer is the minimum backoff.
+	require.Equal(t, testMinBackoff, m.NextBackoff(normal, time.Time{}))
+
+	// Each failed start roughly doubles the backoff.
+	m.Track(high)
+	m.Track(low)
+	prevBackoff := testMinBackoff
+	for range 3 {
+		backoff := m.NextBackoff(normal, time.Time{})
+		require.InDelta(
+			t, 2*prevBackoff, backoff, float64(prevBackoff/10),
+		)
+		prevBackoff = backoff
+	}
+
+	// The backoff of high priority peers is halved without dropping below
+	// the minimum, the one of low priority peers is doubled.
+	require.InDelta(
+		t, testMinBackoff, m.NextBackoff(high, time.Time{}),
+		float64(testMinBackoff/20),
+	)
+	require.InDelta(
+		t, 4*time.Second, m.NextBackoff(low, time.Time{}),
+		float64(time.Second/5),
+	)
+
+	// The backoff never exceeds the maximum backoff.
+	for range 10 {
+		m.NextBackoff(low, time.Time{})
+	}
+	require.Equal(t, testMaxBackoff, m.NextBackoff(low, time.Time{}))
+
+	// The next attempt is scheduled after the backoff.
+	states := m.PeerStates()
+	require.Len(t, states, 3)
+	require.Equal(t, low, states[2].Peer)
+	require.Equal(t, testTime.Add(testMaxBackoff), states[2].NextAttempt)
+	require.Equal(t, testMaxBackoff, states[2].Backoff)
+
+	// A stable connection resets the backoff to the minimum.
+	testClock.SetTime(testTime.Add(time.Hour))
+	require.Equal(t, testMinBackoff, m.NextBackoff(normal, testTime))
+
+	// Forgotten peers are no longer listed.
+	m.Forget(low)
+	require.Len(t, m.PeerStates(), 2)
+}
+
+// TestManagerMaxAttempts tests that peers are exhausted once they reach their
+// maximum number of connection attempts, and that a connection or a reset
+// resumes the attempts.
+func TestManagerMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	cdb, err := channeldb.MakeTestDB(t)
+	require.NoError(t, err)
+
+	m := newTestManager(t, cdb, clock.NewTestClock(testTime))
+
+	peer, other := route.Vertex{1}, route.Vertex{2}
+
+	// Failures of untracked peers aren't counted.
+	require.False(t, m.ConnFailed(peer))
+
+	// The default maximum applies to peers without a limit.
+	m.Track(peer)
+	require.False(t, m.ConnFailed(peer))
+	require.False(t, m.ConnFailed(peer))
+	require.True(t, m.ConnFailed(peer))
+	require.True(t, m.Exhausted(peer))
+
+	// An exhausted peer is only reported once.
+	require.False(t, m.ConnFailed(peer))
+	require.Equal(t, uint32(3), m.PeerStates()[0].FailedAttempts)
+
+	// A connection resumes the attempts.
+	m.Connected(peer)
+	require.False(t, m.Exhausted(peer))
+	require.Zero(t, m.PeerStates()[0].FailedAttempts)
+
+	// The policy of a peer overrides the default maximum.
+	require.NoError(t, m.SetPolicy(other, Policy{MaxAttempts: 1}))
+	m.Track(other)
+	require.True(t, m.ConnFailed(other))
+	require.True(t, m.Exhausted(other))
+
+	// A reset resumes the attempts and resets the backoff.
+	m.NextBackoff(other, time.Time{})
+	m.Reset(other)
+	require.False(t, m.Exhausted(other))
+	require.InDelta(
+		t, 2*testMinBackoff, m.NextBackoff(other, time.Time{}),
+		float64(testMinBackoff/10),
+	)
+}
+
+// TestFilterAddrs tests that only the preferred addresses are connected to,
+// unless a peer has none.
+func TestFilterAddrs(t *testing.T) {
+	t.Parallel()
+
+	clearnet := &lnwire.NetAddress{
+		Address: &net.TCPAddr{IP: net.IPv4(1, 2, 3, 4), Port: 9735},
+	}
+	onion := &lnwire.NetAddress{
+		Address: &tor.OnionAddr{
+			OnionService: "3g2upl4pq6kufc4m.onion",
+			Port:         9735,
+		},
+	}
+	both := []*lnwire.NetAddress{clearnet, onion}
+
+	require.Equal(t, both, FilterAddrs(both, AddrPreferenceNone))
+	require.Equal(
+		t, []*lnwire.NetAddress{clearnet},
+		FilterAddrs(both, AddrPreferenceClearnet),
+	)
+	require.Equal(
+		t, []*lnwire.NetAddress{onion},
+		FilterAddrs(both, AddrPreferenceTor),
+	)
+
+	// Without a preferred address, all addresses are used.
+	onlyClearnet := []*lnwire.NetAddress{clearnet}
+	require.Equal(
+		t, onlyClearnet, FilterAddrs(onlyClearnet, AddrPreferenceTor),
+	)
+}
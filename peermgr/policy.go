@@ -0,0 +1,145 @@
+package peermgr
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tor"
+)
+
+// Priority is the priority of a peer we maintain a persistent connection
+// with.
+type Priority uint8
+
+const (
+	// PriorityNormal is the priority of peers without a policy.
+	PriorityNormal Priority = 0
+
+	// PriorityLow peers are reconnected to last on startup and back off
+	// twice as long as normal peers.
+	PriorityLow Priority = 1
+
+	// PriorityHigh peers are reconnected to first and without staggering on
+	// startup, back off half as long as normal peers and may use the
+	// inbound slots reserved for channel peers.
+	PriorityHigh Priority = 2
+)
+
+// String returns a human-readable representation of the priority.
+func (p Priority) String() string {
+	switch p {
+	case PriorityNormal:
+		return "Normal"
+
+	case PriorityLow:
+		return "Low"
+
+	case PriorityHigh:
+		return "High"
+
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint8(p))
+	}
+}
+
+// rank returns the order in which peers of the priority are connected to,
+// lower ranks first.
+func (p Priority) rank() int {
+	switch p {
+	case PriorityHigh:
+		return 0
+
+	case PriorityLow:
+		return 2
+
+	default:
+		return 1
+	}
+}
+
+// AddrPreference determines which of the addresses of a peer we connect to.
+type AddrPreference uint8
+
+const (
+	// AddrPreferenceNone connects to all addresses of the peer.
+	AddrPreferenceNone AddrPreference = 0
+
+	// AddrPreferenceClearnet only connects to the clearnet addresses of
+	// the peer, unless it has none.
+	AddrPreferenceClearnet AddrPreference = 1
+
+	// AddrPreferenceTor only connects to the onion addresses of the peer,
+	// unless it has none.
+	AddrPreferenceTor AddrPreference = 2
+)
+
+// String returns a human-readable representation of the address preference.
+func (a AddrPreference) String() string {
+	switch a {
+	case AddrPreferenceNone:
+		return "None"
+
+	case AddrPreferenceClearnet:
+		return "Clearnet"
+
+	case AddrPreferenceTor:
+		return "Tor"
+
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint8(a))
+	}
+}
+
+// Policy determines how we maintain the persistent connection with a peer.
+type Policy struct {
+	// Priority is the priority of the peer.
+	Priority Priority
+
+	// MaxAttempts is the number of consecutive failed connection attempts
+	// after which we stop reconnecting to the peer. Zero means the
+	// default of the manager applies.
+	MaxAttempts uint32
+
+	// AddrPreference determines which of the addresses of the peer we
+	// connect to.
+	AddrPreference AddrPreference
+}
+
+// Validate checks that the policy only holds known values.
+func (p *Policy) Validate() error {
+	if p.Priority > PriorityHigh {
+		return fmt.Errorf("unknown priority %v", p.Priority)
+	}
+
+	if p.AddrPreference > AddrPreferenceTor {
+		return fmt.Errorf("unknown address preference %v",
+			p.AddrPreference)
+	}
+
+	return nil
+}
+
+// FilterAddrs returns the addresses of a peer we connect to according to
+// the given preference. If the peer has no address of the preferred kind, all
+// addresses are returned.
+func FilterAddrs(addrs []*lnwire.NetAddress,
+	pref AddrPreference) []*lnwire.NetAddress {
+
+	if pref == AddrPreferenceNone {
+		return addrs
+	}
+
+	var preferred []*lnwire.NetAddress
+	for _, addr := range addrs {
+		_, isOnion := addr.Address.(*tor.OnionAddr)
+		if isOnion == (pref == AddrPreferenceTor) {
+			preferred = append(preferred, addr)
+		}
+	}
+
+	if len(preferred) == 0 {
+		return addrs
+	}
+
+	return preferred
+}
@@ -0,0 +1,167 @@
+package peermgr
+
+import (
+	"bytes"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// peerPoliciesBucket is the top-level bucket that stores the
+	// connection policies of our peers.
+	//
+	// maps: pubKey -> Policy
+	peerPoliciesBucket = []byte("peer-conn-policies")
+)
+
+// Store persists the connection policies of our peers.
+type Store interface {
+	// PutPolicy adds or replaces the policy of a peer.
+	PutPolicy(peer route.Vertex, p *Policy) error
+
+	// FetchPolicies returns the policies of all peers that have one.
+	FetchPolicies() (map[route.Vertex]*Policy, error)
+
+	// DeletePolicy deletes the policy of a peer.
+	DeletePolicy(peer route.Vertex) error
+}
+
+// kvStore is a Store backed by a kvdb backend.
+type kvStore struct {
+	db kvdb.Backend
+}
+
+// A compile-time assertion to ensure that kvStore implements the Store
+// interface.
+var _ Store = (*kvStore)(nil)
+
+// NewStore creates a new store for peer policies, creating its bucket if
+// needed.
+func NewStore(db kvdb.Backend) (Store, error) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(peerPoliciesBucket)
+		return err
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &kvStore{db: db}, nil
+}
+
+// policyRecord is the serialized representation of a Policy.
+type policyRecord struct {
+	priority       uint8
+	maxAttempts    uint32
+	addrPreference uint8
+}
+
+// toTlvStream converts a policyRecord into a tlv representation.
+func (r *policyRecord) toTlvStream() (*tlv.Stream, error) {
+	const (
+		// A set of tlv type definitions used to serialize a policy.
+		priorityType       tlv.Type = 0
+		maxAttemptsType    tlv.Type = 1
+		addrPreferenceType tlv.Type = 2
+	)
+
+	return tlv.NewStream(
+		tlv.MakePrimitiveRecord(priorityType, &r.priority),
+		tlv.MakePrimitiveRecord(maxAttemptsType, &r.maxAttempts),
+		tlv.MakePrimitiveRecord(addrPreferenceType, &r.addrPreference),
+	)
+}
+
+// serializePolicy serializes a Policy based on tlv format.
+func serializePolicy(p *Policy) ([]byte, error) {
+	r := &policyRecord{
+		priority:       uint8(p.Priority),
+		maxAttempts:    p.MaxAttempts,
+		addrPreference: uint8(p.AddrPreference),
+	}
+
+	tlvStream, err := r.toTlvStream()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tlvStream.Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// deserializePolicy deserializes a Policy based on tlv format.
+func deserializePolicy(b []byte) (*Policy, error) {
+	var r policyRecord
+	tlvStream, err := r.toTlvStream()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Decode(bytes.NewReader(b)); err != nil {
+		return nil, err
+	}
+
+	return &Policy{
+		Priority:       Priority(r.priority),
+		MaxAttempts:    r.maxAttempts,
+		AddrPreference: AddrPreference(r.addrPreference),
+	}, nil
+}
+
+// PutPolicy adds or replaces the policy of a peer.
+func (s *kvStore) PutPolicy(peer route.Vertex, p *Policy) error {
+	value, err := serializePolicy(p)
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(peerPoliciesBucket)
+		return bucket.Put(peer[:], value)
+	}, func() {})
+}
+
+// FetchPolicies returns the policies of all peers that have one.
+func (s *kvStore) FetchPolicies() (map[route.Vertex]*Policy, error) {
+	var policies map[route.Vertex]*Policy
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(peerPoliciesBucket)
+
+		return bucket.ForEach(func(k, v []byte) error {
+			peer, err := route.NewVertexFromBytes(k)
+			if err != nil {
+				return err
+			}
+
+			p, err := deserializePolicy(v)
+			if err != nil {
+				return err
+			}
+
+			policies[peer] = p
+
+			return nil
+		})
+	}, func() {
+		policies = make(map[route.Vertex]*Policy)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// DeletePolicy deletes the policy of a peer. Deleting the policy of a peer
+// without one is a no-op.
+func (s *kvStore) DeletePolicy(peer route.Vertex) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		return tx.ReadWriteBucket(peerPoliciesBucket).Delete(peer[:])
+	}, func() {})
+}
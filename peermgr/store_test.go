@@ -0,0 +1,58 @@
+package peermgr
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStore tests storing, replacing and deleting peer policies, and that they
+// survive recreating the store.
+func TestStore(t *testing.T) {
+	cdb, err := channeldb.MakeTestDB(t)
+	require.NoError(t, err)
+
+	store, err := NewStore(cdb)
+	require.NoError(t, err)
+
+	policies, err := store.FetchPolicies()
+	require.NoError(t, err)
+	require.Empty(t, policies)
+
+	peer1 := route.Vertex{1}
+	peer2 := route.Vertex{2}
+
+	policy1 := &Policy{
+		Priority:       PriorityHigh,
+		MaxAttempts:    5,
+		AddrPreference: AddrPreferenceTor,
+	}
+	policy2 := &Policy{Priority: PriorityLow}
+	require.NoError(t, store.PutPolicy(peer1, policy1))
+	require.NoError(t, store.PutPolicy(peer2, policy2))
+
+	// A stored policy is replaced by the next one.
+	policy2.MaxAttempts = 3
+	require.NoError(t, store.PutPolicy(peer2, policy2))
+
+	// The policies survive recreating the store.
+	store, err = NewStore(cdb)
+	require.NoError(t, err)
+
+	policies, err = store.FetchPolicies()
+	require.NoError(t, err)
+	require.Equal(t, map[route.Vertex]*Policy{
+		peer1: policy1,
+		peer2: policy2,
+	}, policies)
+
+	// Deleting a policy leaves the others untouched.
+	require.NoError(t, store.DeletePolicy(peer1))
+	require.NoError(t, store.DeletePolicy(peer1))
+
+	policies, err = store.FetchPolicies()
+	require.NoError(t, err)
+	require.Equal(t, map[route.Vertex]*Policy{peer2: policy2}, policies)
+}
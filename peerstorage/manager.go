@@ -0,0 +1,441 @@
+package peerstorage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/chanbackup"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channelnotifier"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+// Store persists the blobs we store on behalf of our peers.
+type Store interface {
+	// PutPeerStorage stores the given blob on behalf of a peer, replacing
+	// any blob that was stored for the peer before.
+	PutPeerStorage(peer route.Vertex, blob []byte) error
+
+	// FetchPeerStorage returns the blob stored on behalf of a peer, or
+	// channeldb.ErrNoPeerStorage if we don't store a blob for it.
+	FetchPeerStorage(peer route.Vertex) ([]byte, error)
+
+	// DeletePeerStorage deletes the blob stored on behalf of a peer.
+	DeletePeerStorage(peer route.Vertex) error
+}
+
+// Config holds the dependencies of the peer storage manager.
+type Config struct {
+	// Store persists the blobs we store on behalf of our peers.
+	Store Store
+
+	// MaxBlobSize is the largest blob we store on behalf of a peer. Zero
+	// means lnwire.MaxPeerStorageBlobSize.
+	MaxBlobSize int
+
+	// KeyRing is used to encrypt the backups we upload to our peers and
+	// to decrypt the backups they return to us.
+	KeyRing keychain.KeyRing
+
+	// HasChannels returns whether we have any open or pending channels
+	// with the given peer. We only store blobs on behalf of such peers.
+	HasChannels func(peer *btcec.PublicKey) (bool, error)
+
+	// FetchBackups returns the static channel backups of our channels
+	// with the given peer.
+	FetchBackups func(peer *btcec.PublicKey) ([]chanbackup.Single, error)
+
+	// IsKnownChannel returns whether the channel with the given funding
+	// outpoint is known to us, either as an open or as a closed channel.
+	// Known channels are never restored from a backup a peer returned.
+	IsKnownChannel func(chanPoint wire.OutPoint) (bool, error)
+
+	// Restorer restores the channels of the backups our peers return to
+	// us.
+	Restorer chanbackup.ChannelRestorer
+
+	// PeerConnector reconnects to a peer after channels with it were
+	// restored, so that the data loss protection protocol is executed.
+	PeerConnector chanbackup.PeerConnector
+
+	// SendMessage sends a message to the given peer.
+	SendMessage func(peer *btcec.PublicKey, msg lnwire.Message) error
+
+	// SubscribeChannelEvents subscribes to channel open and close events.
+	// The backup uploaded to a peer is updated whenever a channel with it
+	// is opened or closed.
+	SubscribeChannelEvents func() (subscribe.Subscription, error)
+}
+
+// Manager implements option_provide_storage. It stores a small blob on behalf
+// of each of our channel peers and returns it to them when they reconnect. In
+// turn, it uploads an encrypted backup of our channels with each peer that
+// provides storage, so that a node restored from seed can recover its channel
+// state from the backups its peers return to it.
+type Manager struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	mu sync.Mutex
+
+	// storagePeers are the connected peers that store a blob on our
+	// behalf.
+	storagePeers map[route.Vertex]*btcec.PublicKey
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewManager creates a new peer storage manager.
+func NewManager(cfg *Config) *Manager {
+	return &Manager{
+		cfg:          cfg,
+		storagePeers: make(map[route.Vertex]*btcec.PublicKey),
+		quit:         make(chan struct{}),
+	}
+}
+
+// Start starts the manager.
+func (m *Manager) Start() error {
+	var startErr error
+	m.started.Do(func() {
+		log.Debugf("Peer storage manager starting")
+
+		sub, err := m.cfg.SubscribeChannelEvents()
+		if err != nil {
+			startErr = fmt.Errorf("unable to subscribe to channel "+
+				"events: %w", err)
+
+			return
+		}
+
+		m.wg.Add(1)
+		go m.channelEventHandler(sub)
+	})
+
+	return startErr
+}
+
+// Stop stops the manager and waits for all of its goroutines to exit.
+func (m *Manager) Stop() error {
+	m.stopped.Do(func() {
+		log.Debugf("Peer storage manager stopping")
+
+		close(m.quit)
+		m.wg.Wait()
+	})
+
+	return nil
+}
+
+// maxBlobSize returns the configured maximum blob size.
+func (m *Manager) maxBlobSize() int {
+	if m.cfg.MaxBlobSize == 0 {
+		return lnwire.MaxPeerStorageBlobSize
+	}
+
+	return m.cfg.MaxBlobSize
+}
+
+// PeerConnected returns the blob we store on behalf of a newly connected peer
+// to it. If the peer provides storage, we upload the backup of our channels
+// with it.
+func (m *Manager) PeerConnected(peer *btcec.PublicKey,
+	features *lnwire.FeatureVector) {
+
+	vertex := route.NewVertex(peer)
+
+	blob, err := m.cfg.Store.FetchPeerStorage(vertex)
+	switch {
+	case errors.Is(err, channeldb.ErrNoPeerStorage):
+
+	case err != nil:
+		log.Errorf("Unable to fetch peer storage of %v: %v", vertex,
+			err)
+
+	default:
+		log.Debugf("Returning %d byte blob to peer %v", len(blob),
+			vertex)
+
+		err := m.cfg.SendMessage(peer, &lnwire.PeerStorageRetrieval{
+			Blob: blob,
+		})
+		if err != nil {
+			log.Errorf("Unable to return blob to peer %v: %v",
+				vertex, err)
+		}
+	}
+
+	if !features.HasFeature(lnwire.ProvideStorageOptional) {
+		return
+	}
+
+	m.mu.Lock()
+	m.storagePeers[vertex] = peer
+	m.mu.Unlock()
+
+	m.uploadBackup(peer)
+}
+
+// PeerDisconnected stops tracking a peer that disconnected.
+func (m *Manager) PeerDisconnected(peer *btcec.PublicKey) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.storagePeers, route.NewVertex(peer))
+}
+
+// ProcessMessage handles a peer storage message received from the given peer.
+func (m *Manager) ProcessMessage(peer *btcec.PublicKey, msg lnwire.Message) {
+	switch msg := msg.(type) {
+	case *lnwire.PeerStorage:
+		if err := m.storeBlob(peer, msg.Blob); err != nil {
+			log.Warnf("Not storing blob of peer %x: %v",
+				peer.SerializeCompressed(), err)
+		}
+
+	case *lnwire.PeerStorageRetrieval:
+		select {
+		case <-m.quit:
+			return
+		default:
+		}
+
+		m.wg.Add(1)
+		go m.recoverChannels(peer, msg.Blob)
+
+	default:
+		log.Warnf("Unexpected peer storage message %T from %x", msg,
+			peer.SerializeCompressed())
+	}
+}
+
+// storeBlob stores the blob a peer sent us on its behalf. An empty blob
+// deletes the blob we stored for the peer before.
+func (m *Manager) storeBlob(peer *btcec.PublicKey, blob []byte) error {
+	if len(blob) > m.maxBlobSize() {
+		return fmt.Errorf("blob of %d bytes exceeds maximum of %d "+
+			"bytes", len(blob), m.maxBlobSize())
+	}
+
+	hasChannels, err := m.cfg.HasChannels(peer)
+	if err != nil {
+		return err
+	}
+	if !hasChannels {
+		return errors.New("no channels with peer")
+	}
+
+	vertex := route.NewVertex(peer)
+	if len(blob) == 0 {
+		log.Debugf("Deleting blob of peer %v", vertex)
+
+		return m.cfg.Store.DeletePeerStorage(vertex)
+	}
+
+	log.Debugf("Storing %d byte blob of peer %v", len(blob), vertex)
+
+	return m.cfg.Store.PutPeerStorage(vertex, blob)
+}
+
+// uploadBackup uploads the encrypted backup of our channels with the given
+// peer to it.
+func (m *Manager) uploadBackup(peer *btcec.PublicKey) {
+	backups, err := m.cfg.FetchBackups(peer)
+	if err != nil {
+		log.Errorf("Unable to fetch channel backups for peer %x: %v",
+			peer.SerializeCompressed(), err)
+
+		return
+	}
+
+	// Without any channels, there is nothing for the peer to store.
+	if len(backups) == 0 {
+		return
+	}
+
+	blob, err := m.packBackups(backups)
+	if err != nil {
+		log.Errorf("Unable to pack channel backups for peer %x: %v",
+			peer.SerializeCompressed(), err)
+
+		return
+	}
+
+	log.Debugf("Uploading backup of %d channels to peer %x", len(backups),
+		peer.SerializeCompressed())
+
+	err = m.cfg.SendMessage(peer, &lnwire.PeerStorage{Blob: blob})
+	if err != nil {
+		log.Errorf("Unable to upload channel backup to peer %x: %v",
+			peer.SerializeCompressed(), err)
+	}
+}
+
+// packBackups encrypts the given backups into a multi channel backup that
+// fits into a peer storage message. If the backups don't fit, the trailing
+// ones are left out.
+func (m *Manager) packBackups(backups []chanbackup.Single) ([]byte, error) {
+	for len(backups) > 0 {
+		multi := chanbackup.Multi{
+			Version:       chanbackup.DefaultMultiVersion,
+			StaticBackups: backups,
+		}
+
+		var b bytes.Buffer
+		if err := multi.PackToWriter(&b, m.cfg.KeyRing); err != nil {
+			return nil, err
+		}
+
+		if b.Len() <= lnwire.MaxPeerStorageBlobSize {
+			return b.Bytes(), nil
+		}
+
+		log.Warnf("Channel backup of %d bytes exceeds peer storage "+
+			"limit, leaving out ChannelPoint(%v)", b.Len(),
+			backups[len(backups)-1].FundingOutpoint)
+
+		backups = backups[:len(backups)-1]
+	}
+
+	return nil, errors.New("no channel backup fits into peer storage")
+}
+
+// recoverChannels restores the channels in the backup a peer returned to us
+// that are unknown to us. This happens if our node was restored from seed.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Manager) recoverChannels(peer *btcec.PublicKey, blob []byte) {
+	defer m.wg.Done()
+
+	if len(blob) == 0 {
+		return
+	}
+
+	// The peer may return a blob that wasn't created by us, e.g. if it
+	// mixed up its peers, so we don't treat a failure to decrypt it as an
+	// error.
+	var multi chanbackup.Multi
+	err := multi.UnpackFromReader(bytes.NewReader(blob), m.cfg.KeyRing)
+	if err != nil {
+		log.Debugf("Unable to unpack blob returned by peer %x: %v",
+			peer.SerializeCompressed(), err)
+
+		return
+	}
+
+	var unknown []chanbackup.Single
+	for _, backup := range multi.StaticBackups {
+		// We only restore channels with the peer that returned the
+		// backup.
+		if !backup.RemoteNodePub.IsEqual(peer) {
+			continue
+		}
+
+		known, err := m.cfg.IsKnownChannel(backup.FundingOutpoint)
+		if err != nil {
+			log.Errorf("Unable to look up ChannelPoint(%v): %v",
+				backup.FundingOutpoint, err)
+
+			return
+		}
+		if known {
+			continue
+		}
+
+		unknown = append(unknown, backup)
+	}
+
+	if len(unknown) == 0 {
+		return
+	}
+
+	log.Infof("Restoring %d channels from backup returned by peer %x",
+		len(unknown), peer.SerializeCompressed())
+
+	numRestored, err := chanbackup.Recover(
+		unknown, m.cfg.Restorer, m.cfg.PeerConnector,
+	)
+	if err != nil {
+		log.Errorf("Unable to restore channels from backup returned "+
+			"by peer %x: %v", peer.SerializeCompressed(), err)
+
+		return
+	}
+
+	log.Infof("Restored %d channels from backup returned by peer %x",
+		numRestored, peer.SerializeCompressed())
+}
+
+// channelEventHandler updates the backup we uploaded to a peer whenever a
+// channel with it is opened or closed. Once the last channel with a peer is
+// closed, the blob we store on its behalf is deleted.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Manager) channelEventHandler(sub subscribe.Subscription) {
+	defer m.wg.Done()
+	defer sub.Cancel()
+
+	for {
+		select {
+		case e := <-sub.Updates():
+			switch event := e.(type) {
+			case channelnotifier.OpenChannelEvent:
+				m.channelsChanged(event.Channel.IdentityPub)
+
+			case channelnotifier.ClosedChannelEvent:
+				peer := event.CloseSummary.RemotePub
+				m.channelsChanged(peer)
+				m.pruneBlob(peer)
+			}
+
+		case <-sub.Quit():
+			return
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// channelsChanged uploads a new backup to the given peer if it provides
+// storage.
+func (m *Manager) channelsChanged(peer *btcec.PublicKey) {
+	m.mu.Lock()
+	_, ok := m.storagePeers[route.NewVertex(peer)]
+	m.mu.Unlock()
+
+	if ok {
+		m.uploadBackup(peer)
+	}
+}
+
+// pruneBlob deletes the blob we store on behalf of the given peer if we no
+// longer have any channels with it.
+func (m *Manager) pruneBlob(peer *btcec.PublicKey) {
+	hasChannels, err := m.cfg.HasChannels(peer)
+	if err != nil {
+		log.Errorf("Unable to look up channels with peer %x: %v",
+			peer.SerializeCompressed(), err)
+
+		return
+	}
+	if hasChannels {
+		return
+	}
+
+	err = m.cfg.Store.DeletePeerStorage(route.NewVertex(peer))
+	if err != nil {
+		log.Errorf("Unable to delete blob of peer %x: %v",
+			peer.SerializeCompressed(), err)
+	}
+}
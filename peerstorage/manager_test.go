@@ -0,0 +1,306 @@
+package peerstorage
+
+import (
+	"bytes"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/chanbackup"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntest/mock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// mockStore is an in-memory Store.
+type mockStore struct {
+	blobs map[route.Vertex][]byte
+}
+
+func (s *mockStore) PutPeerStorage(peer route.Vertex, blob []byte) error {
+	s.blobs[peer] = blob
+	return nil
+}
+
+func (s *mockStore) FetchPeerStorage(peer route.Vertex) ([]byte, error) {
+	blob, ok := s.blobs[peer]
+	if !ok {
+		return nil, channeldb.ErrNoPeerStorage
+	}
+
+	return blob, nil
+}
+
+func (s *mockStore) DeletePeerStorage(peer route.Vertex) error {
+	delete(s.blobs, peer)
+	return nil
+}
+
+// mockRestorer records the backups it restores and the peers it connects to.
+type mockRestorer struct {
+	mu        sync.Mutex
+	restored  []chanbackup.Single
+	connected []*btcec.PublicKey
+}
+
+func (r *mockRestorer) RestoreChansFromSingles(
+	backups ...chanbackup.Single) error {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.restored = append(r.restored, backups...)
+
+	return nil
+}
+
+func (r *mockRestorer) ConnectPeer(node *btcec.PublicKey, _ []net.Addr) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.connected = append(r.connected, node)
+
+	return nil
+}
+
+// sentMessage is a message the manager sent to a peer.
+type sentMessage struct {
+	peer *btcec.PublicKey
+	msg  lnwire.Message
+}
+
+// testContext holds a manager and the state of its mocked dependencies.
+type testContext struct {
+	manager  *Manager
+	store    *mockStore
+	restorer *mockRestorer
+
+	// channels are the channels we have, keyed by peer.
+	channels map[route.Vertex][]chanbackup.Single
+
+	// known are the funding outpoints of the channels known to us.
+	known map[wire.OutPoint]struct{}
+
+	sent []sentMessage
+}
+
+func newTestContext(t *testing.T, keyRing keychain.KeyRing) *testContext {
+	t.Helper()
+
+	ctx := &testContext{
+		store:    &mockStore{blobs: make(map[route.Vertex][]byte)},
+		restorer: &mockRestorer{},
+		channels: make(map[route.Vertex][]chanbackup.Single),
+		known:    make(map[wire.OutPoint]struct{}),
+	}
+
+	ctx.manager = NewManager(&Config{
+		Store:       ctx.store,
+		MaxBlobSize: 100,
+		KeyRing:     keyRing,
+		HasChannels: func(peer *btcec.PublicKey) (bool, error) {
+			return len(ctx.channels[route.NewVertex(peer)]) > 0, nil
+		},
+		FetchBackups: func(peer *btcec.PublicKey) ([]chanbackup.Single,
+			error) {
+
+			return ctx.channels[route.NewVertex(peer)], nil
+		},
+		IsKnownChannel: func(op wire.OutPoint) (bool, error) {
+			_, ok := ctx.known[op]
+			return ok, nil
+		},
+		Restorer:      ctx.restorer,
+		PeerConnector: ctx.restorer,
+		SendMessage: func(peer *btcec.PublicKey,
+			msg lnwire.Message) error {
+
+			ctx.sent = append(ctx.sent, sentMessage{peer, msg})
+			return nil
+		},
+	})
+
+	return ctx
+}
+
+// addChannel adds a channel with the given peer.
+func (c *testContext) addChannel(t *testing.T, peer *btcec.PublicKey,
+	index uint32) chanbackup.Single {
+
+	t.Helper()
+
+	backup := testBackup(t, peer, index)
+	vertex := route.NewVertex(peer)
+	c.channels[vertex] = append(c.channels[vertex], backup)
+	c.known[backup.FundingOutpoint] = struct{}{}
+
+	return backup
+}
+
+// testKey returns a new private key.
+func testKey(t *testing.T) *btcec.PrivateKey {
+	t.Helper()
+
+	priv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return priv
+}
+
+// testBackup returns a minimal backup of a channel with the given peer.
+func testBackup(t *testing.T, peer *btcec.PublicKey,
+	index uint32) chanbackup.Single {
+
+	t.Helper()
+
+	remoteKey := keychain.KeyDescriptor{PubKey: testKey(t).PubKey()}
+
+	return chanbackup.Single{
+		Version: chanbackup.AnchorsCommitVersion,
+		FundingOutpoint: wire.OutPoint{
+			Hash:  [32]byte{1},
+			Index: index,
+		},
+		RemoteNodePub: peer,
+		Addresses: []net.Addr{
+			&net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 9735},
+		},
+		Capacity: 100_000,
+		RemoteChanCfg: channeldb.ChannelConfig{
+			MultiSigKey:         remoteKey,
+			RevocationBasePoint: remoteKey,
+			PaymentBasePoint:    remoteKey,
+			DelayBasePoint:      remoteKey,
+			HtlcBasePoint:       remoteKey,
+		},
+	}
+}
+
+// TestStoreBlob asserts that we only store size limited blobs of peers we have
+// channels with and return them when the peer reconnects.
+func TestStoreBlob(t *testing.T) {
+	t.Parallel()
+
+	ctx := newTestContext(t, &mock.SecretKeyRing{RootKey: testKey(t)})
+	peer := testKey(t).PubKey()
+	vertex := route.NewVertex(peer)
+
+	// We don't store blobs of peers we don't have channels with.
+	ctx.manager.ProcessMessage(peer, &lnwire.PeerStorage{Blob: []byte{1}})
+	require.Empty(t, ctx.store.blobs)
+
+	// Nor do we store blobs exceeding the size limit.
+	ctx.addChannel(t, peer, 0)
+	ctx.manager.ProcessMessage(peer, &lnwire.PeerStorage{
+		Blob: make([]byte, 101),
+	})
+	require.Empty(t, ctx.store.blobs)
+
+	// Once both conditions are met, the blob is stored and replaced by the
+	// next one.
+	ctx.manager.ProcessMessage(peer, &lnwire.PeerStorage{Blob: []byte{1}})
+	ctx.manager.ProcessMessage(peer, &lnwire.PeerStorage{Blob: []byte{2}})
+	require.Equal(t, []byte{2}, ctx.store.blobs[vertex])
+
+	// The blob is returned to the peer when it reconnects. As it doesn't
+	// provide storage, we don't upload a backup to it.
+	ctx.manager.PeerConnected(peer, lnwire.EmptyFeatureVector())
+	require.Len(t, ctx.sent, 1)
+	require.Equal(t, &lnwire.PeerStorageRetrieval{Blob: []byte{2}},
+		ctx.sent[0].msg)
+
+	// An empty blob deletes the stored one.
+	ctx.manager.ProcessMessage(peer, &lnwire.PeerStorage{})
+	require.Empty(t, ctx.store.blobs)
+
+	// Once the last channel with a peer is closed, its blob is pruned.
+	ctx.manager.ProcessMessage(peer, &lnwire.PeerStorage{Blob: []byte{3}})
+	require.Len(t, ctx.store.blobs, 1)
+
+	delete(ctx.channels, vertex)
+	ctx.manager.pruneBlob(peer)
+	require.Empty(t, ctx.store.blobs)
+}
+
+// TestBackupRecovery asserts that we upload the backup of our channels to
+// peers that provide storage and restore the channels in the backup they
+// return to us that are unknown to us.
+func TestBackupRecovery(t *testing.T) {
+	t.Parallel()
+
+	keyRing := &mock.SecretKeyRing{RootKey: testKey(t)}
+	ctx := newTestContext(t, keyRing)
+
+	peer := testKey(t).PubKey()
+	otherPeer := testKey(t).PubKey()
+
+	backup1 := ctx.addChannel(t, peer, 0)
+	backup2 := ctx.addChannel(t, peer, 1)
+	ctx.addChannel(t, otherPeer, 2)
+
+	// A peer that provides storage receives the backup of our channels
+	// with it once it connects.
+	features := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(lnwire.ProvideStorageOptional),
+		lnwire.Features,
+	)
+	ctx.manager.PeerConnected(peer, features)
+	require.Len(t, ctx.sent, 1)
+	require.True(t, ctx.sent[0].peer.IsEqual(peer))
+
+	upload, ok := ctx.sent[0].msg.(*lnwire.PeerStorage)
+	require.True(t, ok)
+
+	// The backup is encrypted and only contains our channels with the
+	// peer.
+	var multi chanbackup.Multi
+	err := multi.UnpackFromReader(
+		bytes.NewReader(upload.Blob), ctx.manager.cfg.KeyRing,
+	)
+	require.NoError(t, err)
+	require.Len(t, multi.StaticBackups, 2)
+	require.Equal(
+		t, backup1.FundingOutpoint,
+		multi.StaticBackups[0].FundingOutpoint,
+	)
+	require.Equal(
+		t, backup2.FundingOutpoint,
+		multi.StaticBackups[1].FundingOutpoint,
+	)
+
+	// When the peer returns the backup while we still know all of its
+	// channels, nothing is restored. Recoveries run in the background, so
+	// we wait for each of them to complete.
+	retrieval := &lnwire.PeerStorageRetrieval{Blob: upload.Blob}
+	ctx.manager.ProcessMessage(peer, retrieval)
+	ctx.manager.wg.Wait()
+	require.Empty(t, ctx.restorer.restored)
+
+	// After a restore from seed, we only know one of the channels, so the
+	// other one is restored and we reconnect to the peer to execute the
+	// data loss protection protocol.
+	delete(ctx.known, backup2.FundingOutpoint)
+	ctx.manager.ProcessMessage(peer, retrieval)
+	ctx.manager.wg.Wait()
+
+	// A backup that was returned by another peer, or that we can't
+	// decrypt, is ignored.
+	ctx.manager.ProcessMessage(otherPeer, retrieval)
+	ctx.manager.ProcessMessage(peer, &lnwire.PeerStorageRetrieval{
+		Blob: []byte{1, 2, 3},
+	})
+	ctx.manager.wg.Wait()
+
+	require.Len(t, ctx.restorer.restored, 1)
+	require.Equal(
+		t, backup2.FundingOutpoint,
+		ctx.restorer.restored[0].FundingOutpoint,
+	)
+	require.Len(t, ctx.restorer.connected, 1)
+	require.True(t, ctx.restorer.connected[0].IsEqual(peer))
+}
@@ -3,4 +3,10 @@ package record
 const (
 	// KeySendType is the custom record identifier for keysend preimages.
 	KeySendType uint64 = 5482373484
+
+	// ReceiptRequestType is the custom record identifier through which the
+	// sender of a spontaneous payment requests a signed receipt from the
+	// receiver. Its value holds the blinded path the receipt is to be
+	// returned over.
+	ReceiptRequestType uint64 = 5482373487
 )
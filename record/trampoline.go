@@ -0,0 +1,26 @@
+package record
+
+import "github.com/lightningnetwork/lnd/tlv"
+
+const (
+	// TrampolineOnionType is the custom record type used to carry the
+	// trampoline onion packet in the final hop payload of the outer onion
+	// that is sent to a trampoline node.
+	TrampolineOnionType uint64 = 66100
+
+	// Trampoline Onion Packet types.
+
+	// InvoiceFeaturesOnionType is the type used in the trampoline onion to
+	// include the features of the recipient's invoice, so that the
+	// trampoline node can pay a recipient that doesn't support trampoline.
+	InvoiceFeaturesOnionType tlv.Type = 66097
+
+	// OutgoingNodeIDOnionType is the type used in the trampoline onion to
+	// reference the node the trampoline node should forward the payment
+	// to.
+	OutgoingNodeIDOnionType tlv.Type = 66098
+
+	// InvoiceRoutingInfoOnionType is the type used in the trampoline onion
+	// to include the route hints of the recipient's invoice.
+	InvoiceRoutingInfoOnionType tlv.Type = 66099
+)
@@ -48,6 +48,13 @@ type BuildBlindedPathCfg struct {
 	// FetchOurOpenChannels fetches this node's set of open channels.
 	FetchOurOpenChannels func() ([]*channeldb.OpenChannel, error)
 
+	// GetAlias returns the SCID alias that the peer of one of our channels
+	// knows the channel by. If set, paths that enter this node through one
+	// of our private option-scid-alias channels instruct the peer to
+	// forward over the alias instead of the real SCID, which the peer
+	// might not accept for such a channel.
+	GetAlias func(lnwire.ChannelID) (lnwire.ShortChannelID, error)
+
 	// BestHeight can be used to fetch the best block height that this node
 	// is aware of.
 	BestHeight func() (uint32, error)
@@ -107,6 +114,11 @@ type BuildBlindedPathCfg struct {
 	// introduction node. If these default policy values are used, then
 	// the MaxHTLCMsat value must be carefully chosen.
 	DefaultDummyHopPolicy *BlindedHopPolicy
+
+	// OnRouteUsed is an optional callback that is called with each route
+	// returned by FindRoutes that a blinded path was built from. Routes
+	// that didn't result in a usable path are skipped.
+	OnRouteUsed func(route *route.Route)
 }
 
 // BuildBlindedPaymentPaths uses the passed config to construct a set of blinded
@@ -126,6 +138,13 @@ func BuildBlindedPaymentPaths(cfg *BuildBlindedPathCfg) (
 			"use for blinded route construction")
 	}
 
+	// Collect the aliases of our private channels so that paths entering
+	// this node through one of them can use the alias.
+	aliases, err := privateChannelAliases(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Not every route returned will necessarily result in a usable blinded
 	// path and so the number of paths returned might be less than the
 	// number of real routes returned by FindRoutes above.
@@ -141,7 +160,9 @@ func BuildBlindedPaymentPaths(cfg *BuildBlindedPathCfg) (
 		// of hops is met.
 		candidatePath.padWithDummyHops(cfg.MinNumHops)
 
-		path, err := buildBlindedPaymentPath(cfg, candidatePath)
+		path, err := buildBlindedPaymentPath(
+			cfg, candidatePath, aliases,
+		)
 		if errors.Is(err, errInvalidBlindedPath) {
 			log.Debugf("Not using route (%s) as a blinded path "+
 				"since it resulted in an invalid blinded path",
@@ -157,6 +178,10 @@ func BuildBlindedPaymentPaths(cfg *BuildBlindedPathCfg) (
 
 		log.Debugf("Route selected for blinded path: %s", candidatePath)
 
+		if cfg.OnRouteUsed != nil {
+			cfg.OnRouteUsed(route)
+		}
+
 		paths = append(paths, path)
 	}
 
@@ -167,12 +192,57 @@ func BuildBlindedPaymentPaths(cfg *BuildBlindedPathCfg) (
 	return paths, nil
 }
 
-// buildBlindedPaymentPath takes a route from an introduction node to this node
-// and uses the given config to convert it into a blinded payment path.
-func buildBlindedPaymentPath(cfg *BuildBlindedPathCfg, path *candidatePath) (
-	*zpay32.BlindedPaymentPath, error) {
+// privateChannelAliases returns the peer aliases of our private channels that
+// negotiated the option-scid-alias feature, keyed by the SCIDs the channels
+// may be known by in the graph.
+func privateChannelAliases(cfg *BuildBlindedPathCfg) (
+	map[uint64]lnwire.ShortChannelID, error) {
+
+	aliases := make(map[uint64]lnwire.ShortChannelID)
+	if cfg.GetAlias == nil {
+		return aliases, nil
+	}
 
-	hops, minHTLC, maxHTLC, err := collectRelayInfo(cfg, path)
+	channels, err := cfg.FetchOurOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range channels {
+		isPublic := c.ChannelFlags&lnwire.FFAnnounceChannel != 0
+		if isPublic || !c.ChanType.HasScidAliasFeature() {
+			continue
+		}
+
+		chanID := lnwire.NewChanIDFromOutPoint(c.FundingOutpoint)
+		alias, err := cfg.GetAlias(chanID)
+		if err != nil || alias.IsDefault() {
+			log.Debugf("No peer alias found for private "+
+				"channel %v: %v", c.FundingOutpoint, err)
+
+			continue
+		}
+
+		aliases[c.ShortChannelID.ToUint64()] = alias
+
+		// A zero-conf channel may be known by its confirmed SCID too.
+		if c.IsZeroConf() && c.ZeroConfConfirmed() {
+			aliases[c.ZeroConfRealScid().ToUint64()] = alias
+		}
+	}
+
+	return aliases, nil
+}
+
+// buildBlindedPaymentPath takes a route from an introduction node to this node
+// and uses the given config to convert it into a blinded payment path. The
+// aliases map the SCIDs of our private channels to the aliases that our peers
+// know these channels by.
+func buildBlindedPaymentPath(cfg *BuildBlindedPathCfg, path *candidatePath,
+	aliases map[uint64]lnwire.ShortChannelID) (*zpay32.BlindedPaymentPath,
+	error) {
+
+	hops, minHTLC, maxHTLC, err := collectRelayInfo(cfg, path, aliases)
 	if err != nil {
 		return nil, fmt.Errorf("could not collect blinded path relay "+
 			"info: %w", err)
@@ -354,8 +424,12 @@ type hopRelayInfo struct {
 // policy values. If there are no real hops (in other words we are the
 // introduction node), then we use some default routing values and we use the
 // average of our channel capacities for the MaxHTLC value.
-func collectRelayInfo(cfg *BuildBlindedPathCfg, path *candidatePath) (
-	[]*hopRelayInfo, lnwire.MilliSatoshi, lnwire.MilliSatoshi, error) {
+//
+// If the channel of a hop is found in the given aliases, the hop is instructed
+// to forward over the alias instead of the SCID of the channel.
+func collectRelayInfo(cfg *BuildBlindedPathCfg, path *candidatePath,
+	aliases map[uint64]lnwire.ShortChannelID) ([]*hopRelayInfo,
+	lnwire.MilliSatoshi, lnwire.MilliSatoshi, error) {
 
 	var (
 		// The first pub key is that of the introduction node.
@@ -474,11 +548,16 @@ func collectRelayInfo(cfg *BuildBlindedPathCfg, path *candidatePath) (
 			}
 		}
 
+		nextSCID := lnwire.NewShortChanIDFromInt(hop.channelID)
+		if alias, ok := aliases[hop.channelID]; ok && !hop.isDummy {
+			nextSCID = alias
+		}
+
 		// From the policy values for this hop, we can collect the
 		// payment relay info that we will send to this hop.
 		hops = append(hops, &hopRelayInfo{
 			hopPubKey: hopSource,
-			nextSCID:  lnwire.NewShortChanIDFromInt(hop.channelID),
+			nextSCID:  nextSCID,
 			relayInfo: &record.PaymentRelayInfo{
 				FeeRate:         policy.FeeRate,
 				BaseFee:         policy.BaseFee,
@@ -10,7 +10,9 @@ import (
 	"testing/quick"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire/v2"
 	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/graph/db/models"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -925,8 +927,12 @@ func TestBuildBlindedPathWithDummyHops(t *testing.T) {
 	// functioning paths even if some routes cant be used to build a blinded
 	// path. We do this by forcing FetchChannelEdgesByID to error out for
 	// the first 2 calls. FindRoutes returns 3 routes and so by the end, we
-	// still get 1 valid path.
-	var errCount int
+	// still get 1 valid path. Only the route of that path is reported as
+	// used.
+	var (
+		errCount   int
+		usedRoutes []*route.Route
+	)
 	paths, err = BuildBlindedPaymentPaths(&BuildBlindedPathCfg{
 		FindRoutes: func(_ lnwire.MilliSatoshi) ([]*route.Route,
 			error) {
@@ -986,9 +992,13 @@ func TestBuildBlindedPathWithDummyHops(t *testing.T) {
 			MinHTLCMsat:     1000,
 			MaxHTLCMsat:     lnwire.MaxMilliSatoshi,
 		},
+		OnRouteUsed: func(r *route.Route) {
+			usedRoutes = append(usedRoutes, r)
+		},
 	})
 	require.NoError(t, err)
 	require.Len(t, paths, 1)
+	require.Equal(t, []*route.Route{realRoute}, usedRoutes)
 }
 
 // TestSingleHopBlindedPath tests that blinded path construction is done
@@ -1037,6 +1047,124 @@ func TestSingleHopBlindedPath(t *testing.T) {
 	require.EqualValues(t, 12, path.CltvExpiryDelta)
 }
 
+// TestBuildBlindedPathPrivateChannelAlias tests that a blinded path entering
+// our node through a private option-scid-alias channel instructs the peer to
+// forward over the alias of the channel.
+func TestBuildBlindedPathPrivateChannelAlias(t *testing.T) {
+	// Alice chooses the following path to herself for blinded path
+	// construction, where the channel between Bob and Alice is a private
+	// channel that negotiated the option-scid-alias feature:
+	//    	Carol -> Bob -> Alice.
+	var (
+		privC, pkC = btcec.PrivKeyFromBytes([]byte{1})
+		privB, pkB = btcec.PrivKeyFromBytes([]byte{2})
+		_, pkA     = btcec.PrivKeyFromBytes([]byte{3})
+
+		carol = route.NewVertex(pkC)
+		bob   = route.NewVertex(pkB)
+		alice = route.NewVertex(pkA)
+
+		chanCB = uint64(1)
+		chanBA = uint64(2)
+
+		fundingPoint = wire.OutPoint{Index: 2}
+		peerAlias    = lnwire.NewShortChanIDFromInt(16_000_000 << 40)
+	)
+
+	realRoute := &route.Route{
+		SourcePubKey: carol,
+		Hops: []*route.Hop{
+			{
+				PubKeyBytes: bob,
+				ChannelID:   chanCB,
+			},
+			{
+				PubKeyBytes: alice,
+				ChannelID:   chanBA,
+			},
+		},
+	}
+
+	realPolicies := map[uint64]*models.ChannelEdgePolicy{
+		chanCB: {
+			ChannelID: chanCB,
+			ToNode:    bob,
+		},
+		chanBA: {
+			ChannelID: chanBA,
+			ToNode:    alice,
+		},
+	}
+
+	ourChannels := []*channeldb.OpenChannel{{
+		ChanType:        channeldb.ScidAliasFeatureBit,
+		FundingOutpoint: fundingPoint,
+		ShortChannelID:  lnwire.NewShortChanIDFromInt(chanBA),
+	}}
+
+	paths, err := BuildBlindedPaymentPaths(&BuildBlindedPathCfg{
+		FindRoutes: func(_ lnwire.MilliSatoshi) ([]*route.Route,
+			error) {
+
+			return []*route.Route{realRoute}, nil
+		},
+		FetchChannelEdgesByID: func(chanID uint64) (
+			*models.ChannelEdgeInfo, *models.ChannelEdgePolicy,
+			*models.ChannelEdgePolicy, error) {
+
+			return nil, realPolicies[chanID], nil, nil
+		},
+		FetchOurOpenChannels: func() ([]*channeldb.OpenChannel,
+			error) {
+
+			return ourChannels, nil
+		},
+		GetAlias: func(chanID lnwire.ChannelID) (lnwire.ShortChannelID,
+			error) {
+
+			require.Equal(
+				t, lnwire.NewChanIDFromOutPoint(fundingPoint),
+				chanID,
+			)
+
+			return peerAlias, nil
+		},
+		BestHeight: func() (uint32, error) {
+			return 1000, nil
+		},
+		AddPolicyBuffer: func(policy *BlindedHopPolicy) (
+			*BlindedHopPolicy, error) {
+
+			return policy, nil
+		},
+		PathID:                  []byte{1, 2, 3},
+		ValueMsat:               1000,
+		MinFinalCLTVExpiryDelta: 12,
+		BlocksUntilExpiry:       200,
+	})
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+
+	path := paths[0]
+	require.Len(t, path.Hops, 3)
+
+	// Carol forwards over the public channel to Bob.
+	data, blindingPoint := decryptAndDecodeHopData(
+		t, privC, path.FirstEphemeralBlindingPoint,
+		path.Hops[0].CipherText,
+	)
+	require.Equal(
+		t, lnwire.NewShortChanIDFromInt(chanCB),
+		data.ShortChannelID.UnwrapOrFail(t).Val,
+	)
+
+	// Bob forwards over the alias of the private channel to Alice.
+	data, _ = decryptAndDecodeHopData(
+		t, privB, blindingPoint, path.Hops[1].CipherText,
+	)
+	require.Equal(t, peerAlias, data.ShortChannelID.UnwrapOrFail(t).Val)
+}
+
 func decryptAndDecodeHopData(t *testing.T, priv *btcec.PrivateKey,
 	ephem *btcec.PublicKey, cipherText []byte) (*record.BlindedRouteData,
 	*btcec.PublicKey) {
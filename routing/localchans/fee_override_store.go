@@ -0,0 +1,416 @@
+package localchans
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// feeOverridesBucket is the top-level bucket that stores the overrides
+	// of the dynamic fee policy.
+	feeOverridesBucket = []byte("fee-policy-overrides")
+
+	// chanOverridesBucket is the sub-bucket of feeOverridesBucket that
+	// stores the per-channel overrides.
+	//
+	// maps: chanPoint -> FeeOverride
+	chanOverridesBucket = []byte("chan")
+
+	// peerOverridesBucket is the sub-bucket of feeOverridesBucket that
+	// stores the per-peer overrides.
+	//
+	// maps: pubKey -> FeeOverride
+	peerOverridesBucket = []byte("peer")
+)
+
+// FeeOverrideStore persists the per-channel and per-peer overrides of the
+// dynamic fee policy.
+type FeeOverrideStore interface {
+	// PutChanOverride adds or replaces the override of a channel.
+	PutChanOverride(chanPoint wire.OutPoint, o *FeeOverride) error
+
+	// DeleteChanOverride deletes the override of a channel.
+	DeleteChanOverride(chanPoint wire.OutPoint) error
+
+	// PutPeerOverride adds or replaces the override of a peer.
+	PutPeerOverride(peer route.Vertex, o *FeeOverride) error
+
+	// DeletePeerOverride deletes the override of a peer.
+	DeletePeerOverride(peer route.Vertex) error
+
+	// FetchOverrides returns all per-channel and per-peer overrides.
+	FetchOverrides() (map[wire.OutPoint]FeeOverride,
+		map[route.Vertex]FeeOverride, error)
+}
+
+// kvFeeOverrideStore is a FeeOverrideStore backed by a kvdb backend.
+type kvFeeOverrideStore struct {
+	db kvdb.Backend
+}
+
+// A compile-time assertion to ensure that kvFeeOverrideStore implements the
+// FeeOverrideStore interface.
+var _ FeeOverrideStore = (*kvFeeOverrideStore)(nil)
+
+// NewFeeOverrideStore creates a new store for fee policy overrides, creating
+// its buckets if needed.
+func NewFeeOverrideStore(db kvdb.Backend) (FeeOverrideStore, error) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		bucket, err := tx.CreateTopLevelBucket(feeOverridesBucket)
+		if err != nil {
+			return err
+		}
+
+		_, err = bucket.CreateBucketIfNotExists(chanOverridesBucket)
+		if err != nil {
+			return err
+		}
+
+		_, err = bucket.CreateBucketIfNotExists(peerOverridesBucket)
+
+		return err
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &kvFeeOverrideStore{db: db}, nil
+}
+
+// put stores the override under the given key of the given sub-bucket.
+func (s *kvFeeOverrideStore) put(bucketKey, key []byte,
+	o *FeeOverride) error {
+
+	value, err := serializeFeeOverride(o)
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(feeOverridesBucket).
+			NestedReadWriteBucket(bucketKey)
+
+		return bucket.Put(key, value)
+	}, func() {})
+}
+
+// delete deletes the override stored under the given key of the given
+// sub-bucket. Deleting a non-existent override is a no-op.
+func (s *kvFeeOverrideStore) delete(bucketKey, key []byte) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(feeOverridesBucket).
+			NestedReadWriteBucket(bucketKey)
+
+		return bucket.Delete(key)
+	}, func() {})
+}
+
+// PutChanOverride adds or replaces the override of a channel.
+func (s *kvFeeOverrideStore) PutChanOverride(chanPoint wire.OutPoint,
+	o *FeeOverride) error {
+
+	var key bytes.Buffer
+	if err := lnwire.WriteOutPoint(&key, chanPoint); err != nil {
+		return err
+	}
+
+	return s.put(chanOverridesBucket, key.Bytes(), o)
+}
+
+// DeleteChanOverride deletes the override of a channel.
+func (s *kvFeeOverrideStore) DeleteChanOverride(chanPoint wire.OutPoint) error {
+	var key bytes.Buffer
+	if err := lnwire.WriteOutPoint(&key, chanPoint); err != nil {
+		return err
+	}
+
+	return s.delete(chanOverridesBucket, key.Bytes())
+}
+
+// PutPeerOverride adds or replaces the override of a peer.
+func (s *kvFeeOverrideStore) PutPeerOverride(peer route.Vertex,
+	o *FeeOverride) error {
+
+	return s.put(peerOverridesBucket, peer[:], o)
+}
+
+// DeletePeerOverride deletes the override of a peer.
+func (s *kvFeeOverrideStore) DeletePeerOverride(peer route.Vertex) error {
+	return s.delete(peerOverridesBucket, peer[:])
+}
+
+// FetchOverrides returns all per-channel and per-peer overrides.
+func (s *kvFeeOverrideStore) FetchOverrides() (map[wire.OutPoint]FeeOverride,
+	map[route.Vertex]FeeOverride, error) {
+
+	var (
+		chanOverrides map[wire.OutPoint]FeeOverride
+		peerOverrides map[route.Vertex]FeeOverride
+	)
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(feeOverridesBucket)
+
+		chanBucket := bucket.NestedReadBucket(chanOverridesBucket)
+		err := chanBucket.ForEach(func(k, v []byte) error {
+			var chanPoint wire.OutPoint
+			err := lnwire.ReadElement(
+				bytes.NewReader(k), &chanPoint,
+			)
+			if err != nil {
+				return err
+			}
+
+			o, err := deserializeFeeOverride(v)
+			if err != nil {
+				return err
+			}
+			chanOverrides[chanPoint] = *o
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		peerBucket := bucket.NestedReadBucket(peerOverridesBucket)
+
+		return peerBucket.ForEach(func(k, v []byte) error {
+			peer, err := route.NewVertexFromBytes(k)
+			if err != nil {
+				return err
+			}
+
+			o, err := deserializeFeeOverride(v)
+			if err != nil {
+				return err
+			}
+			peerOverrides[peer] = *o
+
+			return nil
+		})
+	}, func() {
+		chanOverrides = make(map[wire.OutPoint]FeeOverride)
+		peerOverrides = make(map[route.Vertex]FeeOverride)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return chanOverrides, peerOverrides, nil
+}
+
+// feeOverrideRecord is the serialized representation of a FeeOverride.
+type feeOverrideRecord struct {
+	exclude uint8
+	params  []byte
+}
+
+// toTlvStream converts a feeOverrideRecord into a tlv representation.
+func (r *feeOverrideRecord) toTlvStream() (*tlv.Stream, error) {
+	const (
+		// A set of tlv type definitions used to serialize an override.
+		excludeType tlv.Type = 0
+		paramsType  tlv.Type = 1
+	)
+
+	return tlv.NewStream(
+		tlv.MakePrimitiveRecord(excludeType, &r.exclude),
+		tlv.MakePrimitiveRecord(paramsType, &r.params),
+	)
+}
+
+// feeParamsRecord is the serialized representation of FeePolicyParams.
+type feeParamsRecord struct {
+	baseFee           uint64
+	feeRateCurve      []byte
+	inboundBaseFee    uint32
+	inboundCurve      []byte
+	volumeWindow      uint64
+	volumeSensitivity uint64
+	maxFeeRate        uint32
+}
+
+// toTlvStream converts a feeParamsRecord into a tlv representation.
+func (r *feeParamsRecord) toTlvStream() (*tlv.Stream, error) {
+	const (
+		// A set of tlv type definitions used to serialize fee policy
+		// params.
+		baseFeeType           tlv.Type = 0
+		feeRateCurveType      tlv.Type = 1
+		inboundBaseFeeType    tlv.Type = 2
+		inboundCurveType      tlv.Type = 3
+		volumeWindowType      tlv.Type = 4
+		volumeSensitivityType tlv.Type = 5
+		maxFeeRateType        tlv.Type = 6
+	)
+
+	return tlv.NewStream(
+		tlv.MakePrimitiveRecord(baseFeeType, &r.baseFee),
+		tlv.MakePrimitiveRecord(feeRateCurveType, &r.feeRateCurve),
+		tlv.MakePrimitiveRecord(inboundBaseFeeType, &r.inboundBaseFee),
+		tlv.MakePrimitiveRecord(inboundCurveType, &r.inboundCurve),
+		tlv.MakePrimitiveRecord(volumeWindowType, &r.volumeWindow),
+		tlv.MakePrimitiveRecord(
+			volumeSensitivityType, &r.volumeSensitivity,
+		),
+		tlv.MakePrimitiveRecord(maxFeeRateType, &r.maxFeeRate),
+	)
+}
+
+// feeCurvePointSize is the serialized size of a single fee curve point: the
+// bits of its local ratio followed by its fee rate.
+const feeCurvePointSize = 16
+
+// serializeFeeCurve serializes the points of a fee curve.
+func serializeFeeCurve(c FeeCurve) []byte {
+	b := make([]byte, 0, len(c)*feeCurvePointSize)
+	for _, p := range c {
+		b = binary.BigEndian.AppendUint64(
+			b, math.Float64bits(p.LocalRatio),
+		)
+		b = binary.BigEndian.AppendUint64(b, uint64(p.FeeRate))
+	}
+
+	return b
+}
+
+// deserializeFeeCurve deserializes the points of a fee curve.
+func deserializeFeeCurve(b []byte) (FeeCurve, error) {
+	if len(b)%feeCurvePointSize != 0 {
+		return nil, fmt.Errorf("invalid fee curve length %d", len(b))
+	}
+
+	var c FeeCurve
+	for ; len(b) != 0; b = b[feeCurvePointSize:] {
+		c = append(c, FeeCurvePoint{
+			LocalRatio: math.Float64frombits(
+				binary.BigEndian.Uint64(b[:8]),
+			),
+			FeeRate: int64(binary.BigEndian.Uint64(b[8:16])),
+		})
+	}
+
+	return c, nil
+}
+
+// serializeFeeParams serializes FeePolicyParams based on tlv format.
+func serializeFeeParams(p *FeePolicyParams) ([]byte, error) {
+	r := &feeParamsRecord{
+		baseFee:           uint64(p.BaseFee),
+		feeRateCurve:      serializeFeeCurve(p.FeeRateCurve),
+		inboundBaseFee:    uint32(p.InboundBaseFee),
+		inboundCurve:      serializeFeeCurve(p.InboundFeeRateCurve),
+		volumeWindow:      uint64(p.VolumeWindow),
+		volumeSensitivity: math.Float64bits(p.VolumeSensitivity),
+		maxFeeRate:        p.MaxFeeRate,
+	}
+
+	tlvStream, err := r.toTlvStream()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tlvStream.Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// deserializeFeeParams deserializes FeePolicyParams based on tlv format.
+func deserializeFeeParams(b []byte) (*FeePolicyParams, error) {
+	var r feeParamsRecord
+	tlvStream, err := r.toTlvStream()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Decode(bytes.NewReader(b)); err != nil {
+		return nil, err
+	}
+
+	feeRateCurve, err := deserializeFeeCurve(r.feeRateCurve)
+	if err != nil {
+		return nil, err
+	}
+
+	inboundCurve, err := deserializeFeeCurve(r.inboundCurve)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FeePolicyParams{
+		BaseFee:             lnwire.MilliSatoshi(r.baseFee),
+		FeeRateCurve:        feeRateCurve,
+		InboundBaseFee:      int32(r.inboundBaseFee),
+		InboundFeeRateCurve: inboundCurve,
+		VolumeWindow:        time.Duration(r.volumeWindow),
+		VolumeSensitivity:   math.Float64frombits(r.volumeSensitivity),
+		MaxFeeRate:          r.maxFeeRate,
+	}, nil
+}
+
+// serializeFeeOverride serializes a FeeOverride based on tlv format.
+func serializeFeeOverride(o *FeeOverride) ([]byte, error) {
+	var r feeOverrideRecord
+	if o.Exclude {
+		r.exclude = 1
+	}
+
+	err := fn.MapOptionZ(o.Params, func(p FeePolicyParams) error {
+		var err error
+		r.params, err = serializeFeeParams(&p)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tlvStream, err := r.toTlvStream()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tlvStream.Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// deserializeFeeOverride deserializes a FeeOverride based on tlv format.
+func deserializeFeeOverride(b []byte) (*FeeOverride, error) {
+	var r feeOverrideRecord
+	tlvStream, err := r.toTlvStream()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Decode(bytes.NewReader(b)); err != nil {
+		return nil, err
+	}
+
+	o := &FeeOverride{Exclude: r.exclude != 0}
+	if len(r.params) != 0 {
+		params, err := deserializeFeeParams(r.params)
+		if err != nil {
+			return nil, err
+		}
+		o.Params = fn.Some(*params)
+	}
+
+	return o, nil
+}
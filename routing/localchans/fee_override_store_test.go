@@ -0,0 +1,85 @@
+package localchans
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFeeOverrideStore tests storing, replacing and deleting per-channel and
+// per-peer overrides, and that they survive recreating the store.
+func TestFeeOverrideStore(t *testing.T) {
+	t.Parallel()
+
+	cdb, err := channeldb.MakeTestDB(t)
+	require.NoError(t, err)
+
+	store, err := NewFeeOverrideStore(cdb)
+	require.NoError(t, err)
+
+	chanOverrides, peerOverrides, err := store.FetchOverrides()
+	require.NoError(t, err)
+	require.Empty(t, chanOverrides)
+	require.Empty(t, peerOverrides)
+
+	chanPoint1 := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1}
+	chanPoint2 := wire.OutPoint{Hash: chainhash.Hash{2}}
+	peer := route.Vertex{3}
+
+	params := FeePolicyParams{
+		BaseFee: 1000,
+		FeeRateCurve: FeeCurve{
+			{LocalRatio: 0, FeeRate: 2000},
+			{LocalRatio: 0.25, FeeRate: 500},
+		},
+		InboundBaseFee: -10,
+		InboundFeeRateCurve: FeeCurve{
+			{LocalRatio: 0.5, FeeRate: -100},
+		},
+		VolumeWindow:      time.Hour,
+		VolumeSensitivity: 0.75,
+		MaxFeeRate:        3000,
+	}
+	excluded := FeeOverride{Exclude: true}
+	custom := FeeOverride{Params: fn.Some(params)}
+
+	require.NoError(t, store.PutChanOverride(chanPoint1, &excluded))
+	require.NoError(t, store.PutChanOverride(chanPoint2, &excluded))
+	require.NoError(t, store.PutPeerOverride(peer, &excluded))
+
+	// A stored override is replaced by the next one.
+	require.NoError(t, store.PutChanOverride(chanPoint2, &custom))
+	require.NoError(t, store.PutPeerOverride(peer, &custom))
+
+	// The overrides survive recreating the store.
+	store, err = NewFeeOverrideStore(cdb)
+	require.NoError(t, err)
+
+	chanOverrides, peerOverrides, err = store.FetchOverrides()
+	require.NoError(t, err)
+	require.Equal(t, map[wire.OutPoint]FeeOverride{
+		chanPoint1: excluded,
+		chanPoint2: custom,
+	}, chanOverrides)
+	require.Equal(t, map[route.Vertex]FeeOverride{
+		peer: custom,
+	}, peerOverrides)
+
+	// Deleting an override leaves the others untouched.
+	require.NoError(t, store.DeleteChanOverride(chanPoint1))
+	require.NoError(t, store.DeleteChanOverride(chanPoint1))
+	require.NoError(t, store.DeletePeerOverride(peer))
+
+	chanOverrides, peerOverrides, err = store.FetchOverrides()
+	require.NoError(t, err)
+	require.Equal(t, map[wire.OutPoint]FeeOverride{
+		chanPoint2: custom,
+	}, chanOverrides)
+	require.Empty(t, peerOverrides)
+}
@@ -0,0 +1,873 @@
+package localchans
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+var (
+	// ErrEmptyFeeCurve is returned when a fee curve without any points is
+	// used.
+	ErrEmptyFeeCurve = errors.New("fee curve must contain at least one " +
+		"point")
+
+	// ErrFeePolicyEngineStopped is returned when a recomputation is
+	// requested after the engine has been stopped.
+	ErrFeePolicyEngineStopped = errors.New("fee policy engine stopped")
+)
+
+// FeeCurvePoint is a single point of a fee curve, mapping a local balance
+// ratio to a proportional fee rate.
+type FeeCurvePoint struct {
+	// LocalRatio is the share of the channel capacity that is on our side
+	// of the channel, in the range [0, 1].
+	LocalRatio float64
+
+	// FeeRate is the proportional fee rate in parts per million that
+	// should be charged at the given local ratio. This value may be
+	// negative for inbound fee curves, which allows discounts to be given
+	// for forwards that increase our local balance.
+	FeeRate int64
+}
+
+// FeeCurve is a piecewise linear function that maps the local balance ratio
+// of a channel to a fee rate. The points of the curve must be sorted by their
+// local ratio in strictly increasing order. Ratios below the first or above
+// the last point are clamped to the fee rate of the respective point.
+type FeeCurve []FeeCurvePoint
+
+// ParseFeeCurve parses a fee curve from its string representation, which is a
+// comma separated list of ratio:ppm pairs, e.g. "0:2000,0.5:500,1:50".
+func ParseFeeCurve(s string) (FeeCurve, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+
+	var curve FeeCurve
+	for _, pair := range strings.Split(s, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid fee curve point %q, "+
+				"expected ratio:ppm", pair)
+		}
+
+		ratio, err := strconv.ParseFloat(parts[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid local ratio %q: %w",
+				parts[0], err)
+		}
+
+		feeRate, err := strconv.ParseInt(parts[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fee rate %q: %w",
+				parts[1], err)
+		}
+
+		curve = append(curve, FeeCurvePoint{
+			LocalRatio: ratio,
+			FeeRate:    feeRate,
+		})
+	}
+
+	if err := curve.Validate(); err != nil {
+		return nil, err
+	}
+
+	return curve, nil
+}
+
+// String returns the string representation of the curve, in the same format
+// accepted by ParseFeeCurve.
+func (c FeeCurve) String() string {
+	pairs := make([]string, 0, len(c))
+	for _, p := range c {
+		pairs = append(pairs, fmt.Sprintf(
+			"%v:%d", strconv.FormatFloat(p.LocalRatio, 'f', -1, 64),
+			p.FeeRate,
+		))
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+// Validate checks that the curve is non-empty, that all ratios are within
+// [0, 1] and that the points are sorted by strictly increasing ratio.
+func (c FeeCurve) Validate() error {
+	if len(c) == 0 {
+		return ErrEmptyFeeCurve
+	}
+
+	for i, p := range c {
+		if p.LocalRatio < 0 || p.LocalRatio > 1 ||
+			math.IsNaN(p.LocalRatio) {
+
+			return fmt.Errorf("local ratio %v of fee curve point "+
+				"%d must be in the range [0, 1]", p.LocalRatio,
+				i)
+		}
+
+		if p.FeeRate > math.MaxInt32 || p.FeeRate < math.MinInt32 {
+			return fmt.Errorf("fee rate %v of fee curve point %d "+
+				"out of range", p.FeeRate, i)
+		}
+
+		if i > 0 && p.LocalRatio <= c[i-1].LocalRatio {
+			return fmt.Errorf("fee curve points must be sorted by "+
+				"strictly increasing local ratio, point %d "+
+				"has ratio %v after %v", i, p.LocalRatio,
+				c[i-1].LocalRatio)
+		}
+	}
+
+	return nil
+}
+
+// Evaluate returns the fee rate of the curve at the given local ratio by
+// linearly interpolating between the two surrounding points.
+func (c FeeCurve) Evaluate(ratio float64) float64 {
+	if len(c) == 0 {
+		return 0
+	}
+
+	if ratio <= c[0].LocalRatio {
+		return float64(c[0].FeeRate)
+	}
+
+	last := c[len(c)-1]
+	if ratio >= last.LocalRatio {
+		return float64(last.FeeRate)
+	}
+
+	// Find the first point with a ratio larger than the target, which is
+	// guaranteed to exist and to not be the first point given the checks
+	// above.
+	i := sort.Search(len(c), func(i int) bool {
+		return c[i].LocalRatio > ratio
+	})
+	lower, upper := c[i-1], c[i]
+
+	frac := (ratio - lower.LocalRatio) /
+		(upper.LocalRatio - lower.LocalRatio)
+
+	return float64(lower.FeeRate) +
+		frac*float64(upper.FeeRate-lower.FeeRate)
+}
+
+// hasPositive returns true if any point of the curve has a positive fee rate.
+func (c FeeCurve) hasPositive() bool {
+	for _, p := range c {
+		if p.FeeRate > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FeePolicyParams are the parameters the fee policy engine uses to derive the
+// forwarding fees of a channel.
+type FeePolicyParams struct {
+	// BaseFee is the outgoing base fee set on every channel.
+	BaseFee lnwire.MilliSatoshi
+
+	// FeeRateCurve maps the local balance ratio of a channel to its
+	// outgoing proportional fee rate.
+	FeeRateCurve FeeCurve
+
+	// InboundBaseFee is the inbound base fee that is set together with the
+	// inbound fee rate. It is only used if InboundFeeRateCurve is set.
+	InboundBaseFee int32
+
+	// InboundFeeRateCurve maps the local balance ratio of a channel to its
+	// inbound proportional fee rate. If empty, the inbound fee of the
+	// channel is left untouched.
+	InboundFeeRateCurve FeeCurve
+
+	// VolumeWindow is the time window of the forwarding log that is taken
+	// into account when determining the outgoing volume of a channel.
+	VolumeWindow time.Duration
+
+	// VolumeSensitivity is the relative increase of the outgoing fee rate
+	// for a channel that forwarded its full capacity within the volume
+	// window. A value of 0.5 means that such a channel charges 50% more
+	// than the curve suggests. Channels with lower volume are scaled
+	// linearly. A value of zero disables volume based adjustments.
+	VolumeSensitivity float64
+
+	// MaxFeeRate caps the outgoing fee rate after all adjustments have
+	// been applied. A value of zero means no cap.
+	MaxFeeRate uint32
+}
+
+// Validate checks that the params are sane. If positive inbound fees are not
+// accepted, the inbound curve must not contain positive fee rates.
+func (p *FeePolicyParams) Validate(acceptPositiveInbound bool) error {
+	if err := p.FeeRateCurve.Validate(); err != nil {
+		return fmt.Errorf("invalid fee rate curve: %w", err)
+	}
+
+	for _, point := range p.FeeRateCurve {
+		if point.FeeRate < 0 {
+			return fmt.Errorf("outgoing fee rate curve must not " +
+				"contain negative fee rates")
+		}
+	}
+
+	if len(p.InboundFeeRateCurve) != 0 {
+		err := p.InboundFeeRateCurve.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid inbound fee rate curve: %w",
+				err)
+		}
+
+		if !acceptPositiveInbound &&
+			(p.InboundFeeRateCurve.hasPositive() ||
+				p.InboundBaseFee > 0) {
+
+			return fmt.Errorf("positive inbound fees are not " +
+				"supported")
+		}
+	}
+
+	if p.VolumeWindow < 0 {
+		return fmt.Errorf("volume window must not be negative")
+	}
+
+	if p.VolumeSensitivity < 0 || math.IsNaN(p.VolumeSensitivity) {
+		return fmt.Errorf("volume sensitivity must not be negative")
+	}
+
+	return nil
+}
+
+// FeeOverride is a per-channel or per-peer override of the engine-wide fee
+// policy params. The override of a channel takes precedence over the one of
+// its peer.
+type FeeOverride struct {
+	// Exclude indicates that the engine must not touch the fees of the
+	// channel at all.
+	Exclude bool
+
+	// Params, if set, is used instead of the engine-wide params for the
+	// channel. A flat curve with a single point can be used to pin a
+	// channel to a fixed fee rate.
+	Params fn.Option[FeePolicyParams]
+}
+
+// FeeRecommendation is the result of a fee recomputation for a single
+// channel.
+type FeeRecommendation struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// ChannelID is the short channel ID of the channel.
+	ChannelID uint64
+
+	// LocalRatio is the share of the channel capacity on our side.
+	LocalRatio float64
+
+	// ForwardedVolume is the outgoing volume of the channel within the
+	// volume window.
+	ForwardedVolume lnwire.MilliSatoshi
+
+	// Current is the fee schema the channel currently advertises.
+	Current routing.FeeSchema
+
+	// Recommended is the fee schema the engine derived for the channel.
+	Recommended routing.FeeSchema
+
+	// Overridden is true if a per-channel or per-peer override was used
+	// to derive the recommendation.
+	Overridden bool
+
+	// Applied is true if the recommended fee schema was applied to the
+	// channel.
+	Applied bool
+
+	// Failure holds the reason the recommendation couldn't be applied, if
+	// any.
+	Failure string
+}
+
+// Changed returns true if the recommended fee schema differs from the
+// current one.
+func (f *FeeRecommendation) Changed() bool {
+	if f.Current.BaseFee != f.Recommended.BaseFee ||
+		f.Current.FeeRate != f.Recommended.FeeRate {
+
+		return true
+	}
+
+	// A recommendation without inbound fee leaves the current inbound fee
+	// untouched.
+	return fn.MapOptionZ(f.Recommended.InboundFee,
+		func(rec models.InboundFee) bool {
+			cur := f.Current.InboundFee.UnwrapOr(
+				models.InboundFee{},
+			)
+
+			return cur != rec
+		},
+	)
+}
+
+// FeePolicyConfig holds the configuration and dependencies of the fee policy
+// engine.
+type FeePolicyConfig struct {
+	// Params are the initial engine-wide fee policy params.
+	Params FeePolicyParams
+
+	// Store persists the per-channel and per-peer overrides.
+	Store FeeOverrideStore
+
+	// Interval is the interval at which fees are recomputed.
+	Interval time.Duration
+
+	// Active indicates whether fees should be recomputed periodically. If
+	// false, recomputation only happens on request.
+	Active bool
+
+	// DryRun indicates whether the periodic recomputation should only log
+	// its recommendations instead of applying them.
+	DryRun bool
+
+	// AcceptPositiveInboundFees indicates whether positive inbound fees
+	// may be set.
+	AcceptPositiveInboundFees bool
+
+	// ForAllOutgoingChannels is used to iterate over all our local
+	// channels. The ChannelEdgePolicy parameter may be nil.
+	ForAllOutgoingChannels func(ctx context.Context,
+		cb func(*models.ChannelEdgeInfo,
+			*models.ChannelEdgePolicy) error, reset func()) error
+
+	// FetchChannel is used to query the current balance of a channel.
+	FetchChannel func(chanPoint wire.OutPoint) (*channeldb.OpenChannel,
+		error)
+
+	// ForwardingVolume returns the total outgoing amount forwarded over
+	// each channel within the given time range, keyed by short channel
+	// ID.
+	ForwardingVolume func(start, end time.Time) (
+		map[lnwire.ShortChannelID]lnwire.MilliSatoshi, error)
+
+	// UpdatePolicy applies the given policy to the given channel.
+	UpdatePolicy func(ctx context.Context, policy routing.ChannelPolicy,
+		chanPoint wire.OutPoint) ([]*lnrpc.FailedUpdate, error)
+
+	// Clock is the clock used to determine the volume window.
+	Clock clock.Clock
+}
+
+// FeePolicyEngine periodically recomputes the forwarding fees of all local
+// channels from their local balance ratio and their recent forwarding volume.
+type FeePolicyEngine struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *FeePolicyConfig
+
+	// mu guards the fields below.
+	mu sync.Mutex
+
+	params        FeePolicyParams
+	dryRun        bool
+	overrides     map[wire.OutPoint]FeeOverride
+	peerOverrides map[route.Vertex]FeeOverride
+
+	lastRun             time.Time
+	lastRecommendations []FeeRecommendation
+
+	// runLock ensures that only a single recomputation runs at a time.
+	runLock sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewFeePolicyEngine creates a new fee policy engine, loading the persisted
+// overrides.
+func NewFeePolicyEngine(cfg *FeePolicyConfig) (*FeePolicyEngine, error) {
+	err := cfg.Params.Validate(cfg.AcceptPositiveInboundFees)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Active && cfg.Interval <= 0 {
+		return nil, fmt.Errorf("fee policy interval must be positive")
+	}
+
+	overrides, peerOverrides, err := cfg.Store.FetchOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch fee policy "+
+			"overrides: %w", err)
+	}
+
+	log.Debugf("Loaded fee policy overrides of %d channels and %d peers",
+		len(overrides), len(peerOverrides))
+
+	return &FeePolicyEngine{
+		cfg:           cfg,
+		params:        cfg.Params,
+		dryRun:        cfg.DryRun,
+		overrides:     overrides,
+		peerOverrides: peerOverrides,
+		quit:          make(chan struct{}),
+	}, nil
+}
+
+// Start launches the periodic recomputation if the engine is active.
+func (e *FeePolicyEngine) Start() error {
+	e.started.Do(func() {
+		if !e.cfg.Active {
+			log.Debugf("Fee policy engine inactive")
+			return
+		}
+
+		log.Infof("Fee policy engine starting, interval=%v, "+
+			"dry_run=%v", e.cfg.Interval, e.cfg.DryRun)
+
+		e.wg.Add(1)
+		go e.run(ticker.New(e.cfg.Interval))
+	})
+
+	return nil
+}
+
+// Stop stops the periodic recomputation.
+func (e *FeePolicyEngine) Stop() error {
+	e.stopped.Do(func() {
+		log.Debugf("Fee policy engine stopping")
+
+		close(e.quit)
+		e.wg.Wait()
+	})
+
+	return nil
+}
+
+// run recomputes the fees of all channels each time the ticker fires.
+//
+// NOTE: This MUST be run as a goroutine.
+func (e *FeePolicyEngine) run(t ticker.Ticker) {
+	defer e.wg.Done()
+
+	t.Resume()
+	defer t.Stop()
+
+	// Make sure a recomputation in progress is canceled on shutdown.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-e.quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		select {
+		case <-t.Ticks():
+			e.mu.Lock()
+			dryRun := e.dryRun
+			e.mu.Unlock()
+
+			_, err := e.Recompute(ctx, dryRun)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				log.Errorf("Unable to recompute channel fees: "+
+					"%v", err)
+			}
+
+		case <-e.quit:
+			return
+		}
+	}
+}
+
+// Params returns the current engine-wide params and whether periodic runs
+// are in dry-run mode.
+func (e *FeePolicyEngine) Params() (FeePolicyParams, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.params, e.dryRun
+}
+
+// SetParams replaces the engine-wide params and dry-run mode used by
+// subsequent recomputations.
+func (e *FeePolicyEngine) SetParams(params FeePolicyParams,
+	dryRun bool) error {
+
+	err := params.Validate(e.cfg.AcceptPositiveInboundFees)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.params = params
+	e.dryRun = dryRun
+
+	return nil
+}
+
+// Active returns whether fees are recomputed periodically and the interval at
+// which that happens.
+func (e *FeePolicyEngine) Active() (bool, time.Duration) {
+	return e.cfg.Active, e.cfg.Interval
+}
+
+// validateOverride checks the custom params of the given override, if any.
+func (e *FeePolicyEngine) validateOverride(override FeeOverride) error {
+	return fn.MapOptionZ(override.Params, func(p FeePolicyParams) error {
+		return p.Validate(e.cfg.AcceptPositiveInboundFees)
+	})
+}
+
+// SetOverride persists and sets the per-channel override for the given
+// channel, replacing any existing one.
+func (e *FeePolicyEngine) SetOverride(chanPoint wire.OutPoint,
+	override FeeOverride) error {
+
+	if err := e.validateOverride(override); err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	err := e.cfg.Store.PutChanOverride(chanPoint, &override)
+	if err != nil {
+		return err
+	}
+	e.overrides[chanPoint] = override
+
+	return nil
+}
+
+// RemoveOverride removes the per-channel override of the given channel. It
+// returns false if no override existed.
+func (e *FeePolicyEngine) RemoveOverride(chanPoint wire.OutPoint) (bool,
+	error) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if _, ok := e.overrides[chanPoint]; !ok {
+		return false, nil
+	}
+
+	if err := e.cfg.Store.DeleteChanOverride(chanPoint); err != nil {
+		return false, err
+	}
+	delete(e.overrides, chanPoint)
+
+	return true, nil
+}
+
+// SetPeerOverride persists and sets the per-peer override for the given peer,
+// replacing any existing one. It applies to all channels with the peer that
+// don't have a per-channel override.
+func (e *FeePolicyEngine) SetPeerOverride(peer route.Vertex,
+	override FeeOverride) error {
+
+	if err := e.validateOverride(override); err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if err := e.cfg.Store.PutPeerOverride(peer, &override); err != nil {
+		return err
+	}
+	e.peerOverrides[peer] = override
+
+	return nil
+}
+
+// RemovePeerOverride removes the per-peer override of the given peer. It
+// returns false if no override existed.
+func (e *FeePolicyEngine) RemovePeerOverride(peer route.Vertex) (bool,
+	error) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if _, ok := e.peerOverrides[peer]; !ok {
+		return false, nil
+	}
+
+	if err := e.cfg.Store.DeletePeerOverride(peer); err != nil {
+		return false, err
+	}
+	delete(e.peerOverrides, peer)
+
+	return true, nil
+}
+
+// Overrides returns a copy of all per-channel and per-peer overrides.
+func (e *FeePolicyEngine) Overrides() (map[wire.OutPoint]FeeOverride,
+	map[route.Vertex]FeeOverride) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return maps.Clone(e.overrides), maps.Clone(e.peerOverrides)
+}
+
+// LastRecommendations returns the time and results of the last
+// recomputation.
+func (e *FeePolicyEngine) LastRecommendations() (time.Time,
+	[]FeeRecommendation) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.lastRun, e.lastRecommendations
+}
+
+// localChannel is a local channel along with its current outgoing policy.
+type localChannel struct {
+	info *models.ChannelEdgeInfo
+	edge *models.ChannelEdgePolicy
+}
+
+// Recompute derives new fees for all local channels. Unless dryRun is set,
+// every changed fee schema is applied to its channel.
+func (e *FeePolicyEngine) Recompute(ctx context.Context,
+	dryRun bool) ([]FeeRecommendation, error) {
+
+	select {
+	case <-e.quit:
+		return nil, ErrFeePolicyEngineStopped
+	default:
+	}
+
+	e.runLock.Lock()
+	defer e.runLock.Unlock()
+
+	e.mu.Lock()
+	params := e.params
+	overrides := maps.Clone(e.overrides)
+	peerOverrides := maps.Clone(e.peerOverrides)
+	e.mu.Unlock()
+
+	// Collect all channels first, as the policy update must not be
+	// applied while we're still iterating over the graph.
+	var channels []localChannel
+	err := e.cfg.ForAllOutgoingChannels(ctx,
+		func(info *models.ChannelEdgeInfo,
+			edge *models.ChannelEdgePolicy) error {
+
+			if edge == nil {
+				return nil
+			}
+
+			channels = append(channels, localChannel{
+				info: info,
+				edge: edge,
+			})
+
+			return nil
+		},
+		func() {
+			channels = nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch the forwarding volume of each distinct window we need.
+	now := e.cfg.Clock.Now()
+	volumes := make(
+		map[time.Duration]map[lnwire.ShortChannelID]lnwire.MilliSatoshi,
+	)
+	fetchVolume := func(window time.Duration) (
+		map[lnwire.ShortChannelID]lnwire.MilliSatoshi, error) {
+
+		if window == 0 {
+			return nil, nil
+		}
+
+		if volume, ok := volumes[window]; ok {
+			return volume, nil
+		}
+
+		volume, err := e.cfg.ForwardingVolume(now.Add(-window), now)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query forwarding "+
+				"volume: %w", err)
+		}
+		volumes[window] = volume
+
+		return volume, nil
+	}
+
+	recs := make([]FeeRecommendation, 0, len(channels))
+	for _, c := range channels {
+		chanPoint := c.info.ChannelPoint
+		channel, err := e.cfg.FetchChannel(chanPoint)
+		if err != nil {
+			log.Warnf("Unable to fetch channel %v for fee "+
+				"recomputation: %v", chanPoint, err)
+
+			continue
+		}
+
+		// The override of the channel takes precedence over the one
+		// of its peer.
+		override, overridden := overrides[chanPoint]
+		if !overridden {
+			peer := route.NewVertex(channel.IdentityPub)
+			override, overridden = peerOverrides[peer]
+		}
+		if override.Exclude {
+			continue
+		}
+
+		chanParams := override.Params.UnwrapOr(params)
+
+		volume, err := fetchVolume(chanParams.VolumeWindow)
+		if err != nil {
+			return nil, err
+		}
+
+		capacity := lnwire.NewMSatFromSatoshis(channel.Capacity)
+		localBalance := channel.LocalCommitment.LocalBalance
+
+		rec := FeeRecommendation{
+			ChanPoint:       chanPoint,
+			ChannelID:       c.info.ChannelID,
+			ForwardedVolume: volume[channel.ShortChannelID],
+			Current:         currentFeeSchema(c.edge),
+			Overridden:      overridden,
+		}
+		if capacity != 0 {
+			rec.LocalRatio = float64(localBalance) /
+				float64(capacity)
+		}
+		rec.Recommended = chanParams.feeSchema(
+			rec.LocalRatio, rec.ForwardedVolume, capacity,
+		)
+
+		if !dryRun && rec.Changed() {
+			e.apply(ctx, c.edge, &rec)
+		}
+
+		recs = append(recs, rec)
+	}
+
+	e.mu.Lock()
+	e.lastRun = now
+	e.lastRecommendations = recs
+	e.mu.Unlock()
+
+	return recs, nil
+}
+
+// apply applies the recommended fee schema to the channel, keeping all other
+// parameters of its current policy.
+func (e *FeePolicyEngine) apply(ctx context.Context,
+	edge *models.ChannelEdgePolicy, rec *FeeRecommendation) {
+
+	policy := routing.ChannelPolicy{
+		FeeSchema:     rec.Recommended,
+		TimeLockDelta: uint32(edge.TimeLockDelta),
+	}
+
+	failed, err := e.cfg.UpdatePolicy(ctx, policy, rec.ChanPoint)
+	switch {
+	case err != nil:
+		rec.Failure = err.Error()
+
+	case len(failed) != 0:
+		rec.Failure = failed[0].UpdateError
+
+	default:
+		rec.Applied = true
+	}
+
+	if rec.Applied {
+		log.Infof("Updated fees of channel %v: base_fee=%v, "+
+			"fee_rate=%v ppm (local_ratio=%.2f)", rec.ChanPoint,
+			rec.Recommended.BaseFee, rec.Recommended.FeeRate,
+			rec.LocalRatio)
+	} else {
+		log.Warnf("Unable to update fees of channel %v: %v",
+			rec.ChanPoint, rec.Failure)
+	}
+}
+
+// currentFeeSchema extracts the fee schema from the given edge policy.
+func currentFeeSchema(edge *models.ChannelEdgePolicy) routing.FeeSchema {
+	schema := routing.FeeSchema{
+		BaseFee: edge.FeeBaseMSat,
+		FeeRate: uint32(edge.FeeProportionalMillionths),
+	}
+	edge.InboundFee.WhenSome(func(fee lnwire.Fee) {
+		schema.InboundFee = fn.Some(models.NewInboundFeeFromWire(fee))
+	})
+
+	return schema
+}
+
+// feeSchema derives the fee schema for a channel with the given local ratio,
+// outgoing volume and capacity.
+func (p *FeePolicyParams) feeSchema(ratio float64,
+	volume, capacity lnwire.MilliSatoshi) routing.FeeSchema {
+
+	feeRate := p.FeeRateCurve.Evaluate(ratio)
+
+	// Scale the fee rate up with the turnover of the channel, which is
+	// capped at the full capacity.
+	if p.VolumeSensitivity > 0 && capacity != 0 {
+		turnover := math.Min(float64(volume)/float64(capacity), 1)
+		feeRate *= 1 + p.VolumeSensitivity*turnover
+	}
+
+	feeRate = math.Max(math.Round(feeRate), 0)
+	if p.MaxFeeRate != 0 {
+		feeRate = math.Min(feeRate, float64(p.MaxFeeRate))
+	}
+	feeRate = math.Min(feeRate, math.MaxUint32)
+
+	schema := routing.FeeSchema{
+		BaseFee: p.BaseFee,
+		FeeRate: uint32(feeRate),
+	}
+
+	if len(p.InboundFeeRateCurve) != 0 {
+		inboundRate := math.Round(p.InboundFeeRateCurve.Evaluate(ratio))
+		schema.InboundFee = fn.Some(models.InboundFee{
+			Base: p.InboundBaseFee,
+			Rate: int32(inboundRate),
+		})
+	}
+
+	return schema
+}
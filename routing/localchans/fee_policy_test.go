@@ -0,0 +1,372 @@
+package localchans
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/v2"
+	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFeeCurve tests parsing, validation and evaluation of fee curves.
+func TestFeeCurve(t *testing.T) {
+	t.Parallel()
+
+	curve, err := ParseFeeCurve("0:2000, 0.5:500,1:100")
+	require.NoError(t, err)
+	require.Equal(t, "0:2000,0.5:500,1:100", curve.String())
+
+	tests := []struct {
+		ratio   float64
+		feeRate float64
+	}{
+		{ratio: -1, feeRate: 2000},
+		{ratio: 0, feeRate: 2000},
+		{ratio: 0.25, feeRate: 1250},
+		{ratio: 0.5, feeRate: 500},
+		{ratio: 0.75, feeRate: 300},
+		{ratio: 1, feeRate: 100},
+		{ratio: 2, feeRate: 100},
+	}
+	for _, test := range tests {
+		require.InDelta(
+			t, test.feeRate, curve.Evaluate(test.ratio), 1e-9,
+			"ratio %v", test.ratio,
+		)
+	}
+
+	empty, err := ParseFeeCurve("")
+	require.NoError(t, err)
+	require.Empty(t, empty)
+	require.ErrorIs(t, empty.Validate(), ErrEmptyFeeCurve)
+
+	invalid := []string{
+		"0.5",
+		"a:100",
+		"0:a",
+		"1.5:100",
+		"0.5:100,0.5:200",
+		"0.5:100,0.2:200",
+	}
+	for _, s := range invalid {
+		_, err := ParseFeeCurve(s)
+		require.Error(t, err, s)
+	}
+}
+
+// TestFeePolicyParamsValidate tests validation of the fee policy params.
+func TestFeePolicyParamsValidate(t *testing.T) {
+	t.Parallel()
+
+	params := FeePolicyParams{
+		FeeRateCurve:        FeeCurve{{LocalRatio: 0, FeeRate: 100}},
+		InboundFeeRateCurve: FeeCurve{{LocalRatio: 0, FeeRate: 50}},
+	}
+
+	// Positive inbound fees are only allowed if explicitly accepted.
+	require.Error(t, params.Validate(false))
+	require.NoError(t, params.Validate(true))
+
+	params.InboundFeeRateCurve = FeeCurve{{LocalRatio: 0, FeeRate: -50}}
+	require.NoError(t, params.Validate(false))
+
+	params.FeeRateCurve = FeeCurve{{LocalRatio: 0, FeeRate: -1}}
+	require.Error(t, params.Validate(true))
+
+	params.FeeRateCurve = nil
+	require.ErrorIs(t, params.Validate(true), ErrEmptyFeeCurve)
+}
+
+// feePolicyTestChannel is a channel used by the fee policy engine tests.
+type feePolicyTestChannel struct {
+	chanPoint    wire.OutPoint
+	peer         *btcec.PublicKey
+	scid         lnwire.ShortChannelID
+	capacity     btcutil.Amount
+	localBalance lnwire.MilliSatoshi
+	edge         *models.ChannelEdgePolicy
+}
+
+// TestFeePolicyEngineRecompute tests that the fee policy engine derives fees
+// from the local balance ratio and forwarding volume, honors persisted
+// overrides and only applies changed fees outside of dry-run mode.
+func TestFeePolicyEngineRecompute(t *testing.T) {
+	t.Parallel()
+
+	peerPriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	otherPeerPriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	newChannel := func(i byte, localBalance lnwire.MilliSatoshi,
+		baseFee, feeRate lnwire.MilliSatoshi) *feePolicyTestChannel {
+
+		return &feePolicyTestChannel{
+			chanPoint:    wire.OutPoint{Hash: chainhash.Hash{i}},
+			peer:         otherPeerPriv.PubKey(),
+			scid:         lnwire.NewShortChanIDFromInt(uint64(i)),
+			capacity:     1_000_000,
+			localBalance: localBalance,
+			edge: &models.ChannelEdgePolicy{
+				ChannelID:                 uint64(i),
+				TimeLockDelta:             80,
+				FeeBaseMSat:               baseFee,
+				FeeProportionalMillionths: feeRate,
+			},
+		}
+	}
+
+	var (
+		// A depleted channel with high forwarding volume.
+		depleted = newChannel(1, 0, 1000, 100)
+
+		// A balanced channel that already has the expected fee.
+		balanced = newChannel(2, 500_000_000, 1000, 500)
+
+		// A full channel with an override.
+		full = newChannel(3, 1_000_000_000, 1000, 100)
+
+		// An excluded channel.
+		excluded = newChannel(4, 0, 1000, 100)
+
+		// A balanced channel with the peer of the full channel, which
+		// already advertises the expected fees.
+		sibling = newChannel(5, 500_000_000, 1000, 500)
+
+		channels = []*feePolicyTestChannel{
+			depleted, balanced, full, excluded, sibling,
+		}
+	)
+	full.peer = peerPriv.PubKey()
+	sibling.peer = peerPriv.PubKey()
+	sibling.edge.InboundFee = fn.Some(lnwire.Fee{FeeRate: -50})
+	peer := route.NewVertex(peerPriv.PubKey())
+
+	cdb, err := channeldb.MakeTestDB(t)
+	require.NoError(t, err)
+	store, err := NewFeeOverrideStore(cdb)
+	require.NoError(t, err)
+
+	testClock := clock.NewTestClock(time.Unix(1_000_000, 0))
+
+	var (
+		applied     = make(map[wire.OutPoint]routing.ChannelPolicy)
+		volumeStart time.Time
+	)
+	cfg := &FeePolicyConfig{
+		Store: store,
+		Params: FeePolicyParams{
+			BaseFee: 1000,
+			FeeRateCurve: FeeCurve{
+				{LocalRatio: 0, FeeRate: 2000},
+				{LocalRatio: 0.5, FeeRate: 500},
+				{LocalRatio: 1, FeeRate: 100},
+			},
+			InboundFeeRateCurve: FeeCurve{
+				{LocalRatio: 0, FeeRate: 0},
+				{LocalRatio: 1, FeeRate: -100},
+			},
+			VolumeWindow:      24 * time.Hour,
+			VolumeSensitivity: 0.5,
+			MaxFeeRate:        2500,
+		},
+		ForAllOutgoingChannels: func(_ context.Context,
+			cb func(*models.ChannelEdgeInfo,
+				*models.ChannelEdgePolicy) error,
+			reset func()) error {
+
+			reset()
+			for _, c := range channels {
+				err := cb(&models.ChannelEdgeInfo{
+					ChannelID:    c.scid.ToUint64(),
+					ChannelPoint: c.chanPoint,
+				}, c.edge)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		FetchChannel: func(chanPoint wire.OutPoint) (
+			*channeldb.OpenChannel, error) {
+
+			for _, c := range channels {
+				if c.chanPoint != chanPoint {
+					continue
+				}
+
+				commit := channeldb.ChannelCommitment{
+					LocalBalance: c.localBalance,
+				}
+
+				return &channeldb.OpenChannel{
+					IdentityPub:     c.peer,
+					Capacity:        c.capacity,
+					ShortChannelID:  c.scid,
+					LocalCommitment: commit,
+				}, nil
+			}
+
+			return nil, channeldb.ErrChannelNotFound
+		},
+		ForwardingVolume: func(start, end time.Time) (
+			map[lnwire.ShortChannelID]lnwire.MilliSatoshi, error) {
+
+			volumeStart = start
+			require.Equal(t, testClock.Now(), end)
+
+			return map[lnwire.ShortChannelID]lnwire.MilliSatoshi{
+				// Twice the capacity, which is capped.
+				depleted.scid: 2_000_000_000,
+			}, nil
+		},
+		UpdatePolicy: func(_ context.Context,
+			policy routing.ChannelPolicy,
+			chanPoint wire.OutPoint) ([]*lnrpc.FailedUpdate,
+			error) {
+
+			applied[chanPoint] = policy
+
+			return nil, nil
+		},
+		Clock: testClock,
+	}
+
+	engine, err := NewFeePolicyEngine(cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, engine.SetOverride(excluded.chanPoint, FeeOverride{
+		Exclude: true,
+	}))
+	require.NoError(t, engine.SetOverride(full.chanPoint, FeeOverride{
+		Params: fn.Some(FeePolicyParams{
+			BaseFee:      0,
+			FeeRateCurve: FeeCurve{{LocalRatio: 0, FeeRate: 10}},
+		}),
+	}))
+	require.NoError(t, engine.SetPeerOverride(peer, FeeOverride{
+		Params: fn.Some(FeePolicyParams{
+			BaseFee:      0,
+			FeeRateCurve: FeeCurve{{LocalRatio: 0, FeeRate: 20}},
+		}),
+	}))
+
+	// The overrides survive a restart.
+	chanOverrides, peerOverrides := engine.Overrides()
+	engine, err = NewFeePolicyEngine(cfg)
+	require.NoError(t, err)
+	restoredChan, restoredPeer := engine.Overrides()
+	require.Equal(t, chanOverrides, restoredChan)
+	require.Equal(t, peerOverrides, restoredPeer)
+	require.Len(t, restoredChan, 2)
+	require.Len(t, restoredPeer, 1)
+
+	// A dry run shouldn't apply any fees.
+	ctx := t.Context()
+	recs, err := engine.Recompute(ctx, true)
+	require.NoError(t, err)
+	require.Empty(t, applied)
+	require.Len(t, recs, 4)
+	require.Equal(t, testClock.Now().Add(-24*time.Hour), volumeStart)
+
+	recsByChan := make(map[wire.OutPoint]FeeRecommendation)
+	for _, rec := range recs {
+		require.False(t, rec.Applied)
+		recsByChan[rec.ChanPoint] = rec
+	}
+	require.NotContains(t, recsByChan, excluded.chanPoint)
+
+	// The depleted channel charges the maximum fee rate, as the curve's
+	// 2000 ppm are increased by 50% due to its volume, but capped at 2500
+	// ppm.
+	rec := recsByChan[depleted.chanPoint]
+	require.Zero(t, rec.LocalRatio)
+	require.EqualValues(t, 2_000_000_000, rec.ForwardedVolume)
+	require.EqualValues(t, 1000, rec.Recommended.BaseFee)
+	require.EqualValues(t, 2500, rec.Recommended.FeeRate)
+	require.Equal(
+		t, fn.Some(models.InboundFee{}), rec.Recommended.InboundFee,
+	)
+	require.True(t, rec.Changed())
+
+	// The balanced channel already advertises the outgoing fee, but not
+	// the inbound discount yet.
+	rec = recsByChan[balanced.chanPoint]
+	require.InDelta(t, 0.5, rec.LocalRatio, 1e-9)
+	require.EqualValues(t, 500, rec.Recommended.FeeRate)
+	require.Equal(t, fn.Some(models.InboundFee{Rate: -50}),
+		rec.Recommended.InboundFee)
+	require.True(t, rec.Changed())
+
+	// The full channel uses its own override instead of the one of its
+	// peer.
+	rec = recsByChan[full.chanPoint]
+	require.True(t, rec.Overridden)
+	require.EqualValues(t, 0, rec.Recommended.BaseFee)
+	require.EqualValues(t, 10, rec.Recommended.FeeRate)
+	require.True(t, rec.Recommended.InboundFee.IsNone())
+
+	// The other channel with the same peer uses the peer's override.
+	rec = recsByChan[sibling.chanPoint]
+	require.True(t, rec.Overridden)
+	require.EqualValues(t, 0, rec.Recommended.BaseFee)
+	require.EqualValues(t, 20, rec.Recommended.FeeRate)
+
+	lastRun, lastRecs := engine.LastRecommendations()
+	require.Equal(t, testClock.Now(), lastRun)
+	require.Equal(t, recs, lastRecs)
+
+	// Now apply the fees for real, after removing the overrides of the
+	// full channel and its peer and making the balanced channel already
+	// advertise the recommended inbound fee.
+	removed, err := engine.RemoveOverride(full.chanPoint)
+	require.NoError(t, err)
+	require.True(t, removed)
+	removed, err = engine.RemoveOverride(full.chanPoint)
+	require.NoError(t, err)
+	require.False(t, removed)
+	removed, err = engine.RemovePeerOverride(peer)
+	require.NoError(t, err)
+	require.True(t, removed)
+	balanced.edge.InboundFee = fn.Some(lnwire.Fee{FeeRate: -50})
+
+	// The removal is persisted as well.
+	engine, err = NewFeePolicyEngine(cfg)
+	require.NoError(t, err)
+	restoredChan, restoredPeer = engine.Overrides()
+	require.Len(t, restoredChan, 1)
+	require.Empty(t, restoredPeer)
+
+	recs, err = engine.Recompute(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, recs, 4)
+
+	require.Len(t, applied, 2)
+	require.Contains(t, applied, depleted.chanPoint)
+	require.Contains(t, applied, full.chanPoint)
+
+	policy := applied[full.chanPoint]
+	require.EqualValues(t, 80, policy.TimeLockDelta)
+	require.EqualValues(t, 100, policy.FeeRate)
+	require.Equal(t, fn.Some(models.InboundFee{Rate: -100}),
+		policy.InboundFee)
+
+	for _, rec := range recs {
+		unchanged := rec.ChanPoint == balanced.chanPoint ||
+			rec.ChanPoint == sibling.chanPoint
+		require.Equal(t, !unchanged, rec.Applied)
+	}
+}
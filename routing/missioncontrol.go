@@ -546,6 +546,15 @@ func (m *MissionControl) GetProbability(fromNode, toNode route.Vertex,
 	now := m.cfg.clock.Now()
 	results, _ := m.state.getLastPairResult(fromNode)
 
+	// Estimators that need to know the source node of the pair get it
+	// passed in directly.
+	if e, ok := m.estimator.(sourceAwareEstimator); ok {
+		return e.sourcePairProbability(
+			now, results, fromNode, toNode, amt, capacity,
+			fromNode == m.cfg.selfNode,
+		)
+	}
+
 	// Use a distinct probability estimation function for local channels.
 	if fromNode == m.cfg.selfNode {
 		return m.estimator.LocalPairProbability(now, results, toNode)
@@ -629,6 +638,32 @@ func (m *MissionControl) ReportPaymentFail(paymentID uint64, rt *route.Route,
 	return m.processPaymentResult(result)
 }
 
+// ReportPaymentAttributedFail reports a failed payment to mission control for
+// which the failure message couldn't be decrypted, but which was attributed to
+// the pair of nodes ending at the node with the given index by the attribution
+// data of the failure. This function returns a reason if this failure is a
+// final failure. In that case no further payment attempts need to be made.
+func (m *MissionControl) ReportPaymentAttributedFail(paymentID uint64,
+	rt *route.Route, attributedIdx int) (*paymentsdb.FailureReason, error) {
+
+	timestamp := m.cfg.clock.Now()
+
+	failure := paymentFailure{
+		attributedIdx: tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType2](
+				uint8(attributedIdx),
+			),
+		),
+	}
+
+	result := newPaymentResult(
+		paymentID, extractMCRoute(rt), timestamp, timestamp,
+		fn.Some(failure),
+	)
+
+	return m.processPaymentResult(result)
+}
+
 // ReportPaymentSuccess reports a successful payment to mission control as input
 // for future probability estimates.
 func (m *MissionControl) ReportPaymentSuccess(paymentID uint64,
@@ -872,6 +907,12 @@ type paymentFailure struct {
 	// a certain hop at the above index, but aren't able to decode the
 	// failure message we indicate this by not setting this field.
 	msg tlv.OptionalRecordT[tlv.TlvType1, failureMessage]
+
+	// attributedIdx is the hop an undecryptable failure was attributed to
+	// by its attribution data. Either this hop or its predecessor is
+	// responsible for the failure. It is only set if the source index is
+	// unknown.
+	attributedIdx tlv.OptionalRecordT[tlv.TlvType2, uint8]
 }
 
 // Record returns a TLV record that can be used to encode/decode a
@@ -915,6 +956,14 @@ func encodePaymentFailure(w io.Writer, val interface{}, _ *[8]byte) error {
 			},
 		)
 
+		v.attributedIdx.WhenSome(
+			func(r tlv.RecordT[tlv.TlvType2, uint8]) {
+				recordProducers = append(
+					recordProducers, &r,
+				)
+			},
+		)
+
 		return lnwire.EncodeRecordsTo(
 			w, lnwire.ProduceRecordsSorted(
 				recordProducers...,
@@ -933,10 +982,13 @@ func decodePaymentFailure(r io.Reader, val interface{}, _ *[8]byte,
 
 		sourceIdx := tlv.ZeroRecordT[tlv.TlvType0, uint8]()
 		msg := tlv.ZeroRecordT[tlv.TlvType1, failureMessage]()
+		attributedIdx := tlv.ZeroRecordT[tlv.TlvType2, uint8]()
 
 		typeMap, err := lnwire.DecodeRecords(
 			r,
-			lnwire.ProduceRecordsSorted(&sourceIdx, &msg)...,
+			lnwire.ProduceRecordsSorted(
+				&sourceIdx, &msg, &attributedIdx,
+			)...,
 		)
 
 		if err != nil {
@@ -951,6 +1003,10 @@ func decodePaymentFailure(r io.Reader, val interface{}, _ *[8]byte,
 			h.msg = tlv.SomeRecordT(msg)
 		}
 
+		if _, ok := typeMap[h.attributedIdx.TlvType()]; ok {
+			h.attributedIdx = tlv.SomeRecordT(attributedIdx)
+		}
+
 		*v = h
 
 		return nil
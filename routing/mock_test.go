@@ -146,6 +146,13 @@ func (m *mockMissionControlOld) ReportPaymentFail(
 	return nil, nil
 }
 
+func (m *mockMissionControlOld) ReportPaymentAttributedFail(
+	paymentID uint64, rt *route.Route,
+	attributedIdx int) (*paymentsdb.FailureReason, error) {
+
+	return nil, nil
+}
+
 func (m *mockMissionControlOld) ReportPaymentSuccess(paymentID uint64,
 	rt *route.Route) error {
 
@@ -681,6 +688,20 @@ func (m *mockMissionControl) ReportPaymentFail(
 	return args.Get(0).(*paymentsdb.FailureReason), args.Error(1)
 }
 
+func (m *mockMissionControl) ReportPaymentAttributedFail(
+	paymentID uint64, rt *route.Route,
+	attributedIdx int) (*paymentsdb.FailureReason, error) {
+
+	args := m.Called(paymentID, rt, attributedIdx)
+
+	// Type assertion on nil will fail, so we check and return here.
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*paymentsdb.FailureReason), args.Error(1)
+}
+
 func (m *mockMissionControl) ReportPaymentSuccess(paymentID uint64,
 	rt *route.Route) error {
 
@@ -156,6 +156,11 @@ func newRoute(sourceVertex route.Vertex,
 		nextIncomingAmount lnwire.MilliSatoshi
 
 		blindedPayment *BlindedPayment
+
+		// upfrontFees tracks whether all forwarding nodes of the route
+		// support upfront fees. We don't attach upfront fees to
+		// blinded routes, as the fees of blinded hops are unknown.
+		upfrontFees = blindedPathSet == nil
 	)
 
 	pathLength := len(pathEdges)
@@ -293,6 +298,10 @@ func newRoute(sourceVertex route.Vertex,
 				fee = 0
 			}
 
+			upfrontFees = upfrontFees && supports(
+				lnwire.ExperimentalUpfrontFeesOptional,
+			)
+
 			// We'll take the total timelock of the preceding hop as
 			// the outgoing timelock or this hop. Then we'll
 			// increment the total timelock incurred by this hop.
@@ -388,6 +397,17 @@ func newRoute(sourceVertex route.Vertex,
 		return nil, err
 	}
 
+	// If every forwarding node understands upfront fees, we pay each of
+	// them a share of its success fee unconditionally. The final hop
+	// doesn't charge a fee, so it doesn't receive an upfront fee either.
+	if upfrontFees {
+		for i, hop := range newRoute.Hops {
+			hop.UpfrontFee = lnwire.UpfrontFeeShare(
+				newRoute.HopFee(i),
+			)
+		}
+	}
+
 	return newRoute, nil
 }
 
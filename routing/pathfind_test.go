@@ -1565,6 +1565,14 @@ func TestNewRoute(t *testing.T) {
 		expectError bool
 
 		expectedMPP *record.MPP
+
+		// upfrontFeeHops is the number of leading hops whose nodes
+		// signal support for upfront fees.
+		upfrontFeeHops int
+
+		// expectedUpfrontFees is a list of upfront fees that every hop
+		// is expected to be paid. If nil, no upfront fees are expected.
+		expectedUpfrontFees []lnwire.MilliSatoshi
 	}{
 		{
 			// For a single hop payment, no fees are expected to be paid.
@@ -1679,6 +1687,38 @@ func TestNewRoute(t *testing.T) {
 			expectedTotalAmount:   101101,
 			expectedTimeLocks:     []uint32{4, 1, 1},
 			expectedTotalTimeLock: 9,
+		}, {
+			// A three hop payment where both forwarding nodes
+			// support upfront fees, so each of them is paid a
+			// share of its success fee.
+			name:          "three hop with upfront fees",
+			paymentAmount: 100000,
+			hops: []*models.CachedEdgePolicy{
+				createHop(0, 10000, 1000000, 10),
+				createHop(0, 10000, 1000000, 5),
+				createHop(0, 10000, 1000000, 3),
+			},
+			upfrontFeeHops:        2,
+			expectedFees:          []lnwire.MilliSatoshi{1010, 1000, 0},
+			expectedUpfrontFees:   []lnwire.MilliSatoshi{10, 10, 0},
+			expectedTotalAmount:   102010,
+			expectedTimeLocks:     []uint32{4, 1, 1},
+			expectedTotalTimeLock: 9,
+		}, {
+			// A three hop payment where only the first forwarding
+			// node supports upfront fees, so none are paid.
+			name:          "three hop with partial upfront fee support",
+			paymentAmount: 100000,
+			hops: []*models.CachedEdgePolicy{
+				createHop(0, 10000, 1000000, 10),
+				createHop(0, 10000, 1000000, 5),
+				createHop(0, 10000, 1000000, 3),
+			},
+			upfrontFeeHops:        1,
+			expectedFees:          []lnwire.MilliSatoshi{1010, 1000, 0},
+			expectedTotalAmount:   102010,
+			expectedTimeLocks:     []uint32{4, 1, 1},
+			expectedTotalTimeLock: 9,
 		}}
 
 	for _, testCase := range testCases {
@@ -1690,6 +1730,14 @@ func TestNewRoute(t *testing.T) {
 			finalHop.ToNodeFeatures = testCase.destFeatures
 		}
 
+		for _, hop := range testCase.hops[:testCase.upfrontFeeHops] {
+			hop.ToNodeFeatures = lnwire.NewFeatureVector(
+				lnwire.NewRawFeatureVector(
+					lnwire.ExperimentalUpfrontFeesOptional,
+				), lnwire.Features,
+			)
+		}
+
 		assertRoute := func(t *testing.T, route *route.Route) {
 			if route.TotalAmount != testCase.expectedTotalAmount {
 				t.Errorf("Expected total amount is be %v"+
@@ -1734,6 +1782,20 @@ func TestNewRoute(t *testing.T) {
 				}
 			}
 
+			var totalUpfrontFee lnwire.MilliSatoshi
+			for i, hop := range route.Hops {
+				var expectedUpfrontFee lnwire.MilliSatoshi
+				if testCase.expectedUpfrontFees != nil {
+					expectedUpfrontFee =
+						testCase.expectedUpfrontFees[i]
+				}
+
+				require.Equal(t, expectedUpfrontFee,
+					hop.UpfrontFee)
+				totalUpfrontFee += expectedUpfrontFee
+			}
+			require.Equal(t, totalUpfrontFee, route.TotalUpfrontFee())
+
 			finalHop := route.Hops[len(route.Hops)-1]
 
 			if !reflect.DeepEqual(
@@ -50,6 +50,10 @@ type paymentLifecycle struct {
 	// to stop.
 	quit chan struct{}
 
+	// cancel is closed when the payment is canceled, which stops the
+	// lifecycle from making new HTLC attempts.
+	cancel chan struct{}
+
 	// resultCollected is used to send the result returned from the switch
 	// for a given HTLC attempt.
 	resultCollected chan *switchResult
@@ -75,6 +79,7 @@ func newPaymentLifecycle(r *ChannelRouter, feeLimit lnwire.MilliSatoshi,
 		shardTracker:          shardTracker,
 		currentHeight:         currentHeight,
 		quit:                  make(chan struct{}),
+		cancel:                make(chan struct{}),
 		resultCollected:       make(chan *switchResult, 1),
 		firstHopCustomRecords: firstHopCustomRecords,
 	}
@@ -86,11 +91,13 @@ func newPaymentLifecycle(r *ChannelRouter, feeLimit lnwire.MilliSatoshi,
 }
 
 // calcFeeBudget returns the available fee to be used for sending HTLC
-// attempts.
+// attempts. The upfront fees of all attempts are deducted from the budget as
+// well, as they are paid even if an attempt fails.
 func (p *paymentLifecycle) calcFeeBudget(
-	feesPaid lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+	ps *paymentsdb.MPPaymentState) lnwire.MilliSatoshi {
 
 	budget := p.feeLimit
+	feesPaid := ps.FeesPaid + ps.UpfrontFeesPaid
 
 	// We'll subtract the used fee from our fee budget. In case of
 	// overflow, we need to check whether feesPaid exceeds our budget
@@ -206,6 +213,11 @@ func (p *paymentLifecycle) resumePayment(ctx context.Context) ([32]byte,
 	// return.
 	defer p.stop()
 
+	// Register the payment as active so it can be canceled while the
+	// lifecycle is running.
+	p.router.addActivePayment(p.identifier, p.cancel)
+	defer p.router.removeActivePayment(p.identifier, p.cancel)
+
 	// If we had any existing attempts outstanding, we'll start by spinning
 	// up goroutines that'll collect their results and deliver them to the
 	// lifecycle loop below.
@@ -360,7 +372,8 @@ lifecycle:
 }
 
 // checkContext checks whether the payment context has been canceled.
-// Cancellation occurs manually or if the context times out.
+// Cancellation occurs manually or if the context times out. It also checks
+// whether the payment was canceled through CancelPayment.
 func (p *paymentLifecycle) checkContext(ctx context.Context) error {
 	select {
 	case <-ctx.Done():
@@ -396,6 +409,20 @@ func (p *paymentLifecycle) checkContext(ctx context.Context) error {
 			return fmt.Errorf("FailPayment got %w", err)
 		}
 
+	case <-p.cancel:
+		log.Warnf("Payment canceled, no more attempts will be made, "+
+			"id=%s", p.identifier.String())
+
+		// The payment is marked as failed so that no new HTLCs are
+		// attempted, while the inflight HTLCs are still resolved.
+		err := p.router.cfg.Control.FailPayment(
+			context.WithoutCancel(ctx), p.identifier,
+			paymentsdb.FailureReasonCanceled,
+		)
+		if err != nil {
+			return fmt.Errorf("FailPayment got %w", err)
+		}
+
 	case <-p.router.quit:
 		return fmt.Errorf("check payment timeout got: %w",
 			ErrRouterShuttingDown)
@@ -412,7 +439,7 @@ func (p *paymentLifecycle) checkContext(ctx context.Context) error {
 func (p *paymentLifecycle) requestRoute(ctx context.Context,
 	ps *paymentsdb.MPPaymentState) (*route.Route, error) {
 
-	remainingFees := p.calcFeeBudget(ps.FeesPaid)
+	remainingFees := p.calcFeeBudget(ps)
 
 	// Query our payment session to construct a route.
 	rt, err := p.paySession.RequestRoute(
@@ -560,6 +587,7 @@ func (p *paymentLifecycle) collectResult(
 	// switch.
 	errorDecryptor := &htlcswitch.SphinxErrorDecrypter{
 		OnionErrorDecrypter: sphinx.NewOnionErrorDecrypter(circuit),
+		Circuit:             circuit,
 	}
 
 	// Now ask the switch to return the result of the payment when
@@ -583,24 +611,49 @@ func (p *paymentLifecycle) collectResult(
 		return result, nil
 	}
 
+	// If stuck HTLC detection is enabled, we'll periodically check the age
+	// of the attempt while waiting for its result.
+	var (
+		stuckCfg     = &p.router.cfg.StuckHTLC
+		stuckMonitor *stuckAttemptMonitor
+		checkStuck   <-chan time.Time
+	)
+	if stuckCfg.Threshold > 0 {
+		stuckMonitor = newStuckAttemptMonitor(
+			stuckCfg, p.identifier, attempt,
+		)
+		checkStuck = p.router.cfg.Clock.TickAfter(
+			stuckCfg.CheckInterval,
+		)
+	}
+
 	// The switch knows about this payment, we'll wait for a result to be
 	// available.
-	select {
-	case r, ok := <-resultChan:
-		if !ok {
-			return nil, htlcswitch.ErrSwitchExiting
-		}
+	for {
+		select {
+		case r, ok := <-resultChan:
+			if !ok {
+				return nil, htlcswitch.ErrSwitchExiting
+			}
 
-		result = r
+			return r, nil
 
-	case <-p.quit:
-		return nil, ErrPaymentLifecycleExiting
+		case <-checkStuck:
+			stuckMonitor.check(
+				p.router.cfg.Clock.Now(), p.router.bestHeight,
+			)
 
-	case <-p.router.quit:
-		return nil, ErrRouterShuttingDown
-	}
+			checkStuck = p.router.cfg.Clock.TickAfter(
+				stuckCfg.CheckInterval,
+			)
 
-	return result, nil
+		case <-p.quit:
+			return nil, ErrPaymentLifecycleExiting
+
+		case <-p.router.quit:
+			return nil, ErrRouterShuttingDown
+		}
+	}
 }
 
 // registerAttempt is responsible for creating and saving an HTLC attempt in db
@@ -705,6 +758,14 @@ func (p *paymentLifecycle) sendAttempt(ctx context.Context,
 		CustomRecords: rt.FirstHopWireCustomRecords,
 	}
 
+	// If the route pays upfront fees, the first hop receives the upfront
+	// fees of all hops, and passes on what isn't its own share.
+	if upfrontFee := rt.TotalUpfrontFee(); upfrontFee > 0 {
+		htlcAdd.UpfrontFee = tlv.SomeRecordT(
+			tlv.NewRecordT[lnwire.UpfrontFeeTlvType](upfrontFee),
+		)
+	}
+
 	// Generate the raw encoded sphinx packet to be included along
 	// with the htlcAdd message that we send directly to the
 	// switch.
@@ -897,6 +958,33 @@ func (p *paymentLifecycle) handleSwitchErr(ctx context.Context,
 		return p.failAttempt(ctx, attemptID, sendErr)
 	}
 
+	// If the unreadable failure was attributed to a pair of nodes, we'll
+	// report the attribution to mission control instead.
+	var unreadableErr *htlcswitch.UnreadableFailureError
+	if errors.As(sendErr, &unreadableErr) {
+		log.Warnf("Unreadable failure when sending htlc: id=%v, "+
+			"hash=%v, attributed to hop %v", attempt.AttemptID,
+			attempt.Hash, unreadableErr.AttributedIdx)
+
+		reason, err := p.router.cfg.MissionControl.
+			ReportPaymentAttributedFail(
+				attemptID, &attempt.Route,
+				unreadableErr.AttributedIdx,
+			)
+		if err != nil {
+			log.Errorf("Error reporting payment result to mc: %v",
+				err)
+
+			reason = &internalErrorReason
+		}
+
+		if reason == nil {
+			return p.failAttempt(ctx, attemptID, sendErr)
+		}
+
+		return p.failPaymentAndAttempt(ctx, attemptID, reason, sendErr)
+	}
+
 	if errors.Is(sendErr, htlcswitch.ErrUnreadableFailureMessage) {
 		log.Warn("Unreadable failure when sending htlc: id=%v, hash=%v",
 			attempt.AttemptID, attempt.Hash)
@@ -949,6 +1037,14 @@ func (p *paymentLifecycle) handleSwitchErr(ctx context.Context,
 	log.Tracef("Node=%v reported failure when sending htlc",
 		failureSourceIdx)
 
+	// Let the payment session know about the failure if it is interested,
+	// for example to move on to another trampoline node.
+	if h, ok := p.paySession.(attemptFailureHandler); ok {
+		h.handleAttemptFailure(
+			&attempt.Route, failureSourceIdx, failureMessage,
+		)
+	}
+
 	return reportAndFail(&failureSourceIdx, failureMessage)
 }
 
@@ -1173,7 +1269,7 @@ func (p *paymentLifecycle) reloadPayment(
 	}
 
 	ps := payment.GetState()
-	remainingFees := p.calcFeeBudget(ps.FeesPaid)
+	remainingFees := p.calcFeeBudget(ps)
 
 	log.Debugf("Payment %v: status=%v, active_shards=%v, rem_value=%v, "+
 		"fee_limit=%v", p.identifier, payment.GetStatus(),
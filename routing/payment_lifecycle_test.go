@@ -359,6 +359,45 @@ func TestCheckTimeoutOnRouterQuit(t *testing.T) {
 	require.ErrorIs(t, err, ErrRouterShuttingDown)
 }
 
+// TestCheckContextPaymentCanceled checks that a payment canceled through the
+// router is marked as failed with the canceled reason.
+func TestCheckContextPaymentCanceled(t *testing.T) {
+	t.Parallel()
+
+	p := createTestPaymentLifecycle()
+	p.cancel = make(chan struct{})
+
+	// Canceling a payment without a running lifecycle fails.
+	err := p.router.CancelPayment(p.identifier)
+	require.ErrorIs(t, err, ErrPaymentNotActive)
+
+	// Mock the control tower's `FailPayment` method.
+	ct := &mockControlTower{}
+	ct.On("FailPayment",
+		p.identifier, paymentsdb.FailureReasonCanceled).Return(nil)
+
+	// Mount the mocked control tower.
+	p.router.cfg.Control = ct
+
+	// As long as the payment isn't canceled, nothing happens.
+	p.router.addActivePayment(p.identifier, p.cancel)
+	require.NoError(t, p.checkContext(t.Context()))
+	ct.AssertNotCalled(t, "FailPayment", mock.Anything, mock.Anything)
+
+	// Cancel the payment, which is allowed multiple times.
+	require.NoError(t, p.router.CancelPayment(p.identifier))
+	require.NoError(t, p.router.CancelPayment(p.identifier))
+
+	// The payment is now marked as failed.
+	require.NoError(t, p.checkContext(t.Context()))
+	ct.AssertExpectations(t)
+
+	// Once the lifecycle exited, the payment can no longer be canceled.
+	p.router.removeActivePayment(p.identifier, p.cancel)
+	err = p.router.CancelPayment(p.identifier)
+	require.ErrorIs(t, err, ErrPaymentNotActive)
+}
+
 // TestRequestRouteSucceed checks that `requestRoute` can successfully request
 // a route.
 func TestRequestRouteSucceed(t *testing.T) {
@@ -401,6 +440,47 @@ func TestRequestRouteSucceed(t *testing.T) {
 	paySession.AssertExpectations(t)
 }
 
+// TestRequestRouteUpfrontFees checks that the upfront fees that were paid are
+// deducted from the fee budget passed to the payment session.
+func TestRequestRouteUpfrontFees(t *testing.T) {
+	t.Parallel()
+
+	p := createTestPaymentLifecycle()
+
+	// Create a mock payment session and a dummy route.
+	paySession := &mockPaymentSession{}
+	dummyRoute := &route.Route{
+		Hops: []*route.Hop{
+			testHop,
+		},
+	}
+
+	// Mount the mocked payment session.
+	p.paySession = paySession
+
+	// Create a payment state where upfront fees were paid on top of the
+	// routing fees.
+	ps := &paymentsdb.MPPaymentState{
+		NumAttemptsInFlight: 1,
+		RemainingAmt:        1,
+		FeesPaid:            100,
+		UpfrontFeesPaid:     20,
+	}
+	p.feeLimit = 150
+
+	// The remaining fee budget must exclude both kinds of fees.
+	paySession.On("RequestRoute",
+		mock.Anything, lnwire.MilliSatoshi(30), mock.Anything,
+		mock.Anything, mock.Anything,
+	).Return(dummyRoute, nil)
+
+	result, err := p.requestRoute(t.Context(), ps)
+	require.NoError(t, err)
+	require.Equal(t, dummyRoute, result)
+
+	paySession.AssertExpectations(t)
+}
+
 // TestRequestRouteHandleCriticalErr checks that `requestRoute` can
 // successfully handle a critical error returned from payment session.
 func TestRequestRouteHandleCriticalErr(t *testing.T) {
@@ -454,6 +454,21 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 			return nil, err
 		}
 
+		// Path finding only limits the routing fees, but upfront fees
+		// are paid on top of them, even if the attempt fails. Attaching
+		// upfront fees is optional, so if they don't fit into the fee
+		// limit of this attempt, we don't pay any.
+		upfrontFee := route.TotalUpfrontFee()
+		if upfrontFee > 0 && route.TotalFees()+upfrontFee > feeLimit {
+			p.log.Debugf("Not paying upfront fee of %v, as it "+
+				"would exceed the fee limit of %v", upfrontFee,
+				feeLimit)
+
+			for _, hop := range route.Hops {
+				hop.UpfrontFee = 0
+			}
+		}
+
 		return route, err
 	}
 }
@@ -62,10 +62,21 @@ func (m *SessionSource) NewPaymentSession(p *LightningPayment,
 		)
 	}
 
-	session, err := newPaymentSession(
-		p, m.SourceNode.PubKeyBytes, getBandwidthHints,
-		m.GraphSessionFactory, m.MissionControl, m.PathFindingConfig,
-	)
+	newSession := func(p *LightningPayment) (*paymentSession, error) {
+		return newPaymentSession(
+			p, m.SourceNode.PubKeyBytes, getBandwidthHints,
+			m.GraphSessionFactory, m.MissionControl,
+			m.PathFindingConfig,
+		)
+	}
+
+	// Payments that are delegated to a trampoline node are routed to the
+	// trampoline node instead of the recipient.
+	if p.Trampoline != nil {
+		return newTrampolineSession(p, newSession)
+	}
+
+	session, err := newSession(p)
 	if err != nil {
 		return nil, err
 	}
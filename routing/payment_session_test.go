@@ -252,6 +252,83 @@ func TestRequestRoute(t *testing.T) {
 	}
 }
 
+// TestRequestRouteUpfrontFeeLimit tests that the upfront fees of a route are
+// only paid if they fit into the fee limit of the attempt together with the
+// routing fees.
+func TestRequestRouteUpfrontFeeLimit(t *testing.T) {
+	t.Parallel()
+
+	payment := &LightningPayment{
+		CltvLimit:      100,
+		FinalCLTVDelta: 8,
+		Amount:         100_000,
+	}
+	require.NoError(t, payment.SetPaymentHash([32]byte{}))
+
+	session, err := newPaymentSession(
+		payment, route.Vertex{},
+		func(Graph) (bandwidthHints, error) {
+			return &mockBandwidthHints{}, nil
+		},
+		&sessionGraph{},
+		&MissionControl{},
+		PathFindingConfig{},
+	)
+	require.NoError(t, err)
+
+	// The path goes through a single forwarding node that supports
+	// upfront fees and charges a routing fee of 1000 msat, so it is paid
+	// an upfront fee of 10 msat.
+	upfrontFeeFeatures := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.ExperimentalUpfrontFeesOptional,
+		), lnwire.Features,
+	)
+	session.pathFinder = func(_ *graphParams, _ *RestrictParams,
+		_ *PathFindingConfig, _, _, _ route.Vertex,
+		_ lnwire.MilliSatoshi, _ float64, _ int32) ([]*unifiedEdge,
+		float64, error) {
+
+		path := []*unifiedEdge{
+			{
+				policy: &models.CachedEdgePolicy{
+					ToNodePubKey: func() route.Vertex {
+						return route.Vertex{1}
+					},
+					ToNodeFeatures: upfrontFeeFeatures,
+				},
+			},
+			{
+				policy: &models.CachedEdgePolicy{
+					ToNodePubKey: func() route.Vertex {
+						return route.Vertex{2}
+					},
+					ToNodeFeatures: lnwire.NewFeatureVector(
+						nil, nil,
+					),
+					FeeBaseMSat: 1000,
+				},
+			},
+		}
+
+		return path, 1.0, nil
+	}
+
+	// With a fee limit that covers both the routing and the upfront fee,
+	// the upfront fee is paid.
+	rt, err := session.RequestRoute(payment.Amount, 1010, 0, 10, nil)
+	require.NoError(t, err)
+	require.Equal(t, lnwire.MilliSatoshi(1000), rt.TotalFees())
+	require.Equal(t, lnwire.MilliSatoshi(10), rt.TotalUpfrontFee())
+
+	// If the upfront fee doesn't fit into the fee limit, the route is
+	// still used, but without upfront fees.
+	rt, err = session.RequestRoute(payment.Amount, 1009, 0, 10, nil)
+	require.NoError(t, err)
+	require.Equal(t, lnwire.MilliSatoshi(1000), rt.TotalFees())
+	require.Zero(t, rt.TotalUpfrontFee())
+}
+
 type sessionGraph struct {
 	Graph
 }
@@ -30,6 +30,20 @@ type Estimator interface {
 	String() string
 }
 
+// sourceAwareEstimator is an Estimator that also takes the source node of a
+// pair into account. Mission control prefers it over the source-less methods
+// of the Estimator interface.
+type sourceAwareEstimator interface {
+	Estimator
+
+	// sourcePairProbability estimates the probability of successfully
+	// traversing from fromNode to toNode. The local flag signals that
+	// fromNode is our own node.
+	sourcePairProbability(now time.Time, results NodeResults,
+		fromNode, toNode route.Vertex, amt lnwire.MilliSatoshi,
+		capacity btcutil.Amount, local bool) float64
+}
+
 // estimatorConfig represents a configuration for a probability estimator.
 type estimatorConfig interface {
 	// validate checks that all configuration parameters are sane.
@@ -0,0 +1,430 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// DefaultExternalCacheTTL is the default value for CacheTTL in
+	// ExternalConfig. Estimates are reused for a short time only, so that
+	// new mission control results are taken into account quickly.
+	DefaultExternalCacheTTL = 10 * time.Second
+
+	// DefaultExternalQueryTimeout is the default value for QueryTimeout in
+	// ExternalConfig. Pathfinding queries the probability of many pairs,
+	// so a single query needs to be answered quickly.
+	DefaultExternalQueryTimeout = 100 * time.Millisecond
+
+	// maxExternalCacheSize is the maximum number of estimates that are
+	// cached. When the cache grows beyond this size, the expired estimates
+	// are removed, or the whole cache if none are expired.
+	maxExternalCacheSize = 100_000
+
+	// ExternalEstimatorName is used to identify the external estimator.
+	ExternalEstimatorName = "external"
+
+	// externalMaxFailures is the number of consecutive failed queries
+	// after which the external client isn't queried anymore for the
+	// duration of externalBreakerCooldown. Each failed query may cost us
+	// the full query timeout, which adds up quickly during pathfinding.
+	externalMaxFailures = 5
+
+	// externalBreakerCooldown is the time during which the fallback
+	// estimator is used without querying the external client once it
+	// failed too often. After the cooldown, a single query is attempted,
+	// whose failure trips the breaker again.
+	externalBreakerCooldown = time.Minute
+)
+
+var (
+	// ErrInvalidCacheTTL is returned when we get a negative cache ttl.
+	ErrInvalidCacheTTL = errors.New("cache ttl must be >= 0")
+
+	// ErrInvalidQueryTimeout is returned when we get a query timeout below
+	// or equal zero.
+	ErrInvalidQueryTimeout = errors.New("query timeout must be larger " +
+		"than zero")
+
+	// ErrExternalClientExists is returned when a client is registered with
+	// the external estimator while another client is still registered.
+	ErrExternalClientExists = errors.New("external estimator client " +
+		"already registered")
+)
+
+// ExternalConfig contains configuration for our external probability
+// estimator.
+type ExternalConfig struct {
+	// CacheTTL is the duration for which an estimate of the external
+	// client is reused for identical queries. A value of zero disables
+	// the cache.
+	CacheTTL time.Duration
+
+	// QueryTimeout is the maximum time we wait for the external client to
+	// answer a query before we use the fallback estimator instead.
+	QueryTimeout time.Duration
+
+	// FallbackConfig is the configuration of the bimodal estimator that
+	// is used while no external client is registered, or when the client
+	// fails to answer a query.
+	FallbackConfig BimodalConfig
+}
+
+// validate checks the configuration of the estimator for allowed values.
+func (p ExternalConfig) validate() error {
+	if p.CacheTTL < 0 {
+		return fmt.Errorf("%v: %w", ExternalEstimatorName,
+			ErrInvalidCacheTTL)
+	}
+
+	if p.QueryTimeout <= 0 {
+		return fmt.Errorf("%v: %w", ExternalEstimatorName,
+			ErrInvalidQueryTimeout)
+	}
+
+	return p.FallbackConfig.validate()
+}
+
+// DefaultExternalConfig returns the default configuration for the estimator.
+func DefaultExternalConfig() ExternalConfig {
+	return ExternalConfig{
+		CacheTTL:       DefaultExternalCacheTTL,
+		QueryTimeout:   DefaultExternalQueryTimeout,
+		FallbackConfig: DefaultBimodalConfig(),
+	}
+}
+
+// ProbabilityQuery is a query for the success probability of a node pair that
+// is forwarded to the client of the external estimator.
+type ProbabilityQuery struct {
+	// FromNode is the source node of the pair.
+	FromNode route.Vertex
+
+	// ToNode is the target node of the pair.
+	ToNode route.Vertex
+
+	// Amount is the amount that is to be sent over the pair.
+	Amount lnwire.MilliSatoshi
+
+	// Capacity is the capacity of the channel between the nodes.
+	Capacity btcutil.Amount
+
+	// Local indicates whether the source node is our own node.
+	Local bool
+
+	// Results are the mission control results of the source node.
+	Results NodeResults
+}
+
+// ExternalEstimatorClient is a client that estimates the success probability
+// of node pairs on behalf of the external estimator.
+type ExternalEstimatorClient interface {
+	// QueryProbability returns the estimated success probability of the
+	// queried pair. The passed context is canceled when the query times
+	// out.
+	QueryProbability(ctx context.Context,
+		query *ProbabilityQuery) (float64, error)
+}
+
+// probabilityCacheKey identifies an estimate in the cache of the external
+// estimator.
+type probabilityCacheKey struct {
+	fromNode route.Vertex
+	toNode   route.Vertex
+	amount   lnwire.MilliSatoshi
+	capacity btcutil.Amount
+	local    bool
+}
+
+// cachedProbability is an estimate in the cache of the external estimator.
+type cachedProbability struct {
+	probability float64
+	expiry      time.Time
+}
+
+// ExternalEstimator forwards probability queries to an external client, which
+// allows experimenting with probability models outside of lnd. Estimates are
+// cached for a short time, and the bimodal estimator is used while no client
+// is registered or if the client fails to answer in time. If the client fails
+// repeatedly, it isn't queried for a while, so that pathfinding doesn't wait
+// for the query timeout of every pair.
+type ExternalEstimator struct {
+	// ExternalConfig contains configuration options for our estimator.
+	ExternalConfig
+
+	// fallback is the estimator that is used if the external client can't
+	// answer a query.
+	fallback *BimodalEstimator
+
+	// client is the currently registered client, if any.
+	client ExternalEstimatorClient
+
+	// cache holds the recent estimates of the client.
+	cache map[probabilityCacheKey]cachedProbability
+
+	// failures is the number of consecutive failed queries of the client.
+	failures int
+
+	// trippedUntil is the time until which the client isn't queried, as
+	// it failed too often.
+	trippedUntil time.Time
+
+	mu sync.Mutex
+}
+
+// NewExternalEstimator creates a new ExternalEstimator.
+func NewExternalEstimator(cfg ExternalConfig) (*ExternalEstimator, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	fallback, err := NewBimodalEstimator(cfg.FallbackConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExternalEstimator{
+		ExternalConfig: cfg,
+		fallback:       fallback,
+		cache:          make(map[probabilityCacheKey]cachedProbability),
+	}, nil
+}
+
+// Compile-time checks that interfaces are implemented.
+var _ Estimator = (*ExternalEstimator)(nil)
+var _ sourceAwareEstimator = (*ExternalEstimator)(nil)
+var _ estimatorConfig = (*ExternalConfig)(nil)
+
+// Config returns the current configuration of the estimator.
+func (e *ExternalEstimator) Config() estimatorConfig {
+	return e.ExternalConfig
+}
+
+// String returns the estimator's configuration as a string representation.
+func (e *ExternalEstimator) String() string {
+	return fmt.Sprintf("estimator type: %v, cache ttl: %v, query "+
+		"timeout: %v, fallback: %v", ExternalEstimatorName,
+		e.CacheTTL, e.QueryTimeout, e.fallback)
+}
+
+// RegisterClient registers the client that answers the probability queries.
+// Only a single client can be registered at a time.
+func (e *ExternalEstimator) RegisterClient(
+	client ExternalEstimatorClient) error {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.client != nil {
+		return ErrExternalClientExists
+	}
+
+	log.Infof("External probability estimator client registered")
+
+	e.client = client
+	e.resetLocked()
+
+	return nil
+}
+
+// UnregisterClient unregisters the given client if it is the currently
+// registered one. Until a new client is registered, the fallback estimator is
+// used.
+func (e *ExternalEstimator) UnregisterClient(client ExternalEstimatorClient) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.client != client {
+		return
+	}
+
+	log.Infof("External probability estimator client unregistered, " +
+		"using fallback estimator")
+
+	e.client = nil
+	e.resetLocked()
+}
+
+// resetLocked clears the cache and the failure state of the estimator. It must
+// be called with the mutex held.
+func (e *ExternalEstimator) resetLocked() {
+	e.cache = make(map[probabilityCacheKey]cachedProbability)
+	e.failures = 0
+	e.trippedUntil = time.Time{}
+}
+
+// PairProbability estimates the probability of successfully traversing to
+// toNode based on historical payment outcomes for the from node. Those
+// outcomes are passed in via the results parameter. As the from node isn't
+// known, it is left empty in the query.
+func (e *ExternalEstimator) PairProbability(now time.Time,
+	results NodeResults, toNode route.Vertex, amt lnwire.MilliSatoshi,
+	capacity btcutil.Amount) float64 {
+
+	return e.sourcePairProbability(
+		now, results, route.Vertex{}, toNode, amt, capacity, false,
+	)
+}
+
+// LocalPairProbability estimates the probability of successfully traversing
+// our own local channels to toNode. As our own node isn't known, it is left
+// empty in the query.
+func (e *ExternalEstimator) LocalPairProbability(now time.Time,
+	results NodeResults, toNode route.Vertex) float64 {
+
+	return e.sourcePairProbability(
+		now, results, route.Vertex{}, toNode, 0, 0, true,
+	)
+}
+
+// sourcePairProbability returns the estimate of the external client for the
+// given pair. The fallback estimator is used if no client is registered, if
+// the client fails to answer or if it failed too often recently.
+//
+// NOTE: This is part of the sourceAwareEstimator interface.
+func (e *ExternalEstimator) sourcePairProbability(now time.Time,
+	results NodeResults, fromNode, toNode route.Vertex,
+	amt lnwire.MilliSatoshi, capacity btcutil.Amount, local bool) float64 {
+
+	fallback := func() float64 {
+		if local {
+			return e.fallback.LocalPairProbability(
+				now, results, toNode,
+			)
+		}
+
+		return e.fallback.PairProbability(
+			now, results, toNode, amt, capacity,
+		)
+	}
+
+	key := probabilityCacheKey{
+		fromNode: fromNode,
+		toNode:   toNode,
+		amount:   amt,
+		capacity: capacity,
+		local:    local,
+	}
+
+	e.mu.Lock()
+	client := e.client
+	cached, ok := e.cache[key]
+	tripped := now.Before(e.trippedUntil)
+	e.mu.Unlock()
+
+	if client == nil {
+		return fallback()
+	}
+
+	if ok && now.Before(cached.expiry) {
+		return cached.probability
+	}
+
+	if tripped {
+		return fallback()
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), e.QueryTimeout,
+	)
+	defer cancel()
+
+	probability, err := client.QueryProbability(ctx, &ProbabilityQuery{
+		FromNode: fromNode,
+		ToNode:   toNode,
+		Amount:   amt,
+		Capacity: capacity,
+		Local:    local,
+		Results:  results,
+	})
+	if err != nil {
+		log.Debugf("External probability query for %v->%v failed, "+
+			"using fallback: %v", fromNode, toNode, err)
+
+		e.recordFailure(now, client)
+
+		return fallback()
+	}
+
+	if probability < 0 || probability > 1 {
+		log.Warnf("External probability estimate %v for %v->%v is "+
+			"out of range, using fallback", probability, fromNode,
+			toNode)
+
+		e.recordFailure(now, client)
+
+		return fallback()
+	}
+
+	e.mu.Lock()
+	if e.client == client {
+		e.failures = 0
+	}
+	e.mu.Unlock()
+
+	if e.CacheTTL > 0 {
+		e.cacheProbability(now, key, probability)
+	}
+
+	return probability
+}
+
+// recordFailure counts a failed query of the given client and stops querying
+// it for the duration of the cooldown if it failed too often in a row. The
+// failure count isn't reset when the breaker trips, so that the first failed
+// query after the cooldown trips it again.
+func (e *ExternalEstimator) recordFailure(now time.Time,
+	client ExternalEstimatorClient) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// The client may have been replaced while we queried it.
+	if e.client != client {
+		return
+	}
+
+	e.failures++
+	if e.failures < externalMaxFailures {
+		return
+	}
+
+	log.Warnf("External probability estimator client failed %d "+
+		"queries in a row, using fallback estimator for %v",
+		e.failures, externalBreakerCooldown)
+
+	e.trippedUntil = now.Add(externalBreakerCooldown)
+}
+
+// cacheProbability adds an estimate to the cache, pruning the cache if it
+// grew too large.
+func (e *ExternalEstimator) cacheProbability(now time.Time,
+	key probabilityCacheKey, probability float64) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if len(e.cache) >= maxExternalCacheSize {
+		for k, v := range e.cache {
+			if !now.Before(v.expiry) {
+				delete(e.cache, k)
+			}
+		}
+
+		if len(e.cache) >= maxExternalCacheSize {
+			clear(e.cache)
+		}
+	}
+
+	e.cache[key] = cachedProbability{
+		probability: probability,
+		expiry:      now.Add(e.CacheTTL),
+	}
+}
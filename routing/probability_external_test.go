@@ -0,0 +1,225 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// mockExternalClient is a mock implementation of ExternalEstimatorClient.
+type mockExternalClient struct {
+	probability float64
+	err         error
+	block       bool
+	queries     []*ProbabilityQuery
+}
+
+// QueryProbability records the query and returns the configured answer.
+func (m *mockExternalClient) QueryProbability(ctx context.Context,
+	query *ProbabilityQuery) (float64, error) {
+
+	m.queries = append(m.queries, query)
+
+	if m.block {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+
+	return m.probability, m.err
+}
+
+// TestExternalEstimator tests that the external estimator forwards queries to
+// the registered client, caches its estimates and falls back to the bimodal
+// estimator if needed.
+func TestExternalEstimator(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultExternalConfig()
+	cfg.QueryTimeout = 10 * time.Millisecond
+
+	estimator, err := NewExternalEstimator(cfg)
+	require.NoError(t, err)
+
+	fallback, err := NewBimodalEstimator(cfg.FallbackConfig)
+	require.NoError(t, err)
+
+	var (
+		fromNode = route.Vertex{10}
+		toNode   = route.Vertex{byte(0)}
+		amt      = smallAmount
+		capacity = capacity.ToSatoshis()
+	)
+	expectedFallback := fallback.PairProbability(
+		now, results, toNode, amt, capacity,
+	)
+	query := func() float64 {
+		return estimator.sourcePairProbability(
+			now, results, fromNode, toNode, amt, capacity, false,
+		)
+	}
+
+	// Without a client, the fallback estimator is used.
+	require.Equal(t, expectedFallback, query())
+
+	// Once a client is registered, its estimate is used.
+	client := &mockExternalClient{probability: 0.42}
+	require.NoError(t, estimator.RegisterClient(client))
+	require.ErrorIs(
+		t, estimator.RegisterClient(&mockExternalClient{}),
+		ErrExternalClientExists,
+	)
+
+	require.Equal(t, 0.42, query())
+	require.Len(t, client.queries, 1)
+	require.Equal(t, &ProbabilityQuery{
+		FromNode: fromNode,
+		ToNode:   toNode,
+		Amount:   amt,
+		Capacity: capacity,
+		Results:  results,
+	}, client.queries[0])
+
+	// A second identical query is answered from the cache.
+	client.probability = 0.1
+	require.Equal(t, 0.42, query())
+	require.Len(t, client.queries, 1)
+
+	// Once the estimate expired, the client is queried again.
+	later := now.Add(cfg.CacheTTL)
+	require.Equal(t, 0.1, estimator.sourcePairProbability(
+		later, results, fromNode, toNode, amt, capacity, false,
+	))
+	require.Len(t, client.queries, 2)
+
+	// Errors, timeouts and invalid estimates of the client result in the
+	// fallback estimate.
+	toNode = route.Vertex{byte(1)}
+	expectedFallback = fallback.PairProbability(
+		now, results, toNode, amt, capacity,
+	)
+
+	client.err = errors.New("model failure")
+	require.Equal(t, expectedFallback, query())
+
+	client.err = nil
+	client.probability = 1.5
+	require.Equal(t, expectedFallback, query())
+
+	client.block = true
+	require.Equal(t, expectedFallback, query())
+	require.Len(t, client.queries, 5)
+
+	// After the client is unregistered, the fallback estimator is used
+	// again without querying the client.
+	estimator.UnregisterClient(client)
+	client.block = false
+	client.probability = 0.42
+	require.Equal(t, expectedFallback, query())
+	require.Len(t, client.queries, 5)
+
+	// Local pairs are forwarded as such.
+	require.NoError(t, estimator.RegisterClient(client))
+	require.Equal(t, 0.42, estimator.sourcePairProbability(
+		now, results, fromNode, toNode, 0, 0, true,
+	))
+	require.True(t, client.queries[5].Local)
+}
+
+// TestExternalConfigValidate tests the validation of the external estimator
+// config.
+func TestExternalConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultExternalConfig()
+	require.NoError(t, cfg.validate())
+
+	cfg.CacheTTL = -1
+	require.ErrorIs(t, cfg.validate(), ErrInvalidCacheTTL)
+
+	cfg = DefaultExternalConfig()
+	cfg.QueryTimeout = 0
+	require.ErrorIs(t, cfg.validate(), ErrInvalidQueryTimeout)
+
+	cfg = DefaultExternalConfig()
+	cfg.FallbackConfig.BimodalDecayTime = 0
+	require.ErrorIs(t, cfg.validate(), ErrInvalidDecayTime)
+}
+
+// TestExternalEstimatorBreaker tests that the external estimator stops
+// querying a client that fails repeatedly for the duration of the cooldown,
+// and queries it again afterwards.
+func TestExternalEstimatorBreaker(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultExternalConfig()
+	cfg.QueryTimeout = 10 * time.Millisecond
+
+	estimator, err := NewExternalEstimator(cfg)
+	require.NoError(t, err)
+
+	fallback, err := NewBimodalEstimator(cfg.FallbackConfig)
+	require.NoError(t, err)
+
+	var (
+		fromNode = route.Vertex{10}
+		toNode   = route.Vertex{byte(0)}
+		amt      = smallAmount
+		capacity = capacity.ToSatoshis()
+	)
+	expectedFallback := fallback.PairProbability(
+		now, results, toNode, amt, capacity,
+	)
+	query := func(at time.Time) float64 {
+		return estimator.sourcePairProbability(
+			at, results, fromNode, toNode, amt, capacity, false,
+		)
+	}
+
+	client := &mockExternalClient{err: errors.New("model failure")}
+	require.NoError(t, estimator.RegisterClient(client))
+
+	// A successful query resets the count of consecutive failures.
+	for i := 0; i < externalMaxFailures-1; i++ {
+		require.Equal(t, expectedFallback, query(now))
+	}
+	client.err = nil
+	client.probability = 0.42
+	require.Equal(t, 0.42, estimator.sourcePairProbability(
+		now, results, fromNode, route.Vertex{1}, amt, capacity, false,
+	))
+
+	// Once the client failed too often in a row, it isn't queried anymore
+	// until the cooldown expired.
+	client.block = true
+	for i := 0; i < externalMaxFailures; i++ {
+		require.Equal(t, expectedFallback, query(now))
+	}
+	numQueries := len(client.queries)
+	require.Equal(t, 2*externalMaxFailures, numQueries)
+
+	require.Equal(t, expectedFallback, query(now))
+	require.Len(t, client.queries, numQueries)
+
+	// After the cooldown, the client is queried again, and a single
+	// failure trips the breaker again.
+	later := now.Add(externalBreakerCooldown)
+	expectedFallback = fallback.PairProbability(
+		later, results, toNode, amt, capacity,
+	)
+	require.Equal(t, expectedFallback, query(later))
+	require.Len(t, client.queries, numQueries+1)
+
+	require.Equal(t, expectedFallback, query(later))
+	require.Len(t, client.queries, numQueries+1)
+
+	// Registering a client again resets the breaker.
+	estimator.UnregisterClient(client)
+	client.block = false
+	require.NoError(t, estimator.RegisterClient(client))
+	require.Equal(t, 0.42, query(later))
+	require.Len(t, client.queries, numQueries+2)
+}
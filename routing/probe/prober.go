@@ -0,0 +1,378 @@
+package probe
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	paymentsdb "github.com/lightningnetwork/lnd/payments/db"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultTimeout is the default timeout of a single probe.
+	DefaultTimeout = 60 * time.Second
+)
+
+var (
+	// errProbeSettled is returned when a probe was unexpectedly settled by
+	// its target.
+	errProbeSettled = errors.New("probe unexpectedly settled")
+)
+
+// Config holds the configuration and dependencies of the prober.
+type Config struct {
+	// Targets are the nodes that are probed one after the other.
+	Targets []route.Vertex
+
+	// MinAmount is the smallest amount that is probed. After a failed
+	// probe, the amount for the target is halved down to this amount.
+	MinAmount lnwire.MilliSatoshi
+
+	// MaxAmount is the largest amount that is probed. After a probe
+	// reached its target, the amount for the target is doubled up to this
+	// amount. Every target is first probed with this amount.
+	MaxAmount lnwire.MilliSatoshi
+
+	// MaxLocked is the maximum total amount of all probes in flight,
+	// including the maximum routing fees they may pay. A probe is skipped
+	// if it would exceed this budget.
+	MaxLocked lnwire.MilliSatoshi
+
+	// MaxFeePPM is the maximum routing fee of a probe in parts per million
+	// of its amount.
+	MaxFeePPM uint64
+
+	// Timeout is the timeout of a single probe. Zero means DefaultTimeout.
+	Timeout time.Duration
+
+	// FinalCltvDelta is the final CLTV delta of the probes.
+	FinalCltvDelta uint16
+
+	// CltvLimit is the maximum total time lock of the probes.
+	CltvLimit uint32
+
+	// SendPayment sends a payment and blocks until it succeeded or failed.
+	// The outcome of every attempt is recorded in mission control by the
+	// payment lifecycle.
+	SendPayment func(ctx context.Context,
+		payment *routing.LightningPayment) ([32]byte, *route.Route,
+		error)
+
+	// DeletePayment deletes a finished probe from the payments database.
+	DeletePayment func(ctx context.Context, hash lntypes.Hash) error
+
+	// Ticker determines the rate at which probes are sent. A single probe
+	// is started on every tick.
+	Ticker ticker.Ticker
+}
+
+// validate checks that the configuration is sane.
+func (c *Config) validate() error {
+	switch {
+	case len(c.Targets) == 0:
+		return errors.New("at least one probe target required")
+
+	case c.MinAmount == 0:
+		return errors.New("minimum probe amount must be positive")
+
+	case c.MinAmount > c.MaxAmount:
+		return errors.New("minimum probe amount must not exceed the " +
+			"maximum probe amount")
+
+	case c.MaxLocked < c.lockedAmount(c.MinAmount):
+		return errors.New("locked liquidity budget must allow at " +
+			"least a single probe of the minimum amount and its " +
+			"fees")
+	}
+
+	return nil
+}
+
+// feeLimit returns the maximum routing fee of a probe of the given amount.
+func (c *Config) feeLimit(amt lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+	return amt * lnwire.MilliSatoshi(c.MaxFeePPM) / 1_000_000
+}
+
+// lockedAmount returns the liquidity that a probe of the given amount may
+// lock, which includes the routing fees of the probe.
+func (c *Config) lockedAmount(amt lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+	return amt + c.feeLimit(amt)
+}
+
+// Status is a snapshot of the state of the prober.
+type Status struct {
+	// Targets are the probed nodes along with the amount their next probe
+	// is sent with.
+	Targets map[route.Vertex]lnwire.MilliSatoshi
+
+	// Locked is the total amount of all probes in flight, including the
+	// maximum routing fees they may pay.
+	Locked lnwire.MilliSatoshi
+
+	// ProbesSent is the number of probes that were sent.
+	ProbesSent uint64
+
+	// ProbesReached is the number of probes that reached their target.
+	ProbesReached uint64
+
+	// ProbesFailed is the number of probes that failed before reaching
+	// their target.
+	ProbesFailed uint64
+
+	// ProbesSkipped is the number of probes that weren't sent because
+	// they would have exceeded the locked liquidity budget.
+	ProbesSkipped uint64
+}
+
+// Prober continuously sends probes with unknown payment hashes to a set of
+// target nodes. The probes can't be settled, but every attempt reveals
+// liquidity bounds of the channels along its route, which the payment
+// lifecycle records in mission control. The amount of every target is
+// adjusted to the results, so that the probes converge to the liquidity
+// available towards the target.
+type Prober struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// mu protects the fields below.
+	mu sync.Mutex
+
+	// amounts holds the amount of the next probe for every target.
+	amounts map[route.Vertex]lnwire.MilliSatoshi
+
+	// next is the index of the next target to probe.
+	next int
+
+	// status holds the counters of the prober.
+	status Status
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New creates a new prober.
+func New(cfg *Config) (*Prober, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	amounts := make(map[route.Vertex]lnwire.MilliSatoshi, len(cfg.Targets))
+	for _, target := range cfg.Targets {
+		amounts[target] = cfg.MaxAmount
+	}
+
+	return &Prober{
+		cfg:     cfg,
+		amounts: amounts,
+		quit:    make(chan struct{}),
+	}, nil
+}
+
+// Start starts the probing loop.
+func (p *Prober) Start() error {
+	p.started.Do(func() {
+		log.Infof("Prober starting with %d targets", len(p.cfg.Targets))
+
+		p.cfg.Ticker.Resume()
+
+		p.wg.Add(1)
+		go p.probeLoop()
+	})
+
+	return nil
+}
+
+// Stop stops the probing loop and waits for all probes in flight to exit.
+func (p *Prober) Stop() error {
+	p.stopped.Do(func() {
+		log.Debugf("Prober stopping")
+
+		p.cfg.Ticker.Stop()
+		close(p.quit)
+		p.wg.Wait()
+	})
+
+	return nil
+}
+
+// Status returns a snapshot of the state of the prober.
+func (p *Prober) Status() Status {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	status := p.status
+	status.Targets = make(
+		map[route.Vertex]lnwire.MilliSatoshi, len(p.amounts),
+	)
+	for target, amt := range p.amounts {
+		status.Targets[target] = amt
+	}
+
+	return status
+}
+
+// probeLoop starts a probe on every tick.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *Prober) probeLoop() {
+	defer p.wg.Done()
+
+	for {
+		select {
+		case <-p.cfg.Ticker.Ticks():
+			target, amt, ok := p.nextProbe()
+			if !ok {
+				continue
+			}
+
+			p.wg.Add(1)
+			go p.probe(target, amt)
+
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// nextProbe selects the next target in a round-robin fashion and reserves
+// the amount of its probe, including its maximum routing fee, in the locked
+// liquidity budget. It returns false if the probe would exceed the budget.
+func (p *Prober) nextProbe() (route.Vertex, lnwire.MilliSatoshi, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	target := p.cfg.Targets[p.next]
+	p.next = (p.next + 1) % len(p.cfg.Targets)
+
+	amt := p.amounts[target]
+	locked := p.cfg.lockedAmount(amt)
+	if p.status.Locked+locked > p.cfg.MaxLocked {
+		log.Debugf("Skipping probe of %v to %v, locked liquidity "+
+			"budget exhausted", amt, target)
+
+		p.status.ProbesSkipped++
+
+		return route.Vertex{}, 0, false
+	}
+
+	p.status.Locked += locked
+	p.status.ProbesSent++
+
+	return target, amt, true
+}
+
+// probe sends a single probe to the target and adjusts the amount of the next
+// probe to the result.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *Prober) probe(target route.Vertex, amt lnwire.MilliSatoshi) {
+	defer p.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-p.quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	reached, err := p.sendProbe(ctx, target, amt)
+	switch {
+	case errors.Is(err, errProbeSettled):
+		log.Errorf("Probe of %v to %v was settled by the target, the "+
+			"amount is lost", amt, target)
+
+	case err != nil:
+		log.Debugf("Probe of %v to %v failed: %v", amt, target, err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.status.Locked -= p.cfg.lockedAmount(amt)
+
+	// Converge to the liquidity towards the target by probing a larger
+	// amount after a probe reached the target and a smaller one after a
+	// failure.
+	if reached {
+		p.status.ProbesReached++
+		p.amounts[target] = min(2*amt, p.cfg.MaxAmount)
+	} else {
+		p.status.ProbesFailed++
+		p.amounts[target] = max(amt/2, p.cfg.MinAmount)
+	}
+
+	log.Debugf("Probe of %v to %v reached target: %v", amt, target,
+		reached)
+}
+
+// sendProbe sends a probe with a random payment hash to the target. It
+// returns true if the probe reached the target, which is signaled by the
+// target rejecting the unknown payment hash.
+func (p *Prober) sendProbe(ctx context.Context, target route.Vertex,
+	amt lnwire.MilliSatoshi) (bool, error) {
+
+	var (
+		hash        lntypes.Hash
+		paymentAddr [32]byte
+	)
+	if _, err := rand.Read(hash[:]); err != nil {
+		return false, err
+	}
+	if _, err := rand.Read(paymentAddr[:]); err != nil {
+		return false, err
+	}
+
+	timeout := p.cfg.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+
+	// The probe can't be settled, so its fees are never paid. They're
+	// locked in the outgoing HTLC while the probe is in flight though.
+	payment := &routing.LightningPayment{
+		Target:            target,
+		Amount:            amt,
+		FeeLimit:          p.cfg.feeLimit(amt),
+		CltvLimit:         p.cfg.CltvLimit,
+		FinalCLTVDelta:    p.cfg.FinalCltvDelta,
+		PayAttemptTimeout: timeout,
+		PaymentAddr:       fn.Some(paymentAddr),
+		MaxParts:          1,
+	}
+	if err := payment.SetPaymentHash(hash); err != nil {
+		return false, err
+	}
+
+	_, _, err := p.cfg.SendPayment(ctx, payment)
+	if err == nil {
+		return false, fmt.Errorf("%w: %v", errProbeSettled, hash)
+	}
+
+	// The failed probe is only needed for the mission control results, so
+	// we don't keep it in the payments database.
+	delErr := p.cfg.DeletePayment(context.Background(), hash)
+	if delErr != nil {
+		log.Debugf("Unable to delete probe %v: %v", hash, delErr)
+	}
+
+	if errors.Is(err, paymentsdb.FailureReasonPaymentDetails) {
+		return true, nil
+	}
+
+	return false, err
+}
@@ -0,0 +1,244 @@
+package probe
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	paymentsdb "github.com/lightningnetwork/lnd/payments/db"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/ticker"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	targetA = route.Vertex{1}
+	targetB = route.Vertex{2}
+)
+
+// mockNetwork simulates the network towards the probe targets by failing all
+// probes that exceed the liquidity towards their target.
+type mockNetwork struct {
+	liquidity map[route.Vertex]lnwire.MilliSatoshi
+
+	// release, if set, blocks all probes until it is closed.
+	release chan struct{}
+
+	mu      sync.Mutex
+	sent    []*routing.LightningPayment
+	deleted []lntypes.Hash
+}
+
+func (m *mockNetwork) SendPayment(ctx context.Context,
+	payment *routing.LightningPayment) ([32]byte, *route.Route, error) {
+
+	m.mu.Lock()
+	m.sent = append(m.sent, payment)
+	m.mu.Unlock()
+
+	if m.release != nil {
+		select {
+		case <-m.release:
+		case <-ctx.Done():
+			return [32]byte{}, nil, ctx.Err()
+		}
+	}
+
+	if payment.Amount > m.liquidity[payment.Target] {
+		return [32]byte{}, nil, paymentsdb.FailureReasonNoRoute
+	}
+
+	return [32]byte{}, nil, paymentsdb.FailureReasonPaymentDetails
+}
+
+func (m *mockNetwork) DeletePayment(_ context.Context,
+	hash lntypes.Hash) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.deleted = append(m.deleted, hash)
+
+	return nil
+}
+
+// newTestProber creates a started prober that probes the given targets of the
+// mock network.
+func newTestProber(t *testing.T, network *mockNetwork,
+	targets ...route.Vertex) (*Prober, *ticker.Force) {
+
+	t.Helper()
+
+	tick := ticker.NewForce(time.Hour)
+	prober, err := New(&Config{
+		Targets:       targets,
+		MinAmount:     1_000,
+		MaxAmount:     16_000,
+		MaxLocked:     17_600,
+		MaxFeePPM:     100_000,
+		SendPayment:   network.SendPayment,
+		DeletePayment: network.DeletePayment,
+		Ticker:        tick,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, prober.Start())
+	t.Cleanup(func() {
+		require.NoError(t, prober.Stop())
+	})
+
+	return prober, tick
+}
+
+// probeOnce triggers a single probe and waits for it to complete.
+func probeOnce(t *testing.T, prober *Prober, tick *ticker.Force) {
+	t.Helper()
+
+	before := prober.Status()
+	tick.Force <- time.Now()
+
+	require.Eventually(t, func() bool {
+		status := prober.Status()
+		done := status.ProbesReached + status.ProbesFailed +
+			status.ProbesSkipped
+
+		return done > before.ProbesReached+before.ProbesFailed+
+			before.ProbesSkipped
+	}, time.Second, 10*time.Millisecond)
+}
+
+// TestProberConvergence tests that the probe amounts converge to the liquidity
+// towards the targets.
+func TestProberConvergence(t *testing.T) {
+	t.Parallel()
+
+	network := &mockNetwork{
+		liquidity: map[route.Vertex]lnwire.MilliSatoshi{
+			targetA: 5_000,
+			targetB: 100_000,
+		},
+	}
+	prober, tick := newTestProber(t, network, targetA, targetB)
+
+	// The targets are probed in turns. The probe to A fails and halves
+	// its amount, while the probe to B reaches the target and stays at
+	// the maximum amount.
+	probeOnce(t, prober, tick)
+	probeOnce(t, prober, tick)
+
+	status := prober.Status()
+	require.EqualValues(t, 8_000, status.Targets[targetA])
+	require.EqualValues(t, 16_000, status.Targets[targetB])
+
+	// The next probe to A fails again, after which it reaches the target
+	// with the halved amount and doubles it again.
+	probeOnce(t, prober, tick)
+	probeOnce(t, prober, tick)
+	require.EqualValues(t, 4_000, prober.Status().Targets[targetA])
+
+	probeOnce(t, prober, tick)
+	probeOnce(t, prober, tick)
+
+	status = prober.Status()
+	require.EqualValues(t, 8_000, status.Targets[targetA])
+	require.EqualValues(t, 6, status.ProbesSent)
+	require.EqualValues(t, 4, status.ProbesReached)
+	require.EqualValues(t, 2, status.ProbesFailed)
+	require.Zero(t, status.Locked)
+
+	// All probes are sent with a single part and removed from the
+	// payments database afterwards.
+	network.mu.Lock()
+	defer network.mu.Unlock()
+
+	require.Len(t, network.sent, 6)
+	require.Len(t, network.deleted, 6)
+	for i, payment := range network.sent {
+		require.EqualValues(t, 1, payment.MaxParts)
+		require.Equal(t, lntypes.Hash(payment.Identifier()),
+			network.deleted[i])
+	}
+}
+
+// TestProberBudget tests that probes are skipped while they would exceed the
+// locked liquidity budget.
+func TestProberBudget(t *testing.T) {
+	t.Parallel()
+
+	network := &mockNetwork{
+		liquidity: map[route.Vertex]lnwire.MilliSatoshi{
+			targetA: 100_000,
+		},
+		release: make(chan struct{}),
+	}
+	prober, tick := newTestProber(t, network, targetA)
+
+	// The first probe locks the whole budget, including its maximum
+	// routing fee, until it is released.
+	tick.Force <- time.Now()
+	require.Eventually(t, func() bool {
+		return prober.Status().Locked == 17_600
+	}, time.Second, 10*time.Millisecond)
+
+	network.mu.Lock()
+	require.EqualValues(t, 1_600, network.sent[0].FeeLimit)
+	network.mu.Unlock()
+
+	probeOnce(t, prober, tick)
+	require.EqualValues(t, 1, prober.Status().ProbesSkipped)
+	require.EqualValues(t, 1, prober.Status().ProbesSent)
+
+	// Once the probe completed, the budget is available again.
+	close(network.release)
+	require.Eventually(t, func() bool {
+		return prober.Status().Locked == 0
+	}, time.Second, 10*time.Millisecond)
+
+	probeOnce(t, prober, tick)
+	require.EqualValues(t, 2, prober.Status().ProbesSent)
+}
+
+// TestConfigValidate tests the validation of the prober config.
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := func() *Config {
+		return &Config{
+			Targets:   []route.Vertex{targetA},
+			MinAmount: 1_000,
+			MaxAmount: 10_000,
+			MaxLocked: 10_000,
+		}
+	}
+	require.NoError(t, valid().validate())
+
+	cfg := valid()
+	cfg.Targets = nil
+	require.Error(t, cfg.validate())
+
+	cfg = valid()
+	cfg.MinAmount = 0
+	require.Error(t, cfg.validate())
+
+	cfg = valid()
+	cfg.MinAmount = 20_000
+	require.Error(t, cfg.validate())
+
+	cfg = valid()
+	cfg.MaxLocked = 500
+	require.Error(t, cfg.validate())
+
+	// The budget must cover the maximum routing fee of a probe of the
+	// minimum amount too.
+	cfg = valid()
+	cfg.MaxFeePPM = 100_000
+	cfg.MaxLocked = 1_100
+	require.NoError(t, cfg.validate())
+
+	cfg.MaxLocked = 1_099
+	require.Error(t, cfg.validate())
+}
@@ -0,0 +1,567 @@
+package rebalance
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	paymentsdb "github.com/lightningnetwork/lnd/payments/db"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// DefaultTimeout is the default timeout of a single circular payment.
+	DefaultTimeout = 60 * time.Second
+
+	// DefaultMaxParts is the default maximum number of shards of a single
+	// circular payment.
+	DefaultMaxParts = 16
+)
+
+var (
+	// ErrRebalancerShuttingDown is returned when a rebalance is started or
+	// interrupted while the rebalancer is shutting down.
+	ErrRebalancerShuttingDown = errors.New("rebalancer shutting down")
+)
+
+// Request describes a rebalance that moves funds out of a set of source
+// channels and back into a set of target channels with circular payments.
+type Request struct {
+	// SourceChannels are the short channel IDs of the channels the funds
+	// are moved out of. The payments can leave through any of them.
+	SourceChannels []uint64
+
+	// TargetChannels are the short channel IDs of the channels the funds
+	// are moved into. The peers of the channels are tried one after the
+	// other as the last hop of the circular payments.
+	TargetChannels []uint64
+
+	// Amount is the total amount to rebalance.
+	Amount lnwire.MilliSatoshi
+
+	// MaxFee is the maximum total routing fee that may be paid. Zero means
+	// that only MaxFeeRate limits the fee.
+	MaxFee lnwire.MilliSatoshi
+
+	// MaxFeeRate is the maximum routing fee in parts per million of the
+	// rebalanced amount. Zero means that only MaxFee limits the fee.
+	MaxFeeRate uint32
+
+	// MinAmount is the smallest amount a single circular payment may
+	// carry. If a payment fails, it is retried with half the amount until
+	// this threshold is reached. Zero means that only the full remaining
+	// amount is tried.
+	MinAmount lnwire.MilliSatoshi
+
+	// MaxParts is the maximum number of shards of a single circular
+	// payment. Zero means DefaultMaxParts.
+	MaxParts uint32
+
+	// Timeout is the timeout of a single circular payment. Zero means
+	// DefaultTimeout.
+	Timeout time.Duration
+}
+
+// feeLimit returns the total fee budget of the request.
+func (r *Request) feeLimit() lnwire.MilliSatoshi {
+	limit := r.MaxFee
+	if r.MaxFeeRate != 0 {
+		rateLimit := r.Amount * lnwire.MilliSatoshi(r.MaxFeeRate) /
+			1_000_000
+
+		if limit == 0 || rateLimit < limit {
+			limit = rateLimit
+		}
+	}
+
+	return limit
+}
+
+// validate checks that the request is sane.
+func (r *Request) validate() error {
+	switch {
+	case len(r.SourceChannels) == 0:
+		return errors.New("at least one source channel required")
+
+	case len(r.TargetChannels) == 0:
+		return errors.New("at least one target channel required")
+
+	case r.Amount == 0:
+		return errors.New("amount must be positive")
+
+	case r.MaxFee == 0 && r.MaxFeeRate == 0:
+		return errors.New("either a maximum fee or a maximum fee " +
+			"rate is required")
+
+	case r.MinAmount > r.Amount:
+		return errors.New("minimum amount must not exceed the amount")
+	}
+
+	sources := fn.NewSet(r.SourceChannels...)
+	for _, target := range r.TargetChannels {
+		if sources.Contains(target) {
+			return fmt.Errorf("channel %v can't be both source "+
+				"and target", lnwire.NewShortChanIDFromInt(
+				target))
+		}
+	}
+
+	return nil
+}
+
+// Config holds the dependencies of the rebalancer.
+type Config struct {
+	// SelfNode is our own node, which is both the source and the target of
+	// the circular payments.
+	SelfNode route.Vertex
+
+	// Store persists the rebalance records.
+	Store *Store
+
+	// FetchChannelPeer returns the peer of the local channel with the
+	// given short channel ID.
+	FetchChannelPeer func(chanID uint64) (route.Vertex, error)
+
+	// AddInvoice adds the invoice that is paid by a circular payment.
+	AddInvoice func(ctx context.Context, invoice *invoices.Invoice,
+		hash lntypes.Hash) (uint64, error)
+
+	// InvoiceFeatures returns the feature vector of our invoices.
+	InvoiceFeatures func() *lnwire.FeatureVector
+
+	// SendPayment sends a payment and blocks until it succeeded or failed.
+	SendPayment func(ctx context.Context,
+		payment *routing.LightningPayment) ([32]byte, *route.Route,
+		error)
+
+	// FetchPayment fetches a payment, used to determine the total fee of
+	// a circular payment.
+	FetchPayment func(ctx context.Context,
+		hash lntypes.Hash) (paymentsdb.DBMPPayment, error)
+
+	// FinalCltvDelta is the final CLTV delta of the circular payments.
+	FinalCltvDelta uint16
+
+	// CltvLimit is the maximum total time lock of the circular payments.
+	CltvLimit uint32
+
+	// Clock is used to timestamp the rebalance records.
+	Clock clock.Clock
+}
+
+// Rebalancer moves liquidity between our channels by sending circular
+// payments to ourselves, leaving through a set of source channels and
+// arriving through a set of target channels.
+type Rebalancer struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// mu serializes updates of the records of running rebalances.
+	mu sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New creates a new rebalancer.
+func New(cfg *Config) *Rebalancer {
+	return &Rebalancer{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start marks all rebalances that were interrupted by a previous shutdown as
+// completed.
+func (r *Rebalancer) Start() error {
+	var startErr error
+	r.started.Do(func() {
+		log.Debugf("Rebalancer starting")
+
+		records, err := r.cfg.Store.FetchAll()
+		if err != nil {
+			startErr = err
+			return
+		}
+
+		for _, rec := range records {
+			if rec.Status != StatusInProgress {
+				continue
+			}
+
+			log.Infof("Marking interrupted rebalance %d as "+
+				"completed", rec.ID)
+
+			rec.FailureReason = "interrupted by shutdown"
+			rec.Status = finalStatus(rec)
+			if err := r.cfg.Store.Update(rec); err != nil {
+				startErr = err
+				return
+			}
+		}
+	})
+
+	return startErr
+}
+
+// Stop interrupts all running rebalances and waits for them to exit.
+func (r *Rebalancer) Stop() error {
+	r.stopped.Do(func() {
+		log.Debugf("Rebalancer stopping")
+
+		close(r.quit)
+		r.wg.Wait()
+	})
+
+	return nil
+}
+
+// StartRebalance validates the request and starts the rebalance in the
+// background. It returns the ID of the new rebalance record.
+func (r *Rebalancer) StartRebalance(req *Request) (uint64, error) {
+	select {
+	case <-r.quit:
+		return 0, ErrRebalancerShuttingDown
+	default:
+	}
+
+	if err := req.validate(); err != nil {
+		return 0, err
+	}
+
+	for _, chanID := range req.SourceChannels {
+		if _, err := r.cfg.FetchChannelPeer(chanID); err != nil {
+			return 0, fmt.Errorf("unknown source channel %v: %w",
+				lnwire.NewShortChanIDFromInt(chanID), err)
+		}
+	}
+
+	// Resolve the target channels to their peers, as the pathfinder can
+	// only restrict the last hop to a node.
+	var (
+		lastHops []route.Vertex
+		seen     = fn.NewSet[route.Vertex]()
+	)
+	for _, chanID := range req.TargetChannels {
+		peer, err := r.cfg.FetchChannelPeer(chanID)
+		if err != nil {
+			return 0, fmt.Errorf("unknown target channel %v: %w",
+				lnwire.NewShortChanIDFromInt(chanID), err)
+		}
+
+		if seen.Contains(peer) {
+			continue
+		}
+		seen.Add(peer)
+		lastHops = append(lastHops, peer)
+	}
+
+	rec := &Record{
+		CreationTime:   r.cfg.Clock.Now(),
+		SourceChannels: req.SourceChannels,
+		TargetChannels: req.TargetChannels,
+		Amount:         req.Amount,
+		FeeLimit:       req.feeLimit(),
+		Status:         StatusInProgress,
+	}
+	if err := r.cfg.Store.Add(rec); err != nil {
+		return 0, err
+	}
+
+	log.Infof("Starting rebalance %d of %v with fee limit %v", rec.ID,
+		rec.Amount, rec.FeeLimit)
+
+	r.wg.Add(1)
+	go r.rebalance(rec, req, lastHops)
+
+	return rec.ID, nil
+}
+
+// ListRebalances returns all rebalance records. If activeOnly is set, only
+// rebalances that are still in progress are returned.
+func (r *Rebalancer) ListRebalances(activeOnly bool) ([]*Record, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	records, err := r.cfg.Store.FetchAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if !activeOnly {
+		return records, nil
+	}
+
+	active := make([]*Record, 0, len(records))
+	for _, rec := range records {
+		if rec.Status == StatusInProgress {
+			active = append(active, rec)
+		}
+	}
+
+	return active, nil
+}
+
+// rebalance executes the rebalance, trying the last hops one after the other
+// until the full amount was rebalanced, the fee budget is exhausted or all
+// last hops were tried.
+//
+// NOTE: This MUST be run as a goroutine.
+func (r *Rebalancer) rebalance(rec *Record, req *Request,
+	lastHops []route.Vertex) {
+
+	defer r.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-r.quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	minAmount := req.MinAmount
+	if minAmount == 0 {
+		minAmount = req.Amount
+	}
+
+	var lastErr error
+	for _, lastHop := range lastHops {
+		amt := req.Amount - rec.AmountRebalanced
+		for amt > 0 {
+			// Each payment gets a share of the fee budget that is
+			// proportional to its amount, capped by what's left.
+			feeLimit := min(
+				proportionalFee(rec.FeeLimit, amt, req.Amount),
+				rec.FeeLimit-rec.FeesPaid,
+			)
+
+			attempt, err := r.attempt(
+				ctx, req, lastHop, amt, feeLimit,
+			)
+			if err != nil {
+				lastErr = err
+			}
+			r.recordAttempt(rec, attempt)
+
+			select {
+			case <-r.quit:
+				r.finish(rec, ErrRebalancerShuttingDown)
+				return
+			default:
+			}
+
+			remaining := req.Amount - rec.AmountRebalanced
+			if remaining == 0 {
+				r.finish(rec, nil)
+				return
+			}
+
+			// On success, continue with the remaining amount over
+			// the same last hop.
+			if attempt.Succeeded {
+				amt = min(amt, remaining)
+				continue
+			}
+
+			// Otherwise, retry with half the amount as long as it
+			// doesn't drop below the minimum.
+			amt /= 2
+			if amt < minAmount {
+				break
+			}
+		}
+	}
+
+	r.finish(rec, lastErr)
+}
+
+// attempt sends a single circular payment of the given amount that returns
+// through the given last hop.
+func (r *Rebalancer) attempt(ctx context.Context, req *Request,
+	lastHop route.Vertex, amt,
+	feeLimit lnwire.MilliSatoshi) (Attempt, error) {
+
+	attempt := Attempt{
+		LastHop: lastHop,
+		Amount:  amt,
+	}
+
+	var (
+		preimage    lntypes.Preimage
+		paymentAddr [32]byte
+	)
+	if _, err := rand.Read(preimage[:]); err != nil {
+		return attempt, err
+	}
+	if _, err := rand.Read(paymentAddr[:]); err != nil {
+		return attempt, err
+	}
+	attempt.PaymentHash = preimage.Hash()
+
+	timeout := req.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	maxParts := req.MaxParts
+	if maxParts == 0 {
+		maxParts = DefaultMaxParts
+	}
+
+	features := r.cfg.InvoiceFeatures()
+	invoice := &invoices.Invoice{
+		CreationDate: r.cfg.Clock.Now(),
+		Memo:         []byte("rebalance"),
+		Terms: invoices.ContractTerm{
+			FinalCltvDelta:  int32(r.cfg.FinalCltvDelta),
+			Expiry:          2 * timeout,
+			Value:           amt,
+			PaymentPreimage: &preimage,
+			PaymentAddr:     paymentAddr,
+			Features:        features,
+		},
+	}
+	_, err := r.cfg.AddInvoice(ctx, invoice, attempt.PaymentHash)
+	if err != nil {
+		attempt.Failure = err.Error()
+		return attempt, fmt.Errorf("unable to add invoice: %w", err)
+	}
+
+	payment := &routing.LightningPayment{
+		Target:             r.cfg.SelfNode,
+		Amount:             amt,
+		FeeLimit:           feeLimit,
+		CltvLimit:          r.cfg.CltvLimit,
+		FinalCLTVDelta:     r.cfg.FinalCltvDelta,
+		PayAttemptTimeout:  timeout,
+		OutgoingChannelIDs: req.SourceChannels,
+		LastHop:            &lastHop,
+		DestFeatures:       features,
+		PaymentAddr:        fn.Some(paymentAddr),
+		MaxParts:           maxParts,
+	}
+	if err := payment.SetPaymentHash(attempt.PaymentHash); err != nil {
+		return attempt, err
+	}
+
+	log.Debugf("Sending circular payment %v of %v via last hop %v with "+
+		"fee limit %v", attempt.PaymentHash, amt, lastHop, feeLimit)
+
+	_, _, err = r.cfg.SendPayment(ctx, payment)
+	if err != nil {
+		attempt.Failure = err.Error()
+		return attempt, err
+	}
+
+	attempt.Succeeded = true
+
+	// The returned route only belongs to the last shard, so we sum up the
+	// fees of all settled shards of the payment.
+	dbPayment, err := r.cfg.FetchPayment(ctx, attempt.PaymentHash)
+	if err != nil {
+		log.Errorf("Unable to fetch circular payment %v: %v",
+			attempt.PaymentHash, err)
+
+		return attempt, nil
+	}
+	for _, htlc := range dbPayment.GetHTLCs() {
+		if htlc.Settle != nil {
+			attempt.Fee += htlc.Route.TotalFees()
+		}
+	}
+
+	return attempt, nil
+}
+
+// recordAttempt adds the attempt to the record and persists it.
+func (r *Rebalancer) recordAttempt(rec *Record, attempt Attempt) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec.Attempts = append(rec.Attempts, attempt)
+	if attempt.Succeeded {
+		rec.AmountRebalanced += attempt.Amount
+		rec.FeesPaid += attempt.Fee
+
+		log.Infof("Rebalance %d moved %v for a fee of %v via last "+
+			"hop %v", rec.ID, attempt.Amount, attempt.Fee,
+			attempt.LastHop)
+	} else {
+		log.Debugf("Rebalance %d attempt of %v via last hop %v "+
+			"failed: %v", rec.ID, attempt.Amount, attempt.LastHop,
+			attempt.Failure)
+	}
+
+	if err := r.cfg.Store.Update(rec); err != nil {
+		log.Errorf("Unable to update rebalance %d: %v", rec.ID, err)
+	}
+}
+
+// finish marks the rebalance as completed and persists it.
+func (r *Rebalancer) finish(rec *Record, lastErr error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if rec.AmountRebalanced != rec.Amount {
+		switch {
+		case lastErr != nil:
+			rec.FailureReason = lastErr.Error()
+
+		default:
+			rec.FailureReason = "no route found within fee limit"
+		}
+	}
+	rec.Status = finalStatus(rec)
+
+	log.Infof("Rebalance %d %v: rebalanced %v of %v for a fee of %v",
+		rec.ID, rec.Status, rec.AmountRebalanced, rec.Amount,
+		rec.FeesPaid)
+
+	if err := r.cfg.Store.Update(rec); err != nil {
+		log.Errorf("Unable to update rebalance %d: %v", rec.ID, err)
+	}
+}
+
+// finalStatus derives the status of a completed rebalance from the amount it
+// rebalanced.
+func finalStatus(rec *Record) Status {
+	switch {
+	case rec.AmountRebalanced >= rec.Amount:
+		return StatusSucceeded
+
+	case rec.AmountRebalanced > 0:
+		return StatusPartiallySucceeded
+
+	default:
+		return StatusFailed
+	}
+}
+
+// proportionalFee returns the share of the fee limit that corresponds to amt
+// out of total.
+func proportionalFee(feeLimit, amt,
+	total lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+
+	if total == 0 {
+		return 0
+	}
+
+	share := float64(feeLimit) * float64(amt) / float64(total)
+
+	return lnwire.MilliSatoshi(math.Floor(share))
+}
@@ -0,0 +1,451 @@
+package rebalance
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	paymentsdb "github.com/lightningnetwork/lnd/payments/db"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	selfNode = route.Vertex{1}
+	peerA    = route.Vertex{2}
+	peerB    = route.Vertex{3}
+	peerC    = route.Vertex{4}
+
+	testChannels = map[uint64]route.Vertex{
+		10: peerC,
+		20: peerA,
+		21: peerA,
+		30: peerB,
+	}
+)
+
+// newTestStore creates a rebalance store backed by a temporary database.
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+
+	db, cleanup, err := kvdb.GetTestBackend(t.TempDir(), "rebalance")
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	store, err := NewStore(db)
+	require.NoError(t, err)
+
+	return store
+}
+
+// TestStore tests that rebalance records are persisted and updated.
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	store := newTestStore(t)
+
+	records, err := store.FetchAll()
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	rec1 := &Record{
+		CreationTime:   time.Unix(0, 1_000),
+		SourceChannels: []uint64{10},
+		TargetChannels: []uint64{20, 30},
+		Amount:         1_000_000,
+		FeeLimit:       1_000,
+		Status:         StatusInProgress,
+	}
+	rec2 := &Record{
+		CreationTime:   time.Unix(0, 2_000),
+		SourceChannels: []uint64{10, 11},
+		TargetChannels: []uint64{30},
+		Amount:         2_000_000,
+		FeeLimit:       2_000,
+		Status:         StatusInProgress,
+	}
+	require.NoError(t, store.Add(rec1))
+	require.NoError(t, store.Add(rec2))
+	require.NotEqual(t, rec1.ID, rec2.ID)
+
+	rec1.Attempts = []Attempt{{
+		PaymentHash: lntypes.Hash{1},
+		LastHop:     peerA,
+		Amount:      1_000_000,
+		Failure:     "no route",
+	}, {
+		PaymentHash: lntypes.Hash{2},
+		LastHop:     peerB,
+		Amount:      500_000,
+		Fee:         50,
+		Succeeded:   true,
+	}}
+	rec1.AmountRebalanced = 500_000
+	rec1.FeesPaid = 50
+	rec1.Status = StatusPartiallySucceeded
+	rec1.FailureReason = "no route"
+	require.NoError(t, store.Update(rec1))
+
+	records, err = store.FetchAll()
+	require.NoError(t, err)
+	require.Equal(t, []*Record{rec1, rec2}, records)
+
+	// Updating an unknown record fails.
+	require.ErrorIs(t, store.Update(&Record{ID: 100}), ErrRecordNotFound)
+}
+
+// mockPayments simulates circular payments that succeed or fail depending on
+// their last hop and amount.
+type mockPayments struct {
+	mu sync.Mutex
+
+	// maxAmt is the maximum amount that can be sent via each last hop.
+	maxAmt map[route.Vertex]lnwire.MilliSatoshi
+
+	// maxSuccesses is the number of payments that succeed via each last
+	// hop.
+	maxSuccesses map[route.Vertex]int
+
+	invoices map[lntypes.Hash]*invoices.Invoice
+	payments map[lntypes.Hash]*paymentsdb.MPPayment
+}
+
+func (m *mockPayments) addInvoice(_ context.Context,
+	invoice *invoices.Invoice, hash lntypes.Hash) (uint64, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.invoices[hash] = invoice
+
+	return uint64(len(m.invoices)), nil
+}
+
+func (m *mockPayments) sendPayment(_ context.Context,
+	payment *routing.LightningPayment) ([32]byte, *route.Route, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	hash := lntypes.Hash(payment.Identifier())
+	invoice, ok := m.invoices[hash]
+	if !ok {
+		return [32]byte{}, nil, errors.New("unknown invoice")
+	}
+	if payment.Target != selfNode || payment.LastHop == nil {
+		return [32]byte{}, nil, errors.New("not a circular payment")
+	}
+
+	lastHop := *payment.LastHop
+	if payment.Amount > m.maxAmt[lastHop] || m.maxSuccesses[lastHop] == 0 {
+		return [32]byte{}, nil, errors.New("no route")
+	}
+	m.maxSuccesses[lastHop]--
+
+	// Pay 1 msat fee per 1000 msat.
+	rt := route.Route{
+		TotalAmount: payment.Amount + payment.Amount/1000,
+		Hops: []*route.Hop{{
+			PubKeyBytes:  selfNode,
+			AmtToForward: payment.Amount,
+		}},
+	}
+	m.payments[hash] = &paymentsdb.MPPayment{
+		HTLCs: []paymentsdb.HTLCAttempt{{
+			HTLCAttemptInfo: paymentsdb.HTLCAttemptInfo{
+				Route: rt,
+			},
+			Settle: &paymentsdb.HTLCSettleInfo{
+				Preimage: *invoice.Terms.PaymentPreimage,
+			},
+		}},
+	}
+
+	return *invoice.Terms.PaymentPreimage, &rt, nil
+}
+
+func (m *mockPayments) fetchPayment(_ context.Context,
+	hash lntypes.Hash) (paymentsdb.DBMPPayment, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	payment, ok := m.payments[hash]
+	if !ok {
+		return nil, paymentsdb.ErrPaymentNotInitiated
+	}
+
+	return payment, nil
+}
+
+// newTestRebalancer creates a started rebalancer with mocked payments.
+func newTestRebalancer(t *testing.T, store *Store,
+	payments *mockPayments) *Rebalancer {
+
+	t.Helper()
+
+	r := New(&Config{
+		SelfNode: selfNode,
+		Store:    store,
+		FetchChannelPeer: func(chanID uint64) (route.Vertex, error) {
+			peer, ok := testChannels[chanID]
+			if !ok {
+				return route.Vertex{}, errors.New("not found")
+			}
+
+			return peer, nil
+		},
+		AddInvoice: payments.addInvoice,
+		InvoiceFeatures: func() *lnwire.FeatureVector {
+			return lnwire.EmptyFeatureVector()
+		},
+		SendPayment:    payments.sendPayment,
+		FetchPayment:   payments.fetchPayment,
+		FinalCltvDelta: 40,
+		CltvLimit:      2016,
+		Clock:          clock.NewTestClock(time.Unix(1_000, 0)),
+	})
+	require.NoError(t, r.Start())
+	t.Cleanup(func() {
+		require.NoError(t, r.Stop())
+	})
+
+	return r
+}
+
+// waitForCompletion waits until the rebalance with the given ID completed and
+// returns its record.
+func waitForCompletion(t *testing.T, r *Rebalancer, id uint64) *Record {
+	t.Helper()
+
+	var rec *Record
+	require.Eventually(t, func() bool {
+		records, err := r.ListRebalances(false)
+		require.NoError(t, err)
+
+		for _, record := range records {
+			if record.ID == id {
+				rec = record
+			}
+		}
+
+		return rec != nil && rec.Status != StatusInProgress
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return rec
+}
+
+// TestRebalance tests that a rebalance splits the amount and moves on to the
+// next target peer when payments fail, and that its costs are recorded.
+func TestRebalance(t *testing.T) {
+	t.Parallel()
+
+	payments := &mockPayments{
+		maxAmt: map[route.Vertex]lnwire.MilliSatoshi{
+			peerA: 500_000,
+			peerB: 1_000_000,
+		},
+		maxSuccesses: map[route.Vertex]int{
+			peerA: 1,
+			peerB: 10,
+		},
+		invoices: make(map[lntypes.Hash]*invoices.Invoice),
+		payments: make(map[lntypes.Hash]*paymentsdb.MPPayment),
+	}
+	r := newTestRebalancer(t, newTestStore(t), payments)
+
+	// Target channels 20 and 21 share the same peer, which should only be
+	// tried once.
+	id, err := r.StartRebalance(&Request{
+		SourceChannels: []uint64{10},
+		TargetChannels: []uint64{20, 21, 30},
+		Amount:         1_000_000,
+		MaxFee:         10_000,
+		MaxFeeRate:     5_000,
+		MinAmount:      250_000,
+	})
+	require.NoError(t, err)
+
+	rec := waitForCompletion(t, r, id)
+	require.Equal(t, StatusSucceeded, rec.Status)
+	require.EqualValues(t, 1_000_000, rec.AmountRebalanced)
+	require.EqualValues(t, 1_000, rec.FeesPaid)
+	require.EqualValues(t, 5_000, rec.FeeLimit)
+	require.Empty(t, rec.FailureReason)
+
+	// Peer A fails the full amount, succeeds with half of it, and then
+	// fails with the remaining half and a quarter. After that, the
+	// remaining half is sent via peer B.
+	expected := []struct {
+		lastHop   route.Vertex
+		amt       lnwire.MilliSatoshi
+		succeeded bool
+	}{
+		{peerA, 1_000_000, false},
+		{peerA, 500_000, true},
+		{peerA, 500_000, false},
+		{peerA, 250_000, false},
+		{peerB, 500_000, true},
+	}
+	require.Len(t, rec.Attempts, len(expected))
+	for i, exp := range expected {
+		attempt := rec.Attempts[i]
+		require.Equal(t, exp.lastHop, attempt.LastHop, "attempt %d", i)
+		require.Equal(t, exp.amt, attempt.Amount, "attempt %d", i)
+		require.Equal(t, exp.succeeded, attempt.Succeeded,
+			"attempt %d", i)
+
+		if exp.succeeded {
+			require.Equal(t, exp.amt/1000, attempt.Fee)
+			require.Empty(t, attempt.Failure)
+		} else {
+			require.Zero(t, attempt.Fee)
+			require.NotEmpty(t, attempt.Failure)
+		}
+	}
+
+	active, err := r.ListRebalances(true)
+	require.NoError(t, err)
+	require.Empty(t, active)
+}
+
+// TestRebalancePartial tests that a rebalance that can only move a part of the
+// amount is recorded as partially succeeded.
+func TestRebalancePartial(t *testing.T) {
+	t.Parallel()
+
+	payments := &mockPayments{
+		maxAmt: map[route.Vertex]lnwire.MilliSatoshi{
+			peerA: 400_000,
+		},
+		maxSuccesses: map[route.Vertex]int{
+			peerA: 1,
+		},
+		invoices: make(map[lntypes.Hash]*invoices.Invoice),
+		payments: make(map[lntypes.Hash]*paymentsdb.MPPayment),
+	}
+	r := newTestRebalancer(t, newTestStore(t), payments)
+
+	id, err := r.StartRebalance(&Request{
+		SourceChannels: []uint64{10},
+		TargetChannels: []uint64{20},
+		Amount:         1_000_000,
+		MaxFeeRate:     1_000,
+		MinAmount:      200_000,
+	})
+	require.NoError(t, err)
+
+	rec := waitForCompletion(t, r, id)
+	require.Equal(t, StatusPartiallySucceeded, rec.Status)
+	require.EqualValues(t, 250_000, rec.AmountRebalanced)
+	require.EqualValues(t, 250, rec.FeesPaid)
+	require.Equal(t, "no route", rec.FailureReason)
+}
+
+// TestStartRebalanceValidation tests that invalid requests are rejected.
+func TestStartRebalanceValidation(t *testing.T) {
+	t.Parallel()
+
+	payments := &mockPayments{
+		invoices: make(map[lntypes.Hash]*invoices.Invoice),
+		payments: make(map[lntypes.Hash]*paymentsdb.MPPayment),
+	}
+	r := newTestRebalancer(t, newTestStore(t), payments)
+
+	valid := Request{
+		SourceChannels: []uint64{10},
+		TargetChannels: []uint64{20},
+		Amount:         1_000_000,
+		MaxFee:         1_000,
+	}
+
+	tests := []struct {
+		name   string
+		modify func(req *Request)
+	}{{
+		name: "no source",
+		modify: func(req *Request) {
+			req.SourceChannels = nil
+		},
+	}, {
+		name: "no target",
+		modify: func(req *Request) {
+			req.TargetChannels = nil
+		},
+	}, {
+		name: "no amount",
+		modify: func(req *Request) {
+			req.Amount = 0
+		},
+	}, {
+		name: "no fee limit",
+		modify: func(req *Request) {
+			req.MaxFee = 0
+		},
+	}, {
+		name: "min amount too large",
+		modify: func(req *Request) {
+			req.MinAmount = req.Amount + 1
+		},
+	}, {
+		name: "source is target",
+		modify: func(req *Request) {
+			req.TargetChannels = []uint64{20, 10}
+		},
+	}, {
+		name: "unknown source",
+		modify: func(req *Request) {
+			req.SourceChannels = []uint64{99}
+		},
+	}, {
+		name: "unknown target",
+		modify: func(req *Request) {
+			req.TargetChannels = []uint64{99}
+		},
+	}}
+	for _, test := range tests {
+		req := valid
+		test.modify(&req)
+
+		_, err := r.StartRebalance(&req)
+		require.Error(t, err, test.name)
+	}
+
+	records, err := r.ListRebalances(false)
+	require.NoError(t, err)
+	require.Empty(t, records)
+}
+
+// TestStartInterrupted tests that rebalances that were still in progress
+// during a previous shutdown are marked as completed on startup.
+func TestStartInterrupted(t *testing.T) {
+	t.Parallel()
+
+	store := newTestStore(t)
+	interrupted := &Record{
+		CreationTime:     time.Unix(1, 0),
+		SourceChannels:   []uint64{10},
+		TargetChannels:   []uint64{20},
+		Amount:           1_000_000,
+		FeeLimit:         1_000,
+		Status:           StatusInProgress,
+		AmountRebalanced: 100_000,
+	}
+	require.NoError(t, store.Add(interrupted))
+
+	r := newTestRebalancer(t, store, &mockPayments{})
+
+	records, err := r.ListRebalances(false)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, StatusPartiallySucceeded, records[0].Status)
+	require.NotEmpty(t, records[0].FailureReason)
+}
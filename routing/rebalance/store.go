@@ -0,0 +1,417 @@
+package rebalance
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// rebalanceBucketKey is the key of the top-level bucket that holds
+	// all rebalance records.
+	//
+	// maps: id -> Record
+	rebalanceBucketKey = []byte("rebalance-log")
+
+	byteOrder = binary.BigEndian
+
+	// ErrRecordNotFound is returned when a rebalance record with the
+	// given ID does not exist.
+	ErrRecordNotFound = errors.New("rebalance record not found")
+)
+
+const (
+	// maxStringLen is the maximum length of a serialized failure string.
+	maxStringLen = 1024
+
+	// maxNumChannels is the maximum number of source or target channels of
+	// a serialized record.
+	maxNumChannels = 1 << 16
+
+	// maxNumAttempts is the maximum number of attempts of a serialized
+	// record.
+	maxNumAttempts = 1 << 16
+)
+
+// Status is the status of a rebalance.
+type Status uint8
+
+const (
+	// StatusInProgress indicates that the rebalance is still running.
+	StatusInProgress Status = 0
+
+	// StatusSucceeded indicates that the full amount was rebalanced.
+	StatusSucceeded Status = 1
+
+	// StatusPartiallySucceeded indicates that only a part of the amount
+	// was rebalanced.
+	StatusPartiallySucceeded Status = 2
+
+	// StatusFailed indicates that nothing could be rebalanced.
+	StatusFailed Status = 3
+)
+
+// String returns a human-readable representation of the status.
+func (s Status) String() string {
+	switch s {
+	case StatusInProgress:
+		return "in_progress"
+
+	case StatusSucceeded:
+		return "succeeded"
+
+	case StatusPartiallySucceeded:
+		return "partially_succeeded"
+
+	case StatusFailed:
+		return "failed"
+
+	default:
+		return fmt.Sprintf("unknown<%d>", uint8(s))
+	}
+}
+
+// Attempt is a single circular payment made as part of a rebalance.
+type Attempt struct {
+	// PaymentHash is the hash of the circular payment.
+	PaymentHash lntypes.Hash
+
+	// LastHop is the peer the payment was routed back through.
+	LastHop route.Vertex
+
+	// Amount is the amount of the payment, excluding fees.
+	Amount lnwire.MilliSatoshi
+
+	// Fee is the total routing fee paid for the payment. It is only set
+	// for successful attempts.
+	Fee lnwire.MilliSatoshi
+
+	// Succeeded is true if the payment succeeded.
+	Succeeded bool
+
+	// Failure is the reason the payment failed, if any.
+	Failure string
+}
+
+// Record holds the parameters and the outcome of a rebalance.
+type Record struct {
+	// ID is the unique identifier of the rebalance.
+	ID uint64
+
+	// CreationTime is the time the rebalance was started.
+	CreationTime time.Time
+
+	// SourceChannels are the channels the funds are moved out of.
+	SourceChannels []uint64
+
+	// TargetChannels are the channels the funds are moved into.
+	TargetChannels []uint64
+
+	// Amount is the amount that should be rebalanced.
+	Amount lnwire.MilliSatoshi
+
+	// FeeLimit is the maximum total fee that may be paid.
+	FeeLimit lnwire.MilliSatoshi
+
+	// Status is the current status of the rebalance.
+	Status Status
+
+	// AmountRebalanced is the amount that was successfully rebalanced.
+	AmountRebalanced lnwire.MilliSatoshi
+
+	// FeesPaid is the total routing fee paid for all successful attempts.
+	FeesPaid lnwire.MilliSatoshi
+
+	// Attempts are all circular payments made as part of the rebalance.
+	Attempts []Attempt
+
+	// FailureReason holds the reason the rebalance didn't complete, if
+	// any.
+	FailureReason string
+}
+
+// Store persists rebalance records.
+type Store struct {
+	db kvdb.Backend
+}
+
+// NewStore creates a new rebalance store, creating its bucket if needed.
+func NewStore(db kvdb.Backend) (*Store, error) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(rebalanceBucketKey)
+		return err
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Add stores a new record, assigning it a fresh ID.
+func (s *Store) Add(r *Record) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(rebalanceBucketKey)
+		if bucket == nil {
+			return ErrRecordNotFound
+		}
+
+		id, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		r.ID = id
+
+		return putRecord(bucket, r)
+	}, func() {})
+}
+
+// Update overwrites an existing record.
+func (s *Store) Update(r *Record) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(rebalanceBucketKey)
+		if bucket == nil {
+			return ErrRecordNotFound
+		}
+
+		var key [8]byte
+		byteOrder.PutUint64(key[:], r.ID)
+		if bucket.Get(key[:]) == nil {
+			return ErrRecordNotFound
+		}
+
+		return putRecord(bucket, r)
+	}, func() {})
+}
+
+// FetchAll returns all records ordered by their ID.
+func (s *Store) FetchAll() ([]*Record, error) {
+	var records []*Record
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(rebalanceBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			r, err := deserializeRecord(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+			r.ID = byteOrder.Uint64(k)
+			records = append(records, r)
+
+			return nil
+		})
+	}, func() {
+		records = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// putRecord serializes the record and stores it under its ID.
+func putRecord(bucket kvdb.RwBucket, r *Record) error {
+	var b bytes.Buffer
+	if err := serializeRecord(&b, r); err != nil {
+		return err
+	}
+
+	var key [8]byte
+	byteOrder.PutUint64(key[:], r.ID)
+
+	return bucket.Put(key[:], b.Bytes())
+}
+
+// serializeRecord writes the record, except its ID which is used as the key,
+// to the given writer.
+func serializeRecord(w io.Writer, r *Record) error {
+	err := binary.Write(w, byteOrder, r.CreationTime.UnixNano())
+	if err != nil {
+		return err
+	}
+
+	if err := writeChannels(w, r.SourceChannels); err != nil {
+		return err
+	}
+	if err := writeChannels(w, r.TargetChannels); err != nil {
+		return err
+	}
+
+	err = binary.Write(w, byteOrder, []uint64{
+		uint64(r.Amount), uint64(r.FeeLimit),
+		uint64(r.AmountRebalanced), uint64(r.FeesPaid),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, r.Status); err != nil {
+		return err
+	}
+
+	err = wire.WriteVarString(w, 0, truncate(r.FailureReason))
+	if err != nil {
+		return err
+	}
+
+	err = binary.Write(w, byteOrder, uint32(len(r.Attempts)))
+	if err != nil {
+		return err
+	}
+	for _, a := range r.Attempts {
+		if _, err := w.Write(a.PaymentHash[:]); err != nil {
+			return err
+		}
+		if _, err := w.Write(a.LastHop[:]); err != nil {
+			return err
+		}
+
+		err := binary.Write(w, byteOrder, []uint64{
+			uint64(a.Amount), uint64(a.Fee),
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := binary.Write(w, byteOrder, a.Succeeded); err != nil {
+			return err
+		}
+
+		err = wire.WriteVarString(w, 0, truncate(a.Failure))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deserializeRecord reads a record that was written by serializeRecord.
+func deserializeRecord(r io.Reader) (*Record, error) {
+	var (
+		rec          Record
+		creationTime int64
+		err          error
+	)
+	if err := binary.Read(r, byteOrder, &creationTime); err != nil {
+		return nil, err
+	}
+	rec.CreationTime = time.Unix(0, creationTime)
+
+	if rec.SourceChannels, err = readChannels(r); err != nil {
+		return nil, err
+	}
+	if rec.TargetChannels, err = readChannels(r); err != nil {
+		return nil, err
+	}
+
+	var amounts [4]uint64
+	if err := binary.Read(r, byteOrder, &amounts); err != nil {
+		return nil, err
+	}
+	rec.Amount = lnwire.MilliSatoshi(amounts[0])
+	rec.FeeLimit = lnwire.MilliSatoshi(amounts[1])
+	rec.AmountRebalanced = lnwire.MilliSatoshi(amounts[2])
+	rec.FeesPaid = lnwire.MilliSatoshi(amounts[3])
+
+	if err := binary.Read(r, byteOrder, &rec.Status); err != nil {
+		return nil, err
+	}
+
+	rec.FailureReason, err = wire.ReadVarString(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var numAttempts uint32
+	if err := binary.Read(r, byteOrder, &numAttempts); err != nil {
+		return nil, err
+	}
+	if numAttempts > maxNumAttempts {
+		return nil, fmt.Errorf("too many attempts: %d", numAttempts)
+	}
+
+	for i := uint32(0); i < numAttempts; i++ {
+		var a Attempt
+		if _, err := io.ReadFull(r, a.PaymentHash[:]); err != nil {
+			return nil, err
+		}
+		if _, err := io.ReadFull(r, a.LastHop[:]); err != nil {
+			return nil, err
+		}
+
+		var amounts [2]uint64
+		if err := binary.Read(r, byteOrder, &amounts); err != nil {
+			return nil, err
+		}
+		a.Amount = lnwire.MilliSatoshi(amounts[0])
+		a.Fee = lnwire.MilliSatoshi(amounts[1])
+
+		if err := binary.Read(r, byteOrder, &a.Succeeded); err != nil {
+			return nil, err
+		}
+
+		a.Failure, err = wire.ReadVarString(r, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		rec.Attempts = append(rec.Attempts, a)
+	}
+
+	return &rec, nil
+}
+
+// writeChannels writes a length prefixed list of channel IDs.
+func writeChannels(w io.Writer, chans []uint64) error {
+	if len(chans) > maxNumChannels {
+		return fmt.Errorf("too many channels: %d", len(chans))
+	}
+
+	err := binary.Write(w, byteOrder, uint32(len(chans)))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, byteOrder, chans)
+}
+
+// readChannels reads a list of channel IDs written by writeChannels.
+func readChannels(r io.Reader) ([]uint64, error) {
+	var numChans uint32
+	if err := binary.Read(r, byteOrder, &numChans); err != nil {
+		return nil, err
+	}
+	if numChans > maxNumChannels {
+		return nil, fmt.Errorf("too many channels: %d", numChans)
+	}
+
+	chans := make([]uint64, numChans)
+	if err := binary.Read(r, byteOrder, chans); err != nil {
+		return nil, err
+	}
+
+	return chans, nil
+}
+
+// truncate limits the length of the given string to maxStringLen.
+func truncate(s string) string {
+	if len(s) > maxStringLen {
+		return s[:maxStringLen]
+	}
+
+	return s
+}
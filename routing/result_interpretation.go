@@ -127,9 +127,17 @@ func (i *interpretedResult) processSuccess(route *mcRoute) {
 // processFail processes a failed payment attempt.
 func (i *interpretedResult) processFail(rt *mcRoute, failure paymentFailure) {
 	// Not having a source index means that we were unable to decrypt the
-	// error message.
+	// error message. The attribution data of the failure may still have
+	// allowed us to narrow down the nodes responsible for it.
 	if failure.sourceIdx.IsNone() {
-		i.processPaymentOutcomeUnknown(rt)
+		if failure.attributedIdx.IsNone() {
+			i.processPaymentOutcomeUnknown(rt)
+			return
+		}
+
+		attributedIdx := failure.attributedIdx.ValOpt().UnwrapOr(0)
+		i.processPaymentOutcomeAttributed(rt, int(attributedIdx))
+
 		return
 	}
 
@@ -321,6 +329,19 @@ func (i *interpretedResult) processPaymentOutcomeFinal(route *mcRoute,
 	case *lnwire.FailInvalidBlinding:
 		failNode()
 
+	// The final hop is a trampoline node that wasn't able to forward the
+	// payment to the recipient. Penalize the trampoline node, but don't
+	// fail the payment so that another trampoline node can be tried.
+	case *lnwire.FailTemporaryTrampolineFailure,
+		*lnwire.FailTrampolineFeeOrExpiryInsufficient:
+
+		i.failNode(route, n)
+
+		// The channels in the route forwarded correctly.
+		if n > 1 {
+			i.successPairRange(route, 0, n-2)
+		}
+
 	// All other errors are considered terminal if coming from the
 	// final hop. They indicate that something is wrong at the
 	// recipient, so we do apply a penalty.
@@ -602,6 +623,45 @@ func (i *interpretedResult) processPaymentOutcomeUnknown(route *mcRoute) {
 	i.failPairRange(route, 0, n-1)
 }
 
+// processPaymentOutcomeAttributed processes a payment outcome for which the
+// failure message couldn't be decrypted, but which was attributed to the pair
+// of nodes ending at the given index.
+func (i *interpretedResult) processPaymentOutcomeAttributed(rt *mcRoute,
+	attributedIdx int) {
+
+	n := len(rt.hops.Val)
+
+	// Nodes after the introduction point of a blinded route don't add
+	// attribution data of their own, so we can't rely on an attribution
+	// to them.
+	introIdx, isBlinded := introductionPointIndex(rt)
+	if attributedIdx < 1 || attributedIdx > n ||
+		(isBlinded && attributedIdx > introIdx) {
+
+		i.processPaymentOutcomeUnknown(rt)
+		return
+	}
+
+	// The attribution data of the nodes before the attributed node is
+	// valid, so they relayed the failure correctly.
+	if attributedIdx > 1 {
+		i.successPairRange(rt, 0, attributedIdx-2)
+	}
+
+	// If all attribution data is valid, the final node sent us a failure
+	// that we can't read. There is no point in retrying in that case.
+	if attributedIdx == n {
+		i.failNode(rt, n)
+		i.finalFailureReason = &reasonError
+
+		return
+	}
+
+	// Otherwise either the attributed node or its predecessor corrupted
+	// the failure, so we penalize the channel between them.
+	i.failPair(rt, attributedIdx-1)
+}
+
 // extractMCRoute extracts the fields required by MC from the Route struct to
 // create the more minimal mcRoute struct.
 func extractMCRoute(r *route.Route) *mcRoute {
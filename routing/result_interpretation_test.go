@@ -9,6 +9,7 @@ import (
 	"github.com/lightningnetwork/lnd/fn/v2"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tlv"
 )
 
 var (
@@ -206,6 +207,10 @@ type resultTestCase struct {
 	failureSrcIdx int
 	failure       lnwire.FailureMessage
 
+	// attributedIdx is the index an unreadable failure was attributed to.
+	// If non-zero, the failure source is unknown.
+	attributedIdx int
+
 	expectedResult *interpretedResult
 }
 
@@ -685,6 +690,25 @@ var resultTestCases = []resultTestCase{
 			finalFailureReason: &reasonError,
 		},
 	},
+	// A trampoline node at the end of the route failed to forward the
+	// payment. The trampoline node is penalized, but the payment can
+	// continue with another trampoline node.
+	{
+		name:          "final node trampoline failure",
+		route:         routeThreeHop,
+		failureSrcIdx: 3,
+		failure:       &lnwire.FailTemporaryTrampolineFailure{},
+
+		expectedResult: &interpretedResult{
+			pairResults: map[DirectedNodePair]pairResult{
+				getTestPair(0, 1): successPairResult(100),
+				getTestPair(1, 2): successPairResult(99),
+				getTestPair(2, 3): failPairResult(0),
+				getTestPair(3, 2): failPairResult(0),
+			},
+			nodeFailure: &hops[3],
+		},
+	},
 	// Introduction node returns invalid blinding erroneously.
 	{
 		name:          "final node intro blinding",
@@ -721,6 +745,41 @@ var resultTestCases = []resultTestCase{
 			},
 		},
 	},
+	// Test that an unreadable failure attributed to an intermediate node
+	// penalizes the channel into that node and rewards the hops before.
+	{
+		name:          "unreadable failure attributed intermediate",
+		route:         routeFourHop,
+		attributedIdx: 3,
+
+		expectedResult: &interpretedResult{
+			pairResults: map[DirectedNodePair]pairResult{
+				getTestPair(0, 1): successPairResult(100),
+				getTestPair(1, 2): successPairResult(99),
+				getTestPair(2, 3): failPairResult(0),
+				getTestPair(3, 2): failPairResult(0),
+			},
+		},
+	},
+	// Test that an unreadable failure with valid attribution data of all
+	// hops is blamed on the final node.
+	{
+		name:          "unreadable failure attributed final",
+		route:         routeFourHop,
+		attributedIdx: 4,
+
+		expectedResult: &interpretedResult{
+			pairResults: map[DirectedNodePair]pairResult{
+				getTestPair(0, 1): successPairResult(100),
+				getTestPair(1, 2): successPairResult(99),
+				getTestPair(2, 3): successPairResult(97),
+				getTestPair(3, 4): failPairResult(0),
+				getTestPair(4, 3): failPairResult(0),
+			},
+			nodeFailure:        &hops[4],
+			finalFailureReason: &reasonError,
+		},
+	},
 }
 
 // TestResultInterpretation executes a list of test cases that test the result
@@ -731,7 +790,19 @@ func TestResultInterpretation(t *testing.T) {
 	for _, testCase := range resultTestCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			var failure fn.Option[paymentFailure]
-			if !testCase.success {
+			switch {
+			case testCase.success:
+
+			case testCase.attributedIdx != 0:
+				idx := uint8(testCase.attributedIdx)
+				record := tlv.NewPrimitiveRecord[tlv.TlvType2](
+					idx,
+				)
+				failure = fn.Some(paymentFailure{
+					attributedIdx: tlv.SomeRecordT(record),
+				})
+
+			default:
 				failure = fn.Some(newPaymentFailure(
 					&testCase.failureSrcIdx,
 					testCase.failure,
@@ -186,6 +186,12 @@ type Hop struct {
 	// spread over more than one HTLC. This field should only be set for
 	// the final hop in a blinded path.
 	TotalAmtMsat lnwire.MilliSatoshi
+
+	// UpfrontFee is the unconditional fee that this hop is paid for
+	// forwarding the HTLC, regardless of whether the HTLC is settled or
+	// failed. It is only set if all forwarding nodes of the route support
+	// experimental upfront fees.
+	UpfrontFee lnwire.MilliSatoshi
 }
 
 // Copy returns a deep copy of the Hop.
@@ -629,6 +635,18 @@ func (r *Route) TotalFees() lnwire.MilliSatoshi {
 	return r.TotalAmount - r.ReceiverAmt()
 }
 
+// TotalUpfrontFee returns the sum of the upfront fees of all hops in the route.
+// This is the upfront fee that must be attached to the HTLC that is sent to the
+// first hop, as every hop keeps its own share and passes on the remainder.
+func (r *Route) TotalUpfrontFee() lnwire.MilliSatoshi {
+	var total lnwire.MilliSatoshi
+	for _, hop := range r.Hops {
+		total += hop.UpfrontFee
+	}
+
+	return total
+}
+
 // ReceiverAmt is the amount received by the final hop of this route.
 func (r *Route) ReceiverAmt() lnwire.MilliSatoshi {
 	if len(r.Hops) == 0 {
@@ -98,6 +98,10 @@ var (
 	// ErrSkipTempErr is returned when a non-MPP is made yet the
 	// skipTempErr flag is set.
 	ErrSkipTempErr = errors.New("cannot skip temp error for non-MPP")
+
+	// ErrPaymentNotActive is returned when canceling a payment that isn't
+	// currently being sent.
+	ErrPaymentNotActive = errors.New("payment not active")
 )
 
 // PaymentAttemptDispatcher is used by the router to send payment attempts onto
@@ -175,6 +179,13 @@ type MissionControlQuerier interface {
 		failureSourceIdx *int, failure lnwire.FailureMessage) (
 		*paymentsdb.FailureReason, error)
 
+	// ReportPaymentAttributedFail reports a failed payment whose failure
+	// message couldn't be decrypted to mission control. The attributed
+	// index is the node that, together with its predecessor, is held
+	// responsible for the failure by its attribution data.
+	ReportPaymentAttributedFail(attemptID uint64, rt *route.Route,
+		attributedIdx int) (*paymentsdb.FailureReason, error)
+
 	// ReportPaymentSuccess reports a successful payment to mission control
 	// as input for future probability estimates.
 	ReportPaymentSuccess(attemptID uint64, rt *route.Route) error
@@ -299,6 +310,10 @@ type Config struct {
 	// KeepFailedPaymentAttempts indicates whether to keep failed payment
 	// attempts in the database.
 	KeepFailedPaymentAttempts bool
+
+	// StuckHTLC holds the configuration of the detection of stuck HTLC
+	// attempts.
+	StuckHTLC StuckHTLCConfig
 }
 
 // EdgeLocator is a struct used to identify a specific edge.
@@ -332,6 +347,11 @@ type ChannelRouter struct {
 	// initialized with.
 	cfg *Config
 
+	// activePayments holds the cancel signals of the payments whose
+	// lifecycle is currently running, keyed by their identifier.
+	activePayments    map[lntypes.Hash]chan struct{}
+	activePaymentsMtx sync.Mutex
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 }
@@ -618,6 +638,14 @@ type BlindedPathRestrictions struct {
 	// via channel id) starting from a channel which points to the receiver
 	// node.
 	IncomingChainedChannels []uint64
+
+	// DiverseIntroNodes indicates that the selected paths should use
+	// distinct introduction nodes where possible, so that a single
+	// unreliable introduction node can't render all of the paths
+	// unusable. Paths that share an introduction node with a better path
+	// are only selected if there aren't enough distinct introduction
+	// nodes to reach MaxNumPaths.
+	DiverseIntroNodes bool
 }
 
 // FindBlindedPaths finds a selection of paths to the destination node that can
@@ -643,10 +671,12 @@ func (r *ChannelRouter) FindBlindedPaths(destination route.Vertex,
 	}
 
 	// routeWithProbability groups a route with the probability of a
-	// payment of the given amount succeeding on that path.
+	// payment of the given amount succeeding on that path and the smallest
+	// capacity of its channels.
 	type routeWithProbability struct {
 		route       *route.Route
 		probability float64
+		capacity    btcutil.Amount
 	}
 
 	// Iterate over all the candidate paths and determine the success
@@ -666,6 +696,7 @@ func (r *ChannelRouter) FindBlindedPaths(destination route.Vertex,
 				[]*route.Hop, 0, len(path)-1,
 			)
 			totalRouteProbability = float64(1)
+			minCapacity           btcutil.Amount
 		)
 
 		// For each set of hops on the path, get the success probability
@@ -679,6 +710,11 @@ func (r *ChannelRouter) FindBlindedPaths(destination route.Vertex,
 
 			totalRouteProbability *= probability
 
+			capacity := path[j-1].edgeCapacity
+			if j == 1 || capacity < minCapacity {
+				minCapacity = capacity
+			}
+
 			hops = append(hops, &route.Hop{
 				PubKeyBytes: path[j].vertex,
 				ChannelID:   path[j-1].channelID,
@@ -693,6 +729,7 @@ func (r *ChannelRouter) FindBlindedPaths(destination route.Vertex,
 				Hops:         hops,
 			},
 			probability: totalRouteProbability,
+			capacity:    minCapacity,
 		}
 
 		// Don't bother adding a route if its success probability less
@@ -710,19 +747,53 @@ func (r *ChannelRouter) FindBlindedPaths(destination route.Vertex,
 		routes = append(routes, routeWithProbability)
 	}
 
-	// Sort the routes based on probability.
-	sort.Slice(routes, func(i, j int) bool {
-		return routes[i].probability > routes[j].probability
+	// Sort the routes based on probability. Routes with the same
+	// probability are sorted by the capacity of their smallest channel, as
+	// a rough indication of the liquidity available along the route.
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].probability != routes[j].probability {
+			return routes[i].probability > routes[j].probability
+		}
+
+		return routes[i].capacity > routes[j].capacity
 	})
 
 	// Now just choose the best paths up until the maximum number of allowed
-	// paths.
-	bestRoutes := make([]*route.Route, 0, restrictions.MaxNumPaths)
-	for _, route := range routes {
-		if len(bestRoutes) >= int(restrictions.MaxNumPaths) {
+	// paths. If diverse introduction nodes are requested, we first select
+	// the best path of every introduction node and only then fill up the
+	// selection with the remaining paths.
+	var (
+		maxNumPaths = int(restrictions.MaxNumPaths)
+		bestRoutes  = make([]*route.Route, 0, maxNumPaths)
+		selected    = make([]bool, len(routes))
+		introNodes  = fn.NewSet[route.Vertex]()
+	)
+	if restrictions.DiverseIntroNodes {
+		for i, route := range routes {
+			if len(bestRoutes) >= maxNumPaths {
+				break
+			}
+
+			introNode := route.route.SourcePubKey
+			if introNodes.Contains(introNode) {
+				continue
+			}
+
+			introNodes.Add(introNode)
+			selected[i] = true
+			bestRoutes = append(bestRoutes, route.route)
+		}
+	}
+
+	for i, route := range routes {
+		if len(bestRoutes) >= maxNumPaths {
 			break
 		}
 
+		if selected[i] {
+			continue
+		}
+
 		bestRoutes = append(bestRoutes, route.route)
 	}
 
@@ -851,6 +922,11 @@ type LightningPayment struct {
 	// Metadata is additional data that is sent along with the payment to
 	// the payee.
 	Metadata []byte
+
+	// Trampoline is set if pathfinding to the target should be delegated
+	// to a trampoline node. The payment is then routed to the trampoline
+	// node, which finds a route to the target on our behalf.
+	Trampoline *TrampolineParams
 }
 
 // AMPOptions houses information that must be known in order to send an AMP
@@ -1000,13 +1076,21 @@ func (r *ChannelRouter) PreparePayment(payment *LightningPayment) (
 		return nil, nil, err
 	}
 
+	// For trampoline payments, the fee budget of the trampoline node is
+	// delivered to it along with the amount, so it is part of the value we
+	// send out.
+	value := payment.Amount
+	if payment.Trampoline != nil {
+		value += payment.Trampoline.FeeBudget
+	}
+
 	// Record this payment hash with the ControlTower, ensuring it is not
 	// already in-flight.
 	//
 	// TODO(roasbeef): store records as part of creation info?
 	info := &paymentsdb.PaymentCreationInfo{
 		PaymentIdentifier:     payment.Identifier(),
-		Value:                 payment.Amount,
+		Value:                 value,
 		CreationTime:          r.cfg.Clock.Now(),
 		PaymentRequest:        payment.PaymentRequest,
 		FirstHopCustomRecords: payment.FirstHopCustomRecords,
@@ -1303,6 +1387,68 @@ func (r *ChannelRouter) sendPayment(ctx context.Context,
 	return p.resumePayment(ctx)
 }
 
+// CancelPayment stops the active payment with the given identifier from making
+// new HTLC attempts. HTLC attempts that are already in flight are not affected.
+// Once they are resolved, the payment fails with the canceled reason, unless
+// one of them settles.
+func (r *ChannelRouter) CancelPayment(identifier lntypes.Hash) error {
+	r.activePaymentsMtx.Lock()
+	defer r.activePaymentsMtx.Unlock()
+
+	cancel, ok := r.activePayments[identifier]
+	if !ok {
+		return ErrPaymentNotActive
+	}
+
+	select {
+	case <-cancel:
+	default:
+		log.Infof("Canceling payment %v", identifier)
+		close(cancel)
+	}
+
+	return nil
+}
+
+// addActivePayment registers the cancel signal of a payment whose lifecycle
+// is running.
+func (r *ChannelRouter) addActivePayment(identifier lntypes.Hash,
+	cancel chan struct{}) {
+
+	r.activePaymentsMtx.Lock()
+	defer r.activePaymentsMtx.Unlock()
+
+	if r.activePayments == nil {
+		r.activePayments = make(map[lntypes.Hash]chan struct{})
+	}
+	r.activePayments[identifier] = cancel
+}
+
+// removeActivePayment removes the cancel signal of a payment once its
+// lifecycle exited.
+func (r *ChannelRouter) removeActivePayment(identifier lntypes.Hash,
+	cancel chan struct{}) {
+
+	r.activePaymentsMtx.Lock()
+	defer r.activePaymentsMtx.Unlock()
+
+	// Only remove the entry if it wasn't replaced by another lifecycle of
+	// the same payment in the meantime.
+	if r.activePayments[identifier] == cancel {
+		delete(r.activePayments, identifier)
+	}
+}
+
+// bestHeight returns the height of the best block known to the router.
+func (r *ChannelRouter) bestHeight() (uint32, error) {
+	_, height, err := r.cfg.Chain.GetBestBlock()
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(height), nil
+}
+
 // extractChannelUpdate examines the error and extracts the channel update.
 func (r *ChannelRouter) extractChannelUpdate(
 	failure lnwire.FailureMessage) *lnwire.ChannelUpdate1 {
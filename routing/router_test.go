@@ -3303,3 +3303,146 @@ func TestFindBlindedPathsWithMC(t *testing.T) {
 		"alice,bob,dave",
 	})
 }
+
+// TestFindBlindedPathsDiverseIntroNodes tests that the FindBlindedPaths method
+// prefers paths with distinct introduction nodes if requested, and that paths
+// of equal probability are ordered by the capacity of their smallest channel.
+func TestFindBlindedPathsDiverseIntroNodes(t *testing.T) {
+	t.Parallel()
+
+	rbFeatureBits := []lnwire.FeatureBit{
+		lnwire.RouteBlindingOptional,
+	}
+
+	// Create the following graph and let all the nodes advertise support
+	// for blinded paths. The channel between Bob and Dave has a smaller
+	// capacity than all the other channels.
+	//
+	//		A -- C -- D
+	//		 \       / |
+	//		  -- B --  |
+	//		           |
+	//	   E -- G -- F ----
+	//
+	featuresWithRouteBlinding := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(rbFeatureBits...), lnwire.Features,
+	)
+
+	policyWithRouteBlinding := &testChannelPolicy{
+		Expiry:   144,
+		FeeRate:  400,
+		MinHTLC:  1,
+		MaxHTLC:  100000000,
+		Features: featuresWithRouteBlinding,
+	}
+
+	testChannels := []*testChannel{
+		symmetricTestChannel(
+			"alice", "charlie", 100000, policyWithRouteBlinding, 1,
+		),
+		symmetricTestChannel(
+			"charlie", "dave", 100000, policyWithRouteBlinding, 2,
+		),
+		symmetricTestChannel(
+			"alice", "bob", 100000, policyWithRouteBlinding, 3,
+		),
+		symmetricTestChannel(
+			"bob", "dave", 50000, policyWithRouteBlinding, 4,
+		),
+		symmetricTestChannel(
+			"george", "frank", 100000, policyWithRouteBlinding, 5,
+		),
+		symmetricTestChannel(
+			"frank", "dave", 100000, policyWithRouteBlinding, 6,
+		),
+		symmetricTestChannel(
+			"eve", "george", 100000, policyWithRouteBlinding, 7,
+		),
+	}
+
+	testGraph, err := createTestGraphFromChannels(
+		t, true, testChannels, "dave", rbFeatureBits...,
+	)
+	require.NoError(t, err)
+
+	ctx := createTestCtxFromGraphInstance(t, 101, testGraph)
+
+	var (
+		alice   = ctx.aliases["alice"]
+		bob     = ctx.aliases["bob"]
+		charlie = ctx.aliases["charlie"]
+		dave    = ctx.aliases["dave"]
+		frank   = ctx.aliases["frank"]
+		george  = ctx.aliases["george"]
+	)
+
+	// The paths through Alice have the same probability, which is higher
+	// than the probability of the path through George.
+	missionControl := map[route.Vertex]map[route.Vertex]float64{
+		alice: {
+			charlie: 1,
+			bob:     1,
+		},
+		charlie: {dave: 1},
+		bob:     {dave: 1},
+		george:  {frank: 0.5},
+		frank:   {dave: 1},
+	}
+
+	probabilitySrc := func(from route.Vertex, to route.Vertex,
+		amt lnwire.MilliSatoshi, capacity btcutil.Amount) float64 {
+
+		return missionControl[from][to]
+	}
+
+	findPaths := func(maxNumPaths uint8, diverse bool) []string {
+		routes, err := ctx.router.FindBlindedPaths(
+			dave, 1000, probabilitySrc, &BlindedPathRestrictions{
+				MinDistanceFromIntroNode: 2,
+				NumHops:                  2,
+				MaxNumPaths:              maxNumPaths,
+				DiverseIntroNodes:        diverse,
+			},
+		)
+		require.NoError(t, err)
+
+		paths := make([]string, 0, len(routes))
+		for _, path := range routes {
+			label := getAliasFromPubKey(
+				path.SourcePubKey, ctx.aliases,
+			)
+			for _, hop := range path.Hops {
+				label += "," + getAliasFromPubKey(
+					hop.PubKeyBytes, ctx.aliases,
+				)
+			}
+
+			paths = append(paths, label)
+		}
+
+		return paths
+	}
+
+	// Without diversity, the two paths through Alice are selected. The
+	// path through Charlie comes first as its smallest channel has the
+	// larger capacity.
+	require.Equal(t, []string{
+		"alice,charlie,dave",
+		"alice,bob,dave",
+	}, findPaths(2, false))
+
+	// With diversity, the best path through Alice is followed by the path
+	// through George.
+	require.Equal(t, []string{
+		"alice,charlie,dave",
+		"george,frank,dave",
+	}, findPaths(2, true))
+
+	// If there aren't enough distinct introduction nodes, the selection is
+	// filled up with the remaining paths.
+	require.Equal(t, []string{
+		"alice,charlie,dave",
+		"george,frank,dave",
+		"alice,bob,dave",
+	}, findPaths(3, true))
+}
@@ -0,0 +1,151 @@
+package routing
+
+import (
+	"time"
+
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	switchhop "github.com/lightningnetwork/lnd/htlcswitch/hop"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	paymentsdb "github.com/lightningnetwork/lnd/payments/db"
+)
+
+// StuckHTLCConfig holds the configuration of the detection of HTLC attempts
+// that are held by a downstream node for longer than expected.
+type StuckHTLCConfig struct {
+	// Threshold is the age after which an in-flight HTLC attempt is
+	// considered stuck. A zero value disables the detection.
+	Threshold time.Duration
+
+	// CheckInterval is the interval at which the age of an in-flight HTLC
+	// attempt is checked.
+	CheckInterval time.Duration
+
+	// ForceCloseDelta is the number of blocks before the expiry of a stuck
+	// HTLC at which the channel to the first hop is force closed. A zero
+	// value disables the force close.
+	ForceCloseDelta uint32
+
+	// NotifyStuckHTLC is called when an HTLC attempt is detected to be
+	// stuck and when the channel to its first hop was force closed.
+	NotifyStuckHTLC func(key htlcswitch.HtlcKey, info htlcswitch.HtlcInfo,
+		stuckInfo htlcswitch.StuckHtlcInfo)
+
+	// ForceCloseChannel force closes the channel with the given short
+	// channel ID.
+	ForceCloseChannel func(chanID lnwire.ShortChannelID) error
+}
+
+// stuckAttemptMonitor tracks the age of a single in-flight HTLC attempt and
+// acts on it once the attempt is stuck.
+type stuckAttemptMonitor struct {
+	cfg *StuckHTLCConfig
+
+	paymentHash lntypes.Hash
+	attempt     *paymentsdb.HTLCAttempt
+
+	// stuck is set once the attempt was detected to be stuck.
+	stuck bool
+
+	// forceClosed is set once the channel to the first hop was force
+	// closed.
+	forceClosed bool
+}
+
+// newStuckAttemptMonitor creates a monitor for the given attempt.
+func newStuckAttemptMonitor(cfg *StuckHTLCConfig, paymentHash lntypes.Hash,
+	attempt *paymentsdb.HTLCAttempt) *stuckAttemptMonitor {
+
+	return &stuckAttemptMonitor{
+		cfg:         cfg,
+		paymentHash: paymentHash,
+		attempt:     attempt,
+	}
+}
+
+// check checks the age of the attempt at the given time. An alert is sent the
+// first time the attempt exceeds the threshold. Once the expiry of a stuck
+// attempt is within the force close delta of the best height, the channel to
+// the first hop is force closed.
+func (m *stuckAttemptMonitor) check(now time.Time,
+	bestHeight func() (uint32, error)) {
+
+	age := now.Sub(m.attempt.AttemptTime)
+	if age < m.cfg.Threshold {
+		return
+	}
+
+	rt := &m.attempt.Route
+	if len(rt.Hops) == 0 {
+		return
+	}
+	firstHop := lnwire.NewShortChanIDFromInt(rt.Hops[0].ChannelID)
+
+	if !m.stuck {
+		m.stuck = true
+
+		log.Warnf("HTLC attempt %v of payment %v over channel %v is "+
+			"stuck for %v", m.attempt.AttemptID, m.paymentHash,
+			firstHop, age)
+
+		m.notify(firstHop, age)
+	}
+
+	if m.forceClosed || m.cfg.ForceCloseDelta == 0 {
+		return
+	}
+
+	height, err := bestHeight()
+	if err != nil {
+		log.Errorf("Unable to get best height: %v", err)
+		return
+	}
+
+	if height+m.cfg.ForceCloseDelta < rt.TotalTimeLock {
+		return
+	}
+
+	log.Warnf("Force closing channel %v as stuck HTLC attempt %v of "+
+		"payment %v expires at height %v", firstHop,
+		m.attempt.AttemptID, m.paymentHash, rt.TotalTimeLock)
+
+	if err := m.cfg.ForceCloseChannel(firstHop); err != nil {
+		log.Errorf("Unable to force close channel %v: %v", firstHop,
+			err)
+
+		return
+	}
+
+	m.forceClosed = true
+	m.notify(firstHop, age)
+}
+
+// notify sends a stuck HTLC event for the attempt.
+func (m *stuckAttemptMonitor) notify(firstHop lnwire.ShortChannelID,
+	age time.Duration) {
+
+	if m.cfg.NotifyStuckHTLC == nil {
+		return
+	}
+
+	key := htlcswitch.HtlcKey{
+		IncomingCircuit: models.CircuitKey{
+			ChanID: switchhop.Source,
+		},
+		OutgoingCircuit: models.CircuitKey{
+			ChanID: firstHop,
+		},
+	}
+	info := htlcswitch.HtlcInfo{
+		OutgoingTimeLock: m.attempt.Route.TotalTimeLock,
+		OutgoingAmt:      m.attempt.Route.TotalAmount,
+	}
+
+	m.cfg.NotifyStuckHTLC(key, info, htlcswitch.StuckHtlcInfo{
+		PaymentHash: m.paymentHash,
+		AttemptID:   m.attempt.AttemptID,
+		Age:         age,
+		ForceClosed: m.forceClosed,
+	})
+}
@@ -0,0 +1,88 @@
+package routing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	paymentsdb "github.com/lightningnetwork/lnd/payments/db"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStuckAttemptMonitor tests that a stuck HTLC attempt is reported once it
+// exceeds the threshold, and that the channel to its first hop is force closed
+// once its expiry comes too close.
+func TestStuckAttemptMonitor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sendTime    = time.Unix(1_000_000, 0)
+		paymentHash = lntypes.Hash{1}
+	)
+
+	attempt := &paymentsdb.HTLCAttempt{
+		HTLCAttemptInfo: makeAttemptInfo(t, 10_000),
+	}
+	attempt.AttemptID = 5
+	attempt.AttemptTime = sendTime
+
+	var (
+		events      []htlcswitch.StuckHtlcInfo
+		forceClosed []lnwire.ShortChannelID
+		height      uint32
+	)
+	cfg := &StuckHTLCConfig{
+		Threshold:       time.Hour,
+		ForceCloseDelta: 10,
+		NotifyStuckHTLC: func(key htlcswitch.HtlcKey,
+			info htlcswitch.HtlcInfo,
+			stuckInfo htlcswitch.StuckHtlcInfo) {
+
+			require.EqualValues(t, 1, key.OutgoingCircuit.ChanID.
+				ToUint64())
+			require.Equal(t, attempt.Route.TotalTimeLock,
+				info.OutgoingTimeLock)
+
+			events = append(events, stuckInfo)
+		},
+		ForceCloseChannel: func(chanID lnwire.ShortChannelID) error {
+			forceClosed = append(forceClosed, chanID)
+			return nil
+		},
+	}
+	bestHeight := func() (uint32, error) {
+		return height, nil
+	}
+
+	m := newStuckAttemptMonitor(cfg, paymentHash, attempt)
+
+	// Below the threshold, the attempt isn't reported.
+	m.check(sendTime.Add(time.Minute), bestHeight)
+	require.Empty(t, events)
+
+	// Once the threshold is exceeded, the attempt is reported once. The
+	// expiry of the route is at height 100, which is still far away.
+	height = 80
+	m.check(sendTime.Add(time.Hour), bestHeight)
+	m.check(sendTime.Add(2*time.Hour), bestHeight)
+	require.Equal(t, []htlcswitch.StuckHtlcInfo{{
+		PaymentHash: paymentHash,
+		AttemptID:   5,
+		Age:         time.Hour,
+	}}, events)
+	require.Empty(t, forceClosed)
+
+	// Once the expiry is within the force close delta, the channel to the
+	// first hop is force closed a single time.
+	height = 90
+	m.check(sendTime.Add(3*time.Hour), bestHeight)
+	m.check(sendTime.Add(4*time.Hour), bestHeight)
+	require.Equal(t, []lnwire.ShortChannelID{
+		lnwire.NewShortChanIDFromInt(1),
+	}, forceClosed)
+	require.Len(t, events, 2)
+	require.True(t, events[1].ForceClosed)
+	require.Equal(t, 3*time.Hour, events[1].Age)
+}
@@ -0,0 +1,336 @@
+package routing
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btclog/v2"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	switchhop "github.com/lightningnetwork/lnd/htlcswitch/hop"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// DefaultTrampolineFeeBase is the base fee of the default trampoline
+	// fee budget.
+	DefaultTrampolineFeeBase = lnwire.MilliSatoshi(5000)
+
+	// DefaultTrampolineFeeRate is the proportional fee, in parts per
+	// million, of the default trampoline fee budget.
+	DefaultTrampolineFeeRate = 2500
+
+	// DefaultTrampolineCltvBudget is the default number of blocks the
+	// trampoline node may use for the route to the recipient, including
+	// its own cltv delta.
+	DefaultTrampolineCltvBudget = 576
+)
+
+var (
+	// errTrampolineNoCandidates is returned when a trampoline payment is
+	// requested without any trampoline nodes.
+	errTrampolineNoCandidates = errors.New("no trampoline nodes specified")
+)
+
+// TrampolineParams describes how a payment should be delegated to a trampoline
+// node.
+type TrampolineParams struct {
+	// Nodes are the trampoline nodes to use, in order of preference. The
+	// next node is only tried if no route to the current node can be found
+	// or the current node fails the payment.
+	Nodes []route.Vertex
+
+	// FeeBudget is the amount the trampoline node may use for fees,
+	// including its own fee. It is paid on top of the payment amount.
+	FeeBudget lnwire.MilliSatoshi
+
+	// CltvBudget is the cltv delta the trampoline node may use for the
+	// route to the recipient, including its own cltv delta.
+	CltvBudget uint16
+}
+
+// DefaultTrampolineFeeBudget returns the default trampoline fee budget for a
+// payment of the given amount.
+func DefaultTrampolineFeeBudget(amt lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+	return DefaultTrampolineFeeBase + amt*DefaultTrampolineFeeRate/1e6
+}
+
+// attemptFailureHandler is implemented by payment sessions that want to learn
+// about failed attempts in addition to mission control.
+type attemptFailureHandler interface {
+	// handleAttemptFailure is called when an attempt along the given route
+	// failed with the given failure at the given source index.
+	handleAttemptFailure(rt *route.Route, srcIdx int,
+		failure lnwire.FailureMessage)
+}
+
+// isTrampolineFailure returns true if the failure can only be returned by a
+// trampoline node.
+func isTrampolineFailure(failure lnwire.FailureMessage) bool {
+	switch failure.(type) {
+	case *lnwire.FailTemporaryTrampolineFailure,
+		*lnwire.FailTrampolineFeeOrExpiryInsufficient:
+
+		return true
+
+	default:
+		return false
+	}
+}
+
+// A compile time assertion to ensure trampolineSession meets the
+// PaymentSession and attemptFailureHandler interfaces.
+var (
+	_ PaymentSession        = (*trampolineSession)(nil)
+	_ attemptFailureHandler = (*trampolineSession)(nil)
+)
+
+// trampolineSession is a payment session that routes the payment to a
+// trampoline node instead of the final recipient. The instructions for the
+// trampoline node are carried in a trampoline onion in the final hop payload.
+// If the current trampoline node can't be reached or fails the payment, the
+// session moves on to the next trampoline node.
+type trampolineSession struct {
+	// payment is the payment to the final recipient.
+	payment *LightningPayment
+
+	// newSession creates a regular payment session for the payment to a
+	// trampoline node.
+	newSession func(*LightningPayment) (*paymentSession, error)
+
+	// candidate is the index of the current trampoline node.
+	candidate int
+
+	// session is the payment session for the current trampoline node. It
+	// is nil if it hasn't been created yet.
+	session *paymentSession
+
+	// mu protects candidate and session.
+	mu sync.Mutex
+
+	log btclog.Logger
+}
+
+// newTrampolineSession creates a new trampoline payment session for the given
+// payment.
+func newTrampolineSession(p *LightningPayment,
+	newSession func(*LightningPayment) (*paymentSession, error)) (
+	*trampolineSession, error) {
+
+	switch {
+	case len(p.Trampoline.Nodes) == 0:
+		return nil, errTrampolineNoCandidates
+
+	case p.amp != nil:
+		return nil, errors.New("trampoline payments can't be AMP " +
+			"payments")
+
+	case p.BlindedPathSet != nil:
+		return nil, errors.New("trampoline payments to blinded " +
+			"paths are not supported")
+
+	case len(p.DestCustomRecords) != 0:
+		return nil, errors.New("trampoline payments can't carry " +
+			"custom records")
+	}
+
+	logPrefix := fmt.Sprintf("TrampolineSession(%x):", p.Identifier())
+
+	return &trampolineSession{
+		payment:    p,
+		newSession: newSession,
+		log:        log.WithPrefix(logPrefix),
+	}, nil
+}
+
+// trampolinePayment creates the payment to the given trampoline node. The
+// trampoline onion instructs the node to forward the payment to the final
+// recipient with an absolute expiry based on the given height.
+func (t *trampolineSession) trampolinePayment(trampoline route.Vertex,
+	height uint32) (*LightningPayment, error) {
+
+	trampolineKey, err := btcec.ParsePubKey(trampoline[:])
+	if err != nil {
+		return nil, err
+	}
+
+	targetKey, err := btcec.ParsePubKey(t.payment.Target[:])
+	if err != nil {
+		return nil, err
+	}
+
+	finalCltv := height + uint32(t.payment.FinalCLTVDelta) +
+		uint32(BlockPadding)
+
+	payload := &switchhop.TrampolinePayload{
+		AmtToForward:   t.payment.Amount,
+		OutgoingCltv:   finalCltv,
+		OutgoingNodeID: targetKey,
+		RouteHints:     t.payment.RouteHints,
+	}
+
+	t.payment.PaymentAddr.WhenSome(func(addr [32]byte) {
+		payload.MPP = record.NewMPP(t.payment.Amount, addr)
+	})
+
+	if features := t.payment.DestFeatures; features != nil {
+		payload.InvoiceFeatures = features.RawFeatureVector
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	paymentHash := t.payment.Identifier()
+	onion, err := switchhop.NewTrampolineOnion(
+		trampolineKey, payload, paymentHash[:], sessionKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create trampoline onion: "+
+			"%w", err)
+	}
+
+	// The trampoline node sees the payment as a regular multi-part
+	// payment, so we use a fresh payment address that is only known to it
+	// to keep the parts of the payment together.
+	var trampolineSecret [32]byte
+	if _, err := rand.Read(trampolineSecret[:]); err != nil {
+		return nil, err
+	}
+
+	p := *t.payment
+	p.Target = trampoline
+	p.Amount = t.payment.Amount + t.payment.Trampoline.FeeBudget
+	p.FinalCLTVDelta += t.payment.Trampoline.CltvBudget
+	p.PaymentAddr = fn.Some(trampolineSecret)
+	p.DestFeatures = lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadRequired,
+			lnwire.PaymentAddrRequired,
+			lnwire.MPPOptional,
+		), lnwire.Features,
+	)
+	p.DestCustomRecords = record.CustomSet{
+		record.TrampolineOnionType: onion,
+	}
+	p.LastHop = nil
+	p.RouteHints = nil
+	p.Metadata = nil
+	p.Trampoline = nil
+
+	return &p, nil
+}
+
+// nextCandidate moves on to the next trampoline node.
+//
+// NOTE: The caller MUST hold the mutex.
+func (t *trampolineSession) nextCandidate() {
+	t.candidate++
+	t.session = nil
+}
+
+// RequestRoute returns the next route to the current trampoline node. If no
+// route to the trampoline node can be found while no other parts of the payment
+// are in flight, the next trampoline node is tried.
+//
+// NOTE: This function is safe for concurrent access.
+// NOTE: Part of the PaymentSession interface.
+func (t *trampolineSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
+	activeShards, height uint32,
+	firstHopCustomRecords lnwire.CustomRecords) (*route.Route, error) {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	nodes := t.payment.Trampoline.Nodes
+	for t.candidate < len(nodes) {
+		trampoline := nodes[t.candidate]
+
+		if t.session == nil {
+			p, err := t.trampolinePayment(trampoline, height)
+			if err != nil {
+				return nil, err
+			}
+
+			t.session, err = t.newSession(p)
+			if err != nil {
+				return nil, err
+			}
+
+			t.log.Debugf("Using trampoline node %v", trampoline)
+		}
+
+		rt, err := t.session.RequestRoute(
+			maxAmt, feeLimit, activeShards, height,
+			firstHopCustomRecords,
+		)
+
+		// We can only switch to another trampoline node if no parts
+		// are in flight to the current one, as all parts need to reach
+		// the same node.
+		if !errors.Is(err, errNoPathFound) || activeShards > 0 {
+			return rt, err
+		}
+
+		t.log.Debugf("No route to trampoline node %v", trampoline)
+
+		t.nextCandidate()
+	}
+
+	return nil, errNoPathFound
+}
+
+// handleAttemptFailure moves on to the next trampoline node if the current one
+// failed the payment.
+//
+// NOTE: Part of the attemptFailureHandler interface.
+func (t *trampolineSession) handleAttemptFailure(rt *route.Route, srcIdx int,
+	failure lnwire.FailureMessage) {
+
+	if srcIdx != len(rt.Hops) || !isTrampolineFailure(failure) {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// All parts sent to the current trampoline node are failed by it, so
+	// only the first failure moves on to the next node.
+	nodes := t.payment.Trampoline.Nodes
+	if t.candidate >= len(nodes) ||
+		rt.FinalHop().PubKeyBytes != nodes[t.candidate] {
+
+		return
+	}
+
+	t.log.Infof("Trampoline node %v failed payment: %v",
+		nodes[t.candidate], failure)
+
+	t.nextCandidate()
+}
+
+// UpdateAdditionalEdge updates the channel edge policy for a private edge.
+// Routes to trampoline nodes don't use private edges.
+//
+// NOTE: Part of the PaymentSession interface.
+func (t *trampolineSession) UpdateAdditionalEdge(_ *lnwire.ChannelUpdate1,
+	_ *btcec.PublicKey, _ *models.CachedEdgePolicy) bool {
+
+	return false
+}
+
+// GetAdditionalEdgePolicy returns nil as routes to trampoline nodes don't use
+// private edges.
+//
+// NOTE: Part of the PaymentSession interface.
+func (t *trampolineSession) GetAdditionalEdgePolicy(_ *btcec.PublicKey,
+	_ uint64) *models.CachedEdgePolicy {
+
+	return nil
+}
@@ -0,0 +1,705 @@
+package trampoline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/htlcswitch/hop"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	paymentsdb "github.com/lightningnetwork/lnd/payments/db"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// DefaultMaxParts is the maximum number of parts the forwarded payment
+	// is split into.
+	DefaultMaxParts = 16
+
+	// DefaultPayAttemptTimeout is the time after which no new attempts
+	// are made to forward a trampoline payment.
+	DefaultPayAttemptTimeout = 60 * time.Second
+)
+
+// A compile time assertion to ensure Forwarder meets the
+// htlcswitch.InvoiceDatabase interface.
+var _ htlcswitch.InvoiceDatabase = (*Forwarder)(nil)
+
+// Config contains the dependencies and the forwarding policy of the
+// trampoline forwarder.
+type Config struct {
+	// Registry is the invoice registry that handles all HTLCs that don't
+	// carry a trampoline onion.
+	Registry htlcswitch.InvoiceDatabase
+
+	// DecodeOnion peels the trampoline onion of a payment.
+	DecodeOnion func(onion, paymentHash []byte) (*hop.TrampolinePayload,
+		error)
+
+	// SendPayment sends a payment and blocks until it succeeded or failed.
+	SendPayment func(ctx context.Context,
+		payment *routing.LightningPayment) ([32]byte, *route.Route,
+		error)
+
+	// FetchPayment fetches a payment that was sent before.
+	FetchPayment func(ctx context.Context,
+		hash lntypes.Hash) (paymentsdb.DBMPPayment, error)
+
+	// SubscribePayment subscribes to the updates of a payment that is in
+	// flight.
+	SubscribePayment func(hash lntypes.Hash) (
+		routing.ControlTowerSubscriber, error)
+
+	// FeeBaseMsat is the base fee we require for forwarding a trampoline
+	// payment.
+	FeeBaseMsat lnwire.MilliSatoshi
+
+	// FeeRate is the proportional fee, in parts per million, we require
+	// for forwarding a trampoline payment.
+	FeeRate lnwire.MilliSatoshi
+
+	// CltvDelta is the cltv delta we require for forwarding a trampoline
+	// payment.
+	CltvDelta uint16
+
+	// MppTimeout is the time after which the parts of an incomplete
+	// trampoline payment are failed.
+	MppTimeout time.Duration
+
+	// Clock is used for the mpp timeout.
+	Clock clock.Clock
+}
+
+// setKey identifies the parts of a trampoline payment.
+type setKey struct {
+	hash lntypes.Hash
+	addr [32]byte
+}
+
+// trampolineHtlc is a single incoming HTLC of a trampoline payment.
+type trampolineHtlc struct {
+	amt          lnwire.MilliSatoshi
+	expiry       uint32
+	acceptHeight int32
+
+	// hodlChan is the channel the resolution of the HTLC is delivered on.
+	// It is nil if the link of the HTLC unsubscribed.
+	hodlChan chan<- interface{}
+}
+
+// htlcSet collects the parts of a trampoline payment.
+type htlcSet struct {
+	key setKey
+
+	// total is the total amount of the trampoline payment, as set by the
+	// sender.
+	total lnwire.MilliSatoshi
+
+	// onion is the trampoline onion of the payment.
+	onion []byte
+
+	htlcs map[models.CircuitKey]*trampolineHtlc
+
+	// forwarding is set once all parts arrived and the payment is
+	// forwarded.
+	forwarding bool
+
+	// complete is closed once all parts arrived.
+	complete chan struct{}
+}
+
+// amount returns the sum of the amounts of all parts.
+func (s *htlcSet) amount() lnwire.MilliSatoshi {
+	var amt lnwire.MilliSatoshi
+	for _, htlc := range s.htlcs {
+		amt += htlc.amt
+	}
+
+	return amt
+}
+
+// minExpiry returns the lowest expiry of all parts.
+func (s *htlcSet) minExpiry() uint32 {
+	var expiry uint32
+	for _, htlc := range s.htlcs {
+		if expiry == 0 || htlc.expiry < expiry {
+			expiry = htlc.expiry
+		}
+	}
+
+	return expiry
+}
+
+// forwardFailure is the failure a trampoline payment is failed with.
+type forwardFailure struct {
+	// outcome is the failure resolution result.
+	outcome invoices.FailResolutionResult
+
+	// msg is the failure message that is returned to the sender.
+	msg lnwire.FailureMessage
+}
+
+// Error returns a human-readable description of the failure.
+func (f *forwardFailure) Error() string {
+	if f.msg == nil {
+		return f.outcome.String()
+	}
+
+	return fmt.Sprintf("%v: %v", f.outcome, f.msg)
+}
+
+// Forwarder forwards trampoline payments. It wraps the invoice registry and
+// intercepts all HTLCs that carry a trampoline onion in their final hop
+// payload. Once all parts of a trampoline payment arrived, it peels the
+// trampoline onion and pays the outgoing node on behalf of the sender. The
+// incoming HTLCs are settled with the preimage of the outgoing payment or
+// failed if the outgoing payment failed.
+//
+// NOTE: Only forwarding to the final recipient is supported, the payment is
+// never forwarded to another trampoline node.
+type Forwarder struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// sets holds the trampoline payments that are being collected or
+	// forwarded.
+	sets map[setKey]*htlcSet
+
+	// mu protects sets.
+	mu sync.Mutex
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewForwarder creates a new trampoline forwarder.
+func NewForwarder(cfg *Config) *Forwarder {
+	return &Forwarder{
+		cfg:  cfg,
+		sets: make(map[setKey]*htlcSet),
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the trampoline forwarder.
+func (f *Forwarder) Start() error {
+	f.started.Do(func() {
+		log.Infof("Trampoline forwarder starting, fee_base=%v, "+
+			"fee_rate=%v, cltv_delta=%v", f.cfg.FeeBaseMsat,
+			f.cfg.FeeRate, f.cfg.CltvDelta)
+	})
+
+	return nil
+}
+
+// Stop stops the trampoline forwarder. Trampoline payments that are being
+// forwarded are resumed by the router and picked up again once their HTLCs are
+// replayed on restart.
+func (f *Forwarder) Stop() error {
+	f.stopped.Do(func() {
+		log.Debugf("Trampoline forwarder stopping")
+
+		close(f.quit)
+		f.wg.Wait()
+	})
+
+	return nil
+}
+
+// LookupInvoice attempts to look up an invoice according to its 32 byte
+// payment hash.
+//
+// NOTE: Part of the htlcswitch.InvoiceDatabase interface.
+func (f *Forwarder) LookupInvoice(ctx context.Context,
+	hash lntypes.Hash) (invoices.Invoice, error) {
+
+	return f.cfg.Registry.LookupInvoice(ctx, hash)
+}
+
+// CancelInvoice attempts to cancel the invoice corresponding to the passed
+// payment hash.
+//
+// NOTE: Part of the htlcswitch.InvoiceDatabase interface.
+func (f *Forwarder) CancelInvoice(ctx context.Context,
+	hash lntypes.Hash) error {
+
+	return f.cfg.Registry.CancelInvoice(ctx, hash)
+}
+
+// SettleHodlInvoice settles a hold invoice.
+//
+// NOTE: Part of the htlcswitch.InvoiceDatabase interface.
+func (f *Forwarder) SettleHodlInvoice(ctx context.Context,
+	preimage lntypes.Preimage) error {
+
+	return f.cfg.Registry.SettleHodlInvoice(ctx, preimage)
+}
+
+// HodlUnsubscribeAll unsubscribes from all htlc resolutions.
+//
+// NOTE: Part of the htlcswitch.InvoiceDatabase interface.
+func (f *Forwarder) HodlUnsubscribeAll(subscriber chan<- interface{}) {
+	f.cfg.Registry.HodlUnsubscribeAll(subscriber)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, set := range f.sets {
+		for _, htlc := range set.htlcs {
+			if htlc.hodlChan == subscriber {
+				htlc.hodlChan = nil
+			}
+		}
+	}
+}
+
+// NotifyExitHopHtlc hands all HTLCs that don't carry a trampoline onion to the
+// invoice registry. Trampoline HTLCs are held until all parts of the payment
+// arrived and the payment was forwarded, their resolution is delivered on the
+// hodl channel.
+//
+// NOTE: Part of the htlcswitch.InvoiceDatabase interface.
+func (f *Forwarder) NotifyExitHopHtlc(hash lntypes.Hash,
+	amt lnwire.MilliSatoshi, expiry uint32, currentHeight int32,
+	circuitKey models.CircuitKey, hodlChan chan<- interface{},
+	wireCustomRecords lnwire.CustomRecords,
+	payload invoices.Payload) (invoices.HtlcResolution, error) {
+
+	onion, ok := payload.CustomRecords()[record.TrampolineOnionType]
+	if !ok {
+		return f.cfg.Registry.NotifyExitHopHtlc(
+			hash, amt, expiry, currentHeight, circuitKey, hodlChan,
+			wireCustomRecords, payload,
+		)
+	}
+
+	// All parts of a trampoline payment carry the sender's payment
+	// address for us, which we use to collect them.
+	mpp := payload.MultiPath()
+	if mpp == nil {
+		log.Debugf("Trampoline htlc %v without payment address",
+			circuitKey)
+
+		return failResolution(
+			circuitKey, currentHeight, temporaryFailure(),
+		), nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := setKey{hash: hash, addr: mpp.PaymentAddr()}
+	set, ok := f.sets[key]
+	if !ok {
+		set = &htlcSet{
+			key:      key,
+			total:    mpp.TotalMsat(),
+			onion:    onion,
+			htlcs:    make(map[models.CircuitKey]*trampolineHtlc),
+			complete: make(chan struct{}),
+		}
+		f.sets[key] = set
+
+		f.wg.Add(1)
+		go f.waitForParts(set)
+	}
+
+	// A replayed htlc only updates the hodl channel.
+	if htlc, ok := set.htlcs[circuitKey]; ok {
+		htlc.hodlChan = hodlChan
+		return nil, nil
+	}
+
+	if set.forwarding || mpp.TotalMsat() != set.total {
+		log.Debugf("Trampoline htlc %v doesn't match payment %v",
+			circuitKey, hash)
+
+		return invoices.NewFailResolution(
+			circuitKey, currentHeight,
+			invoices.ResultHtlcSetTotalMismatch,
+		), nil
+	}
+
+	set.htlcs[circuitKey] = &trampolineHtlc{
+		amt:          amt,
+		expiry:       expiry,
+		acceptHeight: currentHeight,
+		hodlChan:     hodlChan,
+	}
+
+	log.Debugf("Trampoline htlc %v for payment %v accepted, %v of %v "+
+		"received", circuitKey, hash, set.amount(), set.total)
+
+	if set.amount() < set.total {
+		return nil, nil
+	}
+
+	set.forwarding = true
+	close(set.complete)
+
+	f.wg.Add(1)
+	go f.forward(set, currentHeight)
+
+	return nil, nil
+}
+
+// failResolution returns the resolution for an HTLC that is failed with the
+// given failure.
+func failResolution(key models.CircuitKey, acceptHeight int32,
+	failure *forwardFailure) *invoices.HtlcFailResolution {
+
+	resolution := invoices.NewFailResolution(
+		key, acceptHeight, failure.outcome,
+	)
+	resolution.FailureMessage = failure.msg
+
+	return resolution
+}
+
+// temporaryFailure returns the failure for trampoline payments that can't be
+// forwarded for a reason that may be temporary.
+func temporaryFailure() *forwardFailure {
+	return &forwardFailure{
+		outcome: invoices.ResultTrampolineForwardFailed,
+		msg:     &lnwire.FailTemporaryTrampolineFailure{},
+	}
+}
+
+// waitForParts fails the parts of a trampoline payment if not all of them
+// arrive in time.
+//
+// NOTE: This MUST be run as a goroutine.
+func (f *Forwarder) waitForParts(set *htlcSet) {
+	defer f.wg.Done()
+
+	select {
+	case <-set.complete:
+		return
+
+	case <-f.cfg.Clock.TickAfter(f.cfg.MppTimeout):
+
+	case <-f.quit:
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// The last part may have arrived just in time.
+	if set.forwarding {
+		return
+	}
+
+	log.Debugf("Trampoline payment %v timed out with %v of %v received",
+		set.key.hash, set.amount(), set.total)
+
+	f.resolveLocked(set, fn.None[lntypes.Preimage](), &forwardFailure{
+		outcome: invoices.ResultMppTimeout,
+	})
+}
+
+// forward pays the outgoing node of a trampoline payment whose parts all
+// arrived and resolves the parts with the result.
+//
+// NOTE: This MUST be run as a goroutine.
+func (f *Forwarder) forward(set *htlcSet, height int32) {
+	defer f.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-f.quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	hash := set.key.hash
+	preimage, err := f.pay(ctx, set, height)
+
+	// If we are shutting down, the outcome of the payment is unknown. The
+	// parts are resolved once they are replayed after the restart.
+	select {
+	case <-f.quit:
+		log.Debugf("Trampoline payment %v interrupted: %v", hash, err)
+		return
+
+	default:
+	}
+
+	var (
+		failure *forwardFailure
+		reason  paymentsdb.FailureReason
+	)
+	switch {
+	case err == nil:
+		log.Infof("Trampoline payment %v forwarded", hash)
+
+		f.resolve(set, fn.Some(preimage), nil)
+
+		return
+
+	case errors.As(err, &failure):
+
+	case errors.As(err, &reason):
+		failure = failureForReason(reason, set.amount(), height)
+
+	case errors.Is(err, routing.ErrRouterShuttingDown):
+		log.Debugf("Trampoline payment %v interrupted: %v", hash, err)
+		return
+
+	default:
+		failure = temporaryFailure()
+	}
+
+	log.Infof("Unable to forward trampoline payment %v: %v", hash, err)
+
+	f.resolve(set, fn.None[lntypes.Preimage](), failure)
+}
+
+// failureForReason returns the failure for a trampoline payment whose outgoing
+// payment failed with the given reason.
+func failureForReason(reason paymentsdb.FailureReason,
+	amt lnwire.MilliSatoshi, height int32) *forwardFailure {
+
+	switch reason {
+	// The recipient doesn't know the payment, so we pass on its failure
+	// to the sender.
+	case paymentsdb.FailureReasonPaymentDetails:
+		return &forwardFailure{
+			outcome: invoices.ResultTrampolineForwardFailed,
+			msg: lnwire.NewFailIncorrectDetails(
+				amt, uint32(height),
+			),
+		}
+
+	default:
+		return temporaryFailure()
+	}
+}
+
+// pay pays the outgoing node of the trampoline payment. If the payment was
+// already made before a restart, its result is returned instead.
+func (f *Forwarder) pay(ctx context.Context, set *htlcSet,
+	height int32) (lntypes.Preimage, error) {
+
+	hash := set.key.hash
+
+	existing, err := f.cfg.FetchPayment(ctx, hash)
+	switch {
+	case errors.Is(err, paymentsdb.ErrPaymentNotInitiated):
+
+	case err != nil:
+		return lntypes.Preimage{}, err
+
+	// A failed payment can be retried, for example when the sender retries
+	// the payment through us after a previous attempt failed.
+	case existing.GetStatus() == paymentsdb.StatusFailed:
+
+	default:
+		log.Debugf("Trampoline payment %v already sent, waiting for "+
+			"result", hash)
+
+		return f.waitForPayment(hash)
+	}
+
+	payload, err := f.cfg.DecodeOnion(set.onion, hash[:])
+	if err != nil {
+		log.Debugf("Unable to decode trampoline onion of %v: %v", hash,
+			err)
+
+		return lntypes.Preimage{}, temporaryFailure()
+	}
+
+	payment, err := f.newPayment(set, payload, height)
+	if err != nil {
+		return lntypes.Preimage{}, err
+	}
+
+	log.Debugf("Forwarding trampoline payment %v of %v to %v", hash,
+		payment.Amount, payment.Target)
+
+	preimage, _, err := f.cfg.SendPayment(ctx, payment)
+
+	return preimage, err
+}
+
+// newPayment creates the payment to the outgoing node of a trampoline payment
+// after checking that the sender left enough fees and cltv delta for us.
+func (f *Forwarder) newPayment(set *htlcSet, payload *hop.TrampolinePayload,
+	height int32) (*routing.LightningPayment, error) {
+
+	var (
+		amtIn  = set.amount()
+		expiry = set.minExpiry()
+		fee    = f.cfg.FeeBaseMsat +
+			payload.AmtToForward*f.cfg.FeeRate/1e6
+	)
+
+	if amtIn < payload.AmtToForward+fee ||
+		expiry < payload.OutgoingCltv+uint32(f.cfg.CltvDelta) {
+
+		return nil, &forwardFailure{
+			outcome: invoices.ResultTrampolineForwardFailed,
+			msg: lnwire.NewTrampolineFeeOrExpiryInsufficient(
+				uint32(f.cfg.FeeBaseMsat),
+				uint32(f.cfg.FeeRate), f.cfg.CltvDelta,
+			),
+		}
+	}
+
+	// The router adds the block padding to the final cltv delta, so we
+	// subtract it to arrive at the requested expiry.
+	minExpiry := uint32(height) + uint32(routing.BlockPadding)
+	if payload.OutgoingCltv <= minExpiry ||
+		payload.OutgoingCltv-minExpiry > math.MaxUint16 {
+
+		return nil, temporaryFailure()
+	}
+
+	// The remaining cltv delta of the incoming HTLCs minus our own delta
+	// is available for the route to the recipient.
+	cltvLimit := expiry - uint32(f.cfg.CltvDelta) - uint32(height)
+
+	payment := &routing.LightningPayment{
+		Target:            route.NewVertex(payload.OutgoingNodeID),
+		Amount:            payload.AmtToForward,
+		FeeLimit:          amtIn - payload.AmtToForward - fee,
+		CltvLimit:         cltvLimit,
+		FinalCLTVDelta:    uint16(payload.OutgoingCltv - minExpiry),
+		RouteHints:        payload.RouteHints,
+		MaxParts:          DefaultMaxParts,
+		PayAttemptTimeout: DefaultPayAttemptTimeout,
+	}
+
+	// We only forward the full amount to the recipient, so the payment
+	// data must match it.
+	if payload.MPP != nil {
+		if payload.MPP.TotalMsat() != payload.AmtToForward {
+			return nil, temporaryFailure()
+		}
+
+		payment.PaymentAddr = fn.Some(payload.MPP.PaymentAddr())
+	}
+
+	if payload.InvoiceFeatures != nil {
+		payment.DestFeatures = lnwire.NewFeatureVector(
+			payload.InvoiceFeatures, lnwire.Features,
+		)
+	}
+
+	if err := payment.SetPaymentHash(set.key.hash); err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// waitForPayment waits for the result of a payment that is in flight.
+func (f *Forwarder) waitForPayment(hash lntypes.Hash) (lntypes.Preimage,
+	error) {
+
+	sub, err := f.cfg.SubscribePayment(hash)
+	if err != nil {
+		return lntypes.Preimage{}, err
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case update, ok := <-sub.Updates():
+			if !ok {
+				return lntypes.Preimage{}, errors.New(
+					"payment subscription closed",
+				)
+			}
+
+			payment, ok := update.(*paymentsdb.MPPayment)
+			if !ok || !payment.Terminated() {
+				continue
+			}
+
+			htlc, reason := payment.TerminalInfo()
+			switch {
+			case htlc != nil && htlc.Settle != nil:
+				return htlc.Settle.Preimage, nil
+
+			case reason != nil:
+				return lntypes.Preimage{}, *reason
+
+			default:
+				return lntypes.Preimage{}, errors.New(
+					"unknown payment outcome",
+				)
+			}
+
+		case <-f.quit:
+			return lntypes.Preimage{}, routing.ErrRouterShuttingDown
+		}
+	}
+}
+
+// resolve settles the parts of a trampoline payment with the given preimage
+// or fails them with the given failure.
+func (f *Forwarder) resolve(set *htlcSet, preimage fn.Option[lntypes.Preimage],
+	failure *forwardFailure) {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.resolveLocked(set, preimage, failure)
+}
+
+// resolveLocked settles the parts of a trampoline payment with the given
+// preimage or fails them with the given failure.
+//
+// NOTE: The caller MUST hold the mutex.
+func (f *Forwarder) resolveLocked(set *htlcSet,
+	preimage fn.Option[lntypes.Preimage], failure *forwardFailure) {
+
+	if f.sets[set.key] == set {
+		delete(f.sets, set.key)
+	}
+
+	for key, htlc := range set.htlcs {
+		// The link of this part unsubscribed, it is resolved once it
+		// is replayed.
+		if htlc.hodlChan == nil {
+			continue
+		}
+
+		var resolution invoices.HtlcResolution
+		preimage.WhenSome(func(p lntypes.Preimage) {
+			resolution = invoices.NewSettleResolution(
+				p, key, htlc.acceptHeight,
+				invoices.ResultSettled,
+			)
+		})
+		if resolution == nil {
+			resolution = failResolution(
+				key, htlc.acceptHeight, failure,
+			)
+		}
+
+		select {
+		case htlc.hodlChan <- resolution:
+		case <-f.quit:
+			return
+		}
+	}
+}
@@ -0,0 +1,326 @@
+package trampoline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/htlcswitch/hop"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	paymentsdb "github.com/lightningnetwork/lnd/payments/db"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testHeight  = 100
+	testTimeout = 5 * time.Second
+)
+
+var (
+	testHash     = lntypes.Hash{1}
+	testPreimage = lntypes.Preimage{2}
+	testAddr     = [32]byte{3}
+)
+
+// mockPayload is a final hop payload of a trampoline HTLC.
+type mockPayload struct {
+	mpp           *record.MPP
+	customRecords record.CustomSet
+}
+
+func (p *mockPayload) MultiPath() *record.MPP {
+	return p.mpp
+}
+
+func (p *mockPayload) AMPRecord() *record.AMP {
+	return nil
+}
+
+func (p *mockPayload) CustomRecords() record.CustomSet {
+	return p.customRecords
+}
+
+func (p *mockPayload) Metadata() []byte {
+	return nil
+}
+
+func (p *mockPayload) PathID() *chainhash.Hash {
+	return nil
+}
+
+func (p *mockPayload) TotalAmtMsat() lnwire.MilliSatoshi {
+	return p.mpp.TotalMsat()
+}
+
+// mockRegistry records the HTLCs handed to the invoice registry.
+type mockRegistry struct {
+	htlcswitch.InvoiceDatabase
+
+	notified []lntypes.Hash
+}
+
+func (r *mockRegistry) NotifyExitHopHtlc(hash lntypes.Hash,
+	_ lnwire.MilliSatoshi, _ uint32, _ int32, key models.CircuitKey,
+	_ chan<- interface{}, _ lnwire.CustomRecords,
+	_ invoices.Payload) (invoices.HtlcResolution, error) {
+
+	r.notified = append(r.notified, hash)
+
+	return invoices.NewFailResolution(
+		key, testHeight, invoices.ResultInvoiceNotFound,
+	), nil
+}
+
+func (r *mockRegistry) HodlUnsubscribeAll(chan<- interface{}) {}
+
+// forwarderTestContext holds a forwarder with mocked dependencies.
+type forwarderTestContext struct {
+	t         *testing.T
+	forwarder *Forwarder
+	registry  *mockRegistry
+	clock     *clock.TestClock
+
+	// tickSignal receives the durations of the registered timeouts.
+	tickSignal chan time.Duration
+
+	// payments receives the payments sent by the forwarder.
+	payments chan *routing.LightningPayment
+
+	// results is used to return the result of a payment.
+	results chan error
+}
+
+func newForwarderTestContext(t *testing.T) *forwarderTestContext {
+	t.Helper()
+
+	recipient, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	tickSignal := make(chan time.Duration, 10)
+	ctx := &forwarderTestContext{
+		t:        t,
+		registry: &mockRegistry{},
+		clock: clock.NewTestClockWithTickSignal(
+			time.Unix(1, 0), tickSignal,
+		),
+		tickSignal: tickSignal,
+		payments:   make(chan *routing.LightningPayment, 1),
+		results:    make(chan error, 1),
+	}
+
+	ctx.forwarder = NewForwarder(&Config{
+		Registry: ctx.registry,
+		DecodeOnion: func(_, _ []byte) (*hop.TrampolinePayload,
+			error) {
+
+			return &hop.TrampolinePayload{
+				AmtToForward:   100_000,
+				OutgoingCltv:   testHeight + 200,
+				OutgoingNodeID: recipient.PubKey(),
+				MPP: record.NewMPP(
+					100_000, [32]byte{9},
+				),
+			}, nil
+		},
+		SendPayment: func(payCtx context.Context,
+			p *routing.LightningPayment) ([32]byte, *route.Route,
+			error) {
+
+			ctx.payments <- p
+
+			select {
+			case err := <-ctx.results:
+				if err != nil {
+					return [32]byte{}, nil, err
+				}
+
+				return testPreimage, nil, nil
+
+			case <-payCtx.Done():
+				return [32]byte{}, nil, payCtx.Err()
+			}
+		},
+		FetchPayment: func(context.Context,
+			lntypes.Hash) (paymentsdb.DBMPPayment, error) {
+
+			return nil, paymentsdb.ErrPaymentNotInitiated
+		},
+		FeeBaseMsat: 1000,
+		FeeRate:     500,
+		CltvDelta:   144,
+		MppTimeout:  time.Minute,
+		Clock:       ctx.clock,
+	})
+	require.NoError(t, ctx.forwarder.Start())
+	t.Cleanup(func() {
+		require.NoError(t, ctx.forwarder.Stop())
+	})
+
+	return ctx
+}
+
+// notify hands a trampoline HTLC to the forwarder and returns its hodl
+// channel.
+func (c *forwarderTestContext) notify(id uint64, amt,
+	total lnwire.MilliSatoshi, expiry uint32) chan interface{} {
+
+	hodlChan := make(chan interface{}, 1)
+	resolution, err := c.forwarder.NotifyExitHopHtlc(
+		testHash, amt, expiry, testHeight,
+		models.CircuitKey{HtlcID: id}, hodlChan, nil,
+		&mockPayload{
+			mpp: record.NewMPP(total, testAddr),
+			customRecords: record.CustomSet{
+				record.TrampolineOnionType: {1, 2, 3},
+			},
+		},
+	)
+	require.NoError(c.t, err)
+	require.Nil(c.t, resolution)
+
+	return hodlChan
+}
+
+// receiveResolution returns the resolution delivered on the hodl channel.
+func (c *forwarderTestContext) receiveResolution(
+	hodlChan chan interface{}) invoices.HtlcResolution {
+
+	select {
+	case resolution := <-hodlChan:
+		return resolution.(invoices.HtlcResolution)
+
+	case <-time.After(testTimeout):
+		c.t.Fatal("no resolution received")
+		return nil
+	}
+}
+
+// receivePayment returns the payment sent by the forwarder.
+func (c *forwarderTestContext) receivePayment() *routing.LightningPayment {
+	select {
+	case payment := <-c.payments:
+		return payment
+
+	case <-time.After(testTimeout):
+		c.t.Fatal("no payment sent")
+		return nil
+	}
+}
+
+// TestForwarderSettle tests that the parts of a trampoline payment are settled
+// once the payment to the recipient succeeded.
+func TestForwarderSettle(t *testing.T) {
+	t.Parallel()
+
+	ctx := newForwarderTestContext(t)
+
+	// HTLCs without a trampoline onion are handled by the registry.
+	_, err := ctx.forwarder.NotifyExitHopHtlc(
+		lntypes.Hash{5}, 1000, 500, testHeight, models.CircuitKey{},
+		nil, nil, &mockPayload{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []lntypes.Hash{{5}}, ctx.registry.notified)
+
+	// We receive a payment of 100_000 msat with a fee of 2_000 msat, of
+	// which we require 1_050 msat.
+	hodlChan1 := ctx.notify(1, 60_000, 102_000, testHeight+400)
+	hodlChan2 := ctx.notify(2, 42_000, 102_000, testHeight+450)
+
+	payment := ctx.receivePayment()
+	require.EqualValues(t, 100_000, payment.Amount)
+	require.EqualValues(t, 950, payment.FeeLimit)
+	require.EqualValues(t, 400-144, payment.CltvLimit)
+	require.EqualValues(
+		t, 200-routing.BlockPadding, payment.FinalCLTVDelta,
+	)
+	require.EqualValues(t, testHash, payment.Identifier())
+	require.Equal(t, [32]byte{9}, payment.PaymentAddr.UnwrapOr([32]byte{}))
+
+	ctx.results <- nil
+
+	for i, hodlChan := range []chan interface{}{hodlChan1, hodlChan2} {
+		resolution := ctx.receiveResolution(hodlChan)
+		require.EqualValues(t, i+1, resolution.CircuitKey().HtlcID)
+
+		settle, ok := resolution.(*invoices.HtlcSettleResolution)
+		require.True(t, ok)
+		require.Equal(t, testPreimage, settle.Preimage)
+	}
+}
+
+// TestForwarderFail tests that the parts of a trampoline payment are failed
+// with the failure message for the sender.
+func TestForwarderFail(t *testing.T) {
+	t.Parallel()
+
+	requireFailure := func(resolution invoices.HtlcResolution,
+		outcome invoices.FailResolutionResult,
+		msg lnwire.FailureMessage) {
+
+		fail, ok := resolution.(*invoices.HtlcFailResolution)
+		require.True(t, ok)
+		require.Equal(t, outcome, fail.Outcome)
+		require.Equal(t, msg, fail.FailureMessage)
+	}
+
+	// The sender didn't leave us enough fees.
+	ctx := newForwarderTestContext(t)
+	hodlChan := ctx.notify(1, 100_500, 100_500, testHeight+400)
+	requireFailure(
+		ctx.receiveResolution(hodlChan),
+		invoices.ResultTrampolineForwardFailed,
+		lnwire.NewTrampolineFeeOrExpiryInsufficient(1000, 500, 144),
+	)
+
+	// The recipient rejected the payment.
+	ctx = newForwarderTestContext(t)
+	hodlChan = ctx.notify(1, 102_000, 102_000, testHeight+400)
+	ctx.receivePayment()
+	ctx.results <- paymentsdb.FailureReasonPaymentDetails
+
+	requireFailure(
+		ctx.receiveResolution(hodlChan),
+		invoices.ResultTrampolineForwardFailed,
+		lnwire.NewFailIncorrectDetails(102_000, testHeight),
+	)
+
+	// No route to the recipient was found.
+	ctx = newForwarderTestContext(t)
+	hodlChan = ctx.notify(1, 102_000, 102_000, testHeight+400)
+	ctx.receivePayment()
+	ctx.results <- paymentsdb.FailureReasonNoRoute
+
+	requireFailure(
+		ctx.receiveResolution(hodlChan),
+		invoices.ResultTrampolineForwardFailed,
+		&lnwire.FailTemporaryTrampolineFailure{},
+	)
+
+	// Not all parts arrived in time.
+	ctx = newForwarderTestContext(t)
+	hodlChan = ctx.notify(1, 50_000, 102_000, testHeight+400)
+
+	// Wait for the timeout to be registered before advancing the clock.
+	select {
+	case <-ctx.tickSignal:
+	case <-time.After(testTimeout):
+		t.Fatal("timeout not registered")
+	}
+	ctx.clock.SetTime(ctx.clock.Now().Add(time.Minute))
+
+	requireFailure(
+		ctx.receiveResolution(hodlChan), invoices.ResultMppTimeout,
+		nil,
+	)
+}
@@ -0,0 +1,215 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	switchhop "github.com/lightningnetwork/lnd/htlcswitch/hop"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTrampolineSession tests that the trampoline session routes the payment
+// to the trampoline nodes in order and instructs them to forward the payment
+// to the recipient.
+func TestTrampolineSession(t *testing.T) {
+	t.Parallel()
+
+	const height = 100
+
+	var (
+		keys     []*btcec.PrivateKey
+		vertices []route.Vertex
+	)
+	for i := 0; i < 3; i++ {
+		key, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		keys = append(keys, key)
+		vertices = append(vertices, route.NewVertex(key.PubKey()))
+	}
+	recipient, trampoline1, trampoline2 := vertices[0], vertices[1],
+		vertices[2]
+
+	payment := &LightningPayment{
+		Target:         recipient,
+		Amount:         100_000,
+		FeeLimit:       1000,
+		CltvLimit:      2000,
+		FinalCLTVDelta: 40,
+		PaymentAddr:    fn.Some([32]byte{1}),
+		DestFeatures: lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(
+				lnwire.TLVOnionPayloadRequired,
+				lnwire.PaymentAddrRequired,
+				lnwire.MPPOptional,
+			), lnwire.Features,
+		),
+		MaxParts: 1,
+		Trampoline: &TrampolineParams{
+			Nodes:      []route.Vertex{trampoline1, trampoline2},
+			FeeBudget:  5000,
+			CltvBudget: 200,
+		},
+	}
+	paymentHash := lntypes.Hash{2}
+	require.NoError(t, payment.SetPaymentHash(paymentHash))
+
+	// The mocked path finder returns a direct route to the target, unless
+	// the target is unreachable.
+	var unreachable route.Vertex
+	newSession := func(p *LightningPayment) (*paymentSession, error) {
+		session, err := newPaymentSession(
+			p, route.Vertex{},
+			func(Graph) (bandwidthHints, error) {
+				return &mockBandwidthHints{}, nil
+			},
+			&sessionGraph{}, &MissionControl{},
+			PathFindingConfig{},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		session.pathFinder = func(_ *graphParams, _ *RestrictParams,
+			_ *PathFindingConfig, _, _, target route.Vertex,
+			_ lnwire.MilliSatoshi, _ float64, _ int32) (
+			[]*unifiedEdge, float64, error) {
+
+			if target == unreachable {
+				return nil, 0, errNoPathFound
+			}
+
+			return []*unifiedEdge{{
+				policy: &models.CachedEdgePolicy{
+					ToNodePubKey: func() route.Vertex {
+						return target
+					},
+					ToNodeFeatures: p.DestFeatures,
+				},
+			}}, 1, nil
+		}
+
+		return session, nil
+	}
+
+	session, err := newTrampolineSession(payment, newSession)
+	require.NoError(t, err)
+
+	requestRoute := func(activeShards uint32) (*route.Route, error) {
+		return session.RequestRoute(
+			payment.Amount+payment.Trampoline.FeeBudget,
+			payment.FeeLimit, activeShards, height, nil,
+		)
+	}
+
+	// The first route goes to the first trampoline node and carries the
+	// fee and cltv budget.
+	rt, err := requestRoute(0)
+	require.NoError(t, err)
+
+	finalHop := rt.FinalHop()
+	require.Equal(t, trampoline1, finalHop.PubKeyBytes)
+	require.EqualValues(t, 105_000, finalHop.AmtToForward)
+	require.EqualValues(t, 105_000, finalHop.MPP.TotalMsat())
+	require.NotEqual(t, [32]byte{1}, finalHop.MPP.PaymentAddr())
+	require.EqualValues(
+		t, height+40+200+BlockPadding, finalHop.OutgoingTimeLock,
+	)
+
+	// The trampoline node can decode the trampoline onion and finds the
+	// instructions to pay the recipient.
+	processor := switchhop.NewOnionProcessor(sphinx.NewRouter(
+		&sphinx.PrivKeyECDH{PrivKey: keys[1]},
+		sphinx.NewMemoryReplayLog(),
+	))
+	payload, err := processor.DecodeTrampolineOnion(
+		finalHop.CustomRecords[record.TrampolineOnionType],
+		paymentHash[:],
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 100_000, payload.AmtToForward)
+	require.EqualValues(t, height+40+BlockPadding, payload.OutgoingCltv)
+	require.Equal(
+		t, recipient, route.NewVertex(payload.OutgoingNodeID),
+	)
+	require.Equal(t, [32]byte{1}, payload.MPP.PaymentAddr())
+	require.EqualValues(t, 100_000, payload.MPP.TotalMsat())
+
+	// A failure from an intermediate node doesn't affect the trampoline
+	// node choice.
+	session.handleAttemptFailure(
+		rt, 0, &lnwire.FailTemporaryTrampolineFailure{},
+	)
+	rt, err = requestRoute(0)
+	require.NoError(t, err)
+	require.Equal(t, trampoline1, rt.FinalHop().PubKeyBytes)
+
+	// Once the trampoline node fails the payment, the next one is used.
+	session.handleAttemptFailure(
+		rt, 1, &lnwire.FailTemporaryTrampolineFailure{},
+	)
+
+	// A failure for the previous trampoline node doesn't skip the current
+	// one.
+	session.handleAttemptFailure(
+		rt, 1, &lnwire.FailTrampolineFeeOrExpiryInsufficient{},
+	)
+
+	rt, err = requestRoute(0)
+	require.NoError(t, err)
+	require.Equal(t, trampoline2, rt.FinalHop().PubKeyBytes)
+
+	// If the trampoline node becomes unreachable while parts are in
+	// flight to it, we don't move on to the next trampoline node.
+	unreachable = trampoline2
+	_, err = requestRoute(1)
+	require.ErrorIs(t, err, errNoPathFound)
+	require.Equal(t, 1, session.candidate)
+
+	// Without parts in flight, the session moves on but has run out of
+	// trampoline nodes.
+	_, err = requestRoute(0)
+	require.ErrorIs(t, err, errNoPathFound)
+	require.Equal(t, 2, session.candidate)
+}
+
+// TestTrampolineSessionInvalid tests that invalid trampoline payments are
+// rejected.
+func TestTrampolineSessionInvalid(t *testing.T) {
+	t.Parallel()
+
+	payment := &LightningPayment{
+		Target:    route.Vertex{1},
+		Amount:    1000,
+		CltvLimit: 1000,
+		Trampoline: &TrampolineParams{
+			Nodes: []route.Vertex{{2}},
+		},
+	}
+	require.NoError(t, payment.SetPaymentHash(lntypes.Hash{}))
+
+	// The trampoline node key is invalid, so no onion can be created for
+	// it.
+	session, err := newTrampolineSession(
+		payment, func(*LightningPayment) (*paymentSession, error) {
+			t.Fatal("unexpected session")
+			return nil, nil
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = session.RequestRoute(1000, 0, 0, 100, nil)
+	require.Error(t, err)
+
+	// A payment without trampoline nodes is rejected.
+	payment.Trampoline.Nodes = nil
+	_, err = newTrampolineSession(payment, nil)
+	require.ErrorIs(t, err, errTrampolineNoCandidates)
+}
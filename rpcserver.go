@@ -44,6 +44,7 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/channelnotifier"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/closescheduler"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
 	"github.com/lightningnetwork/lnd/feature"
@@ -57,6 +58,7 @@ import (
 	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/liquidityads"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
@@ -74,7 +76,9 @@ import (
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/onionmessage"
 	paymentsdb "github.com/lightningnetwork/lnd/payments/db"
+	"github.com/lightningnetwork/lnd/payments/receipt"
 	"github.com/lightningnetwork/lnd/peer"
+	"github.com/lightningnetwork/lnd/peermgr"
 	"github.com/lightningnetwork/lnd/peernotifier"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/routing/blindedpath"
@@ -575,6 +579,52 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/BuyLiquidity": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ListLiquidityAds": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/BumpFundingFee": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/PauseChannel": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ResumeChannel": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ScheduleChannelClose": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/CancelScheduledClose": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ListPersistentPeers": {{
+			Entity: "peers",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/SetPeerConnPolicy": {{
+			Entity: "peers",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ReconnectPeer": {{
+			Entity: "peers",
+			Action: "write",
+		}},
 	}
 }
 
@@ -771,6 +821,26 @@ func (r *rpcServer) addDeps(ctx context.Context, s *server,
 		ForwardingLog:             s.miscDB.ForwardingLog(),
 		MinForwardingHistoryAge:   s.cfg.Dev.GetMinFwdHistoryAge(),
 		FwdHistoryDeleteBatchSize: s.cfg.FwdHistoryDeleteBatchSize,
+		FeePolicyEngine:           s.feePolicyEngine,
+		Rebalancer:                s.rebalancer,
+		Prober:                    s.prober,
+		ResourceManager:           s.resourceManager,
+		PeerPolicies:              s.peerPolicies,
+	}
+
+	// Receipts are returned in onion messages, so they can only be
+	// requested if onion messages are enabled.
+	if !s.cfg.ProtocolOptions.NoOnionMessages() {
+		routerBackend.NewReceiptReplyPath = func() ([]byte, error) {
+			replyPath, err := receipt.NewReplyPath(
+				s.identityECDH.PubKey(),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return receipt.EncodeReplyPath(replyPath)
+		}
 	}
 
 	genInvoiceFeatures := func() *lnwire.FeatureVector {
@@ -796,9 +866,10 @@ func (r *rpcServer) addDeps(ctx context.Context, s *server,
 	// TODO(roasbeef): extend sub-sever config to have both (local vs remote) DB
 	err = subServerCgs.PopulateDependencies(
 		r.cfg, s.cc, r.cfg.networkDir, macService, atpl, invoiceRegistry,
-		s.htlcSwitch, r.cfg.ActiveNetParams.Params, s.chanRouter,
-		routerBackend, s.nodeSigner, s.graphDB, s.chanStateDB,
-		s.sweeper, tower, s.towerClientMgr, r.cfg.net.ResolveTCPAddr,
+		s.subscriptionMgr, s.htlcSwitch, r.cfg.ActiveNetParams.Params,
+		s.chanRouter, routerBackend, s.nodeSigner, s.graphDB, s.chanStateDB,
+		s.sweeper, s.anchorReclaimer, tower, s.towerClientMgr,
+		r.cfg.net.ResolveTCPAddr,
 		genInvoiceFeatures, genAmpInvoiceFeatures,
 		s.getNodeAnnouncement, s.updateAndBroadcastSelfNode, parseAddr,
 		rpcsLog, s.aliasMgr, r.implCfg.AuxDataParser,
@@ -2430,6 +2501,22 @@ func (r *rpcServer) parseOpenChannelReq(in *lnrpc.OpenChannelRequest,
 
 		*channelType = lnwire.ChannelType(*fv)
 
+	case lnrpc.CommitmentType_ZERO_FEE_COMMITMENTS:
+		channelType = new(lnwire.ChannelType)
+		fv := lnwire.NewRawFeatureVector(
+			lnwire.ZeroFeeCommitmentsRequired,
+		)
+
+		if in.ZeroConf {
+			fv.Set(lnwire.ZeroConfRequired)
+		}
+
+		if in.ScidAlias {
+			fv.Set(lnwire.ScidAliasRequired)
+		}
+
+		*channelType = lnwire.ChannelType(*fv)
+
 	default:
 		return nil, fmt.Errorf("unhandled request channel type %v",
 			in.CommitmentType)
@@ -2480,6 +2567,7 @@ func (r *rpcServer) parseOpenChannelReq(in *lnrpc.OpenChannelRequest,
 		MinFundAmt:        minFundAmt,
 		Memo:              []byte(in.Memo),
 		Outpoints:         outpoints,
+		DualFund:          in.DualFund,
 	}, nil
 }
 
@@ -2893,11 +2981,8 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 			}
 		}
 
-		var (
-			chanInSwitch     = true
-			chanHasRbfCloser = r.server.ChanHasRbfCoopCloser(
-				channel.IdentityPub, *chanPoint,
-			)
+		chanHasRbfCloser := r.server.ChanHasRbfCoopCloser(
+			channel.IdentityPub, *chanPoint,
 		)
 
 		// If the link is not known by the switch, we cannot gracefully close
@@ -2905,8 +2990,6 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		channelID := lnwire.NewChanIDFromOutPoint(*chanPoint)
 
 		if _, err := r.server.htlcSwitch.GetLink(channelID); err != nil {
-			chanInSwitch = false
-
 			// The channel isn't in the switch, but if there's an
 			// active chan closer for the channel, and it's of the
 			// RBF variant, then we can actually bypass the switch.
@@ -2959,30 +3042,12 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		// the htlc switch which will handle the negotiation and
 		// broadcast details.
 
-		var deliveryScript lnwire.DeliveryAddress
-
 		// If a delivery address to close out to was specified, decode it.
-		if len(in.DeliveryAddress) > 0 {
-			// Decode the address provided.
-			addr, err := address.DecodeAddress(
-				in.DeliveryAddress, r.cfg.ActiveNetParams.Params,
-			)
-			if err != nil {
-				return fmt.Errorf("invalid delivery address: "+
-					"%v", err)
-			}
-
-			if !addr.IsForNet(r.cfg.ActiveNetParams.Params) {
-				return fmt.Errorf("delivery address is not "+
-					"for %s",
-					r.cfg.ActiveNetParams.Params.Name)
-			}
-
-			// Create a script to pay out to the address provided.
-			deliveryScript, err = txscript.PayToAddrScript(addr)
-			if err != nil {
-				return err
-			}
+		deliveryScript, err := r.parseDeliveryAddress(
+			in.DeliveryAddress,
+		)
+		if err != nil {
+			return err
 		}
 
 		maxFee := chainfee.SatPerKVByte(
@@ -2997,44 +3062,16 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 				feeRate)
 		}
 
-		if chanHasRbfCloser && !chanInSwitch {
-			rpcsLog.Infof("Bypassing Switch to do fee bump "+
-				"for ChannelPoint(%v)", chanPoint)
-
-			// To perform this RBF bump, we'll send a bump message
-			// to the RBF close actor. We propagate the stream
-			// context so that cancellation of the RPC client also
-			// tears down the observer goroutine.
-			ctx := updateStream.Context()
-			rbfBumpMsg := peer.NewRbfBumpCloseMsg(
-				ctx, *chanPoint, feeRate, deliveryScript,
-			)
-			rbfActorKey := peer.NewRbfCloserPeerServiceKey(
-				*chanPoint,
-			)
-			rbfRouter := peer.RbfChanCloserRouter(
-				r.server.actorSystem, rbfActorKey,
-			)
-
-			closeUpdates, err := rbfRouter.Ask(
-				ctx, rbfBumpMsg,
-			).Await(ctx).Unpack()
-			if err != nil {
-				return fmt.Errorf("unable to ask for RBF "+
-					"close: %w", err)
-			}
-
-			updateChan = closeUpdates.UpdateChan
-			errChan = closeUpdates.ErrChan
-		} else {
-			maxFee := chainfee.SatPerKVByte(
-				in.MaxFeePerVbyte * 1000,
-			).FeePerKWeight()
-			updateChan, errChan = r.server.htlcSwitch.CloseLink(
-				updateStream.Context(), chanPoint,
-				contractcourt.CloseRegular, feeRate, maxFee,
-				deliveryScript,
-			)
+		// If the channel isn't in the switch anymore, this bumps the
+		// fee of the closing transaction through its RBF closer. We
+		// propagate the stream context so that cancellation of the
+		// RPC client also tears down the observer goroutine.
+		updateChan, errChan, err = r.server.coopCloseChannel(
+			updateStream.Context(), channel.IdentityPub,
+			*chanPoint, feeRate, maxFee, deliveryScript,
+		)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -3115,6 +3152,32 @@ out:
 	return nil
 }
 
+// parseDeliveryAddress decodes the delivery address of a cooperative close
+// into the script to pay out to. An empty address results in an empty script.
+func (r *rpcServer) parseDeliveryAddress(
+	deliveryAddr string) (lnwire.DeliveryAddress, error) {
+
+	if len(deliveryAddr) == 0 {
+		return nil, nil
+	}
+
+	// Decode the address provided.
+	addr, err := address.DecodeAddress(
+		deliveryAddr, r.cfg.ActiveNetParams.Params,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid delivery address: %v", err)
+	}
+
+	if !addr.IsForNet(r.cfg.ActiveNetParams.Params) {
+		return nil, fmt.Errorf("delivery address is not for %s",
+			r.cfg.ActiveNetParams.Params.Name)
+	}
+
+	// Create a script to pay out to the address provided.
+	return txscript.PayToAddrScript(addr)
+}
+
 func createRPCCloseUpdate(
 	update interface{}) (*lnrpc.CloseStatusUpdate, error) {
 
@@ -4561,6 +4624,14 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 	resp.WaitingCloseChannels = waitingCloseChannels
 	resp.TotalLimboBalance += limbo
 
+	// Finally, we add the closes scheduled for channels that are still
+	// open or waiting for their closing transaction to confirm.
+	scheduledCloses, err := r.fetchScheduledCloses()
+	if err != nil {
+		return nil, err
+	}
+	resp.ScheduledCloses = scheduledCloses
+
 	err = fn.MapOptionZ(
 		r.server.implCfg.AuxDataParser,
 		func(parser AuxDataParser) error {
@@ -4814,6 +4885,520 @@ func (r *rpcServer) LookupHtlcResolution(
 	}, nil
 }
 
+// BuyLiquidity buys inbound liquidity from a node that advertises lease rates.
+// It opens a dual-funded channel to the node that requests the liquidity, and
+// returns once the funding transaction of the leased channel is published.
+func (r *rpcServer) BuyLiquidity(ctx context.Context,
+	in *lnrpc.BuyLiquidityRequest) (*lnrpc.BuyLiquidityResponse, error) {
+
+	if err := r.canOpenChannel(); err != nil {
+		return nil, err
+	}
+
+	seller, err := btcec.ParsePubKey(in.NodePubkey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node pubkey: %w", err)
+	}
+
+	switch {
+	case in.AmtSat <= 0:
+		return nil, errors.New("amt_sat must be positive")
+
+	case in.MaxFeeSat <= 0:
+		return nil, errors.New("max_fee_sat must be positive")
+
+	case in.SatPerVbyte == 0:
+		return nil, errors.New("sat_per_vbyte must be specified")
+
+	// The lease fee is paid out of our side of the channel, so we need to
+	// contribute to the funding output.
+	case in.LocalFundingAmount <= 0:
+		return nil, errors.New("local_funding_amount must be positive")
+	}
+
+	rates, err := r.server.fetchLeaseRates(ctx, route.NewVertex(seller))
+	if err != nil {
+		return nil, err
+	}
+	if rates == nil {
+		return nil, errors.New("node doesn't advertise lease rates")
+	}
+
+	// The seller must commit to the rates it advertises, so we know the
+	// lease fee up front.
+	amt := btcutil.Amount(in.AmtSat)
+	feeRate := chainfee.SatPerKVByte(in.SatPerVbyte * 1000).FeePerKWeight()
+	leaseFee := liquidityads.LeaseFee(rates, amt, feeRate)
+	if leaseFee > btcutil.Amount(in.MaxFeeSat) {
+		return nil, fmt.Errorf("lease fee of %v exceeds maximum fee "+
+			"of %v", leaseFee, btcutil.Amount(in.MaxFeeSat))
+	}
+
+	_, height, err := r.server.cc.ChainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := r.parseOpenChannelReq(&lnrpc.OpenChannelRequest{
+		NodePubkey:         in.NodePubkey,
+		LocalFundingAmount: in.LocalFundingAmount,
+		SatPerVbyte:        in.SatPerVbyte,
+		Private:            in.Private,
+		CommitmentType:     lnrpc.CommitmentType_SCRIPT_ENFORCED_LEASE,
+		DualFund:           true,
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	req.RequestFunds = &lnwire.RequestFunds{
+		RequestedSats: amt,
+		BlockHeight:   uint32(height),
+	}
+	req.LeaseRates = rates
+
+	updateChan, errChan := r.server.OpenChannel(req)
+	select {
+	case err := <-errChan:
+		return nil, err
+
+	case update := <-updateChan:
+		pending, ok := update.Update.(*lnrpc.OpenStatusUpdate_ChanPending)
+		if !ok {
+			return nil, fmt.Errorf("unexpected update %T",
+				update.Update)
+		}
+
+		return &lnrpc.BuyLiquidityResponse{
+			ChannelPoint: &lnrpc.ChannelPoint{
+				FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+					FundingTxidBytes: pending.ChanPending.Txid,
+				},
+				OutputIndex: pending.ChanPending.OutputIndex,
+			},
+			LeaseExpiry: uint32(height) + liquidityads.LeaseDuration,
+			LeaseFeeSat: int64(leaseFee),
+		}, nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case <-r.quit:
+		return nil, ErrServerShuttingDown
+	}
+}
+
+// ListLiquidityAds lists the nodes in the graph that advertise lease rates for
+// inbound liquidity.
+func (r *rpcServer) ListLiquidityAds(ctx context.Context,
+	_ *lnrpc.ListLiquidityAdsRequest) (*lnrpc.ListLiquidityAdsResponse,
+	error) {
+
+	var resp lnrpc.ListLiquidityAdsResponse
+	err := r.server.v1Graph.ForEachNode(ctx, func(node *models.Node) error {
+		rates, err := lnwire.ExtractLeaseRates(node.ExtraOpaqueData)
+		if err != nil {
+			rpcsLog.Debugf("Invalid lease rates of node %x: %v",
+				node.PubKeyBytes, err)
+
+			return nil
+		}
+		if rates == nil {
+			return nil
+		}
+
+		resp.Ads = append(resp.Ads, &lnrpc.LiquidityAd{
+			PubKey: hex.EncodeToString(node.PubKeyBytes[:]),
+			Alias:  node.Alias.UnwrapOr(""),
+			Rates:  marshalLeaseRates(rates),
+		})
+
+		return nil
+	}, func() {
+		resp.Ads = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// BumpFundingFee replaces the funding transaction of a pending dual-funded
+// channel with one that pays a higher fee rate.
+func (r *rpcServer) BumpFundingFee(ctx context.Context,
+	in *lnrpc.BumpFundingFeeRequest) (*lnrpc.BumpFundingFeeResponse,
+	error) {
+
+	if in.ChanPoint == nil {
+		return nil, errors.New("chan_point must be specified")
+	}
+	if in.SatPerVbyte == 0 {
+		return nil, errors.New("sat_per_vbyte must be specified")
+	}
+
+	txid, err := lnrpc.GetChanPointFundingTxid(in.ChanPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChanPoint.OutputIndex)
+
+	feeRate := chainfee.SatPerKVByte(in.SatPerVbyte * 1000).FeePerKWeight()
+
+	rpcsLog.Debugf("[bumpfundingfee] chan_point=%v, fee_rate=%v",
+		chanPoint, feeRate)
+
+	newChanPoint, err := r.server.fundingMgr.BumpFundingFee(
+		ctx, *chanPoint, feeRate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.BumpFundingFeeResponse{
+		ChanPoint: &lnrpc.ChannelPoint{
+			FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+				FundingTxidBytes: newChanPoint.Hash[:],
+			},
+			OutputIndex: newChanPoint.Index,
+		},
+	}, nil
+}
+
+// defaultPauseChannelTimeout is the time PauseChannel waits for the HTLCs of a
+// channel to be resolved if the caller didn't specify a timeout.
+const defaultPauseChannelTimeout = time.Minute
+
+// lookupChannelLink returns the active link of the channel with the given
+// channel point.
+func (r *rpcServer) lookupChannelLink(
+	rpcChanPoint *lnrpc.ChannelPoint) (htlcswitch.ChannelUpdateHandler,
+	*wire.OutPoint, error) {
+
+	if rpcChanPoint == nil {
+		return nil, nil, errors.New("chan_point must be specified")
+	}
+
+	txid, err := lnrpc.GetChanPointFundingTxid(rpcChanPoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, rpcChanPoint.OutputIndex)
+
+	chanID := lnwire.NewChanIDFromOutPoint(*chanPoint)
+	link, err := r.server.htlcSwitch.GetLink(chanID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to find active link for "+
+			"channel %v: %w", chanPoint, err)
+	}
+
+	return link, chanPoint, nil
+}
+
+// PauseChannel stops a channel from accepting new HTLCs and waits for its
+// in-flight HTLCs to be resolved.
+func (r *rpcServer) PauseChannel(ctx context.Context,
+	in *lnrpc.PauseChannelRequest) (*lnrpc.PauseChannelResponse, error) {
+
+	link, chanPoint, err := r.lookupChannelLink(in.ChanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := defaultPauseChannelTimeout
+	if in.TimeoutSeconds != 0 {
+		timeout = time.Duration(in.TimeoutSeconds) * time.Second
+	}
+
+	rpcsLog.Debugf("[pausechannel] chan_point=%v, timeout=%v", chanPoint,
+		timeout)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := link.Pause(ctx); err != nil {
+		return nil, fmt.Errorf("unable to pause channel %v: %w",
+			chanPoint, err)
+	}
+
+	rpcsLog.Infof("Paused channel %v", chanPoint)
+
+	return &lnrpc.PauseChannelResponse{}, nil
+}
+
+// ResumeChannel allows HTLCs on a previously paused channel again.
+func (r *rpcServer) ResumeChannel(_ context.Context,
+	in *lnrpc.ResumeChannelRequest) (*lnrpc.ResumeChannelResponse, error) {
+
+	link, chanPoint, err := r.lookupChannelLink(in.ChanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[resumechannel] chan_point=%v", chanPoint)
+
+	if err := link.Resume(); err != nil {
+		return nil, fmt.Errorf("unable to resume channel %v: %w",
+			chanPoint, err)
+	}
+
+	rpcsLog.Infof("Resumed channel %v", chanPoint)
+
+	return &lnrpc.ResumeChannelResponse{}, nil
+}
+
+// ScheduleChannelClose schedules the cooperative close of a channel for when
+// its deadline height or target fee rate is reached.
+func (r *rpcServer) ScheduleChannelClose(_ context.Context,
+	in *lnrpc.ScheduleChannelCloseRequest) (
+	*lnrpc.ScheduleChannelCloseResponse, error) {
+
+	if in.ChannelPoint == nil {
+		return nil, errors.New("channel_point must be specified")
+	}
+
+	txid, err := lnrpc.GetChanPointFundingTxid(in.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+	channel, err := r.server.chanStateDB.FetchChannel(*chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like for an immediate close, we refuse to schedule the close of
+	// restored channels or channels that have experienced local data loss.
+	if channel.HasChanStatus(channeldb.ChanStatusRestored) ||
+		channel.HasChanStatus(channeldb.ChanStatusLocalDataLoss) {
+
+		return nil, fmt.Errorf("cannot close channel with state: %v",
+			channel.ChanStatus())
+	}
+
+	deliveryScript, err := r.parseDeliveryAddress(in.DeliveryAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	targetFeeRate := chainfee.SatPerKVByte(
+		in.TargetSatPerVbyte * 1000,
+	).FeePerKWeight()
+
+	rpcsLog.Debugf("[scheduleclose] chan_point=%v, deadline_height=%v, "+
+		"target_fee_rate=%v, target_conf=%v, budget_sat=%v", chanPoint,
+		in.DeadlineHeight, targetFeeRate, in.TargetConf, in.BudgetSat)
+
+	err = r.server.closeScheduler.Schedule(&closescheduler.ScheduledClose{
+		ChanPoint:      *chanPoint,
+		DeadlineHeight: in.DeadlineHeight,
+		TargetFeeRate:  targetFeeRate,
+		ConfTarget:     in.TargetConf,
+		Budget:         btcutil.Amount(in.BudgetSat),
+		DeliveryScript: deliveryScript,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.ScheduleChannelCloseResponse{}, nil
+}
+
+// CancelScheduledClose cancels the scheduled close of a channel before the
+// close was initiated.
+func (r *rpcServer) CancelScheduledClose(_ context.Context,
+	in *lnrpc.CancelScheduledCloseRequest) (
+	*lnrpc.CancelScheduledCloseResponse, error) {
+
+	if in.ChannelPoint == nil {
+		return nil, errors.New("channel_point must be specified")
+	}
+
+	txid, err := lnrpc.GetChanPointFundingTxid(in.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+	rpcsLog.Debugf("[cancelscheduledclose] chan_point=%v", chanPoint)
+
+	if err := r.server.closeScheduler.Cancel(*chanPoint); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.CancelScheduledCloseResponse{}, nil
+}
+
+// ListPersistentPeers lists the peers we maintain a persistent connection
+// with, together with their connection policy and reconnection state.
+func (r *rpcServer) ListPersistentPeers(_ context.Context,
+	_ *lnrpc.ListPersistentPeersRequest) (
+	*lnrpc.ListPersistentPeersResponse, error) {
+
+	resp := &lnrpc.ListPersistentPeersResponse{}
+	for _, state := range r.server.peerMgr.PeerStates() {
+		// Skip peers that are no longer persistent, which may happen if
+		// a peer is pruned while we iterate.
+		info, ok := r.server.fetchPersistentPeerInfo(state.Peer)
+		if !ok {
+			continue
+		}
+
+		var nextAttempt int64
+		if !state.NextAttempt.IsZero() {
+			nextAttempt = state.NextAttempt.Unix()
+		}
+
+		addrs := make([]string, 0, len(info.addrs))
+		for _, addr := range info.addrs {
+			addrs = append(addrs, addr.Address.String())
+		}
+
+		priority := lnrpc.PeerConnPolicy_Priority(
+			state.Policy.Priority,
+		)
+		addrPref := lnrpc.PeerConnPolicy_AddrPreference(
+			state.Policy.AddrPreference,
+		)
+
+		resp.Peers = append(resp.Peers, &lnrpc.PersistentPeer{
+			PubKey:    state.Peer.String(),
+			Connected: info.connected,
+			Perm:      info.perm,
+			Policy: &lnrpc.PeerConnPolicy{
+				Priority:       priority,
+				MaxAttempts:    state.Policy.MaxAttempts,
+				AddrPreference: addrPref,
+			},
+			BackoffMs:       uint64(state.Backoff.Milliseconds()),
+			NextAttemptTime: nextAttempt,
+			FailedAttempts:  state.FailedAttempts,
+			Exhausted:       state.Exhausted,
+			Addresses:       addrs,
+		})
+	}
+
+	return resp, nil
+}
+
+// SetPeerConnPolicy sets or removes the connection policy of a peer.
+func (r *rpcServer) SetPeerConnPolicy(_ context.Context,
+	in *lnrpc.SetPeerConnPolicyRequest) (*lnrpc.SetPeerConnPolicyResponse,
+	error) {
+
+	peer, err := route.NewVertexFromStr(in.PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse pubkey: %w", err)
+	}
+
+	rpcsLog.Debugf("[setpeerconnpolicy] peer=%v, remove=%v", peer,
+		in.Remove)
+
+	if in.Remove {
+		if err := r.server.peerMgr.DeletePolicy(peer); err != nil {
+			return nil, err
+		}
+
+		return &lnrpc.SetPeerConnPolicyResponse{}, nil
+	}
+
+	if in.Policy == nil {
+		return nil, errors.New("policy must be specified")
+	}
+
+	err = r.server.peerMgr.SetPolicy(peer, peermgr.Policy{
+		Priority:    peermgr.Priority(in.Policy.Priority),
+		MaxAttempts: in.Policy.MaxAttempts,
+		AddrPreference: peermgr.AddrPreference(
+			in.Policy.AddrPreference,
+		),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SetPeerConnPolicyResponse{}, nil
+}
+
+// ReconnectPeer forces an immediate reconnection to a persistent peer.
+func (r *rpcServer) ReconnectPeer(_ context.Context,
+	in *lnrpc.ReconnectPeerRequest) (*lnrpc.ReconnectPeerResponse, error) {
+
+	rpcsLog.Debugf("[reconnectpeer] peer=%v", in.PubKey)
+
+	if !r.server.Started() {
+		return nil, ErrServerNotActive
+	}
+
+	pubKeyBytes, err := hex.DecodeString(in.PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode pubkey bytes: %w", err)
+	}
+	pubKey, err := btcec.ParsePubKey(pubKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse pubkey: %w", err)
+	}
+
+	if err := r.server.ReconnectPeer(pubKey); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.ReconnectPeerResponse{}, nil
+}
+
+// fetchScheduledCloses returns the closes scheduled with
+// ScheduleChannelClose.
+func (r *rpcServer) fetchScheduledCloses() (
+	[]*lnrpc.PendingChannelsResponse_ScheduledClose, error) {
+
+	closes, err := r.server.closeScheduler.ScheduledCloses()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(
+		[]*lnrpc.PendingChannelsResponse_ScheduledClose, 0, len(closes),
+	)
+	for _, c := range closes {
+		rpcClose := &lnrpc.PendingChannelsResponse_ScheduledClose{
+			ChannelPoint:   c.ChanPoint.String(),
+			DeadlineHeight: c.DeadlineHeight,
+			TargetSatPerVbyte: uint64(
+				c.TargetFeeRate.FeePerVByte(),
+			),
+			TargetConf:  c.ConfTarget,
+			BudgetSat:   uint64(c.Budget),
+			FeePerVbyte: uint64(c.FeeRate.FeePerVByte()),
+		}
+
+		if c.State == closescheduler.StateClosing {
+			//nolint:ll
+			rpcClose.State = lnrpc.PendingChannelsResponse_ScheduledClose_CLOSING
+		}
+
+		if c.ClosingTxid != (chainhash.Hash{}) {
+			rpcClose.ClosingTxid = c.ClosingTxid.String()
+		}
+
+		result = append(result, rpcClose)
+	}
+
+	return result, nil
+}
+
+// marshalLeaseRates converts lease rates into their RPC representation.
+func marshalLeaseRates(rates *lnwire.LeaseRates) *lnrpc.LeaseRates {
+	return &lnrpc.LeaseRates{
+		FundingWeight:         uint32(rates.FundingWeight),
+		LeaseFeeBasis:         uint32(rates.LeaseFeeBasis),
+		LeaseFeeBaseSat:       rates.LeaseFeeBaseSat,
+		ChannelFeeMaxBaseMsat: rates.ChannelFeeMaxBaseMsat,
+		ChannelFeeMaxProportional: uint32(
+			rates.ChannelFeeMaxProportional,
+		),
+	}
+}
+
 // ListChannels returns a description of all the open channels that this node
 // is a participant in.
 func (r *rpcServer) ListChannels(ctx context.Context,
@@ -4861,12 +5446,21 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 		}
 
 		channelID := lnwire.NewChanIDFromOutPoint(chanPoint)
-		var linkActive bool
+		var linkActive, linkPaused, linkQuiescent bool
 		if link, err := r.server.htlcSwitch.GetLink(channelID); err == nil {
 			// A channel is only considered active if it is known
 			// by the switch *and* able to forward
 			// incoming/outgoing payments.
 			linkActive = link.EligibleToForward()
+			linkPaused = link.IsPaused()
+			linkQuiescent = link.IsQuiescent()
+		} else {
+			// Without an active link, we report whether the
+			// channel will be paused once the link is started.
+			linkPaused, err = dbChannel.IsPaused()
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		// Next, we'll determine whether we should add this channel to
@@ -4892,6 +5486,9 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 			continue
 		}
 
+		channel.Paused = linkPaused
+		channel.Quiescent = linkQuiescent
+
 		resp.Channels = append(resp.Channels, channel)
 	}
 
@@ -4921,6 +5518,9 @@ func rpcCommitmentType(chanType channeldb.ChannelType) lnrpc.CommitmentType {
 	case chanType.IsTaprootFinal():
 		return lnrpc.CommitmentType_SIMPLE_TAPROOT_FINAL
 
+	case chanType.HasZeroFeeCommitments():
+		return lnrpc.CommitmentType_ZERO_FEE_COMMITMENTS
+
 	case chanType.IsTaproot():
 		return lnrpc.CommitmentType_SIMPLE_TAPROOT
 
@@ -5745,6 +6345,9 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 			blindCfg.IncomingChannelList...,
 		)
 
+		blindingRestrictions.DiverseIntroNodes =
+			blindCfg.DiverseIntroNodes
+
 		numChainedChannels :=
 			uint8(len(blindingRestrictions.IncomingChainedChannels))
 
@@ -5829,6 +6432,7 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 				blindingRestrictions,
 			)
 		},
+		BlindedPathRefresher: r.server.blindedPathRefresher,
 	}
 
 	value, err := lnrpc.UnmarshallAmt(invoice.Value, invoice.ValueMsat)
@@ -5865,6 +6469,9 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 				MaxHTLCMsat: 0,
 			},
 			MinNumPathHops: blindingRestrictions.NumHops,
+			RefreshOnChannelClose: blindCfg.
+				GetRefreshOnChannelClose(),
+			RouteRestrictions: blindingRestrictions,
 		}
 	}
 
@@ -7339,18 +7946,24 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 
 	fwdEventLog := r.server.miscDB.ForwardingLog()
 
-	// computeFeeSum is a helper function that computes the total fees for
-	// a particular time slice described by a forwarding event query.
-	computeFeeSum := func(query channeldb.ForwardingEventQuery) (lnwire.MilliSatoshi, error) {
+	// computeFeeSum is a helper function that computes the total success
+	// fees and the total net upfront fees for a particular time slice
+	// described by a forwarding event query.
+	computeFeeSum := func(query channeldb.ForwardingEventQuery) (
+		lnwire.MilliSatoshi, lnwire.MilliSatoshi, error) {
 
-		var totalFees lnwire.MilliSatoshi
+		var totalFees, totalUpfrontFees lnwire.MilliSatoshi
+
+		// Failed forwards still earn us their upfront fees, so we need
+		// to include them.
+		query.IncludeFailed = true
 
 		// We'll continue to fetch the next query and accumulate the
 		// fees until the next query returns no events.
 		for {
 			timeSlice, err := fwdEventLog.Query(query)
 			if err != nil {
-				return 0, err
+				return 0, 0, err
 			}
 
 			// If the timeslice is empty, then we'll return as
@@ -7362,6 +7975,16 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 			// Otherwise, we'll tally up an accumulate the total
 			// fees for this time slice.
 			for _, event := range timeSlice.ForwardingEvents {
+				if upfront := event.UpfrontRevenue(); upfront > 0 {
+					totalUpfrontFees += lnwire.MilliSatoshi(
+						upfront,
+					)
+				}
+
+				if event.Failed {
+					continue
+				}
+
 				fee := event.AmtIn - event.AmtOut
 				totalFees += fee
 			}
@@ -7374,7 +7997,7 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 			query.IndexOffset = timeSlice.LastIndexOffset
 		}
 
-		return totalFees, nil
+		return totalFees, totalUpfrontFees, nil
 	}
 
 	now := time.Now()
@@ -7395,7 +8018,7 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 		EndTime:      now,
 		NumMaxEvents: 1000,
 	}
-	dayFees, err := computeFeeSum(dayQuery)
+	dayFees, dayUpfrontFees, err := computeFeeSum(dayQuery)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve day fees: %w", err)
 	}
@@ -7405,7 +8028,7 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 		EndTime:      now,
 		NumMaxEvents: 1000,
 	}
-	weekFees, err := computeFeeSum(weekQuery)
+	weekFees, weekUpfrontFees, err := computeFeeSum(weekQuery)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve day fees: %w", err)
 	}
@@ -7415,16 +8038,19 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 		EndTime:      now,
 		NumMaxEvents: 1000,
 	}
-	monthFees, err := computeFeeSum(monthQuery)
+	monthFees, monthUpfrontFees, err := computeFeeSum(monthQuery)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve day fees: %w", err)
 	}
 
 	return &lnrpc.FeeReportResponse{
-		ChannelFees: feeReports,
-		DayFeeSum:   uint64(dayFees.ToSatoshis()),
-		WeekFeeSum:  uint64(weekFees.ToSatoshis()),
-		MonthFeeSum: uint64(monthFees.ToSatoshis()),
+		ChannelFees:            feeReports,
+		DayFeeSum:              uint64(dayFees.ToSatoshis()),
+		WeekFeeSum:             uint64(weekFees.ToSatoshis()),
+		MonthFeeSum:            uint64(monthFees.ToSatoshis()),
+		DayUpfrontFeeSumMsat:   uint64(dayUpfrontFees),
+		WeekUpfrontFeeSumMsat:  uint64(weekUpfrontFees),
+		MonthUpfrontFeeSumMsat: uint64(monthUpfrontFees),
 	}, nil
 }
 
@@ -7632,6 +8258,7 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 		NumMaxEvents:    numEvents,
 		IncomingChanIDs: incomingChanIDs,
 		OutgoingChanIDs: outgoingChanIDs,
+		IncludeFailed:   req.IncludeFailed,
 	}
 	timeSlice, err := r.server.miscDB.ForwardingLog().Query(eventQuery)
 	if err != nil {
@@ -7698,17 +8325,30 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 		amtOutMsat := event.AmtOut
 		feeMsat := event.AmtIn - event.AmtOut
 
+		// A failed forward didn't earn a success fee, its only revenue
+		// is the upfront fee.
+		if event.Failed {
+			feeMsat = 0
+		}
+
+		var upfrontFeeMsat uint64
+		if upfront := event.UpfrontRevenue(); upfront > 0 {
+			upfrontFeeMsat = uint64(upfront)
+		}
+
 		resp.ForwardingEvents[i] = &lnrpc.ForwardingEvent{
-			Timestamp:   uint64(event.Timestamp.Unix()),
-			TimestampNs: uint64(event.Timestamp.UnixNano()),
-			ChanIdIn:    event.IncomingChanID.ToUint64(),
-			ChanIdOut:   event.OutgoingChanID.ToUint64(),
-			AmtIn:       uint64(amtInMsat.ToSatoshis()),
-			AmtOut:      uint64(amtOutMsat.ToSatoshis()),
-			Fee:         uint64(feeMsat.ToSatoshis()),
-			FeeMsat:     uint64(feeMsat),
-			AmtInMsat:   uint64(amtInMsat),
-			AmtOutMsat:  uint64(amtOutMsat),
+			Timestamp:      uint64(event.Timestamp.Unix()),
+			TimestampNs:    uint64(event.Timestamp.UnixNano()),
+			ChanIdIn:       event.IncomingChanID.ToUint64(),
+			ChanIdOut:      event.OutgoingChanID.ToUint64(),
+			AmtIn:          uint64(amtInMsat.ToSatoshis()),
+			AmtOut:         uint64(amtOutMsat.ToSatoshis()),
+			Fee:            uint64(feeMsat.ToSatoshis()),
+			FeeMsat:        uint64(feeMsat),
+			AmtInMsat:      uint64(amtInMsat),
+			AmtOutMsat:     uint64(amtOutMsat),
+			UpfrontFeeMsat: upfrontFeeMsat,
+			Failed:         event.Failed,
 		}
 
 		// If the incoming htlc id is present, add it to the response.
@@ -8,7 +8,6 @@ import (
 	"errors"
 	"fmt"
 	"image/color"
-	"math/big"
 	prand "math/rand"
 	"net"
 	"strconv"
@@ -40,6 +39,7 @@ import (
 	"github.com/lightningnetwork/lnd/channelnotifier"
 	"github.com/lightningnetwork/lnd/chanstate"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/closescheduler"
 	"github.com/lightningnetwork/lnd/cluster"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
@@ -54,12 +54,16 @@ import (
 	"github.com/lightningnetwork/lnd/htlcswitch/hop"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/invoices/subscriptions"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/liquidityads"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnencrypt"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnutils"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
@@ -71,13 +75,19 @@ import (
 	"github.com/lightningnetwork/lnd/netann"
 	"github.com/lightningnetwork/lnd/onionmessage"
 	paymentsdb "github.com/lightningnetwork/lnd/payments/db"
+	"github.com/lightningnetwork/lnd/payments/receipt"
 	"github.com/lightningnetwork/lnd/peer"
+	"github.com/lightningnetwork/lnd/peermgr"
 	"github.com/lightningnetwork/lnd/peernotifier"
+	"github.com/lightningnetwork/lnd/peerstorage"
 	"github.com/lightningnetwork/lnd/pool"
 	"github.com/lightningnetwork/lnd/queue"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/routing/localchans"
+	"github.com/lightningnetwork/lnd/routing/probe"
+	"github.com/lightningnetwork/lnd/routing/rebalance"
 	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/routing/trampoline"
 	"github.com/lightningnetwork/lnd/subscribe"
 	"github.com/lightningnetwork/lnd/sweep"
 	"github.com/lightningnetwork/lnd/ticker"
@@ -153,6 +163,15 @@ var (
 	// status.
 	ErrNoMoreRestrictedAccessSlots = errors.New("no more restricted slots")
 
+	// ErrNoMoreInboundSlots is returned when an inbound connection from a
+	// peer without channels is rejected because only the inbound slots
+	// reserved for channel peers are left.
+	ErrNoMoreInboundSlots = errors.New("no more unreserved inbound slots")
+
+	// ErrNotPersistentPeer is returned when a reconnection is requested to
+	// a peer we don't maintain a persistent connection with.
+	ErrNotPersistentPeer = errors.New("not a persistent peer")
+
 	// ErrNoPeerScore is returned when we expect to find a score in
 	// peerScores, but one does not exist.
 	ErrNoPeerScore = errors.New("peer score not found")
@@ -217,6 +236,10 @@ func (p peerAccessStatus) String() string {
 type peerSlotStatus struct {
 	// state determines which privileges the peer has with our server.
 	state peerAccessStatus
+
+	// inbound is true if the peer connected to us, in which case it takes
+	// one of our inbound slots.
+	inbound bool
 }
 
 // server is the main server of the Lightning Network Daemon. The server houses
@@ -289,7 +312,6 @@ type server struct {
 	// and the value below is false. Once it's pruned, all its connections
 	// will be closed, thus the Brontide.Start will return an error.
 	persistentPeers        map[string]bool
-	persistentPeersBackoff map[string]time.Duration
 	persistentPeerAddrs    map[string][]*lnwire.NetAddress
 	persistentConnReqs     map[string][]*connmgr.ConnReq
 	persistentRetryCancels map[string]chan struct{}
@@ -345,6 +367,13 @@ type server struct {
 
 	htlcSwitch *htlcswitch.Switch
 
+	// resourceManager decides whether forwarded HTLCs may use the
+	// resources of their outgoing channel.
+	resourceManager *htlcswitch.ResourceManager
+
+	// peerPolicies limits the HTLCs each peer may have us forward.
+	peerPolicies *htlcswitch.PeerPolicyManager
+
 	interceptableSwitch *htlcswitch.InterceptableSwitch
 
 	invoices *invoices.InvoiceRegistry
@@ -374,10 +403,59 @@ type server struct {
 
 	localChanMgr *localchans.Manager
 
+	feePolicyEngine *localchans.FeePolicyEngine
+
+	rebalancer *rebalance.Rebalancer
+
+	// prober continuously probes the liquidity towards the configured
+	// target nodes. It is nil if the prober is disabled.
+	prober *probe.Prober
+
+	// peerStorage stores backup blobs on behalf of our channel peers and
+	// uploads our channel backups to them. It is nil if peer storage is
+	// disabled.
+	peerStorage *peerstorage.Manager
+
+	// closeScheduler executes the cooperative closes scheduled with the
+	// ScheduleChannelClose RPC.
+	closeScheduler *closescheduler.Scheduler
+
+	// peerMgr holds the connection policies of our peers and tracks the
+	// backoff and connection attempts of our persistent peers.
+	peerMgr *peermgr.Manager
+
+	// leaseSeller leases out inbound liquidity to peers that request it
+	// when opening a dual-funded channel. It is nil if we don't lease out
+	// liquidity.
+	leaseSeller *liquidityads.Seller
+
+	// receiptIssuer returns signed receipts to the senders of spontaneous
+	// payments. It is nil if onion messages are disabled.
+	receiptIssuer *receipt.Issuer
+
+	// receiptCollector stores the receipts returned for our spontaneous
+	// payments. It is nil if onion messages are disabled.
+	receiptCollector *receipt.Collector
+
+	// blindedPathRefresher reissues invoices whose blinded paths use a
+	// channel that was closed.
+	blindedPathRefresher *invoicesrpc.BlindedPathRefresher
+
+	// subscriptionMgr creates the invoices of recurring subscriptions.
+	subscriptionMgr *subscriptions.Manager
+
+	// trampolineForwarder forwards trampoline payments. It is nil if
+	// trampoline routing is disabled.
+	trampolineForwarder *trampoline.Forwarder
+
 	utxoNursery *contractcourt.UtxoNursery
 
 	sweeper *sweep.UtxoSweeper
 
+	// anchorReclaimer sweeps the anchors of closed channels in batches
+	// once fees are low. It is nil if the reclaimer isn't active.
+	anchorReclaimer *sweep.AnchorReclaimer
+
 	chainArb *contractcourt.ChainArbitrator
 
 	sphinxPayment *hop.OnionProcessor
@@ -756,6 +834,11 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		NoExperimentalAccountability: cfg.ProtocolOptions.NoExpAccountability(),
 		NoQuiescence:                 cfg.ProtocolOptions.NoQuiescence(),
 		NoRbfCoopClose:               !cfg.ProtocolOptions.RbfCoopClose,
+		NoTrampolineRouting:          !cfg.ProtocolOptions.TrampolineRouting,
+		NoDualFund:                   !cfg.ProtocolOptions.DualFund,
+		NoUpfrontFees:                !cfg.ProtocolOptions.UpfrontFees,
+		NoPeerStorage:                !cfg.ProtocolOptions.PeerStorage,
+		NoZeroFeeCommitments:         !cfg.ProtocolOptions.ZeroFeeCommitments,
 	})
 	if err != nil {
 		return nil, err
@@ -817,7 +900,6 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		torController: torController,
 
 		persistentPeers:         make(map[string]bool),
-		persistentPeersBackoff:  make(map[string]time.Duration),
 		persistentConnReqs:      make(map[string][]*connmgr.ConnReq),
 		persistentPeerAddrs:     make(map[string][]*lnwire.NetAddress),
 		persistentRetryCancels:  make(map[string]chan struct{}),
@@ -880,6 +962,40 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		return nil, err
 	}
 
+	resourceStore, err := htlcswitch.NewResourceStore(dbs.ChanStateDB)
+	if err != nil {
+		return nil, err
+	}
+	s.resourceManager, err = htlcswitch.NewResourceManager(
+		&htlcswitch.ResourceManagerConfig{
+			Bucketing: cfg.Htlcswitch.ResourceBucketing,
+			ProtectedSlotPercent: cfg.Htlcswitch.
+				ProtectedSlotPercent,
+			ProtectedLiquidityPercent: cfg.Htlcswitch.
+				ProtectedLiquidityPercent,
+			RevenueWindow:    cfg.Htlcswitch.RevenueWindow,
+			ReputationWindow: cfg.Htlcswitch.ReputationWindow,
+			ResolutionPeriod: cfg.Htlcswitch.ResolutionPeriod,
+			Clock:            clock.NewDefaultClock(),
+			Store:            resourceStore,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.peerPolicies = htlcswitch.NewPeerPolicyManager(
+		htlcswitch.PeerPolicy{
+			MaxHtlcs: cfg.Htlcswitch.PeerMaxHtlcs,
+			MaxInFlight: lnwire.MilliSatoshi(
+				cfg.Htlcswitch.PeerMaxInFlightMsat,
+			),
+			MinHtlc: lnwire.MilliSatoshi(
+				cfg.Htlcswitch.PeerMinHtlcMsat,
+			),
+		},
+	)
+
 	s.htlcSwitch, err = htlcswitch.New(htlcswitch.Config{
 		DB:                   dbs.ChanStateDB,
 		FetchAllOpenChannels: s.chanStateDB.FetchAllOpenChannels,
@@ -915,6 +1031,8 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		MaxFeeExposure:         thresholdMSats,
 		SignAliasUpdate:        s.signAliasUpdate,
 		IsAlias:                aliasmgr.IsAlias,
+		ResourceManager:        s.resourceManager,
+		PeerPolicies:           s.peerPolicies,
 	}, uint32(currentHeight))
 	if err != nil {
 		return nil, err
@@ -932,11 +1050,18 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		return nil, err
 	}
 
-	s.witnessBeacon = newPreimageBeacon(
+	witnessBeacon := newPreimageBeacon(
 		dbs.ChanStateDB.NewWitnessCache(),
 		s.interceptableSwitch.ForwardPacket,
 		s.interceptableSwitch.RemoveOnChainIntercept,
 	)
+	s.witnessBeacon = witnessBeacon
+
+	// Now that the witness beacon exists, the invoice registry can use
+	// it to settle auto-settle hodl invoices as soon as their preimage
+	// becomes known.
+	registryConfig.LookupPreimage = witnessBeacon.LookupPreimage
+	registryConfig.SubscribePreimages = witnessBeacon.SubscribePreimages
 
 	chanStatusMgrCfg := &netann.ChanStatusConfig{
 		ChanStatusSampleInterval: cfg.ChanStatusSampleInterval,
@@ -1053,6 +1178,28 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 				return nil, err
 			}
 
+		case routing.ExternalEstimatorName:
+			bCfg := routingConfig.BimodalConfig
+			eCfg := routingConfig.ExternalConfig
+			externalConfig := routing.ExternalConfig{
+				CacheTTL:     eCfg.CacheTTL,
+				QueryTimeout: eCfg.QueryTimeout,
+				FallbackConfig: routing.BimodalConfig{
+					BimodalNodeWeight: bCfg.NodeWeight,
+					BimodalScaleMsat: lnwire.MilliSatoshi(
+						bCfg.Scale,
+					),
+					BimodalDecayTime: bCfg.DecayTime,
+				},
+			}
+
+			estimator, err = routing.NewExternalEstimator(
+				externalConfig,
+			)
+			if err != nil {
+				return nil, err
+			}
+
 		default:
 			return nil, fmt.Errorf("unknown estimator type %v",
 				routingConfig.ProbabilityEstimatorType)
@@ -1130,6 +1277,7 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		return nil, fmt.Errorf("can't create graph builder: %w", err)
 	}
 
+	stuckHTLCCfg := cfg.Routing.StuckHTLC
 	s.chanRouter, err = routing.New(routing.Config{
 		SelfNode:                  nodePubKey,
 		RoutingGraph:              s.v1Graph,
@@ -1146,6 +1294,13 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		ClosedSCIDs:               s.fetchClosedChannelSCIDs(),
 		TrafficShaper:             implCfg.TrafficShaper,
 		KeepFailedPaymentAttempts: cfg.KeepFailedPaymentAttempts,
+		StuckHTLC: routing.StuckHTLCConfig{
+			Threshold:         stuckHTLCCfg.Threshold,
+			CheckInterval:     stuckHTLCCfg.CheckInterval,
+			ForceCloseDelta:   stuckHTLCCfg.ForceCloseDelta,
+			NotifyStuckHTLC:   s.htlcNotifier.NotifyStuckHtlcEvent,
+			ForceCloseChannel: s.forceCloseChannel,
+		},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("can't create router: %w", err)
@@ -1212,6 +1367,23 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		PeerMsgRateBytes:        cfg.Gossip.PeerMsgRateBytes,
 	}, nodeKeyDesc)
 
+	peerMgrStore, err := peermgr.NewStore(dbs.ChanStateDB)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create peer manager "+
+			"store: %w", err)
+	}
+	s.peerMgr, err = peermgr.NewManager(&peermgr.Config{
+		Store:              peerMgrStore,
+		MinBackoff:         cfg.MinBackoff,
+		MaxBackoff:         cfg.MaxBackoff,
+		StableConnDuration: defaultStableConnDuration,
+		MaxAttempts:        cfg.PeerManager.MaxAttempts,
+		Clock:              clock.NewDefaultClock(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	accessCfg := &accessManConfig{
 		initAccessPerms: func() (map[string]channeldb.ChanCount,
 			error) {
@@ -1223,6 +1395,15 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		},
 		shouldDisconnect:   s.authGossiper.ShouldDisconnect,
 		maxRestrictedSlots: int64(s.cfg.NumRestrictedSlots),
+		maxInboundPeers:    int64(s.cfg.PeerManager.MaxInboundPeers),
+		reservedChanSlots:  int64(s.cfg.PeerManager.ReservedChanSlots),
+		hasReservedSlot: func(pub *btcec.PublicKey) bool {
+			policy := s.peerMgr.Policy(
+				route.NewVertex(pub),
+			)
+
+			return policy.Priority == peermgr.PriorityHigh
+		},
 	}
 
 	peerAccessMan, err := newAccessMan(accessCfg)
@@ -1264,6 +1445,176 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		},
 	}
 
+	feeOverrideStore, err := localchans.NewFeeOverrideStore(
+		dbs.ChanStateDB,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.feePolicyEngine, err = newFeePolicyEngine(
+		cfg, s.localChanMgr, feeOverrideStore,
+		dbs.ChanStateDB.ForwardingLog(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rebalanceStore, err := rebalance.NewStore(dbs.ChanStateDB)
+	if err != nil {
+		return nil, err
+	}
+	s.rebalancer = rebalance.New(&rebalance.Config{
+		SelfNode: selfVertex,
+		Store:    rebalanceStore,
+		FetchChannelPeer: func(chanID uint64) (route.Vertex, error) {
+			channels, err := s.chanStateDB.FetchAllOpenChannels()
+			if err != nil {
+				return route.Vertex{}, err
+			}
+
+			for _, c := range channels {
+				if c.ShortChannelID.ToUint64() != chanID {
+					continue
+				}
+
+				return route.NewVertex(c.IdentityPub), nil
+			}
+
+			return route.Vertex{}, channeldb.ErrChannelNotFound
+		},
+		AddInvoice: s.invoices.AddInvoice,
+		InvoiceFeatures: func() *lnwire.FeatureVector {
+			return s.featureMgr.Get(feature.SetInvoice)
+		},
+		SendPayment:    s.chanRouter.SendPayment,
+		FetchPayment:   s.controlTower.FetchPayment,
+		FinalCltvDelta: uint16(cfg.Bitcoin.TimeLockDelta),
+		CltvLimit:      cfg.MaxOutgoingCltvExpiry,
+		Clock:          clock.NewDefaultClock(),
+	})
+
+	subscriptionStore, err := subscriptions.NewStore(dbs.ChanStateDB)
+	if err != nil {
+		return nil, err
+	}
+	s.subscriptionMgr = subscriptions.NewManager(&subscriptions.Config{
+		Store:         subscriptionStore,
+		AddInvoice:    s.addSubscriptionInvoice,
+		LookupInvoice: s.invoices.LookupInvoice,
+		CancelInvoice: s.invoices.CancelInvoice,
+		SubscribeSettles: func() (<-chan *invoices.Invoice, func(),
+			error) {
+
+			sub, err := s.invoices.SubscribeNotifications(
+				context.Background(), 0, 0,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return sub.SettledInvoices, sub.Cancel, nil
+		},
+		PollTicker: ticker.New(subscriptions.DefaultPollInterval),
+		Clock:      clock.NewDefaultClock(),
+	})
+
+	if rates := leaseRates(cfg.LiquidityAds); rates != nil {
+		s.leaseSeller = liquidityads.NewSeller(&liquidityads.Config{
+			Rates:       *rates,
+			MinLeaseAmt: cfg.LiquidityAds.MinLeaseAmt,
+			MaxLeaseAmt: cfg.LiquidityAds.MaxLeaseAmt,
+			MaxFundingFeeRate: cfg.LiquidityAds.MaxFeeRate.
+				FeePerKWeight(),
+			Signer: s.nodeSigner,
+			KeyLoc: s.identityKeyLoc,
+		})
+	}
+
+	refreshStore, err := invoicesrpc.NewRefreshStore(dbs.ChanStateDB)
+	if err != nil {
+		return nil, err
+	}
+	s.blindedPathRefresher = invoicesrpc.NewBlindedPathRefresher(
+		&invoicesrpc.BlindedPathRefresherConfig{
+			SubscribeTopology:    s.graphDB.SubscribeTopology,
+			LookupInvoice:        s.invoices.LookupInvoice,
+			UpdatePaymentRequest: s.invoices.UpdatePaymentRequest,
+			Reissue: func(invoice *invoices.Invoice,
+				refresh *invoicesrpc.BlindedPathRefresh) (
+				[]byte, []uint64, error) {
+
+				return s.reissueBlindedInvoice(
+					selfVertex, invoice, refresh,
+				)
+			},
+			Store: refreshStore,
+			Clock: clock.NewDefaultClock(),
+		},
+	)
+
+	if cfg.Routing.Prober.Active {
+		s.prober, err = newProber(
+			cfg, s.chanRouter.SendPayment, s.paymentsDB,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Receipts of spontaneous payments are returned in onion messages, so
+	// they are only issued and collected if onion messages are enabled.
+	if !cfg.ProtocolOptions.NoOnionMessages() {
+		s.receiptIssuer = receipt.NewIssuer(&receipt.IssuerConfig{
+			SubscribeSettledInvoices: func(ctx context.Context) (
+				*invoices.InvoiceSubscription, error) {
+
+				return s.invoices.SubscribeNotifications(
+					ctx, 0, 0,
+				)
+			},
+			Signer: s.nodeSigner,
+			FindPath: func(ctx context.Context,
+				target route.Vertex) ([]route.Vertex, error) {
+
+				return onionmessage.FindPath(
+					ctx, s.v1Graph, selfVertex, target,
+					receipt.MaxRouteHops,
+				)
+			},
+			SendOnionMessage: s.SendOnionMessage,
+		})
+
+		collectorCfg := &receipt.CollectorConfig{
+			SubscribeOnionMessages: s.SubscribeOnionMessages,
+			FetchPayment:           s.paymentsDB.FetchPayment,
+			StoreReceipt:           s.paymentsDB.StoreReceipt,
+			Clock:                  clock.NewDefaultClock(),
+		}
+		s.receiptCollector = receipt.NewCollector(collectorCfg)
+	}
+
+	if cfg.ProtocolOptions.TrampolineRouting {
+		trampolineCfg := cfg.Routing.Trampoline
+		forwarderCfg := &trampoline.Config{
+			Registry:         s.invoices,
+			DecodeOnion:      s.sphinxPayment.DecodeTrampolineOnion,
+			SendPayment:      s.chanRouter.SendPayment,
+			FetchPayment:     s.controlTower.FetchPayment,
+			SubscribePayment: s.controlTower.SubscribePayment,
+			FeeBaseMsat: lnwire.MilliSatoshi(
+				trampolineCfg.FeeBaseMsat,
+			),
+			FeeRate: lnwire.MilliSatoshi(
+				trampolineCfg.FeeRatePpm,
+			),
+			CltvDelta:  trampolineCfg.CltvDelta,
+			MppTimeout: invoices.DefaultHtlcHoldDuration,
+			Clock:      clock.NewDefaultClock(),
+		}
+		s.trampolineForwarder = trampoline.NewForwarder(forwarderCfg)
+	}
+
 	utxnStore, err := contractcourt.NewNurseryStore(
 		s.cfg.ActiveNetParams.GenesisHash, dbs.ChanStateDB,
 	)
@@ -1310,6 +1661,41 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		NoDeadlineConfTarget: cfg.Sweeper.NoDeadlineConfTarget,
 	})
 
+	var anchorReclaimer fn.Option[contractcourt.AnchorReclaimer]
+	if reclaimCfg := cfg.Sweeper.AnchorReclaim; reclaimCfg.Active {
+		anchorStore, err := sweep.NewAnchorStore(dbs.ChanStateDB)
+		if err != nil {
+			return nil, err
+		}
+
+		anchorScanner := contractcourt.NewClosedAnchorScanner(
+			&contractcourt.ClosedAnchorScannerConfig{
+				FetchClosedChannels: s.chanStateDB.
+					FetchClosedChannels,
+				FetchHistoricalChannel: s.chanStateDB.
+					FetchHistoricalChannel,
+				ChainIO: cc.ChainIO,
+			},
+		)
+
+		s.anchorReclaimer = sweep.NewAnchorReclaimer(
+			&sweep.AnchorReclaimerConfig{
+				SweepInput:    s.sweeper.SweepInput,
+				PendingInputs: s.sweeper.PendingInputs,
+				FeeEstimator:  cc.FeeEstimator,
+				ConfTarget:    cfg.Sweeper.NoDeadlineConfTarget,
+				MaxFeeRate:    reclaimCfg.MaxFeeRate.FeePerKWeight(),
+				Ticker:        ticker.New(reclaimCfg.Interval),
+				Store:         anchorStore,
+				ClosedChanAnchors: anchorScanner.
+					ScanClosedChanAnchors,
+			},
+		)
+		anchorReclaimer = fn.Some[contractcourt.AnchorReclaimer](
+			s.anchorReclaimer,
+		)
+	}
+
 	s.utxoNursery = contractcourt.NewUtxoNursery(&contractcourt.NurseryConfig{
 		ChainIO:             cc.ChainIO,
 		ConfDepth:           1,
@@ -1459,6 +1845,7 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 			return s.chanStatusMgr.RequestDisable(chanPoint, false)
 		},
 		Sweeper:                       s.sweeper,
+		AnchorReclaimer:               anchorReclaimer,
 		Registry:                      s.invoices,
 		NotifyClosedChannel:           s.channelNotifier.NotifyClosedChannelEvent,
 		NotifyEarlyClosedChannel:      s.channelNotifier.NotifyEarlyClosedChannelEvent,
@@ -1581,6 +1968,14 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 			err)
 	}
 
+	// We only lease out inbound liquidity if we advertise lease rates.
+	var sellLease func(*lnwire.RequestFunds, *btcec.PublicKey,
+		chainfee.SatPerKWeight, uint32) (*liquidityads.Lease,
+		*lnwire.WillFund, error)
+	if s.leaseSeller != nil {
+		sellLease = s.leaseSeller.Sell
+	}
+
 	//nolint:ll
 	s.fundingMgr, err = funding.NewFundingManager(funding.Config{
 		Dev:                devCfg,
@@ -1675,6 +2070,7 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 			if _, ok := s.persistentPeers[pubStr]; !ok {
 				s.persistentPeers[pubStr] = false
 			}
+			s.peerMgr.Track(route.NewVertex(peerKey))
 			s.mu.Unlock()
 
 			// With that taken care of, we'll send this channel to
@@ -1733,11 +2129,17 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		DeleteAliasEdge:      deleteAliasEdge,
 		AliasManager:         s.aliasMgr,
 		IsSweeperOutpoint:    s.sweeper.IsSweeperOutpoint,
+		SellLease:            sellLease,
 		AuxFundingController: implCfg.AuxFundingController,
 		AuxSigner:            implCfg.AuxSigner,
 		AuxResolver:          implCfg.AuxContractResolver,
 		AuxChannelNegotiator: implCfg.AuxChannelNegotiator,
 		ShutdownScript:       peer.ChooseAddr(script),
+		AbandonFundingCandidate: func(op wire.OutPoint) error {
+			s.channelNotifier.NotifyFundingTimeout(op)
+
+			return s.chainArb.ResolveContract(op)
+		},
 	})
 	if err != nil {
 		return nil, err
@@ -1765,6 +2167,63 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		return nil, err
 	}
 
+	closeSchedulerStore, err := closescheduler.NewStore(dbs.ChanStateDB)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create close scheduler "+
+			"store: %w", err)
+	}
+	s.closeScheduler = closescheduler.NewScheduler(&closescheduler.Config{
+		Store:        closeSchedulerStore,
+		Notifier:     s.cc.ChainNotifier,
+		FeeEstimator: s.cc.FeeEstimator,
+		FetchChannel: s.chanStateDB.FetchChannel,
+		CloseChannel: func(ctx context.Context, chanPoint wire.OutPoint,
+			feeRate, maxFeeRate chainfee.SatPerKWeight,
+			deliveryScript lnwire.DeliveryAddress) (chan interface{},
+			chan error, error) {
+
+			channel, err := s.chanStateDB.FetchChannel(chanPoint)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return s.coopCloseChannel(
+				ctx, channel.IdentityPub, chanPoint, feeRate,
+				maxFeeRate, deliveryScript,
+			)
+		},
+	})
+
+	// If peer storage is enabled, our channel peers additionally hold an
+	// encrypted backup of our channels with them.
+	if cfg.ProtocolOptions.PeerStorage {
+		s.peerStorage = peerstorage.NewManager(&peerstorage.Config{
+			Store:   s.miscDB,
+			KeyRing: s.cc.KeyRing,
+			HasChannels: func(peer *btcec.PublicKey) (bool, error) {
+				chans, err := s.chanStateDB.FetchOpenChannels(
+					peer,
+				)
+
+				return len(chans) > 0, err
+			},
+			FetchBackups:   s.fetchPeerChanBackups,
+			IsKnownChannel: s.isKnownChannel,
+			Restorer: &chanDBRestorer{
+				db:         s.chanStateDB,
+				secretKeys: s.cc.KeyRing,
+				chainArb:   s.chainArb,
+			},
+			PeerConnector: s,
+			SendMessage:   s.sendPeerMessage,
+			SubscribeChannelEvents: func() (subscribe.Subscription,
+				error) {
+
+				return s.channelNotifier.SubscribeChannelEvents()
+			},
+		})
+	}
+
 	// Assemble a peer notifier which will provide clients with subscriptions
 	// to peer online and offline events.
 	s.peerNotifier = peernotifier.New()
@@ -1953,9 +2412,9 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		OnAccept:       s.InboundPeerConnected,
 		RetryDuration:  time.Second * 5,
 		TargetOutbound: 100,
-		Dial: noiseDial(
+		Dial: s.persistentDial(noiseDial(
 			nodeKeyECDH, s.cfg.net, s.cfg.ConnectionTimeout,
-		),
+		)),
 		OnConnection: s.OutboundPeerConnected,
 	})
 	if err != nil {
@@ -1993,6 +2452,19 @@ func (s *server) UpdateRoutingConfig(cfg *routing.MissionControlConfig) {
 		targetCfg.Scale = int64(c.BimodalScaleMsat)
 		targetCfg.NodeWeight = c.BimodalNodeWeight
 		targetCfg.DecayTime = c.BimodalDecayTime
+
+	case routing.ExternalConfig:
+		routerCfg.ProbabilityEstimatorType =
+			routing.ExternalEstimatorName
+
+		targetCfg := routerCfg.ExternalConfig
+		targetCfg.CacheTTL = c.CacheTTL
+		targetCfg.QueryTimeout = c.QueryTimeout
+
+		fallbackCfg := routerCfg.BimodalConfig
+		fallbackCfg.Scale = int64(c.FallbackConfig.BimodalScaleMsat)
+		fallbackCfg.NodeWeight = c.FallbackConfig.BimodalNodeWeight
+		fallbackCfg.DecayTime = c.FallbackConfig.BimodalDecayTime
 	}
 
 	routerCfg.MaxMcHistory = cfg.MaxMcHistory
@@ -2381,6 +2853,14 @@ func (s *server) Start(ctx context.Context) error {
 			return
 		}
 
+		if s.anchorReclaimer != nil {
+			cleanup = cleanup.add(s.anchorReclaimer.Stop)
+			if err := s.anchorReclaimer.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
 		cleanup = cleanup.add(s.utxoNursery.Stop)
 		if err := s.utxoNursery.Start(); err != nil {
 			startErr = err
@@ -2399,6 +2879,15 @@ func (s *server) Start(ctx context.Context) error {
 			return
 		}
 
+		// The resource manager must be started before htlcSwitch, so
+		// that the reputation of peers is restored before HTLCs are
+		// forwarded.
+		cleanup = cleanup.add(s.resourceManager.Stop)
+		if err := s.resourceManager.Start(); err != nil {
+			startErr = err
+			return
+		}
+
 		// htlcSwitch must be started before chainArb since the latter
 		// relies on htlcSwitch to deliver resolution message upon
 		// start.
@@ -2457,6 +2946,18 @@ func (s *server) Start(ctx context.Context) error {
 			return
 		}
 
+		cleanup = cleanup.add(s.blindedPathRefresher.Stop)
+		if err := s.blindedPathRefresher.Start(); err != nil {
+			startErr = err
+			return
+		}
+
+		cleanup = cleanup.add(s.subscriptionMgr.Stop)
+		if err := s.subscriptionMgr.Start(); err != nil {
+			startErr = err
+			return
+		}
+
 		cleanup = cleanup.add(s.sphinxPayment.Stop)
 		if err := s.sphinxPayment.Start(); err != nil {
 			startErr = err
@@ -2522,6 +3023,62 @@ func (s *server) Start(ctx context.Context) error {
 			return
 		}
 
+		cleanup = cleanup.add(s.feePolicyEngine.Stop)
+		if err := s.feePolicyEngine.Start(); err != nil {
+			startErr = err
+			return
+		}
+
+		cleanup = cleanup.add(s.rebalancer.Stop)
+		if err := s.rebalancer.Start(); err != nil {
+			startErr = err
+			return
+		}
+
+		cleanup = cleanup.add(s.closeScheduler.Stop)
+		if err := s.closeScheduler.Start(); err != nil {
+			startErr = err
+			return
+		}
+
+		if s.peerStorage != nil {
+			cleanup = cleanup.add(s.peerStorage.Stop)
+			if err := s.peerStorage.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
+		if s.prober != nil {
+			cleanup = cleanup.add(s.prober.Stop)
+			if err := s.prober.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
+		if s.receiptIssuer != nil {
+			cleanup = cleanup.add(s.receiptIssuer.Stop)
+			if err := s.receiptIssuer.Start(); err != nil {
+				startErr = err
+				return
+			}
+
+			cleanup = cleanup.add(s.receiptCollector.Stop)
+			if err := s.receiptCollector.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
+		if s.trampolineForwarder != nil {
+			cleanup = cleanup.add(s.trampolineForwarder.Stop)
+			if err := s.trampolineForwarder.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
 		cleanup.add(func() error {
 			s.missionController.StopStoreTickers()
 			return nil
@@ -2787,9 +3344,21 @@ func (s *server) Stop() error {
 		if err := s.htlcSwitch.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop htlcSwitch: %v", err)
 		}
+		if err := s.resourceManager.Stop(); err != nil {
+			srvrLog.Warnf("failed to stop resourceManager: %v",
+				err)
+		}
 		if err := s.sphinxPayment.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop sphinx: %v", err)
 		}
+		if err := s.subscriptionMgr.Stop(); err != nil {
+			srvrLog.Warnf("failed to stop subscriptionMgr: %v",
+				err)
+		}
+		if err := s.blindedPathRefresher.Stop(); err != nil {
+			srvrLog.Warnf("failed to stop blindedPathRefresher: %v",
+				err)
+		}
 		if err := s.invoices.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop invoices: %v", err)
 		}
@@ -2826,6 +3395,12 @@ func (s *server) Stop() error {
 		if err := s.authGossiper.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop authGossiper: %v", err)
 		}
+		if s.anchorReclaimer != nil {
+			if err := s.anchorReclaimer.Stop(); err != nil {
+				srvrLog.Warnf("failed to stop anchorReclaimer: "+
+					"%v", err)
+			}
+		}
 		if err := s.sweeper.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop sweeper: %v", err)
 		}
@@ -2872,6 +3447,44 @@ func (s *server) Stop() error {
 			srvrLog.Warnf("Unable to stop ChannelEventStore: %v",
 				err)
 		}
+		if err := s.feePolicyEngine.Stop(); err != nil {
+			srvrLog.Warnf("Unable to stop FeePolicyEngine: %v",
+				err)
+		}
+		if err := s.rebalancer.Stop(); err != nil {
+			srvrLog.Warnf("Unable to stop Rebalancer: %v", err)
+		}
+		if err := s.closeScheduler.Stop(); err != nil {
+			srvrLog.Warnf("Unable to stop close scheduler: %v",
+				err)
+		}
+		if s.peerStorage != nil {
+			if err := s.peerStorage.Stop(); err != nil {
+				srvrLog.Warnf("Unable to stop peer storage "+
+					"manager: %v", err)
+			}
+		}
+		if s.prober != nil {
+			if err := s.prober.Stop(); err != nil {
+				srvrLog.Warnf("Unable to stop Prober: %v", err)
+			}
+		}
+		if s.receiptIssuer != nil {
+			if err := s.receiptIssuer.Stop(); err != nil {
+				srvrLog.Warnf("Unable to stop receipt "+
+					"issuer: %v", err)
+			}
+			if err := s.receiptCollector.Stop(); err != nil {
+				srvrLog.Warnf("Unable to stop receipt "+
+					"collector: %v", err)
+			}
+		}
+		if s.trampolineForwarder != nil {
+			if err := s.trampolineForwarder.Stop(); err != nil {
+				srvrLog.Warnf("Unable to stop trampoline "+
+					"forwarder: %v", err)
+			}
+		}
 		s.missionController.StopStoreTickers()
 
 		// Disconnect from each active peers to ensure that
@@ -3793,19 +4406,28 @@ func (s *server) establishPersistentConnections(ctx context.Context) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	// We'll reconnect to the peers in the order of their priority, so
+	// that high priority peers are connected to first.
+	peers := make([]route.Vertex, 0, len(nodeAddrsMap))
+	for pubStr := range nodeAddrsMap {
+		peers = append(peers, pubStrVertex(pubStr))
+	}
+	s.peerMgr.SortByPriority(peers)
+
 	// Iterate through the combined list of addresses from prior links and
 	// node announcements and attempt to reconnect to each node.
 	var numOutboundConns int
-	for pubStr, nodeAddr := range nodeAddrsMap {
+	for _, vertex := range peers {
+		pubStr := string(vertex[:])
+		nodeAddr := nodeAddrsMap[pubStr]
+
 		// Add this peer to the set of peers we should maintain a
 		// persistent connection with. We set the value to false to
 		// indicate that we should not continue to reconnect if the
 		// number of channels returns to zero, since this peer has not
 		// been requested as perm by the user.
 		s.persistentPeers[pubStr] = false
-		if _, ok := s.persistentPeersBackoff[pubStr]; !ok {
-			s.persistentPeersBackoff[pubStr] = s.cfg.MinBackoff
-		}
+		s.peerMgr.Track(vertex)
 
 		for _, address := range nodeAddr.addresses {
 			// Create a wrapper address which couples the IP and
@@ -3826,9 +4448,12 @@ func (s *server) establishPersistentConnections(ctx context.Context) error {
 		// that mobile nodes or nodes with a small number of
 		// channels obtain connectivity quickly, but larger
 		// nodes are able to disperse the costs of connecting to
-		// all peers at once.
+		// all peers at once. High priority peers are never
+		// staggered.
+		priority := s.peerMgr.Policy(vertex).Priority
 		if numOutboundConns < numInstantInitReconnect ||
-			!s.cfg.StaggerInitialReconnect {
+			!s.cfg.StaggerInitialReconnect ||
+			priority == peermgr.PriorityHigh {
 
 			go s.connectToPersistentPeer(pubStr)
 		} else {
@@ -3863,8 +4488,8 @@ func (s *server) prunePersistentPeerConnection(compressedPubKey [33]byte) {
 	s.mu.Lock()
 	if perm, ok := s.persistentPeers[pubKeyStr]; ok && !perm {
 		delete(s.persistentPeers, pubKeyStr)
-		delete(s.persistentPeersBackoff, pubKeyStr)
 		delete(s.persistentPeerAddrs, pubKeyStr)
+		s.peerMgr.Forget(route.Vertex(compressedPubKey))
 		s.cancelConnReqs(pubKeyStr, nil)
 		s.mu.Unlock()
 
@@ -3887,8 +4512,8 @@ func (s *server) prunePersistentPeerConnection(compressedPubKey [33]byte) {
 func (s *server) bannedPersistentPeerConnection(remotePub string) {
 	if perm, ok := s.persistentPeers[remotePub]; ok && !perm {
 		delete(s.persistentPeers, remotePub)
-		delete(s.persistentPeersBackoff, remotePub)
 		delete(s.persistentPeerAddrs, remotePub)
+		s.peerMgr.Forget(pubStrVertex(remotePub))
 		s.cancelConnReqs(remotePub, nil)
 	}
 }
@@ -4063,51 +4688,6 @@ func (s *server) findPeerByPubStr(pubStr string) (*peer.Brontide, error) {
 	return peer, nil
 }
 
-// nextPeerBackoff computes the next backoff duration for a peer's pubkey using
-// exponential backoff. If no previous backoff was known, the default is
-// returned.
-func (s *server) nextPeerBackoff(pubStr string,
-	startTime time.Time) time.Duration {
-
-	// Now, determine the appropriate backoff to use for the retry.
-	backoff, ok := s.persistentPeersBackoff[pubStr]
-	if !ok {
-		// If an existing backoff was unknown, use the default.
-		return s.cfg.MinBackoff
-	}
-
-	// If the peer failed to start properly, we'll just use the previous
-	// backoff to compute the subsequent randomized exponential backoff
-	// duration. This will roughly double on average.
-	if startTime.IsZero() {
-		return computeNextBackoff(backoff, s.cfg.MaxBackoff)
-	}
-
-	// The peer succeeded in starting. If the connection didn't last long
-	// enough to be considered stable, we'll continue to back off retries
-	// with this peer.
-	connDuration := time.Since(startTime)
-	if connDuration < defaultStableConnDuration {
-		return computeNextBackoff(backoff, s.cfg.MaxBackoff)
-	}
-
-	// The peer succeed in starting and this was stable peer, so we'll
-	// reduce the timeout duration by the length of the connection after
-	// applying randomized exponential backoff. We'll only apply this in the
-	// case that:
-	//   reb(curBackoff) - connDuration > cfg.MinBackoff
-	relaxedBackoff := computeNextBackoff(backoff, s.cfg.MaxBackoff) - connDuration
-	if relaxedBackoff > s.cfg.MinBackoff {
-		return relaxedBackoff
-	}
-
-	// Lastly, if reb(currBackoff) - connDuration <= cfg.MinBackoff, meaning
-	// the stable connection lasted much longer than our previous backoff.
-	// To reward such good behavior, we'll reconnect after the default
-	// timeout.
-	return s.cfg.MinBackoff
-}
-
 // shouldDropLocalConnection determines if our local connection to a remote peer
 // should be dropped in the case of concurrent connection establishment. In
 // order to deterministically decide which connection should be dropped, we'll
@@ -4474,6 +5054,17 @@ func (s *server) notifyFundingTimeoutPeerEvent(op wire.OutPoint,
 	s.channelNotifier.NotifyFundingTimeout(op)
 }
 
+// peerInvoices returns the invoice database that handles the exit hop HTLCs
+// of our channel links. If trampoline routing is enabled, the trampoline
+// forwarder intercepts the HTLCs of trampoline payments.
+func (s *server) peerInvoices() htlcswitch.InvoiceDatabase {
+	if s.trampolineForwarder != nil {
+		return s.trampolineForwarder
+	}
+
+	return s.invoices
+}
+
 // peerConnected is a function that handles initialization a newly connected
 // peer by adding it to the server's global list of all active peers, and
 // starting all the goroutines the peer needs to function properly. The inbound
@@ -4594,7 +5185,7 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 		},
 		ActorSystem:     s.actorSystem,
 		WitnessBeacon:   s.witnessBeacon,
-		Invoices:        s.invoices,
+		Invoices:        s.peerInvoices(),
 		ChannelNotifier: s.channelNotifier,
 		HtlcNotifier:    s.htlcNotifier,
 		TowerClient:     towerClient,
@@ -4649,11 +5240,19 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 	copy(pCfg.PubKeyBytes[:], peerAddr.IdentityKey.SerializeCompressed())
 	copy(pCfg.ServerPubKey[:], s.identityECDH.PubKey().SerializeCompressed())
 
+	if s.peerStorage != nil {
+		pCfg.HandlePeerStorageMessage = s.peerStorage.ProcessMessage
+	}
+
 	p := peer.NewBrontide(pCfg)
 
 	// Update the access manager with the access permission for this peer.
 	s.peerAccessMan.addPeerAccess(pubKey, access, inbound)
 
+	// A successful connection resets the failed connection attempts of
+	// persistent peers.
+	s.peerMgr.Connected(route.NewVertex(pubKey))
+
 	// TODO(roasbeef): update IP address for link-node
 	//  * also mark last-seen, do it one single transaction?
 
@@ -4760,6 +5359,13 @@ func (s *server) peerInitializer(p *peer.Brontide) {
 	// was successful, and to begin watching the peer's wait group.
 	close(ready)
 
+	// Return the blob we store on behalf of the peer and upload our
+	// channel backup to it. This must happen before acquiring the server
+	// mutex, as sending messages requires looking up the peer.
+	if s.peerStorage != nil {
+		s.peerStorage.PeerConnected(p.IdentityKey(), p.RemoteFeatures())
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -4951,9 +5557,8 @@ func (s *server) peerTerminationWatcher(p *peer.Brontide, ready chan struct{}) {
 		)
 	}
 
-	// Record the computed backoff in the backoff map.
-	backoff := s.nextPeerBackoff(pubStr, p.StartTime())
-	s.persistentPeersBackoff[pubStr] = backoff
+	// Compute the backoff before we reconnect to the peer.
+	backoff := s.peerMgr.NextBackoff(pubStrVertex(pubStr), p.StartTime())
 
 	// Initialize a retry canceller for this peer if one does not
 	// exist.
@@ -4995,13 +5600,28 @@ func (s *server) connectToPersistentPeer(pubKeyStr string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	// We don't reconnect to peers that reached their maximum number of
+	// connection attempts, unless a reconnection is forced.
+	if s.peerMgr.Exhausted(pubStrVertex(pubKeyStr)) {
+		srvrLog.Debugf("Skipping connection to peer %x, maximum "+
+			"connection attempts reached", pubKeyStr)
+
+		return
+	}
+
 	// Create an easy lookup map of the addresses we have stored for the
 	// peer. We will remove entries from this map if we have existing
 	// connection requests for the associated address and then any leftover
 	// entries will indicate which addresses we should create new
 	// connection requests for.
+	//
+	// Only the addresses the peer's policy prefers are used.
+	policy := s.peerMgr.Policy(pubStrVertex(pubKeyStr))
+	addrs := peermgr.FilterAddrs(
+		s.persistentPeerAddrs[pubKeyStr], policy.AddrPreference,
+	)
 	addrMap := make(map[string]*lnwire.NetAddress)
-	for _, addr := range s.persistentPeerAddrs[pubKeyStr] {
+	for _, addr := range addrs {
 		addrMap[addr.String()] = addr
 	}
 
@@ -5083,6 +5703,140 @@ func (s *server) connectToPersistentPeer(pubKeyStr string) {
 	}()
 }
 
+// persistentDial wraps the given dial function to record failed connection
+// attempts to our persistent peers. Once a peer reaches its maximum number of
+// connection attempts, we stop reconnecting to it.
+func (s *server) persistentDial(
+	dial func(net.Addr) (net.Conn, error)) func(net.Addr) (net.Conn,
+	error) {
+
+	return func(addr net.Addr) (net.Conn, error) {
+		conn, err := dial(addr)
+		if err == nil {
+			return conn, nil
+		}
+
+		lnAddr, ok := addr.(*lnwire.NetAddress)
+		if !ok {
+			return nil, err
+		}
+
+		pubKey := route.NewVertex(lnAddr.IdentityKey)
+		if s.peerMgr.ConnFailed(pubKey) {
+			// The connection manager may call us while the
+			// server's mutex is held, so we abandon the peer in a
+			// goroutine.
+			go s.abandonPersistentPeer(string(pubKey[:]))
+		}
+
+		return nil, err
+	}
+}
+
+// abandonPersistentPeer stops all connection attempts to a persistent peer
+// that reached its maximum number of connection attempts. The peer stays
+// persistent, so that a reconnection can be forced with ReconnectPeer.
+func (s *server) abandonPersistentPeer(pubStr string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The peer may have connected to us in the meantime, in which case we
+	// must not cancel the connection request it connected with.
+	if !s.peerMgr.Exhausted(pubStrVertex(pubStr)) {
+		return
+	}
+	if _, ok := s.peersByPub[pubStr]; ok {
+		return
+	}
+
+	srvrLog.Infof("Abandoning connection attempts to peer %x, maximum "+
+		"connection attempts reached", pubStr)
+
+	s.cancelConnReqs(pubStr, nil)
+}
+
+// ReconnectPeer forces an immediate reconnection to the given persistent
+// peer, resetting its backoff and its failed connection attempts. This also
+// resumes the connection attempts to peers that reached their maximum number
+// of connection attempts.
+func (s *server) ReconnectPeer(pubKey *btcec.PublicKey) error {
+	pubStr := string(pubKey.SerializeCompressed())
+
+	s.mu.Lock()
+	if peer, err := s.findPeerByPubStr(pubStr); err == nil {
+		s.mu.Unlock()
+		return &errPeerAlreadyConnected{peer: peer}
+	}
+
+	if _, ok := s.persistentPeers[pubStr]; !ok {
+		s.mu.Unlock()
+		return ErrNotPersistentPeer
+	}
+
+	// Cancel any scheduled reconnection as well as the pending connection
+	// requests, which may be waiting for their retry timeout, so that
+	// we dial all addresses of the peer right away.
+	s.cancelConnReqs(pubStr, nil)
+	s.mu.Unlock()
+
+	srvrLog.Infof("Forcing reconnection to persistent peer %x", pubStr)
+
+	s.peerMgr.Reset(route.NewVertex(pubKey))
+	s.connectToPersistentPeer(pubStr)
+
+	return nil
+}
+
+// persistentPeerInfo describes the persistent connection to a peer.
+type persistentPeerInfo struct {
+	// perm is true if the persistent connection was requested by the
+	// user.
+	perm bool
+
+	// connected is true if we're currently connected to the peer.
+	connected bool
+
+	// addrs are the addresses we reconnect to the peer with.
+	addrs []*lnwire.NetAddress
+}
+
+// fetchPersistentPeerInfo returns the persistent connection info of the given
+// peer. The boolean is false if we don't maintain a persistent connection with
+// the peer.
+func (s *server) fetchPersistentPeerInfo(
+	peer route.Vertex) (*persistentPeerInfo, bool) {
+
+	pubStr := string(peer[:])
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	perm, ok := s.persistentPeers[pubStr]
+	if !ok {
+		return nil, false
+	}
+
+	_, connected := s.peersByPub[pubStr]
+	policy := s.peerMgr.Policy(peer)
+
+	return &persistentPeerInfo{
+		perm:      perm,
+		connected: connected,
+		addrs: peermgr.FilterAddrs(
+			s.persistentPeerAddrs[pubStr], policy.AddrPreference,
+		),
+	}, true
+}
+
+// pubStrVertex converts the string of a serialized public key, which is used
+// to key the server's peer maps, into a vertex.
+func pubStrVertex(pubStr string) route.Vertex {
+	var vertex route.Vertex
+	copy(vertex[:], pubStr)
+
+	return vertex
+}
+
 // removePeerUnsafe removes the passed peer from the server's state of all
 // active peers.
 //
@@ -5113,6 +5867,10 @@ func (s *server) removePeerUnsafe(ctx context.Context, p *peer.Brontide) {
 		delete(s.outboundPeers, pubStr)
 	}
 
+	if s.peerStorage != nil {
+		s.peerStorage.PeerDisconnected(p.IdentityKey())
+	}
+
 	// When removing the peer we make sure to disconnect it asynchronously
 	// to avoid blocking the main server goroutine because it is holding the
 	// server's mutex. Disconnecting the peer might block and wait until the
@@ -5206,9 +5964,7 @@ func (s *server) ConnectToPeer(addr *lnwire.NetAddress,
 		// reconnecting even if the number of channels with this peer is
 		// zero.
 		s.persistentPeers[targetPub] = true
-		if _, ok := s.persistentPeersBackoff[targetPub]; !ok {
-			s.persistentPeersBackoff[targetPub] = s.cfg.MinBackoff
-		}
+		s.peerMgr.Track(pubStrVertex(targetPub))
 		s.persistentConnReqs[targetPub] = append(
 			s.persistentConnReqs[targetPub], connReq,
 		)
@@ -5288,7 +6044,7 @@ func (s *server) DisconnectPeer(pubKey *btcec.PublicKey) error {
 	// them from this map so we don't attempt to re-connect after we
 	// disconnect.
 	delete(s.persistentPeers, pubStr)
-	delete(s.persistentPeersBackoff, pubStr)
+	s.peerMgr.Forget(pubStrVertex(pubStr))
 
 	// Remove the peer by calling Disconnect. Previously this was done with
 	// removePeerUnsafe, which bypassed the peerTerminationWatcher.
@@ -5374,34 +6130,6 @@ func (s *server) Peers() []*peer.Brontide {
 	return peers
 }
 
-// computeNextBackoff uses a truncated exponential backoff to compute the next
-// backoff using the value of the exiting backoff. The returned duration is
-// randomized in either direction by 1/20 to prevent tight loops from
-// stabilizing.
-func computeNextBackoff(currBackoff, maxBackoff time.Duration) time.Duration {
-	// Double the current backoff, truncating if it exceeds our maximum.
-	nextBackoff := 2 * currBackoff
-	if nextBackoff > maxBackoff {
-		nextBackoff = maxBackoff
-	}
-
-	// Using 1/10 of our duration as a margin, compute a random offset to
-	// avoid the nodes entering connection cycles.
-	margin := nextBackoff / 10
-
-	var wiggle big.Int
-	wiggle.SetUint64(uint64(margin))
-	if _, err := rand.Int(rand.Reader, &wiggle); err != nil {
-		// Randomizing is not mission critical, so we'll just return the
-		// current backoff.
-		return nextBackoff
-	}
-
-	// Otherwise add in our wiggle, but subtract out half of the margin so
-	// that the backoff can tweaked by 1/20 in either direction.
-	return nextBackoff + (time.Duration(wiggle.Uint64()) - margin/2)
-}
-
 // errNoAdvertisedAddr is an error returned when we attempt to retrieve the
 // advertised address of a node, but they don't have one.
 var errNoAdvertisedAddr = errors.New("no advertised address found")
@@ -5639,6 +6367,36 @@ func (s *server) fetchClosedChannelSCIDs() map[lnwire.ShortChannelID]struct{} {
 	return closedSCIDs
 }
 
+// forceCloseChannel force closes the channel with the given short channel ID,
+// which may also be an alias of the channel.
+func (s *server) forceCloseChannel(scid lnwire.ShortChannelID) error {
+	link, err := s.htlcSwitch.GetLinkByShortID(scid)
+	if err != nil {
+		return fmt.Errorf("unable to find channel %v: %w", scid, err)
+	}
+
+	// As a precaution, we make sure the switch no longer considers the
+	// channel eligible for forwarding HTLCs before force closing it.
+	chanPoint := link.ChannelPoint()
+	peerPub := link.PeerPubKey()
+	if p, err := s.FindPeerByPubStr(string(peerPub[:])); err == nil {
+		p.WipeChannel(&chanPoint)
+	} else {
+		s.htlcSwitch.RemoveLink(link.ChanID())
+	}
+
+	closingTx, err := s.chainArb.ForceCloseContract(chanPoint)
+	if err != nil {
+		return fmt.Errorf("unable to force close channel %v: %w",
+			chanPoint, err)
+	}
+
+	srvrLog.Infof("Force closed channel %v with tx %v", chanPoint,
+		closingTx.TxHash())
+
+	return nil
+}
+
 // getStartingBeat returns the current beat. This is used during the startup to
 // initialize blockbeat consumers.
 func (s *server) getStartingBeat() (*chainio.Beat, error) {
@@ -5697,6 +6455,56 @@ func (s *server) ChanHasRbfCoopCloser(peerPub *btcec.PublicKey,
 	return targetPeer.ChanHasRbfCoopCloser(chanPoint)
 }
 
+// coopCloseChannel initiates the cooperative close of a channel through the
+// switch. If the link of the channel is no longer known by the switch, but the
+// channel has an RBF closer, the fee of its closing transaction is bumped
+// instead.
+func (s *server) coopCloseChannel(ctx context.Context,
+	peerPub *btcec.PublicKey, chanPoint wire.OutPoint, feeRate,
+	maxFee chainfee.SatPerKWeight,
+	deliveryScript lnwire.DeliveryAddress) (chan interface{}, chan error,
+	error) {
+
+	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+	if _, err := s.htlcSwitch.GetLink(chanID); err == nil {
+		updateChan, errChan := s.htlcSwitch.CloseLink(
+			ctx, &chanPoint, contractcourt.CloseRegular, feeRate,
+			maxFee, deliveryScript,
+		)
+
+		return updateChan, errChan, nil
+	}
+
+	// The channel isn't in the switch, but if there's an active chan
+	// closer for the channel, and it's of the RBF variant, then we can
+	// actually bypass the switch.
+	if !s.ChanHasRbfCoopCloser(peerPub, chanPoint) {
+		return nil, nil, fmt.Errorf("unable to gracefully close "+
+			"channel while peer is offline (try force closing it "+
+			"instead): %w", htlcswitch.ErrChannelLinkNotFound)
+	}
+
+	srvrLog.Infof("Bypassing Switch to do fee bump for ChannelPoint(%v)",
+		chanPoint)
+
+	// To perform this RBF bump, we'll send a bump message to the RBF close
+	// actor. The context is propagated, so that its cancellation also
+	// tears down the observer goroutine.
+	rbfBumpMsg := peer.NewRbfBumpCloseMsg(
+		ctx, chanPoint, feeRate, deliveryScript,
+	)
+	rbfActorKey := peer.NewRbfCloserPeerServiceKey(chanPoint)
+	rbfRouter := peer.RbfChanCloserRouter(s.actorSystem, rbfActorKey)
+
+	closeUpdates, err := rbfRouter.Ask(ctx, rbfBumpMsg).Await(ctx).Unpack()
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to ask for RBF close: %w",
+			err)
+	}
+
+	return closeUpdates.UpdateChan, closeUpdates.ErrChan, nil
+}
+
 // calculateNodeAnnouncementTimestamp returns the timestamp to use for a node
 // announcement, ensuring it's at least one second after the previously
 // persisted timestamp. This ensures BOLT-07 compliance, which requires node
@@ -5826,6 +6634,16 @@ func (s *server) setSelfNode(ctx context.Context, nodePub route.Vertex,
 		return err
 	}
 
+	// If we lease out inbound liquidity, our lease rates are advertised in
+	// the extra data of our node announcement.
+	var extraData lnwire.ExtraOpaqueData
+	if rates := leaseRates(s.cfg.LiquidityAds); rates != nil {
+		if err := extraData.PackRecords(rates); err != nil {
+			return fmt.Errorf("unable to encode lease rates: %w",
+				err)
+		}
+	}
+
 	// TODO(abdulkbk): potentially find a way to use the source node's
 	// features in the self node.
 	selfNode := models.NewV1Node(
@@ -5835,6 +6653,8 @@ func (s *server) setSelfNode(ctx context.Context, nodePub route.Vertex,
 			LastUpdate: nodeLastUpdate,
 			Addresses:  addrs,
 			Features:   s.featureMgr.GetRaw(feature.SetNodeAnn),
+
+			ExtraOpaqueData: extraData,
 		},
 	)
 
@@ -5874,3 +6694,292 @@ func (s *server) setSelfNode(ctx context.Context, nodePub route.Vertex,
 
 	return nil
 }
+
+// newFeePolicyEngine creates the dynamic fee policy engine from the routing
+// config, applying its fee updates through the given local channel manager.
+func newFeePolicyEngine(cfg *Config, localChanMgr *localchans.Manager,
+	store localchans.FeeOverrideStore,
+	fwdLog *channeldb.ForwardingLog) (*localchans.FeePolicyEngine, error) {
+
+	dynCfg := cfg.Routing.DynamicFees
+	baseFee := lnwire.MilliSatoshi(dynCfg.BaseFeeMsat)
+
+	feeRateCurve, err := localchans.ParseFeeCurve(dynCfg.FeeRateCurve)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dynamic fee rate curve: %w", err)
+	}
+
+	inboundCurve, err := localchans.ParseFeeCurve(
+		dynCfg.InboundFeeRateCurve,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dynamic inbound fee rate "+
+			"curve: %w", err)
+	}
+
+	return localchans.NewFeePolicyEngine(&localchans.FeePolicyConfig{
+		Params: localchans.FeePolicyParams{
+			BaseFee:             baseFee,
+			FeeRateCurve:        feeRateCurve,
+			InboundBaseFee:      dynCfg.InboundBaseFeeMsat,
+			InboundFeeRateCurve: inboundCurve,
+			VolumeWindow:        dynCfg.VolumeWindow,
+			VolumeSensitivity:   dynCfg.VolumeSensitivity,
+			MaxFeeRate:          dynCfg.MaxFeeRatePpm,
+		},
+		Store:                     store,
+		Interval:                  dynCfg.Interval,
+		Active:                    dynCfg.Active,
+		DryRun:                    dynCfg.DryRun,
+		AcceptPositiveInboundFees: cfg.AcceptPositiveInboundFees,
+		ForAllOutgoingChannels:    localChanMgr.ForAllOutgoingChannels,
+		FetchChannel:              localChanMgr.FetchChannel,
+		ForwardingVolume: func(start, end time.Time) (
+			map[lnwire.ShortChannelID]lnwire.MilliSatoshi, error) {
+
+			return forwardingVolume(fwdLog, start, end)
+		},
+		UpdatePolicy: func(ctx context.Context,
+			policy routing.ChannelPolicy,
+			chanPoint wire.OutPoint) ([]*lnrpc.FailedUpdate, error) {
+
+			return localChanMgr.UpdatePolicy(
+				ctx, policy, false, chanPoint,
+			)
+		},
+		Clock: clock.NewDefaultClock(),
+	})
+}
+
+// newProber creates the background prober from the routing config. The probes
+// are sent with the given payment function and deleted from the payments
+// database once they failed.
+func newProber(cfg *Config, sendPayment func(context.Context,
+	*routing.LightningPayment) ([32]byte, *route.Route, error),
+	paymentsDB paymentsdb.DB) (*probe.Prober, error) {
+
+	proberCfg := cfg.Routing.Prober
+
+	targets := make([]route.Vertex, 0, len(proberCfg.Targets))
+	for _, target := range proberCfg.Targets {
+		vertex, err := route.NewVertexFromStr(target)
+		if err != nil {
+			return nil, fmt.Errorf("invalid prober target %v: %w",
+				target, err)
+		}
+
+		targets = append(targets, vertex)
+	}
+
+	return probe.New(&probe.Config{
+		Targets:        targets,
+		MinAmount:      lnwire.MilliSatoshi(proberCfg.MinAmtMsat),
+		MaxAmount:      lnwire.MilliSatoshi(proberCfg.MaxAmtMsat),
+		MaxLocked:      lnwire.MilliSatoshi(proberCfg.MaxLockedMsat),
+		MaxFeePPM:      proberCfg.MaxFeePPM,
+		Timeout:        proberCfg.Timeout,
+		FinalCltvDelta: uint16(cfg.Bitcoin.TimeLockDelta),
+		CltvLimit:      cfg.MaxOutgoingCltvExpiry,
+		SendPayment:    sendPayment,
+		DeletePayment: func(ctx context.Context,
+			hash lntypes.Hash) error {
+
+			return paymentsDB.DeletePayment(ctx, hash, false)
+		},
+		Ticker: ticker.New(proberCfg.Interval),
+	})
+}
+
+// forwardingVolume sums up the outgoing amount of all forwards within the
+// given time range per outgoing channel.
+func forwardingVolume(fwdLog *channeldb.ForwardingLog, start,
+	end time.Time) (map[lnwire.ShortChannelID]lnwire.MilliSatoshi, error) {
+
+	const batchSize = 10_000
+
+	volume := make(map[lnwire.ShortChannelID]lnwire.MilliSatoshi)
+	query := channeldb.ForwardingEventQuery{
+		StartTime:    start,
+		EndTime:      end,
+		NumMaxEvents: batchSize,
+	}
+	for {
+		timeSlice, err := fwdLog.Query(query)
+		switch {
+		// Without any forwards yet, there's no volume either.
+		case errors.Is(err, channeldb.ErrNoForwardingEvents):
+			return volume, nil
+
+		case err != nil:
+			return nil, err
+		}
+
+		for _, event := range timeSlice.ForwardingEvents {
+			volume[event.OutgoingChanID] += event.AmtOut
+		}
+
+		if len(timeSlice.ForwardingEvents) < batchSize {
+			return volume, nil
+		}
+		query.IndexOffset = timeSlice.LastIndexOffset
+	}
+}
+
+// addSubscriptionInvoice creates the invoice of a subscription period and
+// returns its payment hash and payment request.
+func (s *server) addSubscriptionInvoice(ctx context.Context,
+	amt lnwire.MilliSatoshi, memo string,
+	expiry time.Duration) (lntypes.Hash, string, error) {
+
+	hash, invoice, err := invoicesrpc.AddInvoice(
+		ctx, s.addInvoiceConfig(), &invoicesrpc.AddInvoiceData{
+			Memo:   memo,
+			Value:  amt,
+			Expiry: int64(expiry / time.Second),
+		},
+	)
+	if err != nil {
+		return lntypes.Hash{}, "", err
+	}
+
+	return *hash, string(invoice.PaymentRequest), nil
+}
+
+// reissueBlindedInvoice rebuilds the blinded paths of an open invoice
+// registered with the blinded path refresher, using the route restrictions
+// its paths were originally built with.
+func (s *server) reissueBlindedInvoice(self route.Vertex,
+	invoice *invoices.Invoice,
+	refresh *invoicesrpc.BlindedPathRefresh) ([]byte, []uint64, error) {
+
+	restrictions := refresh.BlindCfg.RouteRestrictions
+	if restrictions == nil {
+		return nil, nil, fmt.Errorf("no route restrictions for "+
+			"blinded paths of invoice %v", refresh.Hash)
+	}
+
+	cfg := s.addInvoiceConfig()
+	cfg.QueryBlindedRoutes = func(amt lnwire.MilliSatoshi) ([]*route.Route,
+		error) {
+
+		return s.chanRouter.FindBlindedPaths(
+			self, amt, s.defaultMC.GetProbability, restrictions,
+		)
+	}
+
+	return invoicesrpc.ReissueBlindedInvoice(cfg, invoice, refresh)
+}
+
+// addInvoiceConfig returns the configuration for invoices that are added by
+// the server itself.
+func (s *server) addInvoiceConfig() *invoicesrpc.AddInvoiceConfig {
+	return &invoicesrpc.AddInvoiceConfig{
+		AddInvoice:        s.invoices.AddInvoice,
+		IsChannelActive:   s.htlcSwitch.HasActiveLink,
+		ChainParams:       s.cfg.ActiveNetParams.Params,
+		NodeSigner:        s.nodeSigner,
+		DefaultCLTVExpiry: s.cfg.Bitcoin.TimeLockDelta,
+		ChanDB:            s.chanStateDB,
+		Graph:             s.v1Graph,
+		GenInvoiceFeatures: func() *lnwire.FeatureVector {
+			return s.featureMgr.Get(feature.SetInvoice)
+		},
+		GenAmpInvoiceFeatures: func() *lnwire.FeatureVector {
+			return s.featureMgr.Get(feature.SetInvoiceAmp)
+		},
+		GetAlias:   s.aliasMgr.GetPeerAlias,
+		BestHeight: s.cc.BestBlockTracker.BestHeight,
+	}
+}
+
+// leaseRates returns the lease rates we advertise, or nil if we don't lease
+// out inbound liquidity.
+func leaseRates(cfg *lncfg.LiquidityAds) *lnwire.LeaseRates {
+	if !cfg.Active {
+		return nil
+	}
+
+	return &lnwire.LeaseRates{
+		FundingWeight:             cfg.FundingWeight,
+		LeaseFeeBasis:             cfg.LeaseFeeBasis,
+		ChannelFeeMaxProportional: cfg.ChannelFeeMaxProportional,
+		LeaseFeeBaseSat:           uint32(cfg.LeaseFeeBase),
+		ChannelFeeMaxBaseMsat:     cfg.ChannelFeeMaxBaseMsat,
+	}
+}
+
+// fetchLeaseRates returns the lease rates advertised in the node announcement
+// of the given node, or nil if the node doesn't advertise any.
+func (s *server) fetchLeaseRates(ctx context.Context,
+	node route.Vertex) (*lnwire.LeaseRates, error) {
+
+	dbNode, err := s.v1Graph.FetchNode(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+
+	return lnwire.ExtractLeaseRates(dbNode.ExtraOpaqueData)
+}
+
+// sendPeerMessage sends a message to the given peer.
+func (s *server) sendPeerMessage(peer *btcec.PublicKey,
+	msg lnwire.Message) error {
+
+	target, err := s.FindPeer(peer)
+	if err != nil {
+		return err
+	}
+
+	return target.SendMessageLazy(false, msg)
+}
+
+// fetchPeerChanBackups returns the static channel backups of our channels with
+// the given peer.
+func (s *server) fetchPeerChanBackups(
+	peer *btcec.PublicKey) ([]chanbackup.Single, error) {
+
+	chans, err := s.chanStateDB.FetchOpenChannels(peer)
+	if err != nil {
+		return nil, err
+	}
+
+	backups := make([]chanbackup.Single, 0, len(chans))
+	for _, channel := range chans {
+		backup, err := chanbackup.FetchBackupForChan(
+			context.Background(), channel.FundingOutpoint,
+			s.chanStateDB, s.addrSource,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		backups = append(backups, *backup)
+	}
+
+	return backups, nil
+}
+
+// isKnownChannel returns whether the channel with the given funding outpoint
+// is known to us, either as an open or as a closed channel.
+func (s *server) isKnownChannel(chanPoint wire.OutPoint) (bool, error) {
+	_, err := s.chanStateDB.FetchChannel(chanPoint)
+	switch {
+	case err == nil:
+		return true, nil
+
+	case !errors.Is(err, channeldb.ErrChannelNotFound):
+		return false, err
+	}
+
+	_, err = s.chanStateDB.FetchClosedChannel(&chanPoint)
+	switch {
+	case err == nil:
+		return true, nil
+
+	case errors.Is(err, channeldb.ErrClosedChannelNotFound):
+		return false, nil
+
+	default:
+		return false, err
+	}
+}
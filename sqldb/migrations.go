@@ -136,6 +136,31 @@ var (
 			Version:       18,
 			SchemaVersion: 15,
 		},
+		{
+			Name:          "000016_invoice_hold_policies",
+			Version:       19,
+			SchemaVersion: 16,
+		},
+		{
+			Name:          "kv_invoice_hold_policy_migration",
+			Version:       20,
+			SchemaVersion: 16,
+			// A migration function may be attached to this
+			// migration to migrate the hold policies of KV
+			// invoices, as the invoice migration runs before the
+			// hold policy table is created. It must be attached
+			// whenever the invoice migration is.
+		},
+		{
+			Name:          "000017_payment_receipts",
+			Version:       21,
+			SchemaVersion: 17,
+		},
+		{
+			Name:          "000018_payment_hop_upfront_fees",
+			Version:       22,
+			SchemaVersion: 18,
+		},
 	}, migrationAdditions...)
 
 	// ErrMigrationMismatch is returned when a migrated record does not
@@ -622,6 +622,24 @@ func (q *Queries) GetInvoiceHTLCs(ctx context.Context, invoiceID int64) ([]Invoi
 	return items, nil
 }
 
+const getInvoiceHoldPolicy = `-- name: GetInvoiceHoldPolicy :one
+SELECT invoice_id, max_hold_duration, min_cltv_slack, auto_settle
+FROM invoice_hold_policies
+WHERE invoice_id = $1
+`
+
+func (q *Queries) GetInvoiceHoldPolicy(ctx context.Context, invoiceID int64) (InvoiceHoldPolicy, error) {
+	row := q.db.QueryRowContext(ctx, getInvoiceHoldPolicy, invoiceID)
+	var i InvoiceHoldPolicy
+	err := row.Scan(
+		&i.InvoiceID,
+		&i.MaxHoldDuration,
+		&i.MinCltvSlack,
+		&i.AutoSettle,
+	)
+	return i, err
+}
+
 const getKVInvoicePaymentHashByAddIndex = `-- name: GetKVInvoicePaymentHashByAddIndex :one
 SELECT hash
 FROM invoice_payment_hashes
@@ -763,6 +781,31 @@ func (q *Queries) InsertInvoiceHTLCCustomRecord(ctx context.Context, arg InsertI
 	return err
 }
 
+const insertInvoiceHoldPolicy = `-- name: InsertInvoiceHoldPolicy :exec
+INSERT INTO invoice_hold_policies (
+    invoice_id, max_hold_duration, min_cltv_slack, auto_settle
+) VALUES (
+    $1, $2, $3, $4
+)
+`
+
+type InsertInvoiceHoldPolicyParams struct {
+	InvoiceID       int64
+	MaxHoldDuration int64
+	MinCltvSlack    int32
+	AutoSettle      bool
+}
+
+func (q *Queries) InsertInvoiceHoldPolicy(ctx context.Context, arg InsertInvoiceHoldPolicyParams) error {
+	_, err := q.db.ExecContext(ctx, insertInvoiceHoldPolicy,
+		arg.InvoiceID,
+		arg.MaxHoldDuration,
+		arg.MinCltvSlack,
+		arg.AutoSettle,
+	)
+	return err
+}
+
 const insertKVInvoiceKeyAndAddIndex = `-- name: InsertKVInvoiceKeyAndAddIndex :exec
 INSERT INTO invoice_payment_hashes (
     id, add_index
@@ -880,6 +923,22 @@ func (q *Queries) UpdateInvoiceAmountPaid(ctx context.Context, arg UpdateInvoice
 	return q.db.ExecContext(ctx, updateInvoiceAmountPaid, arg.ID, arg.AmountPaidMsat)
 }
 
+const updateInvoicePaymentRequest = `-- name: UpdateInvoicePaymentRequest :execresult
+UPDATE invoices
+SET payment_request = $2, payment_request_hash = $3
+WHERE id = $1
+`
+
+type UpdateInvoicePaymentRequestParams struct {
+	ID                 int64
+	PaymentRequest     sql.NullString
+	PaymentRequestHash []byte
+}
+
+func (q *Queries) UpdateInvoicePaymentRequest(ctx context.Context, arg UpdateInvoicePaymentRequestParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, updateInvoicePaymentRequest, arg.ID, arg.PaymentRequest, arg.PaymentRequestHash)
+}
+
 const updateInvoiceHTLC = `-- name: UpdateInvoiceHTLC :exec
 UPDATE invoice_htlcs 
 SET state=$4, resolve_time=$5
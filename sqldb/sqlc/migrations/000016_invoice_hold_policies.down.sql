@@ -0,0 +1 @@
+DROP TABLE IF EXISTS invoice_hold_policies;
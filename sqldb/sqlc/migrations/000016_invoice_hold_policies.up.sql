@@ -0,0 +1,20 @@
+-- invoice_hold_policies contains the hold policies of hodl invoices. A hold
+-- policy restricts how long the htlcs of an accepted hodl invoice are held
+-- before the invoice is canceled automatically, and whether the invoice is
+-- settled automatically once its preimage becomes known. Invoices without a
+-- hold policy don't have a row in this table.
+CREATE TABLE IF NOT EXISTS invoice_hold_policies (
+    -- The invoice id this hold policy belongs to.
+    invoice_id BIGINT PRIMARY KEY REFERENCES invoices(id) ON DELETE CASCADE,
+
+    -- The maximum duration in nanoseconds the htlcs of the invoice are held
+    -- for after the first of them was accepted. Zero means no limit.
+    max_hold_duration BIGINT NOT NULL,
+
+    -- The minimum number of blocks that must be left until the earliest
+    -- expiry of the accepted htlcs before the invoice is canceled.
+    min_cltv_slack INTEGER NOT NULL,
+
+    -- Whether the invoice is settled as soon as its preimage is known.
+    auto_settle BOOLEAN NOT NULL
+);
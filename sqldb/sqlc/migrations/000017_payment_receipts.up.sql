@@ -0,0 +1,14 @@
+-- payment_receipts contains the receipts signed by the receivers of
+-- spontaneous payments. A receipt proves that the receiver received the
+-- payment along with its custom records. Payments without a receipt don't
+-- have a row in this table.
+CREATE TABLE IF NOT EXISTS payment_receipts (
+    -- The payment id this receipt belongs to.
+    payment_id BIGINT PRIMARY KEY REFERENCES payments(id) ON DELETE CASCADE,
+
+    -- The pubkey-recoverable signature of the receiver.
+    signature BLOB NOT NULL,
+
+    -- The time at which the receipt was received.
+    received_at TIMESTAMP NOT NULL
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS payment_route_hop_upfront_fees;
@@ -0,0 +1,12 @@
+-- payment_route_hop_upfront_fees stores the upfront fees paid to the hops of
+-- a route. Upfront fees are paid unconditionally when an HTLC is added, so
+-- they are needed to account for the fees of failed attempts. Hops that
+-- aren't paid an upfront fee don't have a row in this table.
+CREATE TABLE IF NOT EXISTS payment_route_hop_upfront_fees (
+    -- Primary key referencing the hop.
+    hop_id INTEGER PRIMARY KEY
+    REFERENCES payment_route_hops (id) ON DELETE CASCADE,
+
+    -- The upfront fee paid to the hop in millisatoshis.
+    upfront_fee_msat BIGINT NOT NULL
+);
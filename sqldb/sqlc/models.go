@@ -178,6 +178,13 @@ type InvoiceFeature struct {
 	InvoiceID int64
 }
 
+type InvoiceHoldPolicy struct {
+	InvoiceID       int64
+	MaxHoldDuration int64
+	MinCltvSlack    int32
+	AutoSettle      bool
+}
+
 type InvoiceHtlc struct {
 	ID           int64
 	ChanID       string
@@ -283,6 +290,12 @@ type PaymentIntent struct {
 	IntentPayload []byte
 }
 
+type PaymentReceipt struct {
+	PaymentID  int64
+	Signature  []byte
+	ReceivedAt time.Time
+}
+
 type PaymentRouteHop struct {
 	ID               int64
 	HtlcAttemptIndex int64
@@ -313,3 +326,8 @@ type PaymentRouteHopMpp struct {
 	PaymentAddr []byte
 	TotalMsat   int64
 }
+
+type PaymentRouteHopUpfrontFee struct {
+	HopID          int64
+	UpfrontFeeMsat int64
+}
@@ -170,11 +170,13 @@ SELECT
     a.child_index AS amp_child_index,
     b.encrypted_data,
     b.blinding_point,
-    b.blinded_path_total_amt
+    b.blinded_path_total_amt,
+    u.upfront_fee_msat
 FROM payment_route_hops h
 LEFT JOIN payment_route_hop_mpp m ON m.hop_id = h.id
 LEFT JOIN payment_route_hop_amp a ON a.hop_id = h.id
 LEFT JOIN payment_route_hop_blinded b ON b.hop_id = h.id
+LEFT JOIN payment_route_hop_upfront_fees u ON u.hop_id = h.id
 WHERE h.htlc_attempt_index IN (/*SLICE:htlc_attempt_indices*/?)
 ORDER BY h.htlc_attempt_index ASC, h.hop_index ASC
 `
@@ -196,6 +198,7 @@ type FetchHopsForAttemptsRow struct {
 	EncryptedData       []byte
 	BlindingPoint       []byte
 	BlindedPathTotalAmt sql.NullInt64
+	UpfrontFeeMsat      sql.NullInt64
 }
 
 func (q *Queries) FetchHopsForAttempts(ctx context.Context, htlcAttemptIndices []int64) ([]FetchHopsForAttemptsRow, error) {
@@ -234,6 +237,7 @@ func (q *Queries) FetchHopsForAttempts(ctx context.Context, htlcAttemptIndices [
 			&i.EncryptedData,
 			&i.BlindingPoint,
 			&i.BlindedPathTotalAmt,
+			&i.UpfrontFeeMsat,
 		); err != nil {
 			return nil, err
 		}
@@ -607,6 +611,47 @@ func (q *Queries) FetchPaymentLevelFirstHopCustomRecords(ctx context.Context, pa
 	return items, nil
 }
 
+const fetchPaymentReceipts = `-- name: FetchPaymentReceipts :many
+SELECT payment_id, signature, received_at
+FROM payment_receipts
+WHERE payment_id IN (/*SLICE:payment_ids*/?)
+ORDER BY payment_id ASC
+`
+
+// Batch fetch the receipts of a set of payments.
+func (q *Queries) FetchPaymentReceipts(ctx context.Context, paymentIds []int64) ([]PaymentReceipt, error) {
+	query := fetchPaymentReceipts
+	var queryParams []interface{}
+	if len(paymentIds) > 0 {
+		for _, v := range paymentIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:payment_ids*/?", makeQueryParams(len(queryParams), len(paymentIds)), 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:payment_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PaymentReceipt
+	for rows.Next() {
+		var i PaymentReceipt
+		if err := rows.Scan(&i.PaymentID, &i.Signature, &i.ReceivedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchPaymentsByIDs = `-- name: FetchPaymentsByIDs :many
 SELECT
     p.id,
@@ -1221,6 +1266,34 @@ func (q *Queries) InsertPaymentMig(ctx context.Context, arg InsertPaymentMigPara
 	return id, err
 }
 
+const insertPaymentReceipt = `-- name: InsertPaymentReceipt :exec
+INSERT INTO payment_receipts (
+    payment_id,
+    signature,
+    received_at
+)
+VALUES (
+    $1,
+    $2,
+    $3
+)
+ON CONFLICT (payment_id) DO UPDATE SET
+    signature = EXCLUDED.signature,
+    received_at = EXCLUDED.received_at
+`
+
+type InsertPaymentReceiptParams struct {
+	PaymentID  int64
+	Signature  []byte
+	ReceivedAt time.Time
+}
+
+// Insert the receipt of a payment, replacing any existing receipt.
+func (q *Queries) InsertPaymentReceipt(ctx context.Context, arg InsertPaymentReceiptParams) error {
+	_, err := q.db.ExecContext(ctx, insertPaymentReceipt, arg.PaymentID, arg.Signature, arg.ReceivedAt)
+	return err
+}
+
 const insertRouteHop = `-- name: InsertRouteHop :one
 INSERT INTO payment_route_hops (
     htlc_attempt_index,
@@ -1356,6 +1429,27 @@ func (q *Queries) InsertRouteHopMpp(ctx context.Context, arg InsertRouteHopMppPa
 	return err
 }
 
+const insertRouteHopUpfrontFee = `-- name: InsertRouteHopUpfrontFee :exec
+INSERT INTO payment_route_hop_upfront_fees (
+    hop_id,
+    upfront_fee_msat
+)
+VALUES (
+    $1,
+    $2
+)
+`
+
+type InsertRouteHopUpfrontFeeParams struct {
+	HopID          int64
+	UpfrontFeeMsat int64
+}
+
+func (q *Queries) InsertRouteHopUpfrontFee(ctx context.Context, arg InsertRouteHopUpfrontFeeParams) error {
+	_, err := q.db.ExecContext(ctx, insertRouteHopUpfrontFee, arg.HopID, arg.UpfrontFeeMsat)
+	return err
+}
+
 const settleAttempt = `-- name: SettleAttempt :exec
 INSERT INTO payment_htlc_attempt_resolutions (
     attempt_index,
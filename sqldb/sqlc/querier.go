@@ -56,6 +56,8 @@ type Querier interface {
 	// a given payment ID.
 	FetchPaymentDuplicates(ctx context.Context, paymentID int64) ([]PaymentDuplicate, error)
 	FetchPaymentLevelFirstHopCustomRecords(ctx context.Context, paymentIds []int64) ([]PaymentFirstHopCustomRecord, error)
+	// Batch fetch the receipts of a set of payments.
+	FetchPaymentReceipts(ctx context.Context, paymentIds []int64) ([]PaymentReceipt, error)
 	// Batch fetch payment and intent data for a set of payment IDs.
 	// Used to avoid fetching redundant payment data when processing multiple
 	// attempts for the same payment.
@@ -130,6 +132,7 @@ type Querier interface {
 	GetInvoiceFeatures(ctx context.Context, invoiceID int64) ([]InvoiceFeature, error)
 	GetInvoiceHTLCCustomRecords(ctx context.Context, invoiceID int64) ([]GetInvoiceHTLCCustomRecordsRow, error)
 	GetInvoiceHTLCs(ctx context.Context, invoiceID int64) ([]InvoiceHtlc, error)
+	GetInvoiceHoldPolicy(ctx context.Context, invoiceID int64) (InvoiceHoldPolicy, error)
 	GetKVInvoicePaymentHashByAddIndex(ctx context.Context, addIndex int64) ([]byte, error)
 	GetMigration(ctx context.Context, version int32) (time.Time, error)
 	GetNodeAddresses(ctx context.Context, nodeID int64) ([]GetNodeAddressesRow, error)
@@ -189,6 +192,7 @@ type Querier interface {
 	InsertInvoiceFeature(ctx context.Context, arg InsertInvoiceFeatureParams) error
 	InsertInvoiceHTLC(ctx context.Context, arg InsertInvoiceHTLCParams) (int64, error)
 	InsertInvoiceHTLCCustomRecord(ctx context.Context, arg InsertInvoiceHTLCCustomRecordParams) error
+	InsertInvoiceHoldPolicy(ctx context.Context, arg InsertInvoiceHoldPolicyParams) error
 	InsertKVInvoiceKeyAndAddIndex(ctx context.Context, arg InsertKVInvoiceKeyAndAddIndexParams) error
 	InsertMigratedInvoice(ctx context.Context, arg InsertMigratedInvoiceParams) (int64, error)
 	InsertNodeFeature(ctx context.Context, arg InsertNodeFeatureParams) error
@@ -215,10 +219,13 @@ type Querier interface {
 	// aren't failed yet. During migration, we're inserting historical data
 	// that may already be failed.
 	InsertPaymentMig(ctx context.Context, arg InsertPaymentMigParams) (int64, error)
+	// Insert the receipt of a payment, replacing any existing receipt.
+	InsertPaymentReceipt(ctx context.Context, arg InsertPaymentReceiptParams) error
 	InsertRouteHop(ctx context.Context, arg InsertRouteHopParams) (int64, error)
 	InsertRouteHopAmp(ctx context.Context, arg InsertRouteHopAmpParams) error
 	InsertRouteHopBlinded(ctx context.Context, arg InsertRouteHopBlindedParams) error
 	InsertRouteHopMpp(ctx context.Context, arg InsertRouteHopMppParams) error
+	InsertRouteHopUpfrontFee(ctx context.Context, arg InsertRouteHopUpfrontFeeParams) error
 	IsClosedChannel(ctx context.Context, scid []byte) (bool, error)
 	IsPublicV1Node(ctx context.Context, pubKey []byte) (bool, error)
 	IsPublicV2Node(ctx context.Context, pubKey []byte) (bool, error)
@@ -246,6 +253,7 @@ type Querier interface {
 	UpdateAMPSubInvoiceState(ctx context.Context, arg UpdateAMPSubInvoiceStateParams) error
 	UpdateInvoiceAmountPaid(ctx context.Context, arg UpdateInvoiceAmountPaidParams) (sql.Result, error)
 	UpdateInvoiceHTLC(ctx context.Context, arg UpdateInvoiceHTLCParams) error
+	UpdateInvoicePaymentRequest(ctx context.Context, arg UpdateInvoicePaymentRequestParams) (sql.Result, error)
 	UpdateInvoiceHTLCs(ctx context.Context, arg UpdateInvoiceHTLCsParams) error
 	UpdateInvoiceState(ctx context.Context, arg UpdateInvoiceStateParams) (sql.Result, error)
 	UpsertAMPSubInvoice(ctx context.Context, arg UpsertAMPSubInvoiceParams) (sql.Result, error)
@@ -29,6 +29,18 @@ SELECT *
 FROM invoice_features
 WHERE invoice_id = $1;
 
+-- name: InsertInvoiceHoldPolicy :exec
+INSERT INTO invoice_hold_policies (
+    invoice_id, max_hold_duration, min_cltv_slack, auto_settle
+) VALUES (
+    $1, $2, $3, $4
+);
+
+-- name: GetInvoiceHoldPolicy :one
+SELECT *
+FROM invoice_hold_policies
+WHERE invoice_id = $1;
+
 -- name: GetInvoiceByHash :one
 SELECT i.*
 FROM invoices i
@@ -138,6 +150,11 @@ UPDATE invoices
 SET amount_paid_msat = $2
 WHERE id = $1;
 
+-- name: UpdateInvoicePaymentRequest :execresult
+UPDATE invoices
+SET payment_request = $2, payment_request_hash = $3
+WHERE id = $1;
+
 -- name: NextInvoiceSettleIndex :one
 UPDATE invoice_sequences SET current_value = current_value + 1
 WHERE name = 'settle_index'
@@ -184,11 +184,13 @@ SELECT
     a.child_index AS amp_child_index,
     b.encrypted_data,
     b.blinding_point,
-    b.blinded_path_total_amt
+    b.blinded_path_total_amt,
+    u.upfront_fee_msat
 FROM payment_route_hops h
 LEFT JOIN payment_route_hop_mpp m ON m.hop_id = h.id
 LEFT JOIN payment_route_hop_amp a ON a.hop_id = h.id
 LEFT JOIN payment_route_hop_blinded b ON b.hop_id = h.id
+LEFT JOIN payment_route_hop_upfront_fees u ON u.hop_id = h.id
 WHERE h.htlc_attempt_index IN (sqlc.slice('htlc_attempt_indices')/*SLICE:htlc_attempt_indices*/)
 ORDER BY h.htlc_attempt_index ASC, h.hop_index ASC;
 
@@ -203,6 +205,13 @@ FROM payment_first_hop_custom_records l
 WHERE l.payment_id IN (sqlc.slice('payment_ids')/*SLICE:payment_ids*/)
 ORDER BY l.payment_id ASC, l.key ASC;
 
+-- name: FetchPaymentReceipts :many
+-- Batch fetch the receipts of a set of payments.
+SELECT *
+FROM payment_receipts
+WHERE payment_id IN (sqlc.slice('payment_ids')/*SLICE:payment_ids*/)
+ORDER BY payment_id ASC;
+
 -- name: FetchRouteLevelFirstHopCustomRecords :many
 SELECT
     l.id,
@@ -378,6 +387,16 @@ VALUES (
     @blinded_path_total_amt
 );
 
+-- name: InsertRouteHopUpfrontFee :exec
+INSERT INTO payment_route_hop_upfront_fees (
+    hop_id,
+    upfront_fee_msat
+)
+VALUES (
+    @hop_id,
+    @upfront_fee_msat
+);
+
 -- name: InsertPaymentHopCustomRecord :exec
 INSERT INTO payment_hop_custom_records (
     hop_id,
@@ -486,3 +505,19 @@ VALUES (
     @settle_time
 )
 RETURNING id;
+
+-- name: InsertPaymentReceipt :exec
+-- Insert the receipt of a payment, replacing any existing receipt.
+INSERT INTO payment_receipts (
+    payment_id,
+    signature,
+    received_at
+)
+VALUES (
+    @payment_id,
+    @signature,
+    @received_at
+)
+ON CONFLICT (payment_id) DO UPDATE SET
+    signature = EXCLUDED.signature,
+    received_at = EXCLUDED.received_at;
@@ -16,6 +16,7 @@ import (
 	graphdb "github.com/lightningnetwork/lnd/graph/db"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/invoices/subscriptions"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc/autopilotrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/chainrpc"
@@ -109,6 +110,7 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 	networkDir string, macService *macaroons.Service,
 	atpl *autopilot.Manager,
 	invoiceRegistry *invoices.InvoiceRegistry,
+	subscriptionMgr *subscriptions.Manager,
 	htlcSwitch *htlcswitch.Switch,
 	activeNetParams *chaincfg.Params,
 	chanRouter *routing.ChannelRouter,
@@ -117,6 +119,7 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 	graphDB *graphdb.ChannelGraph,
 	chanStateDB chanstate.Store,
 	sweeper *sweep.UtxoSweeper,
+	anchorReclaimer *sweep.AnchorReclaimer,
 	tower *watchtower.Standalone,
 	towerClientMgr *wtclient.Manager,
 	tcpResolver lncfg.TCPResolver,
@@ -195,6 +198,9 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 			subCfgValue.FieldByName("Sweeper").Set(
 				reflect.ValueOf(sweeper),
 			)
+			subCfgValue.FieldByName("AnchorReclaimer").Set(
+				reflect.ValueOf(anchorReclaimer),
+			)
 			subCfgValue.FieldByName("Chain").Set(
 				reflect.ValueOf(cc.ChainIO),
 			)
@@ -295,6 +301,9 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 			subCfgValue.FieldByName("ParseAuxData").Set(
 				reflect.ValueOf(parseAuxData),
 			)
+			subCfgValue.FieldByName("Subscriptions").Set(
+				reflect.ValueOf(subscriptionMgr),
+			)
 
 		case *neutrinorpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
@@ -0,0 +1,400 @@
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcutil/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// errNoAnchor is used internally to signal that a closed channel has no
+// unspent anchor.
+var errNoAnchor = errors.New("no anchor")
+
+// AnchorReclaimerConfig contains the dependencies of the anchor reclaimer.
+type AnchorReclaimerConfig struct {
+	// SweepInput offers an input to the sweeper.
+	SweepInput func(input.Input, Params) (chan Result, error)
+
+	// PendingInputs returns the inputs the sweeper is currently tracking.
+	PendingInputs func() (map[wire.OutPoint]*PendingInputResponse, error)
+
+	// FeeEstimator is used to check whether the current fee rate is low
+	// enough to reclaim the anchors.
+	FeeEstimator chainfee.Estimator
+
+	// ConfTarget is the conf target used to query the fee estimator.
+	ConfTarget uint32
+
+	// MaxFeeRate is the highest estimated fee rate at which the waiting
+	// anchors are offered to the sweeper.
+	MaxFeeRate chainfee.SatPerKWeight
+
+	// Ticker determines how often the fee rate is checked.
+	Ticker ticker.Ticker
+
+	// Store persists the anchors that are waiting for low fees and the
+	// closed channels that were scanned for unspent anchors.
+	Store AnchorStore
+
+	// ClosedChanAnchors scans the closed channels that aren't part of the
+	// given set for our unspent anchors. It's optional and used on
+	// startup to pick up the anchors of channels that no longer have a
+	// resolver, for example because they were closed before the
+	// reclaimer was activated.
+	ClosedChanAnchors func(scanned map[wire.OutPoint]struct{},
+		quit <-chan struct{}) ([]ClosedChanAnchor, error)
+}
+
+// ClosedChanAnchor is the result of scanning a closed channel for its anchor.
+type ClosedChanAnchor struct {
+	// ChanPoint is the funding outpoint of the closed channel.
+	ChanPoint wire.OutPoint
+
+	// Anchor is our unspent anchor output of the closing transaction of
+	// the channel, if any.
+	Anchor fn.Option[input.Input]
+}
+
+// waitingAnchor is an anchor output that is held back until fee rates are low
+// enough to sweep it economically.
+type waitingAnchor struct {
+	// input is the anchor input.
+	input input.Input
+
+	// params are the sweep params requested by the caller.
+	params Params
+
+	// resultChan is the channel the final sweep result is delivered on.
+	resultChan chan Result
+}
+
+// AnchorReclaimer is a wallet-level job that collects the anchor outputs of
+// our closed channels and sweeps them in a single batch once the fee rate
+// drops below a threshold. Anchors are usually worth less than the fees
+// required to sweep them on their own, which would otherwise cause them to
+// stay unspent.
+type AnchorReclaimer struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *AnchorReclaimerConfig
+
+	// anchors holds all anchors that are waiting for low fees.
+	anchors map[wire.OutPoint]*waitingAnchor
+
+	// mu protects anchors.
+	mu sync.Mutex
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewAnchorReclaimer creates a new anchor reclaimer.
+func NewAnchorReclaimer(cfg *AnchorReclaimerConfig) *AnchorReclaimer {
+	return &AnchorReclaimer{
+		cfg:     cfg,
+		anchors: make(map[wire.OutPoint]*waitingAnchor),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Start loads the persisted anchors, launches the scan of closed channels for
+// unspent anchors and the periodic fee rate check.
+func (a *AnchorReclaimer) Start() error {
+	var startErr error
+	a.started.Do(func() {
+		log.Infof("Anchor reclaimer starting, max_fee_rate=%v",
+			a.cfg.MaxFeeRate)
+
+		anchors, err := a.cfg.Store.FetchAnchors()
+		if err != nil {
+			startErr = fmt.Errorf("unable to fetch anchors: %w",
+				err)
+
+			return
+		}
+
+		a.mu.Lock()
+		for _, anchor := range anchors {
+			a.anchors[anchor.Input.OutPoint()] = &waitingAnchor{
+				input:      anchor.Input,
+				params:     Params{Budget: anchor.Budget},
+				resultChan: make(chan Result, 1),
+			}
+		}
+		a.mu.Unlock()
+
+		log.Debugf("Loaded %d anchors waiting for low fees",
+			len(anchors))
+
+		if a.cfg.ClosedChanAnchors != nil {
+			a.wg.Add(1)
+			go a.scanClosedChannels()
+		}
+
+		a.wg.Add(1)
+		go a.run()
+	})
+
+	return startErr
+}
+
+// Stop stops the anchor reclaimer. Anchors that are still waiting remain
+// persisted and are loaded again on restart.
+func (a *AnchorReclaimer) Stop() error {
+	a.stopped.Do(func() {
+		log.Debugf("Anchor reclaimer stopping")
+
+		close(a.quit)
+		a.wg.Wait()
+	})
+
+	return nil
+}
+
+// ReclaimAnchor hands an anchor output of a closed channel to the reclaimer.
+// The given params are the ones the anchor would have been swept with on its
+// own. If the sweeper is already sweeping the anchor, for example because it
+// was used to fee bump the commitment transaction, the anchor is re-offered to
+// the sweeper right away. Otherwise, it is held back until the fee rate drops
+// below the configured threshold. The returned channel receives the final
+// result of the sweep.
+func (a *AnchorReclaimer) ReclaimAnchor(inp input.Input,
+	params Params) (chan Result, error) {
+
+	op := inp.OutPoint()
+
+	pendingInputs, err := a.cfg.PendingInputs()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch pending inputs: %w",
+			err)
+	}
+
+	if _, ok := pendingInputs[op]; ok {
+		log.Debugf("Anchor %v already known to sweeper, updating "+
+			"sweep params", op)
+
+		return a.cfg.SweepInput(inp, params)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	err = a.cfg.Store.PutAnchor(&StoredAnchor{
+		Input:  inp,
+		Budget: params.Budget,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to store anchor %v: %w", op, err)
+	}
+
+	if anchor, ok := a.anchors[op]; ok {
+		anchor.input = inp
+		anchor.params = params
+
+		return anchor.resultChan, nil
+	}
+
+	log.Infof("Anchor %v (%v) waiting for fee rate below %v", op,
+		btcutil.Amount(inp.SignDesc().Output.Value), a.cfg.MaxFeeRate)
+
+	anchor := &waitingAnchor{
+		input:      inp,
+		params:     params,
+		resultChan: make(chan Result, 1),
+	}
+	a.anchors[op] = anchor
+
+	return anchor.resultChan, nil
+}
+
+// scanClosedChannels hands the unspent anchors of closed channels that were
+// not scanned before to the reclaimer, and records the scanned channels so
+// that they are skipped on the next startup.
+//
+// NOTE: This MUST be run as a goroutine.
+func (a *AnchorReclaimer) scanClosedChannels() {
+	defer a.wg.Done()
+
+	scanned, err := a.cfg.Store.FetchScanned()
+	if err != nil {
+		log.Errorf("Unable to fetch scanned channels: %v", err)
+		return
+	}
+
+	results, err := a.cfg.ClosedChanAnchors(scanned, a.quit)
+	if err != nil {
+		log.Errorf("Unable to scan closed channels for anchors: %v",
+			err)
+
+		return
+	}
+
+	chanPoints := make([]wire.OutPoint, 0, len(results))
+	for _, result := range results {
+		inp, err := result.Anchor.UnwrapOrErr(errNoAnchor)
+		if err == nil {
+			// The anchor is swept on its own terms, so its value
+			// is the budget, as for anchors of resolvers.
+			params := Params{
+				Budget: btcutil.Amount(
+					inp.SignDesc().Output.Value,
+				),
+			}
+
+			_, err = a.ReclaimAnchor(inp, params)
+			if err != nil {
+				log.Errorf("Unable to reclaim anchor %v of "+
+					"closed channel %v: %v", inp.OutPoint(),
+					result.ChanPoint, err)
+
+				// We'll try again on the next startup.
+				continue
+			}
+		}
+
+		chanPoints = append(chanPoints, result.ChanPoint)
+	}
+
+	if err := a.cfg.Store.MarkScanned(chanPoints...); err != nil {
+		log.Errorf("Unable to mark channels as scanned: %v", err)
+		return
+	}
+
+	log.Infof("Scanned %d closed channels for unspent anchors",
+		len(chanPoints))
+}
+
+// PendingAnchors returns the anchors that are waiting for low fees.
+func (a *AnchorReclaimer) PendingAnchors() []*PendingInputResponse {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	anchors := make([]*PendingInputResponse, 0, len(a.anchors))
+	for op, anchor := range a.anchors {
+		anchors = append(anchors, &PendingInputResponse{
+			OutPoint:    op,
+			WitnessType: anchor.input.WitnessType(),
+			Amount: btcutil.Amount(
+				anchor.input.SignDesc().Output.Value,
+			),
+			Params: anchor.params,
+		})
+	}
+
+	return anchors
+}
+
+// run checks the fee rate each time the ticker fires.
+//
+// NOTE: This MUST be run as a goroutine.
+func (a *AnchorReclaimer) run() {
+	defer a.wg.Done()
+
+	a.cfg.Ticker.Resume()
+	defer a.cfg.Ticker.Stop()
+
+	for {
+		select {
+		case <-a.cfg.Ticker.Ticks():
+			a.reclaim()
+
+		case <-a.quit:
+			return
+		}
+	}
+}
+
+// reclaim offers all waiting anchors to the sweeper if the fee rate is below
+// the threshold.
+func (a *AnchorReclaimer) reclaim() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(a.anchors) == 0 {
+		return
+	}
+
+	feeRate, err := a.cfg.FeeEstimator.EstimateFeePerKW(a.cfg.ConfTarget)
+	if err != nil {
+		log.Errorf("Unable to estimate fee rate: %v", err)
+		return
+	}
+
+	if feeRate > a.cfg.MaxFeeRate {
+		log.Debugf("Fee rate %v above %v, holding back %d anchors",
+			feeRate, a.cfg.MaxFeeRate, len(a.anchors))
+
+		return
+	}
+
+	log.Infof("Fee rate %v below %v, sweeping %d anchors", feeRate,
+		a.cfg.MaxFeeRate, len(a.anchors))
+
+	for op, anchor := range a.anchors {
+		// Drop the exclusive group so that all anchors can be swept
+		// in the same transaction, and start at the current low fee
+		// rate. There's no deadline, so the sweeper will only slowly
+		// increase the fee rate within the anchor's budget.
+		params := anchor.params
+		params.ExclusiveGroup = nil
+		params.DeadlineHeight = fn.None[int32]()
+		params.StartingFeeRate = fn.Some(feeRate)
+
+		resultChan, err := a.cfg.SweepInput(anchor.input, params)
+		if err != nil {
+			log.Errorf("Unable to sweep anchor %v: %v", op, err)
+			continue
+		}
+
+		delete(a.anchors, op)
+
+		a.wg.Add(1)
+		go a.waitForResult(op, anchor, resultChan)
+	}
+}
+
+// waitForResult forwards the final result of an anchor sweep to the caller.
+// If the sweep failed for any other reason than the anchor being spent, the
+// anchor is held back again until the next time fees are low.
+//
+// NOTE: This MUST be run as a goroutine.
+func (a *AnchorReclaimer) waitForResult(op wire.OutPoint,
+	anchor *waitingAnchor, resultChan chan Result) {
+
+	defer a.wg.Done()
+
+	var result Result
+	select {
+	case result = <-resultChan:
+	case <-a.quit:
+		return
+	}
+
+	switch {
+	case result.Err == nil,
+		errors.Is(result.Err, ErrRemoteSpend),
+		errors.Is(result.Err, ErrInputMissing):
+
+		if err := a.cfg.Store.DeleteAnchor(op); err != nil {
+			log.Errorf("Unable to delete anchor %v: %v", op, err)
+		}
+
+		anchor.resultChan <- result
+
+	default:
+		log.Warnf("Unable to sweep anchor %v, retrying once fees "+
+			"are low: %v", op, result.Err)
+
+		a.mu.Lock()
+		a.anchors[op] = anchor
+		a.mu.Unlock()
+	}
+}
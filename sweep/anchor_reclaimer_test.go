@@ -0,0 +1,281 @@
+package sweep
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/ticker"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAnchorReclaimer tests that the anchor reclaimer holds back anchors
+// unknown to the sweeper until fees are low, sweeps them together and retries
+// failed sweeps.
+func TestAnchorReclaimer(t *testing.T) {
+	t.Parallel()
+
+	cdb, err := channeldb.MakeTestDB(t)
+	require.NoError(t, err)
+
+	store, err := NewAnchorStore(cdb)
+	require.NoError(t, err)
+
+	var (
+		inflight = newAnchor(1)
+		anchor1  = newAnchor(2)
+		anchor2  = newAnchor(3)
+
+		mu          sync.Mutex
+		sweepParams = make(map[wire.OutPoint]Params)
+		resultChans = make(map[wire.OutPoint]chan Result)
+	)
+
+	estimator := &chainfee.MockEstimator{}
+	forceTicker := ticker.NewForce(time.Hour)
+
+	reclaimer := NewAnchorReclaimer(&AnchorReclaimerConfig{
+		SweepInput: func(inp input.Input, params Params) (chan Result,
+			error) {
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			resultChan := make(chan Result, 1)
+			sweepParams[inp.OutPoint()] = params
+			resultChans[inp.OutPoint()] = resultChan
+
+			return resultChan, nil
+		},
+		PendingInputs: func() (map[wire.OutPoint]*PendingInputResponse,
+			error) {
+
+			return map[wire.OutPoint]*PendingInputResponse{
+				inflight.OutPoint(): {},
+			}, nil
+		},
+		FeeEstimator: estimator,
+		ConfTarget:   1008,
+		MaxFeeRate:   500,
+		Ticker:       forceTicker,
+		Store:        store,
+	})
+	require.NoError(t, reclaimer.Start())
+	t.Cleanup(func() {
+		require.NoError(t, reclaimer.Stop())
+	})
+
+	group := uint64(7)
+	params := Params{
+		Budget:         330,
+		ExclusiveGroup: &group,
+	}
+
+	// An anchor that the sweeper already knows about is re-offered right
+	// away with the given params.
+	_, err = reclaimer.ReclaimAnchor(inflight, params)
+	require.NoError(t, err)
+	require.Equal(t, params, sweepParams[inflight.OutPoint()])
+	require.Empty(t, reclaimer.PendingAnchors())
+
+	// The other anchors are held back.
+	result1, err := reclaimer.ReclaimAnchor(anchor1, params)
+	require.NoError(t, err)
+	result2, err := reclaimer.ReclaimAnchor(anchor2, params)
+	require.NoError(t, err)
+
+	pending := reclaimer.PendingAnchors()
+	require.Len(t, pending, 2)
+	for _, p := range pending {
+		require.EqualValues(t, 330, p.Amount)
+		require.Equal(t, input.CommitmentAnchor, p.WitnessType)
+	}
+
+	// As long as fees are high, nothing is swept. The second tick can only
+	// be delivered once the first one has been processed.
+	estimator.On("EstimateFeePerKW", uint32(1008)).Return(
+		chainfee.SatPerKWeight(2500), nil,
+	).Once()
+	estimator.On("EstimateFeePerKW", uint32(1008)).Return(
+		chainfee.SatPerKWeight(250), nil,
+	).Once()
+
+	forceTicker.Force <- time.Time{}
+	forceTicker.Force <- time.Time{}
+
+	// Once fees are low, both anchors are offered to the sweeper without
+	// an exclusive group so that they are batched together.
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return len(sweepParams) == 3
+	}, time.Second, 10*time.Millisecond)
+	estimator.AssertExpectations(t)
+
+	mu.Lock()
+	for _, anchor := range []input.Input{anchor1, anchor2} {
+		p := sweepParams[anchor.OutPoint()]
+		require.Nil(t, p.ExclusiveGroup)
+		require.EqualValues(t, 330, p.Budget)
+		require.Equal(
+			t, fn.Some(chainfee.SatPerKWeight(250)),
+			p.StartingFeeRate,
+		)
+	}
+	mu.Unlock()
+	require.Empty(t, reclaimer.PendingAnchors())
+
+	// A successful sweep is forwarded to the caller.
+	sweepTx := &wire.MsgTx{}
+	mu.Lock()
+	resultChans[anchor1.OutPoint()] <- Result{Tx: sweepTx}
+	resultChans[anchor2.OutPoint()] <- Result{Err: errors.New("fatal")}
+	mu.Unlock()
+
+	select {
+	case res := <-result1:
+		require.NoError(t, res.Err)
+		require.Equal(t, sweepTx, res.Tx)
+
+	case <-time.After(time.Second):
+		t.Fatal("no sweep result")
+	}
+
+	// A failed sweep puts the anchor back into the waiting set.
+	require.Eventually(t, func() bool {
+		return len(reclaimer.PendingAnchors()) == 1
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(
+		t, anchor2.OutPoint(), reclaimer.PendingAnchors()[0].OutPoint,
+	)
+	require.Empty(t, result2)
+
+	// Only the anchor that is still waiting remains persisted.
+	stored, err := store.FetchAnchors()
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+	require.Equal(t, anchor2.OutPoint(), stored[0].Input.OutPoint())
+}
+
+// TestAnchorReclaimerRestart tests that the anchor reclaimer loads the
+// persisted anchors on startup and picks up the unspent anchors of closed
+// channels that weren't scanned before.
+func TestAnchorReclaimerRestart(t *testing.T) {
+	t.Parallel()
+
+	cdb, err := channeldb.MakeTestDB(t)
+	require.NoError(t, err)
+
+	store, err := NewAnchorStore(cdb)
+	require.NoError(t, err)
+
+	var (
+		waiting    = newAnchor(1)
+		closed     = newAnchor(2)
+		scanned    = wire.OutPoint{Hash: chainhash.Hash{3}}
+		noAnchor   = wire.OutPoint{Hash: chainhash.Hash{4}}
+		withAnchor = wire.OutPoint{Hash: chainhash.Hash{5}}
+	)
+
+	require.NoError(t, store.PutAnchor(&StoredAnchor{
+		Input:  waiting,
+		Budget: 1000,
+	}))
+	require.NoError(t, store.MarkScanned(scanned))
+
+	newReclaimer := func() *AnchorReclaimer {
+		return NewAnchorReclaimer(&AnchorReclaimerConfig{
+			PendingInputs: func() (
+				map[wire.OutPoint]*PendingInputResponse,
+				error) {
+
+				return nil, nil
+			},
+			FeeEstimator: &chainfee.MockEstimator{},
+			ConfTarget:   1008,
+			MaxFeeRate:   500,
+			Ticker:       ticker.NewForce(time.Hour),
+			Store:        store,
+			ClosedChanAnchors: func(
+				skip map[wire.OutPoint]struct{},
+				_ <-chan struct{}) ([]ClosedChanAnchor, error) {
+
+				var results []ClosedChanAnchor
+				for _, r := range []ClosedChanAnchor{
+					{ChanPoint: scanned},
+					{ChanPoint: noAnchor},
+					{
+						ChanPoint: withAnchor,
+						Anchor:    fn.Some(closed),
+					},
+				} {
+					if _, ok := skip[r.ChanPoint]; ok {
+						continue
+					}
+					results = append(results, r)
+				}
+
+				return results, nil
+			},
+		})
+	}
+
+	reclaimer := newReclaimer()
+	require.NoError(t, reclaimer.Start())
+
+	// The persisted anchor is loaded with its budget, and the anchor of
+	// the closed channel is added with its value as the budget.
+	require.Eventually(t, func() bool {
+		return len(reclaimer.PendingAnchors()) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	reclaimer.mu.Lock()
+	require.EqualValues(
+		t, 1000, reclaimer.anchors[waiting.OutPoint()].params.Budget,
+	)
+	require.EqualValues(
+		t, 330, reclaimer.anchors[closed.OutPoint()].params.Budget,
+	)
+	reclaimer.mu.Unlock()
+
+	// Once the scan is done, all channels are marked as scanned.
+	require.Eventually(t, func() bool {
+		s, err := store.FetchScanned()
+		require.NoError(t, err)
+
+		return len(s) == 3
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, reclaimer.Stop())
+
+	// Both anchors survive a restart, while the scan doesn't return any
+	// channels anymore.
+	stored, err := store.FetchAnchors()
+	require.NoError(t, err)
+	require.Len(t, stored, 2)
+
+	reclaimer = newReclaimer()
+	require.NoError(t, reclaimer.Start())
+	require.Len(t, reclaimer.PendingAnchors(), 2)
+	require.NoError(t, reclaimer.Stop())
+}
+
+// newAnchor creates a test anchor input with the given outpoint hash.
+func newAnchor(i byte) input.Input {
+	inp := input.MakeBaseInput(
+		&wire.OutPoint{Hash: chainhash.Hash{i}},
+		input.CommitmentAnchor,
+		&input.SignDescriptor{
+			Output: &wire.TxOut{Value: 330},
+		}, 100, nil,
+	)
+
+	return &inp
+}
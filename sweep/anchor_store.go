@@ -0,0 +1,336 @@
+package sweep
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/v2"
+	"github.com/btcsuite/btcd/txscript/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// anchorReclaimerBucketKey is the top-level bucket of the anchor
+	// reclaimer.
+	anchorReclaimerBucketKey = []byte("anchor-reclaimer")
+
+	// waitingAnchorsBucketKey is the sub-bucket of the anchor reclaimer
+	// bucket that stores the anchors that are waiting for low fees.
+	//
+	// maps: outPoint -> StoredAnchor
+	waitingAnchorsBucketKey = []byte("waiting-anchors")
+
+	// scannedChansBucketKey is the sub-bucket of the anchor reclaimer
+	// bucket that stores the closed channels that were already scanned
+	// for unspent anchors.
+	//
+	// maps: chanPoint -> nil
+	scannedChansBucketKey = []byte("scanned-chans")
+)
+
+// StoredAnchor is an anchor output that is persisted by the anchor reclaimer
+// while it's waiting for low fees.
+type StoredAnchor struct {
+	// Input is the anchor input.
+	Input input.Input
+
+	// Budget is the budget the anchor is swept with.
+	Budget btcutil.Amount
+}
+
+// AnchorStore persists the state of the anchor reclaimer.
+type AnchorStore interface {
+	// PutAnchor adds or replaces an anchor that is waiting for low fees.
+	PutAnchor(anchor *StoredAnchor) error
+
+	// DeleteAnchor deletes an anchor once it's swept.
+	DeleteAnchor(op wire.OutPoint) error
+
+	// FetchAnchors returns all anchors that are waiting for low fees.
+	FetchAnchors() ([]*StoredAnchor, error)
+
+	// MarkScanned records that the given closed channels were scanned for
+	// unspent anchors.
+	MarkScanned(chanPoints ...wire.OutPoint) error
+
+	// FetchScanned returns the closed channels that were scanned for
+	// unspent anchors.
+	FetchScanned() (map[wire.OutPoint]struct{}, error)
+}
+
+// anchorStore is an AnchorStore backed by a kvdb backend.
+type anchorStore struct {
+	db kvdb.Backend
+}
+
+// A compile-time assertion to ensure that anchorStore implements the
+// AnchorStore interface.
+var _ AnchorStore = (*anchorStore)(nil)
+
+// NewAnchorStore creates a new store for the anchor reclaimer, creating its
+// buckets if needed.
+func NewAnchorStore(db kvdb.Backend) (AnchorStore, error) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		bucket, err := tx.CreateTopLevelBucket(anchorReclaimerBucketKey)
+		if err != nil {
+			return err
+		}
+
+		_, err = bucket.CreateBucketIfNotExists(waitingAnchorsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		_, err = bucket.CreateBucketIfNotExists(scannedChansBucketKey)
+
+		return err
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &anchorStore{db: db}, nil
+}
+
+// anchorRecord is the serialized representation of a StoredAnchor.
+type anchorRecord struct {
+	witnessType uint16
+	heightHint  uint32
+	signDesc    []byte
+	signMethod  uint8
+	tapTweak    []byte
+	budget      uint64
+}
+
+// toTlvStream converts an anchorRecord into a tlv representation.
+func (r *anchorRecord) toTlvStream() (*tlv.Stream, error) {
+	const (
+		// A set of tlv type definitions used to serialize an anchor.
+		//
+		// NOTE: The outpoint is stored as the key, so it's not
+		// included here.
+		witnessTypeType tlv.Type = 0
+		heightHintType  tlv.Type = 1
+		signDescType    tlv.Type = 2
+		signMethodType  tlv.Type = 3
+		tapTweakType    tlv.Type = 4
+		budgetType      tlv.Type = 5
+	)
+
+	return tlv.NewStream(
+		tlv.MakePrimitiveRecord(witnessTypeType, &r.witnessType),
+		tlv.MakePrimitiveRecord(heightHintType, &r.heightHint),
+		tlv.MakePrimitiveRecord(signDescType, &r.signDesc),
+		tlv.MakePrimitiveRecord(signMethodType, &r.signMethod),
+		tlv.MakePrimitiveRecord(tapTweakType, &r.tapTweak),
+		tlv.MakePrimitiveRecord(budgetType, &r.budget),
+	)
+}
+
+// serializeAnchor serializes a StoredAnchor based on tlv format.
+func serializeAnchor(anchor *StoredAnchor) ([]byte, error) {
+	inpWitnessType := anchor.Input.WitnessType()
+	witnessType, ok := inpWitnessType.(input.StandardWitnessType)
+	if !ok {
+		return nil, fmt.Errorf("unable to store anchor with witness "+
+			"type %v", anchor.Input.WitnessType())
+	}
+
+	signDesc := anchor.Input.SignDesc()
+
+	var signDescBytes bytes.Buffer
+	err := input.WriteSignDescriptor(&signDescBytes, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &anchorRecord{
+		witnessType: uint16(witnessType),
+		heightHint:  anchor.Input.HeightHint(),
+		signDesc:    signDescBytes.Bytes(),
+		signMethod:  uint8(signDesc.SignMethod),
+		tapTweak:    signDesc.TapTweak,
+		budget:      uint64(anchor.Budget),
+	}
+
+	tlvStream, err := r.toTlvStream()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tlvStream.Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// deserializeAnchor deserializes a StoredAnchor of the given outpoint based on
+// tlv format.
+func deserializeAnchor(op wire.OutPoint, b []byte) (*StoredAnchor, error) {
+	var r anchorRecord
+	tlvStream, err := r.toTlvStream()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Decode(bytes.NewReader(b)); err != nil {
+		return nil, err
+	}
+
+	var signDesc input.SignDescriptor
+	err = input.ReadSignDescriptor(bytes.NewReader(r.signDesc), &signDesc)
+	if err != nil {
+		return nil, err
+	}
+	signDesc.SignMethod = input.SignMethod(r.signMethod)
+	if len(r.tapTweak) > 0 {
+		signDesc.TapTweak = r.tapTweak
+	}
+
+	// Taproot anchors are signed with the previous output, which is the
+	// anchor itself.
+	if signDesc.SignMethod != input.WitnessV0SignMethod {
+		fetcher := txscript.NewCannedPrevOutputFetcher(
+			signDesc.Output.PkScript, signDesc.Output.Value,
+		)
+		signDesc.PrevOutputFetcher = fetcher
+	}
+
+	inp := input.MakeBaseInput(
+		&op, input.StandardWitnessType(r.witnessType), &signDesc,
+		r.heightHint, nil,
+	)
+
+	return &StoredAnchor{
+		Input:  &inp,
+		Budget: btcutil.Amount(r.budget),
+	}, nil
+}
+
+// PutAnchor adds or replaces an anchor that is waiting for low fees.
+func (s *anchorStore) PutAnchor(anchor *StoredAnchor) error {
+	value, err := serializeAnchor(anchor)
+	if err != nil {
+		return err
+	}
+
+	var key bytes.Buffer
+	err = lnwire.WriteOutPoint(&key, anchor.Input.OutPoint())
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(anchorReclaimerBucketKey).
+			NestedReadWriteBucket(waitingAnchorsBucketKey)
+
+		return bucket.Put(key.Bytes(), value)
+	}, func() {})
+}
+
+// DeleteAnchor deletes an anchor once it's swept. Deleting an unknown anchor
+// is a no-op.
+func (s *anchorStore) DeleteAnchor(op wire.OutPoint) error {
+	var key bytes.Buffer
+	if err := lnwire.WriteOutPoint(&key, op); err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(anchorReclaimerBucketKey).
+			NestedReadWriteBucket(waitingAnchorsBucketKey)
+
+		return bucket.Delete(key.Bytes())
+	}, func() {})
+}
+
+// FetchAnchors returns all anchors that are waiting for low fees.
+func (s *anchorStore) FetchAnchors() ([]*StoredAnchor, error) {
+	var anchors []*StoredAnchor
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(anchorReclaimerBucketKey).
+			NestedReadBucket(waitingAnchorsBucketKey)
+
+		return bucket.ForEach(func(k, v []byte) error {
+			var op wire.OutPoint
+			err := lnwire.ReadElement(bytes.NewReader(k), &op)
+			if err != nil {
+				return err
+			}
+
+			anchor, err := deserializeAnchor(op, v)
+			if err != nil {
+				return err
+			}
+			anchors = append(anchors, anchor)
+
+			return nil
+		})
+	}, func() {
+		anchors = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return anchors, nil
+}
+
+// MarkScanned records that the given closed channels were scanned for unspent
+// anchors.
+func (s *anchorStore) MarkScanned(chanPoints ...wire.OutPoint) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(anchorReclaimerBucketKey).
+			NestedReadWriteBucket(scannedChansBucketKey)
+
+		for _, chanPoint := range chanPoints {
+			var key bytes.Buffer
+			err := lnwire.WriteOutPoint(&key, chanPoint)
+			if err != nil {
+				return err
+			}
+
+			if err := bucket.Put(key.Bytes(), nil); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+}
+
+// FetchScanned returns the closed channels that were scanned for unspent
+// anchors.
+func (s *anchorStore) FetchScanned() (map[wire.OutPoint]struct{}, error) {
+	var scanned map[wire.OutPoint]struct{}
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(anchorReclaimerBucketKey).
+			NestedReadBucket(scannedChansBucketKey)
+
+		return bucket.ForEach(func(k, _ []byte) error {
+			var chanPoint wire.OutPoint
+			err := lnwire.ReadElement(
+				bytes.NewReader(k), &chanPoint,
+			)
+			if err != nil {
+				return err
+			}
+			scanned[chanPoint] = struct{}{}
+
+			return nil
+		})
+	}, func() {
+		scanned = make(map[wire.OutPoint]struct{})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return scanned, nil
+}
@@ -0,0 +1,156 @@
+package sweep
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/btcsuite/btcd/wire/v2"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAnchorStore tests storing, replacing and deleting waiting anchors and
+// marking channels as scanned, and that they survive recreating the store.
+func TestAnchorStore(t *testing.T) {
+	t.Parallel()
+
+	cdb, err := channeldb.MakeTestDB(t)
+	require.NoError(t, err)
+
+	store, err := NewAnchorStore(cdb)
+	require.NoError(t, err)
+
+	anchors, err := store.FetchAnchors()
+	require.NoError(t, err)
+	require.Empty(t, anchors)
+
+	scanned, err := store.FetchScanned()
+	require.NoError(t, err)
+	require.Empty(t, scanned)
+
+	priv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	keyDesc := keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamilyMultiSig,
+			Index:  3,
+		},
+		PubKey: priv.PubKey(),
+	}
+
+	segwitOp := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 2}
+	segwitInput := input.MakeBaseInput(
+		&segwitOp, input.CommitmentAnchor, &input.SignDescriptor{
+			KeyDesc:       keyDesc,
+			WitnessScript: []byte{1, 2, 3},
+			Output: &wire.TxOut{
+				Value:    330,
+				PkScript: []byte{0, 32, 1},
+			},
+			HashType: 1,
+		}, 100, nil,
+	)
+
+	taprootOp := wire.OutPoint{Hash: chainhash.Hash{2}}
+	taprootInput := input.MakeBaseInput(
+		&taprootOp, input.TaprootAnchorSweepSpend,
+		&input.SignDescriptor{
+			KeyDesc:    keyDesc,
+			SignMethod: input.TaprootKeySpendSignMethod,
+			TapTweak:   []byte{4, 5, 6},
+			Output: &wire.TxOut{
+				Value:    330,
+				PkScript: []byte{1, 32, 2},
+			},
+		}, 200, nil,
+	)
+
+	require.NoError(t, store.PutAnchor(&StoredAnchor{
+		Input:  &segwitInput,
+		Budget: 100,
+	}))
+	require.NoError(t, store.PutAnchor(&StoredAnchor{
+		Input:  &taprootInput,
+		Budget: 330,
+	}))
+
+	// A stored anchor is replaced by the next one.
+	require.NoError(t, store.PutAnchor(&StoredAnchor{
+		Input:  &segwitInput,
+		Budget: 330,
+	}))
+
+	chanPoint1 := wire.OutPoint{Hash: chainhash.Hash{3}}
+	chanPoint2 := wire.OutPoint{Hash: chainhash.Hash{4}, Index: 1}
+	require.NoError(t, store.MarkScanned(chanPoint1, chanPoint2))
+	require.NoError(t, store.MarkScanned(chanPoint1))
+
+	// The anchors survive recreating the store.
+	store, err = NewAnchorStore(cdb)
+	require.NoError(t, err)
+
+	anchors, err = store.FetchAnchors()
+	require.NoError(t, err)
+	require.Len(t, anchors, 2)
+
+	byOutPoint := make(map[wire.OutPoint]*StoredAnchor)
+	for _, anchor := range anchors {
+		byOutPoint[anchor.Input.OutPoint()] = anchor
+	}
+
+	for _, expected := range []input.Input{&segwitInput, &taprootInput} {
+		anchor := byOutPoint[expected.OutPoint()]
+		require.NotNil(t, anchor)
+		require.EqualValues(t, 330, anchor.Budget)
+		require.Equal(
+			t, expected.WitnessType(), anchor.Input.WitnessType(),
+		)
+		require.Equal(
+			t, expected.HeightHint(), anchor.Input.HeightHint(),
+		)
+
+		want, got := expected.SignDesc(), anchor.Input.SignDesc()
+		require.Equal(
+			t, want.KeyDesc.KeyLocator, got.KeyDesc.KeyLocator,
+		)
+		require.True(
+			t, want.KeyDesc.PubKey.IsEqual(got.KeyDesc.PubKey),
+		)
+		require.True(
+			t, bytes.Equal(want.WitnessScript, got.WitnessScript),
+		)
+		require.Equal(t, want.Output, got.Output)
+		require.Equal(t, want.HashType, got.HashType)
+		require.Equal(t, want.SignMethod, got.SignMethod)
+		require.Equal(t, want.TapTweak, got.TapTweak)
+	}
+
+	// Taproot anchors get a fetcher for their own output.
+	taprootDesc := byOutPoint[taprootOp].Input.SignDesc()
+	require.NotNil(t, taprootDesc.PrevOutputFetcher)
+	require.Equal(
+		t, taprootDesc.Output,
+		taprootDesc.PrevOutputFetcher.FetchPrevOutput(taprootOp),
+	)
+
+	scanned, err = store.FetchScanned()
+	require.NoError(t, err)
+	require.Equal(t, map[wire.OutPoint]struct{}{
+		chanPoint1: {},
+		chanPoint2: {},
+	}, scanned)
+
+	// Deleting an anchor leaves the other one untouched.
+	require.NoError(t, store.DeleteAnchor(segwitOp))
+	require.NoError(t, store.DeleteAnchor(segwitOp))
+
+	anchors, err = store.FetchAnchors()
+	require.NoError(t, err)
+	require.Len(t, anchors, 1)
+	require.Equal(t, taprootOp, anchors[0].Input.OutPoint())
+}
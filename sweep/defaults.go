@@ -1,6 +1,8 @@
 package sweep
 
 import (
+	"time"
+
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
@@ -9,4 +11,12 @@ var (
 	// UtxoSweeper. The current value is equivalent to a fee rate of 1,000
 	// sat/vbyte.
 	DefaultMaxFeeRate chainfee.SatPerVByte = 1e3
+
+	// DefaultAnchorReclaimMaxFeeRate is the default fee rate below which
+	// the anchor reclaimer sweeps the anchors of closed channels.
+	DefaultAnchorReclaimMaxFeeRate chainfee.SatPerVByte = 2
+
+	// DefaultAnchorReclaimInterval is the default interval at which the
+	// anchor reclaimer checks the fee rate.
+	DefaultAnchorReclaimInterval = 10 * time.Minute
 )
@@ -6,6 +6,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcutil/v2"
 	"github.com/btcsuite/btcd/chainhash/v2"
 	"github.com/btcsuite/btcd/rpcclient"
@@ -47,6 +48,16 @@ var (
 	// ErrInputMissing is returned when a given input no longer exists,
 	// e.g., spending from an orphan tx.
 	ErrInputMissing = errors.New("input no longer exists")
+
+	// ErrNonTrucParent is returned when a v3 (TRUC) sweep tx would spend
+	// an unconfirmed output of a tx that isn't a v3 tx itself, which is
+	// not allowed by the mempool policy.
+	ErrNonTrucParent = errors.New("unconfirmed non-TRUC parent")
+
+	// ErrTrucChildTooLarge is returned when a v3 (TRUC) sweep tx that
+	// spends an unconfirmed v3 parent exceeds the maximum weight of such a
+	// child tx.
+	ErrTrucChildTooLarge = errors.New("TRUC child tx too large")
 )
 
 var (
@@ -646,6 +657,19 @@ func (t *TxPublisher) createAndCheckTx(r *monitorRecord) (*sweepTxCtx, error) {
 		return sweepCtx, nil
 	}
 
+	// If the tx spends an unconfirmed parent that can't be relayed on its
+	// own, the parent is likely not in the mempool yet and will only be
+	// accepted along with this tx as a package.
+	if errors.Is(err, chain.ErrMissingInputs) &&
+		len(packageParents(req.Inputs)) > 0 {
+
+		log.Debugf("Skipped mempool check of tx %v as its parent "+
+			"will be published along with it as a package",
+			sweepCtx.tx.TxHash())
+
+		return sweepCtx, nil
+	}
+
 	// Print an error log if the chain backend doesn't support the mempool
 	// acceptance test RPC.
 	if errors.Is(err, rpcclient.ErrBackendVersion) {
@@ -752,10 +776,14 @@ func (t *TxPublisher) broadcast(record *monitorRecord) (*BumpResult, error) {
 
 	// Publish the sweeping tx with customized label. If the publish fails,
 	// this error will be saved in the `BumpResult` and it will be removed
-	// from being monitored.
-	err = t.cfg.Wallet.PublishTransaction(
-		tx, labels.MakeLabel(labels.LabelTypeSweepTransaction, nil),
-	)
+	// from being monitored. If the tx spends parents that can't be relayed
+	// on their own, they're published along with it as a package.
+	label := labels.MakeLabel(labels.LabelTypeSweepTransaction, nil)
+	if parents := packageParents(record.req.Inputs); len(parents) > 0 {
+		err = t.publishPackage(parents, tx, label)
+	} else {
+		err = t.cfg.Wallet.PublishTransaction(tx, label)
+	}
 	if err != nil {
 		// NOTE: we decide to attach this error to the result instead
 		// of returning it here because by the time the tx reaches
@@ -781,6 +809,98 @@ func (t *TxPublisher) broadcast(record *monitorRecord) (*BumpResult, error) {
 	return result, nil
 }
 
+// publishPackage publishes the given tx along with its unconfirmed parents as
+// a package.
+func (t *TxPublisher) publishPackage(parents []*wire.MsgTx, tx *wire.MsgTx,
+	label string) error {
+
+	txns := make([]*wire.MsgTx, 0, len(parents)+1)
+	txns = append(txns, parents...)
+	txns = append(txns, tx)
+
+	log.Debugf("Publishing sweep tx %v as package with %d parent(s)",
+		tx.TxHash(), len(parents))
+
+	result, err := t.cfg.Wallet.SubmitPackage(txns, nil)
+	if err != nil {
+		return err
+	}
+
+	if result != nil {
+		for _, txResult := range result.TxResults {
+			if txResult.Error == nil {
+				continue
+			}
+
+			return fmt.Errorf("package tx %v rejected: %v",
+				txResult.TxID, *txResult.Error)
+		}
+	}
+
+	// The package was submitted to the chain backend directly, so we
+	// also publish the tx through the wallet to make it aware of the
+	// spent inputs and its change output. As the tx is already in the
+	// mempool, this won't fail.
+	return t.cfg.Wallet.PublishTransaction(tx, label)
+}
+
+// packageParents returns the unconfirmed parents of the given inputs that
+// can't be relayed on their own, such as zero-fee commitments, and thus must
+// be published along with the tx spending them as a package.
+func packageParents(inputs []input.Input) []*wire.MsgTx {
+	var (
+		parents []*wire.MsgTx
+		seen    = make(map[chainhash.Hash]struct{})
+	)
+	for _, inp := range inputs {
+		parent := inp.UnconfParent()
+		if parent == nil || parent.Tx == nil {
+			continue
+		}
+
+		txid := parent.Tx.TxHash()
+		if _, ok := seen[txid]; ok {
+			continue
+		}
+		seen[txid] = struct{}{}
+
+		parents = append(parents, parent.Tx)
+	}
+
+	return parents
+}
+
+// sweepTxVersion returns the version of a tx sweeping the given inputs. A tx
+// spending the shared anchor of a zero-fee commitment must be a v3 (TRUC) tx
+// itself, as the commitment is a v3 tx. Otherwise we use version 2 as it is
+// required for CSV. An error is returned if a v3 tx would spend an unconfirmed
+// output of a tx that isn't a v3 tx as well.
+func sweepTxVersion(inputs []input.Input) (int32, error) {
+	truc := fn.Any(inputs, func(inp input.Input) bool {
+		return inp.WitnessType() == input.P2AAnchorSpend
+	})
+	if !truc {
+		return 2, nil
+	}
+
+	// Wallet inputs are always confirmed, so we only need to check the
+	// inputs that come with an unconfirmed parent, such as the anchors of
+	// legacy anchor channels.
+	for _, inp := range inputs {
+		parent := inp.UnconfParent()
+		if parent == nil {
+			continue
+		}
+
+		if parent.Tx == nil || parent.Tx.Version != 3 {
+			return 0, fmt.Errorf("%w: input %v", ErrNonTrucParent,
+				inp.OutPoint())
+		}
+	}
+
+	return 3, nil
+}
+
 // notifyResult sends the result to the resultChan specified by the requestID.
 // This channel is expected to be read by the caller.
 func (t *TxPublisher) notifyResult(result *BumpResult) {
@@ -1107,6 +1227,14 @@ func (t *TxPublisher) handleInitialTxError(r *monitorRecord, err error) {
 	case errors.Is(err, ErrTxNoOutput):
 		result.Event = TxFailed
 
+	// When the inputs can't be swept together in a v3 (TRUC) tx, we'll
+	// send a TxFailed so these inputs can be retried with a different
+	// group in the next block.
+	case errors.Is(err, ErrNonTrucParent),
+		errors.Is(err, ErrTrucChildTooLarge):
+
+		result.Event = TxFailed
+
 	// When the error is due to zero fee rate delta, we'll send a TxFailed
 	// so these inputs can be retried in the next block.
 	case errors.Is(err, ErrZeroFeeRateDelta):
@@ -1510,10 +1638,14 @@ func (t *TxPublisher) createSweepTx(inputs []input.Input,
 		return nil, err
 	}
 
+	version, err := sweepTxVersion(inputs)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
-		// Create the sweep transaction that we will be building. We
-		// use version 2 as it is required for CSV.
-		sweepTx = wire.NewMsgTx(2)
+		// Create the sweep transaction that we will be building.
+		sweepTx = wire.NewMsgTx(version)
 
 		// We'll add the inputs as we go so we know the final ordering
 		// of inputs to sign.
@@ -1599,6 +1731,20 @@ func (t *TxPublisher) createSweepTx(inputs []input.Input,
 		}
 	}
 
+	// A v3 tx spending an unconfirmed v3 parent, such as a zero-fee
+	// commitment, won't be relayed if it exceeds the maximum weight of a
+	// TRUC child.
+	if len(packageParents(inputs)) > 0 {
+		weight := blockchain.GetTransactionWeight(
+			btcutil.NewTx(sweepTx),
+		)
+		if weight > input.TrucChildMaxWeight {
+			return nil, fmt.Errorf("%w: weight=%v, max=%v",
+				ErrTrucChildTooLarge, weight,
+				input.TrucChildMaxWeight)
+		}
+	}
+
 	log.Debugf("Created sweep tx %v for inputs:\n%v", sweepTx.TxHash(),
 		inputTypeSummary(inputs))
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcutil/v2"
 	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/btcsuite/btcd/txscript/v2"
 	"github.com/btcsuite/btcd/wire/v2"
 	"github.com/btcsuite/btcwallet/chain"
 	"github.com/lightningnetwork/lnd/chainntnfs"
@@ -156,6 +157,54 @@ func TestCalcSweepTxWeight(t *testing.T) {
 	require.EqualValuesf(t, 487, weight, "unexpected weight %v", weight)
 }
 
+// TestPackageParents checks that the parents that must be broadcast in a
+// package with the sweep tx are collected once, and that a sweep of a P2A
+// anchor is a v3 tx.
+func TestPackageParents(t *testing.T) {
+	t.Parallel()
+
+	// A regular input neither has a parent nor requires a v3 sweep.
+	inp := createTestInput(100, input.WitnessKeyHash)
+	inputs := []input.Input{&inp}
+	require.Empty(t, packageParents(inputs))
+
+	version, err := sweepTxVersion(inputs)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, version)
+
+	// Two outputs of the same zero-fee commitment are spent. The
+	// commitment is only returned once.
+	commitTx := wire.NewMsgTx(3)
+	commitTx.AddTxOut(&wire.TxOut{PkScript: txscript.PayToAnchorScript})
+
+	parent := &input.TxInfo{Tx: commitTx}
+	for i := 0; i < 2; i++ {
+		anchor := input.MakeBaseInput(
+			&wire.OutPoint{Hash: commitTx.TxHash(), Index: uint32(i)},
+			input.P2AAnchorSpend, &input.SignDescriptor{
+				Output: commitTx.TxOut[0],
+			}, 1, parent,
+		)
+		inputs = append(inputs, &anchor)
+	}
+
+	require.Equal(t, []*wire.MsgTx{commitTx}, packageParents(inputs))
+
+	version, err = sweepTxVersion(inputs)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, version)
+
+	// A v3 sweep must not spend an unconfirmed output of a tx that isn't
+	// v3 itself, such as the anchor of a legacy anchor channel.
+	legacyAnchor := input.MakeBaseInput(
+		&wire.OutPoint{Index: 2}, input.CommitmentAnchor,
+		&input.SignDescriptor{Output: &wire.TxOut{Value: 330}}, 1,
+		&input.TxInfo{Fee: 100, Weight: 1000},
+	)
+	_, err = sweepTxVersion(append(inputs, &legacyAnchor))
+	require.ErrorIs(t, err, ErrNonTrucParent)
+}
+
 // TestBumpRequestMaxFeeRateAllowed tests the max fee rate allowed for a bump
 // request.
 func TestBumpRequestMaxFeeRateAllowed(t *testing.T) {
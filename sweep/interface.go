@@ -1,6 +1,7 @@
 package sweep
 
 import (
+	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/btcutil/v2"
 	"github.com/btcsuite/btcd/chainhash/v2"
 	"github.com/btcsuite/btcd/wire/v2"
@@ -8,6 +9,7 @@ import (
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 // Wallet contains all wallet related functionality required by sweeper.
@@ -16,6 +18,13 @@ type Wallet interface {
 	// broadcasts the passed transaction to the Bitcoin network.
 	PublishTransaction(tx *wire.MsgTx, label string) error
 
+	// SubmitPackage submits a package of related transactions, with the
+	// unconfirmed parents first and the child last, to the chain backend
+	// for atomic validation and acceptance.
+	SubmitPackage(txns []*wire.MsgTx,
+		maxFeeRate *chainfee.SatPerVByte) (*btcjson.SubmitPackageResult,
+		error)
+
 	// ListUnspentWitnessFromDefaultAccount returns all unspent outputs
 	// which are version 0 witness programs from the default wallet account.
 	// The 'minConfs' and 'maxConfs' parameters indicate the minimum
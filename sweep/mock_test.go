@@ -1,6 +1,7 @@
 package sweep
 
 import (
+	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/btcutil/v2"
 	"github.com/btcsuite/btcd/chainhash/v2"
 	"github.com/btcsuite/btcd/wire/v2"
@@ -136,6 +137,20 @@ func (m *MockWallet) PublishTransaction(tx *wire.MsgTx, label string) error {
 	return args.Error(0)
 }
 
+// SubmitPackage submits a package of related transactions to the chain
+// backend.
+func (m *MockWallet) SubmitPackage(txns []*wire.MsgTx,
+	maxFeeRate *chainfee.SatPerVByte) (*btcjson.SubmitPackageResult,
+	error) {
+
+	args := m.Called(txns, maxFeeRate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*btcjson.SubmitPackageResult), args.Error(1)
+}
+
 // ListUnspentWitnessFromDefaultAccount returns all unspent outputs which are
 // version 0 witness programs from the default wallet account.  The 'minConfs'
 // and 'maxConfs' parameters indicate the minimum and maximum number of
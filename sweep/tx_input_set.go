@@ -10,6 +10,7 @@ import (
 	"github.com/btcsuite/btcd/wire/v2"
 	"github.com/lightningnetwork/lnd/fn/v2"
 	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
@@ -266,6 +267,27 @@ func (b *BudgetInputSet) addWalletInput(utxo *lnwallet.Utxo) error {
 	return nil
 }
 
+// sweepsSharedAnchor returns true if the set contains the shared anchor of a
+// zero-fee commitment, which makes its sweep a v3 (TRUC) tx.
+func (b *BudgetInputSet) sweepsSharedAnchor() bool {
+	return fn.Any(b.inputs, func(inp *SweeperInput) bool {
+		return inp.WitnessType() == input.P2AAnchorSpend
+	})
+}
+
+// estimateWeight returns the estimated weight of a tx sweeping the inputs of
+// the set to a single change output.
+func (b *BudgetInputSet) estimateWeight() (lntypes.WeightUnit, error) {
+	_, estimator, err := getWeightEstimate(
+		b.Inputs(), nil, 0, 0, [][]byte{dummyChangePkScript},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return estimator.weight(), nil
+}
+
 // NeedWalletInput returns true if the input set needs more wallet inputs.
 //
 // A set may need wallet inputs when it has a required output or its total
@@ -358,22 +380,63 @@ func (b *BudgetInputSet) AddWalletInputs(wallet Wallet) error {
 		return fmt.Errorf("list unspent witness: %w", err)
 	}
 
+	// A sweep of the shared anchor of a zero-fee commitment is a v3 (TRUC)
+	// child of the unconfirmed commitment, which limits its weight.
+	truc := b.sweepsSharedAnchor()
+
 	// Sort the UTXOs by putting smaller values at the start of the slice
-	// to avoid locking large UTXO for sweeping.
+	// to avoid locking large UTXO for sweeping. For a TRUC child we put
+	// larger values first instead to cover the budget with as few inputs
+	// as possible.
 	//
 	// TODO(yy): add more choices to CoinSelectionStrategy and use the
 	// configured value here.
 	sort.Slice(utxos, func(i, j int) bool {
+		if truc {
+			return utxos[i].Value > utxos[j].Value
+		}
+
 		return utxos[i].Value < utxos[j].Value
 	})
 
 	// Add wallet inputs to the set until the specified budget is covered.
 	for _, utxo := range utxos {
+		// A v3 tx must not spend unconfirmed outputs of non-v3 txns.
+		// We only list confirmed utxos above, but double check here as
+		// the mempool would reject the sweep otherwise.
+		if truc && utxo.Confirmations < 1 {
+			log.Debugf("Skipped unconfirmed wallet input %v for "+
+				"TRUC sweep", utxo.OutPoint)
+
+			continue
+		}
+
 		err := b.addWalletInput(utxo)
 		if err != nil {
 			return err
 		}
 
+		// Remove the input again if it would push the sweep beyond
+		// the maximum weight of a TRUC child.
+		if truc {
+			weight, err := b.estimateWeight()
+			if err != nil {
+				return err
+			}
+
+			if weight > input.TrucChildMaxWeight {
+				log.Debugf("Skipped wallet input %v as the "+
+					"TRUC sweep would have weight %v, "+
+					"max is %v",
+					utxo.OutPoint, weight,
+					input.TrucChildMaxWeight)
+
+				b.inputs = b.inputs[:len(b.inputs)-1]
+
+				continue
+			}
+		}
+
 		// Return if we've reached the minimum output amount.
 		if !b.NeedWalletInput() {
 			return nil
@@ -7,9 +7,11 @@ import (
 
 	"github.com/btcsuite/btcd/btcutil/v2"
 	"github.com/btcsuite/btcd/chainhash/v2"
+	"github.com/btcsuite/btcd/txscript/v2"
 	"github.com/btcsuite/btcd/wire/v2"
 	"github.com/lightningnetwork/lnd/fn/v2"
 	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/stretchr/testify/require"
 )
@@ -454,6 +456,9 @@ func TestAddWalletInputsNotEnoughInputs(t *testing.T) {
 	// Create a mock input that has required outputs.
 	mockInput := &input.MockInput{}
 	mockInput.On("RequiredTxOut").Return(&wire.TxOut{})
+	mockInput.On("WitnessType").Return(
+		input.HtlcOfferedTimeoutSecondLevel,
+	)
 	defer mockInput.AssertExpectations(t)
 
 	sd := &input.SignDescriptor{
@@ -511,6 +516,9 @@ func TestAddWalletInputsEmptyWalletSuccess(t *testing.T) {
 	defer mockInput1.AssertExpectations(t)
 
 	mockInput1.On("RequiredTxOut").Return(&wire.TxOut{})
+	mockInput1.On("WitnessType").Return(
+		input.HtlcOfferedTimeoutSecondLevel,
+	)
 
 	sd := &input.SignDescriptor{
 		Output: &wire.TxOut{
@@ -530,6 +538,7 @@ func TestAddWalletInputsEmptyWalletSuccess(t *testing.T) {
 	defer mockInput2.AssertExpectations(t)
 
 	mockInput2.On("RequiredTxOut").Return(nil)
+	mockInput2.On("WitnessType").Return(input.CommitmentTimeLock)
 	sd2 := &input.SignDescriptor{
 		Output: &wire.TxOut{
 			Value: budget,
@@ -633,3 +642,79 @@ func TestAddWalletInputsSuccess(t *testing.T) {
 	// Weak check, a strong check is to open the slice and check each item.
 	require.Len(t, set.inputs, 3)
 }
+
+// TestAddWalletInputsTruc checks that a set sweeping the shared anchor of a
+// zero-fee commitment only adds confirmed wallet inputs, preferring larger
+// ones, and stays below the maximum weight of a TRUC child.
+func TestAddWalletInputsTruc(t *testing.T) {
+	t.Parallel()
+
+	wallet := &MockWallet{}
+	defer wallet.AssertExpectations(t)
+
+	// Specify the min and max confs used in
+	// ListUnspentWitnessFromDefaultAccount.
+	min, max := int32(1), int32(math.MaxInt32)
+
+	// The budget can't be covered by the wallet, so it keeps adding
+	// inputs until the weight limit is reached.
+	const budget = 1_000_000
+
+	commitTx := wire.NewMsgTx(3)
+	commitTx.AddTxOut(&wire.TxOut{
+		Value:    240,
+		PkScript: txscript.PayToAnchorScript,
+	})
+	anchor := input.MakeBaseInput(
+		&wire.OutPoint{Hash: commitTx.TxHash()}, input.P2AAnchorSpend,
+		&input.SignDescriptor{Output: commitTx.TxOut[0]}, 1,
+		&input.TxInfo{
+			Weight: input.ZeroFeeCommitWeight,
+			Tx:     commitTx,
+		},
+	)
+	pi := &SweeperInput{
+		Input:  &anchor,
+		params: Params{Budget: budget},
+	}
+
+	// The wallet returns an unconfirmed utxo that must be skipped, and
+	// more confirmed utxos than fit into a TRUC child.
+	unconfirmed := &lnwallet.Utxo{
+		AddressType: lnwallet.WitnessPubKey,
+		Value:       budget / 2,
+		OutPoint:    wire.OutPoint{Index: 100},
+	}
+	utxos := []*lnwallet.Utxo{unconfirmed}
+	for i := 0; i < 30; i++ {
+		utxos = append(utxos, &lnwallet.Utxo{
+			AddressType:   lnwallet.WitnessPubKey,
+			Value:         btcutil.Amount(1_000 + i),
+			Confirmations: 1,
+			OutPoint:      wire.OutPoint{Index: uint32(i)},
+		})
+	}
+
+	wallet.On("ListUnspentWitnessFromDefaultAccount",
+		min, max).Return(utxos, nil).Once()
+
+	set := BudgetInputSet{inputs: []*SweeperInput{pi}}
+	err := set.AddWalletInputs(wallet)
+	require.NoError(t, err)
+
+	// Some but not all of the confirmed utxos were added, starting with
+	// the largest one.
+	require.Greater(t, len(set.inputs), 1)
+	require.Less(t, len(set.inputs), len(utxos))
+	require.Equal(t, wire.OutPoint{Index: 29}, set.inputs[1].OutPoint())
+
+	for _, inp := range set.inputs {
+		require.NotEqual(t, unconfirmed.OutPoint, inp.OutPoint())
+	}
+
+	weight, err := set.estimateWeight()
+	require.NoError(t, err)
+	require.LessOrEqual(t, weight, lntypes.WeightUnit(
+		input.TrucChildMaxWeight,
+	))
+}
@@ -139,6 +139,40 @@ func (p *preimageBeacon) SubscribeUpdates(
 	return sub, nil
 }
 
+// SubscribePreimages returns a channel that will be sent upon *each* time a new
+// preimage is discovered, along with a function to cancel the subscription.
+// Unlike SubscribeUpdates, it isn't tied to an HTLC and doesn't notify the
+// HTLC interceptor.
+func (p *preimageBeacon) SubscribePreimages() (<-chan lntypes.Preimage,
+	func()) {
+
+	p.Lock()
+	clientID := p.clientCounter
+	client := &preimageSubscriber{
+		updateChan: make(chan lntypes.Preimage, 10),
+		quit:       make(chan struct{}),
+	}
+
+	p.subscribers[clientID] = client
+
+	p.clientCounter++
+	p.Unlock()
+
+	srvrLog.Debugf("Creating new preimage subscriber, id=%v", clientID)
+
+	var cancelOnce sync.Once
+	cancel := func() {
+		cancelOnce.Do(func() {
+			p.Lock()
+			delete(p.subscribers, clientID)
+			close(client.quit)
+			p.Unlock()
+		})
+	}
+
+	return client.updateChan, cancel
+}
+
 // LookupPreimage attempts to lookup a preimage in the global cache.  True is
 // returned for the second argument if the preimage is found.
 func (p *preimageBeacon) LookupPreimage(